@@ -3,10 +3,14 @@ package main
 import (
 	"flag"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
 	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/tui"
 	tea "github.com/charmbracelet/bubbletea"
 )
@@ -18,15 +22,19 @@ type cliFlags struct {
 	version         bool
 	help            bool
 	test            bool
+	portable        bool
 	dryRun          bool
 	nonInteractive  bool
 	terminal        string
 	shell           string
+	zshMerge        bool
 	windowMgr       string
+	wmExtras        string // comma-separated, "none" for a minimal config
 	nvim            bool
 	zed             bool
 	font            bool
 	backup          bool
+	backupCompress  bool
 	aiTools         string
 	aiFramework     bool
 	aiPreset        string
@@ -40,8 +48,18 @@ type cliFlags struct {
 	projectRolePack string // comma-separated: "developer,pm-lead"
 	skillInstall    string // comma-separated skill names to install
 	skillRemove     string // comma-separated skill names to remove
+	skillManifest   string // skills.txt/skills.json listing skills to install
+	skillExport     string // where to write the installed skill names as a manifest
+	summaryJSON     string // path for the skill operation JSON summary ("-" for stdout)
+	skillTargets    string // comma-separated skill link targets: claude,agents (default all)
 	repoDir         string // override repo directory name
 	repoURL         string // override repo git URL
+	repoRef         string // branch, tag or commit to pin the dotfiles clone to
+	skillsRef       string // branch, tag or commit to pin the skill catalog clone to
+	configPath      string // declarative install config (YAML or JSON)
+	noInteractive   bool   // skip steps that need terminal input
+	printSchema     bool
+	keepBackups     int // backups "Prune old backups" keeps in the TUI
 }
 
 func parseFlags() *cliFlags {
@@ -53,15 +71,19 @@ func parseFlags() *cliFlags {
 	flag.BoolVar(&flags.help, "h", false, "Show help message (shorthand)")
 	flag.BoolVar(&flags.test, "test", false, "Run in test mode (uses temporary directory)")
 	flag.BoolVar(&flags.test, "t", false, "Run in test mode (shorthand)")
+	flag.BoolVar(&flags.portable, "portable", false, "Keep installer state next to the binary instead of in $HOME")
 	flag.BoolVar(&flags.dryRun, "dry-run", false, "Show what would be installed without doing it")
 	flag.BoolVar(&flags.nonInteractive, "non-interactive", false, "Run without TUI, use CLI flags")
 	flag.StringVar(&flags.terminal, "terminal", "", "Terminal: alacritty, wezterm, kitty, ghostty, none")
 	flag.StringVar(&flags.shell, "shell", "", "Shell: fish, zsh, nushell")
+	flag.BoolVar(&flags.zshMerge, "zsh-merge", false, "Keep existing .zshrc and add a managed source block instead of replacing it")
 	flag.StringVar(&flags.windowMgr, "wm", "", "Window manager: tmux, zellij, none")
+	flag.StringVar(&flags.wmExtras, "wm-extras", "", "Window manager extras (comma-separated), none for a minimal config (default: all)")
 	flag.BoolVar(&flags.nvim, "nvim", false, "Install Neovim configuration")
 	flag.BoolVar(&flags.zed, "zed", false, "Install Zed editor with config")
 	flag.BoolVar(&flags.font, "font", false, "Install Nerd Font")
 	flag.BoolVar(&flags.backup, "backup", true, "Backup existing configs (default: true)")
+	flag.BoolVar(&flags.backupCompress, "backup-compress", false, "Write the backup as a .tar.gz archive instead of a directory")
 	flag.StringVar(&flags.aiTools, "ai-tools", "", "AI tools: claude,opencode,gemini,copilot,codex,qwen (comma-separated)")
 	flag.BoolVar(&flags.aiFramework, "ai-framework", false, "Install AI coding framework")
 	flag.StringVar(&flags.aiPreset, "ai-preset", "", "Framework preset: minimal, frontend, backend, fullstack, data, complete")
@@ -76,14 +98,32 @@ func parseFlags() *cliFlags {
 		"Role packs for Obsidian Brain: developer,pm-lead (comma-separated)")
 	flag.StringVar(&flags.skillInstall, "skill-install", "", "Skills to install (comma-separated)")
 	flag.StringVar(&flags.skillRemove, "skill-remove", "", "Skills to remove (comma-separated)")
+	flag.StringVar(&flags.skillManifest, "skill-manifest", "", "Install the skills listed in a skills.txt or skills.json file")
+	flag.StringVar(&flags.skillExport, "skill-export", "", "Write the installed skills to a manifest file (.json for JSON)")
+	flag.StringVar(&flags.skillTargets, "skill-targets", "", "Skill dirs to link into: claude,agents (comma-separated, default all)")
+	flag.StringVar(&flags.summaryJSON, "summary-json", "", "Write a JSON summary of skill operations to a file (- for stdout)")
 	flag.StringVar(&flags.repoDir, "repo-dir", "", "Override repo directory name (default: Gentleman.Dots, env: REPO_DIR)")
 	flag.StringVar(&flags.repoURL, "repo-url", "", "Override repo git URL (default: upstream Gentleman.Dots, env: REPO_URL)")
+	flag.StringVar(&flags.repoRef, "ref", "", "Pin the dotfiles clone to a branch, tag or commit (default: latest)")
+	flag.StringVar(&flags.skillsRef, "skills-ref", "", "Pin the skill catalog clone to a branch, tag or commit (default: latest)")
+	flag.StringVar(&flags.configPath, "config", "", "Install from a YAML or JSON config file (implies --non-interactive)")
+	flag.BoolVar(&flags.noInteractive, "no-interactive-steps", false, "With --config, skip steps that need terminal input instead of prompting")
+	flag.BoolVar(&flags.printSchema, "print-schema", false, "Print an annotated example --config file")
+	flag.IntVar(&flags.keepBackups, "keep-backups", system.DefaultBackupKeep, "Backups to keep when pruning old ones from the TUI")
 
 	flag.Parse()
 	return flags
 }
 
 func main() {
+	// Subcommands come before any flags
+	if len(os.Args) > 1 && os.Args[1] == "trainer" {
+		os.Exit(runTrainerCommand(os.Args[2:], os.Stdout, os.Stderr))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "skills" {
+		os.Exit(runSkillsCommand(os.Args[2:], os.Stdout, os.Stderr))
+	}
+
 	flags := parseFlags()
 
 	if flags.version {
@@ -96,18 +136,56 @@ func main() {
 		os.Exit(0)
 	}
 
+	if flags.printSchema {
+		if err := tui.WriteInstallConfigSchema(os.Stdout); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
 	if flags.test {
 		setupTestMode()
 	}
 
+	if err := setupPortableMode(flags.portable); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Only one installer may change shared state at a time; a dry run touches nothing
+	var lockHolder *system.InstanceLock
+	if !flags.dryRun {
+		holder, err := system.AcquireInstanceLock(lockPath())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not take the instance lock: %v\n", err)
+		}
+		lockHolder = holder
+	}
+
+	// Remove scratch directories that interrupted runs left behind, unless they may belong to the running instance
+	if !flags.dryRun && lockHolder == nil {
+		if removed, err := tui.SweepStaleTempDirs(); err == nil && len(removed) > 0 {
+			fmt.Printf("🧹 Cleaned up %d stale temporary directories from earlier runs\n", len(removed))
+		}
+	}
+
 	if flags.dryRun {
-		os.Setenv("GENTLEMAN_DRY_RUN", "1")
+		tui.SetDryRunMode(true)
 		fmt.Println("🧪 Dry-run mode: No actual installations will be performed")
 	}
 
-	// Non-interactive mode: run installation directly with provided flags
-	if flags.nonInteractive {
-		if err := runNonInteractive(flags); err != nil {
+	// Non-interactive mode: run installation directly with provided flags or config.
+	// A dry run only prints the plan, so it always takes this path.
+	if flags.nonInteractive || flags.dryRun || flags.configPath != "" {
+		if lockHolder != nil {
+			fmt.Fprintf(os.Stderr, "Error: another installer (PID %d) is running; wait for it to finish\n", lockHolder.PID)
+			os.Exit(1)
+		}
+		err := runNonInteractive(flags)
+		printLogPath()
+		releaseLock()
+		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(1)
 		}
@@ -130,6 +208,18 @@ func main() {
 	} else if env := os.Getenv("REPO_URL"); env != "" {
 		model.RepoURL = env
 	}
+	model.Choices.RepoRef = flags.repoRef
+	model.Choices.SkillsRef = flags.skillsRef
+
+	model.BackupKeep = flags.keepBackups
+
+	// Save progress and the full log as steps run, and offer to resume an install that stopped early.
+	// A second instance skips both and only offers what can't corrupt the first one's state.
+	if lockHolder != nil {
+		model.SetLockHolder(lockHolder)
+	} else if err := model.EnableResume(os.Getenv("HOME")); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: could not read the previous install's progress: %v\n", err)
+	}
 
 	p := tea.NewProgram(
 		model,
@@ -138,12 +228,54 @@ func main() {
 	)
 	tui.SetGlobalProgram(p)
 
-	if _, err := p.Run(); err != nil {
+	err := runProgram(p)
+	printLogPath()
+	releaseLock()
+	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error running installer: %v\n", err)
 		os.Exit(1)
 	}
 }
 
+// lockPath is where the instance lock of this user's data dir lives
+func lockPath() string {
+	return system.InstanceLockPath(os.Getenv("HOME"))
+}
+
+// releaseLock frees the instance lock if this process holds it
+func releaseLock() {
+	if err := system.ReleaseInstanceLock(lockPath()); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: could not remove the instance lock: %v\n", err)
+	}
+}
+
+// printLogPath tells where the full log of the install that just ran was written
+func printLogPath() {
+	if path := tui.GetLogPath(); path != "" {
+		fmt.Printf("📄 Install log: %s\n", path)
+	}
+}
+
+// runProgram runs the TUI and removes the install's temporary directories however
+// it exits: completion, a failed step, cancellation or quitting mid-install
+func runProgram(p *tea.Program) error {
+	defer cleanupTempDirs()
+	final, err := p.Run()
+	if m, ok := final.(tui.Model); ok {
+		if err := m.SaveSettings(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not save the installer settings: %v\n", err)
+		}
+	}
+	return err
+}
+
+// cleanupTempDirs removes the install's temporary directories and reports any it had to leave
+func cleanupTempDirs() {
+	for _, dir := range tui.CleanupTempDirs() {
+		fmt.Fprintf(os.Stderr, "Warning: could not remove temporary directory %s\n", dir)
+	}
+}
+
 // parseRolePacks validates and parses the --project-role-pack flag value.
 // It ensures role packs require obsidian-brain memory, validates pack names,
 // and always prepends "core" for obsidian-brain memory (deduplicated).
@@ -180,6 +312,12 @@ func parseRolePacks(rolePack string, memory string) ([]string, error) {
 }
 
 func runNonInteractive(flags *cliFlags) error {
+	defer cleanupTempDirs()
+
+	if flags.configPath != "" {
+		return runConfigInstall(flags)
+	}
+
 	// Handle project init
 	if flags.initProject {
 		if flags.projectPath == "" {
@@ -234,6 +372,11 @@ func runNonInteractive(flags *cliFlags) error {
 		}
 		fmt.Println()
 
+		if flags.dryRun {
+			fmt.Println("🧪 Dry run: would clone project-starter-framework and run init-project.sh with these options")
+			return nil
+		}
+
 		tui.SetNonInteractiveMode(true)
 		if err := tui.RunProjectInitScript(absPath, memory, ci, flags.projectEngram, rolePacks); err != nil {
 			return fmt.Errorf("project initialization failed: %w", err)
@@ -243,68 +386,26 @@ func runNonInteractive(flags *cliFlags) error {
 	}
 
 	// Handle skill operations
-	if flags.skillInstall != "" {
-		names := strings.Split(flags.skillInstall, ",")
-		for i := range names {
-			names[i] = strings.TrimSpace(names[i])
+	if flags.hasSkillOperations() && flags.dryRun {
+		if flags.skillInstall != "" {
+			fmt.Printf("🧪 Dry run: would install skills: %s\n", flags.skillInstall)
 		}
-		fmt.Printf("📥 Installing %d skill(s)...\n", len(names))
-		tui.SetNonInteractiveMode(true)
-
-		// Fetch catalog to get SkillInfo for each requested name
-		catalog, err := tui.FetchSkillCatalog()
-		if err != nil {
-			return fmt.Errorf("failed to fetch skill catalog: %w", err)
+		if flags.skillManifest != "" {
+			fmt.Printf("🧪 Dry run: would install the skills listed in %s\n", flags.skillManifest)
 		}
-		var toInstall []tui.SkillInfo
-		nameSet := make(map[string]bool)
-		for _, n := range names {
-			nameSet[n] = true
+		if flags.skillRemove != "" {
+			fmt.Printf("🧪 Dry run: would remove skills: %s\n", flags.skillRemove)
 		}
-		for _, s := range catalog {
-			if nameSet[s.Name] || nameSet[s.DirName] {
-				toInstall = append(toInstall, s)
-			}
+		if flags.skillExport != "" {
+			fmt.Printf("🧪 Dry run: would export the installed skills to %s\n", flags.skillExport)
 		}
-		if len(toInstall) == 0 {
-			return fmt.Errorf("no matching skills found in catalog for: %s", strings.Join(names, ", "))
-		}
-
-		logLines, err := tui.InstallSkillSymlinks(toInstall)
-		for _, line := range logLines {
-			fmt.Println("  " + line)
-		}
-		if err != nil {
-			return fmt.Errorf("skill installation: %w", err)
-		}
-		fmt.Println("✅ Skills installed!")
 		if flags.shell == "" {
-			return nil // Only skill operation, no env install
+			return nil
 		}
-	}
-
-	if flags.skillRemove != "" {
-		names := strings.Split(flags.skillRemove, ",")
-		for i := range names {
-			names[i] = strings.TrimSpace(names[i])
-		}
-		fmt.Printf("🗑️  Removing %d skill(s)...\n", len(names))
-		tui.SetNonInteractiveMode(true)
-
-		// Build SkillInfo from names (we only need the Name field for removal)
-		var toRemove []tui.SkillInfo
-		for _, n := range names {
-			toRemove = append(toRemove, tui.SkillInfo{Name: n})
-		}
-
-		logLines, err := tui.RemoveSkillSymlinks(toRemove)
-		for _, line := range logLines {
-			fmt.Println("  " + line)
-		}
-		if err != nil {
-			return fmt.Errorf("skill removal: %w", err)
+	} else if flags.hasSkillOperations() {
+		if err := runSkillOperations(flags); err != nil {
+			return err
 		}
-		fmt.Println("✅ Skills removed!")
 		if flags.shell == "" {
 			return nil // Only skill operation, no env install
 		}
@@ -343,6 +444,18 @@ func runNonInteractive(flags *cliFlags) error {
 		wm = "none"
 	}
 
+	// Parse window manager extras; unset keeps every extra, "none" picks none
+	var wmExtras []string
+	if extras := strings.ToLower(strings.TrimSpace(flags.wmExtras)); extras == "none" {
+		wmExtras = []string{}
+	} else if extras != "" {
+		for _, extra := range strings.Split(extras, ",") {
+			if extra = strings.TrimSpace(extra); extra != "" {
+				wmExtras = append(wmExtras, extra)
+			}
+		}
+	}
+
 	// Parse AI tools
 	var aiTools []string
 	if flags.aiTools != "" {
@@ -386,27 +499,96 @@ func runNonInteractive(flags *cliFlags) error {
 	choices := tui.UserChoices{
 		Terminal:              terminal,
 		Shell:                 shell,
+		ZshMerge:              flags.zshMerge && shell == "zsh",
 		WindowMgr:             wm,
+		WMExtras:              wmExtras,
 		InstallNvim:           flags.nvim,
 		InstallZed:            flags.zed,
 		InstallFont:           flags.font,
 		CreateBackup:          flags.backup,
+		BackupCompress:        flags.backupCompress,
 		AITools:               aiTools,
 		InstallAIFramework:    installFramework,
 		AIFrameworkPreset:     aiPreset,
 		AIFrameworkModules:    aiModules,
 		InstallAgentTeamsLite: flags.agentTeamsLite,
+		RepoRef:               flags.repoRef,
+		SkillsRef:             flags.skillsRef,
 	}
 
+	printChoicesSummary(choices)
+
+	repoDir, repoURL := resolveRepo(flags)
+
+	// Run the installation
+	return tui.RunNonInteractive(choices, repoDir, repoURL)
+}
+
+// configConflicts are the flags a --config file replaces; mixing them would
+// leave it unclear which one wins
+var configConflicts = []string{
+	"terminal", "shell", "zsh-merge", "wm", "wm-extras", "nvim", "zed", "font", "backup", "backup-compress",
+	"ai-tools", "ai-framework", "ai-preset", "ai-modules", "agent-teams-lite",
+	"init-project", "project-path", "project-memory", "project-ci", "project-engram", "project-role-pack",
+	"skill-install", "skill-remove", "skill-manifest", "skill-export", "skill-targets", "summary-json",
+}
+
+// runConfigInstall installs from the --config file instead of the choice flags
+func runConfigInstall(flags *cliFlags) error {
+	var conflicts []string
+	flag.Visit(func(f *flag.Flag) {
+		for _, name := range configConflicts {
+			if f.Name == name {
+				conflicts = append(conflicts, "--"+name)
+			}
+		}
+	})
+	if len(conflicts) > 0 {
+		return fmt.Errorf("%s can't be combined with --config; set them in the config file", strings.Join(conflicts, ", "))
+	}
+
+	choices, err := tui.LoadInstallConfig(flags.configPath)
+	if err != nil {
+		return err
+	}
+	// --ref and --skills-ref pin a shared config for one run
+	if flags.repoRef != "" {
+		choices.RepoRef = flags.repoRef
+	}
+	if flags.skillsRef != "" {
+		choices.SkillsRef = flags.skillsRef
+	}
+
+	fmt.Printf("📄 Config: %s\n", flags.configPath)
+	printChoicesSummary(choices)
+
+	repoDir, repoURL := resolveRepo(flags)
+	return tui.RunInstallConfig(choices, repoDir, repoURL, flags.noInteractive)
+}
+
+// printChoicesSummary prints the choices a non-interactive install is about to apply
+func printChoicesSummary(choices tui.UserChoices) {
 	fmt.Println("🚀 Javi.Dots Non-Interactive Installer")
 	fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
 	fmt.Printf("  Terminal:    %s\n", choices.Terminal)
 	fmt.Printf("  Shell:       %s\n", choices.Shell)
+	if choices.ZshMerge {
+		fmt.Printf("  Zsh merge:   keep existing .zshrc\n")
+	}
 	fmt.Printf("  Window Mgr:  %s\n", choices.WindowMgr)
+	if len(choices.WMExtras) > 0 {
+		fmt.Printf("  WM Extras:   %s\n", strings.Join(choices.WMExtras, ", "))
+	} else if choices.WMExtras != nil {
+		fmt.Printf("  WM Extras:   none\n")
+	}
 	fmt.Printf("  Neovim:      %v\n", choices.InstallNvim)
 	fmt.Printf("  Zed:         %v\n", choices.InstallZed)
 	fmt.Printf("  Font:        %v\n", choices.InstallFont)
-	fmt.Printf("  Backup:      %v\n", choices.CreateBackup)
+	if choices.CreateBackup && choices.BackupCompress {
+		fmt.Printf("  Backup:      true (%s)\n", system.BackupArchiveExt)
+	} else {
+		fmt.Printf("  Backup:      %v\n", choices.CreateBackup)
+	}
 	if len(choices.AITools) > 0 {
 		fmt.Printf("  AI Tools:    %s\n", strings.Join(choices.AITools, ", "))
 	}
@@ -422,9 +604,21 @@ func runNonInteractive(flags *cliFlags) error {
 			fmt.Printf("  Agent Teams:  yes\n")
 		}
 	}
+	if choices.InitProject {
+		fmt.Printf("  Project:     %s (memory %s, CI %s)\n", choices.ProjectPath, choices.ProjectMemory, choices.ProjectCI)
+	}
+	if choices.RepoRef != "" {
+		fmt.Printf("  Dotfiles:    pinned to %s\n", choices.RepoRef)
+	}
+	if choices.SkillsRef != "" {
+		fmt.Printf("  Skills:      pinned to %s\n", choices.SkillsRef)
+	}
 	fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
 	fmt.Println()
+}
 
+// resolveRepo picks the repo dir and URL to clone: flag > env > default
+func resolveRepo(flags *cliFlags) (string, string) {
 	// Resolve repo dir: flag > env > default
 	repoDir := tui.DefaultRepoDir
 	if flags.repoDir != "" {
@@ -440,9 +634,136 @@ func runNonInteractive(flags *cliFlags) error {
 	} else if env := os.Getenv("REPO_URL"); env != "" {
 		repoURL = env
 	}
+	return repoDir, repoURL
+}
 
-	// Run the installation
-	return tui.RunNonInteractive(choices, repoDir, repoURL)
+// splitNames splits a comma-separated flag value, dropping blanks
+func splitNames(value string) []string {
+	var names []string
+	for _, n := range strings.Split(value, ",") {
+		if n = strings.TrimSpace(n); n != "" {
+			names = append(names, n)
+		}
+	}
+	return names
+}
+
+// hasSkillOperations reports whether any Skill Manager flag asks for work
+func (f *cliFlags) hasSkillOperations() bool {
+	return f.skillInstall != "" || f.skillRemove != "" || f.skillManifest != "" || f.skillExport != ""
+}
+
+// skillInstallNames are the names of --skill-install followed by the ones
+// --skill-manifest lists, each once
+func skillInstallNames(flags *cliFlags) ([]string, error) {
+	names := splitNames(flags.skillInstall)
+	if flags.skillManifest == "" {
+		return names, nil
+	}
+	listed, err := tui.ReadSkillManifest(flags.skillManifest)
+	if err != nil {
+		return nil, fmt.Errorf("skill manifest: %w", err)
+	}
+	for _, n := range listed {
+		if !slices.Contains(names, n) {
+			names = append(names, n)
+		}
+	}
+	return names, nil
+}
+
+// runSkillOperations installs and removes skills from --skill-install,
+// --skill-manifest and --skill-remove, then writes --skill-export and the
+// --summary-json report. Any failed skill makes it return an error.
+func runSkillOperations(flags *cliFlags) error {
+	targets, err := tui.ParseSkillTargets(flags.skillTargets)
+	if err != nil {
+		return err
+	}
+	opts := tui.SkillLinkOptions{Targets: targets}
+
+	// Keep stdout clean for the JSON when it goes there
+	out := io.Writer(os.Stdout)
+	if flags.summaryJSON == "-" {
+		out = os.Stderr
+	}
+	tui.SetNonInteractiveMode(true)
+
+	summary := tui.NewSkillSummary(tui.SkillCatalogCommit())
+
+	names, err := skillInstallNames(flags)
+	if err != nil {
+		return err
+	}
+	if len(names) > 0 {
+		summary.RequestedAdd = append(summary.RequestedAdd, names...)
+		fmt.Fprintf(out, "📥 Installing %d skill(s)...\n", len(names))
+
+		// Fetch catalog to get SkillInfo for each requested name
+		catalog, warnings, err := tui.FetchSkillCatalog()
+		if err != nil {
+			return fmt.Errorf("failed to fetch skill catalog: %w", err)
+		}
+		// A broken catalog entry would otherwise only show up as "not found"
+		for _, w := range warnings {
+			fmt.Fprintf(out, "  ⚠️  Catalog entry skipped: %s (%s)\n", w.Path, w.Reason)
+		}
+		// The catalog may have been cloned just now
+		summary.CatalogCommit = tui.SkillCatalogCommit()
+
+		toInstall, missing := tui.MatchCatalogSkills(catalog, names)
+		results, logLines, _ := tui.InstallCatalogSkills(toInstall, missing, opts)
+		for _, line := range logLines {
+			fmt.Fprintln(out, "  "+line)
+		}
+		summary.AddResults(results)
+	}
+
+	if flags.skillRemove != "" {
+		names := splitNames(flags.skillRemove)
+		summary.RequestedRemove = append(summary.RequestedRemove, names...)
+		fmt.Fprintf(out, "🗑️  Removing %d skill(s)...\n", len(names))
+
+		// Offline: names the catalog on disk lacks are removed as bare links
+		catalog, err := tui.LocalSkillCatalog()
+		if err != nil {
+			return err
+		}
+		results, logLines, _ := tui.RemoveCatalogSkills(tui.SkillsToRemove(catalog, names), opts)
+		for _, line := range logLines {
+			fmt.Fprintln(out, "  "+line)
+		}
+		summary.AddResults(results)
+	}
+
+	// After the installs and removals, so the manifest shows where they left off
+	if flags.skillExport != "" {
+		catalog, _, err := tui.FetchSkillCatalog()
+		if err != nil {
+			return fmt.Errorf("failed to fetch skill catalog: %w", err)
+		}
+		installed := tui.InstalledSkillNames(catalog)
+		if err := tui.WriteSkillManifest(flags.skillExport, installed); err != nil {
+			return fmt.Errorf("skill export: %w", err)
+		}
+		fmt.Fprintf(out, "📤 Exported %d skill(s) to %s\n", len(installed), flags.skillExport)
+	}
+
+	if flags.summaryJSON != "" {
+		if err := summary.WriteFile(flags.summaryJSON); err != nil {
+			return err
+		}
+	}
+
+	if len(summary.Failed) > 0 {
+		var failed []string
+		for _, f := range summary.Failed {
+			failed = append(failed, f.Name)
+		}
+		return fmt.Errorf("skill operation failed for: %s", strings.Join(failed, ", "))
+	}
+	fmt.Fprintln(out, "✅ Skill operations complete!")
+	return nil
 }
 
 func setupTestMode() {
@@ -467,35 +788,82 @@ func setupTestMode() {
 	fmt.Scanln()
 }
 
+// setupPortableMode turns portable mode on when --portable is given or a
+// portable.marker sits next to the executable
+func setupPortableMode(flagged bool) error {
+	exe, err := os.Executable()
+	if err != nil {
+		if flagged {
+			return fmt.Errorf("--portable: cannot locate the executable: %w", err)
+		}
+		return nil
+	}
+	if resolved, err := filepath.EvalSymlinks(exe); err == nil {
+		exe = resolved
+	}
+	if !flagged && !paths.HasPortableMarker(exe) {
+		return nil
+	}
+
+	dir := paths.PortableStateDir(exe)
+	if err := paths.EnablePortable(dir); err != nil {
+		return fmt.Errorf("cannot create portable state directory %s: %w", dir, err)
+	}
+	fmt.Printf("🧳 Portable mode: installer state is kept in %s\n", dir)
+	return nil
+}
+
 func printHelp() {
 	fmt.Println(`javi.dots - TUI installer for Javi.Dots terminal environment (fork of Gentleman.Dots)
 
 Usage:
   gentleman.dots [flags]
+  gentleman.dots trainer list [--json]
+  gentleman.dots skills <list|install|remove|update> [names...] [--json]
 
 Interactive Mode (default):
   Just run 'gentleman.dots' to start the TUI installer.
 
 Non-Interactive Mode:
   gentleman.dots --non-interactive --shell=<shell> [options]
+  gentleman.dots --config=<file> [--no-interactive-steps]
 
 Flags:
   -h, --help           Show this help message
   -v, --version        Show version information
   -t, --test           Run in test mode (uses temporary directory)
-  --dry-run            Show what would be installed without doing it
+  --portable           Keep installer state in state/ next to the binary
+                       (also enabled by a portable.marker file next to it)
+  --dry-run            Print the resolved choices and planned steps without running them
+                       (implies --non-interactive; exits non-zero on invalid choices)
   --non-interactive    Run without TUI, use CLI flags instead
+  --keep-backups=<n>   Backups "Prune old backups" keeps on the restore screen (default: 5)
+
+Config File Options:
+  --config=<file>      Install from a YAML (.yaml/.yml) or JSON (.json) config file
+                       (implies --non-interactive; unknown keys are an error)
+  --no-interactive-steps
+                       Skip steps that need terminal input (sudo, chsh) with a warning
+                       instead of prompting for them
+  --print-schema       Print an annotated example config file
 
 Non-Interactive Options:
   --repo-dir=<dir>     Override repo directory name (default: Gentleman.Dots, env: REPO_DIR)
   --repo-url=<url>     Override repo git URL (default: upstream Gentleman.Dots, env: REPO_URL)
+  --ref=<ref>          Pin the dotfiles clone to a branch, tag or commit (default: latest)
+  --skills-ref=<ref>   Pin the skill catalog clone to a branch, tag or commit (default: latest)
+                       The commits used are recorded in ~/.gentleman/install-manifest.json
   --shell=<shell>      Shell to install (required): fish, zsh, nushell
+  --zsh-merge          Keep existing .zshrc, add a managed source block (zsh only)
   --terminal=<term>    Terminal: alacritty, wezterm, kitty, ghostty, none
   --wm=<wm>            Window manager: tmux, zellij, none
+  --wm-extras=<x>      Window manager extras (comma-separated, default: all; none for a minimal config)
+                       tmux: essentials, resurrect, kanagawa; zellij: layouts, statusbar, forgot
   --nvim               Install Neovim configuration
   --zed                Install Zed editor with config
   --font               Install Nerd Font
   --backup=false       Disable config backup (default: true)
+  --backup-compress    Write the backup as a .tar.gz archive instead of a directory
 
 AI Options:
   --ai-tools=<tools>   AI tools (comma-separated): claude, opencode, gemini, copilot, codex, qwen
@@ -514,8 +882,29 @@ Project Init Options:
   --project-role-pack=<p>  Role packs: developer,pm-lead (comma-separated, core always included)
 
 Skill Manager Options:
-  --skill-install=<s>  Skills to install (comma-separated names)
+  --skill-install=<s>  Skills to install (comma-separated names); a local skill
+                       directory with the same name is skipped with a warning
   --skill-remove=<s>   Skills to remove (comma-separated names)
+  --skill-manifest=<f> Install the skills listed in <f>: a skills.json ({"skills": [...]})
+                       or a text file with a name per line (# comments); unknown names fail
+  --skill-export=<f>   Write the installed skills to <f> as a manifest (.json for JSON)
+  --skill-targets=<t>  Skill dirs to link into or remove from (comma-separated):
+                       claude (~/.claude/skills), agents (~/.agents/skills), all (default)
+  --summary-json=<f>   Write a JSON summary of skill operations to <f> (- for stdout);
+                       exits non-zero if any requested skill failed
+
+Skill Commands:
+  skills list          Print the skill catalog with each skill's install status
+  skills install <s>   Install skills by name (--all-curated for every curated skill)
+  skills remove <s>    Remove skills
+  skills update        Pull the catalog and the external skill clones
+                       Each takes --json for scripting and --targets=claude,agents;
+                       install, remove and update exit non-zero if anything failed
+
+Trainer Content:
+  trainer list         Print every trainer module with its lesson, practice and boss counts;
+                       exits non-zero if two exercises share an ID
+  trainer list --json  The same listing as JSON, for docs generation
 
 Examples:
   # Interactive TUI
@@ -531,6 +920,10 @@ Examples:
   gentleman.dots --non-interactive --shell=zsh --ai-tools=claude --ai-framework \
     --ai-modules=hooks,skills --agent-teams-lite
 
+  # Install from a config file in CI, skipping password prompts
+  gentleman.dots --print-schema > javi-dots.yaml
+  gentleman.dots --config=javi-dots.yaml --no-interactive-steps
+
   # Test mode with Zsh + Tmux (no terminal, no nvim)
   gentleman.dots --test --non-interactive --shell=zsh --wm=tmux
 
@@ -546,6 +939,13 @@ Examples:
   # Remove skills
   gentleman.dots --non-interactive --skill-remove=react-19
 
+  # Share a team's skill set
+  gentleman.dots --non-interactive --skill-export=skills.txt
+  gentleman.dots --non-interactive --skill-manifest=skills.txt
+
+  # Install skills for Claude Code only
+  gentleman.dots --non-interactive --skill-install=react-19 --skill-targets=claude
+
   # Verbose output (shows all command logs)
   GENTLEMAN_VERBOSE=1 gentleman.dots --non-interactive --shell=fish --nvim
 
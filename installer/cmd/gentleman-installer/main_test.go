@@ -1,8 +1,12 @@
 package main
 
 import (
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/tui"
 )
 
 func TestParseRolePacks(t *testing.T) {
@@ -104,3 +108,35 @@ func TestParseRolePacks(t *testing.T) {
 		}
 	})
 }
+
+func TestMatchCatalogSkills(t *testing.T) {
+	catalog := []tui.SkillInfo{
+		{Name: "react-19", DirName: "react-19"},
+		{Name: "TypeScript", DirName: "typescript"},
+	}
+
+	matched, missing := tui.MatchCatalogSkills(catalog, splitNames("react-19, typescript,,nope "))
+	if len(matched) != 2 {
+		t.Errorf("expected 2 matches (by name and by dir name), got %d", len(matched))
+	}
+	if len(missing) != 1 || missing[0] != "nope" {
+		t.Errorf("expected [nope] missing, got %v", missing)
+	}
+}
+
+func TestSkillInstallNamesFromManifest(t *testing.T) {
+	manifest := filepath.Join(t.TempDir(), "skills.txt")
+	os.WriteFile(manifest, []byte("# team\ntypescript\nreact-19\ntypescript\n"), 0644)
+
+	names, err := skillInstallNames(&cliFlags{skillInstall: "react-19", skillManifest: manifest})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(names, ",") != "react-19,typescript" {
+		t.Errorf("expected each name once, flags first, got %v", names)
+	}
+
+	if _, err := skillInstallNames(&cliFlags{skillManifest: manifest + ".missing"}); err == nil {
+		t.Error("a missing manifest should be an error")
+	}
+}
@@ -0,0 +1,241 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/tui"
+)
+
+const skillsUsage = `Usage: gentleman.dots skills <command> [--json] [--targets=claude,agents]
+
+Commands:
+  list                   List the catalog with each skill's install status
+  install <name>...      Install skills by name or folder name
+  install --all-curated  Install every curated skill
+  remove <name>...       Remove skills
+  update                 Pull the catalog and the external skill clones`
+
+// skillWarningJSON is a catalog entry "skills list" skipped
+type skillWarningJSON struct {
+	Path   string `json:"path"`
+	Reason string `json:"reason"`
+}
+
+// skillsListing is the --json output of "skills list"
+type skillsListing struct {
+	Skills   []tui.SkillStatus  `json:"skills"`
+	Warnings []skillWarningJSON `json:"warnings"`
+}
+
+// runSkillsCommand handles "gentleman.dots skills <command>" and returns the
+// exit code: 1 when any skill failed, 2 for a usage error
+func runSkillsCommand(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprintln(stderr, skillsUsage)
+		return 2
+	}
+
+	fs := flag.NewFlagSet("skills "+args[0], flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	asJSON := fs.Bool("json", false, "Print the result as JSON")
+	targetsFlag := fs.String("targets", "", "Skill dirs to link into or remove from: claude,agents (default all)")
+	allCurated := fs.Bool("all-curated", false, "Install every curated skill")
+	names, err := parseInterspersed(fs, args[1:])
+	if err != nil {
+		return 2
+	}
+	targets, err := tui.ParseSkillTargets(*targetsFlag)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return 2
+	}
+	opts := tui.SkillLinkOptions{Targets: targets}
+
+	usageErr := func(msg string) int {
+		fmt.Fprintf(stderr, "Error: %s\n\n%s\n", msg, skillsUsage)
+		return 2
+	}
+	if *allCurated && args[0] != "install" {
+		return usageErr("--all-curated only applies to install")
+	}
+	switch args[0] {
+	case "list", "update":
+		if len(names) > 0 {
+			return usageErr("skills " + args[0] + " takes no names")
+		}
+	case "install":
+		if len(names) == 0 && !*allCurated {
+			return usageErr("name the skills to install, or pass --all-curated")
+		}
+	case "remove":
+		if len(names) == 0 {
+			return usageErr("name the skills to remove")
+		}
+	default:
+		return usageErr("unknown command " + args[0])
+	}
+
+	tui.SetNonInteractiveMode(true)
+	if args[0] == "update" {
+		return runSkillsUpdate(*asJSON, stdout, stderr)
+	}
+
+	// Removing works offline, so it never clones the catalog
+	var catalog []tui.SkillInfo
+	var warnings []tui.SkillCatalogWarning
+	if args[0] == "remove" {
+		catalog, err = tui.LocalSkillCatalog()
+	} else {
+		catalog, warnings, err = tui.FetchSkillCatalog()
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: failed to fetch skill catalog: %v\n", err)
+		return 1
+	}
+	// A broken catalog entry would otherwise only show up as "not found"
+	if !*asJSON || args[0] != "list" {
+		for _, w := range warnings {
+			fmt.Fprintf(stderr, "⚠️  Catalog entry skipped: %s (%s)\n", w.Path, w.Reason)
+		}
+	}
+
+	if args[0] == "list" {
+		listing := skillsListing{Skills: tui.SkillStatuses(catalog), Warnings: []skillWarningJSON{}}
+		for _, w := range warnings {
+			listing.Warnings = append(listing.Warnings, skillWarningJSON{Path: w.Path, Reason: w.Reason})
+		}
+		if *asJSON {
+			err = writeJSON(stdout, listing)
+		} else {
+			err = writeSkillsTable(stdout, listing.Skills)
+		}
+		if err != nil {
+			fmt.Fprintf(stderr, "Error: %v\n", err)
+			return 1
+		}
+		return 0
+	}
+
+	summary := tui.NewSkillSummary(tui.SkillCatalogCommit())
+	var results []tui.SkillResult
+	var logLines []string
+	if args[0] == "install" {
+		if *allCurated {
+			for _, s := range tui.CuratedSkills(catalog) {
+				names = append(names, s.Name)
+			}
+		}
+		summary.RequestedAdd = append(summary.RequestedAdd, names...)
+		skills, missing := tui.MatchCatalogSkills(catalog, names)
+		results, logLines, _ = tui.InstallCatalogSkills(skills, missing, opts)
+	} else {
+		summary.RequestedRemove = append(summary.RequestedRemove, names...)
+		results, logLines, _ = tui.RemoveCatalogSkills(tui.SkillsToRemove(catalog, names), opts)
+	}
+	summary.AddResults(results)
+
+	if *asJSON {
+		err = summary.Write(stdout)
+	} else {
+		for _, line := range logLines {
+			fmt.Fprintln(stdout, line)
+		}
+		fmt.Fprintf(stdout, "\n%d installed, %d removed, %d skipped, %d failed\n",
+			len(summary.Installed), len(summary.Removed), len(summary.Skipped), len(summary.Failed))
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return 1
+	}
+	if len(summary.Failed) > 0 {
+		return 1
+	}
+	return 0
+}
+
+// runSkillsUpdate pulls the catalog and reports what changed for the linked skills
+func runSkillsUpdate(asJSON bool, stdout, stderr io.Writer) int {
+	report, err := tui.UpdateSkillCatalog(func(string) {})
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return 1
+	}
+	if asJSON {
+		err = writeJSON(stdout, report)
+	} else {
+		for _, line := range report.Log {
+			fmt.Fprintln(stdout, line)
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return 1
+	}
+	if len(report.Failed) > 0 {
+		return 1
+	}
+	return 0
+}
+
+// parseInterspersed parses args with fs, allowing flags after the names
+// ("skills install react-19 --json"), and returns the names
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var names []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			return names, nil
+		}
+		names = append(names, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
+
+// writeJSON encodes v as indented JSON followed by a newline
+func writeJSON(w io.Writer, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+// writeSkillsTable prints one row per skill and the installed count
+func writeSkillsTable(w io.Writer, skills []tui.SkillStatus) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tCATEGORY\tSTATUS\tDESCRIPTION")
+	installed := 0
+	for _, s := range skills {
+		status := "-"
+		if s.Installed {
+			installed++
+			status = "installed"
+			if len(s.InstalledIn) > 0 {
+				status += " (" + strings.Join(s.InstalledIn, ", ") + ")"
+			}
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", s.Name, s.Category, status, truncate(s.Description, 60))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(w, "\n%d skills, %d installed\n", len(skills), installed)
+	return err
+}
+
+// truncate cuts s to max runes, ending in "…" when it was longer
+func truncate(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max-1]) + "…"
+}
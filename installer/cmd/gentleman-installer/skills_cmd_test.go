@@ -0,0 +1,174 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/tui"
+)
+
+// skillsHome sets HOME to a temp dir holding a small skill catalog
+func skillsHome(t *testing.T) string {
+	t.Helper()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	for _, rel := range []string{"curated/react-19", "curated/typescript", "community/chi-router"} {
+		dir := filepath.Join(home, ".gentleman", "skills", rel)
+		os.MkdirAll(dir, 0755)
+		content := "---\nname: " + filepath.Base(rel) + "\ndescription: " + filepath.Base(rel) + " patterns\n---\n"
+		if err := os.WriteFile(filepath.Join(dir, "SKILL.md"), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return home
+}
+
+func runSkills(t *testing.T, args ...string) (int, string, string) {
+	t.Helper()
+	var out, errOut bytes.Buffer
+	code := runSkillsCommand(args, &out, &errOut)
+	return code, out.String(), errOut.String()
+}
+
+func TestSkillsListJSON(t *testing.T) {
+	skillsHome(t)
+	if code, _, errOut := runSkills(t, "install", "typescript", "--targets=claude"); code != 0 {
+		t.Fatalf("install failed: %s", errOut)
+	}
+
+	code, out, errOut := runSkills(t, "list", "--json")
+	if code != 0 {
+		t.Fatalf("expected exit 0, got %d: %s", code, errOut)
+	}
+	// The shape is a contract for scripts: check the keys, not just the values
+	var raw map[string][]map[string]any
+	if err := json.Unmarshal([]byte(out), &raw); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, out)
+	}
+	if raw["warnings"] == nil || len(raw["skills"]) != 3 {
+		t.Fatalf("expected 3 skills and a warnings list, got %s", out)
+	}
+	var keys []string
+	for key := range raw["skills"][0] {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	if want := []string{"category", "description", "installed", "installed_in", "name", "type"}; !slices.Equal(keys, want) {
+		t.Errorf("skill keys = %v, want %v", keys, want)
+	}
+
+	var listing skillsListing
+	json.Unmarshal([]byte(out), &listing)
+	for _, s := range listing.Skills {
+		want := []string{}
+		if s.Name == "typescript" {
+			want = []string{"claude"}
+		}
+		if !slices.Equal(s.InstalledIn, want) || s.Installed != (len(want) > 0) {
+			t.Errorf("%s: installed=%v in %v, want %v", s.Name, s.Installed, s.InstalledIn, want)
+		}
+	}
+	if listing.Skills[2].Category != "community" {
+		t.Errorf("skills should be listed curated first, got %+v", listing.Skills)
+	}
+}
+
+func TestSkillsListTable(t *testing.T) {
+	skillsHome(t)
+	code, out, _ := runSkills(t, "list")
+	if code != 0 {
+		t.Fatalf("expected exit 0, got %d", code)
+	}
+	for _, want := range []string{"NAME", "react-19", "chi-router patterns", "3 skills, 0 installed"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("the table should show %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestSkillsInstallAndRemove(t *testing.T) {
+	home := skillsHome(t)
+
+	// Names resolve against the catalog; an unknown one fails the command
+	code, out, _ := runSkills(t, "install", "react-19", "nope", "--json", "--targets=claude")
+	if code != 1 {
+		t.Errorf("an unknown skill should exit 1, got %d", code)
+	}
+	var summary tui.SkillSummary
+	if err := json.Unmarshal([]byte(out), &summary); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, out)
+	}
+	if !slices.Equal(summary.Installed, []string{"react-19"}) || len(summary.Failed) != 1 || summary.Failed[0].Name != "nope" {
+		t.Errorf("unexpected summary %+v", summary)
+	}
+	if _, err := os.Lstat(filepath.Join(home, ".agents", "skills", "react-19")); !os.IsNotExist(err) {
+		t.Error("--targets=claude should leave ~/.agents alone")
+	}
+
+	code, out, _ = runSkills(t, "install", "--all-curated", "--targets=claude")
+	if code != 0 || !strings.Contains(out, "typescript") || !strings.Contains(out, "1 installed, 0 removed, 1 skipped, 0 failed") {
+		t.Errorf("--all-curated should install the rest of the curated skills, got %d:\n%s", code, out)
+	}
+	if _, err := os.Lstat(filepath.Join(home, ".claude", "skills", "chi-router")); !os.IsNotExist(err) {
+		t.Error("--all-curated should leave community skills out")
+	}
+
+	code, out, _ = runSkills(t, "remove", "--json", "react-19", "typescript")
+	if err := json.Unmarshal([]byte(out), &summary); code != 0 || err != nil || len(summary.Removed) != 2 {
+		t.Errorf("expected both removed, got %d %+v", code, summary)
+	}
+}
+
+// Removing never clones the catalog: without one the names are bare links
+func TestSkillsRemoveWithoutCatalog(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	link := filepath.Join(home, ".claude", "skills", "react-19")
+	os.MkdirAll(filepath.Dir(link), 0755)
+	if err := os.Symlink(t.TempDir(), link); err != nil {
+		t.Fatal(err)
+	}
+
+	code, out, errOut := runSkills(t, "remove", "react-19", "--targets=claude")
+	if code != 0 {
+		t.Fatalf("expected exit 0, got %d: %s %s", code, out, errOut)
+	}
+	if _, err := os.Lstat(link); !os.IsNotExist(err) {
+		t.Error("the link should be removed")
+	}
+	if _, err := os.Stat(filepath.Join(home, ".gentleman", "skills")); !os.IsNotExist(err) {
+		t.Error("removing should not clone the catalog")
+	}
+}
+
+func TestSkillsCommandUsage(t *testing.T) {
+	skillsHome(t)
+	for _, args := range [][]string{
+		nil,
+		{"bogus"},
+		{"list", "react-19"},
+		{"install"},
+		{"remove", "--all-curated"},
+		{"update", "--all-curated"},
+		{"list", "--targets=nope"},
+	} {
+		if code, _, errOut := runSkills(t, args...); code != 2 || !strings.Contains(errOut, "Usage") && !strings.Contains(errOut, "Error") {
+			t.Errorf("%v: expected a usage error, got %d %q", args, code, errOut)
+		}
+	}
+}
+
+func TestParseInterspersed(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	asJSON := fs.Bool("json", false, "")
+	names, err := parseInterspersed(fs, []string{"react-19", "--json", "typescript"})
+	if err != nil || !*asJSON || !slices.Equal(names, []string{"react-19", "typescript"}) {
+		t.Errorf("got %v json=%v (%v)", names, *asJSON, err)
+	}
+}
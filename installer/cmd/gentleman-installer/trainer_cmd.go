@@ -0,0 +1,97 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/tui/trainer"
+)
+
+// trainerListing is the --json output of "trainer list"
+type trainerListing struct {
+	Modules    []trainer.ModuleContent     `json:"modules"`
+	Duplicates []trainer.DuplicateExercise `json:"duplicate_ids"`
+}
+
+// runTrainerCommand handles "gentleman.dots trainer <subcommand>" and returns the exit code
+func runTrainerCommand(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] != "list" {
+		fmt.Fprintln(stderr, "Usage: gentleman.dots trainer list [--json]")
+		return 2
+	}
+
+	fs := flag.NewFlagSet("trainer list", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	asJSON := fs.Bool("json", false, "Print the listing as JSON")
+	if err := fs.Parse(args[1:]); err != nil {
+		return 2
+	}
+
+	listing := trainerListing{
+		Modules:    trainer.ListModuleContent(),
+		Duplicates: trainer.FindDuplicateExerciseIDs(),
+	}
+	if listing.Duplicates == nil {
+		listing.Duplicates = []trainer.DuplicateExercise{}
+	}
+
+	var err error
+	if *asJSON {
+		err = writeTrainerJSON(stdout, listing)
+	} else {
+		err = writeTrainerTable(stdout, listing)
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return 1
+	}
+	// Duplicates fail the audit so CI can catch them
+	if len(listing.Duplicates) > 0 {
+		return 1
+	}
+	return 0
+}
+
+// writeTrainerJSON encodes the listing as indented JSON followed by a newline
+func writeTrainerJSON(w io.Writer, listing trainerListing) error {
+	data, err := json.MarshalIndent(listing, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+// writeTrainerTable prints one row per module, the totals and any duplicate IDs
+func writeTrainerTable(w io.Writer, listing trainerListing) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "MODULE\tLESSONS\tPRACTICE\tBOSS")
+	lessons, practice, bosses := 0, 0, 0
+	for _, m := range listing.Modules {
+		boss := "-"
+		if m.HasBoss {
+			boss = fmt.Sprintf("%s (%d steps)", m.BossName, m.BossSteps)
+			bosses++
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%s\n", m.ID, m.Lessons, m.Practice, boss)
+		lessons += m.Lessons
+		practice += m.Practice
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "\n%d modules, %d lessons, %d practice exercises, %d bosses\n", len(listing.Modules), lessons, practice, bosses)
+	if len(listing.Duplicates) == 0 {
+		fmt.Fprintln(w, "✓ No duplicate exercise IDs")
+		return nil
+	}
+	fmt.Fprintf(w, "❌ %d duplicate exercise IDs:\n", len(listing.Duplicates))
+	for _, d := range listing.Duplicates {
+		fmt.Fprintf(w, "  %s: %v\n", d.ID, d.Locations)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/tui/trainer"
+)
+
+func TestTrainerListTable(t *testing.T) {
+	var out, errOut bytes.Buffer
+	if code := runTrainerCommand([]string{"list"}, &out, &errOut); code != 0 {
+		t.Fatalf("expected exit 0, got %d: %s", code, errOut.String())
+	}
+	got := out.String()
+	for _, m := range trainer.GetAllModules() {
+		if !strings.Contains(got, string(m.ID)) {
+			t.Errorf("listing is missing module %s", m.ID)
+		}
+	}
+	if !strings.Contains(got, "No duplicate exercise IDs") {
+		t.Errorf("expected the duplicate check result, got:\n%s", got)
+	}
+}
+
+func TestTrainerListJSON(t *testing.T) {
+	var out, errOut bytes.Buffer
+	if code := runTrainerCommand([]string{"list", "--json"}, &out, &errOut); code != 0 {
+		t.Fatalf("expected exit 0, got %d: %s", code, errOut.String())
+	}
+	var listing trainerListing
+	if err := json.Unmarshal(out.Bytes(), &listing); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, out.String())
+	}
+	if len(listing.Modules) != len(trainer.GetAllModules()) {
+		t.Errorf("expected every module, got %d", len(listing.Modules))
+	}
+	if listing.Duplicates == nil || len(listing.Duplicates) != 0 {
+		t.Errorf("duplicate_ids should be an empty list, got %v", listing.Duplicates)
+	}
+}
+
+func TestTrainerListTableReportsDuplicates(t *testing.T) {
+	var out bytes.Buffer
+	listing := trainerListing{
+		Modules:    []trainer.ModuleContent{{ID: "horizontal", Lessons: 2, Practice: 2}},
+		Duplicates: []trainer.DuplicateExercise{{ID: "horizontal_001", Locations: []string{"horizontal/lesson", "regex/boss"}}},
+	}
+	if err := writeTrainerTable(&out, listing); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "horizontal_001: [horizontal/lesson regex/boss]") {
+		t.Errorf("duplicates should be listed, got:\n%s", out.String())
+	}
+}
+
+func TestTrainerCommandUsage(t *testing.T) {
+	var out, errOut bytes.Buffer
+	if code := runTrainerCommand(nil, &out, &errOut); code != 2 {
+		t.Errorf("expected usage exit code 2, got %d", code)
+	}
+	if !strings.Contains(errOut.String(), "trainer list") {
+		t.Errorf("expected usage text, got %q", errOut.String())
+	}
+}
@@ -0,0 +1,90 @@
+// Package paths resolves where the installer keeps its own state: the
+// gentleman data dir (skills catalog, frameworks, install marker), trainer
+// stats and config backups.
+//
+// Normally all of it lives under $HOME. In portable mode it goes to a state/
+// directory next to the binary instead, so a copy run from a USB stick leaves
+// the real home untouched except for the configs it actually deploys.
+package paths
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// PortableEnv holds the portable state directory when portable mode is on.
+// An environment variable (like GENTLEMAN_TEST_MODE) so child processes and
+// every package see the same setting without threading it through.
+const PortableEnv = "GENTLEMAN_PORTABLE_DIR"
+
+// PortableMarker next to the executable turns portable mode on without --portable
+const PortableMarker = "portable.marker"
+
+// PortableStateDir is the state directory for a binary at exe
+func PortableStateDir(exe string) string {
+	return filepath.Join(filepath.Dir(exe), "state")
+}
+
+// HasPortableMarker reports whether a portable.marker sits next to exe
+func HasPortableMarker(exe string) bool {
+	_, err := os.Stat(filepath.Join(filepath.Dir(exe), PortableMarker))
+	return err == nil
+}
+
+// EnablePortable creates dir and routes all state paths into it
+func EnablePortable(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	return os.Setenv(PortableEnv, dir)
+}
+
+// PortableRoot returns the portable state directory, or "" outside portable mode
+func PortableRoot() string {
+	return os.Getenv(PortableEnv)
+}
+
+// Portable reports whether portable mode is on
+func Portable() bool {
+	return PortableRoot() != ""
+}
+
+// DataDir is the gentleman data dir: ~/.gentleman, or state/gentleman
+func DataDir(home string) string {
+	if root := PortableRoot(); root != "" {
+		return filepath.Join(root, "gentleman")
+	}
+	return filepath.Join(home, ".gentleman")
+}
+
+// SkillsDir is the centralized skills catalog inside the data dir
+func SkillsDir(home string) string {
+	return filepath.Join(DataDir(home), "skills")
+}
+
+// ExternalSkillsDir holds the skills cloned from their own repositories,
+// beside the catalog so they stay out of its git checkout
+func ExternalSkillsDir(home string) string {
+	return filepath.Join(DataDir(home), "skills-external")
+}
+
+// TrainerPacksDir holds user-made Vim trainer packs (*.json) inside the data dir
+func TrainerPacksDir(home string) string {
+	return filepath.Join(DataDir(home), "trainer-packs")
+}
+
+// TrainerDir holds the Vim trainer stats: ~/.config/gentleman-trainer, or state/trainer
+func TrainerDir(home string) string {
+	if root := PortableRoot(); root != "" {
+		return filepath.Join(root, "trainer")
+	}
+	return filepath.Join(home, ".config", "gentleman-trainer")
+}
+
+// BackupRoot is the directory that holds .gentleman-backup-* dirs: home, or state/backups
+func BackupRoot(home string) string {
+	if root := PortableRoot(); root != "" {
+		return filepath.Join(root, "backups")
+	}
+	return home
+}
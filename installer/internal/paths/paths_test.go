@@ -0,0 +1,68 @@
+package paths
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestHasPortableMarker(t *testing.T) {
+	dir := t.TempDir()
+	exe := filepath.Join(dir, "gentleman.dots")
+
+	if HasPortableMarker(exe) {
+		t.Error("no marker yet, portable mode should be off")
+	}
+	if err := os.WriteFile(filepath.Join(dir, PortableMarker), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if !HasPortableMarker(exe) {
+		t.Error("marker next to the binary should turn portable mode on")
+	}
+	if got, want := PortableStateDir(exe), filepath.Join(dir, "state"); got != want {
+		t.Errorf("PortableStateDir = %q, want %q", got, want)
+	}
+}
+
+func TestStatePaths(t *testing.T) {
+	home := "/home/user"
+	state := filepath.Join(t.TempDir(), "state")
+
+	tests := []struct {
+		name     string
+		portable bool
+		fn       func(string) string
+		want     string
+	}{
+		{"data dir", false, DataDir, "/home/user/.gentleman"},
+		{"skills dir", false, SkillsDir, "/home/user/.gentleman/skills"},
+		{"external skills dir", false, ExternalSkillsDir, "/home/user/.gentleman/skills-external"},
+		{"trainer dir", false, TrainerDir, "/home/user/.config/gentleman-trainer"},
+		{"backup root", false, BackupRoot, "/home/user"},
+		{"portable data dir", true, DataDir, filepath.Join(state, "gentleman")},
+		{"portable skills dir", true, SkillsDir, filepath.Join(state, "gentleman", "skills")},
+		{"portable external skills dir", true, ExternalSkillsDir, filepath.Join(state, "gentleman", "skills-external")},
+		{"portable trainer dir", true, TrainerDir, filepath.Join(state, "trainer")},
+		{"portable backup root", true, BackupRoot, filepath.Join(state, "backups")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(PortableEnv, "")
+			if tt.portable {
+				if err := EnablePortable(state); err != nil {
+					t.Fatalf("EnablePortable: %v", err)
+				}
+			}
+			if got := tt.fn(home); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+			if Portable() != tt.portable {
+				t.Errorf("Portable() = %v, want %v", Portable(), tt.portable)
+			}
+		})
+	}
+
+	if _, err := os.Stat(state); err != nil {
+		t.Errorf("EnablePortable should create the state dir: %v", err)
+	}
+}
@@ -0,0 +1,63 @@
+package system
+
+// AIToolStatus says whether an AI coding tool can be offered for install
+type AIToolStatus struct {
+	Installed   bool   // The tool's binary is already on PATH
+	Needs       string // Command the install needs and can't find ("npm", "curl"); empty when it can run
+	Unsupported string // Platform the tool doesn't run on ("Termux"); empty when it does
+}
+
+// Available reports whether the tool can be selected: it runs on this
+// platform and is either already installed or its install method can run here
+func (s AIToolStatus) Available() bool {
+	return s.Unsupported == "" && (s.Installed || s.Needs == "")
+}
+
+// aiToolBinaries maps each AI tool ID to the command it puts on PATH
+var aiToolBinaries = map[string]string{
+	"claude":   "claude",
+	"opencode": "opencode",
+	"gemini":   "gemini",
+	"copilot":  "copilot",
+	"codex":    "codex",
+	"qwen":     "qwen",
+}
+
+// aiToolScripts are the tools installed by piping a script from curl; the
+// rest always come from npm. On native Windows the scripts are replaced by
+// npm packages too.
+var aiToolScripts = map[string]bool{
+	"claude":   true,
+	"opencode": true,
+	"copilot":  true,
+}
+
+// AIToolBinary returns the command tool puts on PATH, or "" for unknown tools
+func AIToolBinary(tool string) string {
+	return aiToolBinaries[tool]
+}
+
+// AIToolAvailability checks whether tool is installed and whether its install
+// method can run on platform (the OS choice: "mac", "linux", "termux" or
+// "windows"). None of the tools run on Termux. exists reports whether a
+// command is on PATH (CommandExists outside tests); npmComing is set when an
+// earlier step installs Node.js, so npm will be there by the time the AI tools
+// are installed.
+func AIToolAvailability(tool, platform string, npmComing bool, exists func(string) bool) AIToolStatus {
+	status := AIToolStatus{Installed: exists(aiToolBinaries[tool])}
+	if platform == "termux" {
+		status.Unsupported = "Termux"
+		return status
+	}
+	need := "npm"
+	if aiToolScripts[tool] && platform != "windows" {
+		need = "curl"
+	}
+	if need == "npm" && npmComing {
+		return status
+	}
+	if !exists(need) {
+		status.Needs = need
+	}
+	return status
+}
@@ -0,0 +1,45 @@
+package system
+
+import "testing"
+
+func TestAIToolAvailability(t *testing.T) {
+	tests := []struct {
+		name      string
+		tool      string
+		platform  string
+		npmComing bool
+		onPath    []string
+		want      AIToolStatus
+	}{
+		{"script tool with curl", "claude", "linux", false, []string{"curl"}, AIToolStatus{}},
+		{"script tool without curl", "opencode", "linux", false, []string{"npm"}, AIToolStatus{Needs: "curl"}},
+		{"script tool on windows needs npm", "copilot", "windows", false, []string{"curl"}, AIToolStatus{Needs: "npm"}},
+		{"npm tool with npm", "gemini", "linux", false, []string{"npm"}, AIToolStatus{}},
+		{"npm tool without npm", "codex", "linux", false, []string{"curl"}, AIToolStatus{Needs: "npm"}},
+		{"npm tool with node coming", "qwen", "linux", true, nil, AIToolStatus{}},
+		{"node coming doesn't bring curl", "claude", "linux", true, nil, AIToolStatus{Needs: "curl"}},
+		{"installed without npm", "gemini", "linux", false, []string{"gemini"}, AIToolStatus{Installed: true, Needs: "npm"}},
+		{"installed", "claude", "linux", false, []string{"claude", "curl"}, AIToolStatus{Installed: true}},
+		{"nothing runs on termux", "gemini", "termux", false, []string{"npm"}, AIToolStatus{Unsupported: "Termux"}},
+		{"installed on termux", "claude", "termux", false, []string{"claude", "curl"}, AIToolStatus{Installed: true, Unsupported: "Termux"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exists := func(cmd string) bool {
+				for _, c := range tt.onPath {
+					if c == cmd {
+						return true
+					}
+				}
+				return false
+			}
+			got := AIToolAvailability(tt.tool, tt.platform, tt.npmComing, exists)
+			if got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+			if wantAvailable := tt.want.Unsupported == "" && (tt.want.Installed || tt.want.Needs == ""); got.Available() != wantAvailable {
+				t.Errorf("Available() = %v, want %v", got.Available(), wantAvailable)
+			}
+		})
+	}
+}
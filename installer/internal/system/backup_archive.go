@@ -0,0 +1,425 @@
+package system
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// BackupArchiveExt ends the name of a compressed backup. ListBackups tells
+// archives from directory backups by it.
+const BackupArchiveExt = ".tar.gz"
+
+// IsBackupArchive reports whether a backup path is a compressed archive
+func IsBackupArchive(backupPath string) bool {
+	return strings.HasSuffix(backupPath, BackupArchiveExt)
+}
+
+// errArchiveNeedsOpen is returned by functions that read backup files directly
+var errArchiveNeedsOpen = errors.New("compressed backup: extract it with OpenBackup first")
+
+// archiveEntry is one path going into an archive backup
+type archiveEntry struct {
+	name string // Slash-separated path in the archive, starting with the config key
+	src  string
+	info os.FileInfo
+}
+
+// CreateBackupArchive backs up configs like CreateBackupWithOptions, into a
+// gzipped tarball next to the directory backups instead of a directory. File
+// modes and symlinks are kept. The manifest is the archive's first entry, so
+// it can be read without decompressing the rest.
+func CreateBackupArchive(configs []string, opts BackupOptions) (string, error) {
+	archive := GetBackupDir() + BackupArchiveExt
+	if err := EnsureDir(filepath.Dir(archive)); err != nil {
+		return "", fmt.Errorf("failed to create backup directory: %w", err)
+	}
+
+	entries, manifest, err := collectArchiveEntries(configs, opts)
+	if err != nil {
+		return "", err
+	}
+
+	// Write beside the final name so a half-written archive is never listed
+	tmp := archive + ".tmp"
+	if err := writeBackupArchive(tmp, manifest, func(tw *tar.Writer) error {
+		for _, e := range entries {
+			if err := addArchiveEntry(tw, e); err != nil {
+				return fmt.Errorf("failed to backup %s: %w", e.name, err)
+			}
+		}
+		return nil
+	}); err != nil {
+		os.Remove(tmp)
+		return "", err
+	}
+	if err := os.Rename(tmp, archive); err != nil {
+		os.Remove(tmp)
+		return "", fmt.Errorf("failed to save backup archive: %w", err)
+	}
+	return archive, nil
+}
+
+// collectArchiveEntries walks the configs, skipping paths excluded under opts,
+// and hashes what a directory backup's manifest would hold
+func collectArchiveEntries(configs []string, opts BackupOptions) ([]archiveEntry, *BackupManifest, error) {
+	manifest := &BackupManifest{Version: 1, Created: time.Now(), Files: make(map[string]string)}
+	configPaths := ConfigPaths()
+
+	var entries []archiveEntry
+	for _, configKey := range configs {
+		// Extract key from "key: path" format if present
+		key := configKey
+		if idx := strings.Index(configKey, ":"); idx > 0 {
+			key = configKey[:idx]
+		}
+		srcPath, exists := configPaths[key]
+		if !exists {
+			continue
+		}
+		if _, err := os.Stat(srcPath); err != nil {
+			continue // File doesn't exist, skip
+		}
+
+		err := filepath.Walk(srcPath, func(p string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			rel, err := filepath.Rel(srcPath, p)
+			if err != nil {
+				return err
+			}
+			name := key
+			if rel != "." {
+				if IsBackupExcluded(key, rel, opts) {
+					if info.IsDir() {
+						return filepath.SkipDir
+					}
+					return nil
+				}
+				name = key + "/" + filepath.ToSlash(rel)
+			}
+			entries = append(entries, archiveEntry{name: name, src: p, info: info})
+
+			// Same files as hashTree over a directory backup
+			if info.Mode().IsRegular() && (rel == "." || !IsBackupExcluded(key, rel, BackupOptions{})) {
+				sum, err := HashFile(p)
+				if err != nil {
+					return err
+				}
+				manifest.Files[name] = sum
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to backup %s: %w", key, err)
+		}
+	}
+	return entries, manifest, nil
+}
+
+// writeBackupArchive writes an archive holding the manifest followed by
+// whatever addEntries adds
+func writeBackupArchive(archive string, manifest *BackupManifest, addEntries func(*tar.Writer) error) error {
+	f, err := os.OpenFile(archive, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to create backup archive: %w", err)
+	}
+	defer f.Close()
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	hdr := &tar.Header{Name: BackupManifestFile, Mode: 0644, Size: int64(len(data)), ModTime: manifest.Created}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	if _, err := tw.Write(data); err != nil {
+		return err
+	}
+	if err := addEntries(tw); err != nil {
+		return err
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// addArchiveEntry writes one file, directory or symlink from disk
+func addArchiveEntry(tw *tar.Writer, e archiveEntry) error {
+	link := ""
+	if e.info.Mode()&os.ModeSymlink != 0 {
+		target, err := os.Readlink(e.src)
+		if err != nil {
+			return err
+		}
+		link = target
+	}
+	hdr, err := tar.FileInfoHeader(e.info, link)
+	if err != nil {
+		return err
+	}
+	hdr.Name = e.name
+	if e.info.IsDir() {
+		hdr.Name += "/"
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	if !e.info.Mode().IsRegular() {
+		return nil
+	}
+	f, err := os.Open(e.src)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(tw, f)
+	return err
+}
+
+// openArchive opens a backup archive for reading; close the returned file when done
+func openArchive(archive string) (*os.File, *tar.Reader, error) {
+	f, err := os.Open(archive)
+	if err != nil {
+		return nil, nil, err
+	}
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		f.Close()
+		return nil, nil, fmt.Errorf("invalid backup archive %s: %w", filepath.Base(archive), err)
+	}
+	return f, tar.NewReader(gz), nil
+}
+
+// readArchiveManifest loads the manifest of an archive backup, or (nil, nil)
+// when it has none. Archives written here have it first, so this stops early.
+func readArchiveManifest(archive string) (*BackupManifest, error) {
+	f, tr, err := openArchive(archive)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid backup archive %s: %w", filepath.Base(archive), err)
+		}
+		if hdr.Name != BackupManifestFile {
+			continue
+		}
+		var manifest BackupManifest
+		if err := json.NewDecoder(tr).Decode(&manifest); err != nil {
+			return nil, fmt.Errorf("invalid backup manifest: %w", err)
+		}
+		return &manifest, nil
+	}
+}
+
+// archiveTopLevel maps the top-level entries of an archive backup to whether
+// they are directories. It goes by the manifest when there is one, so the
+// archive isn't read to the end.
+func archiveTopLevel(archive string) (map[string]bool, error) {
+	manifest, err := readArchiveManifest(archive)
+	if err != nil {
+		return nil, err
+	}
+	top := make(map[string]bool)
+	if manifest != nil {
+		for p := range manifest.Files {
+			key, rel, _ := strings.Cut(p, "/")
+			top[key] = top[key] || rel != ""
+		}
+		return top, nil
+	}
+
+	f, tr, err := openArchive(archive)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return top, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid backup archive %s: %w", filepath.Base(archive), err)
+		}
+		key, rel, _ := strings.Cut(strings.TrimSuffix(hdr.Name, "/"), "/")
+		top[key] = top[key] || rel != "" || hdr.Typeflag == tar.TypeDir
+	}
+}
+
+// ExtractBackupArchive unpacks an archive backup into dst, which then looks
+// like a directory backup. Modes and symlinks are restored; entries that
+// would land outside dst are an error.
+func ExtractBackupArchive(archive, dst string) error {
+	f, tr, err := openArchive(archive)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	// Directory modes are applied last, so read-only ones can still be filled
+	dirModes := make(map[string]os.FileMode)
+	// Symlinks extracted so far: no later entry may be written through one
+	links := make(map[string]bool)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("invalid backup archive %s: %w", filepath.Base(archive), err)
+		}
+		name := path.Clean(hdr.Name)
+		if !filepath.IsLocal(name) {
+			return fmt.Errorf("unsafe path in backup archive: %s", hdr.Name)
+		}
+		if throughLink(links, name) {
+			return fmt.Errorf("unsafe path in backup archive: %s goes through a symlink", hdr.Name)
+		}
+		target := filepath.Join(dst, filepath.FromSlash(name))
+		mode := os.FileMode(hdr.Mode).Perm()
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+			dirModes[target] = mode
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			if err := extractFile(tr, target, mode); err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			if err := os.Symlink(hdr.Linkname, target); err != nil {
+				return err
+			}
+			links[name] = true
+		}
+	}
+	for dir, mode := range dirModes {
+		if err := os.Chmod(dir, mode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// throughLink reports whether name, or a directory above it, is one of links
+func throughLink(links map[string]bool, name string) bool {
+	for ; name != "."; name = path.Dir(name) {
+		if links[name] {
+			return true
+		}
+	}
+	return false
+}
+
+// extractFile writes the current archive entry to target with the given mode
+func extractFile(r io.Reader, target string, mode os.FileMode) error {
+	out, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, r); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+	// Chmod, unlike OpenFile, isn't narrowed by the umask
+	return os.Chmod(target, mode)
+}
+
+// OpenBackup returns a directory holding the backup at backupPath and a
+// cleanup func to call once done with it. A directory backup is returned
+// as-is; an archive is extracted to a temp dir that cleanup removes.
+func OpenBackup(backupPath string) (string, func(), error) {
+	if !IsBackupArchive(backupPath) {
+		return backupPath, func() {}, nil
+	}
+	dir, err := os.MkdirTemp("", "gentleman-restore-")
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to extract backup: %w", err)
+	}
+	cleanup := func() { os.RemoveAll(dir) }
+	if err := ExtractBackupArchive(backupPath, dir); err != nil {
+		cleanup()
+		return "", nil, fmt.Errorf("failed to extract backup: %w", err)
+	}
+	return dir, cleanup, nil
+}
+
+// rewriteArchiveManifest replaces the manifest of an archive backup, keeping
+// every other entry and the archive's modification time
+func rewriteArchiveManifest(archive string, manifest *BackupManifest) error {
+	info, err := os.Stat(archive)
+	if err != nil {
+		return err
+	}
+	f, tr, err := openArchive(archive)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	tmp := archive + ".tmp"
+	err = writeBackupArchive(tmp, manifest, func(tw *tar.Writer) error {
+		for {
+			hdr, err := tr.Next()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if hdr.Name == BackupManifestFile {
+				continue
+			}
+			if err := tw.WriteHeader(hdr); err != nil {
+				return err
+			}
+			if _, err := io.Copy(tw, tr); err != nil {
+				return err
+			}
+		}
+	})
+	if err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("failed to update backup archive: %w", err)
+	}
+	if err := os.Rename(tmp, archive); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Chtimes(archive, info.ModTime(), info.ModTime())
+}
@@ -0,0 +1,235 @@
+package system
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// archiveFixture creates an nvim config with files of different modes and a
+// symlink, and an Oh My Zsh install with a cache, under a temp HOME
+func archiveFixture(t *testing.T) (home, nvim string) {
+	t.Helper()
+	home = t.TempDir()
+	t.Setenv("HOME", home)
+	nvim = filepath.Join(home, ".config", "nvim")
+	for path, mode := range map[string]os.FileMode{
+		"init.lua":          0644,
+		"lua/options.lua":   0600,
+		"scripts/format.sh": 0755,
+	} {
+		p := filepath.Join(nvim, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte("user "+path), mode); err != nil {
+			t.Fatal(err)
+		}
+		os.Chmod(p, mode)
+	}
+	if err := os.Symlink("init.lua", filepath.Join(nvim, "link.lua")); err != nil {
+		t.Fatal(err)
+	}
+	os.WriteFile(filepath.Join(home, ".tmux.conf"), []byte("user tmux"), 0644)
+	writeSized(t, filepath.Join(home, ".oh-my-zsh", "oh-my-zsh.sh"), 10)
+	writeSized(t, filepath.Join(home, ".oh-my-zsh", "cache", "completions", "_git"), 10)
+	return home, nvim
+}
+
+func assertMode(t *testing.T, path string, want os.FileMode) {
+	t.Helper()
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("%s: %v", path, err)
+	}
+	if got := info.Mode().Perm(); got != want {
+		t.Errorf("%s: mode %v, want %v", filepath.Base(path), got, want)
+	}
+}
+
+func TestBackupArchiveRoundTrip(t *testing.T) {
+	home, nvim := archiveFixture(t)
+
+	archive, err := CreateBackupArchive([]string{"nvim: " + nvim, "tmux", "oh-my-zsh"}, BackupOptions{})
+	if err != nil {
+		t.Fatalf("CreateBackupArchive failed: %v", err)
+	}
+	if !strings.HasSuffix(archive, BackupArchiveExt) || filepath.Dir(archive) != home {
+		t.Errorf("unexpected archive path %s", archive)
+	}
+
+	backups := ListBackups()
+	if len(backups) != 1 || !backups[0].Compressed {
+		t.Fatalf("expected one compressed backup, got %+v", backups)
+	}
+	if got := strings.Join(backups[0].Files, ","); got != "nvim,oh-my-zsh,tmux" {
+		t.Errorf("files = %s, want nvim,oh-my-zsh,tmux", got)
+	}
+	if !backups[0].Items[0].IsDir || backups[0].Items[2].IsDir {
+		t.Errorf("nvim should be a dir and tmux a file: %+v", backups[0].Items)
+	}
+
+	manifest, err := ReadBackupManifest(archive)
+	if err != nil || manifest == nil {
+		t.Fatalf("expected a manifest, got %v", err)
+	}
+	if _, ok := manifest.Files["oh-my-zsh/cache/completions/_git"]; ok {
+		t.Error("caches are excluded by default")
+	}
+	if manifest.Files["nvim/init.lua"] == "" || manifest.Files["tmux"] == "" {
+		t.Errorf("manifest is missing files: %v", manifest.Files)
+	}
+
+	// Replace the configs, then restore the archive
+	os.RemoveAll(nvim)
+	os.MkdirAll(nvim, 0755)
+	os.WriteFile(filepath.Join(nvim, "init.lua"), []byte("gentleman init"), 0644)
+	os.WriteFile(filepath.Join(home, ".tmux.conf"), []byte("gentleman tmux"), 0644)
+
+	if err := RestoreBackup(archive); err != nil {
+		t.Fatalf("RestoreBackup failed: %v", err)
+	}
+	for path, mode := range map[string]os.FileMode{
+		"init.lua":          0644,
+		"lua/options.lua":   0600,
+		"scripts/format.sh": 0755,
+	} {
+		p := filepath.Join(nvim, filepath.FromSlash(path))
+		assertContent(t, p, "user "+path)
+		assertMode(t, p, mode)
+	}
+	assertContent(t, filepath.Join(nvim, "link.lua"), "user init.lua")
+	assertContent(t, filepath.Join(home, ".tmux.conf"), "user tmux")
+
+	if err := DeleteBackup(archive); err != nil || len(ListBackups()) != 0 {
+		t.Errorf("DeleteBackup should remove the archive, got %v", err)
+	}
+}
+
+func TestBackupArchiveIncludeCaches(t *testing.T) {
+	_, nvim := archiveFixture(t)
+
+	archive, err := CreateBackupArchive([]string{"nvim", "oh-my-zsh"}, BackupOptions{IncludeCaches: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir, cleanup, err := OpenBackup(archive)
+	if err != nil {
+		t.Fatalf("OpenBackup failed: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "oh-my-zsh", "cache", "completions", "_git")); err != nil {
+		t.Errorf("expected the cache in the archive: %v", err)
+	}
+	if target, err := os.Readlink(filepath.Join(dir, "nvim", "link.lua")); err != nil || target != "init.lua" {
+		t.Errorf("the archive should keep the symlink, got %q (%v)", target, err)
+	}
+	cleanup()
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Error("cleanup should remove the extracted copy")
+	}
+
+	// A directory backup opens as itself
+	if got, _, _ := OpenBackup(nvim); got != nvim {
+		t.Errorf("OpenBackup(dir) = %s", got)
+	}
+}
+
+func TestBackupArchiveRestorePlan(t *testing.T) {
+	home, nvim := archiveFixture(t)
+	archive, err := CreateBackupArchive([]string{"nvim", "tmux"}, BackupOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	modTime := func() int64 {
+		info, _ := os.Stat(archive)
+		return info.ModTime().UnixNano()
+	}
+	before := modTime()
+
+	// The install replaces tmux; the deployed hashes go into the archive
+	os.WriteFile(filepath.Join(home, ".tmux.conf"), []byte("gentleman tmux"), 0644)
+	if err := RecordDeployedHashes(archive); err != nil {
+		t.Fatalf("RecordDeployedHashes failed: %v", err)
+	}
+	manifest, _ := ReadBackupManifest(archive)
+	if manifest.Deployed["tmux"] == "" || manifest.Files["nvim/init.lua"] == "" {
+		t.Fatalf("expected deployed hashes beside the backup ones, got %+v", manifest)
+	}
+	if modTime() != before {
+		t.Error("updating the manifest should keep the archive's time, which orders backups")
+	}
+
+	if _, err := PlanRestore(archive); !errors.Is(err, errArchiveNeedsOpen) {
+		t.Errorf("planning on an unopened archive should fail, got %v", err)
+	}
+	dir, cleanup, err := OpenBackup(archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+	plan, err := PlanRestore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan) != 1 || plan[0].Path != "tmux" || plan[0].Action != RestoreOverwrite {
+		t.Errorf("expected tmux to be overwritten, got %+v", plan)
+	}
+	if err := RestoreBackupItems(archive, []string{"tmux"}); err != nil {
+		t.Fatalf("RestoreBackupItems failed: %v", err)
+	}
+	assertContent(t, filepath.Join(home, ".tmux.conf"), "user tmux")
+	assertContent(t, filepath.Join(nvim, "init.lua"), "user init.lua")
+}
+
+func TestExtractBackupArchiveRejectsUnsafePaths(t *testing.T) {
+	dir := t.TempDir()
+	archive := filepath.Join(dir, "evil"+BackupArchiveExt)
+	f, _ := os.Create(archive)
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+	tw.WriteHeader(&tar.Header{Name: "../escaped", Mode: 0644, Size: 1, Typeflag: tar.TypeReg})
+	tw.Write([]byte("x"))
+	tw.Close()
+	gz.Close()
+	f.Close()
+
+	dst := filepath.Join(dir, "out")
+	if err := ExtractBackupArchive(archive, dst); err == nil || !strings.Contains(err.Error(), "unsafe path") {
+		t.Errorf("expected an unsafe path error, got %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "escaped")); !os.IsNotExist(err) {
+		t.Error("nothing may be written outside the destination")
+	}
+}
+
+// A symlink in the archive can't be used to write outside the destination
+func TestExtractBackupArchiveRejectsPathsThroughSymlinks(t *testing.T) {
+	for _, name := range []string{"a/x", "a"} {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			outside := filepath.Join(dir, "outside")
+			os.MkdirAll(outside, 0755)
+			archive := filepath.Join(dir, "evil"+BackupArchiveExt)
+			f, _ := os.Create(archive)
+			gz := gzip.NewWriter(f)
+			tw := tar.NewWriter(gz)
+			tw.WriteHeader(&tar.Header{Name: "a", Linkname: outside, Typeflag: tar.TypeSymlink})
+			tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: 1, Typeflag: tar.TypeReg})
+			tw.Write([]byte("x"))
+			tw.Close()
+			gz.Close()
+			f.Close()
+
+			if err := ExtractBackupArchive(archive, filepath.Join(dir, "out")); err == nil || !strings.Contains(err.Error(), "symlink") {
+				t.Errorf("expected a symlink error, got %v", err)
+			}
+			if entries, _ := os.ReadDir(outside); len(entries) != 0 {
+				t.Errorf("nothing may be written through the link, found %v", entries)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package system
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ExclusionKind classifies why a path is left out of a backup
+type ExclusionKind int
+
+const (
+	// ExcludeCache marks regenerable data (plugin clones, package caches).
+	// Skipped by default; BackupOptions.IncludeCaches keeps it.
+	ExcludeCache ExclusionKind = iota
+	// ExcludeHistory marks shell history files.
+	// Kept by default; BackupOptions.ExcludeHistory skips it.
+	ExcludeHistory
+)
+
+// ExclusionRule matches a path relative to a config root (slash-separated).
+// A rule matches the path itself and everything below it.
+type ExclusionRule struct {
+	Path string
+	Kind ExclusionKind
+}
+
+// BackupOptions controls which exclusion rules are applied during a backup
+type BackupOptions struct {
+	IncludeCaches  bool // Back up caches anyway
+	ExcludeHistory bool // Leave shell history out of the backup
+}
+
+// backupExclusionRules lists per-config exclusions, keyed like ConfigPaths().
+// Rules only see what is under a config path, so nvim has none: lazy.nvim's
+// plugin clones and mason's packages live in ~/.local/share/nvim, which the
+// backup never copies, and everything under ~/.config/nvim (lazy-lock.json
+// included) is the user's config. Fish history and Zed's node runtime also
+// live under ~/.local/share, outside their config paths.
+var backupExclusionRules = map[string][]ExclusionRule{
+	"oh-my-zsh": {
+		{Path: "cache", Kind: ExcludeCache},
+		{Path: "log", Kind: ExcludeCache},
+	},
+	"nushell": {
+		{Path: "history.txt", Kind: ExcludeHistory},
+		{Path: "history.sqlite3", Kind: ExcludeHistory},
+	},
+}
+
+// BackupExclusionRules returns the exclusion rules for a config key
+func BackupExclusionRules(key string) []ExclusionRule {
+	return backupExclusionRules[key]
+}
+
+// matchExclusion returns the rule matching relPath, if any
+func matchExclusion(key, relPath string) (ExclusionRule, bool) {
+	relPath = filepath.ToSlash(relPath)
+	for _, rule := range backupExclusionRules[key] {
+		if relPath == rule.Path || strings.HasPrefix(relPath, rule.Path+"/") {
+			return rule, true
+		}
+	}
+	return ExclusionRule{}, false
+}
+
+// IsBackupExcluded reports whether relPath (relative to the config root for key)
+// should be skipped under the given options
+func IsBackupExcluded(key, relPath string, opts BackupOptions) bool {
+	rule, ok := matchExclusion(key, relPath)
+	if !ok {
+		return false
+	}
+	switch rule.Kind {
+	case ExcludeCache:
+		return !opts.IncludeCaches
+	case ExcludeHistory:
+		return opts.ExcludeHistory
+	}
+	return false
+}
+
+// BackupSizeEstimate breaks down the size of a config by exclusion kind
+type BackupSizeEstimate struct {
+	Key     string
+	Path    string
+	Total   int64 // Every file under Path
+	Cache   int64 // Bytes matched by cache rules
+	History int64 // Bytes matched by history rules
+}
+
+// SizeWith returns how many bytes would be copied under the given options
+func (e BackupSizeEstimate) SizeWith(opts BackupOptions) int64 {
+	size := e.Total
+	if !opts.IncludeCaches {
+		size -= e.Cache
+	}
+	if opts.ExcludeHistory {
+		size -= e.History
+	}
+	return size
+}
+
+// EstimateBackupSize walks a config path and classifies its bytes by exclusion kind
+func EstimateBackupSize(key, path string) BackupSizeEstimate {
+	est := BackupSizeEstimate{Key: key, Path: path}
+	filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(path, p)
+		if err != nil {
+			return nil
+		}
+		est.Total += info.Size()
+		if rule, ok := matchExclusion(key, rel); ok {
+			switch rule.Kind {
+			case ExcludeCache:
+				est.Cache += info.Size()
+			case ExcludeHistory:
+				est.History += info.Size()
+			}
+		}
+		return nil
+	})
+	return est
+}
+
+// EstimateBackupSizes estimates every config in DetectExistingConfigs' "key: path" format
+func EstimateBackupSizes(configs []string) []BackupSizeEstimate {
+	configPaths := ConfigPaths()
+	var estimates []BackupSizeEstimate
+	for _, config := range configs {
+		key := config
+		if idx := strings.Index(config, ":"); idx > 0 {
+			key = config[:idx]
+		}
+		path, ok := configPaths[key]
+		if !ok {
+			continue
+		}
+		estimates = append(estimates, EstimateBackupSize(key, path))
+	}
+	return estimates
+}
+
+// FormatBytes renders a byte count as a short human-readable size (e.g. "1.9GB")
+func FormatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+	div, exp := int64(unit), 0
+	for v := n / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%cB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// copyDirExcluding copies src to dst like CopyDir, skipping paths excluded for key
+func copyDirExcluding(key, src, dst string, opts BackupOptions) error {
+	src = strings.TrimSuffix(strings.TrimSuffix(src, "/*"), "/.")
+
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		if relPath != "." && IsBackupExcluded(key, relPath, opts) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		dstPath := filepath.Join(dst, relPath)
+
+		if info.IsDir() {
+			return os.MkdirAll(dstPath, info.Mode())
+		}
+
+		return CopyFile(path, dstPath)
+	})
+}
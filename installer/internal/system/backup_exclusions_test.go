@@ -0,0 +1,175 @@
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeSized creates a file of n bytes, creating parent dirs as needed
+func writeSized(t *testing.T, path string, n int) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(strings.Repeat("x", n)), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// homeFixture lays out a home directory the way nvim and Oh My Zsh really
+// do: nvim's config under ~/.config/nvim with lazy.nvim's plugin clones and
+// mason's packages under ~/.local/share/nvim, and Oh My Zsh's cache and logs
+// inside ~/.oh-my-zsh
+func homeFixture(t *testing.T, home string) {
+	t.Helper()
+	nvim := filepath.Join(home, ".config", "nvim")
+	writeSized(t, filepath.Join(nvim, "init.lua"), 100)
+	writeSized(t, filepath.Join(nvim, "lua", "plugins", "editor.lua"), 200)
+	writeSized(t, filepath.Join(nvim, "lazy-lock.json"), 50)
+	writeSized(t, filepath.Join(nvim, "lua", "lazy.lua"), 10)
+	share := filepath.Join(home, ".local", "share", "nvim")
+	writeSized(t, filepath.Join(share, "lazy", "telescope.nvim", "init.lua"), 5000)
+	writeSized(t, filepath.Join(share, "mason", "bin", "gopls"), 8000)
+
+	omz := filepath.Join(home, ".oh-my-zsh")
+	writeSized(t, filepath.Join(omz, "oh-my-zsh.sh"), 300)
+	writeSized(t, filepath.Join(omz, "custom", "aliases.zsh"), 60)
+	writeSized(t, filepath.Join(omz, "cache", "completions", "_docker"), 4000)
+	writeSized(t, filepath.Join(omz, "log", "update.lock"), 40)
+	// A file merely named like a cache dir must not be excluded
+	writeSized(t, filepath.Join(omz, "plugins", "cache.zsh"), 20)
+}
+
+func TestIsBackupExcluded(t *testing.T) {
+	tests := []struct {
+		key     string
+		rel     string
+		opts    BackupOptions
+		exclude bool
+	}{
+		{"oh-my-zsh", "cache", BackupOptions{}, true},
+		{"oh-my-zsh", "cache/completions/_docker", BackupOptions{}, true},
+		{"oh-my-zsh", "log/update.lock", BackupOptions{}, true},
+		{"oh-my-zsh", "plugins/cache.zsh", BackupOptions{}, false},
+		{"oh-my-zsh", "oh-my-zsh.sh", BackupOptions{}, false},
+		{"oh-my-zsh", "cache/completions/_docker", BackupOptions{IncludeCaches: true}, false},
+		// Everything under ~/.config/nvim is config
+		{"nvim", "lazy", BackupOptions{}, false},
+		{"nvim", "mason/bin/gopls", BackupOptions{}, false},
+		{"nvim", "lazy-lock.json", BackupOptions{}, false},
+		{"nushell", "history.txt", BackupOptions{}, false},
+		{"nushell", "history.sqlite3", BackupOptions{ExcludeHistory: true}, true},
+		{"nushell", "config.nu", BackupOptions{ExcludeHistory: true}, false},
+		{"tmux", "cache", BackupOptions{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key+"/"+tt.rel, func(t *testing.T) {
+			if got := IsBackupExcluded(tt.key, tt.rel, tt.opts); got != tt.exclude {
+				t.Errorf("IsBackupExcluded(%q, %q, %+v) = %v, want %v", tt.key, tt.rel, tt.opts, got, tt.exclude)
+			}
+		})
+	}
+}
+
+func TestEstimateBackupSize(t *testing.T) {
+	home := t.TempDir()
+	homeFixture(t, home)
+
+	est := EstimateBackupSize("oh-my-zsh", filepath.Join(home, ".oh-my-zsh"))
+
+	if est.Total != 4420 {
+		t.Errorf("expected total 4420, got %d", est.Total)
+	}
+	if est.Cache != 4040 {
+		t.Errorf("expected cache 4040, got %d", est.Cache)
+	}
+	if got := est.SizeWith(BackupOptions{}); got != 380 {
+		t.Errorf("expected 380 bytes after exclusions, got %d", got)
+	}
+	if got := est.SizeWith(BackupOptions{IncludeCaches: true}); got != est.Total {
+		t.Errorf("expected full size when including caches, got %d", got)
+	}
+
+	// The plugin data in ~/.local/share/nvim is never under the config path
+	nvim := EstimateBackupSize("nvim", filepath.Join(home, ".config", "nvim"))
+	if nvim.Total != 360 || nvim.Cache != 0 {
+		t.Errorf("expected 360 bytes of nvim config and no cache, got %+v", nvim)
+	}
+}
+
+func TestEstimateBackupSizeHistory(t *testing.T) {
+	root := t.TempDir()
+	writeSized(t, filepath.Join(root, "config.nu"), 100)
+	writeSized(t, filepath.Join(root, "history.txt"), 900)
+
+	est := EstimateBackupSize("nushell", root)
+	if est.History != 900 {
+		t.Errorf("expected history 900, got %d", est.History)
+	}
+	if got := est.SizeWith(BackupOptions{}); got != 1000 {
+		t.Errorf("history should be kept by default, got %d", got)
+	}
+	if got := est.SizeWith(BackupOptions{ExcludeHistory: true}); got != 100 {
+		t.Errorf("expected 100 bytes without history, got %d", got)
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	tests := map[int64]string{
+		0:                      "0B",
+		512:                    "512B",
+		2048:                   "2.0KB",
+		12 * 1024 * 1024:       "12.0MB",
+		1932735283:             "1.8GB",
+		5 * 1024 * 1024 * 1024: "5.0GB",
+	}
+	for n, want := range tests {
+		if got := FormatBytes(n); got != want {
+			t.Errorf("FormatBytes(%d) = %q, want %q", n, got, want)
+		}
+	}
+}
+
+func TestCreateBackupWithOptions(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	homeFixture(t, home)
+	configs := []string{
+		"nvim: " + filepath.Join(home, ".config", "nvim"),
+		"oh-my-zsh: " + filepath.Join(home, ".oh-my-zsh"),
+	}
+
+	t.Run("skips caches by default", func(t *testing.T) {
+		backupDir, err := CreateBackupWithOptions(configs, BackupOptions{})
+		if err != nil {
+			t.Fatalf("CreateBackupWithOptions failed: %v", err)
+		}
+		defer os.RemoveAll(backupDir)
+
+		for _, rel := range []string{"nvim/init.lua", "nvim/lua/plugins/editor.lua", "nvim/lazy-lock.json", "nvim/lua/lazy.lua", "oh-my-zsh/oh-my-zsh.sh", "oh-my-zsh/custom/aliases.zsh", "oh-my-zsh/plugins/cache.zsh"} {
+			if _, err := os.Stat(filepath.Join(backupDir, rel)); err != nil {
+				t.Errorf("expected %s in backup: %v", rel, err)
+			}
+		}
+		for _, rel := range []string{"oh-my-zsh/cache", "oh-my-zsh/log", "nvim/lazy", "nvim/mason"} {
+			if _, err := os.Stat(filepath.Join(backupDir, rel)); !os.IsNotExist(err) {
+				t.Errorf("expected %s to be absent from backup", rel)
+			}
+		}
+	})
+
+	t.Run("includes caches when requested", func(t *testing.T) {
+		backupDir, err := CreateBackupWithOptions(configs, BackupOptions{IncludeCaches: true})
+		if err != nil {
+			t.Fatalf("CreateBackupWithOptions failed: %v", err)
+		}
+		defer os.RemoveAll(backupDir)
+
+		if _, err := os.Stat(filepath.Join(backupDir, "oh-my-zsh", "cache", "completions", "_docker")); err != nil {
+			t.Errorf("expected cache to be backed up: %v", err)
+		}
+	})
+}
@@ -0,0 +1,60 @@
+package system
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+)
+
+// DefaultBackupKeep is how many backups pruning keeps unless told otherwise
+const DefaultBackupKeep = 5
+
+// BackupDiskSize returns the bytes used by every file under a backup directory
+func BackupDiskSize(backupDir string) int64 {
+	var size int64
+	filepath.Walk(backupDir, func(_ string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size
+}
+
+// ComputeBackupSizes returns a copy of backups with Size filled in. It walks
+// every backup, so ListBackups leaves it out and callers run it in the background.
+func ComputeBackupSizes(backups []BackupInfo) []BackupInfo {
+	sized := slices.Clone(backups)
+	for i := range sized {
+		sized[i].Size = BackupDiskSize(sized[i].Path)
+	}
+	return sized
+}
+
+// BackupsToPrune returns the backups beyond the keep most recent ones, newest
+// first. A keep below 1 is treated as 1 so pruning never removes every backup.
+func BackupsToPrune(backups []BackupInfo, keep int) []BackupInfo {
+	keep = max(keep, 1)
+	if len(backups) <= keep {
+		return nil
+	}
+	sorted := slices.Clone(backups)
+	slices.SortStableFunc(sorted, func(a, b BackupInfo) int {
+		return b.Timestamp.Compare(a.Timestamp)
+	})
+	return sorted[keep:]
+}
+
+// PruneBackups deletes the backups BackupsToPrune picks and returns the paths
+// it removed. It stops at the first backup that can't be removed.
+func PruneBackups(backups []BackupInfo, keep int) ([]string, error) {
+	var removed []string
+	for _, b := range BackupsToPrune(backups, keep) {
+		if err := DeleteBackup(b.Path); err != nil {
+			return removed, fmt.Errorf("failed to remove backup %s: %w", filepath.Base(b.Path), err)
+		}
+		removed = append(removed, b.Path)
+	}
+	return removed, nil
+}
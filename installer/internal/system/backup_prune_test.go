@@ -0,0 +1,116 @@
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeBackups creates one backup per size under a temp HOME, oldest first,
+// each holding files of the given byte counts
+func fakeBackups(t *testing.T, sizes ...[]int) []string {
+	t.Helper()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	var dirs []string
+	for i, files := range sizes {
+		stamp := start.Add(time.Duration(i) * time.Hour)
+		dir := filepath.Join(home, ".gentleman-backup-"+stamp.Format("20060102-150405"))
+		for j, n := range files {
+			path := filepath.Join(dir, "nvim", "lua", strings.Repeat("f", j+1)+".lua")
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(path, make([]byte, n), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(dir, stamp, stamp); err != nil {
+			t.Fatal(err)
+		}
+		dirs = append(dirs, dir)
+	}
+	return dirs
+}
+
+func TestComputeBackupSizes(t *testing.T) {
+	dirs := fakeBackups(t, []int{100, 24}, []int{2048}, nil)
+
+	backups := ListBackups()
+	for _, b := range backups {
+		if b.Size != -1 {
+			t.Errorf("ListBackups shouldn't walk backups, got size %d for %s", b.Size, b.Path)
+		}
+	}
+
+	want := map[string]int64{dirs[0]: 124, dirs[1]: 2048, dirs[2]: 0}
+	for _, b := range ComputeBackupSizes(backups) {
+		if b.Size != want[b.Path] {
+			t.Errorf("%s: size = %d, want %d", filepath.Base(b.Path), b.Size, want[b.Path])
+		}
+	}
+	if backups[0].Size != -1 {
+		t.Error("ComputeBackupSizes should not modify its input")
+	}
+}
+
+func TestBackupsToPrune(t *testing.T) {
+	now := time.Now()
+	backups := []BackupInfo{
+		{Path: "b", Timestamp: now.Add(-2 * time.Hour)},
+		{Path: "d", Timestamp: now},
+		{Path: "a", Timestamp: now.Add(-3 * time.Hour)},
+		{Path: "c", Timestamp: now.Add(-1 * time.Hour)},
+	}
+	paths := func(bs []BackupInfo) []string {
+		var out []string
+		for _, b := range bs {
+			out = append(out, b.Path)
+		}
+		return out
+	}
+
+	tests := []struct {
+		keep int
+		want []string
+	}{
+		{keep: 5, want: nil},
+		{keep: 4, want: nil},
+		{keep: 2, want: []string{"b", "a"}},
+		{keep: 0, want: []string{"c", "b", "a"}}, // The newest is always kept
+	}
+	for _, tt := range tests {
+		if got := paths(BackupsToPrune(backups, tt.keep)); !slices.Equal(got, tt.want) {
+			t.Errorf("keep %d: pruned %v, want %v", tt.keep, got, tt.want)
+		}
+	}
+}
+
+func TestPruneBackups(t *testing.T) {
+	dirs := fakeBackups(t, []int{10}, []int{20}, []int{30}, []int{40})
+
+	removed, err := PruneBackups(ListBackups(), 2)
+	if err != nil {
+		t.Fatalf("PruneBackups failed: %v", err)
+	}
+	if want := []string{dirs[1], dirs[0]}; !slices.Equal(removed, want) {
+		t.Errorf("removed %v, want %v", removed, want)
+	}
+	for i, dir := range dirs {
+		_, err := os.Stat(dir)
+		if kept := i >= 2; kept != (err == nil) {
+			t.Errorf("%s: kept = %v, want %v", filepath.Base(dir), err == nil, kept)
+		}
+	}
+	if left := ListBackups(); len(left) != 2 {
+		t.Errorf("expected 2 backups left, got %d", len(left))
+	}
+}
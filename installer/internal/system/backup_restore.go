@@ -0,0 +1,384 @@
+package system
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// BackupManifestFile is stored at the root of every backup directory.
+// Its name is not a ConfigPaths key, so RestoreBackup never treats it as a config.
+const BackupManifestFile = "manifest.json"
+
+// FromBackupSuffix is appended to the backup copy when a conflict is resolved with "keep both"
+const FromBackupSuffix = ".from-backup"
+
+// PreRestoreSuffix is appended to the copy of a config that RestoreBackupItems
+// replaced with a different backup version
+const PreRestoreSuffix = ".pre-restore"
+
+// BackupManifest records content hashes so a later restore can tell which files
+// the user changed after the install. Paths are slash-separated and start with
+// the config key ("nvim/init.lua", or just "zsh" for single-file configs).
+type BackupManifest struct {
+	Version int               `json:"version"`
+	Created time.Time         `json:"created"`
+	Files   map[string]string `json:"files"` // sha256 of each backed-up file
+	// Deployed holds the sha256 of every file the installer left in place once the
+	// install finished. Nil until the install completes.
+	Deployed map[string]string `json:"deployed,omitempty"`
+}
+
+// HashFile returns the hex sha256 of a file's content
+func HashFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// hashTree hashes every regular file under root, keyed by prefix + "/" + relative path.
+// A file root is keyed by prefix alone. Paths excluded by default backup rules are skipped.
+func hashTree(key, root string) map[string]string {
+	hashes := make(map[string]string)
+	info, err := os.Stat(root)
+	if err != nil {
+		return hashes
+	}
+	if !info.IsDir() {
+		if sum, err := HashFile(root); err == nil {
+			hashes[key] = sum
+		}
+		return hashes
+	}
+	filepath.Walk(root, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil || rel == "." {
+			return nil
+		}
+		if IsBackupExcluded(key, rel, BackupOptions{}) {
+			if fi.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+		if sum, err := HashFile(p); err == nil {
+			hashes[key+"/"+filepath.ToSlash(rel)] = sum
+		}
+		return nil
+	})
+	return hashes
+}
+
+// backupKeys returns the config keys present in a backup directory
+func backupKeys(backupDir string) []string {
+	configPaths := ConfigPaths()
+	entries, err := os.ReadDir(backupDir)
+	if err != nil {
+		return nil
+	}
+	var keys []string
+	for _, e := range entries {
+		if _, ok := configPaths[e.Name()]; ok {
+			keys = append(keys, e.Name())
+		}
+	}
+	return keys
+}
+
+// WriteBackupManifest hashes the contents of backupDir and saves the manifest
+func WriteBackupManifest(backupDir string) error {
+	manifest := &BackupManifest{Version: 1, Created: time.Now(), Files: make(map[string]string)}
+	for _, key := range backupKeys(backupDir) {
+		for path, sum := range hashTree(key, filepath.Join(backupDir, key)) {
+			manifest.Files[path] = sum
+		}
+	}
+	return saveBackupManifest(backupDir, manifest)
+}
+
+// ReadBackupManifest loads a backup's manifest. Backups created before manifests
+// existed return (nil, nil).
+func ReadBackupManifest(backupDir string) (*BackupManifest, error) {
+	if IsBackupArchive(backupDir) {
+		return readArchiveManifest(backupDir)
+	}
+	data, err := os.ReadFile(filepath.Join(backupDir, BackupManifestFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var manifest BackupManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("invalid backup manifest: %w", err)
+	}
+	return &manifest, nil
+}
+
+func saveBackupManifest(backupDir string, manifest *BackupManifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(backupDir, BackupManifestFile), data, 0644)
+}
+
+// RecordDeployedHashes snapshots the configs the installer just deployed into the
+// backup's manifest, so a later restore can spot edits made after the install
+func RecordDeployedHashes(backupDir string) error {
+	manifest, err := ReadBackupManifest(backupDir)
+	if err != nil {
+		return err
+	}
+	if manifest == nil {
+		return fmt.Errorf("backup has no manifest: %s", backupDir)
+	}
+
+	keys := backupKeys(backupDir)
+	if IsBackupArchive(backupDir) {
+		top, err := archiveTopLevel(backupDir)
+		if err != nil {
+			return err
+		}
+		keys = slices.Collect(maps.Keys(top))
+	}
+
+	configPaths := ConfigPaths()
+	manifest.Deployed = make(map[string]string)
+	for _, key := range keys {
+		if _, ok := configPaths[key]; !ok {
+			continue
+		}
+		for path, sum := range hashTree(key, configPaths[key]) {
+			manifest.Deployed[path] = sum
+		}
+	}
+	if IsBackupArchive(backupDir) {
+		return rewriteArchiveManifest(backupDir, manifest)
+	}
+	return saveBackupManifest(backupDir, manifest)
+}
+
+// RestoreAction is what a restore does with one file
+type RestoreAction int
+
+const (
+	RestoreUnchanged RestoreAction = iota // Current file already matches the backup
+	RestoreOverwrite                      // Safe to write the backup copy
+	RestoreRemove                         // Installer-added file with no backup copy
+	RestoreConflict                       // User may have changed the file since the install; ask
+)
+
+// RestoreFileState holds the content hashes of one path ("" when absent)
+type RestoreFileState struct {
+	Backup   string // Backup copy
+	Current  string // File on disk now
+	Deployed string // What the installer left in place
+	// Tracked is true when the manifest has a deployed snapshot. Without one
+	// (older backups, unfinished installs) only a differs/doesn't check is possible.
+	Tracked bool
+}
+
+// ClassifyRestore decides what to do with a single file during a restore
+func ClassifyRestore(s RestoreFileState) RestoreAction {
+	if s.Current == s.Backup {
+		return RestoreUnchanged
+	}
+	if s.Current == "" {
+		// Nothing on disk to lose
+		return RestoreOverwrite
+	}
+	if !s.Tracked {
+		// Without a deployed snapshot there's no telling the installer's files
+		// from the user's, so anything that differs, or that the backup
+		// lacks, is the user's call
+		return RestoreConflict
+	}
+	if s.Current == s.Deployed {
+		// Untouched since the install
+		if s.Backup == "" {
+			return RestoreRemove
+		}
+		return RestoreOverwrite
+	}
+	return RestoreConflict
+}
+
+// RestoreItem is one file in a restore plan
+type RestoreItem struct {
+	Path       string // Manifest-style path, e.g. "nvim/init.lua"
+	BackupPath string // Backup copy on disk ("" if the backup doesn't have it)
+	LivePath   string // Deployed location
+	Action     RestoreAction
+}
+
+// ConflictChoice is how the user resolved a RestoreConflict item
+type ConflictChoice int
+
+const (
+	ConflictKeepCurrent ConflictChoice = iota // Leave the current file alone
+	ConflictTakeBackup                        // Replace it with the backup copy
+	ConflictKeepBoth                          // Keep it, and write the backup copy beside it with FromBackupSuffix
+)
+
+// livePathFor maps a manifest-style path to its deployed location
+func livePathFor(configPaths map[string]string, path string) string {
+	key, rel, _ := strings.Cut(path, "/")
+	if rel == "" {
+		return configPaths[key]
+	}
+	return filepath.Join(configPaths[key], filepath.FromSlash(rel))
+}
+
+// PlanRestore compares a backup against the deployed configs and classifies every
+// file. Items are sorted by path; unchanged files are omitted.
+func PlanRestore(backupDir string) ([]RestoreItem, error) {
+	return PlanRestoreItems(backupDir, nil)
+}
+
+// PlanRestoreItems is PlanRestore limited to the configs with the given keys.
+// Nil keys plan every config in the backup; keys the backup lacks are ignored.
+// The plan points into backupDir, so an archive has to be opened with
+// OpenBackup first.
+func PlanRestoreItems(backupDir string, keys []string) ([]RestoreItem, error) {
+	if IsBackupArchive(backupDir) {
+		return nil, fmt.Errorf("%s: %w", filepath.Base(backupDir), errArchiveNeedsOpen)
+	}
+	manifest, err := ReadBackupManifest(backupDir)
+	if err != nil {
+		return nil, err
+	}
+
+	configPaths := ConfigPaths()
+	backupHashes := make(map[string]string)
+	currentHashes := make(map[string]string)
+	for _, key := range backupKeys(backupDir) {
+		if keys != nil && !slices.Contains(keys, key) {
+			continue
+		}
+		if manifest != nil && manifest.Files != nil {
+			for path, sum := range manifest.Files {
+				if path == key || strings.HasPrefix(path, key+"/") {
+					backupHashes[path] = sum
+				}
+			}
+		} else {
+			for path, sum := range hashTree(key, filepath.Join(backupDir, key)) {
+				backupHashes[path] = sum
+			}
+		}
+		for path, sum := range hashTree(key, configPaths[key]) {
+			currentHashes[path] = sum
+		}
+	}
+
+	var deployed map[string]string
+	if manifest != nil {
+		deployed = manifest.Deployed
+	}
+
+	paths := make(map[string]bool)
+	for p := range backupHashes {
+		paths[p] = true
+	}
+	for p := range currentHashes {
+		paths[p] = true
+	}
+
+	var items []RestoreItem
+	for path := range paths {
+		action := ClassifyRestore(RestoreFileState{
+			Backup:   backupHashes[path],
+			Current:  currentHashes[path],
+			Deployed: deployed[path],
+			Tracked:  deployed != nil,
+		})
+		if action == RestoreUnchanged {
+			continue
+		}
+		item := RestoreItem{Path: path, LivePath: livePathFor(configPaths, path), Action: action}
+		if backupHashes[path] != "" {
+			item.BackupPath = filepath.Join(backupDir, filepath.FromSlash(path))
+		}
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Path < items[j].Path })
+	return items, nil
+}
+
+// RestoreConflicts returns the items in a plan that need a user decision
+func RestoreConflicts(items []RestoreItem) []RestoreItem {
+	var conflicts []RestoreItem
+	for _, item := range items {
+		if item.Action == RestoreConflict {
+			conflicts = append(conflicts, item)
+		}
+	}
+	return conflicts
+}
+
+// ApplyRestore carries out a plan. choices maps a conflict's Path to its resolution;
+// unresolved conflicts keep the current file.
+func ApplyRestore(items []RestoreItem, choices map[string]ConflictChoice) error {
+	for _, item := range items {
+		switch item.Action {
+		case RestoreOverwrite:
+			if err := restoreFile(item.BackupPath, item.LivePath); err != nil {
+				return fmt.Errorf("failed to restore %s: %w", item.Path, err)
+			}
+		case RestoreRemove:
+			if err := os.Remove(item.LivePath); err != nil && !os.IsNotExist(err) {
+				return fmt.Errorf("failed to remove %s: %w", item.Path, err)
+			}
+		case RestoreConflict:
+			switch choices[item.Path] {
+			case ConflictTakeBackup:
+				if item.BackupPath == "" {
+					if err := os.Remove(item.LivePath); err != nil && !os.IsNotExist(err) {
+						return fmt.Errorf("failed to remove %s: %w", item.Path, err)
+					}
+					continue
+				}
+				if err := restoreFile(item.BackupPath, item.LivePath); err != nil {
+					return fmt.Errorf("failed to restore %s: %w", item.Path, err)
+				}
+			case ConflictKeepBoth:
+				if item.BackupPath == "" {
+					continue // Nothing to put beside it
+				}
+				if err := restoreFile(item.BackupPath, item.LivePath+FromBackupSuffix); err != nil {
+					return fmt.Errorf("failed to restore %s: %w", item.Path, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// restoreFile copies a backup file into place, replacing whatever is there
+func restoreFile(src, dst string) error {
+	return DeployConfig(src, dst)
+}
@@ -0,0 +1,364 @@
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestClassifyRestore(t *testing.T) {
+	tests := []struct {
+		name  string
+		state RestoreFileState
+		want  RestoreAction
+	}{
+		// Tracked: manifest has a deployed snapshot
+		{"unchanged since backup", RestoreFileState{Backup: "B", Current: "B", Deployed: "D", Tracked: true}, RestoreUnchanged},
+		{"installer copy untouched", RestoreFileState{Backup: "B", Current: "D", Deployed: "D", Tracked: true}, RestoreOverwrite},
+		{"user edited after install", RestoreFileState{Backup: "B", Current: "U", Deployed: "D", Tracked: true}, RestoreConflict},
+		{"deleted after install", RestoreFileState{Backup: "B", Current: "", Deployed: "D", Tracked: true}, RestoreOverwrite},
+		{"installer added file untouched", RestoreFileState{Backup: "", Current: "D", Deployed: "D", Tracked: true}, RestoreRemove},
+		{"installer added file edited", RestoreFileState{Backup: "", Current: "U", Deployed: "D", Tracked: true}, RestoreConflict},
+		{"user created file after install", RestoreFileState{Backup: "", Current: "U", Deployed: "", Tracked: true}, RestoreConflict},
+		{"installer did not touch backed-up file", RestoreFileState{Backup: "B", Current: "B", Deployed: "", Tracked: true}, RestoreUnchanged},
+		{"file gone everywhere", RestoreFileState{Tracked: true}, RestoreUnchanged},
+		{"backup only, never deployed", RestoreFileState{Backup: "B", Current: "", Deployed: "", Tracked: true}, RestoreOverwrite},
+		// Untracked: old backups fall back to differs/doesn't
+		{"old backup same content", RestoreFileState{Backup: "B", Current: "B"}, RestoreUnchanged},
+		{"old backup differs", RestoreFileState{Backup: "B", Current: "X"}, RestoreConflict},
+		{"old backup missing on disk", RestoreFileState{Backup: "B", Current: ""}, RestoreOverwrite},
+		{"old backup extra file on disk", RestoreFileState{Backup: "", Current: "X"}, RestoreConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ClassifyRestore(tt.state); got != tt.want {
+				t.Errorf("ClassifyRestore(%+v) = %d, want %d", tt.state, got, tt.want)
+			}
+		})
+	}
+}
+
+// restoreFixture backs up ~/.config/nvim, then simulates an install that
+// replaces init.lua and adds a plugin file
+func restoreFixture(t *testing.T) (home, backupDir string) {
+	t.Helper()
+	home = t.TempDir()
+	t.Setenv("HOME", home)
+	nvim := filepath.Join(home, ".config", "nvim")
+
+	writeFile := func(path, content string) {
+		t.Helper()
+		os.MkdirAll(filepath.Dir(path), 0755)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFile(filepath.Join(nvim, "init.lua"), "user init")
+	writeFile(filepath.Join(nvim, "lua", "options.lua"), "user options")
+
+	backupDir, err := CreateBackup([]string{"nvim"})
+	if err != nil {
+		t.Fatalf("CreateBackup failed: %v", err)
+	}
+	t.Cleanup(func() { os.RemoveAll(backupDir) })
+
+	// Install deploys its own config
+	writeFile(filepath.Join(nvim, "init.lua"), "gentleman init")
+	writeFile(filepath.Join(nvim, "lua", "options.lua"), "gentleman options")
+	writeFile(filepath.Join(nvim, "lua", "plugins.lua"), "gentleman plugins")
+	if err := RecordDeployedHashes(backupDir); err != nil {
+		t.Fatalf("RecordDeployedHashes failed: %v", err)
+	}
+	return home, backupDir
+}
+
+func TestBackupManifest(t *testing.T) {
+	_, backupDir := restoreFixture(t)
+
+	manifest, err := ReadBackupManifest(backupDir)
+	if err != nil || manifest == nil {
+		t.Fatalf("expected manifest, got %v, %v", manifest, err)
+	}
+	if len(manifest.Files) != 2 {
+		t.Errorf("expected 2 backed-up files, got %v", manifest.Files)
+	}
+	if len(manifest.Deployed) != 3 {
+		t.Errorf("expected 3 deployed files, got %v", manifest.Deployed)
+	}
+
+	for _, b := range ListBackups() {
+		for _, f := range b.Files {
+			if f == BackupManifestFile {
+				t.Error("ListBackups should not list the manifest as backup content")
+			}
+		}
+	}
+}
+
+func TestPlanAndApplyRestore(t *testing.T) {
+	home, backupDir := restoreFixture(t)
+	nvim := filepath.Join(home, ".config", "nvim")
+
+	// User edits one file after the install
+	os.WriteFile(filepath.Join(nvim, "init.lua"), []byte("user edited after install"), 0644)
+
+	items, err := PlanRestore(backupDir)
+	if err != nil {
+		t.Fatalf("PlanRestore failed: %v", err)
+	}
+	actions := make(map[string]RestoreAction)
+	for _, item := range items {
+		actions[item.Path] = item.Action
+	}
+	want := map[string]RestoreAction{
+		"nvim/init.lua":        RestoreConflict,
+		"nvim/lua/options.lua": RestoreOverwrite,
+		"nvim/lua/plugins.lua": RestoreRemove,
+	}
+	for path, action := range want {
+		if actions[path] != action {
+			t.Errorf("%s: expected action %d, got %d", path, action, actions[path])
+		}
+	}
+	if conflicts := RestoreConflicts(items); len(conflicts) != 1 || conflicts[0].Path != "nvim/init.lua" {
+		t.Errorf("expected only init.lua to conflict, got %+v", conflicts)
+	}
+
+	t.Run("keep both", func(t *testing.T) {
+		if err := ApplyRestore(items, map[string]ConflictChoice{"nvim/init.lua": ConflictKeepBoth}); err != nil {
+			t.Fatalf("ApplyRestore failed: %v", err)
+		}
+		assertContent(t, filepath.Join(nvim, "init.lua"), "user edited after install")
+		assertContent(t, filepath.Join(nvim, "init.lua"+FromBackupSuffix), "user init")
+		assertContent(t, filepath.Join(nvim, "lua", "options.lua"), "user options")
+		if _, err := os.Stat(filepath.Join(nvim, "lua", "plugins.lua")); !os.IsNotExist(err) {
+			t.Error("installer-added file should be removed")
+		}
+	})
+}
+
+func TestApplyRestoreConflictChoices(t *testing.T) {
+	dir := t.TempDir()
+	backup := filepath.Join(dir, "backup.lua")
+	os.WriteFile(backup, []byte("backup"), 0644)
+
+	tests := []struct {
+		name   string
+		choice ConflictChoice
+		want   string
+	}{
+		{"keep current", ConflictKeepCurrent, "current"},
+		{"take backup", ConflictTakeBackup, "backup"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			live := filepath.Join(dir, tt.name+".lua")
+			os.WriteFile(live, []byte("current"), 0644)
+			items := []RestoreItem{{Path: "x", BackupPath: backup, LivePath: live, Action: RestoreConflict}}
+			if err := ApplyRestore(items, map[string]ConflictChoice{"x": tt.choice}); err != nil {
+				t.Fatal(err)
+			}
+			assertContent(t, live, tt.want)
+		})
+	}
+
+	t.Run("take backup of file the backup lacks removes it", func(t *testing.T) {
+		live := filepath.Join(dir, "user-created.lua")
+		os.WriteFile(live, []byte("current"), 0644)
+		items := []RestoreItem{{Path: "y", LivePath: live, Action: RestoreConflict}}
+		if err := ApplyRestore(items, map[string]ConflictChoice{"y": ConflictTakeBackup}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := os.Stat(live); !os.IsNotExist(err) {
+			t.Error("expected file to be removed")
+		}
+	})
+}
+
+func TestPlanRestoreOldBackup(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	// Hand-built backup with no manifest, as created by older installers
+	backupDir := filepath.Join(home, ".gentleman-backup-2020-01-01-000000")
+	os.MkdirAll(backupDir, 0755)
+	os.WriteFile(filepath.Join(backupDir, "zsh"), []byte("old zshrc"), 0644)
+	os.WriteFile(filepath.Join(backupDir, "tmux"), []byte("same"), 0644)
+	os.WriteFile(filepath.Join(home, ".zshrc"), []byte("current zshrc"), 0644)
+	os.WriteFile(filepath.Join(home, ".tmux.conf"), []byte("same"), 0644)
+
+	items, err := PlanRestore(backupDir)
+	if err != nil {
+		t.Fatalf("PlanRestore failed: %v", err)
+	}
+	if len(items) != 1 || items[0].Path != "zsh" || items[0].Action != RestoreConflict {
+		t.Errorf("expected only zsh to conflict, got %+v", items)
+	}
+	if items[0].LivePath != filepath.Join(home, ".zshrc") {
+		t.Errorf("expected live path ~/.zshrc, got %s", items[0].LivePath)
+	}
+}
+
+func TestPlanRestoreOldBackupKeepsExtraFiles(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	// A config dir in a backup with no manifest, and a file the user added since
+	backupDir := filepath.Join(home, ".gentleman-backup-2020-01-01-000000")
+	writeSized(t, filepath.Join(backupDir, "nvim", "init.lua"), 10)
+	nvim := filepath.Join(home, ".config", "nvim")
+	writeSized(t, filepath.Join(nvim, "init.lua"), 10)
+	os.WriteFile(filepath.Join(nvim, "notes.md"), []byte("user notes"), 0644)
+
+	items, err := PlanRestore(backupDir)
+	if err != nil {
+		t.Fatalf("PlanRestore failed: %v", err)
+	}
+	if len(items) != 1 || items[0].Path != "nvim/notes.md" || items[0].Action != RestoreConflict {
+		t.Fatalf("expected only the extra file, as a conflict, got %+v", items)
+	}
+
+	// Unresolved conflicts keep the current file
+	if err := ApplyRestore(items, nil); err != nil {
+		t.Fatal(err)
+	}
+	assertContent(t, filepath.Join(nvim, "notes.md"), "user notes")
+}
+
+func assertContent(t *testing.T, path, want string) {
+	t.Helper()
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading %s: %v", path, err)
+	}
+	if string(got) != want {
+		t.Errorf("%s: expected %q, got %q", path, want, got)
+	}
+}
+
+// twoConfigBackup backs up a user nvim dir and .tmux.conf, then replaces
+// both with installed versions
+func twoConfigBackup(t *testing.T) (home, backupDir string) {
+	t.Helper()
+	home, backupDir = restoreFixture(t)
+	tmux := filepath.Join(home, ".tmux.conf")
+	os.WriteFile(tmux, []byte("user tmux"), 0644)
+	if err := CopyFile(tmux, filepath.Join(backupDir, "tmux")); err != nil {
+		t.Fatal(err)
+	}
+	os.WriteFile(tmux, []byte("gentleman tmux"), 0644)
+	return home, backupDir
+}
+
+func TestListBackupsItems(t *testing.T) {
+	home, backupDir := twoConfigBackup(t)
+
+	for _, b := range ListBackups() {
+		if b.Path != backupDir {
+			continue
+		}
+		want := []BackupItem{
+			{Key: "nvim", LivePath: filepath.Join(home, ".config", "nvim"), IsDir: true},
+			{Key: "tmux", LivePath: filepath.Join(home, ".tmux.conf")},
+		}
+		if len(b.Items) != len(want) {
+			t.Fatalf("items = %+v, want %+v", b.Items, want)
+		}
+		for i := range want {
+			if b.Items[i] != want[i] {
+				t.Errorf("item %d = %+v, want %+v", i, b.Items[i], want[i])
+			}
+		}
+		return
+	}
+	t.Fatal("the backup is not listed")
+}
+
+func TestRestoreBackupItems(t *testing.T) {
+	t.Run("restores only the picked items", func(t *testing.T) {
+		home, backupDir := twoConfigBackup(t)
+		nvim := filepath.Join(home, ".config", "nvim")
+
+		if err := RestoreBackupItems(backupDir, []string{"nvim"}); err != nil {
+			t.Fatalf("RestoreBackupItems failed: %v", err)
+		}
+		assertContent(t, filepath.Join(nvim, "init.lua"), "user init")
+		assertContent(t, filepath.Join(nvim, "lua", "options.lua"), "user options")
+		if _, err := os.Stat(filepath.Join(nvim, "lua", "plugins.lua")); !os.IsNotExist(err) {
+			t.Error("the restored dir should match the backup")
+		}
+		assertContent(t, filepath.Join(home, ".tmux.conf"), "gentleman tmux")
+		if _, err := os.Stat(filepath.Join(home, ".tmux.conf"+PreRestoreSuffix)); !os.IsNotExist(err) {
+			t.Error("items that weren't picked must not be touched")
+		}
+	})
+
+	t.Run("keeps replaced configs with the pre-restore suffix", func(t *testing.T) {
+		home, backupDir := twoConfigBackup(t)
+		nvim := filepath.Join(home, ".config", "nvim")
+		// A leftover from an earlier restore is replaced
+		os.WriteFile(filepath.Join(home, ".tmux.conf"+PreRestoreSuffix), []byte("old"), 0644)
+
+		if err := RestoreBackupItems(backupDir, []string{"nvim", "tmux"}); err != nil {
+			t.Fatalf("RestoreBackupItems failed: %v", err)
+		}
+		assertContent(t, filepath.Join(home, ".tmux.conf"), "user tmux")
+		assertContent(t, filepath.Join(home, ".tmux.conf"+PreRestoreSuffix), "gentleman tmux")
+		assertContent(t, filepath.Join(nvim+PreRestoreSuffix, "init.lua"), "gentleman init")
+		assertContent(t, filepath.Join(nvim+PreRestoreSuffix, "lua", "plugins.lua"), "gentleman plugins")
+	})
+
+	t.Run("configs matching the backup are not copied", func(t *testing.T) {
+		home, backupDir := twoConfigBackup(t)
+		os.WriteFile(filepath.Join(home, ".tmux.conf"), []byte("user tmux"), 0644)
+
+		if err := RestoreBackupItems(backupDir, []string{"tmux"}); err != nil {
+			t.Fatalf("RestoreBackupItems failed: %v", err)
+		}
+		if _, err := os.Stat(filepath.Join(home, ".tmux.conf"+PreRestoreSuffix)); !os.IsNotExist(err) {
+			t.Error("nothing differs, so nothing needs keeping")
+		}
+	})
+
+	t.Run("restores configs that no longer exist", func(t *testing.T) {
+		home, backupDir := twoConfigBackup(t)
+		os.Remove(filepath.Join(home, ".tmux.conf"))
+
+		if err := RestoreBackupItems(backupDir, []string{"tmux"}); err != nil {
+			t.Fatalf("RestoreBackupItems failed: %v", err)
+		}
+		assertContent(t, filepath.Join(home, ".tmux.conf"), "user tmux")
+	})
+
+	t.Run("missing items write nothing", func(t *testing.T) {
+		home, backupDir := twoConfigBackup(t)
+
+		err := RestoreBackupItems(backupDir, []string{"tmux", "zellij", "not-a-config"})
+		if err == nil || !strings.Contains(err.Error(), "zellij, not-a-config") {
+			t.Fatalf("expected the missing items to be named, got %v", err)
+		}
+		assertContent(t, filepath.Join(home, ".tmux.conf"), "gentleman tmux")
+		if _, err := os.Stat(filepath.Join(home, ".tmux.conf"+PreRestoreSuffix)); !os.IsNotExist(err) {
+			t.Error("a failed restore must not leave copies behind")
+		}
+	})
+}
+
+func TestPlanRestoreItems(t *testing.T) {
+	_, backupDir := twoConfigBackup(t)
+
+	items, err := PlanRestoreItems(backupDir, []string{"tmux", "zellij"})
+	if err != nil {
+		t.Fatalf("PlanRestoreItems failed: %v", err)
+	}
+	if len(items) != 1 || items[0].Path != "tmux" {
+		t.Errorf("expected only the tmux config, got %+v", items)
+	}
+
+	all, _ := PlanRestore(backupDir)
+	if len(all) != 4 {
+		t.Errorf("PlanRestore should still plan every config, got %+v", all)
+	}
+}
@@ -0,0 +1,240 @@
+package system
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ConfigDeploy is one config file or directory to put in place
+type ConfigDeploy struct {
+	Src string
+	Dst string
+	// Replace drops files in Dst that Src doesn't have. By default a
+	// directory deploy overlays Src on the existing contents, like CopyDir.
+	Replace bool
+}
+
+// DeployError reports which config could not be deployed. The target is
+// left exactly as it was before the deploy started.
+type DeployError struct {
+	Config string
+	Err    error
+}
+
+func (e *DeployError) Error() string {
+	return fmt.Sprintf("failed to deploy %s: %v", e.Config, e.Err)
+}
+
+func (e *DeployError) Unwrap() error {
+	return e.Err
+}
+
+// configDeployer stages, swaps and rolls back config deploys. copyFile is
+// injectable so tests can fail a deploy partway through.
+type configDeployer struct {
+	copyFile   func(src, dst string) error
+	recordPath string // Deploy record to update after each deploy; "" to skip
+}
+
+var defaultDeployer = configDeployer{copyFile: CopyFile}
+
+// DeployConfig atomically copies src (a file or directory) to dst
+func DeployConfig(src, dst string) error {
+	return DeployConfigs(ConfigDeploy{Src: src, Dst: dst})
+}
+
+// DeployConfigs puts several configs in place as one unit. Every config is
+// first staged into a temp dir beside its target (same filesystem), then the
+// existing targets are moved aside and the staged copies renamed into place.
+// If anything fails, all targets are restored and the failing one is reported.
+func DeployConfigs(items ...ConfigDeploy) error {
+	return defaultDeployer.deploy(items)
+}
+
+// stagedDeploy tracks one config between staging and commit
+type stagedDeploy struct {
+	dst      string
+	stageDir string // Temp dir beside dst; holds the staged copy and the old target
+	staged   string
+	aside    string // Where the old target was moved; "" if there was none
+	swapped  bool
+}
+
+func (d configDeployer) deploy(items []ConfigDeploy) error {
+	var units []*stagedDeploy
+	cleanup := func() {
+		for _, u := range units {
+			os.RemoveAll(u.stageDir)
+		}
+	}
+
+	for _, item := range items {
+		u, err := d.stage(item)
+		if u != nil {
+			units = append(units, u)
+		}
+		if err != nil {
+			cleanup()
+			return &DeployError{Config: item.Dst, Err: err}
+		}
+	}
+
+	for _, u := range units {
+		if err := u.swap(); err != nil {
+			for _, done := range units {
+				done.rollback()
+			}
+			cleanup()
+			return &DeployError{Config: u.dst, Err: err}
+		}
+	}
+
+	cleanup()
+	// The configs are in place; a record that can't be written only limits uninstall
+	d.record(items, units)
+	return nil
+}
+
+// stage copies the new config into a temp dir next to its target
+func (d configDeployer) stage(item ConfigDeploy) (*stagedDeploy, error) {
+	dst := item.Dst
+	// Deploy through a symlinked config (e.g. ~/.config/nvim -> ~/dotfiles/nvim)
+	if info, err := os.Lstat(dst); err == nil && info.Mode()&os.ModeSymlink != 0 {
+		if resolved, err := filepath.EvalSymlinks(dst); err == nil {
+			dst = resolved
+		}
+	}
+
+	srcInfo, err := os.Stat(item.Src)
+	if err != nil {
+		return nil, err
+	}
+	if err := EnsureDir(filepath.Dir(dst)); err != nil {
+		return nil, err
+	}
+	stageDir, err := os.MkdirTemp(filepath.Dir(dst), ".deploy-"+filepath.Base(dst)+"-")
+	if err != nil {
+		return nil, err
+	}
+	u := &stagedDeploy{dst: dst, stageDir: stageDir, staged: filepath.Join(stageDir, "new")}
+
+	if !srcInfo.IsDir() {
+		return u, d.copyEntry(item.Src, u.staged, srcInfo)
+	}
+
+	if dstInfo, err := os.Stat(dst); err == nil && dstInfo.IsDir() && !item.Replace {
+		if err := linkTree(dst, u.staged); err != nil {
+			return u, err
+		}
+	}
+	return u, d.overlay(item.Src, u.staged)
+}
+
+// copyEntry copies one file and keeps its permissions, so restored scripts
+// stay executable and private files private. Symlinks are copied as the file
+// they point to.
+func (d configDeployer) copyEntry(src, dst string, info os.FileInfo) error {
+	if err := d.copyFile(src, dst); err != nil {
+		return err
+	}
+	if info.Mode()&os.ModeSymlink != 0 {
+		target, err := os.Stat(src)
+		if err != nil {
+			return err
+		}
+		info = target
+	}
+	return os.Chmod(dst, info.Mode().Perm())
+}
+
+// overlay copies src over dst, replacing staged entries rather than writing
+// through them (they may be hard links to the live config)
+func (d configDeployer) overlay(src, dst string) error {
+	src = strings.TrimSuffix(strings.TrimSuffix(src, "/*"), "/.")
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, relPath)
+		if info.IsDir() {
+			if existing, err := os.Lstat(target); err == nil && !existing.IsDir() {
+				os.Remove(target)
+			}
+			return os.MkdirAll(target, info.Mode()|0700)
+		}
+		if err := os.RemoveAll(target); err != nil {
+			return err
+		}
+		return d.copyEntry(path, target, info)
+	})
+}
+
+// linkTree mirrors src into dst with hard links, falling back to copies
+// across devices. Symlinks are recreated as-is.
+func linkTree(src, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, relPath)
+		switch {
+		case info.IsDir():
+			return os.MkdirAll(target, info.Mode()|0700)
+		case info.Mode()&os.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(link, target)
+		case !info.Mode().IsRegular():
+			return nil // Sockets, fifos: nothing worth carrying over
+		}
+		if err := os.Link(path, target); err == nil {
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(target, data, info.Mode().Perm())
+	})
+}
+
+// swap moves the current target aside and the staged copy into place
+func (u *stagedDeploy) swap() error {
+	if _, err := os.Lstat(u.dst); err == nil {
+		u.aside = filepath.Join(u.stageDir, "old")
+		if err := os.Rename(u.dst, u.aside); err != nil {
+			u.aside = ""
+			return err
+		}
+	}
+	if err := os.Rename(u.staged, u.dst); err != nil {
+		u.rollback()
+		return err
+	}
+	u.swapped = true
+	return nil
+}
+
+// rollback puts the original target back
+func (u *stagedDeploy) rollback() {
+	if u.swapped {
+		os.RemoveAll(u.dst)
+		u.swapped = false
+	}
+	if u.aside != "" {
+		os.Rename(u.aside, u.dst)
+		u.aside = ""
+	}
+}
@@ -0,0 +1,124 @@
+package system
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
+)
+
+// DeployRecordFile lives in the data dir and lists every file the installer deployed
+const DeployRecordFile = "deployed.json"
+
+// DeployRecord maps the absolute path of each deployed file to the sha256 it had
+// when deployed. Uninstall removes only files that still match, so anything the
+// user added or edited afterwards stays.
+type DeployRecord struct {
+	Version int               `json:"version"`
+	Files   map[string]string `json:"files"`
+}
+
+// DeployRecordPath returns where the deploy record is kept for home
+func DeployRecordPath(home string) string {
+	return filepath.Join(paths.DataDir(home), DeployRecordFile)
+}
+
+// RecordDeploys makes every later deploy add its files to the record at path.
+// An empty path turns recording off, so restores don't count as installer files.
+func RecordDeploys(path string) {
+	defaultDeployer.recordPath = path
+}
+
+// LoadDeployRecord reads the record at path. A missing record returns (nil, nil).
+func LoadDeployRecord(path string) (*DeployRecord, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var rec DeployRecord
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return nil, fmt.Errorf("invalid deploy record: %w", err)
+	}
+	if rec.Files == nil {
+		rec.Files = make(map[string]string)
+	}
+	return &rec, nil
+}
+
+// SaveDeployRecord writes rec to path, creating the data dir if needed
+func SaveDeployRecord(path string, rec *DeployRecord) error {
+	data, err := json.MarshalIndent(rec, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// Matches reports whether path is a recorded file whose content is unchanged
+func (r *DeployRecord) Matches(path string) bool {
+	if r == nil {
+		return false
+	}
+	want, ok := r.Files[path]
+	if !ok {
+		return false
+	}
+	got, err := HashFile(path)
+	return err == nil && got == want
+}
+
+// add hashes the files item put in place. Only files that came from Src are
+// recorded: an overlay deploy keeps whatever the user already had in Dst.
+func (r *DeployRecord) add(item ConfigDeploy, dst string) {
+	src := strings.TrimSuffix(strings.TrimSuffix(item.Src, "/*"), "/.")
+	filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil || !info.Mode().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return nil
+		}
+		target := filepath.Join(dst, rel)
+		if sum, err := HashFile(target); err == nil {
+			r.Files[target] = sum
+		}
+		return nil
+	})
+}
+
+// recordMu serializes the read-modify-write of the deploy record: install
+// steps run concurrently, and two deploys racing would drop each other's files
+var recordMu sync.Mutex
+
+// record merges the deployed items into the record at d.recordPath
+func (d configDeployer) record(items []ConfigDeploy, units []*stagedDeploy) error {
+	if d.recordPath == "" {
+		return nil
+	}
+	recordMu.Lock()
+	defer recordMu.Unlock()
+	rec, err := LoadDeployRecord(d.recordPath)
+	if err != nil {
+		return err
+	}
+	if rec == nil {
+		rec = &DeployRecord{Version: 1, Files: make(map[string]string)}
+	}
+	for i, item := range items {
+		if i < len(units) {
+			rec.add(item, units[i].dst)
+		}
+	}
+	return SaveDeployRecord(d.recordPath, rec)
+}
@@ -0,0 +1,243 @@
+package system
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// writeTree creates files (relative path -> content) under root
+func writeTree(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+	for rel, content := range files {
+		path := filepath.Join(root, rel)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// readTree returns every regular file under root as relative path -> content
+func readTree(t *testing.T, root string) map[string]string {
+	t.Helper()
+	files := make(map[string]string)
+	filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, _ := filepath.Rel(root, path)
+		data, _ := os.ReadFile(path)
+		files[rel] = string(data)
+		return nil
+	})
+	return files
+}
+
+// failingCopy copies normally, then errors from the (n+1)th file on
+func failingCopy(n int) func(src, dst string) error {
+	copied := 0
+	return func(src, dst string) error {
+		if copied >= n {
+			return errors.New("disk full")
+		}
+		copied++
+		return CopyFile(src, dst)
+	}
+}
+
+func TestDeployConfigsOverlay(t *testing.T) {
+	base := t.TempDir()
+	src := filepath.Join(base, "repo", "nvim")
+	dst := filepath.Join(base, "home", ".config", "nvim")
+	writeTree(t, src, map[string]string{"init.lua": "new init", "lua/plugins.lua": "new plugins"})
+	writeTree(t, dst, map[string]string{"init.lua": "old init", "lua/user.lua": "mine"})
+
+	if err := DeployConfig(src, dst); err != nil {
+		t.Fatalf("DeployConfig failed: %v", err)
+	}
+
+	want := map[string]string{"init.lua": "new init", "lua/plugins.lua": "new plugins", "lua/user.lua": "mine"}
+	got := readTree(t, dst)
+	if len(got) != len(want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	for rel, content := range want {
+		if got[rel] != content {
+			t.Errorf("%s: expected %q, got %q", rel, content, got[rel])
+		}
+	}
+
+	entries, _ := os.ReadDir(filepath.Dir(dst))
+	for _, e := range entries {
+		if strings.HasPrefix(e.Name(), ".deploy-") {
+			t.Errorf("staging dir left behind: %s", e.Name())
+		}
+	}
+}
+
+func TestDeployConfigsReplace(t *testing.T) {
+	base := t.TempDir()
+	src := filepath.Join(base, "backup", "nvim")
+	dst := filepath.Join(base, "home", "nvim")
+	writeTree(t, src, map[string]string{"init.lua": "backup"})
+	writeTree(t, dst, map[string]string{"init.lua": "live", "extra.lua": "added later"})
+
+	if err := DeployConfigs(ConfigDeploy{Src: src, Dst: dst, Replace: true}); err != nil {
+		t.Fatalf("DeployConfigs failed: %v", err)
+	}
+	if got := readTree(t, dst); len(got) != 1 || got["init.lua"] != "backup" {
+		t.Errorf("expected only the backup contents, got %v", got)
+	}
+}
+
+func TestDeployConfigsRollbackOnPartialFailure(t *testing.T) {
+	base := t.TempDir()
+	repo := filepath.Join(base, "repo")
+	home := filepath.Join(base, "home")
+	writeTree(t, repo, map[string]string{
+		"starship.toml":    "new starship",
+		"fish/config.fish": "new config",
+		"fish/a.fish":      "new a",
+		"fish/b.fish":      "new b",
+	})
+	oldFish := map[string]string{"config.fish": "old config", "fish_variables": "universal vars"}
+	writeTree(t, filepath.Join(home, "fish"), oldFish)
+	writeTree(t, home, map[string]string{"starship.toml": "old starship"})
+
+	// starship.toml stages fine, then the fish dir fails after two of its files
+	d := configDeployer{copyFile: failingCopy(3)}
+	err := d.deploy([]ConfigDeploy{
+		{Src: filepath.Join(repo, "starship.toml"), Dst: filepath.Join(home, "starship.toml")},
+		{Src: filepath.Join(repo, "fish"), Dst: filepath.Join(home, "fish")},
+	})
+
+	var deployErr *DeployError
+	if !errors.As(err, &deployErr) {
+		t.Fatalf("expected a DeployError, got %v", err)
+	}
+	if deployErr.Config != filepath.Join(home, "fish") {
+		t.Errorf("expected the fish config to be reported, got %s", deployErr.Config)
+	}
+
+	if got := readTree(t, filepath.Join(home, "fish")); len(got) != len(oldFish) || got["config.fish"] != "old config" || got["fish_variables"] != "universal vars" {
+		t.Errorf("fish config should be untouched, got %v", got)
+	}
+	if data, _ := os.ReadFile(filepath.Join(home, "starship.toml")); string(data) != "old starship" {
+		t.Errorf("starship.toml should be untouched, got %q", data)
+	}
+	entries, _ := os.ReadDir(home)
+	for _, e := range entries {
+		if strings.HasPrefix(e.Name(), ".deploy-") {
+			t.Errorf("staging dir left behind: %s", e.Name())
+		}
+	}
+}
+
+func TestDeployConfigFollowsSymlinkedTarget(t *testing.T) {
+	base := t.TempDir()
+	src := filepath.Join(base, "repo", "zellij")
+	target := filepath.Join(base, "dotfiles", "zellij")
+	link := filepath.Join(base, "home", "zellij")
+	writeTree(t, src, map[string]string{"config.kdl": "new"})
+	writeTree(t, target, map[string]string{"config.kdl": "old"})
+	os.MkdirAll(filepath.Dir(link), 0755)
+	if err := os.Symlink(target, link); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := DeployConfig(src, link); err != nil {
+		t.Fatalf("DeployConfig failed: %v", err)
+	}
+	if info, err := os.Lstat(link); err != nil || info.Mode()&os.ModeSymlink == 0 {
+		t.Error("symlink should be kept")
+	}
+	if data, _ := os.ReadFile(filepath.Join(target, "config.kdl")); string(data) != "new" {
+		t.Errorf("expected the link target to be updated, got %q", data)
+	}
+}
+
+func TestDeployRecordsOnlyInstallerFiles(t *testing.T) {
+	base := t.TempDir()
+	record := filepath.Join(base, "data", DeployRecordFile)
+	RecordDeploys(record)
+	t.Cleanup(func() { RecordDeploys("") })
+
+	src := filepath.Join(base, "repo", "nvim")
+	dst := filepath.Join(base, "home", ".config", "nvim")
+	writeTree(t, src, map[string]string{"init.lua": "new init"})
+	writeTree(t, dst, map[string]string{"lua/user.lua": "mine"})
+	zshSrc := filepath.Join(base, "repo", ".zshrc")
+	zshDst := filepath.Join(base, "home", ".zshrc")
+	writeTree(t, filepath.Dir(zshSrc), map[string]string{".zshrc": "zsh"})
+
+	if err := DeployConfig(src, dst); err != nil {
+		t.Fatal(err)
+	}
+	if err := DeployConfig(zshSrc, zshDst); err != nil {
+		t.Fatal(err)
+	}
+
+	rec, err := LoadDeployRecord(record)
+	if err != nil || rec == nil {
+		t.Fatalf("LoadDeployRecord() = %v, %v", rec, err)
+	}
+	if len(rec.Files) != 2 {
+		t.Errorf("expected init.lua and .zshrc recorded, got %v", rec.Files)
+	}
+	if !rec.Matches(filepath.Join(dst, "init.lua")) || !rec.Matches(zshDst) {
+		t.Error("freshly deployed files should match the record")
+	}
+	if rec.Matches(filepath.Join(dst, "lua", "user.lua")) {
+		t.Error("files the user already had must not be recorded")
+	}
+
+	os.WriteFile(zshDst, []byte("edited"), 0644)
+	if rec.Matches(zshDst) {
+		t.Error("an edited file should no longer match")
+	}
+
+	// Recording is off again: deploys leave the record alone
+	RecordDeploys("")
+	writeTree(t, src, map[string]string{"extra.lua": "x"})
+	if err := DeployConfig(src, dst); err != nil {
+		t.Fatal(err)
+	}
+	if rec, _ := LoadDeployRecord(record); len(rec.Files) != 2 {
+		t.Errorf("deploys with recording off should not be recorded, got %v", rec.Files)
+	}
+}
+
+// Install steps deploy concurrently; every deploy must land in the record
+func TestDeployRecordConcurrentDeploys(t *testing.T) {
+	base := t.TempDir()
+	record := filepath.Join(base, "data", DeployRecordFile)
+	RecordDeploys(record)
+	t.Cleanup(func() { RecordDeploys("") })
+
+	const n = 20
+	var wg sync.WaitGroup
+	for i := range n {
+		name := fmt.Sprintf("config-%d", i)
+		writeTree(t, filepath.Join(base, "repo"), map[string]string{name: name})
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := DeployConfig(filepath.Join(base, "repo", name), filepath.Join(base, "home", name)); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	rec, err := LoadDeployRecord(record)
+	if err != nil || rec == nil || len(rec.Files) != n {
+		t.Fatalf("expected %d files recorded, got %v (%v)", n, rec, err)
+	}
+}
@@ -16,21 +16,73 @@ const (
 	OSDebian  // Debian-based (Debian, Ubuntu, etc.)
 	OSFedora  // Fedora/RHEL-based (Fedora, CentOS, RHEL, etc.)
 	OSTermux  // Termux on Android
+	OSWindows // Native Windows (WSL reports Linux)
+	OSNixOS
+	OSAlpine
+	OSOpenSUSE
+	OSVoid
 	OSUnknown
 )
 
 type SystemInfo struct {
-	OS        OSType
-	OSName    string
-	IsWSL     bool
-	IsARM     bool
-	IsTermux  bool
-	HomeDir   string
-	HasBrew   bool
-	HasPkg    bool // Termux package manager
-	HasXcode  bool
-	UserShell string
-	Prefix    string // Termux $PREFIX or empty for other systems
+	OS       OSType
+	OSName   string
+	IsWSL    bool
+	IsARM    bool
+	IsTermux bool
+	HomeDir  string
+	HasBrew  bool
+	HasPkg   bool // Termux package manager
+	// PackageManager is the distro package manager ("apt", "pacman", "dnf",
+	// "apk", "zypper", "xbps" or "nix"); empty off Linux or when unknown
+	PackageManager string
+	HasWinget      bool // Windows package managers
+	HasScoop       bool
+	HasXcode       bool
+	UserShell      string
+	Prefix         string // Termux $PREFIX or empty for other systems
+	GOOS           string
+	// DistroID and OSVersion identify the release ("ubuntu" "22.04", "macos"
+	// "14.5"); both are empty when unknown. See VersionWarning.
+	DistroID  string
+	OSVersion string
+	// Unsupported is set when the environment install can't run here (see
+	// SupportsInstall). The skill manager and trainer still work.
+	Unsupported bool
+}
+
+// SupportsInstall reports whether the environment install can run on goos.
+// Termux is supported even though Go reports it as android, and native
+// Windows gets a reduced install (Neovim, AI tools and skills).
+func SupportsInstall(goos string, termux bool) bool {
+	if termux {
+		return true
+	}
+	switch goos {
+	case "darwin", "linux", "windows":
+		return true // WSL reports linux
+	}
+	return false
+}
+
+// WindowsPackageManager picks the package manager for the reduced Windows
+// install: winget when present, then scoop, or "" when there is neither
+func (s *SystemInfo) WindowsPackageManager() string {
+	switch {
+	case s.HasWinget:
+		return "winget"
+	case s.HasScoop:
+		return "scoop"
+	}
+	return ""
+}
+
+// platformNames are display names for platforms Detect doesn't identify further
+var platformNames = map[string]string{
+	"freebsd": "FreeBSD",
+	"openbsd": "OpenBSD",
+	"netbsd":  "NetBSD",
+	"android": "Android",
 }
 
 func Detect() *SystemInfo {
@@ -40,6 +92,7 @@ func Detect() *SystemInfo {
 		HomeDir: os.Getenv("HOME"),
 		IsARM:   runtime.GOARCH == "arm64" || runtime.GOARCH == "arm",
 		Prefix:  os.Getenv("PREFIX"),
+		GOOS:    runtime.GOOS,
 	}
 
 	// Check for Termux FIRST (it runs on Linux but is special)
@@ -58,10 +111,13 @@ func Detect() *SystemInfo {
 		info.OS = OSMac
 		info.OSName = "macOS"
 		info.HasXcode = checkXcode()
+		info.DistroID = "macos"
+		info.OSVersion = macOSVersion()
 	case "linux":
 		info.OS = OSLinux
 		info.OSName = "Linux"
 		info.IsWSL = checkWSL()
+		info.DistroID, info.OSVersion = readOSRelease()
 
 		if isArchLinux() {
 			info.OS = OSArch
@@ -72,8 +128,39 @@ func Detect() *SystemInfo {
 		} else if isDebian() {
 			info.OS = OSDebian
 			info.OSName = "Debian/Ubuntu"
+		} else if isNixOS() {
+			info.OS = OSNixOS
+			info.OSName = "NixOS"
+		} else if isAlpine() {
+			info.OS = OSAlpine
+			info.OSName = "Alpine Linux"
+		} else if strings.HasPrefix(info.DistroID, "opensuse") || info.DistroID == "sles" {
+			info.OS = OSOpenSUSE
+			info.OSName = "openSUSE"
+		} else if info.DistroID == "void" {
+			info.OS = OSVoid
+			info.OSName = "Void Linux"
+		}
+		info.PackageManager = detectPackageManager(info.OS)
+	case "windows":
+		info.OS = OSWindows
+		info.OSName = "Windows"
+		info.DistroID = "windows"
+		if info.HomeDir == "" {
+			// The install steps read $HOME, which only Git Bash sets on Windows
+			info.HomeDir = os.Getenv("USERPROFILE")
+			os.Setenv("HOME", info.HomeDir)
+		}
+		info.HasWinget = CommandExists("winget")
+		info.HasScoop = CommandExists("scoop")
+	default:
+		if name, ok := platformNames[runtime.GOOS]; ok {
+			info.OSName = name
+		} else {
+			info.OSName = runtime.GOOS
 		}
 	}
+	info.Unsupported = !SupportsInstall(runtime.GOOS, false)
 
 	info.HasBrew = checkBrew()
 	info.UserShell = detectCurrentShell()
@@ -112,6 +199,16 @@ func isFedora() bool {
 	return false
 }
 
+func isNixOS() bool {
+	_, err := os.Stat("/etc/NIXOS")
+	return err == nil
+}
+
+func isAlpine() bool {
+	_, err := os.Stat("/etc/alpine-release")
+	return err == nil
+}
+
 // isTermux detects if we're running in Termux on Android
 func isTermux() bool {
 	// Check TERMUX_VERSION environment variable
@@ -16,7 +16,7 @@ func TestOSTypes(t *testing.T) {
 	})
 
 	t.Run("all OS types should be distinct", func(t *testing.T) {
-		osTypes := []OSType{OSMac, OSLinux, OSArch, OSDebian, OSFedora, OSTermux, OSUnknown}
+		osTypes := []OSType{OSMac, OSLinux, OSArch, OSDebian, OSFedora, OSTermux, OSWindows, OSNixOS, OSAlpine, OSOpenSUSE, OSVoid, OSUnknown}
 		seen := make(map[OSType]bool)
 		for _, ot := range osTypes {
 			if seen[ot] {
@@ -71,6 +71,57 @@ func TestDetect(t *testing.T) {
 	})
 }
 
+func TestSupportsInstall(t *testing.T) {
+	tests := []struct {
+		name   string
+		goos   string
+		termux bool
+		want   bool
+	}{
+		{"macOS", "darwin", false, true},
+		{"Linux and WSL", "linux", false, true},
+		{"Termux on a linux build", "linux", true, true},
+		{"Termux on an android build", "android", true, true},
+		{"Android outside Termux", "android", false, false},
+		{"native Windows", "windows", false, true},
+		{"FreeBSD", "freebsd", false, false},
+		{"OpenBSD", "openbsd", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SupportsInstall(tt.goos, tt.termux); got != tt.want {
+				t.Errorf("SupportsInstall(%q, %v) = %v, want %v", tt.goos, tt.termux, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("Detect sets Unsupported from SupportsInstall", func(t *testing.T) {
+		info := Detect()
+		if info.Unsupported != !SupportsInstall(runtime.GOOS, info.IsTermux) {
+			t.Errorf("Unsupported = %v on %s (termux=%v)", info.Unsupported, runtime.GOOS, info.IsTermux)
+		}
+	})
+}
+
+func TestWindowsPackageManager(t *testing.T) {
+	tests := []struct {
+		name string
+		info SystemInfo
+		want string
+	}{
+		{"winget preferred", SystemInfo{HasWinget: true, HasScoop: true}, "winget"},
+		{"scoop only", SystemInfo{HasScoop: true}, "scoop"},
+		{"neither", SystemInfo{}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.info.WindowsPackageManager(); got != tt.want {
+				t.Errorf("WindowsPackageManager() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestCommandExists(t *testing.T) {
 	t.Run("should find common commands", func(t *testing.T) {
 		// These should exist on any unix system
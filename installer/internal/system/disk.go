@@ -0,0 +1,30 @@
+package system
+
+import "math"
+
+// DiskStats are the numbers of a file system DiskFree reads
+type DiskStats struct {
+	BlockSize   uint64
+	BlocksAvail uint64 // Free blocks an unprivileged user may write
+}
+
+// DiskStatter reads the stats of the file system holding a path
+type DiskStatter interface {
+	Stat(path string) (DiskStats, error)
+}
+
+// diskStatter is what DiskFree asks; tests swap it for a fake
+var diskStatter DiskStatter = osStatter{}
+
+// DiskFree is how many bytes a user can still write to the file system
+// holding path, the space root reserves left out
+func DiskFree(path string) (int64, error) {
+	st, err := diskStatter.Stat(path)
+	if err != nil {
+		return 0, err
+	}
+	if st.BlockSize != 0 && st.BlocksAvail > math.MaxInt64/st.BlockSize {
+		return math.MaxInt64, nil
+	}
+	return int64(st.BlocksAvail * st.BlockSize), nil
+}
@@ -0,0 +1,64 @@
+package system
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+type fakeDiskStatter struct {
+	stats DiskStats
+	err   error
+	path  string
+}
+
+func (f *fakeDiskStatter) Stat(path string) (DiskStats, error) {
+	f.path = path
+	return f.stats, f.err
+}
+
+func useDiskStatter(t *testing.T, s DiskStatter) {
+	t.Helper()
+	saved := diskStatter
+	diskStatter = s
+	t.Cleanup(func() { diskStatter = saved })
+}
+
+func TestDiskFree(t *testing.T) {
+	tests := []struct {
+		name  string
+		stats DiskStats
+		want  int64
+	}{
+		{"blocks times block size", DiskStats{BlockSize: 4096, BlocksAvail: 1000}, 4096000},
+		{"full disk", DiskStats{BlockSize: 4096}, 0},
+		{"capped instead of overflowing", DiskStats{BlockSize: 1 << 20, BlocksAvail: math.MaxUint64 >> 8}, math.MaxInt64},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeDiskStatter{stats: tt.stats}
+			useDiskStatter(t, fake)
+			got, err := DiskFree("/home/me")
+			if err != nil || got != tt.want {
+				t.Errorf("DiskFree = %d, %v; want %d", got, err, tt.want)
+			}
+			if fake.path != "/home/me" {
+				t.Errorf("stat asked for %q", fake.path)
+			}
+		})
+	}
+
+	t.Run("stat error", func(t *testing.T) {
+		useDiskStatter(t, &fakeDiskStatter{err: errors.New("no such file")})
+		if _, err := DiskFree("/missing"); err == nil {
+			t.Error("the stat error should be returned")
+		}
+	})
+
+	t.Run("statfs", func(t *testing.T) {
+		free, err := DiskFree(t.TempDir())
+		if err != nil || free <= 0 {
+			t.Errorf("a temp dir should have free space, got %d, %v", free, err)
+		}
+	})
+}
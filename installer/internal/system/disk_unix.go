@@ -0,0 +1,16 @@
+//go:build !windows
+
+package system
+
+import "syscall"
+
+// osStatter is the DiskStatter backed by statfs(2)
+type osStatter struct{}
+
+func (osStatter) Stat(path string) (DiskStats, error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(path, &st); err != nil {
+		return DiskStats{}, err
+	}
+	return DiskStats{BlockSize: uint64(st.Bsize), BlocksAvail: uint64(st.Bavail)}, nil
+}
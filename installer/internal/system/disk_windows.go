@@ -0,0 +1,22 @@
+//go:build windows
+
+package system
+
+import "golang.org/x/sys/windows"
+
+// osStatter is the DiskStatter backed by GetDiskFreeSpaceEx, which counts
+// bytes rather than blocks, so each "block" is one byte
+type osStatter struct{}
+
+func (osStatter) Stat(path string) (DiskStats, error) {
+	dir, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return DiskStats{}, err
+	}
+	// The bytes free to the calling user, quotas included
+	var avail, total, free uint64
+	if err := windows.GetDiskFreeSpaceEx(dir, &avail, &total, &free); err != nil {
+		return DiskStats{}, err
+	}
+	return DiskStats{BlockSize: 1, BlocksAvail: avail}, nil
+}
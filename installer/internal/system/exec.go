@@ -2,15 +2,24 @@ package system
 
 import (
 	"bufio"
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"runtime"
+	"slices"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"syscall"
 	"time"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
 )
 
 // shellPath caches the detected shell path
@@ -96,8 +105,16 @@ type ExecOptions struct {
 	WorkDir    string
 	Env        []string
 	Timeout    time.Duration
+	// RunWithLogs only: kill the command once it has been silent this long
+	IdleTimeout time.Duration
+	// RunWithLogs only: also end lines at \r, where progress meters redraw one
+	SplitCR bool
 }
 
+// ErrIdleTimeout is wrapped by the ExecError of a command killed for
+// producing no output within ExecOptions.IdleTimeout
+var ErrIdleTimeout = errors.New("no output")
+
 // parseCommand splits a command string into executable and arguments
 // This is a simple parser that handles basic quoting
 func parseCommand(command string) (string, []string) {
@@ -145,8 +162,15 @@ func parseCommand(command string) (string, []string) {
 	return executable, args[1:]
 }
 
-// Run executes a command and returns the result with detailed error information
-func Run(command string, opts *ExecOptions) *ExecResult {
+// runsDirect reports whether commands skip the shell wrapper: Termux has
+// fork/exec issues through a shell and native Windows has no sh to call
+func runsDirect() bool {
+	return isTermux() || runtime.GOOS == "windows"
+}
+
+// Run executes a command and returns the result with detailed error information.
+// Cancelling ctx kills the command.
+func Run(ctx context.Context, command string, opts *ExecOptions) *ExecResult {
 	if opts == nil {
 		opts = &ExecOptions{}
 	}
@@ -156,7 +180,6 @@ func Run(command string, opts *ExecOptions) *ExecResult {
 		Command: command,
 	}
 
-	ctx := context.Background()
 	if opts.Timeout > 0 {
 		var cancel context.CancelFunc
 		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
@@ -166,7 +189,7 @@ func Run(command string, opts *ExecOptions) *ExecResult {
 	// In Termux, execute commands directly without shell wrapper
 	// Go has issues with fork/exec through shell on Android
 	var cmd *exec.Cmd
-	if isTermux() {
+	if runsDirect() {
 		executable, args := parseCommand(command)
 		cmd = exec.CommandContext(ctx, executable, args...)
 	} else {
@@ -257,29 +280,29 @@ func streamOutput(r io.Reader, w *strings.Builder) {
 }
 
 // RunSudo runs a command with sudo
-func RunSudo(command string, opts *ExecOptions) *ExecResult {
-	return Run("sudo "+command, opts)
+func RunSudo(ctx context.Context, command string, opts *ExecOptions) *ExecResult {
+	return Run(ctx, "sudo "+command, opts)
 }
 
 // RunBrew runs a brew command
-func RunBrew(args string, opts *ExecOptions) *ExecResult {
+func RunBrew(ctx context.Context, args string, opts *ExecOptions) *ExecResult {
 	brewPath := GetBrewPrefix() + "/bin/brew"
-	return Run(brewPath+" "+args, opts)
+	return Run(ctx, brewPath+" "+args, opts)
 }
 
 // RunPkg runs a Termux pkg command (install packages)
-func RunPkg(args string, opts *ExecOptions) *ExecResult {
-	return Run("pkg "+args, opts)
+func RunPkg(ctx context.Context, args string, opts *ExecOptions) *ExecResult {
+	return Run(ctx, "pkg "+args, opts)
 }
 
 // RunPkgWithLogs runs a Termux pkg command with log streaming
-func RunPkgWithLogs(args string, opts *ExecOptions, logFunc func(string)) *ExecResult {
-	return RunWithLogs("pkg "+args, opts, logFunc)
+func RunPkgWithLogs(ctx context.Context, args string, opts *ExecOptions, logFunc func(string)) *ExecResult {
+	return RunWithLogs(ctx, "pkg "+args, opts, logFunc)
 }
 
 // RunPkgInstall runs pkg install with -y flag for non-interactive installs
-func RunPkgInstall(packages string, opts *ExecOptions, logFunc func(string)) *ExecResult {
-	return RunWithLogs("pkg install -y "+packages, opts, logFunc)
+func RunPkgInstall(ctx context.Context, packages string, opts *ExecOptions, logFunc func(string)) *ExecResult {
+	return RunWithLogs(ctx, "pkg install -y "+packages, opts, logFunc)
 }
 
 // CopyFile copies a file from src to dst
@@ -317,6 +340,21 @@ func CopyDir(src, dst string) error {
 	})
 }
 
+// errPrivilegeNotHeld is ERROR_PRIVILEGE_NOT_HELD, returned by os.Symlink on
+// Windows unless Developer Mode is on or the process is elevated
+const errPrivilegeNotHeld = syscall.Errno(1314)
+
+// IsSymlinkPrivilegeError reports whether err is a symlink Windows refused
+// for lack of privileges, where copying the target instead is the usual way
+// out. Elsewhere, and for a plain access denied, the error stands.
+func IsSymlinkPrivilegeError(err error) bool {
+	return isSymlinkPrivilegeError(runtime.GOOS, err)
+}
+
+func isSymlinkPrivilegeError(goos string, err error) bool {
+	return goos == "windows" && errors.Is(err, errPrivilegeNotHeld)
+}
+
 // EnsureDir creates a directory if it doesn't exist
 func EnsureDir(path string) error {
 	return os.MkdirAll(path, 0755)
@@ -327,6 +365,17 @@ type BackupInfo struct {
 	Path      string
 	Timestamp time.Time
 	Files     []string
+	Items     []BackupItem // The configs in Files that can be restored, by key
+	Size      int64        // Bytes on disk, or -1 until ComputeBackupSizes fills it in
+	// Compressed is true for a BackupArchiveExt archive, false for a directory
+	Compressed bool
+}
+
+// BackupItem is one config file or directory in a backup
+type BackupItem struct {
+	Key      string // ConfigPaths key, also its name in the backup
+	LivePath string // Where it is restored to
+	IsDir    bool
 }
 
 // ConfigPaths returns all config paths that Gentleman.Dots will modify
@@ -363,49 +412,82 @@ func DetectExistingConfigs() []string {
 
 // GetBackupDir returns the backup directory path with timestamp
 func GetBackupDir() string {
-	home := os.Getenv("HOME")
+	root := paths.BackupRoot(os.Getenv("HOME"))
 	timestamp := time.Now().Format("2006-01-02-150405")
-	return home + "/.gentleman-backup-" + timestamp
+	return filepath.Join(root, ".gentleman-backup-"+timestamp)
 }
 
 // ListBackups returns all existing backups
 func ListBackups() []BackupInfo {
-	home := os.Getenv("HOME")
+	root := paths.BackupRoot(os.Getenv("HOME"))
 	backups := []BackupInfo{}
+	configPaths := ConfigPaths()
 
-	entries, err := os.ReadDir(home)
+	entries, err := os.ReadDir(root)
 	if err != nil {
 		return backups
 	}
 
 	for _, entry := range entries {
-		if entry.IsDir() && strings.HasPrefix(entry.Name(), ".gentleman-backup-") {
-			backupPath := home + "/" + entry.Name()
-			info, err := entry.Info()
-			if err != nil {
-				continue
-			}
+		if !strings.HasPrefix(entry.Name(), ".gentleman-backup-") {
+			continue
+		}
+		compressed := entry.Type().IsRegular() && IsBackupArchive(entry.Name())
+		if !entry.IsDir() && !compressed {
+			continue
+		}
+		backupPath := filepath.Join(root, entry.Name())
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
 
-			// List files in backup
-			files := []string{}
+		// List files in backup, by name with whether each is a directory
+		top := make(map[string]bool)
+		if compressed {
+			if top, err = archiveTopLevel(backupPath); err != nil {
+				continue // Unreadable archive
+			}
+		} else {
 			subEntries, _ := os.ReadDir(backupPath)
 			for _, sub := range subEntries {
-				files = append(files, sub.Name())
+				top[sub.Name()] = sub.IsDir()
+			}
+		}
+		files := []string{}
+		var items []BackupItem
+		for _, name := range slices.Sorted(maps.Keys(top)) {
+			if name == BackupManifestFile {
+				continue
+			}
+			files = append(files, name)
+			if livePath, ok := configPaths[name]; ok {
+				items = append(items, BackupItem{Key: name, LivePath: livePath, IsDir: top[name]})
 			}
-
-			backups = append(backups, BackupInfo{
-				Path:      backupPath,
-				Timestamp: info.ModTime(),
-				Files:     files,
-			})
 		}
+
+		backups = append(backups, BackupInfo{
+			Path:       backupPath,
+			Timestamp:  info.ModTime(),
+			Files:      files,
+			Items:      items,
+			Size:       -1,
+			Compressed: compressed,
+		})
 	}
 
 	return backups
 }
 
-// CreateBackup creates a backup of existing configs
+// CreateBackup creates a backup of existing configs using the default exclusions
+// (caches skipped, shell history kept)
 func CreateBackup(configs []string) (string, error) {
+	return CreateBackupWithOptions(configs, BackupOptions{})
+}
+
+// CreateBackupWithOptions creates a backup of existing configs, applying the
+// per-config exclusion rules selected by opts
+func CreateBackupWithOptions(configs []string, opts BackupOptions) (string, error) {
 	backupDir := GetBackupDir()
 	if err := EnsureDir(backupDir); err != nil {
 		return "", fmt.Errorf("failed to create backup directory: %w", err)
@@ -435,8 +517,8 @@ func CreateBackup(configs []string) (string, error) {
 		dstPath := backupDir + "/" + key
 
 		if info.IsDir() {
-			// Copy directory
-			if err := CopyDir(srcPath, dstPath); err != nil {
+			// Copy directory, skipping excluded caches/history
+			if err := copyDirExcluding(key, srcPath, dstPath, opts); err != nil {
 				return backupDir, fmt.Errorf("failed to backup %s: %w", key, err)
 			}
 		} else {
@@ -447,11 +529,21 @@ func CreateBackup(configs []string) (string, error) {
 		}
 	}
 
+	// Record content hashes so restores can detect later edits
+	if err := WriteBackupManifest(backupDir); err != nil {
+		return backupDir, fmt.Errorf("failed to write backup manifest: %w", err)
+	}
+
 	return backupDir, nil
 }
 
-// RestoreBackup restores configs from a backup directory
-func RestoreBackup(backupDir string) error {
+// RestoreBackup restores configs from a backup directory or archive
+func RestoreBackup(backupPath string) error {
+	backupDir, cleanup, err := OpenBackup(backupPath)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
 	configPaths := ConfigPaths()
 
 	entries, err := os.ReadDir(backupDir)
@@ -467,30 +559,82 @@ func RestoreBackup(backupDir string) error {
 		}
 
 		srcPath := backupDir + "/" + key
+		if _, err := os.Stat(srcPath); err != nil {
+			continue
+		}
 
-		// Remove current config
-		os.RemoveAll(dstPath)
+		// Swap the backup in atomically; the current config survives a failure
+		if err := DeployConfigs(ConfigDeploy{Src: srcPath, Dst: dstPath, Replace: true}); err != nil {
+			return fmt.Errorf("failed to restore %s: %w", key, err)
+		}
+	}
 
-		srcInfo, err := os.Stat(srcPath)
-		if err != nil {
+	return nil
+}
+
+// RestoreBackupItems restores only the configs named by items (ConfigPaths
+// keys) from a backup. A current config that differs from the backup copy is
+// first copied beside it with PreRestoreSuffix. Nothing is written when an
+// item isn't in the backup.
+func RestoreBackupItems(backupPath string, items []string) error {
+	backupDir, cleanup, err := OpenBackup(backupPath)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	configPaths := ConfigPaths()
+
+	var missing []string
+	var deploys []ConfigDeploy
+	for _, key := range items {
+		dstPath, known := configPaths[key]
+		srcPath := filepath.Join(backupDir, key)
+		if _, err := os.Stat(srcPath); !known || err != nil {
+			missing = append(missing, key)
 			continue
 		}
+		deploys = append(deploys, ConfigDeploy{Src: srcPath, Dst: dstPath, Replace: true})
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("not in backup %s: %s", filepath.Base(backupPath), strings.Join(missing, ", "))
+	}
 
-		if srcInfo.IsDir() {
-			if err := CopyDir(srcPath, dstPath); err != nil {
-				return fmt.Errorf("failed to restore %s: %w", key, err)
-			}
-		} else {
-			if err := CopyFile(srcPath, dstPath); err != nil {
-				return fmt.Errorf("failed to restore %s: %w", key, err)
-			}
+	for _, d := range deploys {
+		key := filepath.Base(d.Src)
+		if err := keepPreRestore(key, d.Src, d.Dst); err != nil {
+			return fmt.Errorf("failed to save current %s: %w", key, err)
 		}
 	}
 
+	// Swap the backup in atomically; the current configs survive a failure
+	if err := DeployConfigs(deploys...); err != nil {
+		return fmt.Errorf("failed to restore: %w", err)
+	}
 	return nil
 }
 
-// DeleteBackup removes a backup directory
+// keepPreRestore copies the config at dst to dst+PreRestoreSuffix, replacing
+// an older copy, unless it's missing or already matches the backup at src
+func keepPreRestore(key, src, dst string) error {
+	info, err := os.Stat(dst)
+	if err != nil {
+		return nil
+	}
+	if maps.Equal(hashTree(key, src), hashTree(key, dst)) {
+		return nil
+	}
+
+	saved := dst + PreRestoreSuffix
+	if err := os.RemoveAll(saved); err != nil {
+		return err
+	}
+	if info.IsDir() {
+		return CopyDir(dst, saved)
+	}
+	return CopyFile(dst, saved)
+}
+
+// DeleteBackup removes a backup directory or archive
 func DeleteBackup(backupDir string) error {
 	return os.RemoveAll(backupDir)
 }
@@ -499,8 +643,9 @@ func DeleteBackup(backupDir string) error {
 type LogCallback func(line string)
 
 // RunWithLogs executes a command and streams output to a callback function
-// This allows the TUI to display real-time installation progress
-func RunWithLogs(command string, opts *ExecOptions, onLog LogCallback) *ExecResult {
+// This allows the TUI to display real-time installation progress.
+// Cancelling ctx kills the command.
+func RunWithLogs(ctx context.Context, command string, opts *ExecOptions, onLog LogCallback) *ExecResult {
 	if opts == nil {
 		opts = &ExecOptions{}
 	}
@@ -510,16 +655,35 @@ func RunWithLogs(command string, opts *ExecOptions, onLog LogCallback) *ExecResu
 		Command: command,
 	}
 
-	ctx := context.Background()
 	if opts.Timeout > 0 {
 		var cancel context.CancelFunc
 		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
 		defer cancel()
 	}
 
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	activity := make(chan struct{}, 1)
+	var idled atomic.Bool
+	if opts.IdleTimeout > 0 {
+		go watchIdle(ctx, opts.IdleTimeout, activity, func() {
+			idled.Store(true)
+			cancel()
+		})
+	}
+	seen := func(line string) {
+		select {
+		case activity <- struct{}{}:
+		default:
+		}
+		if onLog != nil {
+			onLog(line)
+		}
+	}
+
 	// In Termux, execute commands directly without shell wrapper
 	var cmd *exec.Cmd
-	if isTermux() {
+	if runsDirect() {
 		executable, args := parseCommand(command)
 		cmd = exec.CommandContext(ctx, executable, args...)
 	} else {
@@ -561,12 +725,13 @@ func RunWithLogs(command string, opts *ExecOptions, onLog LogCallback) *ExecResu
 	done := make(chan struct{})
 	go func() {
 		scanner := bufio.NewScanner(stdoutPipe)
+		if opts.SplitCR {
+			scanner.Split(scanLinesCR)
+		}
 		for scanner.Scan() {
 			line := scanner.Text()
 			stdout.WriteString(line + "\n")
-			if onLog != nil {
-				onLog(line)
-			}
+			seen(line)
 		}
 		done <- struct{}{}
 	}()
@@ -574,12 +739,13 @@ func RunWithLogs(command string, opts *ExecOptions, onLog LogCallback) *ExecResu
 	// Stream stderr with callback
 	go func() {
 		scanner := bufio.NewScanner(stderrPipe)
+		if opts.SplitCR {
+			scanner.Split(scanLinesCR)
+		}
 		for scanner.Scan() {
 			line := scanner.Text()
 			stderr.WriteString(line + "\n")
-			if onLog != nil {
-				onLog(line)
-			}
+			seen(line)
 		}
 		done <- struct{}{}
 	}()
@@ -595,6 +761,9 @@ func RunWithLogs(command string, opts *ExecOptions, onLog LogCallback) *ExecResu
 			exitCode = cmd.ProcessState.ExitCode()
 		}
 		result.ExitCode = exitCode
+		if idled.Load() {
+			err = fmt.Errorf("%w for %s", ErrIdleTimeout, opts.IdleTimeout)
+		}
 		result.Error = &ExecError{
 			Command:  command,
 			ExitCode: exitCode,
@@ -615,15 +784,54 @@ func RunWithLogs(command string, opts *ExecOptions, onLog LogCallback) *ExecResu
 	return result
 }
 
+// watchIdle calls expire once timeout passes without a send on activity,
+// and stops when ctx is done
+func watchIdle(ctx context.Context, timeout time.Duration, activity <-chan struct{}, expire func()) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-activity:
+			timer.Reset(timeout)
+		case <-timer.C:
+			expire()
+			return
+		}
+	}
+}
+
+// scanLinesCR is bufio.ScanLines that also ends a line at a lone \r
+func scanLinesCR(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if atEOF && len(data) == 0 {
+		return 0, nil, nil
+	}
+	if i := bytes.IndexAny(data, "\r\n"); i >= 0 {
+		if data[i] == '\r' && i+1 < len(data) && data[i+1] == '\n' {
+			return i + 2, data[:i], nil
+		}
+		if data[i] == '\r' && i+1 == len(data) && !atEOF {
+			// Wait for the next byte: it may be the \n of a \r\n
+			return 0, nil, nil
+		}
+		return i + 1, data[:i], nil
+	}
+	if atEOF {
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
+
 // RunBrewWithLogs runs a brew command with log streaming
-func RunBrewWithLogs(args string, opts *ExecOptions, onLog LogCallback) *ExecResult {
+func RunBrewWithLogs(ctx context.Context, args string, opts *ExecOptions, onLog LogCallback) *ExecResult {
 	brewPath := GetBrewPrefix() + "/bin/brew"
-	return RunWithLogs(brewPath+" "+args, opts, onLog)
+	return RunWithLogs(ctx, brewPath+" "+args, opts, onLog)
 }
 
 // RunSudoWithLogs runs a sudo command with log streaming
-func RunSudoWithLogs(command string, opts *ExecOptions, onLog LogCallback) *ExecResult {
-	return RunWithLogs("sudo "+command, opts, onLog)
+func RunSudoWithLogs(ctx context.Context, command string, opts *ExecOptions, onLog LogCallback) *ExecResult {
+	return RunWithLogs(ctx, "sudo "+command, opts, onLog)
 }
 
 // PatchZshForWM modifies .zshrc based on window manager choice
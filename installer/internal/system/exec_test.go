@@ -1,6 +1,7 @@
 package system
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"strings"
@@ -10,7 +11,7 @@ import (
 
 func TestRun(t *testing.T) {
 	t.Run("should execute simple command", func(t *testing.T) {
-		result := Run("echo hello", nil)
+		result := Run(context.Background(), "echo hello", nil)
 		if result.Error != nil {
 			t.Errorf("Unexpected error: %v", result.Error)
 		}
@@ -20,7 +21,7 @@ func TestRun(t *testing.T) {
 	})
 
 	t.Run("should capture exit code on failure", func(t *testing.T) {
-		result := Run("exit 1", nil)
+		result := Run(context.Background(), "exit 1", nil)
 		if result.Error == nil {
 			t.Error("Expected error for exit 1")
 		}
@@ -31,7 +32,7 @@ func TestRun(t *testing.T) {
 
 	t.Run("should respect timeout", func(t *testing.T) {
 		start := time.Now()
-		result := Run("sleep 10", &ExecOptions{Timeout: 100 * time.Millisecond})
+		result := Run(context.Background(), "sleep 10", &ExecOptions{Timeout: 100 * time.Millisecond})
 		elapsed := time.Since(start)
 
 		if elapsed > 2*time.Second {
@@ -43,7 +44,7 @@ func TestRun(t *testing.T) {
 	})
 
 	t.Run("should use working directory", func(t *testing.T) {
-		result := Run("pwd", &ExecOptions{WorkDir: "/tmp"})
+		result := Run(context.Background(), "pwd", &ExecOptions{WorkDir: "/tmp"})
 		if result.Error != nil {
 			t.Errorf("Unexpected error: %v", result.Error)
 		}
@@ -54,7 +55,7 @@ func TestRun(t *testing.T) {
 	})
 
 	t.Run("should track duration", func(t *testing.T) {
-		result := Run("sleep 0.1", nil)
+		result := Run(context.Background(), "sleep 0.1", nil)
 		if result.Duration < 50*time.Millisecond {
 			t.Errorf("Duration seems too short: %v", result.Duration)
 		}
@@ -62,7 +63,7 @@ func TestRun(t *testing.T) {
 
 	t.Run("should handle environment variables", func(t *testing.T) {
 		// Use sh -c to ensure variable expansion works across all shells
-		result := Run(`sh -c 'echo "$TEST_VAR"'`, &ExecOptions{
+		result := Run(context.Background(), `sh -c 'echo "$TEST_VAR"'`, &ExecOptions{
 			Env: []string{"TEST_VAR=gentleman"},
 		})
 		if result.Error != nil {
@@ -123,6 +124,23 @@ func TestEnsureDir(t *testing.T) {
 	})
 }
 
+func TestIsSymlinkPrivilegeError(t *testing.T) {
+	refused := &os.LinkError{Op: "symlink", Old: "a", New: "b", Err: errPrivilegeNotHeld}
+	if !isSymlinkPrivilegeError("windows", refused) {
+		t.Error("ERROR_PRIVILEGE_NOT_HELD should be a privilege error on Windows")
+	}
+	if isSymlinkPrivilegeError("linux", refused) {
+		t.Error("only Windows refuses symlinks for privileges")
+	}
+	denied := &os.LinkError{Op: "symlink", Old: "a", New: "b", Err: os.ErrPermission}
+	if isSymlinkPrivilegeError("windows", denied) || isSymlinkPrivilegeError("linux", denied) {
+		t.Error("a plain permission error should not fall back to copying")
+	}
+	if isSymlinkPrivilegeError("windows", nil) || isSymlinkPrivilegeError("windows", &os.LinkError{Op: "symlink", Old: "a", New: "b", Err: os.ErrExist}) {
+		t.Error("other errors should not be privilege errors")
+	}
+}
+
 func TestCopyFile(t *testing.T) {
 	t.Run("should copy file contents", func(t *testing.T) {
 		// Create source file
@@ -577,7 +595,7 @@ func TestRunPkg(t *testing.T) {
 		// We can't really test pkg on non-Termux systems,
 		// but we can verify the command construction by checking
 		// that it at least attempts to run "pkg" with the args
-		result := RunPkg("--help", nil)
+		result := RunPkg(context.Background(), "--help", nil)
 
 		// On non-Termux systems, this will fail with "command not found"
 		// which is expected behavior - we just verify it tried
@@ -591,7 +609,7 @@ func TestRunPkg(t *testing.T) {
 			WorkDir: "/tmp",
 			Timeout: 100 * time.Millisecond,
 		}
-		result := RunPkg("--version", opts)
+		result := RunPkg(context.Background(), "--version", opts)
 
 		// Just verify it ran with options
 		if result.Command != "pkg --version" {
@@ -608,7 +626,7 @@ func TestRunPkgWithLogs(t *testing.T) {
 		}
 
 		// Use echo to simulate pkg output
-		result := RunWithLogs("echo 'test output'", nil, logFunc)
+		result := RunWithLogs(context.Background(), "echo 'test output'", nil, logFunc)
 
 		if result.Error != nil {
 			t.Errorf("Unexpected error: %v", result.Error)
@@ -632,7 +650,7 @@ func TestRunPkgWithLogs(t *testing.T) {
 	})
 
 	t.Run("should construct correct pkg command", func(t *testing.T) {
-		result := RunPkgWithLogs("--help", nil, nil)
+		result := RunPkgWithLogs(context.Background(), "--help", nil, nil)
 
 		if result.Command != "pkg --help" {
 			t.Errorf("Expected command 'pkg --help', got '%s'", result.Command)
@@ -642,7 +660,7 @@ func TestRunPkgWithLogs(t *testing.T) {
 
 func TestRunPkgInstall(t *testing.T) {
 	t.Run("should construct correct install command", func(t *testing.T) {
-		result := RunPkgInstall("vim git", nil, nil)
+		result := RunPkgInstall(context.Background(), "vim git", nil, nil)
 
 		// Verify command includes -y flag for non-interactive
 		expectedCmd := "pkg install -y vim git"
@@ -652,7 +670,7 @@ func TestRunPkgInstall(t *testing.T) {
 	})
 
 	t.Run("should include -y flag for non-interactive installs", func(t *testing.T) {
-		result := RunPkgInstall("neovim", nil, nil)
+		result := RunPkgInstall(context.Background(), "neovim", nil, nil)
 
 		if !strings.Contains(result.Command, "-y") {
 			t.Errorf("Command should include -y flag, got: %s", result.Command)
@@ -666,7 +684,7 @@ func TestRunPkgInstall(t *testing.T) {
 		}
 
 		// This will fail on non-Termux but we're just testing the callback wiring
-		RunPkgInstall("somepackage", nil, logFunc)
+		RunPkgInstall(context.Background(), "somepackage", nil, logFunc)
 
 		// Note: on non-Termux systems, pkg won't exist so there might be no output
 		// The important thing is the function doesn't panic
@@ -681,7 +699,7 @@ func TestRunWithLogs(t *testing.T) {
 			logs = append(logs, line)
 		}
 
-		result := RunWithLogs("echo stdout && echo stderr >&2", nil, logFunc)
+		result := RunWithLogs(context.Background(), "echo stdout && echo stderr >&2", nil, logFunc)
 
 		if result.Error != nil {
 			t.Errorf("Unexpected error: %v", result.Error)
@@ -701,7 +719,7 @@ func TestRunWithLogs(t *testing.T) {
 
 	t.Run("should handle nil callback gracefully", func(t *testing.T) {
 		// Should not panic with nil callback
-		result := RunWithLogs("echo test", nil, nil)
+		result := RunWithLogs(context.Background(), "echo test", nil, nil)
 
 		if result.Error != nil {
 			t.Errorf("Unexpected error: %v", result.Error)
@@ -714,7 +732,7 @@ func TestRunWithLogs(t *testing.T) {
 
 	t.Run("should respect timeout", func(t *testing.T) {
 		start := time.Now()
-		result := RunWithLogs("sleep 10", &ExecOptions{Timeout: 100 * time.Millisecond}, nil)
+		result := RunWithLogs(context.Background(), "sleep 10", &ExecOptions{Timeout: 100 * time.Millisecond}, nil)
 		elapsed := time.Since(start)
 
 		if elapsed > 2*time.Second {
@@ -725,9 +743,25 @@ func TestRunWithLogs(t *testing.T) {
 		}
 	})
 
+	t.Run("should stop when the command context is cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(100*time.Millisecond, cancel)
+		start := time.Now()
+		result := RunWithLogs(ctx, "sleep 10", nil, nil)
+		if elapsed := time.Since(start); elapsed > 2*time.Second {
+			t.Errorf("Command should have been killed quickly, took %v", elapsed)
+		}
+		if result.Error == nil {
+			t.Error("Expected an error for the killed command")
+		}
+		if result := Run(ctx, "echo later", nil); result.Error == nil {
+			t.Error("Commands started after the cancel should not run")
+		}
+	})
+
 	t.Run("should capture exit code on failure", func(t *testing.T) {
 		// Use sh -c to ensure exit works correctly across all shells
-		result := RunWithLogs("sh -c 'exit 42'", nil, nil)
+		result := RunWithLogs(context.Background(), "sh -c 'exit 42'", nil, nil)
 
 		if result.Error == nil {
 			t.Error("Expected error for non-zero exit")
@@ -0,0 +1,251 @@
+package system
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MirrorsEnv lists git hosts to fall back to when a clone from the primary
+// URL fails, e.g. GENTLEMAN_MIRRORS="https://codeberg.org,https://gitlab.com"
+const MirrorsEnv = "GENTLEMAN_MIRRORS"
+
+// EnvMirrors returns the mirrors listed in GENTLEMAN_MIRRORS, separated by
+// commas or whitespace
+func EnvMirrors() []string {
+	return strings.FieldsFunc(os.Getenv(MirrorsEnv), func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t' || r == '\n'
+	})
+}
+
+// MirrorURLs returns repoURL followed by the same repository on every mirror.
+// A mirror replaces the scheme and host of repoURL and prefixes its own path,
+// so with https://codeberg.org/backup the repository
+// https://github.com/owner/repo.git is also tried at
+// https://codeberg.org/backup/owner/repo.git. Mirrors that don't parse, and
+// URLs already listed, are skipped.
+func MirrorURLs(repoURL string, mirrors []string) []string {
+	urls := []string{repoURL}
+	repo, err := url.Parse(repoURL)
+	if err != nil || repo.Host == "" {
+		return urls
+	}
+	for _, m := range mirrors {
+		mirror, err := url.Parse(strings.TrimSpace(m))
+		if err != nil || mirror.Scheme == "" || mirror.Host == "" {
+			continue
+		}
+		alt := *repo
+		alt.Scheme = mirror.Scheme
+		alt.Host = mirror.Host
+		alt.User = mirror.User
+		alt.Path = strings.TrimSuffix(mirror.Path, "/") + repo.Path
+		if s := alt.String(); !slices.Contains(urls, s) {
+			urls = append(urls, s)
+		}
+	}
+	return urls
+}
+
+// CloneTimeoutEnv overrides how long a clone may go without any output before
+// it counts as stalled, as a Go duration like "90s" or "10m"
+const CloneTimeoutEnv = "GENTLEMAN_CLONE_TIMEOUT"
+
+// DefaultCloneTimeout is the stall timeout when GENTLEMAN_CLONE_TIMEOUT is unset
+const DefaultCloneTimeout = 5 * time.Minute
+
+// EnvCloneTimeout returns the stall timeout from GENTLEMAN_CLONE_TIMEOUT, or
+// DefaultCloneTimeout when it is unset or not a positive duration
+func EnvCloneTimeout() time.Duration {
+	if d, err := time.ParseDuration(os.Getenv(CloneTimeoutEnv)); err == nil && d > 0 {
+		return d
+	}
+	return DefaultCloneTimeout
+}
+
+// CloneOptions tunes GitClone
+type CloneOptions struct {
+	Full bool        // Clone the whole history instead of --depth 1
+	Log  LogCallback // Receives git's output and which URL is being tried
+	// Progress receives how far the clone is, from 0 to 1
+	Progress func(fraction float64)
+	// Timeout fails an attempt that shows no progress for this long;
+	// EnvCloneTimeout() when zero
+	Timeout time.Duration
+	// Ref pins the checkout to a branch, tag or commit (see CheckoutRef).
+	// A pinned clone fetches the whole history so any commit resolves.
+	Ref string
+}
+
+// gitProgressRe matches a progress meter line of git clone --progress, such as
+// "Receiving objects:  45% (450/1000), 1.20 MiB | 600.00 KiB/s"
+var gitProgressRe = regexp.MustCompile(`^(?:remote: )?([A-Za-z ]+):\s+(\d{1,3})% \(\d+/\d+\)`)
+
+// ParseGitProgress reads the phase and percentage of a git progress line
+func ParseGitProgress(line string) (phase string, percent int, ok bool) {
+	match := gitProgressRe.FindStringSubmatch(strings.TrimSpace(line))
+	if match == nil {
+		return "", 0, false
+	}
+	percent, _ = strconv.Atoi(match[2])
+	return match[1], percent, true
+}
+
+// GitProgressFraction maps a progress phase to the share of the whole clone: objects
+// arrive in the first 90% and deltas are resolved in the rest. The server-side
+// phases say nothing about the download and return false.
+func GitProgressFraction(phase string, percent int) (float64, bool) {
+	p := float64(min(percent, 100)) / 100
+	switch phase {
+	case "Receiving objects":
+		return 0.9 * p, true
+	case "Resolving deltas":
+		return 0.9 + 0.1*p, true
+	}
+	return 0, false
+}
+
+// CloneAttempt is one URL GitClone tried and why it failed
+type CloneAttempt struct {
+	URL string
+	Err error
+}
+
+// CloneError reports every URL GitClone tried, in order
+type CloneError struct {
+	Dest     string
+	Attempts []CloneAttempt
+}
+
+func (e *CloneError) Error() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("could not clone into %s from any of %d URLs:", e.Dest, len(e.Attempts)))
+	for _, a := range e.Attempts {
+		sb.WriteString(fmt.Sprintf("\n  %s: %s", a.URL, cloneFailure(a.Err)))
+	}
+	return sb.String()
+}
+
+// cloneFailure is the last line git wrote to stderr, which says why the clone
+// failed, or the error itself
+func cloneFailure(err error) string {
+	if errors.Is(err, ErrIdleTimeout) {
+		return "stalled: " + errors.Unwrap(err).Error()
+	}
+	if execErr, ok := err.(*ExecError); ok {
+		lines := strings.Split(strings.TrimSpace(execErr.Stderr), "\n")
+		if last := strings.TrimSpace(lines[len(lines)-1]); last != "" {
+			return last
+		}
+		return fmt.Sprintf("exit code %d", execErr.ExitCode)
+	}
+	return err.Error()
+}
+
+// GitClone clones the first of urls that works into dest and returns it.
+// Leftovers of a failed attempt are removed before the next URL is tried;
+// when every URL fails the error is a *CloneError listing them all.
+func GitClone(ctx context.Context, urls []string, dest string, opts CloneOptions) (string, error) {
+	flags := "--progress --depth 1"
+	if opts.Full || opts.Ref != "" {
+		flags = "--progress"
+	}
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = EnvCloneTimeout()
+	}
+	logf := func(line string) {
+		if opts.Log != nil {
+			opts.Log(line)
+		}
+	}
+	// Progress meters become Progress calls; only the final line of each
+	// phase reaches the log
+	onLine := func(line string) {
+		phase, percent, ok := ParseGitProgress(line)
+		if !ok {
+			logf(line)
+			return
+		}
+		if fraction, ok := GitProgressFraction(phase, percent); ok && opts.Progress != nil {
+			opts.Progress(fraction)
+		}
+		if strings.Contains(line, ", done") {
+			logf(line)
+		}
+	}
+	execOpts := &ExecOptions{IdleTimeout: timeout, SplitCR: true}
+
+	cloneErr := &CloneError{Dest: dest}
+	for i, u := range urls {
+		if i > 0 {
+			logf("Trying mirror " + u + "...")
+		}
+		os.RemoveAll(dest)
+		if opts.Progress != nil {
+			opts.Progress(0)
+		}
+		result := RunWithLogs(ctx, "git clone "+flags+" "+u+" "+dest, execOpts, onLine)
+		if result.Error == nil {
+			// Clones without deltas never report past receiving
+			if opts.Progress != nil {
+				opts.Progress(1)
+			}
+			logf("✓ Cloned from " + u)
+			if opts.Ref == "" {
+				return u, nil
+			}
+			sha, err := CheckoutRef(ctx, dest, opts.Ref)
+			if err != nil {
+				// Every mirror has the same refs, so there is nothing to retry
+				os.RemoveAll(dest)
+				return "", err
+			}
+			logf("✓ Pinned to " + opts.Ref + " (" + sha + ")")
+			return u, nil
+		}
+		cloneErr.Attempts = append(cloneErr.Attempts, CloneAttempt{URL: u, Err: result.Error})
+	}
+	os.RemoveAll(dest)
+	return "", cloneErr
+}
+
+// ErrUnknownRef is returned by ResolveRef when the ref names no commit
+var ErrUnknownRef = errors.New("unknown ref")
+
+// ResolveRef returns the commit SHA ref names in the clone at dir. A ref can
+// be a branch of origin, a commit (full or abbreviated), a tag or a local
+// branch. Origin's branch comes first so a fetch moves a pinned branch.
+func ResolveRef(ctx context.Context, dir, ref string) (string, error) {
+	if ref == "" || strings.HasPrefix(ref, "-") {
+		return "", fmt.Errorf("%w %q", ErrUnknownRef, ref)
+	}
+	for _, candidate := range []string{"origin/" + ref, ref} {
+		out, err := exec.CommandContext(ctx, "git", "-C", dir, "rev-parse", "--verify", "--quiet", candidate+"^{commit}").Output()
+		if err == nil {
+			return strings.TrimSpace(string(out)), nil
+		}
+	}
+	return "", fmt.Errorf("%w %q in %s", ErrUnknownRef, ref, dir)
+}
+
+// CheckoutRef resolves ref in the clone at dir and checks that commit out,
+// leaving HEAD detached. It returns the resolved SHA.
+func CheckoutRef(ctx context.Context, dir, ref string) (string, error) {
+	sha, err := ResolveRef(ctx, dir, ref)
+	if err != nil {
+		return "", err
+	}
+	if out, err := exec.CommandContext(ctx, "git", "-C", dir, "checkout", "--quiet", "--detach", sha).CombinedOutput(); err != nil {
+		return "", fmt.Errorf("git checkout %s failed: %s", ref, strings.TrimSpace(string(out)))
+	}
+	return sha, nil
+}
@@ -0,0 +1,285 @@
+package system
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMirrorURLs(t *testing.T) {
+	const repo = "https://github.com/owner/repo.git"
+	tests := []struct {
+		name    string
+		repo    string
+		mirrors []string
+		want    []string
+	}{
+		{"no mirrors", repo, nil, []string{repo}},
+		{"host swap", repo, []string{"https://codeberg.org"}, []string{repo, "https://codeberg.org/owner/repo.git"}},
+		{"path prefix", repo, []string{"https://gitlab.com/backup/"}, []string{repo, "https://gitlab.com/backup/owner/repo.git"}},
+		{"scheme and user", repo, []string{"ssh://git@mirror.lan"}, []string{repo, "ssh://git@mirror.lan/owner/repo.git"}},
+		{"order kept, duplicates and junk dropped", repo,
+			[]string{"https://gitlab.com", "codeberg.org", "https://github.com", " https://gitlab.com "},
+			[]string{repo, "https://gitlab.com/owner/repo.git"}},
+		{"local repo has no mirrors", "/tmp/repo", []string{"https://codeberg.org"}, []string{"/tmp/repo"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MirrorURLs(tt.repo, tt.mirrors); !slices.Equal(got, tt.want) {
+				t.Errorf("MirrorURLs = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnvMirrors(t *testing.T) {
+	t.Setenv(MirrorsEnv, "https://codeberg.org, https://gitlab.com\thttps://git.lan")
+	want := []string{"https://codeberg.org", "https://gitlab.com", "https://git.lan"}
+	if got := EnvMirrors(); !slices.Equal(got, want) {
+		t.Errorf("EnvMirrors = %v, want %v", got, want)
+	}
+}
+
+// gitRepo creates a repository with one commit and returns its file:// URL
+func gitRepo(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "README.md"), []byte("hi\n"), 0644)
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"add", "."},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-q", "-m", "init"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	return "file://" + dir
+}
+
+func TestGitCloneFallsBackToMirror(t *testing.T) {
+	good := gitRepo(t)
+	bad := "file://" + filepath.Join(t.TempDir(), "missing")
+	dest := filepath.Join(t.TempDir(), "clone")
+
+	var logs []string
+	got, err := GitClone(context.Background(), []string{bad, good}, dest, CloneOptions{Log: func(l string) { logs = append(logs, l) }})
+	if err != nil {
+		t.Fatalf("GitClone: %v", err)
+	}
+	if got != good {
+		t.Errorf("cloned from %s, want %s", got, good)
+	}
+	if _, err := os.Stat(filepath.Join(dest, "README.md")); err != nil {
+		t.Errorf("the clone should be checked out: %v", err)
+	}
+	joined := strings.Join(logs, "\n")
+	for _, want := range []string{"Trying mirror " + good, "✓ Cloned from " + good} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("log is missing %q:\n%s", want, joined)
+		}
+	}
+}
+
+func TestGitCloneReportsEveryAttempt(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	tmp := t.TempDir()
+	urls := []string{"file://" + filepath.Join(tmp, "a"), "file://" + filepath.Join(tmp, "b")}
+	dest := filepath.Join(tmp, "clone")
+
+	_, err := GitClone(context.Background(), urls, dest, CloneOptions{})
+	cloneErr, ok := err.(*CloneError)
+	if !ok {
+		t.Fatalf("expected a *CloneError, got %v", err)
+	}
+	if len(cloneErr.Attempts) != 2 {
+		t.Errorf("expected both attempts, got %+v", cloneErr.Attempts)
+	}
+	msg := err.Error()
+	for _, u := range urls {
+		if !strings.Contains(msg, u+": ") {
+			t.Errorf("error should list %s:\n%s", u, msg)
+		}
+	}
+	if _, err := os.Stat(dest); !os.IsNotExist(err) {
+		t.Error("a failed clone should leave nothing behind")
+	}
+}
+
+// gitCloneStderr is the stderr of a real git clone --progress from GitHub,
+// where the meters redraw with \r and pad with spaces
+const gitCloneStderr = "Cloning into 'Gentleman-Skills'...\n" +
+	"remote: Enumerating objects: 412, done.        \n" +
+	"remote: Counting objects:   1% (1/98)        \rremote: Counting objects: 100% (98/98), done.        \n" +
+	"remote: Compressing objects: 100% (71/71), done.        \n" +
+	"Receiving objects:   0% (1/412)\rReceiving objects:  45% (186/412), 1.20 MiB | 600.00 KiB/s\r" +
+	"Receiving objects: 100% (412/412), 2.31 MiB | 1.10 MiB/s, done.\n" +
+	"remote: Total 412 (delta 120), reused 300 (delta 80), pack-reused 0 (from 0)        \n" +
+	"Resolving deltas:   0% (0/120)\rResolving deltas:  50% (60/120)\rResolving deltas: 100% (120/120), done.\n"
+
+func TestParseGitProgress(t *testing.T) {
+	type meter struct {
+		phase   string
+		percent int
+	}
+	var got []meter
+	var other []string
+	scanner := bufio.NewScanner(strings.NewReader(gitCloneStderr))
+	scanner.Split(scanLinesCR)
+	for scanner.Scan() {
+		if phase, percent, ok := ParseGitProgress(scanner.Text()); ok {
+			got = append(got, meter{phase, percent})
+		} else {
+			other = append(other, strings.TrimSpace(scanner.Text()))
+		}
+	}
+
+	want := []meter{
+		{"Counting objects", 1}, {"Counting objects", 100}, {"Compressing objects", 100},
+		{"Receiving objects", 0}, {"Receiving objects", 45}, {"Receiving objects", 100},
+		{"Resolving deltas", 0}, {"Resolving deltas", 50}, {"Resolving deltas", 100},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("meters = %v\nwant %v", got, want)
+	}
+	if len(other) != 3 {
+		t.Errorf("only the clone, enumerate and total lines should not parse, got %q", other)
+	}
+}
+
+func TestGitProgressFraction(t *testing.T) {
+	tests := []struct {
+		phase   string
+		percent int
+		want    float64
+		ok      bool
+	}{
+		{"Receiving objects", 0, 0, true},
+		{"Receiving objects", 50, 0.45, true},
+		{"Resolving deltas", 0, 0.9, true},
+		{"Resolving deltas", 100, 1, true},
+		{"Counting objects", 50, 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := GitProgressFraction(tt.phase, tt.percent)
+		if ok != tt.ok || math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("GitProgressFraction(%q, %d) = %v, %v; want %v, %v", tt.phase, tt.percent, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestGitCloneReportsProgress(t *testing.T) {
+	repo := gitRepo(t)
+	var progress []float64
+	var logs []string
+	_, err := GitClone(context.Background(), []string{repo}, filepath.Join(t.TempDir(), "clone"), CloneOptions{
+		Log:      func(l string) { logs = append(logs, l) },
+		Progress: func(p float64) { progress = append(progress, p) },
+	})
+	if err != nil {
+		t.Fatalf("GitClone: %v", err)
+	}
+	if len(progress) == 0 || progress[len(progress)-1] != 1 {
+		t.Errorf("progress should end at 1, got %v", progress)
+	}
+	for _, l := range logs {
+		if _, _, ok := ParseGitProgress(l); ok && !strings.Contains(l, ", done") {
+			t.Errorf("intermediate meter %q should not reach the log", l)
+		}
+	}
+}
+
+func TestRunWithLogsIdleTimeout(t *testing.T) {
+	if _, err := exec.LookPath("sleep"); err != nil {
+		t.Skip("sleep not available")
+	}
+	start := time.Now()
+	result := RunWithLogs(context.Background(), "echo started; sleep 5", &ExecOptions{IdleTimeout: 200 * time.Millisecond}, nil)
+	if !errors.Is(result.Error, ErrIdleTimeout) {
+		t.Fatalf("a silent command should time out, got %v", result.Error)
+	}
+	if time.Since(start) > 4*time.Second {
+		t.Error("the command should be killed once it goes silent")
+	}
+	if got := cloneFailure(result.Error); got != "stalled: no output for 200ms" {
+		t.Errorf("cloneFailure = %q", got)
+	}
+
+	// Output keeps it alive
+	result = RunWithLogs(context.Background(), "for i in 1 2 3 4; do echo $i; sleep 0.1; done", &ExecOptions{IdleTimeout: 300 * time.Millisecond}, nil)
+	if result.Error != nil {
+		t.Errorf("a command that keeps writing should finish, got %v", result.Error)
+	}
+}
+
+// gitRun runs git in dir and returns its trimmed output
+func gitRun(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %v: %v\n%s", args, err, out)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+func TestGitClonePinsRef(t *testing.T) {
+	url := gitRepo(t)
+	src := strings.TrimPrefix(url, "file://")
+	first := gitRun(t, src, "rev-parse", "HEAD")
+	gitRun(t, src, "tag", "v1")
+	gitRun(t, src, "checkout", "-q", "-b", "next")
+	os.WriteFile(filepath.Join(src, "NEXT.md"), []byte("next\n"), 0644)
+	gitRun(t, src, "add", ".")
+	gitRun(t, src, "commit", "-q", "-m", "next")
+	next := gitRun(t, src, "rev-parse", "HEAD")
+	gitRun(t, src, "checkout", "-q", "-")
+
+	tests := []struct {
+		ref, want string
+	}{
+		{"v1", first},
+		{"next", next},
+		{first[:10], first},
+		{next, next},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			dest := filepath.Join(t.TempDir(), "clone")
+			if _, err := GitClone(context.Background(), []string{url}, dest, CloneOptions{Ref: tt.ref}); err != nil {
+				t.Fatalf("GitClone: %v", err)
+			}
+			if got := gitRun(t, dest, "rev-parse", "HEAD"); got != tt.want {
+				t.Errorf("HEAD = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	dest := filepath.Join(t.TempDir(), "clone")
+	_, err := GitClone(context.Background(), []string{url}, dest, CloneOptions{Ref: "no-such-ref"})
+	if !errors.Is(err, ErrUnknownRef) {
+		t.Fatalf("an unknown ref should fail with ErrUnknownRef, got %v", err)
+	}
+	if _, err := os.Stat(dest); !os.IsNotExist(err) {
+		t.Error("a clone that can't be pinned should be removed")
+	}
+	if _, err := ResolveRef(context.Background(), src, "--all"); !errors.Is(err, ErrUnknownRef) {
+		t.Errorf("options must not pass as refs, got %v", err)
+	}
+}
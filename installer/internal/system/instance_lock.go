@@ -0,0 +1,104 @@
+package system
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
+)
+
+// InstanceLockFile lives in the data dir while an installer that may change
+// shared state (configs, skills, install progress) is running
+const InstanceLockFile = "installer.lock"
+
+// InstanceLock is the content of the lock file: who holds it and since when
+type InstanceLock struct {
+	PID     int       `json:"pid"`
+	Started time.Time `json:"started"`
+}
+
+// InstanceLockPath returns where the instance lock is kept for home
+func InstanceLockPath(home string) string {
+	return filepath.Join(paths.DataDir(home), InstanceLockFile)
+}
+
+// pidAlive reports whether a process with the given PID exists. Tests swap it
+// to fake other instances.
+var pidAlive = processAlive
+
+// AcquireInstanceLock takes the lock at path for this process. When another
+// live instance holds it, that instance's lock is returned and nothing is
+// written; a nil lock means this process holds it now. Locks left by a dead
+// process or unreadable ones are reclaimed.
+func AcquireInstanceLock(path string) (*InstanceLock, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	own := InstanceLock{PID: os.Getpid(), Started: time.Now()}
+	data, err := json.Marshal(own)
+	if err != nil {
+		return nil, err
+	}
+
+	// Two tries: the second follows removing a stale lock
+	for attempt := 0; attempt < 2; attempt++ {
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+		if err == nil {
+			_, werr := f.Write(data)
+			if cerr := f.Close(); werr == nil {
+				werr = cerr
+			}
+			if werr != nil {
+				os.Remove(path)
+				return nil, werr
+			}
+			return nil, nil
+		}
+		if !os.IsExist(err) {
+			return nil, err
+		}
+
+		holder, err := ReadInstanceLock(path)
+		if err == nil && holder.PID == own.PID {
+			return nil, nil
+		}
+		if err == nil && pidAlive(holder.PID) {
+			return holder, nil
+		}
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("could not reclaim stale lock: %w", err)
+		}
+	}
+	return nil, fmt.Errorf("could not take the instance lock at %s", path)
+}
+
+// ReadInstanceLock reads the lock at path
+func ReadInstanceLock(path string) (*InstanceLock, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var lock InstanceLock
+	if err := json.Unmarshal(data, &lock); err != nil {
+		return nil, fmt.Errorf("invalid instance lock: %w", err)
+	}
+	return &lock, nil
+}
+
+// ReleaseInstanceLock removes the lock at path if this process holds it
+func ReleaseInstanceLock(path string) error {
+	holder, err := ReadInstanceLock(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	if holder.PID != os.Getpid() {
+		return nil
+	}
+	return os.Remove(path)
+}
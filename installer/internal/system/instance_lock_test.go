@@ -0,0 +1,126 @@
+package system
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// fakeLiveness makes only the given PIDs count as running
+func fakeLiveness(t *testing.T, live ...int) {
+	t.Helper()
+	prev := pidAlive
+	pidAlive = func(pid int) bool {
+		for _, p := range live {
+			if p == pid {
+				return true
+			}
+		}
+		return false
+	}
+	t.Cleanup(func() { pidAlive = prev })
+}
+
+func writeLock(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func lockJSON(t *testing.T, pid int) string {
+	t.Helper()
+	data, err := json.Marshal(InstanceLock{PID: pid, Started: time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestAcquireInstanceLock(t *testing.T) {
+	const other = 424242
+	tests := []struct {
+		name       string
+		existing   string // Lock file content before acquiring; "" for none
+		live       []int
+		wantHolder bool
+	}{
+		{"no lock", "", nil, false},
+		{"held by a live instance", lockJSON(t, other), []int{other}, true},
+		{"stale lock from a dead PID", lockJSON(t, other), nil, false},
+		{"corrupt lock", "{not json", []int{other}, false},
+		{"already ours", lockJSON(t, os.Getpid()), nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeLiveness(t, tt.live...)
+			path := InstanceLockPath(t.TempDir())
+			if tt.existing != "" {
+				writeLock(t, path, tt.existing)
+			}
+
+			holder, err := AcquireInstanceLock(path)
+			if err != nil {
+				t.Fatalf("AcquireInstanceLock() error: %v", err)
+			}
+			if tt.wantHolder {
+				if holder == nil || holder.PID != other {
+					t.Fatalf("expected the lock of PID %d, got %+v", other, holder)
+				}
+				if got, _ := os.ReadFile(path); string(got) != tt.existing {
+					t.Error("a live lock must be left as it is")
+				}
+				return
+			}
+			if holder != nil {
+				t.Fatalf("expected to take the lock, held by %+v", holder)
+			}
+			lock, err := ReadInstanceLock(path)
+			if err != nil || lock.PID != os.Getpid() || lock.Started.IsZero() {
+				t.Errorf("lock file should name this process, got %+v (%v)", lock, err)
+			}
+		})
+	}
+}
+
+func TestReleaseInstanceLock(t *testing.T) {
+	fakeLiveness(t)
+	path := InstanceLockPath(t.TempDir())
+
+	if err := ReleaseInstanceLock(path); err != nil {
+		t.Errorf("releasing a missing lock should be a no-op: %v", err)
+	}
+
+	if _, err := AcquireInstanceLock(path); err != nil {
+		t.Fatal(err)
+	}
+	if err := ReleaseInstanceLock(path); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Error("release should remove our lock")
+	}
+
+	// Another instance's lock is not ours to remove
+	writeLock(t, path, lockJSON(t, 424242))
+	if err := ReleaseInstanceLock(path); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Error("release must keep another instance's lock")
+	}
+}
+
+func TestProcessAlive(t *testing.T) {
+	if !processAlive(os.Getpid()) {
+		t.Error("this process should be alive")
+	}
+	if processAlive(0) || processAlive(-1) {
+		t.Error("non-positive PIDs are never alive")
+	}
+}
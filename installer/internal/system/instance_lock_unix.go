@@ -0,0 +1,18 @@
+//go:build !windows
+
+package system
+
+import (
+	"errors"
+	"syscall"
+)
+
+// processAlive probes pid with signal 0: no signal is sent, but the kernel
+// still checks the process exists. EPERM means it exists under another user.
+func processAlive(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+	err := syscall.Kill(pid, 0)
+	return err == nil || errors.Is(err, syscall.EPERM)
+}
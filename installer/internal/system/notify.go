@@ -0,0 +1,82 @@
+package system
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// notifyTimeout bounds a notification command, so a stuck one can't hold up
+// whatever finished
+const notifyTimeout = 5 * time.Second
+
+// notifier rings the terminal bell and picks the OS notification command.
+// Its fields are injectable so tests can check the choice without notifying.
+type notifier struct {
+	goos   string
+	termux func() bool
+	exists func(string) bool
+	run    func(name string, args ...string) error
+	bell   io.Writer
+}
+
+var defaultNotifier = notifier{
+	goos:   runtime.GOOS,
+	termux: isTermux,
+	exists: CommandExists,
+	run:    runNotifyCommand,
+	bell:   os.Stderr,
+}
+
+// Notify rings the terminal bell and shows title and body as a desktop
+// notification: termux-notification on Termux, osascript on macOS and
+// notify-send on Linux. Without any of them only the bell rings; a failing
+// command is ignored too.
+func Notify(title, body string) {
+	defaultNotifier.notify(title, body)
+}
+
+func (n notifier) notify(title, body string) {
+	if n.bell != nil {
+		fmt.Fprint(n.bell, "\a")
+	}
+	if argv := n.command(title, body); argv != nil {
+		_ = n.run(argv[0], argv[1:]...)
+	}
+}
+
+// command returns the notification command for this system, or nil when it has none
+func (n notifier) command(title, body string) []string {
+	switch {
+	case n.termux():
+		if n.exists("termux-notification") {
+			return []string{"termux-notification", "--title", title, "--content", body}
+		}
+	case n.goos == "darwin":
+		if n.exists("osascript") {
+			script := fmt.Sprintf("display notification %s with title %s", appleScriptString(body), appleScriptString(title))
+			return []string{"osascript", "-e", script}
+		}
+	case n.goos == "linux":
+		if n.exists("notify-send") {
+			return []string{"notify-send", title, body}
+		}
+	}
+	return nil
+}
+
+// appleScriptString quotes s as an AppleScript string literal
+func appleScriptString(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+func runNotifyCommand(name string, args ...string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), notifyTimeout)
+	defer cancel()
+	return exec.CommandContext(ctx, name, args...).Run()
+}
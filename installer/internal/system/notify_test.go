@@ -0,0 +1,76 @@
+package system
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// fakeNotifier records the commands it would run; only the commands in
+// installed exist
+func fakeNotifier(goos string, termux bool, installed ...string) (*notifier, *[][]string, *bytes.Buffer) {
+	var ran [][]string
+	bell := &bytes.Buffer{}
+	n := &notifier{
+		goos:   goos,
+		termux: func() bool { return termux },
+		exists: func(cmd string) bool { return slices.Contains(installed, cmd) },
+		run: func(name string, args ...string) error {
+			ran = append(ran, append([]string{name}, args...))
+			return errors.New("exit status 1")
+		},
+		bell: bell,
+	}
+	return n, &ran, bell
+}
+
+func TestNotifyBackendSelection(t *testing.T) {
+	tests := []struct {
+		name      string
+		goos      string
+		termux    bool
+		installed []string
+		want      []string
+	}{
+		{"macOS", "darwin", false, []string{"osascript"}, []string{"osascript", "-e", `display notification "Took 3m" with title "Done"`}},
+		{"Linux", "linux", false, []string{"notify-send"}, []string{"notify-send", "Done", "Took 3m"}},
+		{"Termux", "android", true, []string{"termux-notification"}, []string{"termux-notification", "--title", "Done", "--content", "Took 3m"}},
+		{"Termux reports linux", "linux", true, []string{"termux-notification", "notify-send"}, []string{"termux-notification", "--title", "Done", "--content", "Took 3m"}},
+		{"Termux without the API package", "android", true, []string{"notify-send"}, nil},
+		{"Linux without notify-send", "linux", false, []string{"osascript"}, nil},
+		{"macOS without osascript", "darwin", false, []string{"notify-send"}, nil},
+		{"Windows", "windows", false, []string{"notify-send", "osascript"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, ran, bell := fakeNotifier(tt.goos, tt.termux, tt.installed...)
+			n.notify("Done", "Took 3m")
+			if bell.String() != "\a" {
+				t.Errorf("the bell should ring whatever the backend, got %q", bell.String())
+			}
+			if tt.want == nil {
+				if len(*ran) != 0 {
+					t.Errorf("no backend should run nothing, ran %v", *ran)
+				}
+				return
+			}
+			if len(*ran) != 1 || !slices.Equal((*ran)[0], tt.want) {
+				t.Errorf("ran %v, want %v", *ran, tt.want)
+			}
+		})
+	}
+}
+
+func TestAppleScriptString(t *testing.T) {
+	got := appleScriptString(`say "hi" \ bye`)
+	if want := `"say \"hi\" \\ bye"`; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	n, ran, _ := fakeNotifier("darwin", false, "osascript")
+	n.notify(`"Quoted" title`, "body")
+	if script := (*ran)[0][2]; !strings.HasSuffix(script, `with title "\"Quoted\" title"`) {
+		t.Errorf("the title should be escaped, got %s", script)
+	}
+}
@@ -0,0 +1,167 @@
+package system
+
+import (
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// MinimumOSVersion is the oldest release of a distro the install is known to work on
+type MinimumOSVersion struct {
+	Name    string // Display name ("Ubuntu")
+	Minimum string
+	Reason  string // What breaks on older releases
+	// Install step IDs most likely to fail below the minimum, in install order
+	LikelyFailures []string
+}
+
+// minimumOSVersions is keyed by SystemInfo.DistroID. Rolling releases (Arch,
+// Termux) have no entry: there is no version to be behind on.
+var minimumOSVersions = map[string]MinimumOSVersion{
+	"macos": {
+		Name:           "macOS",
+		Minimum:        "13",
+		Reason:         "Homebrew no longer ships bottles for older macOS, so formulas build from source or refuse to install",
+		LikelyFailures: []string{"homebrew", "xcode", "terminal", "font", "nvim"},
+	},
+	"ubuntu": {
+		Name:           "Ubuntu",
+		Minimum:        "22.04",
+		Reason:         "the glibc and apt packages of older releases are too old for current Neovim and terminal builds",
+		LikelyFailures: []string{"deps", "homebrew", "terminal", "nvim"},
+	},
+	"debian": {
+		Name:           "Debian",
+		Minimum:        "12",
+		Reason:         "the glibc and apt packages of older releases are too old for current Neovim and terminal builds",
+		LikelyFailures: []string{"deps", "homebrew", "terminal", "nvim"},
+	},
+	"fedora": {
+		Name:           "Fedora",
+		Minimum:        "39",
+		Reason:         "end-of-life releases lose their dnf mirrors and COPR builds",
+		LikelyFailures: []string{"deps", "terminal", "nvim"},
+	},
+}
+
+// OSVersionWarning describes an OS release below the supported minimum
+type OSVersionWarning struct {
+	MinimumOSVersion
+	Version string // The detected version
+}
+
+// ParseVersion splits a dotted version ("11.7.10", "22.04", "39") into its
+// numbers. Trailing text in a part ("8.9-beta") is ignored. It returns false
+// for an empty or non-numeric version, as rolling releases report.
+func ParseVersion(s string) ([]int, bool) {
+	s = strings.Trim(strings.TrimSpace(s), `"'`)
+	if s == "" {
+		return nil, false
+	}
+	var parts []int
+	for _, field := range strings.Split(s, ".") {
+		end := 0
+		for end < len(field) && field[end] >= '0' && field[end] <= '9' {
+			end++
+		}
+		if end == 0 {
+			break
+		}
+		n, err := strconv.Atoi(field[:end])
+		if err != nil {
+			return nil, false
+		}
+		parts = append(parts, n)
+		if end < len(field) {
+			break
+		}
+	}
+	if len(parts) == 0 {
+		return nil, false
+	}
+	return parts, true
+}
+
+// CompareVersions returns -1, 0 or 1 as a is older than, equal to or newer
+// than b. Missing parts count as zero, so "22.04" equals "22.4.0".
+func CompareVersions(a, b []int) int {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		var x, y int
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+	return 0
+}
+
+// CheckOSVersion returns a warning when version is below the minimum for
+// distro, or nil when it is supported, unknown or can't be parsed
+func CheckOSVersion(distro, version string) *OSVersionWarning {
+	min, ok := minimumOSVersions[distro]
+	if !ok {
+		return nil
+	}
+	have, ok := ParseVersion(version)
+	if !ok {
+		return nil
+	}
+	want, _ := ParseVersion(min.Minimum)
+	if CompareVersions(have, want) >= 0 {
+		return nil
+	}
+	return &OSVersionWarning{MinimumOSVersion: min, Version: version}
+}
+
+// VersionWarning checks the detected OS release against the supported minimums
+func (info *SystemInfo) VersionWarning() *OSVersionWarning {
+	if info == nil {
+		return nil
+	}
+	return CheckOSVersion(info.DistroID, info.OSVersion)
+}
+
+// parseOSRelease reads ID and VERSION_ID from /etc/os-release content
+func parseOSRelease(content string) (id, versionID string) {
+	for _, line := range strings.Split(content, "\n") {
+		key, value, ok := strings.Cut(strings.TrimSpace(line), "=")
+		if !ok {
+			continue
+		}
+		value = strings.Trim(value, `"'`)
+		switch key {
+		case "ID":
+			id = strings.ToLower(value)
+		case "VERSION_ID":
+			versionID = value
+		}
+	}
+	return id, versionID
+}
+
+// readOSRelease returns the distro ID and version of the running Linux system
+func readOSRelease() (id, versionID string) {
+	data, err := os.ReadFile("/etc/os-release")
+	if err != nil {
+		return "", ""
+	}
+	return parseOSRelease(string(data))
+}
+
+// macOSVersion returns the product version reported by sw_vers ("14.5")
+func macOSVersion() string {
+	out, err := exec.Command("sw_vers", "-productVersion").Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
@@ -0,0 +1,146 @@
+package system
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		in     string
+		want   []int
+		wantOK bool
+	}{
+		{"11.7.10", []int{11, 7, 10}, true},
+		{"22.04", []int{22, 4}, true},
+		{"39", []int{39}, true},
+		{`"12"`, []int{12}, true},
+		{" 14.5\n", []int{14, 5}, true},
+		{"8.9-beta", []int{8, 9}, true},
+		{"2024.01.01", []int{2024, 1, 1}, true},
+		{"", nil, false},
+		{"rolling", nil, false},
+		{"n/a", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, ok := ParseVersion(tt.in)
+			if ok != tt.wantOK || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseVersion(%q) = %v, %v; want %v, %v", tt.in, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"11.7.10", "13", -1},
+		{"13", "13", 0},
+		{"13.0.1", "13", 1},
+		{"22.04", "22.4.0", 0},
+		{"18.04", "22.04", -1},
+		{"24.04", "22.04", 1},
+		{"22.10", "22.04", 1},
+		{"10.15.7", "9.99", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+" vs "+tt.b, func(t *testing.T) {
+			a, _ := ParseVersion(tt.a)
+			b, _ := ParseVersion(tt.b)
+			if got := CompareVersions(a, b); got != tt.want {
+				t.Errorf("CompareVersions(%s, %s) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckOSVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		distro  string
+		version string
+		warn    bool
+	}{
+		{"old macOS", "macos", "11.7.10", true},
+		{"current macOS", "macos", "14.5", false},
+		{"minimum macOS", "macos", "13.0", false},
+		{"Ubuntu 18.04", "ubuntu", "18.04", true},
+		{"Ubuntu 22.04", "ubuntu", "22.04", false},
+		{"Ubuntu interim release", "ubuntu", "24.10", false},
+		{"old Debian", "debian", "10", true},
+		{"old Fedora", "fedora", "36", true},
+		{"rolling Arch", "arch", "", false},
+		{"rolling distro reporting a word", "arch", "rolling", false},
+		{"known distro without a version", "ubuntu", "", false},
+		{"unknown distro", "gentoo", "2.15", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := CheckOSVersion(tt.distro, tt.version)
+			if (w != nil) != tt.warn {
+				t.Fatalf("CheckOSVersion(%q, %q) = %+v, want warning %v", tt.distro, tt.version, w, tt.warn)
+			}
+			if w != nil && (w.Version != tt.version || len(w.LikelyFailures) == 0) {
+				t.Errorf("warning should carry the version and the likely failures: %+v", w)
+			}
+		})
+	}
+}
+
+func TestMinimumOSVersionsParse(t *testing.T) {
+	for distro, min := range minimumOSVersions {
+		if _, ok := ParseVersion(min.Minimum); !ok {
+			t.Errorf("%s: minimum %q does not parse", distro, min.Minimum)
+		}
+	}
+}
+
+func TestParseOSRelease(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		wantID      string
+		wantVersion string
+	}{
+		{
+			name:        "Ubuntu",
+			content:     "NAME=\"Ubuntu\"\nVERSION_ID=\"18.04\"\nID=ubuntu\nID_LIKE=debian\n",
+			wantID:      "ubuntu",
+			wantVersion: "18.04",
+		},
+		{
+			name:        "Arch has no VERSION_ID",
+			content:     "NAME=\"Arch Linux\"\nID=arch\nBUILD_ID=rolling\n",
+			wantID:      "arch",
+			wantVersion: "",
+		},
+		{
+			name:        "comments and blank lines",
+			content:     "# generated\n\nID='fedora'\nVERSION_ID=39\n",
+			wantID:      "fedora",
+			wantVersion: "39",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, version := parseOSRelease(tt.content)
+			if id != tt.wantID || version != tt.wantVersion {
+				t.Errorf("parseOSRelease() = %q, %q; want %q, %q", id, version, tt.wantID, tt.wantVersion)
+			}
+		})
+	}
+}
+
+func TestSystemInfoVersionWarning(t *testing.T) {
+	var nilInfo *SystemInfo
+	if nilInfo.VersionWarning() != nil {
+		t.Error("nil info should not warn")
+	}
+	info := &SystemInfo{DistroID: "ubuntu", OSVersion: "18.04"}
+	if w := info.VersionWarning(); w == nil || w.Minimum != "22.04" {
+		t.Errorf("Ubuntu 18.04 should warn with minimum 22.04, got %+v", w)
+	}
+}
@@ -0,0 +1,175 @@
+package system
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+	"strings"
+)
+
+// PackageManager installs packages with the distro package manager. Package
+// names are the Debian-style names the install steps use, translated for each
+// manager (see TranslatePackages).
+type PackageManager interface {
+	Name() string
+	// Update refreshes the package index and upgrades what is installed
+	Update(ctx context.Context) *ExecResult
+	Install(ctx context.Context, pkgs ...string) *ExecResult
+	// UpdateCommand and InstallCommand are the shell commands Update and
+	// Install run, sudo included, for scripts. UpdateCommand is "" for
+	// managers with nothing to refresh.
+	UpdateCommand() string
+	InstallCommand(pkgs ...string) string
+}
+
+// ErrNoPackageManager is returned by NewPackageManager for managers the
+// installer doesn't know how to drive
+var ErrNoPackageManager = errors.New("no supported package manager")
+
+// SupportedPackageManagers lists the names NewPackageManager accepts
+var SupportedPackageManagers = []string{"apt", "pacman", "dnf", "apk", "zypper", "xbps", "nix"}
+
+// distroManager drives one package manager through its command line
+type distroManager struct {
+	name    string
+	update  string // Refresh command; "" when there is none
+	install string // Non-interactive install command, packages appended
+	prefix  string // Prepended to every package name (nix flake refs)
+	sudo    bool
+	log     func(string)
+}
+
+var distroManagers = map[string]distroManager{
+	"apt":    {name: "apt", update: "apt-get update", install: "apt-get install -y", sudo: true},
+	"pacman": {name: "pacman", update: "pacman -Syu --noconfirm", install: "pacman -S --needed --noconfirm", sudo: true},
+	// check-update exits 100 when updates are available
+	"dnf":    {name: "dnf", update: "dnf check-update || true", install: "dnf install -y", sudo: true},
+	"apk":    {name: "apk", update: "apk update", install: "apk add", sudo: true},
+	"zypper": {name: "zypper", update: "zypper --non-interactive refresh", install: "zypper --non-interactive install", sudo: true},
+	"xbps":   {name: "xbps", update: "xbps-install -Suy", install: "xbps-install -y", sudo: true},
+	// nix profiles are per user, so no sudo and no system-wide refresh
+	"nix": {name: "nix", install: "nix profile install", prefix: "nixpkgs#"},
+}
+
+// NewPackageManager returns the manager called name, logging command output
+// to log. Unknown names (including "") return ErrNoPackageManager.
+func NewPackageManager(name string, log func(string)) (PackageManager, error) {
+	pm, ok := distroManagers[name]
+	if !ok {
+		return nil, ErrNoPackageManager
+	}
+	pm.log = log
+	return pm, nil
+}
+
+func (d distroManager) Name() string { return d.name }
+
+func (d distroManager) Update(ctx context.Context) *ExecResult {
+	if d.update == "" {
+		return &ExecResult{}
+	}
+	return RunWithLogs(ctx, d.UpdateCommand(), nil, d.log)
+}
+
+func (d distroManager) Install(ctx context.Context, pkgs ...string) *ExecResult {
+	return RunWithLogs(ctx, d.InstallCommand(pkgs...), nil, d.log)
+}
+
+func (d distroManager) UpdateCommand() string {
+	if d.update == "" {
+		return ""
+	}
+	return d.withSudo(d.update)
+}
+
+func (d distroManager) InstallCommand(pkgs ...string) string {
+	names := TranslatePackages(d.name, pkgs...)
+	for i, n := range names {
+		names[i] = d.prefix + n
+	}
+	return d.withSudo(d.install + " " + strings.Join(names, " "))
+}
+
+func (d distroManager) withSudo(command string) string {
+	if d.sudo {
+		return "sudo " + command
+	}
+	return command
+}
+
+// packageNames maps the package names the install steps use to what each
+// manager calls them. A name may expand to several packages; "" means the
+// manager doesn't package it and the name is dropped. Missing entries are
+// used as is.
+var packageNames = map[string]map[string]string{
+	"build-essential": {"pacman": "base-devel", "dnf": "@development-tools", "apk": "build-base", "zypper": "gcc gcc-c++ make", "xbps": "base-devel", "nix": "gcc gnumake"},
+	"fd":              {"apt": "fd-find", "dnf": "fd-find"},
+	"npm":             {"xbps": "", "nix": ""}, // ships with nodejs
+	"nushell":         {"apt": ""},
+	"starship":        {"apt": ""},
+	"atuin":           {"apt": ""},
+	"lazygit":         {"apt": ""},
+	"tree-sitter-cli": {"apt": "", "nix": "tree-sitter"},
+	"zellij":          {"apt": ""},
+	"wezterm":         {"apt": ""}, // dnf: from COPR
+	"ghostty":         {"apt": "", "apk": "", "xbps": ""},
+	"alacritty":       {"apt": ""},
+}
+
+// TranslatePackages returns the names manager uses for pkgs, dropping the
+// ones it doesn't package
+func TranslatePackages(manager string, pkgs ...string) []string {
+	var names []string
+	for _, p := range pkgs {
+		name := p
+		if alt, ok := packageNames[p][manager]; ok {
+			name = alt
+		}
+		names = append(names, strings.Fields(name)...)
+	}
+	return names
+}
+
+// Packaged reports whether manager has a package for pkg
+func Packaged(manager, pkg string) bool {
+	return len(TranslatePackages(manager, pkg)) > 0
+}
+
+// packageManagerForOS is the manager of each distro Detect recognises
+var packageManagerForOS = map[OSType]string{
+	OSArch:     "pacman",
+	OSDebian:   "apt",
+	OSFedora:   "dnf",
+	OSNixOS:    "nix",
+	OSAlpine:   "apk",
+	OSOpenSUSE: "zypper",
+	OSVoid:     "xbps",
+}
+
+// managerBinaries are probed in order on distros Detect doesn't recognise
+var managerBinaries = []struct{ binary, name string }{
+	{"apt-get", "apt"},
+	{"dnf", "dnf"},
+	{"pacman", "pacman"},
+	{"apk", "apk"},
+	{"zypper", "zypper"},
+	{"xbps-install", "xbps"},
+	{"nix", "nix"},
+}
+
+// detectPackageManager names the package manager for osType, probing PATH
+// on unrecognised Linux distros. It returns "" when there is none.
+func detectPackageManager(osType OSType) string {
+	if name, ok := packageManagerForOS[osType]; ok {
+		return name
+	}
+	if osType != OSLinux {
+		return ""
+	}
+	for _, m := range managerBinaries {
+		if _, err := exec.LookPath(m.binary); err == nil {
+			return m.name
+		}
+	}
+	return ""
+}
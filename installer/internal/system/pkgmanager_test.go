@@ -0,0 +1,103 @@
+package system
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestTranslatePackages(t *testing.T) {
+	pkgs := []string{"build-essential", "git", "fd", "lazygit", "npm"}
+	tests := []struct {
+		manager string
+		want    []string
+	}{
+		{"apt", []string{"build-essential", "git", "fd-find", "npm"}},
+		{"pacman", []string{"base-devel", "git", "fd", "lazygit", "npm"}},
+		{"dnf", []string{"@development-tools", "git", "fd-find", "lazygit", "npm"}},
+		{"apk", []string{"build-base", "git", "fd", "lazygit", "npm"}},
+		{"zypper", []string{"gcc", "gcc-c++", "make", "git", "fd", "lazygit", "npm"}},
+		{"xbps", []string{"base-devel", "git", "fd", "lazygit"}},
+		{"nix", []string{"gcc", "gnumake", "git", "fd", "lazygit"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.manager, func(t *testing.T) {
+			if got := TranslatePackages(tt.manager, pkgs...); !slices.Equal(got, tt.want) {
+				t.Errorf("TranslatePackages(%s) = %v, want %v", tt.manager, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPackageManagerCommands(t *testing.T) {
+	tests := []struct {
+		manager     string
+		wantUpdate  string
+		wantInstall string
+	}{
+		{"apt", "sudo apt-get update", "sudo apt-get install -y fd-find git"},
+		{"pacman", "sudo pacman -Syu --noconfirm", "sudo pacman -S --needed --noconfirm fd git"},
+		{"dnf", "sudo dnf check-update || true", "sudo dnf install -y fd-find git"},
+		{"apk", "sudo apk update", "sudo apk add fd git"},
+		{"zypper", "sudo zypper --non-interactive refresh", "sudo zypper --non-interactive install fd git"},
+		{"xbps", "sudo xbps-install -Suy", "sudo xbps-install -y fd git"},
+		{"nix", "", "nix profile install nixpkgs#fd nixpkgs#git"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.manager, func(t *testing.T) {
+			pm, err := NewPackageManager(tt.manager, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if pm.Name() != tt.manager {
+				t.Errorf("Name() = %q", pm.Name())
+			}
+			if got := pm.UpdateCommand(); got != tt.wantUpdate {
+				t.Errorf("UpdateCommand() = %q, want %q", got, tt.wantUpdate)
+			}
+			if got := pm.InstallCommand("fd", "git"); got != tt.wantInstall {
+				t.Errorf("InstallCommand() = %q, want %q", got, tt.wantInstall)
+			}
+		})
+	}
+	if !slices.Equal(SupportedPackageManagers, []string{"apt", "pacman", "dnf", "apk", "zypper", "xbps", "nix"}) || len(distroManagers) != len(SupportedPackageManagers) {
+		t.Error("SupportedPackageManagers should list every manager")
+	}
+}
+
+func TestNewPackageManagerUnknown(t *testing.T) {
+	for _, name := range []string{"", "emerge"} {
+		if _, err := NewPackageManager(name, nil); !errors.Is(err, ErrNoPackageManager) {
+			t.Errorf("NewPackageManager(%q) should fail with ErrNoPackageManager, got %v", name, err)
+		}
+	}
+}
+
+func TestPackaged(t *testing.T) {
+	if Packaged("apt", "ghostty") || !Packaged("pacman", "ghostty") || !Packaged("apt", "git") {
+		t.Error("Packaged should follow the translation table")
+	}
+}
+
+func TestDetectPackageManager(t *testing.T) {
+	tests := []struct {
+		os   OSType
+		want string
+	}{
+		{OSArch, "pacman"},
+		{OSDebian, "apt"},
+		{OSFedora, "dnf"},
+		{OSNixOS, "nix"},
+		{OSAlpine, "apk"},
+		{OSOpenSUSE, "zypper"},
+		{OSVoid, "xbps"},
+		{OSMac, ""},
+		{OSTermux, ""},
+		{OSWindows, ""},
+	}
+	for _, tt := range tests {
+		if got := detectPackageManager(tt.os); got != tt.want {
+			t.Errorf("detectPackageManager(%d) = %q, want %q", tt.os, got, tt.want)
+		}
+	}
+}
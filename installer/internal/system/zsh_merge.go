@@ -0,0 +1,239 @@
+package system
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Markers delimiting the installer-managed block inside a user's .zshrc.
+// Everything between them is owned by the installer and rewritten on every install.
+const (
+	ZshBlockStart = "# >>> Gentleman.Dots managed block >>>"
+	ZshBlockEnd   = "# <<< Gentleman.Dots managed block <<<"
+)
+
+// ZshMergeConfigPath is where merge mode installs the repo's zsh config,
+// relative to $HOME. The managed block sources it from the user's .zshrc.
+const ZshMergeConfigPath = ".config/gentleman/zshrc.zsh"
+
+// ManagedBlock is the result of locating the managed block in a file
+type ManagedBlock struct {
+	Start int      // Line index of the start marker
+	End   int      // Line index of the end marker
+	Body  []string // Lines between the markers
+}
+
+// FindManagedBlocks returns every complete managed block in content, in order.
+// A start marker without a matching end marker is reported as an error so a
+// truncated block never causes user lines below it to be deleted.
+func FindManagedBlocks(content string) ([]ManagedBlock, error) {
+	lines := strings.Split(content, "\n")
+	var blocks []ManagedBlock
+	start := -1
+	for i, line := range lines {
+		switch strings.TrimSpace(line) {
+		case ZshBlockStart:
+			if start >= 0 {
+				return nil, fmt.Errorf("line %d: managed block opened again before line %d was closed", i+1, start+1)
+			}
+			start = i
+		case ZshBlockEnd:
+			if start < 0 {
+				return nil, fmt.Errorf("line %d: managed block end marker without a start marker", i+1)
+			}
+			body := append([]string(nil), lines[start+1:i]...)
+			blocks = append(blocks, ManagedBlock{Start: start, End: i, Body: body})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		return nil, fmt.Errorf("line %d: managed block is missing its end marker", start+1)
+	}
+	return blocks, nil
+}
+
+// RenderManagedBlock wraps body lines in the managed block markers
+func RenderManagedBlock(body []string) string {
+	lines := make([]string, 0, len(body)+2)
+	lines = append(lines, ZshBlockStart)
+	lines = append(lines, body...)
+	lines = append(lines, ZshBlockEnd)
+	return strings.Join(lines, "\n")
+}
+
+// UpsertManagedBlock returns content with exactly one managed block holding body.
+// The first existing block is replaced in place (user edits inside it are discarded),
+// any further stale copies are dropped, and if there is no block it is appended.
+func UpsertManagedBlock(content string, body []string) (string, error) {
+	blocks, err := FindManagedBlocks(content)
+	if err != nil {
+		return "", err
+	}
+
+	block := RenderManagedBlock(body)
+	if len(blocks) == 0 {
+		if content == "" {
+			return block + "\n", nil
+		}
+		if !strings.HasSuffix(content, "\n") {
+			content += "\n"
+		}
+		return content + "\n" + block + "\n", nil
+	}
+
+	lines := strings.Split(content, "\n")
+	var out []string
+	prev := 0
+	for i, b := range blocks {
+		out = append(out, lines[prev:b.Start]...)
+		if i == 0 {
+			out = append(out, block)
+		}
+		prev = b.End + 1
+	}
+	out = append(out, lines[prev:]...)
+	return strings.Join(out, "\n"), nil
+}
+
+// RemoveManagedBlock strips every managed block from content, along with the
+// blank line UpsertManagedBlock adds before a block it appends. Only a block
+// ending the file was appended, so blank lines before any other block are the
+// user's and stay. Reports whether anything was removed.
+func RemoveManagedBlock(content string) (string, bool, error) {
+	blocks, err := FindManagedBlocks(content)
+	if err != nil {
+		return "", false, err
+	}
+	if len(blocks) == 0 {
+		return content, false, nil
+	}
+
+	lines := strings.Split(content, "\n")
+	var out []string
+	prev := 0
+	for _, b := range blocks {
+		kept := lines[prev:b.Start]
+		atEnd := b.End == len(lines)-1 || (b.End == len(lines)-2 && lines[len(lines)-1] == "")
+		if atEnd && len(kept) > 0 && strings.TrimSpace(kept[len(kept)-1]) == "" {
+			kept = kept[:len(kept)-1]
+		}
+		out = append(out, kept...)
+		prev = b.End + 1
+	}
+	out = append(out, lines[prev:]...)
+	return strings.Join(out, "\n"), true, nil
+}
+
+// ZshMergeBlockBody returns the lines placed inside the managed block
+func ZshMergeBlockBody() []string {
+	return []string{
+		"# Added by the Gentleman.Dots installer. Edits inside this block are overwritten",
+		"# on the next install; put your own config above or below it.",
+		fmt.Sprintf(`[[ -f "$HOME/%s" ]] && source "$HOME/%s"`, ZshMergeConfigPath, ZshMergeConfigPath),
+	}
+}
+
+// MergeZshrc inserts or refreshes the managed block in zshrcPath, creating the
+// file if it doesn't exist. Lines outside the block are left untouched.
+func MergeZshrc(zshrcPath string) error {
+	content, err := os.ReadFile(zshrcPath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	merged, err := UpsertManagedBlock(string(content), ZshMergeBlockBody())
+	if err != nil {
+		return fmt.Errorf("%s: %w", zshrcPath, err)
+	}
+	if merged == string(content) {
+		return nil
+	}
+
+	if err := EnsureDir(filepath.Dir(zshrcPath)); err != nil {
+		return err
+	}
+	return writeZshrc(zshrcPath, merged)
+}
+
+// writeZshrc replaces the contents of zshrcPath through a temp file and a
+// rename, keeping its mode. A symlinked .zshrc (stow, a dotfiles repo) is
+// resolved first and its target written, so the link stays in place and the
+// edit lands in the file it points to.
+func writeZshrc(zshrcPath, content string) error {
+	target, err := filepath.EvalSymlinks(zshrcPath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return err
+		}
+		target = zshrcPath
+	}
+	mode := os.FileMode(0644)
+	if info, err := os.Stat(target); err == nil {
+		mode = info.Mode().Perm()
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(target), ".zshrc-*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.WriteString(content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), mode); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), target)
+}
+
+// UnmergeZshrc removes the managed block from zshrcPath, leaving the rest of the
+// file as the user wrote it. Reports whether a block was found.
+func UnmergeZshrc(zshrcPath string) (bool, error) {
+	content, err := os.ReadFile(zshrcPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	stripped, removed, err := RemoveManagedBlock(string(content))
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", zshrcPath, err)
+	}
+	if !removed {
+		return false, nil
+	}
+	return true, writeZshrc(zshrcPath, stripped)
+}
+
+// RefreshZshrc rewrites the managed block in zshrcPath with the current body,
+// so an update picks up a changed block. A file without a block is left alone:
+// the install didn't merge into it. Reports whether the file changed.
+func RefreshZshrc(zshrcPath string) (bool, error) {
+	content, err := os.ReadFile(zshrcPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	blocks, err := FindManagedBlocks(string(content))
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", zshrcPath, err)
+	}
+	if len(blocks) == 0 {
+		return false, nil
+	}
+	refreshed, err := UpsertManagedBlock(string(content), ZshMergeBlockBody())
+	if err != nil || refreshed == string(content) {
+		return false, err
+	}
+	return true, writeZshrc(zshrcPath, refreshed)
+}
@@ -0,0 +1,296 @@
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestUpsertManagedBlock(t *testing.T) {
+	body := []string{"source ~/.config/gentleman/zshrc.zsh"}
+	block := RenderManagedBlock(body)
+
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "empty file",
+			content: "",
+			want:    block + "\n",
+		},
+		{
+			name:    "appends to existing config",
+			content: "export FOO=1\nalias ll='ls -l'\n",
+			want:    "export FOO=1\nalias ll='ls -l'\n\n" + block + "\n",
+		},
+		{
+			name:    "appends when file lacks trailing newline",
+			content: "export FOO=1",
+			want:    "export FOO=1\n\n" + block + "\n",
+		},
+		{
+			name: "replaces stale block in place",
+			content: "export FOO=1\n" +
+				ZshBlockStart + "\nsource ~/old/path.zsh\n" + ZshBlockEnd + "\n" +
+				"alias ll='ls -l'\n",
+			want: "export FOO=1\n" + block + "\nalias ll='ls -l'\n",
+		},
+		{
+			name: "discards user edits inside the block",
+			content: ZshBlockStart + "\nsource ~/.config/gentleman/zshrc.zsh\nexport MINE=1\n" + ZshBlockEnd + "\n" +
+				"export OUTSIDE=1\n",
+			want: block + "\nexport OUTSIDE=1\n",
+		},
+		{
+			name: "collapses duplicate blocks",
+			content: "a\n" +
+				ZshBlockStart + "\nold1\n" + ZshBlockEnd + "\n" +
+				"b\n" +
+				ZshBlockStart + "\nold2\n" + ZshBlockEnd + "\n" +
+				"c\n",
+			want: "a\n" + block + "\nb\nc\n",
+		},
+		{
+			name:    "markers with surrounding whitespace are recognised",
+			content: "  " + ZshBlockStart + "  \nold\n\t" + ZshBlockEnd + "\n",
+			want:    block + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := UpsertManagedBlock(tt.content, body)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("UpsertManagedBlock() =\n%q\nwant\n%q", got, tt.want)
+			}
+
+			// Re-running must be a no-op
+			again, err := UpsertManagedBlock(got, body)
+			if err != nil {
+				t.Fatalf("unexpected error on second run: %v", err)
+			}
+			if again != got {
+				t.Errorf("second run changed content:\n%q\nto\n%q", got, again)
+			}
+		})
+	}
+}
+
+func TestUpsertManagedBlockMalformed(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"missing end marker", "a\n" + ZshBlockStart + "\nsource x\nexport MINE=1\n"},
+		{"end marker without start", "a\n" + ZshBlockEnd + "\n"},
+		{"nested start marker", ZshBlockStart + "\n" + ZshBlockStart + "\n" + ZshBlockEnd + "\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := UpsertManagedBlock(tt.content, []string{"x"}); err == nil {
+				t.Error("expected error for malformed block")
+			}
+			if _, _, err := RemoveManagedBlock(tt.content); err == nil {
+				t.Error("expected RemoveManagedBlock to refuse malformed block")
+			}
+		})
+	}
+}
+
+func TestRemoveManagedBlock(t *testing.T) {
+	original := "export FOO=1\nalias ll='ls -l'\n"
+	merged, err := UpsertManagedBlock(original, ZshMergeBlockBody())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("round trip restores original", func(t *testing.T) {
+		got, removed, err := RemoveManagedBlock(merged)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !removed {
+			t.Error("expected block to be reported as removed")
+		}
+		if got != original {
+			t.Errorf("expected %q, got %q", original, got)
+		}
+	})
+
+	t.Run("block in the middle keeps surrounding lines", func(t *testing.T) {
+		content := "a\n" + ZshBlockStart + "\nx\n" + ZshBlockEnd + "\nb\n"
+		got, _, err := RemoveManagedBlock(content)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != "a\nb\n" {
+			t.Errorf("expected %q, got %q", "a\nb\n", got)
+		}
+	})
+
+	t.Run("user blank lines before an inner block stay", func(t *testing.T) {
+		content := "a\n\n" + ZshBlockStart + "\nx\n" + ZshBlockEnd + "\nb\n"
+		got, _, err := RemoveManagedBlock(content)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != "a\n\nb\n" {
+			t.Errorf("expected %q, got %q", "a\n\nb\n", got)
+		}
+	})
+
+	t.Run("round trip keeps the user's trailing blank line", func(t *testing.T) {
+		withBlank := original + "\n"
+		merged, err := UpsertManagedBlock(withBlank, ZshMergeBlockBody())
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, _, err := RemoveManagedBlock(merged)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != withBlank {
+			t.Errorf("expected %q, got %q", withBlank, got)
+		}
+	})
+
+	t.Run("no block is a no-op", func(t *testing.T) {
+		got, removed, err := RemoveManagedBlock(original)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if removed || got != original {
+			t.Errorf("expected unchanged content, got removed=%v %q", removed, got)
+		}
+	})
+}
+
+func TestMergeZshrc(t *testing.T) {
+	dir := t.TempDir()
+	zshrc := filepath.Join(dir, ".zshrc")
+	userConfig := "# my config\nexport PATH=\"$HOME/bin:$PATH\"\n"
+	if err := os.WriteFile(zshrc, []byte(userConfig), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := MergeZshrc(zshrc); err != nil {
+			t.Fatalf("MergeZshrc run %d failed: %v", i+1, err)
+		}
+	}
+
+	content, _ := os.ReadFile(zshrc)
+	if !strings.HasPrefix(string(content), userConfig) {
+		t.Errorf("user config should be preserved at the top, got:\n%s", content)
+	}
+	if n := strings.Count(string(content), ZshBlockStart); n != 1 {
+		t.Errorf("expected exactly one managed block, got %d", n)
+	}
+	if !strings.Contains(string(content), ZshMergeConfigPath) {
+		t.Error("managed block should source the merged config")
+	}
+
+	removed, err := UnmergeZshrc(zshrc)
+	if err != nil {
+		t.Fatalf("UnmergeZshrc failed: %v", err)
+	}
+	if !removed {
+		t.Error("expected UnmergeZshrc to find the block")
+	}
+	content, _ = os.ReadFile(zshrc)
+	if string(content) != userConfig {
+		t.Errorf("expected original config after unmerge, got %q", content)
+	}
+}
+
+func TestMergeZshrcCreatesMissingFile(t *testing.T) {
+	zshrc := filepath.Join(t.TempDir(), ".zshrc")
+	if err := MergeZshrc(zshrc); err != nil {
+		t.Fatalf("MergeZshrc failed: %v", err)
+	}
+	content, err := os.ReadFile(zshrc)
+	if err != nil {
+		t.Fatalf("expected .zshrc to be created: %v", err)
+	}
+	if !strings.HasPrefix(string(content), ZshBlockStart) {
+		t.Errorf("expected file to start with managed block, got %q", content)
+	}
+
+	removed, err := UnmergeZshrc(filepath.Join(t.TempDir(), "missing"))
+	if err != nil || removed {
+		t.Errorf("unmerge of missing file should be a no-op, got removed=%v err=%v", removed, err)
+	}
+}
+
+func TestMergeZshrcThroughSymlink(t *testing.T) {
+	dir := t.TempDir()
+	// A dotfiles repo's zshrc, linked into place the way stow does
+	repoFile := filepath.Join(dir, "dotfiles", "zshrc")
+	os.MkdirAll(filepath.Dir(repoFile), 0755)
+	userConfig := "export FOO=1\n"
+	os.WriteFile(repoFile, []byte(userConfig), 0600)
+	zshrc := filepath.Join(dir, ".zshrc")
+	if err := os.Symlink(repoFile, zshrc); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := MergeZshrc(zshrc); err != nil {
+		t.Fatalf("MergeZshrc failed: %v", err)
+	}
+	if info, err := os.Lstat(zshrc); err != nil || info.Mode()&os.ModeSymlink == 0 {
+		t.Fatalf("the .zshrc symlink should stay in place: %v", err)
+	}
+	content, _ := os.ReadFile(repoFile)
+	if !strings.Contains(string(content), ZshBlockStart) {
+		t.Errorf("expected the block in the link target, got %q", content)
+	}
+	if info, _ := os.Stat(repoFile); info.Mode().Perm() != 0600 {
+		t.Errorf("expected the target's mode kept, got %v", info.Mode().Perm())
+	}
+
+	if _, err := UnmergeZshrc(zshrc); err != nil {
+		t.Fatalf("UnmergeZshrc failed: %v", err)
+	}
+	if content, _ := os.ReadFile(repoFile); string(content) != userConfig {
+		t.Errorf("expected the original config after unmerge, got %q", content)
+	}
+	if entries, _ := os.ReadDir(filepath.Dir(repoFile)); len(entries) != 1 {
+		t.Errorf("expected no temp files left beside the target, got %v", entries)
+	}
+}
+
+func TestRefreshZshrc(t *testing.T) {
+	dir := t.TempDir()
+	zshrc := filepath.Join(dir, ".zshrc")
+	stale := "# mine\n\n" + RenderManagedBlock([]string{"source ~/.old-gentleman"}) + "\nalias ll='ls -l'\n"
+	os.WriteFile(zshrc, []byte(stale), 0644)
+
+	changed, err := RefreshZshrc(zshrc)
+	if err != nil || !changed {
+		t.Fatalf("a stale block should be refreshed, got changed=%v err=%v", changed, err)
+	}
+	content, _ := os.ReadFile(zshrc)
+	want := "# mine\n\n" + RenderManagedBlock(ZshMergeBlockBody()) + "\nalias ll='ls -l'\n"
+	if string(content) != want {
+		t.Errorf("got %q, want %q", content, want)
+	}
+	if changed, err := RefreshZshrc(zshrc); err != nil || changed {
+		t.Errorf("a current block should be left alone, got changed=%v err=%v", changed, err)
+	}
+
+	plain := filepath.Join(dir, "plain")
+	os.WriteFile(plain, []byte("# no block\n"), 0644)
+	if changed, err := RefreshZshrc(plain); err != nil || changed {
+		t.Errorf("a file without a block was not merged into, got changed=%v err=%v", changed, err)
+	}
+	if content, _ := os.ReadFile(plain); string(content) != "# no block\n" {
+		t.Errorf("a file without a block should be untouched, got %q", content)
+	}
+}
@@ -0,0 +1,369 @@
+package tui
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
+)
+
+// aiToolConfigRoots maps each AI tool ID to its config directory (relative to $HOME).
+// Framework modules (hooks, commands, agents, skills) are installed under these roots.
+var aiToolConfigRoots = []struct {
+	ToolID string
+	Dir    string
+}{
+	{"claude", ".claude"},
+	{"opencode", ".config/opencode"},
+	{"gemini", ".gemini"},
+	{"copilot", ".copilot"},
+	{"codex", ".codex"},
+	{"qwen", ".qwen"},
+}
+
+// mcpConfigFiles lists the JSON files (relative to $HOME) where MCP servers are registered
+var mcpConfigFiles = []string{
+	".claude.json",
+	".config/opencode/opencode.json",
+	".gemini/settings.json",
+}
+
+// detectInstalledAITools returns the IDs of AI tools whose config directory exists under home.
+func detectInstalledAITools(home string) []string {
+	var tools []string
+	for _, root := range aiToolConfigRoots {
+		if info, err := os.Stat(filepath.Join(home, root.Dir)); err == nil && info.IsDir() {
+			tools = append(tools, root.ToolID)
+		}
+	}
+	return tools
+}
+
+// frameworkItemKey builds the "category/item" key used for diffs and removals
+func frameworkItemKey(catID, itemID string) string {
+	return catID + "/" + itemID
+}
+
+// frameworkItemLabel returns a human-readable label for a "category/item" key
+func frameworkItemLabel(key string) string {
+	catID, itemID, _ := strings.Cut(key, "/")
+	for _, cat := range moduleCategories {
+		if cat.ID != catID {
+			continue
+		}
+		for _, item := range cat.Items {
+			if item.ID == itemID {
+				return cat.Label + " → " + item.Label
+			}
+		}
+	}
+	return key
+}
+
+// frameworkItemPaths returns every on-disk location where a framework item may be
+// installed under a single CLI config root. Commands use "group:name" IDs that map
+// to commands/<group>/<name>.md; OpenCode uses singular directory names.
+func frameworkItemPaths(root, catID, itemID string) []string {
+	switch catID {
+	case "hooks":
+		var paths []string
+		for _, ext := range []string{"", ".sh", ".js", ".ts", ".py"} {
+			paths = append(paths, filepath.Join(root, "hooks", itemID+ext))
+		}
+		return paths
+	case "commands":
+		rel := strings.ReplaceAll(itemID, ":", string(filepath.Separator)) + ".md"
+		return []string{
+			filepath.Join(root, "commands", rel),
+			filepath.Join(root, "command", rel),
+		}
+	case "agents":
+		return []string{
+			filepath.Join(root, "agents", itemID+".md"),
+			filepath.Join(root, "agent", itemID+".md"),
+		}
+	case "skills":
+		return []string{filepath.Join(root, "skills", itemID)}
+	case "sdd":
+		switch itemID {
+		case "sdd-openspec":
+			return []string{
+				filepath.Join(root, "commands", "sdd"),
+				filepath.Join(root, "command", "sdd"),
+			}
+		case "sdd-agent-teams":
+			return []string{filepath.Join(root, "skills", "sdd-init")}
+		}
+	}
+	return nil
+}
+
+// readMCPServers returns the MCP server names registered in a JSON config file.
+// Both the Claude ("mcpServers") and OpenCode ("mcp") layouts are supported.
+func readMCPServers(path string) map[string]bool {
+	servers := make(map[string]bool)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return servers
+	}
+	var cfg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return servers
+	}
+	for _, field := range []string{"mcpServers", "mcp"} {
+		raw, ok := cfg[field]
+		if !ok {
+			continue
+		}
+		var entries map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &entries); err != nil {
+			continue
+		}
+		for name := range entries {
+			servers[name] = true
+		}
+	}
+	return servers
+}
+
+// detectInstalledFrameworkModules inspects the on-disk layout of every AI tool config
+// root under home and returns a selection map (categoryID → []bool) in the same shape
+// as Model.AICategorySelected, with items marked true when they are already installed.
+func detectInstalledFrameworkModules(home string) map[string][]bool {
+	mcpServers := make(map[string]bool)
+	for _, rel := range mcpConfigFiles {
+		for name := range readMCPServers(filepath.Join(home, rel)) {
+			mcpServers[name] = true
+		}
+	}
+
+	sel := make(map[string][]bool)
+	for _, cat := range moduleCategories {
+		bools := make([]bool, len(cat.Items))
+		for i, item := range cat.Items {
+			if cat.ID == "mcp" {
+				bools[i] = mcpServers[strings.TrimPrefix(item.ID, "mcp-")]
+				continue
+			}
+			bools[i] = frameworkItemInstalled(home, cat.ID, item.ID)
+		}
+		sel[cat.ID] = bools
+	}
+	return sel
+}
+
+// frameworkOwnsPath reports whether p, a frameworkItemPaths location, holds
+// the framework's files. Hooks, commands and agents are the framework's by
+// name; a skills/<id> entry may be the Skill Manager's instead.
+func frameworkOwnsPath(home, p string) bool {
+	if filepath.Base(filepath.Dir(p)) != "skills" {
+		return true
+	}
+	return frameworkOwnsSkill(home, p)
+}
+
+// frameworkOwnsSkill reports whether the skill entry at p came from the
+// framework. setup-global.sh copies its skills in as directories and
+// setupCentralizedSkills links them from the framework checkouts; the Skill
+// Manager links from its catalogs, and only copies where symlinks are
+// refused. So a link is the framework's when it points into a checkout, and
+// a directory is unless a catalog has a skill of that name.
+func frameworkOwnsSkill(home, p string) bool {
+	info, err := os.Lstat(p)
+	if err != nil {
+		return false
+	}
+	if info.Mode()&os.ModeSymlink != 0 {
+		target, err := os.Readlink(p)
+		if err != nil {
+			return false
+		}
+		if !filepath.IsAbs(target) {
+			target = filepath.Join(filepath.Dir(p), target)
+		}
+		for _, checkout := range []string{"project-starter-framework", "agent-teams-lite"} {
+			rel, err := filepath.Rel(filepath.Join(paths.DataDir(home), checkout), target)
+			if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+				return true
+			}
+		}
+		return false
+	}
+	name := filepath.Base(p)
+	for _, catalog := range []string{
+		filepath.Join(paths.SkillsDir(home), "curated", name),
+		filepath.Join(paths.SkillsDir(home), "community", name),
+		filepath.Join(paths.ExternalSkillsDir(home), name),
+	} {
+		if _, err := os.Stat(catalog); err == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// frameworkItemInstalled reports whether an item exists under any AI tool config root
+func frameworkItemInstalled(home, catID, itemID string) bool {
+	for _, root := range aiToolConfigRoots {
+		for _, p := range frameworkItemPaths(filepath.Join(home, root.Dir), catID, itemID) {
+			if _, err := os.Lstat(p); err == nil && frameworkOwnsPath(home, p) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// copySelection returns a deep copy of a category selection map
+func copySelection(sel map[string][]bool) map[string][]bool {
+	out := make(map[string][]bool, len(sel))
+	for id, bools := range sel {
+		out[id] = append([]bool(nil), bools...)
+	}
+	return out
+}
+
+// diffFrameworkSelection compares the installed state against the user's selection and
+// returns the "category/item" keys to add and remove, in registry order.
+func diffFrameworkSelection(installed, selected map[string][]bool) (added, removed []string) {
+	for _, cat := range moduleCategories {
+		before := installed[cat.ID]
+		after := selected[cat.ID]
+		for i, item := range cat.Items {
+			was := i < len(before) && before[i]
+			now := i < len(after) && after[i]
+			switch {
+			case now && !was:
+				added = append(added, frameworkItemKey(cat.ID, item.ID))
+			case was && !now:
+				removed = append(removed, frameworkItemKey(cat.ID, item.ID))
+			}
+		}
+	}
+	return added, removed
+}
+
+// planFrameworkApply turns a diff into the framework features to install and the items
+// to remove afterwards. setup-global.sh installs whole categories, so every unselected
+// item in a category being (re)installed is pruned again to honour the user's selection.
+func planFrameworkApply(selected map[string][]bool, added, removed []string) (features []string, agentTeams bool, prune []string) {
+	addedSel := make(map[string][]bool)
+	for _, cat := range moduleCategories {
+		addedSel[cat.ID] = make([]bool, len(cat.Items))
+	}
+	for _, key := range added {
+		catID, itemID, _ := strings.Cut(key, "/")
+		for _, cat := range moduleCategories {
+			if cat.ID != catID {
+				continue
+			}
+			for i, item := range cat.Items {
+				if item.ID == itemID {
+					addedSel[catID][i] = true
+				}
+			}
+		}
+	}
+	features = collectSelectedFeatures(addedSel)
+	agentTeams = isAgentTeamsLiteSelected(addedSel)
+
+	seen := make(map[string]bool)
+	for _, key := range removed {
+		seen[key] = true
+		prune = append(prune, key)
+	}
+	for _, feature := range features {
+		for _, cat := range moduleCategories {
+			if cat.ID != feature {
+				continue
+			}
+			bools := selected[cat.ID]
+			for i, item := range cat.Items {
+				if i < len(bools) && bools[i] {
+					continue
+				}
+				// Agent Teams Lite is a separate installer, not part of the "sdd" feature
+				if item.ID == "sdd-agent-teams" {
+					continue
+				}
+				key := frameworkItemKey(cat.ID, item.ID)
+				if !seen[key] {
+					seen[key] = true
+					prune = append(prune, key)
+				}
+			}
+		}
+	}
+	return features, agentTeams, prune
+}
+
+// removeFrameworkItems deletes the given "category/item" keys from every AI tool config
+// root under home. MCP servers are unregistered from the JSON config files instead, and
+// skill entries the framework doesn't own are left alone (see frameworkOwnsSkill).
+// It returns the keys that were actually found and removed.
+func removeFrameworkItems(home string, keys []string) ([]string, error) {
+	var removed []string
+	for _, key := range keys {
+		catID, itemID, _ := strings.Cut(key, "/")
+		found := false
+		if catID == "mcp" {
+			name := strings.TrimPrefix(itemID, "mcp-")
+			for _, rel := range mcpConfigFiles {
+				ok, err := removeMCPServer(filepath.Join(home, rel), name)
+				if err != nil {
+					return removed, err
+				}
+				found = found || ok
+			}
+		} else {
+			for _, root := range aiToolConfigRoots {
+				for _, p := range frameworkItemPaths(filepath.Join(home, root.Dir), catID, itemID) {
+					if _, err := os.Lstat(p); err != nil || !frameworkOwnsPath(home, p) {
+						continue
+					}
+					if err := os.RemoveAll(p); err != nil {
+						return removed, err
+					}
+					found = true
+				}
+			}
+		}
+		if found {
+			removed = append(removed, key)
+		}
+	}
+	return removed, nil
+}
+
+// removeMCPServer drops a server entry from a JSON MCP config file, reporting whether it existed
+func removeMCPServer(path, name string) (bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false, nil
+	}
+	var cfg map[string]interface{}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return false, nil
+	}
+	found := false
+	for _, field := range []string{"mcpServers", "mcp"} {
+		entries, ok := cfg[field].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if _, ok := entries[name]; ok {
+			delete(entries, name)
+			found = true
+		}
+	}
+	if !found {
+		return false, nil
+	}
+	out, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return false, err
+	}
+	return true, os.WriteFile(path, append(out, '\n'), 0644)
+}
@@ -0,0 +1,421 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// writeFixture creates a file (and parent dirs) under home for installed-layout fixtures
+func writeFixture(t *testing.T, home, rel, content string) {
+	t.Helper()
+	path := filepath.Join(home, rel)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// itemIndex returns the index of an item within a category, failing the test if missing
+func itemIndex(t *testing.T, catID, itemID string) int {
+	t.Helper()
+	for _, cat := range moduleCategories {
+		if cat.ID != catID {
+			continue
+		}
+		for i, item := range cat.Items {
+			if item.ID == itemID {
+				return i
+			}
+		}
+	}
+	t.Fatalf("item %s/%s not in registry", catID, itemID)
+	return -1
+}
+
+// claudeFixture builds a home dir with a typical Claude framework install
+func claudeFixture(t *testing.T) string {
+	t.Helper()
+	home := t.TempDir()
+	writeFixture(t, home, ".claude/hooks/commit-guard.sh", "#!/bin/sh")
+	writeFixture(t, home, ".claude/hooks/block-dangerous-commands.js", "//")
+	writeFixture(t, home, ".claude/commands/git/commit.md", "# commit")
+	writeFixture(t, home, ".claude/agents/orchestrator.md", "# orchestrator")
+	writeFixture(t, home, ".claude/skills/backend-fastapi/SKILL.md", "---\nname: fastapi\n---")
+	writeFixture(t, home, ".claude.json", `{"mcpServers": {"context7": {"command": "npx"}, "engram": {"command": "engram"}}}`)
+	return home
+}
+
+func TestDetectInstalledAITools(t *testing.T) {
+	t.Run("no tools on empty home", func(t *testing.T) {
+		if tools := detectInstalledAITools(t.TempDir()); len(tools) != 0 {
+			t.Errorf("expected no tools, got %v", tools)
+		}
+	})
+
+	t.Run("detects config directories", func(t *testing.T) {
+		home := t.TempDir()
+		os.MkdirAll(filepath.Join(home, ".claude"), 0o755)
+		os.MkdirAll(filepath.Join(home, ".config", "opencode"), 0o755)
+
+		tools := detectInstalledAITools(home)
+		if strings.Join(tools, ",") != "claude,opencode" {
+			t.Errorf("expected claude,opencode, got %v", tools)
+		}
+	})
+
+	t.Run("ignores files named like config dirs", func(t *testing.T) {
+		home := t.TempDir()
+		writeFixture(t, home, ".gemini", "not a dir")
+		if tools := detectInstalledAITools(home); len(tools) != 0 {
+			t.Errorf("expected no tools, got %v", tools)
+		}
+	})
+}
+
+func TestDetectInstalledFrameworkModules(t *testing.T) {
+	t.Run("claude layout", func(t *testing.T) {
+		sel := detectInstalledFrameworkModules(claudeFixture(t))
+
+		want := map[string][]string{
+			"hooks":    {"commit-guard", "block-dangerous-commands"},
+			"commands": {"git:commit"},
+			"agents":   {"orchestrator"},
+			"skills":   {"backend-fastapi"},
+			"mcp":      {"mcp-context7", "mcp-engram"},
+		}
+		for catID, items := range want {
+			for _, itemID := range items {
+				if !sel[catID][itemIndex(t, catID, itemID)] {
+					t.Errorf("expected %s/%s to be detected", catID, itemID)
+				}
+			}
+		}
+
+		if sel["hooks"][itemIndex(t, "hooks", "secret-scanner")] {
+			t.Error("secret-scanner should not be detected")
+		}
+		if sel["sdd"][itemIndex(t, "sdd", "sdd-openspec")] {
+			t.Error("sdd-openspec should not be detected")
+		}
+	})
+
+	t.Run("opencode singular directories", func(t *testing.T) {
+		home := t.TempDir()
+		writeFixture(t, home, ".config/opencode/command/testing/tdd.md", "# tdd")
+		writeFixture(t, home, ".config/opencode/agent/quality-code-reviewer.md", "# reviewer")
+		writeFixture(t, home, ".config/opencode/opencode.json", `{"mcp": {"figma": {}}}`)
+
+		sel := detectInstalledFrameworkModules(home)
+		if !sel["commands"][itemIndex(t, "commands", "testing:tdd")] {
+			t.Error("expected testing:tdd to be detected")
+		}
+		if !sel["agents"][itemIndex(t, "agents", "quality-code-reviewer")] {
+			t.Error("expected quality-code-reviewer to be detected")
+		}
+		if !sel["mcp"][itemIndex(t, "mcp", "mcp-figma")] {
+			t.Error("expected mcp-figma to be detected")
+		}
+	})
+
+	t.Run("every category present with registry length", func(t *testing.T) {
+		sel := detectInstalledFrameworkModules(t.TempDir())
+		for _, cat := range moduleCategories {
+			if len(sel[cat.ID]) != len(cat.Items) {
+				t.Errorf("category %s: expected %d items, got %d", cat.ID, len(cat.Items), len(sel[cat.ID]))
+			}
+		}
+	})
+
+	t.Run("malformed mcp json is ignored", func(t *testing.T) {
+		home := t.TempDir()
+		writeFixture(t, home, ".claude.json", "{not json")
+		sel := detectInstalledFrameworkModules(home)
+		for _, b := range sel["mcp"] {
+			if b {
+				t.Error("expected no mcp servers from malformed json")
+			}
+		}
+	})
+}
+
+func TestDiffFrameworkSelection(t *testing.T) {
+	installed := detectInstalledFrameworkModules(claudeFixture(t))
+	selected := copySelection(installed)
+
+	added, removed := diffFrameworkSelection(installed, selected)
+	if len(added) != 0 || len(removed) != 0 {
+		t.Fatalf("expected empty diff, got +%v -%v", added, removed)
+	}
+
+	selected["hooks"][itemIndex(t, "hooks", "secret-scanner")] = true
+	selected["agents"][itemIndex(t, "agents", "orchestrator")] = false
+
+	added, removed = diffFrameworkSelection(installed, selected)
+	if strings.Join(added, ",") != "hooks/secret-scanner" {
+		t.Errorf("unexpected added: %v", added)
+	}
+	if strings.Join(removed, ",") != "agents/orchestrator" {
+		t.Errorf("unexpected removed: %v", removed)
+	}
+
+	// copySelection must not alias the installed state
+	if installed["hooks"][itemIndex(t, "hooks", "secret-scanner")] {
+		t.Error("modifying the selection changed the installed state")
+	}
+}
+
+func TestPlanFrameworkApply(t *testing.T) {
+	installed := detectInstalledFrameworkModules(claudeFixture(t))
+	selected := copySelection(installed)
+	selected["hooks"][itemIndex(t, "hooks", "secret-scanner")] = true
+	selected["agents"][itemIndex(t, "agents", "orchestrator")] = false
+	added, removed := diffFrameworkSelection(installed, selected)
+
+	features, agentTeams, prune := planFrameworkApply(selected, added, removed)
+
+	if strings.Join(features, ",") != "hooks" {
+		t.Errorf("expected only hooks feature, got %v", features)
+	}
+	if agentTeams {
+		t.Error("agent teams should not be selected")
+	}
+
+	pruneSet := make(map[string]bool)
+	for _, key := range prune {
+		pruneSet[key] = true
+	}
+	if !pruneSet["agents/orchestrator"] {
+		t.Error("expected explicitly removed agent to be pruned")
+	}
+	if !pruneSet["hooks/learning-log"] {
+		t.Error("expected unselected hook to be pruned after reinstalling hooks")
+	}
+	for _, keep := range []string{"hooks/secret-scanner", "hooks/commit-guard", "hooks/block-dangerous-commands"} {
+		if pruneSet[keep] {
+			t.Errorf("selected hook %s must not be pruned", keep)
+		}
+	}
+	if pruneSet["skills/backend-fastapi"] {
+		t.Error("untouched categories must not be pruned")
+	}
+
+	t.Run("agent teams addition", func(t *testing.T) {
+		sel := copySelection(installed)
+		sel["sdd"][itemIndex(t, "sdd", "sdd-agent-teams")] = true
+		add, rem := diffFrameworkSelection(installed, sel)
+		features, agentTeams, _ := planFrameworkApply(sel, add, rem)
+		if !agentTeams {
+			t.Error("expected agent teams to be installed")
+		}
+		if len(features) != 0 {
+			t.Errorf("agent teams alone should not trigger setup-global features, got %v", features)
+		}
+	})
+}
+
+func TestRemoveFrameworkItems(t *testing.T) {
+	home := claudeFixture(t)
+
+	removed, err := removeFrameworkItems(home, []string{
+		"hooks/commit-guard",
+		"commands/git:commit",
+		"skills/backend-fastapi",
+		"mcp/mcp-engram",
+		"hooks/secret-scanner", // not installed
+	})
+	if err != nil {
+		t.Fatalf("removeFrameworkItems failed: %v", err)
+	}
+	if len(removed) != 4 {
+		t.Errorf("expected 4 removed items, got %v", removed)
+	}
+
+	for _, rel := range []string{".claude/hooks/commit-guard.sh", ".claude/commands/git/commit.md", ".claude/skills/backend-fastapi"} {
+		if _, err := os.Stat(filepath.Join(home, rel)); !os.IsNotExist(err) {
+			t.Errorf("expected %s to be removed", rel)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(home, ".claude/hooks/block-dangerous-commands.js")); err != nil {
+		t.Error("unrelated hook should remain")
+	}
+
+	servers := readMCPServers(filepath.Join(home, ".claude.json"))
+	if servers["engram"] {
+		t.Error("expected engram MCP server to be unregistered")
+	}
+	if !servers["context7"] {
+		t.Error("expected context7 MCP server to remain")
+	}
+}
+
+func TestRemoveFrameworkSkillsKeepsSkillManagerEntries(t *testing.T) {
+	home := t.TempDir()
+	catalog := filepath.Join(paths.SkillsDir(home), "community", "backend-fastapi")
+	checkout := filepath.Join(paths.DataDir(home), "project-starter-framework", ".ai-config", "skills", "backend-fastapi")
+	writeFixture(t, catalog, "SKILL.md", "# catalog")
+	writeFixture(t, checkout, "SKILL.md", "# framework")
+
+	// The Skill Manager's link, and its copy where links are refused
+	managerLink := filepath.Join(home, ".claude", "skills", "backend-fastapi")
+	os.MkdirAll(filepath.Dir(managerLink), 0o755)
+	if err := os.Symlink(catalog, managerLink); err != nil {
+		t.Fatal(err)
+	}
+	writeFixture(t, home, ".gemini/skills/backend-fastapi/SKILL.md", "# catalog")
+
+	if frameworkItemInstalled(home, "skills", "backend-fastapi") {
+		t.Error("a Skill Manager skill should not count as a framework module")
+	}
+	removed, err := removeFrameworkItems(home, []string{"skills/backend-fastapi"})
+	if err != nil || len(removed) != 0 {
+		t.Errorf("expected nothing removed, got %v (%v)", removed, err)
+	}
+
+	// The framework's link into its checkout goes; the Skill Manager's entries stay
+	frameworkLink := filepath.Join(home, ".config", "opencode", "skills", "backend-fastapi")
+	os.MkdirAll(filepath.Dir(frameworkLink), 0o755)
+	if err := os.Symlink(checkout, frameworkLink); err != nil {
+		t.Fatal(err)
+	}
+	if !frameworkItemInstalled(home, "skills", "backend-fastapi") {
+		t.Error("a link into the framework checkout is a framework module")
+	}
+	removed, err = removeFrameworkItems(home, []string{"skills/backend-fastapi"})
+	if err != nil || len(removed) != 1 {
+		t.Errorf("expected the framework skill removed, got %v (%v)", removed, err)
+	}
+	if _, err := os.Lstat(frameworkLink); !os.IsNotExist(err) {
+		t.Error("expected the framework link to be removed")
+	}
+	for _, p := range []string{managerLink, filepath.Join(home, ".gemini", "skills", "backend-fastapi")} {
+		if _, err := os.Lstat(p); err != nil {
+			t.Errorf("expected %s to remain: %v", p, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(catalog, "SKILL.md")); err != nil {
+		t.Errorf("the catalog must be untouched: %v", err)
+	}
+}
+
+func TestMainMenuAIFrameworkEntry(t *testing.T) {
+	m := NewModel()
+	m.Screen = ScreenMainMenu
+
+	for _, opt := range m.GetCurrentOptions() {
+		if strings.Contains(opt, "AI Framework") {
+			t.Fatal("AI Framework entry should be hidden when no AI tool is detected")
+		}
+	}
+
+	result, _ := m.Update(aiToolsDetectedMsg{tools: []string{"claude"}})
+	m = result.(Model)
+
+	found := false
+	for _, opt := range m.GetCurrentOptions() {
+		if strings.Contains(opt, "AI Framework") {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("AI Framework entry should be visible when an AI tool is detected")
+	}
+}
+
+func TestAIFrameworkApplyFlow(t *testing.T) {
+	home := claudeFixture(t)
+
+	m := NewModel()
+	m.Screen = ScreenMainMenu
+	m.DetectedAITools = []string{"claude"}
+
+	result, _ := m.enterAIFrameworkApply(home)
+	m = result.(Model)
+
+	if m.Screen != ScreenAIFrameworkCategories {
+		t.Fatalf("expected ScreenAIFrameworkCategories, got %v", m.Screen)
+	}
+	if !m.AIFrameworkApplyMode {
+		t.Fatal("expected apply mode")
+	}
+	if !m.AICategorySelected["hooks"][itemIndex(t, "hooks", "commit-guard")] {
+		t.Error("installed hook should be pre-selected")
+	}
+	if strings.Join(m.Choices.AITools, ",") != "claude" {
+		t.Errorf("expected detected tools in choices, got %v", m.Choices.AITools)
+	}
+
+	// Toggle secret-scanner on
+	m.AICategorySelected["hooks"][itemIndex(t, "hooks", "secret-scanner")] = true
+
+	// Confirm categories → diff screen
+	m.Cursor = len(m.GetCurrentOptions()) - 1
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = result.(Model)
+	if m.Screen != ScreenAIFrameworkApplyDiff {
+		t.Fatalf("expected ScreenAIFrameworkApplyDiff, got %v", m.Screen)
+	}
+	if strings.Join(m.AIFrameworkDiffAdded, ",") != "hooks/secret-scanner" {
+		t.Errorf("unexpected diff: %v", m.AIFrameworkDiffAdded)
+	}
+	if !strings.Contains(m.View(), "Secret Scanner") {
+		t.Error("diff view should list the added module")
+	}
+
+	// Apply → single aiframework step on the installing screen
+	m.Cursor = 0
+	result, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = result.(Model)
+	if m.Screen != ScreenInstalling {
+		t.Fatalf("expected ScreenInstalling, got %v", m.Screen)
+	}
+	if len(m.Steps) != 1 || m.Steps[0].ID != "aiframework" {
+		t.Errorf("expected only the aiframework step, got %+v", m.Steps)
+	}
+	if cmd == nil {
+		t.Error("expected install start command")
+	}
+	if strings.Join(m.Choices.AIFrameworkModules, ",") != "hooks" {
+		t.Errorf("expected hooks feature, got %v", m.Choices.AIFrameworkModules)
+	}
+}
+
+func TestAIFrameworkApplyNoChanges(t *testing.T) {
+	m := NewModel()
+	result, _ := m.enterAIFrameworkApply(claudeFixture(t))
+	m = result.(Model)
+
+	m.Cursor = len(m.GetCurrentOptions()) - 1
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = result.(Model)
+
+	opts := m.GetCurrentOptions()
+	for _, opt := range opts {
+		if strings.Contains(opt, "Apply changes") {
+			t.Error("apply option should be hidden when there are no changes")
+		}
+	}
+}
+
+func TestAIFrameworkApplyEscReturnsToMainMenu(t *testing.T) {
+	m := NewModel()
+	result, _ := m.enterAIFrameworkApply(t.TempDir())
+	m = result.(Model)
+
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = result.(Model)
+
+	if m.Screen != ScreenMainMenu {
+		t.Errorf("expected ScreenMainMenu, got %v", m.Screen)
+	}
+	if m.AIFrameworkApplyMode {
+		t.Error("apply mode should be cleared")
+	}
+}
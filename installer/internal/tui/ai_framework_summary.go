@@ -0,0 +1,127 @@
+package tui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// frameworkFeaturesFlag is the --features flag setup-global.sh receives for the features
+func frameworkFeaturesFlag(features []string) string {
+	if len(features) == 0 {
+		return "--features: none"
+	}
+	return "--features=" + strings.Join(features, ",")
+}
+
+// frameworkSelectionSummary describes a custom module selection: the items picked
+// in every category, the --features flag they collapse to, and whether Agent Teams
+// Lite gets installed. ScreenAIFrameworkSummary and the install log both show it.
+func frameworkSelectionSummary(sel map[string][]bool) []string {
+	var lines []string
+	for _, cat := range moduleCategories {
+		var picked []string
+		for i, on := range sel[cat.ID] {
+			if on && i < len(cat.Items) {
+				picked = append(picked, cat.Items[i].Label)
+			}
+		}
+		if len(picked) > 0 {
+			lines = append(lines, cat.Icon+" "+cat.Label+": "+strings.Join(picked, ", "))
+		}
+	}
+	if len(lines) == 0 {
+		lines = append(lines, "No modules selected")
+	}
+
+	lines = append(lines, frameworkFeaturesFlag(collectSelectedFeatures(sel)))
+	if isAgentTeamsLiteSelected(sel) {
+		lines = append(lines, "Agent Teams Lite: installed")
+	} else {
+		lines = append(lines, "Agent Teams Lite: not installed")
+	}
+	return lines
+}
+
+func (m Model) handleAIFrameworkSummaryKeys(key string) (tea.Model, tea.Cmd) {
+	options := m.canonicalOptions()
+
+	switch key {
+	case "up", "k":
+		if m.Cursor > 0 {
+			m.Cursor--
+		}
+	case "down", "j":
+		if m.Cursor < len(options)-1 {
+			m.Cursor++
+		}
+	case "enter", " ":
+		switch selected := options[m.Cursor]; {
+		case strings.Contains(selected, "Proceed"):
+			return m.enterWizardReview(), nil
+		case strings.Contains(selected, "Save as preset"):
+			return m.enterFrameworkPresetSave(), nil
+		default:
+			return m.backToAICategories()
+		}
+	case "backspace":
+		return m.backToAICategories()
+	}
+
+	return m, nil
+}
+
+// backToAICategories reopens the module categories to edit the selection,
+// with the cursor back on Confirm
+func (m Model) backToAICategories() (tea.Model, tea.Cmd) {
+	m.Choices.InstallAIFramework = true
+	m.Screen = ScreenAIFrameworkCategories
+	m.Cursor = len(m.GetCurrentOptions()) - 1
+	return m, nil
+}
+
+func (m Model) renderAIFrameworkSummary() string {
+	var s strings.Builder
+
+	s.WriteString(m.renderStepProgress())
+	s.WriteString("\n\n")
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
+	s.WriteString("\n\n")
+
+	if m.AIFrameworkPresetNotice != "" {
+		s.WriteString(m.Theme.Success.Render(m.AIFrameworkPresetNotice))
+		s.WriteString("\n\n")
+	}
+
+	lines := frameworkSelectionSummary(m.AICategorySelected)
+	for i, line := range lines {
+		style := m.Theme.Info
+		if i >= len(lines)-2 {
+			// The flags and Agent Teams Lite close the summary
+			style = m.Theme.Muted
+		}
+		s.WriteString(style.Render("  " + line))
+		s.WriteString("\n")
+	}
+	s.WriteString("\n")
+
+	for i, opt := range m.GetCurrentOptions() {
+		cursor := "  "
+		style := m.Theme.Unselected
+		if i == m.Cursor {
+			cursor = "▸ "
+			style = m.Theme.Selected
+		}
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
+		s.WriteString("\n")
+	}
+
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render("↑/k up • ↓/j down • [Enter] select • [Esc] back"))
+
+	return s.String()
+}
@@ -0,0 +1,165 @@
+package tui
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+// summarySelection picks one hook, two skills and both SDD items
+func summarySelection() map[string][]bool {
+	sel := make(map[string][]bool)
+	for _, cat := range moduleCategories {
+		sel[cat.ID] = make([]bool, len(cat.Items))
+	}
+	sel["hooks"][0] = true
+	sel["skills"][0] = true
+	sel["skills"][1] = true
+	sel["sdd"][0] = true
+	sel["sdd"][1] = true
+	return sel
+}
+
+func TestFrameworkSelectionSummary(t *testing.T) {
+	label := func(catID string, idx int) string {
+		for _, cat := range moduleCategories {
+			if cat.ID == catID {
+				return cat.Items[idx].Label
+			}
+		}
+		return ""
+	}
+	icon := func(catID string) string {
+		for _, cat := range moduleCategories {
+			if cat.ID == catID {
+				return cat.Icon + " " + cat.Label
+			}
+		}
+		return ""
+	}
+
+	tests := []struct {
+		name   string
+		modify func(sel map[string][]bool)
+		want   []string
+	}{
+		{
+			name:   "mixed selection",
+			modify: func(map[string][]bool) {},
+			want: []string{
+				icon("hooks") + ": " + label("hooks", 0),
+				icon("skills") + ": " + label("skills", 0) + ", " + label("skills", 1),
+				icon("sdd") + ": " + label("sdd", 0) + ", " + label("sdd", 1),
+				"--features=hooks,skills,sdd",
+				"Agent Teams Lite: installed",
+			},
+		},
+		{
+			// Agent Teams Lite alone is not an sdd feature for setup-global.sh
+			name:   "agent teams lite without openspec",
+			modify: func(sel map[string][]bool) { sel["sdd"][0] = false },
+			want: []string{
+				icon("hooks") + ": " + label("hooks", 0),
+				icon("skills") + ": " + label("skills", 0) + ", " + label("skills", 1),
+				icon("sdd") + ": " + label("sdd", 1),
+				"--features=hooks,skills",
+				"Agent Teams Lite: installed",
+			},
+		},
+		{
+			name: "openspec only",
+			modify: func(sel map[string][]bool) {
+				sel["hooks"][0], sel["skills"][0], sel["skills"][1], sel["sdd"][1] = false, false, false, false
+			},
+			want: []string{
+				icon("sdd") + ": " + label("sdd", 0),
+				"--features=sdd",
+				"Agent Teams Lite: not installed",
+			},
+		},
+		{
+			name: "nothing",
+			modify: func(sel map[string][]bool) {
+				for id := range sel {
+					sel[id] = nil
+				}
+			},
+			want: []string{"No modules selected", "--features: none", "Agent Teams Lite: not installed"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sel := summarySelection()
+			tt.modify(sel)
+			if got := frameworkSelectionSummary(sel); !slices.Equal(got, tt.want) {
+				t.Errorf("summary =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
+
+func TestAIFrameworkSummaryFlow(t *testing.T) {
+	m := NewModel()
+	m.Screen = ScreenAIFrameworkCategories
+	m.Choices.AITools = []string{"claude"}
+	m.Choices.InstallAIFramework = true
+	m.AICategorySelected = summarySelection()
+	m.Cursor = len(m.GetCurrentOptions()) - 1 // Confirm selection
+
+	result, _ := m.handleAICategoriesKeys("enter")
+	m = result.(Model)
+	if m.Screen != ScreenAIFrameworkSummary {
+		t.Fatalf("confirm should open the summary, got %v", m.Screen)
+	}
+	if !slices.Equal(m.Choices.AIFrameworkModules, []string{"hooks", "skills", "sdd"}) || !m.Choices.InstallAgentTeamsLite {
+		t.Errorf("unexpected choices %v / agent teams %v", m.Choices.AIFrameworkModules, m.Choices.InstallAgentTeamsLite)
+	}
+	view := m.View()
+	for _, want := range []string{"--features=hooks,skills,sdd", "Agent Teams Lite: installed", "Proceed with these modules"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("summary view is missing %q:\n%s", want, view)
+		}
+	}
+
+	// The install step describes the same flags
+	m.SetupInstallSteps()
+	described := false
+	for _, step := range m.Steps {
+		if step.ID == "aiframework" {
+			described = step.Description == "Preset: custom (--features=hooks,skills,sdd)"
+		}
+	}
+	if !described {
+		t.Errorf("the aiframework step should describe the features, got %v", m.Steps)
+	}
+
+	// Back to edit returns to the categories, on Confirm
+	m.Screen = ScreenAIFrameworkSummary
+	m.Cursor = len(m.GetCurrentOptions()) - 1
+	result, _ = m.handleAIFrameworkSummaryKeys("enter")
+	m = result.(Model)
+	if m.Screen != ScreenAIFrameworkCategories || m.Cursor != len(m.GetCurrentOptions())-1 {
+		t.Errorf("back should return to the categories on Confirm, got %v cursor %d", m.Screen, m.Cursor)
+	}
+}
+
+func TestAIFrameworkSummaryBackRestoresFramework(t *testing.T) {
+	m := NewModel()
+	m.Screen = ScreenAIFrameworkCategories
+	m.Choices.AITools = []string{"claude"}
+	m.Choices.InstallAIFramework = true
+	m.AICategorySelected = map[string][]bool{}
+	m.Cursor = len(m.GetCurrentOptions()) - 1
+
+	result, _ := m.handleAICategoriesKeys("enter")
+	m = result.(Model)
+	if m.Screen != ScreenAIFrameworkSummary || m.Choices.InstallAIFramework {
+		t.Fatalf("an empty selection should still be summarized and skip the framework, got %v", m.Screen)
+	}
+
+	result, _ = m.handleEscape()
+	m = result.(Model)
+	if m.Screen != ScreenAIFrameworkCategories || !m.Choices.InstallAIFramework {
+		t.Errorf("esc should reopen the categories with the framework on, got %v", m.Screen)
+	}
+}
@@ -0,0 +1,243 @@
+package tui
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// aiModuleHit is a module matching a search, with where its toggle lives:
+// AICategorySelected[moduleCategories[Category].ID][Item]
+type aiModuleHit struct {
+	Category int // Index into moduleCategories
+	Item     int // Index into the category's Items
+}
+
+// searchModuleItems returns the modules of every category whose label or ID
+// contain each word of query, ignoring case, in registry order
+func searchModuleItems(categories []ModuleCategory, query string) []aiModuleHit {
+	words := strings.Fields(strings.ToLower(query))
+	if len(words) == 0 {
+		return nil
+	}
+	var hits []aiModuleHit
+	for c, cat := range categories {
+		for i, item := range cat.Items {
+			text := strings.ToLower(item.Label + " " + item.ID)
+			matches := true
+			for _, w := range words {
+				if !strings.Contains(text, w) {
+					matches = false
+					break
+				}
+			}
+			if matches {
+				hits = append(hits, aiModuleHit{Category: c, Item: i})
+			}
+		}
+	}
+	return hits
+}
+
+// aiCategoryCounter is a category's row on ScreenAIFrameworkCategories
+func (m Model) aiCategoryCounter(cat ModuleCategory) string {
+	selected := 0
+	for _, b := range m.AICategorySelected[cat.ID] {
+		if b {
+			selected++
+		}
+	}
+	return m.Tf("ai_framework_categories.counter", cat.Icon, cat.Label, selected, len(cat.Items))
+}
+
+// aiModuleSearching reports whether the category list shows search results
+func (m Model) aiModuleSearching() bool {
+	return m.Screen == ScreenAIFrameworkCategories && (m.AIModuleSearchActive || m.AIModuleSearch != "")
+}
+
+// aiModuleSelected reports whether the module of hit is toggled on
+func (m Model) aiModuleSelected(hit aiModuleHit) bool {
+	bools := m.AICategorySelected[moduleCategories[hit.Category].ID]
+	return hit.Item < len(bools) && bools[hit.Item]
+}
+
+// toggleAIModule flips the module of hit in its category's selection
+func (m *Model) toggleAIModule(hit aiModuleHit) {
+	cat := moduleCategories[hit.Category]
+	if m.AICategorySelected == nil {
+		m.AICategorySelected = make(map[string][]bool)
+	}
+	bools := m.AICategorySelected[cat.ID]
+	if len(bools) < len(cat.Items) {
+		bools = append(bools, make([]bool, len(cat.Items)-len(bools))...)
+	}
+	bools[hit.Item] = !bools[hit.Item]
+	m.AICategorySelected[cat.ID] = bools
+}
+
+// aiModuleSearchVisible is how many results fit below the category counters
+func (m Model) aiModuleSearchVisible() int {
+	return m.visibleRows(12 + len(moduleCategories))
+}
+
+// clearAIModuleSearch leaves search mode and shows the categories again
+func (m *Model) clearAIModuleSearch() {
+	m.AIModuleSearch = ""
+	m.AIModuleSearchActive = false
+	m.AIModuleSearchScroll = 0
+	m.Cursor = 0
+}
+
+// handleAIModuleSearchKeys handles "/" on the module categories and every key
+// while they show search results. Enter keeps the query and moves to the
+// results, where space or enter toggles the module under the cursor.
+func (m Model) handleAIModuleSearchKeys(key string) (tea.Model, tea.Cmd) {
+	if !m.aiModuleSearching() {
+		// "/" starts a search
+		m.AIModuleSearchActive = true
+		m.AIModuleSearchScroll = 0
+		m.Cursor = 0
+		return m, nil
+	}
+
+	hits := searchModuleItems(moduleCategories, m.AIModuleSearch)
+	switch key {
+	case "esc":
+		m.clearAIModuleSearch()
+		return m, nil
+	case "up":
+		if m.Cursor > 0 {
+			m.Cursor--
+		}
+	case "down":
+		if m.Cursor < len(hits)-1 {
+			m.Cursor++
+		}
+	case "enter":
+		if m.AIModuleSearchActive {
+			m.AIModuleSearchActive = false
+			return m, nil
+		}
+		if m.Cursor < len(hits) {
+			m.toggleAIModule(hits[m.Cursor])
+		}
+		return m, nil
+	default:
+		if !m.AIModuleSearchActive {
+			switch key {
+			case "k":
+				return m.handleAIModuleSearchKeys("up")
+			case "j":
+				return m.handleAIModuleSearchKeys("down")
+			case " ":
+				if m.Cursor < len(hits) {
+					m.toggleAIModule(hits[m.Cursor])
+				}
+			case "/":
+				m.AIModuleSearchActive = true
+			}
+			return m, nil
+		}
+		if key == "backspace" {
+			r := []rune(m.AIModuleSearch)
+			if len(r) == 0 {
+				return m, nil
+			}
+			m.AIModuleSearch = string(r[:len(r)-1])
+		} else if key == " " || utf8.RuneCountInString(key) == 1 && utf8.RuneCountInString(m.AIModuleSearch) < 40 {
+			m.AIModuleSearch += key
+		} else {
+			return m, nil
+		}
+		m.Cursor = 0
+		m.AIModuleSearchScroll = 0
+		return m, nil
+	}
+
+	// Keep the cursor inside the visible window
+	visible := m.aiModuleSearchVisible()
+	if m.Cursor < m.AIModuleSearchScroll {
+		m.AIModuleSearchScroll = m.Cursor
+	} else if m.Cursor >= m.AIModuleSearchScroll+visible {
+		m.AIModuleSearchScroll = m.Cursor - visible + 1
+	}
+	return m, nil
+}
+
+// renderAIModuleSearch renders the search box, the category counters and the
+// matching modules of every category, in place of the category list
+func (m Model) renderAIModuleSearch() string {
+	var s strings.Builder
+
+	if !m.AIFrameworkApplyMode {
+		s.WriteString(m.renderStepProgress())
+		s.WriteString("\n\n")
+	}
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.T("ai_module_search.desc")))
+	s.WriteString("\n\n")
+
+	if m.AIModuleSearchActive {
+		s.WriteString(m.Theme.Highlight.Render("  / " + m.AIModuleSearch + "█"))
+	} else {
+		s.WriteString(m.Theme.Info.Render("  "+m.Tf("common.search", m.AIModuleSearch)) + m.Theme.Muted.Render("  "+m.T("ai_module_search.edit_hint")))
+	}
+	s.WriteString("\n\n")
+
+	// The counters follow toggles made in the results
+	for _, cat := range moduleCategories {
+		s.WriteString(m.Theme.Muted.Render("  " + m.aiCategoryCounter(cat)))
+		s.WriteString("\n")
+	}
+	s.WriteString("\n")
+
+	hits := searchModuleItems(moduleCategories, m.AIModuleSearch)
+	switch {
+	case m.AIModuleSearch == "":
+		s.WriteString(m.Theme.Muted.Render("  " + m.T("common.type_to_search")))
+		s.WriteString("\n")
+	case len(hits) == 0:
+		s.WriteString(m.Theme.Muted.Render("  " + m.Tf("ai_module_search.no_match", m.AIModuleSearch)))
+		s.WriteString("\n")
+	}
+
+	start := m.AIModuleSearchScroll
+	end := min(start+m.aiModuleSearchVisible(), len(hits))
+	for i := start; i < end; i++ {
+		hit := hits[i]
+		cat := moduleCategories[hit.Category]
+		item := cat.Items[hit.Item]
+		cursor := "  "
+		style := m.Theme.Unselected
+		if i == m.Cursor {
+			cursor = "▸ "
+			style = m.Theme.Selected
+		}
+		check := "[ ] "
+		if m.aiModuleSelected(hit) {
+			check = "[x] "
+		}
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+check+cat.Icon+" "+item.Label))
+		s.WriteString(m.Theme.Muted.Render("  " + item.ID))
+		s.WriteString("\n")
+	}
+
+	if len(hits) > end-start {
+		s.WriteString("\n")
+		s.WriteString(m.Theme.Muted.Render(m.Tf("common.showing", start+1, end, len(hits))))
+		s.WriteString("\n")
+	}
+
+	s.WriteString("\n")
+	if m.AIModuleSearchActive {
+		s.WriteString(m.Theme.Help.Render(m.T("ai_module_search.help_typing")))
+	} else {
+		s.WriteString(m.Theme.Help.Render(m.T("ai_module_search.help")))
+	}
+
+	return s.String()
+}
@@ -0,0 +1,156 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func searchModuleCategories() []ModuleCategory {
+	return []ModuleCategory{
+		{ID: "hooks", Label: "Hooks", Items: []ModuleItem{
+			{ID: "commit-guard", Label: "Commit Guard"},
+			{ID: "secret-scanner", Label: "Secret Scanner"},
+		}},
+		{ID: "agents", Label: "Agents", Items: []ModuleItem{
+			{ID: "orchestrator", Label: "General: Orchestrator"},
+			{ID: "security-auditor", Label: "Quality: Security Auditor"},
+			{ID: "git-workflow", Label: "Workflow: Commits"},
+		}},
+	}
+}
+
+func TestSearchModuleItems(t *testing.T) {
+	tests := []struct {
+		query string
+		want  []aiModuleHit
+	}{
+		{"", nil},
+		{"   ", nil},
+		{"secret", []aiModuleHit{{0, 1}}},
+		{"SECURITY", []aiModuleHit{{1, 1}}},
+		{"commit", []aiModuleHit{{0, 0}, {1, 2}}},
+		{"git", []aiModuleHit{{1, 2}}}, // Matches the ID only
+		{"quality auditor", []aiModuleHit{{1, 1}}},
+		{"nothing here", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			got := searchModuleItems(searchModuleCategories(), tt.query)
+			if len(got) != len(tt.want) {
+				t.Fatalf("hits = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("hit %d = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+// aiSearchModel is on the module categories with nothing selected
+func aiSearchModel() Model {
+	m := NewModel()
+	m.Height = 60
+	m.Screen = ScreenAIFrameworkCategories
+	m.AICategorySelected = make(map[string][]bool)
+	for _, cat := range moduleCategories {
+		m.AICategorySelected[cat.ID] = make([]bool, len(cat.Items))
+	}
+	return m
+}
+
+func aiSearchKeys(m Model, keys ...string) Model {
+	for _, k := range keys {
+		var msg tea.KeyMsg
+		switch k {
+		case "enter":
+			msg = tea.KeyMsg{Type: tea.KeyEnter}
+		case "esc":
+			msg = tea.KeyMsg{Type: tea.KeyEsc}
+		case "down":
+			msg = tea.KeyMsg{Type: tea.KeyDown}
+		case " ":
+			msg = tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}
+		default:
+			msg = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+		}
+		result, _ := m.Update(msg)
+		m = result.(Model)
+	}
+	return m
+}
+
+func TestAIModuleSearchToggleMapping(t *testing.T) {
+	m := aiSearchModel()
+	m = aiSearchKeys(m, "/", "g", "i", "t", "enter")
+	hits := searchModuleItems(moduleCategories, "git")
+	cats := map[int]bool{}
+	for _, hit := range hits {
+		cats[hit.Category] = true
+	}
+	if len(cats) < 2 {
+		t.Fatalf("the query should span several categories, got %v", hits)
+	}
+
+	// Toggle every result, one at a time, and check only its own slot flipped
+	for i, hit := range hits {
+		m.Cursor = i
+		m = aiSearchKeys(m, " ")
+		for c, cat := range moduleCategories {
+			for idx, on := range m.AICategorySelected[cat.ID] {
+				want := false
+				for _, done := range hits[:i+1] {
+					if done.Category == c && done.Item == idx {
+						want = true
+					}
+				}
+				if on != want {
+					t.Fatalf("after toggling %s/%s, %s[%d] = %v, want %v",
+						moduleCategories[hit.Category].ID, moduleCategories[hit.Category].Items[hit.Item].ID, cat.ID, idx, on, want)
+				}
+			}
+		}
+	}
+
+	// Enter toggles too, so the last result goes back off
+	m = aiSearchKeys(m, "enter")
+	last := hits[len(hits)-1]
+	if m.aiModuleSelected(last) {
+		t.Error("enter on a result should toggle it off again")
+	}
+}
+
+func TestAIModuleSearchCountersAndEsc(t *testing.T) {
+	m := aiSearchModel()
+	m = aiSearchKeys(m, "/", "s", "e", "c", "r", "e", "t", " ", "s")
+	if m.LeaderMode || m.AIModuleSearch != "secret s" {
+		t.Fatalf("typing should build the query, got %q (leader=%v)", m.AIModuleSearch, m.LeaderMode)
+	}
+	m = aiSearchKeys(m, "enter", " ")
+
+	hooks := moduleCategories[0]
+	counter := m.aiCategoryCounter(hooks)
+	if !strings.Contains(counter, "(1/") {
+		t.Errorf("the counter should count the toggled result, got %q", counter)
+	}
+	if view := m.View(); !strings.Contains(view, counter) || !strings.Contains(view, "[x]") {
+		t.Errorf("the search view should show the live counter and the checked result:\n%s", view)
+	}
+
+	m = aiSearchKeys(m, "esc")
+	if m.aiModuleSearching() || m.Screen != ScreenAIFrameworkCategories || m.Cursor != 0 {
+		t.Fatalf("esc should close the search and stay on the categories, got %v", m.Screen)
+	}
+	if opts := m.GetCurrentOptions(); opts[0] != counter {
+		t.Errorf("the category list should keep the toggle, got %q", opts[0])
+	}
+
+	// Space on the plain category list still opens the leader menu
+	m = aiSearchKeys(m, " ")
+	if !m.LeaderMode {
+		t.Error("space outside the search should activate leader mode")
+	}
+}
@@ -0,0 +1,53 @@
+package tui
+
+import "strings"
+
+// aiPresetRule recommends a framework preset for an AI tool selection. With
+// Only set, the selection must be exactly Tools; otherwise it must include them.
+type aiPresetRule struct {
+	Tools  []string
+	Only   bool
+	Preset string
+	Reason string
+}
+
+// aiPresetRules are checked in order; the first match wins
+var aiPresetRules = []aiPresetRule{
+	{Tools: []string{"copilot"}, Only: true, Preset: "frontend", Reason: "Copilot is mostly used for editor-side UI work"},
+	{Tools: []string{"claude", "opencode"}, Preset: "fullstack", Reason: "Claude Code and OpenCode together cover frontend and backend work"},
+}
+
+// defaultAIPresetRule applies when no rule matches
+var defaultAIPresetRule = aiPresetRule{Preset: "minimal", Reason: "core modules and git commands, easy to extend later"}
+
+// matches reports whether the rule applies to the selected tools
+func (r aiPresetRule) matches(tools []string) bool {
+	selected := make(map[string]bool, len(tools))
+	for _, t := range tools {
+		selected[t] = true
+	}
+	for _, t := range r.Tools {
+		if !selected[t] {
+			return false
+		}
+	}
+	return !r.Only || len(selected) == len(r.Tools)
+}
+
+// recommendAIPreset picks the preset for the "Recommended for my selection" option
+func recommendAIPreset(tools []string) aiPresetRule {
+	for _, rule := range aiPresetRules {
+		if rule.matches(tools) {
+			return rule
+		}
+	}
+	return defaultAIPresetRule
+}
+
+// presetTitle capitalizes a preset ID for display ("fullstack" → "Fullstack")
+func presetTitle(preset string) string {
+	if preset == "" {
+		return ""
+	}
+	return strings.ToUpper(preset[:1]) + preset[1:]
+}
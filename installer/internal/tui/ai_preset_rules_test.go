@@ -0,0 +1,37 @@
+package tui
+
+import "testing"
+
+func TestRecommendAIPreset(t *testing.T) {
+	tests := []struct {
+		name  string
+		tools []string
+		want  string
+	}{
+		{"only Copilot", []string{"copilot"}, "frontend"},
+		{"Copilot with another tool", []string{"copilot", "gemini"}, "minimal"},
+		{"Claude and OpenCode", []string{"claude", "opencode"}, "fullstack"},
+		{"Claude, OpenCode and more", []string{"opencode", "codex", "claude"}, "fullstack"},
+		{"only Claude", []string{"claude"}, "minimal"},
+		{"no tools", nil, "minimal"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := recommendAIPreset(tt.tools)
+			if rule.Preset != tt.want {
+				t.Errorf("recommendAIPreset(%v) = %q, want %q", tt.tools, rule.Preset, tt.want)
+			}
+			if rule.Reason == "" {
+				t.Error("every recommendation should explain itself")
+			}
+		})
+	}
+
+	t.Run("rules only name valid presets", func(t *testing.T) {
+		for _, rule := range append(aiPresetRules, defaultAIPresetRule) {
+			if rule.Preset == "" || !validPresetChoices[rule.Preset] {
+				t.Errorf("rule %v recommends unknown preset %q", rule.Tools, rule.Preset)
+			}
+		}
+	})
+}
@@ -1,6 +1,7 @@
 package tui
 
 import (
+	"os"
 	"strings"
 	"testing"
 
@@ -52,8 +53,11 @@ func TestAIFrameworkConfirmOptions(t *testing.T) {
 	m.Screen = ScreenAIFrameworkConfirm
 	opts := m.GetCurrentOptions()
 
-	if len(opts) != 2 {
-		t.Fatalf("Expected 2 framework confirm options, got %d", len(opts))
+	if len(opts) != 3 {
+		t.Fatalf("Expected 3 framework confirm options, got %d", len(opts))
+	}
+	if !strings.Contains(opts[0], "Recommended for my selection") || !strings.Contains(opts[1], "Customize") {
+		t.Errorf("Expected Recommended then Customize, got %v", opts)
 	}
 }
 
@@ -93,9 +97,9 @@ func TestAIFrameworkPresetSelection(t *testing.T) {
 		if newModel.Choices.AIFrameworkPreset != preset {
 			t.Errorf("Cursor %d: expected preset %q, got %q", i+2, preset, newModel.Choices.AIFrameworkPreset)
 		}
-		// Should proceed to backup/install
-		if newModel.Screen != ScreenBackupConfirm && newModel.Screen != ScreenInstalling {
-			t.Errorf("Preset %s: expected ScreenBackupConfirm or ScreenInstalling, got %v", preset, newModel.Screen)
+		// Should end the wizard on the review
+		if newModel.Screen != ScreenWizardReview {
+			t.Errorf("Preset %s: expected ScreenWizardReview, got %v", preset, newModel.Screen)
 		}
 	}
 }
@@ -680,14 +684,14 @@ func TestAIToolsToggle(t *testing.T) {
 	m.Cursor = 0 // Claude Code
 
 	// Toggle on
-	result, _ := m.handleAIToolsKeys("enter")
+	result, _ := m.handleAIToolsKeys(" ")
 	newModel := result.(Model)
 	if !newModel.AIToolSelected[0] {
 		t.Error("Expected tool 0 (Claude Code) to be toggled ON")
 	}
 
 	// Toggle off
-	result, _ = newModel.handleAIToolsKeys("enter")
+	result, _ = newModel.handleAIToolsKeys(" ")
 	newModel = result.(Model)
 	if newModel.AIToolSelected[0] {
 		t.Error("Expected tool 0 (Claude Code) to be toggled OFF")
@@ -779,9 +783,9 @@ func TestAIToolsSelectNone(t *testing.T) {
 	if len(newModel.Choices.AITools) != 0 {
 		t.Errorf("Expected no AI tools, got %v", newModel.Choices.AITools)
 	}
-	// Should skip framework and go to backup/install
-	if newModel.Screen != ScreenBackupConfirm && newModel.Screen != ScreenInstalling {
-		t.Errorf("Expected ScreenBackupConfirm or ScreenInstalling, got %v", newModel.Screen)
+	// Should skip framework and go to the review
+	if newModel.Screen != ScreenWizardReview {
+		t.Errorf("Expected ScreenWizardReview, got %v", newModel.Screen)
 	}
 }
 
@@ -789,7 +793,7 @@ func TestAIFrameworkConfirmYes(t *testing.T) {
 	m := NewModel()
 	m.Screen = ScreenAIFrameworkConfirm
 	m.Choices.AITools = []string{"claude"}
-	m.Cursor = 0 // Yes
+	m.Cursor = 1 // Customize
 
 	result, _ := m.handleSelection()
 	newModel := result.(Model)
@@ -806,7 +810,7 @@ func TestAIFrameworkConfirmNo(t *testing.T) {
 	m := NewModel()
 	m.Screen = ScreenAIFrameworkConfirm
 	m.Choices.AITools = []string{"claude"}
-	m.Cursor = 1 // No
+	m.Cursor = 2 // No
 
 	result, _ := m.handleSelection()
 	newModel := result.(Model)
@@ -814,9 +818,9 @@ func TestAIFrameworkConfirmNo(t *testing.T) {
 	if newModel.Choices.InstallAIFramework {
 		t.Error("Expected InstallAIFramework to be false")
 	}
-	// Should go to backup/install
-	if newModel.Screen != ScreenBackupConfirm && newModel.Screen != ScreenInstalling {
-		t.Errorf("Expected ScreenBackupConfirm or ScreenInstalling, got %v", newModel.Screen)
+	// Should go to the review
+	if newModel.Screen != ScreenWizardReview {
+		t.Errorf("Expected ScreenWizardReview, got %v", newModel.Screen)
 	}
 }
 
@@ -1656,12 +1660,12 @@ func TestCategoryItemsScrollResetOnScreenChange(t *testing.T) {
 }
 
 // ==========================================================================
-// handleEscape from BackupConfirm Tests
+// handleEscape from WizardReview Tests
 // ==========================================================================
 
-func TestHandleEscapeFromBackupConfirmToCategories(t *testing.T) {
+func TestHandleEscapeFromWizardReviewToSummary(t *testing.T) {
 	m := NewModel()
-	m.Screen = ScreenBackupConfirm
+	m.Screen = ScreenWizardReview
 	m.Choices.AITools = []string{"claude"}
 	m.Choices.InstallAIFramework = true
 	m.AICategorySelected = make(map[string][]bool) // Custom mode
@@ -1669,14 +1673,14 @@ func TestHandleEscapeFromBackupConfirmToCategories(t *testing.T) {
 	result, _ := m.handleEscape()
 	newModel := result.(Model)
 
-	if newModel.Screen != ScreenAIFrameworkCategories {
-		t.Errorf("Expected ScreenAIFrameworkCategories, got %v", newModel.Screen)
+	if newModel.Screen != ScreenAIFrameworkSummary {
+		t.Errorf("Expected ScreenAIFrameworkSummary, got %v", newModel.Screen)
 	}
 }
 
-func TestHandleEscapeFromBackupConfirmToPreset(t *testing.T) {
+func TestHandleEscapeFromWizardReviewToPreset(t *testing.T) {
 	m := NewModel()
-	m.Screen = ScreenBackupConfirm
+	m.Screen = ScreenWizardReview
 	m.Choices.AITools = []string{"claude"}
 	m.Choices.InstallAIFramework = true
 	m.AICategorySelected = nil // Preset mode (not custom)
@@ -1689,9 +1693,9 @@ func TestHandleEscapeFromBackupConfirmToPreset(t *testing.T) {
 	}
 }
 
-func TestHandleEscapeFromBackupConfirmToFrameworkConfirm(t *testing.T) {
+func TestHandleEscapeFromWizardReviewToFrameworkConfirm(t *testing.T) {
 	m := NewModel()
-	m.Screen = ScreenBackupConfirm
+	m.Screen = ScreenWizardReview
 	m.Choices.AITools = []string{"claude"}
 	m.Choices.InstallAIFramework = false
 
@@ -1703,9 +1707,9 @@ func TestHandleEscapeFromBackupConfirmToFrameworkConfirm(t *testing.T) {
 	}
 }
 
-func TestHandleEscapeFromBackupConfirmToAITools(t *testing.T) {
+func TestHandleEscapeFromWizardReviewToAITools(t *testing.T) {
 	m := NewModel()
-	m.Screen = ScreenBackupConfirm
+	m.Screen = ScreenWizardReview
 	m.Choices.AITools = nil // No AI tools
 
 	result, _ := m.handleEscape()
@@ -1717,63 +1721,24 @@ func TestHandleEscapeFromBackupConfirmToAITools(t *testing.T) {
 }
 
 // ==========================================================================
-// handleBackupConfirmKeys esc Tests (same logic, different entry point)
+// handleBackupConfirmKeys esc Tests
 // ==========================================================================
 
-func TestBackupConfirmEscToCategories(t *testing.T) {
+func TestBackupConfirmEscToWizardReview(t *testing.T) {
 	m := NewModel()
 	m.Screen = ScreenBackupConfirm
 	m.Choices.AITools = []string{"claude"}
 	m.Choices.InstallAIFramework = true
-	m.AICategorySelected = make(map[string][]bool)
+	m.Choices.AIFrameworkPreset = "fullstack"
 
 	result, _ := m.handleBackupConfirmKeys("esc")
 	newModel := result.(Model)
 
-	if newModel.Screen != ScreenAIFrameworkCategories {
-		t.Errorf("Expected ScreenAIFrameworkCategories, got %v", newModel.Screen)
+	if newModel.Screen != ScreenWizardReview {
+		t.Errorf("Expected ScreenWizardReview, got %v", newModel.Screen)
 	}
-}
-
-func TestBackupConfirmEscToPreset(t *testing.T) {
-	m := NewModel()
-	m.Screen = ScreenBackupConfirm
-	m.Choices.AITools = []string{"claude"}
-	m.Choices.InstallAIFramework = true
-	m.AICategorySelected = nil
-
-	result, _ := m.handleBackupConfirmKeys("esc")
-	newModel := result.(Model)
-
-	if newModel.Screen != ScreenAIFrameworkPreset {
-		t.Errorf("Expected ScreenAIFrameworkPreset, got %v", newModel.Screen)
-	}
-}
-
-func TestBackupConfirmEscToFrameworkConfirm(t *testing.T) {
-	m := NewModel()
-	m.Screen = ScreenBackupConfirm
-	m.Choices.AITools = []string{"opencode"}
-	m.Choices.InstallAIFramework = false
-
-	result, _ := m.handleBackupConfirmKeys("esc")
-	newModel := result.(Model)
-
-	if newModel.Screen != ScreenAIFrameworkConfirm {
-		t.Errorf("Expected ScreenAIFrameworkConfirm, got %v", newModel.Screen)
-	}
-}
-
-func TestBackupConfirmEscToAITools(t *testing.T) {
-	m := NewModel()
-	m.Screen = ScreenBackupConfirm
-	m.Choices.AITools = nil
-
-	result, _ := m.handleBackupConfirmKeys("esc")
-	newModel := result.(Model)
-
-	if newModel.Screen != ScreenAIToolsSelect {
-		t.Errorf("Expected ScreenAIToolsSelect, got %v", newModel.Screen)
+	if newModel.Choices.AIFrameworkPreset != "fullstack" {
+		t.Errorf("Going back to the review should keep the choices, got preset %q", newModel.Choices.AIFrameworkPreset)
 	}
 }
 
@@ -1846,7 +1811,7 @@ func TestAIToolsToggleWithNilSelected(t *testing.T) {
 	m.Cursor = 0
 
 	// Should not panic
-	result, _ := m.handleAIToolsKeys("enter")
+	result, _ := m.handleAIToolsKeys(" ")
 	newModel := result.(Model)
 	_ = newModel
 }
@@ -1943,12 +1908,12 @@ func TestTermuxSkipsAIScreens(t *testing.T) {
 	result, _ := m.handleSelection()
 	newModel := result.(Model)
 
-	// Should skip AI tools and go straight to backup/install
+	// Should skip AI tools and go straight to the review
 	if newModel.Screen == ScreenAIToolsSelect {
 		t.Error("Termux should skip AI tools screen")
 	}
-	if newModel.Screen != ScreenBackupConfirm && newModel.Screen != ScreenInstalling {
-		t.Errorf("Expected ScreenBackupConfirm or ScreenInstalling for Termux, got %v", newModel.Screen)
+	if newModel.Screen != ScreenWizardReview {
+		t.Errorf("Expected ScreenWizardReview for Termux, got %v", newModel.Screen)
 	}
 }
 
@@ -2028,9 +1993,8 @@ func TestRenderCategoryItemsScrollUpIndicator(t *testing.T) {
 // Space Key vs Leader Mode Tests
 // ==========================================================================
 
-func TestSpaceActivatesLeaderModeOnAIScreens(t *testing.T) {
-	// Space should activate leader mode on AI screens, NOT toggle items
-	// This verifies that handleAIToolsKeys " " branch is unreachable via Update()
+func TestSpaceTogglesOnAIScreens(t *testing.T) {
+	// Space toggles on multi-selects instead of opening the leader menu
 	m := NewModel()
 	m.Screen = ScreenAIToolsSelect
 	m.AIToolSelected = make([]bool, len(aiToolIDMap))
@@ -2039,16 +2003,15 @@ func TestSpaceActivatesLeaderModeOnAIScreens(t *testing.T) {
 	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{' '}})
 	newModel := result.(Model)
 
-	// Space should have activated leader mode, NOT toggled the tool
-	if !newModel.LeaderMode {
-		t.Error("Space should activate leader mode on AI tools screen")
+	if newModel.LeaderMode {
+		t.Error("Space should not activate leader mode on AI tools screen")
 	}
-	if newModel.AIToolSelected[0] {
-		t.Error("Space should NOT toggle AI tool selection (leader mode intercepts)")
+	if !newModel.AIToolSelected[0] {
+		t.Error("Space should toggle AI tool selection")
 	}
 }
 
-func TestSpaceActivatesLeaderModeOnCategoryItems(t *testing.T) {
+func TestSpaceTogglesOnCategoryItems(t *testing.T) {
 	m := NewModel()
 	m.Screen = ScreenAIFrameworkCategoryItems
 	m.SelectedModuleCategory = 0
@@ -2062,11 +2025,11 @@ func TestSpaceActivatesLeaderModeOnCategoryItems(t *testing.T) {
 	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{' '}})
 	newModel := result.(Model)
 
-	if !newModel.LeaderMode {
-		t.Error("Space should activate leader mode on category items screen")
+	if newModel.LeaderMode {
+		t.Error("Space should not activate leader mode on category items screen")
 	}
-	if newModel.AICategorySelected["hooks"][0] {
-		t.Error("Space should NOT toggle item selection (leader mode intercepts)")
+	if !newModel.AICategorySelected["hooks"][0] {
+		t.Error("Space should toggle item selection")
 	}
 }
 
@@ -2147,3 +2110,121 @@ func TestViewDispatchCategoryItems(t *testing.T) {
 		t.Error("View() for category items should contain category name 'Hooks'")
 	}
 }
+
+// fakeAIToolCommands makes only the given commands look installed to the AI
+// tools availability check and install step
+func fakeAIToolCommands(t *testing.T, onPath ...string) {
+	t.Helper()
+	original := aiToolCommandExists
+	aiToolCommandExists = func(cmd string) bool {
+		for _, c := range onPath {
+			if c == cmd {
+				return true
+			}
+		}
+		return false
+	}
+	t.Cleanup(func() { aiToolCommandExists = original })
+}
+
+func TestAIToolsAvailability(t *testing.T) {
+	fakeAIToolCommands(t, "claude", "curl")
+	m := NewModel()
+	m.Choices.OS = "linux"
+	m.Screen = ScreenZedSelect
+	m.Cursor = 1
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = result.(Model)
+
+	opts := m.GetCurrentOptions()
+	want := []string{"Claude Code (already installed)", "OpenCode", "Gemini CLI (unavailable: needs npm)", "GitHub Copilot", "Codex CLI (unavailable: needs npm)", "Qwen Code (unavailable: needs npm)"}
+	for i, w := range want {
+		if opts[i] != w {
+			t.Errorf("option %d = %q, want %q", i, opts[i], w)
+		}
+	}
+
+	// An unavailable tool can't be toggled and says why
+	m.Cursor = 2
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	m = result.(Model)
+	if m.AIToolSelected[2] {
+		t.Error("Gemini CLI should not be selectable without npm")
+	}
+	if !strings.Contains(m.Toast, "install npm first") {
+		t.Errorf("toast = %q", m.Toast)
+	}
+
+	// Select All picks only the available ones, installed included
+	m.Cursor = 7
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	m = result.(Model)
+	if got := flowBools(m.AIToolSelected); got != "xx.x.." {
+		t.Errorf("Select All = %s, want xx.x..", got)
+	}
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	if got := flowBools(result.(Model).AIToolSelected); got != "......" {
+		t.Errorf("a second Select All = %s, want ......", got)
+	}
+}
+
+func TestAIToolsAvailabilityMatrix(t *testing.T) {
+	tests := []struct {
+		name   string
+		os     string
+		nvim   bool
+		onPath []string
+		want   string // "x" available, "." unavailable, per tool
+	}{
+		{"nothing on PATH", "linux", false, nil, "......"},
+		{"curl only", "mac", false, []string{"curl"}, "xx.x.."},
+		{"neovim brings npm", "linux", true, []string{"curl"}, "xxxxxx"},
+		{"windows installs everything with npm", "windows", false, []string{"curl"}, "......"},
+		{"windows with neovim", "windows", true, nil, "xxxxxx"},
+		{"installed tools stay available", "linux", false, []string{"codex", "qwen"}, "....xx"},
+		{"nothing runs on termux", "termux", true, []string{"curl", "npm", "claude"}, "......"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeAIToolCommands(t, tt.onPath...)
+			m := NewModel()
+			m.Choices.OS = tt.os
+			m.Choices.InstallNvim = tt.nvim
+			m = m.enterAIToolsSelect()
+			var got strings.Builder
+			for i := range aiToolIDMap {
+				if m.aiToolAvailable(i) {
+					got.WriteByte('x')
+				} else {
+					got.WriteByte('.')
+				}
+			}
+			if got.String() != tt.want {
+				t.Errorf("available = %s, want %s", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestAIToolsInstallSkipsInstalledTools(t *testing.T) {
+	useTestLog(t)
+	fakeAIToolCommands(t, "gemini")
+	m := NewModel()
+	m.StateHome = t.TempDir()
+	m.openInstallLog()
+
+	if !aiToolPresent("aitools", "gemini", "Gemini CLI") {
+		t.Error("an installed tool should be reported present")
+	}
+	if aiToolPresent("aitools", "codex", "Codex CLI") {
+		t.Error("a missing tool should be installed")
+	}
+	activeLog.Close()
+	data, err := os.ReadFile(m.LogPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if log := string(data); !strings.Contains(log, "Gemini CLI already installed, skipping its install") || strings.Contains(log, "Codex") {
+		t.Errorf("only the skipped install should be logged:\n%s", log)
+	}
+}
@@ -0,0 +1,119 @@
+package tui
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// backupLabel describes a backup in the restore list: when it was taken, how
+// many items it holds and, once sized, how much disk it uses
+func backupLabel(b system.BackupInfo) string {
+	label := fmt.Sprintf("%s (%d items", b.Timestamp.Format("2006-01-02 15:04:05"), len(b.Files))
+	if b.Size >= 0 {
+		label += ", " + system.FormatBytes(b.Size)
+	}
+	return label + ")"
+}
+
+// leaveBackupPrune returns to the backup list with the cursor on the prune row
+func (m Model) leaveBackupPrune() Model {
+	m.Screen = ScreenRestoreBackup
+	m.Cursor = len(m.AvailableBackups) + 1
+	return m
+}
+
+func (m Model) handleBackupPruneKeys(key string) (tea.Model, tea.Cmd) {
+	options := m.canonicalOptions()
+
+	switch key {
+	case "up", "k":
+		if m.Cursor > 0 {
+			m.Cursor--
+		}
+	case "down", "j":
+		if m.Cursor < len(options)-1 {
+			m.Cursor++
+		}
+	case "enter", " ":
+		if m.Cursor != 0 {
+			return m.leaveBackupPrune(), nil
+		}
+		var freed int64
+		for _, b := range system.BackupsToPrune(m.AvailableBackups, m.BackupKeep) {
+			if b.Size > 0 {
+				freed += b.Size
+			}
+		}
+		removed, err := system.PruneBackups(m.AvailableBackups, m.BackupKeep)
+		m.AvailableBackups = system.ListBackups()
+		m.SelectedBackup = 0
+		if err != nil {
+			m.Screen = ScreenError
+			m.ErrorMsg = m.Tf("error.prune_failed", len(removed), err)
+			return m, nil
+		}
+		m.BackupNotice = fmt.Sprintf("🧹 Deleted %d old backup(s)", len(removed))
+		if freed > 0 {
+			m.BackupNotice += ", freed " + system.FormatBytes(freed)
+		}
+		m.Screen = ScreenRestoreBackup
+		m.Cursor = 0
+		return m, backupDiskSizesCmd(m.AvailableBackups)
+	}
+
+	return m, nil
+}
+
+func (m Model) renderBackupPrune() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
+	s.WriteString("\n\n")
+
+	s.WriteString(m.Theme.Subtitle.Render("Will be deleted:"))
+	s.WriteString("\n")
+	var total int64
+	sized := true
+	for _, b := range system.BackupsToPrune(m.AvailableBackups, m.BackupKeep) {
+		size := "sizing…"
+		if b.Size >= 0 {
+			size = system.FormatBytes(b.Size)
+			total += b.Size
+		} else {
+			sized = false
+		}
+		s.WriteString(m.Theme.Info.Render(fmt.Sprintf("  • %s  %s", filepath.Base(b.Path), size)))
+		s.WriteString("\n")
+	}
+	if sized {
+		s.WriteString(m.Theme.Muted.Render("  Frees " + system.FormatBytes(total)))
+		s.WriteString("\n")
+	}
+
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Warning.Render("⚠️  Deleted backups can't be restored!"))
+	s.WriteString("\n\n")
+
+	for i, opt := range m.GetCurrentOptions() {
+		cursor := "  "
+		style := m.Theme.Unselected
+		if i == m.Cursor {
+			cursor = "▸ "
+			style = m.Theme.Selected
+		}
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
+		s.WriteString("\n")
+	}
+
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render("↑/k up • ↓/j down • [Enter] select • [Esc] cancel"))
+
+	return s.String()
+}
@@ -0,0 +1,114 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// writeBackups creates n backups of 1KB each under a temp HOME, oldest first
+func writeBackups(t *testing.T, n int) []string {
+	t.Helper()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	var dirs []string
+	for i := range n {
+		stamp := start.Add(time.Duration(i) * time.Hour)
+		dir := filepath.Join(home, ".gentleman-backup-"+stamp.Format("20060102-150405"))
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "tmux"), make([]byte, 1024), 0644); err != nil {
+			t.Fatal(err)
+		}
+		os.Chtimes(dir, stamp, stamp)
+		dirs = append(dirs, dir)
+	}
+	return dirs
+}
+
+func TestBackupSizesLoadInBackground(t *testing.T) {
+	writeBackups(t, 2)
+	m := NewModel()
+	m.AvailableBackups = system.ListBackups()
+
+	if opt := restoreBackupOptions(m)[0]; strings.Contains(opt, "KB") {
+		t.Errorf("sizes should be left out until computed, got %q", opt)
+	}
+
+	cmd := backupDiskSizesCmd(m.AvailableBackups)
+	if cmd == nil {
+		t.Fatal("expected a command to size the backups")
+	}
+	result, _ := m.Update(cmd())
+	m = result.(Model)
+	if opt := restoreBackupOptions(m)[0]; !strings.Contains(opt, "1 items, 1.0KB") {
+		t.Errorf("expected the size in the label, got %q", opt)
+	}
+}
+
+// restoreBackupOptions returns the options of the backup list
+func restoreBackupOptions(m Model) []string {
+	m.Screen = ScreenRestoreBackup
+	return m.GetCurrentOptions()
+}
+
+func TestPruneBackupsFlow(t *testing.T) {
+	dirs := writeBackups(t, 4)
+	m := NewModel()
+	m.BackupKeep = 2
+	m.AvailableBackups = system.ComputeBackupSizes(system.ListBackups())
+	m.Screen = ScreenRestoreBackup
+
+	options := m.GetCurrentOptions()
+	m.Cursor = len(m.AvailableBackups) + 1
+	if !strings.Contains(options[m.Cursor], "keep 2, delete 2") {
+		t.Fatalf("expected the prune row after the separator, got %q", options)
+	}
+	result, _ := m.handleRestoreBackupKeys("enter")
+	m = result.(Model)
+	if m.Screen != ScreenBackupPrune {
+		t.Fatalf("expected ScreenBackupPrune, got %d", m.Screen)
+	}
+	view := ansi.Strip(m.renderBackupPrune())
+	for _, want := range []string{filepath.Base(dirs[0]), filepath.Base(dirs[1]), "Frees 2.0KB"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("the confirmation should show %q:\n%s", want, view)
+		}
+	}
+	if strings.Contains(view, filepath.Base(dirs[3])) {
+		t.Error("the newest backup must not be listed for deletion")
+	}
+
+	// Esc goes back to the prune row without deleting anything
+	back, _ := m.handleEscape()
+	if nm := back.(Model); nm.Screen != ScreenRestoreBackup || nm.Cursor != 5 {
+		t.Errorf("esc should return to the prune row, got screen %d cursor %d", nm.Screen, nm.Cursor)
+	}
+
+	result, cmd := m.handleBackupPruneKeys("enter")
+	m = result.(Model)
+	if m.Screen != ScreenRestoreBackup || cmd == nil {
+		t.Fatalf("expected the list again with sizes reloading, got %d", m.Screen)
+	}
+	if len(m.AvailableBackups) != 2 || !strings.Contains(m.BackupNotice, "Deleted 2 old backup(s), freed 2.0KB") {
+		t.Errorf("expected 2 backups left and a notice, got %d and %q", len(m.AvailableBackups), m.BackupNotice)
+	}
+	for i, dir := range dirs {
+		if _, err := os.Stat(dir); (err == nil) != (i >= 2) {
+			t.Errorf("%s: exists = %v", filepath.Base(dir), err == nil)
+		}
+	}
+	for _, opt := range m.GetCurrentOptions() {
+		if strings.Contains(opt, "Prune") {
+			t.Error("nothing is left to prune, so the row should be gone")
+		}
+	}
+}
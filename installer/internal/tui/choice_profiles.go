@@ -0,0 +1,503 @@
+package tui
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// choiceProfile is a set of wizard choices saved under a name, so later
+// installs (on this machine or another) can skip the wizard
+type choiceProfile struct {
+	Name    string      `json:"name"`
+	SavedAt time.Time   `json:"saved_at"`
+	Choices UserChoices `json:"choices"`
+}
+
+// choiceProfileEntry is one file in the profiles dir; Profile is nil when it
+// couldn't be loaded, and Err says why
+type choiceProfileEntry struct {
+	Name    string
+	Path    string
+	Profile *choiceProfile
+	Err     error
+}
+
+const maxProfileNameLen = 64
+
+func choiceProfilesDir(home string) string {
+	return filepath.Join(paths.DataDir(home), "profiles")
+}
+
+func choiceProfilePath(home, name string) string {
+	return filepath.Join(choiceProfilesDir(home), name+".json")
+}
+
+// validateProfileName accepts names that are safe as a file name everywhere:
+// letters, digits, '-', '_' and '.', not starting with a dot
+func validateProfileName(name string) error {
+	if name == "" {
+		return errors.New("name cannot be empty")
+	}
+	if len(name) > maxProfileNameLen {
+		return fmt.Errorf("name is too long (max %d characters)", maxProfileNameLen)
+	}
+	if strings.HasPrefix(name, ".") {
+		return errors.New("name cannot start with a dot")
+	}
+	for _, r := range name {
+		ok := r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_' || r == '.'
+		if !ok {
+			return fmt.Errorf("name can only use letters, digits, '-', '_' and '.' (found %q)", r)
+		}
+	}
+	return nil
+}
+
+// saveChoiceProfile writes choices as the profile name under home, replacing
+// an older profile with the same name, and returns the file written
+func saveChoiceProfile(home, name string, choices UserChoices) (string, error) {
+	if err := validateProfileName(name); err != nil {
+		return "", err
+	}
+	// Project init and framework removals belong to one run, not to a machine setup
+	choices.AIFrameworkRemove = nil
+	choices.InitProject = false
+	choices.ProjectPath = ""
+	choices.ProjectStack = ""
+	choices.ProjectMemory = ""
+	choices.ProjectCI = ""
+	choices.ProjectEngram = false
+	choices.ProjectRolePacks = nil
+	choices.InstallObsidian = false
+	// Mirrors depend on the network the install runs on
+	choices.Mirrors = nil
+
+	data, err := json.MarshalIndent(choiceProfile{Name: name, SavedAt: time.Now(), Choices: choices}, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	path := choiceProfilePath(home, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// loadChoiceProfile reads the profile at path. The file name is the profile
+// name, whatever the file itself says.
+func loadChoiceProfile(path string) (*choiceProfile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var p choiceProfile
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("not a valid profile: %w", err)
+	}
+	if p.Choices.OS == "" && p.Choices.Shell == "" {
+		return nil, errors.New("not a valid profile: no choices saved")
+	}
+	p.Name = strings.TrimSuffix(filepath.Base(path), ".json")
+	return &p, nil
+}
+
+// listChoiceProfiles loads every profile under home, sorted by name. Files
+// that fail to load are listed with their error.
+func listChoiceProfiles(home string) []choiceProfileEntry {
+	files, err := filepath.Glob(filepath.Join(choiceProfilesDir(home), "*.json"))
+	if err != nil {
+		return nil
+	}
+	sort.Strings(files)
+	entries := make([]choiceProfileEntry, 0, len(files))
+	for _, path := range files {
+		p, err := loadChoiceProfile(path)
+		entries = append(entries, choiceProfileEntry{
+			Name:    strings.TrimSuffix(filepath.Base(path), ".json"),
+			Path:    path,
+			Profile: p,
+			Err:     err,
+		})
+	}
+	return entries
+}
+
+// choicesSummary describes choices in one line, e.g.
+// "mac · ghostty · fish · zellij · nvim · claude + opencode (fullstack)"
+func choicesSummary(c UserChoices) string {
+	parts := []string{c.OS}
+	if c.Terminal != "" && c.Terminal != "none" {
+		parts = append(parts, c.Terminal)
+	}
+	parts = append(parts, c.Shell)
+	if c.WindowMgr != "" && c.WindowMgr != "none" {
+		parts = append(parts, c.WindowMgr)
+	}
+	if c.InstallNvim {
+		parts = append(parts, "nvim")
+	}
+	if c.InstallZed {
+		parts = append(parts, "zed")
+	}
+	if len(c.AITools) > 0 {
+		tools := strings.Join(c.AITools, " + ")
+		if c.InstallAIFramework && c.AIFrameworkPreset != "" {
+			tools += " (" + c.AIFrameworkPreset + ")"
+		}
+		parts = append(parts, tools)
+	}
+	return strings.Join(parts, " · ")
+}
+
+// detectedOSChoice is the wizard OS choice matching the detected platform
+func detectedOSChoice(info *system.SystemInfo) string {
+	switch {
+	case info == nil:
+		return ""
+	case info.IsTermux:
+		return "termux"
+	case info.OS == system.OSMac:
+		return "mac"
+	case info.OS == system.OSWindows:
+		return "windows"
+	}
+	return "linux"
+}
+
+// profileIssues lists why choices don't fit the machine described by info
+func profileIssues(c UserChoices, info *system.SystemInfo) []string {
+	var issues []string
+	if detected := detectedOSChoice(info); detected != "" && c.OS != detected {
+		issues = append(issues, fmt.Sprintf("saved for %s, but this machine is %s", c.OS, detected))
+	}
+	for _, ce := range ValidateChoices(c, info) {
+		issues = append(issues, ce.Error())
+	}
+	return issues
+}
+
+// adaptChoices moves choices to the detected platform, dropping what that
+// platform can't install. ok is false when problems remain that only the
+// wizard can settle (unknown values in a hand-edited profile, say).
+func adaptChoices(c UserChoices, info *system.SystemInfo) (adapted UserChoices, changes []string, ok bool) {
+	detected := detectedOSChoice(info)
+	if detected != "" && c.OS != detected {
+		changes = append(changes, fmt.Sprintf("OS: %s → %s", c.OS, detected))
+		c.OS = detected
+	}
+	hasTerminal := c.Terminal != "" && c.Terminal != "none"
+	if c.OS == "termux" {
+		if hasTerminal {
+			changes = append(changes, "skip "+c.Terminal+" (Termux is the terminal)")
+			c.Terminal = "none"
+		}
+		if c.InstallZed {
+			changes = append(changes, "skip Zed (not supported on Termux)")
+			c.InstallZed = false
+		}
+		if len(c.AITools) > 0 {
+			changes = append(changes, "skip AI tools (not supported on Termux)")
+			c.AITools = nil
+			c.InstallAIFramework = false
+			c.AIFrameworkPreset = ""
+			c.AIFrameworkModules = nil
+			c.InstallAgentTeamsLite = false
+		}
+	}
+	if c.Terminal == "kitty" && c.OS != "mac" {
+		changes = append(changes, "skip kitty (macOS only)")
+		c.Terminal = "none"
+	}
+	if c.NativePackages && c.OS != "linux" {
+		manager := "Homebrew"
+		if c.OS == "termux" {
+			manager = "pkg"
+		}
+		changes = append(changes, "use "+manager+" instead of distro packages")
+		c.NativePackages = false
+	}
+	return c, changes, len(ValidateChoices(c, info)) == 0
+}
+
+// ---------------------------------------------------------------------------
+// TUI glue
+// ---------------------------------------------------------------------------
+
+// choiceProfilesMsg carries the saved profiles found at startup
+type choiceProfilesMsg struct {
+	profiles []choiceProfileEntry
+}
+
+func loadChoiceProfilesCmd() tea.Cmd {
+	return func() tea.Msg {
+		return choiceProfilesMsg{profiles: listChoiceProfiles(os.Getenv("HOME"))}
+	}
+}
+
+// enterProfileSave asks for a profile name on the path input, from the backup prompt
+func (m Model) enterProfileSave() Model {
+	m.ProjectPathInput = ""
+	m.ProjectPathCursor = 0
+	m.ProjectPathError = ""
+	m.ProjectPathMode = PathModeTyping
+	m.ProfileNotice = ""
+	m.Screen = ScreenProfileSave
+	return m
+}
+
+// handleProfileSaveKeys edits the name; enter saves and returns to the backup prompt
+func (m Model) handleProfileSaveKeys(key string) (tea.Model, tea.Cmd) {
+	if key != "enter" {
+		return m.editPathInput(key), nil
+	}
+	name := strings.TrimSpace(m.ProjectPathInput)
+	if err := validateProfileName(name); err != nil {
+		m.ProjectPathError = "Invalid name: " + err.Error()
+		return m, nil
+	}
+	home := os.Getenv("HOME")
+	_, statErr := os.Stat(choiceProfilePath(home, name))
+	path, err := saveChoiceProfile(home, name, m.Choices)
+	if err != nil {
+		m.ProjectPathError = "Could not save the profile: " + err.Error()
+		return m, nil
+	}
+	m.ProfileNotice = "💾 Saved profile " + name + " to " + contractHome(path)
+	if statErr == nil {
+		m.ProfileNotice += " (replaced the old one)"
+	}
+	m.ChoiceProfiles = listChoiceProfiles(home)
+	m.Screen = ScreenBackupConfirm
+	m.Cursor = 0
+	return m, nil
+}
+
+// enterProfileSelect lists the saved profiles, re-read in case files changed
+func (m Model) enterProfileSelect() Model {
+	m.ChoiceProfiles = listChoiceProfiles(os.Getenv("HOME"))
+	m.ProfileNotice = ""
+	m.Screen = ScreenProfileSelect
+	m.Cursor = 0
+	return m
+}
+
+// choiceProfileOptions lists one entry per saved profile, then Back
+func (m Model) choiceProfileOptions() []string {
+	opts := make([]string, 0, len(m.ChoiceProfiles)+2)
+	for _, e := range m.ChoiceProfiles {
+		if e.Profile == nil {
+			opts = append(opts, "⚠️  "+e.Name+" — can't be read")
+			continue
+		}
+		opts = append(opts, "📂 "+e.Name+" — "+choicesSummary(e.Profile.Choices))
+	}
+	return append(opts, "─────────────", "← Back")
+}
+
+func (m Model) handleProfileSelectKeys(key string) (tea.Model, tea.Cmd) {
+	options := m.canonicalOptions()
+	switch key {
+	case "up", "k":
+		if m.Cursor > 0 {
+			m.Cursor--
+		}
+		if strings.HasPrefix(options[m.Cursor], "───") && m.Cursor > 0 {
+			m.Cursor--
+		}
+	case "down", "j":
+		if m.Cursor < len(options)-1 {
+			m.Cursor++
+		}
+		if strings.HasPrefix(options[m.Cursor], "───") && m.Cursor < len(options)-1 {
+			m.Cursor++
+		}
+	case "enter", " ":
+		if m.Cursor >= len(m.ChoiceProfiles) {
+			m.Screen = ScreenMainMenu
+			m.Cursor = 0
+			m.ProfileNotice = ""
+			return m, nil
+		}
+		entry := m.ChoiceProfiles[m.Cursor]
+		if entry.Profile == nil {
+			m.ProfileNotice = fmt.Sprintf("⚠️  %s can't be loaded: %v", contractHome(entry.Path), entry.Err)
+			return m, nil
+		}
+		return m.installFromProfile(entry.Profile)
+	}
+	return m, nil
+}
+
+// installFromProfile skips the wizard with p's choices, or stops at the
+// mismatch screen when they don't fit this machine
+func (m Model) installFromProfile(p *choiceProfile) (tea.Model, tea.Cmd) {
+	m.ProfileNotice = ""
+	m.PendingProfile = p
+	if issues := profileIssues(p.Choices, m.SystemInfo); len(issues) > 0 {
+		m.ProfileIssues = issues
+		m.Screen = ScreenProfileMismatch
+		m.Cursor = 0
+		return m, nil
+	}
+	m.Choices = p.Choices
+	return m.proceedToBackupOrInstall()
+}
+
+// profileMismatchOptions offers the adapted install only when adapting fixes everything
+func (m Model) profileMismatchOptions() []string {
+	var opts []string
+	if m.PendingProfile != nil {
+		if _, _, ok := adaptChoices(m.PendingProfile.Choices, m.SystemInfo); ok {
+			opts = append(opts, "✅ Adapt it to this machine and install")
+		}
+	}
+	return append(opts, "🔧 Choose options in the wizard", "← Back to profiles")
+}
+
+func (m Model) handleProfileMismatchKeys(key string) (tea.Model, tea.Cmd) {
+	options := m.canonicalOptions()
+	switch key {
+	case "up", "k":
+		if m.Cursor > 0 {
+			m.Cursor--
+		}
+	case "down", "j":
+		if m.Cursor < len(options)-1 {
+			m.Cursor++
+		}
+	case "enter", " ":
+		selected := options[m.Cursor]
+		switch {
+		case strings.Contains(selected, "Adapt it"):
+			m.Choices, _, _ = adaptChoices(m.PendingProfile.Choices, m.SystemInfo)
+			m.PendingProfile = nil
+			m.ProfileIssues = nil
+			return m.proceedToBackupOrInstall()
+		case strings.Contains(selected, "wizard"):
+			m.PendingProfile = nil
+			m.ProfileIssues = nil
+			m.Choices = UserChoices{}
+			m.Screen = ScreenOSSelect
+			m.Cursor = 0
+			if m.SystemInfo != nil && m.SystemInfo.OS == system.OSLinux {
+				m.Cursor = 1 // Linux is second option
+			}
+		default:
+			return m.leaveProfileMismatch(), nil
+		}
+	}
+	return m, nil
+}
+
+// leaveProfileMismatch returns to the profile list without installing
+func (m Model) leaveProfileMismatch() Model {
+	m.PendingProfile = nil
+	m.ProfileIssues = nil
+	m.Screen = ScreenProfileSelect
+	m.Cursor = 0
+	return m
+}
+
+func (m Model) renderProfileSave() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n\n")
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render("  " + choicesSummary(m.Choices)))
+	s.WriteString("\n\n")
+	s.WriteString(m.renderPathInput())
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render("  Enter: save  •  Esc: cancel"))
+
+	return s.String()
+}
+
+func (m Model) renderProfileSelect() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
+	s.WriteString("\n\n")
+
+	if m.ProfileNotice != "" {
+		s.WriteString(m.Theme.Warning.Render(m.ProfileNotice))
+		s.WriteString("\n\n")
+	}
+	for i, opt := range m.GetCurrentOptions() {
+		if strings.HasPrefix(opt, "───") {
+			s.WriteString(m.Theme.Muted.Render(opt))
+			s.WriteString("\n")
+			continue
+		}
+		cursor := "  "
+		style := m.Theme.Unselected
+		if i == m.Cursor {
+			cursor = "▸ "
+			style = m.Theme.Selected
+		}
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
+		s.WriteString("\n")
+	}
+
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render("↑/k up • ↓/j down • [Enter] install • [Esc] back"))
+	return s.String()
+}
+
+func (m Model) renderProfileMismatch() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
+	s.WriteString("\n\n")
+
+	for _, issue := range m.ProfileIssues {
+		s.WriteString(m.Theme.Warning.Render("  ⚠ " + issue))
+		s.WriteString("\n")
+	}
+	if m.PendingProfile != nil {
+		if _, changes, ok := adaptChoices(m.PendingProfile.Choices, m.SystemInfo); ok && len(changes) > 0 {
+			s.WriteString("\n")
+			s.WriteString(m.Theme.Info.Render("Adapting it would:"))
+			s.WriteString("\n")
+			for _, change := range changes {
+				s.WriteString("  • " + change + "\n")
+			}
+		}
+	}
+	s.WriteString("\n")
+
+	for i, opt := range m.GetCurrentOptions() {
+		cursor := "  "
+		style := m.Theme.Unselected
+		if i == m.Cursor {
+			cursor = "▸ "
+			style = m.Theme.Selected
+		}
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
+		s.WriteString("\n")
+	}
+
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render("↑/k up • ↓/j down • [Enter] select • [Esc] back"))
+	return s.String()
+}
@@ -0,0 +1,336 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestChoiceProfileRoundTrip(t *testing.T) {
+	home := t.TempDir()
+	choices := UserChoices{
+		OS:                 "mac",
+		Terminal:           "ghostty",
+		Shell:              "fish",
+		WindowMgr:          "zellij",
+		WMExtras:           []string{"essentials"},
+		InstallNvim:        true,
+		InstallFont:        true,
+		CreateBackup:       true,
+		AITools:            []string{"claude", "opencode"},
+		InstallAIFramework: true,
+		AIFrameworkPreset:  "fullstack",
+		// One-off project setup isn't part of a profile
+		InitProject: true,
+		ProjectPath: "/work/app",
+	}
+
+	path, err := saveChoiceProfile(home, "work-mac", choices)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != filepath.Join(home, ".gentleman", "profiles", "work-mac.json") {
+		t.Errorf("saved to %s", path)
+	}
+
+	p, err := loadChoiceProfile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := choices
+	want.InitProject = false
+	want.ProjectPath = ""
+	if !reflect.DeepEqual(p.Choices, want) {
+		t.Errorf("choices = %+v\nwant %+v", p.Choices, want)
+	}
+	if p.Name != "work-mac" || p.SavedAt.IsZero() {
+		t.Errorf("profile = %+v", p)
+	}
+
+	// Saving under the same name replaces the profile
+	choices.Shell = "zsh"
+	if _, err := saveChoiceProfile(home, "work-mac", choices); err != nil {
+		t.Fatal(err)
+	}
+	entries := listChoiceProfiles(home)
+	if len(entries) != 1 || entries[0].Profile == nil || entries[0].Profile.Choices.Shell != "zsh" {
+		t.Errorf("entries = %+v", entries)
+	}
+}
+
+func TestCorruptedChoiceProfile(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{"invalid json", `{"name": "broken", "choices": {`, "not a valid profile"},
+		{"no choices", `{"name": "empty"}`, "no choices saved"},
+		{"wrong types", `{"choices": {"os": 42}}`, "not a valid profile"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			home := t.TempDir()
+			if _, err := saveChoiceProfile(home, "good", UserChoices{OS: "linux", Shell: "zsh"}); err != nil {
+				t.Fatal(err)
+			}
+			os.WriteFile(choiceProfilePath(home, "broken"), []byte(tt.content), 0644)
+
+			entries := listChoiceProfiles(home)
+			if len(entries) != 2 {
+				t.Fatalf("both files should be listed, got %+v", entries)
+			}
+			broken, good := entries[0], entries[1]
+			if broken.Profile != nil || broken.Err == nil || !strings.Contains(broken.Err.Error(), tt.wantErr) {
+				t.Errorf("broken entry = %+v, want an error with %q", broken, tt.wantErr)
+			}
+			if good.Profile == nil || good.Err != nil {
+				t.Errorf("a corrupted file shouldn't hide the others, got %+v", good)
+			}
+		})
+	}
+}
+
+func TestValidateProfileName(t *testing.T) {
+	tests := []struct {
+		name string
+		ok   bool
+	}{
+		{"work-mac", true},
+		{"server_v2.1", true},
+		{"", false},
+		{".hidden", false},
+		{"../escape", false},
+		{"with space", false},
+		{strings.Repeat("a", maxProfileNameLen+1), false},
+	}
+	for _, tt := range tests {
+		if err := validateProfileName(tt.name); (err == nil) != tt.ok {
+			t.Errorf("validateProfileName(%q) = %v, want ok=%v", tt.name, err, tt.ok)
+		}
+	}
+}
+
+func TestAdaptChoices(t *testing.T) {
+	macProfile := UserChoices{
+		OS: "mac", Terminal: "kitty", Shell: "fish", WindowMgr: "tmux",
+		InstallNvim: true, AITools: []string{"claude"},
+	}
+	tests := []struct {
+		name     string
+		choices  UserChoices
+		info     *system.SystemInfo
+		wantOK   bool
+		check    func(c UserChoices) bool
+		wantNote string
+	}{
+		{
+			name:     "mac profile on linux",
+			choices:  macProfile,
+			info:     &system.SystemInfo{OS: system.OSDebian},
+			wantOK:   true,
+			check:    func(c UserChoices) bool { return c.OS == "linux" && c.Terminal == "none" && len(c.AITools) == 1 },
+			wantNote: "skip kitty",
+		},
+		{
+			name:     "mac profile on termux",
+			choices:  macProfile,
+			info:     &system.SystemInfo{OS: system.OSTermux, IsTermux: true},
+			wantOK:   true,
+			check:    func(c UserChoices) bool { return c.OS == "termux" && c.Terminal == "none" && len(c.AITools) == 0 },
+			wantNote: "skip AI tools",
+		},
+		{
+			name:     "native server profile on mac",
+			choices:  UserChoices{OS: "linux", Terminal: "none", Shell: "zsh", WindowMgr: "tmux", NativePackages: true},
+			info:     &system.SystemInfo{OS: system.OSMac, HasBrew: true, HasXcode: true},
+			wantOK:   true,
+			check:    func(c UserChoices) bool { return c.OS == "mac" && !c.NativePackages },
+			wantNote: "use Homebrew",
+		},
+		{
+			name:    "hand-edited shell",
+			choices: UserChoices{OS: "linux", Terminal: "none", Shell: "tcsh"},
+			info:    &system.SystemInfo{OS: system.OSDebian},
+			wantOK:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if issues := profileIssues(tt.choices, tt.info); len(issues) == 0 {
+				t.Error("the profile should be flagged for this machine")
+			}
+			adapted, changes, ok := adaptChoices(tt.choices, tt.info)
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %v, want %v (changes %v)", ok, tt.wantOK, changes)
+			}
+			if !ok {
+				return
+			}
+			if !tt.check(adapted) {
+				t.Errorf("adapted = %+v", adapted)
+			}
+			if !strings.Contains(strings.Join(changes, "\n"), tt.wantNote) {
+				t.Errorf("changes %v should mention %q", changes, tt.wantNote)
+			}
+			if issues := profileIssues(adapted, tt.info); len(issues) > 0 {
+				t.Errorf("adapted choices should fit, got %v", issues)
+			}
+		})
+	}
+}
+
+func TestSaveProfileFromBackupConfirm(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	m := NewModel()
+	m.Screen = ScreenBackupConfirm
+	m.ExistingConfigs = []string{"nvim: /test"}
+	m.Choices = UserChoices{OS: "linux", Terminal: "none", Shell: "zsh", WindowMgr: "tmux", InstallNvim: true}
+
+	m.Cursor = 2
+	result, _ := m.handleBackupConfirmKeys("enter")
+	m = result.(Model)
+	if m.Screen != ScreenProfileSave {
+		t.Fatalf("expected the name prompt, got %v", m.Screen)
+	}
+
+	for _, r := range "my box" {
+		result, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		m = result.(Model)
+	}
+	if m.ProjectPathInput != "my box" {
+		t.Fatalf("typed %q", m.ProjectPathInput)
+	}
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = result.(Model)
+	if m.Screen != ScreenProfileSave || !strings.HasPrefix(m.ProjectPathError, "Invalid name:") {
+		t.Fatalf("a name with a space should be refused, got %v %q", m.Screen, m.ProjectPathError)
+	}
+
+	m.ProjectPathInput = "server"
+	result, _ = m.handleProfileSaveKeys("enter")
+	m = result.(Model)
+	if m.Screen != ScreenBackupConfirm || !strings.Contains(m.ProfileNotice, "Saved profile server") {
+		t.Fatalf("saving should return to the backup prompt, got %v %q", m.Screen, m.ProfileNotice)
+	}
+	if !strings.Contains(m.renderBackupConfirm(), "Saved profile server") {
+		t.Error("the backup prompt should confirm the save")
+	}
+	if len(m.ChoiceProfiles) != 1 {
+		t.Errorf("the new profile should be listed, got %+v", m.ChoiceProfiles)
+	}
+	if _, err := os.Stat(choiceProfilePath(home, "server")); err != nil {
+		t.Error(err)
+	}
+
+	// Esc on the prompt goes back without saving
+	m = m.enterProfileSave()
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if got := result.(Model).Screen; got != ScreenBackupConfirm {
+		t.Errorf("esc should return to the backup prompt, got %v", got)
+	}
+}
+
+func TestInstallFromProfile(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	linux := UserChoices{OS: "linux", Terminal: "none", Shell: "zsh", WindowMgr: "tmux", InstallNvim: true, CreateBackup: true}
+	mac := UserChoices{OS: "mac", Terminal: "kitty", Shell: "fish", WindowMgr: "tmux", InstallNvim: true}
+	saveChoiceProfile(home, "box", linux)
+	saveChoiceProfile(home, "laptop", mac)
+	os.WriteFile(choiceProfilePath(home, "old"), []byte("not json"), 0644)
+
+	newMenu := func() Model {
+		m := NewModel()
+		m.Screen = ScreenMainMenu
+		m.SystemInfo = &system.SystemInfo{OS: system.OSDebian}
+		result, _ := m.Update(loadChoiceProfilesCmd()())
+		return result.(Model)
+	}
+
+	t.Run("menu entry only with profiles", func(t *testing.T) {
+		m := NewModel()
+		m.Screen = ScreenMainMenu
+		if strings.Contains(strings.Join(m.GetCurrentOptions(), "\n"), "Install from Profile") {
+			t.Error("no profiles loaded, so no menu entry")
+		}
+		m = newMenu()
+		if len(m.ChoiceProfiles) != 3 {
+			t.Fatalf("profiles = %+v", m.ChoiceProfiles)
+		}
+		if opts := m.GetCurrentOptions(); !strings.Contains(opts[1+len(installProfiles)], "Install from Profile") {
+			t.Errorf("the entry should follow the built-in profiles, got %v", opts)
+		}
+	})
+
+	t.Run("matching profile skips the wizard", func(t *testing.T) {
+		m := selectMainMenu(t, newMenu(), "Install from Profile")
+		if m.Screen != ScreenProfileSelect {
+			t.Fatalf("expected the profile list, got %v", m.Screen)
+		}
+		m.Cursor = 0 // box
+		result, _ := m.handleProfileSelectKeys("enter")
+		m = result.(Model)
+		if m.Screen != ScreenInstalling {
+			t.Fatalf("with nothing to back up the install should start, got %v", m.Screen)
+		}
+		if !reflect.DeepEqual(m.Choices, linux) {
+			t.Errorf("choices = %+v", m.Choices)
+		}
+	})
+
+	t.Run("corrupted profile", func(t *testing.T) {
+		m := selectMainMenu(t, newMenu(), "Install from Profile")
+		if !strings.Contains(m.GetCurrentOptions()[2], "old — can't be read") {
+			t.Errorf("options = %v", m.GetCurrentOptions())
+		}
+		m.Cursor = 2
+		result, _ := m.handleProfileSelectKeys("enter")
+		m = result.(Model)
+		if m.Screen != ScreenProfileSelect || !strings.Contains(m.ProfileNotice, "not a valid profile") {
+			t.Errorf("a corrupted profile should stay on the list with the error, got %v %q", m.Screen, m.ProfileNotice)
+		}
+	})
+
+	t.Run("mac profile on linux", func(t *testing.T) {
+		m := selectMainMenu(t, newMenu(), "Install from Profile")
+		m.Cursor = 1 // laptop
+		result, _ := m.handleProfileSelectKeys("enter")
+		m = result.(Model)
+		if m.Screen != ScreenProfileMismatch {
+			t.Fatalf("expected the mismatch warning, got %v", m.Screen)
+		}
+		view := m.renderProfileMismatch()
+		for _, want := range []string{"saved for mac, but this machine is linux", "skip kitty"} {
+			if !strings.Contains(view, want) {
+				t.Errorf("view should show %q, got:\n%s", want, view)
+			}
+		}
+
+		// Esc leaves the profile alone
+		result, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+		if back := result.(Model); back.Screen != ScreenProfileSelect || back.PendingProfile != nil {
+			t.Errorf("esc should return to the list, got %v", back.Screen)
+		}
+
+		// The wizard starts from scratch
+		m.Cursor = 1
+		result, _ = m.handleProfileMismatchKeys("enter")
+		if wizard := result.(Model); wizard.Screen != ScreenOSSelect || wizard.Choices.Shell != "" {
+			t.Errorf("expected a fresh wizard, got %v %+v", wizard.Screen, wizard.Choices)
+		}
+
+		m.Cursor = 0
+		result, _ = m.handleProfileMismatchKeys("enter")
+		m = result.(Model)
+		if m.Screen != ScreenInstalling || m.Choices.OS != "linux" || m.Choices.Terminal != "none" || m.Choices.Shell != "fish" {
+			t.Errorf("expected the adapted install, got %v %+v", m.Screen, m.Choices)
+		}
+	})
+}
@@ -0,0 +1,59 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+)
+
+// skillCloneProgressMsg reports how far the skills catalog clone or pull is
+type skillCloneProgressMsg struct {
+	progress float64
+}
+
+// SendProgress sets how far a running install step is, from 0 to 1; zero is
+// ignored, the bar starts empty
+func SendProgress(stepID string, progress float64) {
+	if nonInteractiveMode || globalProgram == nil || progress <= 0 {
+		return
+	}
+	globalProgram.Send(stepProgressMsg{stepID: sanitizeStepID(stepID), progress: progress})
+}
+
+// sendSkillCloneProgress forwards skills catalog git progress to the skill screens
+func sendSkillCloneProgress(progress float64) {
+	if globalProgram != nil {
+		globalProgram.Send(skillCloneProgressMsg{progress: progress})
+	}
+}
+
+// skillGitProgress turns git progress lines of the skills catalog into
+// skillCloneProgressMsgs
+func skillGitProgress(line string) {
+	if phase, percent, ok := system.ParseGitProgress(line); ok {
+		if progress, ok := system.GitProgressFraction(phase, percent); ok {
+			sendSkillCloneProgress(progress)
+		}
+	}
+}
+
+// progressBar draws progress (0 to 1) as a width-cell bar with its percentage
+func progressBar(progress float64, width int) string {
+	if progress < 0 {
+		progress = 0
+	} else if progress > 1 {
+		progress = 1
+	}
+	filled := int(progress * float64(width))
+	return fmt.Sprintf("%s%s %3.0f%%", strings.Repeat("█", filled), strings.Repeat("░", width-filled), progress*100)
+}
+
+// renderSkillCloneProgress is the bar under the skill spinners while git
+// reports progress, or nothing
+func (m Model) renderSkillCloneProgress() string {
+	if m.SkillCloneProgress <= 0 {
+		return ""
+	}
+	return m.Theme.Info.Render("    "+progressBar(m.SkillCloneProgress, 30)) + "\n"
+}
@@ -0,0 +1,58 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestProgressBar(t *testing.T) {
+	tests := []struct {
+		progress float64
+		want     string
+	}{
+		{0, "░░░░░░░░░░   0%"},
+		{0.45, "████░░░░░░  45%"},
+		{1, "██████████ 100%"},
+		{1.5, "██████████ 100%"},
+	}
+	for _, tt := range tests {
+		if got := progressBar(tt.progress, 10); got != tt.want {
+			t.Errorf("progressBar(%v) = %q, want %q", tt.progress, got, tt.want)
+		}
+	}
+}
+
+func TestStepProgressSurvivesLogLines(t *testing.T) {
+	m := NewModel()
+	m.Screen = ScreenInstalling
+	m.Steps = []InstallStep{{ID: "clone", Name: "Clone Repository", Status: StatusRunning}}
+
+	result, _ := m.Update(stepProgressMsg{stepID: "clone", progress: 0.45})
+	m = result.(Model)
+	result, _ = m.Update(stepProgressMsg{stepID: "clone", log: "remote: Total 412"})
+	m = result.(Model)
+	if m.Steps[0].Progress != 0.45 {
+		t.Fatalf("a log line should keep the progress, got %v", m.Steps[0].Progress)
+	}
+	if view := m.View(); !strings.Contains(view, "45%") {
+		t.Errorf("the running step should show its progress bar:\n%s", view)
+	}
+}
+
+func TestSkillCloneProgress(t *testing.T) {
+	m := NewModel()
+	m.Screen = ScreenSkillUpdate
+	m.SkillLoading = true
+
+	result, _ := m.Update(skillCloneProgressMsg{progress: 0.9})
+	m = result.(Model)
+	if view := m.View(); !strings.Contains(view, "90%") {
+		t.Errorf("the update screen should show the git progress:\n%s", view)
+	}
+
+	result, _ = m.Update(skillUpdateCompleteMsg{})
+	m = result.(Model)
+	if m.SkillCloneProgress != 0 {
+		t.Error("finishing the update should clear the progress")
+	}
+}
@@ -181,6 +181,51 @@ func TestMainMenuOptionsWithBackups(t *testing.T) {
 	})
 }
 
+func TestMainMenuUnsupportedPlatform(t *testing.T) {
+	m := NewModel()
+	m.Screen = ScreenMainMenu
+	m.SystemInfo = &system.SystemInfo{OS: system.OSUnknown, OSName: "FreeBSD", GOOS: "freebsd", Unsupported: true}
+
+	opts := m.GetCurrentOptions()
+	if opts[0] != "🚀 Start Installation"+unsupportedSuffix {
+		t.Errorf("expected the install entry to be marked unsupported, got %q", opts[0])
+	}
+	if !strings.Contains(m.renderMainMenu(), "Skill Manager") {
+		t.Error("other main-menu features should stay available")
+	}
+
+	// Start Installation shows the info screen instead of the OS selection
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = result.(Model)
+	if m.Screen != ScreenUnsupportedPlatform {
+		t.Fatalf("expected ScreenUnsupportedPlatform, got %v", m.Screen)
+	}
+	view := m.View()
+	for _, want := range []string{"FreeBSD", "Skill Manager", "Vim Trainer", "Environment installation"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected the screen to mention %q", want)
+		}
+	}
+
+	for _, key := range []tea.KeyMsg{{Type: tea.KeyEnter}, {Type: tea.KeyEsc}} {
+		m.Screen = ScreenUnsupportedPlatform
+		result, _ = m.Update(key)
+		if got := result.(Model).Screen; got != ScreenMainMenu {
+			t.Errorf("%s: expected to return to the main menu, got %v", key, got)
+		}
+	}
+
+	// The skill manager is still reachable
+	m.Screen = ScreenMainMenu
+	for m.GetCurrentOptions()[m.Cursor] != "🎯 Skill Manager" {
+		m.Cursor++
+	}
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if got := result.(Model).Screen; got != ScreenSkillMenu {
+		t.Errorf("expected the skill manager to open, got %v", got)
+	}
+}
+
 func TestRestoreBackupOptions(t *testing.T) {
 	m := NewModel()
 	m.Screen = ScreenRestoreBackup
@@ -701,7 +746,7 @@ func TestMainMenuToStartInstallation(t *testing.T) {
 func TestMainMenuToLearnAndPractice(t *testing.T) {
 	m := NewModel()
 	m.Screen = ScreenMainMenu
-	m.Cursor = 1 // Learn & Practice
+	m.Cursor = 3 // Learn & Practice
 
 	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
 	newModel := result.(Model)
@@ -759,7 +804,7 @@ func TestMainMenuToRestore(t *testing.T) {
 	m.AvailableBackups = []system.BackupInfo{
 		{Path: "/test", Timestamp: time.Now(), Files: []string{"test"}},
 	}
-	m.Cursor = 2 // Restore from Backup (Start, Learn & Practice, Restore)
+	m.Cursor = 4 // Restore from Backup (Start, Express, Server, Learn & Practice, Restore)
 
 	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
 	newModel := result.(Model)
@@ -925,8 +970,13 @@ func TestWMSelect(t *testing.T) {
 			if newModel.Choices.WindowMgr != wm {
 				t.Errorf("Expected WM '%s', got '%s'", wm, newModel.Choices.WindowMgr)
 			}
-			if newModel.Screen != ScreenNvimSelect {
-				t.Errorf("Expected ScreenNvimSelect, got %v", newModel.Screen)
+			// Tmux and zellij ask for their extras first
+			want := ScreenWMExtras
+			if wm == "none" {
+				want = ScreenNvimSelect
+			}
+			if newModel.Screen != want {
+				t.Errorf("Expected %v, got %v", want, newModel.Screen)
 			}
 		})
 	}
@@ -997,7 +1047,7 @@ func TestBackupConfirmWithoutBackup(t *testing.T) {
 func TestBackupConfirmCancel(t *testing.T) {
 	m := NewModel()
 	m.Screen = ScreenBackupConfirm
-	m.Cursor = 2 // Cancel
+	m.Cursor = 3 // Cancel
 
 	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
 	newModel := result.(Model)
@@ -1008,20 +1058,26 @@ func TestBackupConfirmCancel(t *testing.T) {
 }
 
 func TestBackupConfirmEscape(t *testing.T) {
-	// With no AI tools, escape should go back to AI tools select
+	// Escape goes back to the review of the wizard answers
 	m := NewModel()
 	m.Screen = ScreenBackupConfirm
 
 	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
 	newModel := result.(Model)
 
-	if newModel.Screen != ScreenAIToolsSelect {
-		t.Errorf("Escape with no AI tools should go back to AIToolsSelect, got %v", newModel.Screen)
+	if newModel.Screen != ScreenWizardReview {
+		t.Errorf("Escape should go back to WizardReview, got %v", newModel.Screen)
+	}
+
+	// From the review, with no AI tools, escape should go back to AI tools select
+	result, _ = newModel.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if got := result.(Model).Screen; got != ScreenAIToolsSelect {
+		t.Errorf("Escape with no AI tools should go back to AIToolsSelect, got %v", got)
 	}
 
 	// With AI tools + framework, escape should go to preset
 	m2 := NewModel()
-	m2.Screen = ScreenBackupConfirm
+	m2.Screen = ScreenWizardReview
 	m2.Choices.AITools = []string{"claude"}
 	m2.Choices.InstallAIFramework = true
 
@@ -1485,7 +1541,7 @@ func TestStepProgressMessage(t *testing.T) {
 	if newModel.Steps[0].Progress != 0.5 {
 		t.Errorf("Expected progress 0.5, got %f", newModel.Steps[0].Progress)
 	}
-	if len(newModel.LogLines) != 1 || newModel.LogLines[0] != "Test log" {
+	if lines := newModel.LogLines.Lines(); len(lines) != 1 || lines[0] != "Test log" {
 		t.Error("Log line should be added")
 	}
 }
@@ -1494,14 +1550,14 @@ func TestStepProgressMessageLogLimit(t *testing.T) {
 	m := NewModel()
 	m.Steps = []InstallStep{{ID: "test"}}
 
-	// Add 25 log lines
-	for i := 0; i < 25; i++ {
+	// Add more log lines than the cap
+	for i := 0; i < installLogCap+5; i++ {
 		result, _ := m.Update(stepProgressMsg{stepID: "test", log: "line"})
 		m = result.(Model)
 	}
 
-	if len(m.LogLines) > 20 {
-		t.Errorf("Log lines should be capped at 20, got %d", len(m.LogLines))
+	if m.LogLines.Len() > installLogCap {
+		t.Errorf("Log lines should be capped at %d, got %d", installLogCap, m.LogLines.Len())
 	}
 }
 
@@ -1585,20 +1641,114 @@ func TestInitReturnsCommands(t *testing.T) {
 // SCREEN STRING REPRESENTATION (for debugging)
 // =============================================================================
 
+// screenNames gives failing navigation tests readable screen names
+var screenNames = map[Screen]string{
+	ScreenWelcome:                  "Welcome",
+	ScreenMainMenu:                 "MainMenu",
+	ScreenLearnMenu:                "LearnMenu",
+	ScreenOSSelect:                 "OSSelect",
+	ScreenTerminalSelect:           "TerminalSelect",
+	ScreenFontSelect:               "FontSelect",
+	ScreenShellSelect:              "ShellSelect",
+	ScreenWMSelect:                 "WMSelect",
+	ScreenNvimSelect:               "NvimSelect",
+	ScreenZedSelect:                "ZedSelect",
+	ScreenInstalling:               "Installing",
+	ScreenComplete:                 "Complete",
+	ScreenError:                    "Error",
+	ScreenLearnTerminals:           "LearnTerminals",
+	ScreenLearnShells:              "LearnShells",
+	ScreenLearnWM:                  "LearnWM",
+	ScreenLearnNvim:                "LearnNvim",
+	ScreenKeymaps:                  "Keymaps",
+	ScreenKeymapCategory:           "KeymapCategory",
+	ScreenKeymapsMenu:              "KeymapsMenu",
+	ScreenKeymapsTmux:              "KeymapsTmux",
+	ScreenKeymapsTmuxCat:           "KeymapsTmuxCat",
+	ScreenKeymapsZellij:            "KeymapsZellij",
+	ScreenKeymapsZellijCat:         "KeymapsZellijCat",
+	ScreenKeymapsGhostty:           "KeymapsGhostty",
+	ScreenKeymapsGhosttyCat:        "KeymapsGhosttyCat",
+	ScreenLearnLazyVim:             "LearnLazyVim",
+	ScreenLazyVimTopic:             "LazyVimTopic",
+	ScreenBackupConfirm:            "BackupConfirm",
+	ScreenRestoreBackup:            "RestoreBackup",
+	ScreenRestoreConfirm:           "RestoreConfirm",
+	ScreenAIToolsSelect:            "AIToolsSelect",
+	ScreenAIFrameworkConfirm:       "AIFrameworkConfirm",
+	ScreenAIFrameworkPreset:        "AIFrameworkPreset",
+	ScreenAIFrameworkCategories:    "AIFrameworkCategories",
+	ScreenAIFrameworkCategoryItems: "AIFrameworkCategoryItems",
+	ScreenGhosttyWarning:           "GhosttyWarning",
+	ScreenTrainerMenu:              "TrainerMenu",
+	ScreenTrainerLesson:            "TrainerLesson",
+	ScreenTrainerPractice:          "TrainerPractice",
+	ScreenTrainerBoss:              "TrainerBoss",
+	ScreenTrainerResult:            "TrainerResult",
+	ScreenTrainerBossResult:        "TrainerBossResult",
+	ScreenProjectPath:              "ProjectPath",
+	ScreenProjectStack:             "ProjectStack",
+	ScreenProjectMemory:            "ProjectMemory",
+	ScreenProjectObsidianInstall:   "ProjectObsidianInstall",
+	ScreenProjectEngram:            "ProjectEngram",
+	ScreenProjectRolePack:          "ProjectRolePack",
+	ScreenProjectCI:                "ProjectCI",
+	ScreenProjectConfirm:           "ProjectConfirm",
+	ScreenProjectInstalling:        "ProjectInstalling",
+	ScreenProjectResult:            "ProjectResult",
+	ScreenSkillMenu:                "SkillMenu",
+	ScreenSkillBrowse:              "SkillBrowse",
+	ScreenSkillInstall:             "SkillInstall",
+	ScreenSkillRemove:              "SkillRemove",
+	ScreenSkillResult:              "SkillResult",
+	ScreenSkillUpdate:              "SkillUpdate",
+	ScreenAIFrameworkApplyDiff:     "AIFrameworkApplyDiff",
+	ScreenZshMergeSelect:           "ZshMergeSelect",
+	ScreenRestoreConflict:          "RestoreConflict",
+	ScreenDiagnoseSymptom:          "DiagnoseSymptom",
+	ScreenDiagnoseResults:          "DiagnoseResults",
+	ScreenSkillStats:               "SkillStats",
+	ScreenUnsupportedPlatform:      "UnsupportedPlatform",
+	ScreenTrainerResetConfirm:      "TrainerResetConfirm",
+	ScreenTrainerSettings:          "TrainerSettings",
+	ScreenTrainerResetAll:          "TrainerResetAll",
+	ScreenSkillDetail:              "SkillDetail",
+	ScreenOSVersionWarning:         "OSVersionWarning",
+	ScreenProjectBatchSelect:       "ProjectBatchSelect",
+	ScreenProjectBatchResult:       "ProjectBatchResult",
+	ScreenSkillDoctor:              "SkillDoctor",
+	ScreenUninstall:                "Uninstall",
+	ScreenUninstallResult:          "UninstallResult",
+	ScreenWMExtras:                 "WMExtras",
+	ScreenInstanceLocked:           "InstanceLocked",
+	ScreenTrainerImport:            "TrainerImport",
+	ScreenInstallCheck:             "InstallCheck",
+	ScreenSkillWarnings:            "SkillWarnings",
+	ScreenProfileSave:              "ProfileSave",
+	ScreenProfileSelect:            "ProfileSelect",
+	ScreenProfileMismatch:          "ProfileMismatch",
+	ScreenSkillTargets:             "SkillTargets",
+	ScreenRestoreItems:             "RestoreItems",
+	ScreenBackupPrune:              "BackupPrune",
+	ScreenProjectPreview:           "ProjectPreview",
+	ScreenAIFrameworkSummary:       "AIFrameworkSummary",
+	ScreenAIFrameworkPresetSave:    "AIFrameworkPresetSave",
+	ScreenTrainerTimed:             "TrainerTimed",
+	ScreenInstallRefs:              "InstallRefs",
+	ScreenInstallRefInput:          "InstallRefInput",
+	ScreenSkillConflicts:           "SkillConflicts",
+	ScreenSettings:                 "Settings",
+	ScreenSkillManifest:            "SkillManifest",
+	ScreenDiskSpaceWarning:         "DiskSpaceWarning",
+	ScreenWizardReview:             "WizardReview",
+	ScreenSkillURL:                 "SkillURL",
+}
+
 func (s Screen) String() string {
-	names := []string{
-		"Welcome", "MainMenu", "OSSelect", "TerminalSelect",
-		"FontSelect", "ShellSelect", "WMSelect", "NvimSelect",
-		"Installing", "Complete", "Error",
-		"LearnTerminals", "LearnShells", "LearnWM", "LearnNvim",
-		"Keymaps", "KeymapCategory",
-		"LearnLazyVim", "LazyVimTopic",
-		"BackupConfirm", "RestoreBackup", "RestoreConfirm",
-	}
-	if int(s) < len(names) {
-		return names[s]
-	}
-	return "Unknown"
+	if name, ok := screenNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("Screen(%d)", int(s))
 }
 
 // Helper to avoid import error
@@ -0,0 +1,427 @@
+package tui
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// DiagnosticStatus is the outcome of one automated check
+type DiagnosticStatus int
+
+const (
+	DiagPass DiagnosticStatus = iota
+	DiagWarn
+	DiagFail
+	DiagSkipped // Not applicable on this machine (tool not installed, wrong OS)
+)
+
+// DiagnosticResult is what a check found
+type DiagnosticResult struct {
+	CheckID string
+	Name    string
+	Status  DiagnosticStatus
+	Detail  string
+	Fix     string // diagnosticFixes key offered for this result ("" if none)
+}
+
+// diagEnv is everything a check reads from the machine, injectable for tests
+type diagEnv struct {
+	Home   string
+	Shell  string // $SHELL
+	Term   string // $TERM
+	InTmux bool   // $TMUX is set
+	GOOS   string
+	System *system.SystemInfo // Detected platform; nil when unknown
+	// run executes a command with a timeout and returns combined output
+	run func(name string, args ...string) (string, error)
+	// lookPath reports whether a command is on PATH
+	lookPath func(name string) bool
+}
+
+// currentDiagEnv reads the real environment
+func currentDiagEnv() diagEnv {
+	return diagEnv{
+		Home:   os.Getenv("HOME"),
+		Shell:  os.Getenv("SHELL"),
+		Term:   os.Getenv("TERM"),
+		InTmux: os.Getenv("TMUX") != "",
+		GOOS:   runtime.GOOS,
+		System: system.Detect(),
+		run: func(name string, args ...string) (string, error) {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			out, err := exec.CommandContext(ctx, name, args...).CombinedOutput()
+			return string(out), err
+		},
+		lookPath: system.CommandExists,
+	}
+}
+
+// DiagnosticCheck is one automated probe. Fix names the diagnosticFixes entry
+// offered when the check doesn't pass.
+type DiagnosticCheck struct {
+	ID   string
+	Name string
+	Fix  string
+	Run  func(env diagEnv) (DiagnosticStatus, string)
+}
+
+// DiagnosticSymptom groups the checks worth running for one user-visible problem,
+// ordered from most to least likely cause
+type DiagnosticSymptom struct {
+	ID     string
+	Label  string
+	Checks []string
+}
+
+// DiagnosticFix is a safe one-keypress remedy, run as a single install step
+type DiagnosticFix struct {
+	Label     string
+	Step      InstallStep
+	NeedsRepo bool // Clone the dotfiles repo first if it isn't present
+}
+
+// diagnosticSymptoms is the symptom menu. Add a row here to support a new symptom.
+var diagnosticSymptoms = []DiagnosticSymptom{
+	{ID: "prompt", Label: "🔤 Prompt shows boxes or garbled icons", Checks: []string{"font", "font-cache", "tmux-term", "default-shell"}},
+	{ID: "nvim", Label: "📝 Neovim shows errors on startup", Checks: []string{"nvim-config", "nvim-plugins", "font"}},
+	{ID: "tmux", Label: "🪟 Tmux keybindings don't work", Checks: []string{"tmux-config", "tmux-plugins", "tmux-term"}},
+	{ID: "shell", Label: "🐚 Shell config isn't loaded", Checks: []string{"default-shell", "shell-config"}},
+}
+
+// diagnosticChecks holds every check a symptom can reference, keyed by ID
+var diagnosticChecks = map[string]DiagnosticCheck{
+	"font":          {ID: "font", Name: "Nerd Font installed", Fix: "font", Run: checkNerdFont},
+	"font-cache":    {ID: "font-cache", Name: "Font cache up to date", Fix: "fccache", Run: checkFontCache},
+	"default-shell": {ID: "default-shell", Name: "Default shell", Run: checkDefaultShell},
+	"shell-config":  {ID: "shell-config", Name: "Shell config syntax", Run: checkShellConfig},
+	"nvim-config":   {ID: "nvim-config", Name: "Neovim starts cleanly", Fix: "redeploy-nvim", Run: checkNvimConfig},
+	"nvim-plugins":  {ID: "nvim-plugins", Name: "Neovim plugins synced", Fix: "nvim-sync", Run: checkNvimPlugins},
+	"tmux-config":   {ID: "tmux-config", Name: "Tmux config present", Fix: "redeploy-tmux", Run: checkTmuxConfig},
+	"tmux-plugins":  {ID: "tmux-plugins", Name: "Tmux plugin manager", Fix: "redeploy-tmux", Run: checkTmuxPlugins},
+	"tmux-term":     {ID: "tmux-term", Name: "TERM inside tmux", Fix: "redeploy-tmux", Run: checkTmuxTerm},
+}
+
+// diagnosticFixes maps fix IDs to the install step that applies them
+var diagnosticFixes = map[string]DiagnosticFix{
+	"font":          {Label: "Re-run the font install step", Step: InstallStep{ID: "font", Name: "Install Nerd Font"}},
+	"fccache":       {Label: "Rebuild the font cache (fc-cache)", Step: InstallStep{ID: "fccache", Name: "Rebuild font cache"}},
+	"redeploy-nvim": {Label: "Re-deploy the Neovim config from the repo", Step: InstallStep{ID: "redeploy-nvim", Name: "Re-deploy Neovim config"}, NeedsRepo: true},
+	"nvim-sync":     {Label: "Sync Neovim plugins (Lazy sync)", Step: InstallStep{ID: "nvim-sync", Name: "Sync Neovim plugins"}},
+	"redeploy-tmux": {Label: "Re-deploy the Tmux config from the repo", Step: InstallStep{ID: "redeploy-tmux", Name: "Re-deploy Tmux config"}, NeedsRepo: true},
+}
+
+// RunDiagnosis runs a symptom's checks and ranks the results: failures first,
+// then warnings, then passes and skips, keeping the symptom's likelihood order within each group
+func RunDiagnosis(symptom DiagnosticSymptom, env diagEnv) []DiagnosticResult {
+	var results []DiagnosticResult
+	for _, id := range symptom.Checks {
+		check, ok := diagnosticChecks[id]
+		if !ok {
+			continue
+		}
+		status, detail := check.Run(env)
+		result := DiagnosticResult{CheckID: id, Name: check.Name, Status: status, Detail: detail}
+		if status == DiagFail || status == DiagWarn {
+			result.Fix = check.Fix
+		}
+		results = append(results, result)
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return diagnosticRank(results[i].Status) < diagnosticRank(results[j].Status)
+	})
+	return results
+}
+
+func diagnosticRank(s DiagnosticStatus) int {
+	switch s {
+	case DiagFail:
+		return 0
+	case DiagWarn:
+		return 1
+	case DiagPass:
+		return 2
+	}
+	return 3
+}
+
+// ---------------------------------------------------------------------------
+// Checks
+// ---------------------------------------------------------------------------
+
+// nerdFontInstalled looks for Nerd Font files in the user and system font dirs
+func nerdFontInstalled(env diagEnv) bool {
+	dirs := []string{filepath.Join(env.Home, ".local", "share", "fonts"), filepath.Join(env.Home, ".fonts")}
+	if env.GOOS == "darwin" {
+		dirs = []string{filepath.Join(env.Home, "Library", "Fonts"), "/Library/Fonts"}
+	}
+	for _, dir := range dirs {
+		found := false
+		filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+			if err != nil || found {
+				return nil
+			}
+			if !info.IsDir() && strings.Contains(strings.ToLower(info.Name()), "nerd") {
+				found = true
+			}
+			return nil
+		})
+		if found {
+			return true
+		}
+	}
+	return false
+}
+
+func checkNerdFont(env diagEnv) (DiagnosticStatus, string) {
+	if nerdFontInstalled(env) {
+		return DiagPass, "Nerd Font files found"
+	}
+	// Fonts installed elsewhere still show up in fontconfig
+	if env.lookPath("fc-list") {
+		if out, err := env.run("fc-list"); err == nil && strings.Contains(out, "Nerd Font") {
+			return DiagPass, "Nerd Font registered with fontconfig"
+		}
+	}
+	return DiagFail, "No Nerd Font found — icons render as boxes"
+}
+
+func checkFontCache(env diagEnv) (DiagnosticStatus, string) {
+	if env.GOOS == "darwin" || !env.lookPath("fc-list") {
+		return DiagSkipped, "fontconfig not used here"
+	}
+	if !nerdFontInstalled(env) {
+		return DiagSkipped, "No Nerd Font files to register"
+	}
+	out, err := env.run("fc-list")
+	if err != nil {
+		return DiagWarn, "fc-list failed: " + strings.TrimSpace(out)
+	}
+	if !strings.Contains(out, "Nerd Font") {
+		return DiagFail, "Font files exist but fontconfig doesn't list them"
+	}
+	return DiagPass, "Nerd Font registered with fontconfig"
+}
+
+// managedShells maps $SHELL basenames to the shells the installer configures
+var managedShells = map[string]string{"fish": "fish", "zsh": "zsh", "nu": "nushell"}
+
+func checkDefaultShell(env diagEnv) (DiagnosticStatus, string) {
+	name := filepath.Base(env.Shell)
+	if _, ok := managedShells[name]; ok {
+		return DiagPass, "Login shell is " + name
+	}
+	if env.Shell == "" {
+		return DiagWarn, "$SHELL is not set"
+	}
+	return DiagWarn, "Login shell is " + name + " — run chsh to switch to fish, zsh or nu"
+}
+
+func checkShellConfig(env diagEnv) (DiagnosticStatus, string) {
+	var config string
+	var lint []string
+	switch filepath.Base(env.Shell) {
+	case "zsh":
+		config = filepath.Join(env.Home, ".zshrc")
+		lint = []string{"zsh", "-n", config}
+	case "fish":
+		config = filepath.Join(env.Home, ".config", "fish", "config.fish")
+		lint = []string{"fish", "--no-execute", config}
+	case "nu":
+		config = filepath.Join(env.Home, ".config", "nushell", "config.nu")
+	default:
+		return DiagSkipped, "Login shell isn't managed by the installer"
+	}
+	if _, err := os.Stat(config); err != nil {
+		return DiagFail, "Missing " + config
+	}
+	if lint == nil || !env.lookPath(lint[0]) {
+		return DiagPass, config + " present"
+	}
+	if out, err := env.run(lint[0], lint[1:]...); err != nil {
+		return DiagFail, "Syntax error: " + firstLine(out)
+	}
+	return DiagPass, config + " parses cleanly"
+}
+
+func checkNvimConfig(env diagEnv) (DiagnosticStatus, string) {
+	if !env.lookPath("nvim") {
+		return DiagSkipped, "Neovim not installed"
+	}
+	if _, err := os.Stat(filepath.Join(env.Home, ".config", "nvim", "init.lua")); err != nil {
+		return DiagFail, "~/.config/nvim/init.lua is missing"
+	}
+	out, err := env.run("nvim", "--headless", "+qa")
+	if err != nil || strings.Contains(out, "Error") {
+		return DiagFail, "Startup error: " + firstLine(out)
+	}
+	return DiagPass, "nvim --headless exits cleanly"
+}
+
+func checkNvimPlugins(env diagEnv) (DiagnosticStatus, string) {
+	if !env.lookPath("nvim") {
+		return DiagSkipped, "Neovim not installed"
+	}
+	lazyDir := filepath.Join(env.Home, ".local", "share", "nvim", "lazy")
+	entries, err := os.ReadDir(lazyDir)
+	if err != nil || len(entries) == 0 {
+		return DiagFail, "No plugins installed in " + lazyDir
+	}
+	if _, err := os.Stat(filepath.Join(env.Home, ".config", "nvim", "lazy-lock.json")); err != nil {
+		return DiagWarn, "lazy-lock.json missing — plugins may be out of sync"
+	}
+	return DiagPass, "Plugins installed"
+}
+
+func checkTmuxConfig(env diagEnv) (DiagnosticStatus, string) {
+	if !env.lookPath("tmux") {
+		return DiagSkipped, "Tmux not installed"
+	}
+	if _, err := os.Stat(filepath.Join(env.Home, ".tmux.conf")); err != nil {
+		return DiagFail, "~/.tmux.conf is missing"
+	}
+	return DiagPass, "~/.tmux.conf present"
+}
+
+func checkTmuxPlugins(env diagEnv) (DiagnosticStatus, string) {
+	if !env.lookPath("tmux") {
+		return DiagSkipped, "Tmux not installed"
+	}
+	if _, err := os.Stat(filepath.Join(env.Home, ".tmux", "plugins", "tpm")); err != nil {
+		return DiagFail, "TPM not found in ~/.tmux/plugins — plugin keybinds won't load"
+	}
+	return DiagPass, "TPM installed"
+}
+
+func checkTmuxTerm(env diagEnv) (DiagnosticStatus, string) {
+	if !env.lookPath("tmux") {
+		return DiagSkipped, "Tmux not installed"
+	}
+	if env.InTmux {
+		if strings.HasPrefix(env.Term, "tmux") || strings.HasPrefix(env.Term, "screen") {
+			return DiagPass, "TERM=" + env.Term
+		}
+		return DiagFail, "TERM=" + env.Term + " inside tmux — expected tmux-256color"
+	}
+	content, err := os.ReadFile(filepath.Join(env.Home, ".tmux.conf"))
+	if err != nil {
+		return DiagSkipped, "No tmux config to inspect"
+	}
+	if !strings.Contains(string(content), "default-terminal") {
+		return DiagWarn, "default-terminal not set in ~/.tmux.conf"
+	}
+	return DiagPass, "default-terminal configured"
+}
+
+func firstLine(s string) string {
+	s = strings.TrimSpace(s)
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		return s[:i]
+	}
+	return s
+}
+
+// ---------------------------------------------------------------------------
+// TUI glue
+// ---------------------------------------------------------------------------
+
+// diagnosisCompleteMsg carries the ranked results of a symptom's checks
+type diagnosisCompleteMsg struct {
+	results []DiagnosticResult
+}
+
+// runDiagnosisCmd runs checks off the UI goroutine; nvim --headless can take seconds
+func runDiagnosisCmd(symptom DiagnosticSymptom) tea.Cmd {
+	return func() tea.Msg {
+		return diagnosisCompleteMsg{results: RunDiagnosis(symptom, currentDiagEnv())}
+	}
+}
+
+// diagnoseFixIDs lists the distinct fixes offered by the current results, in rank order
+func (m Model) diagnoseFixIDs() []string {
+	var ids []string
+	seen := make(map[string]bool)
+	for _, r := range m.DiagnoseResults {
+		if r.Fix == "" || seen[r.Fix] {
+			continue
+		}
+		if _, ok := diagnosticFixes[r.Fix]; !ok {
+			continue
+		}
+		seen[r.Fix] = true
+		ids = append(ids, r.Fix)
+	}
+	return ids
+}
+
+// handleDiagnoseResultsKeys applies a fix, re-runs the checks, or goes back
+func (m Model) handleDiagnoseResultsKeys(key string) (tea.Model, tea.Cmd) {
+	if m.DiagnoseRunning {
+		return m, nil
+	}
+	options := m.canonicalOptions()
+	fixIDs := m.diagnoseFixIDs()
+
+	switch key {
+	case "up", "k":
+		if m.Cursor > 0 {
+			m.Cursor--
+		}
+	case "down", "j":
+		if m.Cursor < len(options)-1 {
+			m.Cursor++
+		}
+	case "r":
+		return m.startDiagnosis()
+	case "enter", " ":
+		switch {
+		case m.Cursor < len(fixIDs):
+			fixID := fixIDs[m.Cursor]
+			m.DiagnoseFixMode = true
+			m.DiagnoseLastFix = diagnosticFixes[fixID].Label
+			m.SetupDiagnosticFixSteps(fixID)
+			m.trackProgress = false
+			m.LogLines.Reset()
+			m.Screen = ScreenInstalling
+			m.CurrentStep = 0
+			return m, func() tea.Msg { return installStartMsg{} }
+		case m.Cursor == len(fixIDs):
+			return m.startDiagnosis()
+		default:
+			m.Screen = ScreenDiagnoseSymptom
+			m.Cursor = m.DiagnoseSymptom
+		}
+	}
+	return m, nil
+}
+
+// startDiagnosis switches to the results screen and runs the selected symptom's checks
+func (m Model) startDiagnosis() (tea.Model, tea.Cmd) {
+	m.Screen = ScreenDiagnoseResults
+	m.Cursor = 0
+	m.DiagnoseResults = nil
+	m.DiagnoseRunning = true
+	return m, runDiagnosisCmd(diagnosticSymptoms[m.DiagnoseSymptom])
+}
+
+// SetupDiagnosticFixSteps prepares the step plan for a single fix
+func (m *Model) SetupDiagnosticFixSteps(fixID string) {
+	fix := diagnosticFixes[fixID]
+	m.Steps = nil
+	step := fix.Step
+	step.Status = StatusPending
+	if _, err := os.Stat(m.RepoDir); fix.NeedsRepo && err != nil {
+		m.Steps = append(m.Steps, InstallStep{ID: "clone", Name: "Clone Repository", Status: StatusPending})
+		step.DependsOn = []string{"clone"}
+	}
+	m.Steps = append(m.Steps, step)
+}
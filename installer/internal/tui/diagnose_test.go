@@ -0,0 +1,208 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// fakeDiagEnv returns an env rooted at a temp home with the given commands on PATH
+func fakeDiagEnv(t *testing.T, commands ...string) diagEnv {
+	t.Helper()
+	onPath := make(map[string]bool)
+	for _, c := range commands {
+		onPath[c] = true
+	}
+	return diagEnv{
+		Home: t.TempDir(),
+		GOOS: "linux",
+		run: func(name string, args ...string) (string, error) {
+			return "", nil
+		},
+		lookPath: func(name string) bool { return onPath[name] },
+	}
+}
+
+func TestDiagnosticTablesConsistent(t *testing.T) {
+	for _, symptom := range diagnosticSymptoms {
+		for _, id := range symptom.Checks {
+			if _, ok := diagnosticChecks[id]; !ok {
+				t.Errorf("symptom %q references unknown check %q", symptom.ID, id)
+			}
+		}
+	}
+	for id, check := range diagnosticChecks {
+		if check.ID != id {
+			t.Errorf("check keyed %q has ID %q", id, check.ID)
+		}
+		if check.Fix == "" {
+			continue
+		}
+		if _, ok := diagnosticFixes[check.Fix]; !ok {
+			t.Errorf("check %q references unknown fix %q", id, check.Fix)
+		}
+	}
+}
+
+func TestRunDiagnosisRanking(t *testing.T) {
+	env := fakeDiagEnv(t, "tmux")
+	// Tmux config present but no TPM and no default-terminal
+	os.WriteFile(filepath.Join(env.Home, ".tmux.conf"), []byte("set -g mouse on\n"), 0644)
+
+	results := RunDiagnosis(diagnosticSymptoms[2], env)
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	want := []struct {
+		id     string
+		status DiagnosticStatus
+	}{
+		{"tmux-plugins", DiagFail},
+		{"tmux-term", DiagWarn},
+		{"tmux-config", DiagPass},
+	}
+	for i, w := range want {
+		if results[i].CheckID != w.id || results[i].Status != w.status {
+			t.Errorf("result %d: expected %s/%d, got %s/%d", i, w.id, w.status, results[i].CheckID, results[i].Status)
+		}
+	}
+	if results[2].Fix != "" {
+		t.Error("passing checks should not offer a fix")
+	}
+
+	m := NewModel()
+	m.DiagnoseResults = results
+	// tmux-plugins and tmux-term share a fix; it should be offered once
+	if ids := m.diagnoseFixIDs(); len(ids) != 1 || ids[0] != "redeploy-tmux" {
+		t.Errorf("expected a single redeploy-tmux fix, got %v", ids)
+	}
+}
+
+func TestDiagnosticChecks(t *testing.T) {
+	t.Run("default shell", func(t *testing.T) {
+		tests := []struct {
+			shell string
+			want  DiagnosticStatus
+		}{
+			{"/usr/bin/zsh", DiagPass},
+			{"/opt/homebrew/bin/nu", DiagPass},
+			{"/bin/bash", DiagWarn},
+			{"", DiagWarn},
+		}
+		for _, tt := range tests {
+			env := fakeDiagEnv(t)
+			env.Shell = tt.shell
+			if got, _ := checkDefaultShell(env); got != tt.want {
+				t.Errorf("checkDefaultShell(%q) = %d, want %d", tt.shell, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("tmux term inside tmux", func(t *testing.T) {
+		env := fakeDiagEnv(t, "tmux")
+		env.InTmux = true
+		env.Term = "xterm-256color"
+		if got, _ := checkTmuxTerm(env); got != DiagFail {
+			t.Errorf("expected fail for xterm inside tmux, got %d", got)
+		}
+		env.Term = "tmux-256color"
+		if got, _ := checkTmuxTerm(env); got != DiagPass {
+			t.Errorf("expected pass for tmux-256color, got %d", got)
+		}
+	})
+
+	t.Run("nvim plugins", func(t *testing.T) {
+		env := fakeDiagEnv(t)
+		if got, _ := checkNvimPlugins(env); got != DiagSkipped {
+			t.Errorf("expected skip without nvim, got %d", got)
+		}
+		env = fakeDiagEnv(t, "nvim")
+		if got, _ := checkNvimPlugins(env); got != DiagFail {
+			t.Errorf("expected fail with no plugins, got %d", got)
+		}
+		os.MkdirAll(filepath.Join(env.Home, ".local", "share", "nvim", "lazy", "lazy.nvim"), 0755)
+		if got, _ := checkNvimPlugins(env); got != DiagWarn {
+			t.Errorf("expected warn without lazy-lock.json, got %d", got)
+		}
+	})
+}
+
+func TestSetupDiagnosticFixSteps(t *testing.T) {
+	m := NewModel()
+	m.RepoDir = filepath.Join(t.TempDir(), "missing")
+
+	m.SetupDiagnosticFixSteps("redeploy-tmux")
+	if len(m.Steps) != 2 || m.Steps[0].ID != "clone" || m.Steps[1].ID != "redeploy-tmux" {
+		t.Errorf("expected clone + redeploy-tmux, got %+v", m.Steps)
+	}
+
+	m.SetupDiagnosticFixSteps("nvim-sync")
+	if len(m.Steps) != 1 || m.Steps[0].ID != "nvim-sync" {
+		t.Errorf("expected only nvim-sync, got %+v", m.Steps)
+	}
+}
+
+func TestDiagnoseFlow(t *testing.T) {
+	m := NewModel()
+	m.Screen = ScreenMainMenu
+	for i, opt := range m.GetCurrentOptions() {
+		if opt == "🩺 Diagnose Setup" {
+			m.Cursor = i
+		}
+	}
+
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = result.(Model)
+	if m.Screen != ScreenDiagnoseSymptom {
+		t.Fatalf("expected ScreenDiagnoseSymptom, got %v", m.Screen)
+	}
+
+	m.Cursor = 1
+	result, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = result.(Model)
+	if m.Screen != ScreenDiagnoseResults || !m.DiagnoseRunning || cmd == nil {
+		t.Fatalf("expected checks to start, got screen %v running %v", m.Screen, m.DiagnoseRunning)
+	}
+	if m.DiagnoseSymptom != 1 {
+		t.Errorf("expected symptom 1, got %d", m.DiagnoseSymptom)
+	}
+
+	result, _ = m.Update(diagnosisCompleteMsg{results: []DiagnosticResult{
+		{CheckID: "nvim-plugins", Status: DiagFail, Fix: "nvim-sync"},
+	}})
+	m = result.(Model)
+	if m.DiagnoseRunning {
+		t.Error("expected DiagnoseRunning to be cleared")
+	}
+	opts := m.GetCurrentOptions()
+	if len(opts) != 3 || opts[0] != "🔧 "+diagnosticFixes["nvim-sync"].Label {
+		t.Fatalf("unexpected result options %v", opts)
+	}
+
+	// Apply the fix
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = result.(Model)
+	if m.Screen != ScreenInstalling || !m.DiagnoseFixMode {
+		t.Fatalf("expected fix to run as an install, got screen %v", m.Screen)
+	}
+	if len(m.Steps) != 1 || m.Steps[0].ID != "nvim-sync" {
+		t.Errorf("expected a single nvim-sync step, got %+v", m.Steps)
+	}
+
+	// Completion returns to the results and re-runs the checks
+	result, _ = m.Update(installCompleteMsg{})
+	m = result.(Model)
+	if m.Screen != ScreenDiagnoseResults || m.DiagnoseFixMode || !m.DiagnoseRunning {
+		t.Errorf("expected re-check after fix, got screen %v fixMode %v running %v", m.Screen, m.DiagnoseFixMode, m.DiagnoseRunning)
+	}
+
+	// Esc goes back to the symptom list
+	m.DiagnoseRunning = false
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = result.(Model)
+	if m.Screen != ScreenDiagnoseSymptom || m.Cursor != 1 {
+		t.Errorf("expected symptom screen with cursor 1, got %v/%d", m.Screen, m.Cursor)
+	}
+}
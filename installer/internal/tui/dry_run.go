@@ -0,0 +1,89 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
+)
+
+// errDryRunStep is returned by the step runners if anything tries to execute
+// a step while dry-run mode is on
+func errDryRunStep(stepID string) error {
+	return fmt.Errorf("dry run: refusing to execute step %q", stepID)
+}
+
+// writeDryRunPlan prints the resolved choices, the config backup decision and
+// every planned step in order. It uses the same SetupInstallSteps plan the TUI
+// runs, so what is printed is what an install would do on this machine.
+func writeDryRunPlan(w io.Writer, m *Model) error {
+	m.SetupInstallSteps()
+
+	c := m.Choices
+	fmt.Fprintln(w, "🧪 Dry run — nothing will be installed")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Resolved choices:")
+	fmt.Fprintf(w, "  OS:           %s\n", c.OS)
+	fmt.Fprintf(w, "  Terminal:     %s\n", c.Terminal)
+	fmt.Fprintf(w, "  Shell:        %s\n", c.Shell)
+	fmt.Fprintf(w, "  Zsh merge:    %v\n", c.ZshMerge)
+	fmt.Fprintf(w, "  Window Mgr:   %s\n", c.WindowMgr)
+	if len(wmExtraOptions[c.WindowMgr]) > 0 {
+		fmt.Fprintf(w, "  WM Extras:    %s\n", strings.Join(wmExtraIDs(selectedWMExtras(c.WindowMgr, c.WMExtras)), ","))
+	}
+	fmt.Fprintf(w, "  Font:         %v\n", c.InstallFont)
+	fmt.Fprintf(w, "  Neovim:       %v\n", c.InstallNvim)
+	fmt.Fprintf(w, "  Zed:          %v\n", c.InstallZed)
+	fmt.Fprintf(w, "  AI Tools:     %s\n", listOrNone(c.AITools))
+	fmt.Fprintf(w, "  AI Framework: %v", c.InstallAIFramework)
+	if c.AIFrameworkPreset != "" {
+		fmt.Fprintf(w, " (preset %s)", c.AIFrameworkPreset)
+	} else if len(c.AIFrameworkModules) > 0 {
+		fmt.Fprintf(w, " (modules %s)", strings.Join(c.AIFrameworkModules, ","))
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "  Agent Teams:  %v\n", c.InstallAgentTeamsLite)
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(w, "Existing configs:")
+	if len(m.ExistingConfigs) == 0 {
+		fmt.Fprintln(w, "  (none found)")
+	}
+	for _, cfg := range m.ExistingConfigs {
+		fmt.Fprintf(w, "  • %s\n", cfg)
+	}
+	switch {
+	case !c.CreateBackup:
+		fmt.Fprintln(w, "Backup: disabled (--backup=false)")
+	case len(m.ExistingConfigs) == 0:
+		fmt.Fprintln(w, "Backup: not needed, nothing would be overwritten")
+	default:
+		fmt.Fprintf(w, "Backup: would be created under %s\n", paths.BackupRoot(os.Getenv("HOME")))
+	}
+	fmt.Fprintln(w)
+
+	fmt.Fprintf(w, "Planned steps (%d):\n", len(m.Steps))
+	for i, step := range m.Steps {
+		flags := ""
+		if step.Interactive {
+			flags += "  [interactive]"
+		}
+		if step.Retryable {
+			flags += "  [retried]"
+		}
+		fmt.Fprintf(w, "  %2d. %-12s %s%s\n", i+1, step.ID, step.Name, flags)
+		if step.Description != "" {
+			fmt.Fprintf(w, "      %s\n", step.Description)
+		}
+	}
+	return nil
+}
+
+func listOrNone(items []string) string {
+	if len(items) == 0 {
+		return "none"
+	}
+	return strings.Join(items, ", ")
+}
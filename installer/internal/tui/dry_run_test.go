@@ -0,0 +1,179 @@
+package tui
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+)
+
+// enableDryRun turns dry-run mode on for the duration of a test
+func enableDryRun(t *testing.T) {
+	t.Helper()
+	SetDryRunMode(true)
+	t.Cleanup(func() { SetDryRunMode(false) })
+}
+
+// shimPath replaces PATH with a directory of fake commands that record every
+// invocation in the returned log file
+func shimPath(t *testing.T, commands ...string) string {
+	t.Helper()
+	dir := t.TempDir()
+	logFile := filepath.Join(dir, "spawned.log")
+	for _, name := range commands {
+		script := "#!/bin/sh\necho \"" + name + " $*\" >> " + logFile + "\n"
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(script), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Setenv("PATH", dir)
+	return logFile
+}
+
+func TestWriteDryRunPlan(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	m := NewModel()
+	m.SystemInfo = &system.SystemInfo{OS: system.OSArch}
+	m.Choices = UserChoices{
+		OS:           "linux",
+		Terminal:     "kitty",
+		Shell:        "zsh",
+		WindowMgr:    "tmux",
+		InstallNvim:  true,
+		CreateBackup: true,
+		AITools:      []string{"claude"},
+	}
+	m.ExistingConfigs = []string{"nvim: ~/.config/nvim"}
+
+	var out bytes.Buffer
+	if err := writeDryRunPlan(&out, &m); err != nil {
+		t.Fatalf("writeDryRunPlan: %v", err)
+	}
+	plan := out.String()
+
+	for _, want := range []string{
+		"Shell:        zsh",
+		"AI Tools:     claude",
+		"• nvim: ~/.config/nvim",
+		"Backup: would be created under",
+	} {
+		if !strings.Contains(plan, want) {
+			t.Errorf("plan should contain %q\n%s", want, plan)
+		}
+	}
+
+	// Every step is listed in plan order with its name, description and interactive flag
+	last := -1
+	for _, step := range m.Steps {
+		idx := strings.Index(plan, " "+step.ID+" ")
+		if idx < 0 {
+			t.Errorf("step %q missing from plan", step.ID)
+			continue
+		}
+		if idx < last {
+			t.Errorf("step %q printed out of order", step.ID)
+		}
+		last = idx
+		line := plan[idx:]
+		line = line[:strings.IndexByte(line, '\n')]
+		if !strings.Contains(line, step.Name) {
+			t.Errorf("step %q line should show its name %q: %q", step.ID, step.Name, line)
+		}
+		if step.Interactive != strings.Contains(line, "[interactive]") {
+			t.Errorf("step %q line has the wrong interactive marker: %q", step.ID, line)
+		}
+		if step.Description != "" && !strings.Contains(plan, "      "+step.Description+"\n") {
+			t.Errorf("step %q description %q missing", step.ID, step.Description)
+		}
+	}
+	if !strings.Contains(plan, "[interactive]") {
+		t.Error("Linux deps need sudo and should be marked interactive")
+	}
+}
+
+func TestWriteDryRunPlanBackupDecision(t *testing.T) {
+	tests := []struct {
+		name     string
+		backup   bool
+		existing []string
+		want     string
+	}{
+		{"disabled", false, []string{"fish: ~/.config/fish"}, "Backup: disabled"},
+		{"nothing to back up", true, nil, "Backup: not needed"},
+		{"would back up", true, []string{"fish: ~/.config/fish"}, "Backup: would be created"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewModel()
+			m.SystemInfo = &system.SystemInfo{HasBrew: true, HasXcode: true}
+			m.Choices = UserChoices{OS: "mac", Shell: "fish", Terminal: "none", WindowMgr: "none", CreateBackup: tt.backup}
+			m.ExistingConfigs = tt.existing
+
+			var out bytes.Buffer
+			writeDryRunPlan(&out, &m)
+			if !strings.Contains(out.String(), tt.want) {
+				t.Errorf("expected %q in\n%s", tt.want, out.String())
+			}
+		})
+	}
+}
+
+// TestRunNonInteractiveDryRunSpawnsNothing runs the real non-interactive entry
+// point in dry-run mode with every installer command shimmed, and checks that
+// none of them ran and nothing was cloned
+func TestRunNonInteractiveDryRunSpawnsNothing(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	logFile := shimPath(t, "git", "brew", "bash", "sh", "sudo", "curl", "apt-get", "pacman", "dnf", "chsh", "fish", "zsh", "nvim", "tmux")
+	enableDryRun(t)
+	defer SetNonInteractiveMode(false)
+
+	repoDir := filepath.Join(home, "Javi.Dots")
+	choices := UserChoices{
+		Terminal:     "none",
+		Shell:        "fish",
+		WindowMgr:    "tmux",
+		InstallNvim:  true,
+		InstallFont:  true,
+		CreateBackup: true,
+		AITools:      []string{"claude", "opencode"},
+	}
+	if err := RunNonInteractive(choices, repoDir, DefaultRepoURL); err != nil {
+		t.Fatalf("dry run should plan cleanly, got %v", err)
+	}
+
+	if data, err := os.ReadFile(logFile); err == nil {
+		t.Errorf("dry run spawned commands:\n%s", data)
+	}
+	if _, err := os.Stat(repoDir); !os.IsNotExist(err) {
+		t.Error("dry run must not clone the repository")
+	}
+}
+
+func TestRunNonInteractiveDryRunInvalidChoices(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	enableDryRun(t)
+	defer SetNonInteractiveMode(false)
+
+	err := RunNonInteractive(UserChoices{Shell: "tcsh", Terminal: "none", WindowMgr: "none"}, DefaultRepoDir, DefaultRepoURL)
+	if err == nil || !strings.Contains(err.Error(), "shell") {
+		t.Errorf("expected an invalid shell error, got %v", err)
+	}
+}
+
+func TestStepRunnersRefuseDuringDryRun(t *testing.T) {
+	enableDryRun(t)
+	m := NewModel()
+
+	if err := executeStep(context.Background(), "clone", &m); err == nil || !strings.Contains(err.Error(), "dry run") {
+		t.Errorf("executeStep should refuse in dry-run mode, got %v", err)
+	}
+	msg := runInteractiveStep("setshell", &m)()
+	if finished, ok := msg.(execFinishedMsg); !ok || finished.err == nil {
+		t.Errorf("runInteractiveStep should refuse in dry-run mode, got %#v", msg)
+	}
+}
@@ -0,0 +1,220 @@
+package tui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// frameworkPresetsVersion is the layout of framework-presets.json; files
+// written by a newer installer are refused rather than misread
+const frameworkPresetsVersion = 1
+
+// frameworkPresetsFile holds the custom module selections saved as presets
+type frameworkPresetsFile struct {
+	Version int               `json:"version"`
+	Presets []frameworkPreset `json:"presets"`
+}
+
+// frameworkPreset is a custom module selection saved under a name. Modules are
+// kept by ID per category, so reordering moduleCategories can't shift them.
+type frameworkPreset struct {
+	Name    string              `json:"name"`
+	SavedAt time.Time           `json:"saved_at"`
+	Modules map[string][]string `json:"modules"` // Category ID → item IDs
+}
+
+// userPresetsStart is the option index of the first saved preset on
+// ScreenAIFrameworkPreset: Custom, separator, six built-ins, separator
+const userPresetsStart = 9
+
+func frameworkPresetsPath(home string) string {
+	return filepath.Join(paths.DataDir(home), "framework-presets.json")
+}
+
+// loadFrameworkPresets reads the saved presets; a missing file has none
+func loadFrameworkPresets(home string) ([]frameworkPreset, error) {
+	data, err := os.ReadFile(frameworkPresetsPath(home))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var file frameworkPresetsFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("invalid framework presets file: %w", err)
+	}
+	if file.Version > frameworkPresetsVersion {
+		return nil, fmt.Errorf("framework presets version %d is newer than this installer supports (%d); update the installer", file.Version, frameworkPresetsVersion)
+	}
+	return file.Presets, nil
+}
+
+// saveFrameworkPreset stores sel as the preset name under home, replacing a
+// preset with the same name, and returns the file written
+func saveFrameworkPreset(home, name string, sel map[string][]bool) (string, error) {
+	if err := validateProfileName(name); err != nil {
+		return "", err
+	}
+	presets, err := loadFrameworkPresets(home)
+	if err != nil {
+		return "", err
+	}
+	preset := presetFromSelection(name, sel)
+	replaced := false
+	for i := range presets {
+		if presets[i].Name == name {
+			presets[i] = preset
+			replaced = true
+		}
+	}
+	if !replaced {
+		presets = append(presets, preset)
+	}
+
+	data, err := json.MarshalIndent(frameworkPresetsFile{Version: frameworkPresetsVersion, Presets: presets}, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	path := frameworkPresetsPath(home)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// presetFromSelection keeps the IDs of the selected items of every category
+func presetFromSelection(name string, sel map[string][]bool) frameworkPreset {
+	preset := frameworkPreset{Name: name, SavedAt: time.Now(), Modules: make(map[string][]string)}
+	for _, cat := range moduleCategories {
+		for i, on := range sel[cat.ID] {
+			if on && i < len(cat.Items) {
+				preset.Modules[cat.ID] = append(preset.Modules[cat.ID], cat.Items[i].ID)
+			}
+		}
+	}
+	return preset
+}
+
+// selection rebuilds the AICategorySelected map of the preset. Modules that no
+// longer exist in moduleCategories are returned as "category/item" keys.
+func (p frameworkPreset) selection() (sel map[string][]bool, missing []string) {
+	sel = make(map[string][]bool, len(moduleCategories))
+	known := make(map[string]bool)
+	for _, cat := range moduleCategories {
+		bools := make([]bool, len(cat.Items))
+		for _, id := range p.Modules[cat.ID] {
+			for i, item := range cat.Items {
+				if item.ID == id {
+					bools[i] = true
+					known[frameworkItemKey(cat.ID, id)] = true
+				}
+			}
+		}
+		sel[cat.ID] = bools
+	}
+	for catID, ids := range p.Modules {
+		for _, id := range ids {
+			if key := frameworkItemKey(catID, id); !known[key] {
+				missing = append(missing, key)
+			}
+		}
+	}
+	slices.Sort(missing)
+	return sel, missing
+}
+
+// moduleCount is how many modules the preset selects
+func (p frameworkPreset) moduleCount() int {
+	n := 0
+	for _, ids := range p.Modules {
+		n += len(ids)
+	}
+	return n
+}
+
+// userPresetOptions lists the saved presets below the built-ins
+func (m Model) userPresetOptions() []string {
+	if len(m.AIFrameworkUserPresets) == 0 {
+		return nil
+	}
+	opts := []string{"─────────────"}
+	for _, p := range m.AIFrameworkUserPresets {
+		opts = append(opts, fmt.Sprintf("📁 %s — %d modules", p.Name, p.moduleCount()))
+	}
+	return opts
+}
+
+// applyFrameworkPreset restores a saved preset and opens the categories to review it
+func (m Model) applyFrameworkPreset(p frameworkPreset) Model {
+	sel, missing := p.selection()
+	m.Choices.AIFrameworkPreset = ""
+	m.AICategorySelected = sel
+	m.AIFrameworkPresetNotice = "📁 Loaded preset " + p.Name
+	if len(missing) > 0 {
+		m.AIFrameworkPresetNotice += fmt.Sprintf(" (skipped %d modules that no longer exist: %s)", len(missing), strings.Join(missing, ", "))
+	}
+	m.Screen = ScreenAIFrameworkCategories
+	m.Cursor = 0
+	return m
+}
+
+// enterFrameworkPresetSave asks for a name to save the current selection under
+func (m Model) enterFrameworkPresetSave() Model {
+	m.ProjectPathInput = ""
+	m.ProjectPathCursor = 0
+	m.ProjectPathError = ""
+	m.ProjectPathMode = PathModeTyping
+	m.Screen = ScreenAIFrameworkPresetSave
+	return m
+}
+
+// handleFrameworkPresetSaveKeys edits the name; enter saves and returns to the summary
+func (m Model) handleFrameworkPresetSaveKeys(key string) (tea.Model, tea.Cmd) {
+	if key != "enter" {
+		return m.editPathInput(key), nil
+	}
+	name := strings.TrimSpace(m.ProjectPathInput)
+	if err := validateProfileName(name); err != nil {
+		m.ProjectPathError = "Invalid name: " + err.Error()
+		return m, nil
+	}
+	home := os.Getenv("HOME")
+	path, err := saveFrameworkPreset(home, name, m.AICategorySelected)
+	if err != nil {
+		m.ProjectPathError = "Could not save the preset: " + err.Error()
+		return m, nil
+	}
+	m.AIFrameworkPresetNotice = "💾 Saved preset " + name + " to " + contractHome(path)
+	m.AIFrameworkUserPresets, _ = loadFrameworkPresets(home)
+	m.Screen = ScreenAIFrameworkSummary
+	m.Cursor = 0
+	return m, nil
+}
+
+func (m Model) renderFrameworkPresetSave() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n\n")
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render("  " + frameworkFeaturesFlag(collectSelectedFeatures(m.AICategorySelected))))
+	s.WriteString("\n\n")
+	s.WriteString(m.renderPathInput())
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render("  Enter: save  •  Esc: cancel"))
+
+	return s.String()
+}
@@ -0,0 +1,162 @@
+package tui
+
+import (
+	"os"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestFrameworkPresetSaveLoad(t *testing.T) {
+	home := t.TempDir()
+
+	presets, err := loadFrameworkPresets(home)
+	if err != nil || presets != nil {
+		t.Fatalf("a missing file should have no presets, got %v, %v", presets, err)
+	}
+
+	sel := summarySelection()
+	if _, err := saveFrameworkPreset(home, "my-stack", sel); err != nil {
+		t.Fatal(err)
+	}
+	sel["hooks"][1] = true
+	if _, err := saveFrameworkPreset(home, "other", sel); err != nil {
+		t.Fatal(err)
+	}
+	// Saving under an existing name replaces it in place
+	sel["hooks"][1] = false
+	sel["skills"][1] = false
+	if _, err := saveFrameworkPreset(home, "my-stack", sel); err != nil {
+		t.Fatal(err)
+	}
+
+	presets, err = loadFrameworkPresets(home)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(presets) != 2 || presets[0].Name != "my-stack" || presets[1].Name != "other" {
+		t.Fatalf("expected my-stack and other, got %+v", presets)
+	}
+	got, missing := presets[0].selection()
+	if len(missing) != 0 {
+		t.Errorf("no modules should be missing, got %v", missing)
+	}
+	for _, cat := range moduleCategories {
+		if !slices.Equal(got[cat.ID], sel[cat.ID]) {
+			t.Errorf("%s = %v, want %v", cat.ID, got[cat.ID], sel[cat.ID])
+		}
+	}
+	if presets[0].moduleCount() != 4 {
+		t.Errorf("moduleCount = %d, want 4", presets[0].moduleCount())
+	}
+
+	data, _ := os.ReadFile(frameworkPresetsPath(home))
+	if !strings.Contains(string(data), `"version": 1`) {
+		t.Errorf("the file should carry its version:\n%s", data)
+	}
+}
+
+func TestFrameworkPresetSaveRejectsBadNames(t *testing.T) {
+	home := t.TempDir()
+	for _, name := range []string{"", "../escape", ".hidden", "has space"} {
+		if _, err := saveFrameworkPreset(home, name, summarySelection()); err == nil {
+			t.Errorf("%q should be rejected", name)
+		}
+	}
+}
+
+func TestFrameworkPresetLoadErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"not json", "{", "invalid framework presets file"},
+		{"newer version", `{"version": 2, "presets": []}`, "newer than this installer supports"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			home := t.TempDir()
+			path := frameworkPresetsPath(home)
+			os.MkdirAll(strings.TrimSuffix(path, "framework-presets.json"), 0755)
+			os.WriteFile(path, []byte(tt.content), 0644)
+			if _, err := loadFrameworkPresets(home); err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestFrameworkPresetIgnoresMissingModules(t *testing.T) {
+	p := frameworkPreset{Name: "old", Modules: map[string][]string{
+		"hooks":   {"commit-guard", "retired-hook"},
+		"retired": {"anything"},
+		"sdd":     {"sdd-agent-teams"},
+	}}
+	sel, missing := p.selection()
+	if want := []string{"hooks/retired-hook", "retired/anything"}; !slices.Equal(missing, want) {
+		t.Errorf("missing = %v, want %v", missing, want)
+	}
+	if _, ok := sel["retired"]; ok {
+		t.Error("unknown categories should not reach the selection")
+	}
+	for _, cat := range moduleCategories {
+		if len(sel[cat.ID]) != len(cat.Items) {
+			t.Errorf("%s should have one slot per item, got %d", cat.ID, len(sel[cat.ID]))
+		}
+	}
+	if !isAgentTeamsLiteSelected(sel) || !slices.Equal(collectSelectedFeatures(sel), []string{"hooks"}) {
+		t.Errorf("the known modules should be restored, got %v", collectSelectedFeatures(sel))
+	}
+}
+
+func TestFrameworkPresetFlow(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	m := NewModel()
+	m.Screen = ScreenAIFrameworkCategories
+	m.Choices.AITools = []string{"claude"}
+	m.Choices.InstallAIFramework = true
+	m.AICategorySelected = summarySelection()
+	m.Cursor = len(m.GetCurrentOptions()) - 1
+	m, _ = simulateKeyPress(m, "enter")
+	if m.Screen != ScreenAIFrameworkSummary {
+		t.Fatalf("expected the summary, got %v", m.Screen)
+	}
+
+	// Summary → Save as preset → name → back on the summary
+	m.Cursor = slices.Index(m.GetCurrentOptions(), "💾 Save as preset…")
+	m, _ = simulateKeyPress(m, "enter")
+	if m.Screen != ScreenAIFrameworkPresetSave {
+		t.Fatalf("expected the name prompt, got %v", m.Screen)
+	}
+	m = typeKeys(t, m, runes("w"), runes("e"), runes("b"))
+	m, _ = simulateKeyPress(m, "enter")
+	if m.Screen != ScreenAIFrameworkSummary || !strings.Contains(m.AIFrameworkPresetNotice, "Saved preset web") {
+		t.Fatalf("saving should return to the summary with a notice, got %v %q", m.Screen, m.AIFrameworkPresetNotice)
+	}
+
+	// A new run lists it below the built-ins and restores it for review
+	m = NewModel()
+	m.Screen = ScreenAIFrameworkConfirm
+	m.Choices.AITools = []string{"claude"}
+	m.Cursor = 1 // Customize
+	m, _ = simulateKeyPress(m, "enter")
+	opts := m.GetCurrentOptions()
+	if len(opts) != userPresetsStart+1 || opts[userPresetsStart] != "📁 web — 5 modules" {
+		t.Fatalf("expected the saved preset after the built-ins, got %v", opts)
+	}
+	m.Cursor = userPresetsStart
+	m, _ = simulateKeyPress(m, "enter")
+	if m.Screen != ScreenAIFrameworkCategories || m.Choices.AIFrameworkPreset != "" {
+		t.Fatalf("a saved preset should open the categories, got %v", m.Screen)
+	}
+	for _, cat := range moduleCategories {
+		if want := summarySelection()[cat.ID]; !slices.Equal(m.AICategorySelected[cat.ID], want) {
+			t.Errorf("%s = %v, want %v", cat.ID, m.AICategorySelected[cat.ID], want)
+		}
+	}
+	if !strings.Contains(m.View(), "Loaded preset web") {
+		t.Error("the categories should say which preset was loaded")
+	}
+}
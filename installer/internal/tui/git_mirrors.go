@@ -0,0 +1,15 @@
+package tui
+
+import "github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+
+// Repositories cloned besides the dotfiles; every clone also tries the mirrors
+const (
+	skillsRepoURL    = "https://github.com/Gentleman-Programming/Gentleman-Skills.git"
+	frameworkRepoURL = "https://github.com/JNZader/project-starter-framework.git"
+)
+
+// cloneURLs lists repoURL and its copy on every mirror, those of
+// GENTLEMAN_MIRRORS first and then the config file's
+func cloneURLs(repoURL string, choices UserChoices) []string {
+	return system.MirrorURLs(repoURL, append(system.EnvMirrors(), choices.Mirrors...))
+}
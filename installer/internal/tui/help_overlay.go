@@ -0,0 +1,106 @@
+package tui
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// typingText reports whether the screen takes typed text right now, so "?"
+// is input there and only f1 opens the help overlay. (ctrl+? can't be used:
+// terminals send it as backspace.)
+func (m Model) typingText() bool {
+	switch m.Screen {
+	case ScreenProjectPath, ScreenProfileSave, ScreenAIFrameworkPresetSave, ScreenInstallRefInput, ScreenTrainerImport, ScreenTrainerResetAll, ScreenSkillManifest, ScreenSkillURL,
+		ScreenTrainerLesson, ScreenTrainerPractice, ScreenTrainerBoss:
+		return true
+	case ScreenTrainerTimed:
+		return !m.timedFinished()
+	case ScreenSkillInstall, ScreenSkillRemove:
+		return m.SkillFilterActive
+	case ScreenAIFrameworkCategories:
+		return m.AIModuleSearchActive
+	}
+	return m.keymapSearching() && m.KeymapSearchActive
+}
+
+// renderHelpOverlay renders the box listing the keys of the screen
+func (m Model) renderHelpOverlay() string {
+	var s strings.Builder
+
+	title := m.GetScreenTitle()
+	if title == "" {
+		title = "Keys"
+	}
+	s.WriteString(m.Theme.Title.Render(title))
+	s.WriteString("\n\n")
+
+	// Global keys are listed once, under Everywhere
+	var bindings []KeyBinding
+	for _, b := range m.keyBindings() {
+		if !slices.Contains(globalKeyBindings, b) {
+			bindings = append(bindings, b)
+		}
+	}
+	keyWidth := 0
+	for _, list := range [][]KeyBinding{bindings, globalKeyBindings} {
+		for _, b := range list {
+			keyWidth = max(keyWidth, lipgloss.Width(b.Key))
+		}
+	}
+	writeBindings := func(bindings []KeyBinding) {
+		for _, b := range bindings {
+			pad := strings.Repeat(" ", keyWidth-lipgloss.Width(b.Key))
+			s.WriteString("  " + m.Theme.Key.Render(b.Key) + pad + "  " + b.Description + "\n")
+		}
+	}
+
+	if len(bindings) == 0 {
+		s.WriteString(m.Theme.Muted.Render("  "+m.T("help_overlay.no_keys")) + "\n")
+	}
+	writeBindings(bindings)
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Info.Render(m.T("help_overlay.everywhere")))
+	s.WriteString("\n")
+	writeBindings(globalKeyBindings)
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render(m.T("help_overlay.close")))
+
+	return m.Theme.Box.Render(s.String())
+}
+
+// overlayCenter draws box in the middle of a width x height area over bg,
+// which is shown dimmed around it. A zero width or height uses bg's size.
+func overlayCenter(t *Theme, bg, box string, width, height int) string {
+	lines := strings.Split(ansi.Strip(bg), "\n")
+	for len(lines) < height {
+		lines = append(lines, "")
+	}
+	for _, line := range lines {
+		width = max(width, ansi.StringWidth(line))
+	}
+
+	boxLines := strings.Split(box, "\n")
+	boxWidth := lipgloss.Width(box)
+	for len(lines) < len(boxLines) {
+		lines = append(lines, "")
+	}
+	top := (len(lines) - len(boxLines)) / 2
+	left := max((width-boxWidth)/2, 0)
+
+	for i, line := range lines {
+		if i < top || i >= top+len(boxLines) {
+			lines[i] = t.Muted.Render(line)
+			continue
+		}
+		if w := ansi.StringWidth(line); w < left {
+			line += strings.Repeat(" ", left-w)
+		}
+		lines[i] = t.Muted.Render(ansi.Truncate(line, left, "")) +
+			boxLines[i-top] +
+			t.Muted.Render(ansi.TruncateLeft(line, left+boxWidth, ""))
+	}
+	return strings.Join(lines, "\n")
+}
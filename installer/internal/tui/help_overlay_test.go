@@ -0,0 +1,114 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestEveryScreenHasKeyBindings(t *testing.T) {
+	for screen, name := range screenNames {
+		t.Run(name, func(t *testing.T) {
+			bindings, ok := screenKeyBindings[screen]
+			if !ok {
+				t.Fatal("no key bindings registered")
+			}
+			for _, b := range bindings {
+				if b.Key == "" || b.Description == "" {
+					t.Errorf("binding %+v needs a key and a description", b)
+				}
+			}
+		})
+	}
+}
+
+// The footer and the overlay come from the same bindings, in every mode
+func TestFooterHintsAreHelpBindings(t *testing.T) {
+	m := NewModel()
+	m.Screen = ScreenSkillResult
+	if got := m.footerHints(); len(got) != 1 || got[0].Action != "return" {
+		t.Errorf("expected only the return hint, got %v", got)
+	}
+	m.SkillUndo = &skillOperation{Kind: "install", Changes: []skillChange{{Name: "x", Created: []SkillLink{{Path: "/tmp/x"}}}}}
+	if got := m.footerHints(); len(got) != 2 || got[1].Action != "undo" {
+		t.Errorf("an undoable result should add the undo hint, got %v", got)
+	}
+	m.Width, m.Height = 100, 40
+	m.ShowHelp = true
+	if view := ansi.Strip(m.View()); !strings.Contains(view, "Undo the last install or removal") {
+		t.Errorf("the overlay should explain the undo key:\n%s", view)
+	}
+
+	m.Screen = ScreenProjectInstalling
+	m.ShowHelp = false
+	if got := m.footerHints(); len(got) != 3 || got[1].Key != "ctrl+c" {
+		t.Errorf("the footer should show the global quit key, got %v", got)
+	}
+	m.ShowHelp = true
+	if view := ansi.Strip(m.View()); strings.Count(view, "Quit right away") != 1 {
+		t.Errorf("global keys should be listed once, under Everywhere:\n%s", view)
+	}
+}
+
+func TestHelpOverlayToggle(t *testing.T) {
+	press := func(m Model, msg tea.KeyMsg) Model {
+		result, _ := m.Update(msg)
+		return result.(Model)
+	}
+	question := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'?'}}
+
+	m := newFlowModel(t)
+	m.Screen = ScreenMainMenu
+	m.Width, m.Height = 100, 40
+
+	m = press(m, question)
+	if !m.ShowHelp {
+		t.Fatal("? should open the help overlay")
+	}
+	view := ansi.Strip(m.View())
+	for _, want := range []string{"Press any key to close", "Select the option under the cursor", "ctrl+z"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("the overlay should contain %q:\n%s", want, view)
+		}
+	}
+
+	// Any key closes it without acting on the screen
+	m = press(m, tea.KeyMsg{Type: tea.KeyDown})
+	if m.ShowHelp || m.Cursor != 0 {
+		t.Errorf("a key should only close the overlay, got help=%v cursor=%d", m.ShowHelp, m.Cursor)
+	}
+
+	// Clicks are ignored while it's open
+	m.ShowHelp = true
+	if m = click(m, 5); m.Screen != ScreenMainMenu || !m.ShowHelp {
+		t.Error("the mouse should be ignored while the overlay is open")
+	}
+}
+
+func TestHelpOverlayOnTextInput(t *testing.T) {
+	m := newFlowModel(t)
+	m.Screen = ScreenProjectPath
+
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'?'}})
+	m = result.(Model)
+	if m.ShowHelp || !strings.HasSuffix(m.ProjectPathInput, "?") {
+		t.Fatalf("? should be typed into the path, got %q (help=%v)", m.ProjectPathInput, m.ShowHelp)
+	}
+
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyF1})
+	m = result.(Model)
+	if !m.ShowHelp || !strings.Contains(ansi.Strip(m.View()), "Open or close the folder browser") {
+		t.Error("f1 should open the overlay on text input screens")
+	}
+}
+
+func TestOverlayCenter(t *testing.T) {
+	bg := strings.Repeat("abcdefghij\n", 4) + "abcdefghij"
+	got := strings.Split(ansi.Strip(overlayCenter(NewTheme(defaultTheme), bg, "XX\nYY", 10, 5)), "\n")
+	want := []string{"abcdefghij", "abcdXXghij", "abcdYYghij", "abcdefghij", "abcdefghij"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("overlayCenter = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,100 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+)
+
+// Languages the UI text is translated to. English is the default and has
+// every message; the others fall back to it for any message they lack.
+const (
+	langEnglish = "en"
+	langSpanish = "es"
+)
+
+// languageNames are the selectable languages in Settings order, each named
+// in itself
+var languageNames = []struct{ Code, Name string }{
+	{langEnglish, "English"},
+	{langSpanish, "Español"},
+}
+
+// messages is the UI text catalog, messages[language][key]. Keys start with
+// the screen they belong to ("os_select.title") or "common." when shared.
+var messages = map[string]map[string]string{
+	langEnglish: messagesEnglish,
+	langSpanish: messagesSpanish,
+}
+
+// languageEnv overrides the language picked in Settings
+const languageEnv = "GENTLEMAN_LANG"
+
+// languageCodes lists the codes of languageNames
+func languageCodes() []string {
+	codes := make([]string, len(languageNames))
+	for i, l := range languageNames {
+		codes[i] = l.Code
+	}
+	return codes
+}
+
+// languageName is the name of the language code, or the code itself when unknown
+func languageName(code string) string {
+	for _, l := range languageNames {
+		if l.Code == code {
+			return l.Name
+		}
+	}
+	return code
+}
+
+// normalizeLanguage reduces a setting or locale value ("es", "es_AR.UTF-8",
+// "ES") to a catalog language, or "" when there is no catalog for it
+func normalizeLanguage(value string) string {
+	code, _, _ := strings.Cut(strings.ToLower(value), ".")
+	code, _, _ = strings.Cut(code, "_")
+	code, _, _ = strings.Cut(code, "-")
+	if slices.Contains(languageCodes(), code) {
+		return code
+	}
+	return ""
+}
+
+// activeLanguage is the language picked in Settings, unless GENTLEMAN_LANG
+// names another one with a catalog
+func activeLanguage(setting string) string {
+	if lang := normalizeLanguage(os.Getenv(languageEnv)); lang != "" {
+		return lang
+	}
+	if lang := normalizeLanguage(setting); lang != "" {
+		return lang
+	}
+	return langEnglish
+}
+
+// T is the UI text for key in the model's language, falling back to English
+// when the language lacks it and to the key itself when English does too
+func (m Model) T(key string) string {
+	if text, ok := messages[m.Lang][key]; ok {
+		return text
+	}
+	if text, ok := messages[langEnglish][key]; ok {
+		return text
+	}
+	return key
+}
+
+// Tf is T for messages with fmt verbs, filled in from args
+func (m Model) Tf(key string, args ...any) string {
+	return fmt.Sprintf(m.T(key), args...)
+}
+
+// canonicalOptions are the current screen's options in English. Key handlers
+// pick rows by their text, so they match against these; GetCurrentOptions
+// has the same rows in the model's language for the views.
+func (m Model) canonicalOptions() []string {
+	m.Lang = langEnglish
+	return m.GetCurrentOptions()
+}
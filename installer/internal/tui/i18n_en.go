@@ -0,0 +1,770 @@
+package tui
+
+// messagesEnglish has every message the UI shows; the other catalogs in
+// messages translate them
+var messagesEnglish = map[string]string{
+	"common.back":                   "← Back",
+	"common.lazyvim_guide":          "📖 LazyVim Guide",
+	"common.back_to_main_menu":      "← Back to main menu",
+	"common.none":                   "None",
+	"common.select_all":             "🔘 Select All",
+	"common.confirm_selection":      "✅ Confirm selection",
+	"common.cancel":                 "❌ Cancel",
+	"common.full_log":               "Full log: %s",
+	"common.category_not_found":     "Category not found",
+	"common.more_up":                "↑ more",
+	"common.more_down":              "↓ more",
+	"common.showing":                "Showing %d-%d of %d",
+	"common.lines_scroll":           "Lines %d-%d of %d (↑↓ to scroll, PgUp/PgDn for fast scroll)",
+	"common.skill_catalog_fetching": "Fetching skill catalog...",
+	"common.more_above":             "▲ %d more above",
+	"common.more_below":             "▼ %d more below",
+	"common.search":                 "Search: %s",
+	"common.type_to_search":         "Type to search",
+
+	"welcome.title":       "Welcome to Javi.Dots Installer",
+	"welcome.help":        "Press [Enter] to start • [Space q] to quit",
+	"welcome.help_resume": "Press [r] to resume previous installation • [Enter] to start • [Space q] to quit",
+	"welcome.tagline":     "Your terminal environment, configured in minutes.",
+	"welcome.detected":    "Detected: %s",
+	"welcome.unfinished":  "⏸  Unfinished installation: %s",
+
+	"main_menu.title":        "Main Menu",
+	"main_menu.start":        "🚀 Start Installation",
+	"main_menu.update":       "🔁 Update Javi.Dots",
+	"main_menu.profile":      "📂 Install from Profile",
+	"main_menu.learn":        "📚 Learn & Practice",
+	"main_menu.restore":      "🔄 Restore from Backup",
+	"main_menu.project":      "📦 Initialize Project",
+	"main_menu.skills":       "🎯 Skill Manager",
+	"main_menu.ai_framework": "🧩 AI Framework",
+	"main_menu.check":        "🩻 Check Installation",
+	"main_menu.diagnose":     "🩺 Diagnose Setup",
+	"main_menu.uninstall":    "🧹 Uninstall",
+	"main_menu.settings":     "⚙️  Settings",
+	"main_menu.exit":         "❌ Exit",
+	"main_menu.unsupported":  unsupportedSuffix,
+	"main_menu.locked":       lockedSuffix,
+	"main_menu.prompt":       "What would you like to do?",
+	"main_menu.portable":     "🧳 Portable mode — installer state is kept in %s",
+	"main_menu.read_only":    "🔒 Read-only — another installer (%s) is running",
+
+	"learn_menu.title":   "📚 Learn & Practice",
+	"learn_menu.desc":    "Explore tools, keymaps, guides, and practice Vim",
+	"learn_menu.tools":   "📚 Learn About Tools",
+	"learn_menu.keymaps": "⌨️  Keymaps Reference",
+	"learn_menu.trainer": "🎮 Vim Trainer",
+
+	"os_select.title":    "Step 1: Select Your Operating System",
+	"os_select.desc":     "Detected: %s",
+	"os_select.detected": "%s (detected)",
+
+	"terminal_select.title":            "Step 2: Choose Terminal Emulator",
+	"terminal_select.desc_wsl":         "Note: Terminal emulators should be installed on Windows for WSL",
+	"terminal_select.desc":             "Select your preferred terminal emulator",
+	"terminal_select.alacritty_source": "Alacritty ⏱️  (builds from source, installs Rust ~5-10 min)",
+	"terminal_select.learn":            "ℹ️  Learn about terminals",
+
+	"font_select.title": "Step 3: Nerd Font Installation",
+	"font_select.desc":  "Iosevka Term Nerd Font is required for icons and glyphs",
+	"font_select.yes":   "Yes, install Iosevka Term Nerd Font",
+	"font_select.no":    "No, I already have it",
+
+	"shell_select.title": "Step 4: Choose Your Shell",
+	"shell_select.desc":  "Current shell: %s",
+	"shell_select.learn": "ℹ️  Learn about shells",
+
+	"wm_select.title": "Step 5: Choose Window Manager",
+	"wm_select.desc":  "Terminal multiplexer for managing sessions",
+	"wm_select.learn": "ℹ️  Learn about multiplexers",
+
+	"wm_extras.title": "Step 5: %s Extras",
+	"wm_extras.desc":  "Optional pieces of the %s config. Uncheck them all for a minimal config.",
+
+	"nvim_select.title":   "Step 6: Neovim Configuration",
+	"nvim_select.desc":    "Includes LSP, TreeSitter, and Gentleman config",
+	"nvim_select.yes":     "Yes, install Neovim with config",
+	"nvim_select.no":      "No, skip Neovim",
+	"nvim_select.learn":   "ℹ️  Learn about Neovim",
+	"nvim_select.keymaps": "⌨️  View Keymaps",
+
+	"zed_select.title": "Step 7: Zed Editor",
+	"zed_select.desc":  "High-performance editor with Vim mode and AI agent support",
+	"zed_select.yes":   "Yes, install Zed with config",
+	"zed_select.no":    "No, skip Zed",
+
+	"ai_tools_select.title": "Step 8: AI Coding Tools",
+	"ai_tools_select.desc":  "Toggle tools with Enter. Confirm when ready.",
+
+	"ai_framework_confirm.title":       "Step 9: AI Framework",
+	"ai_framework_confirm.desc":        "Agents, skills, hooks, and commands for AI coding tools\nRecommended: %s",
+	"ai_framework_confirm.recommended": "✨ Recommended for my selection (%s)",
+	"ai_framework_confirm.customize":   "🔧 Customize…",
+	"ai_framework_confirm.skip":        "No, skip framework",
+
+	"ai_framework_preset.title":     "Step 9: Choose Framework Preset",
+	"ai_framework_preset.desc":      "Presets bundle agents, skills, hooks, and commands by role",
+	"ai_framework_preset.custom":    "🔧 Custom — Pick individual modules",
+	"ai_framework_preset.minimal":   "🎯 Minimal — Core + git commands only",
+	"ai_framework_preset.frontend":  "🖥️  Frontend — React, Vue, Angular, testing, security hooks",
+	"ai_framework_preset.backend":   "⚙️  Backend — APIs, databases, microservices, security hooks",
+	"ai_framework_preset.fullstack": "🔄 Fullstack — Frontend + Backend + infra + all commands",
+	"ai_framework_preset.data":      "📊 Data — Data engineering, ML/AI, analytics",
+	"ai_framework_preset.complete":  "📦 Complete — Everything included",
+
+	"ai_framework_categories.title_apply": "🧩 AI Framework Modules",
+	"ai_framework_categories.title":       "Step 9: Select Module Categories",
+	"ai_framework_categories.desc_apply":  "Installed modules are pre-selected. Toggle to add or remove.",
+	"ai_framework_categories.desc":        "Select a category to configure its modules",
+	"ai_framework_categories.help":        "↑/k up • ↓/j down • [Enter] open/confirm • [/] search • [Esc] back",
+	"ai_framework_categories.counter":     "%s %s (%d/%d selected)",
+
+	"ai_framework_category_items.title":          "Step 9: Select Modules",
+	"ai_framework_category_items.desc":           "Toggle modules with Enter. Press Esc to go back.",
+	"ai_framework_category_items.title_category": "Step 9: %s %s",
+	"ai_framework_category_items.help":           "↑/k up • ↓/j down • [Space/Enter] toggle • [a] select all • [Esc] back",
+
+	"ai_framework_summary.title":   "Step 9: Review Module Selection",
+	"ai_framework_summary.desc":    "The selected modules, grouped by category, and what setup-global.sh receives",
+	"ai_framework_summary.proceed": "✅ Proceed with these modules",
+	"ai_framework_summary.back":    "← Back to edit",
+	"ai_framework_summary.save":    "💾 Save as preset…",
+
+	"ai_framework_preset_save.title": "💾 Save Framework Preset",
+	"ai_framework_preset_save.desc":  "Name this module selection to pick it again from the preset list:",
+
+	"ai_framework_apply_diff.title":      "🧩 Review AI Framework Changes",
+	"ai_framework_apply_diff.desc":       "Only the AI framework step will run",
+	"ai_framework_apply_diff.back":       "← Back to modules",
+	"ai_framework_apply_diff.apply":      "✅ Apply changes",
+	"ai_framework_apply_diff.no_changes": "No changes — your selection matches what's installed.",
+
+	"backup_confirm.title":            "⚠️  Existing Configs Detected",
+	"backup_confirm.with_backup":      "✅ Install with Backup (recommended)",
+	"backup_confirm.without_backup":   "⚠️  Install without Backup",
+	"backup_confirm.save_profile":     "💾 Save these choices as a profile",
+	"backup_confirm.help":             "↑/k up • ↓/j down • [Enter] select • [p] pin versions • [Esc] back",
+	"backup_confirm.overwritten":      "The following configs will be overwritten:",
+	"backup_confirm.explain":          "Creating a backup allows you to restore later if needed.",
+	"backup_confirm.estimating":       "Estimating backup size...",
+	"backup_confirm.no_estimate":      "No size estimate available",
+	"backup_confirm.after_exclusions": " → %s after exclusions",
+	"backup_confirm.caches":           "[c] Include caches (Oh My Zsh cache and logs)",
+	"backup_confirm.history":          "[h] Exclude shell history",
+	"backup_confirm.compress":         "[z] Compress (%s)",
+
+	"os_version_warning.title":         "⚠️  Unsupported OS Version",
+	"os_version_warning.desc":          "This OS release is older than the supported minimum",
+	"os_version_warning.desc_detected": "Detected %s %s; the minimum supported is %s",
+	"os_version_warning.continue":      "⚠️  Continue anyway",
+	"os_version_warning.abort":         "❌ Abort installation",
+	"os_version_warning.steps":         "Steps most likely to fail:",
+	"os_version_warning.may_fail":      "Installing may fail: %s.",
+
+	"disk_space_warning.title":    "⚠️  Not Enough Disk Space",
+	"disk_space_warning.desc":     "The planned install is estimated to need more space than is free",
+	"disk_space_warning.continue": "⚠️  Install anyway",
+	"disk_space_warning.abort":    "❌ Abort installation",
+
+	"wizard_review.title":        "Review Your Choices",
+	"wizard_review.desc":         "Pick an answer to change it, or install",
+	"wizard_review.install":      "✅ Install",
+	"wizard_review.os":           "Operating system",
+	"wizard_review.terminal":     "Terminal",
+	"wizard_review.font":         "Nerd Font",
+	"wizard_review.shell":        "Shell",
+	"wizard_review.zsh_merge":    "Keep .zshrc",
+	"wizard_review.wm":           "Multiplexer",
+	"wizard_review.wm_extras":    "Extras",
+	"wizard_review.nvim":         "Neovim",
+	"wizard_review.zed":          "Zed",
+	"wizard_review.ai_tools":     "AI tools",
+	"wizard_review.ai_framework": "AI framework",
+	"wizard_review.yes":          "yes",
+	"wizard_review.no":           "no",
+	"wizard_review.all":          "all",
+	"wizard_review.custom":       "custom, %d modules",
+
+	"restore_backup.title": "🔄 Restore from Backup",
+	"restore_backup.prune": "🧹 Prune old backups (keep %d, delete %d)",
+	"restore_backup.desc":  "Select a backup to restore or delete",
+	"restore_backup.empty": "No backups found.",
+
+	"restore_confirm.title":          "🔄 Confirm Restore",
+	"restore_confirm.yes":            "✅ Yes, restore this backup",
+	"restore_confirm.delete":         "🗑️  Delete this backup",
+	"restore_confirm.none":           "No backup selected",
+	"restore_confirm.contents":       "Contents:",
+	"restore_confirm.warning":        "⚠️  Restoring will overwrite your current configs!",
+	"restore_confirm.review":         "Files you changed since the install will be reviewed first.",
+	"restore_confirm.from":           "Backup from: %s",
+	"restore_confirm.format_dir":     "Format: directory",
+	"restore_confirm.format_archive": "Format: compressed archive (%s, extracted to a temp dir to restore)",
+
+	"restore_conflict.title":         "⚠️  Restore Conflict (%d/%d)",
+	"restore_conflict.keep":          "📄 Keep current file",
+	"restore_conflict.take":          "📦 Take backup version",
+	"restore_conflict.both":          "📑 Keep both (backup saved as %s)",
+	"restore_conflict.help":          "↑/k up • ↓/j down • [Enter] select • [a] apply to all • [Esc] cancel restore",
+	"restore_conflict.none":          "No conflicts to resolve",
+	"restore_conflict.not_in_backup": "Not in the backup; it may be a file you added or changed.",
+	"restore_conflict.differs":       "Changed since the install and differs from the backup.",
+	"restore_conflict.apply_all":     "%s [a] Apply to all %d remaining conflicts",
+
+	"restore_items.title": "🔄 Choose What to Restore",
+	"restore_items.desc":  "Toggle configs with Space; the ones left out stay as they are",
+
+	"backup_prune.title":  "🧹 Prune Old Backups",
+	"backup_prune.desc":   "Keeps the %d most recent backups and deletes the rest",
+	"backup_prune.delete": "🗑️  Delete %d old backup(s)",
+
+	"diagnose_symptom.title": "🩺 Diagnose Setup",
+	"diagnose_symptom.desc":  "What looks broken? The matching checks run automatically.",
+	"diagnose_symptom.help":  "↑/k up • ↓/j down • [Enter] run checks • [Esc] back",
+
+	"diagnose_results.title":       "🩺 Diagnosis",
+	"diagnose_results.rerun":       "🔁 Run checks again",
+	"diagnose_results.help":        "↑/k up • ↓/j down • [Enter] select • [r] re-run • [Esc] back",
+	"diagnose_results.no_fix":      "No automatic fix applies to these results.",
+	"diagnose_results.running":     "Running checks...",
+	"diagnose_results.fix_applied": "🔧 Fix applied: %s",
+
+	"install_check.title": "🩻 Check Installation",
+	"install_check.desc":  "Read-only checks of what the installer set up; nothing is changed",
+
+	"ghostty_warning.title":    "⚠️  Ghostty Compatibility Warning",
+	"ghostty_warning.desc":     "Ghostty installation may fail on Ubuntu/Debian.\nThe installer script only supports certain versions.",
+	"ghostty_warning.continue": "⚠️  Continue with Ghostty anyway",
+	"ghostty_warning.change":   "🔄 Choose a different terminal",
+	"ghostty_warning.cancel":   "❌ Cancel installation",
+
+	"zsh_merge_select.title":   "Step 4: Existing .zshrc Found",
+	"zsh_merge_select.desc":    "Merge sources the Gentleman.Dots config from a marked block\nand leaves the rest of your .zshrc untouched",
+	"zsh_merge_select.merge":   "🔀 Merge: keep my .zshrc, add a managed block",
+	"zsh_merge_select.replace": "♻️  Replace .zshrc with Gentleman.Dots config",
+
+	"installing.title_update": "Updating...",
+	"installing.title":        "Installing...",
+	"installing.help":         "[space+d] toggle details • [space+l] full log",
+	"installing.heading":      "🚀 Installing Javi.Dots",
+
+	"complete.title_update":      "Update Complete!",
+	"complete.title":             "Installation Complete!",
+	"complete.framework_updated": "✨ AI Framework Updated! ✨",
+	"complete.heading":           "✨ Installation Complete! ✨",
+	"complete.summary":           "Summary",
+	"complete.next_step":         "Next Step",
+	"complete.run_shell":         "To use your new shell now, run:",
+	"complete.framework_added":   "Added: %d module(s)",
+	"complete.framework_removed": "Removed: %d module(s)",
+	"complete.os":                "OS: %s",
+	"complete.terminal":          "Terminal: %s",
+	"complete.shell":             "Shell: %s",
+	"complete.wm":                "Window Manager: %s",
+	"complete.wm_extras":         "%s extras: %s",
+	"complete.font":              "Font: Iosevka Term Nerd Font",
+	"complete.editor":            "Editor: Neovim with Gentleman config",
+	"complete.total_time":        "Total time: %s",
+
+	"error.title":             "Error",
+	"error.restore_failed":    "Failed to restore backup: %v",
+	"error.prune_failed":      "Pruned %d backup(s), then: %v",
+	"error.step_failed":       "Step '%s' failed:\n%v",
+	"error.step_blocked":      "Step '%s' can't start: the steps it depends on never finish",
+	"error.failed":            "❌ Installation Failed",
+	"error.label":             "Error:",
+	"error.recent_logs":       "Recent logs:",
+	"error.help_retry":        "[r] retry • [space+q] quit",
+	"error.help_step":         "[r] retry failed step • [s] skip and continue • [space+q] quit",
+	"error.cancelled":         "⏹ Installation cancelled",
+	"error.cancelled_done":    "Completed (%d):",
+	"error.cancelled_skipped": "Skipped (%d):",
+	"error.cancelled_failed":  "Failed (%d):",
+	"error.help_resume":       "[r] resume • [space+q] quit",
+
+	"learn_terminals.title": "📚 Learn: Terminal Emulators",
+	"learn_terminals.desc":  "Select a terminal to learn more about it",
+
+	"learn_shells.title": "📚 Learn: Shells",
+	"learn_shells.desc":  "Select a shell to learn more about it",
+
+	"learn_wm.title": "📚 Learn: Window Managers",
+	"learn_wm.desc":  "Select a window manager to learn more about it",
+
+	"learn_nvim.title":    "📚 Learn: Neovim",
+	"learn_nvim.features": "View Features",
+	"learn_nvim.keymaps":  "View Keymaps",
+	"learn_nvim.desc":     "Explore Neovim features and keybindings",
+
+	"keymaps.title": "⌨️  Neovim Keymaps Reference",
+	"keymaps.desc":  "Select a category to view keybindings",
+
+	"keymap_category.title":       "⌨️  Keymaps",
+	"keymap_category.keys":        "Keys",
+	"keymap_category.mode":        "Mode",
+	"keymap_category.description": "Description",
+
+	"keymaps_menu.title":   "⌨️  Keymaps Reference",
+	"keymaps_menu.refresh": "🔄 Refresh from my config",
+	"keymaps_menu.desc":    "Select a tool to view its keybindings",
+
+	"keymaps_tmux.title": "⌨️  Tmux Keymaps",
+	"keymaps_tmux.desc":  "Select a category to view Tmux keybindings",
+
+	"keymaps_tmux_cat.title": "⌨️  Tmux Keymaps",
+
+	"keymaps_zellij.title": "⌨️  Zellij Keymaps",
+	"keymaps_zellij.desc":  "Select a category to view Zellij keybindings",
+
+	"keymaps_zellij_cat.title": "⌨️  Zellij Keymaps",
+
+	"keymaps_ghostty.title": "⌨️  Ghostty Keymaps",
+	"keymaps_ghostty.desc":  "Select a category to view Ghostty keybindings",
+
+	"keymaps_ghostty_cat.title": "⌨️  Ghostty Keymaps",
+
+	"learn_lazy_vim.title": "📖 LazyVim Guide",
+	"learn_lazy_vim.desc":  "Learn how to use and customize LazyVim",
+
+	"lazy_vim_topic.title":     "📖 LazyVim",
+	"lazy_vim_topic.not_found": "Topic not found",
+
+	"trainer_menu.title":         "🎮 Vim Trainer - Module Selection",
+	"trainer_menu.help":          "↑/k up • ↓/j down • [Enter/l] lesson • [p] practice • [b] boss • [r] reset • [i] placement • [t] speed run • [e/I] export/import • [s] settings • [q/Esc] back",
+	"trainer_menu.heading":       "🎮 Vim Mastery Trainer",
+	"trainer_menu.tagline":       "Master Vim motions through progressive challenges",
+	"trainer_menu.select_module": "Select a Module:",
+	"trainer_menu.score":         "Score: %d",
+	"trainer_menu.streak":        "Streak: %d",
+	"trainer_menu.bosses":        "Bosses: %d/7",
+	"trainer_menu.accuracy":      "Accuracy: %.0f%%",
+	"trainer_menu.placement":     "📍 Placement: %d/%d (%s)",
+	"trainer_menu.lessons":       "Lessons: %d/%d (%.0f%%)",
+	"trainer_menu.placed":        " (placement)",
+	"trainer_menu.practice":      "Practice: %.0f%%",
+	"trainer_menu.mastered":      "Mastered: %d/%d",
+	"trainer_menu.lessons_none":  "Lessons: 0/0",
+
+	"trainer_lesson.title": "🎮 Vim Trainer - Lesson",
+
+	"trainer_practice.title": "🎮 Vim Trainer - Practice",
+
+	"trainer_boss.title":     "🎮 Vim Trainer - Boss Fight!",
+	"trainer_boss.help":      "Type command • [Enter] submit • [Esc] forfeit",
+	"trainer_boss.none":      "No boss loaded",
+	"trainer_boss.challenge": "📋 Challenge:",
+	"trainer_boss.header":    "⚔️  BOSS FIGHT: %s",
+	"trainer_boss.status":    "Lives: %s%s  |  Step: %d/%d",
+	"trainer_boss.par":       "(par %s)",
+	"trainer_boss.time":      "Time: %s",
+	"trainer_boss.new_best":  "🏅 New best!",
+	"trainer_boss.best_time": "Best time: %s",
+
+	"trainer_result.title":       "🎮 Vim Trainer - Result",
+	"trainer_result.help":        "[Enter] continue • [Esc] back",
+	"trainer_result.correct":     "✨ CORRECT! ✨",
+	"trainer_result.incorrect":   "❌ INCORRECT",
+	"trainer_result.explanation": "📖 Explanation:",
+	"trainer_result.session":     "Session Score: %d  |  Streak: %d",
+
+	"trainer_boss_result.title":     "🎮 Vim Trainer - Boss Battle Complete",
+	"trainer_boss_result.help":      "[Enter/Space/Esc/q] return to menu",
+	"trainer_boss_result.victory":   "🏆 VICTORY! 🏆",
+	"trainer_boss_result.bonus":     "🎉 +500 bonus points!",
+	"trainer_boss_result.unlocked":  "🔓 Next module unlocked!",
+	"trainer_boss_result.defeated":  "💀 DEFEATED 💀",
+	"trainer_boss_result.try_again": "Keep practicing and try again!",
+	"trainer_boss_result.you_won":   "You defeated %s!",
+	"trainer_boss_result.lives":     "Lives remaining: %s",
+	"trainer_boss_result.under_par": "⚡ Under par! +%d speed bonus",
+	"trainer_boss_result.boss_wins": "%s wins this time...",
+	"trainer_boss_result.totals":    "Total Score: %d  |  Bosses Defeated: %d/7",
+
+	"trainer_timed.title":    "🎮 Vim Trainer - Speed Run",
+	"trainer_timed.desc":     "As many exercises from your unlocked modules as you can answer in a minute",
+	"trainer_timed.status":   "⏱  %ds left | Score: %d | %d/%d correct",
+	"trainer_timed.paused":   " | ⏸  Paused, press any key to resume",
+	"trainer_timed.times_up": "⏱  Time's up!",
+	"trainer_timed.score":    "  Score:    %d",
+	"trainer_timed.accuracy": "  Accuracy: %.0f%% (%d/%d correct)",
+	"trainer_timed.best":     "  Best:     %d",
+	"trainer_timed.new_best": "  🏆 New best score!",
+	"trainer_timed.help":     "[Enter/r] run again • [q/Esc] back to modules",
+
+	"project_path.title":            "📦 Initialize Project — Path",
+	"project_path.desc":             "Enter the path to your project directory",
+	"project_path.help_recent":      "↑/↓: navigate  •  Enter/Tab: fill in  •  Esc: cancel",
+	"project_path.help":             "Tab: complete  •  Ctrl+B: browse  •  Ctrl+R: recent  •  Enter: confirm  •  Esc: cancel",
+	"project_path.help_matches":     "↑/↓: navigate  •  Enter/Tab: select  •  Esc: cancel",
+	"project_path.help_browser":     "j/↓ k/↑: move  •  Enter/l: open  •  h: up  •  n: new dir  •  .: hidden  •  Esc: close",
+	"project_path.matches":          "Matches:",
+	"project_path.browsing":         "Browsing: %s",
+	"project_path.select_dir":       "✅ Select this directory",
+	"project_path.empty":            "Path cannot be empty",
+	"project_path.invalid":          "Invalid path: %v",
+	"project_path.not_found":        "Directory not found: %s",
+	"project_path.not_found_hint":   " (press ctrl+n to create it)",
+	"project_path.not_dir":          "Path is not a directory: %s",
+	"project_path.invalid_dir_name": "Invalid directory name: %q",
+	"project_path.already_exists":   "Already exists: %s",
+	"project_path.create_failed":    "Could not create %s: %v",
+	"project_path.new_dir":          "New directory in %s:",
+	"project_path.help_new_dir":     "Enter: create  •  Esc: cancel",
+
+	"project_stack.title":         "📦 Initialize Project — Stack",
+	"project_stack.desc":          "Select your project's tech stacks",
+	"project_stack.desc_detected": "Auto-detected: %s (Space to adjust)",
+
+	"project_memory.title": "📦 Initialize Project — Memory Module",
+	"project_memory.desc":  "Choose an AI memory module for your project",
+	"project_memory.none":  "❌ None",
+
+	"project_obsidian_install.title": "📦 Initialize Project — Obsidian App",
+	"project_obsidian_install.desc":  "Obsidian app not detected. Install it for Obsidian Brain?",
+	"project_obsidian_install.yes":   "Yes, install Obsidian",
+	"project_obsidian_install.no":    "No, continue without it",
+
+	"project_engram.title": "📦 Initialize Project — Engram Add-on",
+	"project_engram.desc":  "Add Engram persistent memory alongside Obsidian Brain?",
+	"project_engram.yes":   "Yes, add Engram too",
+	"project_engram.no":    "No, just Obsidian Brain",
+
+	"project_role_pack.title": "📦 Initialize Project — Role Packs",
+	"project_role_pack.desc":  "Select role packs for your Obsidian Brain vault",
+	"project_role_pack.core":  "Core (always included)",
+	"project_role_pack.dev":   "Developer Pack",
+	"project_role_pack.pm":    "PM/Tech Lead Pack",
+
+	"project_ci.title": "📦 Initialize Project — CI/CD Provider",
+	"project_ci.desc":  "Select CI/CD provider for your project",
+
+	"project_confirm.title":       "📦 Initialize Project — Confirm",
+	"project_confirm.desc":        "Review your choices before initializing",
+	"project_confirm.confirm":     "✅ Confirm & Initialize",
+	"project_confirm.preview":     "🔍 Preview changes",
+	"project_confirm.summary":     "Configuration Summary:",
+	"project_confirm.no_commands": "No build/test commands detected for CLAUDE.md",
+	"project_confirm.parent":      "Parent:",
+	"project_confirm.projects":    "Projects (%d):",
+	"project_confirm.path":        "Path:",
+	"project_confirm.stack":       "Stack:",
+	"project_confirm.memory":      "Memory:",
+	"project_confirm.engram":      "Engram:",
+	"project_confirm.packs":       "Packs:",
+	"project_confirm.ci":          "CI:",
+	"project_confirm.yes":         "Yes",
+	"project_confirm.no":          "No",
+	"project_confirm.commands":    "Commands for CLAUDE.md / AGENTS.md:",
+
+	"project_preview.title": "📦 Initialize Project — Preview",
+	"project_preview.desc":  "What init would create: + new, = kept, ⚠ overwritten",
+
+	"project_installing.title":            "📦 Initializing Project...",
+	"project_installing.desc":             "Running init-project.sh...",
+	"project_installing.initializing":     "Initializing %s...",
+	"project_installing.initializing_one": "Initializing project...",
+
+	"project_result.title": "📦 Project Initialization Result",
+	"project_result.desc":  "Initialization complete",
+
+	"project_batch_select.title": "📦 Initialize Project — Sub-projects",
+	"project_batch_select.desc":  "Found %d projects here; the same setup is applied to each one you keep checked",
+
+	"project_batch_result.title": "📦 Batch Initialization Result",
+	"project_batch_result.desc":  "Per-project results",
+
+	"skill_menu.title":           "🎯 Skill Manager",
+	"skill_menu.desc":            "Manage skills from the Gentleman-Skills catalog",
+	"skill_menu.undo":            "↩️  Undo Last Operation",
+	"skill_menu.browse":          "🔍 Browse Skills",
+	"skill_menu.install":         "📥 Install Skills",
+	"skill_menu.remove":          "🗑️  Remove Skills",
+	"skill_menu.update":          "🔄 Update Catalog",
+	"skill_menu.stats":           "📊 Catalog Stats",
+	"skill_menu.doctor":          "🩺 Doctor",
+	"skill_menu.manifest":        "📄 Install from Manifest",
+	"skill_menu.export":          "📤 Export Installed Skills",
+	"skill_menu.url":             "🔗 Install from URL",
+	"skill_menu.nothing_to_undo": nothingToUndoSuffix,
+
+	"skill_browse.title":     "🎯 Skill Manager — Browse",
+	"skill_browse.desc":      "Available skills from the catalog",
+	"skill_browse.desc_tag":  "Skills tagged #%s (t next tag, Esc shows all)",
+	"skill_browse.help":      "↑/k up • ↓/j down • [Enter] details • [Esc] back",
+	"skill_browse.help_tags": "↑/k up • ↓/j down • [Enter] details • [t] filter by tag • [Esc] back",
+
+	"skill_install.title":         "🎯 Skill Manager — Install",
+	"skill_install.desc":          "Toggle skills to install with Space, then confirm",
+	"skill_install.help_filter":   "Type to filter • [Enter] keep filter • [Esc] clear",
+	"skill_install.help":          "↑/k up • ↓/j down • [Space] toggle • [Enter] confirm • [/] filter • [Esc] back",
+	"skill_install.filter":        "Filter: %s",
+	"skill_install.filter_clear":  "(Esc to clear)",
+	"skill_install.all_installed": "✅ All skills are already installed!",
+	"skill_install.confirm":       "✅ Confirm installation",
+
+	"skill_remove.title":   "🎯 Skill Manager — Remove",
+	"skill_remove.desc":    "Toggle skills to remove with Space, then confirm",
+	"skill_remove.loading": "Loading installed skills...",
+	"skill_remove.none":    "No skills installed",
+	"skill_remove.confirm": "✅ Confirm removal",
+
+	"skill_result.title":       "🎯 Skill Manager — Result",
+	"skill_result.desc":        "Operation results",
+	"skill_result.some_failed": "⚠ Some operations failed",
+	"skill_result.all_done":    "✅ All operations completed",
+	"skill_result.key_orphans": "r to remove the orphaned links",
+	"skill_result.key_undo":    "u to undo the %s",
+	"skill_result.key_return":  "Enter to return",
+	"skill_result.press":       "Press %s",
+
+	"skill_doctor.title":        "🎯 Skill Manager — Doctor",
+	"skill_doctor.desc":         "Checks the links in ~/.claude/skills and ~/.agents/skills",
+	"skill_doctor.desc_summary": "Skill links: %s",
+
+	"uninstall.title":      "🧹 Uninstall",
+	"uninstall.desc_empty": "Nothing installed by Javi.Dots was found",
+	"uninstall.desc":       "Check what to remove, then pick whether to back it up first",
+
+	"uninstall_result.title": "🧹 Uninstall Result",
+
+	"skill_update.title":    "🎯 Skill Manager — Update Catalog",
+	"skill_update.desc":     "Pulling latest changes from Gentleman-Skills",
+	"skill_update.updating": "Updating catalog...",
+
+	"skill_stats.title":   "🎯 Skill Manager — Catalog Stats",
+	"skill_stats.desc":    "Summary of the local skill catalog",
+	"skill_stats.reading": "Reading skill catalog...",
+
+	"skill_warnings.title": "🎯 Skill Manager — Skipped Skills",
+	"skill_warnings.desc":  "Catalog folders that were left out of the skill list, and why",
+
+	"skill_targets.title":       "🎯 Skill Manager — Link Targets",
+	"skill_targets.desc_remove": "Remove the skills from these directories only",
+	"skill_targets.desc":        "Link the skills into these directories (plugins always go to ~/.claude/plugins)",
+
+	"skill_conflicts.title": "🎯 Skill Manager — Local Skills in the Way",
+	"skill_conflicts.desc":  "These are real directories, not links from the catalog; pick what to do with each",
+
+	"settings.title":              "⚙️  Settings",
+	"settings.desc":               "Saved to %s when you quit",
+	"settings.desc_read_only":     "Another installer is running: changes last until you quit and aren't saved",
+	"settings.show_hidden":        "Show hidden folders in the folder browser",
+	"settings.skip_welcome":       "Skip the welcome screen",
+	"settings.verbose_logs":       "Show step details while installing",
+	"settings.theme":              "🎨 Theme: %s",
+	"settings.language":           "🌐 Language: %s",
+	"settings.no_color":           " (NO_COLOR is set)",
+	"settings.lang_env":           " (GENTLEMAN_LANG is set)",
+	"settings.icons":              "🔣 Icons: %s",
+	"settings.icons_ascii":        " (ASCII for %s)",
+	"settings.icons_emoji":        " (emoji)",
+	"startup.icons_ascii":         "ℹ ASCII icons for %s; pick emoji in Settings → Icons",
+	"settings.notify":             "Notify when an install or a long task finishes",
+	"settings.help":               "↑/k up • ↓/j down • [Space] toggle • [←/→] theme, language, icons • [Esc] back",
+	"settings.preview":            "Preview: %s",
+	"settings.preview_selected":   "Selected option",
+	"settings.preview_unselected": "Unselected option",
+	"settings.preview_done":       "done",
+	"settings.preview_warning":    "warning",
+	"settings.preview_failed":     "failed",
+	"settings.preview_info":       "info",
+	"settings.preview_find_files": "find files",
+	"settings.preview_select":     "select",
+
+	"notify.title":                "Gentleman.Dots",
+	"notify.install_done":         "Installation complete in %s",
+	"notify.install_failed":       "Installation failed",
+	"notify.project_done":         "Project initialized: %s",
+	"notify.project_failed":       "Project initialization failed: %s",
+	"notify.batch_done":           "Projects initialized: %d succeeded, %d failed",
+	"notify.skills_updated":       "Skill catalog updated",
+	"notify.skills_update_failed": "Skill catalog update failed",
+
+	"skill_manifest.title_export": "🎯 Skill Manager — Export Installed Skills",
+	"skill_manifest.title":        "🎯 Skill Manager — Install from Manifest",
+	"skill_manifest.desc_export":  "Write the installed skills to this file (.json for JSON, anything else for a name per line)",
+	"skill_manifest.desc":         "A skills.json ({\"skills\": [...]}) or a text file with a skill name per line",
+	"skill_url.title":             "🎯 Skill Manager — Install from URL",
+	"skill_url.desc":              "A git repository with a SKILL.md at its root or in one subdirectory",
+
+	"profile_save.title": "💾 Save Profile",
+	"profile_save.desc":  "Name these choices to install them again without the wizard:",
+
+	"install_refs.title": "⚙️  Advanced: Pin Versions",
+	"install_refs.desc":  "Clone a branch, tag or commit instead of the latest, to reproduce an install",
+
+	"install_ref_input.desc_skills": "Branch, tag or commit of the skill catalog (empty for the latest):",
+	"install_ref_input.desc":        "Branch, tag or commit of the dotfiles repo (empty for the latest):",
+
+	"profile_select.title": "📂 Install from Profile",
+	"profile_select.desc":  "Saved choices from %s — pick one to skip the wizard",
+
+	"profile_mismatch.title": "⚠️  Profile Doesn't Match This Machine",
+	"profile_mismatch.desc":  "This profile was saved on a different kind of machine",
+
+	"unsupported_platform.title":         "⚠️  Unsupported Platform",
+	"unsupported_platform.desc":          "Installation isn't available on %s",
+	"unsupported_platform.help":          "[Enter/Esc] back",
+	"unsupported_platform.works":         "Works here:",
+	"unsupported_platform.not_available": "Not available:",
+	"unsupported_platform.supported":     "Installation is supported on macOS, Linux (including WSL), Termux and Windows.",
+	"unsupported_platform.works_skills":  "✓ Skill Manager",
+	"unsupported_platform.works_learn":   "✓ Learn & Practice (guides, keymaps, Vim Trainer)",
+	"unsupported_platform.no_install":    "✗ Environment installation (terminal, shell, multiplexer, Neovim, AI tools)",
+
+	"instance_locked.title":           "🔒 Another Installer Is Running",
+	"instance_locked.desc":            "This screen changes your setup, so it's off until the other installer exits",
+	"instance_locked.holder":          "Another installer (%s) is changing your setup.",
+	"instance_locked.holder_since":    "PID %d, running since %s",
+	"instance_locked.why":             "Running both at once could corrupt install progress, configs or skills.",
+	"instance_locked.available":       "Still available:",
+	"instance_locked.available_learn": "✓ Learn & Practice (guides, keymaps, Vim Trainer; progress isn't saved)",
+	"instance_locked.available_check": "✓ Check Installation (read-only health checks)",
+	"instance_locked.hint":            "Finish or quit the other installer, then start this one again.",
+	"instance_locked.help":            "[Enter/Esc] back",
+
+	"trainer_reset_confirm.title":    "🎮 Vim Trainer - Reset Practice",
+	"trainer_reset_confirm.desc":     "This can't be undone from the trainer",
+	"trainer_reset_confirm.yes":      "🔄 Yes, reset practice progress",
+	"trainer_reset_confirm.cancel":   "← Cancel",
+	"trainer_reset_confirm.help":     "↑/k up • ↓/j down • [Enter] select • [y] reset • [n/Esc] cancel",
+	"trainer_reset_confirm.lose":     "You will lose:",
+	"trainer_reset_confirm.kept":     "Lessons, boss progress and score are kept.",
+	"trainer_reset_confirm.question": "Reset practice progress for %s %s?",
+	"trainer_reset_confirm.mastered": "Exercises mastered: %d/%d",
+	"trainer_reset_confirm.accuracy": "Practice accuracy: %.0f%% over %d attempts",
+
+	"trainer_settings.title":     "🎮 Vim Trainer - Settings",
+	"trainer_settings.desc":      "Manage your trainer progress",
+	"trainer_settings.reset_all": "🗑️  Reset all trainer progress",
+
+	"trainer_import.title": "🎮 Vim Trainer - Import Progress",
+	"trainer_import.desc":  "Path to a progress export from another machine. It's merged with yours, keeping the best of both.",
+
+	"trainer_reset_all.title":  "🎮 Vim Trainer - Reset All Progress",
+	"trainer_reset_all.desc":   "Every module, boss, score and streak starts over. Your current stats are kept in a .bak file.",
+	"trainer_reset_all.help":   "[Enter] confirm • [Esc] cancel",
+	"trainer_reset_all.prompt": "Type %q and press Enter to reset all trainer progress:",
+
+	"skill_detail.title":       "🎯 Skill Manager — Skill",
+	"skill_detail.desc":        "Skill details and files",
+	"skill_detail.title_skill": "🎯 Skill Manager — %s",
+
+	"trainer.review_empty":           "Nothing to review. Mistakes show up here.",
+	"trainer.timed_empty":            "No exercises available for a Speed Run yet.",
+	"trainer.timed_stopped":          "Speed Run stopped.",
+	"trainer.timed_optimal":          "✨ +%d Optimal!",
+	"trainer.timed_correct":          "✓ +%d (optimal: %s)",
+	"trainer.timed_wrong":            "✗ Was: %s",
+	"trainer.export_no_home":         "⚠️  Export failed: could not find your home directory",
+	"trainer.export_failed":          "⚠️  Export failed: %v",
+	"trainer.exported":               "📤 Progress exported to %s",
+	"trainer.import_failed":          "⚠️  Import failed: %v",
+	"trainer.imported":               "📥 Progress imported from %s and merged with yours",
+	"trainer.reset_cancelled":        "Reset cancelled.",
+	"trainer.import_cancelled":       "Import cancelled.",
+	"trainer.import_read_only":       "⚠️  Import is off while another installer is running",
+	"trainer.module_locked":          "🔒 Module locked! Complete previous boss first.",
+	"trainer.no_lessons":             "No lessons available for this module yet.",
+	"trainer.practice_complete":      "🎉 Practice complete! All exercises mastered! Press [r] to reset.",
+	"trainer.practice_locked":        "Complete all lessons first to unlock practice!",
+	"trainer.practice_module_locked": "🔒 Module locked. Complete previous boss first.",
+	"trainer.no_practice_progress":   "No practice progress to reset for %s.",
+	"trainer.no_placement":           "Placement test not available.",
+	"trainer.no_boss":                "Boss not implemented yet!",
+	"trainer.boss_locked":            "Complete lessons + 80% practice accuracy to fight boss!",
+	"trainer.practice_reset":         "🔄 Practice progress reset for %s. Try again!",
+	"trainer.reset_type_word":        "Type %q to confirm, or press Esc to cancel.",
+	"trainer.reset_failed":           "Could not reset progress: %v",
+	"trainer.reset_all_done":         "🗑️  All trainer progress reset.",
+	"trainer.reset_all_backup":       " Previous stats saved to %s",
+	"trainer.perfect":                "✨ Perfect! Optimal solution!",
+	"trainer.correct_not_optimal":    "✓ Correct! But %s is more efficient.",
+	"trainer.correct_creative":       "✓ Correct! Creative solution! Optimal: %s",
+	"trainer.incorrect":              "✗ Incorrect. Solutions: %s",
+	"trainer.hint":                   "💡 Hint: %s",
+	"trainer.boss_abandoned":         "Boss fight abandoned!",
+	"trainer.boss_victory":           "🏆 VICTORY! You defeated %s!",
+	"trainer.boss_perfect":           "✨ Perfect! Next challenge...",
+	"trainer.boss_good":              "✓ Good! (Optimal: %s) Next...",
+	"trainer.boss_defeated":          "💀 DEFEATED! Solution was: %s",
+	"trainer.boss_wrong":             "✗ Wrong! Was: %s | Lives: %s",
+	"trainer.review_cleared":         "🎉 Review list cleared! Every mistake is fixed.",
+	"trainer.all_mastered":           "🎉 All exercises mastered! You're a Vim master! 🏆",
+	"trainer.lesson_complete":        "🎉 Lesson complete! Practice mode unlocked!",
+	"trainer.help_timed":             "Type command • [Enter] submit • [Backspace] clear • [Esc] stop",
+	"trainer.help":                   "Type command • [Enter] submit • [Tab] hint • [Backspace] clear • [Esc] quit",
+	"trainer.no_exercise":            "No exercise loaded",
+	"trainer.mission":                "📋 Mission:",
+	"trainer.code":                   "📝 Code:",
+	"trainer.your_answer":            "⌨️  Your answer:",
+	"trainer.no_daily_streak":        "📅 No daily streak yet: answer an exercise to start one",
+	"trainer.streak_ended":           "⏰ Your streak ended; practice today to start a new one",
+	"trainer.exercise_of":            "Exercise %d of %d",
+	"trainer.score_review":           "Score: %d | Streak: %d | To review: %d",
+	"trainer.score":                  "Score: %d | Streak: %d",
+	"trainer.day":                    "1 day",
+	"trainer.days":                   "%d days",
+	"trainer.daily_streak":           "📅 Daily streak: %s  |  Longest: %s  |  Answered: %d",
+	"trainer.keep_streak":            "⏰ Practice today to keep your %s streak going",
+	"trainer.answer_time":            "⏱  Time: %.1fs",
+	"trainer.new_best":               "🏅 New personal best!",
+	"trainer.best_time":              "(best %.1fs)",
+	"trainer.mode_title":             "🎮 %s Mode: %s",
+	"trainer.mode_placement":         "Placement",
+	"trainer.mode_lesson":            "Lesson",
+	"trainer.mode_review":            "Review",
+	"trainer.mode_practice":          "Practice",
+	"trainer.mode_speed_run":         "Speed Run",
+
+	"help.select_back":      "↑/k up • ↓/j down • [Enter] select • [Esc] back",
+	"help.select_quit":      "↑/k up • ↓/j down • [Enter] select • [Space q] quit",
+	"help.select_cancel":    "↑/k up • ↓/j down • [Enter] select • [Esc] cancel",
+	"help.select_back_quit": "↑/k up • ↓/j down • [Enter] select • [Esc] back • [Space q] quit",
+	"help.toggle_confirm":   "↑/k up • ↓/j down • [Space] toggle • [Enter] confirm • [Esc] back",
+	"help.select_search":    "↑/k up • ↓/j down • [Enter] select • [/] search • [Esc/q] back",
+	"help.select_back_q":    "↑/k up • ↓/j down • [Enter] select • [Esc/q] back",
+	"help.back":             "↑/k up • ↓/j down • [Enter/Esc/q] back",
+	"help.back_enter_esc":   "↑/k up • ↓/j down • [Enter/Esc] back",
+	"help.page_back":        "↑/k up • ↓/j down • PgUp/PgDn • [Enter/Esc/q] back",
+	"help.page_back_list":   "↑/k up • ↓/j down • PgUp/PgDn • [Enter/Esc/q] back to list",
+	"help.exit":             "Press [Enter] or [q] to exit",
+	"help.esc_back":         "Press Esc to go back",
+	"help.wait":             "Please wait...",
+
+	"tool_info.not_found": "Tool not found",
+	"tool_info.pros":      "✓ Pros",
+	"tool_info.cons":      "✗ Cons",
+
+	"diagnose.no_install": "Installed dotfiles: no install recorded",
+	"diagnose.installed":  "Installed dotfiles: %s, installed %s",
+
+	"install_log.no_output":   "No output yet",
+	"install_log.lines":       "Lines %d-%d of %d",
+	"install_log.following":   " (following new output)",
+	"install_log.newer_below": " (%d newer below, G to follow)",
+	"install_log.help":        "j/k scroll • PgUp/PgDn page • gg/G top/bottom • [Esc] back to the steps",
+
+	"layout.too_small": "Terminal too small",
+	"layout.needs":     "%dx%d, needs %dx%d",
+	"layout.resize":    "Resize to continue",
+
+	"help_overlay.no_keys":    "No keys besides the ones below",
+	"help_overlay.everywhere": "Everywhere",
+	"help_overlay.close":      "Press any key to close",
+
+	"keymap_search.desc":        "Search keys and descriptions in every category",
+	"keymap_search.edit_hint":   "(/ to edit, Esc to clear)",
+	"keymap_search.no_match":    "No keymaps match %q",
+	"keymap_search.help_typing": "Type to search • ↑/↓ move • [Enter] open • [Esc] clear",
+	"keymap_search.help":        "↑/k up • ↓/j down • [Enter] open • [/] edit search • [Esc] clear",
+
+	"ai_module_search.desc":        "Search module names and IDs in every category",
+	"ai_module_search.edit_hint":   "(/ to edit, Esc to close)",
+	"ai_module_search.no_match":    "No modules match %q",
+	"ai_module_search.help_typing": "Type to search • ↑/↓ move • [Enter] to results • [Esc] close",
+	"ai_module_search.help":        "↑/k up • ↓/j down • [Space/Enter] toggle • [/] edit search • [Esc] close",
+
+	"skill_select.all":      "✅ Select All",
+	"skill_select.no_match": "No skills match %q",
+}
@@ -0,0 +1,770 @@
+package tui
+
+// messagesSpanish is the Spanish catalog; messages missing here are shown in
+// English
+var messagesSpanish = map[string]string{
+	"common.back":                   "← Volver",
+	"common.lazyvim_guide":          "📖 Guía de LazyVim",
+	"common.back_to_main_menu":      "← Volver al menú principal",
+	"common.none":                   "Ninguno",
+	"common.select_all":             "🔘 Seleccionar todo",
+	"common.confirm_selection":      "✅ Confirmar selección",
+	"common.cancel":                 "❌ Cancelar",
+	"common.full_log":               "Log completo: %s",
+	"common.category_not_found":     "Categoría no encontrada",
+	"common.more_up":                "↑ más",
+	"common.more_down":              "↓ más",
+	"common.showing":                "Mostrando %d-%d de %d",
+	"common.lines_scroll":           "Líneas %d-%d de %d (↑↓ para desplazarte, PgUp/PgDn para ir más rápido)",
+	"common.skill_catalog_fetching": "Descargando el catálogo de skills...",
+	"common.more_above":             "▲ %d más arriba",
+	"common.more_below":             "▼ %d más abajo",
+	"common.search":                 "Búsqueda: %s",
+	"common.type_to_search":         "Escribí para buscar",
+
+	"welcome.title":       "Bienvenido al instalador de Javi.Dots",
+	"welcome.help":        "Presioná [Enter] para empezar • [Space q] para salir",
+	"welcome.help_resume": "Presioná [r] para reanudar la instalación anterior • [Enter] para empezar • [Space q] para salir",
+	"welcome.tagline":     "Tu entorno de terminal, configurado en minutos.",
+	"welcome.detected":    "Detectado: %s",
+	"welcome.unfinished":  "⏸  Instalación sin terminar: %s",
+
+	"main_menu.title":        "Menú principal",
+	"main_menu.start":        "🚀 Iniciar instalación",
+	"main_menu.update":       "🔁 Actualizar Javi.Dots",
+	"main_menu.profile":      "📂 Instalar desde un perfil",
+	"main_menu.learn":        "📚 Aprender y practicar",
+	"main_menu.restore":      "🔄 Restaurar un backup",
+	"main_menu.project":      "📦 Inicializar proyecto",
+	"main_menu.skills":       "🎯 Gestor de skills",
+	"main_menu.ai_framework": "🧩 Framework de IA",
+	"main_menu.check":        "🩻 Verificar instalación",
+	"main_menu.diagnose":     "🩺 Diagnosticar",
+	"main_menu.uninstall":    "🧹 Desinstalar",
+	"main_menu.settings":     "⚙️  Ajustes",
+	"main_menu.exit":         "❌ Salir",
+	"main_menu.unsupported":  " (no disponible en esta plataforma)",
+	"main_menu.locked":       " (solo lectura)",
+	"main_menu.prompt":       "¿Qué querés hacer?",
+	"main_menu.portable":     "🧳 Modo portable — el estado del instalador se guarda en %s",
+	"main_menu.read_only":    "🔒 Solo lectura — hay otro instalador (%s) en marcha",
+
+	"learn_menu.title":   "📚 Aprender y practicar",
+	"learn_menu.desc":    "Explorá herramientas, keymaps y guías, y practicá Vim",
+	"learn_menu.tools":   "📚 Conocer las herramientas",
+	"learn_menu.keymaps": "⌨️  Referencia de keymaps",
+	"learn_menu.trainer": "🎮 Entrenador de Vim",
+
+	"os_select.title":    "Paso 1: Elegí tu sistema operativo",
+	"os_select.desc":     "Detectado: %s",
+	"os_select.detected": "%s (detectado)",
+
+	"terminal_select.title":            "Paso 2: Elegí el emulador de terminal",
+	"terminal_select.desc_wsl":         "Nota: en WSL los emuladores de terminal se instalan en Windows",
+	"terminal_select.desc":             "Elegí tu emulador de terminal preferido",
+	"terminal_select.alacritty_source": "Alacritty ⏱️  (se compila desde el código, instala Rust ~5-10 min)",
+	"terminal_select.learn":            "ℹ️  Conocer las terminales",
+
+	"font_select.title": "Paso 3: Instalación de la Nerd Font",
+	"font_select.desc":  "Iosevka Term Nerd Font es necesaria para los íconos y glifos",
+	"font_select.yes":   "Sí, instalar Iosevka Term Nerd Font",
+	"font_select.no":    "No, ya la tengo",
+
+	"shell_select.title": "Paso 4: Elegí tu shell",
+	"shell_select.desc":  "Shell actual: %s",
+	"shell_select.learn": "ℹ️  Conocer las shells",
+
+	"wm_select.title": "Paso 5: Elegí el gestor de ventanas",
+	"wm_select.desc":  "Multiplexor de terminal para manejar sesiones",
+	"wm_select.learn": "ℹ️  Conocer los multiplexores",
+
+	"wm_extras.title": "Paso 5: Extras de %s",
+	"wm_extras.desc":  "Partes opcionales de la config de %s. Desmarcalas todas para una config mínima.",
+
+	"nvim_select.title":   "Paso 6: Configuración de Neovim",
+	"nvim_select.desc":    "Incluye LSP, TreeSitter y la config de Gentleman",
+	"nvim_select.yes":     "Sí, instalar Neovim con la config",
+	"nvim_select.no":      "No, saltear Neovim",
+	"nvim_select.learn":   "ℹ️  Conocer Neovim",
+	"nvim_select.keymaps": "⌨️  Ver keymaps",
+
+	"zed_select.title": "Paso 7: Editor Zed",
+	"zed_select.desc":  "Editor de alto rendimiento con modo Vim y soporte para agentes de IA",
+	"zed_select.yes":   "Sí, instalar Zed con la config",
+	"zed_select.no":    "No, saltear Zed",
+
+	"ai_tools_select.title": "Paso 8: Herramientas de IA para programar",
+	"ai_tools_select.desc":  "Marcá las herramientas con Enter. Confirmá cuando estés listo.",
+
+	"ai_framework_confirm.title":       "Paso 9: Framework de IA",
+	"ai_framework_confirm.desc":        "Agentes, skills, hooks y comandos para las herramientas de IA\nRecomendado: %s",
+	"ai_framework_confirm.recommended": "✨ Lo recomendado para mi selección (%s)",
+	"ai_framework_confirm.customize":   "🔧 Personalizar…",
+	"ai_framework_confirm.skip":        "No, saltear el framework",
+
+	"ai_framework_preset.title":     "Paso 9: Elegí un preset del framework",
+	"ai_framework_preset.desc":      "Los presets agrupan agentes, skills, hooks y comandos por rol",
+	"ai_framework_preset.custom":    "🔧 Personalizado — Elegir módulos sueltos",
+	"ai_framework_preset.minimal":   "🎯 Mínimo — Núcleo + comandos de git",
+	"ai_framework_preset.frontend":  "🖥️  Frontend — React, Vue, Angular, testing, hooks de seguridad",
+	"ai_framework_preset.backend":   "⚙️  Backend — APIs, bases de datos, microservicios, hooks de seguridad",
+	"ai_framework_preset.fullstack": "🔄 Fullstack — Frontend + Backend + infra + todos los comandos",
+	"ai_framework_preset.data":      "📊 Datos — Ingeniería de datos, ML/IA, analítica",
+	"ai_framework_preset.complete":  "📦 Completo — Todo incluido",
+
+	"ai_framework_categories.title_apply": "🧩 Módulos del framework de IA",
+	"ai_framework_categories.title":       "Paso 9: Elegí las categorías de módulos",
+	"ai_framework_categories.desc_apply":  "Los módulos instalados vienen marcados. Marcá para agregar o quitar.",
+	"ai_framework_categories.desc":        "Elegí una categoría para configurar sus módulos",
+	"ai_framework_categories.help":        "↑/k arriba • ↓/j abajo • [Enter] abrir/confirmar • [/] buscar • [Esc] volver",
+	"ai_framework_categories.counter":     "%s %s (%d/%d elegidos)",
+
+	"ai_framework_category_items.title":          "Paso 9: Elegí los módulos",
+	"ai_framework_category_items.desc":           "Marcá los módulos con Enter. Esc para volver.",
+	"ai_framework_category_items.title_category": "Paso 9: %s %s",
+	"ai_framework_category_items.help":           "↑/k arriba • ↓/j abajo • [Space/Enter] marcar • [a] seleccionar todo • [Esc] volver",
+
+	"ai_framework_summary.title":   "Paso 9: Revisá los módulos elegidos",
+	"ai_framework_summary.desc":    "Los módulos elegidos, por categoría, y lo que recibe setup-global.sh",
+	"ai_framework_summary.proceed": "✅ Seguir con estos módulos",
+	"ai_framework_summary.back":    "← Volver a editar",
+	"ai_framework_summary.save":    "💾 Guardar como preset…",
+
+	"ai_framework_preset_save.title": "💾 Guardar preset del framework",
+	"ai_framework_preset_save.desc":  "Poné un nombre a esta selección para elegirla de nuevo en la lista de presets:",
+
+	"ai_framework_apply_diff.title":      "🧩 Revisá los cambios del framework de IA",
+	"ai_framework_apply_diff.desc":       "Solo se va a correr el paso del framework de IA",
+	"ai_framework_apply_diff.back":       "← Volver a los módulos",
+	"ai_framework_apply_diff.apply":      "✅ Aplicar cambios",
+	"ai_framework_apply_diff.no_changes": "Sin cambios — tu selección coincide con lo instalado.",
+
+	"backup_confirm.title":            "⚠️  Se encontraron configs existentes",
+	"backup_confirm.with_backup":      "✅ Instalar con backup (recomendado)",
+	"backup_confirm.without_backup":   "⚠️  Instalar sin backup",
+	"backup_confirm.save_profile":     "💾 Guardar estas elecciones como perfil",
+	"backup_confirm.help":             "↑/k arriba • ↓/j abajo • [Enter] elegir • [p] fijar versiones • [Esc] volver",
+	"backup_confirm.overwritten":      "Se van a sobrescribir estas configs:",
+	"backup_confirm.explain":          "Con un backup podés restaurar después si hace falta.",
+	"backup_confirm.estimating":       "Estimando el tamaño del backup...",
+	"backup_confirm.no_estimate":      "No hay una estimación del tamaño",
+	"backup_confirm.after_exclusions": " → %s tras las exclusiones",
+	"backup_confirm.caches":           "[c] Incluir cachés (caché y logs de Oh My Zsh)",
+	"backup_confirm.history":          "[h] Excluir el historial del shell",
+	"backup_confirm.compress":         "[z] Comprimir (%s)",
+
+	"os_version_warning.title":         "⚠️  Versión del sistema no soportada",
+	"os_version_warning.desc":          "Esta versión del sistema es anterior a la mínima soportada",
+	"os_version_warning.desc_detected": "Se detectó %s %s; la mínima soportada es %s",
+	"os_version_warning.continue":      "⚠️  Continuar de todas formas",
+	"os_version_warning.abort":         "❌ Cancelar la instalación",
+	"os_version_warning.steps":         "Pasos con más chances de fallar:",
+	"os_version_warning.may_fail":      "La instalación puede fallar: %s.",
+
+	"disk_space_warning.title":    "⚠️  No hay suficiente espacio en disco",
+	"disk_space_warning.desc":     "Se estima que la instalación planeada necesita más espacio del que hay libre",
+	"disk_space_warning.continue": "⚠️  Instalar de todas formas",
+	"disk_space_warning.abort":    "❌ Cancelar la instalación",
+
+	"wizard_review.title":        "Revisá tus elecciones",
+	"wizard_review.desc":         "Elegí una respuesta para cambiarla, o instalá",
+	"wizard_review.install":      "✅ Instalar",
+	"wizard_review.os":           "Sistema operativo",
+	"wizard_review.terminal":     "Terminal",
+	"wizard_review.font":         "Nerd Font",
+	"wizard_review.shell":        "Shell",
+	"wizard_review.zsh_merge":    "Conservar .zshrc",
+	"wizard_review.wm":           "Multiplexor",
+	"wizard_review.wm_extras":    "Extras",
+	"wizard_review.nvim":         "Neovim",
+	"wizard_review.zed":          "Zed",
+	"wizard_review.ai_tools":     "Herramientas de IA",
+	"wizard_review.ai_framework": "Framework de IA",
+	"wizard_review.yes":          "sí",
+	"wizard_review.no":           "no",
+	"wizard_review.all":          "todos",
+	"wizard_review.custom":       "a medida, %d módulos",
+
+	"restore_backup.title": "🔄 Restaurar un backup",
+	"restore_backup.prune": "🧹 Limpiar backups viejos (conservar %d, borrar %d)",
+	"restore_backup.desc":  "Elegí un backup para restaurar o borrar",
+	"restore_backup.empty": "No se encontraron backups.",
+
+	"restore_confirm.title":          "🔄 Confirmar restauración",
+	"restore_confirm.yes":            "✅ Sí, restaurar este backup",
+	"restore_confirm.delete":         "🗑️  Borrar este backup",
+	"restore_confirm.none":           "No hay ningún backup elegido",
+	"restore_confirm.contents":       "Contenido:",
+	"restore_confirm.warning":        "⚠️  ¡Restaurar sobrescribe tus configs actuales!",
+	"restore_confirm.review":         "Los archivos que cambiaste desde la instalación se revisan primero.",
+	"restore_confirm.from":           "Backup del %s",
+	"restore_confirm.format_dir":     "Formato: carpeta",
+	"restore_confirm.format_archive": "Formato: archivo comprimido (%s, se extrae a una carpeta temporal para restaurar)",
+
+	"restore_conflict.title":         "⚠️  Conflicto al restaurar (%d/%d)",
+	"restore_conflict.keep":          "📄 Conservar el archivo actual",
+	"restore_conflict.take":          "📦 Usar la versión del backup",
+	"restore_conflict.both":          "📑 Conservar ambos (backup guardado como %s)",
+	"restore_conflict.help":          "↑/k arriba • ↓/j abajo • [Enter] elegir • [a] aplicar a todos • [Esc] cancelar la restauración",
+	"restore_conflict.none":          "No hay conflictos para resolver",
+	"restore_conflict.not_in_backup": "No está en el backup; puede ser un archivo que agregaste o cambiaste.",
+	"restore_conflict.differs":       "Cambió desde la instalación y es distinto del backup.",
+	"restore_conflict.apply_all":     "%s [a] Aplicar a los %d conflictos que quedan",
+
+	"restore_items.title": "🔄 Elegí qué restaurar",
+	"restore_items.desc":  "Marcá las configs con Espacio; las que quedan afuera no se tocan",
+
+	"backup_prune.title":  "🧹 Limpiar backups viejos",
+	"backup_prune.desc":   "Conserva los %d backups más recientes y borra el resto",
+	"backup_prune.delete": "🗑️  Borrar %d backup(s) viejo(s)",
+
+	"diagnose_symptom.title": "🩺 Diagnosticar",
+	"diagnose_symptom.desc":  "¿Qué parece roto? Los chequeos correspondientes corren solos.",
+	"diagnose_symptom.help":  "↑/k arriba • ↓/j abajo • [Enter] correr los chequeos • [Esc] volver",
+
+	"diagnose_results.title":       "🩺 Diagnóstico",
+	"diagnose_results.rerun":       "🔁 Correr los chequeos de nuevo",
+	"diagnose_results.help":        "↑/k arriba • ↓/j abajo • [Enter] elegir • [r] volver a correr • [Esc] volver",
+	"diagnose_results.no_fix":      "Ningún arreglo automático aplica a estos resultados.",
+	"diagnose_results.running":     "Corriendo los chequeos...",
+	"diagnose_results.fix_applied": "🔧 Arreglo aplicado: %s",
+
+	"install_check.title": "🩻 Verificar instalación",
+	"install_check.desc":  "Chequeos de solo lectura de lo que instaló el instalador; no se cambia nada",
+
+	"ghostty_warning.title":    "⚠️  Advertencia de compatibilidad de Ghostty",
+	"ghostty_warning.desc":     "La instalación de Ghostty puede fallar en Ubuntu/Debian.\nEl script de instalación solo soporta algunas versiones.",
+	"ghostty_warning.continue": "⚠️  Seguir con Ghostty de todas formas",
+	"ghostty_warning.change":   "🔄 Elegir otra terminal",
+	"ghostty_warning.cancel":   "❌ Cancelar la instalación",
+
+	"zsh_merge_select.title":   "Paso 4: Se encontró un .zshrc",
+	"zsh_merge_select.desc":    "Combinar carga la config de Gentleman.Dots desde un bloque marcado\ny deja el resto de tu .zshrc como está",
+	"zsh_merge_select.merge":   "🔀 Combinar: conservar mi .zshrc y agregar un bloque gestionado",
+	"zsh_merge_select.replace": "♻️  Reemplazar el .zshrc por la config de Gentleman.Dots",
+
+	"installing.title_update": "Actualizando...",
+	"installing.title":        "Instalando...",
+	"installing.help":         "[space+d] mostrar/ocultar detalles • [space+l] log completo",
+	"installing.heading":      "🚀 Instalando Javi.Dots",
+
+	"complete.title_update":      "¡Actualización completa!",
+	"complete.title":             "¡Instalación completa!",
+	"complete.framework_updated": "✨ ¡Framework de IA actualizado! ✨",
+	"complete.heading":           "✨ ¡Instalación completa! ✨",
+	"complete.summary":           "Resumen",
+	"complete.next_step":         "Siguiente paso",
+	"complete.run_shell":         "Para usar tu nuevo shell ahora, ejecutá:",
+	"complete.framework_added":   "Agregados: %d módulo(s)",
+	"complete.framework_removed": "Quitados: %d módulo(s)",
+	"complete.os":                "SO: %s",
+	"complete.terminal":          "Terminal: %s",
+	"complete.shell":             "Shell: %s",
+	"complete.wm":                "Gestor de ventanas: %s",
+	"complete.wm_extras":         "Extras de %s: %s",
+	"complete.font":              "Fuente: Iosevka Term Nerd Font",
+	"complete.editor":            "Editor: Neovim con la config de Gentleman",
+	"complete.total_time":        "Tiempo total: %s",
+
+	"error.title":             "Error",
+	"error.restore_failed":    "No se pudo restaurar el backup: %v",
+	"error.prune_failed":      "Se borraron %d backup(s), y luego: %v",
+	"error.step_failed":       "Falló el paso '%s':\n%v",
+	"error.step_blocked":      "El paso '%s' no puede empezar: los pasos de los que depende nunca terminan",
+	"error.failed":            "❌ Falló la instalación",
+	"error.label":             "Error:",
+	"error.recent_logs":       "Logs recientes:",
+	"error.help_retry":        "[r] reintentar • [space+q] salir",
+	"error.help_step":         "[r] reintentar el paso fallido • [s] saltear y seguir • [space+q] salir",
+	"error.cancelled":         "⏹ Instalación cancelada",
+	"error.cancelled_done":    "Completados (%d):",
+	"error.cancelled_skipped": "Salteados (%d):",
+	"error.cancelled_failed":  "Fallidos (%d):",
+	"error.help_resume":       "[r] reanudar • [space+q] salir",
+
+	"learn_terminals.title": "📚 Aprender: emuladores de terminal",
+	"learn_terminals.desc":  "Elegí una terminal para saber más sobre ella",
+
+	"learn_shells.title": "📚 Aprender: shells",
+	"learn_shells.desc":  "Elegí un shell para saber más sobre él",
+
+	"learn_wm.title": "📚 Aprender: gestores de ventanas",
+	"learn_wm.desc":  "Elegí un gestor de ventanas para saber más sobre él",
+
+	"learn_nvim.title":    "📚 Aprender: Neovim",
+	"learn_nvim.features": "Ver características",
+	"learn_nvim.keymaps":  "Ver keymaps",
+	"learn_nvim.desc":     "Explorá las funciones y los atajos de Neovim",
+
+	"keymaps.title": "⌨️  Referencia de keymaps de Neovim",
+	"keymaps.desc":  "Elegí una categoría para ver sus atajos",
+
+	"keymap_category.title":       "⌨️  Keymaps",
+	"keymap_category.keys":        "Teclas",
+	"keymap_category.mode":        "Modo",
+	"keymap_category.description": "Descripción",
+
+	"keymaps_menu.title":   "⌨️  Referencia de keymaps",
+	"keymaps_menu.refresh": "🔄 Actualizar desde mi config",
+	"keymaps_menu.desc":    "Elegí una herramienta para ver sus atajos",
+
+	"keymaps_tmux.title": "⌨️  Keymaps de Tmux",
+	"keymaps_tmux.desc":  "Elegí una categoría para ver los atajos de Tmux",
+
+	"keymaps_tmux_cat.title": "⌨️  Keymaps de Tmux",
+
+	"keymaps_zellij.title": "⌨️  Keymaps de Zellij",
+	"keymaps_zellij.desc":  "Elegí una categoría para ver los atajos de Zellij",
+
+	"keymaps_zellij_cat.title": "⌨️  Keymaps de Zellij",
+
+	"keymaps_ghostty.title": "⌨️  Keymaps de Ghostty",
+	"keymaps_ghostty.desc":  "Elegí una categoría para ver los atajos de Ghostty",
+
+	"keymaps_ghostty_cat.title": "⌨️  Keymaps de Ghostty",
+
+	"learn_lazy_vim.title": "📖 Guía de LazyVim",
+	"learn_lazy_vim.desc":  "Aprendé a usar y personalizar LazyVim",
+
+	"lazy_vim_topic.title":     "📖 LazyVim",
+	"lazy_vim_topic.not_found": "Tema no encontrado",
+
+	"trainer_menu.title":         "🎮 Entrenador de Vim - Elegir módulo",
+	"trainer_menu.help":          "↑/k arriba • ↓/j abajo • [Enter/l] lección • [p] práctica • [b] jefe • [r] reiniciar • [i] prueba de nivel • [t] contrarreloj • [e/I] exportar/importar • [s] ajustes • [q/Esc] volver",
+	"trainer_menu.heading":       "🎮 Entrenador de Vim",
+	"trainer_menu.tagline":       "Dominá los movimientos de Vim con desafíos progresivos",
+	"trainer_menu.select_module": "Elegí un módulo:",
+	"trainer_menu.score":         "Puntaje: %d",
+	"trainer_menu.streak":        "Racha: %d",
+	"trainer_menu.bosses":        "Jefes: %d/7",
+	"trainer_menu.accuracy":      "Precisión: %.0f%%",
+	"trainer_menu.placement":     "📍 Prueba de nivel: %d/%d (%s)",
+	"trainer_menu.lessons":       "Lecciones: %d/%d (%.0f%%)",
+	"trainer_menu.placed":        " (prueba de nivel)",
+	"trainer_menu.practice":      "Práctica: %.0f%%",
+	"trainer_menu.mastered":      "Dominados: %d/%d",
+	"trainer_menu.lessons_none":  "Lecciones: 0/0",
+
+	"trainer_lesson.title": "🎮 Entrenador de Vim - Lección",
+
+	"trainer_practice.title": "🎮 Entrenador de Vim - Práctica",
+
+	"trainer_boss.title":     "🎮 Entrenador de Vim - ¡Pelea con el jefe!",
+	"trainer_boss.help":      "Escribí el comando • [Enter] enviar • [Esc] rendirse",
+	"trainer_boss.none":      "No hay ningún jefe cargado",
+	"trainer_boss.challenge": "📋 Desafío:",
+	"trainer_boss.header":    "⚔️  PELEA CON EL JEFE: %s",
+	"trainer_boss.status":    "Vidas: %s%s  |  Paso: %d/%d",
+	"trainer_boss.par":       "(par %s)",
+	"trainer_boss.time":      "Tiempo: %s",
+	"trainer_boss.new_best":  "🏅 ¡Nuevo récord!",
+	"trainer_boss.best_time": "Mejor tiempo: %s",
+
+	"trainer_result.title":       "🎮 Entrenador de Vim - Resultado",
+	"trainer_result.help":        "[Enter] seguir • [Esc] volver",
+	"trainer_result.correct":     "✨ ¡CORRECTO! ✨",
+	"trainer_result.incorrect":   "❌ INCORRECTO",
+	"trainer_result.explanation": "📖 Explicación:",
+	"trainer_result.session":     "Puntaje de la sesión: %d  |  Racha: %d",
+
+	"trainer_boss_result.title":     "🎮 Entrenador de Vim - Pelea con el jefe terminada",
+	"trainer_boss_result.help":      "[Enter/Space/Esc/q] volver al menú",
+	"trainer_boss_result.victory":   "🏆 ¡VICTORIA! 🏆",
+	"trainer_boss_result.bonus":     "🎉 ¡+500 puntos extra!",
+	"trainer_boss_result.unlocked":  "🔓 ¡Siguiente módulo desbloqueado!",
+	"trainer_boss_result.defeated":  "💀 DERROTA 💀",
+	"trainer_boss_result.try_again": "¡Seguí practicando y volvé a intentarlo!",
+	"trainer_boss_result.you_won":   "¡Derrotaste a %s!",
+	"trainer_boss_result.lives":     "Vidas restantes: %s",
+	"trainer_boss_result.under_par": "⚡ ¡Bajo el par! +%d de bonus por velocidad",
+	"trainer_boss_result.boss_wins": "%s gana esta vez...",
+	"trainer_boss_result.totals":    "Puntaje total: %d  |  Jefes derrotados: %d/7",
+
+	"trainer_timed.title":    "🎮 Entrenador de Vim - Contrarreloj",
+	"trainer_timed.desc":     "Tantos ejercicios de tus módulos desbloqueados como puedas responder en un minuto",
+	"trainer_timed.status":   "⏱  quedan %ds | Puntaje: %d | %d/%d correctas",
+	"trainer_timed.paused":   " | ⏸  En pausa, presioná cualquier tecla para seguir",
+	"trainer_timed.times_up": "⏱  ¡Se acabó el tiempo!",
+	"trainer_timed.score":    "  Puntaje:   %d",
+	"trainer_timed.accuracy": "  Precisión: %.0f%% (%d/%d correctas)",
+	"trainer_timed.best":     "  Récord:    %d",
+	"trainer_timed.new_best": "  🏆 ¡Nuevo récord!",
+	"trainer_timed.help":     "[Enter/r] jugar de nuevo • [q/Esc] volver a los módulos",
+
+	"project_path.title":            "📦 Inicializar proyecto — Ruta",
+	"project_path.desc":             "Ingresá la ruta al directorio de tu proyecto",
+	"project_path.help_recent":      "↑/↓: moverse  •  Enter/Tab: completar  •  Esc: cancelar",
+	"project_path.help":             "Tab: completar  •  Ctrl+B: explorar  •  Ctrl+R: recientes  •  Enter: confirmar  •  Esc: cancelar",
+	"project_path.help_matches":     "↑/↓: moverse  •  Enter/Tab: elegir  •  Esc: cancelar",
+	"project_path.help_browser":     "j/↓ k/↑: moverse  •  Enter/l: abrir  •  h: subir  •  n: carpeta nueva  •  .: ocultas  •  Esc: cerrar",
+	"project_path.matches":          "Coincidencias:",
+	"project_path.browsing":         "Explorando: %s",
+	"project_path.select_dir":       "✅ Elegir esta carpeta",
+	"project_path.empty":            "La ruta no puede estar vacía",
+	"project_path.invalid":          "Ruta inválida: %v",
+	"project_path.not_found":        "No se encontró el directorio: %s",
+	"project_path.not_found_hint":   " (presioná ctrl+n para crearlo)",
+	"project_path.not_dir":          "La ruta no es un directorio: %s",
+	"project_path.invalid_dir_name": "Nombre de directorio inválido: %q",
+	"project_path.already_exists":   "Ya existe: %s",
+	"project_path.create_failed":    "No se pudo crear %s: %v",
+	"project_path.new_dir":          "Nuevo directorio en %s:",
+	"project_path.help_new_dir":     "Enter: crear  •  Esc: cancelar",
+
+	"project_stack.title":         "📦 Inicializar proyecto — Stack",
+	"project_stack.desc":          "Elegí los stacks de tu proyecto",
+	"project_stack.desc_detected": "Detectado: %s (Espacio para ajustar)",
+
+	"project_memory.title": "📦 Inicializar proyecto — Módulo de memoria",
+	"project_memory.desc":  "Elegí un módulo de memoria de IA para tu proyecto",
+	"project_memory.none":  "❌ Ninguno",
+
+	"project_obsidian_install.title": "📦 Inicializar proyecto — App de Obsidian",
+	"project_obsidian_install.desc":  "No se encontró la app de Obsidian. ¿Instalarla para Obsidian Brain?",
+	"project_obsidian_install.yes":   "Sí, instalar Obsidian",
+	"project_obsidian_install.no":    "No, seguir sin ella",
+
+	"project_engram.title": "📦 Inicializar proyecto — Complemento Engram",
+	"project_engram.desc":  "¿Agregar la memoria persistente de Engram junto a Obsidian Brain?",
+	"project_engram.yes":   "Sí, agregar Engram también",
+	"project_engram.no":    "No, solo Obsidian Brain",
+
+	"project_role_pack.title": "📦 Inicializar proyecto — Packs de rol",
+	"project_role_pack.desc":  "Elegí los packs de rol para tu bóveda de Obsidian Brain",
+	"project_role_pack.core":  "Núcleo (siempre incluido)",
+	"project_role_pack.dev":   "Pack de desarrollo",
+	"project_role_pack.pm":    "Pack de PM/Tech Lead",
+
+	"project_ci.title": "📦 Inicializar proyecto — Proveedor de CI/CD",
+	"project_ci.desc":  "Elegí el proveedor de CI/CD de tu proyecto",
+
+	"project_confirm.title":       "📦 Inicializar proyecto — Confirmar",
+	"project_confirm.desc":        "Revisá tus elecciones antes de inicializar",
+	"project_confirm.confirm":     "✅ Confirmar e inicializar",
+	"project_confirm.preview":     "🔍 Ver los cambios",
+	"project_confirm.summary":     "Resumen de la configuración:",
+	"project_confirm.no_commands": "No se detectaron comandos de build/test para CLAUDE.md",
+	"project_confirm.parent":      "Carpeta:",
+	"project_confirm.projects":    "Proyectos (%d):",
+	"project_confirm.path":        "Ruta:",
+	"project_confirm.stack":       "Stack:",
+	"project_confirm.memory":      "Memoria:",
+	"project_confirm.engram":      "Engram:",
+	"project_confirm.packs":       "Packs:",
+	"project_confirm.ci":          "CI:",
+	"project_confirm.yes":         "Sí",
+	"project_confirm.no":          "No",
+	"project_confirm.commands":    "Comandos para CLAUDE.md / AGENTS.md:",
+
+	"project_preview.title": "📦 Inicializar proyecto — Vista previa",
+	"project_preview.desc":  "Lo que crearía init: + nuevo, = conservado, ⚠ sobrescrito",
+
+	"project_installing.title":            "📦 Inicializando proyecto...",
+	"project_installing.desc":             "Corriendo init-project.sh...",
+	"project_installing.initializing":     "Inicializando %s...",
+	"project_installing.initializing_one": "Inicializando el proyecto...",
+
+	"project_result.title": "📦 Resultado de la inicialización",
+	"project_result.desc":  "Inicialización completa",
+
+	"project_batch_select.title": "📦 Inicializar proyecto — Subproyectos",
+	"project_batch_select.desc":  "Se encontraron %d proyectos; la misma configuración se aplica a cada uno que quede marcado",
+
+	"project_batch_result.title": "📦 Resultado de la inicialización en lote",
+	"project_batch_result.desc":  "Resultados por proyecto",
+
+	"skill_menu.title":           "🎯 Gestor de skills",
+	"skill_menu.desc":            "Gestioná skills del catálogo Gentleman-Skills",
+	"skill_menu.undo":            "↩️  Deshacer la última operación",
+	"skill_menu.browse":          "🔍 Explorar skills",
+	"skill_menu.install":         "📥 Instalar skills",
+	"skill_menu.remove":          "🗑️  Quitar skills",
+	"skill_menu.update":          "🔄 Actualizar catálogo",
+	"skill_menu.stats":           "📊 Estadísticas del catálogo",
+	"skill_menu.doctor":          "🩺 Doctor",
+	"skill_menu.manifest":        "📄 Instalar desde un manifiesto",
+	"skill_menu.export":          "📤 Exportar skills instaladas",
+	"skill_menu.url":             "🔗 Instalar desde una URL",
+	"skill_menu.nothing_to_undo": " (nada que deshacer)",
+
+	"skill_browse.title":     "🎯 Gestor de skills — Explorar",
+	"skill_browse.desc":      "Skills disponibles en el catálogo",
+	"skill_browse.desc_tag":  "Skills con la etiqueta #%s (t siguiente etiqueta, Esc muestra todas)",
+	"skill_browse.help":      "↑/k arriba • ↓/j abajo • [Enter] detalles • [Esc] volver",
+	"skill_browse.help_tags": "↑/k arriba • ↓/j abajo • [Enter] detalles • [t] filtrar por etiqueta • [Esc] volver",
+
+	"skill_install.title":         "🎯 Gestor de skills — Instalar",
+	"skill_install.desc":          "Marcá las skills a instalar con Espacio y confirmá",
+	"skill_install.help_filter":   "Escribí para filtrar • [Enter] mantener el filtro • [Esc] borrar",
+	"skill_install.help":          "↑/k arriba • ↓/j abajo • [Space] marcar • [Enter] confirmar • [/] filtrar • [Esc] volver",
+	"skill_install.filter":        "Filtro: %s",
+	"skill_install.filter_clear":  "(Esc para borrar)",
+	"skill_install.all_installed": "✅ ¡Ya están instaladas todas las skills!",
+	"skill_install.confirm":       "✅ Confirmar la instalación",
+
+	"skill_remove.title":   "🎯 Gestor de skills — Quitar",
+	"skill_remove.desc":    "Marcá las skills a quitar con Espacio y confirmá",
+	"skill_remove.loading": "Cargando las skills instaladas...",
+	"skill_remove.none":    "No hay skills instaladas",
+	"skill_remove.confirm": "✅ Confirmar la eliminación",
+
+	"skill_result.title":       "🎯 Gestor de skills — Resultado",
+	"skill_result.desc":        "Resultados de la operación",
+	"skill_result.some_failed": "⚠ Algunas operaciones fallaron",
+	"skill_result.all_done":    "✅ Todas las operaciones terminaron",
+	"skill_result.key_orphans": "r para quitar los links huérfanos",
+	"skill_result.key_undo":    "u para deshacer: %s",
+	"skill_result.key_return":  "Enter para volver",
+	"skill_result.press":       "Presioná %s",
+
+	"skill_doctor.title":        "🎯 Gestor de skills — Doctor",
+	"skill_doctor.desc":         "Revisa los links en ~/.claude/skills y ~/.agents/skills",
+	"skill_doctor.desc_summary": "Links de skills: %s",
+
+	"uninstall.title":      "🧹 Desinstalar",
+	"uninstall.desc_empty": "No se encontró nada instalado por Javi.Dots",
+	"uninstall.desc":       "Marcá qué quitar y elegí si hacer un backup antes",
+
+	"uninstall_result.title": "🧹 Resultado de la desinstalación",
+
+	"skill_update.title":    "🎯 Gestor de skills — Actualizar catálogo",
+	"skill_update.desc":     "Trayendo los últimos cambios de Gentleman-Skills",
+	"skill_update.updating": "Actualizando el catálogo...",
+
+	"skill_stats.title":   "🎯 Gestor de skills — Estadísticas del catálogo",
+	"skill_stats.desc":    "Resumen del catálogo local de skills",
+	"skill_stats.reading": "Leyendo el catálogo de skills...",
+
+	"skill_warnings.title": "🎯 Gestor de skills — Skills salteadas",
+	"skill_warnings.desc":  "Carpetas del catálogo que quedaron fuera de la lista de skills, y por qué",
+
+	"skill_targets.title":       "🎯 Gestor de skills — Destinos de los links",
+	"skill_targets.desc_remove": "Quitar las skills solo de estos directorios",
+	"skill_targets.desc":        "Linkear las skills en estos directorios (los plugins siempre van a ~/.claude/plugins)",
+
+	"skill_conflicts.title": "🎯 Gestor de skills — Skills locales en el camino",
+	"skill_conflicts.desc":  "Son directorios reales, no links del catálogo; elegí qué hacer con cada uno",
+
+	"settings.title":              "⚙️  Ajustes",
+	"settings.desc":               "Se guardan en %s al salir",
+	"settings.desc_read_only":     "Hay otro instalador en marcha: los cambios duran hasta que salgas y no se guardan",
+	"settings.show_hidden":        "Mostrar carpetas ocultas en el explorador de carpetas",
+	"settings.skip_welcome":       "Saltear la pantalla de bienvenida",
+	"settings.verbose_logs":       "Mostrar el detalle de los pasos al instalar",
+	"settings.theme":              "🎨 Tema: %s",
+	"settings.language":           "🌐 Idioma: %s",
+	"settings.no_color":           " (NO_COLOR está definido)",
+	"settings.lang_env":           " (GENTLEMAN_LANG está definido)",
+	"settings.icons":              "🔣 Íconos: %s",
+	"settings.icons_ascii":        " (ASCII por %s)",
+	"settings.icons_emoji":        " (emoji)",
+	"startup.icons_ascii":         "ℹ Íconos ASCII por %s; elegí emoji en Ajustes → Íconos",
+	"settings.notify":             "Avisar cuando termina una instalación o una tarea larga",
+	"settings.help":               "↑/k arriba • ↓/j abajo • [Space] marcar • [←/→] tema, idioma, íconos • [Esc] volver",
+	"settings.preview":            "Vista previa: %s",
+	"settings.preview_selected":   "Opción elegida",
+	"settings.preview_unselected": "Opción sin elegir",
+	"settings.preview_done":       "listo",
+	"settings.preview_warning":    "aviso",
+	"settings.preview_failed":     "falló",
+	"settings.preview_info":       "info",
+	"settings.preview_find_files": "buscar archivos",
+	"settings.preview_select":     "elegir",
+
+	"notify.title":                "Gentleman.Dots",
+	"notify.install_done":         "Instalación completa en %s",
+	"notify.install_failed":       "Falló la instalación",
+	"notify.project_done":         "Proyecto inicializado: %s",
+	"notify.project_failed":       "Falló la inicialización del proyecto: %s",
+	"notify.batch_done":           "Proyectos inicializados: %d bien, %d con errores",
+	"notify.skills_updated":       "Catálogo de skills actualizado",
+	"notify.skills_update_failed": "Falló la actualización del catálogo de skills",
+
+	"skill_manifest.title_export": "🎯 Gestor de skills — Exportar skills instaladas",
+	"skill_manifest.title":        "🎯 Gestor de skills — Instalar desde un manifiesto",
+	"skill_manifest.desc_export":  "Escribir las skills instaladas en este archivo (.json para JSON, otra extensión para un nombre por línea)",
+	"skill_manifest.desc":         "Un skills.json ({\"skills\": [...]}) o un archivo de texto con un nombre de skill por línea",
+	"skill_url.title":             "🎯 Gestor de skills — Instalar desde una URL",
+	"skill_url.desc":              "Un repositorio git con un SKILL.md en la raíz o en un único subdirectorio",
+
+	"profile_save.title": "💾 Guardar perfil",
+	"profile_save.desc":  "Poné un nombre a estas elecciones para instalarlas de nuevo sin el asistente:",
+
+	"install_refs.title": "⚙️  Avanzado: fijar versiones",
+	"install_refs.desc":  "Clonar una rama, tag o commit en vez de lo último, para reproducir una instalación",
+
+	"install_ref_input.desc_skills": "Rama, tag o commit del catálogo de skills (vacío para lo último):",
+	"install_ref_input.desc":        "Rama, tag o commit del repo de dotfiles (vacío para lo último):",
+
+	"profile_select.title": "📂 Instalar desde un perfil",
+	"profile_select.desc":  "Elecciones guardadas en %s — elegí una para saltear el asistente",
+
+	"profile_mismatch.title": "⚠️  El perfil no coincide con esta máquina",
+	"profile_mismatch.desc":  "Este perfil se guardó en otro tipo de máquina",
+
+	"unsupported_platform.title":         "⚠️  Plataforma no soportada",
+	"unsupported_platform.desc":          "La instalación no está disponible en %s",
+	"unsupported_platform.help":          "[Enter/Esc] volver",
+	"unsupported_platform.works":         "Funciona acá:",
+	"unsupported_platform.not_available": "No disponible:",
+	"unsupported_platform.supported":     "La instalación funciona en macOS, Linux (incluido WSL), Termux y Windows.",
+	"unsupported_platform.works_skills":  "✓ Gestor de skills",
+	"unsupported_platform.works_learn":   "✓ Aprender y practicar (guías, atajos, entrenador de Vim)",
+	"unsupported_platform.no_install":    "✗ Instalación del entorno (terminal, shell, multiplexor, Neovim, herramientas de IA)",
+
+	"instance_locked.title":           "🔒 Hay otro instalador corriendo",
+	"instance_locked.desc":            "Esta pantalla cambia tu configuración, así que está desactivada hasta que el otro instalador termine",
+	"instance_locked.holder":          "Otro instalador (%s) está cambiando tu configuración.",
+	"instance_locked.holder_since":    "PID %d, corriendo desde %s",
+	"instance_locked.why":             "Correr los dos a la vez podría romper el progreso de la instalación, las configs o las skills.",
+	"instance_locked.available":       "Sigue disponible:",
+	"instance_locked.available_learn": "✓ Aprender y practicar (guías, atajos, entrenador de Vim; el progreso no se guarda)",
+	"instance_locked.available_check": "✓ Verificar instalación (chequeos de solo lectura)",
+	"instance_locked.hint":            "Terminá o cerrá el otro instalador y después volvé a abrir este.",
+	"instance_locked.help":            "[Enter/Esc] volver",
+
+	"trainer_reset_confirm.title":    "🎮 Entrenador de Vim - Reiniciar práctica",
+	"trainer_reset_confirm.desc":     "Esto no se puede deshacer desde el entrenador",
+	"trainer_reset_confirm.yes":      "🔄 Sí, reiniciar el progreso de práctica",
+	"trainer_reset_confirm.cancel":   "← Cancelar",
+	"trainer_reset_confirm.help":     "↑/k arriba • ↓/j abajo • [Enter] elegir • [y] reiniciar • [n/Esc] cancelar",
+	"trainer_reset_confirm.lose":     "Vas a perder:",
+	"trainer_reset_confirm.kept":     "Las lecciones, el progreso contra los jefes y el puntaje se conservan.",
+	"trainer_reset_confirm.question": "¿Reiniciar el progreso de práctica de %s %s?",
+	"trainer_reset_confirm.mastered": "Ejercicios dominados: %d/%d",
+	"trainer_reset_confirm.accuracy": "Precisión en la práctica: %.0f%% en %d intentos",
+
+	"trainer_settings.title":     "🎮 Entrenador de Vim - Ajustes",
+	"trainer_settings.desc":      "Gestioná tu progreso en el entrenador",
+	"trainer_settings.reset_all": "🗑️  Reiniciar todo el progreso del entrenador",
+
+	"trainer_import.title": "🎮 Entrenador de Vim - Importar progreso",
+	"trainer_import.desc":  "Ruta a un progreso exportado desde otra máquina. Se combina con el tuyo, quedándose con lo mejor de ambos.",
+
+	"trainer_reset_all.title":  "🎮 Entrenador de Vim - Reiniciar todo el progreso",
+	"trainer_reset_all.desc":   "Cada módulo, jefe, puntaje y racha empieza de cero. Tus estadísticas actuales quedan en un archivo .bak.",
+	"trainer_reset_all.help":   "[Enter] confirmar • [Esc] cancelar",
+	"trainer_reset_all.prompt": "Escribí %q y presioná Enter para reiniciar todo el progreso del entrenador:",
+
+	"skill_detail.title":       "🎯 Gestor de skills — Skill",
+	"skill_detail.desc":        "Detalles y archivos de la skill",
+	"skill_detail.title_skill": "🎯 Gestor de skills — %s",
+
+	"trainer.review_empty":           "Nada para repasar. Acá aparecen tus errores.",
+	"trainer.timed_empty":            "Todavía no hay ejercicios para la contrarreloj.",
+	"trainer.timed_stopped":          "Contrarreloj detenida.",
+	"trainer.timed_optimal":          "✨ +%d ¡Óptimo!",
+	"trainer.timed_correct":          "✓ +%d (óptimo: %s)",
+	"trainer.timed_wrong":            "✗ Era: %s",
+	"trainer.export_no_home":         "⚠️  Falló la exportación: no se encontró tu directorio home",
+	"trainer.export_failed":          "⚠️  Falló la exportación: %v",
+	"trainer.exported":               "📤 Progreso exportado a %s",
+	"trainer.import_failed":          "⚠️  Falló la importación: %v",
+	"trainer.imported":               "📥 Progreso importado desde %s y combinado con el tuyo",
+	"trainer.reset_cancelled":        "Reinicio cancelado.",
+	"trainer.import_cancelled":       "Importación cancelada.",
+	"trainer.import_read_only":       "⚠️  La importación está desactivada mientras corre otro instalador",
+	"trainer.module_locked":          "🔒 ¡Módulo bloqueado! Primero vencé al jefe anterior.",
+	"trainer.no_lessons":             "Todavía no hay lecciones para este módulo.",
+	"trainer.practice_complete":      "🎉 ¡Práctica completa! ¡Dominaste todos los ejercicios! Presioná [r] para reiniciar.",
+	"trainer.practice_locked":        "¡Completá todas las lecciones para desbloquear la práctica!",
+	"trainer.practice_module_locked": "🔒 Módulo bloqueado. Primero vencé al jefe anterior.",
+	"trainer.no_practice_progress":   "No hay progreso de práctica para reiniciar en %s.",
+	"trainer.no_placement":           "La prueba de nivel no está disponible.",
+	"trainer.no_boss":                "¡El jefe todavía no está implementado!",
+	"trainer.boss_locked":            "¡Completá las lecciones y un 80% de precisión en la práctica para pelear con el jefe!",
+	"trainer.practice_reset":         "🔄 Progreso de práctica reiniciado para %s. ¡Probá de nuevo!",
+	"trainer.reset_type_word":        "Escribí %q para confirmar, o presioná Esc para cancelar.",
+	"trainer.reset_failed":           "No se pudo reiniciar el progreso: %v",
+	"trainer.reset_all_done":         "🗑️  Se reinició todo el progreso del entrenador.",
+	"trainer.reset_all_backup":       " Las estadísticas anteriores se guardaron en %s",
+	"trainer.perfect":                "✨ ¡Perfecto! ¡Solución óptima!",
+	"trainer.correct_not_optimal":    "✓ ¡Correcto! Pero %s es más eficiente.",
+	"trainer.correct_creative":       "✓ ¡Correcto! ¡Solución creativa! Óptima: %s",
+	"trainer.incorrect":              "✗ Incorrecto. Soluciones: %s",
+	"trainer.hint":                   "💡 Pista: %s",
+	"trainer.boss_abandoned":         "¡Abandonaste la pelea con el jefe!",
+	"trainer.boss_victory":           "🏆 ¡VICTORIA! ¡Venciste a %s!",
+	"trainer.boss_perfect":           "✨ ¡Perfecto! Siguiente desafío...",
+	"trainer.boss_good":              "✓ ¡Bien! (Óptimo: %s) Siguiente...",
+	"trainer.boss_defeated":          "💀 ¡DERROTA! La solución era: %s",
+	"trainer.boss_wrong":             "✗ ¡Mal! Era: %s | Vidas: %s",
+	"trainer.review_cleared":         "🎉 ¡Lista de repaso vacía! Corregiste todos los errores.",
+	"trainer.all_mastered":           "🎉 ¡Dominaste todos los ejercicios! ¡Sos un maestro de Vim! 🏆",
+	"trainer.lesson_complete":        "🎉 ¡Lección completa! ¡Modo práctica desbloqueado!",
+	"trainer.help_timed":             "Escribí el comando • [Enter] enviar • [Backspace] borrar • [Esc] parar",
+	"trainer.help":                   "Escribí el comando • [Enter] enviar • [Tab] pista • [Backspace] borrar • [Esc] salir",
+	"trainer.no_exercise":            "No hay ningún ejercicio cargado",
+	"trainer.mission":                "📋 Misión:",
+	"trainer.code":                   "📝 Código:",
+	"trainer.your_answer":            "⌨️  Tu respuesta:",
+	"trainer.no_daily_streak":        "📅 Todavía no tenés racha diaria: respondé un ejercicio para empezar una",
+	"trainer.streak_ended":           "⏰ Tu racha terminó; practicá hoy para empezar una nueva",
+	"trainer.exercise_of":            "Ejercicio %d de %d",
+	"trainer.score_review":           "Puntaje: %d | Racha: %d | Para repasar: %d",
+	"trainer.score":                  "Puntaje: %d | Racha: %d",
+	"trainer.day":                    "1 día",
+	"trainer.days":                   "%d días",
+	"trainer.daily_streak":           "📅 Racha diaria: %s  |  Más larga: %s  |  Respondidos: %d",
+	"trainer.keep_streak":            "⏰ Practicá hoy para mantener tu racha de %s",
+	"trainer.answer_time":            "⏱  Tiempo: %.1fs",
+	"trainer.new_best":               "🏅 ¡Nuevo récord personal!",
+	"trainer.best_time":              "(récord %.1fs)",
+	"trainer.mode_title":             "🎮 Modo %s: %s",
+	"trainer.mode_placement":         "Prueba de nivel",
+	"trainer.mode_lesson":            "Lección",
+	"trainer.mode_review":            "Repaso",
+	"trainer.mode_practice":          "Práctica",
+	"trainer.mode_speed_run":         "Contrarreloj",
+
+	"help.select_back":      "↑/k arriba • ↓/j abajo • [Enter] elegir • [Esc] volver",
+	"help.select_quit":      "↑/k arriba • ↓/j abajo • [Enter] elegir • [Space q] salir",
+	"help.select_cancel":    "↑/k arriba • ↓/j abajo • [Enter] elegir • [Esc] cancelar",
+	"help.select_back_quit": "↑/k arriba • ↓/j abajo • [Enter] elegir • [Esc] volver • [Space q] salir",
+	"help.toggle_confirm":   "↑/k arriba • ↓/j abajo • [Space] marcar • [Enter] confirmar • [Esc] volver",
+	"help.select_search":    "↑/k arriba • ↓/j abajo • [Enter] elegir • [/] buscar • [Esc/q] volver",
+	"help.select_back_q":    "↑/k arriba • ↓/j abajo • [Enter] elegir • [Esc/q] volver",
+	"help.back":             "↑/k arriba • ↓/j abajo • [Enter/Esc/q] volver",
+	"help.back_enter_esc":   "↑/k arriba • ↓/j abajo • [Enter/Esc] volver",
+	"help.page_back":        "↑/k arriba • ↓/j abajo • PgUp/PgDn • [Enter/Esc/q] volver",
+	"help.page_back_list":   "↑/k arriba • ↓/j abajo • PgUp/PgDn • [Enter/Esc/q] volver a la lista",
+	"help.exit":             "Presioná [Enter] o [q] para salir",
+	"help.esc_back":         "Presioná Esc para volver",
+	"help.wait":             "Esperá...",
+
+	"tool_info.not_found": "Herramienta no encontrada",
+	"tool_info.pros":      "✓ Ventajas",
+	"tool_info.cons":      "✗ Desventajas",
+
+	"diagnose.no_install": "Dotfiles instalados: no hay ninguna instalación registrada",
+	"diagnose.installed":  "Dotfiles instalados: %s, instalados el %s",
+
+	"install_log.no_output":   "Todavía no hay salida",
+	"install_log.lines":       "Líneas %d-%d de %d",
+	"install_log.following":   " (siguiendo la salida nueva)",
+	"install_log.newer_below": " (%d más nuevas abajo, G para seguirlas)",
+	"install_log.help":        "j/k desplazarte • PgUp/PgDn página • gg/G inicio/final • [Esc] volver a los pasos",
+
+	"layout.too_small": "La terminal es demasiado chica",
+	"layout.needs":     "%dx%d, hace falta %dx%d",
+	"layout.resize":    "Agrandala para continuar",
+
+	"help_overlay.no_keys":    "No hay más teclas que las de abajo",
+	"help_overlay.everywhere": "En todas las pantallas",
+	"help_overlay.close":      "Presioná cualquier tecla para cerrar",
+
+	"keymap_search.desc":        "Buscá teclas y descripciones en todas las categorías",
+	"keymap_search.edit_hint":   "(/ para editar, Esc para borrar)",
+	"keymap_search.no_match":    "Ningún atajo coincide con %q",
+	"keymap_search.help_typing": "Escribí para buscar • ↑/↓ moverte • [Enter] abrir • [Esc] borrar",
+	"keymap_search.help":        "↑/k arriba • ↓/j abajo • [Enter] abrir • [/] editar la búsqueda • [Esc] borrar",
+
+	"ai_module_search.desc":        "Buscá nombres e IDs de módulos en todas las categorías",
+	"ai_module_search.edit_hint":   "(/ para editar, Esc para cerrar)",
+	"ai_module_search.no_match":    "Ningún módulo coincide con %q",
+	"ai_module_search.help_typing": "Escribí para buscar • ↑/↓ moverte • [Enter] a los resultados • [Esc] cerrar",
+	"ai_module_search.help":        "↑/k arriba • ↓/j abajo • [Space/Enter] marcar • [/] editar la búsqueda • [Esc] cerrar",
+
+	"skill_select.all":      "✅ Elegir todas",
+	"skill_select.no_match": "Ninguna skill coincide con %q",
+}
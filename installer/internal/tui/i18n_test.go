@@ -0,0 +1,170 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// usedMessageKeys are the keys passed as literals to T and Tf in the package
+// source, plus the ones kept in readOnlyEntries
+func usedMessageKeys(t *testing.T) []string {
+	t.Helper()
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	call := regexp.MustCompile(`\bTf?\("([^"]+)"`)
+	keys := append([]string{}, readOnlyEntries...)
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, match := range call.FindAllStringSubmatch(string(data), -1) {
+			keys = append(keys, match[1])
+		}
+	}
+	if len(keys) < 100 {
+		t.Fatalf("found only %d message keys, the source scan looks broken", len(keys))
+	}
+	return keys
+}
+
+func TestEveryUsedKeyHasEnglish(t *testing.T) {
+	for _, key := range usedMessageKeys(t) {
+		if _, ok := messagesEnglish[key]; !ok {
+			t.Errorf("%q has no English message", key)
+		}
+	}
+}
+
+func TestCatalogsOnlyTranslateEnglishKeys(t *testing.T) {
+	for lang, catalog := range messages {
+		for key := range catalog {
+			if _, ok := messagesEnglish[key]; !ok {
+				t.Errorf("%s has %q, which English lacks", lang, key)
+			}
+		}
+	}
+}
+
+func TestSpanishCoversTheWizard(t *testing.T) {
+	wizard := []string{
+		"common.", "welcome.", "main_menu.", "os_select.", "terminal_select.", "font_select.",
+		"shell_select.", "zsh_merge_select.", "wm_select.", "wm_extras.", "nvim_select.",
+		"zed_select.", "ai_tools_select.", "ai_framework_", "backup_confirm.", "installing.",
+		"complete.", "error.", "settings.", "disk_space_warning.", "wizard_review.",
+	}
+	for key := range messagesEnglish {
+		for _, prefix := range wizard {
+			if strings.HasPrefix(key, prefix) {
+				if _, ok := messagesSpanish[key]; !ok {
+					t.Errorf("the wizard message %q has no Spanish translation", key)
+				}
+			}
+		}
+	}
+
+	m := NewModel()
+	m.Lang = langSpanish
+	for _, screen := range []Screen{ScreenMainMenu, ScreenOSSelect, ScreenTerminalSelect, ScreenFontSelect, ScreenShellSelect, ScreenNvimSelect} {
+		m.Screen = screen
+		if title := m.GetScreenTitle(); title == "" || title == m.canonicalTitle() {
+			t.Errorf("screen %v should have a Spanish title, got %q", screen, title)
+		}
+	}
+}
+
+// canonicalTitle is the screen title in English
+func (m Model) canonicalTitle() string {
+	m.Lang = langEnglish
+	return m.GetScreenTitle()
+}
+
+func TestMissingTranslationFallsBackToEnglish(t *testing.T) {
+	saved := messagesSpanish["os_select.title"]
+	delete(messagesSpanish, "os_select.title")
+	t.Cleanup(func() { messagesSpanish["os_select.title"] = saved })
+
+	m := NewModel()
+	m.Lang = langSpanish
+	if got := m.T("os_select.title"); got != messagesEnglish["os_select.title"] {
+		t.Errorf("a missing Spanish message should be shown in English, got %q", got)
+	}
+	if got := m.T("no.such.key"); got != "no.such.key" {
+		t.Errorf("an unknown key should be shown as is, got %q", got)
+	}
+}
+
+func TestLanguageEnvOverride(t *testing.T) {
+	for _, tc := range []struct{ env, setting, want string }{
+		{"", langSpanish, langSpanish},
+		{"es_AR.UTF-8", langEnglish, langSpanish},
+		{"EN", langSpanish, langEnglish},
+		{"klingon", langSpanish, langSpanish},
+		{"", "klingon", langEnglish},
+	} {
+		t.Setenv(languageEnv, tc.env)
+		if got := activeLanguage(tc.setting); got != tc.want {
+			t.Errorf("GENTLEMAN_LANG=%q with %q set: got %q, want %q", tc.env, tc.setting, got, tc.want)
+		}
+	}
+
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv(languageEnv, "es")
+	m := NewModel()
+	m.Screen = ScreenMainMenu
+	if m.Lang != langSpanish || m.GetCurrentOptions()[0] != messagesSpanish["main_menu.start"] {
+		t.Errorf("GENTLEMAN_LANG should switch the UI to Spanish, got %q", m.GetCurrentOptions()[0])
+	}
+}
+
+func TestSettingsLanguage(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv(languageEnv, "")
+	press := func(m Model, key tea.KeyMsg) Model {
+		result, _ := m.Update(key)
+		return result.(Model)
+	}
+
+	m := NewModel()
+	m.Screen = ScreenMainMenu
+	m = selectMainMenu(t, m, "Settings")
+	m.Cursor = settingsLanguageRow
+	m = press(m, tea.KeyMsg{Type: tea.KeyRight})
+	if m.Settings.Language != langSpanish || m.Lang != langSpanish {
+		t.Fatalf("right should switch to Spanish, got %q", m.Settings.Language)
+	}
+	if !strings.Contains(m.View(), messagesSpanish["settings.title"]) {
+		t.Error("the settings screen should be redrawn in Spanish right away")
+	}
+
+	// Handlers still find their rows while the options are in Spanish
+	m = press(m, tea.KeyMsg{Type: tea.KeyEsc})
+	m = selectMainMenu(t, m, "Ajustes")
+	if m.Screen != ScreenSettings {
+		t.Fatalf("the Spanish main menu should still open the settings, got %v", m.Screen)
+	}
+
+	if err := m.SaveSettings(); err != nil {
+		t.Fatal(err)
+	}
+	if settings, _ := loadSettings(home); settings.Language != langSpanish {
+		t.Errorf("the language should be saved, got %q", settings.Language)
+	}
+
+	writeSettingsFile(t, home, `{"version": 2, "language": "klingon", "skip_welcome": true}`)
+	if settings, _ := loadSettings(home); settings.Language != langEnglish || !settings.SkipWelcome {
+		t.Errorf("an unknown language should fall back and keep the rest, got %+v", settings)
+	}
+}
@@ -0,0 +1,122 @@
+package tui
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Values of the Icons setting. Auto draws emoji unless the terminal looks
+// like it can't show them.
+const (
+	iconsAuto  = ""
+	iconsEmoji = "emoji"
+	iconsASCII = "ascii"
+)
+
+var iconModes = []string{iconsAuto, iconsEmoji, iconsASCII}
+
+// iconModeName is how Settings shows an Icons value
+func iconModeName(mode string) string {
+	if mode == iconsAuto {
+		return "auto"
+	}
+	return mode
+}
+
+// consoleTerms are TERM values of consoles whose fonts have no emoji
+var consoleTerms = []string{"linux", "dumb", "vt100", "vt102", "vt220", "cons25"}
+
+// terminalLacksEmoji reports whether the terminal described by the
+// environment can't draw the UI's icons, and why: a console TERM, or a locale
+// naming a charset other than UTF-8. An unset or C locale says nothing about
+// the terminal, so only an explicit charset counts.
+func terminalLacksEmoji(getenv func(string) string) (bool, string) {
+	if term := getenv("TERM"); slices.Contains(consoleTerms, term) {
+		return true, "TERM=" + term
+	}
+	for _, name := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		locale := getenv(name)
+		if locale == "" {
+			continue
+		}
+		_, charset, ok := strings.Cut(locale, ".")
+		charset, _, _ = strings.Cut(charset, "@")
+		charset = strings.ReplaceAll(strings.ToLower(charset), "-", "")
+		if ok && charset != "utf8" {
+			return true, name + "=" + locale
+		}
+		return false, ""
+	}
+	return false, ""
+}
+
+// useASCIIIcons reports whether the UI draws the ASCII icon set for the
+// Icons setting mode in the environment described by getenv
+func useASCIIIcons(mode string, getenv func(string) string) bool {
+	switch mode {
+	case iconsASCII:
+		return true
+	case iconsEmoji:
+		return false
+	}
+	lacks, _ := terminalLacksEmoji(getenv)
+	return lacks
+}
+
+// asciiIconSet replaces the UI's icons, marks and line drawing in ASCII mode.
+// Symbols missing here are drawn as "*".
+var asciiIconSet = map[rune]string{
+	// Marks
+	'✅': "OK", '❌': "X", '✓': "x", '✗': "X", '⚠': "!", 'ℹ': "i", '⊘': "-", '★': "*", '●': "*", '○': "o",
+	// Pointers and arrows
+	'▸': ">", '▶': ">", '▲': "^", '▼': "v", '←': "<", '→': ">", '↑': "^", '↓': "v", '⬆': "^", '↩': "<", '↻': "@",
+	// Skill categories
+	'📦': "#", '🌐': "@", '🏠': "~", '📁': "/", '📂': "/", '🔗': "&",
+	// Spinner frames, turned into a spinning bar
+	'⠋': "|", '⠙': "/", '⠹': "-", '⠸': "\\", '⠼': "|", '⠴': "/", '⠦': "-", '⠧': "\\", '⠇': "|", '⠏': "/",
+	// Lines, boxes and bars
+	'─': "-", '━': "=", '═': "=", '│': "|", '║': "|",
+	'├': "+", '└': "+", '┌': "+", '┐': "+", '┘': "+", '┤': "+", '┬': "+", '┴': "+", '┼': "+",
+	'╔': "+", '╗': "+", '╚': "+", '╝': "+", '╭': "+", '╮': "+", '╰': "+", '╯': "+",
+	'█': "#", '▓': "#", '▒': ":", '░': ".",
+	// Punctuation
+	'•': "*", '·': ".", '—': "-", '–': "-", '…': ".", '“': "\"", '”': "\"",
+}
+
+// asciiIcons redraws s with the ASCII icon set. Each replacement is padded to
+// the width of the icon it stands for, so boxes and columns stay aligned.
+// Letters, accented ones included, are kept.
+func asciiIcons(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for i, r := range s {
+		if r < utf8.RuneSelf {
+			b.WriteRune(r)
+			continue
+		}
+		if r == '\uFE0F' || r == '\u200D' {
+			continue // Emoji presentation selector and joiner
+		}
+		ascii, ok := asciiIconSet[r]
+		if !ok {
+			if !unicode.In(r, unicode.So, unicode.Sm, unicode.Sk) {
+				b.WriteRune(r)
+				continue
+			}
+			ascii = "*"
+		}
+		width := lipgloss.Width(string(r))
+		if next, _ := utf8.DecodeRuneInString(s[i+utf8.RuneLen(r):]); next == '\uFE0F' {
+			width = 2
+		}
+		b.WriteString(ascii)
+		if pad := width - len(ascii); pad > 0 {
+			b.WriteString(strings.Repeat(" ", pad))
+		}
+	}
+	return b.String()
+}
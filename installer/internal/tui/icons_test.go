@@ -0,0 +1,166 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"unicode"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func envFrom(vars map[string]string) func(string) string {
+	return func(name string) string { return vars[name] }
+}
+
+func TestTerminalLacksEmoji(t *testing.T) {
+	tests := []struct {
+		name   string
+		env    map[string]string
+		want   bool
+		reason string
+	}{
+		{"nothing set", nil, false, ""},
+		{"terminal emulator", map[string]string{"TERM": "xterm-256color", "LANG": "en_US.UTF-8"}, false, ""},
+		{"utf8 spelling", map[string]string{"LANG": "es_AR.utf8"}, false, ""},
+		{"linux console", map[string]string{"TERM": "linux", "LANG": "en_US.UTF-8"}, true, "TERM=linux"},
+		{"dumb terminal", map[string]string{"TERM": "dumb"}, true, "TERM=dumb"},
+		{"latin-1 locale", map[string]string{"LANG": "de_DE.ISO-8859-1"}, true, "LANG=de_DE.ISO-8859-1"},
+		{"modifier after the charset", map[string]string{"LANG": "de_DE.ISO-8859-15@euro"}, true, "LANG=de_DE.ISO-8859-15@euro"},
+		{"LC_ALL wins", map[string]string{"LC_ALL": "en_US.ISO-8859-1", "LANG": "en_US.UTF-8"}, true, "LC_ALL=en_US.ISO-8859-1"},
+		{"LC_CTYPE before LANG", map[string]string{"LC_CTYPE": "en_US.UTF-8", "LANG": "en_US.ISO-8859-1"}, false, ""},
+		{"C locale says nothing", map[string]string{"LC_ALL": "C"}, false, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, reason := terminalLacksEmoji(envFrom(tt.env))
+			if got != tt.want || reason != tt.reason {
+				t.Errorf("got %v %q, want %v %q", got, reason, tt.want, tt.reason)
+			}
+		})
+	}
+}
+
+func TestUseASCIIIcons(t *testing.T) {
+	console := envFrom(map[string]string{"TERM": "linux"})
+	xterm := envFrom(map[string]string{"TERM": "xterm"})
+	if !useASCIIIcons(iconsAuto, console) || useASCIIIcons(iconsAuto, xterm) {
+		t.Error("auto should follow the terminal")
+	}
+	if useASCIIIcons(iconsEmoji, console) || !useASCIIIcons(iconsASCII, xterm) {
+		t.Error("an explicit setting should win over the terminal")
+	}
+}
+
+func TestASCIIIcons(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"✅ Confirm installation", "OK Confirm installation"},
+		{"[✓] 📦 Curated", "[x] #  Curated"},
+		{"⚠️  Catalog entry skipped", "!   Catalog entry skipped"},
+		{"▸ ← Back", "> < Back"},
+		{"🎩 Instalación — ¿listo?", "*  Instalación - ¿listo?"},
+		{"⠋ Fetching", "| Fetching"},
+		{"plain text", "plain text"},
+	}
+	for _, tt := range tests {
+		if got := asciiIcons(tt.in); got != tt.want {
+			t.Errorf("asciiIcons(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+
+	// Replacements keep the width of what they replace, so boxes stay closed
+	box := lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Render("✅ done\n⚠️ warning 📦\n• ℹ info")
+	for i, line := range strings.Split(asciiIcons(box), "\n") {
+		if want := lipgloss.Width(strings.Split(box, "\n")[i]); lipgloss.Width(line) != want {
+			t.Errorf("line %d %q is %d wide, want %d", i, line, lipgloss.Width(line), want)
+		}
+	}
+}
+
+// nonASCIISymbols returns the non-ASCII symbols, marks and line drawing left in s
+func nonASCIISymbols(s string) string {
+	var found []rune
+	for _, r := range s {
+		if _, ok := asciiIconSet[r]; ok || r > unicode.MaxASCII && unicode.In(r, unicode.So, unicode.Sm, unicode.Sk) {
+			found = append(found, r)
+		}
+	}
+	return string(found)
+}
+
+func TestASCIIIconsWholeUI(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("TERM", "xterm-256color")
+	writeSettingsFile(t, home, `{"version": 2, "icons": "ascii"}`)
+
+	m := NewModel()
+	if !m.ASCIIIcons {
+		t.Fatal("the icons setting should switch to ASCII")
+	}
+	m.Width, m.Height = 100, 40
+	for _, screen := range []Screen{ScreenWelcome, ScreenMainMenu, ScreenSettings, ScreenSkillMenu, ScreenOSSelect} {
+		m.Screen = screen
+		m.Cursor = 0
+		if left := nonASCIISymbols(m.View()); left != "" {
+			t.Errorf("screen %v still draws %q", screen, left)
+		}
+	}
+}
+
+func TestIconsSetting(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("TERM", "linux")
+	t.Setenv("LC_ALL", "")
+	t.Setenv("LANG", "")
+
+	m := NewModel()
+	if !m.ASCIIIcons {
+		t.Fatal("auto should pick ASCII on the Linux console")
+	}
+	m.Width, m.Height = 100, 40
+	if view := m.renderWelcome(); !strings.Contains(view, "ASCII icons for TERM=linux") {
+		t.Error("the welcome screen should say auto switched to ASCII")
+	}
+	if view := m.renderMainMenu(); strings.Contains(view, "ASCII icons for") {
+		t.Error("the main menu should leave the notice to the welcome screen")
+	}
+	m.Settings.SkipWelcome = true
+	if view := m.renderMainMenu(); !strings.Contains(view, "ASCII icons for TERM=linux") {
+		t.Error("without the welcome screen, the main menu should carry the notice")
+	}
+	m.Settings.SkipWelcome = false
+
+	m.Screen = ScreenSettings
+	m.Cursor = settingsIconsRow
+	if opt := m.GetCurrentOptions()[settingsIconsRow]; !strings.Contains(opt, "auto (ASCII for TERM=linux)") {
+		t.Errorf("the icons row should say why auto picked ASCII, got %q", opt)
+	}
+
+	m = typeKeys(t, m, tea.KeyMsg{Type: tea.KeyRight})
+	if m.Settings.Icons != iconsEmoji || m.ASCIIIcons {
+		t.Errorf("right should step to emoji and redraw right away, got %q ascii=%v", m.Settings.Icons, m.ASCIIIcons)
+	}
+	m = typeKeys(t, m, runes(" "))
+	if m.Settings.Icons != iconsASCII || !m.ASCIIIcons {
+		t.Errorf("space should step to ascii, got %q", m.Settings.Icons)
+	}
+	m = typeKeys(t, m, tea.KeyMsg{Type: tea.KeyRight})
+	if m.Settings.Icons != iconsAuto {
+		t.Errorf("right should wrap around to auto, got %q", m.Settings.Icons)
+	}
+
+	m = typeKeys(t, m, tea.KeyMsg{Type: tea.KeyLeft})
+	if err := m.SaveSettings(); err != nil {
+		t.Fatal(err)
+	}
+	if settings, _ := loadSettings(home); settings.Icons != iconsASCII {
+		t.Errorf("the icons setting should be saved, got %q", settings.Icons)
+	}
+
+	writeSettingsFile(t, home, `{"version": 2, "icons": "sparkles"}`)
+	if settings, _ := loadSettings(home); settings.Icons != iconsAuto {
+		t.Errorf("an unknown icon set should fall back to auto, got %q", settings.Icons)
+	}
+}
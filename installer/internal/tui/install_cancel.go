@@ -0,0 +1,155 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// errInstallCancelled is what a step stopped by <space>x returns
+var errInstallCancelled = errors.New("installation cancelled")
+
+// startInstallContext gives the install a context that <space>x cancels. The
+// commands the steps run are started under it, so cancelling kills them.
+func (m *Model) startInstallContext() {
+	if m.InstallCancel != nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	m.InstallCtx, m.InstallCancel = ctx, cancel
+}
+
+// releaseInstallContext drops the context of an install that has stopped
+func (m *Model) releaseInstallContext() {
+	if m.InstallCancel != nil {
+		m.InstallCancel()
+		m.InstallCancel = nil
+	}
+	m.InstallCtx = nil
+}
+
+// installContext is the context the steps of the running install run under
+func (m Model) installContext() context.Context {
+	if m.InstallCtx == nil {
+		return context.Background()
+	}
+	return m.InstallCtx
+}
+
+// cancelInstall stops the install: the running commands are killed, the steps
+// not started yet are skipped, and once the running steps return the
+// cancelled screen is shown. An interactive step owns the terminal until it
+// exits, so the cancel takes effect when it does.
+func (m Model) cancelInstall() (tea.Model, tea.Cmd) {
+	m.InstallCancelled = true
+	if m.InstallCancel != nil {
+		m.InstallCancel()
+	}
+	m.LogLines.Add("⏹ Cancelling installation...")
+	activeLog.Write("", "Cancelling installation")
+	for i := range m.Steps {
+		if m.Steps[i].Status == StatusPending {
+			m.skipCancelledStep(i)
+		}
+	}
+	m.saveProgress()
+	m.skipFinishedSteps()
+	return m.runReadySteps()
+}
+
+// skipCancelledStep marks step i as skipped by the cancel, so a resume runs it
+func (m *Model) skipCancelledStep(i int) {
+	m.Steps[i].Status = StatusSkipped
+	m.Steps[i].Cancelled = true
+	m.Steps[i].Error = nil
+	m.LogLines.Add("⏭️  Skipped " + m.Steps[i].Name)
+}
+
+// showInstallCancelled lands a cancelled install on the error screen, which
+// then lists what was done and what was skipped (see renderInstallCancelled)
+func (m Model) showInstallCancelled() Model {
+	activeLog.Write("", "Installation cancelled")
+	m.releaseInstallContext()
+	m.Screen = ScreenError
+	m.ErrorMsg = errInstallCancelled.Error()
+	m.TempCleanupNote = cleanupAfterFailure()
+	m.saveProgress()
+	system.RecordDeploys("")
+	return m
+}
+
+// resumeCancelledInstall runs the steps the cancel skipped or stopped, and
+// any that failed while it waited for them
+func (m Model) resumeCancelledInstall() (tea.Model, tea.Cmd) {
+	m.InstallCancelled = false
+	for i := range m.Steps {
+		if m.Steps[i].Cancelled || m.Steps[i].Status == StatusFailed {
+			m.Steps[i].Status = StatusPending
+			m.Steps[i].Cancelled = false
+			m.Steps[i].Progress = 0
+			m.Steps[i].Error = nil
+			if i < m.CurrentStep {
+				m.CurrentStep = i
+			}
+		}
+	}
+	return m.continueInstall()
+}
+
+// renderInstallCancelled is the error screen of a cancelled install
+func (m Model) renderInstallCancelled() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Warning.Render(m.T("error.cancelled")))
+	s.WriteString("\n\n")
+
+	var done, skipped, failed []string
+	for _, step := range m.Steps {
+		switch {
+		case step.Status == StatusDone:
+			done = append(done, step.Name)
+		case step.Cancelled:
+			skipped = append(skipped, step.Name)
+		case step.Status == StatusFailed:
+			failed = append(failed, step.Name)
+		}
+	}
+	s.WriteString(m.Theme.Muted.Render(m.Tf("error.cancelled_done", len(done))))
+	s.WriteString("\n")
+	for _, name := range done {
+		s.WriteString(m.Theme.Success.Render("  ✓ " + name))
+		s.WriteString("\n")
+	}
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.Tf("error.cancelled_skipped", len(skipped))))
+	s.WriteString("\n")
+	for _, name := range skipped {
+		s.WriteString(m.Theme.Warning.Render("  ⏭ " + name))
+		s.WriteString("\n")
+	}
+	s.WriteString("\n")
+	if len(failed) > 0 {
+		s.WriteString(m.Theme.Muted.Render(m.Tf("error.cancelled_failed", len(failed))))
+		s.WriteString("\n")
+		for _, name := range failed {
+			s.WriteString(m.Theme.Error.Render("  ✗ " + name))
+			s.WriteString("\n")
+		}
+		s.WriteString("\n")
+	}
+
+	if m.TempCleanupNote != "" {
+		s.WriteString(m.Theme.Muted.Render(m.TempCleanupNote))
+		s.WriteString("\n\n")
+	}
+	if m.LogPath != "" {
+		s.WriteString(m.Theme.Muted.Render(m.Tf("common.full_log", m.LogPath)))
+		s.WriteString("\n\n")
+	}
+
+	s.WriteString(m.Theme.Help.Render(m.T("error.help_resume")))
+	return s.String()
+}
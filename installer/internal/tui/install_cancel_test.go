@@ -0,0 +1,129 @@
+package tui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// cancelModel is an install of fast, then slow, then later (which needs slow)
+func cancelModel(t *testing.T, slow func(m *Model) error) Model {
+	t.Helper()
+	useTestRegistry(t,
+		StepSpec{ID: "fast", Name: "Fast", Run: func(*Model) error { return nil }},
+		StepSpec{ID: "slow", Name: "Slow", Run: slow},
+		StepSpec{ID: "later", Name: "Later", DependsOn: []string{"slow"}, Run: func(*Model) error { return nil }},
+	)
+	m := NewModel()
+	m.Screen = ScreenInstalling
+	m.RepoDir = t.TempDir()
+	m.LogPath = "/tmp/gentleman/install.log"
+	m.SetupInstallSteps()
+	return m
+}
+
+func TestCancelInstallKillsTheRunningStep(t *testing.T) {
+	m := cancelModel(t, func(m *Model) error {
+		if result := system.RunWithLogs(m.installContext(), "sleep 10", nil, nil); result.Error != nil {
+			return result.Error
+		}
+		return nil
+	})
+
+	result, _ := m.Update(installStartMsg{})
+	m = result.(Model)
+	result, _ = m.Update(m.stepCmd("fast", false)())
+	m = result.(Model)
+	slow := make(chan tea.Msg, 1)
+	go func() { slow <- m.stepCmd("slow", false)() }()
+
+	if !strings.Contains(m.renderLeaderBar(), "x cancel install") {
+		t.Fatalf("the leader bar should offer the cancel while installing: %q", m.renderLeaderBar())
+	}
+	result, _ = m.runLeader("x")
+	m = result.(Model)
+	if m.Screen != ScreenInstalling || m.Steps[2].Status != StatusSkipped {
+		t.Fatalf("the pending step should be skipped while the slow one stops, got %v and %v", m.Screen, m.Steps[2].Status)
+	}
+
+	var msg tea.Msg
+	select {
+	case msg = <-slow:
+	case <-time.After(3 * time.Second):
+		t.Fatal("the slow step's command was not killed")
+	}
+	if err := msg.(stepCompleteMsg).err; !errors.Is(err, errInstallCancelled) {
+		t.Errorf("the stopped step should report the cancel, got %v", err)
+	}
+	result, _ = m.Update(msg)
+	m = result.(Model)
+
+	if m.Screen != ScreenError || !m.InstallCancelled || m.InstallCancel != nil {
+		t.Fatalf("expected the cancelled screen with the context released, got %v", m.Screen)
+	}
+	for i, want := range []StepStatus{StatusDone, StatusSkipped, StatusSkipped} {
+		if m.Steps[i].Status != want {
+			t.Errorf("step %s: status %v, want %v", m.Steps[i].ID, m.Steps[i].Status, want)
+		}
+	}
+	view := m.View()
+	for _, want := range []string{"Installation cancelled", "Completed (1)", "Skipped (2)", "Full log: /tmp/gentleman/install.log"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("the cancelled screen should show %q", want)
+		}
+	}
+	if strings.Contains(view, "Installation Failed") {
+		t.Error("a cancel is not a failure")
+	}
+}
+
+func TestCancelDuringInteractiveStepWaitsForIt(t *testing.T) {
+	m := cancelModel(t, func(*Model) error { return nil })
+	m.Steps[1].Interactive = true
+
+	result, _ := m.Update(installStartMsg{})
+	m = result.(Model)
+	result, _ = m.Update(stepCompleteMsg{stepID: "fast"})
+	m = result.(Model)
+	if m.Steps[1].Status != StatusRunning {
+		t.Fatalf("the interactive step should be running, got %v", m.Steps[1].Status)
+	}
+
+	result, _ = m.runLeader("x")
+	m = result.(Model)
+	if m.Screen != ScreenInstalling {
+		t.Fatal("the cancel should wait for the interactive step to exit")
+	}
+	result, _ = m.Update(execFinishedMsg{stepID: "slow"})
+	m = result.(Model)
+	if m.Screen != ScreenError || m.Steps[1].Status != StatusDone || m.Steps[2].Status != StatusSkipped {
+		t.Fatalf("the cancel should land at the step boundary, got %v with %v / %v", m.Screen, m.Steps[1].Status, m.Steps[2].Status)
+	}
+
+	// The saved progress still offers the skipped step to a later run
+	if st := newInstallState(m); !st.Incomplete() {
+		t.Error("a cancelled install should stay resumable")
+	}
+
+	m.InputSettleUntil = time.Time{} // Past the stray-input guard after the exec
+	result, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	m = result.(Model)
+	if cmd == nil || m.Screen != ScreenInstalling || m.InstallCancelled || m.Steps[2].Status != StatusPending {
+		t.Fatalf("r should resume the skipped steps, got %v with %v", m.Screen, m.Steps[2].Status)
+	}
+}
+
+func TestCancelStepsNotStarted(t *testing.T) {
+	ran := false
+	m := cancelModel(t, func(*Model) error { ran = true; return nil })
+	m.startInstallContext()
+	m.InstallCancel()
+
+	if err := executeStep(m.installContext(), "slow", &m); !errors.Is(err, errInstallCancelled) || ran {
+		t.Errorf("a step reached after the cancel should not run, got %v (ran %v)", err, ran)
+	}
+}
@@ -0,0 +1,262 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// installCheck is one read-only probe of the Check Installation screen. Fix is
+// the one-line suggestion shown when the check warns or fails.
+type installCheck struct {
+	Name string
+	Run  func(env diagEnv) (DiagnosticStatus, string)
+	Fix  string
+}
+
+// installCheckResult is what one installCheck found
+type installCheckResult struct {
+	Name   string
+	Status DiagnosticStatus
+	Detail string
+	Fix    string // Only set when the check warned or failed
+}
+
+// installChecks is the health check, in display order. Add a row here to check
+// something new; checks that don't apply to this machine return DiagSkipped.
+var installChecks = []installCheck{
+	{Name: "Package manager", Run: checkPackageManager, Fix: "install Homebrew from https://brew.sh (macOS) or your distro's package manager"},
+	{Name: "Git", Run: checkCommand("git"), Fix: "install git with your package manager, then re-run the installer"},
+	{Name: "Nerd Font installed", Run: checkNerdFont, Fix: "re-run the installer and pick a font, or use Diagnose Setup → prompt shows boxes"},
+	{Name: "Default shell", Run: checkDefaultShell, Fix: "run chsh -s $(which fish) (or zsh / nu), then log in again"},
+	{Name: "Shell config", Run: checkShellConfig, Fix: "re-run the installer with the same shell to redeploy its config"},
+	{Name: "Neovim config", Run: checkNvimDeployed, Fix: "re-run the installer with Neovim, or use Diagnose Setup → Neovim shows errors"},
+	{Name: "Neovim plugins", Run: checkNvimPlugins, Fix: "run nvim --headless \"+Lazy! sync\" +qa"},
+	{Name: "Tmux config", Run: checkTmuxConfig, Fix: "re-run the installer with Tmux to redeploy ~/.tmux.conf"},
+	{Name: "Tmux plugin manager", Run: checkTmuxPlugins, Fix: "git clone https://github.com/tmux-plugins/tpm ~/.tmux/plugins/tpm"},
+	{Name: "Zellij config", Run: checkZellijConfig, Fix: "re-run the installer with Zellij to redeploy ~/.config/zellij"},
+	{Name: "Skill links", Run: checkSkillLinks, Fix: "open Skill Manager → Doctor to remove the broken links"},
+}
+
+// RunInstallChecks runs every install check against env, in order
+func RunInstallChecks(env diagEnv) []installCheckResult {
+	results := make([]installCheckResult, 0, len(installChecks))
+	for _, check := range installChecks {
+		status, detail := check.Run(env)
+		result := installCheckResult{Name: check.Name, Status: status, Detail: detail}
+		if status == DiagFail || status == DiagWarn {
+			result.Fix = check.Fix
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// installCheckSummary counts the results as "3 passed, 1 warning, 2 failed"
+func installCheckSummary(results []installCheckResult) string {
+	counts := make(map[DiagnosticStatus]int)
+	for _, r := range results {
+		counts[r.Status]++
+	}
+	plural := func(n int, word string) string {
+		if n == 1 {
+			return fmt.Sprintf("%d %s", n, word)
+		}
+		return fmt.Sprintf("%d %ss", n, word)
+	}
+	s := fmt.Sprintf("%d passed, %s, %d failed", counts[DiagPass], plural(counts[DiagWarn], "warning"), counts[DiagFail])
+	if counts[DiagSkipped] > 0 {
+		s += fmt.Sprintf(", %d not applicable", counts[DiagSkipped])
+	}
+	return s
+}
+
+// ---------------------------------------------------------------------------
+// Checks
+// ---------------------------------------------------------------------------
+
+// checkCommand passes when name is on PATH
+func checkCommand(name string) func(env diagEnv) (DiagnosticStatus, string) {
+	return func(env diagEnv) (DiagnosticStatus, string) {
+		if env.lookPath(name) {
+			return DiagPass, name + " found on PATH"
+		}
+		return DiagFail, name + " not found on PATH"
+	}
+}
+
+func checkPackageManager(env diagEnv) (DiagnosticStatus, string) {
+	info := env.System
+	if info == nil {
+		return DiagSkipped, "Platform not detected"
+	}
+	switch {
+	case info.IsTermux:
+		if info.HasPkg {
+			return DiagPass, "pkg available"
+		}
+		return DiagFail, "pkg not found"
+	case info.HasBrew:
+		return DiagPass, "Homebrew available"
+	case env.GOOS == "darwin":
+		return DiagFail, "Homebrew not found — the installer needs it on macOS"
+	}
+	for _, pm := range []string{"apt-get", "pacman", "dnf"} {
+		if env.lookPath(pm) {
+			return DiagPass, pm + " available"
+		}
+	}
+	return DiagWarn, "No supported package manager found (brew, apt, pacman, dnf)"
+}
+
+func checkNvimDeployed(env diagEnv) (DiagnosticStatus, string) {
+	if !env.lookPath("nvim") {
+		return DiagSkipped, "Neovim not installed"
+	}
+	if _, err := os.Stat(filepath.Join(env.Home, ".config", "nvim", "init.lua")); err != nil {
+		return DiagFail, "~/.config/nvim/init.lua is missing"
+	}
+	return DiagPass, "~/.config/nvim/init.lua present"
+}
+
+func checkZellijConfig(env diagEnv) (DiagnosticStatus, string) {
+	if !env.lookPath("zellij") {
+		return DiagSkipped, "Zellij not installed"
+	}
+	if _, err := os.Stat(filepath.Join(env.Home, ".config", "zellij", "config.kdl")); err != nil {
+		return DiagFail, "~/.config/zellij/config.kdl is missing"
+	}
+	return DiagPass, "~/.config/zellij/config.kdl present"
+}
+
+func checkSkillLinks(env diagEnv) (DiagnosticStatus, string) {
+	report := scanSkillLinks(env.Home)
+	if len(report.Entries) == 0 {
+		return DiagSkipped, "No skills linked"
+	}
+	if report.Count(skillLinkDangling) > 0 {
+		return DiagFail, report.Summary()
+	}
+	return DiagPass, report.Summary()
+}
+
+// ---------------------------------------------------------------------------
+// TUI glue
+// ---------------------------------------------------------------------------
+
+// installCheckCompleteMsg carries the results of a health check run
+type installCheckCompleteMsg struct {
+	results []installCheckResult
+}
+
+// runInstallChecksCmd runs the checks off the UI goroutine; some shell out
+func runInstallChecksCmd() tea.Cmd {
+	return func() tea.Msg {
+		return installCheckCompleteMsg{results: RunInstallChecks(currentDiagEnv())}
+	}
+}
+
+// startInstallCheck opens the check screen and runs every check
+func (m Model) startInstallCheck() (tea.Model, tea.Cmd) {
+	m.Screen = ScreenInstallCheck
+	m.Cursor = 0
+	m.InstallCheckResults = nil
+	m.InstallCheckScroll = 0
+	m.InstallCheckRunning = true
+	return m, runInstallChecksCmd()
+}
+
+// installCheckLines renders the results table, one row per check plus the fix
+// under each that didn't pass
+func installCheckLines(t *Theme, results []installCheckResult) []string {
+	var lines []string
+	for _, r := range results {
+		var mark string
+		switch r.Status {
+		case DiagFail:
+			mark = t.Error.Render("✗ FAIL")
+		case DiagWarn:
+			mark = t.Warning.Render("⚠ WARN")
+		case DiagPass:
+			mark = t.Success.Render("✓ PASS")
+		default:
+			mark = t.Muted.Render("– N/A ")
+		}
+		lines = append(lines, fmt.Sprintf("  %s  %-22s %s", mark, r.Name, t.Muted.Render(r.Detail)))
+		if r.Fix != "" {
+			lines = append(lines, t.Info.Render("            → "+r.Fix))
+		}
+	}
+	return lines
+}
+
+// installCheckVisibleLines is how many table lines fit between the header and the help line
+func (m Model) installCheckVisibleLines() int {
+	return m.visibleRows(10)
+}
+
+func (m Model) handleInstallCheckKeys(key string) (tea.Model, tea.Cmd) {
+	if m.InstallCheckRunning {
+		return m, nil
+	}
+	maxScroll := len(installCheckLines(m.Theme, m.InstallCheckResults)) - m.installCheckVisibleLines()
+	switch key {
+	case "up", "k":
+		if m.InstallCheckScroll > 0 {
+			m.InstallCheckScroll--
+		}
+	case "down", "j":
+		if m.InstallCheckScroll < maxScroll {
+			m.InstallCheckScroll++
+		}
+	case "r":
+		return m.startInstallCheck()
+	case "enter", "backspace":
+		m.Screen = ScreenMainMenu
+		m.Cursor = 0
+		m.InstallCheckScroll = 0
+	}
+	return m, nil
+}
+
+func (m Model) renderInstallCheck() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
+	s.WriteString("\n\n")
+
+	if m.InstallCheckRunning {
+		s.WriteString(m.Theme.Info.Render(spinnerFrames[m.SpinnerFrame%len(spinnerFrames)] + " Running checks..."))
+		s.WriteString("\n")
+		return s.String()
+	}
+
+	s.WriteString(m.Theme.Subtitle.Render(installCheckSummary(m.InstallCheckResults)))
+	s.WriteString("\n\n")
+
+	lines := installCheckLines(m.Theme, m.InstallCheckResults)
+	start := min(m.InstallCheckScroll, len(lines))
+	end := min(start+m.installCheckVisibleLines(), len(lines))
+	if start > 0 {
+		s.WriteString(m.Theme.Muted.Render(fmt.Sprintf("  ▲ %d more above", start)))
+		s.WriteString("\n")
+	}
+	for _, line := range lines[start:end] {
+		s.WriteString(line)
+		s.WriteString("\n")
+	}
+	if end < len(lines) {
+		s.WriteString(m.Theme.Muted.Render(fmt.Sprintf("  ▼ %d more below", len(lines)-end)))
+		s.WriteString("\n")
+	}
+
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render("↑/k up • ↓/j down • [r] re-run • [Enter/Esc] back"))
+
+	return s.String()
+}
@@ -0,0 +1,159 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestInstallChecksTable(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, check := range installChecks {
+		if check.Name == "" || check.Run == nil || check.Fix == "" {
+			t.Errorf("check %+v needs a name, a probe and a fix hint", check.Name)
+		}
+		if seen[check.Name] {
+			t.Errorf("duplicate check %q", check.Name)
+		}
+		seen[check.Name] = true
+	}
+}
+
+func resultByName(t *testing.T, results []installCheckResult, name string) installCheckResult {
+	t.Helper()
+	for _, r := range results {
+		if r.Name == name {
+			return r
+		}
+	}
+	t.Fatalf("no result for %q", name)
+	return installCheckResult{}
+}
+
+func TestRunInstallChecks(t *testing.T) {
+	env := fakeDiagEnv(t, "git", "nvim", "zellij", "apt-get")
+	env.Shell = "/bin/bash"
+	env.System = &system.SystemInfo{OS: system.OSDebian}
+	os.MkdirAll(filepath.Join(env.Home, ".config", "zellij"), 0755)
+	os.WriteFile(filepath.Join(env.Home, ".config", "zellij", "config.kdl"), []byte("theme \"kanagawa\""), 0644)
+
+	// One skill linked into the catalog and one whose target is gone, in every agent dir
+	skill := writeUndoCatalog(t, env.Home, "react-19")
+	linkSkill(t, env.Home, "react-19", skill.FullPath)
+	linkSkill(t, env.Home, "gone", filepath.Join(env.Home, ".gentleman", "skills", "curated", "gone"))
+
+	results := RunInstallChecks(env)
+	if len(results) != len(installChecks) {
+		t.Fatalf("expected one result per check, got %d", len(results))
+	}
+	for i, r := range results {
+		if r.Name != installChecks[i].Name {
+			t.Errorf("result %d is %q, want the table order (%q)", i, r.Name, installChecks[i].Name)
+		}
+	}
+
+	want := map[string]DiagnosticStatus{
+		"Package manager":     DiagPass,
+		"Git":                 DiagPass,
+		"Nerd Font installed": DiagFail,
+		"Default shell":       DiagWarn,
+		"Shell config":        DiagSkipped,
+		"Neovim config":       DiagFail,
+		"Neovim plugins":      DiagFail,
+		"Tmux config":         DiagSkipped,
+		"Tmux plugin manager": DiagSkipped,
+		"Zellij config":       DiagPass,
+		"Skill links":         DiagFail,
+	}
+	for name, status := range want {
+		r := resultByName(t, results, name)
+		if r.Status != status {
+			t.Errorf("%s: status %v (%s), want %v", name, r.Status, r.Detail, status)
+		}
+		if hasFix := r.Fix != ""; hasFix != (status == DiagFail || status == DiagWarn) {
+			t.Errorf("%s: fix %q should only be offered when the check doesn't pass", name, r.Fix)
+		}
+	}
+	if r := resultByName(t, results, "Default shell"); !strings.Contains(r.Fix, "chsh -s") {
+		t.Errorf("the shell fix should say how to switch, got %q", r.Fix)
+	}
+	if r := resultByName(t, results, "Skill links"); !strings.Contains(r.Detail, "2 dangling") {
+		t.Errorf("skill links detail = %q", r.Detail)
+	}
+
+	if got := installCheckSummary(results); got != "3 passed, 1 warning, 4 failed, 3 not applicable" {
+		t.Errorf("summary = %q", got)
+	}
+}
+
+func TestInstallCheckScreen(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	m := NewModel()
+	m.Height = 15
+	m.Screen = ScreenMainMenu
+	m = selectMainMenu(t, m, "Check Installation")
+	if m.Screen != ScreenInstallCheck || !m.InstallCheckRunning {
+		t.Fatalf("selecting the entry should start the checks, got %v", m.Screen)
+	}
+	if view := m.renderInstallCheck(); !strings.Contains(view, "Running checks") {
+		t.Errorf("a run in progress should show the spinner, got:\n%s", view)
+	}
+
+	results := []installCheckResult{
+		{Name: "Git", Status: DiagPass, Detail: "git found on PATH"},
+		{Name: "Default shell", Status: DiagWarn, Detail: "Login shell is bash", Fix: "run chsh -s $(which fish)"},
+		{Name: "Neovim config", Status: DiagFail, Detail: "init.lua is missing", Fix: "re-run the installer"},
+		{Name: "Tmux config", Status: DiagSkipped, Detail: "Tmux not installed"},
+		{Name: "Zellij config", Status: DiagPass, Detail: "present"},
+		{Name: "Skill links", Status: DiagPass, Detail: "2 valid"},
+	}
+	result, _ := m.Update(installCheckCompleteMsg{results: results})
+	m = result.(Model)
+	if m.InstallCheckRunning {
+		t.Fatal("results should end the run")
+	}
+
+	view := m.renderInstallCheck()
+	for _, want := range []string{"3 passed, 1 warning, 1 failed", "✓ PASS", "⚠ WARN", "✗ FAIL", "→ run chsh -s $(which fish)", "▼ 3 more below"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("view should show %q, got:\n%s", want, view)
+		}
+	}
+
+	// The 8 table lines don't fit in the 5 visible ones
+	for range 10 {
+		result, _ = m.handleInstallCheckKeys("down")
+		m = result.(Model)
+	}
+	if m.InstallCheckScroll != 3 {
+		t.Errorf("scroll should stop at the last line, got %d", m.InstallCheckScroll)
+	}
+	if view := m.renderInstallCheck(); !strings.Contains(view, "▲ 3 more above") || !strings.Contains(view, "Skill links") {
+		t.Errorf("scrolled view should show the end of the table, got:\n%s", view)
+	}
+
+	result, cmd := m.handleInstallCheckKeys("r")
+	m = result.(Model)
+	if !m.InstallCheckRunning || m.InstallCheckScroll != 0 || cmd == nil {
+		t.Fatal("r should re-run the checks from the top")
+	}
+	if _, ok := cmd().(installCheckCompleteMsg); !ok {
+		t.Error("the re-run should report its results")
+	}
+
+	// Keys wait for the run; esc then returns to the main menu
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if got := result.(Model).Screen; got != ScreenInstallCheck {
+		t.Errorf("esc should wait for the running checks, got %v", got)
+	}
+	m.InstallCheckRunning = false
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if got := result.(Model).Screen; got != ScreenMainMenu {
+		t.Errorf("esc should return to the main menu, got %v", got)
+	}
+}
@@ -0,0 +1,468 @@
+package tui
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// installConfig is the declarative form of UserChoices read by --config, so CI
+// and provisioning scripts can install without the TUI. Keys follow the CLI
+// flags, with underscores.
+type installConfig struct {
+	OS               string   `json:"os"`
+	Terminal         string   `json:"terminal"`
+	Font             bool     `json:"font"`
+	Shell            string   `json:"shell"`
+	ZshMerge         bool     `json:"zsh_merge"`
+	WindowManager    string   `json:"window_manager"`
+	WMExtras         []string `json:"wm_extras"`
+	Nvim             bool     `json:"nvim"`
+	Zed              bool     `json:"zed"`
+	Backup           *bool    `json:"backup"`
+	BackupCompress   bool     `json:"backup_compress"`
+	NativePackages   bool     `json:"native_packages"`
+	AITools          []string `json:"ai_tools"`
+	AIFramework      bool     `json:"ai_framework"`
+	AIPreset         string   `json:"ai_preset"`
+	AIModules        []string `json:"ai_modules"`
+	AgentTeamsLite   bool     `json:"agent_teams_lite"`
+	ProjectPath      string   `json:"project_path"`
+	ProjectMemory    string   `json:"project_memory"`
+	ProjectCI        string   `json:"project_ci"`
+	ProjectEngram    bool     `json:"project_engram"`
+	ProjectRolePacks []string `json:"project_role_packs"`
+	Mirrors          []string `json:"mirrors"`
+	RepoRef          string   `json:"repo_ref"`
+	SkillsRef        string   `json:"skills_ref"`
+}
+
+var (
+	validAIFeatures      = []string{"hooks", "commands", "skills", "agents", "sdd", "mcp"}
+	validProjectMemories = []string{"obsidian-brain", "vibekanban", "engram", "simple", "none"}
+	validProjectCIs      = []string{"github", "gitlab", "woodpecker", "none"}
+	validRolePacks       = []string{"developer", "pm-lead"}
+)
+
+// installConfigSchema is the annotated example printed by --print-schema. It
+// must stay loadable as-is, see TestInstallConfigSchemaLoads.
+const installConfigSchema = `# Javi.Dots install config, used with --config <file>.
+# Write it as YAML (.yaml/.yml) or JSON (.json) with the same keys.
+# Unknown keys are an error, so a typo never installs the wrong thing.
+
+# Platform the config is for: mac, linux, termux, windows, or auto (default).
+# Anything but auto must match the machine running the install.
+os: auto
+
+# Terminal emulator: alacritty, wezterm, kitty (macOS only), ghostty, none (default)
+terminal: none
+# Install the Iosevka Term Nerd Font
+font: false
+
+# Shell (required): fish, zsh, nushell
+shell: zsh
+# zsh only: keep the existing .zshrc and add a managed source block
+zsh_merge: false
+
+# Window manager: tmux, zellij, none (default)
+window_manager: tmux
+# Window manager extras; leave out for all of them, [] for a minimal config.
+# tmux: essentials, resurrect, kanagawa; zellij: layouts, statusbar, forgot
+wm_extras: [essentials, resurrect]
+
+# Neovim configuration and the Zed editor
+nvim: true
+zed: false
+
+# Back up configs the install would overwrite (default: true)
+backup: true
+# Write that backup as a .tar.gz archive instead of a directory
+backup_compress: false
+# Linux only: install with apt/dnf/pacman instead of Homebrew
+native_packages: false
+
+# AI tools: claude, opencode, gemini, copilot, codex, qwen
+ai_tools:
+  - claude
+  - opencode
+# AI framework; a preset or modules imply ai_framework: true
+ai_framework: false
+# Preset: minimal, frontend, backend, fullstack, data, complete
+ai_preset: ""
+# Or explicit features, each installing its whole category:
+# hooks, commands, skills, agents, sdd, mcp
+ai_modules: []
+# Agent Teams Lite SDD framework
+agent_teams_lite: false
+
+# Initialize a project after the install (leave project_path out to skip)
+# project_path: ~/code/my-app
+# Memory module: obsidian-brain, vibekanban, engram, simple (default), none
+# project_memory: simple
+# CI provider: github, gitlab, woodpecker, none (default)
+# project_ci: none
+# obsidian-brain only: add Engram alongside it
+# project_engram: false
+# obsidian-brain only: role packs on top of core (developer, pm-lead)
+# project_role_packs: [developer]
+
+# Git hosts to clone from when GitHub fails, tried in order after it; a path
+# is prefixed to owner/repo. GENTLEMAN_MIRRORS entries are tried first.
+mirrors: []
+# mirrors: [https://codeberg.org, https://gitlab.com/backup]
+
+# Pin the dotfiles and skill catalog clones to a branch, tag or commit for a
+# reproducible install; leave empty for the latest. The commits used are
+# recorded in ~/.gentleman/install-manifest.json.
+repo_ref: ""
+skills_ref: ""
+`
+
+// WriteInstallConfigSchema prints an annotated example config for --print-schema
+func WriteInstallConfigSchema(w io.Writer) error {
+	_, err := io.WriteString(w, installConfigSchema)
+	return err
+}
+
+// LoadInstallConfig reads the config file at path into UserChoices. It rejects
+// unknown keys and reports every invalid value at once; choices that depend on
+// the machine are checked later by ValidateChoices. An os of auto is left empty
+// for detection to fill in.
+func LoadInstallConfig(path string) (UserChoices, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return UserChoices{}, err
+	}
+
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json":
+	case ".yaml", ".yml":
+		values, err := parseConfigYAML(data)
+		if err != nil {
+			return UserChoices{}, fmt.Errorf("%s: %w", path, err)
+		}
+		if data, err = json.Marshal(values); err != nil {
+			return UserChoices{}, fmt.Errorf("%s: %w", path, err)
+		}
+	default:
+		return UserChoices{}, fmt.Errorf("%s: unsupported config format %q (use .json, .yaml or .yml)", path, ext)
+	}
+
+	var cfg installConfig
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&cfg); err != nil {
+		if key, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+			return UserChoices{}, fmt.Errorf("%s: unknown key %s", path, key)
+		}
+		return UserChoices{}, fmt.Errorf("%s: %w", path, err)
+	}
+
+	choices, errs := cfg.choices()
+	if len(errs) > 0 {
+		return UserChoices{}, fmt.Errorf("%s: %w", path, errs)
+	}
+	return choices, nil
+}
+
+// choices normalizes the config the way the CLI flags are and checks what
+// ValidateChoices doesn't cover: AI features and project init
+func (c installConfig) choices() (UserChoices, ChoiceErrors) {
+	var errs ChoiceErrors
+	add := func(field, format string, args ...interface{}) {
+		errs = append(errs, ChoiceError{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+	lower := func(s string) string { return strings.ToLower(strings.TrimSpace(s)) }
+	lowerAll := func(items []string) []string {
+		if items == nil {
+			return nil
+		}
+		out := make([]string, 0, len(items))
+		for _, item := range items {
+			if item = lower(item); item != "" {
+				out = append(out, item)
+			}
+		}
+		return out
+	}
+
+	choices := UserChoices{
+		OS:                    lower(c.OS),
+		Terminal:              lower(c.Terminal),
+		InstallFont:           c.Font,
+		Shell:                 lower(c.Shell),
+		WindowMgr:             lower(c.WindowManager),
+		WMExtras:              lowerAll(c.WMExtras),
+		InstallNvim:           c.Nvim,
+		InstallZed:            c.Zed,
+		CreateBackup:          c.Backup == nil || *c.Backup,
+		BackupCompress:        c.BackupCompress,
+		NativePackages:        c.NativePackages,
+		AITools:               lowerAll(c.AITools),
+		AIFrameworkPreset:     lower(c.AIPreset),
+		AIFrameworkModules:    lowerAll(c.AIModules),
+		InstallAgentTeamsLite: c.AgentTeamsLite,
+		RepoRef:               strings.TrimSpace(c.RepoRef),
+		SkillsRef:             strings.TrimSpace(c.SkillsRef),
+	}
+	if choices.OS == "auto" {
+		choices.OS = ""
+	}
+	if choices.Terminal == "" {
+		choices.Terminal = "none"
+	}
+	if choices.WindowMgr == "" {
+		choices.WindowMgr = "none"
+	}
+	choices.ZshMerge = c.ZshMerge && choices.Shell == "zsh"
+	choices.InstallAIFramework = c.AIFramework || choices.AIFrameworkPreset != "" ||
+		len(choices.AIFrameworkModules) > 0 || c.AgentTeamsLite
+
+	for _, mirror := range c.Mirrors {
+		mirror = strings.TrimSpace(mirror)
+		if u, err := url.Parse(mirror); err != nil || u.Scheme == "" || u.Host == "" {
+			add("mirrors", "%q is not a URL like https://codeberg.org", mirror)
+			continue
+		}
+		choices.Mirrors = append(choices.Mirrors, mirror)
+	}
+
+	for _, mod := range choices.AIFrameworkModules {
+		if !slices.Contains(validAIFeatures, mod) {
+			add("ai_modules", "unknown feature %q (valid: %s)", mod, strings.Join(validAIFeatures, ", "))
+		}
+	}
+	if choices.AIFrameworkPreset != "" && len(choices.AIFrameworkModules) > 0 {
+		add("ai_modules", "set either ai_preset or ai_modules, not both")
+	}
+
+	if strings.TrimSpace(c.ProjectPath) == "" {
+		if c.ProjectMemory != "" || c.ProjectCI != "" || c.ProjectEngram || len(c.ProjectRolePacks) > 0 {
+			add("project_path", "is required when other project_ keys are set")
+		}
+		return choices, errs
+	}
+
+	choices.InitProject = true
+	if abs, err := filepath.Abs(ExpandPath(strings.TrimSpace(c.ProjectPath))); err != nil {
+		add("project_path", "invalid path %q: %v", c.ProjectPath, err)
+	} else if info, err := os.Stat(abs); err != nil || !info.IsDir() {
+		add("project_path", "%s is not an existing directory", abs)
+	} else {
+		choices.ProjectPath = abs
+	}
+
+	choices.ProjectMemory = lower(c.ProjectMemory)
+	if choices.ProjectMemory == "" {
+		choices.ProjectMemory = "simple"
+	}
+	if !slices.Contains(validProjectMemories, choices.ProjectMemory) {
+		add("project_memory", "unsupported value %q (valid: %s)", choices.ProjectMemory, strings.Join(validProjectMemories, ", "))
+	}
+	choices.ProjectCI = lower(c.ProjectCI)
+	if choices.ProjectCI == "" {
+		choices.ProjectCI = "none"
+	}
+	if !slices.Contains(validProjectCIs, choices.ProjectCI) {
+		add("project_ci", "unsupported value %q (valid: %s)", choices.ProjectCI, strings.Join(validProjectCIs, ", "))
+	}
+
+	brain := choices.ProjectMemory == "obsidian-brain"
+	if c.ProjectEngram && !brain {
+		add("project_engram", "requires project_memory: obsidian-brain")
+	}
+	choices.ProjectEngram = c.ProjectEngram
+	// Core is implicit and always comes first, like --project-role-pack
+	if brain {
+		choices.ProjectRolePacks = []string{"core"}
+	}
+	for _, pack := range lowerAll(c.ProjectRolePacks) {
+		switch {
+		case pack == "core":
+		case !brain:
+			add("project_role_packs", "requires project_memory: obsidian-brain")
+		case !slices.Contains(validRolePacks, pack):
+			add("project_role_packs", "unknown role pack %q (valid: %s)", pack, strings.Join(validRolePacks, ", "))
+		default:
+			choices.ProjectRolePacks = append(choices.ProjectRolePacks, pack)
+		}
+	}
+	return choices, errs
+}
+
+// parseConfigYAML reads the YAML subset install configs need: top-level keys
+// with a scalar, a [flow, list] or a block list of "- item" lines, plus #
+// comments. The result goes through the same strict decoder as JSON.
+func parseConfigYAML(data []byte) (map[string]interface{}, error) {
+	values := make(map[string]interface{})
+	listKey := ""                  // Key whose block list is being read
+	blockKeys := map[string]bool{} // Keys that took no inline value
+	for i, raw := range strings.Split(string(data), "\n") {
+		lineNo := i + 1
+		line := strings.TrimRight(stripYAMLComment(raw), " \t\r")
+		if strings.TrimSpace(line) == "" || line == "---" {
+			continue
+		}
+		if strings.HasPrefix(line, "\t") {
+			return nil, fmt.Errorf("line %d: indent with spaces, not tabs", lineNo)
+		}
+
+		if trimmed := strings.TrimLeft(line, " "); trimmed != line {
+			item, ok := strings.CutPrefix(trimmed, "-")
+			if !ok || (item != "" && !strings.HasPrefix(item, " ")) {
+				return nil, fmt.Errorf("line %d: nested keys are not supported", lineNo)
+			}
+			if listKey == "" {
+				return nil, fmt.Errorf("line %d: list item without a key", lineNo)
+			}
+			value, err := parseYAMLScalar(strings.TrimSpace(item))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNo, err)
+			}
+			values[listKey] = append(values[listKey].([]interface{}), value)
+			continue
+		}
+
+		key, rest, ok := strings.Cut(line, ":")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" || strings.ContainsAny(key, " \"'") {
+			return nil, fmt.Errorf("line %d: expected \"key: value\"", lineNo)
+		}
+		if _, dup := values[key]; dup {
+			return nil, fmt.Errorf("line %d: duplicate key %q", lineNo, key)
+		}
+		listKey = ""
+		rest = strings.TrimSpace(rest)
+
+		switch {
+		case rest == "":
+			// A block list follows, or the key is left empty
+			values[key] = []interface{}{}
+			listKey = key
+			blockKeys[key] = true
+		case strings.HasPrefix(rest, "["):
+			if !strings.HasSuffix(rest, "]") {
+				return nil, fmt.Errorf("line %d: unterminated list", lineNo)
+			}
+			items := []interface{}{}
+			if inner := strings.TrimSpace(rest[1 : len(rest)-1]); inner != "" {
+				for _, part := range strings.Split(inner, ",") {
+					value, err := parseYAMLScalar(strings.TrimSpace(part))
+					if err != nil {
+						return nil, fmt.Errorf("line %d: %w", lineNo, err)
+					}
+					items = append(items, value)
+				}
+			}
+			values[key] = items
+		default:
+			value, err := parseYAMLScalar(rest)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNo, err)
+			}
+			values[key] = value
+		}
+	}
+
+	// A key with neither a value nor list items is unset
+	for key := range blockKeys {
+		if len(values[key].([]interface{})) == 0 {
+			values[key] = nil
+		}
+	}
+	return values, nil
+}
+
+// stripYAMLComment drops a # comment that starts the line or follows a space,
+// outside quotes
+func stripYAMLComment(line string) string {
+	var quote rune
+	for i, r := range line {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t'):
+			return line[:i]
+		}
+	}
+	return line
+}
+
+// parseYAMLScalar turns a YAML scalar into a string, bool or nil
+func parseYAMLScalar(s string) (interface{}, error) {
+	switch {
+	case s == "" || s == "~" || s == "null":
+		return nil, nil
+	case s == "true":
+		return true, nil
+	case s == "false":
+		return false, nil
+	case strings.HasPrefix(s, `"`):
+		value, err := strconv.Unquote(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid quoted string %s", s)
+		}
+		return value, nil
+	case strings.HasPrefix(s, "'"):
+		if len(s) < 2 || !strings.HasSuffix(s, "'") {
+			return nil, fmt.Errorf("invalid quoted string %s", s)
+		}
+		return strings.ReplaceAll(s[1:len(s)-1], "''", "'"), nil
+	case strings.ContainsAny(s[:1], "{[&*!|>"):
+		return nil, fmt.Errorf("unsupported YAML value %s", s)
+	}
+	return s, nil
+}
+
+// RunInstallConfig installs choices loaded by LoadInstallConfig without the
+// TUI. It plans the same steps the TUI would with SetupInstallSteps, runs them
+// in order with plain stdout logging and initializes the project if the config
+// asks for one. With skipInteractive, steps that need terminal input are
+// skipped with a warning instead of prompting.
+func RunInstallConfig(choices UserChoices, repoDir, repoURL string, skipInteractive bool) error {
+	model, err := newNonInteractiveModel(choices, repoDir, repoURL)
+	if err != nil {
+		return err
+	}
+	model.SetupInstallSteps()
+
+	if dryRunMode {
+		if err := writeDryRunPlan(os.Stdout, model); err != nil {
+			return err
+		}
+		if skipInteractive {
+			fmt.Println("\nInteractive steps would be skipped (--no-interactive-steps)")
+		}
+		if choices.InitProject {
+			fmt.Printf("\nProject: would run init-project.sh in %s (memory %s, CI %s)\n", choices.ProjectPath, choices.ProjectMemory, choices.ProjectCI)
+		}
+		return nil
+	}
+
+	if err := runStepsPlain(model, model.Steps, skipInteractive); err != nil {
+		return err
+	}
+	if !choices.InitProject {
+		return nil
+	}
+
+	fmt.Println()
+	fmt.Printf("📦 Initializing project in %s...\n", choices.ProjectPath)
+	if err := runProjectInitScript(context.Background(), choices.ProjectPath, choices.ProjectMemory, choices.ProjectCI, choices.ProjectEngram, choices.ProjectRolePacks); err != nil {
+		return fmt.Errorf("project initialization failed: %w", err)
+	}
+	fmt.Println("✅ Project initialized successfully!")
+	return nil
+}
@@ -0,0 +1,216 @@
+package tui
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// writeConfig writes a config file named name into a temp dir
+func writeConfig(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadInstallConfig(t *testing.T) {
+	project := t.TempDir()
+
+	yaml := `# provisioning
+os: linux
+terminal: Ghostty
+shell: zsh
+zsh_merge: true
+window_manager: tmux
+wm_extras: [essentials, "resurrect"]
+nvim: true
+backup: false
+ai_tools:
+  - claude
+  - opencode   # memory via engram
+ai_modules: [hooks, sdd]
+project_path: ` + project + `
+project_memory: obsidian-brain
+project_role_packs:
+  - developer
+repo_ref: v2.1.0
+`
+	json := `{
+  "os": "linux",
+  "terminal": "ghostty",
+  "shell": "zsh",
+  "zsh_merge": true,
+  "window_manager": "tmux",
+  "wm_extras": ["essentials", "resurrect"],
+  "nvim": true,
+  "backup": false,
+  "ai_tools": ["claude", "opencode"],
+  "ai_modules": ["hooks", "sdd"],
+  "project_path": "` + project + `",
+  "project_memory": "obsidian-brain",
+  "project_role_packs": ["developer"],
+  "repo_ref": "v2.1.0"
+}`
+	want := UserChoices{
+		OS:                 "linux",
+		Terminal:           "ghostty",
+		Shell:              "zsh",
+		ZshMerge:           true,
+		WindowMgr:          "tmux",
+		WMExtras:           []string{"essentials", "resurrect"},
+		InstallNvim:        true,
+		AITools:            []string{"claude", "opencode"},
+		InstallAIFramework: true,
+		AIFrameworkModules: []string{"hooks", "sdd"},
+		InitProject:        true,
+		ProjectPath:        project,
+		ProjectMemory:      "obsidian-brain",
+		ProjectCI:          "none",
+		ProjectRolePacks:   []string{"core", "developer"},
+		RepoRef:            "v2.1.0",
+	}
+
+	for _, name := range []string{"config.yaml", "config.yml", "config.json"} {
+		t.Run(name, func(t *testing.T) {
+			content := yaml
+			if strings.HasSuffix(name, ".json") {
+				content = json
+			}
+			got, err := LoadInstallConfig(writeConfig(t, name, content))
+			if err != nil {
+				t.Fatalf("LoadInstallConfig: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("choices = %+v\nwant %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestLoadInstallConfigDefaults(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		check   func(c UserChoices) bool
+	}{
+		{"backup on by default", "shell: fish", func(c UserChoices) bool { return c.CreateBackup }},
+		{"no terminal or wm", "shell: fish", func(c UserChoices) bool { return c.Terminal == "none" && c.WindowMgr == "none" }},
+		{"auto os is detected later", "os: auto\nshell: fish", func(c UserChoices) bool { return c.OS == "" }},
+		{"unset wm extras keep all", "shell: fish\nwindow_manager: tmux", func(c UserChoices) bool { return c.WMExtras == nil }},
+		{"empty key keeps all", "shell: fish\nwm_extras:", func(c UserChoices) bool { return c.WMExtras == nil }},
+		{"empty list is minimal", "shell: fish\nwm_extras: []", func(c UserChoices) bool { return c.WMExtras != nil && len(c.WMExtras) == 0 }},
+		{"preset implies framework", "shell: fish\nai_preset: backend", func(c UserChoices) bool { return c.InstallAIFramework }},
+		{"zsh merge is zsh only", "shell: fish\nzsh_merge: true", func(c UserChoices) bool { return !c.ZshMerge }},
+		{"no project by default", "shell: fish", func(c UserChoices) bool { return !c.InitProject && c.ProjectMemory == "" }},
+		{"compressed backup", "shell: fish\nbackup_compress: true", func(c UserChoices) bool { return c.CreateBackup && c.BackupCompress }},
+		{"mirrors keep their order", "shell: fish\nmirrors: [https://codeberg.org, ' https://gitlab.com/backup']", func(c UserChoices) bool {
+			return reflect.DeepEqual(c.Mirrors, []string{"https://codeberg.org", "https://gitlab.com/backup"})
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoadInstallConfig(writeConfig(t, "config.yaml", tt.content))
+			if err != nil {
+				t.Fatalf("LoadInstallConfig: %v", err)
+			}
+			if !tt.check(got) {
+				t.Errorf("choices = %+v", got)
+			}
+		})
+	}
+}
+
+func TestLoadInstallConfigErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		want    string
+	}{
+		{"unknown yaml key", "c.yaml", "shell: fish\nshel: zsh", `unknown key "shel"`},
+		{"unknown json key", "c.json", `{"shell": "fish", "neovim": true}`, `unknown key "neovim"`},
+		{"wrong type", "c.yaml", "shell: fish\nnvim: yes", "nvim"},
+		{"nested keys", "c.yaml", "ai:\n  tools: [claude]", "line 2: nested keys are not supported"},
+		{"duplicate key", "c.yaml", "shell: fish\nshell: zsh", `line 2: duplicate key "shell"`},
+		{"not a mapping", "c.yaml", "- fish", `line 1: expected "key: value"`},
+		{"unterminated list", "c.yaml", "ai_tools: [claude", "line 1: unterminated list"},
+		{"unsupported format", "c.toml", `shell = "fish"`, `unsupported config format ".toml"`},
+		{"unknown feature", "c.yaml", "shell: fish\nai_modules: [hooks, skils]", `ai_modules: unknown feature "skils"`},
+		{"preset and modules", "c.yaml", "shell: fish\nai_preset: data\nai_modules: [mcp]", "not both"},
+		{"project keys without a path", "c.yaml", "shell: fish\nproject_ci: github", "project_path: is required"},
+		{"missing project dir", "c.yaml", "shell: fish\nproject_path: /does/not/exist", "not an existing directory"},
+		{"role packs need obsidian brain", "c.yaml", "shell: fish\nproject_path: /\nproject_role_packs: [developer]", "project_role_packs: requires"},
+		{"engram needs obsidian brain", "c.yaml", "shell: fish\nproject_path: /\nproject_engram: true", "project_engram: requires"},
+		{"bad mirror", "c.yaml", "shell: fish\nmirrors: [codeberg.org]", `mirrors: "codeberg.org" is not a URL`},
+		{"bad memory", "c.yaml", "shell: fish\nproject_path: /\nproject_memory: notion", `project_memory: unsupported value "notion"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadInstallConfig(writeConfig(t, tt.file, tt.content))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %v, want it to mention %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadInstallConfigReportsEveryError(t *testing.T) {
+	_, err := LoadInstallConfig(writeConfig(t, "c.yaml", "shell: fish\nai_modules: [nope]\nproject_ci: jenkins"))
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	for _, want := range []string{"ai_modules", "project_path"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q should mention %s", err, want)
+		}
+	}
+}
+
+// TestInstallConfigSchemaLoads keeps --print-schema in step with the decoder:
+// the example must load and document every key
+func TestInstallConfigSchemaLoads(t *testing.T) {
+	var out bytes.Buffer
+	if err := WriteInstallConfigSchema(&out); err != nil {
+		t.Fatal(err)
+	}
+	choices, err := LoadInstallConfig(writeConfig(t, "schema.yaml", out.String()))
+	if err != nil {
+		t.Fatalf("the printed schema should load: %v", err)
+	}
+	choices.OS = "linux"
+	if errs := ValidateChoices(choices, nil); len(errs) > 0 {
+		t.Errorf("the printed schema should be valid: %v", errs)
+	}
+
+	fields := reflect.TypeOf(installConfig{})
+	for i := 0; i < fields.NumField(); i++ {
+		key := fields.Field(i).Tag.Get("json")
+		if !strings.Contains(out.String(), key+":") {
+			t.Errorf("the schema doesn't document %q", key)
+		}
+	}
+}
+
+func TestRunStepsPlainInteractive(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	enableDryRun(t)
+	SetNonInteractiveMode(true)
+	t.Cleanup(func() { SetNonInteractiveMode(false) })
+
+	m := NewModel()
+	steps := []InstallStep{{ID: "setshell", Name: "Set default shell", Interactive: true}}
+
+	// Dry-run mode makes any step that does run fail, so success means skipped
+	if err := runStepsPlain(&m, steps, true); err != nil {
+		t.Errorf("--no-interactive-steps should skip the step, got %v", err)
+	}
+	if err := runStepsPlain(&m, steps, false); err == nil || !strings.Contains(err.Error(), "dry run") {
+		t.Errorf("without --no-interactive-steps the step should run, got %v", err)
+	}
+}
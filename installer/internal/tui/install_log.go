@@ -0,0 +1,126 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
+)
+
+// installLog writes the complete install log to a file as lines arrive. The
+// screen only keeps the last 20 lines; the file keeps everything. Writes go
+// straight to the file, so nothing is lost when the installer quits.
+type installLog struct {
+	mu   sync.Mutex
+	f    *os.File
+	path string
+}
+
+// activeLog is the log of the running (or last) install
+var activeLog = &installLog{}
+
+func installLogDir(home string) string {
+	return filepath.Join(paths.DataDir(home), "logs")
+}
+
+// Open starts a new log file under home, closing any previous one
+func (l *installLog) Open(home string, now time.Time) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.f != nil {
+		l.f.Close()
+		l.f = nil
+	}
+	if err := os.MkdirAll(installLogDir(home), 0755); err != nil {
+		return err
+	}
+	path := filepath.Join(installLogDir(home), "install-"+now.Format("20060102-150405")+".log")
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	l.f = f
+	l.path = path
+	return nil
+}
+
+// Write appends a line tagged with its step. It is safe to call from step
+// goroutines and does nothing while no log is open.
+func (l *installLog) Write(stepID, line string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.f == nil {
+		return
+	}
+	prefix := installClock().Format("15:04:05")
+	if stepID != "" {
+		prefix += " [" + stepID + "]"
+	}
+	// Multi-line messages (step errors) keep every line, each with the prefix
+	for _, part := range strings.Split(line, "\n") {
+		fmt.Fprintf(l.f, "%s %s\n", prefix, part)
+	}
+}
+
+// Sync flushes the log to disk, so a failure's last lines survive a crash
+func (l *installLog) Sync() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.f != nil {
+		l.f.Sync()
+	}
+}
+
+// Close finishes the log; Path keeps reporting where it was written
+func (l *installLog) Close() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.f != nil {
+		l.f.Close()
+		l.f = nil
+	}
+}
+
+// Path returns the file of the current or last install log, or ""
+func (l *installLog) Path() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.path
+}
+
+// GetLogPath returns the log file of the current or last install, or "" when
+// no install has run
+func GetLogPath() string {
+	return activeLog.Path()
+}
+
+// logStepStart records that a step began running
+func logStepStart(step InstallStep) {
+	activeLog.Write(step.ID, "── Started: "+step.Name)
+}
+
+// logStepEnd records how a step finished; a failure is flushed to disk right away
+func logStepEnd(step InstallStep, err error) {
+	if err != nil {
+		activeLog.Write(step.ID, fmt.Sprintf("── Failed after %s: %s\n%v", formatElapsed(step.Elapsed), step.Name, err))
+		activeLog.Sync()
+		return
+	}
+	activeLog.Write(step.ID, fmt.Sprintf("── Done in %s: %s", formatElapsed(step.Elapsed), step.Name))
+}
+
+// openInstallLog starts the log file for a new install run and remembers its path
+func (m *Model) openInstallLog() {
+	if m.StateHome == "" {
+		return
+	}
+	if err := activeLog.Open(m.StateHome, installClock()); err != nil {
+		m.LogLines.Add("⚠️  Could not create the install log: " + err.Error())
+		return
+	}
+	m.LogPath = activeLog.Path()
+}
@@ -0,0 +1,108 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// useTestLog gives the test its own install log, restored afterwards
+func useTestLog(t *testing.T) {
+	t.Helper()
+	saved := activeLog
+	activeLog = &installLog{}
+	t.Cleanup(func() {
+		activeLog.Close()
+		activeLog = saved
+	})
+}
+
+func TestInstallLogWritesEveryLine(t *testing.T) {
+	useTestLog(t)
+	home := t.TempDir()
+	if err := activeLog.Open(home, time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)); err != nil {
+		t.Fatal(err)
+	}
+	want := filepath.Join(home, ".gentleman", "logs", "install-20260301-093000.log")
+	if GetLogPath() != want {
+		t.Errorf("GetLogPath() = %q, want %q", GetLogPath(), want)
+	}
+
+	activeLog.Write("clone", "first\nsecond")
+	activeLog.Close()
+	activeLog.Write("clone", "after close")
+
+	data, err := os.ReadFile(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 2 || !strings.HasSuffix(lines[0], "[clone] first") || !strings.HasSuffix(lines[1], "[clone] second") {
+		t.Errorf("unexpected log contents:\n%s", data)
+	}
+	if GetLogPath() != want {
+		t.Error("the path should still be reported after the log is closed")
+	}
+}
+
+func TestInstallLogKeepsFullHistoryOnFailure(t *testing.T) {
+	useTestLog(t)
+	m := NewModel()
+	m.StateHome = t.TempDir()
+	m.Screen = ScreenInstalling
+	m.Steps = []InstallStep{{ID: "deps", Name: "Install Dependencies", Status: StatusPending}}
+	m.openInstallLog()
+	if m.LogPath == "" {
+		t.Fatal("the log path should be recorded on the model")
+	}
+
+	result, _ := m.Update(installStartMsg{})
+	m = result.(Model)
+	for i := 0; i < installLogCap+10; i++ {
+		line := fmt.Sprintf("\x1b[32mpackage %02d\x1b[0m", i)
+		SendLog("deps", line)
+		result, _ = m.Update(stepProgressMsg{stepID: "deps", log: line})
+		m = result.(Model)
+	}
+	result, _ = m.Update(stepCompleteMsg{stepID: "deps", err: errors.New("apt exited with 100")})
+	m = result.(Model)
+
+	if m.LogLines.Len() > installLogCap {
+		t.Errorf("the screen should still keep at most %d lines, got %d", installLogCap, m.LogLines.Len())
+	}
+	data, err := os.ReadFile(m.LogPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	log := string(data)
+	for _, want := range []string{"── Started: Install Dependencies", "package 00", "package 29", "── Failed after", "apt exited with 100"} {
+		if !strings.Contains(log, want) {
+			t.Errorf("log is missing %q:\n%s", want, log)
+		}
+	}
+	if !strings.Contains(log, "\x1b[32mpackage 00\x1b[0m") {
+		t.Error("the log file should keep the raw output, escape sequences included")
+	}
+	for _, line := range m.LogLines.Lines() {
+		if strings.Contains(line, "\x1b[") {
+			t.Errorf("escape sequences should be stripped from the screen, got %q", line)
+		}
+	}
+	if !strings.Contains(m.renderError(), "Full log: "+m.LogPath) {
+		t.Error("the error screen should show the log path")
+	}
+}
+
+func TestCompleteScreenShowsLogPath(t *testing.T) {
+	m := NewModel()
+	m.Screen = ScreenComplete
+	m.Choices = UserChoices{OS: "linux", Shell: "fish"}
+	m.LogPath = "/home/user/.gentleman/logs/install-20260301-093000.log"
+	if !strings.Contains(m.renderComplete(), "Full log: "+m.LogPath) {
+		t.Error("the completion screen should show the log path")
+	}
+}
@@ -0,0 +1,109 @@
+package tui
+
+import "strings"
+
+// The full-log view (<space>l on the installing screen) pages through
+// LogLines while the install carries on. It follows new output until the user
+// scrolls up, and stays on the lines they scrolled to while more arrive.
+
+// installLogHeight is how many log lines the full-log view shows
+func (m Model) installLogHeight() int {
+	return m.visibleRows(9)
+}
+
+// toggleInstallLog opens the full-log view at the newest line, or closes it
+func (m Model) toggleInstallLog() Model {
+	m.ShowInstallLog = !m.ShowInstallLog
+	m.InstallLogFollow = true
+	m.InstallLogPendingG = false
+	return m
+}
+
+// installLogTop is the index in LogLines of the first line on view
+func (m Model) installLogTop() int {
+	bottom := max(m.LogLines.Len()-m.installLogHeight(), 0)
+	if m.InstallLogFollow {
+		return bottom
+	}
+	// InstallLogScroll counts from the first line ever logged, so lines
+	// dropped at the cap don't shift the view
+	return min(max(m.InstallLogScroll-m.LogLines.Omitted(), 0), bottom)
+}
+
+// scrollInstallLog moves the view to top; reaching the bottom follows again
+func (m Model) scrollInstallLog(top int) Model {
+	bottom := max(m.LogLines.Len()-m.installLogHeight(), 0)
+	top = min(max(top, 0), bottom)
+	m.InstallLogFollow = top == bottom
+	m.InstallLogScroll = top + m.LogLines.Omitted()
+	return m
+}
+
+// handleInstallLogKeys scrolls the full-log view; esc goes back to the steps
+func (m Model) handleInstallLogKeys(key string) Model {
+	pendingG := m.InstallLogPendingG
+	m.InstallLogPendingG = false
+
+	top, page := m.installLogTop(), m.installLogHeight()
+	switch key {
+	case "j", "down":
+		return m.scrollInstallLog(top + 1)
+	case "k", "up":
+		return m.scrollInstallLog(top - 1)
+	case "pgdown", "ctrl+d":
+		return m.scrollInstallLog(top + page)
+	case "pgup", "ctrl+u":
+		return m.scrollInstallLog(top - page)
+	case "G", "end":
+		return m.scrollInstallLog(m.LogLines.Len())
+	case "home":
+		return m.scrollInstallLog(0)
+	case "g":
+		if pendingG {
+			return m.scrollInstallLog(0)
+		}
+		m.InstallLogPendingG = true
+	case "esc", "q":
+		return m.toggleInstallLog()
+	}
+	return m
+}
+
+// renderInstallLog fills the installing screen with a page of the log
+func (m Model) renderInstallLog() string {
+	var s strings.Builder
+	if header := m.LogLines.Header(); header != "" {
+		s.WriteString(m.Theme.Muted.Render(header))
+		s.WriteString("\n")
+	}
+	if m.LogLines.Len() == 0 {
+		s.WriteString(m.Theme.Muted.Render(m.T("install_log.no_output")))
+		s.WriteString("\n")
+	}
+
+	lines := m.LogLines.Lines()
+	top := m.installLogTop()
+	end := min(top+m.installLogHeight(), len(lines))
+	for _, line := range lines[top:end] {
+		s.WriteString(clampLogLine(line, m.logLineWidth()))
+		s.WriteString("\n")
+	}
+
+	if len(lines) > 0 {
+		position := m.Tf("install_log.lines", top+1, end, len(lines))
+		if m.InstallLogFollow {
+			position += m.T("install_log.following")
+		} else if below := len(lines) - end; below > 0 {
+			position += m.Tf("install_log.newer_below", below)
+		}
+		s.WriteString(m.Theme.Muted.Render(position))
+		s.WriteString("\n")
+	}
+	if m.LogPath != "" {
+		s.WriteString(m.Theme.Muted.Render(m.Tf("common.full_log", m.LogPath)))
+		s.WriteString("\n")
+	}
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render(m.T("install_log.help")))
+	return s.String()
+}
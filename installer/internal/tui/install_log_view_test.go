@@ -0,0 +1,94 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestInstallLogView(t *testing.T) {
+	press := func(m Model, keys ...string) Model {
+		for _, key := range keys {
+			msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+			switch key {
+			case "esc":
+				msg = tea.KeyMsg{Type: tea.KeyEsc}
+			case "pgdown":
+				msg = tea.KeyMsg{Type: tea.KeyPgDown}
+			}
+			result, _ := m.Update(msg)
+			m = result.(Model)
+		}
+		return m
+	}
+	logLines := func(m Model, lines ...string) Model {
+		for _, line := range lines {
+			result, _ := m.Update(stepProgressMsg{stepID: "nvim", log: line})
+			m = result.(Model)
+		}
+		return m
+	}
+	firstShown := func(m Model) string {
+		return m.LogLines.Lines()[m.installLogTop()]
+	}
+
+	m := NewModel()
+	m.Screen = ScreenInstalling
+	m.Height = 20 // 11 log lines on view
+	m.Steps = []InstallStep{{ID: "nvim", Name: "Install Neovim", Status: StatusRunning}}
+	m = logLines(m, numberedLines(1, 50)...)
+
+	m, _ = leaderKey(t, m, "l")
+	if view := m.renderInstalling(); !strings.Contains(view, "line 50") || !strings.Contains(view, "following new output") {
+		t.Fatalf("the log should open at the newest line, got:\n%s", view)
+	}
+
+	// Scrolled up, the view stays put while the install keeps logging
+	m = press(m, "k", "k")
+	if m.InstallLogFollow || firstShown(m) != "line 38" {
+		t.Fatalf("k should scroll up, got %q", firstShown(m))
+	}
+	m = logLines(m, numberedLines(51, 55)...)
+	if firstShown(m) != "line 38" || !strings.Contains(m.renderInstalling(), "7 newer below") {
+		t.Errorf("new lines should not move a scrolled view, got %q", firstShown(m))
+	}
+
+	m = press(m, "g", "g")
+	if firstShown(m) != "line 1" {
+		t.Errorf("gg should jump to the top, got %q", firstShown(m))
+	}
+	m = press(m, "pgdown")
+	if firstShown(m) != "line 12" {
+		t.Errorf("pgdn should scroll a page, got %q", firstShown(m))
+	}
+	m = press(m, "G")
+	m = logLines(m, "line 56")
+	if !m.InstallLogFollow || firstShown(m) != "line 46" {
+		t.Errorf("G should follow new output again, got %q", firstShown(m))
+	}
+	m = press(m, "k", "j")
+	if !m.InstallLogFollow {
+		t.Error("scrolling back to the bottom should follow again")
+	}
+
+	m = press(m, "esc")
+	if m.ShowInstallLog || m.Screen != ScreenInstalling {
+		t.Errorf("esc should go back to the steps, got %v", m.Screen)
+	}
+}
+
+func TestInstallLogViewKeepsPlaceAtCap(t *testing.T) {
+	m := NewModel()
+	m.Screen = ScreenInstalling
+	m.Height = 20
+	m.LogLines.Add(numberedLines(1, installLogCap)...)
+	m.ShowInstallLog = true
+	m = m.scrollInstallLog(100)
+
+	// The oldest lines drop off, the lines on view stay
+	m.LogLines.Add(numberedLines(installLogCap+1, installLogCap+10)...)
+	if got := m.LogLines.Lines()[m.installLogTop()]; got != "line 101" {
+		t.Errorf("the view should keep its lines when the log drops old ones, got %q", got)
+	}
+}
@@ -0,0 +1,206 @@
+package tui
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+)
+
+// Keys of installManifest.Repos
+const (
+	manifestDotfiles = "dotfiles"
+	manifestSkills   = "skills"
+)
+
+// manifestRepo is one repository a run cloned: where from, the ref it was
+// pinned to, and the commit that ended up checked out
+type manifestRepo struct {
+	URL    string     `json:"url"`
+	Ref    string     `json:"ref,omitempty"` // "" follows the default branch
+	Commit RepoCommit `json:"commit"`
+}
+
+// installManifest records the exact commits the last run used, so an install
+// can be reproduced with the same refs. It is also the marker of an earlier
+// install, which the update flow and Diagnose Setup look for.
+type installManifest struct {
+	UpdatedAt time.Time               `json:"updated_at"`
+	Repos     map[string]manifestRepo `json:"repos"`
+}
+
+func installManifestPath(home string) string {
+	return filepath.Join(paths.DataDir(home), "install-manifest.json")
+}
+
+// legacyInstallMarkerPath is where older versions recorded the deployed
+// commit, before the manifest took over
+func legacyInstallMarkerPath(home string) string {
+	return filepath.Join(paths.DataDir(home), "install.json")
+}
+
+// loadInstallManifest returns the manifest under home, or nil if none was
+// written. Without one, the install.json of an older version is read instead.
+func loadInstallManifest(home string) (*installManifest, error) {
+	data, err := os.ReadFile(installManifestPath(home))
+	if os.IsNotExist(err) {
+		return loadLegacyInstallMarker(home)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var manifest installManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("invalid install manifest: %w", err)
+	}
+	if manifest.Repos == nil {
+		manifest.Repos = make(map[string]manifestRepo)
+	}
+	return &manifest, nil
+}
+
+// loadLegacyInstallMarker reads an older version's install.json as a
+// manifest with the dotfiles entry only, or returns nil if there is none
+func loadLegacyInstallMarker(home string) (*installManifest, error) {
+	data, err := os.ReadFile(legacyInstallMarkerPath(home))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var marker struct {
+		InstalledAt time.Time  `json:"installed_at"`
+		RepoURL     string     `json:"repo_url"`
+		Commit      RepoCommit `json:"commit"`
+	}
+	if err := json.Unmarshal(data, &marker); err != nil {
+		return nil, fmt.Errorf("invalid install marker: %w", err)
+	}
+	return &installManifest{
+		UpdatedAt: marker.InstalledAt,
+		Repos:     map[string]manifestRepo{manifestDotfiles: {URL: marker.RepoURL, Commit: marker.Commit}},
+	}, nil
+}
+
+// dotfilesCommit returns the dotfiles commit the manifest records, zero when
+// the manifest is nil or has none
+func (manifest *installManifest) dotfilesCommit() RepoCommit {
+	if manifest == nil {
+		return RepoCommit{}
+	}
+	return manifest.Repos[manifestDotfiles].Commit
+}
+
+func saveInstallManifest(home string, manifest *installManifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(installManifestPath(home)), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(installManifestPath(home), data, 0644)
+}
+
+// recordInstallManifest merges what the run in m checked out into the manifest
+// under home and returns it. Repos the run didn't touch keep their entry; the
+// skill catalog is read from its checkout, which outlives the run.
+func recordInstallManifest(run CommandRunner, home string, m *Model) (*installManifest, error) {
+	manifest, err := loadInstallManifest(home)
+	if err != nil || manifest == nil {
+		manifest = &installManifest{Repos: make(map[string]manifestRepo)}
+	}
+	if m.RepoCommit.Hash != "" {
+		manifest.Repos[manifestDotfiles] = manifestRepo{URL: m.RepoURL, Ref: m.Choices.RepoRef, Commit: m.RepoCommit}
+	}
+	if skillsDir := paths.SkillsDir(home); isGitCheckout(skillsDir) {
+		if commit, err := readRepoCommit(run, skillsDir); err == nil {
+			manifest.Repos[manifestSkills] = manifestRepo{URL: skillsRepoURL, Ref: m.Choices.SkillsRef, Commit: commit}
+		}
+	}
+	manifest.UpdatedAt = time.Now()
+	return manifest, saveInstallManifest(home, manifest)
+}
+
+// remoteHead returns the commit origin's default branch points to in the
+// checkout at dir, as of its last fetch
+func remoteHead(run CommandRunner, dir string) (RepoCommit, error) {
+	out, err := run("git", "-C", dir, "rev-parse", "--verify", "refs/remotes/origin/HEAD")
+	if err != nil {
+		return RepoCommit{}, fmt.Errorf("no remote HEAD: %s", firstLine(out))
+	}
+	return RepoCommit{Hash: strings.TrimSpace(out)}, nil
+}
+
+// manifestStatus compares a recorded commit with origin's HEAD in the freshly
+// fetched checkout at dir: "installed: abc1234 → latest: def5678, 3 commits behind"
+func manifestStatus(run CommandRunner, dir string, recorded manifestRepo) string {
+	head, err := remoteHead(run, dir)
+	if err != nil {
+		return "remote HEAD unknown"
+	}
+	s := repoUpdateLine(run, dir, recorded.Commit, head)
+	if recorded.Ref != "" {
+		s += " (pinned to " + recorded.Ref + ")"
+	}
+	return s
+}
+
+// manifestRepoStatus is the status line of the manifest entry key for the
+// checkout at dir, or nothing when the manifest has no such entry
+func manifestRepoStatus(manifest *installManifest, key, label, dir string) []string {
+	if manifest == nil {
+		return nil
+	}
+	recorded, ok := manifest.Repos[key]
+	if !ok || recorded.Commit.Hash == "" {
+		return nil
+	}
+	return []string{label + ": " + manifestStatus(execRunner, dir, recorded)}
+}
+
+// fetchPinned fetches the checkout at dir and moves it to ref, which may have
+// been a tag or commit it doesn't have yet
+func fetchPinned(ctx context.Context, dir, ref string, log func(string)) error {
+	log("Fetching " + dir + "...")
+	opts := &system.ExecOptions{IdleTimeout: system.EnvCloneTimeout(), SplitCR: true}
+	if result := system.RunWithLogs(ctx, "git -C "+dir+" fetch --tags --progress origin", opts, log); result.Error != nil {
+		return fmt.Errorf("git fetch failed: %w", result.Error)
+	}
+	sha, err := system.CheckoutRef(ctx, dir, ref)
+	if err != nil {
+		return err
+	}
+	log("✓ Pinned to " + ref + " (" + sha[:7] + ")")
+	return nil
+}
+
+// manifestLine renders a manifest entry for the complete screen
+func manifestLine(repo manifestRepo) string {
+	if repo.Ref == "" {
+		return repo.Commit.Label()
+	}
+	return repo.Commit.Label() + " @ " + repo.Ref
+}
+
+// commitItems lists the commits the run ended on for the complete screens:
+// the dotfiles from the run itself, the skill catalog from the manifest
+func (m Model) commitItems() []string {
+	var items []string
+	if m.RepoCommit.Hash != "" {
+		items = append(items, "Dotfiles commit: "+manifestLine(manifestRepo{Ref: m.Choices.RepoRef, Commit: m.RepoCommit}))
+	}
+	if m.InstallManifest != nil {
+		if skills, ok := m.InstallManifest.Repos[manifestSkills]; ok {
+			items = append(items, "Skill catalog commit: "+manifestLine(skills))
+		}
+	}
+	return items
+}
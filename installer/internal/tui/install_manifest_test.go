@@ -0,0 +1,213 @@
+package tui
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
+)
+
+// gitRun runs git in dir and returns its trimmed output
+func gitRun(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com", "-c", "init.defaultBranch=main"}, args...)...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %v: %v\n%s", args, err, out)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+func TestInstallManifestRoundTrip(t *testing.T) {
+	home := t.TempDir()
+	if manifest, err := loadInstallManifest(home); manifest != nil || err != nil {
+		t.Fatalf("a fresh home should have no manifest, got %+v, %v", manifest, err)
+	}
+
+	// An entry the run doesn't touch is kept
+	saveInstallManifest(home, &installManifest{Repos: map[string]manifestRepo{
+		manifestSkills: {URL: skillsRepoURL, Ref: "v1", Commit: RepoCommit{Hash: "aaa1111"}},
+	}})
+	m := NewModel()
+	m.RepoURL = "https://example.com/dots.git"
+	m.Choices.RepoRef = "v2.1.0"
+	m.RepoCommit = RepoCommit{Hash: "bbb2222", Date: time.Date(2025, time.March, 3, 0, 0, 0, 0, time.UTC)}
+	if _, err := recordInstallManifest(cannedRunner(nil), home, &m); err != nil {
+		t.Fatal(err)
+	}
+
+	manifest, err := loadInstallManifest(home)
+	if err != nil || manifest == nil {
+		t.Fatalf("loadInstallManifest: %+v, %v", manifest, err)
+	}
+	dots := manifest.Repos[manifestDotfiles]
+	if dots.URL != m.RepoURL || dots.Ref != "v2.1.0" || dots.Commit.Hash != "bbb2222" || !dots.Commit.Date.Equal(m.RepoCommit.Date) {
+		t.Errorf("unexpected dotfiles entry %+v", dots)
+	}
+	if manifest.Repos[manifestSkills].Commit.Hash != "aaa1111" {
+		t.Errorf("the skill catalog entry should be kept, got %+v", manifest.Repos)
+	}
+	if manifest.UpdatedAt.IsZero() {
+		t.Error("the manifest should record when it was written")
+	}
+
+	os.WriteFile(installManifestPath(home), []byte("{"), 0644)
+	if _, err := loadInstallManifest(home); err == nil {
+		t.Error("a corrupt manifest should be an error")
+	}
+}
+
+func TestRecordInstallManifestSkills(t *testing.T) {
+	home := t.TempDir()
+	skills := paths.SkillsDir(home)
+	os.MkdirAll(skills, 0755)
+	gitRun(t, skills, "init", "--quiet")
+	gitRun(t, skills, "commit", "--quiet", "--allow-empty", "-m", "one")
+
+	m := NewModel()
+	m.Choices.SkillsRef = "main"
+	manifest, err := recordInstallManifest(execRunner, home, &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := manifest.Repos[manifestDotfiles]; ok {
+		t.Error("a run without a dotfiles commit shouldn't record one")
+	}
+	entry := manifest.Repos[manifestSkills]
+	if entry.Commit.Hash != gitRun(t, skills, "rev-parse", "HEAD") || entry.Ref != "main" {
+		t.Errorf("unexpected skill catalog entry %+v", entry)
+	}
+
+	m.InstallManifest = manifest
+	if items := m.commitItems(); len(items) != 1 || !strings.HasPrefix(items[0], "Skill catalog commit: "+entry.Commit.Short()) || !strings.HasSuffix(items[0], " @ main") {
+		t.Errorf("unexpected complete screen lines %v", items)
+	}
+}
+
+func TestManifestStatus(t *testing.T) {
+	upstream := t.TempDir()
+	gitRun(t, upstream, "init", "--quiet")
+	gitRun(t, upstream, "commit", "--quiet", "--allow-empty", "-m", "one")
+	clone := filepath.Join(t.TempDir(), "clone")
+	gitRun(t, upstream, "clone", "--quiet", upstream, clone)
+
+	installed, err := readRepoCommit(execRunner, clone)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorded := manifestRepo{Commit: installed}
+	if got := manifestStatus(execRunner, clone, recorded); !strings.HasSuffix(got, "up to date") {
+		t.Errorf("expected up to date, got %q", got)
+	}
+
+	gitRun(t, upstream, "commit", "--quiet", "--allow-empty", "-m", "two")
+	gitRun(t, upstream, "commit", "--quiet", "--allow-empty", "-m", "three")
+	gitRun(t, clone, "fetch", "--quiet")
+	if got := manifestStatus(execRunner, clone, recorded); !strings.HasSuffix(got, "2 commits behind") {
+		t.Errorf("expected 2 commits behind, got %q", got)
+	}
+
+	recorded.Ref = "v1"
+	if got := manifestStatus(execRunner, clone, recorded); !strings.HasSuffix(got, "2 commits behind (pinned to v1)") {
+		t.Errorf("a pinned clone should say so, got %q", got)
+	}
+	if got := manifestStatus(execRunner, t.TempDir(), recorded); got != "remote HEAD unknown" {
+		t.Errorf("a directory without a clone has no remote HEAD, got %q", got)
+	}
+}
+
+func TestInstallRefsScreen(t *testing.T) {
+	m := NewModel()
+	m.Screen = ScreenBackupConfirm
+	result, _ := m.handleBackupConfirmKeys("p")
+	m = result.(Model)
+	if m.Screen != ScreenInstallRefs || !strings.HasSuffix(m.GetCurrentOptions()[0], "latest (default branch)") {
+		t.Fatalf("expected the pin screen with nothing pinned, got %v %v", m.Screen, m.GetCurrentOptions())
+	}
+
+	result, _ = m.handleInstallRefsKeys("down")
+	m = result.(Model)
+	result, _ = m.handleInstallRefsKeys("enter")
+	m = result.(Model)
+	if m.Screen != ScreenInstallRefInput || m.RefField != 1 {
+		t.Fatalf("expected the skill catalog ref input, got %v field %d", m.Screen, m.RefField)
+	}
+
+	m.ProjectPathInput = "-x"
+	result, _ = m.handleInstallRefInputKeys("enter")
+	m = result.(Model)
+	if m.Screen != ScreenInstallRefInput || m.ProjectPathError == "" {
+		t.Fatal("an invalid ref should stay on the input with an error")
+	}
+
+	m.ProjectPathInput = " v1.2 "
+	result, _ = m.handleInstallRefInputKeys("enter")
+	m = result.(Model)
+	if m.Screen != ScreenInstallRefs || m.Choices.SkillsRef != "v1.2" || m.Choices.RepoRef != "" {
+		t.Fatalf("expected the skill catalog pinned to v1.2, got %+v", m.Choices)
+	}
+	if m.GetCurrentOptions()[1] != "📌 Skill catalog: v1.2" {
+		t.Errorf("the list should show the pin, got %v", m.GetCurrentOptions())
+	}
+
+	result, _ = m.handleEscape()
+	m = result.(Model)
+	if m.Screen != ScreenBackupConfirm {
+		t.Errorf("esc should return to the backup prompt, got %v", m.Screen)
+	}
+}
+
+// Installs from before the manifest left an install.json, which still counts
+func TestInstallManifestReadsLegacyMarker(t *testing.T) {
+	home := t.TempDir()
+	os.MkdirAll(paths.DataDir(home), 0755)
+	os.WriteFile(legacyInstallMarkerPath(home), []byte(`{
+  "installed_at": "2024-02-01T10:00:00Z",
+  "repo_url": "https://example.com/dots.git",
+  "commit": {"hash": "abc1234aaaa", "date": "2024-01-12T09:00:00Z"}
+}`), 0644)
+
+	manifest, err := loadInstallManifest(home)
+	if err != nil || manifest == nil {
+		t.Fatalf("loadInstallManifest: %+v, %v", manifest, err)
+	}
+	if got := manifest.dotfilesCommit().Label(); got != "abc1234 (Jan 12 2024)" {
+		t.Errorf("the marker's commit should be the dotfiles entry, got %q", got)
+	}
+	if manifest.Repos[manifestDotfiles].URL != "https://example.com/dots.git" || manifest.UpdatedAt.Year() != 2024 {
+		t.Errorf("unexpected manifest %+v", manifest)
+	}
+
+	// A manifest, once written, wins over the old marker
+	saveInstallManifest(home, &installManifest{Repos: map[string]manifestRepo{manifestDotfiles: {Commit: RepoCommit{Hash: "def5678"}}}})
+	if manifest, _ := loadInstallManifest(home); manifest.dotfilesCommit().Hash != "def5678" {
+		t.Errorf("the manifest should win, got %+v", manifest)
+	}
+	var none *installManifest
+	if none.dotfilesCommit().Hash != "" {
+		t.Error("a nil manifest has no commit")
+	}
+}
+
+// The update keeps the manifest's pins, but --ref and --skills-ref win
+func TestStartUpdateRefs(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	saveInstallManifest(home, &installManifest{Repos: map[string]manifestRepo{
+		manifestDotfiles: {Ref: "v1.0"},
+		manifestSkills:   {Ref: "s1"},
+	}})
+
+	m := NewModel()
+	m.Choices.RepoRef = "v2.0" // --ref
+	result, _ := m.startUpdate()
+	m = result.(Model)
+	if m.Choices.RepoRef != "v2.0" || m.Choices.SkillsRef != "s1" {
+		t.Errorf("expected the flag's dotfiles ref and the pinned catalog, got %q and %q", m.Choices.RepoRef, m.Choices.SkillsRef)
+	}
+}
@@ -0,0 +1,110 @@
+package tui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// installProfile pre-sets every wizard choice for one kind of machine, so the
+// install starts straight from the main menu. Defaults apply everywhere;
+// Variants adjust them per stepPlatform key ("mac", "arch", "debian", "fedora",
+// "linux", "termux").
+type installProfile struct {
+	ID          string
+	Icon        string
+	Label       string // Main menu entry, after the icon
+	Description string
+	Defaults    UserChoices
+	Variants    map[string]func(c *UserChoices)
+}
+
+// installProfiles are the built-in profiles, in main menu order
+var installProfiles = []installProfile{
+	{
+		ID:          "express",
+		Icon:        "⚡",
+		Label:       "Express Install",
+		Description: "The recommended setup in one step: Ghostty, Nerd Font, Fish, Tmux and Neovim",
+		Defaults: UserChoices{
+			OS:           "linux",
+			Terminal:     "ghostty",
+			InstallFont:  true,
+			Shell:        "fish",
+			WindowMgr:    "tmux",
+			InstallNvim:  true,
+			CreateBackup: true,
+		},
+		Variants: map[string]func(c *UserChoices){
+			"mac": func(c *UserChoices) { c.OS = "mac" },
+			// Termux is the terminal already
+			"termux": func(c *UserChoices) {
+				c.OS = "termux"
+				c.Terminal = "none"
+			},
+		},
+	},
+	{
+		ID:          "server",
+		Icon:        "🖥️ ",
+		Label:       "Server (minimal)",
+		Description: "Zsh, Tmux and Neovim configs only: no terminal emulator, fonts or AI tools",
+		Defaults: UserChoices{
+			OS:             "linux",
+			Terminal:       "none",
+			Shell:          "zsh",
+			WindowMgr:      "tmux",
+			InstallNvim:    true,
+			CreateBackup:   true,
+			NativePackages: true, // apt/pacman/dnf instead of Homebrew
+		},
+		Variants: map[string]func(c *UserChoices){
+			// Homebrew is the only package manager the installer uses on macOS
+			"mac": func(c *UserChoices) {
+				c.OS = "mac"
+				c.NativePackages = false
+			},
+			// Termux is the terminal already, and pkg is its native backend
+			"termux": func(c *UserChoices) {
+				c.OS = "termux"
+				c.NativePackages = false
+			},
+		},
+	},
+}
+
+// menuEntry is the profile's main menu label
+func (p installProfile) menuEntry() string {
+	return p.Icon + " " + p.Label
+}
+
+// profileForEntry returns the profile behind a main menu entry, or nil
+func profileForEntry(opt string) *installProfile {
+	for i := range installProfiles {
+		if strings.Contains(opt, installProfiles[i].Label) {
+			return &installProfiles[i]
+		}
+	}
+	return nil
+}
+
+// choicesFor returns the profile's choices for the machine m runs on
+func (p installProfile) choicesFor(m *Model) UserChoices {
+	choices := p.Defaults
+	// Slices are shared with the table, so give the copy its own
+	choices.WMExtras = append([]string(nil), p.Defaults.WMExtras...)
+	choices.AITools = append([]string(nil), p.Defaults.AITools...)
+	if variant, ok := p.Variants[stepPlatform(m)]; ok {
+		variant(&choices)
+	}
+	return choices
+}
+
+// startProfileInstall skips the wizard with the choices of p, going through
+// the usual OS version warning and backup prompt
+func (m Model) startProfileInstall(p installProfile) (tea.Model, tea.Cmd) {
+	// Detection decides the platform, not whatever an earlier wizard run picked
+	m.Choices = UserChoices{}
+	m.Choices = p.choicesFor(&m)
+	return m.proceedToBackupOrInstall()
+}
@@ -0,0 +1,206 @@
+package tui
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+)
+
+func TestServerProfileSteps(t *testing.T) {
+	profile := profileForEntry("Server (minimal)")
+	if profile == nil {
+		t.Fatal("the server profile should be registered")
+	}
+
+	tests := []struct {
+		name         string
+		sysInfo      *system.SystemInfo
+		wantOS       string
+		wantNative   bool
+		wantHomebrew bool
+	}{
+		{"debian", &system.SystemInfo{OS: system.OSDebian}, "linux", true, false},
+		{"arch", &system.SystemInfo{OS: system.OSArch}, "linux", true, false},
+		{"fedora", &system.SystemInfo{OS: system.OSFedora}, "linux", true, false},
+		{"other linux", &system.SystemInfo{OS: system.OSLinux}, "linux", true, false},
+		{"mac without brew", &system.SystemInfo{OS: system.OSMac, HasXcode: true}, "mac", false, true},
+		{"mac with brew", &system.SystemInfo{OS: system.OSMac, HasXcode: true, HasBrew: true}, "mac", false, false},
+		{"termux", &system.SystemInfo{OS: system.OSTermux, IsTermux: true}, "termux", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewModel()
+			m.SystemInfo = tt.sysInfo
+			m.Choices = profile.choicesFor(&m)
+			if m.Choices.OS != tt.wantOS || m.Choices.NativePackages != tt.wantNative {
+				t.Errorf("choices = %+v", m.Choices)
+			}
+			if errs := ValidateChoices(m.Choices, tt.sysInfo); len(errs) > 0 {
+				t.Errorf("profile choices should be valid: %v", errs)
+			}
+
+			m.SetupInstallSteps()
+			ids := make(map[string]bool)
+			for _, step := range m.Steps {
+				ids[step.ID] = true
+			}
+			for _, id := range []string{"terminal", "font", "zed", "aitools", "aiframework"} {
+				if ids[id] {
+					t.Errorf("a server install shouldn't plan the %s step", id)
+				}
+			}
+			for _, id := range []string{"shell", "wm", "nvim"} {
+				if !ids[id] {
+					t.Errorf("a server install should plan the %s step", id)
+				}
+			}
+			if ids["homebrew"] != tt.wantHomebrew {
+				t.Errorf("homebrew planned = %v, want %v", ids["homebrew"], tt.wantHomebrew)
+			}
+		})
+	}
+}
+
+func TestServerProfileFromMainMenu(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	m := NewModel()
+	m.Screen = ScreenMainMenu
+	m.SystemInfo = &system.SystemInfo{OS: system.OSDebian}
+	// An earlier wizard run must not leak into the profile
+	m.Choices = UserChoices{OS: "mac", Terminal: "ghostty", InstallFont: true, AITools: []string{"claude"}}
+
+	m = selectMainMenu(t, m, "Server (minimal)")
+	if m.Screen != ScreenInstalling {
+		t.Fatalf("with nothing to back up the install should start, got %v", m.Screen)
+	}
+	if m.Choices.Terminal != "none" || m.Choices.InstallFont || len(m.Choices.AITools) > 0 || !m.Choices.NativePackages {
+		t.Errorf("choices = %+v", m.Choices)
+	}
+	for _, step := range m.Steps {
+		if (step.ID == "shell" || step.ID == "wm" || step.ID == "nvim") && !step.Interactive {
+			t.Errorf("%s installs distro packages with sudo, so it should be interactive", step.ID)
+		}
+	}
+}
+
+func TestProfileEntriesFollowStartInstallation(t *testing.T) {
+	m := NewModel()
+	m.Screen = ScreenMainMenu
+	opts := m.GetCurrentOptions()
+	for i, p := range installProfiles {
+		if !strings.Contains(opts[i+1], p.Label) {
+			t.Errorf("entry %d = %q, want the %s profile", i+1, opts[i+1], p.ID)
+		}
+	}
+
+	m.SystemInfo = &system.SystemInfo{OS: system.OSUnknown, GOOS: "freebsd", Unsupported: true}
+	if got := selectMainMenu(t, m, "Server (minimal)").Screen; got != ScreenUnsupportedPlatform {
+		t.Errorf("profiles can't install on unsupported platforms either, got %v", got)
+	}
+}
+
+func TestExpressProfileChoices(t *testing.T) {
+	profile := profileForEntry("Express Install")
+	if profile == nil {
+		t.Fatal("the express profile should be registered")
+	}
+	for _, sysInfo := range []*system.SystemInfo{
+		{OS: system.OSDebian},
+		{OS: system.OSArch},
+		{OS: system.OSFedora},
+		{OS: system.OSMac, HasXcode: true, HasBrew: true},
+		{OS: system.OSTermux, IsTermux: true},
+	} {
+		m := NewModel()
+		m.SystemInfo = sysInfo
+		m.Choices = profile.choicesFor(&m)
+		if errs := ValidateChoices(m.Choices, sysInfo); len(errs) > 0 {
+			t.Errorf("%v: profile choices should be valid: %v", sysInfo.OS, errs)
+		}
+		m.SetupInstallSteps()
+		ids := strings.Join(stepIDs(m.Steps), " ")
+		for _, id := range []string{"shell", "wm", "nvim"} {
+			if !strings.Contains(ids, id) {
+				t.Errorf("%v: express should plan the %s step, got %s", sysInfo.OS, id, ids)
+			}
+		}
+		if wantTerminal := !sysInfo.IsTermux; strings.Contains(ids, "terminal") != wantTerminal {
+			t.Errorf("%v: terminal step planned = %v, want %v", sysInfo.OS, !wantTerminal, wantTerminal)
+		}
+	}
+}
+
+// The server .zshrc must keep the lines PatchZshForWM rewrites
+func TestServerZshrcPatchesForWM(t *testing.T) {
+	src, err := os.ReadFile(filepath.Join("..", "..", "..", serverZshrc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if firstLine(string(src)) != serverZshrcHeader {
+		t.Fatalf("the server .zshrc should start with %q", serverZshrcHeader)
+	}
+	for _, line := range strings.Split(string(src), "\n") {
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		for _, needs := range []string{"brew", "oh-my-zsh", "p10k", "carapace"} {
+			if strings.Contains(line, needs) {
+				t.Errorf("the server .zshrc shouldn't need %s: %s", needs, line)
+			}
+		}
+	}
+
+	tests := []struct{ wm, want, gone string }{
+		{"zellij", `WM_CMD="zellij"`, `WM_CMD="tmux"`},
+		{"none", "", "start_if_needed"},
+	}
+	for _, tt := range tests {
+		path := filepath.Join(t.TempDir(), ".zshrc")
+		os.WriteFile(path, src, 0644)
+		if err := system.PatchZshForWM(path, tt.wm, true); err != nil {
+			t.Fatal(err)
+		}
+		patched, _ := os.ReadFile(path)
+		if !strings.Contains(string(patched), tt.want) || strings.Contains(string(patched), tt.gone) {
+			t.Errorf("%s: patched config keeps %q or lacks %q:\n%s", tt.wm, tt.gone, tt.want, patched)
+		}
+	}
+}
+
+func TestUpdateSourceForServerZshrc(t *testing.T) {
+	home := t.TempDir()
+	zshrc := filepath.Join(home, ".zshrc")
+	os.WriteFile(zshrc, []byte(serverZshrcHeader+"\nbindkey -e\n"), 0644)
+	if got := updateSourceFor(home, "/repo", zshrc); got != filepath.Join("/repo", serverZshrc) {
+		t.Errorf("a server .zshrc should update from %s, got %s", serverZshrc, got)
+	}
+	os.WriteFile(zshrc, []byte("# Gentleman zsh\n"), 0644)
+	if got := updateSourceFor(home, "/repo", zshrc); got != filepath.Join("/repo", "GentlemanZsh/.zshrc") {
+		t.Errorf("the full .zshrc should update from GentlemanZsh/.zshrc, got %s", got)
+	}
+}
+
+func TestNvimTooOld(t *testing.T) {
+	tests := []struct {
+		out     string
+		err     error
+		wantOld bool
+	}{
+		{"NVIM v0.9.5\nBuild type: Release", nil, true},
+		{"NVIM v0.11.1", nil, true},
+		{"NVIM v0.11.2", nil, false},
+		{"NVIM v0.12.0-dev-1234+gabc", nil, false},
+		{"garbage", nil, false},
+		{"", errors.New("not found"), false},
+	}
+	for _, tt := range tests {
+		run := func(string, ...string) (string, error) { return tt.out, tt.err }
+		if _, old := nvimTooOld(run); old != tt.wantOld {
+			t.Errorf("%q: too old = %v, want %v", tt.out, old, tt.wantOld)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// validateGitRef rejects refs that can't name a branch, tag or commit. An
+// empty ref is valid and means the default branch.
+func validateGitRef(ref string) error {
+	switch {
+	case ref == "":
+		return nil
+	case strings.HasPrefix(ref, "-"):
+		return fmt.Errorf("%q can't start with '-'", ref)
+	case strings.Contains(ref, ".."):
+		return fmt.Errorf("%q can't contain '..'", ref)
+	}
+	for _, r := range ref {
+		if unicode.IsSpace(r) || unicode.IsControl(r) || strings.ContainsRune("~^:?*[\\", r) {
+			return fmt.Errorf("%q is not a valid branch, tag or commit", ref)
+		}
+	}
+	return nil
+}
+
+// refLabel shows a pinned ref, or what an empty one means
+func refLabel(ref string) string {
+	if ref == "" {
+		return "latest (default branch)"
+	}
+	return ref
+}
+
+// installRefsOptions lists the two pins, then Back
+func (m Model) installRefsOptions() []string {
+	return []string{
+		"📌 Dotfiles: " + refLabel(m.Choices.RepoRef),
+		"📌 Skill catalog: " + refLabel(m.Choices.SkillsRef),
+		"─────────────",
+		"← Back",
+	}
+}
+
+// enterInstallRefs opens the advanced pin screen from the backup prompt
+func (m Model) enterInstallRefs() Model {
+	m.Screen = ScreenInstallRefs
+	m.Cursor = 0
+	return m
+}
+
+// leaveInstallRefs returns to the backup prompt
+func (m Model) leaveInstallRefs() Model {
+	m.Screen = ScreenBackupConfirm
+	m.Cursor = 0
+	return m
+}
+
+func (m Model) handleInstallRefsKeys(key string) (tea.Model, tea.Cmd) {
+	options := m.canonicalOptions()
+	switch key {
+	case "up", "k":
+		if m.Cursor > 0 {
+			m.Cursor--
+		}
+		if strings.HasPrefix(options[m.Cursor], "───") && m.Cursor > 0 {
+			m.Cursor--
+		}
+	case "down", "j":
+		if m.Cursor < len(options)-1 {
+			m.Cursor++
+		}
+		if strings.HasPrefix(options[m.Cursor], "───") && m.Cursor < len(options)-1 {
+			m.Cursor++
+		}
+	case "enter", " ":
+		if m.Cursor > 1 {
+			return m.leaveInstallRefs(), nil
+		}
+		ref := m.Choices.RepoRef
+		if m.Cursor == 1 {
+			ref = m.Choices.SkillsRef
+		}
+		m.RefField = m.Cursor
+		m.ProjectPathInput = ref
+		m.ProjectPathCursor = len([]rune(ref))
+		m.ProjectPathError = ""
+		m.ProjectPathMode = PathModeTyping
+		m.Screen = ScreenInstallRefInput
+	case "backspace":
+		return m.leaveInstallRefs(), nil
+	}
+	return m, nil
+}
+
+// handleInstallRefInputKeys edits the ref; enter stores it and returns to the list
+func (m Model) handleInstallRefInputKeys(key string) (tea.Model, tea.Cmd) {
+	if key != "enter" {
+		return m.editPathInput(key), nil
+	}
+	ref := strings.TrimSpace(m.ProjectPathInput)
+	if err := validateGitRef(ref); err != nil {
+		m.ProjectPathError = "Invalid ref: " + err.Error()
+		return m, nil
+	}
+	if m.RefField == 1 {
+		m.Choices.SkillsRef = ref
+	} else {
+		m.Choices.RepoRef = ref
+	}
+	m.Screen = ScreenInstallRefs
+	m.Cursor = m.RefField
+	return m, nil
+}
+
+func (m Model) renderInstallRefs() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
+	s.WriteString("\n\n")
+	for i, opt := range m.GetCurrentOptions() {
+		if strings.HasPrefix(opt, "───") {
+			s.WriteString(m.Theme.Muted.Render(opt))
+			s.WriteString("\n")
+			continue
+		}
+		cursor := "  "
+		style := m.Theme.Unselected
+		if i == m.Cursor {
+			cursor = "▸ "
+			style = m.Theme.Selected
+		}
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
+		s.WriteString("\n")
+	}
+	s.WriteString("\n")
+	home, _ := os.UserHomeDir()
+	s.WriteString(m.Theme.Muted.Render("The commits used are recorded in " + contractHome(installManifestPath(home))))
+	return s.String()
+}
+
+func (m Model) renderInstallRefInput() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n\n")
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
+	s.WriteString("\n\n")
+	s.WriteString(m.renderPathInput())
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render("  Enter: save  •  Esc: cancel"))
+	return s.String()
+}
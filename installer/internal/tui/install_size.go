@@ -0,0 +1,228 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// stepSize is roughly what a step downloads and what it leaves on disk, in bytes
+type stepSize struct {
+	Download  int64
+	Installed int64
+}
+
+func (s stepSize) add(o stepSize) stepSize {
+	return stepSize{Download: s.Download + o.Download, Installed: s.Installed + o.Installed}
+}
+
+const (
+	megabyte = int64(1) << 20
+	gigabyte = int64(1) << 30
+)
+
+// stepSizes are rough sizes of the install steps, keyed by step ID, or by
+// "ID:choice" where the choice changes them a lot (see stepSizeKeys). Steps
+// missing here, like the backup and the cleanup, count as nothing.
+var stepSizes = map[string]stepSize{
+	"clone":              {Download: 40 * megabyte, Installed: 120 * megabyte},
+	"homebrew":           {Download: 120 * megabyte, Installed: 600 * megabyte},
+	"deps":               {Download: 250 * megabyte, Installed: 900 * megabyte},
+	"xcode":              {Download: 700 * megabyte, Installed: 2600 * megabyte},
+	"terminal:alacritty": {Download: 30 * megabyte, Installed: 80 * megabyte},
+	// Built from source, with a Rust toolchain, where there's no reliable package
+	"terminal:alacritty-source": {Download: 600 * megabyte, Installed: 2 * gigabyte},
+	"terminal:wezterm":          {Download: 60 * megabyte, Installed: 200 * megabyte},
+	"terminal:kitty":            {Download: 40 * megabyte, Installed: 120 * megabyte},
+	"terminal:ghostty":          {Download: 40 * megabyte, Installed: 130 * megabyte},
+	"font":                      {Download: 60 * megabyte, Installed: 150 * megabyte},
+	"font:termux":               {Download: 3 * megabyte, Installed: 3 * megabyte},
+	"shell:fish":                {Download: 20 * megabyte, Installed: 70 * megabyte},
+	"shell:zsh":                 {Download: 25 * megabyte, Installed: 90 * megabyte},
+	"shell:nushell":             {Download: 40 * megabyte, Installed: 130 * megabyte},
+	"wm:tmux":                   {Download: 10 * megabyte, Installed: 30 * megabyte},
+	"wm:zellij":                 {Download: 15 * megabyte, Installed: 45 * megabyte},
+	// Neovim, Node.js, the language servers and the plugins
+	"nvim":        {Download: 350 * megabyte, Installed: 1200 * megabyte},
+	"zed":         {Download: 150 * megabyte, Installed: 450 * megabyte},
+	"aiframework": {Download: 10 * megabyte, Installed: 30 * megabyte},
+	// AI tools are sized one by one, keyed by tool ID
+	"aitools:claude":   {Download: 60 * megabyte, Installed: 200 * megabyte},
+	"aitools:opencode": {Download: 50 * megabyte, Installed: 150 * megabyte},
+	"aitools:gemini":   {Download: 60 * megabyte, Installed: 250 * megabyte},
+	"aitools:copilot":  {Download: 50 * megabyte, Installed: 200 * megabyte},
+	"aitools:codex":    {Download: 40 * megabyte, Installed: 150 * megabyte},
+	"aitools:qwen":     {Download: 60 * megabyte, Installed: 250 * megabyte},
+}
+
+// stepSizeKeys are the stepSizes keys of a planned step: one per AI tool for
+// aitools, otherwise the most specific key with an entry
+func stepSizeKeys(id string, m *Model) []string {
+	var choice string
+	switch id {
+	case "aitools":
+		keys := make([]string, len(m.Choices.AITools))
+		for i, tool := range m.Choices.AITools {
+			keys[i] = "aitools:" + tool
+		}
+		return keys
+	case "terminal":
+		choice = m.Choices.Terminal
+		if choice == "alacritty" && alacrittyFromSource(m) {
+			choice = "alacritty-source"
+		}
+	case "font":
+		if isTermuxChoice(m) {
+			choice = "termux"
+		}
+	case "shell":
+		choice = m.Choices.Shell
+	case "wm":
+		choice = m.Choices.WindowMgr
+	}
+	if _, ok := stepSizes[id+":"+choice]; choice != "" && ok {
+		return []string{id + ":" + choice}
+	}
+	return []string{id}
+}
+
+// alacrittyFromSource reports whether the terminal step builds Alacritty from
+// source on this machine, as it does on every Linux but Arch and Fedora
+func alacrittyFromSource(m *Model) bool {
+	return terminalVariants["alacritty"].installKey(m) == "linux"
+}
+
+// stepSizeLine is one planned step in an installSize
+type stepSizeLine struct {
+	Name string
+	Size stepSize
+}
+
+// installSize estimates the planned install, step by step
+type installSize struct {
+	Steps []stepSizeLine // Only the steps with a size
+	Total stepSize
+}
+
+// estimateInstallSize adds up stepSizes over the steps the choices plan
+func estimateInstallSize(m Model) installSize {
+	plan := m
+	plan.SetupInstallSteps()
+	var est installSize
+	for _, step := range plan.Steps {
+		var size stepSize
+		for _, key := range stepSizeKeys(step.ID, &plan) {
+			size = size.add(stepSizes[key])
+		}
+		if size == (stepSize{}) {
+			continue
+		}
+		est.Steps = append(est.Steps, stepSizeLine{Name: step.Name, Size: size})
+		est.Total = est.Total.add(size)
+	}
+	return est
+}
+
+// diskFree reads the free space of the home partition; tests swap it
+var diskFree = system.DiskFree
+
+// diskCheck compares the estimated install size with the free space in $HOME
+type diskCheck struct {
+	Size installSize
+	Path string
+	Free int64 // -1 when the free space couldn't be read
+}
+
+// checkDiskSpace estimates the planned install and reads the free space
+func (m Model) checkDiskSpace() *diskCheck {
+	check := &diskCheck{Size: estimateInstallSize(m), Path: os.Getenv("HOME"), Free: -1}
+	if free, err := diskFree(check.Path); err == nil {
+		check.Free = free
+	}
+	return check
+}
+
+// Short reports an install estimated to need more than the free space
+func (c *diskCheck) Short() bool {
+	return c != nil && c.Free >= 0 && c.Size.Total.Installed > c.Free
+}
+
+// Summary is the one-line estimate shown before installing
+func (c *diskCheck) Summary() string {
+	line := fmt.Sprintf("Install size: ~%s download, ~%s on disk",
+		system.FormatBytes(c.Size.Total.Download), system.FormatBytes(c.Size.Total.Installed))
+	if c.Free >= 0 {
+		line += fmt.Sprintf(" · %s free in %s", system.FormatBytes(c.Free), contractHome(c.Path))
+	}
+	return line
+}
+
+// handleDiskSpaceWarningKeys asks to go on with an install that may not fit
+func (m Model) handleDiskSpaceWarningKeys(key string) (tea.Model, tea.Cmd) {
+	options := m.canonicalOptions()
+
+	switch key {
+	case "up", "k":
+		if m.Cursor > 0 {
+			m.Cursor--
+		}
+	case "down", "j":
+		if m.Cursor < len(options)-1 {
+			m.Cursor++
+		}
+	case "enter":
+		if m.Cursor == 0 { // Install anyway
+			m.DiskWarningAccepted = true
+			return m.proceedToBackupOrInstall()
+		}
+		// Abort - same as cancelling the wizard
+		m.Screen = ScreenMainMenu
+		m.Cursor = 0
+		m.Choices = UserChoices{}
+	case "esc", "backspace":
+		return m.goBackInstallStep()
+	}
+
+	return m, nil
+}
+
+// renderDiskSpaceWarning lists the estimate step by step against the free space
+func (m Model) renderDiskSpaceWarning() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
+	s.WriteString("\n\n")
+
+	if c := m.DiskCheck; c != nil {
+		for _, step := range c.Size.Steps {
+			s.WriteString(m.Theme.Muted.Render(fmt.Sprintf("  %-28s ~%s", step.Name, system.FormatBytes(step.Size.Installed))))
+			s.WriteString("\n")
+		}
+		s.WriteString("\n")
+		s.WriteString(m.Theme.Warning.Render(fmt.Sprintf("⚠️  About %s needed, %s free in %s",
+			system.FormatBytes(c.Size.Total.Installed), system.FormatBytes(c.Free), contractHome(c.Path))))
+		s.WriteString("\n\n")
+	}
+
+	for i, opt := range m.GetCurrentOptions() {
+		cursor := "  "
+		style := m.Theme.Unselected
+		if i == m.Cursor {
+			cursor = "▸ "
+			style = m.Theme.Selected
+		}
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
+		s.WriteString("\n")
+	}
+
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render("↑/k up • ↓/j down • [Enter] select • [Esc] back"))
+
+	return s.String()
+}
@@ -0,0 +1,158 @@
+package tui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+)
+
+// useDiskFree fakes the free space of the home partition
+func useDiskFree(t *testing.T, free int64, err error) {
+	t.Helper()
+	saved := diskFree
+	diskFree = func(string) (int64, error) { return free, err }
+	t.Cleanup(func() { diskFree = saved })
+}
+
+// sizedModel is a finished wizard on Arch with Homebrew, so the plan is
+// clone, terminal, font, shell, wm, nvim, aitools and the steps without a size
+func sizedModel(t *testing.T) Model {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	m := NewModel()
+	m.SystemInfo = &system.SystemInfo{OS: system.OSArch, HasBrew: true, HomeDir: t.TempDir()}
+	m.Choices = UserChoices{
+		OS: "linux", Terminal: "wezterm", InstallFont: true, Shell: "fish",
+		WindowMgr: "tmux", InstallNvim: true, AITools: []string{"claude", "codex"},
+	}
+	return m
+}
+
+func TestEstimateInstallSize(t *testing.T) {
+	m := sizedModel(t)
+	est := estimateInstallSize(m)
+
+	var want stepSize
+	for _, key := range []string{"clone", "deps", "terminal:wezterm", "font", "shell:fish", "wm:tmux", "nvim", "aitools:claude", "aitools:codex"} {
+		want = want.add(stepSizes[key])
+	}
+	if est.Total != want {
+		t.Errorf("total = %+v, want %+v", est.Total, want)
+	}
+
+	var names []string
+	for _, step := range est.Steps {
+		names = append(names, step.Name)
+	}
+	if got := strings.Join(names, ", "); got != "Clone Repository, Install Dependencies, Install wezterm, Install Iosevka Nerd Font, Install fish, Install tmux, Install Neovim, Install AI Tools" {
+		t.Errorf("only sized steps should be listed, got %s", got)
+	}
+	if ai := est.Steps[len(est.Steps)-1].Size; ai != stepSizes["aitools:claude"].add(stepSizes["aitools:codex"]) {
+		t.Errorf("the AI tools should be sized one by one, got %+v", ai)
+	}
+}
+
+func TestStepSizeKeys(t *testing.T) {
+	m := sizedModel(t)
+	tests := []struct {
+		id, want string
+		set      func(m *Model)
+	}{
+		{"terminal", "terminal:wezterm", nil},
+		{"terminal", "terminal:alacritty", func(m *Model) { m.Choices.Terminal = "alacritty" }},
+		{"terminal", "terminal:alacritty-source", func(m *Model) {
+			m.Choices.Terminal = "alacritty"
+			m.SystemInfo = &system.SystemInfo{OS: system.OSDebian, PackageManager: "apt"}
+		}},
+		// Estimated the way the step installs: Fedora packages it
+		{"terminal", "terminal:alacritty", func(m *Model) {
+			m.Choices.Terminal = "alacritty"
+			m.SystemInfo = &system.SystemInfo{OS: system.OSFedora, PackageManager: "dnf"}
+		}},
+		{"terminal", "terminal:alacritty-source", func(m *Model) {
+			m.Choices.Terminal = "alacritty"
+			m.SystemInfo = &system.SystemInfo{OS: system.OSLinux, PackageManager: "zypper"}
+		}},
+		{"font", "font", nil},
+		{"font", "font:termux", func(m *Model) { m.Choices.OS = "termux" }},
+		{"shell", "shell:fish", nil},
+		// A choice without its own entry falls back to the step
+		{"nvim", "nvim", nil},
+		{"wm", "wm", func(m *Model) { m.Choices.WindowMgr = "screen" }},
+	}
+	for _, tt := range tests {
+		mm := m
+		if tt.set != nil {
+			tt.set(&mm)
+		}
+		if got := strings.Join(stepSizeKeys(tt.id, &mm), ","); got != tt.want {
+			t.Errorf("stepSizeKeys(%s) = %s, want %s", tt.id, got, tt.want)
+		}
+	}
+}
+
+func TestDiskCheck(t *testing.T) {
+	m := sizedModel(t)
+	need := estimateInstallSize(m).Total.Installed
+
+	useDiskFree(t, need+1, nil)
+	if c := m.checkDiskSpace(); c.Short() || !strings.Contains(c.Summary(), "free in") {
+		t.Errorf("enough space should pass with the free space shown, got %q", c.Summary())
+	}
+
+	useDiskFree(t, need-1, nil)
+	if !m.checkDiskSpace().Short() {
+		t.Error("less space than the estimate should be short")
+	}
+
+	useDiskFree(t, 0, errors.New("statfs failed"))
+	if c := m.checkDiskSpace(); c.Short() || strings.Contains(c.Summary(), "free in") {
+		t.Errorf("an unknown free space should not warn, got %q", c.Summary())
+	}
+}
+
+func TestDiskSpaceWarning(t *testing.T) {
+	m := sizedModel(t)
+	useDiskFree(t, 100*megabyte, nil)
+
+	result, _ := m.proceedToBackupOrInstall()
+	m = result.(Model)
+	if m.Screen != ScreenDiskSpaceWarning {
+		t.Fatalf("a short disk should stop at the warning, got %v", m.Screen)
+	}
+	view := m.View()
+	for _, want := range []string{"Not Enough Disk Space", "Install Neovim", "100.0MB free", "Install anyway"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("the warning should show %q", want)
+		}
+	}
+
+	// Aborting drops the wizard
+	abort := m
+	abort.Cursor = 1
+	result, _ = abort.handleDiskSpaceWarningKeys("enter")
+	if abort = result.(Model); abort.Screen != ScreenMainMenu || abort.Choices.OS != "" {
+		t.Errorf("abort should return to the main menu, got %v", abort.Screen)
+	}
+
+	// Going on needs the explicit answer, and is asked once
+	result, _ = m.handleDiskSpaceWarningKeys("enter")
+	m = result.(Model)
+	if !m.DiskWarningAccepted || (m.Screen != ScreenBackupConfirm && m.Screen != ScreenInstalling) {
+		t.Errorf("install anyway should move on, got %v", m.Screen)
+	}
+}
+
+func TestBackupConfirmShowsInstallSize(t *testing.T) {
+	m := sizedModel(t)
+	useDiskFree(t, 100*gigabyte, nil)
+	m.DiskCheck = m.checkDiskSpace()
+	m.Screen = ScreenBackupConfirm
+	m.ExistingConfigs = []string{".config/nvim"}
+
+	if view := m.View(); !strings.Contains(view, "Install size: ~") || !strings.Contains(view, "100.0GB free") {
+		t.Errorf("the backup screen should show the estimate and the free space, got:\n%s", view)
+	}
+}
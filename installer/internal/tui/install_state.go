@@ -0,0 +1,273 @@
+package tui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// savedStep is one step of the plan as recorded in the resume state
+type savedStep struct {
+	ID          string        `json:"id"`
+	Name        string        `json:"name"`
+	Description string        `json:"description"`
+	Interactive bool          `json:"interactive"`
+	Status      StepStatus    `json:"status"`
+	Elapsed     time.Duration `json:"elapsed,omitempty"`
+}
+
+// installState is the progress of an install, written after every step so a
+// failed or interrupted run can pick up where it stopped
+type installState struct {
+	SavedAt    time.Time   `json:"saved_at"`
+	Choices    UserChoices `json:"choices"`
+	Steps      []savedStep `json:"steps"`
+	BackupDir  string      `json:"backup_dir,omitempty"`
+	RepoCommit RepoCommit  `json:"repo_commit"`
+}
+
+func installStatePath(home string) string {
+	return filepath.Join(paths.DataDir(home), "install-state.json")
+}
+
+// newInstallState snapshots the plan and choices of the running install
+func newInstallState(m Model) installState {
+	st := installState{
+		SavedAt:    time.Now(),
+		Choices:    m.Choices,
+		BackupDir:  m.BackupDir,
+		RepoCommit: m.RepoCommit,
+	}
+	for _, step := range m.Steps {
+		status := step.Status
+		if step.Cancelled {
+			// A later run offers to resume the steps a cancel left out
+			status = StatusPending
+		}
+		st.Steps = append(st.Steps, savedStep{
+			ID:          step.ID,
+			Name:        step.Name,
+			Description: step.Description,
+			Interactive: step.Interactive,
+			Status:      status,
+			Elapsed:     step.Elapsed,
+		})
+	}
+	return st
+}
+
+// saveInstallState writes st to the state file under home
+func saveInstallState(home string, st installState) error {
+	data, err := json.MarshalIndent(st, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(installStatePath(home)), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(installStatePath(home), data, 0644)
+}
+
+// loadInstallState returns the saved install progress, or nil if none was written
+func loadInstallState(home string) (*installState, error) {
+	data, err := os.ReadFile(installStatePath(home))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var st installState
+	if err := json.Unmarshal(data, &st); err != nil {
+		return nil, fmt.Errorf("invalid install state: %w", err)
+	}
+	return &st, nil
+}
+
+// clearInstallState removes the state file once an install has finished
+func clearInstallState(home string) error {
+	if err := os.Remove(installStatePath(home)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// stepFinished reports whether a step needs no further work
+func stepFinished(status StepStatus) bool {
+	return status == StatusDone || status == StatusSkipped
+}
+
+// Incomplete reports whether any step still has to run
+func (st *installState) Incomplete() bool {
+	for _, step := range st.Steps {
+		if !stepFinished(step.Status) {
+			return true
+		}
+	}
+	return false
+}
+
+// Summary describes the saved progress for the welcome screen,
+// e.g. "3 of 9 steps done, stopped at Install Neovim"
+func (st *installState) Summary() string {
+	done := 0
+	stopped := ""
+	for _, step := range st.Steps {
+		if stepFinished(step.Status) {
+			done++
+		} else if stopped == "" {
+			stopped = step.Name
+		}
+	}
+	s := fmt.Sprintf("%d of %d steps done", done, len(st.Steps))
+	if stopped != "" {
+		s += ", stopped at " + stopped
+	}
+	return s
+}
+
+// EnableResume turns on progress tracking and install logs under home and picks
+// up an unfinished install left by an earlier run, which the welcome screen then
+// offers to resume
+func (m *Model) EnableResume(home string) error {
+	m.StateHome = home
+	st, err := loadInstallState(home)
+	if err != nil {
+		return err
+	}
+	if st != nil && st.Incomplete() {
+		m.ResumeState = st
+		// The resume offer is on the welcome screen, even when it's skipped
+		m.Screen = ScreenWelcome
+	}
+	return nil
+}
+
+// saveProgress records the install's progress, logging instead of failing when it can't
+func (m *Model) saveProgress() {
+	if !m.trackProgress || m.StateHome == "" {
+		return
+	}
+	if err := saveInstallState(m.StateHome, newInstallState(*m)); err != nil {
+		m.LogLines.Add("⚠️  Could not save install progress: " + err.Error())
+	}
+}
+
+// finishProgress forgets the saved progress of an install that ran to the end
+func (m *Model) finishProgress() {
+	if !m.trackProgress || m.StateHome == "" {
+		return
+	}
+	m.trackProgress = false
+	if err := clearInstallState(m.StateHome); err != nil {
+		m.LogLines.Add("⚠️  Could not clear install progress: " + err.Error())
+	}
+}
+
+// skipFinishedSteps moves CurrentStep past steps that are already done or skipped
+func (m *Model) skipFinishedSteps() {
+	for m.CurrentStep < len(m.Steps) && stepFinished(m.Steps[m.CurrentStep].Status) {
+		m.CurrentStep++
+	}
+}
+
+// requeueMissingClone re-runs the clone when later steps still need a checkout
+// that is gone (a failed step removes it along with the other temporary files)
+func (m *Model) requeueMissingClone() {
+	for i := range m.Steps {
+		if m.Steps[i].ID != "clone" || m.Steps[i].Status != StatusDone {
+			continue
+		}
+		if _, err := os.Stat(m.RepoDir); err == nil {
+			return
+		}
+		m.Steps[i].Status = StatusPending
+		m.Steps[i].Progress = 0
+		if i < m.CurrentStep {
+			m.CurrentStep = i
+		}
+		return
+	}
+}
+
+// retryFailedStep runs the failed steps again and continues from there
+func (m Model) retryFailedStep() (tea.Model, tea.Cmd) {
+	for i := range m.Steps {
+		if m.Steps[i].Status == StatusFailed {
+			m.Steps[i].Status = StatusPending
+			m.Steps[i].Progress = 0
+			m.Steps[i].Error = nil
+		}
+	}
+	return m.continueInstall()
+}
+
+// skipFailedStep marks the failed steps as skipped and continues with the
+// rest; the steps waiting on them run too
+func (m Model) skipFailedStep() (tea.Model, tea.Cmd) {
+	for i := range m.Steps {
+		if m.Steps[i].Status == StatusFailed {
+			m.Steps[i].Status = StatusSkipped
+			m.Steps[i].Error = nil
+			m.LogLines.Add("⏭️  Skipped " + m.Steps[i].Name)
+		}
+	}
+	m.saveProgress()
+	return m.continueInstall()
+}
+
+// continueInstall returns to the installing screen and runs the first unfinished step
+func (m Model) continueInstall() (tea.Model, tea.Cmd) {
+	m.ErrorMsg = ""
+	m.TempCleanupNote = ""
+	m.requeueMissingClone()
+	m.skipFinishedSteps()
+	m.Screen = ScreenInstalling
+	return m, func() tea.Msg { return installStartMsg{} }
+}
+
+// resumeInstallation restores the plan and choices of the saved install and
+// continues with its first unfinished step; finished steps are not run again
+func (m Model) resumeInstallation() (tea.Model, tea.Cmd) {
+	st := m.ResumeState
+	m.ResumeState = nil
+	m.Choices = st.Choices
+	m.BackupDir = st.BackupDir
+	m.RepoCommit = st.RepoCommit
+	m.Steps = nil
+	for _, s := range st.Steps {
+		status := s.Status
+		if !stepFinished(status) {
+			status = StatusPending
+		}
+		m.Steps = append(m.Steps, InstallStep{
+			ID:          s.ID,
+			Name:        s.Name,
+			Description: s.Description,
+			Interactive: s.Interactive,
+			Status:      status,
+			Elapsed:     s.Elapsed,
+		})
+		if stepFinished(status) {
+			m.Steps[len(m.Steps)-1].Progress = 1.0
+		}
+		// The state file keeps no ordering, so take it from the registry again
+		if spec, ok := lookupStepSpec(s.ID); ok {
+			planned := spec.planStep(&m)
+			m.Steps[len(m.Steps)-1].DependsOn = planned.DependsOn
+			m.Steps[len(m.Steps)-1].Locks = planned.Locks
+		}
+	}
+	m.CurrentStep = 0
+	m.trackProgress = true
+	m.openInstallLog()
+	m.recordDeploys()
+	m.LogLines.Add("Resuming the installation saved " + st.SavedAt.Format("Jan 2 15:04"))
+	activeLog.Write("", "Resuming the installation saved "+st.SavedAt.Format("Jan 2 15:04"))
+	return m.continueInstall()
+}
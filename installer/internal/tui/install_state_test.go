@@ -0,0 +1,189 @@
+package tui
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func resumableModel(t *testing.T) Model {
+	t.Helper()
+	home := t.TempDir()
+	m := NewModel()
+	m.StateHome = home
+	m.trackProgress = true
+	m.Screen = ScreenInstalling
+	m.RepoDir = t.TempDir() // an existing checkout, so the clone is not requeued
+	m.Choices = UserChoices{OS: "linux", Shell: "fish", InstallNvim: true}
+	m.Steps = []InstallStep{
+		{ID: "clone", Name: "Clone Repository", Status: StatusRunning},
+		{ID: "shell", Name: "Install Fish", Status: StatusPending, DependsOn: []string{"clone"}, Locks: []string{lockPackages}},
+		{ID: "nvim", Name: "Install Neovim", Status: StatusPending, DependsOn: []string{"clone"}, Locks: []string{lockPackages}},
+	}
+	return m
+}
+
+func TestInstallStateSavedAfterEachStep(t *testing.T) {
+	m := resumableModel(t)
+
+	result, _ := m.Update(stepCompleteMsg{stepID: "clone"})
+	m = result.(Model)
+	result, _ = m.Update(stepCompleteMsg{stepID: "shell", err: errors.New("boom")})
+	m = result.(Model)
+
+	st, err := loadInstallState(m.StateHome)
+	if err != nil || st == nil {
+		t.Fatalf("expected a saved state, got %v, %v", st, err)
+	}
+	if st.Choices.Shell != "fish" {
+		t.Errorf("choices not saved: %+v", st.Choices)
+	}
+	want := []StepStatus{StatusDone, StatusFailed, StatusPending}
+	for i, s := range st.Steps {
+		if s.Status != want[i] {
+			t.Errorf("step %s: status %d, want %d", s.ID, s.Status, want[i])
+		}
+	}
+	if !st.Incomplete() {
+		t.Error("a state with a failed step should be incomplete")
+	}
+	if got := st.Summary(); got != "1 of 3 steps done, stopped at Install Fish" {
+		t.Errorf("unexpected summary %q", got)
+	}
+}
+
+func TestInstallStateNotSavedWithoutTracking(t *testing.T) {
+	m := resumableModel(t)
+	m.trackProgress = false
+
+	result, _ := m.Update(stepCompleteMsg{stepID: "clone"})
+	m = result.(Model)
+	if _, err := os.Stat(installStatePath(m.StateHome)); !os.IsNotExist(err) {
+		t.Error("diagnose fixes and apply-only runs must not write the resume state")
+	}
+}
+
+func TestInstallStateClearedOnCompletion(t *testing.T) {
+	m := resumableModel(t)
+	m.saveProgress()
+	if _, err := os.Stat(installStatePath(m.StateHome)); err != nil {
+		t.Fatalf("state should exist: %v", err)
+	}
+
+	result, _ := m.Update(installCompleteMsg{})
+	if _, err := os.Stat(installStatePath(result.(Model).StateHome)); !os.IsNotExist(err) {
+		t.Error("a finished install should remove the resume state")
+	}
+}
+
+func TestErrorScreenRetryAndSkip(t *testing.T) {
+	failed := func(t *testing.T) Model {
+		m := resumableModel(t)
+		// Neovim waits on the shell, so nothing else can run after it fails
+		m.Steps[2].DependsOn = []string{"shell"}
+		result, _ := m.Update(stepCompleteMsg{stepID: "clone"})
+		m = result.(Model)
+		result, _ = m.Update(stepCompleteMsg{stepID: "shell", err: errors.New("boom")})
+		return result.(Model)
+	}
+
+	t.Run("r retries the failed step", func(t *testing.T) {
+		m := failed(t)
+		result, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+		nm := result.(Model)
+		if nm.Screen != ScreenInstalling || cmd == nil {
+			t.Fatalf("expected the install to continue, got screen %d", nm.Screen)
+		}
+		if nm.CurrentStep != 1 || nm.Steps[1].Status != StatusPending {
+			t.Errorf("expected step 1 pending, got step %d with status %d", nm.CurrentStep, nm.Steps[1].Status)
+		}
+		if nm.Steps[0].Status != StatusDone {
+			t.Error("the finished clone must not run again")
+		}
+	})
+
+	t.Run("s skips the failed step", func(t *testing.T) {
+		m := failed(t)
+		result, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+		nm := result.(Model)
+		if nm.Screen != ScreenInstalling || cmd == nil {
+			t.Fatalf("expected the install to continue, got screen %d", nm.Screen)
+		}
+		if nm.CurrentStep != 2 || nm.Steps[1].Status != StatusSkipped {
+			t.Errorf("expected step 2 next with step 1 skipped, got step %d with status %d", nm.CurrentStep, nm.Steps[1].Status)
+		}
+		st, _ := loadInstallState(nm.StateHome)
+		if st == nil || st.Steps[1].Status != StatusSkipped {
+			t.Error("the skip should be saved")
+		}
+	})
+
+	t.Run("retry re-clones a removed checkout first", func(t *testing.T) {
+		m := failed(t)
+		m.RepoDir = filepath.Join(t.TempDir(), "gone")
+		result, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+		nm := result.(Model)
+		if nm.CurrentStep != 0 || nm.Steps[0].Status != StatusPending {
+			t.Errorf("expected the clone to run again, got step %d with status %d", nm.CurrentStep, nm.Steps[0].Status)
+		}
+	})
+}
+
+func TestResumePreviousInstallation(t *testing.T) {
+	home := t.TempDir()
+	m := resumableModel(t)
+	m.StateHome = home
+	m.Steps[0].Status = StatusDone
+	m.Steps[1].Status = StatusFailed
+	m.saveProgress()
+
+	fresh := NewModel()
+	fresh.RepoDir = m.RepoDir
+	if err := fresh.EnableResume(home); err != nil {
+		t.Fatal(err)
+	}
+	if fresh.ResumeState == nil {
+		t.Fatal("an unfinished install should be offered for resume")
+	}
+	fresh.Width, fresh.Height = 100, 40
+	if view := fresh.renderWelcome(); !strings.Contains(view, "resume previous installation") {
+		t.Error("the welcome screen should offer to resume")
+	}
+
+	result, cmd := fresh.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	nm := result.(Model)
+	if nm.Screen != ScreenInstalling || cmd == nil {
+		t.Fatalf("expected the install to resume, got screen %d", nm.Screen)
+	}
+	if nm.Choices.Shell != "fish" {
+		t.Errorf("choices should be restored, got %+v", nm.Choices)
+	}
+	if nm.CurrentStep != 1 {
+		t.Errorf("expected to resume at the failed step, got %d", nm.CurrentStep)
+	}
+	if nm.Steps[0].Status != StatusDone || nm.Steps[1].Status != StatusPending {
+		t.Errorf("unexpected statuses %d, %d", nm.Steps[0].Status, nm.Steps[1].Status)
+	}
+}
+
+func TestEnableResumeIgnoresFinishedState(t *testing.T) {
+	home := t.TempDir()
+	st := installState{Steps: []savedStep{{ID: "clone", Status: StatusDone}, {ID: "nvim", Status: StatusSkipped}}}
+	if err := saveInstallState(home, st); err != nil {
+		t.Fatal(err)
+	}
+	m := NewModel()
+	if err := m.EnableResume(home); err != nil {
+		t.Fatal(err)
+	}
+	if m.ResumeState != nil {
+		t.Error("a state with every step finished has nothing to resume")
+	}
+	if m.StateHome != home {
+		t.Errorf("StateHome not set: %q", m.StateHome)
+	}
+}
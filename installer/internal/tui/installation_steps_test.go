@@ -559,11 +559,17 @@ func TestNavigationToEachScreen(t *testing.T) {
 		}
 	})
 
-	t.Run("WM select -> nvim select", func(t *testing.T) {
+	t.Run("WM select -> WM extras -> nvim select", func(t *testing.T) {
 		m := NewModel()
 		m.Screen = ScreenWMSelect
 		m.Cursor = 0 // Tmux
 
+		m, _ = simulateKeyPress(m, "enter")
+		if m.Screen != ScreenWMExtras {
+			t.Errorf("Expected WMExtras, got %v", m.Screen)
+		}
+
+		m.Cursor = len(m.GetCurrentOptions()) - 1 // Confirm selection
 		m, _ = simulateKeyPress(m, "enter")
 		if m.Screen != ScreenNvimSelect {
 			t.Errorf("Expected NvimSelect, got %v", m.Screen)
@@ -765,7 +771,7 @@ func TestBackupOptions(t *testing.T) {
 		m := NewModel()
 		m.Screen = ScreenBackupConfirm
 		m.ExistingConfigs = []string{"nvim: /test"}
-		m.Cursor = 2 // Cancel
+		m.Cursor = 3 // Cancel
 
 		m, _ = simulateKeyPress(m, "enter")
 		if m.Screen != ScreenMainMenu {
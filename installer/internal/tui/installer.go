@@ -1,6 +1,9 @@
 package tui
 
 import (
+	"bufio"
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
@@ -9,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
 	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
 )
 
@@ -44,41 +48,56 @@ func wrapStepError(stepID, stepName, description string, cause error) error {
 	}
 }
 
-// executeStep runs the actual installation for a step
-func executeStep(stepID string, m *Model) error {
+// executeStep runs the actual installation for a step. Once ctx is cancelled
+// the step doesn't start, and one that was running (its commands run under
+// m.installContext(), so cancelling kills them) returns errInstallCancelled.
+func executeStep(ctx context.Context, stepID string, m *Model) error {
+	if dryRunMode {
+		return errDryRunStep(stepID)
+	}
+	if ctx.Err() != nil {
+		return errInstallCancelled
+	}
+	err := runStep(ctx, stepID, m)
+	if err != nil && ctx.Err() != nil {
+		return errInstallCancelled
+	}
+	return err
+}
+
+// runStep dispatches stepID to its runner
+func runStep(ctx context.Context, stepID string, m *Model) error {
+	if spec, ok := lookupStepSpec(stepID); ok {
+		run := func() error {
+			if spec.Run != nil {
+				return spec.Run(m)
+			}
+			return runDeclarativeStep(spec, m)
+		}
+		if spec.Retryable {
+			return stepRetryPolicy.run(ctx, stepID, run)
+		}
+		return run()
+	}
+
+	// Steps outside the install plan (project, diagnose and update tooling)
 	switch stepID {
-	case "backup":
-		return stepBackupConfigs(m)
-	case "clone":
-		return stepCloneRepo(m)
-	case "homebrew":
-		return stepInstallHomebrew(m)
-	case "deps":
-		return stepInstallDeps(m)
-	case "xcode":
-		return stepInstallXcode(m)
-	case "terminal":
-		return stepInstallTerminal(m)
-	case "font":
-		return stepInstallFont(m)
-	case "shell":
-		return stepInstallShell(m)
-	case "wm":
-		return stepInstallWM(m)
-	case "nvim":
-		return stepInstallNvim(m)
-	case "zed":
-		return stepInstallZed(m)
-	case "aitools":
-		return stepInstallAITools(m)
-	case "aiframework":
-		return stepInstallAIFramework(m)
 	case "engram":
 		return stepInstallEngram(m)
-	case "cleanup":
-		return stepCleanup(m)
-	case "setshell":
-		return stepSetDefaultShell(m)
+	case "fccache":
+		return stepRebuildFontCache(m)
+	case "nvim-sync":
+		return stepSyncNvimPlugins(m)
+	case "update-pull":
+		return stepPullDotfiles(m)
+	case "update-configs":
+		return stepUpdateConfigs(m)
+	case "update-skills":
+		return stepUpdateSkills(m)
+	case "redeploy-nvim":
+		return stepRedeployNvim(m)
+	case "redeploy-tmux":
+		return stepRedeployTmux(m)
 	default:
 		return fmt.Errorf("unknown step: %s", stepID)
 	}
@@ -100,7 +119,23 @@ func stepBackupConfigs(m *Model) error {
 		SendLog(stepID, fmt.Sprintf("  → %s", config))
 	}
 
-	backupDir, err := system.CreateBackup(configKeys)
+	opts := system.BackupOptions{
+		IncludeCaches:  m.Choices.BackupIncludeCaches,
+		ExcludeHistory: m.Choices.BackupExcludeHistory,
+	}
+	if !opts.IncludeCaches {
+		SendLog(stepID, "Skipping regenerable caches (Oh My Zsh cache and logs)")
+	}
+	if opts.ExcludeHistory {
+		SendLog(stepID, "Skipping shell history files")
+	}
+
+	create := system.CreateBackupWithOptions
+	if m.Choices.BackupCompress {
+		SendLog(stepID, "Compressing the backup into a "+system.BackupArchiveExt+" archive")
+		create = system.CreateBackupArchive
+	}
+	backupDir, err := create(configKeys, opts)
 	if err != nil {
 		return fmt.Errorf("failed to create backup: %w", err)
 	}
@@ -117,24 +152,26 @@ func stepCloneRepo(m *Model) error {
 	// Check if already exists
 	if _, err := os.Stat(repoDir); err == nil {
 		SendLog(stepID, "Removing existing "+repoDir+" directory...")
-		result := system.RunWithLogs("rm -rf "+repoDir, nil, func(line string) {
-			SendLog(stepID, line)
-		})
-		if result.Error != nil {
+		if err := os.RemoveAll(repoDir); err != nil {
 			return wrapStepError("clone", "Clone Repository",
 				"Failed to remove existing "+repoDir+" directory",
-				result.Error)
+				err)
 		}
 	}
 
-	SendLog(stepID, "Cloning repository from GitHub...")
-	result := system.RunWithLogs("git clone --progress "+m.RepoURL+" "+repoDir, nil, func(line string) {
-		SendLog(stepID, line)
+	SendLog(stepID, "Cloning repository...")
+	installTemps.Track(repoDir)
+	// The full history lets the update check count commits since the last install
+	_, err := system.GitClone(m.installContext(), cloneURLs(m.RepoURL, m.Choices), repoDir, system.CloneOptions{
+		Full:     true,
+		Ref:      m.Choices.RepoRef,
+		Log:      func(line string) { SendLog(stepID, line) },
+		Progress: func(progress float64) { SendProgress(stepID, progress) },
 	})
-	if result.Error != nil {
+	if err != nil {
 		return wrapStepError("clone", "Clone Repository",
 			"Failed to clone the repository. Check your internet connection and git installation.",
-			result.Error)
+			err)
 	}
 
 	// Verify clone was successful
@@ -145,6 +182,18 @@ func stepCloneRepo(m *Model) error {
 	}
 
 	SendLog(stepID, "✓ Repository cloned successfully")
+
+	// Record the deployed commit and show how far the last install was behind it
+	commit, err := readRepoCommit(execRunner, repoDir)
+	if err != nil {
+		SendLog(stepID, "Warning: could not read the repository commit: "+err.Error())
+		return nil
+	}
+	m.RepoCommit = commit
+	SendLog(stepID, "Commit: "+commit.Label())
+	if prev, _ := loadInstallManifest(os.Getenv("HOME")); prev.dotfilesCommit().Hash != "" {
+		SendLog(stepID, repoUpdateLine(execRunner, repoDir, prev.dotfilesCommit(), commit))
+	}
 	return nil
 }
 
@@ -163,7 +212,7 @@ func stepInstallHomebrew(m *Model) error {
 	}
 
 	SendLog(stepID, "Installing Homebrew package manager...")
-	result := system.RunWithLogs(`/bin/bash -c "$(curl -fsSL https://raw.githubusercontent.com/Homebrew/install/HEAD/install.sh)"`, nil, func(line string) {
+	result := system.RunWithLogs(m.installContext(), `/bin/bash -c "$(curl -fsSL https://raw.githubusercontent.com/Homebrew/install/HEAD/install.sh)"`, nil, func(line string) {
 		SendLog(stepID, line)
 	})
 	if result.Error != nil {
@@ -189,12 +238,48 @@ func stepInstallHomebrew(m *Model) error {
 	}
 
 	// Source it now
-	system.Run(shellConfig, nil)
+	system.Run(m.installContext(), shellConfig, nil)
 
 	SendLog(stepID, "✓ Homebrew installed successfully")
 	return nil
 }
 
+// baseDependencies are the packages the deps step installs on Linux
+var baseDependencies = []string{"build-essential", "curl", "file", "git", "wget", "unzip", "fontconfig"}
+
+// distroPackageManager returns the package manager of this Linux distro,
+// logging to stepID. The error names the supported managers so the step can
+// stop before running the wrong one.
+func distroPackageManager(m *Model, stepID string) (system.PackageManager, error) {
+	pm, err := system.NewPackageManager(m.SystemInfo.PackageManager, func(line string) {
+		SendLog(stepID, line)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w on %s (supported: %s); install the packages by hand or use Homebrew",
+			err, m.SystemInfo.OSName, strings.Join(system.SupportedPackageManagers, ", "))
+	}
+	return pm, nil
+}
+
+// runNativeInstall installs packages with the distro package manager instead
+// of Homebrew, for installs with Choices.NativePackages. packages is keyed by
+// package manager name, with "linux" as the fallback; names the manager
+// doesn't package are dropped.
+func runNativeInstall(m *Model, stepID string, packages map[string]string) *system.ExecResult {
+	pm, err := distroPackageManager(m, stepID)
+	if err != nil {
+		return &system.ExecResult{Error: err}
+	}
+	pkgs, ok := packages[pm.Name()]
+	if !ok {
+		pkgs, ok = packages["linux"]
+	}
+	if !ok || len(system.TranslatePackages(pm.Name(), strings.Fields(pkgs)...)) == 0 {
+		return &system.ExecResult{Error: fmt.Errorf("not packaged for %s; use Start Installation to install it with Homebrew", pm.Name())}
+	}
+	return pm.Install(m.installContext(), strings.Fields(pkgs)...)
+}
+
 func stepInstallDeps(m *Model) error {
 	stepID := "deps"
 
@@ -203,7 +288,7 @@ func stepInstallDeps(m *Model) error {
 	isTermux := m.SystemInfo.IsTermux || m.Choices.OS == "termux"
 	if isTermux {
 		SendLog(stepID, "Updating Termux packages...")
-		result := system.RunPkgWithLogs("update", nil, func(line string) {
+		result := system.RunPkgWithLogs(m.installContext(), "update", nil, func(line string) {
 			SendLog(stepID, line)
 		})
 		if result.Error != nil {
@@ -211,7 +296,7 @@ func stepInstallDeps(m *Model) error {
 				"Failed to update Termux packages",
 				result.Error)
 		}
-		result = system.RunPkgWithLogs("upgrade -y", nil, func(line string) {
+		result = system.RunPkgWithLogs(m.installContext(), "upgrade -y", nil, func(line string) {
 			SendLog(stepID, line)
 		})
 		if result.Error != nil {
@@ -219,7 +304,7 @@ func stepInstallDeps(m *Model) error {
 			SendLog(stepID, "Warning: package upgrade had issues, continuing...")
 		}
 		SendLog(stepID, "Installing base dependencies...")
-		result = system.RunPkgInstall("git curl", nil, func(line string) {
+		result = system.RunPkgInstall(m.installContext(), "git curl", nil, func(line string) {
 			SendLog(stepID, line)
 		})
 		if result.Error != nil {
@@ -230,53 +315,29 @@ func stepInstallDeps(m *Model) error {
 		return nil
 	}
 
-	// Arch Linux
-	if m.SystemInfo.OS == system.OSArch {
-		result := system.RunSudo("pacman -Syu --noconfirm", nil)
-		if result.Error != nil {
-			return wrapStepError("deps", "Install Dependencies",
-				"Failed to update Arch Linux packages",
-				result.Error)
-		}
-		result = system.RunSudo("pacman -S --needed --noconfirm base-devel curl file git wget unzip fontconfig", nil)
-		if result.Error != nil {
-			return wrapStepError("deps", "Install Dependencies",
-				"Failed to install base dependencies on Arch Linux",
-				result.Error)
-		}
-		return nil
-	}
-
-	// Fedora/RHEL
-	if m.SystemInfo.OS == system.OSFedora {
-		result := system.RunSudo("dnf check-update || true", nil) // dnf check-update returns 100 if updates available
-		result = system.RunSudo("dnf install -y @development-tools curl file git wget unzip fontconfig", nil)
-		if result.Error != nil {
-			return wrapStepError("deps", "Install Dependencies",
-				"Failed to install base dependencies on Fedora/RHEL",
-				result.Error)
-		}
-		return nil
+	pm, err := distroPackageManager(m, stepID)
+	if err != nil {
+		return wrapStepError("deps", "Install Dependencies",
+			"No supported package manager found",
+			err)
 	}
-
-	// Debian/Ubuntu
-	result := system.RunSudo("apt-get update", nil)
-	if result.Error != nil {
+	SendLog(stepID, "Updating "+pm.Name()+" packages...")
+	if result := pm.Update(m.installContext()); result.Error != nil {
 		return wrapStepError("deps", "Install Dependencies",
-			"Failed to update apt package list",
+			"Failed to update "+pm.Name()+" packages",
 			result.Error)
 	}
-	result = system.RunSudo("apt-get install -y build-essential curl file git unzip fontconfig", nil)
-	if result.Error != nil {
+	SendLog(stepID, "Installing base dependencies...")
+	if result := pm.Install(m.installContext(), baseDependencies...); result.Error != nil {
 		return wrapStepError("deps", "Install Dependencies",
-			"Failed to install base dependencies on Debian/Ubuntu",
+			"Failed to install base dependencies with "+pm.Name(),
 			result.Error)
 	}
 	return nil
 }
 
 func stepInstallXcode(m *Model) error {
-	result := system.Run("xcode-select --install", nil)
+	result := system.Run(m.installContext(), "xcode-select --install", nil)
 	if result.Error != nil {
 		// xcode-select returns error if already installed, which is fine
 		if result.ExitCode == 1 && strings.Contains(result.Stderr, "already installed") {
@@ -289,324 +350,85 @@ func stepInstallXcode(m *Model) error {
 	return nil
 }
 
-func stepInstallTerminal(m *Model) error {
-	terminal := m.Choices.Terminal
-	homeDir := os.Getenv("HOME")
-	repoDir := m.RepoDir
-	stepID := "terminal"
-
-	switch terminal {
-	case "alacritty":
-		if !system.CommandExists("alacritty") {
-			SendLog(stepID, "Installing Alacritty...")
-			var result *system.ExecResult
-			if m.SystemInfo.OS == system.OSArch {
-				result = system.RunSudoWithLogs("pacman -S --noconfirm alacritty", nil, func(line string) {
-					SendLog(stepID, line)
-				})
-			} else if m.SystemInfo.OS == system.OSMac {
-				result = system.RunBrewWithLogs("install --cask alacritty", nil, func(line string) {
-					SendLog(stepID, line)
-				})
-			} else if m.SystemInfo.OS == system.OSFedora {
-				// Fedora: install from dnf
-				result = system.RunSudoWithLogs("dnf install -y alacritty", nil, func(line string) {
-					SendLog(stepID, line)
-				})
-			} else if m.SystemInfo.OS == system.OSDebian || m.SystemInfo.OS == system.OSLinux {
-				// Debian/Ubuntu: compile from source (PPAs are unreliable)
-				SendLog(stepID, "Building Alacritty from source...")
-				SendLog(stepID, "Installing build dependencies...")
-				result = system.RunSudoWithLogs("apt-get install -y cmake pkg-config libfreetype6-dev libfontconfig1-dev libxcb-xfixes0-dev libxkbcommon-dev python3 gzip scdoc git curl", nil, func(line string) {
-					SendLog(stepID, line)
-				})
-				if result.Error != nil {
-					return wrapStepError("terminal", "Install Alacritty",
-						"Failed to install build dependencies",
-						result.Error)
-				}
-				// Install Rust/Cargo only for this build
-				cargoPath := filepath.Join(homeDir, ".cargo/bin/cargo")
-				if !system.CommandExists("cargo") && !system.CommandExists(cargoPath) {
-					SendLog(stepID, "Installing Rust/Cargo toolchain...")
-					result = system.RunWithLogs("curl --proto '=https' --tlsv1.2 -sSf https://sh.rustup.rs | sh -s -- -y", nil, func(line string) {
-						SendLog(stepID, line)
-					})
-					if result.Error != nil {
-						return wrapStepError("terminal", "Install Alacritty",
-							"Failed to install Rust",
-							result.Error)
-					}
-					cargoPath = filepath.Join(homeDir, ".cargo/bin/cargo")
-				}
-				// Clone and build Alacritty
-				SendLog(stepID, "Cloning Alacritty repository...")
-				alacrittyDir := filepath.Join(os.TempDir(), "alacritty-build")
-				os.RemoveAll(alacrittyDir)
-				result = system.RunWithLogs(fmt.Sprintf("git clone https://github.com/alacritty/alacritty.git %s", alacrittyDir), nil, func(line string) {
-					SendLog(stepID, line)
-				})
-				if result.Error != nil {
-					return wrapStepError("terminal", "Install Alacritty",
-						"Failed to clone Alacritty repository",
-						result.Error)
-				}
-				SendLog(stepID, "Building Alacritty (this may take 5-10 minutes)...")
-				if !system.CommandExists("cargo") {
-					cargoPath = filepath.Join(homeDir, ".cargo/bin/cargo")
-				} else {
-					cargoPath = "cargo"
-				}
-				result = system.RunWithLogs(fmt.Sprintf("%s build --release --manifest-path %s/Cargo.toml", cargoPath, alacrittyDir), nil, func(line string) {
-					SendLog(stepID, line)
-				})
-				if result.Error != nil {
-					return wrapStepError("terminal", "Install Alacritty",
-						"Failed to build Alacritty",
-						result.Error)
-				}
-				SendLog(stepID, "Installing Alacritty binary...")
-				result = system.RunSudoWithLogs(fmt.Sprintf("cp %s/target/release/alacritty /usr/local/bin/alacritty", alacrittyDir), nil, func(line string) {
-					SendLog(stepID, line)
-				})
-				if result.Error != nil {
-					return wrapStepError("terminal", "Install Alacritty",
-						"Failed to install Alacritty binary",
-						result.Error)
-				}
-				system.RunSudoWithLogs(fmt.Sprintf("cp %s/extra/linux/Alacritty.desktop /usr/share/applications/", alacrittyDir), nil, func(line string) {
-					SendLog(stepID, line)
-				})
-				os.RemoveAll(alacrittyDir)
-				SendLog(stepID, "✓ Alacritty built and installed from source")
-			} else {
-				return wrapStepError("terminal", "Install Alacritty",
-					"Unsupported operating system for Alacritty installation",
-					fmt.Errorf("OS type: %v", m.SystemInfo.OS))
-			}
-			if result.Error != nil {
-				return wrapStepError("terminal", "Install Alacritty",
-					"Failed to install Alacritty terminal emulator",
-					result.Error)
-			}
-		} else {
-			SendLog(stepID, "Alacritty already installed")
-		}
-		SendLog(stepID, "Copying Alacritty configuration...")
-		if err := system.EnsureDir(filepath.Join(homeDir, ".config/alacritty")); err != nil {
-			return wrapStepError("terminal", "Install Alacritty",
-				"Failed to create Alacritty config directory",
-				err)
-		}
-		if err := system.CopyFile(filepath.Join(repoDir, "alacritty.toml"), filepath.Join(homeDir, ".config/alacritty/alacritty.toml")); err != nil {
-			return wrapStepError("terminal", "Install Alacritty",
-				"Failed to copy Alacritty configuration",
-				err)
-		}
-		SendLog(stepID, "✓ Alacritty configured")
-
-	case "wezterm":
-		if !system.CommandExists("wezterm") {
-			SendLog(stepID, "Installing WezTerm...")
-			var result *system.ExecResult
-			if m.SystemInfo.OS == system.OSArch {
-				result = system.RunSudoWithLogs("pacman -S --noconfirm wezterm", nil, func(line string) {
-					SendLog(stepID, line)
-				})
-			} else if m.SystemInfo.OS == system.OSFedora {
-				// Fedora: enable COPR and install
-				system.RunSudo("dnf copr enable -y wezfurlong/wezterm-nightly", nil)
-				result = system.RunSudoWithLogs("dnf install -y wezterm", nil, func(line string) {
-					SendLog(stepID, line)
-				})
-			} else if m.SystemInfo.OS == system.OSMac {
-				result = system.RunBrewWithLogs("install --cask wezterm", nil, func(line string) {
-					SendLog(stepID, line)
-				})
-			} else {
-				system.Run("brew tap wez/wezterm-linuxbrew", nil)
-				result = system.RunBrewWithLogs("install wezterm", nil, func(line string) {
-					SendLog(stepID, line)
-				})
-			}
-			if result.Error != nil {
-				return wrapStepError("terminal", "Install WezTerm",
-					"Failed to install WezTerm terminal emulator",
-					result.Error)
-			}
-		} else {
-			SendLog(stepID, "WezTerm already installed")
-		}
-		SendLog(stepID, "Copying WezTerm configuration...")
-		if err := system.EnsureDir(filepath.Join(homeDir, ".config/wezterm")); err != nil {
-			return wrapStepError("terminal", "Install WezTerm",
-				"Failed to create WezTerm config directory",
-				err)
-		}
-		if err := system.CopyFile(filepath.Join(repoDir, ".wezterm.lua"), filepath.Join(homeDir, ".config/wezterm/wezterm.lua")); err != nil {
-			return wrapStepError("terminal", "Install WezTerm",
-				"Failed to copy WezTerm configuration",
-				err)
-		}
-		SendLog(stepID, "✓ WezTerm configured")
-
-	case "kitty":
-		if !system.CommandExists("kitty") && m.SystemInfo.OS == system.OSMac {
-			SendLog(stepID, "Installing Kitty...")
-			result := system.RunBrewWithLogs("install --cask kitty", nil, func(line string) {
-				SendLog(stepID, line)
-			})
-			if result.Error != nil {
-				return wrapStepError("terminal", "Install Kitty",
-					"Failed to install Kitty terminal emulator",
-					result.Error)
-			}
-		} else {
-			SendLog(stepID, "Kitty already installed")
-		}
-		SendLog(stepID, "Copying Kitty configuration...")
-		if err := system.EnsureDir(filepath.Join(homeDir, ".config/kitty")); err != nil {
-			return wrapStepError("terminal", "Install Kitty",
-				"Failed to create Kitty config directory",
-				err)
-		}
-		if err := system.CopyDir(filepath.Join(repoDir, "GentlemanKitty"), filepath.Join(homeDir, ".config", "kitty")); err != nil {
-			return wrapStepError("terminal", "Install Kitty",
-				"Failed to copy Kitty configuration",
-				err)
-		}
-		SendLog(stepID, "✓ Kitty configured")
-
-	case "ghostty":
-		if !system.CommandExists("ghostty") {
-			SendLog(stepID, "Installing Ghostty...")
-			var result *system.ExecResult
-			if m.SystemInfo.OS == system.OSArch {
-				result = system.RunSudoWithLogs("pacman -S --noconfirm ghostty", nil, func(line string) {
-					SendLog(stepID, line)
-				})
-			} else if m.SystemInfo.OS == system.OSFedora {
-				// Fedora: enable COPR and install
-				system.RunSudo("dnf copr enable -y pgdev/ghostty", nil)
-				result = system.RunSudoWithLogs("dnf install -y ghostty", nil, func(line string) {
-					SendLog(stepID, line)
-				})
-			} else if m.SystemInfo.OS == system.OSMac {
-				result = system.RunBrewWithLogs("install --cask ghostty", nil, func(line string) {
-					SendLog(stepID, line)
-				})
-			} else {
-				result = system.RunWithLogs(`/bin/bash -c "$(curl -fsSL https://raw.githubusercontent.com/mkasberg/ghostty-ubuntu/HEAD/install.sh)"`, nil, func(line string) {
-					SendLog(stepID, line)
-				})
-			}
-			if result.Error != nil {
-				return wrapStepError("terminal", "Install Ghostty",
-					"Failed to install Ghostty terminal emulator",
-					result.Error)
-			}
-		} else {
-			SendLog(stepID, "Ghostty already installed")
-		}
-		SendLog(stepID, "Copying Ghostty configuration...")
-		if err := system.EnsureDir(filepath.Join(homeDir, ".config/ghostty")); err != nil {
-			return wrapStepError("terminal", "Install Ghostty",
-				"Failed to create Ghostty config directory",
-				err)
-		}
-		if err := system.CopyDir(filepath.Join(repoDir, "GentlemanGhostty"), filepath.Join(homeDir, ".config", "ghostty")); err != nil {
-			return wrapStepError("terminal", "Install Ghostty",
-				"Failed to copy Ghostty configuration",
-				err)
-		}
-		SendLog(stepID, "✓ Ghostty configured")
-	}
-
-	return nil
-}
-
-func stepInstallFont(m *Model) error {
-	homeDir := os.Getenv("HOME")
-	stepID := "font"
-
-	// Termux: fonts work differently - copy to ~/.termux/font.ttf
-	isTermux := m.SystemInfo.IsTermux || m.Choices.OS == "termux"
-	if isTermux {
-		SendLog(stepID, "Downloading JetBrainsMono Nerd Font for Termux...")
-		termuxDir := filepath.Join(homeDir, ".termux")
-		if err := system.EnsureDir(termuxDir); err != nil {
-			return wrapStepError("font", "Install Nerd Font",
-				"Failed to create .termux directory",
-				err)
-		}
-
-		// Download a single TTF file for Termux
-		result := system.RunWithLogs(fmt.Sprintf("curl -fsSL -o %s/font.ttf https://github.com/ryanoasis/nerd-fonts/raw/HEAD/patched-fonts/JetBrainsMono/Ligatures/Regular/JetBrainsMonoNerdFont-Regular.ttf", termuxDir), nil, func(line string) {
-			SendLog(stepID, line)
-		})
-		if result.Error != nil {
-			return wrapStepError("font", "Install Nerd Font",
-				"Failed to download font. Check your internet connection.",
-				result.Error)
-		}
-
-		SendLog(stepID, "Reloading Termux settings...")
-		system.Run("termux-reload-settings", nil)
-		SendLog(stepID, "✓ Font installed - restart Termux to apply")
-		return nil
-	}
-
-	if m.SystemInfo.OS == system.OSMac {
-		SendLog(stepID, "Installing Iosevka Term Nerd Font...")
-		result := system.RunBrewWithLogs("install --cask font-iosevka-term-nerd-font", nil, func(line string) {
-			SendLog(stepID, line)
-		})
-		if result.Error != nil {
-			return wrapStepError("font", "Install Iosevka Nerd Font",
-				"Failed to install font via Homebrew. Try installing manually from https://www.nerdfonts.com/",
-				result.Error)
-		}
-		SendLog(stepID, "✓ Font installed")
-		return nil
-	}
-
-	// Linux
-	fontDir := filepath.Join(homeDir, ".local/share/fonts")
-	SendLog(stepID, "Creating fonts directory...")
-	if err := system.EnsureDir(fontDir); err != nil {
-		return wrapStepError("font", "Install Iosevka Nerd Font",
-			"Failed to create fonts directory",
-			err)
-	}
-
-	SendLog(stepID, "Downloading Iosevka Term Nerd Font...")
-	result := system.RunWithLogs(fmt.Sprintf("curl -fsSL -o %s/IosevkaTerm.zip https://github.com/ryanoasis/nerd-fonts/releases/download/v3.3.0/IosevkaTerm.zip", fontDir), nil, func(line string) {
+// stepRebuildFontCache refreshes fontconfig so already-installed fonts are picked up
+func stepRebuildFontCache(m *Model) error {
+	stepID := "fccache"
+	SendLog(stepID, "Rebuilding font cache...")
+	result := system.RunWithLogs(m.installContext(), "fc-cache -fv", nil, func(line string) {
 		SendLog(stepID, line)
 	})
 	if result.Error != nil {
-		return wrapStepError("font", "Install Iosevka Nerd Font",
-			"Failed to download font. Check your internet connection.",
+		return wrapStepError("fccache", "Rebuild font cache",
+			"fc-cache failed. Is fontconfig installed?",
 			result.Error)
 	}
+	SendLog(stepID, "✓ Font cache rebuilt")
+	return nil
+}
 
-	SendLog(stepID, "Extracting font archive...")
-	result = system.RunWithLogs(fmt.Sprintf("unzip -o %s/IosevkaTerm.zip -d %s/", fontDir, fontDir), nil, func(line string) {
+// stepSyncNvimPlugins installs and updates Neovim plugins headlessly
+func stepSyncNvimPlugins(m *Model) error {
+	stepID := "nvim-sync"
+	SendLog(stepID, "Syncing Neovim plugins (this can take a minute)...")
+	result := system.RunWithLogs(m.installContext(), `nvim --headless "+Lazy! sync" +qa`, nil, func(line string) {
 		SendLog(stepID, line)
 	})
 	if result.Error != nil {
-		return wrapStepError("font", "Install Iosevka Nerd Font",
-			"Failed to extract font archive",
+		return wrapStepError("nvim-sync", "Sync Neovim plugins",
+			"Lazy sync failed. Open nvim and run :Lazy to see details.",
 			result.Error)
 	}
+	m.UpdateSummary.PluginsSynced = true
+	SendLog(stepID, "✓ Neovim plugins synced")
+	return nil
+}
 
-	SendLog(stepID, "Updating font cache...")
-	system.RunWithLogs("fc-cache -fv", nil, func(line string) {
-		SendLog(stepID, line)
-	})
-	SendLog(stepID, "✓ Font installed")
+// stepRedeployNvim copies the repo's Neovim config over ~/.config/nvim
+// without reinstalling Neovim or its dependencies
+func stepRedeployNvim(m *Model) error {
+	stepID := "redeploy-nvim"
+	nvimDir := filepath.Join(os.Getenv("HOME"), ".config/nvim")
+	// The copy overwrites the user's edits, so keep them in a restorable backup
+	if entries, err := os.ReadDir(nvimDir); err == nil && len(entries) > 0 {
+		SendLog(stepID, "Backing up the current Neovim config...")
+		backupDir, err := system.CreateBackup([]string{"nvim"})
+		if err != nil {
+			return wrapStepError("redeploy-nvim", "Re-deploy Neovim config",
+				"Failed to back up the current Neovim config, nothing was overwritten",
+				err)
+		}
+		m.BackupDir = backupDir
+		SendLog(stepID, "✓ Backup created at: "+backupDir)
+	}
+	SendLog(stepID, "Copying Neovim configuration...")
+	if err := system.EnsureDir(nvimDir); err != nil {
+		return wrapStepError("redeploy-nvim", "Re-deploy Neovim config",
+			"Failed to create Neovim config directory",
+			err)
+	}
+	if err := system.DeployConfig(filepath.Join(m.RepoDir, "GentlemanNvim", "nvim"), nvimDir); err != nil {
+		return wrapStepError("redeploy-nvim", "Re-deploy Neovim config",
+			"Failed to copy Neovim configuration",
+			err)
+	}
+	SendLog(stepID, "✓ Neovim config re-deployed")
 	return nil
 }
 
+// stepRedeployTmux re-runs the tmux part of the WM step, which skips the
+// package install when tmux is already present
+func stepRedeployTmux(m *Model) error {
+	m.Choices.WindowMgr = "tmux"
+	if m.Choices.Shell == "" {
+		// Keep tmux's default-shell pointing at the current login shell
+		if shell, ok := managedShells[filepath.Base(os.Getenv("SHELL"))]; ok {
+			m.Choices.Shell = shell
+		}
+	}
+	spec, _ := lookupStepSpec("wm")
+	return runDeclarativeStep(spec, m)
+}
+
 func stepInstallShell(m *Model) error {
 	homeDir := os.Getenv("HOME")
 	repoDir := m.RepoDir
@@ -625,11 +447,16 @@ func stepInstallShell(m *Model) error {
 		SendLog(stepID, "Installing Fish shell and plugins...")
 		var result *system.ExecResult
 		if m.SystemInfo.IsTermux {
-			result = system.RunPkgInstall("fish starship zoxide", nil, func(line string) {
+			result = system.RunPkgInstall(m.installContext(), "fish starship zoxide", nil, func(line string) {
 				SendLog(stepID, line)
 			})
+		} else if m.Choices.NativePackages {
+			result = runNativeInstall(m, stepID, map[string]string{
+				"linux":  "fish zoxide",
+				"pacman": "fish zoxide starship atuin",
+			})
 		} else {
-			result = system.RunBrewWithLogs("install fish carapace zoxide atuin starship", nil, func(line string) {
+			result = system.RunBrewWithLogs(m.installContext(), "install fish carapace zoxide atuin starship", nil, func(line string) {
 				SendLog(stepID, line)
 			})
 		}
@@ -639,12 +466,12 @@ func stepInstallShell(m *Model) error {
 				result.Error)
 		}
 		SendLog(stepID, "Copying Fish configuration...")
-		if err := system.CopyFile(filepath.Join(repoDir, "starship.toml"), filepath.Join(homeDir, ".config/starship.toml")); err != nil {
+		if err := system.DeployConfig(filepath.Join(repoDir, "starship.toml"), filepath.Join(homeDir, ".config/starship.toml")); err != nil {
 			return wrapStepError("shell", "Install Fish",
 				"Failed to copy starship configuration",
 				err)
 		}
-		if err := system.CopyDir(filepath.Join(repoDir, "GentlemanFish", "fish"), filepath.Join(homeDir, ".config", "fish")); err != nil {
+		if err := system.DeployConfig(filepath.Join(repoDir, "GentlemanFish", "fish"), filepath.Join(homeDir, ".config", "fish")); err != nil {
 			return wrapStepError("shell", "Install Fish",
 				"Failed to copy Fish configuration",
 				err)
@@ -682,11 +509,16 @@ func stepInstallShell(m *Model) error {
 		var result *system.ExecResult
 		if m.SystemInfo.IsTermux {
 			// Termux has zsh in pkg, but plugins need to be installed differently
-			result = system.RunPkgInstall("zsh starship zoxide", nil, func(line string) {
+			result = system.RunPkgInstall(m.installContext(), "zsh starship zoxide", nil, func(line string) {
 				SendLog(stepID, line)
 			})
+		} else if m.Choices.NativePackages {
+			result = runNativeInstall(m, stepID, map[string]string{
+				"linux":  "zsh zoxide zsh-autosuggestions zsh-syntax-highlighting",
+				"pacman": "zsh zoxide zsh-autosuggestions zsh-syntax-highlighting starship atuin",
+			})
 		} else {
-			result = system.RunBrewWithLogs("install zsh carapace zoxide atuin zsh-autosuggestions zsh-syntax-highlighting zsh-autocomplete powerlevel10k", nil, func(line string) {
+			result = system.RunBrewWithLogs(m.installContext(), "install zsh carapace zoxide atuin zsh-autosuggestions zsh-syntax-highlighting zsh-autocomplete powerlevel10k", nil, func(line string) {
 				SendLog(stepID, line)
 			})
 		}
@@ -695,28 +527,50 @@ func stepInstallShell(m *Model) error {
 				"Failed to install Zsh and plugins",
 				result.Error)
 		}
+		// Merge mode installs the config beside the user's .zshrc and sources it from a managed block
+		zshConfig := filepath.Join(homeDir, ".zshrc")
+		if m.Choices.ZshMerge {
+			zshConfig = filepath.Join(homeDir, system.ZshMergeConfigPath)
+			system.EnsureDir(filepath.Dir(zshConfig))
+		}
+		// Native installs get none of Oh My Zsh, Powerlevel10k or carapace, so
+		// they get the minimal config that only loads what is installed
+		zshSource := "GentlemanZsh/.zshrc"
+		if m.Choices.NativePackages {
+			zshSource = serverZshrc
+		}
 		SendLog(stepID, "Copying Zsh configuration...")
-		if err := system.CopyFile(filepath.Join(repoDir, "GentlemanZsh/.zshrc"), filepath.Join(homeDir, ".zshrc")); err != nil {
+		if err := system.DeployConfig(filepath.Join(repoDir, zshSource), zshConfig); err != nil {
 			return wrapStepError("shell", "Install Zsh",
 				"Failed to copy .zshrc configuration",
 				err)
 		}
+		if m.Choices.ZshMerge {
+			SendLog(stepID, "Merging managed block into existing .zshrc...")
+			if err := system.MergeZshrc(filepath.Join(homeDir, ".zshrc")); err != nil {
+				return wrapStepError("shell", "Install Zsh",
+					"Failed to merge managed block into .zshrc",
+					err)
+			}
+		}
 		// Patch .zshrc based on WM choice
 		SendLog(stepID, "Configuring shell for window manager...")
-		if err := system.PatchZshForWM(filepath.Join(homeDir, ".zshrc"), m.Choices.WindowMgr, m.Choices.InstallNvim); err != nil {
+		if err := system.PatchZshForWM(zshConfig, m.Choices.WindowMgr, m.Choices.InstallNvim); err != nil {
 			return wrapStepError("shell", "Install Zsh",
 				"Failed to configure .zshrc for window manager",
 				err)
 		}
-		if err := system.CopyFile(filepath.Join(repoDir, "GentlemanZsh/.p10k.zsh"), filepath.Join(homeDir, ".p10k.zsh")); err != nil {
-			return wrapStepError("shell", "Install Zsh",
-				"Failed to copy Powerlevel10k configuration",
-				err)
-		}
-		if err := system.CopyDir(filepath.Join(repoDir, "GentlemanZsh", ".oh-my-zsh"), filepath.Join(homeDir, ".oh-my-zsh")); err != nil {
-			return wrapStepError("shell", "Install Zsh",
-				"Failed to copy Oh-My-Zsh directory",
-				err)
+		if !m.Choices.NativePackages {
+			if err := system.DeployConfig(filepath.Join(repoDir, "GentlemanZsh/.p10k.zsh"), filepath.Join(homeDir, ".p10k.zsh")); err != nil {
+				return wrapStepError("shell", "Install Zsh",
+					"Failed to copy Powerlevel10k configuration",
+					err)
+			}
+			if err := system.DeployConfig(filepath.Join(repoDir, "GentlemanZsh", ".oh-my-zsh"), filepath.Join(homeDir, ".oh-my-zsh")); err != nil {
+				return wrapStepError("shell", "Install Zsh",
+					"Failed to copy Oh-My-Zsh directory",
+					err)
+			}
 		}
 		// Termux: Add zsh to $PREFIX/etc/shells so tmux doesn't complain
 		if m.SystemInfo.IsTermux {
@@ -733,17 +587,25 @@ func stepInstallShell(m *Model) error {
 				f.Close()
 			}
 		}
-		SendLog(stepID, "✓ Zsh configured with Powerlevel10k")
+		if m.Choices.NativePackages {
+			SendLog(stepID, "✓ Zsh configured with the minimal server config")
+		} else {
+			SendLog(stepID, "✓ Zsh configured with Powerlevel10k")
+		}
 
 	case "nushell":
 		SendLog(stepID, "Installing Nushell and dependencies...")
 		var result *system.ExecResult
 		if m.SystemInfo.IsTermux {
-			result = system.RunPkgInstall("nushell starship zoxide jq", nil, func(line string) {
+			result = system.RunPkgInstall(m.installContext(), "nushell starship zoxide jq", nil, func(line string) {
 				SendLog(stepID, line)
 			})
+		} else if m.Choices.NativePackages {
+			result = runNativeInstall(m, stepID, map[string]string{
+				"pacman": "nushell zoxide starship atuin jq bash",
+			})
 		} else {
-			result = system.RunBrewWithLogs("install nushell carapace zoxide atuin jq bash starship", nil, func(line string) {
+			result = system.RunBrewWithLogs(m.installContext(), "install nushell carapace zoxide atuin jq bash starship", nil, func(line string) {
 				SendLog(stepID, line)
 			})
 		}
@@ -753,17 +615,17 @@ func stepInstallShell(m *Model) error {
 				result.Error)
 		}
 		SendLog(stepID, "Copying Nushell configuration...")
-		if err := system.CopyFile(filepath.Join(repoDir, "starship.toml"), filepath.Join(homeDir, ".config/starship.toml")); err != nil {
+		if err := system.DeployConfig(filepath.Join(repoDir, "starship.toml"), filepath.Join(homeDir, ".config/starship.toml")); err != nil {
 			return wrapStepError("shell", "Install Nushell",
 				"Failed to copy starship configuration",
 				err)
 		}
-		if err := system.CopyFile(filepath.Join(repoDir, "bash-env-json"), filepath.Join(homeDir, ".config/bash-env-json")); err != nil {
+		if err := system.DeployConfig(filepath.Join(repoDir, "bash-env-json"), filepath.Join(homeDir, ".config/bash-env-json")); err != nil {
 			return wrapStepError("shell", "Install Nushell",
 				"Failed to copy bash-env-json",
 				err)
 		}
-		if err := system.CopyFile(filepath.Join(repoDir, "bash-env.nu"), filepath.Join(homeDir, ".config/bash-env.nu")); err != nil {
+		if err := system.DeployConfig(filepath.Join(repoDir, "bash-env.nu"), filepath.Join(homeDir, ".config/bash-env.nu")); err != nil {
 			return wrapStepError("shell", "Install Nushell",
 				"Failed to copy bash-env.nu",
 				err)
@@ -780,7 +642,7 @@ func stepInstallShell(m *Model) error {
 				"Failed to create Nushell config directory",
 				err)
 		}
-		if err := system.CopyDir(filepath.Join(repoDir, "GentlemanNushell"), nuDir); err != nil {
+		if err := system.DeployConfig(filepath.Join(repoDir, "GentlemanNushell"), nuDir); err != nil {
 			return wrapStepError("shell", "Install Nushell",
 				"Failed to copy Nushell configuration",
 				err)
@@ -813,176 +675,142 @@ func stepInstallShell(m *Model) error {
 	return nil
 }
 
-func stepInstallWM(m *Model) error {
+// configureTmux finishes the tmux deploy: TPM and the plugins of the picked
+// extras, the plugin list in tmux.conf and the default shell
+func configureTmux(m *Model) error {
 	homeDir := os.Getenv("HOME")
-	repoDir := m.RepoDir
-	wm := m.Choices.WindowMgr
 	stepID := "wm"
 
-	switch wm {
-	case "tmux":
-		if !system.CommandExists("tmux") {
-			SendLog(stepID, "Installing Tmux...")
-			var result *system.ExecResult
-			if m.SystemInfo.IsTermux {
-				result = system.RunPkgInstall("tmux", nil, func(line string) {
-					SendLog(stepID, line)
-				})
-			} else {
-				result = system.RunBrewWithLogs("install tmux", nil, func(line string) {
-					SendLog(stepID, line)
-				})
-			}
-			if result.Error != nil {
-				return wrapStepError("wm", "Install Tmux",
-					"Failed to install Tmux",
-					result.Error)
-			}
-		} else {
-			SendLog(stepID, "Tmux already installed")
-		}
-
-		// TPM
-		tpmDir := filepath.Join(homeDir, ".tmux/plugins/tpm")
-		if _, err := os.Stat(tpmDir); os.IsNotExist(err) {
-			SendLog(stepID, "Cloning TPM (Tmux Plugin Manager)...")
-			result := system.RunWithLogs(fmt.Sprintf("git clone https://github.com/tmux-plugins/tpm %s", tpmDir), nil, func(line string) {
-				SendLog(stepID, line)
-			})
-			if result.Error != nil {
-				return wrapStepError("wm", "Install Tmux",
-					"Failed to clone TPM (Tmux Plugin Manager)",
-					result.Error)
-			}
-		}
-
-		SendLog(stepID, "Copying Tmux configuration...")
-		if err := system.EnsureDir(filepath.Join(homeDir, ".tmux")); err != nil {
+	// TPM is only needed for plugin extras
+	extras := selectedWMExtras("tmux", m.Choices.WMExtras)
+	tpmDir := filepath.Join(homeDir, ".tmux/plugins/tpm")
+	if _, err := os.Stat(tpmDir); len(extras) > 0 && os.IsNotExist(err) {
+		SendLog(stepID, "Cloning TPM (Tmux Plugin Manager)...")
+		result := system.RunWithLogs(m.installContext(), fmt.Sprintf("git clone https://github.com/tmux-plugins/tpm %s", tpmDir), nil, func(line string) {
+			SendLog(stepID, line)
+		})
+		if result.Error != nil {
 			return wrapStepError("wm", "Install Tmux",
-				"Failed to create .tmux directory",
-				err)
+				"Failed to clone TPM (Tmux Plugin Manager)",
+				result.Error)
 		}
-		if err := system.CopyDir(filepath.Join(repoDir, "GentlemanTmux", "plugins"), filepath.Join(homeDir, ".tmux", "plugins")); err != nil {
+	}
+	if len(extras) > 0 {
+		if err := system.DeployConfig(filepath.Join(m.RepoDir, "GentlemanTmux", "plugins"), filepath.Join(homeDir, ".tmux", "plugins")); err != nil {
 			return wrapStepError("wm", "Install Tmux",
 				"Failed to copy Tmux plugins",
 				err)
 		}
-		if err := system.CopyFile(filepath.Join(repoDir, "GentlemanTmux/tmux.conf"), filepath.Join(homeDir, ".tmux.conf")); err != nil {
-			return wrapStepError("wm", "Install Tmux",
-				"Failed to copy tmux.conf",
-				err)
-		}
+	}
 
-		// Configure tmux to use the user's chosen shell
-		SendLog(stepID, "Configuring tmux default shell...")
-		tmuxConfPath := filepath.Join(homeDir, ".tmux.conf")
-		shellName := ""
-		switch m.Choices.Shell {
-		case "fish":
-			shellName = "fish"
-		case "zsh":
-			shellName = "zsh"
-		case "nushell":
-			shellName = "nu"
-		}
-		if shellName != "" {
-			// Find the full path to the shell
-			shellFullPath := ""
-			if m.SystemInfo.IsTermux {
-				// In Termux, construct the path directly (which command has issues)
-				prefix := os.Getenv("PREFIX")
-				if prefix == "" {
-					prefix = "/data/data/com.termux/files/usr"
-				}
-				shellFullPath = filepath.Join(prefix, "bin", shellName)
-			} else {
-				result := system.Run(fmt.Sprintf("which %s", shellName), nil)
-				if result.Error == nil && result.Output != "" {
-					shellFullPath = strings.TrimSpace(result.Output)
-				}
+	SendLog(stepID, "Extras: "+strings.Join(wmExtraIDs(extras), ", "))
+	tmuxConfPath := filepath.Join(homeDir, ".tmux.conf")
+	if err := templateConfigFile(tmuxConfPath, func(content string) string {
+		return templateTmuxConf(content, extras)
+	}); err != nil {
+		return wrapStepError("wm", "Install Tmux",
+			"Failed to write the plugin list to tmux.conf",
+			err)
+	}
+
+	// Configure tmux to use the user's chosen shell
+	SendLog(stepID, "Configuring tmux default shell...")
+	if shellName := wmShellCommand(m.Choices.Shell); shellName != "" {
+		// Find the full path to the shell
+		shellFullPath := ""
+		if m.SystemInfo.IsTermux {
+			// In Termux, construct the path directly (which command has issues)
+			prefix := os.Getenv("PREFIX")
+			if prefix == "" {
+				prefix = "/data/data/com.termux/files/usr"
 			}
-			if shellFullPath == "" {
-				shellFullPath = shellName // Fallback
+			shellFullPath = filepath.Join(prefix, "bin", shellName)
+		} else {
+			result := system.Run(m.installContext(), fmt.Sprintf("which %s", shellName), nil)
+			if result.Error == nil && result.Output != "" {
+				shellFullPath = strings.TrimSpace(result.Output)
 			}
+		}
+		if shellFullPath == "" {
+			shellFullPath = shellName // Fallback
+		}
 
-			// Replace placeholder in tmux.conf with actual shell config
-			content, err := os.ReadFile(tmuxConfPath)
-			if err == nil {
-				shellConfig := fmt.Sprintf("set -g default-command \"%s\"\nset -g default-shell \"%s\"", shellFullPath, shellFullPath)
-				newContent := strings.Replace(string(content), "# GENTLEMAN_DEFAULT_SHELL", shellConfig, 1)
-				os.WriteFile(tmuxConfPath, []byte(newContent), 0644)
-			}
+		// Replace placeholder in tmux.conf with actual shell config
+		content, err := os.ReadFile(tmuxConfPath)
+		if err == nil {
+			shellConfig := fmt.Sprintf("set -g default-command \"%s\"\nset -g default-shell \"%s\"", shellFullPath, shellFullPath)
+			newContent := strings.Replace(string(content), "# GENTLEMAN_DEFAULT_SHELL", shellConfig, 1)
+			os.WriteFile(tmuxConfPath, []byte(newContent), 0644)
 		}
+	}
 
-		// Install plugins
+	// Install plugins
+	if len(extras) > 0 {
 		SendLog(stepID, "Installing Tmux plugins...")
-		system.RunWithLogs(filepath.Join(homeDir, ".tmux/plugins/tpm/bin/install_plugins"), nil, func(line string) {
+		system.RunWithLogs(m.installContext(), filepath.Join(homeDir, ".tmux/plugins/tpm/bin/install_plugins"), nil, func(line string) {
 			SendLog(stepID, line)
 		})
-		SendLog(stepID, "✓ Tmux configured")
-
-	case "zellij":
-		if !system.CommandExists("zellij") {
-			SendLog(stepID, "Installing Zellij...")
-			var result *system.ExecResult
-			if m.SystemInfo.IsTermux {
-				result = system.RunPkgInstall("zellij", nil, func(line string) {
-					SendLog(stepID, line)
-				})
-			} else {
-				result = system.RunBrewWithLogs("install zellij", nil, func(line string) {
-					SendLog(stepID, line)
-				})
-			}
-			if result.Error != nil {
-				return wrapStepError("wm", "Install Zellij",
-					"Failed to install Zellij",
-					result.Error)
-			}
-		} else {
-			SendLog(stepID, "Zellij already installed")
-		}
+	}
+	return nil
+}
 
-		SendLog(stepID, "Copying Zellij configuration...")
-		zellijDir := filepath.Join(homeDir, ".config/zellij")
-		if err := system.EnsureDir(zellijDir); err != nil {
-			return wrapStepError("wm", "Install Zellij",
-				"Failed to create Zellij config directory",
-				err)
-		}
-		if err := system.CopyDir(filepath.Join(repoDir, "GentlemanZellij", "zellij"), zellijDir); err != nil {
-			return wrapStepError("wm", "Install Zellij",
-				"Failed to copy Zellij configuration",
-				err)
-		}
+// configureZellij finishes the Zellij deploy: the layouts and plugins of the
+// picked extras and the default shell
+func configureZellij(m *Model) error {
+	stepID := "wm"
+	zellijDir := filepath.Join(os.Getenv("HOME"), ".config/zellij")
 
-		// Configure zellij to use the user's chosen shell
-		SendLog(stepID, "Configuring zellij default shell...")
-		zellijConfPath := filepath.Join(zellijDir, "config.kdl")
-		shellPath := ""
-		switch m.Choices.Shell {
-		case "fish":
-			shellPath = "fish"
-		case "zsh":
-			shellPath = "zsh"
-		case "nushell":
-			shellPath = "nu"
-		}
-		if shellPath != "" {
-			// Append default_shell config to zellij config.kdl
-			f, err := os.OpenFile(zellijConfPath, os.O_APPEND|os.O_WRONLY, 0644)
-			if err == nil {
-				f.WriteString(fmt.Sprintf("\n// Default shell (configured by Gentleman.Dots)\ndefault_shell \"%s\"\n", shellPath))
-				f.Close()
-			}
+	// config.kdl is deployed with the step; layouts and plugins only for the picked extras
+	extras := selectedWMExtras("zellij", m.Choices.WMExtras)
+	srcDir := filepath.Join(m.RepoDir, "GentlemanZellij", "zellij")
+	var deploys []system.ConfigDeploy
+	for _, extra := range extras {
+		for _, file := range extra.Files {
+			deploys = append(deploys, system.ConfigDeploy{Src: filepath.Join(srcDir, file), Dst: filepath.Join(zellijDir, file)})
 		}
-		SendLog(stepID, "✓ Zellij configured")
+	}
+	if err := system.DeployConfigs(deploys...); err != nil {
+		return wrapStepError("wm", "Install Zellij",
+			"Failed to copy Zellij configuration",
+			err)
+	}
+	SendLog(stepID, "Extras: "+strings.Join(wmExtraIDs(extras), ", "))
+	if err := templateZellijDir(zellijDir, extras); err != nil {
+		return wrapStepError("wm", "Install Zellij",
+			"Failed to apply the extras to the Zellij configuration",
+			err)
 	}
 
+	// Configure zellij to use the user's chosen shell
+	SendLog(stepID, "Configuring zellij default shell...")
+	if shellPath := wmShellCommand(m.Choices.Shell); shellPath != "" {
+		// Append default_shell config to zellij config.kdl
+		f, err := os.OpenFile(filepath.Join(zellijDir, "config.kdl"), os.O_APPEND|os.O_WRONLY, 0644)
+		if err == nil {
+			f.WriteString(fmt.Sprintf("\n// Default shell (configured by Gentleman.Dots)\ndefault_shell \"%s\"\n", shellPath))
+			f.Close()
+		}
+	}
 	return nil
 }
 
+// wmShellCommand is the command a multiplexer starts for shell, or "" to keep its default
+func wmShellCommand(shell string) string {
+	switch shell {
+	case "fish":
+		return "fish"
+	case "zsh":
+		return "zsh"
+	case "nushell":
+		return "nu"
+	}
+	return ""
+}
+
 func stepInstallNvim(m *Model) error {
+	if isWindowsChoice(m) {
+		return stepInstallNvimWindows(m)
+	}
 	homeDir := os.Getenv("HOME")
 	repoDir := m.RepoDir
 	stepID := "nvim"
@@ -993,19 +821,19 @@ func stepInstallNvim(m *Model) error {
 		var obsResult *system.ExecResult
 		switch m.SystemInfo.OS {
 		case system.OSMac:
-			obsResult = system.RunBrewWithLogs("install --cask obsidian", nil, func(line string) {
+			obsResult = system.RunBrewWithLogs(m.installContext(), "install --cask obsidian", nil, func(line string) {
 				SendLog(stepID, line)
 			})
 		case system.OSArch:
-			obsResult = system.RunSudoWithLogs("pacman -S --noconfirm obsidian", nil, func(line string) {
+			obsResult = system.RunSudoWithLogs(m.installContext(), "pacman -S --noconfirm obsidian", nil, func(line string) {
 				SendLog(stepID, line)
 			})
 		case system.OSDebian, system.OSLinux:
-			obsResult = system.RunWithLogs("flatpak install -y flathub md.obsidian.Obsidian", nil, func(line string) {
+			obsResult = system.RunWithLogs(m.installContext(), "flatpak install -y flathub md.obsidian.Obsidian", nil, func(line string) {
 				SendLog(stepID, line)
 			})
 		case system.OSFedora:
-			obsResult = system.RunWithLogs("flatpak install -y flathub md.obsidian.Obsidian", nil, func(line string) {
+			obsResult = system.RunWithLogs(m.installContext(), "flatpak install -y flathub md.obsidian.Obsidian", nil, func(line string) {
 				SendLog(stepID, line)
 			})
 		}
@@ -1028,11 +856,15 @@ func stepInstallNvim(m *Model) error {
 		SendLog(stepID, "Installing Node.js...")
 		var result *system.ExecResult
 		if m.SystemInfo.IsTermux {
-			result = system.RunPkgInstall("nodejs", nil, func(line string) {
+			result = system.RunPkgInstall(m.installContext(), "nodejs", nil, func(line string) {
 				SendLog(stepID, line)
 			})
+		} else if m.Choices.NativePackages {
+			result = runNativeInstall(m, stepID, map[string]string{
+				"linux": "nodejs npm",
+			})
 		} else {
-			result = system.RunBrewWithLogs("install node", nil, func(line string) {
+			result = system.RunBrewWithLogs(m.installContext(), "install node", nil, func(line string) {
 				SendLog(stepID, line)
 			})
 		}
@@ -1050,11 +882,16 @@ func stepInstallNvim(m *Model) error {
 	var result *system.ExecResult
 	if m.SystemInfo.IsTermux {
 		// Termux package names (neovim instead of nvim, clang instead of gcc)
-		result = system.RunPkgInstall("neovim git clang fzf fd ripgrep bat curl lazygit", nil, func(line string) {
+		result = system.RunPkgInstall(m.installContext(), "neovim git clang fzf fd ripgrep bat curl lazygit", nil, func(line string) {
 			SendLog(stepID, line)
 		})
+	} else if m.Choices.NativePackages {
+		result = runNativeInstall(m, stepID, map[string]string{
+			"linux":  "neovim git gcc fzf fd ripgrep bat curl",
+			"pacman": "neovim git gcc fzf fd ripgrep bat curl lazygit tree-sitter-cli",
+		})
 	} else {
-		result = system.RunBrewWithLogs("install nvim git gcc fzf fd ripgrep coreutils bat curl lazygit tree-sitter", nil, func(line string) {
+		result = system.RunBrewWithLogs(m.installContext(), "install nvim git gcc fzf fd ripgrep coreutils bat curl lazygit tree-sitter", nil, func(line string) {
 			SendLog(stepID, line)
 		})
 	}
@@ -1064,6 +901,15 @@ func stepInstallNvim(m *Model) error {
 			result.Error)
 	}
 
+	// Distro packages can lag far behind what the config needs
+	if m.Choices.NativePackages {
+		if version, ok := nvimTooOld(nvimVersionRunner); ok {
+			SendLog(stepID, fmt.Sprintf("⚠ Neovim %s is older than the %s the Gentleman config needs; skipping the config", version, minNvimVersion))
+			SendLog(stepID, "Install a newer Neovim from https://github.com/neovim/neovim/releases and run the installer again")
+			return nil
+		}
+	}
+
 	// Copy config
 	SendLog(stepID, "Copying Neovim configuration...")
 	nvimDir := filepath.Join(homeDir, ".config/nvim")
@@ -1074,7 +920,7 @@ func stepInstallNvim(m *Model) error {
 	}
 	// Copy nvim config directory
 	srcNvim := filepath.Join(repoDir, "GentlemanNvim", "nvim")
-	if err := system.CopyDir(srcNvim, nvimDir); err != nil {
+	if err := system.DeployConfig(srcNvim, nvimDir); err != nil {
 		return wrapStepError("nvim", "Install Neovim",
 			"Failed to copy Neovim configuration",
 			err)
@@ -1084,6 +930,29 @@ func stepInstallNvim(m *Model) error {
 	return nil
 }
 
+// minNvimVersion is the oldest Neovim the shipped LazyVim config runs on
+const minNvimVersion = "0.11.2"
+
+// nvimVersionRunner runs nvim --version; tests swap it
+var nvimVersionRunner CommandRunner = execRunner
+
+// nvimTooOld returns the installed Neovim version when it is older than
+// minNvimVersion. A version that can't be read doesn't count as too old.
+func nvimTooOld(run CommandRunner) (string, bool) {
+	out, err := run("nvim", "--version")
+	if err != nil {
+		return "", false
+	}
+	// "NVIM v0.9.5"
+	version := strings.TrimPrefix(strings.TrimPrefix(firstLine(out), "NVIM "), "v")
+	got, ok := system.ParseVersion(version)
+	if !ok {
+		return "", false
+	}
+	want, _ := system.ParseVersion(minNvimVersion)
+	return version, system.CompareVersions(got, want) < 0
+}
+
 func stepInstallZed(m *Model) error {
 	homeDir := os.Getenv("HOME")
 	repoDir := m.RepoDir
@@ -1101,19 +970,19 @@ func stepInstallZed(m *Model) error {
 		var result *system.ExecResult
 		switch m.SystemInfo.OS {
 		case system.OSMac:
-			result = system.RunBrewWithLogs("install --cask zed", nil, func(line string) {
+			result = system.RunBrewWithLogs(m.installContext(), "install --cask zed", nil, func(line string) {
 				SendLog(stepID, line)
 			})
 		case system.OSArch:
-			result = system.RunSudoWithLogs("pacman -S --noconfirm zed", nil, func(line string) {
+			result = system.RunSudoWithLogs(m.installContext(), "pacman -S --noconfirm zed", nil, func(line string) {
 				SendLog(stepID, line)
 			})
 		case system.OSDebian, system.OSLinux, system.OSFedora:
-			result = system.RunWithLogs("bash -c 'curl -f https://zed.dev/install.sh | sh'", nil, func(line string) {
+			result = system.RunWithLogs(m.installContext(), "bash -c 'curl -f https://zed.dev/install.sh | sh'", nil, func(line string) {
 				SendLog(stepID, line)
 			})
 		default:
-			result = system.RunWithLogs("bash -c 'curl -f https://zed.dev/install.sh | sh'", nil, func(line string) {
+			result = system.RunWithLogs(m.installContext(), "bash -c 'curl -f https://zed.dev/install.sh | sh'", nil, func(line string) {
 				SendLog(stepID, line)
 			})
 		}
@@ -1147,6 +1016,16 @@ func stepInstallZed(m *Model) error {
 	return nil
 }
 
+// aiToolPresent logs and reports whether tool's binary is already on PATH,
+// in which case its install is skipped and only the config is copied
+func aiToolPresent(stepID, tool, name string) bool {
+	if !aiToolCommandExists(system.AIToolBinary(tool)) {
+		return false
+	}
+	SendLog(stepID, "✓ "+name+" already installed, skipping its install")
+	return true
+}
+
 // hasAITool checks if a tool is in the selected AI tools list
 func hasAITool(tools []string, name string) bool {
 	for _, t := range tools {
@@ -1164,10 +1043,12 @@ func stepInstallAITools(m *Model) error {
 
 	// Install and configure Claude Code
 	if hasAITool(m.Choices.AITools, "claude") {
-		SendLog(stepID, "Installing Claude Code...")
-		system.RunWithLogs(`curl -fsSL https://claude.ai/install.sh | bash`, nil, func(line string) {
-			SendLog(stepID, line)
-		})
+		if !aiToolPresent(stepID, "claude", "Claude Code") {
+			SendLog(stepID, "Installing Claude Code...")
+			system.RunWithLogs(m.installContext(), aiToolInstallCommand(m, "claude", `curl -fsSL https://claude.ai/install.sh | bash`), nil, func(line string) {
+				SendLog(stepID, line)
+			})
+		}
 
 		SendLog(stepID, "Configuring Claude Code...")
 		claudeDir := filepath.Join(homeDir, ".claude")
@@ -1178,35 +1059,41 @@ func stepInstallAITools(m *Model) error {
 		system.CopyFile(filepath.Join(repoDir, "GentlemanClaude/CLAUDE.md"), filepath.Join(claudeDir, "CLAUDE.md"))
 		system.CopyFile(filepath.Join(repoDir, "GentlemanClaude/settings.json"), filepath.Join(claudeDir, "settings.json"))
 		system.CopyFile(filepath.Join(repoDir, "GentlemanClaude/statusline.sh"), filepath.Join(claudeDir, "statusline.sh"))
-		system.Run(fmt.Sprintf("chmod +x %s", filepath.Join(claudeDir, "statusline.sh")), nil)
+		if !isWindowsChoice(m) {
+			system.Run(m.installContext(), fmt.Sprintf("chmod +x %s", filepath.Join(claudeDir, "statusline.sh")), nil)
+		}
 		system.CopyFile(filepath.Join(repoDir, "GentlemanClaude/output-styles/gentleman.md"), filepath.Join(claudeDir, "output-styles/gentleman.md"))
 		system.CopyFile(filepath.Join(repoDir, "GentlemanClaude/mcp-servers.template.json"), filepath.Join(claudeDir, "mcp-servers.template.json"))
 		system.CopyFile(filepath.Join(repoDir, "GentlemanClaude/tweakcc-theme.json"), filepath.Join(claudeDir, "tweakcc-theme.json"))
 		SendLog(stepID, "⚙️ Copied CLAUDE.md, statusline, output styles, config")
 
 		SendLog(stepID, "Applying tweakcc theme...")
-		result := system.Run("npx tweakcc --apply", nil)
+		result := system.Run(m.installContext(), "npx tweakcc --apply", nil)
 		if result.Error == nil {
 			SendLog(stepID, "🎨 Applied tweakcc theme")
 		} else {
 			SendLog(stepID, "⚠️ Could not apply tweakcc theme (run 'npx tweakcc --apply' manually)")
 		}
 
-		// Ensure ~/.local/bin is in PATH
-		SendLog(stepID, "Ensuring ~/.local/bin is in PATH...")
-		if err := ensureLocalBinInPATH(homeDir, m.SystemInfo.UserShell); err != nil {
-			SendLog(stepID, fmt.Sprintf("⚠️ Could not update PATH: %v", err))
-		} else {
-			SendLog(stepID, "✓ PATH updated for ~/.local/bin")
+		// Ensure ~/.local/bin is in PATH (npm puts its binaries on PATH on Windows)
+		if !isWindowsChoice(m) {
+			SendLog(stepID, "Ensuring ~/.local/bin is in PATH...")
+			if err := ensureLocalBinInPATH(homeDir, m.SystemInfo.UserShell); err != nil {
+				SendLog(stepID, fmt.Sprintf("⚠️ Could not update PATH: %v", err))
+			} else {
+				SendLog(stepID, "✓ PATH updated for ~/.local/bin")
+			}
 		}
 	}
 
 	// Install and configure OpenCode
 	if hasAITool(m.Choices.AITools, "opencode") {
-		SendLog(stepID, "Installing OpenCode...")
-		system.RunWithLogs(`curl -fsSL https://opencode.ai/install | bash`, nil, func(line string) {
-			SendLog(stepID, line)
-		})
+		if !aiToolPresent(stepID, "opencode", "OpenCode") {
+			SendLog(stepID, "Installing OpenCode...")
+			system.RunWithLogs(m.installContext(), aiToolInstallCommand(m, "opencode", `curl -fsSL https://opencode.ai/install | bash`), nil, func(line string) {
+				SendLog(stepID, line)
+			})
+		}
 
 		SendLog(stepID, "Configuring OpenCode...")
 		openCodeDir := filepath.Join(homeDir, ".config/opencode")
@@ -1237,9 +1124,9 @@ func stepInstallAITools(m *Model) error {
 	}
 
 	// Install Gemini CLI
-	if hasAITool(m.Choices.AITools, "gemini") {
+	if hasAITool(m.Choices.AITools, "gemini") && !aiToolPresent(stepID, "gemini", "Gemini CLI") {
 		SendLog(stepID, "Installing Gemini CLI...")
-		result := system.RunWithLogs(`npm install -g @google/gemini-cli`, nil, func(line string) {
+		result := system.RunWithLogs(m.installContext(), `npm install -g @google/gemini-cli`, nil, func(line string) {
 			SendLog(stepID, line)
 		})
 		if result.Error != nil {
@@ -1251,14 +1138,16 @@ func stepInstallAITools(m *Model) error {
 
 	// Install and configure OpenAI Codex CLI
 	if hasAITool(m.Choices.AITools, "codex") {
-		SendLog(stepID, "Installing Codex CLI...")
-		result := system.RunWithLogs(`npm install -g @openai/codex`, nil, func(line string) {
-			SendLog(stepID, line)
-		})
-		if result.Error != nil {
-			SendLog(stepID, "⚠️ Could not install Codex CLI (run 'npm install -g @openai/codex' manually)")
-		} else {
-			SendLog(stepID, "✓ Codex CLI installed")
+		if !aiToolPresent(stepID, "codex", "Codex CLI") {
+			SendLog(stepID, "Installing Codex CLI...")
+			result := system.RunWithLogs(m.installContext(), `npm install -g @openai/codex`, nil, func(line string) {
+				SendLog(stepID, line)
+			})
+			if result.Error != nil {
+				SendLog(stepID, "⚠️ Could not install Codex CLI (run 'npm install -g @openai/codex' manually)")
+			} else {
+				SendLog(stepID, "✓ Codex CLI installed")
+			}
 		}
 
 		SendLog(stepID, "Configuring Codex CLI...")
@@ -1272,14 +1161,16 @@ func stepInstallAITools(m *Model) error {
 
 	// Install and configure Qwen Code
 	if hasAITool(m.Choices.AITools, "qwen") {
-		SendLog(stepID, "Installing Qwen Code...")
-		result := system.RunWithLogs(`npm install -g @qwen-code/qwen-code@latest`, nil, func(line string) {
-			SendLog(stepID, line)
-		})
-		if result.Error != nil {
-			SendLog(stepID, "⚠️ Could not install Qwen Code (run 'npm install -g @qwen-code/qwen-code@latest' manually)")
-		} else {
-			SendLog(stepID, "✓ Qwen Code installed")
+		if !aiToolPresent(stepID, "qwen", "Qwen Code") {
+			SendLog(stepID, "Installing Qwen Code...")
+			result := system.RunWithLogs(m.installContext(), `npm install -g @qwen-code/qwen-code@latest`, nil, func(line string) {
+				SendLog(stepID, line)
+			})
+			if result.Error != nil {
+				SendLog(stepID, "⚠️ Could not install Qwen Code (run 'npm install -g @qwen-code/qwen-code@latest' manually)")
+			} else {
+				SendLog(stepID, "✓ Qwen Code installed")
+			}
 		}
 
 		SendLog(stepID, "Configuring Qwen Code...")
@@ -1292,18 +1183,20 @@ func stepInstallAITools(m *Model) error {
 	}
 
 	// Install GitHub Copilot CLI (new standalone version)
-	if hasAITool(m.Choices.AITools, "copilot") {
+	if hasAITool(m.Choices.AITools, "copilot") && !aiToolPresent(stepID, "copilot", "GitHub Copilot CLI") {
 		SendLog(stepID, "Installing GitHub Copilot CLI...")
-		result := system.RunWithLogs(`curl -fsSL https://gh.io/copilot-install | bash`, nil, func(line string) {
+		result := system.RunWithLogs(m.installContext(), aiToolInstallCommand(m, "copilot", `curl -fsSL https://gh.io/copilot-install | bash`), nil, func(line string) {
 			SendLog(stepID, line)
 		})
 		if result.Error != nil {
 			SendLog(stepID, "⚠️ Could not install GitHub Copilot (run 'curl -fsSL https://gh.io/copilot-install | bash' manually)")
 		} else {
 			SendLog(stepID, "✓ GitHub Copilot CLI installed")
-			// Ensure ~/.local/bin is in PATH (copilot installs here)
-			if err := ensureLocalBinInPATH(homeDir, m.SystemInfo.UserShell); err != nil {
-				SendLog(stepID, fmt.Sprintf("⚠️ Could not update PATH for copilot: %v", err))
+			// Ensure ~/.local/bin is in PATH (copilot installs here, except via npm on Windows)
+			if !isWindowsChoice(m) {
+				if err := ensureLocalBinInPATH(homeDir, m.SystemInfo.UserShell); err != nil {
+					SendLog(stepID, fmt.Sprintf("⚠️ Could not update PATH for copilot: %v", err))
+				}
 			}
 		}
 	}
@@ -1329,9 +1222,9 @@ func stepInstallAITools(m *Model) error {
 func setupCentralizedSkills(m *Model) error {
 	homeDir := os.Getenv("HOME")
 	stepID := "aitools"
-	centralDir := filepath.Join(homeDir, ".gentleman", "skills")
-	psfDir := filepath.Join(homeDir, ".gentleman", "project-starter-framework")
-	atlDir := filepath.Join(homeDir, ".gentleman", "agent-teams-lite")
+	centralDir := paths.SkillsDir(homeDir)
+	psfDir := filepath.Join(paths.DataDir(homeDir), "project-starter-framework")
+	atlDir := filepath.Join(paths.DataDir(homeDir), "agent-teams-lite")
 
 	// Determine if any CLI needs skills
 	needsClaude := hasAITool(m.Choices.AITools, "claude")
@@ -1345,10 +1238,11 @@ func setupCentralizedSkills(m *Model) error {
 
 	SendLog(stepID, "Setting up centralized skills...")
 
-	// Clone or update Gentleman-Skills repo
+	// Clone or update Gentleman-Skills repo. A pinned catalog is always
+	// cloned again: the cached one may be at another commit.
 	needsClone := true
 	if info, err := os.Stat(centralDir); err == nil {
-		if time.Since(info.ModTime()) < time.Hour {
+		if m.Choices.SkillsRef == "" && time.Since(info.ModTime()) < time.Hour {
 			needsClone = false
 			SendLog(stepID, "Using cached Gentleman-Skills repo")
 		} else {
@@ -1358,13 +1252,18 @@ func setupCentralizedSkills(m *Model) error {
 
 	if needsClone {
 		SendLog(stepID, "Cloning Gentleman-Skills...")
-		system.EnsureDir(filepath.Join(homeDir, ".gentleman"))
-		result := system.RunWithLogs(
-			"git clone --depth 1 https://github.com/Gentleman-Programming/Gentleman-Skills.git "+centralDir,
-			nil, func(line string) { SendLog(stepID, line) },
-		)
-		if result.Error != nil {
-			SendLog(stepID, fmt.Sprintf("⚠️ Failed to clone Gentleman-Skills: %v", result.Error))
+		system.EnsureDir(paths.DataDir(homeDir))
+		// The rest of the step runs once, so only the clone is retried
+		err := stepRetryPolicy.run(m.installContext(), stepID, func() error {
+			_, err := system.GitClone(m.installContext(), cloneURLs(skillsRepoURL, m.Choices), centralDir, system.CloneOptions{
+				Ref:      m.Choices.SkillsRef,
+				Log:      func(line string) { SendLog(stepID, line) },
+				Progress: func(progress float64) { SendProgress(stepID, progress) },
+			})
+			return err
+		})
+		if err != nil {
+			SendLog(stepID, fmt.Sprintf("⚠️ Failed to clone Gentleman-Skills: %v", err))
 		}
 	}
 
@@ -1381,13 +1280,13 @@ func setupCentralizedSkills(m *Model) error {
 
 	if needsClonePSF {
 		SendLog(stepID, "Cloning Project-Starter-Framework...")
-		system.EnsureDir(filepath.Join(homeDir, ".gentleman"))
-		result := system.RunWithLogs(
-			"git clone --depth 1 https://github.com/JNZader/project-starter-framework.git "+psfDir,
-			nil, func(line string) { SendLog(stepID, line) },
-		)
-		if result.Error != nil {
-			SendLog(stepID, fmt.Sprintf("⚠️ Failed to clone Project-Starter-Framework: %v", result.Error))
+		system.EnsureDir(paths.DataDir(homeDir))
+		_, err := system.GitClone(m.installContext(), cloneURLs(frameworkRepoURL, m.Choices), psfDir, system.CloneOptions{
+			Log:      func(line string) { SendLog(stepID, line) },
+			Progress: func(progress float64) { SendProgress(stepID, progress) },
+		})
+		if err != nil {
+			SendLog(stepID, fmt.Sprintf("⚠️ Failed to clone Project-Starter-Framework: %v", err))
 		}
 	}
 
@@ -1404,8 +1303,9 @@ func setupCentralizedSkills(m *Model) error {
 
 	if needsCloneATL {
 		SendLog(stepID, "Cloning Agent-Teams-Lite...")
-		system.EnsureDir(filepath.Join(homeDir, ".gentleman"))
+		system.EnsureDir(paths.DataDir(homeDir))
 		result := system.RunWithLogs(
+			m.installContext(),
 			"git clone --depth 1 https://github.com/Gentleman-Programming/agent-teams-lite.git "+atlDir,
 			nil, func(line string) { SendLog(stepID, line) },
 		)
@@ -1485,7 +1385,7 @@ func setupCentralizedSkills(m *Model) error {
 			dst := filepath.Join(claudeSkillsDir, name)
 			// Remove existing (file, dir, or stale symlink)
 			os.RemoveAll(dst)
-			if err := os.Symlink(sp, dst); err != nil {
+			if _, err := linkSkillDir(sp, dst); err != nil {
 				SendLog(stepID, fmt.Sprintf("⚠️ Could not symlink %s for Claude: %v", name, err))
 			} else {
 				linked++
@@ -1504,7 +1404,7 @@ func setupCentralizedSkills(m *Model) error {
 			dst := filepath.Join(agentsSkillsDir, name)
 			// Remove existing (file, dir, or stale symlink)
 			os.RemoveAll(dst)
-			if err := os.Symlink(sp, dst); err != nil {
+			if _, err := linkSkillDir(sp, dst); err != nil {
 				SendLog(stepID, fmt.Sprintf("⚠️ Could not symlink %s for agents: %v", name, err))
 			} else {
 				linked++
@@ -1541,23 +1441,32 @@ func stepInstallAIFramework(m *Model) error {
 		features = m.Choices.AIFrameworkModules
 	}
 
+	// Record what was picked, as reviewed on the summary screen
+	if m.Choices.AIFrameworkPreset == "" && m.AICategorySelected != nil && !m.AIFrameworkApplyMode {
+		for _, line := range frameworkSelectionSummary(m.AICategorySelected) {
+			SendLog(stepID, line)
+		}
+	}
+
 	// Run project-starter-framework setup if there are features to install
 	if len(features) > 0 {
 		// Clean up any leftover clone from a previous failed run
-		system.Run("rm -rf /tmp/project-starter-framework-install", nil)
+		frameworkDir := filepath.Join(os.TempDir(), frameworkTempName)
+		system.Run(m.installContext(), "rm -rf "+frameworkDir, nil)
 
 		SendLog(stepID, "Cloning project-starter-framework...")
-		result := system.RunWithLogs(
-			"git clone --depth 1 https://github.com/JNZader/project-starter-framework.git /tmp/project-starter-framework-install",
-			nil, func(line string) { SendLog(stepID, line) },
-		)
-		if result.Error != nil {
+		installTemps.Track(frameworkDir)
+		_, err := system.GitClone(m.installContext(), cloneURLs(frameworkRepoURL, m.Choices), frameworkDir, system.CloneOptions{
+			Log:      func(line string) { SendLog(stepID, line) },
+			Progress: func(progress float64) { SendProgress(stepID, progress) },
+		})
+		if err != nil {
 			return wrapStepError("aiframework", "Install AI Framework",
-				"Failed to clone project-starter-framework", result.Error)
+				"Failed to clone project-starter-framework", err)
 		}
 
 		// Build the setup-global.sh command
-		setupCmd := "/tmp/project-starter-framework-install/scripts/setup-global.sh --auto --skip-install"
+		setupCmd := frameworkDir + "/scripts/setup-global.sh --auto --skip-install"
 
 		// Determine which CLIs to configure based on selected AI tools
 		var clis []string
@@ -1587,7 +1496,7 @@ func stepInstallAIFramework(m *Model) error {
 
 		SendLog(stepID, "Running framework setup...")
 		SendLog(stepID, fmt.Sprintf("Command: %s", setupCmd))
-		result = system.RunWithLogs(setupCmd, nil, func(line string) {
+		result := system.RunWithLogs(m.installContext(), setupCmd, nil, func(line string) {
 			SendLog(stepID, line)
 		})
 		if result.Error != nil {
@@ -1596,7 +1505,8 @@ func stepInstallAIFramework(m *Model) error {
 		}
 
 		// Cleanup cloned framework repo
-		system.Run("rm -rf /tmp/project-starter-framework-install", nil)
+		system.Run(m.installContext(), "rm -rf "+frameworkDir, nil)
+		installTemps.Untrack(frameworkDir)
 
 		SendLog(stepID, "✓ AI framework configured")
 	}
@@ -1611,6 +1521,19 @@ func stepInstallAIFramework(m *Model) error {
 		}
 	}
 
+	// Remove deselected modules (apply-only mode on an existing setup)
+	if len(m.Choices.AIFrameworkRemove) > 0 {
+		SendLog(stepID, fmt.Sprintf("Removing %d deselected module(s)...", len(m.Choices.AIFrameworkRemove)))
+		removed, err := removeFrameworkItems(os.Getenv("HOME"), m.Choices.AIFrameworkRemove)
+		for _, key := range removed {
+			SendLog(stepID, "🗑️  Removed "+frameworkItemLabel(key))
+		}
+		if err != nil {
+			return wrapStepError("aiframework", "Install AI Framework",
+				"Failed to remove deselected modules", err)
+		}
+	}
+
 	return nil
 }
 
@@ -1641,7 +1564,7 @@ func stepInstallEngram(m *Model) error {
 
 	// Install engram via Homebrew
 	SendLog(stepID, "Installing Engram via Homebrew...")
-	result := system.RunWithLogs("brew install gentleman-programming/tap/engram", nil, func(line string) {
+	result := system.RunWithLogs(m.installContext(), "brew install gentleman-programming/tap/engram", nil, func(line string) {
 		SendLog(stepID, line)
 	})
 	if result.Error != nil {
@@ -1654,7 +1577,7 @@ func stepInstallEngram(m *Model) error {
 
 	// Configure for OpenCode
 	SendLog(stepID, "Configuring Engram for OpenCode...")
-	result = system.RunWithLogs("engram setup opencode", nil, func(line string) {
+	result = system.RunWithLogs(m.installContext(), "engram setup opencode", nil, func(line string) {
 		SendLog(stepID, line)
 	})
 	if result.Error != nil {
@@ -1681,9 +1604,9 @@ func setupEngramService(m *Model) bool {
 
 	switch runtime.GOOS {
 	case "linux":
-		return setupEngramSystemd(homeDir, stepID)
+		return setupEngramSystemd(m.installContext(), homeDir, stepID)
 	case "darwin":
-		return setupEngramLaunchd(homeDir, stepID)
+		return setupEngramLaunchd(m.installContext(), homeDir, stepID)
 	default:
 		SendLog(stepID, "⚠️ Auto-start service not supported on this OS")
 		return false
@@ -1691,7 +1614,7 @@ func setupEngramService(m *Model) bool {
 }
 
 // setupEngramSystemd creates systemd user service for Linux
-func setupEngramSystemd(homeDir, stepID string) bool {
+func setupEngramSystemd(ctx context.Context, homeDir, stepID string) bool {
 	configDir := filepath.Join(homeDir, ".config/systemd/user")
 	serviceFile := filepath.Join(configDir, "engram.service")
 
@@ -1735,20 +1658,20 @@ WantedBy=default.target
 	}
 
 	// Enable and start service
-	result := system.Run("systemctl --user daemon-reload", nil)
+	result := system.Run(ctx, "systemctl --user daemon-reload", nil)
 	if result.Error != nil {
 		SendLog(stepID, fmt.Sprintf("⚠️ Could not reload systemd: %v", result.Error))
 		return false
 	}
 
-	result = system.Run("systemctl --user enable engram.service", nil)
+	result = system.Run(ctx, "systemctl --user enable engram.service", nil)
 	if result.Error != nil {
 		SendLog(stepID, fmt.Sprintf("⚠️ Could not enable engram service: %v", result.Error))
 		return false
 	}
 
 	// Try to start, but don't fail if it doesn't (might need logout/login)
-	result = system.Run("systemctl --user start engram.service", nil)
+	result = system.Run(ctx, "systemctl --user start engram.service", nil)
 	if result.Error != nil {
 		SendLog(stepID, "Note: Engram service enabled but not started (will start on next login)")
 	} else {
@@ -1759,7 +1682,7 @@ WantedBy=default.target
 }
 
 // setupEngramLaunchd creates launchd plist for macOS
-func setupEngramLaunchd(homeDir, stepID string) bool {
+func setupEngramLaunchd(ctx context.Context, homeDir, stepID string) bool {
 	launchAgentsDir := filepath.Join(homeDir, "Library/LaunchAgents")
 	plistFile := filepath.Join(launchAgentsDir, "com.gentleman.engram.plist")
 
@@ -1819,14 +1742,14 @@ func setupEngramLaunchd(homeDir, stepID string) bool {
 	}
 
 	// Load the plist
-	result := system.Run(fmt.Sprintf("launchctl load %s", plistFile), nil)
+	result := system.Run(ctx, fmt.Sprintf("launchctl load %s", plistFile), nil)
 	if result.Error != nil {
 		SendLog(stepID, fmt.Sprintf("⚠️ Could not load launchd service: %v", result.Error))
 		return false
 	}
 
 	// Try to start
-	result = system.Run("launchctl start com.gentleman.engram", nil)
+	result = system.Run(ctx, "launchctl start com.gentleman.engram", nil)
 	if result.Error != nil {
 		SendLog(stepID, "Note: Engram service loaded but not started (will start on next login)")
 	} else {
@@ -1839,14 +1762,16 @@ func setupEngramLaunchd(homeDir, stepID string) bool {
 // installAgentTeamsLite clones the agent-teams-lite repo and runs install.sh for each selected AI tool.
 func installAgentTeamsLite(m *Model) error {
 	const repoURL = "https://github.com/Gentleman-Programming/agent-teams-lite.git"
-	const clonePath = "/tmp/agent-teams-lite-install"
+	clonePath := filepath.Join(os.TempDir(), agentTeamsTempName)
 	stepID := "aiframework"
 
 	// Cleanup any leftover
-	system.Run("rm -rf "+clonePath, nil)
+	system.Run(m.installContext(), "rm -rf "+clonePath, nil)
 
 	SendLog(stepID, "Cloning agent-teams-lite...")
+	installTemps.Track(clonePath)
 	result := system.RunWithLogs(
+		m.installContext(),
 		"git clone --depth 1 "+repoURL+" "+clonePath,
 		nil, func(line string) { SendLog(stepID, line) },
 	)
@@ -1855,7 +1780,7 @@ func installAgentTeamsLite(m *Model) error {
 	}
 
 	// Make install script executable
-	system.Run("chmod +x "+clonePath+"/scripts/install.sh", nil)
+	system.Run(m.installContext(), "chmod +x "+clonePath+"/scripts/install.sh", nil)
 
 	// Map our AI tool IDs to agent-teams-lite agent names
 	agentMap := map[string]string{
@@ -1874,7 +1799,7 @@ func installAgentTeamsLite(m *Model) error {
 		}
 		SendLog(stepID, fmt.Sprintf("Installing Agent Teams Lite for %s...", agentName))
 		installCmd := fmt.Sprintf("%s/scripts/install.sh --agent %s", clonePath, agentName)
-		result = system.RunWithLogs(installCmd, nil, func(line string) {
+		result = system.RunWithLogs(m.installContext(), installCmd, nil, func(line string) {
 			SendLog(stepID, line)
 		})
 		if result.Error != nil {
@@ -1885,7 +1810,8 @@ func installAgentTeamsLite(m *Model) error {
 	}
 
 	// Cleanup
-	system.Run("rm -rf "+clonePath, nil)
+	system.Run(m.installContext(), "rm -rf "+clonePath, nil)
+	installTemps.Untrack(clonePath)
 
 	if installed == 0 {
 		return fmt.Errorf("no AI tools could be configured with Agent Teams Lite")
@@ -1897,12 +1823,12 @@ func stepCleanup(m *Model) error {
 	stepID := "cleanup"
 	SendLog(stepID, "Removing temporary files...")
 	// Only remove the cloned repo - no sudo needed
-	result := system.Run("rm -rf "+m.RepoDir, nil)
-	if result.Error != nil {
+	if err := os.RemoveAll(m.RepoDir); err != nil {
 		// Non-critical error, just log it
 		SendLog(stepID, "Warning: Could not remove temporary directory")
 		return nil
 	}
+	installTemps.Untrack(m.RepoDir)
 	SendLog(stepID, "✓ Cleanup complete")
 	return nil
 }
@@ -1933,7 +1859,7 @@ func stepSetDefaultShell(m *Model) error {
 		SendLog(stepID, "Configuring shell auto-start for Termux...")
 
 		// Find the shell path
-		shellPath := system.Run(fmt.Sprintf("which %s", shellCmd), nil)
+		shellPath := system.Run(m.installContext(), fmt.Sprintf("which %s", shellCmd), nil)
 		if shellPath.Error != nil || strings.TrimSpace(shellPath.Output) == "" {
 			SendLog(stepID, fmt.Sprintf("Shell '%s' not found in PATH, skipping", shellCmd))
 			return nil
@@ -1983,7 +1909,7 @@ fi
 
 	// Non-Termux: Try to set shell using sudo usermod (works if NOPASSWD configured)
 	// Find the shell path first
-	shellPath := system.Run(fmt.Sprintf("which %s", shellCmd), nil)
+	shellPath := system.Run(m.installContext(), fmt.Sprintf("which %s", shellCmd), nil)
 	if shellPath.Error != nil || strings.TrimSpace(shellPath.Output) == "" {
 		SendLog(stepID, fmt.Sprintf("Shell '%s' not found in PATH, skipping", shellCmd))
 		return nil
@@ -1997,7 +1923,7 @@ fi
 	}
 	if currentUser == "" {
 		// Fallback to whoami command (useful in Docker containers)
-		whoamiResult := system.Run("whoami", nil)
+		whoamiResult := system.Run(m.installContext(), "whoami", nil)
 		if whoamiResult.Error == nil {
 			currentUser = strings.TrimSpace(whoamiResult.Output)
 		}
@@ -2009,10 +1935,10 @@ fi
 
 	// First, ensure shell is in /etc/shells
 	SendLog(stepID, fmt.Sprintf("Adding %s to /etc/shells if needed...", shellPathStr))
-	checkShells := system.Run(fmt.Sprintf("grep -q '^%s$' /etc/shells", shellPathStr), nil)
+	checkShells := system.Run(m.installContext(), fmt.Sprintf("grep -q '^%s$' /etc/shells", shellPathStr), nil)
 	if checkShells.Error != nil {
 		// Shell not in /etc/shells, try to add it
-		addResult := system.RunSudo(fmt.Sprintf("sh -c 'echo \"%s\" >> /etc/shells'", shellPathStr), nil)
+		addResult := system.RunSudo(m.installContext(), fmt.Sprintf("sh -c 'echo \"%s\" >> /etc/shells'", shellPathStr), nil)
 		if addResult.Error != nil {
 			SendLog(stepID, fmt.Sprintf("Could not add %s to /etc/shells (may need manual setup)", shellPathStr))
 		}
@@ -2020,11 +1946,11 @@ fi
 
 	// Try sudo usermod first (more reliable than chsh in scripts)
 	SendLog(stepID, fmt.Sprintf("Setting %s as default shell for %s...", shell, currentUser))
-	result := system.RunSudo(fmt.Sprintf("usermod -s %s %s", shellPathStr, currentUser), nil)
+	result := system.RunSudo(m.installContext(), fmt.Sprintf("usermod -s %s %s", shellPathStr, currentUser), nil)
 	if result.Error != nil {
 		// usermod failed, try chsh as fallback
 		SendLog(stepID, "usermod failed, trying chsh...")
-		result = system.RunSudo(fmt.Sprintf("chsh -s %s %s", shellPathStr, currentUser), nil)
+		result = system.RunSudo(m.installContext(), fmt.Sprintf("chsh -s %s %s", shellPathStr, currentUser), nil)
 		if result.Error != nil {
 			// Both failed - not critical, just inform user
 			SendLog(stepID, fmt.Sprintf("Could not set default shell automatically"))
@@ -2038,10 +1964,22 @@ fi
 	return nil
 }
 
-// runProjectInitScript clones project-starter-framework and runs init-project.sh.
-// If memory is "obsidian-brain" and rolePacks is non-empty, it also copies
-// role pack templates into the project vault after the script finishes.
-func runProjectInitScript(projectPath, memory, ci string, engram bool, rolePacks []string) error {
+// errProjectInitCancelled is what a project init stopped with Esc returns
+var errProjectInitCancelled = errors.New("cancelled")
+
+// sendProjectLog shows a line in the ScreenProjectInstalling log
+func sendProjectLog(line string) {
+	if globalProgram != nil {
+		globalProgram.Send(projectInstallLogMsg{line: line})
+	}
+}
+
+// runProjectInitScript clones project-starter-framework and runs init-project.sh,
+// streaming its output to the log line by line. If memory is "obsidian-brain"
+// and rolePacks is non-empty, it also copies role pack templates into the
+// project vault after the script finishes. Cancelling ctx kills the clone or
+// the script and returns errProjectInitCancelled.
+func runProjectInitScript(ctx context.Context, projectPath, memory, ci string, engram bool, rolePacks []string) error {
 	cacheDir := filepath.Join(os.TempDir(), "project-starter-framework-install")
 
 	// Check cache freshness (1 hour)
@@ -2055,12 +1993,14 @@ func runProjectInitScript(projectPath, memory, ci string, engram bool, rolePacks
 	}
 
 	if needsClone {
-		if globalProgram != nil {
-			globalProgram.Send(projectInstallLogMsg{line: "Cloning project-starter-framework..."})
-		}
-		cmd := exec.Command("git", "clone", "--depth", "1",
+		sendProjectLog("Cloning project-starter-framework...")
+		cmd := exec.CommandContext(ctx, "git", "clone", "--depth", "1",
 			"https://github.com/JNZader/project-starter-framework.git", cacheDir)
 		if out, err := cmd.CombinedOutput(); err != nil {
+			if ctx.Err() != nil {
+				os.RemoveAll(cacheDir)
+				return errProjectInitCancelled
+			}
 			return fmt.Errorf("failed to clone framework: %s: %w", string(out), err)
 		}
 	}
@@ -2099,55 +2039,66 @@ func runProjectInitScript(projectPath, memory, ci string, engram bool, rolePacks
 		args = append(args, "--engram")
 	}
 
-	if globalProgram != nil {
-		globalProgram.Send(projectInstallLogMsg{line: fmt.Sprintf("Running: bash %s", strings.Join(args, " "))})
-	}
-
-	cmd := exec.Command("bash", args...)
-	cmd.Dir = projectPath
-
-	output, err := cmd.CombinedOutput()
-	lines := strings.Split(string(output), "\n")
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-		if line != "" && globalProgram != nil {
-			globalProgram.Send(projectInstallLogMsg{line: line})
+	sendProjectLog(fmt.Sprintf("Running: bash %s", strings.Join(args, " ")))
+	if err := runStreamedCommand(ctx, projectPath, "bash", args, sendProjectLog); err != nil {
+		if ctx.Err() != nil {
+			return errProjectInitCancelled
 		}
-	}
-
-	if err != nil {
 		return fmt.Errorf("init-project.sh failed: %w", err)
 	}
 
 	// Copy role pack templates if obsidian-brain is selected and packs were chosen
 	if memory == "obsidian-brain" && len(rolePacks) > 0 {
-		if globalProgram != nil {
-			globalProgram.Send(projectInstallLogMsg{line: "Copying Obsidian Brain role pack templates..."})
-		}
+		sendProjectLog("Copying Obsidian Brain role pack templates...")
 		repoRoot := findRepoDirForTemplates("")
 		if repoRoot == "" {
-			if globalProgram != nil {
-				globalProgram.Send(projectInstallLogMsg{line: "⚠ Could not locate template assets, skipping role pack templates"})
-			}
+			sendProjectLog("⚠ Could not locate template assets, skipping role pack templates")
+		} else if err := copyRolePackTemplates(repoRoot, projectPath, rolePacks); err != nil {
+			sendProjectLog(fmt.Sprintf("⚠ Role pack template copy failed: %v", err))
 		} else {
-			if err := copyRolePackTemplates(repoRoot, projectPath, rolePacks); err != nil {
-				if globalProgram != nil {
-					globalProgram.Send(projectInstallLogMsg{line: fmt.Sprintf("⚠ Role pack template copy failed: %v", err)})
-				}
-			} else {
-				if globalProgram != nil {
-					globalProgram.Send(projectInstallLogMsg{line: fmt.Sprintf("✓ Copied templates for packs: %s", strings.Join(rolePacks, ", "))})
-				}
-			}
+			sendProjectLog(fmt.Sprintf("✓ Copied templates for packs: %s", strings.Join(rolePacks, ", ")))
 		}
 	}
 
+	// The simple memory module gets the project's own build/test commands
+	if memory == "simple" {
+		writeProjectCommands(projectPath)
+	}
+
 	return nil
 }
 
+// runStreamedCommand runs name with args in dir, passing each non-blank line
+// of its combined output to log as it is printed. Where the OS allows, the
+// command gets its own process group, so cancelling ctx kills it along with
+// everything it started.
+func runStreamedCommand(ctx context.Context, dir, name string, args []string, log func(string)) error {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Dir = dir
+	killProcessGroupOnCancel(cmd)
+	// Don't hang on a process that left the group but kept the output open
+	cmd.WaitDelay = time.Second
+
+	out, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	cmd.Stderr = cmd.Stdout
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	scanner := bufio.NewScanner(out)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			log(line)
+		}
+	}
+	return cmd.Wait()
+}
+
 // RunProjectInitScript exposes runProjectInitScript for CLI usage
 func RunProjectInitScript(projectPath, memory, ci string, engram bool, rolePacks []string) error {
-	return runProjectInitScript(projectPath, memory, ci, engram, rolePacks)
+	return runProjectInitScript(context.Background(), projectPath, memory, ci, engram, rolePacks)
 }
 
 // findRepoDirForTemplates locates the Javi.Dots repo root so we can find
@@ -2198,6 +2149,16 @@ func findRepoDirForTemplates(repoDir string) string {
 	return ""
 }
 
+// vaultCoreDirs are created in every Obsidian Brain vault; ".obsidian" is the
+// marker obsidian.nvim uses to detect the vault
+var vaultCoreDirs = []string{"inbox", "resources", "knowledge", "templates", ".obsidian"}
+
+// rolePackDirs are the extra vault directories of each role pack
+var rolePackDirs = map[string][]string{
+	"developer": {"architecture", "sessions", "debugging"},
+	"pm-lead":   {"meetings", "sprints", "risks", "briefs"},
+}
+
 // copyRolePackTemplates copies selected role pack templates into the project vault.
 // repoDir is the path to the Javi.Dots repo root containing GentlemanNvim/obsidian-brain/.
 func copyRolePackTemplates(repoDir, projectPath string, rolePacks []string) error {
@@ -2205,34 +2166,20 @@ func copyRolePackTemplates(repoDir, projectPath string, rolePacks []string) erro
 	templatesDir := filepath.Join(vaultDir, "templates")
 
 	// Create core vault folder structure
-	coreDirs := []string{
-		vaultDir,
-		filepath.Join(vaultDir, "inbox"),
-		filepath.Join(vaultDir, "resources"),
-		filepath.Join(vaultDir, "knowledge"),
-		templatesDir,
-		filepath.Join(vaultDir, ".obsidian"), // marker for obsidian.nvim plugin detection
-	}
-	for _, dir := range coreDirs {
-		if err := system.EnsureDir(dir); err != nil {
+	if err := system.EnsureDir(vaultDir); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", vaultDir, err)
+	}
+	for _, dir := range vaultCoreDirs {
+		if err := system.EnsureDir(filepath.Join(vaultDir, dir)); err != nil {
 			return fmt.Errorf("failed to create directory %s: %w", dir, err)
 		}
 	}
 
 	// Create role-specific directories
 	for _, pack := range rolePacks {
-		switch pack {
-		case "developer":
-			for _, dir := range []string{"architecture", "sessions", "debugging"} {
-				if err := system.EnsureDir(filepath.Join(vaultDir, dir)); err != nil {
-					return fmt.Errorf("failed to create developer directory %s: %w", dir, err)
-				}
-			}
-		case "pm-lead":
-			for _, dir := range []string{"meetings", "sprints", "risks", "briefs"} {
-				if err := system.EnsureDir(filepath.Join(vaultDir, dir)); err != nil {
-					return fmt.Errorf("failed to create pm-lead directory %s: %w", dir, err)
-				}
+		for _, dir := range rolePackDirs[pack] {
+			if err := system.EnsureDir(filepath.Join(vaultDir, dir)); err != nil {
+				return fmt.Errorf("failed to create %s directory %s: %w", pack, dir, err)
 			}
 		}
 	}
@@ -1,9 +1,16 @@
 package tui
 
 import (
+	"context"
+	"errors"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
 )
 
 func TestCopyRolePackTemplates(t *testing.T) {
@@ -199,3 +206,134 @@ func TestCopyRolePackTemplates(t *testing.T) {
 		}
 	})
 }
+
+func TestDepsUseTheDistroPackageManager(t *testing.T) {
+	tests := []struct {
+		name    string
+		sys     *system.SystemInfo
+		install string
+	}{
+		{"alpine", &system.SystemInfo{OS: system.OSAlpine, PackageManager: "apk"}, "sudo apk add build-base curl file git wget unzip fontconfig"},
+		{"void", &system.SystemInfo{OS: system.OSVoid, PackageManager: "xbps"}, "sudo xbps-install -y base-devel curl file git wget unzip fontconfig"},
+		{"debian", &system.SystemInfo{OS: system.OSDebian, PackageManager: "apt"}, "sudo apt-get install -y build-essential curl file git wget unzip fontconfig"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewModel()
+			m.SystemInfo = tt.sys
+			m.Choices.OS = "linux"
+			script, err := getDepsScript(&m)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(script, tt.install) {
+				t.Errorf("expected %q in the script:\n%s", tt.install, script)
+			}
+			if tt.name != "debian" && strings.Contains(script, "apt-get") {
+				t.Error("apt should not run off Debian")
+			}
+		})
+	}
+}
+
+func TestDepsFailEarlyWithoutPackageManager(t *testing.T) {
+	m := NewModel()
+	m.SystemInfo = &system.SystemInfo{OS: system.OSLinux, OSName: "Linux"}
+	m.Choices.OS = "linux"
+
+	err := stepInstallDeps(&m)
+	var stepErr *StepError
+	if !errors.As(err, &stepErr) || !errors.Is(err, system.ErrNoPackageManager) {
+		t.Fatalf("expected a step error for the missing package manager, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "supported: apt, pacman") {
+		t.Errorf("the error should list the supported managers, got %q", err)
+	}
+	if _, err := getDepsScript(&m); !errors.Is(err, system.ErrNoPackageManager) {
+		t.Errorf("the interactive script should fail the same way, got %v", err)
+	}
+}
+
+// writeFakeScript writes a bash script into a temp dir for runStreamedCommand
+func writeFakeScript(t *testing.T, body string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "fake-init.sh")
+	if err := os.WriteFile(path, []byte(body), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestRunStreamedCommandStreamsLines(t *testing.T) {
+	script := writeFakeScript(t, "for i in 1 2 3; do echo \"line $i\"; sleep 0.2; done\necho oops >&2\n")
+
+	start := time.Now()
+	var lines []string
+	var firstAfter time.Duration
+	err := runStreamedCommand(context.Background(), t.TempDir(), "bash", []string{script}, func(line string) {
+		if lines == nil {
+			firstAfter = time.Since(start)
+		}
+		lines = append(lines, line)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(lines, ",") != "line 1,line 2,line 3,oops" {
+		t.Errorf("expected the numbered lines then stderr, got %v", lines)
+	}
+	if total := time.Since(start); firstAfter >= total-300*time.Millisecond {
+		t.Errorf("the first line should arrive while the script runs, got it after %v of %v", firstAfter, total)
+	}
+}
+
+func TestRunStreamedCommandCancelKillsGroup(t *testing.T) {
+	// The backgrounded sleep holds the output open; only killing the whole
+	// group ends the command before the one second WaitDelay
+	script := writeFakeScript(t, "sleep 30 &\necho started\nwait\n")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var cancelledAt time.Time
+	err := runStreamedCommand(ctx, t.TempDir(), "bash", []string{script}, func(line string) {
+		if line == "started" {
+			cancelledAt = time.Now()
+			cancel()
+		}
+	})
+	if err == nil {
+		t.Error("a cancelled command should return an error")
+	}
+	if cancelledAt.IsZero() {
+		t.Fatal("the script never started")
+	}
+	if waited := time.Since(cancelledAt); waited > 900*time.Millisecond {
+		t.Errorf("the script's children should die with it, waited %v", waited)
+	}
+}
+
+func TestRedeployNvimBacksUpTheCurrentConfig(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv(paths.PortableEnv, "")
+	nvim := filepath.Join(home, ".config", "nvim")
+	os.MkdirAll(nvim, 0755)
+	os.WriteFile(filepath.Join(nvim, "init.lua"), []byte("-- my edits"), 0644)
+	repo := t.TempDir()
+	os.MkdirAll(filepath.Join(repo, "GentlemanNvim", "nvim"), 0755)
+	os.WriteFile(filepath.Join(repo, "GentlemanNvim", "nvim", "init.lua"), []byte("-- from the repo"), 0644)
+
+	m := NewModel()
+	m.RepoDir = repo
+	if err := stepRedeployNvim(&m); err != nil {
+		t.Fatal(err)
+	}
+	if data, _ := os.ReadFile(filepath.Join(nvim, "init.lua")); string(data) != "-- from the repo" {
+		t.Errorf("the config should be re-deployed, got %q", data)
+	}
+	if m.BackupDir == "" {
+		t.Fatal("the overwritten config should be backed up")
+	}
+	if data, _ := os.ReadFile(filepath.Join(m.BackupDir, "nvim", "init.lua")); string(data) != "-- my edits" {
+		t.Errorf("the backup should hold the user's config, got %q", data)
+	}
+}
@@ -0,0 +1,90 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/tui/trainer"
+)
+
+// lockedSuffix marks main menu entries that are off while another instance runs
+const lockedSuffix = " (read-only)"
+
+// readOnlyEntries are the message keys of the main menu entries that never
+// change shared state, so they stay available while another instance holds the
+// lock. What they would save (trainer progress, settings) is kept in memory only.
+var readOnlyEntries = []string{"main_menu.learn", "main_menu.check", "main_menu.settings", "main_menu.exit"}
+
+// SetLockHolder puts the model in read-only mode because holder, another
+// running installer, owns the instance lock. A nil holder leaves it writable.
+func (m *Model) SetLockHolder(holder *system.InstanceLock) {
+	m.LockHolder = holder
+}
+
+// readOnly reports whether another instance holds the lock
+func (m Model) readOnly() bool {
+	return m.LockHolder != nil
+}
+
+// saveTrainerStats saves the trainer progress, unless another instance holds
+// the lock: then it lasts until quitting, as writing stats.json could race
+// with the other instance
+func (m Model) saveTrainerStats() error {
+	if m.readOnly() {
+		return nil
+	}
+	return trainer.SaveStats(m.TrainerStats)
+}
+
+// lockMainMenuEntry marks opt as locked when it would change shared state
+func (m Model) lockMainMenuEntry(opt string) string {
+	if !m.readOnly() {
+		return opt
+	}
+	for _, entry := range readOnlyEntries {
+		if opt == m.T(entry) {
+			return opt
+		}
+	}
+	return opt + m.T("main_menu.locked")
+}
+
+// lockHolderSummary names the instance holding the lock and since when
+func (m Model) lockHolderSummary() string {
+	if m.LockHolder == nil {
+		return ""
+	}
+	if m.LockHolder.Started.IsZero() {
+		return fmt.Sprintf("PID %d", m.LockHolder.PID)
+	}
+	return m.Tf("instance_locked.holder_since", m.LockHolder.PID, m.LockHolder.Started.Local().Format("Jan 2 15:04"))
+}
+
+// renderInstanceLocked explains why a screen that changes shared state is refused
+func (m Model) renderInstanceLocked() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
+	s.WriteString("\n\n")
+
+	s.WriteString(m.Theme.Error.Render(m.Tf("instance_locked.holder", m.lockHolderSummary())))
+	s.WriteString("\n")
+	s.WriteString(m.T("instance_locked.why") + "\n")
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Success.Render(m.T("instance_locked.available")))
+	s.WriteString("\n")
+	s.WriteString("  " + m.T("instance_locked.available_learn") + "\n")
+	s.WriteString("  " + m.T("instance_locked.available_check") + "\n")
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.T("instance_locked.hint")))
+	s.WriteString("\n\n")
+
+	s.WriteString(m.Theme.Selected.Render("▸ " + m.GetCurrentOptions()[0]))
+	s.WriteString("\n\n")
+	s.WriteString(m.Theme.Help.Render(m.T("instance_locked.help")))
+
+	return s.String()
+}
@@ -0,0 +1,117 @@
+package tui
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/tui/trainer"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func lockedModel() Model {
+	m := NewModel()
+	m.Screen = ScreenMainMenu
+	m.SetLockHolder(&system.InstanceLock{PID: 4242, Started: time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)})
+	return m
+}
+
+// selectMainMenu moves the cursor to the main menu entry containing label and presses enter
+func selectMainMenu(t *testing.T, m Model, label string) Model {
+	t.Helper()
+	for i, opt := range m.GetCurrentOptions() {
+		if strings.Contains(opt, label) {
+			m.Cursor = i
+			result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+			return result.(Model)
+		}
+	}
+	t.Fatalf("no main menu entry %q in %v", label, m.GetCurrentOptions())
+	return m
+}
+
+func TestMainMenuReadOnlyEntries(t *testing.T) {
+	m := lockedModel()
+	for _, opt := range m.GetCurrentOptions() {
+		readOnly := strings.Contains(opt, "Learn & Practice") || strings.Contains(opt, "Check Installation") || strings.Contains(opt, "Settings") || strings.Contains(opt, "Exit")
+		if locked := strings.HasSuffix(opt, lockedSuffix); locked == readOnly {
+			t.Errorf("%q: locked = %v", opt, locked)
+		}
+	}
+
+	view := m.renderMainMenu()
+	if !strings.Contains(view, "Read-only") || !strings.Contains(view, "PID 4242") {
+		t.Errorf("the main menu should name the other instance, got:\n%s", view)
+	}
+
+	for _, opt := range NewModel().GetCurrentOptions() {
+		if strings.HasSuffix(opt, lockedSuffix) {
+			t.Errorf("without a lock holder nothing is locked, got %q", opt)
+		}
+	}
+}
+
+func TestReadOnlyRefusesMutatingScreens(t *testing.T) {
+	for _, label := range []string{"Start Installation", "Initialize Project", "Skill Manager", "Diagnose Setup", "Uninstall"} {
+		t.Run(label, func(t *testing.T) {
+			m := selectMainMenu(t, lockedModel(), label)
+			if m.Screen != ScreenInstanceLocked {
+				t.Fatalf("expected the lock notice, got %v", m.Screen)
+			}
+			if view := m.View(); !strings.Contains(view, "PID 4242") {
+				t.Errorf("the notice should name the other PID, got:\n%s", view)
+			}
+
+			result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+			if got := result.(Model).Screen; got != ScreenMainMenu {
+				t.Errorf("esc should return to the main menu, got %v", got)
+			}
+		})
+	}
+}
+
+func TestReadOnlyKeepsLearning(t *testing.T) {
+	m := selectMainMenu(t, lockedModel(), "Learn & Practice")
+	if m.Screen != ScreenLearnMenu {
+		t.Fatalf("learning should stay available, got %v", m.Screen)
+	}
+	m.Cursor = 1 // Keymaps Reference
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if got := result.(Model).Screen; got != ScreenKeymapsMenu {
+		t.Errorf("keymaps should stay available, got %v", got)
+	}
+}
+
+// The second instance keeps trainer progress and settings in memory only
+func TestReadOnlyWritesNothing(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	m := lockedModel()
+
+	m.TrainerStats = trainer.NewUserStats()
+	if err := m.saveTrainerStats(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(trainer.GetStatsPath()); !os.IsNotExist(err) {
+		t.Errorf("trainer progress should not be saved, got %v", err)
+	}
+
+	m.Settings.SkipWelcome = true
+	if err := m.SaveSettings(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(settingsPath(home)); !os.IsNotExist(err) {
+		t.Errorf("settings should not be saved, got %v", err)
+	}
+	m.Screen = ScreenSettings
+	if !strings.Contains(m.GetScreenDescription(), "aren't saved") {
+		t.Errorf("Settings should say its changes aren't saved, got %q", m.GetScreenDescription())
+	}
+
+	m.Screen = ScreenTrainerMenu
+	if m = m.enterTrainerImport(); m.Screen != ScreenTrainerMenu || !strings.Contains(m.TrainerMessage, "another installer") {
+		t.Errorf("import should be refused, got %v %q", m.Screen, m.TrainerMessage)
+	}
+}
@@ -88,13 +88,22 @@ func TestFullInstallationFlow(t *testing.T) {
 		result, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
 		m = result.(Model)
 
-		if m.Screen != ScreenNvimSelect {
-			t.Fatalf("Expected ScreenNvimSelect, got %v", m.Screen)
+		if m.Screen != ScreenWMExtras {
+			t.Fatalf("Expected ScreenWMExtras, got %v", m.Screen)
 		}
 		if m.Choices.WindowMgr != "zellij" {
 			t.Fatalf("Expected WindowMgr 'zellij', got '%s'", m.Choices.WindowMgr)
 		}
 
+		// Keep every extra: confirm is the last option
+		m.Cursor = len(m.GetCurrentOptions()) - 1
+		result, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		m = result.(Model)
+
+		if m.Screen != ScreenNvimSelect {
+			t.Fatalf("Expected ScreenNvimSelect, got %v", m.Screen)
+		}
+
 		// Select "Yes" for Nvim (cursor at 0)
 		// This should go to Zed selection (non-Termux)
 		result, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
@@ -342,8 +351,8 @@ func TestBackupFlow(t *testing.T) {
 		}
 		m.Choices = UserChoices{OS: "mac", Shell: "fish"}
 
-		// Test cancel (cursor = 2)
-		m.Cursor = 2
+		// Test cancel (cursor = 3)
+		m.Cursor = 3
 		result, _ := m.handleBackupConfirmKeys("enter")
 		newM := result.(Model)
 
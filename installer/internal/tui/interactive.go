@@ -1,6 +1,7 @@
 package tui
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/exec"
@@ -19,6 +20,9 @@ type needsExecProcessMsg struct {
 // This suspends the TUI and gives full terminal control to the process
 func runInteractiveStep(stepID string, m *Model) tea.Cmd {
 	return func() tea.Msg {
+		if dryRunMode {
+			return execFinishedMsg{stepID: stepID, err: errDryRunStep(stepID)}
+		}
 		script, err := getInteractiveScript(stepID, m)
 		if err != nil {
 			return execFinishedMsg{stepID: stepID, err: fmt.Errorf("failed to get script for %s: %w", stepID, err)}
@@ -39,6 +43,36 @@ func runInteractiveStep(stepID string, m *Model) tea.Cmd {
 	}
 }
 
+// runAttachedStep runs an interactive step outside the TUI, with the script
+// attached to this process's terminal so sudo and chsh can prompt. Steps that
+// have no script of their own (native package installs) prompt through sudo
+// while running in-process, which already shares the terminal.
+func runAttachedStep(stepID string, m *Model) error {
+	if dryRunMode {
+		return errDryRunStep(stepID)
+	}
+	switch stepID {
+	case "homebrew", "deps", "terminal", "setshell":
+	default:
+		return executeStep(context.Background(), stepID, m)
+	}
+
+	script, err := getInteractiveScript(stepID, m)
+	if err != nil {
+		return fmt.Errorf("failed to get script for %s: %w", stepID, err)
+	}
+	if script == "" {
+		return nil
+	}
+	cmd, err := createTempScriptCommand(script)
+	if err != nil {
+		return fmt.Errorf("failed to create script for %s: %w", stepID, err)
+	}
+	defer os.Remove(cmd.Args[len(cmd.Args)-1])
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	return cmd.Run()
+}
+
 // getInteractiveScript returns the bash script for interactive steps only
 // Interactive steps are those that NEED user input (sudo password, chsh, etc)
 func getInteractiveScript(stepID string, m *Model) (string, error) {
@@ -101,62 +135,32 @@ read dummy
 
 // getDepsScript returns script to install dependencies on Linux (needs sudo)
 func getDepsScript(m *Model) (string, error) {
-	var script string
+	pm, err := distroPackageManager(m, "deps")
+	if err != nil {
+		return "", err
+	}
 
-	if m.SystemInfo.OS == system.OSArch {
-		script = `#!/bin/sh
-set -e
-echo ""
-echo "🔄 Updating Arch Linux packages..."
+	update := ""
+	if cmd := pm.UpdateCommand(); cmd != "" {
+		update = fmt.Sprintf(`echo "🔄 Updating %s packages..."
 echo "   (You may be prompted for your password)"
 echo ""
-sudo pacman -Syu --noconfirm
-echo ""
-echo "📦 Installing base dependencies..."
-sudo pacman -S --needed --noconfirm base-devel curl file git wget unzip fontconfig
-echo ""
-echo "✅ Dependencies installed successfully!"
-echo ""
-echo "Press Enter to continue..."
-read dummy
-`
-	} else if m.SystemInfo.OS == system.OSFedora {
-		// Fedora/RHEL
-		script = `#!/bin/sh
-set -e
-echo ""
-echo "🔄 Checking for Fedora/RHEL updates..."
-echo "   (You may be prompted for your password)"
-echo ""
-sudo dnf check-update || true
-echo ""
-echo "📦 Installing base dependencies..."
-sudo dnf install -y @development-tools curl file git wget unzip fontconfig
-echo ""
-echo "✅ Dependencies installed successfully!"
+%s
 echo ""
-echo "Press Enter to continue..."
-read dummy
-`
-	} else {
-		// Debian/Ubuntu
-		script = `#!/bin/sh
+`, pm.Name(), cmd)
+	}
+
+	script := fmt.Sprintf(`#!/bin/sh
 set -e
 echo ""
-echo "🔄 Updating apt package list..."
-echo "   (You may be prompted for your password)"
-echo ""
-sudo apt-get update
-echo ""
-echo "📦 Installing base dependencies..."
-sudo apt-get install -y build-essential curl file git unzip fontconfig
+%secho "📦 Installing base dependencies..."
+%s
 echo ""
 echo "✅ Dependencies installed successfully!"
 echo ""
 echo "Press Enter to continue..."
 read dummy
-`
-	}
+`, update, pm.InstallCommand(baseDependencies...))
 
 	return script, nil
 }
@@ -173,10 +177,12 @@ func getTerminalScript(m *Model) (string, error) {
 	case "alacritty":
 		if system.CommandExists("alacritty") {
 			installCmd = `echo "✓ Alacritty already installed"`
-		} else if m.SystemInfo.OS == system.OSArch {
-			installCmd = `sudo pacman -S --noconfirm alacritty`
-		} else if m.SystemInfo.OS == system.OSFedora {
-			installCmd = `sudo dnf install -y alacritty`
+		} else if m.SystemInfo.OS != system.OSDebian && m.SystemInfo.PackageManager != "apt" {
+			pm, err := distroPackageManager(m, "terminal")
+			if err != nil {
+				return "", err
+			}
+			installCmd = pm.InstallCommand("alacritty")
 		} else {
 			// Debian/Ubuntu: compile from source (PPAs are unreliable)
 			installCmd = `echo "📦 Installing build dependencies..."
@@ -216,11 +222,11 @@ cp "Gentleman.Dots/alacritty.toml" "%s/.config/alacritty/alacritty.toml"`, homeD
 	case "wezterm":
 		if system.CommandExists("wezterm") {
 			installCmd = `echo "✓ WezTerm already installed"`
-		} else if m.SystemInfo.OS == system.OSArch {
-			installCmd = `sudo pacman -S --noconfirm wezterm`
-		} else if m.SystemInfo.OS == system.OSFedora {
-			installCmd = `sudo dnf copr enable -y wezfurlong/wezterm-nightly
-sudo dnf install -y wezterm`
+		} else if pm, err := distroPackageManager(m, "terminal"); err == nil && system.Packaged(pm.Name(), "wezterm") {
+			installCmd = pm.InstallCommand("wezterm")
+			if pm.Name() == "dnf" {
+				installCmd = "sudo dnf copr enable -y wezfurlong/wezterm-nightly\n" + installCmd
+			}
 		} else {
 			// Debian uses brew, not interactive
 			return "", nil
@@ -231,11 +237,13 @@ cp "Gentleman.Dots/.wezterm.lua" "%s/.config/wezterm/wezterm.lua"`, homeDir, hom
 	case "ghostty":
 		if system.CommandExists("ghostty") {
 			installCmd = `echo "✓ Ghostty already installed"`
-		} else if m.SystemInfo.OS == system.OSArch {
-			installCmd = `sudo pacman -S --noconfirm ghostty`
-		} else if m.SystemInfo.OS == system.OSFedora {
-			installCmd = `sudo dnf copr enable -y pgdev/ghostty
-sudo dnf install -y ghostty`
+		} else if pm, err := distroPackageManager(m, "terminal"); err == nil && system.Packaged(pm.Name(), "ghostty") {
+			installCmd = pm.InstallCommand("ghostty")
+			if pm.Name() == "dnf" {
+				installCmd = "sudo dnf copr enable -y pgdev/ghostty\n" + installCmd
+			}
+		} else if err == nil && pm.Name() != "apt" {
+			return "", fmt.Errorf("ghostty isn't packaged for %s; see https://ghostty.org/docs/install/binary", pm.Name())
 		} else {
 			// Debian uses install script
 			installCmd = `curl -fsSL https://raw.githubusercontent.com/mkasberg/ghostty-ubuntu/HEAD/install.sh | bash`
@@ -0,0 +1,351 @@
+package tui
+
+import "slices"
+
+// KeyBinding is one key a screen handles. Every binding is explained in the
+// help overlay; the ones with a Hint are also shown in the footer bar, in
+// list order, so keep the most relevant first: narrow terminals drop hints
+// from the end.
+type KeyBinding struct {
+	Key         string
+	Description string
+	Hint        string // Short footer action, "" to leave it out of the footer
+}
+
+// Bindings shared by many screens
+var (
+	bindMove      = KeyBinding{"↑↓", "Move (also k/j)", "move"}
+	bindScroll    = KeyBinding{"↑↓", "Scroll (also k/j)", "scroll"}
+	bindPage      = KeyBinding{"pgup/pgdn", "Scroll a page", "page"}
+	bindSelect    = KeyBinding{"enter", "Select the option under the cursor", "select"}
+	bindBack      = KeyBinding{"esc", "Go back (also backspace)", "back"}
+	bindCancel    = KeyBinding{"esc", "Cancel", "cancel"}
+	bindLeader    = KeyBinding{"space", "Leader key: then q quits, h opens the main menu, s the Skill Manager", "leader"}
+	bindToggle    = KeyBinding{"space", "Toggle the item under the cursor", "toggle"}
+	bindConfirm   = KeyBinding{"enter", "Run the action under the cursor; on an item, jump to Confirm", "confirm"}
+	bindMainMenu  = KeyBinding{"enter", "Return to the main menu", "main menu"}
+	bindReadBack  = KeyBinding{"esc", "Go back (also q or enter)", "back"}
+	bindSearch    = KeyBinding{"/", "Search keys and descriptions in every category", "search"}
+	bindFilter    = KeyBinding{"/", "Filter skills by name or description", "filter"}
+	bindRerun     = KeyBinding{"r", "Run the checks again", "re-run"}
+	bindTrainerIn = KeyBinding{"enter", "Submit the typed keys", "submit"}
+)
+
+// Bindings that work on every screen. Screens list bindQuit and bindSuspend
+// too when the footer should show them; the help overlay lists them once,
+// under "Everywhere".
+var (
+	bindHelp = KeyBinding{"?", "Show this help (f1 on screens you type into)", ""}
+	bindQuit = KeyBinding{"ctrl+c", "Quit right away", "quit"}
+	// Long-running screens: the work pauses with the process and carries on after fg
+	bindSuspend = KeyBinding{"ctrl+z", "Suspend to the shell; fg resumes (not while installing)", "suspend (fg resumes)"}
+)
+
+// globalKeyBindings work on every screen and close every help overlay
+var globalKeyBindings = []KeyBinding{bindHelp, bindQuit, bindSuspend}
+
+// menuBindings fit any single-select list
+var menuBindings = []KeyBinding{bindMove, bindSelect, bindBack, bindLeader}
+
+// multiSelectBindings fit checklists with a Confirm row, see multi_select.go
+var multiSelectBindings = []KeyBinding{bindMove, bindToggle, bindConfirm, bindBack}
+
+// keymapMenuBindings fit the keymap menus, see keymap_search.go
+var keymapMenuBindings = []KeyBinding{bindMove, bindSelect, bindSearch, bindBack, bindLeader}
+
+// keymapCategoryBindings fit the keymap tables, which scroll by line only
+var keymapCategoryBindings = []KeyBinding{bindScroll, bindReadBack}
+
+// readerBindings fit scrollable read-only content
+var readerBindings = []KeyBinding{bindScroll, bindPage, bindReadBack}
+
+// screenKeyBindings lists every key the handlers of each screen act on, for
+// the footer and the help overlay. Keep it in step with handleKeyPress and the
+// screen handlers; modes that change what the keys do are in keyBindings.
+var screenKeyBindings = map[Screen][]KeyBinding{
+	ScreenWelcome: {
+		{"enter", "Start", "start"},
+		{"space", "Continue to the main menu", "continue"},
+	},
+	ScreenMainMenu:            {bindMove, bindSelect, bindLeader},
+	ScreenLearnMenu:           menuBindings,
+	ScreenOSSelect:            menuBindings,
+	ScreenTerminalSelect:      menuBindings,
+	ScreenFontSelect:          menuBindings,
+	ScreenShellSelect:         menuBindings,
+	ScreenZshMergeSelect:      menuBindings,
+	ScreenWMSelect:            menuBindings,
+	ScreenNvimSelect:          menuBindings,
+	ScreenZedSelect:           menuBindings,
+	ScreenGhosttyWarning:      menuBindings,
+	ScreenUnsupportedPlatform: {{"enter", "Back to the main menu", "back"}, bindBack},
+	ScreenInstalling: {
+		{"space d", "Show or hide the command log", "details"},
+		{"space l", "Switch between the steps and the full-height log", "log"},
+		{"space x", "Cancel the install: running commands are stopped, the steps left are skipped", "cancel"},
+		bindQuit,
+	},
+	ScreenComplete: {{"enter", "Exit (also space)", "exit"}},
+	ScreenError: {
+		{"r", "Retry the failed step", "retry step"},
+		{"s", "Skip the failed step and continue", "skip"},
+		{"enter", "Quit (also space)", "quit"},
+	},
+	ScreenLearnTerminals:    menuBindings,
+	ScreenLearnShells:       menuBindings,
+	ScreenLearnWM:           menuBindings,
+	ScreenLearnNvim:         menuBindings,
+	ScreenKeymaps:           keymapMenuBindings,
+	ScreenKeymapCategory:    keymapCategoryBindings,
+	ScreenKeymapsMenu:       menuBindings,
+	ScreenKeymapsTmux:       keymapMenuBindings,
+	ScreenKeymapsTmuxCat:    keymapCategoryBindings,
+	ScreenKeymapsZellij:     keymapMenuBindings,
+	ScreenKeymapsZellijCat:  keymapCategoryBindings,
+	ScreenKeymapsGhostty:    keymapMenuBindings,
+	ScreenKeymapsGhosttyCat: keymapCategoryBindings,
+	ScreenLearnLazyVim:      menuBindings,
+	ScreenLazyVimTopic:      readerBindings,
+	ScreenBackupConfirm: {
+		bindMove, bindSelect,
+		{"c", "Include or leave out regenerable caches", ""},
+		{"h", "Include or leave out shell history", ""},
+		{"z", "Write the backup as a .tar.gz archive or a plain directory", ""},
+		{"p", "Pin the clones to a branch, tag or commit", ""},
+		bindBack, bindLeader,
+	},
+	ScreenOSVersionWarning: menuBindings,
+	ScreenDiskSpaceWarning: menuBindings,
+	ScreenWizardReview:     menuBindings,
+	ScreenRestoreBackup:    menuBindings,
+	ScreenRestoreConfirm:   {bindMove, bindSelect, bindCancel},
+	ScreenRestoreConflict: {
+		bindMove, bindSelect,
+		{"a", "Apply the choice to every remaining conflict", "apply to all"},
+		{"esc", "Cancel the restore", "cancel restore"},
+	},
+	ScreenAIToolsSelect:         multiSelectBindings,
+	ScreenAIFrameworkConfirm:    menuBindings,
+	ScreenAIFrameworkPreset:     menuBindings,
+	ScreenAIFrameworkCategories: {bindMove, {"enter", "Open the category", "open"}, {"/", "Search modules in every category", "search"}, bindBack, bindLeader},
+	ScreenAIFrameworkCategoryItems: {
+		bindMove, bindToggle,
+		{"enter", "Toggle the item; on ← Back, return to the categories", "toggle/back"},
+		{"a", "Toggle every item in the category", "all"},
+		bindBack,
+	},
+	ScreenAIFrameworkSummary:    menuBindings,
+	ScreenAIFrameworkPresetSave: {{"enter", "Save the preset", "save"}, {"esc", "Skip saving", "cancel"}},
+	ScreenAIFrameworkApplyDiff:  menuBindings,
+	ScreenTrainerMenu: {
+		bindMove,
+		{"enter", "Start the module's lessons (also l)", "lesson"},
+		{"p", "Practice the module", "practice"},
+		{"b", "Fight the module's boss", "boss"},
+		{"r", "Reset the module's practice progress", ""},
+		{"i", "Take the placement test", ""},
+		{"t", "Start a one-minute Speed Run", ""},
+		{"e", "Export progress to a file", ""},
+		{"I", "Import exported progress", ""},
+		{"s", "Open trainer settings", "settings"},
+		{"esc", "Save and go back (also q)", "back"},
+	},
+	ScreenTrainerLesson: {
+		bindTrainerIn,
+		{"tab", "Show a hint", "hint"},
+		{"esc", "Save and quit the lesson", "quit"},
+	},
+	ScreenTrainerPractice: {
+		bindTrainerIn,
+		{"tab", "Show a hint", "hint"},
+		{"esc", "Save and quit the practice", "quit"},
+	},
+	ScreenTrainerBoss: {
+		bindTrainerIn,
+		{"esc", "Forfeit the fight", "forfeit"},
+	},
+	ScreenTrainerResult:     {{"enter", "Continue (also space)", "continue"}, {"esc", "Back to the module list (also q)", "back"}},
+	ScreenTrainerBossResult: {{"enter", "Back to the module list", "menu"}},
+	ScreenTrainerTimed: {
+		bindTrainerIn,
+		{"esc", "Stop the run; once time is up, back to the module list (also q)", "stop"},
+	},
+	ScreenTrainerResetConfirm: {
+		bindMove, bindSelect,
+		{"y", "Reset the progress", "reset"},
+		{"n", "Keep the progress", "cancel"},
+	},
+	ScreenTrainerSettings: {bindMove, bindSelect, {"esc", "Go back (also q or backspace)", "back"}, bindLeader},
+	ScreenTrainerResetAll: {
+		{"enter", "Reset everything once the confirmation word is typed", "confirm"},
+		bindCancel,
+	},
+	ScreenProjectPath: {
+		{"tab", "Complete the path", "complete"},
+		{"ctrl+b", "Open or close the folder browser", "browse"},
+		{"ctrl+r", "Pick a recent project (also ↑↓ while the input is empty)", "recent"},
+		{"enter", "Confirm the path", "confirm"},
+		{"↑↓", "Move through the suggestions", ""},
+		{"ctrl+n", "Create the typed path when it doesn't exist, then confirm it", ""},
+		{"esc", "Cancel", "cancel"},
+	},
+	ScreenProjectStack:           multiSelectBindings,
+	ScreenProjectMemory:          menuBindings,
+	ScreenProjectObsidianInstall: menuBindings,
+	ScreenProjectEngram:          menuBindings,
+	ScreenProjectRolePack:        multiSelectBindings,
+	ScreenProjectCI:              menuBindings,
+	ScreenProjectConfirm:         menuBindings,
+	ScreenProjectInstalling:      {{"esc", "Stop init-project.sh; files already written stay", "cancel"}, bindQuit, bindSuspend},
+	ScreenProjectResult:          {bindMove, bindSelect, {"esc", "Return to the main menu", "main menu"}},
+	ScreenProjectBatchSelect:     multiSelectBindings,
+	ScreenProjectBatchResult:     {bindMainMenu},
+	ScreenSkillMenu:              menuBindings,
+	ScreenSkillBrowse: {
+		bindMove,
+		{"enter", "Show the skill's details", "details"},
+		{"w", "List catalog folders that were skipped", ""},
+		{"t", "Show only the skills with the next tag; after the last one, all skills", "tag"},
+		{"esc", "Clear the tag filter, or go back", "back"},
+		bindLeader,
+	},
+	ScreenSkillInstall: {bindMove, bindToggle, bindConfirm, bindFilter, {"esc", "Clear the filter, or go back", "back"}},
+	ScreenSkillRemove:  {bindMove, bindToggle, bindConfirm, bindFilter, {"esc", "Clear the filter, or go back", "back"}},
+	ScreenSkillResult: {
+		{"enter", "Return to the skill menu", "return"},
+		{"r", "After a catalog update, remove the orphaned skill links", ""},
+	},
+	ScreenSkillUpdate:     {bindQuit, bindSuspend},
+	ScreenSkillStats:      keymapCategoryBindings,
+	ScreenSkillDetail:     readerBindings,
+	ScreenSkillDoctor:     menuBindings,
+	ScreenUninstall:       multiSelectBindings,
+	ScreenUninstallResult: {bindMainMenu},
+	ScreenWMExtras:        multiSelectBindings,
+	ScreenInstanceLocked:  {{"enter", "Back to the main menu", "back"}, bindBack},
+	ScreenTrainerImport:   {{"enter", "Import the typed file", "import"}, bindBack},
+	ScreenDiagnoseSymptom: {bindMove, {"enter", "Run the checks for the symptom", "run checks"}, bindBack, bindLeader},
+	ScreenDiagnoseResults: {
+		bindMove,
+		{"enter", "Apply the fix under the cursor", "select"},
+		bindRerun,
+		bindBack, bindLeader,
+	},
+	ScreenInstallCheck:    {bindScroll, bindRerun, {"esc", "Go back (also enter or backspace)", "back"}, bindLeader},
+	ScreenSkillWarnings:   {bindScroll, {"esc", "Go back (also q, w or enter)", "back"}},
+	ScreenProfileSave:     {{"enter", "Save the profile", "save"}, {"esc", "Skip saving", "cancel"}},
+	ScreenInstallRefs:     menuBindings,
+	ScreenInstallRefInput: {{"enter", "Save the ref", "save"}, bindCancel},
+	ScreenProfileSelect:   {bindMove, {"enter", "Install from the profile", "install"}, bindBack, bindLeader},
+	ScreenProfileMismatch: menuBindings,
+	ScreenSkillTargets:    multiSelectBindings,
+	ScreenRestoreItems:    multiSelectBindings,
+	ScreenBackupPrune:     {bindMove, bindSelect, bindCancel},
+	ScreenProjectPreview:  {bindScroll, bindPage, {"enter", "Initialize the project", "initialize"}, bindBack},
+	ScreenSkillConflicts: {
+		bindMove,
+		{"space", "Change what happens to the directory: skip, backup, overwrite", "change"},
+		{"s/b/o", "Skip, back up or overwrite the directory", "skip/backup/overwrite"},
+		bindConfirm, bindBack,
+	},
+	ScreenSettings: {
+		bindMove, bindToggle,
+		{"enter", "Toggle the setting, or go back on ← Back", "toggle"},
+		{"←/→", "Pick the previous or next theme or language", ""},
+		bindBack,
+	},
+	ScreenSkillManifest: {{"enter", "Install from the typed file, or export to it", "confirm"}, bindBack},
+	ScreenSkillURL:      {{"enter", "Clone the typed repository and link its skill", "install"}, bindBack},
+}
+
+// keyBindings returns the keys the screen handles right now, following input
+// modes that change what the keys do
+func (m Model) keyBindings() []KeyBinding {
+	switch {
+	case m.Screen == ScreenProjectPath && m.ProjectPathMode == PathModeRecent:
+		return []KeyBinding{
+			{"↑↓", "Move through the recent projects", "move"},
+			{"enter", "Fill in the project under the cursor", "fill in"},
+			{"esc", "Close the list", "cancel"},
+		}
+	case m.Screen == ScreenProjectPath && m.ProjectPathMode == PathModeCompletion:
+		return []KeyBinding{
+			{"↑↓", "Move through the suggestions", "move"},
+			{"enter", "Accept the suggestion (also tab)", "select"},
+			{"esc", "Close the list", "cancel"},
+		}
+	case m.Screen == ScreenProjectPath && m.ProjectPathMode == PathModeBrowser:
+		return []KeyBinding{
+			{"↑↓", "Move through the folders", "move"},
+			{"enter", "Open the folder", "open"},
+			{"h", "Parent folder (also ←)", "up"},
+			{"n", "Create a folder here", "new dir"},
+			{".", "Show or hide hidden folders", "hidden"},
+			{"esc", "Close the browser (also ctrl+b)", "close"},
+		}
+	case m.Screen == ScreenProjectPath && m.ProjectPathMode == PathModeNewDir:
+		return []KeyBinding{
+			{"type", "Name the new folder", "name"},
+			{"enter", "Create the folder", "create"},
+			bindCancel,
+		}
+	case m.Screen == ScreenWelcome && m.ResumeState != nil:
+		return slices.Concat([]KeyBinding{{"r", "Resume the unfinished install", "resume"}}, screenKeyBindings[ScreenWelcome])
+	case m.Screen == ScreenError && m.InstallCancelled:
+		return []KeyBinding{
+			{"r", "Resume the cancelled install", "resume"},
+			{"enter", "Quit (also space)", "quit"},
+		}
+	case m.Screen == ScreenError && (m.DiagnoseFixMode || m.CurrentStep >= len(m.Steps)):
+		return []KeyBinding{
+			{"r", "Try again", "retry"},
+			{"enter", "Quit (also space)", "quit"},
+		}
+	case m.Screen == ScreenInstalling && m.ShowInstallLog:
+		return []KeyBinding{
+			{"j/k", "Scroll a line; new output is followed at the bottom", "scroll"},
+			bindPage,
+			{"gg/G", "Jump to the first or the newest line", "top/bottom"},
+			{"esc", "Back to the steps (also space l)", "steps"},
+			{"space x", "Cancel the install: running commands are stopped, the steps left are skipped", ""},
+		}
+	case m.Screen == ScreenSkillResult && m.SkillUndo.Undoable():
+		return slices.Concat(screenKeyBindings[ScreenSkillResult], []KeyBinding{{"u", "Undo the last install or removal", "undo"}})
+	case (m.Screen == ScreenSkillInstall || m.Screen == ScreenSkillRemove) && m.SkillFilterActive:
+		return []KeyBinding{
+			{"type", "Filter skills by name or description", "filter"},
+			{"enter", "Keep the filter and go back to the list", "keep"},
+			{"esc", "Clear the filter", "clear"},
+		}
+	case m.keymapSearching() && m.KeymapSearchActive:
+		return []KeyBinding{
+			{"type", "Search keys and descriptions", "search"},
+			{"↑↓", "Move through the results", "move"},
+			{"enter", "Open the category of the result", "open"},
+			{"esc", "Clear the search", "clear"},
+		}
+	case m.keymapSearching():
+		return []KeyBinding{
+			{"↑↓", "Move through the results (also k/j)", "move"},
+			{"enter", "Open the category of the result", "open"},
+			{"/", "Edit the search", "edit"},
+			{"esc", "Clear the search", "clear"},
+		}
+	case m.aiModuleSearching() && m.AIModuleSearchActive:
+		return []KeyBinding{
+			{"type", "Search module names and IDs", "search"},
+			{"↑↓", "Move through the results", "move"},
+			{"enter", "Keep the search and go to the results", "results"},
+			{"esc", "Close the search", "close"},
+		}
+	case m.aiModuleSearching():
+		return []KeyBinding{
+			{"↑↓", "Move through the results (also k/j)", "move"},
+			{"space", "Toggle the module", "toggle"},
+			{"enter", "Toggle the module", ""},
+			{"/", "Edit the search", "edit"},
+			{"esc", "Close the search", "close"},
+		}
+	}
+	return screenKeyBindings[m.Screen]
+}
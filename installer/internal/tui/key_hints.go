@@ -0,0 +1,50 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// keyHint is one binding shown in the footer bar
+type keyHint struct {
+	Key    string
+	Action string
+}
+
+// footerHints returns the footer hints of the current screen: its key
+// bindings that have one, see key_bindings.go
+func (m Model) footerHints() []keyHint {
+	return hintsOf(m.keyBindings())
+}
+
+// hintsOf returns the footer hints of bindings, in order
+func hintsOf(bindings []KeyBinding) []keyHint {
+	var hints []keyHint
+	for _, b := range bindings {
+		if b.Hint != "" {
+			hints = append(hints, keyHint{b.Key, b.Hint})
+		}
+	}
+	return hints
+}
+
+// renderFooter renders the hints as one line no wider than width, dropping
+// trailing hints that don't fit. width <= 0 means unlimited.
+func renderFooter(t *Theme, hints []keyHint, width int) string {
+	const sep = " · "
+	var parts []string
+	used := 0
+	for _, h := range hints {
+		w := lipgloss.Width(h.Key + " " + h.Action)
+		if len(parts) > 0 {
+			w += lipgloss.Width(sep)
+		}
+		if width > 0 && used+w > width {
+			break
+		}
+		used += w
+		parts = append(parts, t.FooterKey.Render(h.Key)+" "+t.FooterText.Render(h.Action))
+	}
+	return strings.Join(parts, t.FooterText.Render(sep))
+}
@@ -0,0 +1,96 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestEveryScreenHasKeyHints(t *testing.T) {
+	for screen, name := range screenNames {
+		t.Run(name, func(t *testing.T) {
+			hints := hintsOf(screenKeyBindings[screen])
+			if len(hints) == 0 {
+				t.Fatal("no footer hints registered")
+			}
+
+			m := NewModel()
+			m.Screen = screen
+			if len(m.GetCurrentOptions()) < 2 {
+				return
+			}
+			// List screens must at least say how to move and pick
+			var move, enter bool
+			for _, h := range hints {
+				move = move || h.Key == "↑↓"
+				enter = enter || h.Key == "enter"
+			}
+			if !move || !enter {
+				t.Errorf("list screen is missing navigation hints: %v", hints)
+			}
+		})
+	}
+}
+
+func TestRenderFooterTruncates(t *testing.T) {
+	menuHints := hintsOf(menuBindings)
+	full := renderFooter(NewTheme(defaultTheme), menuHints, 0)
+	for _, h := range menuHints {
+		if !strings.Contains(full, h.Action) {
+			t.Errorf("unlimited footer is missing %q: %q", h.Action, full)
+		}
+	}
+
+	tests := []struct {
+		width int
+		want  []string
+		drop  []string
+	}{
+		{width: 40, want: []string{"move", "select", "back"}, drop: []string{"leader"}},
+		{width: 22, want: []string{"move", "select"}, drop: []string{"back", "leader"}},
+		{width: 5, drop: []string{"move"}},
+	}
+	for _, tt := range tests {
+		got := renderFooter(NewTheme(defaultTheme), menuHints, tt.width)
+		if w := lipgloss.Width(got); w > tt.width {
+			t.Errorf("width %d: footer is %d wide: %q", tt.width, w, got)
+		}
+		for _, s := range tt.want {
+			if !strings.Contains(got, s) {
+				t.Errorf("width %d: expected %q in %q", tt.width, s, got)
+			}
+		}
+		for _, s := range tt.drop {
+			if strings.Contains(got, s) {
+				t.Errorf("width %d: expected %q to be dropped from %q", tt.width, s, got)
+			}
+		}
+	}
+}
+
+func TestFooterFollowsInputMode(t *testing.T) {
+	m := NewModel()
+	m.Screen = ScreenSkillInstall
+	if !strings.Contains(m.View(), "/ filter") {
+		t.Error("expected the filter hint on the install screen")
+	}
+	m.SkillFilterActive = true
+	if got := m.footerHints(); got[0].Action != "filter" || got[1].Action != "keep" {
+		t.Errorf("expected filter-mode hints, got %v", got)
+	}
+
+	m = NewModel()
+	m.Screen = ScreenProjectPath
+	m.ProjectPathMode = PathModeBrowser
+	if got := m.footerHints(); got[len(got)-1].Action != "close" {
+		t.Errorf("expected file browser hints, got %v", got)
+	}
+
+	m = NewModel()
+	m.Screen = ScreenMainMenu
+	m.LeaderMode = true
+	if strings.Contains(m.View(), "space leader") {
+		t.Error("the leader indicator should replace the footer")
+	}
+}
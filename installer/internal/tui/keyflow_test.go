@@ -0,0 +1,461 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// =============================================================================
+// KEY FLOW HARNESS - scripted inputs fed through Update with checkpoints
+// =============================================================================
+
+// flowStep is one scripted input (named keys, typed text or a raw message)
+// followed by an optional checkpoint on the resulting model
+type flowStep struct {
+	keys   []string
+	text   string
+	msg    tea.Msg
+	expect checkpoint
+}
+
+// checkpoint maps flowFields names to their expected rendering
+type checkpoint map[string]string
+
+// press sends named keys ("enter", "esc", "down", "ctrl+u", ...) or single runes
+func press(keys ...string) flowStep { return flowStep{keys: keys} }
+
+// pressN presses the same key n times
+func pressN(key string, n int) flowStep {
+	keys := make([]string, n)
+	for i := range keys {
+		keys[i] = key
+	}
+	return flowStep{keys: keys}
+}
+
+// typeText sends text one rune at a time, like a user typing it
+func typeText(text string) flowStep { return flowStep{text: text} }
+
+// send delivers a message the real program would get from a tea.Cmd
+func send(msg tea.Msg) flowStep { return flowStep{msg: msg} }
+
+// then attaches a checkpoint to the step
+func (s flowStep) then(expect checkpoint) flowStep {
+	s.expect = expect
+	return s
+}
+
+func (s flowStep) label() string {
+	switch {
+	case s.msg != nil:
+		return fmt.Sprintf("%T", s.msg)
+	case s.text != "":
+		return strconv.Quote(s.text)
+	}
+	return strings.Join(s.keys, " ")
+}
+
+// flowKeys are the named keys a script can press
+var flowKeys = map[string]tea.KeyMsg{
+	"enter":     {Type: tea.KeyEnter},
+	"esc":       {Type: tea.KeyEsc},
+	"up":        {Type: tea.KeyUp},
+	"down":      {Type: tea.KeyDown},
+	"space":     {Type: tea.KeySpace, Runes: []rune{' '}},
+	"backspace": {Type: tea.KeyBackspace},
+	"tab":       {Type: tea.KeyTab},
+	"ctrl+u":    {Type: tea.KeyCtrlU},
+}
+
+func (s flowStep) messages(t *testing.T) []tea.Msg {
+	t.Helper()
+	if s.msg != nil {
+		return []tea.Msg{s.msg}
+	}
+	var msgs []tea.Msg
+	for _, r := range s.text {
+		msgs = append(msgs, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	for _, key := range s.keys {
+		if msg, ok := flowKeys[key]; ok {
+			msgs = append(msgs, msg)
+		} else if runes := []rune(key); len(runes) == 1 {
+			msgs = append(msgs, tea.KeyMsg{Type: tea.KeyRunes, Runes: runes})
+		} else {
+			t.Fatalf("unknown key %q in flow script", key)
+		}
+	}
+	return msgs
+}
+
+// flowFields renders the model fields a checkpoint can assert on
+var flowFields = map[string]func(Model) string{
+	"Screen":                     func(m Model) string { return m.Screen.String() },
+	"Cursor":                     func(m Model) string { return strconv.Itoa(m.Cursor) },
+	"Choices.OS":                 func(m Model) string { return m.Choices.OS },
+	"Choices.Terminal":           func(m Model) string { return m.Choices.Terminal },
+	"Choices.InstallFont":        func(m Model) string { return strconv.FormatBool(m.Choices.InstallFont) },
+	"Choices.Shell":              func(m Model) string { return m.Choices.Shell },
+	"Choices.ZshMerge":           func(m Model) string { return strconv.FormatBool(m.Choices.ZshMerge) },
+	"Choices.WindowMgr":          func(m Model) string { return m.Choices.WindowMgr },
+	"Choices.WMExtras":           func(m Model) string { return flowExtras(m.Choices.WMExtras) },
+	"Choices.InstallNvim":        func(m Model) string { return strconv.FormatBool(m.Choices.InstallNvim) },
+	"Choices.InstallZed":         func(m Model) string { return strconv.FormatBool(m.Choices.InstallZed) },
+	"Choices.AITools":            func(m Model) string { return strings.Join(m.Choices.AITools, ",") },
+	"Choices.InstallAIFramework": func(m Model) string { return strconv.FormatBool(m.Choices.InstallAIFramework) },
+	"Choices.AIFrameworkPreset":  func(m Model) string { return m.Choices.AIFrameworkPreset },
+	"Choices.InitProject":        func(m Model) string { return strconv.FormatBool(m.Choices.InitProject) },
+	"Choices.InstallObsidian":    func(m Model) string { return strconv.FormatBool(m.Choices.InstallObsidian) },
+	"AIToolSelected":             func(m Model) string { return flowBools(m.AIToolSelected) },
+	"WMExtraSelected":            func(m Model) string { return flowBools(m.WMExtraSelected) },
+	"AISelectedCount":            func(m Model) string { return strconv.Itoa(len(collectSelectedFeatures(m.AICategorySelected))) },
+	"SelectedModuleCategory":     func(m Model) string { return strconv.Itoa(m.SelectedModuleCategory) },
+	"ProjectPathInput":           func(m Model) string { return m.ProjectPathInput },
+	"ProjectPathError":           func(m Model) string { return m.ProjectPathError },
+	"ProjectStack":               func(m Model) string { return m.ProjectStack },
+	"ProjectMemory":              func(m Model) string { return m.ProjectMemory },
+	"ProjectEngram":              func(m Model) string { return strconv.FormatBool(m.ProjectEngram) },
+	"ProjectRolePacks":           func(m Model) string { return strings.Join(m.ProjectRolePacks, ",") },
+	"ProjectCI":                  func(m Model) string { return m.ProjectCI },
+	"ProjectCommands":            func(m Model) string { return strconv.Itoa(len(m.ProjectCommands)) },
+	"SkillLoading":               func(m Model) string { return strconv.FormatBool(m.SkillLoading) },
+	"SkillSelected":              func(m Model) string { return flowBools(m.SkillSelected) },
+	"SkillResultLog":             func(m Model) string { return strings.Join(m.SkillResultLog.Lines(), "|") },
+}
+
+// flowExtras renders picked extras, telling nil (every extra) apart from none
+func flowExtras(ids []string) string {
+	if ids == nil {
+		return "all"
+	}
+	return strings.Join(ids, ",")
+}
+
+// flowBools renders a selection as "x.x" (x = selected)
+func flowBools(bools []bool) string {
+	var b strings.Builder
+	for _, on := range bools {
+		if on {
+			b.WriteByte('x')
+		} else {
+			b.WriteByte('.')
+		}
+	}
+	return b.String()
+}
+
+// diff returns one aligned line per mismatching field, or "" if all match
+func (c checkpoint) diff(m Model) string {
+	fields := make([]string, 0, len(c))
+	for field := range c {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	var lines []string
+	for _, field := range fields {
+		render, ok := flowFields[field]
+		if !ok {
+			lines = append(lines, fmt.Sprintf("  %-26s unknown field (add it to flowFields)", field))
+			continue
+		}
+		if got := render(m); got != c[field] {
+			lines = append(lines, fmt.Sprintf("  %-26s want %-20q got %q", field, c[field], got))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// runFlow feeds each step through Update and stops at the first checkpoint
+// that doesn't match, printing the mismatching fields and the inputs so far.
+// Commands returned by Update are not run; scripts send their results instead.
+func runFlow(t *testing.T, m Model, steps ...flowStep) Model {
+	t.Helper()
+	var trail []string
+	for i, step := range steps {
+		for _, msg := range step.messages(t) {
+			result, _ := m.Update(msg)
+			m = result.(Model)
+		}
+		trail = append(trail, step.label())
+		if diff := step.expect.diff(m); diff != "" {
+			t.Fatalf("checkpoint %d (after %s) on screen %s:\n%s\ninputs: %s",
+				i+1, step.label(), m.Screen, diff, strings.Join(trail, " → "))
+		}
+	}
+	return m
+}
+
+// newFlowModel pins everything the flows would otherwise pick up from the
+// host: a macOS system, an empty HOME and a PATH without any tools
+func newFlowModel(t *testing.T) Model {
+	t.Helper()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	m := NewModel()
+	t.Setenv("PATH", t.TempDir())
+	// Every AI tool can be installed and none is yet
+	fakeAIToolCommands(t, "curl", "npm")
+	m.SystemInfo = &system.SystemInfo{OS: system.OSMac, OSName: "macOS", HomeDir: home}
+	m.AvailableBackups = nil
+	m.DetectedAITools = nil
+	return m
+}
+
+// =============================================================================
+// RECORDED FLOWS
+// =============================================================================
+
+func TestKeyFlowWizardHappyPath(t *testing.T) {
+	runFlow(t, newFlowModel(t),
+		press("enter").then(checkpoint{"Screen": "MainMenu", "Cursor": "0"}),
+		press("enter").then(checkpoint{"Screen": "OSSelect", "Cursor": "0"}),
+		press("enter").then(checkpoint{"Screen": "TerminalSelect", "Choices.OS": "mac"}),
+		press("down", "down", "down", "enter").then(checkpoint{"Screen": "FontSelect", "Choices.Terminal": "ghostty"}),
+		press("enter").then(checkpoint{"Screen": "ShellSelect", "Choices.InstallFont": "true"}),
+		press("enter").then(checkpoint{"Screen": "WMSelect", "Choices.Shell": "fish"}),
+		press("down", "enter").then(checkpoint{"Screen": "WMExtras", "Choices.WindowMgr": "zellij", "WMExtraSelected": "xxx"}),
+		// Space toggles; down skips the separator onto Confirm
+		press("space", "down", "down", "space", "down").then(checkpoint{"WMExtraSelected": ".x.", "Cursor": "4", "Choices.WMExtras": "all"}),
+		press("enter").then(checkpoint{"Screen": "NvimSelect", "Choices.WMExtras": "statusbar"}),
+		press("enter").then(checkpoint{"Screen": "ZedSelect", "Choices.InstallNvim": "true"}),
+		press("down", "enter").then(checkpoint{"Screen": "AIToolsSelect", "Choices.InstallZed": "false", "AIToolSelected": "......"}),
+		press("space", "down", "space").then(checkpoint{"AIToolSelected": "xx....", "Cursor": "1"}),
+		// Select All turns every tool on, a second press turns them all off
+		press("down", "down", "down", "down", "down", "space").then(checkpoint{"Cursor": "7", "AIToolSelected": "xxxxxx"}),
+		press("space").then(checkpoint{"AIToolSelected": "......"}),
+		press("up", "up", "up", "up", "up", "up", "space").then(checkpoint{"Cursor": "0", "AIToolSelected": "x....."}),
+		// Enter on a tool moves to Confirm instead of toggling it
+		press("enter").then(checkpoint{"Screen": "AIToolsSelect", "Cursor": "8", "AIToolSelected": "x....."}),
+		press("enter").then(checkpoint{
+			"Screen":          "AIFrameworkConfirm",
+			"Choices.AITools": "claude",
+		}),
+		press("down", "enter").then(checkpoint{"Screen": "AIFrameworkPreset", "Choices.InstallAIFramework": "true"}),
+		// Down skips the separator straight onto Minimal
+		press("down").then(checkpoint{"Cursor": "2"}),
+		press("enter").then(checkpoint{"Screen": "WizardReview", "Choices.AIFrameworkPreset": "minimal"}),
+		// The review opens on Install; no existing configs, so no backup prompt
+		press("enter").then(checkpoint{"Screen": "Installing"}),
+	)
+}
+
+func TestKeyFlowAIFrameworkRecommended(t *testing.T) {
+	m := newFlowModel(t)
+	// An existing config makes the wizard stop at the backup prompt
+	os.MkdirAll(filepath.Join(os.Getenv("HOME"), ".config", "nvim"), 0755)
+
+	runFlow(t, m,
+		press("enter", "enter", "enter", "enter", "enter", "enter", "enter", "down", "down", "down", "enter", "enter", "enter").then(checkpoint{"Screen": "AIToolsSelect"}),
+		press("space", "down", "space").then(checkpoint{"AIToolSelected": "xx...."}),
+		press("down", "down", "down", "down", "down", "down", "enter").then(checkpoint{
+			"Screen":          "AIFrameworkConfirm",
+			"Choices.AITools": "claude,opencode",
+			"Cursor":          "0",
+		}),
+		// Recommended skips the preset screen entirely
+		press("enter").then(checkpoint{
+			"Screen":                     "WizardReview",
+			"Choices.InstallAIFramework": "true",
+			"Choices.AIFrameworkPreset":  "fullstack",
+		}),
+		press("enter").then(checkpoint{"Screen": "BackupConfirm"}),
+		// ...so back returns through the review to the confirm screen and forgets the preset
+		press("esc").then(checkpoint{"Screen": "WizardReview"}),
+		press("esc").then(checkpoint{
+			"Screen":                     "AIFrameworkConfirm",
+			"Choices.InstallAIFramework": "false",
+			"Choices.AIFrameworkPreset":  "",
+		}),
+		press("down", "enter").then(checkpoint{"Screen": "AIFrameworkPreset"}),
+		press("down", "enter", "enter").then(checkpoint{"Screen": "BackupConfirm", "Choices.AIFrameworkPreset": "minimal"}),
+		press("backspace", "backspace").then(checkpoint{"Screen": "AIFrameworkPreset"}),
+		press("esc", "down", "down", "enter", "enter").then(checkpoint{"Screen": "BackupConfirm", "Choices.InstallAIFramework": "false"}),
+		press("esc", "esc").then(checkpoint{"Screen": "AIFrameworkConfirm"}),
+	)
+}
+
+func TestKeyFlowWizardBackNavigation(t *testing.T) {
+	m := runFlow(t, newFlowModel(t),
+		press("enter", "enter", "enter").then(checkpoint{"Screen": "TerminalSelect"}),
+		press("down", "down", "down", "enter", "enter", "enter", "enter").then(checkpoint{"Screen": "WMExtras"}),
+		press("space", "down", "down", "down", "enter", "enter").then(checkpoint{"Screen": "ZedSelect", "Choices.WMExtras": "resurrect,kanagawa"}),
+		press("enter", "space").then(checkpoint{"Screen": "AIToolsSelect", "AIToolSelected": "x....."}),
+		press("down", "down", "down", "down", "down", "down", "down", "enter", "down", "enter").then(checkpoint{"Screen": "AIFrameworkPreset"}),
+		press("enter").then(checkpoint{"Screen": "AIFrameworkCategories"}),
+	)
+
+	// Every step back clears the choice made on the screen being left
+	runFlow(t, m,
+		press("esc").then(checkpoint{"Screen": "AIFrameworkPreset", "Cursor": "0"}),
+		press("backspace").then(checkpoint{"Screen": "AIFrameworkConfirm", "Choices.AIFrameworkPreset": ""}),
+		press("esc").then(checkpoint{"Screen": "AIToolsSelect", "Choices.InstallAIFramework": "false"}),
+		press("esc").then(checkpoint{"Screen": "ZedSelect", "Choices.AITools": "", "AIToolSelected": ""}),
+		press("backspace").then(checkpoint{"Screen": "NvimSelect", "Choices.InstallZed": "false"}),
+		// The extras come back as they were picked
+		press("esc").then(checkpoint{"Screen": "WMExtras", "Choices.InstallNvim": "false", "WMExtraSelected": ".xx"}),
+		press("esc").then(checkpoint{"Screen": "WMSelect", "Choices.WMExtras": "all", "WMExtraSelected": ""}),
+		press("esc").then(checkpoint{"Screen": "ShellSelect", "Choices.WindowMgr": ""}),
+		press("esc").then(checkpoint{"Screen": "FontSelect", "Choices.Shell": ""}),
+		press("esc").then(checkpoint{"Screen": "TerminalSelect", "Choices.InstallFont": "false"}),
+		press("esc").then(checkpoint{"Screen": "OSSelect", "Choices.Terminal": ""}),
+		press("esc").then(checkpoint{"Screen": "MainMenu", "Choices.OS": ""}),
+	)
+}
+
+func TestKeyFlowWizardSkippedScreensBackNavigation(t *testing.T) {
+	m := newFlowModel(t)
+	os.WriteFile(filepath.Join(os.Getenv("HOME"), ".zshrc"), []byte("# mine\n"), 0644)
+
+	runFlow(t, m,
+		press("enter", "enter", "enter").then(checkpoint{"Screen": "TerminalSelect"}),
+		// No terminal skips the font screen, and back skips it too
+		press("down", "down", "down", "down", "enter").then(checkpoint{"Screen": "ShellSelect", "Choices.Terminal": "none"}),
+		press("esc").then(checkpoint{"Screen": "TerminalSelect", "Choices.Shell": ""}),
+		press("down", "down", "down", "down", "enter").then(checkpoint{"Screen": "ShellSelect"}),
+		// An existing .zshrc adds the merge screen in both directions
+		press("down", "enter").then(checkpoint{"Screen": "ZshMergeSelect", "Choices.Shell": "zsh"}),
+		press("enter").then(checkpoint{"Screen": "WMSelect", "Choices.ZshMerge": "true"}),
+		press("esc").then(checkpoint{"Screen": "ZshMergeSelect"}),
+		press("esc").then(checkpoint{"Screen": "ShellSelect", "Choices.ZshMerge": "false"}),
+		press("enter").then(checkpoint{"Screen": "WMSelect", "Choices.Shell": "fish"}),
+		press("esc").then(checkpoint{"Screen": "ShellSelect"}),
+	)
+}
+
+func TestKeyFlowAIFrameworkDrillDown(t *testing.T) {
+	m := runFlow(t, newFlowModel(t),
+		press("enter", "enter", "enter", "enter", "enter", "enter", "enter", "down", "down", "down", "enter", "enter", "enter").then(checkpoint{"Screen": "AIToolsSelect"}),
+		press("space", "down", "down", "down", "down", "down", "down", "down", "enter", "down", "enter", "enter").then(checkpoint{
+			"Screen":          "AIFrameworkCategories",
+			"AISelectedCount": "0",
+		}),
+	)
+
+	runFlow(t, m,
+		press("down", "enter").then(checkpoint{"Screen": "AIFrameworkCategoryItems", "SelectedModuleCategory": "1", "Cursor": "0"}),
+		press("a").then(checkpoint{"Screen": "AIFrameworkCategoryItems"}),
+		// Back returns to the category that was open, keeping the selection
+		press("esc").then(checkpoint{"Screen": "AIFrameworkCategories", "Cursor": "1"}),
+		press("enter", "a", "backspace").then(checkpoint{"Screen": "AIFrameworkCategories", "AISelectedCount": "0"}),
+		press("esc").then(checkpoint{"Screen": "AIFrameworkPreset"}),
+	)
+}
+
+// flowSkillCatalog is a fixed catalog: two curated skills and one community skill
+func flowSkillCatalog() []SkillInfo {
+	return []SkillInfo{
+		{Name: "react-19", Category: "curated", Type: "skill", FullPath: "/catalog/curated/react-19"},
+		{Name: "typescript", Category: "curated", Type: "skill", FullPath: "/catalog/curated/typescript"},
+		{Name: "htmx", Category: "community", Type: "skill", FullPath: "/catalog/community/htmx"},
+	}
+}
+
+func TestKeyFlowSkillInstallGroupToggles(t *testing.T) {
+	// Options: Select All, 📦 Curated, react-19, typescript, 🌐 Community, htmx, ───, Confirm
+	runFlow(t, newFlowModel(t),
+		press("enter", "down", "down", "down", "down", "down").then(checkpoint{"Screen": "MainMenu", "Cursor": "5"}),
+		press("enter").then(checkpoint{"Screen": "SkillMenu", "Cursor": "0"}),
+		press("down", "enter").then(checkpoint{"Screen": "SkillInstall", "SkillLoading": "true"}),
+		send(skillsLoadedMsg{skills: flowSkillCatalog()}).then(checkpoint{"SkillLoading": "false", "SkillSelected": "..."}),
+		press("space").then(checkpoint{"SkillSelected": "xxx"}),
+		// The curated header toggles its whole group off, then one skill comes back
+		press("down", "space").then(checkpoint{"Cursor": "1", "SkillSelected": "..x"}),
+		press("down", "space").then(checkpoint{"Cursor": "2", "SkillSelected": "x.x"}),
+		press("down", "down", "space").then(checkpoint{"Cursor": "4", "SkillSelected": "x.."}),
+		press("space").then(checkpoint{"SkillSelected": "x.x"}),
+		press("down", "down").then(checkpoint{"Cursor": "7"}),
+		// Both link targets are picked when neither CLI is set up yet
+		press("enter").then(checkpoint{"Screen": "SkillTargets", "Cursor": "3"}),
+		press("enter").then(checkpoint{"Screen": "SkillResult"}),
+		send(skillActionCompleteMsg{logLines: []string{"✅ react-19", "✅ htmx"}}).then(checkpoint{
+			"Screen":         "SkillResult",
+			"SkillResultLog": "✅ react-19|✅ htmx",
+		}),
+		press("enter").then(checkpoint{"Screen": "SkillMenu", "Cursor": "0"}),
+		press("esc").then(checkpoint{"Screen": "MainMenu"}),
+	)
+}
+
+func TestKeyFlowProjectInitValidationRecovery(t *testing.T) {
+	project := t.TempDir()
+	os.WriteFile(filepath.Join(project, "go.mod"), []byte("module example.com/x\n"), 0644)
+	missing := filepath.Join(project, "does-not-exist")
+
+	runFlow(t, newFlowModel(t),
+		press("enter", "down", "down", "down", "down", "enter").then(checkpoint{"Screen": "ProjectPath"}),
+		press("ctrl+u", "enter").then(checkpoint{"Screen": "ProjectPath", "ProjectPathError": "Path cannot be empty"}),
+		typeText(missing).then(checkpoint{"ProjectPathInput": missing, "ProjectPathError": ""}),
+		press("enter").then(checkpoint{"Screen": "ProjectPath", "ProjectPathError": "Directory not found: " + missing + " (press ctrl+n to create it)"}),
+		// Deleting back to the project dir clears the error and recovers
+		pressN("backspace", len("/does-not-exist")).then(checkpoint{
+			"ProjectPathInput": project,
+			"ProjectPathError": "",
+		}),
+		// The detected stack is pre-checked with the cursor on Confirm
+		press("enter").then(checkpoint{"Screen": "ProjectStack", "ProjectStack": "go", "Cursor": "14"}),
+		press("enter").then(checkpoint{"Screen": "ProjectMemory", "ProjectStack": "go"}),
+		press("down", "down", "down", "enter").then(checkpoint{"Screen": "ProjectCI", "ProjectMemory": "simple"}),
+		press("down", "down", "down", "enter").then(checkpoint{"Screen": "ProjectConfirm", "ProjectCI": "none", "ProjectCommands": "2"}),
+		press("enter").then(checkpoint{"Screen": "ProjectInstalling", "Choices.InitProject": "true"}),
+	)
+}
+
+func TestKeyFlowProjectInitBackNavigation(t *testing.T) {
+	project := t.TempDir()
+
+	m := runFlow(t, newFlowModel(t),
+		press("enter", "down", "down", "down", "down", "enter", "ctrl+u").then(checkpoint{"Screen": "ProjectPath", "ProjectPathInput": ""}),
+		typeText(project).then(checkpoint{"ProjectPathInput": project}),
+		press("enter").then(checkpoint{"Screen": "ProjectStack"}),
+		press("backspace").then(checkpoint{"Screen": "ProjectPath", "ProjectPathInput": project}),
+		// Nothing detected: Confirm is a no-op until a stack is checked
+		press("enter", "enter", "enter").then(checkpoint{"Screen": "ProjectStack", "Cursor": "14"}),
+		press("up", "up", "space", "enter", "enter").then(checkpoint{"Screen": "ProjectMemory", "ProjectStack": "deno"}),
+		// obsidian isn't on PATH, so Obsidian Brain offers to install it first
+		press("enter").then(checkpoint{"Screen": "ProjectObsidianInstall", "ProjectMemory": "obsidian-brain"}),
+		press("enter").then(checkpoint{"Screen": "ProjectEngram", "Choices.InstallObsidian": "true"}),
+		press("enter").then(checkpoint{"Screen": "ProjectRolePack", "ProjectEngram": "true"}),
+		press("down", "space", "down", "down", "down").then(checkpoint{"Cursor": "4"}),
+		press("enter").then(checkpoint{"Screen": "ProjectCI", "ProjectRolePacks": "core,developer"}),
+		press("enter").then(checkpoint{"Screen": "ProjectConfirm", "ProjectCI": "github"}),
+	)
+
+	runFlow(t, m,
+		press("backspace").then(checkpoint{"Screen": "ProjectCI"}),
+		press("backspace").then(checkpoint{"Screen": "ProjectRolePack"}),
+		press("esc").then(checkpoint{"Screen": "ProjectEngram", "ProjectRolePacks": ""}),
+		press("backspace").then(checkpoint{"Screen": "ProjectObsidianInstall"}),
+		press("backspace").then(checkpoint{"Screen": "ProjectMemory"}),
+		press("backspace").then(checkpoint{"Screen": "ProjectStack"}),
+		press("backspace").then(checkpoint{"Screen": "ProjectPath"}),
+		press("esc").then(checkpoint{"Screen": "MainMenu", "Cursor": "0"}),
+	)
+}
+
+// TestKeyFlowCheckpointDiff keeps the failure output readable
+func TestKeyFlowCheckpointDiff(t *testing.T) {
+	m := newFlowModel(t)
+	m.Screen = ScreenShellSelect
+	m.Choices.Terminal = "none"
+
+	diff := checkpoint{"Screen": "FontSelect", "Choices.Terminal": "none", "Bogus": "x"}.diff(m)
+	lines := strings.Split(diff, "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 diff lines (unknown field, screen), got:\n%s", diff)
+	}
+	if !strings.Contains(lines[0], "Bogus") || !strings.Contains(lines[0], "unknown field") {
+		t.Errorf("expected the unknown field first, got %q", lines[0])
+	}
+	if !strings.Contains(lines[1], `want "FontSelect"`) || !strings.Contains(lines[1], `got "ShellSelect"`) {
+		t.Errorf("expected want/got for Screen, got %q", lines[1])
+	}
+}
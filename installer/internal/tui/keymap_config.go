@@ -0,0 +1,585 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// configBinding is a key binding read from an installed config file
+type configBinding struct {
+	Keys    string // In the notation of the keymap tables, e.g. "Ctrl+a v"
+	Mode    string // Vim modes ("n,v"), tmux "copy", zellij mode; "" for any
+	Action  string // What the config runs, as written there
+	Desc    string // The config's own description (nvim desc, tmux -N); "" if none
+	Unbound bool   // The config removes the binding
+}
+
+// keymapConfigSource is an installed config that Refresh reads bindings from
+type keymapConfigSource struct {
+	Tool   string
+	Paths  []string // Relative to the home directory; the first one found is read
+	Parse  func(string) ([]configBinding, error)
+	Static func() []KeymapCategory
+	Set    func(*Model, []KeymapCategory)
+}
+
+var keymapConfigSources = []keymapConfigSource{
+	{"Neovim", []string{".config/nvim/lua/config/keymaps.lua"}, parseNvimKeymapConfig, GetNvimKeymaps,
+		func(m *Model, c []KeymapCategory) { m.KeymapCategories = c }},
+	{"Tmux", []string{".tmux.conf", ".config/tmux/tmux.conf"}, parseTmuxKeymapConfig, GetTmuxKeymaps,
+		func(m *Model, c []KeymapCategory) { m.TmuxKeymapCategories = c }},
+	{"Zellij", []string{".config/zellij/config.kdl"}, parseZellijKeymapConfig, GetZellijKeymaps,
+		func(m *Model, c []KeymapCategory) { m.ZellijKeymapCategories = c }},
+	{"Ghostty", []string{".config/ghostty/config"}, parseGhosttyKeymapConfig, GetGhosttyKeymaps,
+		func(m *Model, c []KeymapCategory) { m.GhosttyKeymapCategories = c }},
+}
+
+// configKeymapCategory names the category that collects undocumented bindings
+const configKeymapCategory = "From Your Config"
+
+// refreshKeymapsFromConfig rebuilds each tool's keymaps from the documented
+// tables merged with its installed config, noting per tool how many bindings
+// were found. A config that can't be read or parsed leaves the documented
+// keymaps in place with a warning.
+func (m Model) refreshKeymapsFromConfig(home string) Model {
+	m.KeymapRefreshNotes = nil
+	for _, src := range keymapConfigSources {
+		categories := src.Static()
+		note := src.Tool + ": no config found, showing the documented keymaps"
+		for _, rel := range src.Paths {
+			path := filepath.Join(home, rel)
+			data, err := os.ReadFile(path)
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			if err == nil {
+				var found []configBinding
+				if found, err = src.Parse(string(data)); err == nil {
+					var n int
+					categories, n = mergeConfigKeymaps(categories, found, contractHome(path))
+					note = fmt.Sprintf("%s: %d binding(s) found in %s", src.Tool, n, contractHome(path))
+					break
+				}
+			}
+			note = fmt.Sprintf("⚠ %s: could not parse %s (%v), showing the documented keymaps", src.Tool, contractHome(path), err)
+			break
+		}
+		src.Set(&m, categories)
+		m.KeymapRefreshNotes = append(m.KeymapRefreshNotes, note)
+	}
+	return m
+}
+
+// mergeConfigKeymaps marks the documented keymaps the config binds differently
+// (Keymap.Config) and collects the bindings the tables don't document in a
+// configKeymapCategory at the end. It returns the categories and the number
+// of bindings the config defines.
+func mergeConfigKeymaps(categories []KeymapCategory, found []configBinding, path string) ([]KeymapCategory, int) {
+	extra := KeymapCategory{
+		Name:        configKeymapCategory,
+		Description: "Bindings in " + path + " that the reference doesn't document",
+	}
+	bound := 0
+	for _, b := range found {
+		if !b.Unbound {
+			bound++
+		}
+		documented := false
+		for ci := range categories {
+			for ki := range categories[ci].Keymaps {
+				km := &categories[ci].Keymaps[ki]
+				if !keymapMatches(*km, b) {
+					continue
+				}
+				documented = true
+				switch {
+				case b.Unbound:
+					km.Config = "unbound in your config"
+				case b.Desc != "" && !strings.EqualFold(b.Desc, km.Description):
+					km.Config = "your config: " + b.Desc
+				}
+			}
+		}
+		if documented || b.Unbound {
+			continue
+		}
+		desc := b.Desc
+		if desc == "" {
+			desc = b.Action
+		}
+		extra.Keymaps = append(extra.Keymaps, Keymap{Keys: b.Keys, Description: desc, Mode: b.Mode})
+	}
+	if len(extra.Keymaps) > 0 {
+		categories = append(categories, extra)
+	}
+	return categories, bound
+}
+
+// keymapMatches reports whether a documented keymap covers a config binding:
+// one of its keys (after expanding "h/j/k/l" and "1-9") is the binding's and
+// the modes agree, an empty mode on either side matching any
+func keymapMatches(km Keymap, b configBinding) bool {
+	if km.Mode != "" && b.Mode != "" && !strings.Contains(","+b.Mode+",", ","+km.Mode+",") {
+		return false
+	}
+	for _, k := range expandKeymapKeys(km.Keys) {
+		if k == b.Keys {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	keyModifiersRe = regexp.MustCompile(`^((?:(?:Ctrl|Alt|Shift|Cmd|Super)\+)*)(.+)$`)
+	keyRangeRe     = regexp.MustCompile(`^(\d)-(\d)$`)
+)
+
+// expandKeymapKeys spells out the alternatives a table entry lists in its last
+// key: "Ctrl+a h/l" is "Ctrl+a h" and "Ctrl+a l", "Cmd+1-9" is Cmd+1 to Cmd+9
+func expandKeymapKeys(keys string) []string {
+	head, last := "", keys
+	if i := strings.LastIndex(keys, " "); i >= 0 {
+		head, last = keys[:i+1], keys[i+1:]
+	}
+	parts := keyModifiersRe.FindStringSubmatch(last)
+	if parts == nil {
+		return []string{keys}
+	}
+	mods, key := parts[1], parts[2]
+
+	var alts []string
+	if r := keyRangeRe.FindStringSubmatch(key); r != nil {
+		for d := r[1][0]; d <= r[2][0]; d++ {
+			alts = append(alts, string(d))
+		}
+	} else if len(key) > 1 && strings.Contains(key, "/") {
+		alts = strings.Split(key, "/")
+		for _, a := range alts {
+			if a == "" {
+				return []string{keys}
+			}
+		}
+	} else {
+		return []string{keys}
+	}
+
+	expanded := make([]string, len(alts))
+	for i, a := range alts {
+		expanded[i] = head + mods + a
+	}
+	return expanded
+}
+
+// keyModifierNames maps the modifier spellings of the configs to the tables'
+var keyModifierNames = map[string]string{
+	"c": "Ctrl", "ctrl": "Ctrl", "control": "Ctrl",
+	"m": "Alt", "alt": "Alt", "meta": "Alt", "opt": "Alt", "option": "Alt",
+	"s": "Shift", "shift": "Shift",
+	"cmd": "Cmd", "command": "Cmd", "super": "Cmd",
+}
+
+// keyNames maps the named keys of the configs to the tables' spelling
+var keyNames = map[string]string{
+	"left": "←", "right": "→", "up": "↑", "down": "↓",
+	"space": "Space", "enter": "Enter", "return": "Enter", "tab": "Tab",
+	"esc": "Esc", "escape": "Esc", "bspace": "Backspace", "backspace": "Backspace",
+	"home": "Home", "end": "End",
+	"pageup": "PageUp", "page_up": "PageUp", "ppage": "PageUp",
+	"pagedown": "PageDown", "page_down": "PageDown", "npage": "PageDown",
+	"equal": "=", "minus": "-", "plus": "+", "comma": ",", "period": ".", "slash": "/",
+	"bracket_left": "[", "bracket_right": "]",
+}
+
+// formatKey spells a key and its modifiers like the keymap tables: "Ctrl+Shift+h"
+func formatKey(mods []string, key string) string {
+	var s strings.Builder
+	for _, mod := range mods {
+		if name, ok := keyModifierNames[strings.ToLower(mod)]; ok {
+			mod = name
+		}
+		s.WriteString(mod + "+")
+	}
+	if name, ok := keyNames[strings.ToLower(key)]; ok && len(key) > 1 {
+		key = name
+	}
+	s.WriteString(key)
+	return s.String()
+}
+
+// lineOf is the 1-based line of offset i in src
+func lineOf(src string, i int) int {
+	return strings.Count(src[:i], "\n") + 1
+}
+
+var (
+	luaStringRe = regexp.MustCompile(`"((?:[^"\\]|\\.)*)"|'((?:[^'\\]|\\.)*)'`)
+	luaDescRe   = regexp.MustCompile(`\bdesc\s*=\s*("(?:[^"\\]|\\.)*"|'(?:[^'\\]|\\.)*')`)
+)
+
+// parseNvimKeymapConfig finds the vim.keymap.set calls of a keymaps.lua
+func parseNvimKeymapConfig(src string) ([]configBinding, error) {
+	const call = "vim.keymap.set("
+	var found []configBinding
+	for offset := 0; ; {
+		i := strings.Index(src[offset:], call)
+		if i < 0 {
+			return found, nil
+		}
+		start := offset + i
+		if lineStart := strings.LastIndex(src[:start], "\n") + 1; strings.Contains(src[lineStart:start], "--") {
+			// Commented out
+			offset = start + len(call)
+			continue
+		}
+		args, end, err := luaCallArgs(src, start+len(call))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineOf(src, start), err)
+		}
+		offset = end
+		if len(args) < 3 {
+			return nil, fmt.Errorf("line %d: vim.keymap.set needs a mode, keys and an action", lineOf(src, start))
+		}
+		keys, ok := luaString(args[1])
+		if !ok {
+			// Keys built at runtime can't be listed
+			continue
+		}
+
+		var modes []string
+		for _, mode := range luaStringRe.FindAllString(args[0], -1) {
+			mode, _ = luaString(mode)
+			modes = append(modes, mode)
+		}
+		action, ok := luaString(args[2])
+		if !ok {
+			action = args[2]
+			if strings.HasPrefix(action, "function") {
+				action = "Lua function"
+			}
+		}
+		b := configBinding{Keys: keys, Mode: strings.Join(modes, ","), Action: action}
+		if len(args) > 3 {
+			if desc := luaDescRe.FindStringSubmatch(args[3]); desc != nil {
+				b.Desc, _ = luaString(desc[1])
+			}
+		}
+		found = append(found, b)
+	}
+}
+
+// luaCallArgs splits the arguments of the Lua call whose "(" ends just before
+// open, skipping strings and comments; end is the offset after its ")"
+func luaCallArgs(src string, open int) (args []string, end int, err error) {
+	depth, argStart := 0, open
+	for i := open; i < len(src); i++ {
+		switch c := src[i]; {
+		case c == '"' || c == '\'':
+			j := i + 1
+			for ; j < len(src) && src[j] != c; j++ {
+				if src[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(src) {
+				return nil, 0, errors.New("unterminated string")
+			}
+			i = j
+		case strings.HasPrefix(src[i:], "[["):
+			j := strings.Index(src[i:], "]]")
+			if j < 0 {
+				return nil, 0, errors.New("unterminated [[ string")
+			}
+			i += j + 1
+		case strings.HasPrefix(src[i:], "--"):
+			if strings.HasPrefix(src[i:], "--[[") {
+				j := strings.Index(src[i:], "]]")
+				if j < 0 {
+					return nil, 0, errors.New("unterminated comment")
+				}
+				i += j + 1
+			} else if j := strings.IndexByte(src[i:], '\n'); j >= 0 {
+				i += j
+			} else {
+				i = len(src)
+			}
+		case c == '(' || c == '{' || c == '[':
+			depth++
+		case c == ')' && depth == 0:
+			if arg := strings.TrimSpace(src[argStart:i]); arg != "" || len(args) > 0 {
+				args = append(args, arg)
+			}
+			return args, i + 1, nil
+		case c == ')' || c == '}' || c == ']':
+			depth--
+		case c == ',' && depth == 0:
+			args = append(args, strings.TrimSpace(src[argStart:i]))
+			argStart = i + 1
+		}
+	}
+	return nil, 0, errors.New("unterminated call")
+}
+
+// luaString is the value of a Lua string literal ("", ” or [[ ]])
+func luaString(lit string) (string, bool) {
+	lit = strings.TrimSpace(lit)
+	if strings.HasPrefix(lit, "[[") && strings.HasSuffix(lit, "]]") && len(lit) >= 4 {
+		return lit[2 : len(lit)-2], true
+	}
+	m := luaStringRe.FindStringSubmatch(lit)
+	if m == nil || len(m[0]) != len(lit) {
+		return "", false
+	}
+	s := m[1] + m[2]
+	return strings.NewReplacer(`\\`, `\`, `\"`, `"`, `\'`, `'`).Replace(s), true
+}
+
+// parseTmuxKeymapConfig reads the bind-key and unbind-key lines of a
+// .tmux.conf. Prefix bindings get the configured prefix in front.
+func parseTmuxKeymapConfig(src string) ([]configBinding, error) {
+	type bindLine struct {
+		fields []string
+		line   int
+	}
+	prefix := "C-b"
+	var binds []bindLine
+	for n, line := range strings.Split(src, "\n") {
+		switch first, _, _ := strings.Cut(strings.TrimSpace(line), " "); first {
+		case "set", "set-option", "bind", "bind-key", "unbind", "unbind-key":
+		default:
+			continue
+		}
+		fields, err := shellFields(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n+1, err)
+		}
+		switch fields[0] {
+		case "set", "set-option":
+			for i, f := range fields[1:] {
+				if f == "prefix" && i+2 < len(fields) {
+					prefix = fields[i+2]
+				}
+			}
+		case "bind", "bind-key", "unbind", "unbind-key":
+			binds = append(binds, bindLine{fields, n + 1})
+		}
+	}
+
+	var found []configBinding
+	for _, bl := range binds {
+		table, note := "prefix", ""
+		unbind := strings.HasPrefix(bl.fields[0], "unbind")
+		rest := bl.fields[1:]
+		for len(rest) > 0 && strings.HasPrefix(rest[0], "-") && len(rest[0]) > 1 {
+			switch flag := rest[0]; flag {
+			case "-n":
+				table = "root"
+			case "-T", "-N":
+				if len(rest) < 2 {
+					return nil, fmt.Errorf("line %d: %s needs a value", bl.line, flag)
+				}
+				if flag == "-T" {
+					table = rest[1]
+				} else {
+					note = rest[1]
+				}
+				rest = rest[1:]
+			case "-a":
+				// unbind -a drops a whole table; nothing to list
+				rest = nil
+				continue
+			}
+			rest = rest[1:]
+		}
+		if len(rest) == 0 {
+			if unbind {
+				continue
+			}
+			return nil, fmt.Errorf("line %d: %s without a key", bl.line, bl.fields[0])
+		}
+
+		b := configBinding{Keys: tmuxKey(rest[0]), Action: strings.TrimSpace(strings.TrimSuffix(strings.Join(rest[1:], " "), "{")), Desc: note, Unbound: unbind}
+		switch {
+		case table == "prefix":
+			b.Keys = tmuxKey(prefix) + " " + b.Keys
+		case strings.HasPrefix(table, "copy-mode"):
+			b.Mode = "copy"
+		case table != "root":
+			b.Mode = table
+		}
+		found = append(found, b)
+	}
+	return found, nil
+}
+
+// tmuxKey spells a tmux key ("C-a", "M-Left", "^b") like the keymap tables
+func tmuxKey(key string) string {
+	var mods []string
+	if len(key) == 2 && key[0] == '^' {
+		return formatKey([]string{"C"}, key[1:])
+	}
+	for len(key) > 2 && key[1] == '-' && strings.ContainsRune("CMS", rune(key[0])) {
+		mods = append(mods, key[:1])
+		key = key[2:]
+	}
+	return formatKey(mods, key)
+}
+
+// shellFields splits a config line into words, honoring quotes
+func shellFields(line string) ([]string, error) {
+	var fields []string
+	var word strings.Builder
+	inWord := false
+	for i := 0; i < len(line); i++ {
+		switch c := line[i]; c {
+		case ' ', '\t':
+			if inWord {
+				fields = append(fields, word.String())
+				word.Reset()
+				inWord = false
+			}
+		case '#':
+			if !inWord {
+				// A comment runs to the end of the line
+				i = len(line)
+				continue
+			}
+			word.WriteByte(c)
+		case '"', '\'':
+			j := strings.IndexByte(line[i+1:], c)
+			if j < 0 {
+				return nil, errors.New("unterminated quote")
+			}
+			word.WriteString(line[i+1 : i+1+j])
+			i += j + 1
+			inWord = true
+		case '\\':
+			if i+1 < len(line) {
+				i++
+				word.WriteByte(line[i])
+			}
+			inWord = true
+		default:
+			word.WriteByte(c)
+			inWord = true
+		}
+	}
+	if inWord {
+		fields = append(fields, word.String())
+	}
+	return fields, nil
+}
+
+var (
+	kdlBlockRe = regexp.MustCompile(`^([\w-]+)((?:\s+"[^"]*")*)[^{]*\{$`)
+	kdlBindRe  = regexp.MustCompile(`^(un)?bind((?:\s+"[^"]*")+)\s*(?:\{(.*)\})?;?$`)
+	kdlQuoteRe = regexp.MustCompile(`"([^"]*)"`)
+)
+
+// parseZellijKeymapConfig reads the keybinds block of a zellij config.kdl;
+// each binding takes the mode of the block it is in, shared blocks none
+func parseZellijKeymapConfig(src string) ([]configBinding, error) {
+	var found []configBinding
+	var stack []string // Names of the open blocks
+	lines := strings.Split(src, "\n")
+	for n := 0; n < len(lines); n++ {
+		line := kdlLine(lines[n])
+		inKeybinds := len(stack) >= 2 && stack[0] == "keybinds"
+
+		// A bind whose actions span lines is joined into one
+		if inKeybinds && strings.HasPrefix(line, "bind ") && strings.Count(line, "{") > strings.Count(line, "}") {
+			start := n
+			for strings.Count(line, "{") > strings.Count(line, "}") {
+				if n++; n >= len(lines) {
+					return nil, fmt.Errorf("line %d: unterminated bind", start+1)
+				}
+				line += " " + kdlLine(lines[n])
+			}
+		}
+
+		switch {
+		case line == "":
+		case line == "}":
+			if len(stack) == 0 {
+				return nil, fmt.Errorf("line %d: unexpected }", n+1)
+			}
+			stack = stack[:len(stack)-1]
+		case inKeybinds && kdlBindRe.MatchString(line):
+			m := kdlBindRe.FindStringSubmatch(line)
+			mode := stack[len(stack)-1]
+			if strings.HasPrefix(mode, "shared") {
+				mode = ""
+			}
+			action := strings.TrimSuffix(strings.TrimSpace(m[3]), ";")
+			for _, key := range kdlQuoteRe.FindAllStringSubmatch(m[2], -1) {
+				found = append(found, configBinding{Keys: zellijKey(key[1]), Mode: mode, Action: action, Unbound: m[1] != ""})
+			}
+		case strings.HasSuffix(line, "{"):
+			m := kdlBlockRe.FindStringSubmatch(line)
+			if m == nil {
+				return nil, fmt.Errorf("line %d: unexpected %q", n+1, line)
+			}
+			stack = append(stack, m[1])
+		}
+	}
+	if len(stack) > 0 {
+		return nil, fmt.Errorf("unclosed %s block", stack[len(stack)-1])
+	}
+	return found, nil
+}
+
+// kdlLine is a KDL line without its // comment and surrounding space
+func kdlLine(line string) string {
+	if i := strings.Index(line, "//"); i >= 0 && strings.Count(line[:i], `"`)%2 == 0 {
+		line = line[:i]
+	}
+	return strings.TrimSpace(line)
+}
+
+// zellijKey spells a zellij key ("Ctrl g", "Alt left") like the keymap tables
+func zellijKey(key string) string {
+	words := strings.Fields(key)
+	if len(words) == 0 {
+		return key
+	}
+	return formatKey(words[:len(words)-1], words[len(words)-1])
+}
+
+// parseGhosttyKeymapConfig reads the keybind lines of a ghostty config
+func parseGhosttyKeymapConfig(src string) ([]configBinding, error) {
+	var found []configBinding
+	for n, line := range strings.Split(src, "\n") {
+		key, value, ok := strings.Cut(strings.TrimSpace(line), "=")
+		if !ok || strings.TrimSpace(key) != "keybind" {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		if value == "clear" {
+			continue
+		}
+		// The trigger can't hold "=" unescaped, so the first one ends it
+		trigger, action, ok := strings.Cut(value, "=")
+		if !ok || trigger == "" {
+			return nil, fmt.Errorf("line %d: keybind %q has no action", n+1, value)
+		}
+		for _, p := range []string{"global:", "all:", "unconsumed:", "performable:"} {
+			trigger = strings.TrimPrefix(trigger, p)
+		}
+
+		var keys []string
+		for _, step := range strings.Split(trigger, ">") {
+			parts := strings.Split(step, "+")
+			if len(parts) > 1 && parts[len(parts)-1] == "" {
+				// "ctrl++" binds the plus key
+				parts = append(parts[:len(parts)-2], "+")
+			}
+			keys = append(keys, formatKey(parts[:len(parts)-1], parts[len(parts)-1]))
+		}
+		found = append(found, configBinding{Keys: strings.Join(keys, " "), Action: action, Unbound: action == "unbind"})
+	}
+	return found, nil
+}
@@ -0,0 +1,165 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestParseKeymapConfigs(t *testing.T) {
+	tests := []struct {
+		fixture string
+		parse   func(string) ([]configBinding, error)
+		want    []configBinding
+	}{
+		{"keymaps.lua", parseNvimKeymapConfig, []configBinding{
+			{Keys: "<C-c>", Mode: "i,n,v", Action: `<C-\><C-n>`},
+			{Keys: `<C-\>`, Mode: "n", Action: "nvim_tmux_nav.NvimTmuxNavigateLastActive"},
+			{Keys: "<leader>bq", Mode: "n", Action: "<Esc>:%bdelete|edit #|normal`\"<Return>", Desc: "Delete other buffers but the current one"},
+			{Keys: "<leader>fm", Mode: "n", Action: "Lua function", Desc: "Open mini.files here"},
+		}},
+		{"tmux.conf", parseTmuxKeymapConfig, []configBinding{
+			{Keys: "Ctrl+a Ctrl+b", Unbound: true},
+			{Keys: "Ctrl+a Ctrl+a", Action: "send-prefix"},
+			{Keys: `Ctrl+a "`, Unbound: true},
+			{Keys: "Ctrl+a v", Action: "split-window -h -c #{pane_current_path}"},
+			{Keys: "Alt+g", Action: "if-shell -F #{==:#{session_name},scratch}"},
+			{Keys: "Ctrl+a K", Action: "confirm-before -p Kill all other sessions? (y/n) kill-session -a", Desc: "Kill other sessions"},
+			{Keys: "Ctrl+a r", Action: "source-file ~/.tmux.conf", Desc: "Reload the config"},
+			{Keys: "v", Mode: "copy", Action: "send-keys -X begin-selection"},
+		}},
+		{"config.kdl", parseZellijKeymapConfig, []configBinding{
+			{Keys: "Ctrl+g", Mode: "locked", Action: `SwitchToMode "normal"`},
+			{Keys: "h", Mode: "pane", Action: `MoveFocus "left"`},
+			{Keys: "←", Mode: "pane", Action: `MoveFocus "left"`},
+			{Keys: "x", Mode: "pane", Action: `CloseFocus; SwitchToMode "locked"`},
+			{Keys: "Alt+y", Action: `LaunchOrFocusPlugin "zellij_forgot" { floating true }`},
+			{Keys: "Ctrl+q", Unbound: true},
+		}},
+		{"ghostty", parseGhosttyKeymapConfig, []configBinding{
+			{Keys: "Alt+v", Action: "new_split:right"},
+			{Keys: "Ctrl+Shift+j", Action: "resize_split:up,10"},
+			{Keys: "Ctrl+grave_accent", Action: "toggle_quick_terminal"},
+			{Keys: "Ctrl+a n", Action: "new_window"},
+			{Keys: "Alt+←", Action: "unbind", Unbound: true},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			data, err := os.ReadFile(filepath.Join("testdata", "keymap-configs", tt.fixture))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := tt.parse(string(data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got  %+v\nwant %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseKeymapConfigErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		parse func(string) ([]configBinding, error)
+		src   string
+	}{
+		{"unclosed lua call", parseNvimKeymapConfig, `vim.keymap.set("n", "<leader>x", "<cmd>X<CR>"`},
+		{"lua call without action", parseNvimKeymapConfig, `vim.keymap.set("n", "<leader>x")`},
+		{"tmux table without name", parseTmuxKeymapConfig, "bind -T"},
+		{"tmux unterminated quote", parseTmuxKeymapConfig, `set -g status-left "oops`},
+		{"zellij unclosed block", parseZellijKeymapConfig, "keybinds {\n    locked {\n"},
+		{"zellij stray brace", parseZellijKeymapConfig, "}"},
+		{"ghostty keybind without action", parseGhosttyKeymapConfig, "keybind = ctrl+x"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.parse(tt.src); err == nil {
+				t.Error("expected a parse error")
+			}
+		})
+	}
+}
+
+func TestMergeConfigKeymaps(t *testing.T) {
+	static := []KeymapCategory{{Name: "Panes", Keymaps: []Keymap{
+		{Keys: "Ctrl+a h/l", Description: "Previous/next pane"},
+		{Keys: "Ctrl+a K", Description: "Kill all other sessions"},
+		{Keys: "Ctrl+a 0-9", Description: "Switch to window number"},
+		{Keys: "x", Description: "Close pane", Mode: "pane"},
+	}}}
+	found := []configBinding{
+		{Keys: "Ctrl+a l", Action: "select-pane -R"},
+		{Keys: "Ctrl+a K", Desc: "Kill other sessions"},
+		{Keys: "Ctrl+a 7", Unbound: true},
+		{Keys: "x", Mode: "tab", Action: "CloseTab"},
+		{Keys: "Ctrl+a r", Desc: "Reload the config"},
+	}
+
+	merged, n := mergeConfigKeymaps(static, found, "~/.tmux.conf")
+	if n != 4 {
+		t.Errorf("counted %d bindings, want 4 (unbinds left out)", n)
+	}
+	keymaps := merged[0].Keymaps
+	if keymaps[0].Config != "" {
+		t.Errorf("a documented binding should stay as it is, got %q", keymaps[0].Config)
+	}
+	if keymaps[1].Config != "your config: Kill other sessions" || keymaps[2].Config != "unbound in your config" {
+		t.Errorf("changed bindings should be marked, got %q and %q", keymaps[1].Config, keymaps[2].Config)
+	}
+	if len(merged) != 2 || merged[1].Name != configKeymapCategory {
+		t.Fatalf("undocumented bindings should get their own category, got %d categories", len(merged))
+	}
+	want := []Keymap{{Keys: "x", Mode: "tab", Description: "CloseTab"}, {Keys: "Ctrl+a r", Description: "Reload the config"}}
+	if !reflect.DeepEqual(merged[1].Keymaps, want) {
+		t.Errorf("got %+v, want %+v", merged[1].Keymaps, want)
+	}
+}
+
+func TestRefreshKeymapsFromConfig(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	tmux, _ := os.ReadFile(filepath.Join("testdata", "keymap-configs", "tmux.conf"))
+	os.WriteFile(filepath.Join(home, ".tmux.conf"), tmux, 0644)
+	os.MkdirAll(filepath.Join(home, ".config", "ghostty"), 0755)
+	os.WriteFile(filepath.Join(home, ".config", "ghostty", "config"), []byte("keybind = ctrl+x\n"), 0644)
+
+	m := NewModel()
+	m.Screen = ScreenKeymapsMenu
+	m.Cursor = 4
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = result.(Model)
+
+	want := []string{
+		"Neovim: no config found, showing the documented keymaps",
+		"Tmux: 6 binding(s) found in ~/.tmux.conf",
+		"Zellij: no config found, showing the documented keymaps",
+		"⚠ Ghostty: could not parse ~/.config/ghostty/config (line 1: keybind \"ctrl+x\" has no action), showing the documented keymaps",
+	}
+	if !reflect.DeepEqual(m.KeymapRefreshNotes, want) {
+		t.Errorf("notes = %q", m.KeymapRefreshNotes)
+	}
+	if !reflect.DeepEqual(m.GhosttyKeymapCategories, GetGhosttyKeymaps()) || !reflect.DeepEqual(m.KeymapCategories, GetNvimKeymaps()) {
+		t.Error("a missing or broken config should leave the documented keymaps")
+	}
+	last := m.TmuxKeymapCategories[len(m.TmuxKeymapCategories)-1]
+	if last.Name != configKeymapCategory || !strings.Contains(last.Description, "~/.tmux.conf") {
+		t.Errorf("undocumented tmux bindings should be listed, got %q", last.Name)
+	}
+	if view := m.View(); !strings.Contains(view, "Tmux: 6 binding(s)") || !strings.Contains(view, "could not parse") {
+		t.Error("the menu should show the outcome per tool")
+	}
+
+	// Refreshing again starts from the documented tables
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if got := len(result.(Model).TmuxKeymapCategories); got != len(m.TmuxKeymapCategories) {
+		t.Errorf("a second refresh should not add categories again, got %d", got)
+	}
+}
@@ -0,0 +1,246 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// keymapViewer ties a keymap menu screen to its dataset and category view.
+// The four keymap datasets (Neovim, Tmux, Zellij, Ghostty) are searched and
+// opened the same way through it.
+type keymapViewer struct {
+	category Screen
+	// state returns the viewer's categories plus pointers to its selected
+	// category and scroll offset
+	state func(m *Model) (categories []KeymapCategory, selected, scroll *int)
+}
+
+var keymapViewers = map[Screen]keymapViewer{
+	ScreenKeymaps: {ScreenKeymapCategory, func(m *Model) ([]KeymapCategory, *int, *int) {
+		return m.KeymapCategories, &m.SelectedCategory, &m.KeymapScroll
+	}},
+	ScreenKeymapsTmux: {ScreenKeymapsTmuxCat, func(m *Model) ([]KeymapCategory, *int, *int) {
+		return m.TmuxKeymapCategories, &m.TmuxSelectedCategory, &m.TmuxKeymapScroll
+	}},
+	ScreenKeymapsZellij: {ScreenKeymapsZellijCat, func(m *Model) ([]KeymapCategory, *int, *int) {
+		return m.ZellijKeymapCategories, &m.ZellijSelectedCategory, &m.ZellijKeymapScroll
+	}},
+	ScreenKeymapsGhostty: {ScreenKeymapsGhosttyCat, func(m *Model) ([]KeymapCategory, *int, *int) {
+		return m.GhosttyKeymapCategories, &m.GhosttySelectedCategory, &m.GhosttyKeymapScroll
+	}},
+}
+
+// keymapHit is a keymap matching a search, with where it sits in its dataset
+type keymapHit struct {
+	Category int // Index into the dataset's categories
+	Row      int // Index into the category's keymaps
+	Keymap   Keymap
+}
+
+// searchKeymaps returns the keymaps of every category whose keys or
+// description contain each word of query, ignoring case, in dataset order
+func searchKeymaps(categories []KeymapCategory, query string) []keymapHit {
+	words := strings.Fields(strings.ToLower(query))
+	if len(words) == 0 {
+		return nil
+	}
+	var hits []keymapHit
+	for c, cat := range categories {
+		for r, km := range cat.Keymaps {
+			text := strings.ToLower(km.Keys + " " + km.Description)
+			matches := true
+			for _, w := range words {
+				if !strings.Contains(text, w) {
+					matches = false
+					break
+				}
+			}
+			if matches {
+				hits = append(hits, keymapHit{Category: c, Row: r, Keymap: km})
+			}
+		}
+	}
+	return hits
+}
+
+// keymapSearching reports whether the keymap menu on screen shows search results
+func (m Model) keymapSearching() bool {
+	_, ok := keymapViewers[m.Screen]
+	return ok && (m.KeymapSearchActive || m.KeymapSearch != "")
+}
+
+// keymapSearchHits runs the current search on the dataset of the menu on screen
+func (m Model) keymapSearchHits() []keymapHit {
+	viewer, ok := keymapViewers[m.Screen]
+	if !ok {
+		return nil
+	}
+	categories, _, _ := viewer.state(&m)
+	return searchKeymaps(categories, m.KeymapSearch)
+}
+
+// keymapSearchVisible is how many results fit on screen (same budget as the category views)
+func (m Model) keymapSearchVisible() int {
+	return m.visibleRows(9)
+}
+
+// clearKeymapSearch leaves search mode and shows the categories again
+func (m *Model) clearKeymapSearch() {
+	m.KeymapSearch = ""
+	m.KeymapSearchActive = false
+	m.KeymapSearchScroll = 0
+	m.Cursor = 0
+}
+
+// handleKeymapSearchKeys handles "/" on a keymap menu and every key while it
+// shows search results. The cursor moves over the results; Enter opens the
+// category of the one under it, scrolled to its row.
+func (m Model) handleKeymapSearchKeys(key string) (tea.Model, tea.Cmd) {
+	if !m.keymapSearching() {
+		// "/" starts a search
+		m.KeymapSearchActive = true
+		m.KeymapSearchScroll = 0
+		m.Cursor = 0
+		return m, nil
+	}
+
+	hits := m.keymapSearchHits()
+	switch key {
+	case "esc":
+		m.clearKeymapSearch()
+		return m, nil
+	case "up":
+		if m.Cursor > 0 {
+			m.Cursor--
+		}
+	case "down":
+		if m.Cursor < len(hits)-1 {
+			m.Cursor++
+		}
+	case "enter":
+		if m.Cursor >= len(hits) {
+			m.KeymapSearchActive = false
+			return m, nil
+		}
+		return m.openKeymapHit(hits[m.Cursor]), nil
+	default:
+		if !m.KeymapSearchActive {
+			switch key {
+			case "k":
+				return m.handleKeymapSearchKeys("up")
+			case "j":
+				return m.handleKeymapSearchKeys("down")
+			case "/":
+				m.KeymapSearchActive = true
+			}
+			return m, nil
+		}
+		if key == "backspace" {
+			r := []rune(m.KeymapSearch)
+			if len(r) == 0 {
+				return m, nil
+			}
+			m.KeymapSearch = string(r[:len(r)-1])
+		} else if key == " " || utf8.RuneCountInString(key) == 1 && utf8.RuneCountInString(m.KeymapSearch) < 40 {
+			m.KeymapSearch += key
+		} else {
+			return m, nil
+		}
+		m.Cursor = 0
+		m.KeymapSearchScroll = 0
+		return m, nil
+	}
+
+	// Keep the cursor inside the visible window
+	visible := m.keymapSearchVisible()
+	if m.Cursor < m.KeymapSearchScroll {
+		m.KeymapSearchScroll = m.Cursor
+	} else if m.Cursor >= m.KeymapSearchScroll+visible {
+		m.KeymapSearchScroll = m.Cursor - visible + 1
+	}
+	return m, nil
+}
+
+// openKeymapHit shows the category of hit with its row at the top, or as far
+// down as the view scrolls. The search is kept, so going back shows the results.
+func (m Model) openKeymapHit(hit keymapHit) Model {
+	viewer := keymapViewers[m.Screen]
+	categories, selected, scroll := viewer.state(&m)
+	maxScroll := max(len(categories[hit.Category].Keymaps)-m.keymapSearchVisible(), 0)
+
+	*selected = hit.Category
+	*scroll = min(hit.Row, maxScroll)
+	m.KeymapSearchActive = false
+	m.Screen = viewer.category
+	return m
+}
+
+// renderKeymapSearch renders the search box and the matching keymaps of every
+// category of the menu on screen, in place of its category list
+func (m Model) renderKeymapSearch() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.T("keymap_search.desc")))
+	s.WriteString("\n\n")
+
+	if m.KeymapSearchActive {
+		s.WriteString(m.Theme.Highlight.Render("  / " + m.KeymapSearch + "█"))
+	} else {
+		s.WriteString(m.Theme.Info.Render("  "+m.Tf("common.search", m.KeymapSearch)) + m.Theme.Muted.Render("  "+m.T("keymap_search.edit_hint")))
+	}
+	s.WriteString("\n\n")
+
+	viewer := keymapViewers[m.Screen]
+	categories, _, _ := viewer.state(&m)
+	hits := m.keymapSearchHits()
+	switch {
+	case m.KeymapSearch == "":
+		s.WriteString(m.Theme.Muted.Render("  " + m.T("common.type_to_search")))
+		s.WriteString("\n")
+	case len(hits) == 0:
+		s.WriteString(m.Theme.Muted.Render("  " + m.Tf("keymap_search.no_match", m.KeymapSearch)))
+		s.WriteString("\n")
+	}
+
+	// Pad category names so the keys line up
+	nameWidth := 0
+	for _, hit := range hits {
+		nameWidth = max(nameWidth, utf8.RuneCountInString(categories[hit.Category].Name))
+	}
+
+	start := m.KeymapSearchScroll
+	end := min(start+m.keymapSearchVisible(), len(hits))
+	for i := start; i < end; i++ {
+		hit := hits[i]
+		cursor := "  "
+		style := m.Theme.Unselected
+		if i == m.Cursor {
+			cursor = "▸ "
+			style = m.Theme.Selected
+		}
+		line := fmt.Sprintf("%-*s  %-15s %-6s %s", nameWidth, categories[hit.Category].Name, hit.Keymap.Keys, hit.Keymap.Mode, hit.Keymap.Description)
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+line))
+		s.WriteString("\n")
+	}
+
+	if len(hits) > end-start {
+		s.WriteString("\n")
+		s.WriteString(m.Theme.Muted.Render(m.Tf("common.showing", start+1, end, len(hits))))
+		s.WriteString("\n")
+	}
+
+	s.WriteString("\n")
+	if m.KeymapSearchActive {
+		s.WriteString(m.Theme.Help.Render(m.T("keymap_search.help_typing")))
+	} else {
+		s.WriteString(m.Theme.Help.Render(m.T("keymap_search.help")))
+	}
+
+	return s.String()
+}
@@ -0,0 +1,140 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// searchCategories is a small dataset with a long category, so a jump has to scroll
+func searchCategories() []KeymapCategory {
+	long := KeymapCategory{Name: "Windows", Description: "Splits"}
+	for i := range 30 {
+		long.Keymaps = append(long.Keymaps, Keymap{Keys: fmt.Sprintf("<C-w>%d", i), Description: fmt.Sprintf("Window %d", i), Mode: "n"})
+	}
+	long.Keymaps = append(long.Keymaps, Keymap{Keys: "<C-w>q", Description: "Close window", Mode: "n"})
+	return []KeymapCategory{
+		{Name: "Files", Keymaps: []Keymap{
+			{Keys: "<leader>ff", Description: "Find files", Mode: "n"},
+			{Keys: "<leader>fg", Description: "Live grep", Mode: "n"},
+		}},
+		{Name: "Buffers", Keymaps: []Keymap{
+			{Keys: "<leader>bd", Description: "Close buffer", Mode: "n"},
+		}},
+		long,
+	}
+}
+
+func TestSearchKeymaps(t *testing.T) {
+	tests := []struct {
+		query string
+		want  []string // Keys of the hits, in order
+	}{
+		{"", nil},
+		{"   ", nil},
+		{"close", []string{"<leader>bd", "<C-w>q"}},
+		{"CLOSE Buffer", []string{"<leader>bd"}},
+		{"<leader>f", []string{"<leader>ff", "<leader>fg"}},
+		{"grep leader", []string{"<leader>fg"}},
+		{"nothing here", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			var got []string
+			for _, hit := range searchKeymaps(searchCategories(), tt.query) {
+				got = append(got, hit.Keymap.Keys)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("hits = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeymapSearchFlow(t *testing.T) {
+	keys := func(m Model, keys ...string) Model {
+		for _, k := range keys {
+			var msg tea.KeyMsg
+			switch k {
+			case "enter":
+				msg = tea.KeyMsg{Type: tea.KeyEnter}
+			case "esc":
+				msg = tea.KeyMsg{Type: tea.KeyEsc}
+			case "backspace":
+				msg = tea.KeyMsg{Type: tea.KeyBackspace}
+			case " ":
+				msg = tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}
+			default:
+				msg = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+			}
+			result, _ := m.Update(msg)
+			m = result.(Model)
+		}
+		return m
+	}
+	typed := func(s string) []string { return strings.Split(s, "") }
+
+	for menu, viewer := range keymapViewers {
+		t.Run(screenNames[menu], func(t *testing.T) {
+			t.Setenv("HOME", t.TempDir())
+			m := NewModel()
+			m.Height = 20
+			m.KeymapCategories = searchCategories()
+			m.TmuxKeymapCategories = searchCategories()
+			m.ZellijKeymapCategories = searchCategories()
+			m.GhosttyKeymapCategories = searchCategories()
+			m.Screen = menu
+
+			m = keys(m, "/")
+			m = keys(m, typed("close w")...)
+			if m.LeaderMode || m.KeymapSearch != "close w" {
+				t.Fatalf("typing should build the query, got %q (leader=%v)", m.KeymapSearch, m.LeaderMode)
+			}
+			if view := m.View(); !strings.Contains(view, "Windows") || strings.Contains(view, "Close buffer") {
+				t.Errorf("the results should list the window keymap with its category only:\n%s", view)
+			}
+
+			m = keys(m, "enter")
+			_, selected, scroll := viewer.state(&m)
+			if m.Screen != viewer.category || *selected != 2 {
+				t.Fatalf("enter should open the Windows category, got %v / %d", m.Screen, *selected)
+			}
+			if *scroll == 0 || !strings.Contains(m.View(), "Close window") {
+				t.Errorf("the category should be scrolled to the matching row (scroll %d)", *scroll)
+			}
+
+			// Going back keeps the results, which j/k move through
+			m = keys(m, "esc")
+			if m.Screen != menu || !m.keymapSearching() || m.KeymapSearchActive {
+				t.Fatalf("esc should return to the results, got %v", m.Screen)
+			}
+			m = keys(m, "/", "backspace")
+			m = keys(m, "esc")
+			if m.keymapSearching() || m.Screen != menu {
+				t.Error("esc should clear the search and stay on the menu")
+			}
+		})
+	}
+}
+
+func TestKeymapSearchMovesThroughResults(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	m := NewModel()
+	m.Screen = ScreenKeymaps
+	m.Height = 15 // Room for 6 results
+	m.KeymapCategories = searchCategories()
+	m.KeymapSearch = "window"
+
+	for range 7 {
+		result, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+		m = result.(Model)
+	}
+	if m.Cursor != 7 || m.KeymapSearchScroll != 2 {
+		t.Errorf("j should move and scroll the results, got cursor %d scroll %d", m.Cursor, m.KeymapSearchScroll)
+	}
+	if !strings.Contains(m.View(), "Window 7") || strings.Contains(m.View(), "<C-w>0 ") {
+		t.Errorf("the visible window should follow the cursor:\n%s", m.View())
+	}
+}
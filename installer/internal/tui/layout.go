@@ -0,0 +1,59 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// The smallest terminal the screens are laid out for; below it View shows
+// renderTooSmall until the terminal grows again
+const (
+	minTermWidth  = 60
+	minTermHeight = 15
+)
+
+// assumedTermHeight stands in for the height before the first WindowSizeMsg
+const assumedTermHeight = 24
+
+// screenPadding is the horizontal padding View puts around every screen
+const screenPadding = 4
+
+// visibleRows is how many list or text rows fit once reserved lines (title,
+// description, scroll info, help) are taken from the terminal height. It is
+// never below one, so scroll math can't go negative on a tiny terminal.
+func (m Model) visibleRows(reserved int) int {
+	height := m.Height
+	if height <= 0 {
+		height = assumedTermHeight
+	}
+	return max(height-reserved, 1)
+}
+
+// tooSmall reports whether the terminal is below the minimum size. An unknown
+// size (before the first WindowSizeMsg) is not too small.
+func (m Model) tooSmall() bool {
+	return m.Width > 0 && m.Height > 0 && (m.Width < minTermWidth || m.Height < minTermHeight)
+}
+
+// renderTooSmall replaces the screen until the terminal is resized to fit
+func (m Model) renderTooSmall() string {
+	var s strings.Builder
+	s.WriteString(m.Theme.Warning.Render(m.T("layout.too_small")))
+	s.WriteString("\n\n")
+	s.WriteString(m.Tf("layout.needs", m.Width, m.Height, minTermWidth, minTermHeight))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.T("layout.resize")))
+	return s.String()
+}
+
+// renderRow renders an option row in style, truncated to the terminal width
+// with an ellipsis so a long label can't wrap and push the rows below out of
+// line with the cursor
+func (m Model) renderRow(style lipgloss.Style, row string) string {
+	if m.Width > 0 {
+		row = ansi.Truncate(row, max(m.Width-screenPadding-style.GetHorizontalFrameSize(), 1), "…")
+	}
+	return style.Render(row)
+}
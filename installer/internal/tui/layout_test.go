@@ -0,0 +1,70 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestVisibleRows(t *testing.T) {
+	tests := []struct {
+		height, reserved, want int
+	}{
+		{24, 8, 16},
+		{15, 9, 6},
+		{10, 12, 1}, // Never below one row
+		{0, 8, 16},  // Before the first WindowSizeMsg the height is assumed
+		{-3, 8, 16},
+	}
+	for _, tt := range tests {
+		m := NewModel()
+		m.Height = tt.height
+		if got := m.visibleRows(tt.reserved); got != tt.want {
+			t.Errorf("visibleRows(%d) at height %d = %d, want %d", tt.reserved, tt.height, got, tt.want)
+		}
+	}
+}
+
+func TestTooSmallRecoversOnResize(t *testing.T) {
+	m := NewModel()
+	m.Screen = ScreenMainMenu
+	for _, size := range []tea.WindowSizeMsg{{Width: 59, Height: 24}, {Width: 80, Height: 14}} {
+		result, _ := m.Update(size)
+		m = result.(Model)
+		view := m.View()
+		if !strings.Contains(view, "Terminal too small") || strings.Contains(view, "Start Installation") {
+			t.Errorf("%dx%d should show the resize prompt, got:\n%s", size.Width, size.Height, view)
+		}
+	}
+
+	result, _ := m.Update(tea.WindowSizeMsg{Width: 60, Height: 15})
+	m = result.(Model)
+	if view := m.View(); strings.Contains(view, "Terminal too small") {
+		t.Errorf("60x15 should render the screen again, got:\n%s", view)
+	}
+
+	m.Width, m.Height = 0, 0
+	if m.tooSmall() {
+		t.Error("an unknown size is not too small")
+	}
+}
+
+func TestRenderRowTruncates(t *testing.T) {
+	m := NewModel()
+	m.Width = 30
+	style := lipgloss.NewStyle().PaddingLeft(4)
+	row := m.renderRow(style, "▸ "+strings.Repeat("long label ", 5))
+	if w := ansi.StringWidth(row); w != 30-screenPadding {
+		t.Errorf("row should fill the width left by the padding, got %d: %q", w, row)
+	}
+	if !strings.HasSuffix(strings.TrimSpace(ansi.Strip(row)), "…") {
+		t.Errorf("a truncated row should end in an ellipsis, got %q", row)
+	}
+
+	if short := m.renderRow(style, "▸ ok"); strings.Contains(short, "…") {
+		t.Errorf("a short row should be left alone, got %q", short)
+	}
+}
@@ -0,0 +1,135 @@
+package tui
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// leaderTimeout is how long leader mode waits for its second key
+const leaderTimeout = 2 * time.Second
+
+// LeaderCommand is what a key does after the <space> leader. Screens add
+// their own to Model.LeaderCommands and scope them with Available.
+type LeaderCommand struct {
+	Label     string                             // Shown in the leader hint bar
+	Available func(m Model) bool                 // nil means on every screen
+	Run       func(m Model) (tea.Model, tea.Cmd) // Gets the model with leader mode already off
+}
+
+// defaultLeaderCommands are the leader commands every model starts with
+func defaultLeaderCommands() map[string]LeaderCommand {
+	return map[string]LeaderCommand{
+		"q": {
+			Label:     "quit",
+			Available: func(m Model) bool { return m.Screen != ScreenInstalling },
+			Run: func(m Model) (tea.Model, tea.Cmd) {
+				m.Quitting = true
+				return m, tea.Quit
+			},
+		},
+		"d": {
+			Label:     "details",
+			Available: func(m Model) bool { return m.Screen == ScreenInstalling },
+			Run: func(m Model) (tea.Model, tea.Cmd) {
+				m.ShowDetails = !m.ShowDetails
+				return m, nil
+			},
+		},
+		"l": {
+			Label:     "log",
+			Available: func(m Model) bool { return m.Screen == ScreenInstalling },
+			Run: func(m Model) (tea.Model, tea.Cmd) {
+				return m.toggleInstallLog(), nil
+			},
+		},
+		"x": {
+			Label: "cancel install",
+			Available: func(m Model) bool {
+				return m.Screen == ScreenInstalling && m.InstallCancel != nil && !m.InstallCancelled
+			},
+			Run: func(m Model) (tea.Model, tea.Cmd) {
+				return m.cancelInstall()
+			},
+		},
+		"h": {
+			Label:     "main menu",
+			Available: func(m Model) bool { return m.Screen != ScreenMainMenu && m.leaderCanLeave() },
+			Run: func(m Model) (tea.Model, tea.Cmd) {
+				m.Screen = ScreenMainMenu
+				m.Cursor = 0
+				return m, nil
+			},
+		},
+		"s": {
+			Label: "skill manager",
+			Available: func(m Model) bool {
+				return m.Screen != ScreenSkillMenu && !m.readOnly() && m.leaderCanLeave()
+			},
+			Run: func(m Model) (tea.Model, tea.Cmd) {
+				m.Screen = ScreenSkillMenu
+				m.Cursor = 0
+				m.SkillUndo = currentSkillUndo()
+				return m, nil
+			},
+		},
+	}
+}
+
+// leaderCanLeave reports whether jumping off the current screen is safe: no
+// install, update or check is running behind it
+func (m Model) leaderCanLeave() bool {
+	switch m.Screen {
+	case ScreenWelcome, ScreenInstalling, ScreenProjectInstalling, ScreenSkillUpdate:
+		return false
+	}
+	return !m.SkillLoading && !m.DiagnoseRunning && !m.InstallCheckRunning && !m.UninstallRunning
+}
+
+// leaderKeys lists the keys of the leader commands available on the current
+// screen, in key order
+func (m Model) leaderKeys() []string {
+	var keys []string
+	for key, cmd := range m.LeaderCommands {
+		if cmd.Available == nil || cmd.Available(m) {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// startLeader turns on leader mode; it ends with the next key or after leaderTimeout
+func (m Model) startLeader() Model {
+	m.LeaderMode = true
+	m.LeaderSince = time.Now()
+	return m
+}
+
+// runLeader ends leader mode and runs the command bound to key, if any here
+func (m Model) runLeader(key string) (tea.Model, tea.Cmd) {
+	m.LeaderMode = false
+	if !slices.Contains(m.leaderKeys(), key) {
+		return m, nil
+	}
+	return m.LeaderCommands[key].Run(m)
+}
+
+// expireLeader cancels leader mode once it has waited leaderTimeout by now
+func (m Model) expireLeader(now time.Time) Model {
+	if m.LeaderMode && now.Sub(m.LeaderSince) >= leaderTimeout {
+		m.LeaderMode = false
+	}
+	return m
+}
+
+// renderLeaderBar lists the leader commands of the current screen
+func (m Model) renderLeaderBar() string {
+	var cmds []string
+	for _, key := range m.leaderKeys() {
+		cmds = append(cmds, key+" "+m.LeaderCommands[key].Label)
+	}
+	return m.Theme.Warning.Render("▶ LEADER  " + strings.Join(cmds, " · ") + " · esc cancel")
+}
@@ -0,0 +1,149 @@
+package tui
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// leaderKey presses <space> then key on m
+func leaderKey(t *testing.T, m Model, key string) (Model, tea.Cmd) {
+	t.Helper()
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{' '}})
+	m = result.(Model)
+	if !m.LeaderMode {
+		t.Fatalf("space should start leader mode on %v", m.Screen)
+	}
+	result, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+	m = result.(Model)
+	if m.LeaderMode {
+		t.Error("the second key should end leader mode")
+	}
+	return m, cmd
+}
+
+func TestLeaderCommands(t *testing.T) {
+	t.Run("q quits", func(t *testing.T) {
+		m := NewModel()
+		m.Screen = ScreenTerminalSelect
+		m, _ = leaderKey(t, m, "q")
+		if !m.Quitting {
+			t.Error("space q should quit")
+		}
+	})
+
+	t.Run("h jumps to the main menu", func(t *testing.T) {
+		m := NewModel()
+		m.Screen = ScreenTrainerMenu
+		m.Cursor = 3
+		m, _ = leaderKey(t, m, "h")
+		if m.Screen != ScreenMainMenu || m.Cursor != 0 {
+			t.Errorf("expected the main menu, got %v/%d", m.Screen, m.Cursor)
+		}
+	})
+
+	t.Run("s jumps to the skill manager", func(t *testing.T) {
+		m := NewModel()
+		m.Screen = ScreenMainMenu
+		m, _ = leaderKey(t, m, "s")
+		if m.Screen != ScreenSkillMenu {
+			t.Errorf("expected the skill manager, got %v", m.Screen)
+		}
+	})
+
+	t.Run("d and l only while installing", func(t *testing.T) {
+		m := NewModel()
+		m.Screen = ScreenInstalling
+		m, _ = leaderKey(t, m, "d")
+		if !m.ShowDetails {
+			t.Error("space d should show the details")
+		}
+		m, _ = leaderKey(t, m, "l")
+		if !m.ShowInstallLog || !strings.Contains(m.renderInstalling(), "back to the steps") {
+			t.Error("space l should show the full log")
+		}
+		for _, key := range []string{"q", "h", "s"} {
+			if next, _ := leaderKey(t, m, key); next.Quitting || next.Screen != ScreenInstalling {
+				t.Errorf("space %s should do nothing during an install", key)
+			}
+		}
+
+		m = NewModel()
+		m.Screen = ScreenMainMenu
+		if m, _ = leaderKey(t, m, "l"); m.ShowInstallLog {
+			t.Error("space l is only for the installing screen")
+		}
+	})
+
+	t.Run("s is off in read-only mode", func(t *testing.T) {
+		m := NewModel()
+		m.Screen = ScreenMainMenu
+		m.LockHolder = &system.InstanceLock{PID: 1}
+		if m, _ = leaderKey(t, m, "s"); m.Screen != ScreenMainMenu {
+			t.Error("the skill manager changes shared state")
+		}
+	})
+
+	t.Run("screens can register commands", func(t *testing.T) {
+		m := NewModel()
+		m.Screen = ScreenTrainerMenu
+		m.LeaderCommands["t"] = LeaderCommand{
+			Label:     "timed run",
+			Available: func(m Model) bool { return m.Screen == ScreenTrainerMenu },
+			Run: func(m Model) (tea.Model, tea.Cmd) {
+				m.TrainerMessage = "timed"
+				return m, nil
+			},
+		}
+		if keys := m.leaderKeys(); !slices.Contains(keys, "t") {
+			t.Errorf("the registered command should be listed, got %v", keys)
+		}
+		if m, _ = leaderKey(t, m, "t"); m.TrainerMessage != "timed" {
+			t.Error("the registered command should run")
+		}
+	})
+}
+
+func TestLeaderBar(t *testing.T) {
+	m := NewModel()
+	m.Screen = ScreenTrainerMenu
+	m = m.startLeader()
+	view := m.View()
+	if !strings.Contains(view, "▶ LEADER  h main menu · q quit · s skill manager · esc cancel") {
+		t.Errorf("the leader bar should list the commands of the screen:\n%s", view)
+	}
+
+	m.Screen = ScreenInstalling
+	if bar := m.renderLeaderBar(); !strings.Contains(bar, "d details · l log ·") || strings.Contains(bar, "quit") {
+		t.Errorf("unexpected installing leader bar %q", bar)
+	}
+}
+
+func TestLeaderTimeout(t *testing.T) {
+	m := NewModel()
+	m.Screen = ScreenMainMenu
+	result, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{' '}})
+	m = result.(Model)
+	if !m.LeaderMode || cmd == nil {
+		t.Fatal("leader mode should start ticking for its timeout")
+	}
+
+	result, _ = m.Update(tickMsg(m.LeaderSince.Add(leaderTimeout - time.Millisecond)))
+	m = result.(Model)
+	if !m.LeaderMode {
+		t.Fatal("leader mode should wait for the full timeout")
+	}
+	result, _ = m.Update(tickMsg(m.LeaderSince.Add(leaderTimeout)))
+	m = result.(Model)
+	if m.LeaderMode {
+		t.Fatal("leader mode should cancel itself after the timeout")
+	}
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+	if result.(Model).Quitting {
+		t.Error("q after the timeout is not a leader command")
+	}
+}
@@ -0,0 +1,88 @@
+package tui
+
+import "fmt"
+
+// Caps of the on-screen logs. The full output of an install goes to the log
+// file (see install_log.go); these only bound what the TUI keeps in memory.
+const (
+	installLogCap = 2000 // LogLines: the full-log view pages through it, the details box and error screen show the tail
+	projectLogCap = 30   // ProjectLogLines: streamed init-project.sh output
+	skillLogCap   = 200  // SkillResultLog: one line per skill, so batches get big
+)
+
+// logBuffer keeps the last Cap lines of a log in a ring and counts the lines
+// that fell off the front, so views can say how much they aren't showing.
+// The zero value is unbounded; use newLogBuffer for a capped one.
+type logBuffer struct {
+	lines   []string // Ring storage; full once len(lines) == cap
+	start   int      // Index of the oldest line once the ring is full
+	cap     int
+	omitted int
+}
+
+func newLogBuffer(cap int) logBuffer {
+	return logBuffer{cap: cap}
+}
+
+// Add appends lines, dropping the oldest ones beyond the cap
+func (b *logBuffer) Add(lines ...string) {
+	for _, line := range lines {
+		if b.cap <= 0 || len(b.lines) < b.cap {
+			b.lines = append(b.lines, line)
+			continue
+		}
+		b.lines[b.start] = line
+		b.start = (b.start + 1) % b.cap
+		b.omitted++
+	}
+}
+
+// Set replaces the contents with lines, keeping the cap
+func (b *logBuffer) Set(lines ...string) {
+	b.Reset()
+	b.Add(lines...)
+}
+
+// Reset empties the buffer, keeping the cap
+func (b *logBuffer) Reset() {
+	b.lines = nil
+	b.start = 0
+	b.omitted = 0
+}
+
+// Len returns how many lines are retained
+func (b logBuffer) Len() int {
+	return len(b.lines)
+}
+
+// Omitted returns how many lines were dropped to stay under the cap
+func (b logBuffer) Omitted() int {
+	return b.omitted
+}
+
+// Lines returns every retained line, oldest first
+func (b logBuffer) Lines() []string {
+	out := make([]string, 0, len(b.lines))
+	out = append(out, b.lines[b.start:]...)
+	return append(out, b.lines[:b.start]...)
+}
+
+// Tail returns the last n retained lines, oldest first
+func (b logBuffer) Tail(n int) []string {
+	lines := b.Lines()
+	if n < len(lines) {
+		return lines[len(lines)-n:]
+	}
+	return lines
+}
+
+// Header notes the dropped lines above the retained ones, "" when none were
+func (b logBuffer) Header() string {
+	switch b.omitted {
+	case 0:
+		return ""
+	case 1:
+		return "… 1 earlier line omitted"
+	}
+	return fmt.Sprintf("… %d earlier lines omitted", b.omitted)
+}
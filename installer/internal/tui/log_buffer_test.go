@@ -0,0 +1,124 @@
+package tui
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func numberedLines(from, to int) []string {
+	var lines []string
+	for i := from; i <= to; i++ {
+		lines = append(lines, fmt.Sprintf("line %d", i))
+	}
+	return lines
+}
+
+func TestLogBufferWraparound(t *testing.T) {
+	tests := []struct {
+		name    string
+		added   int
+		want    []string
+		omitted int
+	}{
+		{"empty", 0, []string{}, 0},
+		{"under the cap", 2, numberedLines(1, 2), 0},
+		{"exactly full", 3, numberedLines(1, 3), 0},
+		{"wrapped once", 4, numberedLines(2, 4), 1},
+		{"wrapped past the start again", 8, numberedLines(6, 8), 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newLogBuffer(3)
+			b.Add(numberedLines(1, tt.added)...)
+			if got := b.Lines(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Lines() = %v, want %v", got, tt.want)
+			}
+			if b.Omitted() != tt.omitted {
+				t.Errorf("Omitted() = %d, want %d", b.Omitted(), tt.omitted)
+			}
+		})
+	}
+}
+
+func TestLogBufferTailAndReset(t *testing.T) {
+	b := newLogBuffer(4)
+	b.Add(numberedLines(1, 6)...)
+	if got := b.Tail(2); !reflect.DeepEqual(got, numberedLines(5, 6)) {
+		t.Errorf("Tail(2) = %v", got)
+	}
+	if got := b.Tail(10); !reflect.DeepEqual(got, numberedLines(3, 6)) {
+		t.Errorf("Tail past the retained lines = %v", got)
+	}
+
+	b.Set("fresh")
+	if b.Len() != 1 || b.Omitted() != 0 || b.Header() != "" {
+		t.Errorf("Set should start over, got %v omitted %d", b.Lines(), b.Omitted())
+	}
+	b.Add(numberedLines(1, 4)...)
+	if got := b.Lines(); !reflect.DeepEqual(got, numberedLines(1, 4)) {
+		t.Errorf("Set should keep the cap, got %v", got)
+	}
+
+	var unbounded logBuffer
+	unbounded.Add(numberedLines(1, 500)...)
+	if unbounded.Len() != 500 || unbounded.Omitted() != 0 {
+		t.Errorf("the zero value should keep everything, got %d", unbounded.Len())
+	}
+}
+
+func TestLogBufferHeader(t *testing.T) {
+	tests := []struct {
+		added int
+		want  string
+	}{
+		{2, ""},
+		{3, "… 1 earlier line omitted"},
+		{12, "… 10 earlier lines omitted"},
+	}
+	for _, tt := range tests {
+		b := newLogBuffer(2)
+		b.Add(numberedLines(1, tt.added)...)
+		if got := b.Header(); got != tt.want {
+			t.Errorf("after %d lines Header() = %q, want %q", tt.added, got, tt.want)
+		}
+	}
+}
+
+func TestLogViewsShowRetainedTail(t *testing.T) {
+	m := NewModel()
+	m.Screen = ScreenSkillResult
+	m.SkillResultLog.Add(numberedLines(1, skillLogCap+5)...)
+
+	view := m.renderSkillResult()
+	if !strings.Contains(view, "… 5 earlier lines omitted") {
+		t.Errorf("the result screen should note the dropped lines, got:\n%s", view)
+	}
+	if strings.Contains(view, "line 5\n") || !strings.Contains(view, "line 6\n") || !strings.Contains(view, fmt.Sprintf("line %d\n", skillLogCap+5)) {
+		t.Error("the result screen should show exactly the retained lines")
+	}
+
+	m = NewModel()
+	m.Screen = ScreenProjectInstalling
+	for _, line := range numberedLines(1, projectLogCap+1) {
+		result, _ := m.Update(projectInstallLogMsg{line: line})
+		m = result.(Model)
+	}
+	view = m.renderProjectInstalling()
+	if !strings.Contains(view, "… 1 earlier line omitted") || strings.Contains(view, "line 1\n") {
+		t.Errorf("the project log should drop the oldest line, got:\n%s", view)
+	}
+
+	m = NewModel()
+	m.Screen = ScreenInstalling
+	m.ShowDetails = true
+	m.LogLines.Add(numberedLines(1, installLogCap+3)...)
+	view = m.renderInstalling()
+	first := regexp.MustCompile(fmt.Sprintf(`line %d\b`, installLogCap-6))
+	dropped := regexp.MustCompile(fmt.Sprintf(`line %d\b`, installLogCap-7))
+	if dropped.MatchString(view) || !first.MatchString(view) {
+		t.Errorf("the details box should show the last 10 lines, got:\n%s", view)
+	}
+}
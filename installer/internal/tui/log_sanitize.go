@@ -0,0 +1,68 @@
+package tui
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// ansiEscape matches CSI sequences (colors, cursor moves, erase), OSC sequences
+// (window titles, hyperlinks) and the remaining two-byte escapes
+var ansiEscape = regexp.MustCompile(`\x1b\[[0-?]*[ -/]*[@-~]|\x1b\][^\x07\x1b]*(?:\x07|\x1b\\)|\x1b[@-Z\\-_]`)
+
+// sanitizeLogLine turns a raw line of child process output into something safe to
+// draw inside the TUI: escape sequences are stripped, a line rewritten with \r
+// (progress bars, git's counters) is reduced to its final state, remaining control
+// characters are dropped and the result is clamped to width runes. width <= 0
+// means no clamping.
+func sanitizeLogLine(line string, width int) string {
+	line = ansiEscape.ReplaceAllString(line, "")
+
+	// Keep what the terminal would finally show: the last non-blank \r segment
+	if strings.Contains(line, "\r") {
+		segments := strings.Split(line, "\r")
+		line = ""
+		for i := len(segments) - 1; i >= 0; i-- {
+			if strings.TrimSpace(segments[i]) != "" {
+				line = segments[i]
+				break
+			}
+		}
+	}
+
+	line = strings.Map(func(r rune) rune {
+		switch {
+		case r == '\t':
+			return ' '
+		case unicode.IsControl(r):
+			return -1
+		}
+		return r
+	}, line)
+	return clampLogLine(strings.TrimRight(line, " "), width)
+}
+
+// clampLogLine cuts line to width runes, ending it with "…" when cut. width
+// <= 0 leaves it whole.
+func clampLogLine(line string, width int) string {
+	if width > 0 {
+		if runes := []rune(line); len(runes) > width {
+			return string(runes[:width-1]) + "…"
+		}
+	}
+	return line
+}
+
+// sanitizeStepID keeps only the characters step IDs are made of, so a malformed
+// ID can never carry escape sequences into the UI
+func sanitizeStepID(id string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		case r == '-', r == '_', r == ':', r == '.':
+			return r
+		}
+		return -1
+	}, id)
+}
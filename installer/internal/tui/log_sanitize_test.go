@@ -0,0 +1,109 @@
+package tui
+
+import (
+	"slices"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestSanitizeLogLine(t *testing.T) {
+	tests := []struct {
+		name  string
+		raw   string
+		width int
+		want  string
+	}{
+		{
+			name: "git clone progress counters",
+			raw:  "Receiving objects:   1% (42/4187)\rReceiving objects:  57% (2387/4187), 9.1 MiB | 4.5 MiB/s\rReceiving objects: 100% (4187/4187), 15.92 MiB | 5.02 MiB/s, done.",
+			want: "Receiving objects: 100% (4187/4187), 15.92 MiB | 5.02 MiB/s, done.",
+		},
+		{
+			name: "git progress ending in a carriage return",
+			raw:  "Resolving deltas:  50% (900/1800)\rResolving deltas: 100% (1800/1800), done.\r",
+			want: "Resolving deltas: 100% (1800/1800), done.",
+		},
+		{
+			name: "brew colored arrows",
+			raw:  "\x1b[34m==>\x1b[0m \x1b[1mDownloading https://ghcr.io/v2/homebrew/core/neovim/manifests/0.10.2\x1b[0m",
+			want: "==> Downloading https://ghcr.io/v2/homebrew/core/neovim/manifests/0.10.2",
+		},
+		{
+			name: "brew progress bar with erase-line escapes",
+			raw:  "\r\x1b[K#####                                                   8.3%\r\x1b[K##############################################  78.0%\r\x1b[K######################################################## 100.0%",
+			want: "######################################################## 100.0%",
+		},
+		{
+			name: "cursor movement and window title",
+			raw:  "\x1b]0;brew install\x07\x1b[2A\x1b[1G🍺  /opt/homebrew/Cellar/fish/3.7.1: 1,204 files, 12.4MB",
+			want: "🍺  /opt/homebrew/Cellar/fish/3.7.1: 1,204 files, 12.4MB",
+		},
+		{
+			name: "stray control characters and tabs",
+			raw:  "step\x07\tdone\x00\x08",
+			want: "step done",
+		},
+		{
+			name: "spinner-only line keeps its last frame",
+			raw:  "\r⠋\r   \r",
+			want: "⠋",
+		},
+		{
+			name:  "clamped to width",
+			raw:   "Cloning into 'Javi.Dots'...",
+			width: 12,
+			want:  "Cloning int…",
+		},
+		{
+			name:  "short line untouched by clamp",
+			raw:   "✓ done",
+			width: 12,
+			want:  "✓ done",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sanitizeLogLine(tt.raw, tt.width); got != tt.want {
+				t.Errorf("got  %q\nwant %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSanitizeStepID(t *testing.T) {
+	if got := sanitizeStepID("ai:framework-1"); got != "ai:framework-1" {
+		t.Errorf("valid ID changed to %q", got)
+	}
+	if got := sanitizeStepID("clone\x1b[2J\n"); got != "clone2J" {
+		t.Errorf("escape not removed: %q", got)
+	}
+}
+
+func TestStepProgressSanitizesLog(t *testing.T) {
+	m := NewModel()
+	m.Width = 38
+	m.Steps = []InstallStep{{ID: "clone", Status: StatusRunning}}
+
+	for _, raw := range []string{
+		"\x1b[32mCloning into 'Javi.Dots'...\x1b[0m",
+		"Receiving objects:  10%\rReceiving objects: 100%, done.",
+		"\r\x1b[K",
+	} {
+		result, _ := m.Update(stepProgressMsg{stepID: "clone", log: raw})
+		m = result.(Model)
+	}
+
+	want := []string{"Cloning into 'Javi.Dots'...", "Receiving objects: 100%, do…"}
+	if lines := m.logTail(10); !slices.Equal(lines, want) {
+		t.Fatalf("got log lines %q, want %q", lines, want)
+	}
+
+	// A wider window redraws the buffered lines whole
+	result, _ := m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+	m = result.(Model)
+	want[1] = "Receiving objects: 100%, done."
+	if lines := m.logTail(10); !slices.Equal(lines, want) {
+		t.Errorf("after a resize got %q, want %q", lines, want)
+	}
+}
@@ -1,9 +1,12 @@
 package tui
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
 	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/tui/trainer"
@@ -73,7 +76,7 @@ const (
 	ScreenTrainerBossResult // Result after boss fight
 	// Project Init screens
 	ScreenProjectPath            // Text input: project directory
-	ScreenProjectStack           // Single-select: detected stack confirmation/override
+	ScreenProjectStack           // Checklist: detected stacks pre-checked, adjustable
 	ScreenProjectMemory          // Single-select: memory module
 	ScreenProjectObsidianInstall // Offer to install Obsidian app if not detected
 	ScreenProjectEngram          // Yes/No: add Engram alongside Obsidian Brain
@@ -89,6 +92,61 @@ const (
 	ScreenSkillRemove  // Multi-select from installed skills
 	ScreenSkillResult  // Success/error output
 	ScreenSkillUpdate  // Updating catalog (git pull)
+	// AI Framework apply-only mode
+	ScreenAIFrameworkApplyDiff // Review additions/removals before applying to an existing setup
+	// Shell config merge
+	ScreenZshMergeSelect // Replace an existing .zshrc or merge a managed block into it
+	// Restore conflicts
+	ScreenRestoreConflict // Resolve files changed since the install, one at a time
+	// Troubleshooting wizard
+	ScreenDiagnoseSymptom // Pick what looks broken
+	ScreenDiagnoseResults // Ranked likely causes with one-keypress fixes
+	// Skill catalog statistics
+	ScreenSkillStats // Read-only catalog summary
+	// Shown instead of the OS selection when installation can't run here
+	ScreenUnsupportedPlatform
+	// Trainer progress resets, both confirmed before anything is lost
+	ScreenTrainerResetConfirm // Confirm resetting one module's practice progress
+	ScreenTrainerSettings     // Trainer settings, home of the full progress reset
+	ScreenTrainerResetAll     // Type "reset" to wipe all trainer progress
+	// Read-only view of one skill, opened from Browse
+	ScreenSkillDetail
+	ScreenOSVersionWarning // The OS release is below the supported minimum; continue or abort
+	// Batch project init: pick sub-projects of a parent directory, then the per-repo summary
+	ScreenProjectBatchSelect
+	ScreenProjectBatchResult
+	ScreenSkillDoctor // Broken skill symlinks and the repairs on offer
+	// Uninstall: pick what the installer left behind, then what was removed and kept
+	ScreenUninstall
+	ScreenUninstallResult
+	// Optional plugins and layouts of the chosen multiplexer
+	ScreenWMExtras
+	// Refuses a screen that changes shared state while another instance runs
+	ScreenInstanceLocked
+	ScreenTrainerImport // Path prompt for a trainer progress export to merge
+	ScreenInstallCheck  // Read-only health check of an existing installation
+	ScreenSkillWarnings // Catalog skills skipped as unparsable, opened from Browse
+	// Saved wizard choices: naming a new profile, picking one to install,
+	// and the warning when one doesn't fit this machine
+	ScreenProfileSave
+	ScreenProfileSelect
+	ScreenProfileMismatch
+	ScreenSkillTargets   // Where to link or unlink the skills picked on Install/Remove
+	ScreenRestoreItems   // Which configs of the selected backup to restore
+	ScreenBackupPrune    // Confirm deleting the backups beyond the BackupKeep most recent
+	ScreenProjectPreview // Scrollable list of what project init would create
+	// Custom AI framework modules, features and Agent Teams Lite before installing
+	ScreenAIFrameworkSummary
+	ScreenAIFrameworkPresetSave // Name the custom selection to reuse it as a preset
+	ScreenTrainerTimed          // Speed Run: one minute of exercises, then its results
+	ScreenInstallRefs           // Advanced: the branch, tag or commit each clone is pinned to
+	ScreenInstallRefInput       // Type the ref for the clone picked on ScreenInstallRefs
+	ScreenSkillConflicts        // Local skill directories an install would replace: skip, back up or overwrite
+	ScreenSettings              // UI preferences kept in installer-settings.json
+	ScreenSkillManifest         // Path prompt for a skill manifest to install from or export to
+	ScreenDiskSpaceWarning      // The planned install may not fit in the free space; continue or abort
+	ScreenWizardReview          // Every wizard answer before installing; picking one edits it
+	ScreenSkillURL              // Git URL prompt for a skill kept in its own repository
 )
 
 // Path input modes
@@ -96,6 +154,8 @@ const (
 	PathModeTyping     = 0
 	PathModeCompletion = 1
 	PathModeBrowser    = 2
+	PathModeRecent     = 3
+	PathModeNewDir     = 4
 )
 
 // InstallStep represents a single installation step
@@ -106,7 +166,32 @@ type InstallStep struct {
 	Status      StepStatus
 	Progress    float64
 	Error       error
-	Interactive bool // If true, this step needs terminal control (sudo, chsh, etc)
+	Interactive bool          // If true, this step needs terminal control (sudo, chsh, etc)
+	Retryable   bool          // Network-bound and safe to rerun, so failures are retried (see step_retry.go)
+	DependsOn   []string      // IDs of steps that must be done or skipped first (see step_scheduler.go)
+	Locks       []string      // Steps sharing a lock never run at the same time
+	StartedAt   time.Time     // When the step last started running
+	Elapsed     time.Duration // How long the step ran, set when it finishes or fails
+	Cancelled   bool          // Skipped or stopped by <space>x; resuming runs it again
+}
+
+// installClock tells the time for step and install durations; tests replace it
+var installClock = time.Now
+
+// stopClock records how long the step has been running
+func (s *InstallStep) stopClock() {
+	if !s.StartedAt.IsZero() {
+		s.Elapsed = installClock().Sub(s.StartedAt)
+	}
+}
+
+// formatElapsed renders a duration as "0.4s", "12.3s" or "2m 05s"
+func formatElapsed(d time.Duration) string {
+	if d < time.Minute {
+		return fmt.Sprintf("%.1fs", d.Seconds())
+	}
+	d = d.Round(time.Second)
+	return fmt.Sprintf("%dm %02ds", int(d.Minutes()), int(d.Seconds())%60)
 }
 
 type StepStatus int
@@ -124,17 +209,26 @@ type UserChoices struct {
 	OS           string // "mac", "linux"
 	Terminal     string // "alacritty", "wezterm", "kitty", "ghostty", "none"
 	InstallFont  bool
-	Shell        string // "fish", "zsh", "nushell"
-	WindowMgr    string // "tmux", "zellij", "none"
+	Shell        string   // "fish", "zsh", "nushell"
+	ZshMerge     bool     // Keep the existing .zshrc and add a managed source block
+	WindowMgr    string   // "tmux", "zellij", "none"
+	WMExtras     []string // IDs from wmExtraOptions; nil installs every extra
 	InstallNvim  bool
 	InstallZed   bool
 	CreateBackup bool // Whether to backup existing configs
+	// Install packages with apt/pacman/dnf instead of Homebrew (Linux only, set by profiles)
+	NativePackages bool
+	// Backup exclusions (caches skipped by default, history kept by default)
+	BackupIncludeCaches  bool
+	BackupExcludeHistory bool
+	BackupCompress       bool // Write the backup as a .tar.gz archive instead of a directory
 	// AI Tools and Framework
 	AITools               []string // Selected AI tools: "claude", "opencode"
 	InstallAIFramework    bool     // Whether to install project-starter-framework
 	AIFrameworkPreset     string   // Preset: "minimal", "frontend", "backend", "fullstack", "data", "complete"
 	AIFrameworkModules    []string // Individual module names when preset is "custom"
 	InstallAgentTeamsLite bool     // Whether to install agent-teams-lite SDD framework
+	AIFrameworkRemove     []string // "category/item" keys to remove after install (apply-only mode)
 	// Project init
 	InitProject      bool
 	ProjectPath      string
@@ -144,30 +238,71 @@ type UserChoices struct {
 	ProjectEngram    bool
 	ProjectRolePacks []string
 	InstallObsidian  bool
+	// Extra git hosts to clone from when GitHub fails, from the config file
+	Mirrors []string
+	// Branch, tag or commit to pin the dotfiles and skill catalog clones to;
+	// "" follows the default branch
+	RepoRef   string
+	SkillsRef string
 }
 
 // Model is the main application state
 type Model struct {
-	Screen      Screen
-	PrevScreen  Screen // For going back from learn/keymaps screens
-	Width       int
-	Height      int
-	SystemInfo  *system.SystemInfo
-	Choices     UserChoices
-	RepoDir     string // Directory name for the cloned repo (overridable for forks)
-	RepoURL     string // Git URL for the dots repo (overridable for forks)
-	Steps       []InstallStep
-	CurrentStep int
-	Cursor      int
-	ErrorMsg    string
-	ShowDetails bool
-	LogLines    []string
-	TotalTime   float64
-	Quitting    bool
+	Screen         Screen
+	PrevScreen     Screen // For going back from learn/keymaps screens
+	Width          int
+	Height         int
+	SystemInfo     *system.SystemInfo
+	Choices        UserChoices
+	RepoDir        string // Directory name for the cloned repo (overridable for forks)
+	RepoURL        string // Git URL for the dots repo (overridable for forks)
+	Steps          []InstallStep
+	CurrentStep    int
+	Cursor         int
+	ErrorMsg       string
+	ShowDetails    bool
+	ShowInstallLog bool      // Full-height log in place of the step list (<space>l)
+	LogLines       logBuffer // Tail of the install output; the full log goes to the install log file
+	TotalTime      float64   // Seconds from installStartMsg to the end of the last step
+	Quitting       bool
+	// When the running install started; zero between installs
+	InstallStarted time.Time
+	// When the running project init or skill catalog update started, for notifyLongTask
+	TaskStarted time.Time
+	// Cancelling the running install (<space>x, see install_cancel.go); the
+	// steps and their commands run under InstallCtx, nil when none runs
+	InstallCtx       context.Context
+	InstallCancel    context.CancelFunc
+	InstallCancelled bool // <space>x was pressed; cleared when the install is resumed
+	// Full-log view (see install_log_view.go): the first line on view, counted
+	// from the first line ever logged, unless it follows the newest output
+	InstallLogScroll   int
+	InstallLogFollow   bool
+	InstallLogPendingG bool // "g" was pressed; a second one jumps to the top
+	// Result of removing temporary directories after a failed step, shown on the error screen
+	TempCleanupNote string
+	// Resumable installs and install logs are written under StateHome ("" disables both)
+	StateHome     string
+	LogPath       string        // Full log of the current or last install, shown on the complete and error screens
+	ResumeState   *installState // Unfinished install from an earlier run, offered on the welcome screen
+	trackProgress bool          // The running plan is a full install whose progress is saved
+	// Shown before installing on an OS release below the supported minimum
+	OSWarning         *system.OSVersionWarning
+	OSWarningAccepted bool // "Continue anyway" was picked; don't ask again this session
+	// Estimated install size against the free space, set when the wizard ends
+	DiskCheck           *diskCheck
+	DiskWarningAccepted bool // "Install anyway" was picked; don't ask again this session
+	// Editing one answer from ScreenWizardReview: confirming it returns to the
+	// review instead of going on with the wizard, and Esc restores ReviewChoices
+	ReturnToReview bool
+	ReviewChoices  UserChoices
+	// Another running installer holds the instance lock; only read-only features are offered
+	LockHolder *system.InstanceLock
 	// Program reference for sending messages during installation
 	Program *tea.Program
 	// Spinner animation
 	SpinnerFrame int
+	Ticking      bool // True while a tick is scheduled; ticks pause when nothing animates
 	// Learn mode
 	ViewingTool string // Current tool being viewed in learn mode
 	// Keymaps mode
@@ -184,15 +319,63 @@ type Model struct {
 	GhosttyKeymapCategories []KeymapCategory
 	GhosttySelectedCategory int
 	GhosttyKeymapScroll     int
+	// Search across the categories of the keymap menu on screen (see keymap_search.go)
+	KeymapSearch       string // Case-insensitive query matched against keys and descriptions
+	KeymapSearchActive bool   // True while typing the query after "/"
+	KeymapSearchScroll int    // Scroll offset of the result list
+	// Outcome per tool of the last Refresh from my config (see keymap_config.go)
+	KeymapRefreshNotes []string
 	// LazyVim mode
 	LazyVimTopics        []LazyVimTopic
 	SelectedLazyVimTopic int
 	LazyVimScroll        int // For scrolling through topic content
 	// Backup mode
-	ExistingConfigs  []string            // Configs that will be overwritten
-	AvailableBackups []system.BackupInfo // Available backups for restore
-	SelectedBackup   int                 // Selected backup index
-	BackupDir        string              // Last backup directory created
+	ExistingConfigs  []string                    // Configs that will be overwritten
+	AvailableBackups []system.BackupInfo         // Available backups for restore
+	SelectedBackup   int                         // Selected backup index
+	BackupKeep       int                         // Backups "Prune old backups" keeps (--keep-backups)
+	BackupNotice     string                      // Result of the last prune, shown on ScreenRestoreBackup
+	BackupDir        string                      // Last backup directory created
+	RepoCommit       RepoCommit                  // Dotfiles commit deployed by this install
+	BackupSizes      []system.BackupSizeEstimate // Size breakdown of ExistingConfigs
+	BackupSizing     bool                        // BackupSizes is still being estimated
+	// Version pinning (see install_refs.go and install_manifest.go)
+	RefField        int              // Clone being edited on ScreenInstallRefInput: 0 dotfiles, 1 skill catalog
+	InstallManifest *installManifest // Commits recorded after the last run; nil before one finishes
+	// Saved choice profiles
+	ChoiceProfiles []choiceProfileEntry // Profiles under ~/.gentleman/profiles, loaded at startup
+	ProfileNotice  string               // Result of the last save or failed load
+	ProfileIssues  []string             // Why PendingProfile doesn't fit this machine
+	PendingProfile *choiceProfile       // Profile held on ScreenProfileMismatch
+	// Restore conflict resolution
+	RestoreItemSelected []bool                           // Toggle state per item of the selected backup on ScreenRestoreItems
+	RestorePlan         []system.RestoreItem             // Every file the selected restore touches
+	RestoreConflicts    []system.RestoreItem             // Items in RestorePlan that need a decision
+	RestoreConflictIdx  int                              // Conflict currently being resolved
+	RestoreChoices      map[string]system.ConflictChoice // Decisions so far, keyed by item path
+	RestoreApplyAll     bool                             // Apply the next decision to all remaining conflicts
+	RestoreCleanup      func()                           // Removes the extracted copy of a compressed backup; nil when none
+	// Diagnose wizard
+	DiagnoseSymptom  int                // Index into diagnosticSymptoms
+	DiagnoseResults  []DiagnosticResult // Ranked results of the last run
+	DiagnoseRunning  bool               // Checks in progress
+	DiagnoseFixMode  bool               // The install pipeline is running a diagnostic fix
+	DiagnoseLastFix  string             // Label of the last fix applied
+	DiagnoseManifest *installManifest   // Last install's manifest, loaded when Diagnose Setup opens (nil if none)
+	// Update-only flow for an existing install (see update_only.go)
+	ExistingInstall *existingInstall // Install found at startup; nil when there is none
+	UpdateMode      bool             // The install pipeline is running an update
+	UpdateSummary   updateSummary    // What the running or last update changed
+	// Check Installation
+	InstallCheckResults []installCheckResult // Results of the last run, in installChecks order
+	InstallCheckRunning bool
+	InstallCheckScroll  int
+	// Uninstall
+	UninstallItems   []uninstallItem   // Detected installer leftovers, with checkboxes
+	UninstallResults []uninstallResult // Per-item outcome of the last run
+	UninstallBackup  string            // Backup taken before removing, "" if none
+	UninstallErr     string            // Why the run stopped before removing anything
+	UninstallRunning bool
 	// Vim Trainer mode
 	TrainerStats       *trainer.UserStats   // User's training stats
 	TrainerGameState   *trainer.GameState   // Current game session state
@@ -201,14 +384,42 @@ type Model struct {
 	TrainerInput       string               // User's input for current exercise
 	TrainerLastCorrect bool                 // Was last answer correct
 	TrainerMessage     string               // Feedback message to display
+	TrainerResetInput  string               // Typed confirmation on the reset-all screen
+	// Whether the finished Speed Run beat the best score
+	TrainerTimedNewBest bool
 	// AI Tools multi-select toggle
 	AIToolSelected []bool // Toggle state for each tool in ScreenAIToolsSelect
+	// Whether each tool is installed or can be installed, see enterAIToolsSelect
+	AIToolStatus []system.AIToolStatus
+	// Multiplexer extras multi-select toggle
+	WMExtraSelected []bool // Toggle state for each extra in ScreenWMExtras
 	// AI Framework category drill-down selection
 	AICategorySelected     map[string][]bool // Toggle state per category: categoryID → []bool for items
 	SelectedModuleCategory int               // Index into moduleCategories for current drill-down
-	CategoryItemsScroll    int               // Scroll offset for long item lists in category drill-down
+	AIModuleSearch         string            // Case-insensitive query matched against module labels and IDs
+	AIModuleSearchActive   bool              // True while typing the query after "/"
+	AIModuleSearchScroll   int               // Scroll offset of the result list
+	// Custom selections saved as presets, listed below the built-ins, and the
+	// last one loaded or saved
+	AIFrameworkUserPresets  []frameworkPreset
+	AIFrameworkPresetNotice string
+	CategoryItemsScroll     int // Scroll offset for long item lists in category drill-down
 	// Leader key mode (like Vim's <space> leader)
-	LeaderMode bool // True when waiting for next key after <space>
+	LeaderMode     bool                     // True when waiting for next key after <space>
+	LeaderSince    time.Time                // When leader mode started; it cancels itself after leaderTimeout
+	LeaderCommands map[string]LeaderCommand // Commands by the key after <space>, see leader.go
+	// Help overlay listing the keys of the screen (see help_overlay.go)
+	ShowHelp bool
+	// Keys arriving before this instant are dropped: after an exec process hands the
+	// terminal back, buffered or half-read input would otherwise hit the wrong screen
+	InputSettleUntil time.Time
+	// Short notice shown in place of the key hints footer until ToastUntil
+	Toast      string
+	ToastUntil time.Time
+	// Mouse (see mouse.go)
+	VisibleRows     *optionRows // Option under each line of the last rendered screen
+	MouseLastOption int         // Option of the last left click, for double clicks
+	MouseLastClick  time.Time
 	// Project init
 	ProjectPathInput string
 	ProjectPathError string
@@ -218,30 +429,87 @@ type Model struct {
 	ProjectCI        string
 	ProjectRolePacks []string
 	RolePackSelected []bool
-	ProjectLogLines  []string
+	// Stacks found in the project root, and the ones checked on ScreenProjectStack;
+	// ProjectStack is the first checked
+	ProjectDetectedStacks []string
+	ProjectStackSelected  []bool
+	ProjectStacks         []string
+	ProjectCommands       []ProjectCommand // Inferred for the "simple" memory preview
+	// Set by "Same settings, new path": a valid path goes straight to the summary
+	ProjectReuseSettings bool
+	// Preview of what project init would create, and its scroll offset
+	ProjectPreview    []projectPreviewLine
+	ProjectPreviewPos int
+	ProjectLogLines   logBuffer
+	ProjectInitCancel context.CancelFunc // Stops the running init-project.sh; nil when none runs
+	// Sub-projects of a batch init; nil when initializing a single directory
+	ProjectBatch        []ProjectBatchItem
+	ProjectBatchCurrent int // Index of the project being initialized
 	// Project path enhanced input
-	ProjectPathCursor      int      // cursor position within rune slice
-	ProjectPathMode        int      // 0=typing, 1=completion, 2=browser
-	ProjectPathCompletions []string // tab-completion matches
-	ProjectPathCompIdx     int      // highlighted completion (-1 = none)
+	ProjectPathCursor      int             // cursor position within rune slice
+	ProjectPathMode        int             // 0=typing, 1=completion, 2=browser, 3=recent, 4=new directory
+	ProjectPathCompletions []string        // tab-completion matches
+	ProjectPathCompIdx     int             // highlighted completion (-1 = none)
+	ProjectRecent          []recentProject // Last validated paths, most recent first
+	ProjectRecentIdx       int             // Highlighted entry while the history is open
 	// File browser
 	FileBrowserEntries    []string // directory names in current listed dir
 	FileBrowserCursor     int      // highlighted entry in browser list
 	FileBrowserScroll     int      // scroll offset for long listings
 	FileBrowserRoot       string   // absolute path being browsed
 	FileBrowserShowHidden bool     // show dotfiles toggle
+	FileBrowserNewDir     string   // name typed for a directory to create in FileBrowserRoot
 	// Skill manager
-	SkillCatalog   []SkillInfo // full catalog from fetchSkillCatalog
-	SkillSelected  []bool      // selection state (reused per screen)
-	SkillScroll    int
-	SkillLoading   bool
-	SkillLoadError string
-	SkillResultLog []string
+	SkillCatalog         []SkillInfo           // full catalog from fetchSkillCatalog
+	SkillCatalogWarnings []SkillCatalogWarning // catalog dirs fetchSkillCatalog skipped, shown on ScreenSkillWarnings
+	SkillWarningScroll   int
+	SkillSelected        []bool // selection state (reused per screen)
+	SkillScroll          int
+	SkillLoading         bool
+	SkillCloneProgress   float64 // git progress of the catalog clone or pull, 0 until git reports any
+	SkillLoadError       string
+	SkillNotice          string // One-line note shown above the skill list (e.g. selections dropped by a reload)
+	SkillResultLog       logBuffer
+	SkillLogPath         string             // Skill log the last result was appended to, "" when none
+	SkillUndo            *skillOperation    // Last install/remove from the skill ledger, nil when nothing can be undone
+	SkillDoctor          *skillDoctorReport // Last scan of the skill link dirs, shown on ScreenSkillDoctor
+	SkillOrphans         []skillLinkEntry   // Links the last catalog update left without a skill; r removes them
+	SkillStats           *SkillCatalogStats // Cached until the catalog or installed set changes
+	SkillDetail          *SkillInfo         // Skill shown on the detail screen
+	SkillDoc             skillDocument      // SKILL.md/PLUGIN.md of SkillDetail, read when the screen opens
+	SkillTree            []string           // Directory tree of SkillDetail, read when the screen opens
+	SkillDetailPos       int                // Scroll offset of the detail screen; Browse keeps its own cursor and scroll
+	SkillManifestExport  bool               // ScreenSkillManifest writes the installed skills instead of installing
+	// Link targets: picked on ScreenSkillTargets after Install/Remove, set up
+	// from the CLI dirs that exist when the Skill Manager opens
+	SkillTargets        []string    // skillTargets IDs the next install starts from
+	SkillTargetSelected []bool      // Toggle state per skillTargets entry on ScreenSkillTargets
+	SkillTargetsFor     Screen      // ScreenSkillInstall or ScreenSkillRemove
+	SkillPending        []SkillInfo // Skills picked on that screen, waiting for targets
+	// Local directories in the way of the pending install, and what to do with each
+	SkillConflicts       []SkillConflict
+	SkillConflictActions []SkillConflictAction
+	// Incremental filter on the install/remove screens
+	SkillFilter       string // Case-insensitive query matched against skill names and descriptions
+	SkillFilterActive bool   // True while typing the query after "/"
+	SkillTagFilter    string // Browse lists only the skills carrying this tag; "t" cycles through them
+	// AI Framework apply-only mode
+	DetectedAITools        []string          // AI tools whose config dir exists (enables the main menu entry)
+	AIFrameworkApplyMode   bool              // True when editing modules of an already-installed setup
+	AIFrameworkRecommended bool              // Preset came from "Recommended for my selection" (preset screen skipped)
+	AIFrameworkInstalled   map[string][]bool // Installed state detected on disk, same shape as AICategorySelected
+	AIFrameworkDiffAdded   []string          // "category/item" keys to add
+	AIFrameworkDiffRemoved []string          // "category/item" keys to remove
+	// UI preferences, loaded by NewModel and saved on quit
+	Settings   installerSettings
+	Theme      *Theme // Styles every view renders with, from Settings.Theme
+	Lang       string // Language of the UI text (see i18n.go), from Settings.Language or GENTLEMAN_LANG
+	ASCIIIcons bool   // Draw the ASCII icon set (see icons.go), from Settings.Icons or the terminal
 }
 
 // NewModel creates a new Model with initial state
 func NewModel() Model {
-	return Model{
+	m := Model{
 		Screen:                  ScreenWelcome,
 		PrevScreen:              ScreenWelcome,
 		Width:                   80,
@@ -254,7 +522,8 @@ func NewModel() Model {
 		CurrentStep:             0,
 		Cursor:                  0,
 		ShowDetails:             false,
-		LogLines:                []string{},
+		LeaderCommands:          defaultLeaderCommands(),
+		LogLines:                newLogBuffer(installLogCap),
 		SpinnerFrame:            0,
 		KeymapCategories:        GetNvimKeymaps(),
 		SelectedCategory:        0,
@@ -274,8 +543,11 @@ func NewModel() Model {
 		ExistingConfigs:         []string{},
 		AvailableBackups:        []system.BackupInfo{},
 		SelectedBackup:          0,
+		BackupKeep:              system.DefaultBackupKeep,
 		BackupDir:               "",
 		Program:                 nil, // Will be set after tea.Program is created
+		VisibleRows:             newOptionRows(),
+		MouseLastOption:         -1,
 		// Trainer initialization
 		TrainerStats:       nil, // Will be loaded when entering trainer
 		TrainerGameState:   nil,
@@ -293,7 +565,7 @@ func NewModel() Model {
 		ProjectCI:              "",
 		ProjectRolePacks:       nil,
 		RolePackSelected:       nil,
-		ProjectLogLines:        []string{},
+		ProjectLogLines:        newLogBuffer(projectLogCap),
 		ProjectPathCursor:      0,
 		ProjectPathMode:        PathModeTyping,
 		ProjectPathCompletions: nil,
@@ -309,8 +581,12 @@ func NewModel() Model {
 		SkillScroll:    0,
 		SkillLoading:   false,
 		SkillLoadError: "",
-		SkillResultLog: []string{},
+		SkillResultLog: newLogBuffer(skillLogCap),
 	}
+	// A missing or broken settings file leaves the defaults
+	home, _ := os.UserHomeDir()
+	settings, _ := loadSettings(home)
+	return m.applySettings(settings)
 }
 
 // SetProgram sets the tea.Program reference for sending messages during installation
@@ -334,8 +610,20 @@ func SetNonInteractiveMode(enabled bool) {
 	nonInteractiveMode = enabled
 }
 
+// dryRunMode plans the install without running any step
+var dryRunMode bool
+
+// SetDryRunMode enables or disables dry-run mode. While enabled, the
+// non-interactive installer prints its plan instead of running it and the
+// step runners refuse to execute anything.
+func SetDryRunMode(enabled bool) {
+	dryRunMode = enabled
+}
+
 // SendLog sends a log message to the TUI during installation
 func SendLog(stepID string, log string) {
+	// The file gets the raw output; only what is drawn on screen is sanitized
+	activeLog.Write(sanitizeStepID(stepID), log)
 	if nonInteractiveMode {
 		// In non-interactive mode, print to stdout if verbose
 		if os.Getenv("GENTLEMAN_VERBOSE") == "1" {
@@ -345,7 +633,7 @@ func SendLog(stepID string, log string) {
 	}
 	if globalProgram != nil {
 		globalProgram.Send(stepProgressMsg{
-			stepID: stepID,
+			stepID: sanitizeStepID(stepID),
 			log:    log,
 		})
 	}
@@ -360,94 +648,141 @@ func (m *Model) SendLog(stepID string, log string) {
 func (m Model) GetCurrentOptions() []string {
 	switch m.Screen {
 	case ScreenMainMenu:
-		opts := []string{
-			"🚀 Start Installation",
-			"📚 Learn & Practice",
+		startLabel := m.T("main_menu.start")
+		if m.installUnsupported() {
+			startLabel += m.T("main_menu.unsupported")
 		}
+		opts := []string{startLabel}
+		// Offer the update-only flow once an earlier install is found
+		if m.ExistingInstall != nil && !m.installUnsupported() {
+			opts = append(opts, m.T("main_menu.update"))
+		}
+		for _, p := range installProfiles {
+			label := p.menuEntry()
+			if m.installUnsupported() {
+				label += m.T("main_menu.unsupported")
+			}
+			opts = append(opts, label)
+		}
+		if len(m.ChoiceProfiles) > 0 {
+			label := m.T("main_menu.profile")
+			if m.installUnsupported() {
+				label += m.T("main_menu.unsupported")
+			}
+			opts = append(opts, label)
+		}
+		opts = append(opts, m.T("main_menu.learn"))
 		// Add restore option if backups exist
 		if len(m.AvailableBackups) > 0 {
-			opts = append(opts, "🔄 Restore from Backup")
+			opts = append(opts, m.T("main_menu.restore"))
+		}
+		opts = append(opts, m.T("main_menu.project"))
+		opts = append(opts, m.T("main_menu.skills"))
+		// Add framework editing when an AI tool is already set up
+		if len(m.DetectedAITools) > 0 {
+			opts = append(opts, m.T("main_menu.ai_framework"))
+		}
+		opts = append(opts, m.T("main_menu.check"))
+		opts = append(opts, m.T("main_menu.diagnose"))
+		opts = append(opts, m.T("main_menu.uninstall"))
+		opts = append(opts, m.T("main_menu.settings"))
+		opts = append(opts, m.T("main_menu.exit"))
+		for i, opt := range opts {
+			opts[i] = m.lockMainMenuEntry(opt)
 		}
-		opts = append(opts, "📦 Initialize Project")
-		opts = append(opts, "🎯 Skill Manager")
-		opts = append(opts, "❌ Exit")
 		return opts
+	case ScreenDiagnoseSymptom:
+		opts := make([]string, 0, len(diagnosticSymptoms)+2)
+		for _, symptom := range diagnosticSymptoms {
+			opts = append(opts, symptom.Label)
+		}
+		return append(opts, "─────────────", m.T("common.back"))
+	case ScreenDiagnoseResults:
+		var opts []string
+		for _, fixID := range m.diagnoseFixIDs() {
+			opts = append(opts, "🔧 "+diagnosticFixes[fixID].Label)
+		}
+		return append(opts, m.T("diagnose_results.rerun"), m.T("common.back"))
 	case ScreenLearnMenu:
 		return []string{
-			"📚 Learn About Tools",
-			"⌨️  Keymaps Reference",
-			"📖 LazyVim Guide",
-			"🎮 Vim Trainer",
+			m.T("learn_menu.tools"),
+			m.T("learn_menu.keymaps"),
+			m.T("common.lazyvim_guide"),
+			m.T("learn_menu.trainer"),
 			"─────────────",
-			"← Back",
+			m.T("common.back"),
 		}
 	case ScreenKeymapsMenu:
-		return []string{"Neovim", "Tmux", "Zellij", "Ghostty", "─────────────", "← Back"}
+		return []string{"Neovim", "Tmux", "Zellij", "Ghostty", m.T("keymaps_menu.refresh"), "─────────────", m.T("common.back")}
+	case ScreenUnsupportedPlatform, ScreenInstanceLocked:
+		return []string{m.T("common.back_to_main_menu")}
+	case ScreenTrainerResetConfirm:
+		return []string{m.T("trainer_reset_confirm.yes"), m.T("trainer_reset_confirm.cancel")}
+	case ScreenTrainerSettings:
+		return []string{m.T("trainer_settings.reset_all"), "─────────────", m.T("common.back")}
 	case ScreenOSSelect:
 		macLabel := "macOS"
 		linuxLabel := "Linux"
 		termuxLabel := "Termux"
 		if m.SystemInfo.OS == system.OSMac {
-			macLabel = "macOS (detected)"
+			macLabel = m.Tf("os_select.detected", "macOS")
 		} else if m.SystemInfo.OS == system.OSTermux {
-			termuxLabel = "Termux (detected)"
-		} else if m.SystemInfo.OS == system.OSLinux || m.SystemInfo.OS == system.OSArch || m.SystemInfo.OS == system.OSDebian || m.SystemInfo.OS == system.OSFedora {
-			linuxLabel = "Linux (detected)"
+			termuxLabel = m.Tf("os_select.detected", "Termux")
+		} else if m.SystemInfo.OS != system.OSWindows && m.SystemInfo.OS != system.OSUnknown {
+			linuxLabel = m.Tf("os_select.detected", "Linux")
+		}
+		// Native Windows is only offered where it was detected
+		if m.SystemInfo.OS == system.OSWindows {
+			return []string{macLabel, linuxLabel, termuxLabel, m.Tf("os_select.detected", "Windows")}
 		}
 		return []string{macLabel, linuxLabel, termuxLabel}
 	case ScreenTerminalSelect:
 		alacrittyLabel := "Alacritty"
-		// On Debian/Ubuntu, Alacritty needs to be built from source (PPAs are unreliable)
-		// This applies to ALL Debian-based systems, not just ARM
-		if m.SystemInfo != nil && (m.SystemInfo.OS == system.OSDebian || m.SystemInfo.OS == system.OSLinux) && m.Choices.OS == "linux" {
-			alacrittyLabel = "Alacritty ⏱️  (builds from source, installs Rust ~5-10 min)"
+		if alacrittyFromSource(&m) {
+			alacrittyLabel = m.T("terminal_select.alacritty_source")
 		}
 		if m.Choices.OS == "mac" {
-			return []string{alacrittyLabel, "WezTerm", "Kitty", "Ghostty", "None", "─────────────", "ℹ️  Learn about terminals"}
+			return []string{alacrittyLabel, "WezTerm", "Kitty", "Ghostty", m.T("common.none"), "─────────────", m.T("terminal_select.learn")}
 		}
-		return []string{alacrittyLabel, "WezTerm", "Ghostty", "None", "─────────────", "ℹ️  Learn about terminals"}
+		return []string{alacrittyLabel, "WezTerm", "Ghostty", m.T("common.none"), "─────────────", m.T("terminal_select.learn")}
 	case ScreenFontSelect:
-		return []string{"Yes, install Iosevka Term Nerd Font", "No, I already have it"}
+		return []string{m.T("font_select.yes"), m.T("font_select.no")}
 	case ScreenShellSelect:
-		return []string{"Fish", "Zsh", "Nushell", "─────────────", "ℹ️  Learn about shells"}
+		return []string{"Fish", "Zsh", "Nushell", "─────────────", m.T("shell_select.learn")}
 	case ScreenWMSelect:
-		return []string{"Tmux", "Zellij", "None", "─────────────", "ℹ️  Learn about multiplexers"}
+		return []string{"Tmux", "Zellij", m.T("common.none"), "─────────────", m.T("wm_select.learn")}
+	case ScreenWMExtras:
+		return m.wmExtrasOptions()
 	case ScreenNvimSelect:
-		return []string{"Yes, install Neovim with config", "No, skip Neovim", "─────────────", "ℹ️  Learn about Neovim", "⌨️  View Keymaps", "📖 LazyVim Guide"}
+		return []string{m.T("nvim_select.yes"), m.T("nvim_select.no"), "─────────────", m.T("nvim_select.learn"), m.T("nvim_select.keymaps"), m.T("common.lazyvim_guide")}
 	case ScreenZedSelect:
-		return []string{"Yes, install Zed with config", "No, skip Zed"}
+		return []string{m.T("zed_select.yes"), m.T("zed_select.no")}
 	case ScreenAIToolsSelect:
-		return []string{"Claude Code", "OpenCode", "Gemini CLI", "GitHub Copilot", "Codex CLI", "Qwen Code", "─────────────", "🔘 Select All", "✅ Confirm selection"}
+		return append(m.aiToolOptions(), "─────────────", m.T("common.select_all"), m.T("common.confirm_selection"))
 	case ScreenAIFrameworkConfirm:
-		return []string{"Yes, install AI Framework", "No, skip framework"}
-	case ScreenAIFrameworkPreset:
 		return []string{
-			"🔧 Custom — Pick individual modules",
-			"─────────────",
-			"🎯 Minimal — Core + git commands only",
-			"🖥️  Frontend — React, Vue, Angular, testing, security hooks",
-			"⚙️  Backend — APIs, databases, microservices, security hooks",
-			"🔄 Fullstack — Frontend + Backend + infra + all commands",
-			"📊 Data — Data engineering, ML/AI, analytics",
-			"📦 Complete — Everything included",
+			m.Tf("ai_framework_confirm.recommended", presetTitle(recommendAIPreset(m.Choices.AITools).Preset)),
+			m.T("ai_framework_confirm.customize"),
+			m.T("ai_framework_confirm.skip"),
 		}
+	case ScreenAIFrameworkPreset:
+		return append([]string{
+			m.T("ai_framework_preset.custom"),
+			"─────────────",
+			m.T("ai_framework_preset.minimal"),
+			m.T("ai_framework_preset.frontend"),
+			m.T("ai_framework_preset.backend"),
+			m.T("ai_framework_preset.fullstack"),
+			m.T("ai_framework_preset.data"),
+			m.T("ai_framework_preset.complete"),
+		}, m.userPresetOptions()...)
 	case ScreenAIFrameworkCategories:
 		opts := make([]string, 0, len(moduleCategories)+2)
-		for i, cat := range moduleCategories {
-			selected := 0
-			total := len(cat.Items)
-			if bools, ok := m.AICategorySelected[cat.ID]; ok {
-				for _, b := range bools {
-					if b {
-						selected++
-					}
-				}
-			}
-			opts = append(opts, fmt.Sprintf("%s %s (%d/%d selected)", cat.Icon, cat.Label, selected, total))
-			_ = i
+		for _, cat := range moduleCategories {
+			opts = append(opts, m.aiCategoryCounter(cat))
 		}
 		opts = append(opts, "─────────────")
-		opts = append(opts, "✅ Confirm selection")
+		opts = append(opts, m.T("common.confirm_selection"))
 		return opts
 	case ScreenAIFrameworkCategoryItems:
 		if m.SelectedModuleCategory < 0 || m.SelectedModuleCategory >= len(moduleCategories) {
@@ -461,48 +796,97 @@ func (m Model) GetCurrentOptions() []string {
 			opts[i] = e.label
 		}
 		return opts
+	case ScreenAIFrameworkSummary:
+		if !m.Choices.InstallAIFramework {
+			// Nothing selected, so nothing worth saving
+			return []string{m.T("ai_framework_summary.proceed"), m.T("ai_framework_summary.back")}
+		}
+		return []string{m.T("ai_framework_summary.proceed"), m.T("ai_framework_summary.save"), m.T("ai_framework_summary.back")}
+	case ScreenAIFrameworkApplyDiff:
+		if len(m.AIFrameworkDiffAdded) == 0 && len(m.AIFrameworkDiffRemoved) == 0 {
+			return []string{m.T("ai_framework_apply_diff.back"), m.T("common.cancel")}
+		}
+		return []string{m.T("ai_framework_apply_diff.apply"), m.T("ai_framework_apply_diff.back"), m.T("common.cancel")}
+	case ScreenOSVersionWarning:
+		return []string{m.T("os_version_warning.continue"), m.T("os_version_warning.abort")}
 	case ScreenBackupConfirm:
 		return []string{
-			"✅ Install with Backup (recommended)",
-			"⚠️  Install without Backup",
-			"❌ Cancel",
+			m.T("backup_confirm.with_backup"),
+			m.T("backup_confirm.without_backup"),
+			m.T("backup_confirm.save_profile"),
+			m.T("common.cancel"),
 		}
+	case ScreenDiskSpaceWarning:
+		return []string{m.T("disk_space_warning.continue"), m.T("disk_space_warning.abort")}
+	case ScreenWizardReview:
+		return m.wizardReviewOptions()
+	case ScreenInstallRefs:
+		return m.installRefsOptions()
+	case ScreenProfileSelect:
+		return m.choiceProfileOptions()
+	case ScreenProfileMismatch:
+		return m.profileMismatchOptions()
+	case ScreenSkillTargets:
+		return m.skillTargetsOptions()
+	case ScreenSkillConflicts:
+		return m.skillConflictsOptions()
+	case ScreenSettings:
+		return m.settingsOptions()
+	case ScreenRestoreItems:
+		return m.restoreItemsOptions()
 	case ScreenRestoreBackup:
-		opts := make([]string, len(m.AvailableBackups)+2)
-		for i, backup := range m.AvailableBackups {
-			// Format: timestamp + file count
-			opts[i] = fmt.Sprintf("%s (%d items)", backup.Timestamp.Format("2006-01-02 15:04:05"), len(backup.Files))
+		var opts []string
+		for _, backup := range m.AvailableBackups {
+			opts = append(opts, backupLabel(backup))
+		}
+		opts = append(opts, "─────────────")
+		if n := len(system.BackupsToPrune(m.AvailableBackups, m.BackupKeep)); n > 0 {
+			opts = append(opts, m.Tf("restore_backup.prune", max(m.BackupKeep, 1), n))
+		}
+		return append(opts, m.T("common.back"))
+	case ScreenBackupPrune:
+		return []string{
+			m.Tf("backup_prune.delete", len(system.BackupsToPrune(m.AvailableBackups, m.BackupKeep))),
+			m.T("common.cancel"),
+		}
+	case ScreenRestoreConflict:
+		return []string{
+			m.T("restore_conflict.keep"),
+			m.T("restore_conflict.take"),
+			m.Tf("restore_conflict.both", system.FromBackupSuffix),
 		}
-		opts[len(m.AvailableBackups)] = "─────────────"
-		opts[len(m.AvailableBackups)+1] = "← Back"
-		return opts
 	case ScreenRestoreConfirm:
 		return []string{
-			"✅ Yes, restore this backup",
-			"🗑️  Delete this backup",
-			"❌ Cancel",
+			m.T("restore_confirm.yes"),
+			m.T("restore_confirm.delete"),
+			m.T("common.cancel"),
+		}
+	case ScreenZshMergeSelect:
+		return []string{
+			m.T("zsh_merge_select.merge"),
+			m.T("zsh_merge_select.replace"),
 		}
 	case ScreenGhosttyWarning:
 		return []string{
-			"⚠️  Continue with Ghostty anyway",
-			"🔄 Choose a different terminal",
-			"❌ Cancel installation",
+			m.T("ghostty_warning.continue"),
+			m.T("ghostty_warning.change"),
+			m.T("ghostty_warning.cancel"),
 		}
 	case ScreenLearnTerminals:
-		return []string{"Alacritty", "WezTerm", "Kitty", "Ghostty", "─────────────", "← Back"}
+		return []string{"Alacritty", "WezTerm", "Kitty", "Ghostty", "─────────────", m.T("common.back")}
 	case ScreenLearnShells:
-		return []string{"Fish", "Zsh", "Nushell", "─────────────", "← Back"}
+		return []string{"Fish", "Zsh", "Nushell", "─────────────", m.T("common.back")}
 	case ScreenLearnWM:
-		return []string{"Tmux", "Zellij", "─────────────", "← Back"}
+		return []string{"Tmux", "Zellij", "─────────────", m.T("common.back")}
 	case ScreenLearnNvim:
-		return []string{"View Features", "View Keymaps", "📖 LazyVim Guide", "─────────────", "← Back"}
+		return []string{m.T("learn_nvim.features"), m.T("learn_nvim.keymaps"), m.T("common.lazyvim_guide"), "─────────────", m.T("common.back")}
 	case ScreenKeymaps:
 		categories := make([]string, len(m.KeymapCategories)+2)
 		for i, cat := range m.KeymapCategories {
 			categories[i] = cat.Name
 		}
 		categories[len(m.KeymapCategories)] = "─────────────"
-		categories[len(m.KeymapCategories)+1] = "← Back"
+		categories[len(m.KeymapCategories)+1] = m.T("common.back")
 		return categories
 	case ScreenKeymapsTmux:
 		categories := make([]string, len(m.TmuxKeymapCategories)+2)
@@ -510,7 +894,7 @@ func (m Model) GetCurrentOptions() []string {
 			categories[i] = cat.Name
 		}
 		categories[len(m.TmuxKeymapCategories)] = "─────────────"
-		categories[len(m.TmuxKeymapCategories)+1] = "← Back"
+		categories[len(m.TmuxKeymapCategories)+1] = m.T("common.back")
 		return categories
 	case ScreenKeymapsZellij:
 		categories := make([]string, len(m.ZellijKeymapCategories)+2)
@@ -518,7 +902,7 @@ func (m Model) GetCurrentOptions() []string {
 			categories[i] = cat.Name
 		}
 		categories[len(m.ZellijKeymapCategories)] = "─────────────"
-		categories[len(m.ZellijKeymapCategories)+1] = "← Back"
+		categories[len(m.ZellijKeymapCategories)+1] = m.T("common.back")
 		return categories
 	case ScreenKeymapsGhostty:
 		categories := make([]string, len(m.GhosttyKeymapCategories)+2)
@@ -526,48 +910,56 @@ func (m Model) GetCurrentOptions() []string {
 			categories[i] = cat.Name
 		}
 		categories[len(m.GhosttyKeymapCategories)] = "─────────────"
-		categories[len(m.GhosttyKeymapCategories)+1] = "← Back"
+		categories[len(m.GhosttyKeymapCategories)+1] = m.T("common.back")
 		return categories
 	case ScreenLearnLazyVim:
 		titles := GetLazyVimTopicTitles()
 		result := make([]string, len(titles)+2)
 		copy(result, titles)
 		result[len(titles)] = "─────────────"
-		result[len(titles)+1] = "← Back"
+		result[len(titles)+1] = m.T("common.back")
 		return result
 	// Project Init screens
 	case ScreenProjectStack:
-		return []string{"Angular", "Node.js", "Go", "Python", "Rust", "Java", "Ruby", "PHP", "Other"}
+		return m.projectStackOptions()
 	case ScreenProjectMemory:
-		return []string{"🧠 Obsidian Brain", "📋 VibeKanban", "🧠 Engram", "📝 Simple", "❌ None"}
+		return []string{"🧠 Obsidian Brain", "📋 VibeKanban", "🧠 Engram", "📝 Simple", m.T("project_memory.none")}
 	case ScreenProjectObsidianInstall:
-		return []string{"Yes, install Obsidian", "No, continue without it"}
+		return []string{m.T("project_obsidian_install.yes"), m.T("project_obsidian_install.no")}
 	case ScreenProjectEngram:
-		return []string{"Yes, add Engram too", "No, just Obsidian Brain"}
+		return []string{m.T("project_engram.yes"), m.T("project_engram.no")}
 	case ScreenProjectRolePack:
-		coreLabel := "[x] Core (always included)"
-		devLabel := "[ ] Developer Pack"
-		pmLabel := "[ ] PM/Tech Lead Pack"
+		coreLabel := "[x] " + m.T("project_role_pack.core")
+		devLabel := "[ ] " + m.T("project_role_pack.dev")
+		pmLabel := "[ ] " + m.T("project_role_pack.pm")
 		if m.RolePackSelected != nil && len(m.RolePackSelected) > 0 && m.RolePackSelected[0] {
-			devLabel = "[x] Developer Pack"
+			devLabel = "[x] " + m.T("project_role_pack.dev")
 		}
 		if m.RolePackSelected != nil && len(m.RolePackSelected) > 1 && m.RolePackSelected[1] {
-			pmLabel = "[x] PM/Tech Lead Pack"
+			pmLabel = "[x] " + m.T("project_role_pack.pm")
 		}
-		return []string{coreLabel, devLabel, pmLabel, "─────────────", "✅ Confirm selection"}
+		return []string{coreLabel, devLabel, pmLabel, "─────────────", m.T("common.confirm_selection")}
 	case ScreenProjectCI:
-		return []string{"GitHub Actions", "GitLab CI", "Woodpecker", "None"}
+		return []string{"GitHub Actions", "GitLab CI", "Woodpecker", m.T("common.none")}
 	case ScreenProjectConfirm:
-		return []string{"✅ Confirm & Initialize", "❌ Cancel"}
+		return []string{m.T("project_confirm.confirm"), m.T("project_confirm.preview"), m.T("common.cancel")}
+	case ScreenProjectBatchSelect:
+		return m.projectBatchOptions()
+	case ScreenProjectResult:
+		return m.projectResultOptions()
 	// Skill Manager screens
 	case ScreenSkillMenu:
-		return []string{"🔍 Browse Skills", "📥 Install Skills", "🗑️  Remove Skills", "🔄 Update Catalog", "─────────────", "← Back"}
-	case ScreenSkillBrowse:
-		return m.buildSkillBrowseOptions()
-	case ScreenSkillInstall:
-		return m.buildSkillInstallOptions()
-	case ScreenSkillRemove:
-		return m.buildSkillRemoveOptions()
+		undo := m.T("skill_menu.undo") + m.T("skill_menu.nothing_to_undo")
+		if m.SkillUndo.Undoable() {
+			undo = m.T("skill_menu.undo") + " (" + m.SkillUndo.Label() + ")"
+		}
+		return []string{m.T("skill_menu.browse"), m.T("skill_menu.install"), m.T("skill_menu.remove"), m.T("skill_menu.update"), m.T("skill_menu.stats"), undo, m.T("skill_menu.doctor"), m.T("skill_menu.manifest"), m.T("skill_menu.export"), m.T("skill_menu.url"), "─────────────", m.T("common.back")}
+	case ScreenSkillDoctor:
+		return m.skillDoctorOptions()
+	case ScreenUninstall:
+		return m.uninstallOptions()
+	case ScreenSkillBrowse, ScreenSkillInstall, ScreenSkillRemove:
+		return optionLabels(m.skillRows())
 	default:
 		return []string{}
 	}
@@ -577,144 +969,237 @@ func (m Model) GetCurrentOptions() []string {
 func (m Model) GetScreenTitle() string {
 	switch m.Screen {
 	case ScreenWelcome:
-		return "Welcome to Javi.Dots Installer"
+		return m.T("welcome.title")
 	case ScreenMainMenu:
-		return "Main Menu"
+		return m.T("main_menu.title")
 	case ScreenLearnMenu:
-		return "📚 Learn & Practice"
+		return m.T("learn_menu.title")
 	case ScreenOSSelect:
-		return "Step 1: Select Your Operating System"
+		return m.T("os_select.title")
 	case ScreenTerminalSelect:
-		return "Step 2: Choose Terminal Emulator"
+		return m.T("terminal_select.title")
 	case ScreenFontSelect:
-		return "Step 3: Nerd Font Installation"
+		return m.T("font_select.title")
 	case ScreenShellSelect:
-		return "Step 4: Choose Your Shell"
+		return m.T("shell_select.title")
 	case ScreenWMSelect:
-		return "Step 5: Choose Window Manager"
+		return m.T("wm_select.title")
+	case ScreenWMExtras:
+		return m.Tf("wm_extras.title", wmDisplayName(m.Choices.WindowMgr))
 	case ScreenNvimSelect:
-		return "Step 6: Neovim Configuration"
+		return m.T("nvim_select.title")
 	case ScreenZedSelect:
-		return "Step 7: Zed Editor"
+		return m.T("zed_select.title")
 	case ScreenAIToolsSelect:
-		return "Step 8: AI Coding Tools"
+		return m.T("ai_tools_select.title")
 	case ScreenAIFrameworkConfirm:
-		return "Step 9: AI Framework"
+		return m.T("ai_framework_confirm.title")
 	case ScreenAIFrameworkPreset:
-		return "Step 9: Choose Framework Preset"
+		return m.T("ai_framework_preset.title")
 	case ScreenAIFrameworkCategories:
-		return "Step 9: Select Module Categories"
+		if m.AIFrameworkApplyMode {
+			return m.T("ai_framework_categories.title_apply")
+		}
+		return m.T("ai_framework_categories.title")
 	case ScreenAIFrameworkCategoryItems:
 		if m.SelectedModuleCategory >= 0 && m.SelectedModuleCategory < len(moduleCategories) {
 			cat := moduleCategories[m.SelectedModuleCategory]
-			return fmt.Sprintf("Step 9: %s %s", cat.Icon, cat.Label)
+			return m.Tf("ai_framework_category_items.title_category", cat.Icon, cat.Label)
 		}
-		return "Step 9: Select Modules"
+		return m.T("ai_framework_category_items.title")
+	case ScreenAIFrameworkSummary:
+		return m.T("ai_framework_summary.title")
+	case ScreenAIFrameworkPresetSave:
+		return m.T("ai_framework_preset_save.title")
+	case ScreenAIFrameworkApplyDiff:
+		return m.T("ai_framework_apply_diff.title")
 	case ScreenBackupConfirm:
-		return "⚠️  Existing Configs Detected"
+		return m.T("backup_confirm.title")
+	case ScreenOSVersionWarning:
+		return m.T("os_version_warning.title")
+	case ScreenDiskSpaceWarning:
+		return m.T("disk_space_warning.title")
+	case ScreenWizardReview:
+		return m.T("wizard_review.title")
 	case ScreenRestoreBackup:
-		return "🔄 Restore from Backup"
+		return m.T("restore_backup.title")
 	case ScreenRestoreConfirm:
-		return "🔄 Confirm Restore"
+		return m.T("restore_confirm.title")
+	case ScreenRestoreItems:
+		return m.T("restore_items.title")
+	case ScreenBackupPrune:
+		return m.T("backup_prune.title")
+	case ScreenDiagnoseSymptom:
+		return m.T("diagnose_symptom.title")
+	case ScreenDiagnoseResults:
+		return m.T("diagnose_results.title")
+	case ScreenInstallCheck:
+		return m.T("install_check.title")
+	case ScreenRestoreConflict:
+		return m.Tf("restore_conflict.title", m.RestoreConflictIdx+1, len(m.RestoreConflicts))
 	case ScreenGhosttyWarning:
-		return "⚠️  Ghostty Compatibility Warning"
+		return m.T("ghostty_warning.title")
+	case ScreenZshMergeSelect:
+		return m.T("zsh_merge_select.title")
 	case ScreenInstalling:
-		return "Installing..."
+		if m.UpdateMode {
+			return m.T("installing.title_update")
+		}
+		return m.T("installing.title")
 	case ScreenComplete:
-		return "Installation Complete!"
+		if m.UpdateMode {
+			return m.T("complete.title_update")
+		}
+		return m.T("complete.title")
 	case ScreenError:
-		return "Error"
+		return m.T("error.title")
 	case ScreenLearnTerminals:
-		return "📚 Learn: Terminal Emulators"
+		return m.T("learn_terminals.title")
 	case ScreenLearnShells:
-		return "📚 Learn: Shells"
+		return m.T("learn_shells.title")
 	case ScreenLearnWM:
-		return "📚 Learn: Window Managers"
+		return m.T("learn_wm.title")
 	case ScreenLearnNvim:
-		return "📚 Learn: Neovim"
+		return m.T("learn_nvim.title")
 	case ScreenKeymaps:
-		return "⌨️  Neovim Keymaps Reference"
+		return m.T("keymaps.title")
 	case ScreenKeymapCategory:
 		if m.SelectedCategory < len(m.KeymapCategories) {
 			return "⌨️  " + m.KeymapCategories[m.SelectedCategory].Name
 		}
-		return "⌨️  Keymaps"
+		return m.T("keymap_category.title")
 	case ScreenKeymapsMenu:
-		return "⌨️  Keymaps Reference"
+		return m.T("keymaps_menu.title")
 	case ScreenKeymapsTmux:
-		return "⌨️  Tmux Keymaps"
+		return m.T("keymaps_tmux.title")
 	case ScreenKeymapsTmuxCat:
 		if m.TmuxSelectedCategory < len(m.TmuxKeymapCategories) {
 			return "⌨️  " + m.TmuxKeymapCategories[m.TmuxSelectedCategory].Name
 		}
-		return "⌨️  Tmux Keymaps"
+		return m.T("keymaps_tmux_cat.title")
 	case ScreenKeymapsZellij:
-		return "⌨️  Zellij Keymaps"
+		return m.T("keymaps_zellij.title")
 	case ScreenKeymapsZellijCat:
 		if m.ZellijSelectedCategory < len(m.ZellijKeymapCategories) {
 			return "⌨️  " + m.ZellijKeymapCategories[m.ZellijSelectedCategory].Name
 		}
-		return "⌨️  Zellij Keymaps"
+		return m.T("keymaps_zellij_cat.title")
 	case ScreenKeymapsGhostty:
-		return "⌨️  Ghostty Keymaps"
+		return m.T("keymaps_ghostty.title")
 	case ScreenKeymapsGhosttyCat:
 		if m.GhosttySelectedCategory < len(m.GhosttyKeymapCategories) {
 			return "⌨️  " + m.GhosttyKeymapCategories[m.GhosttySelectedCategory].Name
 		}
-		return "⌨️  Ghostty Keymaps"
+		return m.T("keymaps_ghostty_cat.title")
 	case ScreenLearnLazyVim:
-		return "📖 LazyVim Guide"
+		return m.T("learn_lazy_vim.title")
 	case ScreenLazyVimTopic:
 		if m.SelectedLazyVimTopic < len(m.LazyVimTopics) {
 			return "📖 " + m.LazyVimTopics[m.SelectedLazyVimTopic].Title
 		}
-		return "📖 LazyVim"
+		return m.T("lazy_vim_topic.title")
 	case ScreenTrainerMenu:
-		return "🎮 Vim Trainer - Module Selection"
+		return m.T("trainer_menu.title")
 	case ScreenTrainerLesson:
-		return "🎮 Vim Trainer - Lesson"
+		return m.T("trainer_lesson.title")
 	case ScreenTrainerPractice:
-		return "🎮 Vim Trainer - Practice"
+		return m.T("trainer_practice.title")
 	case ScreenTrainerBoss:
-		return "🎮 Vim Trainer - Boss Fight!"
+		return m.T("trainer_boss.title")
 	case ScreenTrainerResult:
-		return "🎮 Vim Trainer - Result"
+		return m.T("trainer_result.title")
 	case ScreenTrainerBossResult:
-		return "🎮 Vim Trainer - Boss Battle Complete"
+		return m.T("trainer_boss_result.title")
+	case ScreenTrainerTimed:
+		return m.T("trainer_timed.title")
 	// Project Init screens
 	case ScreenProjectPath:
-		return "📦 Initialize Project — Path"
+		return m.T("project_path.title")
 	case ScreenProjectStack:
-		return "📦 Initialize Project — Stack"
+		return m.T("project_stack.title")
 	case ScreenProjectMemory:
-		return "📦 Initialize Project — Memory Module"
+		return m.T("project_memory.title")
 	case ScreenProjectObsidianInstall:
-		return "📦 Initialize Project — Obsidian App"
+		return m.T("project_obsidian_install.title")
 	case ScreenProjectEngram:
-		return "📦 Initialize Project — Engram Add-on"
+		return m.T("project_engram.title")
 	case ScreenProjectRolePack:
-		return "📦 Initialize Project — Role Packs"
+		return m.T("project_role_pack.title")
 	case ScreenProjectCI:
-		return "📦 Initialize Project — CI/CD Provider"
+		return m.T("project_ci.title")
 	case ScreenProjectConfirm:
-		return "📦 Initialize Project — Confirm"
+		return m.T("project_confirm.title")
+	case ScreenProjectPreview:
+		return m.T("project_preview.title")
 	case ScreenProjectInstalling:
-		return "📦 Initializing Project..."
+		return m.T("project_installing.title")
 	case ScreenProjectResult:
-		return "📦 Project Initialization Result"
+		return m.T("project_result.title")
+	case ScreenProjectBatchSelect:
+		return m.T("project_batch_select.title")
+	case ScreenProjectBatchResult:
+		return m.T("project_batch_result.title")
 	// Skill Manager screens
 	case ScreenSkillMenu:
-		return "🎯 Skill Manager"
+		return m.T("skill_menu.title")
 	case ScreenSkillBrowse:
-		return "🎯 Skill Manager — Browse"
+		return m.T("skill_browse.title")
 	case ScreenSkillInstall:
-		return "🎯 Skill Manager — Install"
+		return m.T("skill_install.title")
 	case ScreenSkillRemove:
-		return "🎯 Skill Manager — Remove"
+		return m.T("skill_remove.title")
 	case ScreenSkillResult:
-		return "🎯 Skill Manager — Result"
+		return m.T("skill_result.title")
+	case ScreenSkillDoctor:
+		return m.T("skill_doctor.title")
+	case ScreenUninstall:
+		return m.T("uninstall.title")
+	case ScreenUninstallResult:
+		return m.T("uninstall_result.title")
 	case ScreenSkillUpdate:
-		return "🎯 Skill Manager — Update Catalog"
+		return m.T("skill_update.title")
+	case ScreenSkillStats:
+		return m.T("skill_stats.title")
+	case ScreenSkillWarnings:
+		return m.T("skill_warnings.title")
+	case ScreenSkillTargets:
+		return m.T("skill_targets.title")
+	case ScreenSkillConflicts:
+		return m.T("skill_conflicts.title")
+	case ScreenSettings:
+		return m.T("settings.title")
+	case ScreenSkillManifest:
+		if m.SkillManifestExport {
+			return m.T("skill_manifest.title_export")
+		}
+		return m.T("skill_manifest.title")
+	case ScreenSkillURL:
+		return m.T("skill_url.title")
+	case ScreenProfileSave:
+		return m.T("profile_save.title")
+	case ScreenInstallRefs, ScreenInstallRefInput:
+		return m.T("install_refs.title")
+	case ScreenProfileSelect:
+		return m.T("profile_select.title")
+	case ScreenProfileMismatch:
+		return m.T("profile_mismatch.title")
+	case ScreenUnsupportedPlatform:
+		return m.T("unsupported_platform.title")
+	case ScreenInstanceLocked:
+		return m.T("instance_locked.title")
+	case ScreenTrainerResetConfirm:
+		return m.T("trainer_reset_confirm.title")
+	case ScreenTrainerSettings:
+		return m.T("trainer_settings.title")
+	case ScreenTrainerImport:
+		return m.T("trainer_import.title")
+	case ScreenTrainerResetAll:
+		return m.T("trainer_reset_all.title")
+	case ScreenSkillDetail:
+		if m.SkillDetail != nil {
+			return m.Tf("skill_detail.title_skill", m.SkillDetail.Name)
+		}
+		return m.T("skill_detail.title")
 	default:
 		return ""
 	}
@@ -724,92 +1209,234 @@ func (m Model) GetScreenTitle() string {
 func (m Model) GetScreenDescription() string {
 	switch m.Screen {
 	case ScreenLearnMenu:
-		return "Explore tools, keymaps, guides, and practice Vim"
+		return m.T("learn_menu.desc")
 	case ScreenOSSelect:
 		detected := m.SystemInfo.OSName
 		if m.SystemInfo.IsWSL {
 			detected += " (WSL)"
 		}
-		return "Detected: " + detected
+		return m.Tf("os_select.desc", detected)
 	case ScreenTerminalSelect:
 		if m.SystemInfo.IsWSL {
-			return "Note: Terminal emulators should be installed on Windows for WSL"
+			return m.T("terminal_select.desc_wsl")
 		}
-		return "Select your preferred terminal emulator"
+		return m.T("terminal_select.desc")
 	case ScreenFontSelect:
-		return "Iosevka Term Nerd Font is required for icons and glyphs"
+		return m.T("font_select.desc")
 	case ScreenShellSelect:
-		return "Current shell: " + m.SystemInfo.UserShell
+		return m.Tf("shell_select.desc", m.SystemInfo.UserShell)
 	case ScreenWMSelect:
-		return "Terminal multiplexer for managing sessions"
+		return m.T("wm_select.desc")
+	case ScreenWMExtras:
+		return m.Tf("wm_extras.desc", wmDisplayName(m.Choices.WindowMgr))
 	case ScreenNvimSelect:
-		return "Includes LSP, TreeSitter, and Gentleman config"
+		return m.T("nvim_select.desc")
 	case ScreenZedSelect:
-		return "High-performance editor with Vim mode and AI agent support"
+		return m.T("zed_select.desc")
 	case ScreenAIToolsSelect:
-		return "Toggle tools with Enter. Confirm when ready."
+		return m.T("ai_tools_select.desc")
 	case ScreenAIFrameworkConfirm:
-		return "Agents, skills, hooks, and commands for AI coding tools"
+		return m.Tf("ai_framework_confirm.desc", recommendAIPreset(m.Choices.AITools).Reason)
 	case ScreenAIFrameworkPreset:
-		return "Presets bundle agents, skills, hooks, and commands by role"
+		return m.T("ai_framework_preset.desc")
 	case ScreenAIFrameworkCategories:
-		return "Select a category to configure its modules"
+		if m.AIFrameworkApplyMode {
+			return m.T("ai_framework_categories.desc_apply")
+		}
+		return m.T("ai_framework_categories.desc")
 	case ScreenAIFrameworkCategoryItems:
-		return "Toggle modules with Enter. Press Esc to go back."
+		return m.T("ai_framework_category_items.desc")
+	case ScreenAIFrameworkSummary:
+		return m.T("ai_framework_summary.desc")
+	case ScreenAIFrameworkPresetSave:
+		return m.T("ai_framework_preset_save.desc")
+	case ScreenAIFrameworkApplyDiff:
+		return m.T("ai_framework_apply_diff.desc")
+	case ScreenDiagnoseSymptom:
+		return m.T("diagnose_symptom.desc")
+	case ScreenDiagnoseResults:
+		return diagnosticSymptoms[m.DiagnoseSymptom].Label
+	case ScreenInstallCheck:
+		return m.T("install_check.desc")
+	case ScreenZshMergeSelect:
+		return m.T("zsh_merge_select.desc")
 	case ScreenGhosttyWarning:
-		return "Ghostty installation may fail on Ubuntu/Debian.\nThe installer script only supports certain versions."
+		return m.T("ghostty_warning.desc")
 	// Project Init screens
 	case ScreenProjectPath:
-		return "Enter the path to your project directory"
+		return m.T("project_path.desc")
 	case ScreenProjectStack:
-		if m.ProjectStack != "" && m.ProjectStack != "unknown" {
-			return "Auto-detected: " + m.ProjectStack
+		detected := m.ProjectDetectedStacks
+		if len(detected) == 0 && m.ProjectStack != "" && m.ProjectStack != "unknown" {
+			detected = []string{m.ProjectStack}
+		}
+		if len(detected) > 0 {
+			return m.Tf("project_stack.desc_detected", strings.Join(detected, ", "))
 		}
-		return "Select your project's tech stack"
+		return m.T("project_stack.desc")
 	case ScreenProjectMemory:
-		return "Choose an AI memory module for your project"
+		return m.T("project_memory.desc")
 	case ScreenProjectObsidianInstall:
-		return "Obsidian app not detected. Install it for Obsidian Brain?"
+		return m.T("project_obsidian_install.desc")
 	case ScreenProjectEngram:
-		return "Add Engram persistent memory alongside Obsidian Brain?"
+		return m.T("project_engram.desc")
 	case ScreenProjectRolePack:
-		return "Select role packs for your Obsidian Brain vault"
+		return m.T("project_role_pack.desc")
 	case ScreenProjectCI:
-		return "Select CI/CD provider for your project"
+		return m.T("project_ci.desc")
 	case ScreenProjectConfirm:
-		return "Review your choices before initializing"
+		return m.T("project_confirm.desc")
+	case ScreenProjectPreview:
+		return m.T("project_preview.desc")
 	case ScreenProjectInstalling:
-		return "Running init-project.sh..."
+		return m.T("project_installing.desc")
 	case ScreenProjectResult:
-		return "Initialization complete"
+		return m.T("project_result.desc")
+	case ScreenProjectBatchSelect:
+		return m.Tf("project_batch_select.desc", len(m.ProjectBatch))
+	case ScreenProjectBatchResult:
+		return m.T("project_batch_result.desc")
 	// Skill Manager screens
 	case ScreenSkillMenu:
-		return "Manage skills from the Gentleman-Skills catalog"
+		return m.T("skill_menu.desc")
 	case ScreenSkillBrowse:
-		return "Available skills from the catalog"
+		if m.SkillTagFilter != "" {
+			return m.Tf("skill_browse.desc_tag", m.SkillTagFilter)
+		}
+		return m.T("skill_browse.desc")
 	case ScreenSkillInstall:
-		return "Toggle skills to install with Enter, then confirm"
+		return m.T("skill_install.desc")
 	case ScreenSkillRemove:
-		return "Toggle skills to remove with Enter, then confirm"
+		return m.T("skill_remove.desc")
+	case ScreenSkillStats:
+		return m.T("skill_stats.desc")
+	case ScreenSkillWarnings:
+		return m.T("skill_warnings.desc")
+	case ScreenSkillTargets:
+		if m.SkillTargetsFor == ScreenSkillRemove {
+			return m.T("skill_targets.desc_remove")
+		}
+		return m.T("skill_targets.desc")
+	case ScreenSkillConflicts:
+		return m.T("skill_conflicts.desc")
+	case ScreenSettings:
+		if m.readOnly() {
+			return m.T("settings.desc_read_only")
+		}
+		home, _ := os.UserHomeDir()
+		return m.Tf("settings.desc", contractHome(settingsPath(home)))
+	case ScreenSkillManifest:
+		if m.SkillManifestExport {
+			return m.T("skill_manifest.desc_export")
+		}
+		return m.T("skill_manifest.desc")
+	case ScreenSkillURL:
+		return m.T("skill_url.desc")
+	case ScreenBackupPrune:
+		return m.Tf("backup_prune.desc", max(m.BackupKeep, 1))
+	case ScreenRestoreItems:
+		return m.T("restore_items.desc")
+	case ScreenProfileSave:
+		return m.T("profile_save.desc")
+	case ScreenInstallRefs:
+		return m.T("install_refs.desc")
+	case ScreenInstallRefInput:
+		if m.RefField == 1 {
+			return m.T("install_ref_input.desc_skills")
+		}
+		return m.T("install_ref_input.desc")
+	case ScreenProfileSelect:
+		home, _ := os.UserHomeDir()
+		return m.Tf("profile_select.desc", contractHome(choiceProfilesDir(home)))
+	case ScreenProfileMismatch:
+		return m.T("profile_mismatch.desc")
+	case ScreenUnsupportedPlatform:
+		return m.Tf("unsupported_platform.desc", m.platformName())
+	case ScreenInstanceLocked:
+		return m.T("instance_locked.desc")
+	case ScreenTrainerResetConfirm:
+		return m.T("trainer_reset_confirm.desc")
+	case ScreenTrainerSettings:
+		return m.T("trainer_settings.desc")
+	case ScreenTrainerImport:
+		return m.T("trainer_import.desc")
+	case ScreenTrainerResetAll:
+		return m.T("trainer_reset_all.desc")
+	case ScreenTrainerTimed:
+		return m.T("trainer_timed.desc")
+	case ScreenSkillDetail:
+		return m.T("skill_detail.desc")
+	case ScreenSkillDoctor:
+		if m.SkillDoctor == nil {
+			return m.T("skill_doctor.desc")
+		}
+		return m.Tf("skill_doctor.desc_summary", m.SkillDoctor.Summary())
+	case ScreenUninstall:
+		if len(m.UninstallItems) == 0 {
+			return m.T("uninstall.desc_empty")
+		}
+		return m.T("uninstall.desc")
+	case ScreenDiskSpaceWarning:
+		return m.T("disk_space_warning.desc")
+	case ScreenWizardReview:
+		return m.T("wizard_review.desc")
+	case ScreenOSVersionWarning:
+		if m.OSWarning != nil {
+			return m.Tf("os_version_warning.desc_detected", m.OSWarning.Name, m.OSWarning.Version, m.OSWarning.Minimum)
+		}
+		return m.T("os_version_warning.desc")
 	case ScreenSkillResult:
-		return "Operation results"
+		return m.T("skill_result.desc")
 	case ScreenSkillUpdate:
-		return "Pulling latest changes from Gentleman-Skills"
+		return m.T("skill_update.desc")
 	default:
 		return ""
 	}
 }
 
+// unsupportedSuffix marks the disabled Start Installation entry
+const unsupportedSuffix = " (unsupported on this platform)"
+
+// nothingToUndoSuffix marks the disabled undo entry of the skill menu
+const nothingToUndoSuffix = " (nothing to undo)"
+
+// installUnsupported reports whether the environment install is gated off
+func (m Model) installUnsupported() bool {
+	return m.SystemInfo != nil && m.SystemInfo.Unsupported
+}
+
+// platformName names the detected platform for the unsupported screen
+func (m Model) platformName() string {
+	if m.SystemInfo == nil || m.SystemInfo.OSName == "" {
+		return "this platform"
+	}
+	return m.SystemInfo.OSName
+}
+
 // SkillInfo holds parsed metadata about a skill or plugin from the catalog
 type SkillInfo struct {
 	Name        string   // from frontmatter "name"
 	Description string   // from frontmatter "description" (first line only for display)
-	Category    string   // "curated", "community", "plugin", or "local"
+	Category    string   // "curated", "community", "plugin", "external", or "local"
 	DirName     string   // folder name (e.g. "react-19")
 	FullPath    string   // absolute path to the skill/plugin dir
 	Installed   bool     // true if symlink/dir exists in the appropriate path
+	InstalledIn []string // skills only: skillTargets IDs with a working link
 	Type        string   // "skill" or "plugin"
-	Permissions []string // only for plugins: settings.json permission entries
+	Tags        []string // from frontmatter "tags"
+	Permissions []string // plugins: settings.json permission entries; skills: "allowed-tools"
+	// CollidesWith names another skill that differs only by case; both map to
+	// the same symlink on case-insensitive filesystems
+	CollidesWith string
+}
+
+// skillOptionName is the skill name as shown in option lists, with a warning
+// when another skill differs only by case
+func skillOptionName(s SkillInfo) string {
+	if s.CollidesWith != "" {
+		return s.Name + " ⚠ case clash with " + s.CollidesWith
+	}
+	return s.Name
 }
 
 // truncateDesc truncates a description to maxLen characters, adding ellipsis if needed
@@ -835,8 +1462,8 @@ func filterSkillsByCategory(skills []SkillInfo, category string) []SkillInfo {
 func getSkillCategoryOrder(skills []SkillInfo) []string {
 	seen := make(map[string]bool)
 	var order []string
-	// Fixed order: curated first, community second, plugin third, then local groups
-	for _, prio := range []string{"curated", "community", "plugin"} {
+	// Fixed order: curated, community, plugin, external, then local groups
+	for _, prio := range []string{"curated", "community", "plugin", externalSkillCategory} {
 		for _, s := range skills {
 			if s.Category == prio && !seen[prio] {
 				seen[prio] = true
@@ -864,6 +1491,8 @@ func skillCategoryHeader(category string) string {
 		return "🌐 Community"
 	case "plugin":
 		return "━━━ Plugins ━━━"
+	case externalSkillCategory:
+		return "🔗 External"
 	case "local":
 		return "🏠 Local"
 	default:
@@ -875,98 +1504,163 @@ func skillCategoryHeader(category string) string {
 	}
 }
 
-// buildSkillBrowseOptions builds options for the browse screen with group headers and installed indicators
-func (m Model) buildSkillBrowseOptions() []string {
-	opts := make([]string, 0, len(m.SkillCatalog)+10)
+// buildSkillBrowseRows builds the browse screen: group headers, and the skills
+// with installed indicators, their SkillIdx indexing browseSkills
+func (m Model) buildSkillBrowseRows() []OptionRow {
+	rows := make([]OptionRow, 0, len(m.SkillCatalog)+10)
+	idx := 0
 	for _, cat := range getSkillCategoryOrder(m.SkillCatalog) {
-		group := filterSkillsByCategory(m.SkillCatalog, cat)
+		group := filterSkillsByTag(filterSkillsByCategory(m.SkillCatalog, cat), m.SkillTagFilter)
 		if len(group) == 0 {
 			continue
 		}
-		opts = append(opts, skillCategoryHeader(cat))
+		rows = append(rows, OptionRow{Label: skillCategoryHeader(cat), Kind: RowHeader, SkillIdx: -1})
 		for _, s := range group {
-			badge := "  "
-			if s.Installed {
-				badge = "✓ "
-			}
-			desc := truncateDesc(s.Description, 60)
-			if desc != "" {
-				opts = append(opts, badge+s.Name+" — "+desc)
-			} else {
-				opts = append(opts, badge+s.Name)
+			label := skillInstalledBadge(s) + skillOptionName(s)
+			if desc := truncateDesc(s.Description, 60); desc != "" {
+				label += " — " + desc
 			}
+			rows = append(rows, OptionRow{Label: label + skillTagSuffix(s), Kind: RowSkill, SkillIdx: idx})
+			idx++
 		}
 	}
-	opts = append(opts, "─────────────")
-	opts = append(opts, "← Back")
-	return opts
+	return append(rows, separatorRow, OptionRow{Label: m.T("common.back"), Kind: RowBack, SkillIdx: -1})
 }
 
-// buildSkillInstallOptions builds options for the install screen (only NOT-installed skills)
-func (m Model) buildSkillInstallOptions() []string {
-	notInstalled := m.getNotInstalledSkills()
+// browseSkills returns the catalog in the order Browse lists it, the list
+// its rows' SkillIdx index into
+func (m Model) browseSkills() []SkillInfo {
+	var skills []SkillInfo
+	for _, cat := range getSkillCategoryOrder(m.SkillCatalog) {
+		skills = append(skills, filterSkillsByTag(filterSkillsByCategory(m.SkillCatalog, cat), m.SkillTagFilter)...)
+	}
+	return skills
+}
 
-	if len(notInstalled) == 0 {
-		return []string{"✅ All skills are already installed!", "─────────────", "← Back"}
+// skillTagSuffix renders the skill's tags after its browse row
+func skillTagSuffix(s SkillInfo) string {
+	if len(s.Tags) == 0 {
+		return ""
 	}
+	return "  #" + strings.Join(s.Tags, " #")
+}
 
-	opts := make([]string, 0, len(notInstalled)+10)
-	opts = append(opts, "✅ Select All")
-	for _, cat := range getSkillCategoryOrder(notInstalled) {
-		group := filterSkillsByCategory(notInstalled, cat)
-		if len(group) == 0 {
-			continue
+// filterSkillsByTag keeps the skills carrying tag; an empty tag keeps them all
+func filterSkillsByTag(skills []SkillInfo, tag string) []SkillInfo {
+	if tag == "" {
+		return skills
+	}
+	var result []SkillInfo
+	for _, s := range skills {
+		if slices.Contains(s.Tags, tag) {
+			result = append(result, s)
 		}
-		opts = append(opts, skillCategoryHeader(cat))
-		for _, s := range group {
-			desc := truncateDesc(s.Description, 60)
-			if desc != "" {
-				opts = append(opts, s.Name+" — "+desc)
-			} else {
-				opts = append(opts, s.Name)
+	}
+	return result
+}
+
+// skillCatalogTags lists every tag in the catalog, sorted
+func skillCatalogTags(skills []SkillInfo) []string {
+	var tags []string
+	for _, s := range skills {
+		for _, tag := range s.Tags {
+			if !slices.Contains(tags, tag) {
+				tags = append(tags, tag)
 			}
 		}
 	}
-	opts = append(opts, "─────────────")
-	opts = append(opts, "✅ Confirm installation")
-	return opts
+	slices.Sort(tags)
+	return tags
+}
+
+// nextSkillTag is the tag filter after current: the catalog's tags in order,
+// then back to no filter
+func nextSkillTag(skills []SkillInfo, current string) string {
+	tags := skillCatalogTags(skills)
+	i := slices.Index(tags, current)
+	if i+1 < len(tags) {
+		return tags[i+1]
+	}
+	return ""
+}
+
+// buildSkillInstallRows builds the install screen (only NOT-installed skills)
+func (m Model) buildSkillInstallRows() []OptionRow {
+	notInstalled := m.getNotInstalledSkills()
+
+	if len(notInstalled) == 0 {
+		return []OptionRow{noteRow(m.T("skill_install.all_installed")), separatorRow, {Label: m.T("common.back"), Kind: RowBack, SkillIdx: -1}}
+	}
+	return m.buildSkillSelectRows(notInstalled, m.T("skill_install.confirm"))
 }
 
-// buildSkillRemoveOptions builds options for the remove screen (only installed skills)
-func (m Model) buildSkillRemoveOptions() []string {
+// buildSkillRemoveRows builds the remove screen (only installed skills)
+func (m Model) buildSkillRemoveRows() []OptionRow {
 	installed := m.getInstalledSkills()
 
 	if len(installed) == 0 {
-		return []string{"No skills installed", "─────────────", "← Back"}
+		return []OptionRow{noteRow(m.T("skill_remove.none")), separatorRow, {Label: m.T("common.back"), Kind: RowBack, SkillIdx: -1}}
 	}
+	return m.buildSkillSelectRows(installed, m.T("skill_remove.confirm"))
+}
 
-	opts := make([]string, 0, len(installed)+10)
-	opts = append(opts, "✅ Select All")
-	for _, cat := range getSkillCategoryOrder(installed) {
-		group := filterSkillsByCategory(installed, cat)
-		if len(group) == 0 {
-			continue
+// buildSkillSelectRows lists the skills of a multi-select screen that match
+// SkillFilter, grouped by category; groups without matches get no header.
+// Each skill row's SkillIdx indexes skills, and so SkillSelected.
+func (m Model) buildSkillSelectRows(skills []SkillInfo, confirm string) []OptionRow {
+	visible := m.visibleSkillIndexes(skills)
+	rows := make([]OptionRow, 0, len(visible)+10)
+	if len(visible) == 0 {
+		rows = append(rows, noteRow(m.Tf("skill_select.no_match", m.SkillFilter)))
+	} else {
+		rows = append(rows, OptionRow{Label: m.T("skill_select.all"), Kind: RowSelectAll, SkillIdx: -1})
+	}
+	category := ""
+	for n, i := range visible {
+		s := skills[i]
+		if n == 0 || s.Category != category {
+			category = s.Category
+			rows = append(rows, OptionRow{Label: skillCategoryHeader(category), Kind: RowHeader, SkillIdx: -1})
 		}
-		opts = append(opts, skillCategoryHeader(cat))
-		for _, s := range group {
-			desc := truncateDesc(s.Description, 60)
-			if desc != "" {
-				opts = append(opts, s.Name+" — "+desc)
-			} else {
-				opts = append(opts, s.Name)
+		label := skillOptionName(s)
+		if desc := truncateDesc(s.Description, 60); desc != "" {
+			label += " — " + desc
+		}
+		rows = append(rows, OptionRow{Label: label, Kind: RowSkill, SkillIdx: i})
+	}
+	return append(rows, separatorRow, OptionRow{Label: confirm, Kind: RowConfirm, SkillIdx: -1})
+}
+
+// skillMatchesFilter reports whether the skill's name or description contains query, ignoring case
+func skillMatchesFilter(s SkillInfo, query string) bool {
+	if query == "" {
+		return true
+	}
+	query = strings.ToLower(query)
+	return strings.Contains(strings.ToLower(s.Name), query) ||
+		strings.Contains(strings.ToLower(s.Description), query)
+}
+
+// visibleSkillIndexes returns, in display order, the indexes into skills (and so into
+// SkillSelected) of the skills matching SkillFilter
+func (m Model) visibleSkillIndexes(skills []SkillInfo) []int {
+	var visible []int
+	for _, cat := range getSkillCategoryOrder(skills) {
+		for i, s := range skills {
+			if s.Category == cat && skillMatchesFilter(s, m.SkillFilter) {
+				visible = append(visible, i)
 			}
 		}
 	}
-	opts = append(opts, "─────────────")
-	opts = append(opts, "✅ Confirm removal")
-	return opts
+	return visible
 }
 
-// getNotInstalledSkills returns skills from catalog that are not installed
+// getNotInstalledSkills returns skills from catalog that are missing from at
+// least one of the link targets the next install starts from
 func (m Model) getNotInstalledSkills() []SkillInfo {
 	var result []SkillInfo
 	for _, s := range m.SkillCatalog {
-		if !s.Installed {
+		if !s.installedInAll(m.SkillTargets) {
 			result = append(result, s)
 		}
 	}
@@ -984,166 +1678,98 @@ func (m Model) getInstalledSkills() []SkillInfo {
 	return result
 }
 
-// SetupInstallSteps creates the installation steps based on user choices
-func (m *Model) SetupInstallSteps() {
-	m.Steps = []InstallStep{}
-
-	// Backup step if user chose to backup (not interactive - just file copies)
-	if m.Choices.CreateBackup && len(m.ExistingConfigs) > 0 {
-		m.Steps = append(m.Steps, InstallStep{
-			ID:          "backup",
-			Name:        "Backup Existing Configs",
-			Description: "Creating backup of your current configuration",
-			Status:      StatusPending,
-		})
-	}
-
-	// Always clone repo first (not interactive - just git clone)
-	m.Steps = append(m.Steps, InstallStep{
-		ID:          "clone",
-		Name:        "Clone Repository",
-		Description: "Downloading Javi.Dots",
-		Status:      StatusPending,
-	})
+// skillSelectionKey identifies a skill across catalog reloads
+func skillSelectionKey(s SkillInfo) string {
+	return s.Category + "/" + s.Name
+}
 
-	// Homebrew (interactive - first install needs password)
-	// Skip for Termux - it uses pkg instead
-	if !m.SystemInfo.HasBrew && !m.SystemInfo.IsTermux {
-		m.Steps = append(m.Steps, InstallStep{
-			ID:          "homebrew",
-			Name:        "Install Homebrew",
-			Description: "Package manager",
-			Status:      StatusPending,
-			Interactive: true,
-		})
+// skillSelectionList returns the list SkillSelected indexes into on the current screen
+func (m Model) skillSelectionList() []SkillInfo {
+	switch m.Screen {
+	case ScreenSkillInstall:
+		return m.getNotInstalledSkills()
+	case ScreenSkillRemove:
+		return m.getInstalledSkills()
 	}
+	return nil
+}
 
-	// Dependencies based on OS
-	// Check both Choices.OS and SystemInfo for Termux detection (redundancy)
-	isTermux := m.Choices.OS == "termux" || m.SystemInfo.IsTermux
-	if m.Choices.OS == "linux" && !isTermux {
-		m.Steps = append(m.Steps, InstallStep{
-			ID:          "deps",
-			Name:        "Install Dependencies",
-			Description: "Base packages",
-			Status:      StatusPending,
-			Interactive: true, // Needs sudo
-		})
-	} else if isTermux {
-		m.Steps = append(m.Steps, InstallStep{
-			ID:          "deps",
-			Name:        "Install Dependencies",
-			Description: "Base packages (pkg)",
-			Status:      StatusPending,
-			Interactive: false, // Termux doesn't need sudo
-		})
-	} else if m.Choices.OS == "mac" && !m.SystemInfo.HasXcode {
-		m.Steps = append(m.Steps, InstallStep{
-			ID:          "xcode",
-			Name:        "Install Xcode CLI",
-			Description: "Developer tools",
-			Status:      StatusPending,
-		})
+// applySkillCatalog replaces the catalog and rebuilds SkillSelected for the current
+// screen, carrying over selections for skills that are still listed. Selections for
+// skills that vanished are dropped and reported in SkillNotice.
+func (m *Model) applySkillCatalog(skills []SkillInfo) {
+	previous := make(map[string]string) // key -> name, in selection order
+	var order []string
+	for i, s := range m.skillSelectionList() {
+		if i < len(m.SkillSelected) && m.SkillSelected[i] {
+			key := skillSelectionKey(s)
+			previous[key] = s.Name
+			order = append(order, key)
+		}
 	}
 
-	// Terminal
-	if m.Choices.Terminal != "none" && m.Choices.Terminal != "" {
-		m.Steps = append(m.Steps, InstallStep{
-			ID:          "terminal",
-			Name:        "Install " + m.Choices.Terminal,
-			Description: "Terminal emulator",
-			Status:      StatusPending,
-			Interactive: m.Choices.OS == "linux", // Linux needs sudo for pacman/apt
-		})
+	m.SkillCatalog = skills
+	list := m.skillSelectionList()
+	if list == nil {
+		return
 	}
-
-	// Font (not interactive - brew doesn't need password after installed)
-	if m.Choices.InstallFont {
-		m.Steps = append(m.Steps, InstallStep{
-			ID:          "font",
-			Name:        "Install Iosevka Nerd Font",
-			Description: "Nerd font with icons",
-			Status:      StatusPending,
-		})
+	m.SkillSelected = make([]bool, len(list))
+	for i, s := range list {
+		key := skillSelectionKey(s)
+		if _, ok := previous[key]; ok {
+			m.SkillSelected[i] = true
+			delete(previous, key)
+		}
 	}
 
-	// Shell (not interactive - brew doesn't need password)
-	m.Steps = append(m.Steps, InstallStep{
-		ID:          "shell",
-		Name:        "Install " + m.Choices.Shell,
-		Description: "Shell and plugins",
-		Status:      StatusPending,
-	})
-
-	// Window manager (not interactive - brew doesn't need password)
-	if m.Choices.WindowMgr != "none" && m.Choices.WindowMgr != "" {
-		m.Steps = append(m.Steps, InstallStep{
-			ID:          "wm",
-			Name:        "Install " + m.Choices.WindowMgr,
-			Description: "Terminal multiplexer",
-			Status:      StatusPending,
-		})
+	var dropped []string
+	for _, key := range order {
+		if name, ok := previous[key]; ok {
+			dropped = append(dropped, name)
+		}
 	}
-
-	// Neovim (not interactive - brew doesn't need password)
-	if m.Choices.InstallNvim {
-		m.Steps = append(m.Steps, InstallStep{
-			ID:          "nvim",
-			Name:        "Install Neovim",
-			Description: "Editor with config",
-			Status:      StatusPending,
-		})
+	if len(dropped) > 0 {
+		m.SkillNotice = "Selection dropped for skills no longer available: " + strings.Join(dropped, ", ")
 	}
+}
 
-	// Zed editor (not interactive)
-	if m.Choices.InstallZed {
-		m.Steps = append(m.Steps, InstallStep{
-			ID:          "zed",
-			Name:        "Install Zed",
-			Description: "Editor with Vim mode",
-			Status:      StatusPending,
-		})
+// SetupInstallSteps composes the installation plan from installStepRegistry
+func (m *Model) SetupInstallSteps() {
+	m.Steps = []InstallStep{}
+	for _, spec := range installStepRegistry {
+		if spec.When != nil && !spec.When(m) {
+			continue
+		}
+		m.Steps = append(m.Steps, spec.planStep(m))
 	}
+}
 
-	// AI Tools: Claude Code + OpenCode (not interactive)
-	if len(m.Choices.AITools) > 0 {
-		toolNames := strings.Join(m.Choices.AITools, " + ")
-		m.Steps = append(m.Steps, InstallStep{
-			ID:          "aitools",
-			Name:        "Install AI Tools",
-			Description: toolNames,
-			Status:      StatusPending,
-		})
+// likelyFailingSteps names the planned install steps that the OS version
+// warning expects to fail, in install order
+func (m Model) likelyFailingSteps() []string {
+	if m.OSWarning == nil {
+		return nil
 	}
-
-	// AI Framework (not interactive)
-	if m.Choices.InstallAIFramework {
-		presetLabel := m.Choices.AIFrameworkPreset
-		if presetLabel == "" {
-			presetLabel = "custom"
-		}
-		m.Steps = append(m.Steps, InstallStep{
-			ID:          "aiframework",
-			Name:        "Install AI Framework",
-			Description: "Preset: " + presetLabel,
-			Status:      StatusPending,
-		})
+	plan := m
+	plan.SetupInstallSteps()
+	var names []string
+	for _, step := range plan.Steps {
+		for _, id := range m.OSWarning.LikelyFailures {
+			if step.ID == id {
+				names = append(names, step.Name)
+			}
+		}
 	}
+	return names
+}
 
-	// Set default shell (interactive - chsh needs password)
-	m.Steps = append(m.Steps, InstallStep{
-		ID:          "setshell",
-		Name:        "Set Default Shell",
-		Description: "Configure default shell",
-		Status:      StatusPending,
-		Interactive: true,
-	})
-
-	// Cleanup (not interactive - just file deletion)
-	m.Steps = append(m.Steps, InstallStep{
-		ID:          "cleanup",
-		Name:        "Cleanup",
-		Description: "Removing temporary files",
+// SetupAIFrameworkApplySteps prepares the single-step plan used by apply-only mode,
+// which adds or removes framework modules without re-running the full install.
+func (m *Model) SetupAIFrameworkApplySteps() {
+	m.Steps = []InstallStep{{
+		ID:          "aiframework",
+		Name:        "Apply AI Framework Changes",
+		Description: fmt.Sprintf("+%d / -%d modules", len(m.AIFrameworkDiffAdded), len(m.AIFrameworkDiffRemoved)),
 		Status:      StatusPending,
-	})
+	}}
 }
@@ -381,8 +381,8 @@ func TestBackupScreenOptions(t *testing.T) {
 
 		opts := m.GetCurrentOptions()
 
-		if len(opts) != 3 {
-			t.Errorf("Expected 3 options for BackupConfirm, got %d", len(opts))
+		if len(opts) != 4 {
+			t.Errorf("Expected 4 options for BackupConfirm, got %d", len(opts))
 		}
 
 		// Check options contain expected text
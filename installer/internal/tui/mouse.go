@@ -0,0 +1,109 @@
+package tui
+
+import (
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Mouse support: the wheel moves the cursor like ↑/↓ (and scrolls the keymap
+// and topic viewers, which bind those keys to scrolling), a left click puts
+// the cursor on the option under the pointer, and a second click on the same
+// option within doubleClickWindow selects it like Enter.
+//
+// Clicks only work on screens whose renderer records its option rows with
+// optionRows.mark; elsewhere they're ignored.
+
+// doubleClickWindow is how soon a second click on the same option counts as a double click
+const doubleClickWindow = 500 * time.Millisecond
+
+// viewTopPadding is the blank line View puts above every screen
+const viewTopPadding = 1
+
+// optionRows maps the lines of the last rendered screen to option indexes.
+// View renders a copy of the model, so it records into this struct through a
+// pointer every copy shares.
+type optionRows struct {
+	rows map[int]int // Screen body line → option index
+	// Lines of the builder being marked counted so far, so each mark only
+	// counts what was written since the previous one
+	builder *strings.Builder
+	counted int // Bytes of builder already counted
+	lines   int // Newlines in them
+}
+
+func newOptionRows() *optionRows {
+	return &optionRows{rows: map[int]int{}}
+}
+
+// reset forgets the rows of the previous frame
+func (r *optionRows) reset() {
+	if r == nil {
+		return
+	}
+	clear(r.rows)
+	r.builder, r.counted, r.lines = nil, 0, 0
+}
+
+// mark records that the next line written to s, the screen's top-level
+// builder, shows option idx
+func (r *optionRows) mark(s *strings.Builder, idx int) {
+	if r == nil {
+		return
+	}
+	text := s.String()
+	if s != r.builder || len(text) < r.counted {
+		r.builder, r.counted, r.lines = s, 0, 0
+	}
+	r.lines += strings.Count(text[r.counted:], "\n")
+	r.counted = len(text)
+	r.rows[r.lines] = idx
+}
+
+// at returns the option shown on terminal row y, or -1 when there is none
+func (r *optionRows) at(y int) int {
+	if r == nil {
+		return -1
+	}
+	if idx, ok := r.rows[y-viewTopPadding]; ok {
+		return idx
+	}
+	return -1
+}
+
+func (m Model) handleMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	if m.LeaderMode || m.ShowHelp {
+		return m, nil
+	}
+
+	switch msg.Button {
+	case tea.MouseButtonWheelUp:
+		if msg.Action == tea.MouseActionPress {
+			return m.handleKeyPress(tea.KeyMsg{Type: tea.KeyUp})
+		}
+	case tea.MouseButtonWheelDown:
+		if msg.Action == tea.MouseActionPress {
+			return m.handleKeyPress(tea.KeyMsg{Type: tea.KeyDown})
+		}
+	case tea.MouseButtonLeft:
+		if msg.Action != tea.MouseActionRelease {
+			break
+		}
+		idx := m.VisibleRows.at(msg.Y)
+		if idx < 0 {
+			break
+		}
+		now := time.Now()
+		double := idx == m.MouseLastOption && idx == m.Cursor && now.Sub(m.MouseLastClick) <= doubleClickWindow
+		m.Cursor = idx
+		if double {
+			m.MouseLastClick = time.Time{}
+			return m.handleKeyPress(tea.KeyMsg{Type: tea.KeyEnter})
+		}
+		m.MouseLastOption = idx
+		m.MouseLastClick = now
+	}
+
+	return m, nil
+}
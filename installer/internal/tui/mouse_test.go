@@ -0,0 +1,143 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// rowOf renders m and returns the terminal row of the first line containing text
+func rowOf(t *testing.T, m Model, text string) int {
+	t.Helper()
+	for y, line := range strings.Split(m.View(), "\n") {
+		if strings.Contains(line, text) {
+			return y
+		}
+	}
+	t.Fatalf("%q is not on screen", text)
+	return -1
+}
+
+func click(m Model, y int) Model {
+	result, _ := m.Update(tea.MouseMsg{X: 5, Y: y, Button: tea.MouseButtonLeft, Action: tea.MouseActionRelease})
+	return result.(Model)
+}
+
+func wheel(m Model, button tea.MouseButton) Model {
+	result, _ := m.Update(tea.MouseMsg{Button: button, Action: tea.MouseActionPress})
+	return result.(Model)
+}
+
+func TestMouseClickSelectsOption(t *testing.T) {
+	m := newFlowModel(t)
+	m.Screen = ScreenMainMenu
+	options := m.GetCurrentOptions()
+	target := len(options) - 1
+
+	m = click(m, rowOf(t, m, options[target]))
+	if m.Cursor != target || m.Screen != ScreenMainMenu {
+		t.Fatalf("a click should move the cursor to %d, got %d on %v", target, m.Cursor, m.Screen)
+	}
+
+	// Clicking outside the options changes nothing
+	if m = click(m, 0); m.Cursor != target {
+		t.Errorf("a click on the padding should be ignored, cursor %d", m.Cursor)
+	}
+
+	// A second click on the same option is a double click: Enter
+	m.Cursor = 1
+	y := rowOf(t, m, options[1])
+	m = click(m, y)
+	m = click(m, y)
+	if m.Screen == ScreenMainMenu {
+		t.Error("a double click should select the option")
+	}
+}
+
+func TestMouseSlowSecondClickOnlyMovesCursor(t *testing.T) {
+	m := newFlowModel(t)
+	m.Screen = ScreenMainMenu
+	options := m.GetCurrentOptions()
+	y := rowOf(t, m, options[1])
+
+	m = click(m, y)
+	m.MouseLastClick = time.Now().Add(-2 * doubleClickWindow)
+	if m = click(m, y); m.Screen != ScreenMainMenu || m.Cursor != 1 {
+		t.Errorf("clicks further apart than the window should not select, got %v", m.Screen)
+	}
+}
+
+func TestMouseClickScrolledSkillList(t *testing.T) {
+	m := newFlowModel(t)
+	m.Screen = ScreenSkillInstall
+	m.Height = 15 // Room for 7 rows, so the list scrolls
+	m.SkillCatalog = flowSkillCatalog()
+	m.SkillSelected = make([]bool, len(m.SkillCatalog))
+	// Select All, 📦 Curated, react-19, typescript, 🌐 Community, htmx, ───, Confirm
+	m.Cursor = 7
+	m.updateSkillScroll(len(m.GetCurrentOptions()))
+	if m.SkillScroll == 0 {
+		t.Fatal("the list should be scrolled for this test")
+	}
+
+	m = click(m, rowOf(t, m, "htmx"))
+	if m.Cursor != 5 {
+		t.Fatalf("the click should land on htmx (5), got %d", m.Cursor)
+	}
+	m = click(m, rowOf(t, m, "htmx"))
+	if m.Screen != ScreenSkillInstall || m.Cursor != 7 {
+		t.Errorf("a double click on an item should act like Enter and move to Confirm, got cursor %d on %v", m.Cursor, m.Screen)
+	}
+}
+
+func TestMouseWheel(t *testing.T) {
+	m := newFlowModel(t)
+	m.Screen = ScreenMainMenu
+	m = wheel(m, tea.MouseButtonWheelDown)
+	m = wheel(m, tea.MouseButtonWheelDown)
+	if m.Cursor != 2 {
+		t.Errorf("the wheel should move the cursor, got %d", m.Cursor)
+	}
+	if m = wheel(m, tea.MouseButtonWheelUp); m.Cursor != 1 {
+		t.Errorf("wheel up should move the cursor back, got %d", m.Cursor)
+	}
+
+	// Keymap viewers scroll instead
+	m.Screen = ScreenKeymapCategory
+	m.Height = 10
+	m = wheel(m, tea.MouseButtonWheelDown)
+	if m.KeymapScroll != 1 || m.Screen != ScreenKeymapCategory {
+		t.Errorf("the wheel should scroll the keymap list, got scroll %d", m.KeymapScroll)
+	}
+
+	m.LeaderMode = true
+	if m = wheel(m, tea.MouseButtonWheelDown); m.KeymapScroll != 1 {
+		t.Error("the mouse should be ignored in leader mode")
+	}
+}
+
+func TestOptionRowsCountsEachBuilder(t *testing.T) {
+	r := newOptionRows()
+	var s strings.Builder
+	s.WriteString("Title\n\n")
+	r.mark(&s, 0)
+	s.WriteString("first\nwrapped\n")
+	r.mark(&s, 1)
+	s.WriteString("second\n")
+
+	// A new frame, or a different builder, counts from its own start
+	var other strings.Builder
+	other.WriteString("header\n")
+	r.mark(&other, 2)
+	for y, want := range map[int]int{2 + viewTopPadding: 0, 4 + viewTopPadding: 1, 1 + viewTopPadding: 2, 3 + viewTopPadding: -1} {
+		if got := r.at(y); got != want {
+			t.Errorf("row %d: got option %d, want %d", y, got, want)
+		}
+	}
+	r.reset()
+	if got := r.at(2 + viewTopPadding); got != -1 {
+		t.Errorf("reset should forget the rows, got %d", got)
+	}
+}
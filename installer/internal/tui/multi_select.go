@@ -0,0 +1,28 @@
+package tui
+
+// Multi-select screens share one key convention, so a press never toggles
+// when the user meant to confirm or confirms when they meant to toggle:
+//
+//   - Space toggles item rows (checkboxes, category headers, Select All) and
+//     does nothing on action rows (Confirm, Back, ...)
+//   - Enter runs action rows
+//   - Enter on an item row moves the cursor to the screen's Confirm row; it
+//     only toggles the item on screens without one
+//
+// Space reaches these screens instead of opening the leader menu, see the
+// passthrough list in handleKeyPress.
+
+// multiSelectRuns applies the convention to an enter or space press and
+// reports whether the row under the cursor should toggle or run. confirmIdx is
+// the screen's Confirm row, or -1 when it has none. When it returns false the
+// press was ignored or moved the cursor to Confirm.
+func (m *Model) multiSelectRuns(key string, onItem bool, confirmIdx int) bool {
+	switch {
+	case key == " " && !onItem:
+		return false
+	case key == "enter" && onItem && confirmIdx >= 0:
+		m.Cursor = confirmIdx
+		return false
+	}
+	return true
+}
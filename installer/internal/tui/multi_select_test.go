@@ -0,0 +1,229 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestMultiSelectRuns(t *testing.T) {
+	tests := []struct {
+		name       string
+		key        string
+		onItem     bool
+		confirmIdx int
+		wantRun    bool
+		wantCursor int
+	}{
+		{"space toggles an item", " ", true, 5, true, 1},
+		{"space ignores an action", " ", false, 5, false, 1},
+		{"enter moves an item to confirm", "enter", true, 5, false, 5},
+		{"enter toggles an item without confirm", "enter", true, -1, true, 1},
+		{"enter runs an action", "enter", false, 5, true, 1},
+		{"enter runs an action without confirm", "enter", false, -1, true, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewModel()
+			m.Cursor = 1
+			if got := m.multiSelectRuns(tt.key, tt.onItem, tt.confirmIdx); got != tt.wantRun {
+				t.Errorf("runs = %v, want %v", got, tt.wantRun)
+			}
+			if m.Cursor != tt.wantCursor {
+				t.Errorf("cursor = %d, want %d", m.Cursor, tt.wantCursor)
+			}
+		})
+	}
+}
+
+// TestMultiSelectKeyMatrix pins the key convention on every multi-select:
+// space toggles items and ignores actions, enter runs actions, and enter on an
+// item moves to Confirm (or toggles on screens without one)
+func TestMultiSelectKeyMatrix(t *testing.T) {
+	tests := []struct {
+		name       string
+		setup      func(m Model) Model
+		item       int                // Cursor of an item row
+		selected   func(m Model) bool // Whether that item is checked
+		confirm    int                // Confirm row, -1 when the screen has none
+		action     int                // Action row that enter runs
+		actionDone Screen             // Screen after enter on the action row
+	}{
+		{
+			name: "AI tools",
+			setup: func(m Model) Model {
+				m.Screen = ScreenAIToolsSelect
+				m.AIToolSelected = make([]bool, len(aiToolIDMap))
+				m.AIToolSelected[1] = true
+				return m
+			},
+			item:       0,
+			selected:   func(m Model) bool { return m.AIToolSelected[0] },
+			confirm:    8,
+			action:     8,
+			actionDone: ScreenAIFrameworkConfirm,
+		},
+		{
+			name: "AI category items",
+			setup: func(m Model) Model {
+				m.Screen = ScreenAIFrameworkCategoryItems
+				m.Height = 200
+				m.SelectedModuleCategory = 0
+				m.AICategorySelected = map[string][]bool{}
+				for _, cat := range moduleCategories {
+					m.AICategorySelected[cat.ID] = make([]bool, len(cat.Items))
+				}
+				return m
+			},
+			item:       categoryItemRow(0),
+			selected:   func(m Model) bool { return m.AICategorySelected[moduleCategories[0].ID][0] },
+			confirm:    -1,
+			action:     len(buildCatItemEntries(moduleCategories[0], make([]bool, len(moduleCategories[0].Items)))) - 1,
+			actionDone: ScreenAIFrameworkCategories,
+		},
+		{
+			name: "skill install",
+			setup: func(m Model) Model {
+				m.Screen = ScreenSkillInstall
+				m.SkillCatalog = flowSkillCatalog()
+				m.SkillSelected = []bool{false, true, false}
+				return m
+			},
+			// Select All, 📦 Curated, react-19, typescript, 🌐 Community, htmx, ───, Confirm
+			item:       2,
+			selected:   func(m Model) bool { return m.SkillSelected[0] },
+			confirm:    7,
+			action:     7,
+			actionDone: ScreenSkillTargets,
+		},
+		{
+			name: "skill remove",
+			setup: func(m Model) Model {
+				m.Screen = ScreenSkillRemove
+				m.SkillCatalog = flowSkillCatalog()
+				for i := range m.SkillCatalog {
+					m.SkillCatalog[i].Installed = true
+				}
+				m.SkillSelected = []bool{false, true, false}
+				return m
+			},
+			item:       2,
+			selected:   func(m Model) bool { return m.SkillSelected[0] },
+			confirm:    7,
+			action:     7,
+			actionDone: ScreenSkillTargets,
+		},
+		{
+			name: "uninstall",
+			setup: func(m Model) Model {
+				m.Screen = ScreenUninstall
+				m.UninstallItems = []uninstallItem{{ID: "nvim", Label: "Neovim"}, {ID: "fish", Label: "Fish"}}
+				return m
+			},
+			// Neovim, Fish, ───, backup first, without backup, Back
+			item:       0,
+			selected:   func(m Model) bool { return m.UninstallItems[0].Selected },
+			confirm:    3,
+			action:     5,
+			actionDone: ScreenMainMenu,
+		},
+		{
+			name: "WM extras",
+			setup: func(m Model) Model {
+				m.Choices.WindowMgr = "tmux"
+				m = m.enterWMExtras()
+				m.WMExtraSelected[0] = false
+				return m
+			},
+			item:       0,
+			selected:   func(m Model) bool { return m.WMExtraSelected[0] },
+			confirm:    len(wmExtraOptions["tmux"]) + 1,
+			action:     len(wmExtraOptions["tmux"]) + 1,
+			actionDone: ScreenNvimSelect,
+		},
+		{
+			name: "role packs",
+			setup: func(m Model) Model {
+				m.Screen = ScreenProjectRolePack
+				m.RolePackSelected = make([]bool, 2)
+				return m
+			},
+			// Core, Developer, PM/Tech Lead, ───, Confirm
+			item:       1,
+			selected:   func(m Model) bool { return m.RolePackSelected[0] },
+			confirm:    4,
+			action:     4,
+			actionDone: ScreenProjectCI,
+		},
+		{
+			name: "project batch",
+			setup: func(m Model) Model {
+				m.Screen = ScreenProjectBatchSelect
+				m.ProjectBatch = []ProjectBatchItem{{Path: "/work/api", Stack: "go"}, {Path: "/work/web", Stack: "node", Selected: true}}
+				return m
+			},
+			// api, web, ───, Initialize, Only this directory
+			item:       0,
+			selected:   func(m Model) bool { return m.ProjectBatch[0].Selected },
+			confirm:    3,
+			action:     3,
+			actionDone: ScreenProjectMemory,
+		},
+	}
+
+	space := tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+	press := func(m Model, key tea.KeyMsg) Model {
+		result, _ := m.Update(key)
+		return result.(Model)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("HOME", t.TempDir())
+			base := tt.setup(NewModel())
+			screen := base.Screen
+
+			m := base
+			m.Cursor = tt.item
+			m = press(m, space)
+			if m.LeaderMode || m.Screen != screen || !tt.selected(m) {
+				t.Errorf("space on an item should toggle it (leader=%v, screen=%v)", m.LeaderMode, m.Screen)
+			}
+
+			m = tt.setup(NewModel())
+			m.Cursor = tt.item
+			m = press(m, enter)
+			if tt.confirm >= 0 {
+				if m.Cursor != tt.confirm || tt.selected(m) || m.Screen != screen {
+					t.Errorf("enter on an item should move to Confirm without toggling, got cursor %d on %v", m.Cursor, m.Screen)
+				}
+			} else if !tt.selected(m) {
+				t.Error("enter on an item should toggle it when there's no Confirm")
+			}
+
+			m = tt.setup(NewModel())
+			m.Cursor = tt.action
+			m = press(m, space)
+			if m.Screen != screen || m.Cursor != tt.action || m.LeaderMode {
+				t.Errorf("space on an action should do nothing, got cursor %d on %v", m.Cursor, m.Screen)
+			}
+
+			m = press(m, enter)
+			if m.Screen != tt.actionDone {
+				t.Errorf("enter on the action should go to %v, got %v", tt.actionDone, m.Screen)
+			}
+		})
+	}
+}
+
+// categoryItemRow is the cursor of the first regular item in a category's list
+func categoryItemRow(category int) int {
+	cat := moduleCategories[category]
+	for i, e := range buildCatItemEntries(cat, make([]bool, len(cat.Items))) {
+		if e.itemIdx == 0 && !e.selectAll && !e.isGroupHeader() {
+			return i
+		}
+	}
+	return -1
+}
@@ -1,8 +1,10 @@
 package tui
 
 import (
+	"context"
 	"fmt"
-	"runtime"
+	"os"
+	"strings"
 
 	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
 )
@@ -11,18 +13,44 @@ import (
 // repoDir overrides the default repo directory name used for cloning.
 // repoURL overrides the default git URL for the dots repository.
 func RunNonInteractive(choices UserChoices, repoDir string, repoURL string) error {
+	model, err := newNonInteractiveModel(choices, repoDir, repoURL)
+	if err != nil {
+		return err
+	}
+
+	if dryRunMode {
+		return writeDryRunPlan(os.Stdout, model)
+	}
+
+	// Define steps to run based on choices
+	return runStepsPlain(model, buildStepsForChoices(model), false)
+}
+
+// newNonInteractiveModel detects the system, fills in the OS and validates
+// choices, returning the model the install steps run against. Choices may
+// name the OS already (a config file does), but only this machine's.
+func newNonInteractiveModel(choices UserChoices, repoDir string, repoURL string) (*Model, error) {
 	// Enable non-interactive mode for logging
 	SetNonInteractiveMode(true)
 
 	// Detect system info
 	sysInfo := system.Detect()
+	if sysInfo.Unsupported {
+		return nil, fmt.Errorf("installation is not supported on %s (supported: macOS, Linux including WSL, Termux, Windows)", sysInfo.OSName)
+	}
 
 	// Determine OS choice based on system
-	osChoice := "linux"
-	if runtime.GOOS == "darwin" {
-		osChoice = "mac"
+	osChoice := detectedOSChoice(sysInfo)
+	if choices.OS == "" {
+		choices.OS = osChoice
+	} else if validOSChoices[choices.OS] && choices.OS != osChoice {
+		return nil, fmt.Errorf("os: the choices are for %s but this machine is %s", choices.OS, osChoice)
+	}
+
+	// Report every invalid choice at once before any step runs
+	if errs := ValidateChoices(choices, sysInfo); len(errs) > 0 {
+		return nil, errs
 	}
-	choices.OS = osChoice
 
 	// Create a minimal model for the installation functions
 	model := &Model{
@@ -30,24 +58,62 @@ func RunNonInteractive(choices UserChoices, repoDir string, repoURL string) erro
 		Choices:    choices,
 		RepoDir:    repoDir,
 		RepoURL:    repoURL,
-		LogLines:   []string{},
+		LogLines:   newLogBuffer(installLogCap),
 	}
 
 	// Detect existing configs for backup functionality
-	if choices.CreateBackup {
+	if choices.CreateBackup || dryRunMode {
 		model.ExistingConfigs = system.DetectExistingConfigs()
 	}
+	return model, nil
+}
 
-	// Define steps to run based on choices
-	steps := buildStepsForChoices(model)
+// runStepsPlain runs steps in order with plain stdout progress, stopping at
+// the first failure. Interactive steps get the terminal, or are skipped with a
+// warning when skipInteractive is set.
+func runStepsPlain(model *Model, steps []InstallStep, skipInteractive bool) error {
+	// Nobody is there to answer the warning screen, so warn and carry on
+	if w := model.SystemInfo.VersionWarning(); w != nil {
+		fmt.Printf("⚠️  %s %s is older than the supported minimum (%s): %s.\n", w.Name, w.Version, w.Minimum, w.Reason)
+		model.OSWarning = w
+		if names := model.likelyFailingSteps(); len(names) > 0 {
+			fmt.Printf("   Steps most likely to fail: %s\n", strings.Join(names, ", "))
+		}
+		fmt.Println()
+	}
+
+	// Keep the full log on disk; GetLogPath reports where
+	if err := activeLog.Open(os.Getenv("HOME"), installClock()); err != nil {
+		fmt.Printf("⚠️  Could not create the install log: %v\n", err)
+	}
+	defer activeLog.Close()
+	// Lets a later uninstall tell the deployed files from the user's
+	system.RecordDeploys(system.DeployRecordPath(os.Getenv("HOME")))
+	defer system.RecordDeploys("")
 
 	fmt.Printf("📋 Running %d installation steps...\n\n", len(steps))
 
 	// Execute each step
+	var skipped []string
 	for i, step := range steps {
 		fmt.Printf("[%d/%d] %s...\n", i+1, len(steps), step.Name)
 
-		err := executeStep(step.ID, model)
+		if step.Interactive && skipInteractive {
+			fmt.Printf("    ⚠️  Skipped: needs terminal input (--no-interactive-steps)\n")
+			skipped = append(skipped, step.Name)
+			continue
+		}
+
+		step.StartedAt = installClock()
+		logStepStart(step)
+		var err error
+		if step.Interactive {
+			err = runAttachedStep(step.ID, model)
+		} else {
+			err = executeStep(context.Background(), step.ID, model)
+		}
+		step.stopClock()
+		logStepEnd(step, err)
 		if err != nil {
 			fmt.Printf("    ❌ FAILED: %v\n", err)
 			return fmt.Errorf("step '%s' failed: %w", step.Name, err)
@@ -55,9 +121,30 @@ func RunNonInteractive(choices UserChoices, repoDir string, repoURL string) erro
 		fmt.Printf("    ✓ Done\n")
 	}
 
+	// Snapshot what was deployed so a later restore can spot user edits
+	if model.BackupDir != "" {
+		if err := system.RecordDeployedHashes(model.BackupDir); err != nil {
+			fmt.Printf("⚠️  Could not record deployed config hashes: %v\n", err)
+		}
+	}
+	if model.RepoCommit.Hash != "" {
+		manifest, err := recordInstallManifest(execRunner, os.Getenv("HOME"), model)
+		if err != nil {
+			fmt.Printf("⚠️  Could not write the install manifest: %v\n", err)
+		}
+		model.InstallManifest = manifest
+	}
+
 	fmt.Println()
 	fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
 	fmt.Println("✅ Installation complete!")
+	for _, item := range model.commitItems() {
+		fmt.Println("   " + item)
+	}
+	if len(skipped) > 0 {
+		fmt.Println("⚠️  Skipped interactive steps: " + strings.Join(skipped, ", "))
+		fmt.Println("   Run again from a terminal without --no-interactive-steps to finish them.")
+	}
 	fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
 
 	return nil
@@ -80,8 +167,10 @@ func buildStepsForChoices(m *Model) []InstallStep {
 		steps = append(steps, InstallStep{ID: "homebrew", Name: "Install/Update Homebrew"})
 	}
 
-	// Dependencies
-	steps = append(steps, InstallStep{ID: "deps", Name: "Install dependencies"})
+	// Dependencies (distro or Termux packages)
+	if m.Choices.OS == "linux" || isTermuxChoice(m) {
+		steps = append(steps, InstallStep{ID: "deps", Name: "Install dependencies"})
+	}
 
 	// Xcode (Mac only)
 	if m.SystemInfo.OS == system.OSMac {
@@ -98,8 +187,10 @@ func buildStepsForChoices(m *Model) []InstallStep {
 		steps = append(steps, InstallStep{ID: "font", Name: "Install Nerd Font"})
 	}
 
-	// Shell
-	steps = append(steps, InstallStep{ID: "shell", Name: fmt.Sprintf("Install %s shell", m.Choices.Shell)})
+	// Shell (none on native Windows)
+	if !isWindowsChoice(m) {
+		steps = append(steps, InstallStep{ID: "shell", Name: fmt.Sprintf("Install %s shell", m.Choices.Shell)})
+	}
 
 	// Window Manager
 	if m.Choices.WindowMgr != "none" {
@@ -122,7 +213,7 @@ func buildStepsForChoices(m *Model) []InstallStep {
 	}
 
 	// Engram persistent memory (if OpenCode is selected)
-	if len(m.Choices.AITools) > 0 && hasAITool(m.Choices.AITools, "opencode") {
+	if len(m.Choices.AITools) > 0 && hasAITool(m.Choices.AITools, "opencode") && !isWindowsChoice(m) {
 		steps = append(steps, InstallStep{ID: "engram", Name: "Install Engram persistent memory"})
 	}
 
@@ -132,7 +223,9 @@ func buildStepsForChoices(m *Model) []InstallStep {
 	}
 
 	// Set shell as default
-	steps = append(steps, InstallStep{ID: "setshell", Name: "Set shell as default"})
+	if !isWindowsChoice(m) {
+		steps = append(steps, InstallStep{ID: "setshell", Name: "Set shell as default"})
+	}
 
 	// Cleanup
 	steps = append(steps, InstallStep{ID: "cleanup", Name: "Cleanup"})
@@ -0,0 +1,44 @@
+package tui
+
+import (
+	"time"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// longTaskNotifyAfter is how long a project init or skill catalog update has
+// to run before its end is worth a notification
+const longTaskNotifyAfter = 30 * time.Second
+
+// notify rings the bell and shows a desktop notification; tests replace it
+var notify = system.Notify
+
+// notifyCmd notifies with body when the Notify setting is on
+func (m Model) notifyCmd(body string) tea.Cmd {
+	if !m.Settings.Notify {
+		return nil
+	}
+	title := m.T("notify.title")
+	return func() tea.Msg {
+		notify(title, body)
+		return nil
+	}
+}
+
+// notifyLongTask is notifyCmd for the task started at TaskStarted, sent only
+// when it ran for longTaskNotifyAfter or more
+func (m Model) notifyLongTask(body string) tea.Cmd {
+	if m.TaskStarted.IsZero() || installClock().Sub(m.TaskStarted) < longTaskNotifyAfter {
+		return nil
+	}
+	return m.notifyCmd(body)
+}
+
+// finishLongTask is notifyLongTask, clearing TaskStarted so a later task isn't
+// timed from this one
+func (m *Model) finishLongTask(body string) tea.Cmd {
+	cmd := m.notifyLongTask(body)
+	m.TaskStarted = time.Time{}
+	return cmd
+}
@@ -0,0 +1,119 @@
+package tui
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestMain keeps the package's tests from ringing the bell or showing desktop
+// notifications, which are on by default
+func TestMain(m *testing.M) {
+	notify = func(title, body string) {}
+	os.Exit(m.Run())
+}
+
+// captureNotify replaces notify and returns the bodies it was sent
+func captureNotify(t *testing.T) *[]string {
+	t.Helper()
+	var sent []string
+	saved := notify
+	notify = func(title, body string) { sent = append(sent, title+": "+body) }
+	t.Cleanup(func() { notify = saved })
+	return &sent
+}
+
+// runNotify runs cmd, the way Bubble Tea would, and returns what it notified
+func runNotify(cmd tea.Cmd, sent *[]string) []string {
+	*sent = nil
+	if cmd != nil {
+		cmd()
+	}
+	return *sent
+}
+
+func TestInstallNotifications(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	sent := captureNotify(t)
+
+	m := NewModel()
+	m.Settings.Notify = true
+	m.Screen = ScreenInstalling
+	_, cmd := m.Update(installCompleteMsg{totalTime: 185})
+	if got := runNotify(cmd, sent); len(got) != 1 || got[0] != "Gentleman.Dots: Installation complete in 3m 05s" {
+		t.Errorf("a finished install should notify, got %q", got)
+	}
+
+	m.Screen = ScreenInstalling
+	m.Steps = []InstallStep{{ID: "clone", Name: "Clone", Status: StatusRunning}}
+	_, cmd = m.Update(stepCompleteMsg{stepID: "clone", err: errors.New("no network")})
+	if got := runNotify(cmd, sent); len(got) != 1 || got[0] != "Gentleman.Dots: Installation failed" {
+		t.Errorf("a failed install should notify, got %q", got)
+	}
+
+	m.Settings.Notify = false
+	m.Screen = ScreenInstalling
+	if _, cmd = m.Update(installCompleteMsg{totalTime: 185}); len(runNotify(cmd, sent)) != 0 {
+		t.Error("the setting off should keep the install quiet")
+	}
+}
+
+func TestLongTaskNotifications(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	sent := captureNotify(t)
+	now := fakeClock(t)
+
+	m := NewModel()
+	m.Settings.Notify = true
+	m.ProjectPathInput = "/work/api"
+	m.TaskStarted = now.Add(-10 * time.Second)
+	if _, cmd := m.Update(projectInstallCompleteMsg{}); len(runNotify(cmd, sent)) != 0 {
+		t.Error("a quick project init should not notify")
+	}
+
+	m.TaskStarted = now.Add(-longTaskNotifyAfter)
+	_, cmd := m.Update(projectInstallCompleteMsg{err: errors.New("exit status 1")})
+	if got := runNotify(cmd, sent); len(got) != 1 || got[0] != "Gentleman.Dots: Project initialization failed: /work/api" {
+		t.Errorf("a long project init should notify, got %q", got)
+	}
+
+	m.TaskStarted = now.Add(-time.Minute)
+	result, cmd := m.Update(skillUpdateCompleteMsg{changes: &skillCatalogChanges{}})
+	if got := runNotify(cmd, sent); len(got) != 1 || got[0] != "Gentleman.Dots: Skill catalog updated" {
+		t.Errorf("a long catalog update should notify, got %q", got)
+	}
+	if !result.(Model).TaskStarted.IsZero() {
+		t.Error("the finished task should no longer be timed")
+	}
+	// A quick catalog update after it isn't timed from the earlier task
+	if _, cmd = result.Update(skillUpdateCompleteMsg{changes: &skillCatalogChanges{}}); len(runNotify(cmd, sent)) != 0 {
+		t.Error("only a timed task should notify")
+	}
+
+	m.ProjectBatch = []ProjectBatchItem{{Path: "/work/a", Selected: true, Status: StatusDone}, {Path: "/work/b", Selected: true, Status: StatusFailed}}
+	_, cmd = m.advanceProjectBatch(len(m.ProjectBatch))
+	if got := runNotify(cmd, sent); len(got) != 1 || got[0] != "Gentleman.Dots: Projects initialized: 1 succeeded, 1 failed" {
+		t.Errorf("a long batch should notify once at the end, got %q", got)
+	}
+
+	m.Settings.Notify = false
+	if _, cmd = m.Update(skillUpdateCompleteMsg{changes: &skillCatalogChanges{}}); len(runNotify(cmd, sent)) != 0 {
+		t.Error("the setting off should keep long tasks quiet")
+	}
+}
+
+func TestNotifySetting(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	m := NewModel()
+	if !m.Settings.Notify {
+		t.Error("notifications should be on by default")
+	}
+	m = m.toggleSetting(settingsNotifyRow)
+	if m.Settings.Notify || !strings.HasPrefix(m.settingsOptions()[settingsNotifyRow], "[ ] Notify") {
+		t.Errorf("the notify row should toggle, got %q", m.settingsOptions()[settingsNotifyRow])
+	}
+}
@@ -0,0 +1,114 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+)
+
+// oldUbuntuModel is a Linux install on Ubuntu 18.04 with the wizard finished
+func oldUbuntuModel(t *testing.T) Model {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	m := NewModel()
+	m.SystemInfo = &system.SystemInfo{
+		OS:        system.OSDebian,
+		OSName:    "Debian/Ubuntu",
+		HomeDir:   t.TempDir(),
+		DistroID:  "ubuntu",
+		OSVersion: "18.04",
+	}
+	m.Choices = UserChoices{OS: "linux", Terminal: "none", Shell: "zsh", InstallNvim: true}
+	return m
+}
+
+func TestOSVersionWarningShownBeforeInstall(t *testing.T) {
+	m := oldUbuntuModel(t)
+
+	result, _ := m.proceedToBackupOrInstall()
+	m = result.(Model)
+	if m.Screen != ScreenOSVersionWarning {
+		t.Fatalf("expected the OS version warning, got %v", m.Screen)
+	}
+
+	view := m.renderOSVersionWarning()
+	for _, want := range []string{"Ubuntu 18.04", "22.04", "Steps most likely to fail", "Install Neovim"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("warning should mention %q, got:\n%s", want, view)
+		}
+	}
+
+	// Steps that aren't planned aren't listed
+	m.Choices.InstallNvim = false
+	if view := m.renderOSVersionWarning(); strings.Contains(view, "Install Neovim") {
+		t.Errorf("only planned steps should be listed, got:\n%s", view)
+	}
+}
+
+func TestOSVersionWarningContinue(t *testing.T) {
+	m := oldUbuntuModel(t)
+	result, _ := m.proceedToBackupOrInstall()
+	m = result.(Model)
+
+	m.Cursor = 0
+	result, _ = m.handleOSVersionWarningKeys("enter")
+	m = result.(Model)
+	if !m.OSWarningAccepted {
+		t.Error("continuing should remember the answer")
+	}
+	if m.Screen != ScreenBackupConfirm && m.Screen != ScreenInstalling {
+		t.Errorf("continuing should move on to backup or install, got %v", m.Screen)
+	}
+}
+
+func TestOSVersionWarningAbort(t *testing.T) {
+	m := oldUbuntuModel(t)
+	result, _ := m.proceedToBackupOrInstall()
+	m = result.(Model)
+
+	m.Cursor = 1
+	result, _ = m.handleOSVersionWarningKeys("enter")
+	m = result.(Model)
+	if m.Screen != ScreenMainMenu {
+		t.Errorf("aborting should return to the main menu, got %v", m.Screen)
+	}
+	if m.Choices.OS != "" {
+		t.Error("aborting should reset the wizard choices")
+	}
+}
+
+func TestOSVersionWarningEscGoesBack(t *testing.T) {
+	m := oldUbuntuModel(t)
+	result, _ := m.proceedToBackupOrInstall()
+	m = result.(Model)
+
+	result, _ = m.handleOSVersionWarningKeys("esc")
+	if got := result.(Model).Screen; got != ScreenWizardReview {
+		t.Errorf("esc should return to the review of the wizard answers, got %v", got)
+	}
+}
+
+func TestOSVersionWarningSkippedWhenSupported(t *testing.T) {
+	tests := []struct {
+		name    string
+		distro  string
+		version string
+	}{
+		{"current Ubuntu", "ubuntu", "24.04"},
+		{"rolling release", "arch", ""},
+		{"unknown version", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := oldUbuntuModel(t)
+			m.SystemInfo.DistroID = tt.distro
+			m.SystemInfo.OSVersion = tt.version
+
+			result, _ := m.proceedToBackupOrInstall()
+			if got := result.(Model).Screen; got == ScreenOSVersionWarning {
+				t.Errorf("%s %q should not warn", tt.distro, tt.version)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package tui
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// openNewDirPrompt asks, below the browser listing, for the name of a
+// directory to create in FileBrowserRoot
+func (m Model) openNewDirPrompt() Model {
+	m.ProjectPathMode = PathModeNewDir
+	m.FileBrowserNewDir = ""
+	m.ProjectPathError = ""
+	return m
+}
+
+// handlePathNewDirKeys edits the new directory's name; Enter creates it and
+// Esc goes back to the browser
+func (m Model) handlePathNewDirKeys(key string) (tea.Model, tea.Cmd) {
+	switch key {
+	case "enter":
+		return m.createBrowserDir(), nil
+	case "esc":
+		m.ProjectPathMode = PathModeBrowser
+		m.FileBrowserNewDir = ""
+		m.ProjectPathError = ""
+	case "backspace":
+		if runes := []rune(m.FileBrowserNewDir); len(runes) > 0 {
+			m.FileBrowserNewDir = string(runes[:len(runes)-1])
+		}
+		m.ProjectPathError = ""
+	case " ":
+		m.FileBrowserNewDir += " "
+		m.ProjectPathError = ""
+	default:
+		if len(key) == 1 && key[0] >= 32 && key[0] <= 126 {
+			m.FileBrowserNewDir += key
+			m.ProjectPathError = ""
+		}
+	}
+	return m, nil
+}
+
+// createBrowserDir makes the typed directory in FileBrowserRoot and lands
+// the browser cursor on it. A bad name, an existing entry or a failed mkdir
+// keeps the prompt open with the error.
+func (m Model) createBrowserDir() Model {
+	name := strings.TrimSpace(m.FileBrowserNewDir)
+	if name == "" || name == "." || name == ".." || strings.ContainsRune(name, filepath.Separator) {
+		m.ProjectPathError = m.Tf("project_path.invalid_dir_name", name)
+		return m
+	}
+	if err := os.Mkdir(filepath.Join(m.FileBrowserRoot, name), 0o755); err != nil {
+		if errors.Is(err, fs.ErrExist) {
+			m.ProjectPathError = m.Tf("project_path.already_exists", name)
+		} else {
+			m.ProjectPathError = m.Tf("project_path.create_failed", name, err)
+		}
+		return m
+	}
+
+	// A dotted name is only listed with hidden folders shown
+	if strings.HasPrefix(name, ".") && !m.FileBrowserShowHidden {
+		m.FileBrowserShowHidden = true
+		m.Settings.ShowHidden = true
+	}
+	m.FileBrowserEntries = listDirectories(m.FileBrowserRoot, "", m.FileBrowserShowHidden)
+	m.FileBrowserCursor = 0
+	if idx := slices.Index(m.FileBrowserEntries, name); idx >= 0 {
+		m.FileBrowserCursor = idx + 2 // After "select" and "../"
+	}
+	m.ProjectPathMode = PathModeBrowser
+	m.FileBrowserNewDir = ""
+	m.ProjectPathError = ""
+	return m.scrollBrowserToCursor()
+}
+
+// createTypedPath makes the typed project path, parents included, and then
+// confirms it as Enter would
+func (m Model) createTypedPath() (tea.Model, tea.Cmd) {
+	path := expandPath(m.ProjectPathInput)
+	if path == "" {
+		m.ProjectPathError = m.T("project_path.empty")
+		return m, nil
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		m.ProjectPathError = m.Tf("project_path.invalid", err)
+		return m, nil
+	}
+	if err := os.MkdirAll(absPath, 0o755); err != nil {
+		m.ProjectPathError = m.Tf("project_path.create_failed", absPath, err)
+		return m, nil
+	}
+	return m.handlePathTypingKeys("enter")
+}
+
+// renderNewDirPrompt is the name input shown below the browser listing
+func (m Model) renderNewDirPrompt() string {
+	var s strings.Builder
+	s.WriteString(m.Theme.Info.Render("  " + m.Tf("project_path.new_dir", contractHome(m.FileBrowserRoot))))
+	s.WriteString("\n")
+	s.WriteString("  > " + m.FileBrowserNewDir)
+	s.WriteString(m.Theme.Cursor.Render(" "))
+	s.WriteString("\n")
+	if m.ProjectPathError != "" {
+		s.WriteString("\n")
+		s.WriteString(m.Theme.Error.Render("  ⚠ " + m.ProjectPathError))
+		s.WriteString("\n")
+	}
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render("  " + m.T("project_path.help_new_dir")))
+	return s.String()
+}
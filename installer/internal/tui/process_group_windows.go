@@ -0,0 +1,9 @@
+//go:build windows
+
+package tui
+
+import "os/exec"
+
+// killProcessGroupOnCancel leaves cmd as it is: Windows has no process
+// groups to kill, so cancelling the context kills the command alone
+func killProcessGroupOnCancel(cmd *exec.Cmd) {}
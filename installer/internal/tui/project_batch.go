@@ -0,0 +1,326 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ProjectBatchItem is one sub-project of a batch project init
+type ProjectBatchItem struct {
+	Path     string
+	Stack    string
+	Selected bool
+	Status   StepStatus
+	Err      string // Why initialization failed
+}
+
+// Name is the directory name shown in lists
+func (it ProjectBatchItem) Name() string {
+	return filepath.Base(it.Path)
+}
+
+// projectBatchStepMsg reports that one sub-project finished initializing
+type projectBatchStepMsg struct {
+	index int
+	err   error
+}
+
+// projectInitRunner runs the single-project pipeline; tests replace it
+var projectInitRunner = runProjectInitScript
+
+// discoverSubProjects lists the immediate subdirectories of parent that look like
+// projects (detectStack recognizes them), sorted by name. Hidden directories are
+// skipped. Every project starts selected.
+func discoverSubProjects(parent string) []ProjectBatchItem {
+	entries, err := os.ReadDir(parent)
+	if err != nil {
+		return nil
+	}
+	var items []ProjectBatchItem
+	for _, e := range entries {
+		if !e.IsDir() || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		path := filepath.Join(parent, e.Name())
+		if stack := detectStack(path); stack != "unknown" {
+			items = append(items, ProjectBatchItem{Path: path, Stack: stack, Selected: true})
+		}
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Path < items[j].Path })
+	return items
+}
+
+// batchSelectedCount returns how many sub-projects will be initialized
+func (m Model) batchSelectedCount() int {
+	n := 0
+	for _, it := range m.ProjectBatch {
+		if it.Selected {
+			n++
+		}
+	}
+	return n
+}
+
+// batchCounts tallies the finished sub-projects of a batch run
+func (m Model) batchCounts() (succeeded, failed int) {
+	for _, it := range m.ProjectBatch {
+		switch it.Status {
+		case StatusDone:
+			succeeded++
+		case StatusFailed:
+			failed++
+		}
+	}
+	return succeeded, failed
+}
+
+// nextBatchIndex returns the first selected, still pending sub-project at or
+// after from, or -1 when the batch is finished
+func (m Model) nextBatchIndex(from int) int {
+	for i := from; i < len(m.ProjectBatch); i++ {
+		if m.ProjectBatch[i].Selected && m.ProjectBatch[i].Status == StatusPending {
+			return i
+		}
+	}
+	return -1
+}
+
+// runProjectBatchStep initializes one sub-project with the shared choices,
+// keeping its cancel func so Esc can stop it
+func (m Model) runProjectBatchStep(i int) (Model, tea.Cmd) {
+	path := m.ProjectBatch[i].Path
+	memory := m.ProjectMemory
+	ci := m.ProjectCI
+	engram := m.ProjectEngram
+	rolePacks := m.ProjectRolePacks
+	ctx, cancel := context.WithCancel(context.Background())
+	m.ProjectInitCancel = cancel
+	return m, func() tea.Msg {
+		err := projectInitRunner(ctx, path, memory, ci, engram, rolePacks)
+		return projectBatchStepMsg{index: i, err: err}
+	}
+}
+
+// startProjectBatch resets the per-repo results and starts the first selected project
+func (m Model) startProjectBatch() (tea.Model, tea.Cmd) {
+	for i := range m.ProjectBatch {
+		m.ProjectBatch[i].Status = StatusPending
+		m.ProjectBatch[i].Err = ""
+	}
+	return m.advanceProjectBatch(0)
+}
+
+// advanceProjectBatch runs the next pending project, or shows the summary when none is left
+func (m Model) advanceProjectBatch(from int) (tea.Model, tea.Cmd) {
+	next := m.nextBatchIndex(from)
+	if next < 0 {
+		m.Screen = ScreenProjectBatchResult
+		m.Cursor = 0
+		succeeded, failed := m.batchCounts()
+		cmd := m.finishLongTask(m.Tf("notify.batch_done", succeeded, failed))
+		return m, cmd
+	}
+	m.ProjectBatchCurrent = next
+	m.ProjectBatch[next].Status = StatusRunning
+	m.ProjectLogLines.Add("── " + m.ProjectBatch[next].Name())
+	return m.runProjectBatchStep(next)
+}
+
+// handleProjectBatchStep records one project's result; a failure doesn't stop the batch
+func (m Model) handleProjectBatchStep(msg projectBatchStepMsg) (tea.Model, tea.Cmd) {
+	if msg.index < 0 || msg.index >= len(m.ProjectBatch) {
+		return m, nil
+	}
+	m = m.releaseProjectInit()
+	it := &m.ProjectBatch[msg.index]
+	if errors.Is(msg.err, errProjectInitCancelled) {
+		// Esc stops the whole batch; the projects not reached are skipped
+		it.Status = StatusFailed
+		it.Err = msg.err.Error()
+		m.ProjectLogLines.Add("⏹ " + it.Name() + ": cancelled")
+		for i := range m.ProjectBatch {
+			if m.ProjectBatch[i].Selected && m.ProjectBatch[i].Status == StatusPending {
+				m.ProjectBatch[i].Status = StatusSkipped
+			}
+		}
+		return m.advanceProjectBatch(len(m.ProjectBatch))
+	}
+	if msg.err != nil {
+		it.Status = StatusFailed
+		it.Err = msg.err.Error()
+		m.ProjectLogLines.Add("❌ " + it.Name() + ": " + strings.SplitN(it.Err, "\n", 2)[0])
+	} else {
+		it.Status = StatusDone
+		m.ProjectLogLines.Add("✅ " + it.Name())
+	}
+	return m.advanceProjectBatch(msg.index + 1)
+}
+
+// projectBatchOptions lists one checkbox per sub-project, then the actions
+func (m Model) projectBatchOptions() []string {
+	opts := make([]string, 0, len(m.ProjectBatch)+3)
+	for _, it := range m.ProjectBatch {
+		check := "[ ]"
+		if it.Selected {
+			check = "[x]"
+		}
+		opts = append(opts, fmt.Sprintf("%s %s (%s)", check, it.Name(), it.Stack))
+	}
+	return append(opts,
+		"─────────────",
+		fmt.Sprintf("✅ Initialize %d selected projects", m.batchSelectedCount()),
+		"📁 Only this directory",
+	)
+}
+
+func (m Model) handleProjectBatchSelectKeys(key string) (tea.Model, tea.Cmd) {
+	options := m.canonicalOptions()
+	separatorIdx := len(m.ProjectBatch)
+	confirmIdx := separatorIdx + 1
+
+	switch key {
+	case "up", "k":
+		if m.Cursor > 0 {
+			m.Cursor--
+			if m.Cursor == separatorIdx {
+				m.Cursor--
+			}
+		}
+	case "down", "j":
+		if m.Cursor < len(options)-1 {
+			m.Cursor++
+			if m.Cursor == separatorIdx {
+				m.Cursor++
+			}
+		}
+	case "enter", " ":
+		if !m.multiSelectRuns(key, m.Cursor < separatorIdx, confirmIdx) {
+			break
+		}
+		switch {
+		case m.Cursor < separatorIdx:
+			m.ProjectBatch[m.Cursor].Selected = !m.ProjectBatch[m.Cursor].Selected
+		case m.Cursor == confirmIdx:
+			if m.batchSelectedCount() == 0 {
+				return m, nil
+			}
+			// Each project keeps its own detected stack, so the stack screen is skipped
+			m.ProjectStack = ""
+			if m.ProjectReuseSettings {
+				return m.enterProjectConfirm(), nil
+			}
+			m.Screen = ScreenProjectMemory
+			m.Cursor = projectChoiceCursor(projectMemoryIDs, m.ProjectMemory)
+		case m.Cursor > confirmIdx:
+			// Treat the parent as a single project
+			m.ProjectBatch = nil
+			m = m.enterProjectStack()
+		}
+	case "esc", "backspace":
+		return m.goBackInstallStep()
+	}
+
+	return m, nil
+}
+
+// renderProjectBatchSelect renders the sub-project checklist of a batch init
+func (m Model) renderProjectBatchSelect() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
+	s.WriteString("\n\n")
+
+	for i, opt := range m.GetCurrentOptions() {
+		if strings.HasPrefix(opt, "───") {
+			s.WriteString(m.Theme.Muted.Render("  " + opt))
+			s.WriteString("\n")
+			continue
+		}
+		cursor := "  "
+		style := m.Theme.Unselected
+		if i == m.Cursor {
+			cursor = "▸ "
+			style = m.Theme.Selected
+		}
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
+		s.WriteString("\n")
+	}
+
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render("↑/k up • ↓/j down • [Space] toggle • [Enter] confirm • [Esc] back"))
+	return s.String()
+}
+
+// renderProjectBatchProgress lists every selected project with its status
+func (m Model) renderProjectBatchProgress() string {
+	var s strings.Builder
+	done, failed := m.batchCounts()
+	s.WriteString(fmt.Sprintf("  %d of %d projects finished\n\n", done+failed, m.batchSelectedCount()))
+	for _, it := range m.ProjectBatch {
+		if !it.Selected {
+			continue
+		}
+		var line string
+		switch it.Status {
+		case StatusRunning:
+			line = m.Theme.Selected.Render("  ▸ " + it.Name())
+		case StatusDone:
+			line = m.Theme.Success.Render("  ✓ " + it.Name())
+		case StatusFailed:
+			line = m.Theme.Error.Render("  ✗ " + it.Name())
+		default:
+			line = m.Theme.Muted.Render("  ○ " + it.Name())
+		}
+		s.WriteString(line)
+		s.WriteString("\n")
+	}
+	s.WriteString("\n")
+	return s.String()
+}
+
+// renderProjectBatchResult renders the per-repo summary of a batch init
+func (m Model) renderProjectBatchResult() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n\n")
+
+	done, failed := m.batchCounts()
+	if failed == 0 {
+		s.WriteString(m.Theme.Success.Render(fmt.Sprintf("  ✅ All %d projects initialized", done)))
+	} else {
+		s.WriteString(m.Theme.Warning.Render(fmt.Sprintf("  ⚠️  %d initialized, %d failed", done, failed)))
+	}
+	s.WriteString("\n\n")
+
+	for _, it := range m.ProjectBatch {
+		switch it.Status {
+		case StatusDone:
+			s.WriteString(m.Theme.Success.Render(fmt.Sprintf("  ✓ %s (%s)", it.Name(), it.Stack)))
+			s.WriteString("\n")
+		case StatusFailed:
+			s.WriteString(m.Theme.Error.Render(fmt.Sprintf("  ✗ %s (%s)", it.Name(), it.Stack)))
+			s.WriteString("\n")
+			// Script failures carry their whole output; the first line says what went wrong
+			s.WriteString(m.Theme.Muted.Render("      " + strings.SplitN(it.Err, "\n", 2)[0]))
+			s.WriteString("\n")
+		case StatusSkipped:
+			s.WriteString(m.Theme.Muted.Render(fmt.Sprintf("  – %s (%s): not started", it.Name(), it.Stack)))
+			s.WriteString("\n")
+		}
+	}
+
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render("  Press Enter to return to the main menu"))
+	return s.String()
+}
@@ -0,0 +1,261 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// batchFixture builds a parent directory with projects, plain folders, a hidden
+// project and a loose file
+func batchFixture(t *testing.T) string {
+	t.Helper()
+	parent := t.TempDir()
+	files := []string{
+		"billing/go.mod",
+		"gateway/package.json",
+		"ml/requirements.txt",
+		"docs/README.md",
+		".cache/go.mod",
+		"notes.txt",
+	}
+	for _, f := range files {
+		path := filepath.Join(parent, f)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Mkdir(filepath.Join(parent, "empty"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return parent
+}
+
+// fakeProjectInit replaces the init pipeline, failing for the named projects
+func fakeProjectInit(t *testing.T, failing ...string) *[]string {
+	t.Helper()
+	var ran []string
+	saved := projectInitRunner
+	projectInitRunner = func(_ context.Context, path, memory, ci string, engram bool, rolePacks []string) error {
+		name := filepath.Base(path)
+		ran = append(ran, name)
+		for _, f := range failing {
+			if f == name {
+				return errors.New("init-project.sh failed: exit status 1\nfull output")
+			}
+		}
+		return nil
+	}
+	t.Cleanup(func() { projectInitRunner = saved })
+	return &ran
+}
+
+func TestDiscoverSubProjects(t *testing.T) {
+	parent := batchFixture(t)
+
+	var got [][2]string
+	for _, it := range discoverSubProjects(parent) {
+		if !it.Selected || it.Status != StatusPending {
+			t.Errorf("%s should start selected and pending", it.Name())
+		}
+		got = append(got, [2]string{it.Name(), it.Stack})
+	}
+	want := [][2]string{{"billing", "go"}, {"gateway", "node"}, {"ml", "python"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("discoverSubProjects() = %v, want %v", got, want)
+	}
+
+	if subs := discoverSubProjects(filepath.Join(parent, "missing")); subs != nil {
+		t.Errorf("a missing directory has no sub-projects, got %v", subs)
+	}
+}
+
+func TestProjectPathOffersBatch(t *testing.T) {
+	parent := batchFixture(t)
+
+	tests := []struct {
+		name string
+		path string
+		want Screen
+	}{
+		{"parent of projects", parent, ScreenProjectBatchSelect},
+		{"a project itself", filepath.Join(parent, "billing"), ScreenProjectStack},
+		{"no projects inside", filepath.Join(parent, "docs"), ScreenProjectStack},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewModel()
+			m.Screen = ScreenProjectPath
+			m.ProjectPathInput = tt.path
+			m.ProjectPathCursor = len(tt.path)
+
+			result, _ := m.handlePathTypingKeys("enter")
+			nm := result.(Model)
+			if nm.Screen != tt.want {
+				t.Fatalf("expected %v, got %v", tt.want, nm.Screen)
+			}
+			if (nm.ProjectBatch != nil) != (tt.want == ScreenProjectBatchSelect) {
+				t.Errorf("batch should only be set when offered, got %v", nm.ProjectBatch)
+			}
+		})
+	}
+}
+
+func TestProjectBatchSelectKeys(t *testing.T) {
+	m := NewModel()
+	m.Screen = ScreenProjectBatchSelect
+	m.ProjectPathInput = batchFixture(t)
+	m.ProjectBatch = discoverSubProjects(m.ProjectPathInput)
+
+	// Uncheck all three projects
+	for i := 0; i < 3; i++ {
+		m.Cursor = i
+		result, _ := m.handleProjectBatchSelectKeys(" ")
+		m = result.(Model)
+	}
+	if n := m.batchSelectedCount(); n != 0 {
+		t.Fatalf("expected nothing selected, got %d", n)
+	}
+
+	// Moving down from the last project skips the separator
+	m.Cursor = 2
+	result, _ := m.handleProjectBatchSelectKeys("down")
+	m = result.(Model)
+	if m.Cursor != 4 {
+		t.Fatalf("cursor should skip the separator, got %d", m.Cursor)
+	}
+
+	// Confirming with nothing selected stays put
+	result, _ = m.handleProjectBatchSelectKeys("enter")
+	m = result.(Model)
+	if m.Screen != ScreenProjectBatchSelect {
+		t.Fatalf("an empty batch should not continue, got %v", m.Screen)
+	}
+
+	m.ProjectBatch[1].Selected = true
+	result, _ = m.handleProjectBatchSelectKeys("enter")
+	m = result.(Model)
+	if m.Screen != ScreenProjectMemory {
+		t.Fatalf("confirming should skip to the memory screen, got %v", m.Screen)
+	}
+
+	// Esc on the memory screen returns to the checklist
+	result, _ = m.goBackInstallStep()
+	m = result.(Model)
+	if m.Screen != ScreenProjectBatchSelect {
+		t.Errorf("back from memory should return to the checklist, got %v", m.Screen)
+	}
+
+	// "Only this directory" drops the batch
+	m.Cursor = len(m.GetCurrentOptions()) - 1
+	result, _ = m.handleProjectBatchSelectKeys("enter")
+	m = result.(Model)
+	if m.Screen != ScreenProjectStack || m.ProjectBatch != nil {
+		t.Errorf("single mode should go to the stack screen without a batch, got %v %v", m.Screen, m.ProjectBatch)
+	}
+}
+
+func TestProjectBatchRun(t *testing.T) {
+	ran := fakeProjectInit(t, "gateway")
+
+	m := NewModel()
+	m.Screen = ScreenProjectInstalling
+	m.ProjectPathInput = batchFixture(t)
+	m.ProjectMemory = "engram"
+	m.ProjectCI = "github"
+	m.ProjectBatch = discoverSubProjects(m.ProjectPathInput)
+	m.ProjectBatch = append(m.ProjectBatch, ProjectBatchItem{Path: "/srv/skipped", Stack: "go"})
+	m.Ticking = true // Keep Update from batching a tick with the step command
+
+	var msg tea.Msg = projectInstallStartMsg{}
+	for i := 0; msg != nil; i++ {
+		if i > 10 {
+			t.Fatal("batch did not finish")
+		}
+		result, cmd := m.Update(msg)
+		m = result.(Model)
+		if m.Screen == ScreenProjectInstalling && !strings.Contains(m.renderProjectInstalling(), "projects finished") {
+			t.Error("the progress view should list the batch")
+		}
+		msg = nil
+		if cmd != nil {
+			if next, ok := cmd().(projectBatchStepMsg); ok {
+				msg = next
+			}
+		}
+	}
+
+	if m.Screen != ScreenProjectBatchResult {
+		t.Fatalf("expected the batch summary, got %v", m.Screen)
+	}
+	if want := []string{"billing", "gateway", "ml"}; !reflect.DeepEqual(*ran, want) {
+		t.Errorf("projects run = %v, want %v (unselected skipped)", *ran, want)
+	}
+	if ok, failed := m.batchCounts(); ok != 2 || failed != 1 {
+		t.Errorf("counts = %d ok, %d failed; want 2, 1", ok, failed)
+	}
+	if m.ProjectBatch[1].Status != StatusFailed || m.ProjectBatch[1].Err == "" {
+		t.Errorf("gateway should be recorded as failed: %+v", m.ProjectBatch[1])
+	}
+
+	view := m.renderProjectBatchResult()
+	for _, want := range []string{"2 initialized, 1 failed", "✓ billing (go)", "✗ gateway (node)", "init-project.sh failed: exit status 1"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("summary should contain %q, got:\n%s", want, view)
+		}
+	}
+	if strings.Contains(view, "full output") || strings.Contains(view, "skipped") {
+		t.Errorf("summary should show only the first error line and selected projects, got:\n%s", view)
+	}
+
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if got := result.(Model).Screen; got != ScreenMainMenu {
+		t.Errorf("enter should return to the main menu, got %v", got)
+	}
+}
+
+func TestProjectBatchCancel(t *testing.T) {
+	saved := projectInitRunner
+	projectInitRunner = func(ctx context.Context, path, memory, ci string, engram bool, rolePacks []string) error {
+		if ctx.Err() != nil {
+			return errProjectInitCancelled
+		}
+		return nil
+	}
+	t.Cleanup(func() { projectInitRunner = saved })
+
+	m := NewModel()
+	m.Screen = ScreenProjectInstalling
+	m.ProjectPathInput = batchFixture(t)
+	m.ProjectBatch = discoverSubProjects(m.ProjectPathInput)
+	m.Ticking = true
+
+	result, cmd := m.Update(projectInstallStartMsg{})
+	m = result.(Model)
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = result.(Model)
+	if m.Screen != ScreenProjectInstalling || m.ProjectInitCancel != nil {
+		t.Fatalf("esc should cancel in place, got %v", m.Screen)
+	}
+	result, _ = m.Update(cmd())
+	m = result.(Model)
+
+	if m.Screen != ScreenProjectBatchResult {
+		t.Fatalf("a cancel should end the batch, got %v", m.Screen)
+	}
+	view := m.renderProjectBatchResult()
+	for _, want := range []string{"✗ billing (go)", "cancelled", "gateway (node): not started", "ml (python): not started"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("summary should contain %q, got:\n%s", want, view)
+		}
+	}
+}
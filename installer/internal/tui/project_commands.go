@@ -0,0 +1,326 @@
+package tui
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// ProjectCommand is a build/test/lint command discovered in a project
+type ProjectCommand struct {
+	Purpose string // "build", "test", "lint", "run" or "other"
+	Command string
+	Source  string // File the command was inferred from
+}
+
+// projectCommandPurposes is the section order in the generated memory file
+var projectCommandPurposes = []struct {
+	ID    string
+	Title string
+}{
+	{"build", "Build"},
+	{"test", "Test"},
+	{"lint", "Lint & Format"},
+	{"run", "Run"},
+	{"other", "Other"},
+}
+
+// projectCommandExtractors infer commands per stack. Each one looks for its
+// indicator file and returns nothing when the project doesn't have it.
+var projectCommandExtractors = []func(dir string) []ProjectCommand{
+	extractGoCommands,
+	extractNodeCommands,
+	extractRustCommands,
+	extractMakeCommands,
+}
+
+// InferProjectCommands scans a project directory for build/test commands
+func InferProjectCommands(dir string) []ProjectCommand {
+	var cmds []ProjectCommand
+	for _, extract := range projectCommandExtractors {
+		cmds = append(cmds, extract(dir)...)
+	}
+	return cmds
+}
+
+// classifyCommandName guesses a purpose from a script or target name
+func classifyCommandName(name string) string {
+	lower := strings.ToLower(name)
+	switch {
+	case strings.Contains(lower, "test") || strings.Contains(lower, "spec") || lower == "e2e":
+		return "test"
+	case strings.Contains(lower, "build") || lower == "compile" || lower == "dist":
+		return "build"
+	case strings.Contains(lower, "lint") || strings.Contains(lower, "format") || lower == "fmt" ||
+		strings.Contains(lower, "typecheck") || lower == "check" || lower == "vet":
+		return "lint"
+	case lower == "dev" || lower == "start" || lower == "serve" || lower == "run" || lower == "preview":
+		return "run"
+	}
+	return "other"
+}
+
+func extractGoCommands(dir string) []ProjectCommand {
+	if _, err := os.Stat(filepath.Join(dir, "go.mod")); err != nil {
+		return nil
+	}
+	cmds := []ProjectCommand{
+		{Purpose: "build", Command: "go build ./...", Source: "go.mod"},
+	}
+	if hasGoTests(dir) {
+		cmds = append(cmds, ProjectCommand{Purpose: "test", Command: "go test ./...", Source: "go.mod"})
+	}
+	cmds = append(cmds, ProjectCommand{Purpose: "lint", Command: "go vet ./...", Source: "go.mod"})
+	return cmds
+}
+
+// hasGoTests reports whether any _test.go file exists outside vendor/testdata
+func hasGoTests(dir string) bool {
+	errFound := errors.New("found")
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+		if info.IsDir() {
+			switch info.Name() {
+			case "vendor", "testdata", "node_modules", ".git":
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if strings.HasSuffix(info.Name(), "_test.go") {
+			return errFound
+		}
+		return nil
+	})
+	return err == errFound
+}
+
+// nodePackageManagers maps lockfiles to the runner that owns them
+var nodePackageManagers = []struct {
+	Lockfile string
+	Runner   string
+}{
+	{"pnpm-lock.yaml", "pnpm"},
+	{"yarn.lock", "yarn"},
+	{"bun.lockb", "bun run"},
+	{"bun.lock", "bun run"},
+}
+
+func extractNodeCommands(dir string) []ProjectCommand {
+	data, err := os.ReadFile(filepath.Join(dir, "package.json"))
+	if err != nil {
+		return nil
+	}
+	var pkg struct {
+		Scripts map[string]string `json:"scripts"`
+	}
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return nil
+	}
+
+	runner := "npm run"
+	for _, pm := range nodePackageManagers {
+		if _, err := os.Stat(filepath.Join(dir, pm.Lockfile)); err == nil {
+			runner = pm.Runner
+			break
+		}
+	}
+
+	names := make([]string, 0, len(pkg.Scripts))
+	for name := range pkg.Scripts {
+		// Lifecycle hooks (pretest, postbuild) run on their own
+		if _, ok := pkg.Scripts[strings.TrimPrefix(name, "pre")]; ok && strings.HasPrefix(name, "pre") {
+			continue
+		}
+		if _, ok := pkg.Scripts[strings.TrimPrefix(name, "post")]; ok && strings.HasPrefix(name, "post") {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var cmds []ProjectCommand
+	for _, name := range names {
+		cmds = append(cmds, ProjectCommand{
+			Purpose: classifyCommandName(name),
+			Command: runner + " " + name,
+			Source:  "package.json",
+		})
+	}
+	return cmds
+}
+
+func extractRustCommands(dir string) []ProjectCommand {
+	if _, err := os.Stat(filepath.Join(dir, "Cargo.toml")); err != nil {
+		return nil
+	}
+	return []ProjectCommand{
+		{Purpose: "build", Command: "cargo build", Source: "Cargo.toml"},
+		{Purpose: "test", Command: "cargo test", Source: "Cargo.toml"},
+		{Purpose: "lint", Command: "cargo clippy", Source: "Cargo.toml"},
+		{Purpose: "lint", Command: "cargo fmt --check", Source: "Cargo.toml"},
+	}
+}
+
+// makeTargetPattern matches "target:" rule lines, but not "VAR := value"
+var makeTargetPattern = regexp.MustCompile(`^([A-Za-z0-9][A-Za-z0-9_.-]*)\s*:([^=]|$)`)
+
+func extractMakeCommands(dir string) []ProjectCommand {
+	var data []byte
+	for _, name := range []string{"Makefile", "makefile", "GNUmakefile"} {
+		if b, err := os.ReadFile(filepath.Join(dir, name)); err == nil {
+			data = b
+			break
+		}
+	}
+	if data == nil {
+		return nil
+	}
+
+	var cmds []ProjectCommand
+	seen := make(map[string]bool)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		match := makeTargetPattern.FindStringSubmatch(scanner.Text())
+		if match == nil || seen[match[1]] {
+			continue
+		}
+		seen[match[1]] = true
+		cmds = append(cmds, ProjectCommand{
+			Purpose: classifyCommandName(match[1]),
+			Command: "make " + match[1],
+			Source:  "Makefile",
+		})
+	}
+	return cmds
+}
+
+// Markers delimit the generated section so re-running init replaces it
+const (
+	stackSectionStart = "<!-- javi-dots:stack-commands -->"
+	stackSectionEnd   = "<!-- /javi-dots:stack-commands -->"
+)
+
+var stackSectionTemplate = template.Must(template.New("stack").Parse(`{{.Start}}
+## Project Commands ({{.Stack}})
+
+Inferred from the project files. Prefer these over guessing.
+{{range .Groups}}
+### {{.Title}}
+
+{{range .Commands}}- ` + "`{{.Command}}`" + ` ({{.Source}})
+{{end}}{{end}}{{.End}}
+`))
+
+// RenderStackMemorySection renders the commands as a markdown section for
+// CLAUDE.md / AGENTS.md. Returns "" when nothing was inferred.
+func RenderStackMemorySection(stack string, cmds []ProjectCommand) string {
+	if len(cmds) == 0 {
+		return ""
+	}
+	type group struct {
+		Title    string
+		Commands []ProjectCommand
+	}
+	var groups []group
+	for _, purpose := range projectCommandPurposes {
+		g := group{Title: purpose.Title}
+		for _, c := range cmds {
+			if c.Purpose == purpose.ID {
+				g.Commands = append(g.Commands, c)
+			}
+		}
+		if len(g.Commands) > 0 {
+			groups = append(groups, g)
+		}
+	}
+	if stack == "" {
+		stack = "unknown"
+	}
+
+	var buf bytes.Buffer
+	stackSectionTemplate.Execute(&buf, struct {
+		Start, End, Stack string
+		Groups            []group
+	}{stackSectionStart, stackSectionEnd, stack, groups})
+	return buf.String()
+}
+
+// projectMemoryFiles are the memory files the "simple" module maintains
+var projectMemoryFiles = []string{"CLAUDE.md", "AGENTS.md"}
+
+// injectStackSection writes the section into each memory file in the project,
+// replacing a previous generated section. CLAUDE.md is created if neither exists.
+func injectStackSection(projectPath, section string) ([]string, error) {
+	if section == "" {
+		return nil, nil
+	}
+	var targets []string
+	for _, name := range projectMemoryFiles {
+		if _, err := os.Stat(filepath.Join(projectPath, name)); err == nil {
+			targets = append(targets, name)
+		}
+	}
+	if len(targets) == 0 {
+		targets = []string{projectMemoryFiles[0]}
+	}
+
+	for _, name := range targets {
+		path := filepath.Join(projectPath, name)
+		existing, err := os.ReadFile(path)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		if err := os.WriteFile(path, []byte(replaceStackSection(string(existing), section)), 0644); err != nil {
+			return nil, err
+		}
+	}
+	return targets, nil
+}
+
+// replaceStackSection swaps the generated block in content, or appends it
+func replaceStackSection(content, section string) string {
+	start := strings.Index(content, stackSectionStart)
+	end := strings.Index(content, stackSectionEnd)
+	if start >= 0 && end > start {
+		rest := strings.TrimPrefix(content[end+len(stackSectionEnd):], "\n")
+		return content[:start] + section + rest
+	}
+	if content == "" {
+		return section
+	}
+	if !strings.HasSuffix(content, "\n") {
+		content += "\n"
+	}
+	return content + "\n" + section
+}
+
+// writeProjectCommands injects the inferred commands into the project's memory
+// files. Failures are logged, not fatal: the scaffold itself is already in place.
+func writeProjectCommands(projectPath string) {
+	logLine := func(line string) {
+		if globalProgram != nil {
+			globalProgram.Send(projectInstallLogMsg{line: line})
+		}
+	}
+	cmds := InferProjectCommands(projectPath)
+	if len(cmds) == 0 {
+		logLine("No build/test commands found to add to the memory files")
+		return
+	}
+	files, err := injectStackSection(projectPath, RenderStackMemorySection(detectStack(projectPath), cmds))
+	if err != nil {
+		logLine(fmt.Sprintf("⚠ Could not add project commands: %v", err))
+		return
+	}
+	logLine(fmt.Sprintf("✓ Added %d project commands to %s", len(cmds), strings.Join(files, ", ")))
+}
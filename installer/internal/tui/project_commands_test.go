@@ -0,0 +1,110 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/exp/golden"
+)
+
+func TestProjectCommandExtractors(t *testing.T) {
+	tests := []struct {
+		fixture string
+		extract func(dir string) []ProjectCommand
+		want    []string
+	}{
+		{"go", extractGoCommands, []string{"go build ./...", "go test ./...", "go vet ./..."}},
+		{"node", extractNodeCommands, []string{"pnpm build", "pnpm dev", "pnpm lint", "pnpm preview", "pnpm storybook", "pnpm test", "pnpm test:e2e", "pnpm typecheck"}},
+		{"rust", extractRustCommands, []string{"cargo build", "cargo test", "cargo clippy", "cargo fmt --check"}},
+		{"make", extractMakeCommands, []string{"make build", "make test", "make lint", "make clean"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			cmds := tt.extract(filepath.Join("testdata", "projects", tt.fixture))
+			var got []string
+			for _, c := range cmds {
+				got = append(got, c.Command)
+			}
+			if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	t.Run("go without tests", func(t *testing.T) {
+		dir := t.TempDir()
+		os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module x\n"), 0644)
+		for _, c := range extractGoCommands(dir) {
+			if c.Purpose == "test" {
+				t.Errorf("did not expect a test command, got %q", c.Command)
+			}
+		}
+	})
+
+	t.Run("missing indicator files", func(t *testing.T) {
+		if cmds := InferProjectCommands(t.TempDir()); len(cmds) != 0 {
+			t.Errorf("expected no commands for an empty dir, got %v", cmds)
+		}
+	})
+}
+
+func TestRenderStackMemorySection(t *testing.T) {
+	for _, stack := range []string{"go", "node", "rust", "make"} {
+		t.Run(stack, func(t *testing.T) {
+			cmds := InferProjectCommands(filepath.Join("testdata", "projects", stack))
+			golden.RequireEqual(t, []byte(RenderStackMemorySection(stack, cmds)))
+		})
+	}
+}
+
+func TestInjectStackSection(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "AGENTS.md"), []byte("# Agents\n\nHand-written notes."), 0644)
+
+	first := RenderStackMemorySection("go", []ProjectCommand{{Purpose: "build", Command: "go build ./...", Source: "go.mod"}})
+	files, err := injectStackSection(dir, first)
+	if err != nil || len(files) != 1 || files[0] != "AGENTS.md" {
+		t.Fatalf("expected only AGENTS.md to be updated, got %v (%v)", files, err)
+	}
+
+	// Re-running replaces the generated block instead of appending another
+	second := RenderStackMemorySection("go", []ProjectCommand{{Purpose: "test", Command: "go test ./...", Source: "go.mod"}})
+	if _, err := injectStackSection(dir, second); err != nil {
+		t.Fatal(err)
+	}
+	data, _ := os.ReadFile(filepath.Join(dir, "AGENTS.md"))
+	content := string(data)
+	if !strings.HasPrefix(content, "# Agents\n\nHand-written notes.\n") {
+		t.Errorf("hand-written content should be kept, got:\n%s", content)
+	}
+	if strings.Count(content, stackSectionStart) != 1 || strings.Contains(content, "go build") || !strings.Contains(content, "go test") {
+		t.Errorf("expected a single, updated generated section, got:\n%s", content)
+	}
+
+	t.Run("creates CLAUDE.md when no memory file exists", func(t *testing.T) {
+		dir := t.TempDir()
+		files, err := injectStackSection(dir, first)
+		if err != nil || len(files) != 1 || files[0] != "CLAUDE.md" {
+			t.Fatalf("expected CLAUDE.md, got %v (%v)", files, err)
+		}
+	})
+}
+
+func TestProjectConfirmPreviewsSimpleMemoryCommands(t *testing.T) {
+	m := NewModel()
+	m.Screen = ScreenProjectCI
+	m.ProjectPathInput = filepath.Join("testdata", "projects", "go")
+	m.ProjectMemory = "simple"
+	m.Cursor = 3 // none
+
+	result, _ := m.handleSelection()
+	m = result.(Model)
+	if m.Screen != ScreenProjectConfirm || len(m.ProjectCommands) != 3 {
+		t.Fatalf("expected 3 inferred commands on the confirm screen, got %v", m.ProjectCommands)
+	}
+	if view := m.renderProjectConfirm(); !strings.Contains(view, "go test ./...") {
+		t.Errorf("expected the preview to list inferred commands, got:\n%s", view)
+	}
+}
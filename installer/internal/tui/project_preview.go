@@ -0,0 +1,234 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// projectManifestEntry is one path project init creates, relative to the project
+type projectManifestEntry struct {
+	Path  string // Slash-separated; directories end in "/"
+	Group string // What creates it, shown as a heading in the preview
+}
+
+// IsDir reports whether the entry is a directory
+func (e projectManifestEntry) IsDir() bool {
+	return strings.HasSuffix(e.Path, "/")
+}
+
+// projectAgentFiles are written by init-project.sh for every memory module
+var projectAgentFiles = []string{".ai-config/", ".ai-config/agents/", ".ai-config/skills/", "AGENTS.md", "CLAUDE.md"}
+
+// projectMemoryManifest lists what each memory module adds to the project
+var projectMemoryManifest = map[string][]string{
+	"vibekanban": {".vibekanban/", ".vibekanban/config.json"},
+	"engram":     {".engram/", ".engram/config.json"},
+	"simple":     {".project/", ".project/Memory/", ".project/Memory/CONTEXT.md", ".project/Memory/DECISIONS.md", ".project/Memory/BLOCKERS.md"},
+}
+
+// projectCIManifest lists the workflow files of each CI provider
+var projectCIManifest = map[string][]string{
+	"github":     {".github/", ".github/workflows/", ".github/workflows/ci.yml"},
+	"gitlab":     {".gitlab-ci.yml"},
+	"woodpecker": {".woodpecker.yml"},
+}
+
+// projectInitManifest lists the files and directories project init creates for
+// the given choices, without touching the disk. It mirrors init-project.sh and
+// the role pack copy in runProjectInitScript, so keep them in step.
+func projectInitManifest(memory, ci string, engram bool, rolePacks []string) []projectManifestEntry {
+	var entries []projectManifestEntry
+	add := func(group string, paths ...string) {
+		for _, p := range paths {
+			entries = append(entries, projectManifestEntry{Path: p, Group: group})
+		}
+	}
+
+	add("Agents config", projectAgentFiles...)
+
+	memoryGroup := "Memory module (" + memory + ")"
+	if memory == "obsidian-brain" {
+		add(memoryGroup, ".obsidian-brain/")
+		for _, dir := range vaultCoreDirs {
+			add(memoryGroup, ".obsidian-brain/"+dir+"/")
+		}
+		for _, pack := range rolePacks {
+			for _, dir := range rolePackDirs[pack] {
+				add(memoryGroup, ".obsidian-brain/"+dir+"/")
+			}
+		}
+		if engram {
+			add(memoryGroup, projectMemoryManifest["engram"]...)
+		}
+	} else {
+		add(memoryGroup, projectMemoryManifest[memory]...)
+	}
+
+	add("CI ("+ci+")", projectCIManifest[ci]...)
+	return entries
+}
+
+// projectPreviewLine is one line of the scrollable preview
+type projectPreviewLine struct {
+	Text    string
+	Heading bool
+	Warn    bool // An existing file that would be overwritten
+}
+
+// projectPreviewLines checks the manifest against projectPath. Existing files
+// are flagged as overwritten; existing directories are kept and only noted.
+func projectPreviewLines(projectPath string, manifest []projectManifestEntry) []projectPreviewLine {
+	lines := []projectPreviewLine{{Text: contractHome(projectPath), Heading: true}}
+	created, overwritten := 0, 0
+	group := ""
+	for _, e := range manifest {
+		if e.Group != group {
+			group = e.Group
+			lines = append(lines, projectPreviewLine{Text: "  " + group + ":"})
+		}
+		_, err := os.Stat(filepath.Join(projectPath, filepath.FromSlash(e.Path)))
+		switch {
+		case err != nil:
+			created++
+			lines = append(lines, projectPreviewLine{Text: "    + " + e.Path})
+		case e.IsDir():
+			lines = append(lines, projectPreviewLine{Text: "    = " + e.Path + " (exists)"})
+		default:
+			overwritten++
+			lines = append(lines, projectPreviewLine{Text: "    ⚠ " + e.Path + " (exists, would be overwritten)", Warn: true})
+		}
+	}
+	lines = append(lines, projectPreviewLine{Text: fmt.Sprintf("  %d new, %d overwritten", created, overwritten)})
+	return lines
+}
+
+// enterProjectPreview builds the preview for the project, or for each selected
+// project of a batch
+func (m Model) enterProjectPreview() Model {
+	manifest := projectInitManifest(m.ProjectMemory, m.ProjectCI, m.ProjectEngram, m.ProjectRolePacks)
+	paths := []string{expandPath(m.ProjectPathInput)}
+	if m.ProjectBatch != nil {
+		paths = nil
+		for _, it := range m.ProjectBatch {
+			if it.Selected {
+				paths = append(paths, it.Path)
+			}
+		}
+	}
+
+	m.ProjectPreview = nil
+	for i, p := range paths {
+		if i > 0 {
+			m.ProjectPreview = append(m.ProjectPreview, projectPreviewLine{})
+		}
+		m.ProjectPreview = append(m.ProjectPreview, projectPreviewLines(p, manifest)...)
+	}
+	m.ProjectPreviewPos = 0
+	m.Screen = ScreenProjectPreview
+	return m
+}
+
+// startProjectInit saves the project choices and starts initializing
+func (m Model) startProjectInit() (tea.Model, tea.Cmd) {
+	m.Choices.InitProject = true
+	m.Choices.ProjectPath = m.ProjectPathInput
+	m.Choices.ProjectStack = m.ProjectStack
+	m.Choices.ProjectMemory = m.ProjectMemory
+	m.Choices.ProjectCI = m.ProjectCI
+	m.Choices.ProjectEngram = m.ProjectEngram
+	m.Choices.ProjectRolePacks = m.ProjectRolePacks
+	m.ProjectLogLines.Reset()
+	m.ProjectPreview = nil
+	m.Screen = ScreenProjectInstalling
+	return m, func() tea.Msg { return projectInstallStartMsg{} }
+}
+
+// projectPreviewViewHeight is how many preview lines fit on screen
+func (m Model) projectPreviewViewHeight() int {
+	return m.visibleRows(9)
+}
+
+// handleProjectPreviewKeys scrolls the preview; Enter initializes the project
+func (m Model) handleProjectPreviewKeys(key string) (tea.Model, tea.Cmd) {
+	maxScroll := len(m.ProjectPreview) - m.projectPreviewViewHeight()
+	if maxScroll < 0 {
+		maxScroll = 0
+	}
+
+	switch key {
+	case "up", "k":
+		if m.ProjectPreviewPos > 0 {
+			m.ProjectPreviewPos--
+		}
+	case "down", "j":
+		if m.ProjectPreviewPos < maxScroll {
+			m.ProjectPreviewPos++
+		}
+	case "pgup":
+		m.ProjectPreviewPos -= 10
+		if m.ProjectPreviewPos < 0 {
+			m.ProjectPreviewPos = 0
+		}
+	case "pgdown":
+		m.ProjectPreviewPos += 10
+		if m.ProjectPreviewPos > maxScroll {
+			m.ProjectPreviewPos = maxScroll
+		}
+	case "enter":
+		return m.startProjectInit()
+	}
+	return m, nil
+}
+
+// leaveProjectPreview returns to the confirm screen with the cursor on Preview
+func (m Model) leaveProjectPreview() Model {
+	m.Screen = ScreenProjectConfirm
+	m.Cursor = 1
+	m.ProjectPreview = nil
+	m.ProjectPreviewPos = 0
+	return m
+}
+
+func (m Model) renderProjectPreview() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
+	s.WriteString("\n\n")
+
+	viewHeight := m.projectPreviewViewHeight()
+	start := m.ProjectPreviewPos
+	end := start + viewHeight
+	if end > len(m.ProjectPreview) {
+		end = len(m.ProjectPreview)
+	}
+	if start > end {
+		start = 0
+	}
+
+	for _, line := range m.ProjectPreview[start:end] {
+		switch {
+		case line.Heading:
+			s.WriteString(m.Theme.Subtitle.Render(line.Text))
+		case line.Warn:
+			s.WriteString(m.Theme.Warning.Render(line.Text))
+		default:
+			s.WriteString(m.Theme.Info.Render(line.Text))
+		}
+		s.WriteString("\n")
+	}
+
+	if len(m.ProjectPreview) > viewHeight {
+		s.WriteString("\n")
+		s.WriteString(m.Theme.Muted.Render(fmt.Sprintf("Lines %d-%d of %d (↑↓ to scroll, PgUp/PgDn for fast scroll)", start+1, end, len(m.ProjectPreview))))
+	}
+
+	s.WriteString("\n\n")
+	s.WriteString(m.Theme.Help.Render("↑/k up • ↓/j down • PgUp/PgDn • [Enter] initialize • [Esc] back"))
+	return s.String()
+}
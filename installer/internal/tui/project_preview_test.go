@@ -0,0 +1,141 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func manifestPaths(entries []projectManifestEntry) []string {
+	var paths []string
+	for _, e := range entries {
+		paths = append(paths, e.Path)
+	}
+	return paths
+}
+
+func TestProjectInitManifest(t *testing.T) {
+	agents := []string{".ai-config/", ".ai-config/agents/", ".ai-config/skills/", "AGENTS.md", "CLAUDE.md"}
+	memories := map[string][]string{
+		"obsidian-brain": {".obsidian-brain/", ".obsidian-brain/inbox/", ".obsidian-brain/resources/",
+			".obsidian-brain/knowledge/", ".obsidian-brain/templates/", ".obsidian-brain/.obsidian/"},
+		"vibekanban": {".vibekanban/", ".vibekanban/config.json"},
+		"engram":     {".engram/", ".engram/config.json"},
+		"simple": {".project/", ".project/Memory/", ".project/Memory/CONTEXT.md",
+			".project/Memory/DECISIONS.md", ".project/Memory/BLOCKERS.md"},
+		"none": nil,
+	}
+	cis := map[string][]string{
+		"github":     {".github/", ".github/workflows/", ".github/workflows/ci.yml"},
+		"gitlab":     {".gitlab-ci.yml"},
+		"woodpecker": {".woodpecker.yml"},
+		"none":       nil,
+	}
+
+	for memory, memoryPaths := range memories {
+		for ci, ciPaths := range cis {
+			t.Run(memory+"/"+ci, func(t *testing.T) {
+				want := slices.Concat(agents, memoryPaths, ciPaths)
+				got := manifestPaths(projectInitManifest(memory, ci, false, nil))
+				if !slices.Equal(got, want) {
+					t.Errorf("manifest = %v\nwant %v", got, want)
+				}
+			})
+		}
+	}
+}
+
+func TestProjectInitManifestObsidianExtras(t *testing.T) {
+	got := manifestPaths(projectInitManifest("obsidian-brain", "none", true, []string{"developer", "pm-lead"}))
+	for _, want := range []string{".obsidian-brain/architecture/", ".obsidian-brain/sessions/", ".obsidian-brain/debugging/",
+		".obsidian-brain/meetings/", ".obsidian-brain/sprints/", ".obsidian-brain/risks/", ".obsidian-brain/briefs/", ".engram/config.json"} {
+		if !slices.Contains(got, want) {
+			t.Errorf("manifest is missing %s: %v", want, got)
+		}
+	}
+}
+
+func TestProjectPreviewLines(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "CLAUDE.md"), []byte("# mine\n"), 0644)
+	os.MkdirAll(filepath.Join(dir, ".github", "workflows"), 0755)
+
+	lines := projectPreviewLines(dir, projectInitManifest("none", "github", false, nil))
+	var warned []string
+	text := map[string]bool{}
+	for _, l := range lines {
+		if l.Warn {
+			warned = append(warned, l.Text)
+		}
+		text[l.Text] = true
+	}
+
+	if len(warned) != 1 || !strings.Contains(warned[0], "CLAUDE.md") {
+		t.Errorf("only CLAUDE.md should be flagged as overwritten, got %v", warned)
+	}
+	for _, want := range []string{"    = .github/workflows/ (exists)", "    + .github/workflows/ci.yml", "    + AGENTS.md", "  CI (github):", "  5 new, 1 overwritten"} {
+		if !text[want] {
+			t.Errorf("preview is missing %q", want)
+		}
+	}
+}
+
+func TestProjectPreviewFlow(t *testing.T) {
+	dir := t.TempDir()
+	m := NewModel()
+	m.Screen = ScreenProjectConfirm
+	m.ProjectPathInput = dir
+	m.ProjectMemory = "simple"
+	m.ProjectCI = "gitlab"
+	m.Cursor = 1 // Preview changes
+
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = result.(Model)
+	if m.Screen != ScreenProjectPreview {
+		t.Fatalf("expected the preview screen, got %d", m.Screen)
+	}
+	if view := m.renderProjectPreview(); !strings.Contains(view, ".gitlab-ci.yml") || !strings.Contains(view, ".project/Memory/CONTEXT.md") {
+		t.Errorf("the preview should list the CI and memory files, got:\n%s", view)
+	}
+
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = result.(Model)
+	if m.Screen != ScreenProjectConfirm || m.Cursor != 1 || m.ProjectPreview != nil {
+		t.Fatalf("Esc should return to the confirm screen on Preview, got screen %d cursor %d", m.Screen, m.Cursor)
+	}
+
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = result.(Model)
+	result, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = result.(Model)
+	if m.Screen != ScreenProjectInstalling || cmd == nil {
+		t.Fatalf("Enter on the preview should start the init, got screen %d", m.Screen)
+	}
+	if !m.Choices.InitProject || m.Choices.ProjectCI != "gitlab" {
+		t.Errorf("the choices should be saved as on Confirm, got %+v", m.Choices)
+	}
+}
+
+func TestProjectPreviewScroll(t *testing.T) {
+	m := NewModel()
+	m.Height = 20
+	m.ProjectPreview = make([]projectPreviewLine, 30)
+	m.Screen = ScreenProjectPreview
+
+	for range 40 {
+		result, _ := m.handleProjectPreviewKeys("down")
+		m = result.(Model)
+	}
+	if want := 30 - m.projectPreviewViewHeight(); m.ProjectPreviewPos != want {
+		t.Errorf("scroll should stop at %d, got %d", want, m.ProjectPreviewPos)
+	}
+	result, _ := m.handleProjectPreviewKeys("pgup")
+	m = result.(Model)
+	if m.ProjectPreviewPos != 9 {
+		t.Errorf("PgUp should scroll 10 lines, got %d", m.ProjectPreviewPos)
+	}
+}
@@ -0,0 +1,130 @@
+package tui
+
+import (
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// projectMemoryIDs and projectCIIDs follow the options of ScreenProjectMemory
+// and ScreenProjectCI
+var (
+	projectMemoryIDs = []string{"obsidian-brain", "vibekanban", "engram", "simple", "none"}
+	projectCIIDs     = []string{"github", "gitlab", "woodpecker", "none"}
+)
+
+// projectChoiceCursor puts the cursor on a choice kept from the previous
+// project, or on the first option when there is none
+func projectChoiceCursor(ids []string, current string) int {
+	return max(slices.Index(ids, current), 0)
+}
+
+// enterProjectPath opens the path input on path and clears what the previous
+// run left behind. The memory, CI and Engram choices are kept.
+func (m Model) enterProjectPath(path string) Model {
+	m.ProjectPathInput = path
+	m.ProjectPathCursor = len([]rune(path))
+	m.ProjectPathError = ""
+	m.ProjectPathMode = PathModeTyping
+	m.ProjectPathCompletions = nil
+	m.ProjectPathCompIdx = -1
+	m.FileBrowserEntries = nil
+	m.FileBrowserCursor = 0
+	m.FileBrowserScroll = 0
+	m.FileBrowserRoot = ""
+	m.FileBrowserShowHidden = m.Settings.ShowHidden
+	m.ProjectStack = ""
+	m.ProjectStacks = nil
+	m.ProjectDetectedStacks = nil
+	m.ProjectCommands = nil
+	m.ProjectPreview = nil
+	m.ProjectLogLines.Reset()
+	m.ProjectBatch = nil
+	m.ErrorMsg = ""
+	m.Screen = ScreenProjectPath
+	m.Cursor = 0
+	return m
+}
+
+// enterProjectConfirm shows the summary, inferring the commands the "simple"
+// memory module would add
+func (m Model) enterProjectConfirm() Model {
+	m.ProjectCommands = nil
+	// A batch has no single project to preview; each repo gets its own commands
+	if m.ProjectMemory == "simple" && m.ProjectBatch == nil {
+		m.ProjectCommands = InferProjectCommands(expandPath(m.ProjectPathInput))
+	}
+	m.Screen = ScreenProjectConfirm
+	m.Cursor = 0
+	return m
+}
+
+// projectResultOptions are the ways on from a finished project init
+func (m Model) projectResultOptions() []string {
+	return []string{"📦 Initialize another project", "⚡ Same settings, new path", "← Main menu"}
+}
+
+func (m Model) handleProjectResultKeys(key string) (tea.Model, tea.Cmd) {
+	options := m.canonicalOptions()
+	switch key {
+	case "up", "k":
+		if m.Cursor > 0 {
+			m.Cursor--
+		}
+	case "down", "j":
+		if m.Cursor < len(options)-1 {
+			m.Cursor++
+		}
+	case "enter":
+		switch m.Cursor {
+		case 0:
+			m.ProjectReuseSettings = false
+			return m.enterProjectPath(""), loadRecentProjectsCmd()
+		case 1:
+			m.ProjectReuseSettings = true
+			return m.enterProjectPath(""), loadRecentProjectsCmd()
+		default:
+			m.Screen = ScreenMainMenu
+			m.Cursor = 0
+		}
+	}
+	return m, nil
+}
+
+// renderProjectResult renders the project initialization result screen
+func (m Model) renderProjectResult() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n\n")
+
+	if m.ErrorMsg == errProjectInitCancelled.Error() {
+		s.WriteString(m.Theme.Warning.Render("  ⏹ Project initialization cancelled"))
+		s.WriteString("\n\n")
+		s.WriteString("    The script was stopped; files it already wrote are left in place.")
+	} else if m.ErrorMsg != "" {
+		s.WriteString(m.Theme.Error.Render("  ❌ Project initialization failed"))
+		s.WriteString("\n\n")
+		s.WriteString("    " + m.ErrorMsg)
+	} else {
+		s.WriteString(m.Theme.Success.Render("  ✅ Project initialized successfully!"))
+	}
+	s.WriteString("\n\n")
+
+	for i, opt := range m.GetCurrentOptions() {
+		cursor := "  "
+		style := m.Theme.Unselected
+		if i == m.Cursor {
+			cursor = "▸ "
+			style = m.Theme.Selected
+		}
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
+		s.WriteString("\n")
+	}
+
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render("↑/k up • ↓/j down • [Enter] select • [Esc] main menu"))
+	return s.String()
+}
@@ -0,0 +1,126 @@
+package tui
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// finishedProjectModel is the result screen after initializing one project
+func finishedProjectModel(t *testing.T) Model {
+	m := NewModel()
+	m.Screen = ScreenProjectResult
+	m.ProjectPathInput = t.TempDir()
+	m.ProjectPathError = "stale"
+	m.ProjectStack = "go"
+	m.ProjectMemory = "engram"
+	m.ProjectCI = "gitlab"
+	m.ProjectEngram = true
+	m.ProjectLogLines.Add("Running: bash init-project.sh")
+	m.ErrorMsg = "init-project.sh failed: exit status 1"
+	return m
+}
+
+func TestProjectResultLoop(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor int
+		reuse  bool
+	}{
+		{name: "initialize another project", cursor: 0, reuse: false},
+		{name: "same settings, new path", cursor: 1, reuse: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := finishedProjectModel(t)
+			m.Cursor = tt.cursor
+			result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+			m = result.(Model)
+
+			if m.Screen != ScreenProjectPath || m.ProjectReuseSettings != tt.reuse {
+				t.Fatalf("expected the path screen with reuse %v, got screen %d reuse %v", tt.reuse, m.Screen, m.ProjectReuseSettings)
+			}
+			if m.ProjectPathInput != "" || m.ProjectPathError != "" || m.ProjectStack != "" {
+				t.Errorf("the path state should be cleared, got %q / %q / %q", m.ProjectPathInput, m.ProjectPathError, m.ProjectStack)
+			}
+			if m.ErrorMsg != "" || len(m.ProjectLogLines.Tail(10)) != 0 {
+				t.Errorf("the previous run's error and log should be cleared, got %q / %v", m.ErrorMsg, m.ProjectLogLines.Tail(10))
+			}
+			if m.ProjectMemory != "engram" || m.ProjectCI != "gitlab" || !m.ProjectEngram {
+				t.Errorf("the choices should survive, got memory %q CI %q engram %v", m.ProjectMemory, m.ProjectCI, m.ProjectEngram)
+			}
+		})
+	}
+}
+
+func TestProjectSameSettingsSkipsToConfirm(t *testing.T) {
+	m := finishedProjectModel(t)
+	m.Cursor = 1
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = result.(Model)
+
+	next := t.TempDir()
+	os.WriteFile(filepath.Join(next, "go.mod"), []byte("module x\n"), 0644)
+	m.ProjectPathInput = next
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = result.(Model)
+
+	if m.Screen != ScreenProjectConfirm || m.Cursor != 0 {
+		t.Fatalf("a valid path should go straight to the confirm screen, got %d", m.Screen)
+	}
+	if m.ProjectStack != "go" || m.ProjectMemory != "engram" || m.ProjectCI != "gitlab" {
+		t.Errorf("expected the new stack with the kept choices, got %q / %q / %q", m.ProjectStack, m.ProjectMemory, m.ProjectCI)
+	}
+}
+
+func TestProjectAnotherPreselectsChoices(t *testing.T) {
+	m := finishedProjectModel(t)
+	m.Cursor = 0
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = result.(Model)
+
+	next := t.TempDir()
+	os.WriteFile(filepath.Join(next, "package.json"), []byte("{}\n"), 0644)
+	m.ProjectPathInput = next
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = result.(Model)
+	if m.Screen != ScreenProjectStack {
+		t.Fatalf("without the fast path the full flow runs, got %d", m.Screen)
+	}
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = result.(Model)
+	if m.Screen != ScreenProjectMemory || m.GetCurrentOptions()[m.Cursor] != "🧠 Engram" {
+		t.Fatalf("the memory screen should start on the kept choice, got cursor %d", m.Cursor)
+	}
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = result.(Model)
+	if m.Screen != ScreenProjectCI || m.GetCurrentOptions()[m.Cursor] != "GitLab CI" {
+		t.Errorf("the CI screen should start on the kept choice, got cursor %d", m.Cursor)
+	}
+}
+
+func TestProjectInitCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	m := NewModel()
+	m.Screen = ScreenProjectInstalling
+	m.ProjectInitCancel = cancel
+
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = result.(Model)
+	if ctx.Err() == nil || m.Screen != ScreenProjectInstalling {
+		t.Fatalf("esc should stop the script and wait for it, got %v", m.Screen)
+	}
+	if log := strings.Join(m.ProjectLogLines.Tail(5), "\n"); !strings.Contains(log, "Cancelling") {
+		t.Errorf("the cancel should be logged, got %q", log)
+	}
+
+	result, _ = m.Update(projectInstallCompleteMsg{err: errProjectInitCancelled})
+	m = result.(Model)
+	if m.Screen != ScreenProjectResult || !strings.Contains(m.renderProjectResult(), "Project initialization cancelled") {
+		t.Errorf("the result should report the cancel, got %v:\n%s", m.Screen, m.renderProjectResult())
+	}
+}
@@ -485,10 +485,10 @@ func TestProjectEscapeBackNavigation(t *testing.T) {
 }
 
 func TestProjectConfirmCancel(t *testing.T) {
-	t.Run("Cursor=1 (Cancel) → ScreenMainMenu", func(t *testing.T) {
+	t.Run("Cursor=2 (Cancel) → ScreenMainMenu", func(t *testing.T) {
 		m := NewModel()
 		m.Screen = ScreenProjectConfirm
-		m.Cursor = 1 // Cancel
+		m.Cursor = 2 // Cancel
 
 		result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
 		nm := result.(Model)
@@ -530,13 +530,13 @@ func TestGetCurrentOptionsProjectScreens(t *testing.T) {
 		}
 	})
 
-	t.Run("ScreenProjectConfirm → 2 options", func(t *testing.T) {
+	t.Run("ScreenProjectConfirm → 3 options", func(t *testing.T) {
 		m := NewModel()
 		m.Screen = ScreenProjectConfirm
 		opts := m.GetCurrentOptions()
 
-		if len(opts) != 2 {
-			t.Errorf("expected 2 options, got %d: %v", len(opts), opts)
+		if len(opts) != 3 {
+			t.Errorf("expected 3 options, got %d: %v", len(opts), opts)
 		}
 	})
 }
@@ -596,9 +596,10 @@ func TestGetScreenDescriptionProjectStack(t *testing.T) {
 }
 
 func TestProjectResultEnter(t *testing.T) {
-	t.Run("Enter on ScreenProjectResult → ScreenMainMenu", func(t *testing.T) {
+	t.Run("Enter on Main menu → ScreenMainMenu", func(t *testing.T) {
 		m := NewModel()
 		m.Screen = ScreenProjectResult
+		m.Cursor = 2
 
 		result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
 		nm := result.(Model)
@@ -1143,6 +1144,111 @@ func TestBrowserToggleHidden(t *testing.T) {
 	})
 }
 
+func TestBrowserNewDir(t *testing.T) {
+	browser := func(t *testing.T) (Model, string) {
+		dir := t.TempDir()
+		os.Mkdir(filepath.Join(dir, "alpha"), 0o755)
+		os.Mkdir(filepath.Join(dir, "zeta"), 0o755)
+
+		m := NewModel()
+		m.Screen = ScreenProjectPath
+		m.Height = 30
+		m.ProjectPathInput = dir
+		result, _ := m.openFileBrowser()
+		return result.(Model), dir
+	}
+	typeName := func(m Model, name string) Model {
+		result, _ := m.handlePathBrowserKeys("n")
+		m = result.(Model)
+		for _, r := range name {
+			result, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+			m = result.(Model)
+		}
+		result, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		return result.(Model)
+	}
+
+	t.Run("n creates the directory and highlights it", func(t *testing.T) {
+		m, dir := browser(t)
+		m = typeName(m, "my app")
+
+		if info, err := os.Stat(filepath.Join(dir, "my app")); err != nil || !info.IsDir() {
+			t.Fatalf("expected the directory to be created, got %v", err)
+		}
+		if m.ProjectPathMode != PathModeBrowser || m.ProjectPathError != "" {
+			t.Fatalf("expected the browser back without an error, got mode %d, error %q", m.ProjectPathMode, m.ProjectPathError)
+		}
+		if got := m.FileBrowserEntries[m.FileBrowserCursor-2]; got != "my app" {
+			t.Errorf("expected the cursor on the new directory, got %q", got)
+		}
+	})
+
+	t.Run("an existing name is reported and the prompt stays", func(t *testing.T) {
+		m, _ := browser(t)
+		m = typeName(m, "zeta")
+
+		if m.ProjectPathMode != PathModeNewDir || !strings.Contains(m.ProjectPathError, "Already exists") {
+			t.Fatalf("expected the collision in the prompt, got mode %d, error %q", m.ProjectPathMode, m.ProjectPathError)
+		}
+		if !strings.Contains(m.View(), "Already exists: zeta") {
+			t.Error("the error should be shown under the prompt")
+		}
+
+		result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+		if nm := result.(Model); nm.ProjectPathMode != PathModeBrowser || nm.Screen != ScreenProjectPath {
+			t.Errorf("esc should close only the prompt, got mode %d", nm.ProjectPathMode)
+		}
+	})
+
+	t.Run("a name with a separator is rejected", func(t *testing.T) {
+		m, dir := browser(t)
+		m = typeName(m, "a/b")
+
+		if m.ProjectPathError == "" {
+			t.Error("expected an invalid name error")
+		}
+		if _, err := os.Stat(filepath.Join(dir, "a")); err == nil {
+			t.Error("nothing should be created")
+		}
+	})
+
+	t.Run("a failed mkdir is reported", func(t *testing.T) {
+		if os.Getuid() == 0 {
+			t.Skip("root ignores directory permissions")
+		}
+		m, dir := browser(t)
+		os.Chmod(dir, 0o555)
+		t.Cleanup(func() { os.Chmod(dir, 0o755) })
+		m = typeName(m, "new")
+
+		if !strings.Contains(m.ProjectPathError, "Could not create new") {
+			t.Errorf("expected the mkdir error, got %q", m.ProjectPathError)
+		}
+	})
+}
+
+func TestProjectPathCreateMissing(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "brand", "new")
+
+	m := NewModel()
+	m.Screen = ScreenProjectPath
+	m.ProjectPathInput = path
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = result.(Model)
+	if !strings.HasSuffix(m.ProjectPathError, "press ctrl+n to create it)") {
+		t.Fatalf("a missing path should offer ctrl+n, got %q", m.ProjectPathError)
+	}
+
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlN})
+	m = result.(Model)
+	if info, err := os.Stat(path); err != nil || !info.IsDir() {
+		t.Fatalf("ctrl+n should create the path with its parents, got %v", err)
+	}
+	if m.Screen != ScreenProjectStack || m.ProjectPathInput != path {
+		t.Errorf("ctrl+n should go on as Enter would, got screen %d with %q", m.Screen, m.ProjectPathInput)
+	}
+}
+
 // --- Pre-fill + helper tests ---
 
 func TestProjectPathPrefilledWithCwd(t *testing.T) {
@@ -1399,14 +1505,14 @@ func TestRolePackToggle(t *testing.T) {
 		m.Screen = ScreenProjectRolePack
 		m.RolePackSelected = make([]bool, 2)
 		m.Cursor = 1 // Developer Pack
-		result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		result, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{' '}})
 		nm := result.(Model)
 		if !nm.RolePackSelected[0] {
 			t.Error("expected Developer Pack to be toggled on")
 		}
 		// Toggle off
 		nm.Cursor = 1
-		result2, _ := nm.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		result2, _ := nm.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{' '}})
 		nm2 := result2.(Model)
 		if nm2.RolePackSelected[0] {
 			t.Error("expected Developer Pack to be toggled off")
@@ -1418,14 +1524,14 @@ func TestRolePackToggle(t *testing.T) {
 		m.Screen = ScreenProjectRolePack
 		m.RolePackSelected = make([]bool, 2)
 		m.Cursor = 2 // PM/Tech Lead Pack
-		result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		result, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{' '}})
 		nm := result.(Model)
 		if !nm.RolePackSelected[1] {
 			t.Error("expected PM/Tech Lead Pack to be toggled on")
 		}
 		// Toggle off
 		nm.Cursor = 2
-		result2, _ := nm.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		result2, _ := nm.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{' '}})
 		nm2 := result2.(Model)
 		if nm2.RolePackSelected[1] {
 			t.Error("expected PM/Tech Lead Pack to be toggled off")
@@ -1449,7 +1555,7 @@ func TestRolePackToggle(t *testing.T) {
 		m.Screen = ScreenProjectRolePack
 		m.RolePackSelected = make([]bool, 2)
 		m.Cursor = 3 // Separator
-		result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		result, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{' '}})
 		nm := result.(Model)
 		if nm.Screen != ScreenProjectRolePack {
 			t.Errorf("expected to stay on ScreenProjectRolePack, got %d", nm.Screen)
@@ -0,0 +1,168 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// projectStack is one row of ScreenProjectStack and the files that reveal it
+type projectStack struct {
+	ID         string
+	Label      string
+	Indicators []string // File names or filepath.Match patterns in the project root
+}
+
+// projectStacks are listed, and detected, in priority order: the first one
+// found becomes ProjectStack. Angular comes before Node since angular.json
+// always sits beside a package.json.
+var projectStacks = []projectStack{
+	{"angular", "Angular", []string{"angular.json"}},
+	{"node", "Node.js", []string{"package.json"}},
+	{"go", "Go", []string{"go.mod"}},
+	{"python", "Python", []string{"pyproject.toml", "requirements.txt", "setup.py"}},
+	{"rust", "Rust", []string{"Cargo.toml"}},
+	{"java", "Java", []string{"pom.xml", "build.gradle", "build.gradle.kts"}},
+	{"ruby", "Ruby", []string{"Gemfile"}},
+	{"php", "PHP", []string{"composer.json"}},
+	{"dotnet", ".NET", []string{"*.csproj", "*.fsproj", "*.sln"}},
+	{"flutter", "Flutter", []string{"pubspec.yaml"}},
+	{"elixir", "Elixir", []string{"mix.exs"}},
+	{"deno", "Deno", []string{"deno.json", "deno.jsonc"}},
+	{"other", "Other", nil},
+}
+
+// detectStacks returns every stack with an indicator file in path, in
+// projectStacks order
+func detectStacks(path string) []string {
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil
+	}
+	var stacks []string
+	for _, stack := range projectStacks {
+		if stackDetected(entries, stack.Indicators) {
+			stacks = append(stacks, stack.ID)
+		}
+	}
+	return stacks
+}
+
+func stackDetected(entries []os.DirEntry, indicators []string) bool {
+	for _, e := range entries {
+		for _, pattern := range indicators {
+			if ok, _ := filepath.Match(pattern, e.Name()); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// detectStack returns the highest-priority stack detected in path, or "unknown"
+func detectStack(path string) string {
+	if stacks := detectStacks(path); len(stacks) > 0 {
+		return stacks[0]
+	}
+	return "unknown"
+}
+
+// enterProjectStack opens the stack checklist with the detected stacks checked
+// and, when there are any, the cursor on Confirm
+func (m Model) enterProjectStack() Model {
+	m.ProjectStackSelected = make([]bool, len(projectStacks))
+	for i, stack := range projectStacks {
+		for _, id := range m.ProjectDetectedStacks {
+			if id == stack.ID {
+				m.ProjectStackSelected[i] = true
+			}
+		}
+	}
+	m.Screen = ScreenProjectStack
+	m.Cursor = 0
+	if len(m.ProjectDetectedStacks) > 0 {
+		m.Cursor = len(projectStacks) + 1
+	}
+	return m
+}
+
+// projectStackOptions lists every stack with its checkbox, then Confirm
+func (m Model) projectStackOptions() []string {
+	var opts []string
+	for i, stack := range projectStacks {
+		check := "[ ] "
+		if i < len(m.ProjectStackSelected) && m.ProjectStackSelected[i] {
+			check = "[x] "
+		}
+		opts = append(opts, check+stack.Label)
+	}
+	return append(opts, "─────────────", "✅ Confirm selection")
+}
+
+// pickedProjectStacks returns the IDs of the checked stacks in priority order
+func (m Model) pickedProjectStacks() []string {
+	var ids []string
+	for i, sel := range m.ProjectStackSelected {
+		if sel && i < len(projectStacks) {
+			ids = append(ids, projectStacks[i].ID)
+		}
+	}
+	return ids
+}
+
+// projectStackSummary describes the picked stacks for the confirm screen
+func (m Model) projectStackSummary() string {
+	if len(m.ProjectStacks) > 1 {
+		return strings.Join(m.ProjectStacks, ", ")
+	}
+	return m.ProjectStack
+}
+
+func (m Model) handleProjectStackKeys(key string) (tea.Model, tea.Cmd) {
+	if m.ProjectStackSelected == nil {
+		m.ProjectStackSelected = make([]bool, len(projectStacks))
+	}
+	options := m.canonicalOptions()
+	separatorIdx := len(projectStacks)
+	confirmIdx := separatorIdx + 1
+
+	switch key {
+	case "up", "k":
+		if m.Cursor > 0 {
+			m.Cursor--
+			if m.Cursor == separatorIdx {
+				m.Cursor--
+			}
+		}
+	case "down", "j":
+		if m.Cursor < len(options)-1 {
+			m.Cursor++
+			if m.Cursor == separatorIdx {
+				m.Cursor++
+			}
+		}
+	case "enter", " ":
+		if !m.multiSelectRuns(key, m.Cursor < separatorIdx, confirmIdx) {
+			break
+		}
+		switch {
+		case m.Cursor < separatorIdx:
+			m.ProjectStackSelected[m.Cursor] = !m.ProjectStackSelected[m.Cursor]
+		case m.Cursor == confirmIdx:
+			picked := m.pickedProjectStacks()
+			if len(picked) == 0 {
+				return m, nil // No-op if nothing selected
+			}
+			m.ProjectStacks = picked
+			m.ProjectStack = picked[0]
+			m.Screen = ScreenProjectMemory
+			m.Cursor = projectChoiceCursor(projectMemoryIDs, m.ProjectMemory)
+		}
+	case "backspace":
+		return m.goBackInstallStep()
+	}
+
+	return m, nil
+}
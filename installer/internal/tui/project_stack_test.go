@@ -0,0 +1,108 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestDetectStacks(t *testing.T) {
+	tests := []struct {
+		name  string
+		files []string
+		want  []string
+	}{
+		{name: "empty", want: nil},
+		{name: "angular", files: []string{"angular.json"}, want: []string{"angular"}},
+		{name: "node", files: []string{"package.json"}, want: []string{"node"}},
+		{name: "go", files: []string{"go.mod"}, want: []string{"go"}},
+		{name: "python pyproject", files: []string{"pyproject.toml"}, want: []string{"python"}},
+		{name: "python requirements", files: []string{"requirements.txt"}, want: []string{"python"}},
+		{name: "python setup.py", files: []string{"setup.py"}, want: []string{"python"}},
+		{name: "rust", files: []string{"Cargo.toml"}, want: []string{"rust"}},
+		{name: "java maven", files: []string{"pom.xml"}, want: []string{"java"}},
+		{name: "java gradle", files: []string{"build.gradle"}, want: []string{"java"}},
+		{name: "java gradle kotlin", files: []string{"build.gradle.kts"}, want: []string{"java"}},
+		{name: "ruby", files: []string{"Gemfile"}, want: []string{"ruby"}},
+		{name: "php", files: []string{"composer.json"}, want: []string{"php"}},
+		{name: "dotnet csproj", files: []string{"Api.csproj"}, want: []string{"dotnet"}},
+		{name: "dotnet fsproj", files: []string{"Lib.fsproj"}, want: []string{"dotnet"}},
+		{name: "dotnet solution", files: []string{"App.sln"}, want: []string{"dotnet"}},
+		{name: "flutter", files: []string{"pubspec.yaml"}, want: []string{"flutter"}},
+		{name: "elixir", files: []string{"mix.exs"}, want: []string{"elixir"}},
+		{name: "deno", files: []string{"deno.json"}, want: []string{"deno"}},
+		{name: "deno jsonc", files: []string{"deno.jsonc"}, want: []string{"deno"}},
+		{name: "unrelated files", files: []string{"README.md", "Makefile"}, want: nil},
+		{name: "angular before node", files: []string{"package.json", "angular.json"}, want: []string{"angular", "node"}},
+		{name: "node and go", files: []string{"go.mod", "package.json"}, want: []string{"node", "go"}},
+		{name: "python and rust", files: []string{"Cargo.toml", "pyproject.toml", "requirements.txt"}, want: []string{"python", "rust"}},
+		{name: "dotnet and node", files: []string{"package.json", "App.sln", "Web.csproj"}, want: []string{"node", "dotnet"}},
+		{name: "deno beside node", files: []string{"deno.json", "package.json"}, want: []string{"node", "deno"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for _, f := range tt.files {
+				os.WriteFile(filepath.Join(dir, f), nil, 0644)
+			}
+			if got := detectStacks(dir); !slices.Equal(got, tt.want) {
+				t.Errorf("detectStacks = %v, want %v", got, tt.want)
+			}
+			want := "unknown"
+			if len(tt.want) > 0 {
+				want = tt.want[0]
+			}
+			if got := detectStack(dir); got != want {
+				t.Errorf("detectStack = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestDetectStacksIgnoresIndicatorDirectories(t *testing.T) {
+	dir := t.TempDir()
+	os.Mkdir(filepath.Join(dir, "node_modules"), 0755)
+	os.WriteFile(filepath.Join(dir, "go.mod"), nil, 0644)
+	if got := detectStacks(dir); !slices.Equal(got, []string{"go"}) {
+		t.Errorf("detectStacks = %v, want [go]", got)
+	}
+}
+
+func TestProjectStackMultiSelect(t *testing.T) {
+	dir := t.TempDir()
+	for _, f := range []string{"package.json", "go.mod"} {
+		os.WriteFile(filepath.Join(dir, f), nil, 0644)
+	}
+
+	m := NewModel()
+	m.Screen = ScreenProjectPath
+	m.ProjectPathInput = dir
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = result.(Model)
+
+	if m.Screen != ScreenProjectStack || m.Cursor != len(projectStacks)+1 {
+		t.Fatalf("expected the stack checklist on Confirm, got screen %d cursor %d", m.Screen, m.Cursor)
+	}
+	if desc := m.GetScreenDescription(); !strings.Contains(desc, "node, go") {
+		t.Errorf("the description should list every stack found, got %q", desc)
+	}
+	opts := m.GetCurrentOptions()
+	if opts[1] != "[x] Node.js" || opts[2] != "[x] Go" || opts[0] != "[ ] Angular" {
+		t.Errorf("detected stacks should be pre-checked, got %v", opts[:3])
+	}
+
+	// Uncheck Node, leaving Go as the project stack
+	m.Cursor = 1
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{' '}})
+	m = result.(Model)
+	m.Cursor = len(projectStacks) + 1
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = result.(Model)
+	if m.Screen != ScreenProjectMemory || m.ProjectStack != "go" || !slices.Equal(m.ProjectStacks, []string{"go"}) {
+		t.Errorf("expected go alone, got screen %d stack %q stacks %v", m.Screen, m.ProjectStack, m.ProjectStacks)
+	}
+}
@@ -0,0 +1,198 @@
+package tui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// maxRecentProjects is how many project paths the history keeps
+const maxRecentProjects = 10
+
+// recentProjectsFile holds the last validated project paths, most recent first
+type recentProjectsFile struct {
+	Paths []string `json:"paths"`
+}
+
+// recentProject is a history entry on ScreenProjectPath; Missing entries are
+// shown greyed out and skipped
+type recentProject struct {
+	Path    string
+	Missing bool
+}
+
+// recentProjectsLoadedMsg carries the history read when the path screen opens
+type recentProjectsLoadedMsg struct {
+	projects []recentProject
+}
+
+func recentProjectsPath(home string) string {
+	return filepath.Join(paths.DataDir(home), "recent-projects.json")
+}
+
+// loadRecentProjects reads the history; a missing file is an empty history
+func loadRecentProjects(home string) ([]string, error) {
+	data, err := os.ReadFile(recentProjectsPath(home))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var file recentProjectsFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("invalid recent projects file: %w", err)
+	}
+	return file.Paths, nil
+}
+
+// addRecentProject puts path first, dropping an older copy of it and anything
+// beyond maxRecentProjects
+func addRecentProject(history []string, path string) []string {
+	out := []string{path}
+	for _, p := range history {
+		if p != path && len(out) < maxRecentProjects {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// recordRecentProject adds path to the history saved under home
+func recordRecentProject(home, path string) error {
+	history, err := loadRecentProjects(home)
+	if err != nil {
+		// A broken file is replaced rather than blocking the history for good
+		history = nil
+	}
+	data, err := json.MarshalIndent(recentProjectsFile{Paths: addRecentProject(history, path)}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(recentProjectsPath(home)), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(recentProjectsPath(home), data, 0644)
+}
+
+// loadRecentProjectsCmd reads the history and checks which entries still exist
+func loadRecentProjectsCmd() tea.Cmd {
+	return func() tea.Msg {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return recentProjectsLoadedMsg{}
+		}
+		history, _ := loadRecentProjects(home)
+		var projects []recentProject
+		for _, p := range history {
+			info, err := os.Stat(p)
+			projects = append(projects, recentProject{Path: p, Missing: err != nil || !info.IsDir()})
+		}
+		return recentProjectsLoadedMsg{projects: projects}
+	}
+}
+
+// recordRecentProjectCmd saves a validated path to the history. Failing to
+// save only costs the shortcut, so errors are dropped.
+func recordRecentProjectCmd(path string) tea.Cmd {
+	return func() tea.Msg {
+		if home, err := os.UserHomeDir(); err == nil {
+			recordRecentProject(home, path)
+		}
+		return nil
+	}
+}
+
+// rememberProjectPath adds a validated path to the history on screen and on disk
+func (m Model) rememberProjectPath(path string) (Model, tea.Cmd) {
+	m.ProjectRecent = slices.DeleteFunc(m.ProjectRecent, func(r recentProject) bool { return r.Path == path })
+	m.ProjectRecent = append([]recentProject{{Path: path}}, m.ProjectRecent...)
+	if len(m.ProjectRecent) > maxRecentProjects {
+		m.ProjectRecent = m.ProjectRecent[:maxRecentProjects]
+	}
+	return m, recordRecentProjectCmd(path)
+}
+
+// nextRecentProject returns the first entry from i on, moving by step, whose
+// directory still exists, or -1 when there is none
+func (m Model) nextRecentProject(i, step int) int {
+	for ; i >= 0 && i < len(m.ProjectRecent); i += step {
+		if !m.ProjectRecent[i].Missing {
+			return i
+		}
+	}
+	return -1
+}
+
+// openRecentProjects switches to the history list with the first (or, going
+// up, the last) usable entry highlighted
+func (m Model) openRecentProjects(up bool) Model {
+	idx := m.nextRecentProject(0, 1)
+	if up {
+		idx = m.nextRecentProject(len(m.ProjectRecent)-1, -1)
+	}
+	if idx < 0 {
+		return m
+	}
+	m.ProjectRecentIdx = idx
+	m.ProjectPathMode = PathModeRecent
+	m.ProjectPathError = ""
+	return m
+}
+
+// handlePathRecentKeys moves through the history; Enter fills the input
+func (m Model) handlePathRecentKeys(key string) (tea.Model, tea.Cmd) {
+	switch key {
+	case "up", "k":
+		if i := m.nextRecentProject(m.ProjectRecentIdx-1, -1); i >= 0 {
+			m.ProjectRecentIdx = i
+		}
+	case "down", "j":
+		if i := m.nextRecentProject(m.ProjectRecentIdx+1, 1); i >= 0 {
+			m.ProjectRecentIdx = i
+		}
+	case "enter", "tab":
+		path := m.ProjectRecent[m.ProjectRecentIdx].Path
+		m.ProjectPathInput = path
+		m.ProjectPathCursor = len([]rune(path))
+		m.ProjectPathMode = PathModeTyping
+	case "ctrl+r":
+		m.ProjectPathMode = PathModeTyping
+	default:
+		// Any other key: back to typing and re-process
+		m.ProjectPathMode = PathModeTyping
+		return m.handlePathTypingKeys(key)
+	}
+	return m, nil
+}
+
+// renderRecentProjects lists the history above the path input, highlighting
+// the selected entry while the list is open
+func (m Model) renderRecentProjects() string {
+	if len(m.ProjectRecent) == 0 {
+		return ""
+	}
+	var s strings.Builder
+	s.WriteString(m.Theme.Muted.Render("  Recent projects:"))
+	s.WriteString("\n")
+	for i, r := range m.ProjectRecent {
+		label := contractHome(r.Path)
+		switch {
+		case r.Missing:
+			s.WriteString(m.Theme.Muted.Render("    " + label + " (missing)"))
+		case m.ProjectPathMode == PathModeRecent && i == m.ProjectRecentIdx:
+			s.WriteString(m.Theme.Selected.Render("  ▸ " + label))
+		default:
+			s.WriteString(m.Theme.Unselected.Render("    " + label))
+		}
+		s.WriteString("\n")
+	}
+	s.WriteString("\n")
+	return s.String()
+}
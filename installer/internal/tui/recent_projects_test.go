@@ -0,0 +1,145 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestAddRecentProject(t *testing.T) {
+	var ten []string
+	for i := range 10 {
+		ten = append(ten, fmt.Sprintf("/p%d", i))
+	}
+
+	tests := []struct {
+		name    string
+		history []string
+		path    string
+		want    []string
+	}{
+		{name: "first entry", path: "/a", want: []string{"/a"}},
+		{name: "newest goes first", history: []string{"/a", "/b"}, path: "/c", want: []string{"/c", "/a", "/b"}},
+		{name: "a repeated path moves to the front", history: []string{"/a", "/b", "/c"}, path: "/b", want: []string{"/b", "/a", "/c"}},
+		{name: "capped at ten", history: ten, path: "/new", want: append([]string{"/new"}, ten[:9]...)},
+		{name: "a repeat in a full history keeps the rest", history: ten, path: "/p9", want: append([]string{"/p9"}, ten[:9]...)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := addRecentProject(tt.history, tt.path); !slices.Equal(got, tt.want) {
+				t.Errorf("addRecentProject = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecentProjectsPersistence(t *testing.T) {
+	home := t.TempDir()
+	if got, err := loadRecentProjects(home); err != nil || got != nil {
+		t.Fatalf("no file should be an empty history, got %v, %v", got, err)
+	}
+
+	for i := range 12 {
+		if err := recordRecentProject(home, fmt.Sprintf("/p%d", i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	recordRecentProject(home, "/p5")
+
+	got, err := loadRecentProjects(home)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"/p5", "/p11", "/p10", "/p9", "/p8", "/p7", "/p6", "/p4", "/p3", "/p2"}
+	if !slices.Equal(got, want) {
+		t.Errorf("history = %v, want %v", got, want)
+	}
+	if _, err := os.Stat(filepath.Join(home, ".gentleman", "recent-projects.json")); err != nil {
+		t.Errorf("the history should live in ~/.gentleman: %v", err)
+	}
+}
+
+func TestValidatedPathIsRecorded(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	project := t.TempDir()
+
+	m := NewModel()
+	m.Screen = ScreenProjectPath
+	m.ProjectPathInput = project
+	result, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = result.(Model)
+	if cmd == nil || len(m.ProjectRecent) != 1 || m.ProjectRecent[0].Path != project {
+		t.Fatalf("the validated path should be added to the history, got %v", m.ProjectRecent)
+	}
+	cmd()
+	if got, _ := loadRecentProjects(home); !slices.Equal(got, []string{project}) {
+		t.Errorf("saved history = %v, want [%s]", got, project)
+	}
+
+	// The next visit loads it, flagging entries that are gone
+	recordRecentProject(home, filepath.Join(home, "deleted"))
+	msg := loadRecentProjectsCmd()().(recentProjectsLoadedMsg)
+	if len(msg.projects) != 2 || !msg.projects[0].Missing || msg.projects[1].Missing {
+		t.Errorf("expected the deleted path flagged as missing, got %+v", msg.projects)
+	}
+}
+
+func TestRecentProjectSelection(t *testing.T) {
+	m := NewModel()
+	m.Screen = ScreenProjectPath
+	m.ProjectRecent = []recentProject{
+		{Path: "/gone", Missing: true},
+		{Path: "/work/api"},
+		{Path: "/old", Missing: true},
+		{Path: "/work/web"},
+	}
+	press := func(keys ...tea.KeyMsg) {
+		for _, k := range keys {
+			result, _ := m.Update(k)
+			m = result.(Model)
+		}
+	}
+
+	// Down on the empty input opens the list on the first entry that exists
+	press(tea.KeyMsg{Type: tea.KeyDown})
+	if m.ProjectPathMode != PathModeRecent || m.ProjectRecentIdx != 1 {
+		t.Fatalf("expected the history open on /work/api, got mode %d index %d", m.ProjectPathMode, m.ProjectRecentIdx)
+	}
+	press(tea.KeyMsg{Type: tea.KeyDown})
+	if m.ProjectRecentIdx != 3 {
+		t.Errorf("missing entries should be skipped, got index %d", m.ProjectRecentIdx)
+	}
+	press(tea.KeyMsg{Type: tea.KeyDown})
+	if m.ProjectRecentIdx != 3 {
+		t.Errorf("the cursor should stop on the last usable entry, got %d", m.ProjectRecentIdx)
+	}
+	view := m.renderProjectPath()
+	if !strings.Contains(view, "/old (missing)") || !strings.Contains(view, "▸ /work/web") {
+		t.Errorf("expected missing entries flagged and the selection marked, got:\n%s", view)
+	}
+
+	press(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.Screen != ScreenProjectPath || m.ProjectPathMode != PathModeTyping || m.ProjectPathInput != "/work/web" || m.ProjectPathCursor != len("/work/web") {
+		t.Fatalf("Enter should fill the input with the cursor at the end, got %q at %d", m.ProjectPathInput, m.ProjectPathCursor)
+	}
+
+	// Up with text in the input doesn't reopen the list; Ctrl+R does
+	press(tea.KeyMsg{Type: tea.KeyUp})
+	if m.ProjectPathMode != PathModeTyping {
+		t.Errorf("up should only open the history on an empty input")
+	}
+	press(tea.KeyMsg{Type: tea.KeyCtrlR})
+	if m.ProjectPathMode != PathModeRecent || m.ProjectRecentIdx != 1 {
+		t.Errorf("Ctrl+R should open the history, got mode %d index %d", m.ProjectPathMode, m.ProjectRecentIdx)
+	}
+	press(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.Screen != ScreenProjectPath || m.ProjectPathMode != PathModeTyping || m.ProjectPathInput != "/work/web" {
+		t.Errorf("Esc should close the history and keep the input, got screen %d mode %d", m.Screen, m.ProjectPathMode)
+	}
+}
@@ -0,0 +1,115 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CommandRunner executes a command and returns its combined output.
+// Git lookups take one so tests can feed canned output instead of a real repo.
+type CommandRunner func(name string, args ...string) (string, error)
+
+// execRunner runs commands for real with a timeout
+func execRunner(name string, args ...string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	out, err := exec.CommandContext(ctx, name, args...).CombinedOutput()
+	return string(out), err
+}
+
+// RepoCommit identifies the dotfiles commit an install deployed
+type RepoCommit struct {
+	Hash string    `json:"hash"`
+	Date time.Time `json:"date"`
+}
+
+// Short returns the abbreviated hash
+func (c RepoCommit) Short() string {
+	if len(c.Hash) > 7 {
+		return c.Hash[:7]
+	}
+	return c.Hash
+}
+
+// Label renders the commit as "abc1234 (Jan 12)", adding the year when it isn't the current one
+func (c RepoCommit) Label() string {
+	if c.Hash == "" {
+		return "unknown"
+	}
+	if c.Date.IsZero() {
+		return c.Short()
+	}
+	layout := "Jan 2"
+	if c.Date.Year() != time.Now().Year() {
+		layout = "Jan 2 2006"
+	}
+	return fmt.Sprintf("%s (%s)", c.Short(), c.Date.Format(layout))
+}
+
+// readRepoCommit returns HEAD and its commit date for the checkout in repoDir
+func readRepoCommit(run CommandRunner, repoDir string) (RepoCommit, error) {
+	return readRepoCommitAt(run, repoDir, "HEAD")
+}
+
+// readRepoCommitAt returns the commit rev names in repoDir and its date
+func readRepoCommitAt(run CommandRunner, repoDir, rev string) (RepoCommit, error) {
+	out, err := run("git", "-C", repoDir, "rev-parse", rev)
+	if err != nil {
+		return RepoCommit{}, fmt.Errorf("git rev-parse failed: %s", firstLine(out))
+	}
+	commit := RepoCommit{Hash: strings.TrimSpace(out)}
+
+	out, err = run("git", "-C", repoDir, "show", "-s", "--format=%cI", rev)
+	if err != nil {
+		return commit, fmt.Errorf("git show failed: %s", firstLine(out))
+	}
+	date, err := time.Parse(time.RFC3339, strings.TrimSpace(out))
+	if err != nil {
+		return commit, fmt.Errorf("unexpected commit date %q", strings.TrimSpace(out))
+	}
+	commit.Date = date
+	return commit, nil
+}
+
+// commitsBehind counts the commits in repoDir between from and to
+func commitsBehind(run CommandRunner, repoDir, from, to string) (int, error) {
+	out, err := run("git", "-C", repoDir, "rev-list", "--count", from+".."+to)
+	if err != nil {
+		return 0, fmt.Errorf("git rev-list failed: %s", firstLine(out))
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(out))
+	if err != nil {
+		return 0, fmt.Errorf("unexpected commit count %q", strings.TrimSpace(out))
+	}
+	return n, nil
+}
+
+// describeRepoUpdate renders "installed: abc1234 (Jan 12) → latest: def5678 (Mar 3), 27 commits behind".
+// behind < 0 means the count is unknown (the old commit is no longer in the repo).
+func describeRepoUpdate(installed, latest RepoCommit, behind int) string {
+	s := fmt.Sprintf("installed: %s → latest: %s", installed.Label(), latest.Label())
+	switch {
+	case installed.Hash == latest.Hash:
+		return s + ", up to date"
+	case behind == 1:
+		return s + ", 1 commit behind"
+	case behind >= 0:
+		return fmt.Sprintf("%s, %d commits behind", s, behind)
+	}
+	return s
+}
+
+// repoUpdateLine compares a previously installed commit with the fresh checkout in repoDir
+func repoUpdateLine(run CommandRunner, repoDir string, installed, latest RepoCommit) string {
+	behind := -1
+	if installed.Hash != latest.Hash {
+		if n, err := commitsBehind(run, repoDir, installed.Hash, latest.Hash); err == nil {
+			behind = n
+		}
+	}
+	return describeRepoUpdate(installed, latest, behind)
+}
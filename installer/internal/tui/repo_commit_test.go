@@ -0,0 +1,118 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+// cannedRunner answers commands from a table keyed by the joined argument
+// list; anything not in the table fails like a missing revision would
+func cannedRunner(outputs map[string]string) CommandRunner {
+	return func(name string, args ...string) (string, error) {
+		key := name + " " + strings.Join(args, " ")
+		if out, ok := outputs[key]; ok {
+			return out, nil
+		}
+		return "fatal: bad revision\n", errors.New("exit status 128")
+	}
+}
+
+func TestReadRepoCommit(t *testing.T) {
+	run := cannedRunner(map[string]string{
+		"git -C repo rev-parse HEAD":              "def5678901234567890\n",
+		"git -C repo show -s --format=%cI HEAD":   "2025-03-03T10:15:00+01:00\n",
+		"git -C broken rev-parse HEAD":            "abc1234\n",
+		"git -C broken show -s --format=%cI HEAD": "yesterday\n",
+	})
+
+	commit, err := readRepoCommit(run, "repo")
+	if err != nil {
+		t.Fatalf("readRepoCommit: %v", err)
+	}
+	if commit.Hash != "def5678901234567890" || commit.Short() != "def5678" {
+		t.Errorf("unexpected hash %q (short %q)", commit.Hash, commit.Short())
+	}
+	if commit.Date.Month() != time.March || commit.Date.Day() != 3 {
+		t.Errorf("unexpected date %v", commit.Date)
+	}
+
+	if _, err := readRepoCommit(run, "missing"); err == nil || !strings.Contains(err.Error(), "bad revision") {
+		t.Errorf("expected rev-parse failure to surface git output, got %v", err)
+	}
+	commit, err = readRepoCommit(run, "broken")
+	if err == nil {
+		t.Error("expected an error for an unparseable date")
+	}
+	if commit.Hash != "abc1234" {
+		t.Errorf("hash should be kept when only the date fails, got %q", commit.Hash)
+	}
+}
+
+func TestRepoUpdateLine(t *testing.T) {
+	year := time.Now().Year()
+	installed := RepoCommit{Hash: "abc1234aaaa", Date: time.Date(year, time.January, 12, 0, 0, 0, 0, time.UTC)}
+	latest := RepoCommit{Hash: "def5678bbbb", Date: time.Date(year, time.March, 3, 0, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name      string
+		installed RepoCommit
+		outputs   map[string]string
+		want      string
+	}{
+		{
+			name:      "behind",
+			installed: installed,
+			outputs:   map[string]string{"git -C repo rev-list --count abc1234aaaa..def5678bbbb": "27\n"},
+			want:      "installed: abc1234 (Jan 12) → latest: def5678 (Mar 3), 27 commits behind",
+		},
+		{
+			name:      "one behind",
+			installed: installed,
+			outputs:   map[string]string{"git -C repo rev-list --count abc1234aaaa..def5678bbbb": "1\n"},
+			want:      "installed: abc1234 (Jan 12) → latest: def5678 (Mar 3), 1 commit behind",
+		},
+		{
+			name:      "up to date",
+			installed: latest,
+			want:      "installed: def5678 (Mar 3) → latest: def5678 (Mar 3), up to date",
+		},
+		{
+			name:      "old commit gone",
+			installed: installed,
+			want:      "installed: abc1234 (Jan 12) → latest: def5678 (Mar 3)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := repoUpdateLine(cannedRunner(tt.outputs), "repo", tt.installed, latest)
+			if got != tt.want {
+				t.Errorf("got  %q\nwant %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPendingUpdateLine(t *testing.T) {
+	year := time.Now().Year()
+	run := cannedRunner(map[string]string{
+		"git -C repo rev-parse @{u}":                            "def5678bbbb\n",
+		"git -C repo show -s --format=%cI @{u}":                 fmt.Sprintf("%d-03-03T10:00:00Z\n", year),
+		"git -C repo rev-parse abc1234aaaa":                     "abc1234aaaa\n",
+		"git -C repo show -s --format=%cI abc1234aaaa":          fmt.Sprintf("%d-01-12T10:00:00Z\n", year),
+		"git -C repo rev-list --count abc1234aaaa..def5678bbbb": "27\n",
+	})
+
+	want := "installed: abc1234 (Jan 12) → latest: def5678 (Mar 3), 27 commits behind"
+	if got := pendingUpdateLine(run, "repo", RepoCommit{Hash: "abc1234aaaa"}); got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+	if got := pendingUpdateLine(run, "repo", RepoCommit{}); got != "" {
+		t.Errorf("an unknown install should show nothing, got %q", got)
+	}
+	if got := pendingUpdateLine(run, "no-upstream", RepoCommit{Hash: "abc1234aaaa"}); got != "" {
+		t.Errorf("a checkout without an upstream should show nothing, got %q", got)
+	}
+}
@@ -0,0 +1,185 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// enterRestoreItems lists the configs of the selected backup, all picked, with
+// the cursor on the restore action
+func (m Model) enterRestoreItems() Model {
+	items := m.AvailableBackups[m.SelectedBackup].Items
+	m.RestoreItemSelected = make([]bool, len(items))
+	for i := range m.RestoreItemSelected {
+		m.RestoreItemSelected[i] = true
+	}
+	m.Screen = ScreenRestoreItems
+	m.Cursor = len(items) + 2
+	return m
+}
+
+// restoreItemsOptions lists Select All, one row per backup item, then the
+// action that restores the picked ones and Back
+func (m Model) restoreItemsOptions() []string {
+	if m.SelectedBackup >= len(m.AvailableBackups) {
+		return []string{"← Back"}
+	}
+	opts := []string{"✅ Select All"}
+	for _, item := range m.AvailableBackups[m.SelectedBackup].Items {
+		opts = append(opts, item.Key)
+	}
+	return append(opts, "─────────────", fmt.Sprintf("✅ Restore %d config(s)", len(m.pickedRestoreItems())), "← Back")
+}
+
+// pickedRestoreItems returns the keys of the items toggled on ScreenRestoreItems
+func (m Model) pickedRestoreItems() []string {
+	var keys []string
+	items := m.AvailableBackups[m.SelectedBackup].Items
+	for i, sel := range m.RestoreItemSelected {
+		if sel && i < len(items) {
+			keys = append(keys, items[i].Key)
+		}
+	}
+	return keys
+}
+
+// leaveRestoreConflicts drops the planned restore and returns to the item
+// picks, which are kept
+func (m Model) leaveRestoreConflicts() Model {
+	m.releaseRestoreBackup()
+	m.RestorePlan = nil
+	m.RestoreConflicts = nil
+	m.RestoreChoices = nil
+	if m.RestoreItemSelected == nil {
+		// The backup had no items to pick from
+		m.Screen = ScreenRestoreConfirm
+		m.Cursor = 0
+		return m
+	}
+	m.Screen = ScreenRestoreItems
+	m.Cursor = len(m.RestoreItemSelected) + 2
+	return m
+}
+
+func (m Model) handleRestoreItemsKeys(key string) (tea.Model, tea.Cmd) {
+	options := m.canonicalOptions()
+	count := len(m.RestoreItemSelected)
+	actionIdx := count + 2
+
+	switch key {
+	case "up", "k":
+		if m.Cursor > 0 {
+			m.Cursor--
+			if strings.HasPrefix(options[m.Cursor], "───") && m.Cursor > 0 {
+				m.Cursor--
+			}
+		}
+	case "down", "j":
+		if m.Cursor < len(options)-1 {
+			m.Cursor++
+			if strings.HasPrefix(options[m.Cursor], "───") && m.Cursor < len(options)-1 {
+				m.Cursor++
+			}
+		}
+	case "enter", " ":
+		if !m.multiSelectRuns(key, m.Cursor <= count, actionIdx) {
+			break
+		}
+		switch {
+		case m.Cursor == 0:
+			// Select All turns everything off once everything is on
+			all := !m.allRestoreItemsSelected()
+			for i := range m.RestoreItemSelected {
+				m.RestoreItemSelected[i] = all
+			}
+		case m.Cursor <= count:
+			m.RestoreItemSelected[m.Cursor-1] = !m.RestoreItemSelected[m.Cursor-1]
+		case m.Cursor == actionIdx:
+			keys := m.pickedRestoreItems()
+			if len(keys) == 0 {
+				return m, nil // No-op if nothing selected
+			}
+			return m.planRestore(keys)
+		case m.Cursor == len(options)-1:
+			m.Screen = ScreenRestoreConfirm
+			m.Cursor = 0
+			m.RestoreItemSelected = nil
+		}
+	}
+
+	return m, nil
+}
+
+// allRestoreItemsSelected reports whether every item is toggled on
+func (m Model) allRestoreItemsSelected() bool {
+	for _, sel := range m.RestoreItemSelected {
+		if !sel {
+			return false
+		}
+	}
+	return true
+}
+
+func (m Model) renderRestoreItems() string {
+	var s strings.Builder
+
+	if m.SelectedBackup >= len(m.AvailableBackups) {
+		return m.Theme.Error.Render("No backup selected")
+	}
+	backup := m.AvailableBackups[m.SelectedBackup]
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render("Backup from: " + backup.Timestamp.Format("2006-01-02 15:04:05")))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
+	s.WriteString("\n\n")
+
+	home, _ := os.UserHomeDir()
+	for i, opt := range m.GetCurrentOptions() {
+		if strings.HasPrefix(opt, "───") {
+			s.WriteString(m.Theme.Muted.Render(opt))
+			s.WriteString("\n")
+			continue
+		}
+
+		cursor := "  "
+		style := m.Theme.Unselected
+		if i == m.Cursor {
+			cursor = "▸ "
+			style = m.Theme.Selected
+		}
+
+		if i == 0 || i > len(backup.Items) {
+			m.VisibleRows.mark(&s, i)
+			s.WriteString(m.renderRow(style, cursor+opt))
+			s.WriteString("\n")
+			continue
+		}
+
+		checkbox := "[ ] "
+		if i-1 < len(m.RestoreItemSelected) && m.RestoreItemSelected[i-1] {
+			checkbox = "[✓] "
+		}
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+checkbox+opt))
+		s.WriteString("\n")
+		note := backup.Items[i-1].LivePath
+		if home != "" && strings.HasPrefix(note, home) {
+			note = "~" + strings.TrimPrefix(note, home)
+		}
+		if _, err := os.Stat(backup.Items[i-1].LivePath); err != nil {
+			note += " (missing now)"
+		}
+		s.WriteString(m.Theme.Muted.Render("      " + note))
+		s.WriteString("\n")
+	}
+
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render("↑/k up • ↓/j down • [Space] toggle • [Enter] confirm • [Esc] back"))
+
+	return s.String()
+}
@@ -0,0 +1,147 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestRestoreItemsPartialRestore(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	zshrc := filepath.Join(home, ".zshrc")
+	tmux := filepath.Join(home, ".tmux.conf")
+	os.WriteFile(zshrc, []byte("user zshrc"), 0644)
+	os.WriteFile(tmux, []byte("user tmux"), 0644)
+	backupDir, err := system.CreateBackup([]string{"zsh", "tmux"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.WriteFile(zshrc, []byte("gentleman zshrc"), 0644)
+	os.WriteFile(tmux, []byte("gentleman tmux"), 0644)
+	if err := system.RecordDeployedHashes(backupDir); err != nil {
+		t.Fatal(err)
+	}
+
+	m := NewModel()
+	m.AvailableBackups = system.ListBackups()
+	m.Screen = ScreenRestoreConfirm
+	result, _ := m.handleRestoreConfirmKeys("enter")
+	m = result.(Model)
+
+	if m.Screen != ScreenRestoreItems {
+		t.Fatalf("expected ScreenRestoreItems, got %d", m.Screen)
+	}
+	options := m.GetCurrentOptions()
+	if len(m.RestoreItemSelected) != 2 || !m.allRestoreItemsSelected() {
+		t.Fatalf("every item should start picked, got %v", m.RestoreItemSelected)
+	}
+	if !strings.HasPrefix(options[m.Cursor], "✅ Restore 2") {
+		t.Errorf("the cursor should start on the restore action, got %q", options[m.Cursor])
+	}
+	view := ansi.Strip(m.renderRestoreItems())
+	if !strings.Contains(view, "~/.tmux.conf") {
+		t.Errorf("items should show where they're restored to:\n%s", view)
+	}
+
+	// Select All clears everything, and restoring nothing is a no-op
+	m.Cursor = 0
+	result, _ = m.handleRestoreItemsKeys(" ")
+	m = result.(Model)
+	if len(m.pickedRestoreItems()) != 0 {
+		t.Fatalf("Select All should clear the picks once all are on, got %v", m.pickedRestoreItems())
+	}
+	m.Cursor = 4
+	result, _ = m.handleRestoreItemsKeys("enter")
+	if m = result.(Model); m.Screen != ScreenRestoreItems {
+		t.Fatal("restoring nothing should stay on the screen")
+	}
+
+	// Pick tmux only (items are sorted by key): enter on its row moves to the action, space toggles it
+	m.Cursor = 1
+	result, _ = m.handleRestoreItemsKeys("enter")
+	if m = result.(Model); m.Cursor != 4 || len(m.pickedRestoreItems()) != 0 {
+		t.Fatalf("enter on an item should move to the action, got cursor %d", m.Cursor)
+	}
+	m.Cursor = 1
+	result, _ = m.handleRestoreItemsKeys(" ")
+	m = result.(Model)
+	if got := m.pickedRestoreItems(); len(got) != 1 || got[0] != "tmux" {
+		t.Fatalf("expected only tmux picked, got %v", got)
+	}
+	m.Cursor = 4
+	result, _ = m.handleRestoreItemsKeys("enter")
+	m = result.(Model)
+
+	if m.Screen != ScreenComplete {
+		t.Fatalf("expected ScreenComplete, got %d", m.Screen)
+	}
+	if got, _ := os.ReadFile(tmux); string(got) != "user tmux" {
+		t.Errorf("tmux should be restored, got %q", got)
+	}
+	if got, _ := os.ReadFile(zshrc); string(got) != "gentleman zshrc" {
+		t.Errorf("zsh wasn't picked and must be left alone, got %q", got)
+	}
+}
+
+func TestRestoreCompressedBackup(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	tmux := filepath.Join(home, ".tmux.conf")
+	os.WriteFile(tmux, []byte("user tmux"), 0644)
+	archive, err := system.CreateBackupArchive([]string{"tmux"}, system.BackupOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.WriteFile(tmux, []byte("gentleman tmux"), 0644)
+	if err := system.RecordDeployedHashes(archive); err != nil {
+		t.Fatal(err)
+	}
+
+	m := NewModel()
+	m.AvailableBackups = system.ListBackups()
+	m.Screen = ScreenRestoreConfirm
+	if view := ansi.Strip(m.renderRestoreConfirm()); !strings.Contains(view, "compressed archive") {
+		t.Errorf("the confirm screen should show the format:\n%s", view)
+	}
+
+	result, _ := m.handleRestoreConfirmKeys("enter")
+	m = result.(Model)
+	result, _ = m.handleRestoreItemsKeys("enter")
+	m = result.(Model)
+	if m.Screen != ScreenComplete {
+		t.Fatalf("expected ScreenComplete, got %d (%s)", m.Screen, m.ErrorMsg)
+	}
+	if got, _ := os.ReadFile(tmux); string(got) != "user tmux" {
+		t.Errorf("tmux should be restored from the archive, got %q", got)
+	}
+	if m.RestoreCleanup != nil {
+		t.Error("the extracted copy should be cleaned up")
+	}
+	if _, err := os.Stat(archive); err != nil {
+		t.Errorf("restoring must keep the archive: %v", err)
+	}
+}
+
+func TestRestoreItemsBack(t *testing.T) {
+	m := NewModel()
+	m.AvailableBackups = []system.BackupInfo{{Path: "/backup", Items: []system.BackupItem{{Key: "nvim"}}}}
+	m = m.enterRestoreItems()
+
+	for _, leave := range []func(Model) Model{
+		func(m Model) Model { r, _ := m.handleEscape(); return r.(Model) },
+		func(m Model) Model {
+			m.Cursor = len(m.GetCurrentOptions()) - 1
+			r, _ := m.handleRestoreItemsKeys("enter")
+			return r.(Model)
+		},
+	} {
+		if got := leave(m); got.Screen != ScreenRestoreConfirm || got.RestoreItemSelected != nil {
+			t.Errorf("expected ScreenRestoreConfirm with the picks dropped, got %d", got.Screen)
+		}
+	}
+}
@@ -0,0 +1,346 @@
+package tui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// installerSettingsVersion is the layout of installer-settings.json. Version 2
+// renamed the "gentleman" theme to "gentleman-dark".
+const installerSettingsVersion = 2
+
+// installerSettings are the UI preferences kept between runs, edited on
+// ScreenSettings and saved when the installer quits
+type installerSettings struct {
+	Version     int    `json:"version"`
+	ShowHidden  bool   `json:"show_hidden"`  // The folder browser starts with dotfiles shown
+	SkipWelcome bool   `json:"skip_welcome"` // Start on the main menu
+	Theme       string `json:"theme"`        // One of themePalettes
+	VerboseLogs bool   `json:"verbose_logs"` // Show the step details while installing
+	Language    string `json:"language"`     // One of languageNames
+	Icons       string `json:"icons"`        // One of iconModes; "" follows the terminal
+	Notify      bool   `json:"notify"`       // Notify when an install, or a long project init or catalog update, ends
+}
+
+func defaultSettings() installerSettings {
+	return installerSettings{Version: installerSettingsVersion, Theme: defaultTheme, Language: langEnglish, Notify: true}
+}
+
+func settingsPath(home string) string {
+	return filepath.Join(paths.DataDir(home), "installer-settings.json")
+}
+
+// migrateSettings brings settings read from disk up to this installer's
+// layout. Files from before the version field get defaults for whatever they
+// leave out, and version 1 theme names are renamed; an unknown theme,
+// language or icon set falls back to the default. A file written by a newer installer keeps its version,
+// so saving won't downgrade it.
+func migrateSettings(s installerSettings) installerSettings {
+	if s.Version < 2 && s.Theme == "gentleman" {
+		s.Theme = defaultTheme
+	}
+	if s.Version < installerSettingsVersion {
+		s.Version = installerSettingsVersion
+	}
+	if !slices.Contains(themeNames(), s.Theme) {
+		s.Theme = defaultTheme
+	}
+	if !slices.Contains(languageCodes(), s.Language) {
+		s.Language = langEnglish
+	}
+	if !slices.Contains(iconModes, s.Icons) {
+		s.Icons = iconsAuto
+	}
+	return s
+}
+
+// loadSettings reads the settings under home. A missing file gives the
+// defaults; so does a corrupt one, along with the error.
+func loadSettings(home string) (installerSettings, error) {
+	data, err := os.ReadFile(settingsPath(home))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return defaultSettings(), nil
+		}
+		return defaultSettings(), err
+	}
+	settings := defaultSettings()
+	settings.Version = 0
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return defaultSettings(), fmt.Errorf("invalid settings file: %w", err)
+	}
+	return migrateSettings(settings), nil
+}
+
+// saveSettings writes s under home. A file from a newer installer is left alone.
+func saveSettings(home string, s installerSettings) error {
+	if s.Version > installerSettingsVersion {
+		return fmt.Errorf("settings version %d is newer than this installer supports (%d); not overwritten", s.Version, installerSettingsVersion)
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := settingsPath(home)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// SaveSettings stores the model's settings for the next run. While another
+// instance holds the lock nothing is written: the changes last until quitting.
+func (m Model) SaveSettings() error {
+	if m.readOnly() {
+		return nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return err
+	}
+	return saveSettings(home, m.Settings)
+}
+
+// applySettings sets up the model from s: the screen it starts on, the
+// browser and install log defaults, the theme, the language and the icons
+func (m Model) applySettings(s installerSettings) Model {
+	m.Settings = s
+	m.FileBrowserShowHidden = s.ShowHidden
+	m.ShowDetails = s.VerboseLogs
+	if s.SkipWelcome && m.Screen == ScreenWelcome {
+		m.Screen = ScreenMainMenu
+	}
+	m.Theme = activeTheme(s.Theme)
+	m.Lang = activeLanguage(s.Language)
+	m.ASCIIIcons = useASCIIIcons(s.Icons, os.Getenv)
+	return m
+}
+
+// The setting rows after the three checkboxes; settingsItemCount is the
+// number of rows before the separator
+const (
+	settingsThemeRow = iota + 3
+	settingsLanguageRow
+	settingsIconsRow
+	settingsNotifyRow
+	settingsItemCount
+)
+
+// settingsOptions lists a row per setting, then Back
+func (m Model) settingsOptions() []string {
+	check := func(on bool) string {
+		if on {
+			return "[✓] "
+		}
+		return "[ ] "
+	}
+	return []string{
+		check(m.Settings.ShowHidden) + m.T("settings.show_hidden"),
+		check(m.Settings.SkipWelcome) + m.T("settings.skip_welcome"),
+		check(m.Settings.VerboseLogs) + m.T("settings.verbose_logs"),
+		m.Tf("settings.theme", m.Settings.Theme) + m.themeOverride(),
+		m.Tf("settings.language", languageName(m.Settings.Language)) + m.languageOverride(),
+		m.Tf("settings.icons", iconModeName(m.Settings.Icons)) + m.iconsDetected(),
+		check(m.Settings.Notify) + m.T("settings.notify"),
+		"─────────────",
+		m.T("common.back"),
+	}
+}
+
+// leaveSettings returns to the main menu with the cursor on Settings
+func (m Model) leaveSettings() Model {
+	m.Screen = ScreenMainMenu
+	m.Cursor = 0
+	for i, opt := range m.canonicalOptions() {
+		if strings.Contains(opt, "Settings") {
+			m.Cursor = i
+		}
+	}
+	return m
+}
+
+// themeOverride notes that NO_COLOR keeps the picked theme from showing
+func (m Model) themeOverride() string {
+	if m.Theme.Name != m.Settings.Theme {
+		return m.T("settings.no_color")
+	}
+	return ""
+}
+
+// languageOverride notes that GENTLEMAN_LANG keeps the picked language from showing
+func (m Model) languageOverride() string {
+	if m.Lang != m.Settings.Language {
+		return m.T("settings.lang_env")
+	}
+	return ""
+}
+
+// iconsDetected notes which icons auto picked, and why it fell back to ASCII
+func (m Model) iconsDetected() string {
+	if m.Settings.Icons != iconsAuto {
+		return ""
+	}
+	if lacks, reason := terminalLacksEmoji(os.Getenv); lacks {
+		return m.Tf("settings.icons_ascii", reason)
+	}
+	return m.T("settings.icons_emoji")
+}
+
+// autoASCIINotice is the line the first screen shows when auto switched the
+// icons to ASCII, so the plainer UI doesn't look broken, or "" otherwise
+func (m Model) autoASCIINotice() string {
+	if m.Settings.Icons != iconsAuto {
+		return ""
+	}
+	if lacks, reason := terminalLacksEmoji(os.Getenv); lacks {
+		return m.Tf("startup.icons_ascii", reason)
+	}
+	return ""
+}
+
+// stepTheme moves the theme by delta through themePalettes, wrapping
+// around, and restyles right away
+func (m Model) stepTheme(delta int) Model {
+	names := themeNames()
+	next := (slices.Index(names, m.Settings.Theme) + delta + len(names)) % len(names)
+	m.Settings.Theme = names[next]
+	m.Theme = activeTheme(m.Settings.Theme)
+	return m
+}
+
+// stepLanguage moves the language by delta through languageNames, wrapping
+// around, and switches the UI text right away
+func (m Model) stepLanguage(delta int) Model {
+	codes := languageCodes()
+	next := (slices.Index(codes, m.Settings.Language) + delta + len(codes)) % len(codes)
+	m.Settings.Language = codes[next]
+	m.Lang = activeLanguage(m.Settings.Language)
+	return m
+}
+
+// stepIcons moves the icon set by delta through iconModes, wrapping around,
+// and redraws right away
+func (m Model) stepIcons(delta int) Model {
+	next := (slices.Index(iconModes, m.Settings.Icons) + delta + len(iconModes)) % len(iconModes)
+	m.Settings.Icons = iconModes[next]
+	m.ASCIIIcons = useASCIIIcons(m.Settings.Icons, os.Getenv)
+	return m
+}
+
+// toggleSetting flips the setting on row i, or steps to the next theme,
+// language or icon set, and applies it right away
+func (m Model) toggleSetting(i int) Model {
+	switch i {
+	case 0:
+		m.Settings.ShowHidden = !m.Settings.ShowHidden
+		m.FileBrowserShowHidden = m.Settings.ShowHidden
+	case 1:
+		m.Settings.SkipWelcome = !m.Settings.SkipWelcome
+	case 2:
+		m.Settings.VerboseLogs = !m.Settings.VerboseLogs
+		m.ShowDetails = m.Settings.VerboseLogs
+	case settingsThemeRow:
+		m = m.stepTheme(1)
+	case settingsLanguageRow:
+		m = m.stepLanguage(1)
+	case settingsIconsRow:
+		m = m.stepIcons(1)
+	case settingsNotifyRow:
+		m.Settings.Notify = !m.Settings.Notify
+	}
+	return m
+}
+
+func (m Model) handleSettingsKeys(key string) (tea.Model, tea.Cmd) {
+	options := m.canonicalOptions()
+	switch key {
+	case "up", "k":
+		if m.Cursor > 0 {
+			m.Cursor--
+			if strings.HasPrefix(options[m.Cursor], "───") && m.Cursor > 0 {
+				m.Cursor--
+			}
+		}
+	case "down", "j":
+		if m.Cursor < len(options)-1 {
+			m.Cursor++
+			if strings.HasPrefix(options[m.Cursor], "───") && m.Cursor < len(options)-1 {
+				m.Cursor++
+			}
+		}
+	case "left", "h", "right", "l":
+		delta := 1
+		if key == "left" || key == "h" {
+			delta = -1
+		}
+		switch m.Cursor {
+		case settingsThemeRow:
+			return m.stepTheme(delta), nil
+		case settingsLanguageRow:
+			return m.stepLanguage(delta), nil
+		case settingsIconsRow:
+			return m.stepIcons(delta), nil
+		}
+	case "enter", " ":
+		if !m.multiSelectRuns(key, m.Cursor < settingsItemCount, -1) {
+			break
+		}
+		if m.Cursor < settingsItemCount {
+			return m.toggleSetting(m.Cursor), nil
+		}
+		return m.leaveSettings(), nil
+	case "backspace":
+		return m.leaveSettings(), nil
+	}
+	return m, nil
+}
+
+func (m Model) renderSettings() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
+	s.WriteString("\n\n")
+	for i, opt := range m.GetCurrentOptions() {
+		if strings.HasPrefix(opt, "───") {
+			s.WriteString(m.Theme.Muted.Render(opt))
+			s.WriteString("\n")
+			continue
+		}
+		cursor := "  "
+		style := m.Theme.Unselected
+		if i == m.Cursor {
+			cursor = "▸ "
+			style = m.Theme.Selected
+		}
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
+		s.WriteString("\n")
+	}
+	s.WriteString("\n")
+	s.WriteString(m.renderThemePreview())
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render(m.T("settings.help")))
+
+	return s.String()
+}
+
+// renderThemePreview shows a sample of each kind of text in the current theme
+func (m Model) renderThemePreview() string {
+	t := m.Theme
+	lines := []string{
+		t.Subtitle.Render(m.Tf("settings.preview", t.Name)),
+		t.Selected.UnsetPaddingLeft().Render("▸ "+m.T("settings.preview_selected")) + "  " + t.Unselected.UnsetPaddingLeft().Render(m.T("settings.preview_unselected")),
+		t.Success.Render("✓ "+m.T("settings.preview_done")) + "  " + t.Warning.Render("⚠ "+m.T("settings.preview_warning")) + "  " + t.Error.Render("✗ "+m.T("settings.preview_failed")) + "  " + t.Info.Render("ℹ "+m.T("settings.preview_info")),
+		t.Key.Render("<leader>ff") + " " + t.Muted.Render(m.T("settings.preview_find_files")) + "  " + t.Code.Render("git clone") + "  " + t.FooterKey.Render("enter") + " " + t.FooterText.Render(m.T("settings.preview_select")),
+	}
+	return t.Box.Render(strings.Join(lines, "\n"))
+}
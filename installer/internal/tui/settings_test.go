@@ -0,0 +1,195 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func writeSettingsFile(t *testing.T, home, content string) {
+	t.Helper()
+	os.MkdirAll(filepath.Dir(settingsPath(home)), 0755)
+	if err := os.WriteFile(settingsPath(home), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSettingsRoundTrip(t *testing.T) {
+	home := t.TempDir()
+	settings, err := loadSettings(home)
+	if err != nil || settings != defaultSettings() {
+		t.Fatalf("a missing file should give the defaults, got %+v (%v)", settings, err)
+	}
+
+	want := installerSettings{Version: installerSettingsVersion, ShowHidden: true, SkipWelcome: true, Theme: "mono", VerboseLogs: true, Language: langSpanish}
+	if err := saveSettings(home, want); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := loadSettings(home); err != nil || got != want {
+		t.Errorf("loadSettings = %+v (%v), want %+v", got, err, want)
+	}
+}
+
+func TestSettingsCorruptFile(t *testing.T) {
+	home := t.TempDir()
+	writeSettingsFile(t, home, "{not json")
+	settings, err := loadSettings(home)
+	if err == nil {
+		t.Error("a corrupt file should be reported")
+	}
+	if settings != defaultSettings() {
+		t.Errorf("a corrupt file should fall back to the defaults, got %+v", settings)
+	}
+}
+
+func TestSettingsMigration(t *testing.T) {
+	t.Run("file without a version", func(t *testing.T) {
+		home := t.TempDir()
+		writeSettingsFile(t, home, `{"skip_welcome": true}`)
+		settings, err := loadSettings(home)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := defaultSettings()
+		want.SkipWelcome = true
+		if settings != want {
+			t.Errorf("missing fields should get the defaults, got %+v", settings)
+		}
+	})
+
+	t.Run("version 1 theme name", func(t *testing.T) {
+		home := t.TempDir()
+		writeSettingsFile(t, home, `{"version": 1, "theme": "gentleman"}`)
+		settings, _ := loadSettings(home)
+		if settings.Theme != "gentleman-dark" || settings.Version != installerSettingsVersion {
+			t.Errorf("the theme should be renamed, got %+v", settings)
+		}
+	})
+
+	t.Run("unknown theme", func(t *testing.T) {
+		home := t.TempDir()
+		writeSettingsFile(t, home, `{"version": 2, "theme": "neon", "show_hidden": true}`)
+		settings, _ := loadSettings(home)
+		if settings.Theme != defaultTheme || !settings.ShowHidden {
+			t.Errorf("an unknown theme should fall back and keep the rest, got %+v", settings)
+		}
+	})
+
+	t.Run("newer installer", func(t *testing.T) {
+		home := t.TempDir()
+		writeSettingsFile(t, home, `{"version": 9, "verbose_logs": true, "font_size": 3}`)
+		settings, err := loadSettings(home)
+		if err != nil || settings.Version != 9 || !settings.VerboseLogs {
+			t.Fatalf("the known fields should be read, got %+v (%v)", settings, err)
+		}
+		if err := saveSettings(home, settings); err == nil {
+			t.Error("a newer file should not be overwritten")
+		}
+		if data, _ := os.ReadFile(settingsPath(home)); !strings.Contains(string(data), "font_size") {
+			t.Error("the newer file should be left as it was")
+		}
+	})
+}
+
+func TestNewModelAppliesSettings(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	writeSettingsFile(t, home, `{"version": 1, "skip_welcome": true, "show_hidden": true, "verbose_logs": true}`)
+
+	m := NewModel()
+	if m.Screen != ScreenMainMenu || !m.FileBrowserShowHidden || !m.ShowDetails {
+		t.Errorf("the settings should be applied, got screen %v hidden %v details %v", m.Screen, m.FileBrowserShowHidden, m.ShowDetails)
+	}
+
+	// An unfinished install is still offered on the welcome screen
+	if err := saveInstallState(home, installState{Steps: []savedStep{{ID: "clone", Status: StatusDone}, {ID: "shell", Status: StatusPending}}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.EnableResume(home); err != nil {
+		t.Fatal(err)
+	}
+	if m.ResumeState == nil || m.Screen != ScreenWelcome {
+		t.Errorf("a resumable install should show the welcome screen, got %v", m.Screen)
+	}
+}
+
+func TestSettingsScreen(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("NO_COLOR", "")
+	press := func(m Model, key tea.KeyMsg) Model {
+		result, _ := m.Update(key)
+		return result.(Model)
+	}
+	space := tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}
+	down := tea.KeyMsg{Type: tea.KeyDown}
+
+	m := NewModel()
+	m.Screen = ScreenMainMenu
+	m = selectMainMenu(t, m, "Settings")
+	if m.Screen != ScreenSettings {
+		t.Fatalf("the main menu should open the settings, got %v", m.Screen)
+	}
+
+	m = press(m, space)
+	if !m.Settings.ShowHidden || !m.FileBrowserShowHidden {
+		t.Error("space should toggle showing hidden folders")
+	}
+	m = press(press(m, down), tea.KeyMsg{Type: tea.KeyEnter})
+	if !m.Settings.SkipWelcome || !strings.HasPrefix(m.GetCurrentOptions()[1], "[✓]") {
+		t.Error("enter should toggle skipping the welcome screen")
+	}
+	m = press(press(m, down), space)
+	if !m.Settings.VerboseLogs || !m.ShowDetails {
+		t.Error("space should toggle the install details")
+	}
+	m = press(press(m, down), space)
+	if m.Settings.Theme != "light" || m.Theme.Name != "light" {
+		t.Errorf("the theme should switch to light right away, got %s", m.Settings.Theme)
+	}
+	m = press(m, tea.KeyMsg{Type: tea.KeyLeft})
+	if m.Settings.Theme != defaultTheme || !strings.Contains(m.View(), "Preview: "+defaultTheme) {
+		t.Errorf("left should step back to the default theme and preview it, got %s", m.Settings.Theme)
+	}
+	m = press(m, tea.KeyMsg{Type: tea.KeyLeft})
+	if m.Settings.Theme != "mono" {
+		t.Errorf("left should wrap around to the last theme, got %s", m.Settings.Theme)
+	}
+
+	m = press(m, tea.KeyMsg{Type: tea.KeyEsc})
+	if m.Screen != ScreenMainMenu || !strings.Contains(m.GetCurrentOptions()[m.Cursor], "Settings") {
+		t.Errorf("esc should return to the Settings entry, got %v", m.Screen)
+	}
+
+	if err := m.SaveSettings(); err != nil {
+		t.Fatal(err)
+	}
+	if settings, _ := loadSettings(home); settings != m.Settings {
+		t.Errorf("saved %+v, want %+v", settings, m.Settings)
+	}
+}
+
+func TestStatePathsFollowPortableMode(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	state := filepath.Join(t.TempDir(), "state")
+	t.Setenv(paths.PortableEnv, state)
+
+	m := NewModel()
+	for screen, want := range map[Screen]string{
+		ScreenSettings:      filepath.Join(state, "gentleman", "installer-settings.json"),
+		ScreenProfileSelect: filepath.Join(state, "gentleman", "profiles"),
+	} {
+		m.Screen = screen
+		if desc := m.GetScreenDescription(); !strings.Contains(desc, want) || strings.Contains(desc, "~/.gentleman") {
+			t.Errorf("screen %v should name %s, got %q", screen, want, desc)
+		}
+	}
+	m.Screen = ScreenInstallRefs
+	if view := m.renderInstallRefs(); !strings.Contains(view, filepath.Join(state, "gentleman", "install-manifest.json")) {
+		t.Errorf("the refs screen should name the portable manifest:\n%s", view)
+	}
+}
@@ -0,0 +1,146 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// skillCatalogChanges is what an "Update Catalog" pull changed for the skills
+// linked on this machine
+type skillCatalogChanges struct {
+	From, To string           // Catalog HEAD before and after the pull
+	Updated  []string         // Linked skills whose directory changed, by link name
+	Orphaned []skillLinkEntry // Links into the catalog whose skill is gone
+	// ExternalFailed are the external clones that couldn't be pulled
+	ExternalFailed []SkillSummaryEntry
+}
+
+// changedSkillDirs maps the paths git diff reports to the catalog skill
+// directories they belong to ("curated/react-19"), each listed once
+func changedSkillDirs(files []string) []string {
+	var dirs []string
+	for _, f := range files {
+		parts := strings.Split(filepath.ToSlash(f), "/")
+		if len(parts) < 3 || (parts[0] != "curated" && parts[0] != "community") {
+			continue
+		}
+		dir := parts[0] + "/" + parts[1]
+		if !slices.Contains(dirs, dir) {
+			dirs = append(dirs, dir)
+		}
+	}
+	return dirs
+}
+
+// diffSkillCatalog compares the skill links under home with the catalog
+// commits from..to: which linked skills changed and which links lost their skill.
+// alsoChanged are skill directories changed outside the catalog's history,
+// such as pulled external clones.
+func diffSkillCatalog(run CommandRunner, home, from, to string, alsoChanged ...string) (*skillCatalogChanges, error) {
+	changes := &skillCatalogChanges{From: from, To: to}
+	catalog := paths.SkillsDir(home)
+	var changed []string
+	if from != to {
+		out, err := run("git", "-C", catalog, "diff", "--name-only", from, to)
+		if err != nil {
+			return nil, fmt.Errorf("git diff failed: %s", firstLine(out))
+		}
+		changed = changedSkillDirs(strings.Split(strings.TrimSpace(out), "\n"))
+	}
+	changed = append(changed, alsoChanged...)
+	for _, link := range installerSkillLinks(home) {
+		if link.State == skillLinkDangling {
+			changes.Orphaned = append(changes.Orphaned, link)
+			continue
+		}
+		rel, err := filepath.Rel(catalog, link.Target)
+		if err != nil {
+			continue
+		}
+		name := filepath.Base(link.Path)
+		if slices.Contains(changed, filepath.ToSlash(rel)) && !slices.Contains(changes.Updated, name) {
+			changes.Updated = append(changes.Updated, name)
+		}
+	}
+	return changes, nil
+}
+
+// updateSkillCatalog pulls the catalog under home, keeping it on the ref the
+// manifest pins it to, then the external clones in it, and reports what the
+// pulls changed for the linked skills
+func updateSkillCatalog(run CommandRunner, home string, log func(string)) (*skillCatalogChanges, error) {
+	catalog := paths.SkillsDir(home)
+	before, _ := run("git", "-C", catalog, "rev-parse", "HEAD")
+	var ref string
+	if manifest, _ := loadInstallManifest(home); manifest != nil {
+		ref = manifest.Repos[manifestSkills].Ref
+	}
+	if err := pullSkillCatalog(context.Background(), home, ref, log); err != nil {
+		return nil, err
+	}
+	external, failed := pullExternalSkills(run, home)
+	after, err := run("git", "-C", catalog, "rev-parse", "HEAD")
+	var changes *skillCatalogChanges
+	if err != nil || strings.TrimSpace(before) == "" {
+		// Nothing to compare against; still check for orphaned links
+		changes, err = diffSkillCatalog(run, home, "", "", external...)
+	} else {
+		changes, err = diffSkillCatalog(run, home, strings.TrimSpace(before), strings.TrimSpace(after), external...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	changes.ExternalFailed = failed
+	return changes, nil
+}
+
+// namePreview joins up to max names, ending in "…" when there are more
+func namePreview(names []string, max int) string {
+	if len(names) <= max {
+		return strings.Join(names, ", ")
+	}
+	return strings.Join(names[:max], ", ") + ", …"
+}
+
+// logLines summarizes the changes for the skill result screen
+func (c *skillCatalogChanges) logLines(home string) []string {
+	lines := []string{"✅ Catalog updated successfully"}
+	if c == nil {
+		return lines
+	}
+	switch {
+	case c.From != "" && c.From == c.To && len(c.Updated) == 0:
+		lines = append(lines, "Already up to date")
+	case len(c.Updated) == 1:
+		lines = append(lines, "🔄 1 installed skill updated: "+c.Updated[0])
+	case len(c.Updated) > 1:
+		lines = append(lines, fmt.Sprintf("🔄 %d installed skills updated: %s", len(c.Updated), namePreview(c.Updated, 5)))
+	case c.From != "":
+		lines = append(lines, "None of your installed skills changed")
+	}
+	for _, f := range c.ExternalFailed {
+		lines = append(lines, fmt.Sprintf("⚠️  %s: git pull failed: %s", f.Name, f.Reason))
+	}
+	for _, link := range c.Orphaned {
+		lines = append(lines, fmt.Sprintf("⚠️  %s is orphaned: its skill was removed from the catalog", contractHome(link.Path)))
+	}
+	return lines
+}
+
+// removeOrphanedSkillsCmd removes the links the last catalog update orphaned
+func removeOrphanedSkillsCmd(orphaned []skillLinkEntry) tea.Cmd {
+	return func() tea.Msg {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return skillActionCompleteMsg{err: fmt.Errorf("cannot determine home directory: %w", err)}
+		}
+		return skillActionCompleteMsg{logLines: repairSkillLinks(home, orphaned, nil), undo: currentSkillUndo()}
+	}
+}
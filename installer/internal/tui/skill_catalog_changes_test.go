@@ -0,0 +1,154 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestChangedSkillDirs(t *testing.T) {
+	got := changedSkillDirs([]string{
+		"README.md",
+		"curated/react-19/SKILL.md",
+		"curated/react-19/examples/hooks.md",
+		"community/chi-router/SKILL.md",
+		"curated/index.json",
+		"plugins/foo/PLUGIN.md",
+	})
+	if want := []string{"curated/react-19", "community/chi-router"}; !slices.Equal(got, want) {
+		t.Errorf("changedSkillDirs = %v, want %v", got, want)
+	}
+}
+
+// writeSkill writes a minimal SKILL.md under dir/rel
+func writeSkill(t *testing.T, dir, rel, body string) {
+	t.Helper()
+	os.MkdirAll(filepath.Join(dir, rel), 0755)
+	content := "---\nname: " + filepath.Base(rel) + "\ndescription: test\n---\n" + body
+	if err := os.WriteFile(filepath.Join(dir, rel, "SKILL.md"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUpdateSkillCatalogChanges(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	upstream := t.TempDir()
+	gitRun(t, upstream, "init", "--quiet")
+	writeSkill(t, upstream, "curated/react-19", "v1")
+	writeSkill(t, upstream, "curated/go-testing", "v1")
+	writeSkill(t, upstream, "community/old-skill", "v1")
+	gitRun(t, upstream, "add", "-A")
+	gitRun(t, upstream, "commit", "--quiet", "-m", "one")
+
+	catalog := paths.SkillsDir(home)
+	os.MkdirAll(filepath.Dir(catalog), 0755)
+	gitRun(t, upstream, "clone", "--quiet", upstream, catalog)
+	for _, dir := range skillLinkDirs(home) {
+		os.MkdirAll(dir, 0755)
+		for _, rel := range []string{"curated/react-19", "curated/go-testing", "community/old-skill"} {
+			if err := os.Symlink(filepath.Join(catalog, rel), filepath.Join(dir, filepath.Base(rel))); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	// Upstream changes one linked skill and removes another
+	writeSkill(t, upstream, "curated/react-19", "v2")
+	os.RemoveAll(filepath.Join(upstream, "community", "old-skill"))
+	os.WriteFile(filepath.Join(upstream, "README.md"), []byte("catalog"), 0644)
+	gitRun(t, upstream, "add", "-A")
+	gitRun(t, upstream, "commit", "--quiet", "-m", "two")
+
+	changes, err := updateSkillCatalog(execRunner, home, func(string) {})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changes.From == changes.To {
+		t.Fatal("the pull should have moved the catalog")
+	}
+	if !slices.Equal(changes.Updated, []string{"react-19"}) {
+		t.Errorf("expected react-19 updated once, got %v", changes.Updated)
+	}
+	if len(changes.Orphaned) != 2 || filepath.Base(changes.Orphaned[0].Path) != "old-skill" {
+		t.Errorf("expected old-skill orphaned in both link dirs, got %+v", changes.Orphaned)
+	}
+	lines := strings.Join(changes.logLines(home), "\n")
+	if !strings.Contains(lines, "1 installed skill updated: react-19") || !strings.Contains(lines, "old-skill is orphaned") {
+		t.Errorf("unexpected summary:\n%s", lines)
+	}
+
+	changes, err = updateSkillCatalog(execRunner, home, func(string) {})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lines := changes.logLines(home); !slices.Contains(lines, "Already up to date") {
+		t.Errorf("a second pull should change nothing, got %v", lines)
+	}
+}
+
+// A catalog the manifest pins stays on its ref when Update Catalog runs
+func TestUpdateSkillCatalogKeepsPin(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	upstream := t.TempDir()
+	gitRun(t, upstream, "init", "--quiet")
+	writeSkill(t, upstream, "curated/react-19", "v1")
+	gitRun(t, upstream, "add", "-A")
+	gitRun(t, upstream, "commit", "--quiet", "-m", "one")
+	gitRun(t, upstream, "tag", "v1")
+	catalog := paths.SkillsDir(home)
+	os.MkdirAll(filepath.Dir(catalog), 0755)
+	gitRun(t, upstream, "clone", "--quiet", upstream, catalog)
+	pinned := gitRun(t, catalog, "rev-parse", "HEAD")
+	saveInstallManifest(home, &installManifest{Repos: map[string]manifestRepo{manifestSkills: {Ref: "v1"}}})
+
+	writeSkill(t, upstream, "curated/react-19", "v2")
+	gitRun(t, upstream, "commit", "--quiet", "-am", "two")
+
+	if _, err := updateSkillCatalog(execRunner, home, func(string) {}); err != nil {
+		t.Fatal(err)
+	}
+	if head := gitRun(t, catalog, "rev-parse", "HEAD"); head != pinned {
+		t.Errorf("the pinned catalog should stay on v1 (%s), got %s", pinned, head)
+	}
+}
+
+func TestSkillCatalogChangesPreview(t *testing.T) {
+	c := &skillCatalogChanges{From: "a", To: "b", Updated: []string{"a", "b", "c", "d", "e", "f"}}
+	if lines := c.logLines(""); lines[1] != "🔄 6 installed skills updated: a, b, c, d, e, …" {
+		t.Errorf("unexpected preview %q", lines[1])
+	}
+	c.Updated = nil
+	if lines := c.logLines(""); lines[1] != "None of your installed skills changed" {
+		t.Errorf("unexpected line %q", lines[1])
+	}
+}
+
+func TestSkillResultRemovesOrphans(t *testing.T) {
+	m := NewModel()
+	m.Screen = ScreenSkillUpdate
+	orphan := skillLinkEntry{Path: "/home/u/.claude/skills/old", Target: "/gone", State: skillLinkDangling}
+	result, _ := m.Update(skillUpdateCompleteMsg{changes: &skillCatalogChanges{From: "a", To: "b", Orphaned: []skillLinkEntry{orphan}}})
+	m = result.(Model)
+	if m.Screen != ScreenSkillResult || len(m.SkillOrphans) != 1 {
+		t.Fatalf("expected the result screen with one orphan, got %v %v", m.Screen, m.SkillOrphans)
+	}
+	if !strings.Contains(m.renderSkillResult(), "Press r to remove the orphaned links") {
+		t.Error("the result screen should offer removing the orphans")
+	}
+
+	result, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+	m = result.(Model)
+	if cmd == nil || m.SkillOrphans != nil {
+		t.Error("r should start removing the orphans")
+	}
+	if _, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}}); cmd != nil {
+		t.Error("r does nothing once the orphans are handled")
+	}
+}
@@ -0,0 +1,153 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Skill names that differ only by case ("API-Gateway" vs "api-gateway") map to
+// the same symlink on case-insensitive filesystems like macOS's default APFS.
+// Everything here compares names with strings.EqualFold instead of asking the
+// filesystem, so the behaviour is the same on every platform.
+
+// markSkillCollisions sets CollidesWith on skills whose name case-folds to
+// another skill of the same type
+func markSkillCollisions(skills []SkillInfo) {
+	for i := range skills {
+		skills[i].CollidesWith = ""
+		for j := range skills {
+			if i == j || skills[i].Type != skills[j].Type {
+				continue
+			}
+			if skills[i].Name != skills[j].Name && strings.EqualFold(skills[i].Name, skills[j].Name) {
+				skills[i].CollidesWith = skills[j].Name
+				break
+			}
+		}
+	}
+}
+
+// skillLinkDirs are the directories skills are symlinked into, one per skillTargets entry
+func skillLinkDirs(home string) []string {
+	dirs := make([]string, len(skillTargets))
+	for i, t := range skillTargets {
+		dirs[i] = filepath.Join(home, t.Dir)
+	}
+	return dirs
+}
+
+// foldedEntries returns the paths of entries in dir whose name case-folds to name
+func foldedEntries(dir, name string) []string {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	var paths []string
+	for _, e := range entries {
+		if strings.EqualFold(e.Name(), name) {
+			paths = append(paths, filepath.Join(dir, e.Name()))
+		}
+	}
+	return paths
+}
+
+// sameSkillTarget reports whether link resolves to the skill directory target
+func sameSkillTarget(link, target string) bool {
+	if symlinkPointsTo(link, target) {
+		return true
+	}
+	resolvedLink, err := filepath.EvalSymlinks(link)
+	if err != nil {
+		return false
+	}
+	resolvedTarget, err := filepath.EvalSymlinks(target)
+	return err == nil && resolvedLink == resolvedTarget
+}
+
+// isSkillLinked reports whether one of the skill link dirs has an entry for s
+// that resolves to s.FullPath. Used instead of isSkillInstalled for colliding
+// skills, where a lookup by name would also match the other skill's link.
+func isSkillLinked(home string, s SkillInfo) bool {
+	return len(skillLinkedTargets(home, s)) > 0
+}
+
+// skillLinkedTargets lists the IDs of the link targets where isSkillLinked finds s
+func skillLinkedTargets(home string, s SkillInfo) []string {
+	var ids []string
+	for _, t := range skillTargets {
+		for _, path := range foldedEntries(filepath.Join(home, t.Dir), s.Name) {
+			if sameSkillTarget(path, s.FullPath) {
+				ids = append(ids, t.ID)
+				break
+			}
+		}
+	}
+	return ids
+}
+
+// planSkillInstall splits a batch into skills that can be installed and
+// results for the ones refused because of a case collision, either with
+// another skill in the same batch or with one that is already installed
+func planSkillInstall(home string, skills []SkillInfo) ([]SkillInfo, []SkillResult) {
+	var ok []SkillInfo
+	var refused []SkillResult
+	for i, s := range skills {
+		if s.Type == "plugin" {
+			ok = append(ok, s)
+			continue
+		}
+
+		clash := ""
+		for j, other := range skills {
+			if i != j && other.Type != "plugin" && other.Name != s.Name && strings.EqualFold(other.Name, s.Name) {
+				clash = other.Name
+				break
+			}
+		}
+		if clash != "" {
+			refused = append(refused, SkillResult{
+				Name:   s.Name,
+				Status: SkillFailed,
+				Reason: fmt.Sprintf("name collides with %s on case-insensitive filesystems; install only one of them", clash),
+			})
+			continue
+		}
+
+		if link, target := installedCaseVariant(home, s); link != "" {
+			refused = append(refused, SkillResult{
+				Name:   s.Name,
+				Status: SkillFailed,
+				Reason: fmt.Sprintf("conflicts with installed %s (→ %s); remove it first", link, target),
+			})
+			continue
+		}
+		ok = append(ok, s)
+	}
+	return ok, refused
+}
+
+// installedCaseVariant finds an installed link that would be overwritten by s:
+// one that differs from s.Name only by case, or, for a colliding skill, one
+// with the same name that resolves to the other skill. Returns the link name
+// and its target, or "" if there is none.
+func installedCaseVariant(home string, s SkillInfo) (string, string) {
+	for _, dir := range skillLinkDirs(home) {
+		for _, path := range foldedEntries(dir, s.Name) {
+			name := filepath.Base(path)
+			if name == s.Name && s.CollidesWith == "" {
+				continue // Same skill name: reinstalling replaces it as before
+			}
+			if sameSkillTarget(path, s.FullPath) {
+				continue
+			}
+			target, err := os.Readlink(path)
+			if err != nil {
+				target = path
+			}
+			return name, target
+		}
+	}
+	return "", ""
+}
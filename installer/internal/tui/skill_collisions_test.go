@@ -0,0 +1,156 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeCollidingCatalog creates "API-Gateway" (curated) and "api-gateway"
+// (community) as distinct fixture dirs, which a case-insensitive filesystem
+// would link to the same ~/.claude/skills entry
+func writeCollidingCatalog(t *testing.T, home string) (upper, lower string) {
+	t.Helper()
+	upper = filepath.Join(home, ".gentleman", "skills", "curated", "API-Gateway")
+	lower = filepath.Join(home, ".gentleman", "skills", "community", "api-gateway")
+	for dir, name := range map[string]string{upper: "API-Gateway", lower: "api-gateway"} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		os.WriteFile(filepath.Join(dir, "SKILL.md"), []byte("---\nname: "+name+"\n---\n"), 0644)
+	}
+	return upper, lower
+}
+
+// linkSkill simulates what a case-insensitive filesystem leaves behind: a
+// single entry, spelled name, pointing at target
+func linkSkill(t *testing.T, home, name, target string) {
+	t.Helper()
+	for _, dir := range skillLinkDirs(home) {
+		os.MkdirAll(dir, 0755)
+		if err := os.Symlink(target, filepath.Join(dir, name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func findSkill(skills []SkillInfo, name string) SkillInfo {
+	for _, s := range skills {
+		if s.Name == name {
+			return s
+		}
+	}
+	return SkillInfo{}
+}
+
+func TestMarkSkillCollisions(t *testing.T) {
+	skills := []SkillInfo{
+		{Name: "API-Gateway", Type: "skill"},
+		{Name: "api-gateway", Type: "skill"},
+		{Name: "react-19", Type: "skill"},
+		{Name: "Api-Gateway", Type: "plugin"},
+	}
+	markSkillCollisions(skills)
+
+	want := []string{"api-gateway", "API-Gateway", "", ""}
+	for i, s := range skills {
+		if s.CollidesWith != want[i] {
+			t.Errorf("%s (%s): expected CollidesWith %q, got %q", s.Name, s.Type, want[i], s.CollidesWith)
+		}
+	}
+	if name := skillOptionName(skills[0]); !strings.Contains(name, "case clash with api-gateway") {
+		t.Errorf("expected a warning in the option label, got %q", name)
+	}
+}
+
+func TestFetchSkillCatalogResolvesCollidingInstall(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	_, lower := writeCollidingCatalog(t, home)
+	// Only the community skill is installed, but its link is spelled like the curated one
+	linkSkill(t, home, "API-Gateway", lower)
+
+	skills, _, err := fetchSkillCatalog()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := findSkill(skills, "API-Gateway"); s.Installed || s.CollidesWith != "api-gateway" {
+		t.Errorf("API-Gateway should be flagged and not installed, got %+v", s)
+	}
+	if s := findSkill(skills, "api-gateway"); !s.Installed || s.CollidesWith != "API-Gateway" {
+		t.Errorf("api-gateway should be flagged and installed, got %+v", s)
+	}
+}
+
+func TestInstallRefusesCollidingSkills(t *testing.T) {
+	t.Run("both in one batch", func(t *testing.T) {
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+		upper, lower := writeCollidingCatalog(t, home)
+		skills := []SkillInfo{
+			{Name: "API-Gateway", FullPath: upper, Type: "skill", CollidesWith: "api-gateway"},
+			{Name: "api-gateway", FullPath: lower, Type: "skill", CollidesWith: "API-Gateway"},
+		}
+
+		results, _, err := installSkills(skills, SkillLinkOptions{})
+		if err == nil {
+			t.Error("expected an error when installing both colliding skills")
+		}
+		for _, r := range results {
+			if r.Status != SkillFailed || !strings.Contains(r.Reason, "case-insensitive") {
+				t.Errorf("expected %s to be refused, got %+v", r.Name, r)
+			}
+		}
+		for _, dir := range skillLinkDirs(home) {
+			if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+				t.Errorf("expected no links in %s, got %d", dir, len(entries))
+			}
+		}
+	})
+
+	t.Run("other one already installed", func(t *testing.T) {
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+		upper, lower := writeCollidingCatalog(t, home)
+		linkSkill(t, home, "API-Gateway", lower)
+
+		results, _, _ := installSkills([]SkillInfo{
+			{Name: "API-Gateway", FullPath: upper, Type: "skill", CollidesWith: "api-gateway"},
+		}, SkillLinkOptions{})
+		if len(results) != 1 || results[0].Status != SkillFailed || !strings.Contains(results[0].Reason, "remove it first") {
+			t.Fatalf("expected a refusal, got %+v", results)
+		}
+		if !symlinkPointsTo(filepath.Join(home, ".claude", "skills", "API-Gateway"), lower) {
+			t.Error("the installed skill's link should be left alone")
+		}
+	})
+}
+
+func TestRemoveCollidingSkillMatchesTarget(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	upper, lower := writeCollidingCatalog(t, home)
+	linkSkill(t, home, "API-Gateway", lower)
+	link := filepath.Join(home, ".claude", "skills", "API-Gateway")
+
+	results, _, _ := removeSkills([]SkillInfo{
+		{Name: "API-Gateway", FullPath: upper, Type: "skill", CollidesWith: "api-gateway"},
+	}, SkillLinkOptions{})
+	if results[0].Status != SkillSkipped {
+		t.Errorf("expected API-Gateway to be skipped as not installed, got %+v", results[0])
+	}
+	if _, err := os.Lstat(link); err != nil {
+		t.Fatal("removing API-Gateway must not remove api-gateway's link")
+	}
+
+	results, _, err := removeSkills([]SkillInfo{
+		{Name: "api-gateway", FullPath: lower, Type: "skill", CollidesWith: "API-Gateway"},
+	}, SkillLinkOptions{})
+	if err != nil || results[0].Status != SkillRemoved {
+		t.Fatalf("expected api-gateway to be removed, got %+v (%v)", results[0], err)
+	}
+	if _, err := os.Lstat(link); !os.IsNotExist(err) {
+		t.Error("expected the link to be gone")
+	}
+}
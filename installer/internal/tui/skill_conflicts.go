@@ -0,0 +1,191 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// SkillConflictAction is what an install does with a real directory (not a
+// symlink) sitting where a skill link goes
+type SkillConflictAction string
+
+const (
+	SkillConflictSkip      SkillConflictAction = "skip"      // Leave the directory and don't link there
+	SkillConflictBackup    SkillConflictAction = "backup"    // Rename it to <name>.bak-<timestamp>, then link
+	SkillConflictOverwrite SkillConflictAction = "overwrite" // Delete it, then link
+)
+
+// skillConflictCycle is the order space steps through on the conflict screen
+var skillConflictCycle = []SkillConflictAction{SkillConflictSkip, SkillConflictBackup, SkillConflictOverwrite}
+
+// SkillConflict is a local directory in a link target named like a skill
+// being installed
+type SkillConflict struct {
+	Name string // Skill name
+	Path string // The directory in the link target
+}
+
+// isLocalSkillDir reports whether path exists and is not a symlink: something
+// the user made, which removing it would lose
+func isLocalSkillDir(path string) bool {
+	info, err := os.Lstat(path)
+	return err == nil && info.Mode()&os.ModeSymlink == 0
+}
+
+// findSkillConflicts lists the link destinations under home of the skills
+// that hold a local directory. Plugins are copies the installer owns and
+// never conflict.
+func findSkillConflicts(home string, skills []SkillInfo, opts SkillLinkOptions) []SkillConflict {
+	var conflicts []SkillConflict
+	for _, s := range skills {
+		if s.Type == "plugin" {
+			continue
+		}
+		for _, t := range opts.targets() {
+			if dst := filepath.Join(home, t.Dir, s.Name); isLocalSkillDir(dst) {
+				conflicts = append(conflicts, SkillConflict{Name: s.Name, Path: dst})
+			}
+		}
+	}
+	return conflicts
+}
+
+// backupSkillDir moves path aside to <path>.bak-<timestamp> and returns where it went
+func backupSkillDir(path string, now time.Time) (string, error) {
+	backup := path + ".bak-" + now.Format("20060102-150405")
+	if err := os.Rename(path, backup); err != nil {
+		return "", err
+	}
+	return backup, nil
+}
+
+// enterSkillConflicts asks what to do with the local directories an install
+// would replace; every one starts as skip
+func (m Model) enterSkillConflicts(conflicts []SkillConflict) Model {
+	m.SkillConflicts = conflicts
+	m.SkillConflictActions = make([]SkillConflictAction, len(conflicts))
+	for i := range m.SkillConflictActions {
+		m.SkillConflictActions[i] = SkillConflictSkip
+	}
+	m.Screen = ScreenSkillConflicts
+	m.Cursor = 0
+	return m
+}
+
+// leaveSkillConflicts returns to the link targets with the install still pending
+func (m Model) leaveSkillConflicts() Model {
+	m.SkillConflicts = nil
+	m.SkillConflictActions = nil
+	m.Screen = ScreenSkillTargets
+	m.Cursor = len(skillTargets) + 1
+	return m
+}
+
+// skillConflictsOptions lists a row per conflict with its action, then Continue and Back
+func (m Model) skillConflictsOptions() []string {
+	var opts []string
+	for i, c := range m.SkillConflicts {
+		opts = append(opts, fmt.Sprintf("%-11s %s", "["+string(m.SkillConflictActions[i])+"]", contractHome(c.Path)))
+	}
+	return append(opts, "─────────────", fmt.Sprintf("✅ Install %d skill(s)", len(m.SkillPending)), "← Back")
+}
+
+// skillConflictOptions turns the picked actions into link options for the pending install
+func (m Model) skillConflictOptions() SkillLinkOptions {
+	opts := SkillLinkOptions{Targets: m.SkillTargets, Conflicts: map[string]SkillConflictAction{}}
+	for i, c := range m.SkillConflicts {
+		opts.Conflicts[c.Path] = m.SkillConflictActions[i]
+	}
+	return opts
+}
+
+func (m Model) handleSkillConflictsKeys(key string) (tea.Model, tea.Cmd) {
+	options := m.canonicalOptions()
+	actionIdx := len(m.SkillConflicts) + 1
+	onItem := m.Cursor < len(m.SkillConflicts)
+
+	switch key {
+	case "up", "k":
+		if m.Cursor > 0 {
+			m.Cursor--
+			if strings.HasPrefix(options[m.Cursor], "───") && m.Cursor > 0 {
+				m.Cursor--
+			}
+		}
+	case "down", "j":
+		if m.Cursor < len(options)-1 {
+			m.Cursor++
+			if strings.HasPrefix(options[m.Cursor], "───") && m.Cursor < len(options)-1 {
+				m.Cursor++
+			}
+		}
+	case "s", "b", "o":
+		if onItem {
+			m.SkillConflictActions[m.Cursor] = map[string]SkillConflictAction{
+				"s": SkillConflictSkip, "b": SkillConflictBackup, "o": SkillConflictOverwrite,
+			}[key]
+		}
+	case "enter", " ":
+		if !m.multiSelectRuns(key, onItem, actionIdx) {
+			break
+		}
+		switch {
+		case onItem:
+			current := m.SkillConflictActions[m.Cursor]
+			for i, action := range skillConflictCycle {
+				if action == current {
+					m.SkillConflictActions[m.Cursor] = skillConflictCycle[(i+1)%len(skillConflictCycle)]
+				}
+			}
+		case m.Cursor == actionIdx:
+			skills, opts := m.SkillPending, m.skillConflictOptions()
+			m.SkillPending = nil
+			m.SkillConflicts = nil
+			m.SkillConflictActions = nil
+			m.ErrorMsg = ""
+			m.SkillResultLog.Reset()
+			m.Screen = ScreenSkillResult
+			return m, installSkillActionCmd(skills, opts)
+		case m.Cursor == len(options)-1:
+			return m.leaveSkillConflicts(), nil
+		}
+	}
+
+	return m, nil
+}
+
+func (m Model) renderSkillConflicts() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
+	s.WriteString("\n\n")
+	for i, opt := range m.GetCurrentOptions() {
+		if strings.HasPrefix(opt, "───") {
+			s.WriteString(m.Theme.Muted.Render(opt))
+			s.WriteString("\n")
+			continue
+		}
+		cursor := "  "
+		style := m.Theme.Unselected
+		if i == m.Cursor {
+			cursor = "▸ "
+			style = m.Theme.Selected
+		}
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
+		s.WriteString("\n")
+	}
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render("skip: leave it, don't link there • backup: keep it as <name>.bak-<timestamp> • overwrite: delete it"))
+	s.WriteString("\n\n")
+	s.WriteString(m.Theme.Help.Render("↑/k up • ↓/j down • [Space] change • [s/b/o] skip/backup/overwrite • [Enter] confirm • [Esc] back"))
+
+	return s.String()
+}
@@ -0,0 +1,199 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// writeLocalSkill creates a real skill directory under home/<dir>, as a user would
+func writeLocalSkill(t *testing.T, home, dir string) string {
+	t.Helper()
+	path := filepath.Join(home, dir, "react-19")
+	if err := os.MkdirAll(path, 0755); err != nil {
+		t.Fatal(err)
+	}
+	os.WriteFile(filepath.Join(path, "SKILL.md"), []byte("my own notes"), 0644)
+	return path
+}
+
+func TestFindSkillConflicts(t *testing.T) {
+	home := t.TempDir()
+	skill := writeTargetSkill(t, home)
+	claude := filepath.Join(home, ".claude", "skills", "react-19")
+	agents := filepath.Join(home, ".agents", "skills", "react-19")
+
+	// Missing destinations
+	if got := findSkillConflicts(home, []SkillInfo{skill}, SkillLinkOptions{}); len(got) != 0 {
+		t.Errorf("missing destinations don't conflict, got %v", got)
+	}
+
+	// A symlink is the installer's own and gets replaced as before
+	os.MkdirAll(filepath.Dir(claude), 0755)
+	os.Symlink(skill.FullPath, claude)
+	if got := findSkillConflicts(home, []SkillInfo{skill}, SkillLinkOptions{}); len(got) != 0 {
+		t.Errorf("a symlink doesn't conflict, got %v", got)
+	}
+
+	// A real directory does, in the targets picked only
+	writeLocalSkill(t, home, filepath.Join(".agents", "skills"))
+	got := findSkillConflicts(home, []SkillInfo{skill}, SkillLinkOptions{})
+	if len(got) != 1 || got[0].Path != agents || got[0].Name != "react-19" {
+		t.Errorf("expected the agents directory to conflict, got %v", got)
+	}
+	if got := findSkillConflicts(home, []SkillInfo{skill}, SkillLinkOptions{Targets: []string{"claude"}}); len(got) != 0 {
+		t.Errorf("an unpicked target doesn't conflict, got %v", got)
+	}
+
+	plugin := SkillInfo{Name: "react-19", Type: "plugin"}
+	if got := findSkillConflicts(home, []SkillInfo{plugin}, SkillLinkOptions{}); len(got) != 0 {
+		t.Errorf("plugins never conflict, got %v", got)
+	}
+}
+
+func TestInstallSkillsConflicts(t *testing.T) {
+	t.Run("skipped by default", func(t *testing.T) {
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+		skill := writeTargetSkill(t, home)
+		local := writeLocalSkill(t, home, filepath.Join(".claude", "skills"))
+
+		results, logLines, err := installSkills([]SkillInfo{skill}, SkillLinkOptions{Targets: []string{"claude"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if results[0].Status != SkillSkipped || len(results[0].Conflicts) != 1 || results[0].Conflicts[0] != local {
+			t.Errorf("expected a skip with the conflict, got %+v", results[0])
+		}
+		if data, _ := os.ReadFile(filepath.Join(local, "SKILL.md")); string(data) != "my own notes" {
+			t.Error("the local directory should be left alone")
+		}
+		if !strings.Contains(strings.Join(logLines, "\n"), "a local directory is in the way, skipped") {
+			t.Errorf("the skip should be reported, got %v", logLines)
+		}
+	})
+
+	t.Run("other targets still linked", func(t *testing.T) {
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+		skill := writeTargetSkill(t, home)
+		writeLocalSkill(t, home, filepath.Join(".claude", "skills"))
+
+		results, _, _ := installSkills([]SkillInfo{skill}, SkillLinkOptions{})
+		if results[0].Status != SkillInstalled || len(results[0].Conflicts) != 1 {
+			t.Errorf("expected installed into agents with one conflict, got %+v", results[0])
+		}
+		if !symlinkPointsTo(filepath.Join(home, ".agents", "skills", "react-19"), skill.FullPath) {
+			t.Error("the agents link should be created")
+		}
+	})
+
+	t.Run("overwrite", func(t *testing.T) {
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+		skill := writeTargetSkill(t, home)
+		local := writeLocalSkill(t, home, filepath.Join(".claude", "skills"))
+
+		opts := SkillLinkOptions{Targets: []string{"claude"}, Conflicts: map[string]SkillConflictAction{local: SkillConflictOverwrite}}
+		results, _, err := installSkills([]SkillInfo{skill}, opts)
+		if err != nil || results[0].Status != SkillInstalled {
+			t.Fatalf("expected installed, got %+v (%v)", results, err)
+		}
+		if !symlinkPointsTo(local, skill.FullPath) || len(results[0].Lost) != 1 {
+			t.Errorf("the directory should be replaced and reported lost, got %+v", results[0])
+		}
+	})
+
+	t.Run("backup", func(t *testing.T) {
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+		skill := writeTargetSkill(t, home)
+		local := writeLocalSkill(t, home, filepath.Join(".claude", "skills"))
+
+		opts := SkillLinkOptions{Targets: []string{"claude"}, Conflicts: map[string]SkillConflictAction{local: SkillConflictBackup}}
+		results, _, err := installSkills([]SkillInfo{skill}, opts)
+		if err != nil || results[0].Status != SkillInstalled {
+			t.Fatalf("expected installed, got %+v (%v)", results, err)
+		}
+		if !symlinkPointsTo(local, skill.FullPath) {
+			t.Error("the skill should be linked in place of the directory")
+		}
+		backups, _ := filepath.Glob(local + ".bak-*")
+		if len(backups) != 1 {
+			t.Fatalf("expected one backup, got %v", backups)
+		}
+		if data, _ := os.ReadFile(filepath.Join(backups[0], "SKILL.md")); string(data) != "my own notes" {
+			t.Error("the backup should hold the local directory")
+		}
+	})
+}
+
+func TestBackupSkillDir(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "react-19")
+	os.MkdirAll(dir, 0755)
+	backup, err := backupSkillDir(dir, time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if backup != dir+".bak-20260304-050607" {
+		t.Errorf("unexpected backup path %s", backup)
+	}
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Error("the directory should be moved")
+	}
+}
+
+func TestSkillConflictsScreen(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	skill := writeTargetSkill(t, home)
+	local := writeLocalSkill(t, home, filepath.Join(".claude", "skills"))
+	press := func(m Model, key tea.KeyMsg) (Model, tea.Cmd) {
+		result, cmd := m.Update(key)
+		return result.(Model), cmd
+	}
+	runes := func(r rune) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}} }
+	space := tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+
+	m := NewModel()
+	m.SkillTargets = []string{"claude"}
+	m = m.enterSkillTargets(ScreenSkillInstall, []SkillInfo{skill})
+	m, cmd := press(m, enter)
+	if m.Screen != ScreenSkillConflicts || cmd != nil {
+		t.Fatalf("a local directory should stop the install on the conflict screen, got %v", m.Screen)
+	}
+	if opts := m.GetCurrentOptions(); !strings.HasPrefix(opts[0], "[skip]") || !strings.Contains(opts[0], "react-19") {
+		t.Errorf("the conflict should start as skip, got %q", opts[0])
+	}
+
+	m, _ = press(m, space)
+	if m.SkillConflictActions[0] != SkillConflictBackup {
+		t.Errorf("space should step to backup, got %s", m.SkillConflictActions[0])
+	}
+	m, _ = press(m, runes('o'))
+	if m.SkillConflictActions[0] != SkillConflictOverwrite {
+		t.Errorf("o should pick overwrite, got %s", m.SkillConflictActions[0])
+	}
+
+	// Esc goes back to the targets with the install still pending
+	m, _ = press(m, tea.KeyMsg{Type: tea.KeyEsc})
+	if m.Screen != ScreenSkillTargets || len(m.SkillPending) != 1 {
+		t.Fatalf("esc should return to the targets, got %v with %d pending", m.Screen, len(m.SkillPending))
+	}
+
+	m, _ = press(m, enter)
+	m, _ = press(m, runes('b'))
+	if opts := m.skillConflictOptions(); opts.Conflicts[local] != SkillConflictBackup {
+		t.Errorf("the picked action should reach the install, got %v", opts.Conflicts)
+	}
+	m, _ = press(m, enter) // Jumps to the install row
+	m, cmd = press(m, enter)
+	if m.Screen != ScreenSkillResult || cmd == nil || m.SkillConflicts != nil {
+		t.Fatalf("confirming should start the install, got %v", m.Screen)
+	}
+}
@@ -0,0 +1,226 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+)
+
+const (
+	skillTreeMaxDepth   = 3  // Directories below this depth are listed but not expanded
+	skillTreeMaxEntries = 15 // Entries shown per directory before "… and N more"
+)
+
+// skillTreeLines renders dir as an indented tree with file sizes. Directories
+// come before files, each group sorted by name, so the output is stable.
+// Directories show the total size of everything below them.
+func skillTreeLines(dir string, maxDepth, maxEntries int) []string {
+	var lines []string
+	appendSkillTree(&lines, dir, 1, maxDepth, maxEntries)
+	return lines
+}
+
+func appendSkillTree(lines *[]string, dir string, depth, maxDepth, maxEntries int) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		*lines = append(*lines, skillTreeIndent(depth)+"⚠ "+err.Error())
+		return
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].IsDir() != entries[j].IsDir() {
+			return entries[i].IsDir()
+		}
+		return entries[i].Name() < entries[j].Name()
+	})
+
+	for i, e := range entries {
+		if maxEntries > 0 && i == maxEntries {
+			*lines = append(*lines, fmt.Sprintf("%s… and %d more", skillTreeIndent(depth), len(entries)-i))
+			return
+		}
+		path := filepath.Join(dir, e.Name())
+		if e.IsDir() {
+			*lines = append(*lines, skillTreeLine(depth, e.Name()+"/", skillDirSize(path)))
+			if depth < maxDepth {
+				appendSkillTree(lines, path, depth+1, maxDepth, maxEntries)
+			}
+			continue
+		}
+		var size int64
+		if info, err := e.Info(); err == nil {
+			size = info.Size()
+		}
+		*lines = append(*lines, skillTreeLine(depth, e.Name(), size))
+	}
+}
+
+func skillTreeIndent(depth int) string {
+	return strings.Repeat("  ", depth)
+}
+
+func skillTreeLine(depth int, name string, size int64) string {
+	return fmt.Sprintf("%-36s %9s", skillTreeIndent(depth)+name, system.FormatBytes(size))
+}
+
+// skillDetailKind tells the detail view how to style a line
+type skillDetailKind int
+
+const (
+	skillDetailText skillDetailKind = iota
+	skillDetailHeading
+	skillDetailCode
+)
+
+// skillDetailLine is one line of the scrollable detail view
+type skillDetailLine struct {
+	Text string
+	Kind skillDetailKind
+}
+
+// skillDocumentPath returns the markdown file that describes s
+func skillDocumentPath(s SkillInfo) string {
+	if s.Type == "plugin" {
+		return filepath.Join(s.FullPath, "PLUGIN.md")
+	}
+	return filepath.Join(s.FullPath, "SKILL.md")
+}
+
+// skillInstallPaths lists where installing s puts it, relative to the home
+// directory: a link in every one of skillTargets, or the Claude plugin copy
+func skillInstallPaths(s SkillInfo) []string {
+	if s.Type == "plugin" {
+		return []string{"~/" + filepath.ToSlash(filepath.Join(".claude", "plugins", s.Name))}
+	}
+	var paths []string
+	for _, t := range skillTargets {
+		paths = append(paths, "~/"+filepath.ToSlash(filepath.Join(t.Dir, s.Name)))
+	}
+	return paths
+}
+
+// skillDetailLines builds the detail view: frontmatter fields, the document body
+// and the file tree. Prose is wrapped to width; code blocks are kept as-is.
+func skillDetailLines(s SkillInfo, doc skillDocument, tree []string, width int) []skillDetailLine {
+	var lines []skillDetailLine
+	text := func(t string) { lines = append(lines, skillDetailLine{Text: t}) }
+	heading := func(t string) { lines = append(lines, skillDetailLine{Text: t, Kind: skillDetailHeading}) }
+
+	installed := "no"
+	if label := skillInstalledLabel(s); label != "" {
+		installed = "yes (" + label + ")"
+	}
+	kind := s.Type
+	if doc.Type != "" && doc.Type != s.Type {
+		kind += " (declares " + doc.Type + ")"
+	}
+	tags := doc.Tags
+	if len(tags) == 0 {
+		tags = s.Tags
+	}
+	text("Name:         " + s.Name)
+	text("Category:     " + skillCategoryHeader(s.Category))
+	text("Type:         " + kind)
+	if len(tags) > 0 {
+		text("Tags:         " + strings.Join(tags, ", "))
+	}
+	text("Installed:    " + installed)
+	text("Source:       " + s.FullPath)
+	text("")
+	heading("Install paths:")
+	for _, p := range skillInstallPaths(s) {
+		text("  " + p)
+	}
+
+	description := strings.ReplaceAll(doc.Description, "\n", " ")
+	if description == "" {
+		description = s.Description
+	}
+	if description != "" {
+		text("")
+		heading("Description:")
+		for _, l := range wrapSkillText(description, width-2) {
+			text("  " + l)
+		}
+	}
+	permissions := doc.Permissions
+	if len(permissions) == 0 {
+		permissions = s.Permissions
+	}
+	if len(permissions) > 0 {
+		text("")
+		// Plugins ask for settings.json permissions, skills for the tools they may use
+		if s.Type == "plugin" {
+			heading("Permissions:")
+		} else {
+			heading("Allowed tools:")
+		}
+		for _, p := range permissions {
+			text("  " + p)
+		}
+	}
+
+	text("")
+	heading(filepath.Base(skillDocumentPath(s)) + ":")
+	var body []string
+	if doc.Body == "" {
+		text("  No content")
+	} else {
+		body = strings.Split(doc.Body, "\n")
+	}
+	inFence := false
+	for _, l := range body {
+		trimmed := strings.TrimSpace(l)
+		switch {
+		case strings.HasPrefix(trimmed, "```"):
+			inFence = !inFence
+			lines = append(lines, skillDetailLine{Text: l, Kind: skillDetailCode})
+		case inFence:
+			lines = append(lines, skillDetailLine{Text: l, Kind: skillDetailCode})
+		case strings.HasPrefix(trimmed, "#"):
+			heading(l)
+		default:
+			for _, w := range wrapSkillText(l, width) {
+				text(w)
+			}
+		}
+	}
+
+	text("")
+	heading("Files:")
+	if len(tree) == 0 {
+		text("  None")
+	}
+	for _, l := range tree {
+		text(l)
+	}
+	return lines
+}
+
+// wrapSkillText word-wraps one line of prose to width, keeping its indentation.
+// Words longer than width are left on their own line.
+func wrapSkillText(line string, width int) []string {
+	if width < 20 {
+		width = 20
+	}
+	if len([]rune(line)) <= width {
+		return []string{line}
+	}
+	indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+	var out []string
+	current := indent
+	for _, word := range strings.Fields(line) {
+		if current != indent && len([]rune(current))+1+len([]rune(word)) > width {
+			out = append(out, current)
+			current = indent
+		}
+		if current != indent {
+			current += " "
+		}
+		current += word
+	}
+	return append(out, current)
+}
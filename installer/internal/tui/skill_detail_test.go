@@ -0,0 +1,308 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// writeSkillFixture lays out a small skill with nested references and assets
+func writeSkillFixture(t *testing.T) string {
+	t.Helper()
+	dir := filepath.Join(t.TempDir(), "react-19")
+	files := map[string]int{
+		"SKILL.md":                           1500,
+		"references/hooks.md":                300,
+		"references/api/server.md":           200,
+		"references/api/deep/more/hidden.md": 50,
+		"assets/logo.svg":                    2048,
+		"README.md":                          10,
+	}
+	for name, size := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(strings.Repeat("x", size)), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestSkillTreeLines(t *testing.T) {
+	dir := writeSkillFixture(t)
+
+	got := skillTreeLines(dir, 3, 0)
+	want := []string{
+		skillTreeLine(1, "assets/", 2048),
+		skillTreeLine(2, "logo.svg", 2048),
+		skillTreeLine(1, "references/", 550),
+		skillTreeLine(2, "api/", 250),
+		skillTreeLine(3, "deep/", 50),
+		skillTreeLine(3, "server.md", 200),
+		skillTreeLine(2, "hooks.md", 300),
+		skillTreeLine(1, "README.md", 10),
+		skillTreeLine(1, "SKILL.md", 1500),
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("tree mismatch\ngot:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if !strings.Contains(got[0], "2.0KB") {
+		t.Errorf("expected a human-readable size, got %q", got[0])
+	}
+}
+
+func TestSkillTreeLinesCapsEntries(t *testing.T) {
+	dir := t.TempDir()
+	for i := 0; i < 5; i++ {
+		if err := os.WriteFile(filepath.Join(dir, fmt.Sprintf("file%d.md", i)), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got := skillTreeLines(dir, 3, 3)
+	if len(got) != 4 {
+		t.Fatalf("expected 3 entries plus a summary line, got %v", got)
+	}
+	if strings.TrimSpace(got[3]) != "… and 2 more" {
+		t.Errorf("unexpected summary line %q", got[3])
+	}
+}
+
+func TestSkillDetailFromBrowse(t *testing.T) {
+	dir := writeSkillFixture(t)
+	m := NewModel()
+	m.Screen = ScreenSkillBrowse
+	m.Height = 40
+	m.SkillCatalog = []SkillInfo{
+		{Name: "angular", Category: "curated", Type: "skill"},
+		{Name: "react-19", Category: "curated", Type: "skill", FullPath: dir, Description: "React 19 patterns"},
+	}
+	// Header, angular, react-19
+	m.Cursor = 2
+
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	nm := result.(Model)
+	if nm.Screen != ScreenSkillDetail || nm.SkillDetail == nil || nm.SkillDetail.Name != "react-19" {
+		t.Fatalf("expected the react-19 detail screen, got screen %d detail %+v", nm.Screen, nm.SkillDetail)
+	}
+	view := nm.View()
+	for _, want := range []string{"React 19 patterns", "Files:", "references/", "SKILL.md"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("detail view missing %q", want)
+		}
+	}
+
+	result, _ = nm.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	nm = result.(Model)
+	if nm.Screen != ScreenSkillBrowse || nm.Cursor != 2 {
+		t.Errorf("expected Browse with the cursor kept at 2, got screen %d cursor %d", nm.Screen, nm.Cursor)
+	}
+}
+
+func TestParseSkillDocument(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "SKILL.md")
+	content := "---\nname: react-19\ndescription: >\n  React 19 patterns\n  with the compiler\n---\n\n# React 19\n\nUse the compiler.\n"
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	doc := parseSkillDocument(path)
+	if doc.Name != "react-19" {
+		t.Errorf("unexpected name %q", doc.Name)
+	}
+	if doc.Description != "React 19 patterns\nwith the compiler" {
+		t.Errorf("expected the full description, got %q", doc.Description)
+	}
+	if doc.Body != "# React 19\n\nUse the compiler." {
+		t.Errorf("unexpected body %q", doc.Body)
+	}
+	if desc := parseSkillFrontmatter(path).Description; desc != "React 19 patterns" {
+		t.Errorf("parseSkillFrontmatter should keep only the first description line, got %q", desc)
+	}
+
+	plain := filepath.Join(dir, "plain.md")
+	if err := os.WriteFile(plain, []byte("# Just markdown\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if doc := parseSkillDocument(plain); doc.Name != "" || doc.Body != "# Just markdown" {
+		t.Errorf("a file without frontmatter should be all body, got %+v", doc)
+	}
+}
+
+func TestSkillDetailLines(t *testing.T) {
+	skill := SkillInfo{Name: "react-19", Category: "curated", Type: "skill", FullPath: "/catalog/react-19"}
+	doc := skillDocument{
+		SkillMeta: SkillMeta{Description: "React 19 patterns"},
+		Body:      "# Usage\n\n```tsx\nconst x = use(promise)\n```\n" + strings.Repeat("word ", 30),
+	}
+
+	lines := skillDetailLines(skill, doc, []string{skillTreeLine(1, "SKILL.md", 10)}, 40)
+	kinds := map[string]skillDetailKind{}
+	for _, l := range lines {
+		kinds[l.Text] = l.Kind
+		if l.Kind == skillDetailText && len([]rune(l.Text)) > 40 && !strings.Contains(l.Text, "SKILL.md") {
+			t.Errorf("prose line not wrapped: %q", l.Text)
+		}
+	}
+	for text, want := range map[string]skillDetailKind{
+		"Install paths:":              skillDetailHeading,
+		"  ~/.claude/skills/react-19": skillDetailText,
+		"# Usage":                     skillDetailHeading,
+		"const x = use(promise)":      skillDetailCode,
+		"SKILL.md:":                   skillDetailHeading,
+		"Files:":                      skillDetailHeading,
+	} {
+		got, ok := kinds[text]
+		if !ok {
+			t.Errorf("missing line %q", text)
+		} else if got != want {
+			t.Errorf("line %q has kind %d, want %d", text, got, want)
+		}
+	}
+}
+
+func TestSkillInstallPathsFollowTargets(t *testing.T) {
+	saved := skillTargets
+	skillTargets = append(slices.Clone(saved), skillTarget{ID: "cursor", Dir: filepath.Join(".cursor", "skills")})
+	t.Cleanup(func() { skillTargets = saved })
+
+	want := []string{"~/.claude/skills/react-19", "~/.agents/skills/react-19", "~/.cursor/skills/react-19"}
+	if got := skillInstallPaths(SkillInfo{Name: "react-19", Type: "skill"}); !slices.Equal(got, want) {
+		t.Errorf("skillInstallPaths = %v, want %v", got, want)
+	}
+	if got := skillInstallPaths(SkillInfo{Name: "review", Type: "plugin"}); !slices.Equal(got, []string{"~/.claude/plugins/review"}) {
+		t.Errorf("a plugin is copied under Claude only, got %v", got)
+	}
+}
+
+func TestSkillDetailKeepsBrowsePosition(t *testing.T) {
+	dir := t.TempDir()
+	body := strings.Repeat("line\n", 60)
+	if err := os.WriteFile(filepath.Join(dir, "SKILL.md"), []byte("---\nname: long\n---\n"+body), 0644); err != nil {
+		t.Fatal(err)
+	}
+	m := NewModel()
+	m.Screen = ScreenSkillBrowse
+	m.Height = 20
+	m.SkillCatalog = []SkillInfo{{Name: "long", Category: "curated", Type: "skill", FullPath: dir}}
+	m.Cursor = 1
+	m.SkillScroll = 1
+
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	nm := result.(Model)
+	for i := 0; i < 3; i++ {
+		result, _ = nm.Update(tea.KeyMsg{Type: tea.KeyDown})
+		nm = result.(Model)
+	}
+	if nm.SkillDetailPos != 3 {
+		t.Errorf("expected the detail view to scroll to 3, got %d", nm.SkillDetailPos)
+	}
+	if !strings.Contains(nm.View(), "Lines 4-") {
+		t.Error("expected the scroll indicator in the detail view")
+	}
+
+	result, _ = nm.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	nm = result.(Model)
+	if nm.Screen != ScreenSkillBrowse || nm.Cursor != 1 || nm.SkillScroll != 1 {
+		t.Errorf("expected Browse at cursor 1 scroll 1, got screen %d cursor %d scroll %d", nm.Screen, nm.Cursor, nm.SkillScroll)
+	}
+}
+
+func TestSkillDetailShowsMetadata(t *testing.T) {
+	skill := SkillInfo{Name: "notify", Category: "curated", Type: "skill", FullPath: "/catalog/notify"}
+	doc := skillDocument{SkillMeta: SkillMeta{Type: "hook", Tags: []string{"hooks", "desktop"}, Permissions: []string{"Read", "Bash(notify-send:*)"}}}
+
+	var texts []string
+	for _, l := range skillDetailLines(skill, doc, nil, 80) {
+		texts = append(texts, l.Text)
+	}
+	view := strings.Join(texts, "\n")
+	for _, want := range []string{"Type:         skill (declares hook)", "Tags:         hooks, desktop", "Allowed tools:\n  Read\n  Bash(notify-send:*)"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("detail view missing %q:\n%s", want, view)
+		}
+	}
+
+	skill.Type = "plugin"
+	if lines := skillDetailLines(skill, doc, nil, 80); !slices.ContainsFunc(lines, func(l skillDetailLine) bool { return l.Text == "Permissions:" }) {
+		t.Error("a plugin should list its permissions")
+	}
+}
+
+func TestSkillBrowseTagFilter(t *testing.T) {
+	m := NewModel()
+	m.Screen = ScreenSkillBrowse
+	m.SkillCatalog = []SkillInfo{
+		{Name: "angular", Category: "curated", Type: "skill", Tags: []string{"frontend"}},
+		{Name: "go-testing", Category: "curated", Type: "skill", Tags: []string{"backend", "testing"}},
+		{Name: "react-19", Category: "community", Type: "skill", Description: "React 19 patterns", Tags: []string{"frontend", "react"}},
+		{Name: "untagged", Category: "community", Type: "skill"},
+	}
+	if opts := m.GetCurrentOptions(); !strings.HasSuffix(opts[4], "react-19 — React 19 patterns  #frontend #react") {
+		t.Errorf("rows should end with the skill's tags, got %q", opts)
+	}
+
+	press := func(msg tea.KeyMsg) {
+		result, _ := m.Update(msg)
+		m = result.(Model)
+	}
+	tKey := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}}
+	names := func() []string {
+		var names []string
+		for _, s := range m.browseSkills() {
+			names = append(names, s.Name)
+		}
+		return names
+	}
+
+	// Tags come in sorted order, then the filter is off again
+	for _, want := range []struct {
+		tag   string
+		names []string
+	}{
+		{"backend", []string{"go-testing"}},
+		{"frontend", []string{"angular", "react-19"}},
+		{"react", []string{"react-19"}},
+		{"testing", []string{"go-testing"}},
+		{"", []string{"angular", "go-testing", "react-19", "untagged"}},
+	} {
+		press(tKey)
+		if m.SkillTagFilter != want.tag || !slices.Equal(names(), want.names) {
+			t.Fatalf("tag %q lists %v, want tag %q listing %v", m.SkillTagFilter, names(), want.tag, want.names)
+		}
+	}
+
+	// Enter opens the skill at the cursor among the filtered ones
+	press(tKey)
+	press(tKey)
+	m.Cursor = 3 // Curated header, angular, Community header, react-19
+	press(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.SkillDetail == nil || m.SkillDetail.Name != "react-19" {
+		t.Fatalf("expected react-19's details, got %+v", m.SkillDetail)
+	}
+	press(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.Screen != ScreenSkillBrowse || m.SkillTagFilter != "frontend" {
+		t.Fatalf("the filter should survive the detail screen, got %q", m.SkillTagFilter)
+	}
+	if !strings.Contains(m.View(), "Skills tagged #frontend") {
+		t.Error("the description should name the tag")
+	}
+
+	// Esc clears the filter before leaving Browse
+	press(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.Screen != ScreenSkillBrowse || m.SkillTagFilter != "" {
+		t.Errorf("the first Esc should clear the tag, got screen %s tag %q", m.Screen, m.SkillTagFilter)
+	}
+	press(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.Screen != ScreenSkillMenu {
+		t.Errorf("the second Esc should leave Browse, got %s", m.Screen)
+	}
+}
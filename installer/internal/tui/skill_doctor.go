@@ -0,0 +1,269 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// skillLinkState classifies an entry of a skill link dir
+type skillLinkState int
+
+const (
+	skillLinkValid    skillLinkState = iota // Symlink into the skills catalog that resolves
+	skillLinkDangling                       // Symlink whose target is gone
+	skillLinkForeign                        // Real directory, file, or symlink outside the catalog; never touched
+)
+
+// skillLinkEntry is one entry found in ~/.claude/skills or ~/.agents/skills
+type skillLinkEntry struct {
+	Path   string
+	Target string // Link target as stored, "" for non-links
+	State  skillLinkState
+}
+
+// skillDoctorReport is the result of scanning the skill link dirs
+type skillDoctorReport struct {
+	Entries []skillLinkEntry
+}
+
+// Count returns how many entries are in state
+func (r *skillDoctorReport) Count(state skillLinkState) int {
+	if r == nil {
+		return 0
+	}
+	n := 0
+	for _, e := range r.Entries {
+		if e.State == state {
+			n++
+		}
+	}
+	return n
+}
+
+// Dangling returns the broken links, the only entries the doctor repairs
+func (r *skillDoctorReport) Dangling() []skillLinkEntry {
+	if r == nil {
+		return nil
+	}
+	var out []skillLinkEntry
+	for _, e := range r.Entries {
+		if e.State == skillLinkDangling {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// Summary is the one-line count shown on the doctor and result screens
+func (r *skillDoctorReport) Summary() string {
+	return fmt.Sprintf("%d valid, %d dangling, %d foreign",
+		r.Count(skillLinkValid), r.Count(skillLinkDangling), r.Count(skillLinkForeign))
+}
+
+// classifySkillEntry inspects path without following it first, so a dangling
+// link is reported as such instead of looking like a missing entry
+func classifySkillEntry(skillRoots []string, path string) skillLinkEntry {
+	entry := skillLinkEntry{Path: path, State: skillLinkForeign}
+	info, err := os.Lstat(path)
+	if err != nil || info.Mode()&os.ModeSymlink == 0 {
+		return entry
+	}
+	entry.Target, _ = os.Readlink(path)
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		entry.State = skillLinkDangling
+		return entry
+	}
+	for _, root := range skillRoots {
+		if rel, err := filepath.Rel(root, resolved); err == nil && !strings.HasPrefix(rel, "..") {
+			entry.State = skillLinkValid
+		}
+	}
+	return entry
+}
+
+// skillRootDirs are the directories installer skill links point into: the
+// catalog and the external clones beside it
+func skillRootDirs(home string) []string {
+	return []string{paths.SkillsDir(home), paths.ExternalSkillsDir(home)}
+}
+
+// scanSkillLinks classifies every entry of the skill link dirs
+func scanSkillLinks(home string) *skillDoctorReport {
+	roots := skillRootDirs(home)
+	for i, root := range roots {
+		if resolved, err := filepath.EvalSymlinks(root); err == nil {
+			roots[i] = resolved
+		}
+	}
+	report := &skillDoctorReport{}
+	for _, dir := range skillLinkDirs(home) {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, e := range entries {
+			report.Entries = append(report.Entries, classifySkillEntry(roots, filepath.Join(dir, e.Name())))
+		}
+	}
+	return report
+}
+
+// relinkCandidate finds the catalog skill a dangling link should point at: the
+// skill whose DirName matches the old target's folder, or else the link's name
+func relinkCandidate(e skillLinkEntry, catalog []SkillInfo) (SkillInfo, bool) {
+	names := []string{filepath.Base(e.Target), filepath.Base(e.Path)}
+	for _, name := range names {
+		for _, s := range catalog {
+			if s.Type == "skill" && (s.Category == "curated" || s.Category == "community") && s.DirName == name {
+				return s, true
+			}
+		}
+	}
+	return SkillInfo{}, false
+}
+
+// repairSkillLinks removes each dangling link and, when catalog is non-nil,
+// points it at the matching catalog skill. Links without a match are left in
+// place so nothing is lost silently. It returns the summary log.
+func repairSkillLinks(home string, dangling []skillLinkEntry, catalog []SkillInfo) []string {
+	relink := catalog != nil
+	var logLines []string
+	fixed := 0
+	for _, e := range dangling {
+		var target SkillInfo
+		if relink {
+			var ok bool
+			if target, ok = relinkCandidate(e, catalog); !ok {
+				logLines = append(logLines, fmt.Sprintf("⚠️  %s: no catalog skill matches, left as is", contractHome(e.Path)))
+				continue
+			}
+		}
+		if err := os.Remove(e.Path); err != nil {
+			logLines = append(logLines, fmt.Sprintf("❌ %s: %v", contractHome(e.Path), err))
+			continue
+		}
+		if !relink {
+			logLines = append(logLines, fmt.Sprintf("🧹 removed %s (→ %s)", contractHome(e.Path), e.Target))
+			fixed++
+			continue
+		}
+		if err := os.Symlink(target.FullPath, e.Path); err != nil {
+			logLines = append(logLines, fmt.Sprintf("❌ %s: removed, but relinking failed: %v", contractHome(e.Path), err))
+			continue
+		}
+		logLines = append(logLines, fmt.Sprintf("🔗 relinked %s → %s", contractHome(e.Path), contractHome(target.FullPath)))
+		fixed++
+	}
+	logLines = append(logLines, fmt.Sprintf("✅ Repaired %d of %d dangling links", fixed, len(dangling)))
+	return logLines
+}
+
+// skillDoctorCmd repairs the dangling links of report. Relinking loads the
+// catalog first, which clones it again when the checkout is gone.
+func skillDoctorCmd(report *skillDoctorReport, relink bool) tea.Cmd {
+	dangling := report.Dangling()
+	summary := "🩺 Scanned skill links: " + report.Summary()
+	return func() tea.Msg {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return skillActionCompleteMsg{err: fmt.Errorf("cannot determine home directory: %w", err)}
+		}
+		var catalog []SkillInfo
+		if relink {
+			if catalog, _, err = fetchSkillCatalog(); err != nil {
+				return skillActionCompleteMsg{logLines: []string{summary}, err: err, undo: currentSkillUndo()}
+			}
+			if catalog == nil {
+				catalog = []SkillInfo{}
+			}
+		}
+		logLines := append([]string{summary}, repairSkillLinks(home, dangling, catalog)...)
+		return skillActionCompleteMsg{logLines: logLines, undo: currentSkillUndo()}
+	}
+}
+
+// skillDoctorOptions offers the repairs only when something is broken
+func (m Model) skillDoctorOptions() []string {
+	n := m.SkillDoctor.Count(skillLinkDangling)
+	if n == 0 {
+		return []string{"← Back"}
+	}
+	return []string{
+		fmt.Sprintf("🧹 Remove %d dangling links", n),
+		fmt.Sprintf("🔗 Relink %d from the catalog", n),
+		"← Back",
+	}
+}
+
+func (m Model) handleSkillDoctorKeys(key string) (tea.Model, tea.Cmd) {
+	options := m.canonicalOptions()
+
+	switch key {
+	case "up", "k":
+		if m.Cursor > 0 {
+			m.Cursor--
+		}
+	case "down", "j":
+		if m.Cursor < len(options)-1 {
+			m.Cursor++
+		}
+	case "enter":
+		if m.Cursor == len(options)-1 { // Back
+			m.Screen = ScreenSkillMenu
+			m.Cursor = 6
+			return m, nil
+		}
+		report := m.SkillDoctor
+		m.SkillLoadError = ""
+		m.SkillResultLog.Reset()
+		m.ErrorMsg = ""
+		m.Screen = ScreenSkillResult
+		return m, skillDoctorCmd(report, m.Cursor == 1)
+	}
+
+	return m, nil
+}
+
+// renderSkillDoctor lists the dangling links; foreign entries are only counted
+func (m Model) renderSkillDoctor() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
+	s.WriteString("\n\n")
+
+	if m.SkillDoctor != nil {
+		for _, e := range m.SkillDoctor.Dangling() {
+			s.WriteString(m.Theme.Error.Render(fmt.Sprintf("  ✗ %s → %s (missing)", contractHome(e.Path), e.Target)))
+			s.WriteString("\n")
+		}
+		if m.SkillDoctor.Count(skillLinkDangling) == 0 {
+			s.WriteString(m.Theme.Success.Render("  ✓ No broken skill links"))
+			s.WriteString("\n")
+		}
+	}
+	s.WriteString("\n")
+
+	for i, opt := range m.GetCurrentOptions() {
+		cursor := "  "
+		style := m.Theme.Unselected
+		if i == m.Cursor {
+			cursor = "▸ "
+			style = m.Theme.Selected
+		}
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
+		s.WriteString("\n")
+	}
+
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render("↑/k up • ↓/j down • [Enter] select • [Esc] back"))
+	return s.String()
+}
@@ -0,0 +1,154 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// doctorFixture links one live skill and one whose checkout was moved away,
+// next to a local skill directory and a link outside the catalog
+func doctorFixture(t *testing.T) (home string, live SkillInfo) {
+	t.Helper()
+	home = t.TempDir()
+	t.Setenv("HOME", home)
+	live = writeUndoCatalog(t, home, "react-19")
+	live.Category, live.DirName = "curated", "react-19"
+	linkSkill(t, home, "react-19", live.FullPath)
+
+	// The old clone location is gone
+	linkSkill(t, home, "go-testing", filepath.Join(home, "old-skills", "curated", "go-testing"))
+
+	local := filepath.Join(home, ".claude", "skills", "my-local")
+	os.MkdirAll(local, 0755)
+	elsewhere := filepath.Join(home, "elsewhere")
+	os.MkdirAll(elsewhere, 0755)
+	os.Symlink(elsewhere, filepath.Join(home, ".agents", "skills", "mine"))
+	return home, live
+}
+
+func TestScanSkillLinks(t *testing.T) {
+	home, _ := doctorFixture(t)
+
+	report := scanSkillLinks(home)
+	if got := report.Summary(); got != "2 valid, 2 dangling, 2 foreign" {
+		t.Errorf("Summary() = %q", got)
+	}
+	for _, e := range report.Dangling() {
+		if filepath.Base(e.Path) != "go-testing" || !strings.HasSuffix(e.Target, "old-skills/curated/go-testing") {
+			t.Errorf("unexpected dangling entry %+v", e)
+		}
+	}
+}
+
+func TestIsSkillInstalledIgnoresDanglingLinks(t *testing.T) {
+	home, _ := doctorFixture(t)
+
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"react-19", true},
+		{"go-testing", false},
+		{"my-local", true},
+		{"missing", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isSkillInstalled(home, tt.name); got != tt.want {
+				t.Errorf("isSkillInstalled(%q) = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRepairSkillLinksRemove(t *testing.T) {
+	home, _ := doctorFixture(t)
+
+	report := scanSkillLinks(home)
+	logLines := repairSkillLinks(home, report.Dangling(), nil)
+	if last := logLines[len(logLines)-1]; last != "✅ Repaired 2 of 2 dangling links" {
+		t.Errorf("unexpected summary %q", last)
+	}
+	after := scanSkillLinks(home)
+	if got := after.Summary(); got != "2 valid, 0 dangling, 2 foreign" {
+		t.Errorf("after removing, Summary() = %q; foreign entries must be left alone", got)
+	}
+}
+
+func TestRepairSkillLinksRelink(t *testing.T) {
+	home, live := doctorFixture(t)
+	moved := writeUndoCatalog(t, home, "go-testing")
+	moved.Category, moved.DirName = "curated", "go-testing"
+
+	report := scanSkillLinks(home)
+	// A catalog without the skill leaves the link for the user to decide
+	logLines := repairSkillLinks(home, report.Dangling(), []SkillInfo{live})
+	if !strings.Contains(strings.Join(logLines, "\n"), "no catalog skill matches") {
+		t.Errorf("expected unmatched links to be reported, got %v", logLines)
+	}
+	if scanSkillLinks(home).Count(skillLinkDangling) != 2 {
+		t.Fatal("unmatched links should be left in place")
+	}
+
+	logLines = repairSkillLinks(home, report.Dangling(), []SkillInfo{live, moved})
+	if got := strings.Join(logLines, "\n"); strings.Count(got, "🔗 relinked") != 2 {
+		t.Errorf("expected both links relinked, got:\n%s", got)
+	}
+	for _, dir := range skillLinkDirs(home) {
+		if !sameSkillTarget(filepath.Join(dir, "go-testing"), moved.FullPath) {
+			t.Errorf("%s/go-testing should point at the catalog copy", dir)
+		}
+	}
+	if !isSkillInstalled(home, "go-testing") {
+		t.Error("a relinked skill is installed again")
+	}
+}
+
+func TestSkillDoctorScreen(t *testing.T) {
+	doctorFixture(t)
+
+	m := NewModel()
+	m.Screen = ScreenSkillMenu
+	m.Cursor = 6
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = result.(Model)
+	if m.Screen != ScreenSkillDoctor {
+		t.Fatalf("expected the doctor screen, got %v", m.Screen)
+	}
+	view := m.renderSkillDoctor()
+	for _, want := range []string{"2 dangling", "go-testing", "Remove 2 dangling links", "Relink 2 from the catalog"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("doctor screen should show %q, got:\n%s", want, view)
+		}
+	}
+
+	// Removing reports on the result screen
+	m.Cursor = 0
+	result, cmd := m.handleSkillDoctorKeys("enter")
+	m = result.(Model)
+	if m.Screen != ScreenSkillResult || cmd == nil {
+		t.Fatalf("repairs should run and land on the result screen, got %v", m.Screen)
+	}
+	msg := runSkillCmd(t, cmd)
+	if msg.err != nil || !strings.HasPrefix(msg.logLines[0], "🩺 Scanned skill links: 2 valid, 2 dangling") {
+		t.Errorf("unexpected result %v %v", msg.logLines, msg.err)
+	}
+
+	// Nothing left to fix: only Back remains, and it returns to the menu on Doctor
+	m = NewModel()
+	m.Screen = ScreenSkillMenu
+	m.Cursor = 6
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = result.(Model)
+	if opts := m.GetCurrentOptions(); len(opts) != 1 {
+		t.Fatalf("a clean scan should only offer Back, got %v", opts)
+	}
+	result, _ = m.handleSkillDoctorKeys("enter")
+	if got := result.(Model); got.Screen != ScreenSkillMenu || got.Cursor != 6 {
+		t.Errorf("Back should return to the Doctor entry, got %v cursor %d", got.Screen, got.Cursor)
+	}
+}
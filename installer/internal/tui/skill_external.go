@@ -0,0 +1,332 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// externalSkillCategory is the category of skills cloned from their own repository
+const externalSkillCategory = "external"
+
+// externalSkillsDir holds one clone per external skill repository
+func externalSkillsDir(home string) string {
+	return paths.ExternalSkillsDir(home)
+}
+
+// legacyExternalSkillsDir is where older versions cloned external skills,
+// inside the catalog's checkout
+func legacyExternalSkillsDir(home string) string {
+	return filepath.Join(paths.SkillsDir(home), externalSkillCategory)
+}
+
+// moveLegacyExternalSkills moves the clones of an older version out of the
+// catalog checkout and points their skill links at the new place
+func moveLegacyExternalSkills(home string) error {
+	legacy := legacyExternalSkillsDir(home)
+	if _, err := os.Stat(legacy); err != nil {
+		return nil
+	}
+	if _, err := os.Lstat(externalSkillsDir(home)); err == nil {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(externalSkillsDir(home)), 0755); err != nil {
+		return err
+	}
+	if err := os.Rename(legacy, externalSkillsDir(home)); err != nil {
+		return err
+	}
+	for _, dir := range skillLinkDirs(home) {
+		entries, _ := os.ReadDir(dir)
+		for _, e := range entries {
+			link := filepath.Join(dir, e.Name())
+			target, err := os.Readlink(link)
+			if err != nil {
+				continue
+			}
+			rel, err := filepath.Rel(legacy, target)
+			if err != nil || strings.HasPrefix(rel, "..") {
+				continue
+			}
+			if err := os.Remove(link); err == nil {
+				os.Symlink(filepath.Join(externalSkillsDir(home), rel), link)
+			}
+		}
+	}
+	return nil
+}
+
+// externalCloneDir returns the clone under the external dir that holds
+// skillDir, or "" when skillDir isn't in one
+func externalCloneDir(home, skillDir string) string {
+	rel, err := filepath.Rel(externalSkillsDir(home), skillDir)
+	if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return ""
+	}
+	return filepath.Join(externalSkillsDir(home), strings.Split(filepath.ToSlash(rel), "/")[0])
+}
+
+var (
+	// scpURLRe matches the scp-like form git accepts, git@github.com:owner/repo
+	scpURLRe = regexp.MustCompile(`^[\w.-]+@[\w.-]+:([^\s]+)$`)
+	// repoNameRe is what a clone directory name may contain
+	repoNameRe = regexp.MustCompile(`^[\w.-]+$`)
+)
+
+// externalSkillRepoName checks that rawURL is a git URL and returns the name
+// of the repository it points at, the clone directory under the external dir
+func externalSkillRepoName(rawURL string) (string, error) {
+	var repoPath string
+	if u, err := url.Parse(rawURL); err == nil && slices.Contains([]string{"https", "http", "ssh", "git", "file"}, u.Scheme) {
+		repoPath = u.Path
+	} else if match := scpURLRe.FindStringSubmatch(rawURL); match != nil {
+		repoPath = match[1]
+	}
+	name := strings.TrimSuffix(path.Base(strings.Trim(repoPath, "/")), ".git")
+	if strings.ContainsAny(rawURL, " \t") || !repoNameRe.MatchString(name) || name == "." || name == ".." {
+		return "", fmt.Errorf("invalid git URL %q: use https://host/owner/repo, git@host:owner/repo or file:///path/to/repo", rawURL)
+	}
+	return name, nil
+}
+
+// findExternalSkillDir returns the directory of the SKILL.md in a cloned
+// repository: the root, or else its only subdirectory holding one
+func findExternalSkillDir(repoDir string) (string, error) {
+	if _, err := os.Stat(filepath.Join(repoDir, "SKILL.md")); err == nil {
+		return repoDir, nil
+	}
+	entries, err := os.ReadDir(repoDir)
+	if err != nil {
+		return "", err
+	}
+	var found []string
+	for _, e := range entries {
+		if !e.IsDir() || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(repoDir, e.Name(), "SKILL.md")); err == nil {
+			found = append(found, e.Name())
+		}
+	}
+	switch len(found) {
+	case 0:
+		return "", errors.New("no SKILL.md at the repository root or in a subdirectory")
+	case 1:
+		return filepath.Join(repoDir, found[0]), nil
+	default:
+		return "", fmt.Errorf("SKILL.md found in %d subdirectories (%s); only one skill per repository is supported",
+			len(found), namePreview(found, 3))
+	}
+}
+
+// readExternalSkill reads the skill cloned into repoDir as a catalog entry
+func readExternalSkill(home, repoDir string) (SkillInfo, error) {
+	skillDir, err := findExternalSkillDir(repoDir)
+	if err != nil {
+		return SkillInfo{}, err
+	}
+	skillFile := filepath.Join(skillDir, "SKILL.md")
+	if reason := skillFileProblem(skillFile); reason != "" {
+		return SkillInfo{}, errors.New(reason)
+	}
+	meta := parseSkillFrontmatter(skillFile)
+	name := meta.Name
+	if name == "" {
+		name = filepath.Base(skillDir)
+	}
+	installedIn := skillInstalledTargets(home, name)
+	return SkillInfo{
+		Name:        name,
+		Description: meta.Description,
+		Category:    externalSkillCategory,
+		DirName:     filepath.Base(skillDir),
+		FullPath:    skillDir,
+		Installed:   len(installedIn) > 0,
+		InstalledIn: installedIn,
+		Type:        "skill",
+		Tags:        meta.Tags,
+		Permissions: meta.Permissions,
+	}, nil
+}
+
+// scanExternalSkills reads every external clone, with a warning for each one
+// that holds no usable skill. Clones left in the catalog by an older version
+// are moved out first.
+func scanExternalSkills(home string) ([]SkillInfo, []SkillCatalogWarning) {
+	var warnings []SkillCatalogWarning
+	if err := moveLegacyExternalSkills(home); err != nil {
+		warnings = append(warnings, SkillCatalogWarning{Path: legacyExternalSkillsDir(home), Reason: "could not move out of the catalog: " + err.Error()})
+	}
+	entries, err := os.ReadDir(externalSkillsDir(home))
+	if err != nil {
+		return nil, warnings
+	}
+	var skills []SkillInfo
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		repoDir := filepath.Join(externalSkillsDir(home), e.Name())
+		skill, err := readExternalSkill(home, repoDir)
+		if err != nil {
+			warnings = append(warnings, SkillCatalogWarning{Path: repoDir, Reason: err.Error()})
+			continue
+		}
+		skills = append(skills, skill)
+	}
+	return skills, warnings
+}
+
+// cloneExternalSkill clones rawURL shallowly into the external dir and
+// returns its skill. A repository already cloned, a clone without a usable
+// SKILL.md, or a skill named like one in the catalog is an error, and the
+// clone is removed again.
+func cloneExternalSkill(home, rawURL string, catalog []SkillInfo) (SkillInfo, error) {
+	repo, err := externalSkillRepoName(rawURL)
+	if err != nil {
+		return SkillInfo{}, err
+	}
+	dest := filepath.Join(externalSkillsDir(home), repo)
+	if _, err := os.Lstat(dest); err == nil {
+		return SkillInfo{}, fmt.Errorf("%s already exists; remove it to clone %s again", contractHome(dest), repo)
+	}
+	if err := os.MkdirAll(externalSkillsDir(home), 0755); err != nil {
+		return SkillInfo{}, err
+	}
+	if _, err := system.GitClone(context.Background(), []string{rawURL}, dest, system.CloneOptions{Progress: sendSkillCloneProgress}); err != nil {
+		return SkillInfo{}, fmt.Errorf("failed to clone %s: %w", rawURL, err)
+	}
+
+	skill, err := readExternalSkill(home, dest)
+	if err == nil {
+		for _, s := range catalog {
+			if s.Type == "skill" && strings.EqualFold(s.Name, skill.Name) {
+				err = fmt.Errorf("a skill named %s is already in the catalog (%s)", s.Name, s.Category)
+				break
+			}
+		}
+	}
+	if err != nil {
+		os.RemoveAll(dest)
+		return SkillInfo{}, fmt.Errorf("%s: %w", rawURL, err)
+	}
+	return skill, nil
+}
+
+// pullExternalSkills pulls every external clone under home. It returns the
+// skill directories whose commit moved, relative to the catalog like
+// changedSkillDirs, and the clones that couldn't be pulled with git's reason.
+func pullExternalSkills(run CommandRunner, home string) (changed []string, failed []SkillSummaryEntry) {
+	entries, err := os.ReadDir(externalSkillsDir(home))
+	if err != nil {
+		return nil, nil
+	}
+	for _, e := range entries {
+		repoDir := filepath.Join(externalSkillsDir(home), e.Name())
+		if _, err := os.Stat(filepath.Join(repoDir, ".git")); err != nil {
+			continue
+		}
+		before, _ := run("git", "-C", repoDir, "rev-parse", "HEAD")
+		if out, err := run("git", "-C", repoDir, "pull", "--ff-only"); err != nil {
+			failed = append(failed, SkillSummaryEntry{Name: externalSkillCategory + "/" + e.Name(), Reason: firstLine(out)})
+			continue
+		}
+		after, _ := run("git", "-C", repoDir, "rev-parse", "HEAD")
+		if strings.TrimSpace(before) == strings.TrimSpace(after) {
+			continue
+		}
+		if skillDir, err := findExternalSkillDir(repoDir); err == nil {
+			if rel, err := filepath.Rel(paths.SkillsDir(home), skillDir); err == nil {
+				changed = append(changed, filepath.ToSlash(rel))
+			}
+		}
+	}
+	return changed, failed
+}
+
+// enterSkillURL opens the URL prompt on an empty input
+func (m Model) enterSkillURL() Model {
+	m.ProjectPathInput = ""
+	m.ProjectPathCursor = 0
+	m.ProjectPathError = ""
+	m.ProjectPathMode = PathModeTyping
+	m.Screen = ScreenSkillURL
+	return m
+}
+
+// handleSkillURLKeys edits the URL; enter clones it and links its skill,
+// showing the outcome on the result screen
+func (m Model) handleSkillURLKeys(key string) (tea.Model, tea.Cmd) {
+	if key != "enter" {
+		return m.editPathInput(key), nil
+	}
+
+	rawURL := strings.TrimSpace(m.ProjectPathInput)
+	if rawURL == "" {
+		m.ProjectPathError = "URL cannot be empty"
+		return m, nil
+	}
+	if _, err := externalSkillRepoName(rawURL); err != nil {
+		m.ProjectPathError = err.Error()
+		return m, nil
+	}
+
+	m.initSkillTargets()
+	m.SkillLoadError = ""
+	m.SkillResultLog.Reset()
+	m.ErrorMsg = ""
+	m.Screen = ScreenSkillResult
+	return m, installExternalSkillCmd(rawURL, SkillLinkOptions{Targets: m.SkillTargets})
+}
+
+// installExternalSkillCmd clones the skill repository at rawURL and links its
+// skill like a catalog one
+func installExternalSkillCmd(rawURL string, opts SkillLinkOptions) tea.Cmd {
+	return func() tea.Msg {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return skillActionCompleteMsg{err: fmt.Errorf("cannot determine home directory: %w", err), undo: currentSkillUndo()}
+		}
+		// Also clones the catalog the external skills live in when it's missing
+		catalog, _, err := fetchSkillCatalog()
+		if err != nil {
+			return skillActionCompleteMsg{err: err, undo: currentSkillUndo()}
+		}
+		skill, err := cloneExternalSkill(home, rawURL, catalog)
+		if err != nil {
+			return skillActionCompleteMsg{logLines: []string{"❌ " + err.Error()}, err: err, undo: currentSkillUndo()}
+		}
+		repo, _ := externalSkillRepoName(rawURL)
+		logLines := []string{"📦 Cloned " + rawURL + " into " + contractHome(filepath.Join(externalSkillsDir(home), repo))}
+		_, installLog, err := InstallCatalogSkills([]SkillInfo{skill}, nil, opts)
+		logLines = append(logLines, installLog...)
+		return skillActionCompleteMsg{logLines: logLines, err: err, undo: currentSkillUndo()}
+	}
+}
+
+func (m Model) renderSkillURL() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n\n")
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
+	s.WriteString("\n\n")
+	s.WriteString(m.renderPathInput())
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render("  e.g. https://github.com/owner/my-skill or git@github.com:owner/my-skill.git"))
+	s.WriteString("\n\n")
+	s.WriteString(m.Theme.Help.Render("  Enter: install  •  Esc: cancel"))
+
+	return s.String()
+}
@@ -0,0 +1,233 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestExternalSkillRepoName(t *testing.T) {
+	valid := map[string]string{
+		"https://github.com/owner/my-skill":      "my-skill",
+		"https://github.com/owner/my-skill.git/": "my-skill",
+		"git@github.com:owner/my-skill.git":      "my-skill",
+		"ssh://git@host:2222/owner/my_skill":     "my_skill",
+		"file:///tmp/repos/my-skill":             "my-skill",
+	}
+	for url, want := range valid {
+		if got, err := externalSkillRepoName(url); err != nil || got != want {
+			t.Errorf("%s: got %q (%v), want %q", url, got, err, want)
+		}
+	}
+	for _, url := range []string{"my-skill", "https://github.com", "ftp://host/owner/repo", "https://github.com/owner/my skill", "--upload-pack=evil", "/tmp/repo"} {
+		if _, err := externalSkillRepoName(url); err == nil {
+			t.Errorf("%q should be rejected", url)
+		}
+	}
+}
+
+func TestFindExternalSkillDir(t *testing.T) {
+	root := t.TempDir()
+	writeSkill(t, root, "root", "")
+	if dir, err := findExternalSkillDir(filepath.Join(root, "root")); err != nil || dir != filepath.Join(root, "root") {
+		t.Errorf("a SKILL.md at the root should be used, got %q (%v)", dir, err)
+	}
+
+	writeSkill(t, root, "nested/skill", "")
+	os.MkdirAll(filepath.Join(root, "nested", "docs"), 0755)
+	if dir, err := findExternalSkillDir(filepath.Join(root, "nested")); err != nil || dir != filepath.Join(root, "nested", "skill") {
+		t.Errorf("the only subdirectory with a SKILL.md should be used, got %q (%v)", dir, err)
+	}
+
+	writeSkill(t, root, "nested/other", "")
+	if _, err := findExternalSkillDir(filepath.Join(root, "nested")); err == nil || !strings.Contains(err.Error(), "only one skill") {
+		t.Errorf("several skills should be refused, got %v", err)
+	}
+
+	os.MkdirAll(filepath.Join(root, "empty", "docs"), 0755)
+	if _, err := findExternalSkillDir(filepath.Join(root, "empty")); err == nil || !strings.Contains(err.Error(), "no SKILL.md") {
+		t.Errorf("a repository without SKILL.md should be refused, got %v", err)
+	}
+}
+
+// skillRepo creates a git repository named name holding the skill files of
+// rels, and returns its file:// URL
+func skillRepo(t *testing.T, name string, rels ...string) string {
+	t.Helper()
+	dir := filepath.Join(t.TempDir(), name)
+	os.MkdirAll(dir, 0755)
+	gitRun(t, dir, "init", "--quiet")
+	os.WriteFile(filepath.Join(dir, "README.md"), []byte(name), 0644)
+	for _, rel := range rels {
+		writeSkill(t, dir, rel, "v1")
+	}
+	gitRun(t, dir, "add", "-A")
+	gitRun(t, dir, "commit", "--quiet", "-m", "one")
+	return "file://" + dir
+}
+
+func TestSkillURLScreen(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	writeTargetSkill(t, home)
+	press := func(m Model, key tea.KeyMsg) (Model, tea.Cmd) {
+		result, cmd := m.Update(key)
+		return result.(Model), cmd
+	}
+	install := func(m Model, url string) Model {
+		t.Helper()
+		m = m.enterSkillURL()
+		m.ProjectPathInput = url
+		m, cmd := press(m, tea.KeyMsg{Type: tea.KeyEnter})
+		if m.Screen != ScreenSkillResult || cmd == nil {
+			t.Fatalf("expected the clone to start, got %v (%s)", m.Screen, m.ProjectPathError)
+		}
+		result, _ := m.Update(cmd())
+		return result.(Model)
+	}
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+
+	m := NewModel()
+	m.Screen = ScreenSkillMenu
+	m.Cursor = 9
+	m, _ = press(m, enter)
+	if m.Screen != ScreenSkillURL || m.ProjectPathInput != "" {
+		t.Fatalf("expected the URL prompt, got %v", m.Screen)
+	}
+	m.ProjectPathInput = "not a url"
+	m, cmd := press(m, enter)
+	if m.Screen != ScreenSkillURL || cmd != nil || !strings.Contains(m.ProjectPathError, "invalid git URL") {
+		t.Fatalf("an invalid URL should be reported on the prompt, got %v %q", m.Screen, m.ProjectPathError)
+	}
+	m, _ = press(m, tea.KeyMsg{Type: tea.KeyEsc})
+	if m.Screen != ScreenSkillMenu || m.Cursor != 9 {
+		t.Errorf("esc should return to Install from URL, got %v at %d", m.Screen, m.Cursor)
+	}
+
+	// A skill in a subdirectory is cloned beside the catalog and linked
+	m.SkillTargets = []string{"claude"}
+	url := skillRepo(t, "my-skill", "skill")
+	m = install(m, url)
+	clone := filepath.Join(paths.ExternalSkillsDir(home), "my-skill")
+	if !symlinkPointsTo(filepath.Join(home, ".claude", "skills", "skill"), filepath.Join(clone, "skill")) || m.ErrorMsg != "" {
+		t.Fatalf("the skill should be linked into the clone, got %v (%q)", m.SkillResultLog.Lines(), m.ErrorMsg)
+	}
+	catalog, _, err := fetchSkillCatalog()
+	if err != nil {
+		t.Fatal(err)
+	}
+	i := slices.IndexFunc(catalog, func(s SkillInfo) bool { return s.Name == "skill" })
+	if i < 0 || catalog[i].Category != externalSkillCategory || !catalog[i].Installed {
+		t.Fatalf("the external skill should be listed as installed, got %+v", catalog)
+	}
+	m.SkillCatalog = catalog
+	if !slices.Contains(optionLabels(m.buildSkillBrowseRows()), "🔗 External") {
+		t.Error("Browse should group it under External")
+	}
+
+	// The same repository twice, a skill named like a catalog one, no SKILL.md
+	for _, tc := range []struct{ url, want string }{
+		{url, "already exists"},
+		{skillRepo(t, "react", "react-19"), "a skill named react-19 is already in the catalog"},
+		{skillRepo(t, "notes"), "no SKILL.md"},
+	} {
+		m = install(m, tc.url)
+		if !strings.Contains(strings.Join(m.SkillResultLog.Lines(), "\n"), tc.want) || m.ErrorMsg == "" {
+			t.Errorf("%s: expected %q, got %v", tc.url, tc.want, m.SkillResultLog.Lines())
+		}
+	}
+	if _, err := os.Stat(filepath.Join(paths.ExternalSkillsDir(home), "react")); !os.IsNotExist(err) {
+		t.Error("a refused clone should be removed")
+	}
+	if _, err := os.Stat(filepath.Join(clone, "skill", "SKILL.md")); err != nil {
+		t.Error("the existing clone should be left alone")
+	}
+}
+
+func TestUpdateSkillCatalogPullsExternalClones(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	upstream := t.TempDir()
+	gitRun(t, upstream, "init", "--quiet")
+	writeSkill(t, upstream, "curated/react-19", "v1")
+	gitRun(t, upstream, "add", "-A")
+	gitRun(t, upstream, "commit", "--quiet", "-m", "one")
+	catalog := paths.SkillsDir(home)
+	os.MkdirAll(filepath.Dir(catalog), 0755)
+	gitRun(t, upstream, "clone", "--quiet", upstream, catalog)
+
+	url := skillRepo(t, "my-skill", "my-skill")
+	skill, err := cloneExternalSkill(home, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := installSkills([]SkillInfo{skill}, SkillLinkOptions{Targets: []string{"claude"}}); err != nil {
+		t.Fatal(err)
+	}
+	// A clone whose remote is gone can't be pulled
+	broken := skillRepo(t, "broken", "broken")
+	if _, err := cloneExternalSkill(home, broken, nil); err != nil {
+		t.Fatal(err)
+	}
+	os.RemoveAll(strings.TrimPrefix(broken, "file://"))
+
+	repo := strings.TrimPrefix(url, "file://")
+	writeSkill(t, repo, "my-skill", "v2")
+	gitRun(t, repo, "commit", "--quiet", "-am", "two")
+
+	changes, err := updateSkillCatalog(execRunner, home, func(string) {})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(changes.Updated, []string{"my-skill"}) {
+		t.Errorf("the pulled external skill should be reported, got %v", changes.Updated)
+	}
+	lines := strings.Join(changes.logLines(home), "\n")
+	if !strings.Contains(lines, "1 installed skill updated: my-skill") || !strings.Contains(lines, "external/broken: git pull failed") {
+		t.Errorf("unexpected summary:\n%s", lines)
+	}
+	data, _ := os.ReadFile(filepath.Join(home, ".claude", "skills", "my-skill", "SKILL.md"))
+	if !strings.Contains(string(data), "v2") {
+		t.Error("the linked skill should read the pulled version")
+	}
+}
+
+func TestExternalSkillClonesOutsideCatalog(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	// A clone left under the catalog by an older version is moved out, and
+	// its links follow it
+	legacy := filepath.Join(legacyExternalSkillsDir(home), "old-skill")
+	writeSkill(t, legacy, "old-skill", "v1")
+	link := filepath.Join(home, ".claude", "skills", "old-skill")
+	os.MkdirAll(filepath.Dir(link), 0755)
+	if err := os.Symlink(filepath.Join(legacy, "old-skill"), link); err != nil {
+		t.Fatal(err)
+	}
+	skills, warnings := scanExternalSkills(home)
+	if len(warnings) != 0 || len(skills) != 1 {
+		t.Fatalf("the legacy clone should be listed, got %+v %v", skills, warnings)
+	}
+	moved := filepath.Join(paths.ExternalSkillsDir(home), "old-skill")
+	if !symlinkPointsTo(link, filepath.Join(moved, "old-skill")) {
+		t.Error("the link should point into the moved clone")
+	}
+	if _, err := os.Stat(legacyExternalSkillsDir(home)); !os.IsNotExist(err) {
+		t.Error("the catalog should no longer hold external clones")
+	}
+
+	// Removing the last link of an external skill deletes its clone
+	results, lines, err := removeSkills(skills, SkillLinkOptions{Targets: []string{"claude"}})
+	if err != nil || len(results) != 1 || len(results[0].Removed) != 1 {
+		t.Fatalf("removal failed: %+v %v", results, err)
+	}
+	if _, err := os.Stat(moved); !os.IsNotExist(err) {
+		t.Errorf("the clone should be deleted, got %v", lines)
+	}
+}
@@ -0,0 +1,136 @@
+package tui
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func filterTestModel() Model {
+	m := NewModel()
+	m.Screen = ScreenSkillInstall
+	m.SkillCatalog = []SkillInfo{
+		{Name: "react-19", Category: "curated", Description: "React 19 patterns"},
+		{Name: "angular", Category: "community", Description: "Signals and standalone components"},
+		{Name: "typescript", Category: "curated", Description: "Strict types for React and Node"},
+		{Name: "zod-4", Category: "community", Description: "Schema validation"},
+	}
+	m.SkillSelected = make([]bool, len(m.SkillCatalog))
+	return m
+}
+
+func typeKeys(t *testing.T, m Model, keys ...tea.KeyMsg) Model {
+	t.Helper()
+	for _, k := range keys {
+		result, _ := m.Update(k)
+		m = result.(Model)
+	}
+	return m
+}
+
+func runes(s string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+func TestSkillFilterOptions(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter string
+		want   []string
+	}{
+		{
+			name:   "no filter keeps every group",
+			filter: "",
+			want:   []string{"✅ Select All", skillCategoryHeader("curated"), "react-19", "typescript", skillCategoryHeader("community"), "angular", "zod-4"},
+		},
+		{
+			name:   "matches name or description ignoring case",
+			filter: "REACT",
+			want:   []string{"✅ Select All", skillCategoryHeader("curated"), "react-19", "typescript"},
+		},
+		{
+			name:   "empty groups lose their header",
+			filter: "schema",
+			want:   []string{"✅ Select All", skillCategoryHeader("community"), "zod-4"},
+		},
+		{
+			name:   "no match",
+			filter: "rust",
+			want:   []string{`No skills match "rust"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := filterTestModel()
+			m.SkillFilter = tt.filter
+			opts := m.GetCurrentOptions()
+			got := opts[:len(opts)-2] // drop separator and Confirm
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if !strings.HasPrefix(got[i], tt.want[i]) {
+					t.Errorf("option %d = %q, want prefix %q", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSkillFilterIndexMapping(t *testing.T) {
+	m := filterTestModel()
+	m = typeKeys(t, m, runes("/"), runes("z"), runes("o"), runes("d"), tea.KeyMsg{Type: tea.KeyEnter})
+	if m.SkillFilterActive || m.SkillFilter != "zod" {
+		t.Fatalf("expected the kept filter %q, got %q (active=%v)", "zod", m.SkillFilter, m.SkillFilterActive)
+	}
+
+	// Options: [0] Select All, [1] Community, [2] zod-4
+	if idx := skillRowIndex(m.skillRows(), 2); idx != 3 {
+		t.Errorf("filtered row should map to catalog index 3, got %d", idx)
+	}
+	m.Cursor = 2
+	m = typeKeys(t, m, runes(" "))
+	if want := []bool{false, false, false, true}; !slices.Equal(m.SkillSelected, want) {
+		t.Errorf("toggling the filtered row selected %v, want %v", m.SkillSelected, want)
+	}
+
+	// Select All only touches what the filter shows
+	m.SkillFilter = "react"
+	m.Cursor = 0
+	m = typeKeys(t, m, runes(" "))
+	if want := []bool{true, false, true, true}; !slices.Equal(m.SkillSelected, want) {
+		t.Errorf("Select All under a filter selected %v, want %v", m.SkillSelected, want)
+	}
+
+	// Category header toggles only its visible skills
+	m.SkillFilter = "a"
+	m.SkillSelected = make([]bool, 4)
+	// Options: [0] Select All, [1] Curated, [2] react-19, [3] typescript, [4] Community, [5] angular, [6] zod-4
+	m.Cursor = 4
+	m = typeKeys(t, m, runes(" "))
+	if want := []bool{false, true, false, true}; !slices.Equal(m.SkillSelected, want) {
+		t.Errorf("header toggle under a filter selected %v, want %v", m.SkillSelected, want)
+	}
+}
+
+func TestSkillFilterEscape(t *testing.T) {
+	m := filterTestModel()
+	m = typeKeys(t, m, runes("/"), runes("x"), tea.KeyMsg{Type: tea.KeyBackspace}, runes("z"))
+	if !m.SkillFilterActive || m.SkillFilter != "z" {
+		t.Fatalf("expected an active filter %q, got %q (active=%v)", "z", m.SkillFilter, m.SkillFilterActive)
+	}
+	if !strings.Contains(m.View(), "/ z") {
+		t.Error("expected the filter input in the view")
+	}
+
+	m = typeKeys(t, m, tea.KeyMsg{Type: tea.KeyEsc})
+	if m.Screen != ScreenSkillInstall || m.SkillFilter != "" || m.SkillFilterActive {
+		t.Errorf("first Esc should clear the filter and stay, got screen %d filter %q", m.Screen, m.SkillFilter)
+	}
+	m = typeKeys(t, m, tea.KeyMsg{Type: tea.KeyEsc})
+	if m.Screen != ScreenSkillMenu {
+		t.Errorf("second Esc should leave the screen, got %d", m.Screen)
+	}
+}
@@ -0,0 +1,49 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// appendSkillLog adds the lines of one skill operation to the day's skill log
+// under home, next to the install logs. The result screen only keeps the last
+// skillLogCap lines; the file keeps them all.
+func appendSkillLog(home string, now time.Time, lines []string) (string, error) {
+	if err := os.MkdirAll(installLogDir(home), 0755); err != nil {
+		return "", err
+	}
+	path := filepath.Join(installLogDir(home), "skills-"+now.Format("20060102")+".log")
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	var s strings.Builder
+	prefix := now.Format("15:04:05") + " "
+	s.WriteString(prefix + "──\n")
+	for _, line := range lines {
+		s.WriteString(prefix + line + "\n")
+	}
+	if _, err := f.WriteString(s.String()); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// setSkillResultLog shows lines on the result screen and appends them to the
+// skill log, when logs are written (see StateHome)
+func (m *Model) setSkillResultLog(lines []string) {
+	m.SkillResultLog.Set(lines...)
+	m.SkillLogPath = ""
+	if m.StateHome == "" || len(lines) == 0 {
+		return
+	}
+	path, err := appendSkillLog(m.StateHome, installClock(), lines)
+	if err != nil {
+		m.SkillResultLog.Add("⚠️  Could not write the skill log: " + err.Error())
+		return
+	}
+	m.SkillLogPath = path
+}
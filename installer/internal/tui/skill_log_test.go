@@ -0,0 +1,49 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSkillResultLogKeepsEveryLineInFile(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	fakeClock(t)
+
+	m := NewModel()
+	m.StateHome = home
+	var lines []string
+	for i := range skillLogCap + 50 {
+		lines = append(lines, fmt.Sprintf("✅ skill-%03d → ~/.claude/skills/", i))
+	}
+	result, _ := m.Update(skillActionCompleteMsg{logLines: lines})
+	m = result.(Model)
+
+	if m.SkillResultLog.Omitted() != 50 || m.SkillLogPath == "" {
+		t.Fatalf("the screen should keep the tail and point at the log, got %d omitted, path %q", m.SkillResultLog.Omitted(), m.SkillLogPath)
+	}
+	if filepath.Dir(m.SkillLogPath) != installLogDir(home) {
+		t.Errorf("the skill log should sit with the install logs, got %s", m.SkillLogPath)
+	}
+	data, err := os.ReadFile(m.SkillLogPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "skill-000") || !strings.Contains(string(data), lines[len(lines)-1]) {
+		t.Error("the file should keep the lines the screen dropped")
+	}
+	if !strings.Contains(m.renderSkillResult(), "Full log: ~/") {
+		t.Error("the result screen should show where the log is")
+	}
+
+	// A later operation is appended to the same day's log
+	result, _ = m.Update(skillActionCompleteMsg{logLines: []string{"🗑️  react-19 removed"}})
+	m = result.(Model)
+	data, _ = os.ReadFile(m.SkillLogPath)
+	if !strings.Contains(string(data), "skill-000") || !strings.Contains(string(data), "react-19 removed") {
+		t.Error("operations should be appended, not overwrite the log")
+	}
+}
@@ -0,0 +1,207 @@
+package tui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// SkillManifestFileName is where the manifest prompts start, under the home directory
+const SkillManifestFileName = "skills.txt"
+
+// skillManifestJSON is the layout of a skills.json manifest
+type skillManifestJSON struct {
+	Skills []string `json:"skills"`
+}
+
+// ReadSkillManifest reads the skill names a manifest lists: a .json file
+// holding {"skills": [...]}, or any other file with a name per line, where
+// blank lines and # comments are ignored. A name listed twice is kept once.
+func ReadSkillManifest(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var listed []string
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		var manifest skillManifestJSON
+		if err := json.Unmarshal(data, &manifest); err != nil {
+			return nil, fmt.Errorf("invalid skill manifest: %w", err)
+		}
+		listed = manifest.Skills
+	} else {
+		for _, line := range strings.Split(string(data), "\n") {
+			if line = strings.TrimSpace(line); !strings.HasPrefix(line, "#") {
+				listed = append(listed, line)
+			}
+		}
+	}
+
+	var names []string
+	for _, n := range listed {
+		if n = strings.TrimSpace(n); n != "" && !slices.Contains(names, n) {
+			names = append(names, n)
+		}
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no skills listed in %s", path)
+	}
+	return names, nil
+}
+
+// WriteSkillManifest writes names as a manifest, in JSON when path ends in .json
+func WriteSkillManifest(path string, names []string) error {
+	var data []byte
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		var err error
+		if data, err = json.MarshalIndent(skillManifestJSON{Skills: names}, "", "  "); err != nil {
+			return err
+		}
+		data = append(data, '\n')
+	} else {
+		var b strings.Builder
+		b.WriteString("# Skills to install with --skill-manifest or Skill Manager > Install from Manifest\n")
+		for _, n := range names {
+			b.WriteString(n + "\n")
+		}
+		data = []byte(b.String())
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// MatchCatalogSkills looks up each requested name (skill name or directory name)
+// in the catalog, returning the matches and the names that weren't found. The
+// first match wins, so curated skills come before community ones, and a skill
+// asked for twice, by either name, is returned once.
+func MatchCatalogSkills(catalog []SkillInfo, names []string) ([]SkillInfo, []string) {
+	var matched []SkillInfo
+	var missing []string
+	for _, n := range names {
+		i := slices.IndexFunc(catalog, func(s SkillInfo) bool { return s.Name == n || s.DirName == n })
+		switch {
+		case i < 0:
+			if !slices.Contains(missing, n) {
+				missing = append(missing, n)
+			}
+		case !slices.ContainsFunc(matched, func(s SkillInfo) bool { return s.FullPath == catalog[i].FullPath && s.Name == catalog[i].Name }):
+			matched = append(matched, catalog[i])
+		}
+	}
+	return matched, missing
+}
+
+// InstalledSkillNames lists the installed skills and plugins of the catalog,
+// sorted. Local skills are left out: nobody else's catalog has them.
+func InstalledSkillNames(catalog []SkillInfo) []string {
+	var names []string
+	for _, s := range catalog {
+		if s.Installed && s.Category != "local" && !slices.Contains(names, s.Name) {
+			names = append(names, s.Name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// enterSkillManifest opens the manifest prompt on the path input, prefilled
+// with ~/skills.txt; export writes the installed skills there instead of
+// installing the ones it lists
+func (m Model) enterSkillManifest(export bool) Model {
+	path := "~/" + SkillManifestFileName
+	m.SkillManifestExport = export
+	m.ProjectPathInput = path
+	m.ProjectPathCursor = len([]rune(path))
+	m.ProjectPathError = ""
+	m.ProjectPathMode = PathModeTyping
+	m.Screen = ScreenSkillManifest
+	return m
+}
+
+// handleSkillManifestKeys edits the manifest path; enter installs what it
+// lists, or exports to it, and shows the outcome on the result screen
+func (m Model) handleSkillManifestKeys(key string) (tea.Model, tea.Cmd) {
+	if key != "enter" {
+		return m.editPathInput(key), nil
+	}
+
+	path := expandPath(strings.TrimSpace(m.ProjectPathInput))
+	if path == "" {
+		m.ProjectPathError = "Path cannot be empty"
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	if m.SkillManifestExport {
+		cmd = exportSkillManifestCmd(path)
+	} else {
+		names, err := ReadSkillManifest(path)
+		if err != nil {
+			m.ProjectPathError = err.Error()
+			return m, nil
+		}
+		m.initSkillTargets()
+		cmd = installSkillManifestCmd(names, SkillLinkOptions{Targets: m.SkillTargets})
+	}
+	m.SkillLoadError = ""
+	m.SkillResultLog.Reset()
+	m.ErrorMsg = ""
+	m.Screen = ScreenSkillResult
+	return m, cmd
+}
+
+// installSkillManifestCmd installs the catalog skills named in a manifest,
+// listing the names the catalog doesn't have
+func installSkillManifestCmd(names []string, opts SkillLinkOptions) tea.Cmd {
+	return func() tea.Msg {
+		catalog, _, err := fetchSkillCatalog()
+		if err != nil {
+			return skillActionCompleteMsg{err: err, undo: currentSkillUndo()}
+		}
+		skills, missing := MatchCatalogSkills(catalog, names)
+		_, logLines, err := InstallCatalogSkills(skills, missing, opts)
+		return skillActionCompleteMsg{logLines: logLines, err: err, undo: currentSkillUndo()}
+	}
+}
+
+// exportSkillManifestCmd writes the installed catalog skills to a manifest at path
+func exportSkillManifestCmd(path string) tea.Cmd {
+	return func() tea.Msg {
+		catalog, _, err := fetchSkillCatalog()
+		if err != nil {
+			return skillActionCompleteMsg{err: err, undo: currentSkillUndo()}
+		}
+		names := InstalledSkillNames(catalog)
+		if err := WriteSkillManifest(path, names); err != nil {
+			return skillActionCompleteMsg{err: err, undo: currentSkillUndo()}
+		}
+		line := fmt.Sprintf("📤 Exported %d skill(s) to %s", len(names), contractHome(path))
+		return skillActionCompleteMsg{logLines: []string{line}, undo: currentSkillUndo()}
+	}
+}
+
+func (m Model) renderSkillManifest() string {
+	var s strings.Builder
+
+	action := "install"
+	if m.SkillManifestExport {
+		action = "export"
+	}
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n\n")
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
+	s.WriteString("\n\n")
+	s.WriteString(m.renderPathInput())
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render("  Enter: " + action + "  •  Esc: cancel"))
+
+	return s.String()
+}
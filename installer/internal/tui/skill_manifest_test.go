@@ -0,0 +1,136 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestReadSkillManifest(t *testing.T) {
+	tests := []struct {
+		fixture string
+		want    []string
+	}{
+		{"skills.txt", []string{"react-19", "typescript", "tailwind-4"}},
+		{"skills.json", []string{"react-19", "typescript"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			got, err := ReadSkillManifest(filepath.Join("testdata", "skill-manifests", tt.fixture))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v (duplicates and blanks dropped)", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := ReadSkillManifest(filepath.Join("testdata", "skill-manifests", "empty.txt")); err == nil {
+		t.Error("a manifest without skills should be an error")
+	}
+	bad := filepath.Join(t.TempDir(), "skills.json")
+	os.WriteFile(bad, []byte(`["react-19"`), 0644)
+	if _, err := ReadSkillManifest(bad); err == nil {
+		t.Error("invalid JSON should be an error")
+	}
+}
+
+func TestWriteSkillManifestRoundTrip(t *testing.T) {
+	names := []string{"react-19", "typescript"}
+	for _, file := range []string{"skills.txt", "skills.json"} {
+		path := filepath.Join(t.TempDir(), "team", file)
+		if err := WriteSkillManifest(path, names); err != nil {
+			t.Fatal(err)
+		}
+		if got, err := ReadSkillManifest(path); err != nil || !reflect.DeepEqual(got, names) {
+			t.Errorf("%s: read back %v (%v), want %v", file, got, err, names)
+		}
+	}
+}
+
+func TestInstalledSkillNames(t *testing.T) {
+	catalog := []SkillInfo{
+		{Name: "typescript", Installed: true},
+		{Name: "react-19", Installed: true},
+		{Name: "react-19", Category: "community", Installed: true},
+		{Name: "tailwind-4"},
+		{Name: "my-notes", Category: "local", Installed: true},
+	}
+	if got := InstalledSkillNames(catalog); !reflect.DeepEqual(got, []string{"react-19", "typescript"}) {
+		t.Errorf("got %v", got)
+	}
+}
+
+func TestSkillManifestScreen(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	skill := writeTargetSkill(t, home)
+	manifest := filepath.Join(home, "team.txt")
+	os.WriteFile(manifest, []byte("react-19\nnope\nreact-19\n"), 0644)
+	press := func(m Model, key tea.KeyMsg) (Model, tea.Cmd) {
+		result, cmd := m.Update(key)
+		return result.(Model), cmd
+	}
+	deliver := func(m Model, msg tea.Msg) Model {
+		result, _ := m.Update(msg)
+		return result.(Model)
+	}
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+
+	m := NewModel()
+	m.Screen = ScreenSkillMenu
+	m.Cursor = 7
+	m, _ = press(m, enter)
+	if m.Screen != ScreenSkillManifest || m.ProjectPathInput != "~/skills.txt" {
+		t.Fatalf("expected the manifest prompt on ~/skills.txt, got %v %q", m.Screen, m.ProjectPathInput)
+	}
+
+	// A missing file stays on the prompt
+	m, cmd := press(m, enter)
+	if m.Screen != ScreenSkillManifest || cmd != nil || m.ProjectPathError == "" {
+		t.Fatalf("a missing manifest should be reported on the prompt, got %v", m.Screen)
+	}
+
+	m.ProjectPathInput = manifest
+	m.SkillTargets = []string{"claude"}
+	m, cmd = press(m, enter)
+	if m.Screen != ScreenSkillResult || cmd == nil {
+		t.Fatalf("expected the install to start, got %v", m.Screen)
+	}
+	m = deliver(m, cmd())
+	if !symlinkPointsTo(filepath.Join(home, ".claude", "skills", "react-19"), skill.FullPath) {
+		t.Error("the listed skill should be linked")
+	}
+	log := strings.Join(m.SkillResultLog.Lines(), "\n")
+	if !strings.Contains(log, "nope: not found in catalog") || m.ErrorMsg == "" {
+		t.Errorf("the unknown skill should be reported, got %q (%q)", log, m.ErrorMsg)
+	}
+
+	// Export writes what is installed now
+	m.Screen = ScreenSkillMenu
+	m.Cursor = 8
+	m, _ = press(m, enter)
+	m.ProjectPathInput = filepath.Join(home, "out", "skills.json")
+	m, cmd = press(m, enter)
+	m = deliver(m, cmd())
+	if got, err := ReadSkillManifest(filepath.Join(home, "out", "skills.json")); err != nil || !reflect.DeepEqual(got, []string{"react-19"}) {
+		t.Errorf("exported %v (%v)", got, err)
+	}
+	if !strings.Contains(strings.Join(m.SkillResultLog.Lines(), "\n"), "Exported 1 skill(s)") {
+		t.Errorf("the export should be reported, got %v", m.SkillResultLog.Lines())
+	}
+
+	// Esc returns to the entry it came from
+	m.Screen = ScreenSkillMenu
+	m.Cursor = 8
+	m, _ = press(m, enter)
+	m, _ = press(m, tea.KeyMsg{Type: tea.KeyEsc})
+	if m.Screen != ScreenSkillMenu || m.Cursor != 8 {
+		t.Errorf("esc should return to Export, got %v at %d", m.Screen, m.Cursor)
+	}
+}
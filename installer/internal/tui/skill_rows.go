@@ -0,0 +1,95 @@
+package tui
+
+// OptionRowKind says what a row of a skill list is, so the handlers and
+// renderers never have to recognise rows by their label or icon
+type OptionRowKind int
+
+const (
+	RowSkill     OptionRowKind = iota // A skill; SkillIdx says which
+	RowHeader                         // A category header over the skill rows after it
+	RowSelectAll                      // Toggles every skill listed
+	RowSeparator
+	RowConfirm // Acts on the selected skills
+	RowBack
+	RowNote // Text standing in for an empty list
+)
+
+// OptionRow is one row of the Browse, Install and Remove lists. SkillIdx
+// indexes the list the screen picks from, browseSkills on Browse and
+// SkillSelected on Install and Remove, and is -1 on every other kind of row.
+type OptionRow struct {
+	Label    string
+	Kind     OptionRowKind
+	SkillIdx int
+}
+
+var separatorRow = OptionRow{Label: "─────────────", Kind: RowSeparator, SkillIdx: -1}
+
+func noteRow(label string) OptionRow {
+	return OptionRow{Label: label, Kind: RowNote, SkillIdx: -1}
+}
+
+// optionLabels returns the label of each row, the options GetCurrentOptions lists
+func optionLabels(rows []OptionRow) []string {
+	labels := make([]string, len(rows))
+	for i, r := range rows {
+		labels[i] = r.Label
+	}
+	return labels
+}
+
+// skillRows builds the rows of the current skill list screen
+func (m Model) skillRows() []OptionRow {
+	switch m.Screen {
+	case ScreenSkillBrowse:
+		return m.buildSkillBrowseRows()
+	case ScreenSkillInstall:
+		return m.buildSkillInstallRows()
+	case ScreenSkillRemove:
+		return m.buildSkillRemoveRows()
+	}
+	return nil
+}
+
+// rowAt returns row i, or a note row when i is out of range
+func rowAt(rows []OptionRow, i int) OptionRow {
+	if i < 0 || i >= len(rows) {
+		return noteRow("")
+	}
+	return rows[i]
+}
+
+// skillRowIndex returns the SkillIdx of the skill row at cursor, or -1 off a skill
+func skillRowIndex(rows []OptionRow, cursor int) int {
+	if row := rowAt(rows, cursor); row.Kind == RowSkill {
+		return row.SkillIdx
+	}
+	return -1
+}
+
+// skillGroupIndexes returns the SkillIdx of every skill under the category
+// header at cursor, or nil if the cursor is not on a header with skills
+func skillGroupIndexes(rows []OptionRow, cursor int) []int {
+	if rowAt(rows, cursor).Kind != RowHeader {
+		return nil
+	}
+	var group []int
+	for _, row := range rows[cursor+1:] {
+		if row.Kind != RowSkill {
+			break
+		}
+		group = append(group, row.SkillIdx)
+	}
+	return group
+}
+
+// skillConfirmIndex returns the Confirm row of a skill multi-select, or -1
+// when the screen has nothing to confirm
+func skillConfirmIndex(rows []OptionRow) int {
+	for i, row := range rows {
+		if row.Kind == RowConfirm {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,203 @@
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// rowsTestModel lists a skill of every category, out of Browse order, on the
+// install screen
+func rowsTestModel(t *testing.T, ascii bool) Model {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	m := NewModel()
+	m.ASCIIIcons = ascii
+	m.Screen = ScreenSkillInstall
+	dir := t.TempDir()
+	m.SkillCatalog = []SkillInfo{
+		{Name: "react-19", Category: "curated", Type: "skill", Description: "React 19 patterns", FullPath: dir},
+		{Name: "mermaid", Category: "plugin", Type: "plugin", FullPath: dir},
+		{Name: "my-skill", Category: externalSkillCategory, Type: "skill", FullPath: dir},
+		{Name: "zod-4", Category: "community", Type: "skill", FullPath: dir},
+		{Name: "bff", Category: "local:backend", Type: "skill", FullPath: dir},
+		{Name: "typescript", Category: "curated", Type: "skill", FullPath: dir},
+	}
+	m.SkillSelected = make([]bool, len(m.SkillCatalog))
+	return m
+}
+
+// rowShape describes rows by kind and SkillIdx only, leaving the labels out
+func rowShape(rows []OptionRow) string {
+	var parts []string
+	for _, r := range rows {
+		switch r.Kind {
+		case RowSkill:
+			parts = append(parts, fmt.Sprintf("skill:%d", r.SkillIdx))
+		case RowHeader:
+			parts = append(parts, "header")
+		case RowSelectAll:
+			parts = append(parts, "all")
+		case RowSeparator:
+			parts = append(parts, "sep")
+		case RowConfirm:
+			parts = append(parts, "confirm")
+		case RowBack:
+			parts = append(parts, "back")
+		case RowNote:
+			parts = append(parts, "note")
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestSkillInstallRows(t *testing.T) {
+	m := rowsTestModel(t, false)
+	rows := m.skillRows()
+	// Curated, community, plugin, external, then the local groups
+	want := "all header skill:0 skill:5 header skill:3 header skill:1 header skill:2 header skill:4 sep confirm"
+	if got := rowShape(rows); got != want {
+		t.Fatalf("rows = %s\nwant   %s", got, want)
+	}
+	for i, header := range map[int]string{1: "📦 Curated", 6: "━━━ Plugins ━━━", 8: "🔗 External", 10: "🏠 Backend"} {
+		if rows[i].Label != header {
+			t.Errorf("row %d = %q, want the %q header", i, rows[i].Label, header)
+		}
+	}
+	if got := optionLabels(rows); strings.Join(got, "|") != strings.Join(m.GetCurrentOptions(), "|") {
+		t.Errorf("GetCurrentOptions should list the row labels, got %v", m.GetCurrentOptions())
+	}
+	if skillConfirmIndex(rows) != len(rows)-1 {
+		t.Errorf("Confirm should be the last row, got %d", skillConfirmIndex(rows))
+	}
+
+	m.SkillFilter = "nothing-matches"
+	if got := rowShape(m.skillRows()); got != "note sep confirm" {
+		t.Errorf("a filter matching nothing should leave a note, got %s", got)
+	}
+	m.SkillFilter = ""
+	for i := range m.SkillCatalog {
+		m.SkillCatalog[i].InstalledIn = skillTargetIDs()
+	}
+	if got := rowShape(m.skillRows()); got != "note sep back" {
+		t.Errorf("nothing to install should leave a note and Back, got %s", got)
+	}
+}
+
+func TestSkillRowGroups(t *testing.T) {
+	rows := rowsTestModel(t, false).skillRows()
+	tests := []struct {
+		cursor int
+		want   []int
+	}{
+		{1, []int{0, 5}},
+		{4, []int{3}},
+		{6, []int{1}}, // Plugins has no emoji to be recognised by
+		{8, []int{2}},
+		{10, []int{4}},
+		{0, nil},
+		{2, nil},
+		{12, nil},
+		{99, nil},
+	}
+	for _, tt := range tests {
+		if got := skillGroupIndexes(rows, tt.cursor); !slices.Equal(got, tt.want) {
+			t.Errorf("group at %d = %v, want %v", tt.cursor, got, tt.want)
+		}
+	}
+	if idx := skillRowIndex(rows, 3); idx != 5 {
+		t.Errorf("row 3 should be typescript, catalog index 5, got %d", idx)
+	}
+	for _, cursor := range []int{-1, 0, 1, 12, 13, 14} {
+		if idx := skillRowIndex(rows, cursor); idx != -1 {
+			t.Errorf("row %d is not a skill, got %d", cursor, idx)
+		}
+	}
+}
+
+// The selection goes by row kind, so both icon sets pick the same skills
+func TestSkillSelectionBothIconSets(t *testing.T) {
+	space := runes(" ")
+	down := tea.KeyMsg{Type: tea.KeyDown}
+	for _, ascii := range []bool{false, true} {
+		t.Run(fmt.Sprintf("ascii=%v", ascii), func(t *testing.T) {
+			m := rowsTestModel(t, ascii)
+
+			m.Cursor = 6
+			m = typeKeys(t, m, space)
+			if want := []bool{false, true, false, false, false, false}; !slices.Equal(m.SkillSelected, want) {
+				t.Errorf("the Plugins header should toggle mermaid alone, got %v", m.SkillSelected)
+			}
+			m.Cursor = 8
+			m = typeKeys(t, m, space, down, space)
+			if want := []bool{false, true, false, false, false, false}; !slices.Equal(m.SkillSelected, want) {
+				t.Errorf("the External header and then my-skill should toggle it twice, got %v", m.SkillSelected)
+			}
+			m.Cursor = 1
+			m = typeKeys(t, m, space)
+			if want := []bool{true, true, false, false, false, true}; !slices.Equal(m.SkillSelected, want) {
+				t.Errorf("the Curated header should toggle both curated skills, got %v", m.SkillSelected)
+			}
+
+			// Down from the last skill skips the separator onto Confirm
+			m.Cursor = 11
+			m = typeKeys(t, m, down)
+			if m.Cursor != 13 {
+				t.Errorf("down should skip the separator, got cursor %d", m.Cursor)
+			}
+
+			view := m.View()
+			checks := []string{"[✓] typescript", "[✓] 📦 Curated", "[ ] 🔗 External"}
+			if ascii {
+				checks = []string{"[x] typescript", "[x] #  Curated", "[ ] &  External"}
+			}
+			for _, check := range checks {
+				if !strings.Contains(view, check) {
+					t.Errorf("expected %q in the view:\n%s", check, view)
+				}
+			}
+			if ascii && strings.ContainsAny(view, "✓✅📦🔗▸─") {
+				t.Errorf("the ASCII view should have no emoji or line drawing:\n%s", view)
+			}
+
+			m.Cursor = skillConfirmIndex(m.skillRows())
+			m = typeKeys(t, m, tea.KeyMsg{Type: tea.KeyEnter})
+			if m.Screen != ScreenSkillTargets || len(m.SkillPending) != 3 {
+				t.Errorf("Confirm should go on with the 3 selected skills, got %v %d", m.Screen, len(m.SkillPending))
+			}
+		})
+	}
+}
+
+func TestSkillBrowseRowsOpenTheRightSkill(t *testing.T) {
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+	for _, ascii := range []bool{false, true} {
+		t.Run(fmt.Sprintf("ascii=%v", ascii), func(t *testing.T) {
+			m := rowsTestModel(t, ascii)
+			m.Screen = ScreenSkillBrowse
+			rows := m.skillRows()
+			want := "header skill:0 skill:1 header skill:2 header skill:3 header skill:4 header skill:5 sep back"
+			if got := rowShape(rows); got != want {
+				t.Fatalf("rows = %s\nwant   %s", got, want)
+			}
+			// Every skill row opens the skill it shows, past the Plugins and External headers too
+			for i, row := range rows {
+				if row.Kind != RowSkill {
+					continue
+				}
+				m.Cursor = i
+				opened := typeKeys(t, m, enter)
+				if opened.Screen != ScreenSkillDetail || !strings.Contains(row.Label, opened.SkillDetail.Name) {
+					t.Errorf("row %d %q opened %+v", i, row.Label, opened.SkillDetail)
+				}
+			}
+			m.Cursor = len(rows) - 1
+			if m = typeKeys(t, m, enter); m.Screen != ScreenSkillMenu {
+				t.Errorf("Back should return to the menu, got %v", m.Screen)
+			}
+		})
+	}
+}
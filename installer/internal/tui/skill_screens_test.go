@@ -2,6 +2,9 @@ package tui
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -9,14 +12,14 @@ import (
 )
 
 func TestSkillMenuOptions(t *testing.T) {
-	t.Run("ScreenSkillMenu returns 6 items", func(t *testing.T) {
+	t.Run("ScreenSkillMenu returns 12 items", func(t *testing.T) {
 		m := NewModel()
 		m.Screen = ScreenSkillMenu
 		opts := m.GetCurrentOptions()
 
-		// Browse, Install, Remove, Update, separator, Back = 6
-		if len(opts) != 6 {
-			t.Errorf("expected 6 options (Browse, Install, Remove, Update, separator, Back), got %d: %v", len(opts), opts)
+		// Browse, Install, Remove, Update, Stats, Undo, Doctor, Manifest, Export, URL, separator, Back = 12
+		if len(opts) != 12 {
+			t.Errorf("expected 12 options (Browse, Install, Remove, Update, Stats, Undo, Doctor, Manifest, Export, URL, separator, Back), got %d: %v", len(opts), opts)
 		}
 	})
 }
@@ -116,7 +119,7 @@ func TestSkillBrowseEscape(t *testing.T) {
 }
 
 func TestSkillInstallToggle(t *testing.T) {
-	t.Run("Enter toggles skill selection on and off", func(t *testing.T) {
+	t.Run("Space toggles skill selection on and off", func(t *testing.T) {
 		m := NewModel()
 		m.Screen = ScreenSkillInstall
 		m.SkillCatalog = []SkillInfo{
@@ -130,7 +133,7 @@ func TestSkillInstallToggle(t *testing.T) {
 		m.Cursor = 2
 
 		// Toggle on
-		result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		result, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{' '}})
 		nm := result.(Model)
 
 		if !nm.SkillSelected[0] {
@@ -139,7 +142,7 @@ func TestSkillInstallToggle(t *testing.T) {
 
 		// Toggle off
 		nm.Cursor = 2
-		result, _ = nm.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		result, _ = nm.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{' '}})
 		nm = result.(Model)
 
 		if nm.SkillSelected[0] {
@@ -219,6 +222,45 @@ func TestSkillsLoadedMsg(t *testing.T) {
 	})
 }
 
+func TestSkillsLoadedMsgPreservesSelection(t *testing.T) {
+	m := NewModel()
+	m.Screen = ScreenSkillInstall
+	m.SkillLoading = true
+
+	result, _ := m.Update(skillsLoadedMsg{skills: []SkillInfo{
+		{Name: "a", Category: "curated"},
+		{Name: "b", Category: "curated"},
+		{Name: "c", Category: "community"},
+	}})
+	m = result.(Model)
+	m.SkillSelected[0] = true // a
+	m.SkillSelected[2] = true // c
+
+	// Reload arrives mid-selection: "a" is gone and a new skill appears first
+	result, _ = m.Update(skillsLoadedMsg{skills: []SkillInfo{
+		{Name: "new", Category: "curated"},
+		{Name: "b", Category: "curated"},
+		{Name: "c", Category: "community"},
+	}})
+	m = result.(Model)
+
+	want := []bool{false, false, true}
+	if len(m.SkillSelected) != len(want) {
+		t.Fatalf("expected %d selection booleans, got %d", len(want), len(m.SkillSelected))
+	}
+	for i := range want {
+		if m.SkillSelected[i] != want[i] {
+			t.Errorf("SkillSelected[%d] = %v, want %v", i, m.SkillSelected[i], want[i])
+		}
+	}
+	if !strings.HasSuffix(m.SkillNotice, ": a") {
+		t.Errorf("expected notice naming only the dropped skill, got %q", m.SkillNotice)
+	}
+	if !strings.Contains(m.View(), m.SkillNotice) {
+		t.Error("expected the notice to be rendered on the install screen")
+	}
+}
+
 func TestSkillsLoadedMsgError(t *testing.T) {
 	t.Run("error sets SkillLoadError, catalog stays empty", func(t *testing.T) {
 		m := NewModel()
@@ -308,6 +350,7 @@ func TestGetScreenTitleSkillScreens(t *testing.T) {
 		ScreenSkillRemove,
 		ScreenSkillResult,
 		ScreenSkillUpdate,
+		ScreenSkillStats,
 	}
 
 	m := NewModel()
@@ -348,53 +391,51 @@ func TestMainMenuHasNewItems(t *testing.T) {
 	})
 }
 
-func TestSkillOptionToIndex(t *testing.T) {
-	t.Run("maps cursor to correct skill index skipping headers", func(t *testing.T) {
-		options := []string{
-			"✅ Select All",
-			"📦 Curated",
-			"react-19 — React 19 patterns",
-			"typescript — TypeScript types",
-			"🌐 Community",
-			"electron — Electron patterns",
-			"─────────────",
-			"✅ Confirm installation",
-		}
-
-		// Select All → -1
-		if idx := skillOptionToIndex(options, 0); idx != -1 {
-			t.Errorf("expected -1 for Select All, got %d", idx)
-		}
-		// Group header → -1
-		if idx := skillOptionToIndex(options, 1); idx != -1 {
-			t.Errorf("expected -1 for group header, got %d", idx)
-		}
-		// react-19 → 0
-		if idx := skillOptionToIndex(options, 2); idx != 0 {
-			t.Errorf("expected 0 for react-19, got %d", idx)
-		}
-		// typescript → 1
-		if idx := skillOptionToIndex(options, 3); idx != 1 {
-			t.Errorf("expected 1 for typescript, got %d", idx)
-		}
-		// community header → -1
-		if idx := skillOptionToIndex(options, 4); idx != -1 {
-			t.Errorf("expected -1 for community header, got %d", idx)
-		}
-		// electron → 2
-		if idx := skillOptionToIndex(options, 5); idx != 2 {
-			t.Errorf("expected 2 for electron, got %d", idx)
-		}
-	})
-}
-
 func TestParseSkillFrontmatter(t *testing.T) {
 	t.Run("returns empty for non-existent file", func(t *testing.T) {
-		name, desc, skillType, perms := parseSkillFrontmatter("/tmp/nonexistent-skill-test-file.md")
-		if name != "" || desc != "" || skillType != "" || perms != nil {
-			t.Errorf("expected empty values for missing file, got name=%q desc=%q type=%q perms=%v", name, desc, skillType, perms)
+		meta := parseSkillFrontmatter("/tmp/nonexistent-skill-test-file.md")
+		if !reflect.DeepEqual(meta, SkillMeta{}) {
+			t.Errorf("expected empty values for missing file, got %+v", meta)
 		}
 	})
+
+	tests := []struct {
+		name    string
+		content string
+		want    SkillMeta
+	}{
+		{
+			name:    "inline lists",
+			content: "---\nname: react-19\ndescription: React 19 patterns\ntype: skill\ntags: [react, 'frontend', \"ui\"]\nallowed-tools: Read, Grep, Bash(npm run *)\n---\n# React\n",
+			want:    SkillMeta{Name: "react-19", Description: "React 19 patterns", Type: "skill", Tags: []string{"react", "frontend", "ui"}, Permissions: []string{"Read", "Grep", "Bash(npm run *)"}},
+		},
+		{
+			name:    "dash lists",
+			content: "---\nname: notify\ntags:\n  - hooks\n  - \"desktop\"\npermissions:\n  - Bash(notify-send:*)\n- Read\ntype: plugin\n---\n",
+			want:    SkillMeta{Name: "notify", Type: "plugin", Tags: []string{"hooks", "desktop"}, Permissions: []string{"Bash(notify-send:*)", "Read"}},
+		},
+		{
+			name:    "multi-line description keeps the first line",
+			content: "---\ndescription: >-\n  Go testing patterns\n  with table tests\ntags: [go]\nname: go-testing\n---\n",
+			want:    SkillMeta{Name: "go-testing", Description: "Go testing patterns", Tags: []string{"go"}},
+		},
+		{
+			name:    "missing fields",
+			content: "---\nname: bare\ntags:\n---\n",
+			want:    SkillMeta{Name: "bare"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "SKILL.md")
+			if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+			if got := parseSkillFrontmatter(path); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got  %+v\nwant %+v", got, tt.want)
+			}
+		})
+	}
 }
 
 func TestTruncateDesc(t *testing.T) {
@@ -443,9 +484,9 @@ func TestSkillRemoveCategoryToggleWithLocalSkills(t *testing.T) {
 			t.Fatal("Backend header not found in options")
 		}
 
-		// Press Enter on header — should NOT go back to SkillMenu
+		// Press Space on header — should NOT go back to SkillMenu
 		m.Cursor = headerIdx
-		result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		result, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{' '}})
 		nm := result.(Model)
 
 		if nm.Screen != ScreenSkillRemove {
@@ -489,16 +530,16 @@ func TestSkillRemoveCategoryToggleWithLocalSkills(t *testing.T) {
 		}
 		t.Logf("bff-concepts at option index %d", bffIdx)
 
-		// Position cursor on bff-concepts and press Enter
+		// Position cursor on bff-concepts and press Space
 		m.Cursor = bffIdx
-		result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		result, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{' '}})
 		nm := result.(Model)
 
 		// Verify it toggled correctly
-		skillIdx := skillOptionToIndex(opts, bffIdx)
-		t.Logf("skillOptionToIndex for bff-concepts: %d", skillIdx)
+		skillIdx := skillRowIndex(m.skillRows(), bffIdx)
+		t.Logf("skillRowIndex for bff-concepts: %d", skillIdx)
 		if skillIdx < 0 || skillIdx >= len(nm.SkillSelected) {
-			t.Fatalf("skillOptionToIndex returned %d, SkillSelected len %d", skillIdx, len(nm.SkillSelected))
+			t.Fatalf("skillRowIndex returned %d, SkillSelected len %d", skillIdx, len(nm.SkillSelected))
 		}
 		if !nm.SkillSelected[skillIdx] {
 			t.Error("expected bff-concepts to be selected after toggle")
@@ -0,0 +1,124 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+)
+
+// The Skill Manager screens and the "skills" subcommand both go through the
+// functions below, so an install or removal does the same thing either way:
+// names resolve against the same catalog and every change is recorded for undo.
+
+// SkillStatus is a catalog entry as "skills list" reports it
+type SkillStatus struct {
+	Name        string   `json:"name"`
+	Category    string   `json:"category"`
+	Type        string   `json:"type"`
+	Description string   `json:"description"`
+	Installed   bool     `json:"installed"`
+	InstalledIn []string `json:"installed_in"` // skillTargets IDs; plugins have none
+}
+
+// SkillStatuses lists the catalog in Browse order, with every list initialised
+// so empty ones serialise as []
+func SkillStatuses(catalog []SkillInfo) []SkillStatus {
+	statuses := []SkillStatus{}
+	for _, cat := range getSkillCategoryOrder(catalog) {
+		for _, s := range filterSkillsByCategory(catalog, cat) {
+			installedIn := s.InstalledIn
+			if installedIn == nil {
+				installedIn = []string{}
+			}
+			statuses = append(statuses, SkillStatus{
+				Name:        s.Name,
+				Category:    s.Category,
+				Type:        s.Type,
+				Description: s.Description,
+				Installed:   s.Installed,
+				InstalledIn: installedIn,
+			})
+		}
+	}
+	return statuses
+}
+
+// CuratedSkills returns the curated skills of the catalog
+func CuratedSkills(catalog []SkillInfo) []SkillInfo {
+	return filterSkillsByCategory(catalog, "curated")
+}
+
+// InstallCatalogSkills installs skills, fails each of the missing names as
+// not found in the catalog, and records the install for undo
+func InstallCatalogSkills(skills []SkillInfo, missing []string, opts SkillLinkOptions) ([]SkillResult, []string, error) {
+	var results []SkillResult
+	var logLines []string
+	for _, n := range missing {
+		results = append(results, SkillResult{Name: n, Status: SkillFailed, Reason: "not found in catalog"})
+		logLines = append(logLines, "❌ "+n+": not found in catalog")
+	}
+	var err error
+	if len(missing) > 0 {
+		err = fmt.Errorf("%d skill(s) not found in catalog", len(missing))
+	}
+	if len(skills) > 0 {
+		installed, installLog, installErr := installSkills(skills, opts)
+		logLines = append(logLines, recordSkillAction("install", installed, installLog)...)
+		results = append(results, installed...)
+		if installErr != nil {
+			err = installErr
+		}
+	}
+	return results, logLines, err
+}
+
+// RemoveCatalogSkills removes skills and records the removal for undo
+func RemoveCatalogSkills(skills []SkillInfo, opts SkillLinkOptions) ([]SkillResult, []string, error) {
+	results, logLines, err := removeSkills(skills, opts)
+	return results, recordSkillAction("remove", results, logLines), err
+}
+
+// SkillsToRemove resolves names against the catalog, so plugins are removed
+// as plugins. Names the catalog lacks are kept as bare link names: their
+// skill may be gone from the catalog while its links remain.
+func SkillsToRemove(catalog []SkillInfo, names []string) []SkillInfo {
+	skills, missing := MatchCatalogSkills(catalog, names)
+	for _, n := range missing {
+		skills = append(skills, SkillInfo{Name: n, Type: "skill"})
+	}
+	return skills
+}
+
+// SkillUpdateReport is what "skills update" reports, and prints with --json
+type SkillUpdateReport struct {
+	From     string              `json:"from"`     // Catalog commit before the pull
+	To       string              `json:"to"`       // and after it
+	Updated  []string            `json:"updated"`  // Linked skills the pulls changed
+	Orphaned []string            `json:"orphaned"` // Links whose skill left the catalog
+	Failed   []SkillSummaryEntry `json:"failed"`   // External clones that couldn't be pulled
+	Log      []string            `json:"-"`        // The Skill Manager's result lines
+}
+
+// UpdateSkillCatalog pulls the catalog and its external clones like Update
+// Catalog does, sending git's output to log
+func UpdateSkillCatalog(log func(string)) (*SkillUpdateReport, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("cannot determine home directory: %w", err)
+	}
+	changes, err := updateSkillCatalog(execRunner, home, log)
+	if err != nil {
+		return nil, err
+	}
+	report := &SkillUpdateReport{
+		From:     changes.From,
+		To:       changes.To,
+		Updated:  append([]string{}, changes.Updated...),
+		Orphaned: []string{},
+		Failed:   append([]SkillSummaryEntry{}, changes.ExternalFailed...),
+		Log:      changes.logLines(home),
+	}
+	for _, link := range changes.Orphaned {
+		report.Orphaned = append(report.Orphaned, link.Path)
+	}
+	return report, nil
+}
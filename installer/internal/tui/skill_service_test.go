@@ -0,0 +1,55 @@
+package tui
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMatchCatalogSkillsResolution(t *testing.T) {
+	catalog := []SkillInfo{
+		{Name: "react-19", DirName: "react-19", Category: "curated", FullPath: "/c/curated/react-19"},
+		{Name: "TypeScript", DirName: "typescript", Category: "curated", FullPath: "/c/curated/typescript"},
+		{Name: "react-19", DirName: "react-19", Category: "community", FullPath: "/c/community/react-19"},
+	}
+	matched, missing := MatchCatalogSkills(catalog, []string{"react-19", "typescript", "TypeScript", "nope", "react-19", "nope"})
+	var got []string
+	for _, s := range matched {
+		got = append(got, s.Category+"/"+s.Name)
+	}
+	if want := []string{"curated/react-19", "curated/TypeScript"}; !slices.Equal(got, want) {
+		t.Errorf("matched %v, want %v (curated first, each skill once)", got, want)
+	}
+	if !slices.Equal(missing, []string{"nope"}) {
+		t.Errorf("missing %v, want [nope]", missing)
+	}
+}
+
+func TestSkillsToRemove(t *testing.T) {
+	catalog := []SkillInfo{{Name: "mermaid", DirName: "mermaid", Type: "plugin", Category: "plugin"}}
+	got := SkillsToRemove(catalog, []string{"mermaid", "gone-from-catalog"})
+	if len(got) != 2 || got[0].Type != "plugin" || got[1].Name != "gone-from-catalog" || got[1].Type != "skill" {
+		t.Errorf("plugins should resolve and unknown names stay link names, got %+v", got)
+	}
+}
+
+func TestSkillStatuses(t *testing.T) {
+	catalog := []SkillInfo{
+		{Name: "notes", Category: "local", Installed: true},
+		{Name: "react-19", Category: "curated", Installed: true, InstalledIn: []string{"claude"}},
+		{Name: "chi-router", Category: "community"},
+	}
+	statuses := SkillStatuses(catalog)
+	var order []string
+	for _, s := range statuses {
+		order = append(order, s.Name)
+		if s.InstalledIn == nil {
+			t.Errorf("%s: installed_in should be an empty list, not null", s.Name)
+		}
+	}
+	if !slices.Equal(order, []string{"react-19", "chi-router", "notes"}) {
+		t.Errorf("statuses should follow the Browse order, got %v", order)
+	}
+	if len(SkillStatuses(nil)) != 0 || SkillStatuses(nil) == nil {
+		t.Error("an empty catalog should list as []")
+	}
+}
@@ -0,0 +1,215 @@
+package tui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// SkillCategoryCount is the number of catalog and installed skills in one category
+type SkillCategoryCount struct {
+	Category  string
+	Total     int
+	Installed int
+}
+
+// SkillSize is the on-disk size of one skill directory
+type SkillSize struct {
+	Name     string
+	Category string
+	Bytes    int64
+}
+
+// SkillCatalogStats summarizes the skill catalog for the stats screen
+type SkillCatalogStats struct {
+	Total      int
+	Installed  int
+	ByCategory []SkillCategoryCount // In display order
+	// NewSinceUpdate lists skills that weren't in the catalog before the last
+	// "Update Catalog". Only meaningful when HasSnapshot is true.
+	NewSinceUpdate []string
+	HasSnapshot    bool
+	Largest        []SkillSize // Biggest first
+}
+
+// skillStatsTopN is how many of the largest skills the stats screen lists
+const skillStatsTopN = 5
+
+// ComputeSkillStats builds catalog statistics. previous is the name set saved before
+// the last catalog update (nil when none was saved); sizes maps FullPath to bytes.
+func ComputeSkillStats(skills []SkillInfo, previous []string, sizes map[string]int64, topN int) SkillCatalogStats {
+	stats := SkillCatalogStats{Total: len(skills), HasSnapshot: previous != nil}
+
+	for _, cat := range getSkillCategoryOrder(skills) {
+		count := SkillCategoryCount{Category: cat}
+		for _, s := range filterSkillsByCategory(skills, cat) {
+			count.Total++
+			if s.Installed {
+				count.Installed++
+			}
+		}
+		stats.Installed += count.Installed
+		stats.ByCategory = append(stats.ByCategory, count)
+	}
+
+	if previous != nil {
+		known := make(map[string]bool, len(previous))
+		for _, name := range previous {
+			known[name] = true
+		}
+		for _, s := range skills {
+			if !known[s.Name] {
+				stats.NewSinceUpdate = append(stats.NewSinceUpdate, s.Name)
+			}
+		}
+		sort.Strings(stats.NewSinceUpdate)
+	}
+
+	for _, s := range skills {
+		if size, ok := sizes[s.FullPath]; ok {
+			stats.Largest = append(stats.Largest, SkillSize{Name: s.Name, Category: s.Category, Bytes: size})
+		}
+	}
+	sort.SliceStable(stats.Largest, func(i, j int) bool {
+		if stats.Largest[i].Bytes != stats.Largest[j].Bytes {
+			return stats.Largest[i].Bytes > stats.Largest[j].Bytes
+		}
+		return stats.Largest[i].Name < stats.Largest[j].Name
+	})
+	if len(stats.Largest) > topN {
+		stats.Largest = stats.Largest[:topN]
+	}
+	return stats
+}
+
+// skillCatalogSnapshot is the catalog's skill name set, saved before each update
+type skillCatalogSnapshot struct {
+	Saved  time.Time `json:"saved"`
+	Skills []string  `json:"skills"`
+}
+
+func skillCatalogSnapshotPath(home string) string {
+	return filepath.Join(paths.DataDir(home), "skill-catalog-snapshot.json")
+}
+
+// saveSkillCatalogSnapshot records the current skill names so the next stats
+// view can tell which skills an update added
+func saveSkillCatalogSnapshot(home string, skills []SkillInfo) error {
+	names := make([]string, 0, len(skills))
+	for _, s := range skills {
+		names = append(names, s.Name)
+	}
+	sort.Strings(names)
+	data, err := json.MarshalIndent(skillCatalogSnapshot{Saved: time.Now(), Skills: names}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(skillCatalogSnapshotPath(home)), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(skillCatalogSnapshotPath(home), data, 0644)
+}
+
+// loadSkillCatalogSnapshot returns the saved name set, or nil if none was saved
+func loadSkillCatalogSnapshot(home string) ([]string, error) {
+	data, err := os.ReadFile(skillCatalogSnapshotPath(home))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var snap skillCatalogSnapshot
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return nil, fmt.Errorf("invalid catalog snapshot: %w", err)
+	}
+	if snap.Skills == nil {
+		snap.Skills = []string{}
+	}
+	return snap.Skills, nil
+}
+
+// skillDirSize totals the regular files under a skill directory
+func skillDirSize(dir string) int64 {
+	var total int64
+	filepath.Walk(dir, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+		if info.Mode().IsRegular() {
+			total += info.Size()
+		}
+		return nil
+	})
+	return total
+}
+
+// skillStatsMsg carries freshly computed catalog statistics
+type skillStatsMsg struct {
+	stats SkillCatalogStats
+	err   error
+}
+
+// loadSkillStatsCmd reads the catalog, snapshot and skill sizes off the UI goroutine
+func loadSkillStatsCmd() tea.Cmd {
+	return func() tea.Msg {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return skillStatsMsg{err: fmt.Errorf("cannot determine home directory: %w", err)}
+		}
+		skills, _, err := fetchSkillCatalog()
+		if err != nil {
+			return skillStatsMsg{err: err}
+		}
+		previous, err := loadSkillCatalogSnapshot(home)
+		if err != nil {
+			return skillStatsMsg{err: err}
+		}
+		sizes := make(map[string]int64, len(skills))
+		for _, s := range skills {
+			sizes[s.FullPath] = skillDirSize(s.FullPath)
+		}
+		return skillStatsMsg{stats: ComputeSkillStats(skills, previous, sizes, skillStatsTopN)}
+	}
+}
+
+// skillStatsLines renders the stats as plain lines for the scrollable view
+func skillStatsLines(stats SkillCatalogStats) []string {
+	lines := []string{
+		fmt.Sprintf("Total skills:  %d", stats.Total),
+		fmt.Sprintf("Installed:     %d", stats.Installed),
+		"",
+		"By category:",
+	}
+	for _, c := range stats.ByCategory {
+		lines = append(lines, fmt.Sprintf("  %-22s %3d  (%d installed)", skillCategoryHeader(c.Category), c.Total, c.Installed))
+	}
+
+	lines = append(lines, "", "New since last catalog update:")
+	switch {
+	case !stats.HasSnapshot:
+		lines = append(lines, "  Unknown — run Update Catalog to start tracking")
+	case len(stats.NewSinceUpdate) == 0:
+		lines = append(lines, "  None")
+	default:
+		for _, name := range stats.NewSinceUpdate {
+			lines = append(lines, "  + "+name)
+		}
+	}
+
+	lines = append(lines, "", "Largest skills:")
+	if len(stats.Largest) == 0 {
+		lines = append(lines, "  None")
+	}
+	for _, s := range stats.Largest {
+		lines = append(lines, fmt.Sprintf("  %-28s %10s", s.Name, system.FormatBytes(s.Bytes)))
+	}
+	return lines
+}
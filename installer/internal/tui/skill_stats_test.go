@@ -0,0 +1,137 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestComputeSkillStats(t *testing.T) {
+	skills := []SkillInfo{
+		{Name: "react-19", Category: "curated", FullPath: "/c/react-19", Installed: true},
+		{Name: "typescript", Category: "curated", FullPath: "/c/typescript"},
+		{Name: "htmx", Category: "community", FullPath: "/m/htmx", Installed: true},
+		{Name: "my-notes", Category: "local", FullPath: "/l/my-notes", Installed: true},
+	}
+	sizes := map[string]int64{"/c/react-19": 300, "/c/typescript": 900, "/m/htmx": 300, "/l/my-notes": 50}
+
+	t.Run("counts and largest", func(t *testing.T) {
+		stats := ComputeSkillStats(skills, []string{"react-19", "typescript", "my-notes", "removed-skill"}, sizes, 3)
+
+		if stats.Total != 4 || stats.Installed != 3 {
+			t.Errorf("expected 4 total / 3 installed, got %d / %d", stats.Total, stats.Installed)
+		}
+		wantCats := []SkillCategoryCount{
+			{Category: "curated", Total: 2, Installed: 1},
+			{Category: "community", Total: 1, Installed: 1},
+			{Category: "local", Total: 1, Installed: 1},
+		}
+		if !reflect.DeepEqual(stats.ByCategory, wantCats) {
+			t.Errorf("ByCategory = %+v, want %+v", stats.ByCategory, wantCats)
+		}
+		if !stats.HasSnapshot || !reflect.DeepEqual(stats.NewSinceUpdate, []string{"htmx"}) {
+			t.Errorf("expected htmx as the only new skill, got %v", stats.NewSinceUpdate)
+		}
+		// Ties broken by name
+		wantLargest := []string{"typescript", "htmx", "react-19"}
+		var gotLargest []string
+		for _, s := range stats.Largest {
+			gotLargest = append(gotLargest, s.Name)
+		}
+		if !reflect.DeepEqual(gotLargest, wantLargest) {
+			t.Errorf("Largest = %v, want %v", gotLargest, wantLargest)
+		}
+	})
+
+	t.Run("no snapshot", func(t *testing.T) {
+		stats := ComputeSkillStats(skills, nil, nil, 3)
+		if stats.HasSnapshot || stats.NewSinceUpdate != nil {
+			t.Errorf("expected no new-skill data without a snapshot, got %+v", stats)
+		}
+		if len(stats.Largest) != 0 {
+			t.Errorf("expected no sizes, got %v", stats.Largest)
+		}
+	})
+}
+
+func TestSkillCatalogSnapshotRoundTrip(t *testing.T) {
+	home := t.TempDir()
+
+	names, err := loadSkillCatalogSnapshot(home)
+	if err != nil || names != nil {
+		t.Fatalf("expected nil snapshot before the first save, got %v, %v", names, err)
+	}
+
+	if err := saveSkillCatalogSnapshot(home, []SkillInfo{{Name: "b"}, {Name: "a"}}); err != nil {
+		t.Fatalf("save failed: %v", err)
+	}
+	names, err = loadSkillCatalogSnapshot(home)
+	if err != nil || !reflect.DeepEqual(names, []string{"a", "b"}) {
+		t.Errorf("expected [a b], got %v, %v", names, err)
+	}
+}
+
+func TestSkillDirSize(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "SKILL.md"), make([]byte, 100), 0644)
+	os.MkdirAll(filepath.Join(dir, "assets"), 0755)
+	os.WriteFile(filepath.Join(dir, "assets", "x.txt"), make([]byte, 23), 0644)
+
+	if got := skillDirSize(dir); got != 123 {
+		t.Errorf("expected 123 bytes, got %d", got)
+	}
+}
+
+func TestSkillStatsScreen(t *testing.T) {
+	m := NewModel()
+	m.Screen = ScreenSkillMenu
+	m.Cursor = 4
+
+	result, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = result.(Model)
+	if m.Screen != ScreenSkillStats || !m.SkillLoading || cmd == nil {
+		t.Fatalf("expected stats screen to start loading, got screen %v loading %v", m.Screen, m.SkillLoading)
+	}
+
+	stats := ComputeSkillStats([]SkillInfo{{Name: "a", Category: "curated"}}, nil, nil, skillStatsTopN)
+	result, _ = m.Update(skillStatsMsg{stats: stats})
+	m = result.(Model)
+	if m.SkillLoading || m.SkillStats == nil {
+		t.Fatal("expected stats to be cached after skillStatsMsg")
+	}
+	if !strings.Contains(m.View(), "Total skills:  1") {
+		t.Error("expected the total to be rendered")
+	}
+
+	// Scrolling is clamped to the content
+	m.Height = 10
+	for i := 0; i < 50; i++ {
+		result, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+		m = result.(Model)
+	}
+	if max := len(skillStatsLines(stats)) - m.skillStatsVisibleLines(); m.SkillScroll != max {
+		t.Errorf("expected scroll clamped at %d, got %d", max, m.SkillScroll)
+	}
+
+	// Back, then re-enter: cached stats are reused without reloading
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = result.(Model)
+	if m.Screen != ScreenSkillMenu || m.Cursor != 4 {
+		t.Fatalf("expected skill menu at stats entry, got %v/%d", m.Screen, m.Cursor)
+	}
+	result, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = result.(Model)
+	if cmd != nil && m.SkillLoading {
+		t.Error("cached stats should not trigger a reload")
+	}
+
+	// A catalog update invalidates the cache
+	result, _ = m.Update(skillUpdateCompleteMsg{})
+	if result.(Model).SkillStats != nil {
+		t.Error("catalog update should invalidate cached stats")
+	}
+}
@@ -0,0 +1,125 @@
+package tui
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
+)
+
+// SkillResultStatus is the outcome of installing or removing one skill
+type SkillResultStatus string
+
+const (
+	SkillInstalled SkillResultStatus = "installed"
+	SkillRemoved   SkillResultStatus = "removed"
+	SkillSkipped   SkillResultStatus = "skipped"
+	SkillFailed    SkillResultStatus = "failed"
+)
+
+// SkillResult records what happened to a single skill during a CLI operation
+type SkillResult struct {
+	Name   string
+	Status SkillResultStatus
+	Reason string // Why it was skipped or failed
+	// What changed on disk, so the operation can be undone
+	Created []SkillLink // Links and plugin copies the operation made
+	Removed []SkillLink // Symlinks it deleted, with their targets
+	Lost    []string    // Directories it deleted that cannot be restored
+	// Local directories left in place of a link, see SkillLinkOptions.Conflicts
+	Conflicts []string
+}
+
+// SkillSummarySchemaVersion is bumped whenever a field in SkillSummary changes meaning
+const SkillSummarySchemaVersion = 1
+
+// SkillSummaryEntry is a skill name with the reason it was skipped or failed
+type SkillSummaryEntry struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// SkillSummary is the machine-readable result of --skill-install/--skill-remove.
+// Field names and ordering are a stable contract for CI consumers.
+type SkillSummary struct {
+	SchemaVersion   int                 `json:"schema_version"`
+	CatalogCommit   string              `json:"catalog_commit"`
+	RequestedAdd    []string            `json:"requested_install"`
+	RequestedRemove []string            `json:"requested_remove"`
+	Installed       []string            `json:"installed"`
+	Removed         []string            `json:"removed"`
+	Skipped         []SkillSummaryEntry `json:"skipped"`
+	Failed          []SkillSummaryEntry `json:"failed"`
+}
+
+// NewSkillSummary returns a summary with every list initialised, so empty
+// lists serialise as [] rather than null
+func NewSkillSummary(catalogCommit string) *SkillSummary {
+	return &SkillSummary{
+		SchemaVersion:   SkillSummarySchemaVersion,
+		CatalogCommit:   catalogCommit,
+		RequestedAdd:    []string{},
+		RequestedRemove: []string{},
+		Installed:       []string{},
+		Removed:         []string{},
+		Skipped:         []SkillSummaryEntry{},
+		Failed:          []SkillSummaryEntry{},
+	}
+}
+
+// AddResults files each per-skill result under the matching summary list
+func (s *SkillSummary) AddResults(results []SkillResult) {
+	for _, r := range results {
+		switch r.Status {
+		case SkillInstalled:
+			s.Installed = append(s.Installed, r.Name)
+		case SkillRemoved:
+			s.Removed = append(s.Removed, r.Name)
+		case SkillSkipped:
+			s.Skipped = append(s.Skipped, SkillSummaryEntry{Name: r.Name, Reason: r.Reason})
+		case SkillFailed:
+			s.Failed = append(s.Failed, SkillSummaryEntry{Name: r.Name, Reason: r.Reason})
+		}
+	}
+}
+
+// Write encodes the summary as indented JSON followed by a newline
+func (s *SkillSummary) Write(w io.Writer) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+// WriteFile writes the summary to path, or to stdout when path is "-"
+func (s *SkillSummary) WriteFile(path string) error {
+	if path == "-" {
+		return s.Write(os.Stdout)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("cannot write skill summary: %w", err)
+	}
+	defer f.Close()
+	return s.Write(f)
+}
+
+// SkillCatalogCommit returns the HEAD commit of the local skills catalog
+// (~/.gentleman/skills), or "" if it isn't a git checkout.
+func SkillCatalogCommit() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	out, err := exec.Command("git", "-C", paths.SkillsDir(home), "rev-parse", "HEAD").Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
@@ -0,0 +1,73 @@
+package tui
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/charmbracelet/x/exp/golden"
+)
+
+func TestSkillSummaryGolden(t *testing.T) {
+	summary := NewSkillSummary("0123456789abcdef0123456789abcdef01234567")
+	summary.RequestedAdd = []string{"react-19", "typescript", "missing-skill"}
+	summary.RequestedRemove = []string{"old-skill"}
+	summary.AddResults([]SkillResult{
+		{Name: "react-19", Status: SkillInstalled},
+		{Name: "typescript", Status: SkillSkipped, Reason: "already present"},
+		{Name: "missing-skill", Status: SkillFailed, Reason: "not found in catalog"},
+		{Name: "old-skill", Status: SkillRemoved},
+	})
+
+	var buf bytes.Buffer
+	if err := summary.Write(&buf); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+	golden.RequireEqual(t, buf.Bytes())
+}
+
+func TestSkillSummaryEmptyListsAreArrays(t *testing.T) {
+	var buf bytes.Buffer
+	if err := NewSkillSummary("").Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(buf.Bytes(), []byte("null")) {
+		t.Errorf("empty summary should not contain null lists:\n%s", buf.String())
+	}
+}
+
+func TestInstallAndRemoveSkillResults(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	skillDir := filepath.Join(home, ".gentleman", "skills", "curated", "react-19")
+	os.MkdirAll(skillDir, 0755)
+	os.WriteFile(filepath.Join(skillDir, "SKILL.md"), []byte("---\nname: react-19\n---\n"), 0644)
+	skill := SkillInfo{Name: "react-19", DirName: "react-19", FullPath: skillDir, Type: "skill"}
+
+	results, _, err := installSkills([]SkillInfo{skill}, SkillLinkOptions{})
+	if err != nil {
+		t.Fatalf("installSkills failed: %v", err)
+	}
+	if len(results) != 1 || results[0].Status != SkillInstalled {
+		t.Fatalf("expected installed result, got %+v", results)
+	}
+
+	// A second install finds the symlinks already in place
+	results, _, _ = installSkills([]SkillInfo{skill}, SkillLinkOptions{})
+	if results[0].Status != SkillSkipped || results[0].Reason != "already present" {
+		t.Errorf("expected skipped/already present, got %+v", results[0])
+	}
+
+	results, _, err = removeSkills([]SkillInfo{{Name: "react-19"}, {Name: "never-installed"}}, SkillLinkOptions{})
+	if err != nil {
+		t.Fatalf("removeSkills failed: %v", err)
+	}
+	if results[0].Status != SkillRemoved {
+		t.Errorf("expected react-19 removed, got %+v", results[0])
+	}
+	if results[1].Status != SkillSkipped || results[1].Reason != "not installed" {
+		t.Errorf("expected never-installed skipped, got %+v", results[1])
+	}
+}
@@ -0,0 +1,327 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// skillTarget is a directory skills are symlinked into, read by one family of CLIs
+type skillTarget struct {
+	ID    string // Name used in --skill-targets and SkillInfo.InstalledIn
+	Label string // The CLIs that read the directory
+	Dir   string // Relative to home
+}
+
+// skillTargets lists every link target, in the order installs visit them
+var skillTargets = []skillTarget{
+	{ID: "claude", Label: "Claude Code", Dir: filepath.Join(".claude", "skills")},
+	{ID: "agents", Label: "OpenCode, Codex, Gemini", Dir: filepath.Join(".agents", "skills")},
+}
+
+// SkillLinkOptions picks which targets skill installs and removals touch. The
+// zero value uses every target, as before targets could be picked.
+type SkillLinkOptions struct {
+	Targets []string // skillTargets IDs; empty means all of them
+	// What to do with a local directory in the way of a link, by its path.
+	// One without an entry is skipped and reported in SkillResult.Conflicts.
+	Conflicts map[string]SkillConflictAction
+}
+
+// targets resolves the picked IDs in skillTargets order
+func (o SkillLinkOptions) targets() []skillTarget {
+	if len(o.Targets) == 0 {
+		return skillTargets
+	}
+	var picked []skillTarget
+	for _, t := range skillTargets {
+		if slices.Contains(o.Targets, t.ID) {
+			picked = append(picked, t)
+		}
+	}
+	return picked
+}
+
+// skillTargetIDs returns the IDs of every target
+func skillTargetIDs() []string {
+	ids := make([]string, len(skillTargets))
+	for i, t := range skillTargets {
+		ids[i] = t.ID
+	}
+	return ids
+}
+
+// displayDir is the target dir as shown to the user, e.g. ~/.claude/skills/
+func (t skillTarget) displayDir() string {
+	return "~/" + filepath.ToSlash(t.Dir) + "/"
+}
+
+// ParseSkillTargets reads a comma-separated --skill-targets value. "all" or an
+// empty value picks every target, like the zero SkillLinkOptions.
+func ParseSkillTargets(value string) ([]string, error) {
+	var ids []string
+	for _, id := range strings.Split(value, ",") {
+		id = strings.ToLower(strings.TrimSpace(id))
+		switch {
+		case id == "":
+			continue
+		case id == "all":
+			return nil, nil
+		case !slices.Contains(skillTargetIDs(), id):
+			return nil, fmt.Errorf("invalid skill target: %s (valid: %s, all)", id, strings.Join(skillTargetIDs(), ", "))
+		case !slices.Contains(ids, id):
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// defaultSkillTargets picks the targets whose CLI is set up under home: its
+// skills dir or the CLI's own dir exists. When none is, it picks them all.
+func defaultSkillTargets(home string) []string {
+	var ids []string
+	for _, t := range skillTargets {
+		dir := filepath.Join(home, t.Dir)
+		if _, err := os.Stat(dir); err == nil {
+			ids = append(ids, t.ID)
+		} else if _, err := os.Stat(filepath.Dir(dir)); err == nil {
+			ids = append(ids, t.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return skillTargetIDs()
+	}
+	return ids
+}
+
+// installedIn reports whether s is installed in the target with the given ID.
+// Plugins and skills without per-target data count as installed everywhere
+// once installed.
+func (s SkillInfo) installedIn(id string) bool {
+	if s.InstalledIn == nil {
+		return s.Installed
+	}
+	return slices.Contains(s.InstalledIn, id)
+}
+
+// installedInAll reports whether s is installed in every target in ids, or in
+// every target at all when ids is empty
+func (s SkillInfo) installedInAll(ids []string) bool {
+	if len(ids) == 0 {
+		ids = skillTargetIDs()
+	}
+	for _, id := range ids {
+		if !s.installedIn(id) {
+			return false
+		}
+	}
+	return true
+}
+
+// skillInstalledLabel says where s is installed: a target ID, "both" or "" when
+// it isn't. Plugins only ever go to Claude.
+func skillInstalledLabel(s SkillInfo) string {
+	switch {
+	case !s.Installed:
+		return ""
+	case s.Type == "plugin":
+		return "claude"
+	case s.installedInAll(nil):
+		return "both"
+	}
+	return strings.Join(s.InstalledIn, ", ")
+}
+
+// skillInstalledBadge prefixes a skill in the browse list with where it's
+// installed, padded so names line up
+func skillInstalledBadge(s SkillInfo) string {
+	label := skillInstalledLabel(s)
+	if label == "" {
+		return fmt.Sprintf("%-9s", "")
+	}
+	return fmt.Sprintf("%-9s", "✓ "+label)
+}
+
+// initSkillTargets picks the default link targets the first time the install
+// or remove list is opened
+func (m *Model) initSkillTargets() {
+	if m.SkillTargets != nil {
+		return
+	}
+	home, _ := os.UserHomeDir()
+	m.SkillTargets = defaultSkillTargets(home)
+}
+
+// enterSkillTargets asks where to link (or unlink) the skills picked on the
+// install or remove screen. An install starts from the targets used last; a
+// removal from wherever the picked skills are installed.
+func (m Model) enterSkillTargets(from Screen, skills []SkillInfo) Model {
+	picked := m.SkillTargets
+	if from == ScreenSkillRemove {
+		picked = nil
+		for _, s := range skills {
+			for _, id := range skillTargetIDs() {
+				if s.Type != "plugin" && s.installedIn(id) && !slices.Contains(picked, id) {
+					picked = append(picked, id)
+				}
+			}
+		}
+		if len(picked) == 0 {
+			picked = m.SkillTargets
+		}
+	}
+	if len(picked) == 0 {
+		picked = skillTargetIDs()
+	}
+
+	m.SkillTargetsFor = from
+	m.SkillPending = skills
+	m.SkillTargetSelected = make([]bool, len(skillTargets))
+	for i, t := range skillTargets {
+		m.SkillTargetSelected[i] = slices.Contains(picked, t.ID)
+	}
+	m.Screen = ScreenSkillTargets
+	m.Cursor = len(skillTargets) + 1
+	return m
+}
+
+// skillTargetsOptions lists the targets plus the action that runs the install or removal
+func (m Model) skillTargetsOptions() []string {
+	var opts []string
+	for _, t := range skillTargets {
+		opts = append(opts, t.Label)
+	}
+	action := fmt.Sprintf("✅ Install %d skill(s)", len(m.SkillPending))
+	if m.SkillTargetsFor == ScreenSkillRemove {
+		action = fmt.Sprintf("✅ Remove %d skill(s)", len(m.SkillPending))
+	}
+	return append(opts, "─────────────", action, "← Back")
+}
+
+// leaveSkillTargets returns to the list the skills were picked on, with the
+// picks kept and the cursor on its Confirm row
+func (m Model) leaveSkillTargets() Model {
+	m.Screen = m.SkillTargetsFor
+	m.SkillPending = nil
+	rows := m.skillRows()
+	m.Cursor = max(skillConfirmIndex(rows), 0)
+	m.updateSkillScroll(len(rows))
+	return m
+}
+
+func (m Model) handleSkillTargetsKeys(key string) (tea.Model, tea.Cmd) {
+	options := m.canonicalOptions()
+	actionIdx := len(skillTargets) + 1
+
+	switch key {
+	case "up", "k":
+		if m.Cursor > 0 {
+			m.Cursor--
+			if strings.HasPrefix(options[m.Cursor], "───") && m.Cursor > 0 {
+				m.Cursor--
+			}
+		}
+	case "down", "j":
+		if m.Cursor < len(options)-1 {
+			m.Cursor++
+			if strings.HasPrefix(options[m.Cursor], "───") && m.Cursor < len(options)-1 {
+				m.Cursor++
+			}
+		}
+	case "enter", " ":
+		if !m.multiSelectRuns(key, m.Cursor < len(skillTargets), actionIdx) {
+			break
+		}
+		switch {
+		case m.Cursor < len(skillTargets):
+			m.SkillTargetSelected[m.Cursor] = !m.SkillTargetSelected[m.Cursor]
+		case m.Cursor == actionIdx:
+			var picked []string
+			for i, sel := range m.SkillTargetSelected {
+				if sel && i < len(skillTargets) {
+					picked = append(picked, skillTargets[i].ID)
+				}
+			}
+			if len(picked) == 0 {
+				return m, nil // No-op if nothing selected
+			}
+			skills := m.SkillPending
+			opts := SkillLinkOptions{Targets: picked}
+			if m.SkillTargetsFor == ScreenSkillInstall {
+				// The next install starts from these targets
+				m.SkillTargets = picked
+				home, _ := os.UserHomeDir()
+				if conflicts := findSkillConflicts(home, skills, opts); len(conflicts) > 0 {
+					return m.enterSkillConflicts(conflicts), nil
+				}
+			}
+			m.SkillPending = nil
+			m.ErrorMsg = ""
+			m.SkillResultLog.Reset()
+			m.Screen = ScreenSkillResult
+			if m.SkillTargetsFor == ScreenSkillRemove {
+				return m, removeSkillActionCmd(skills, opts)
+			}
+			return m, installSkillActionCmd(skills, opts)
+		case m.Cursor == len(options)-1:
+			return m.leaveSkillTargets(), nil
+		}
+	}
+
+	return m, nil
+}
+
+func (m Model) renderSkillTargets() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
+	s.WriteString("\n\n")
+
+	home, _ := os.UserHomeDir()
+	for i, opt := range m.GetCurrentOptions() {
+		if strings.HasPrefix(opt, "───") {
+			s.WriteString(m.Theme.Muted.Render(opt))
+			s.WriteString("\n")
+			continue
+		}
+
+		cursor := "  "
+		style := m.Theme.Unselected
+		if i == m.Cursor {
+			cursor = "▸ "
+			style = m.Theme.Selected
+		}
+
+		if i >= len(skillTargets) {
+			m.VisibleRows.mark(&s, i)
+			s.WriteString(m.renderRow(style, cursor+opt))
+			s.WriteString("\n")
+			continue
+		}
+
+		checkbox := "[ ] "
+		if i < len(m.SkillTargetSelected) && m.SkillTargetSelected[i] {
+			checkbox = "[✓] "
+		}
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+checkbox+opt))
+		s.WriteString("\n")
+		note := skillTargets[i].displayDir()
+		if _, err := os.Stat(filepath.Join(home, skillTargets[i].Dir)); err != nil && m.SkillTargetsFor == ScreenSkillInstall {
+			note += " (will be created)"
+		}
+		s.WriteString(m.Theme.Muted.Render("      " + note))
+		s.WriteString("\n")
+	}
+
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render("↑/k up • ↓/j down • [Space] toggle • [Enter] confirm • [Esc] back"))
+
+	return s.String()
+}
@@ -0,0 +1,177 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestParseSkillTargets(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    []string
+		wantErr bool
+	}{
+		{"", nil, false},
+		{"all", nil, false},
+		{"claude", []string{"claude"}, false},
+		{" Agents , claude,agents", []string{"agents", "claude"}, false},
+		{"claude,all", nil, false},
+		{"gemini", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseSkillTargets(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("targets = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefaultSkillTargets(t *testing.T) {
+	tests := []struct {
+		name string
+		dirs []string
+		want []string
+	}{
+		{"nothing set up", nil, []string{"claude", "agents"}},
+		{"claude only", []string{".claude"}, []string{"claude"}},
+		{"agents skills dir", []string{".agents/skills"}, []string{"agents"}},
+		{"both", []string{".claude", ".agents"}, []string{"claude", "agents"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			home := t.TempDir()
+			for _, dir := range tt.dirs {
+				os.MkdirAll(filepath.Join(home, dir), 0755)
+			}
+			if got := defaultSkillTargets(home); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("targets = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// writeTargetSkill creates a catalog skill under home for the target tests
+func writeTargetSkill(t *testing.T, home string) SkillInfo {
+	t.Helper()
+	dir := filepath.Join(home, ".gentleman", "skills", "curated", "react-19")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	os.WriteFile(filepath.Join(dir, "SKILL.md"), []byte("---\nname: react-19\n---\n"), 0644)
+	return SkillInfo{Name: "react-19", DirName: "react-19", FullPath: dir, Type: "skill"}
+}
+
+func TestInstallSkillsSelectedTargets(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	skill := writeTargetSkill(t, home)
+
+	results, _, err := installSkills([]SkillInfo{skill}, SkillLinkOptions{Targets: []string{"claude"}})
+	if err != nil || results[0].Status != SkillInstalled {
+		t.Fatalf("expected installed, got %+v (%v)", results, err)
+	}
+	if !symlinkPointsTo(filepath.Join(home, ".claude", "skills", "react-19"), skill.FullPath) {
+		t.Error("expected a link in ~/.claude/skills")
+	}
+	if _, err := os.Stat(filepath.Join(home, ".agents")); !os.IsNotExist(err) {
+		t.Error("~/.agents should not be created when only claude is picked")
+	}
+	if got := skillInstalledTargets(home, "react-19"); !reflect.DeepEqual(got, []string{"claude"}) {
+		t.Errorf("installed targets = %v, want [claude]", got)
+	}
+
+	// Adding the other target links it there without counting as already installed
+	results, _, _ = installSkills([]SkillInfo{skill}, SkillLinkOptions{})
+	if results[0].Status != SkillInstalled {
+		t.Errorf("expected the agents link to be added, got %+v", results[0])
+	}
+
+	results, _, err = removeSkills([]SkillInfo{skill}, SkillLinkOptions{Targets: []string{"agents"}})
+	if err != nil || results[0].Status != SkillRemoved {
+		t.Fatalf("expected removed, got %+v (%v)", results, err)
+	}
+	if got := skillInstalledTargets(home, "react-19"); !reflect.DeepEqual(got, []string{"claude"}) {
+		t.Errorf("removing from agents should keep the claude link, got %v", got)
+	}
+}
+
+func TestSkillInstalledBadge(t *testing.T) {
+	tests := []struct {
+		name  string
+		skill SkillInfo
+		want  string
+	}{
+		{"not installed", SkillInfo{Type: "skill"}, ""},
+		{"claude", SkillInfo{Type: "skill", Installed: true, InstalledIn: []string{"claude"}}, "✓ claude"},
+		{"agents", SkillInfo{Type: "skill", Installed: true, InstalledIn: []string{"agents"}}, "✓ agents"},
+		{"both", SkillInfo{Type: "skill", Installed: true, InstalledIn: []string{"claude", "agents"}}, "✓ both"},
+		{"plugin", SkillInfo{Type: "plugin", Installed: true}, "✓ claude"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := strings.TrimSpace(skillInstalledBadge(tt.skill)); got != tt.want {
+				t.Errorf("badge = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSkillTargetsScreen(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	press := func(m Model, key tea.KeyMsg) Model {
+		result, _ := m.Update(key)
+		return result.(Model)
+	}
+	space := tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+
+	m := NewModel()
+	m.SkillTargets = []string{"claude"}
+	m = m.enterSkillTargets(ScreenSkillInstall, flowSkillCatalog()[:1])
+	if m.Screen != ScreenSkillTargets || !m.SkillTargetSelected[0] || m.SkillTargetSelected[1] {
+		t.Fatalf("install should start from the last targets, got %v on %v", m.SkillTargetSelected, m.Screen)
+	}
+
+	m.Cursor = 1
+	m = press(m, space)
+	if !m.SkillTargetSelected[1] {
+		t.Error("space should toggle the agents target")
+	}
+
+	// Nothing picked keeps the screen open
+	m.SkillTargetSelected = []bool{false, false}
+	m.Cursor = len(skillTargets) + 1
+	if m = press(m, enter); m.Screen != ScreenSkillTargets {
+		t.Errorf("confirm with no targets should do nothing, got %v", m.Screen)
+	}
+
+	m.SkillTargetSelected = []bool{false, true}
+	m = press(m, enter)
+	if m.Screen != ScreenSkillResult || !reflect.DeepEqual(m.SkillTargets, []string{"agents"}) {
+		t.Errorf("confirm should install and remember the targets, got %v with %v", m.Screen, m.SkillTargets)
+	}
+
+	// A removal starts from where the skills are installed
+	m = NewModel()
+	m.SkillTargets = []string{"claude"}
+	skill := SkillInfo{Name: "htmx", Type: "skill", Installed: true, InstalledIn: []string{"agents"}}
+	m = m.enterSkillTargets(ScreenSkillRemove, []SkillInfo{skill})
+	if m.SkillTargetSelected[0] || !m.SkillTargetSelected[1] {
+		t.Errorf("remove should preselect the installed targets, got %v", m.SkillTargetSelected)
+	}
+
+	m = press(m, tea.KeyMsg{Type: tea.KeyEsc})
+	if m.Screen != ScreenSkillRemove || m.SkillPending != nil {
+		t.Errorf("esc should return to the remove list, got %v", m.Screen)
+	}
+}
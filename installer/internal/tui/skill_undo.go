@@ -0,0 +1,224 @@
+package tui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// SkillLink is a path an install or remove created or deleted. Target is the
+// symlink's destination; it is empty for copied plugin directories.
+type SkillLink struct {
+	Path   string `json:"path"`
+	Target string `json:"target,omitempty"`
+}
+
+// skillChange is what one operation did to one skill, as much as undo needs
+type skillChange struct {
+	Name    string      `json:"name"`
+	Created []SkillLink `json:"created,omitempty"`
+	Removed []SkillLink `json:"removed,omitempty"`
+	Lost    []string    `json:"lost,omitempty"`
+}
+
+// skillOperation is the last install or remove, kept so it can be undone
+type skillOperation struct {
+	Kind    string        `json:"kind"` // "install" or "remove"
+	At      time.Time     `json:"at"`
+	Changes []skillChange `json:"changes"`
+}
+
+// skillLedger is the skill manager's persistent record. Undo is single-level:
+// each operation replaces the record and undoing clears it.
+type skillLedger struct {
+	Undo *skillOperation `json:"undo,omitempty"`
+}
+
+func skillLedgerPath(home string) string {
+	return filepath.Join(paths.DataDir(home), "skill-ledger.json")
+}
+
+// loadSkillLedger reads the ledger; a missing file is an empty ledger
+func loadSkillLedger(home string) (skillLedger, error) {
+	var ledger skillLedger
+	data, err := os.ReadFile(skillLedgerPath(home))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return ledger, nil
+		}
+		return ledger, err
+	}
+	if err := json.Unmarshal(data, &ledger); err != nil {
+		return ledger, fmt.Errorf("invalid skill ledger: %w", err)
+	}
+	return ledger, nil
+}
+
+// saveSkillLedger writes the ledger under home
+func saveSkillLedger(home string, ledger skillLedger) error {
+	data, err := json.MarshalIndent(ledger, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(skillLedgerPath(home)), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(skillLedgerPath(home), data, 0644)
+}
+
+// removeEntry deletes path with remove and notes what was there: a symlink can
+// be put back by undo, anything else is lost
+func (r *SkillResult) removeEntry(path string, remove func(string) error) error {
+	target, linkErr := os.Readlink(path)
+	_, statErr := os.Lstat(path)
+	if err := remove(path); err != nil {
+		return err
+	}
+	switch {
+	case linkErr == nil:
+		r.Removed = append(r.Removed, SkillLink{Path: path, Target: target})
+	case statErr == nil:
+		r.Lost = append(r.Lost, path)
+	}
+	return nil
+}
+
+// newSkillOperation collects the undo information from an operation's results
+func newSkillOperation(kind string, results []SkillResult) *skillOperation {
+	op := &skillOperation{Kind: kind, At: time.Now()}
+	for _, r := range results {
+		if len(r.Created) == 0 && len(r.Removed) == 0 && len(r.Lost) == 0 {
+			continue
+		}
+		op.Changes = append(op.Changes, skillChange{Name: r.Name, Created: r.Created, Removed: r.Removed, Lost: r.Lost})
+	}
+	return op
+}
+
+// Undoable reports whether undoing op would revert anything
+func (op *skillOperation) Undoable() bool {
+	if op == nil {
+		return false
+	}
+	for _, c := range op.Changes {
+		if len(c.Created) > 0 || len(c.Removed) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Label describes op for the skill menu, e.g. "remove of 3 skills"
+func (op *skillOperation) Label() string {
+	if len(op.Changes) == 1 {
+		return fmt.Sprintf("%s of %s", op.Kind, op.Changes[0].Name)
+	}
+	return fmt.Sprintf("%s of %d skills", op.Kind, len(op.Changes))
+}
+
+// recordSkillOperation makes op the operation to undo. An operation that
+// changed nothing leaves the previous record in place.
+func recordSkillOperation(home string, op *skillOperation) error {
+	if !op.Undoable() {
+		return nil
+	}
+	ledger, err := loadSkillLedger(home)
+	if err != nil {
+		// A corrupt ledger only held the previous undo record; start over
+		ledger = skillLedger{}
+	}
+	ledger.Undo = op
+	return saveSkillLedger(home, ledger)
+}
+
+// currentSkillUndo returns the operation that can be undone, or nil
+func currentSkillUndo() *skillOperation {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil
+	}
+	ledger, err := loadSkillLedger(home)
+	if err != nil || !ledger.Undo.Undoable() {
+		return nil
+	}
+	return ledger.Undo
+}
+
+// undoSkillOperation reverts op: links it created are removed (only while they
+// still point where the operation left them) and links it removed are put back.
+// It returns one log line per reverted or unrecoverable path.
+func undoSkillOperation(home string, op *skillOperation) ([]string, error) {
+	var logLines []string
+	failed := 0
+	for _, c := range op.Changes {
+		for _, l := range c.Created {
+			if l.Target != "" && !symlinkPointsTo(l.Path, l.Target) {
+				logLines = append(logLines, fmt.Sprintf("⏭️  %s: %s changed since, left alone", c.Name, contractHome(l.Path)))
+				continue
+			}
+			if err := os.RemoveAll(l.Path); err != nil {
+				logLines = append(logLines, fmt.Sprintf("❌ %s: failed to remove %s: %v", c.Name, contractHome(l.Path), err))
+				failed++
+				continue
+			}
+			logLines = append(logLines, fmt.Sprintf("↩️  %s: removed %s", c.Name, contractHome(l.Path)))
+		}
+		for _, l := range c.Removed {
+			if _, err := os.Lstat(l.Path); err == nil {
+				logLines = append(logLines, fmt.Sprintf("⏭️  %s: %s exists again, left alone", c.Name, contractHome(l.Path)))
+				continue
+			}
+			target := l.Target
+			if !filepath.IsAbs(target) {
+				target = filepath.Join(filepath.Dir(l.Path), target)
+			}
+			if _, err := os.Stat(target); err != nil {
+				// Such as the clone of a removed external skill
+				logLines = append(logLines, fmt.Sprintf("⏭️  %s: %s is gone, %s not restored", c.Name, contractHome(l.Target), contractHome(l.Path)))
+				continue
+			}
+			err := os.MkdirAll(filepath.Dir(l.Path), 0755)
+			if err == nil {
+				err = os.Symlink(l.Target, l.Path)
+			}
+			if err != nil {
+				logLines = append(logLines, fmt.Sprintf("❌ %s: failed to restore %s: %v", c.Name, contractHome(l.Path), err))
+				failed++
+				continue
+			}
+			logLines = append(logLines, fmt.Sprintf("↩️  %s: restored %s → %s", c.Name, contractHome(l.Path), l.Target))
+		}
+		for _, path := range c.Lost {
+			logLines = append(logLines, fmt.Sprintf("⚠️  %s: %s was deleted and has no backup", c.Name, contractHome(path)))
+		}
+	}
+	if failed > 0 {
+		return logLines, fmt.Errorf("%d path(s) could not be reverted", failed)
+	}
+	return logLines, nil
+}
+
+// undoSkillActionCmd reverts op and clears the undo record
+func undoSkillActionCmd(op *skillOperation) tea.Cmd {
+	return func() tea.Msg {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return skillActionCompleteMsg{err: fmt.Errorf("cannot determine home directory: %w", err)}
+		}
+		logLines, err := undoSkillOperation(home, op)
+		ledger, lerr := loadSkillLedger(home)
+		if lerr == nil {
+			ledger.Undo = nil
+			lerr = saveSkillLedger(home, ledger)
+		}
+		if lerr != nil {
+			logLines = append(logLines, "⚠️  Could not clear the undo record: "+lerr.Error())
+		}
+		return skillActionCompleteMsg{logLines: logLines, err: err}
+	}
+}
@@ -0,0 +1,213 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// writeUndoCatalog creates a catalog skill and returns it
+func writeUndoCatalog(t *testing.T, home, name string) SkillInfo {
+	t.Helper()
+	dir := filepath.Join(home, ".gentleman", "skills", "curated", name)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	os.WriteFile(filepath.Join(dir, "SKILL.md"), []byte("---\nname: "+name+"\n---\n"), 0644)
+	return SkillInfo{Name: name, Type: "skill", FullPath: dir}
+}
+
+// runSkillCmd runs a skill action command and returns its completion message
+func runSkillCmd(t *testing.T, cmd tea.Cmd) skillActionCompleteMsg {
+	t.Helper()
+	msg, ok := cmd().(skillActionCompleteMsg)
+	if !ok {
+		t.Fatal("expected a skillActionCompleteMsg")
+	}
+	return msg
+}
+
+func TestUndoSkillInstall(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	skill := writeUndoCatalog(t, home, "react-19")
+
+	msg := runSkillCmd(t, installSkillActionCmd([]SkillInfo{skill}, SkillLinkOptions{}))
+	if !msg.undo.Undoable() || msg.undo.Kind != "install" {
+		t.Fatalf("the install should be undoable, got %+v", msg.undo)
+	}
+
+	msg = runSkillCmd(t, undoSkillActionCmd(msg.undo))
+	if msg.err != nil {
+		t.Fatalf("undo failed: %v", msg.err)
+	}
+	for _, dir := range skillLinkDirs(home) {
+		if _, err := os.Lstat(filepath.Join(dir, "react-19")); !os.IsNotExist(err) {
+			t.Errorf("undo should remove the link in %s", dir)
+		}
+	}
+	if got := strings.Join(msg.logLines, "\n"); strings.Count(got, "removed ~/") != 2 {
+		t.Errorf("expected both removed links to be reported, got:\n%s", got)
+	}
+	if currentSkillUndo() != nil {
+		t.Error("undo is single-level and should clear the record")
+	}
+}
+
+func TestUndoSkillRemove(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	skill := writeUndoCatalog(t, home, "react-19")
+	linkSkill(t, home, "react-19", skill.FullPath)
+
+	msg := runSkillCmd(t, removeSkillActionCmd([]SkillInfo{skill}, SkillLinkOptions{}))
+	if !msg.undo.Undoable() || msg.undo.Kind != "remove" {
+		t.Fatalf("the removal should be undoable, got %+v", msg.undo)
+	}
+
+	// The record survives a restart: a new session picks it up from the ledger
+	m := NewModel()
+	m.Screen = ScreenMainMenu
+	for i, opt := range m.GetCurrentOptions() {
+		if strings.Contains(opt, "Skill Manager") {
+			m.Cursor = i
+		}
+	}
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = result.(Model)
+	if !m.SkillUndo.Undoable() {
+		t.Fatal("the skill menu should load the undo record from the ledger")
+	}
+	if opt := m.GetCurrentOptions()[5]; !strings.Contains(opt, "remove of react-19") {
+		t.Errorf("unexpected undo entry %q", opt)
+	}
+
+	m.Cursor = 5
+	result, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = result.(Model)
+	if m.Screen != ScreenSkillResult || cmd == nil {
+		t.Fatalf("expected the undo to run, got screen %d", m.Screen)
+	}
+	result, _ = m.Update(runSkillCmd(t, cmd))
+	m = result.(Model)
+
+	for _, dir := range skillLinkDirs(home) {
+		if !symlinkPointsTo(filepath.Join(dir, "react-19"), skill.FullPath) {
+			t.Errorf("undo should restore the link in %s", dir)
+		}
+	}
+	if got := strings.Join(m.SkillResultLog.Lines(), "\n"); strings.Count(got, "restored ~/") != 2 {
+		t.Errorf("expected both restored links to be reported, got:\n%s", got)
+	}
+	if m.SkillUndo != nil {
+		t.Error("nothing should be left to undo")
+	}
+}
+
+func TestUndoDisabledWithoutRecord(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	m := NewModel()
+	m.Screen = ScreenSkillMenu
+	m.SkillUndo = currentSkillUndo()
+
+	opt := m.GetCurrentOptions()[5]
+	if !strings.HasSuffix(opt, nothingToUndoSuffix) {
+		t.Errorf("the undo entry should be disabled, got %q", opt)
+	}
+
+	m.Cursor = 5
+	result, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if result.(Model).Screen != ScreenSkillMenu || cmd != nil {
+		t.Error("a disabled undo entry should do nothing")
+	}
+
+	t.Run("a no-op operation keeps nothing to undo", func(t *testing.T) {
+		msg := runSkillCmd(t, removeSkillActionCmd([]SkillInfo{{Name: "never-installed", Type: "skill"}}, SkillLinkOptions{}))
+		if msg.undo != nil {
+			t.Errorf("removing a skill that isn't installed has nothing to undo, got %+v", msg.undo)
+		}
+	})
+
+	t.Run("deleted directories are reported but not undoable", func(t *testing.T) {
+		plugin := filepath.Join(home, ".claude", "plugins", "mermaid")
+		os.MkdirAll(plugin, 0755)
+		msg := runSkillCmd(t, removeSkillActionCmd([]SkillInfo{{Name: "mermaid", Type: "plugin"}}, SkillLinkOptions{}))
+		if msg.undo != nil {
+			t.Errorf("a removed plugin copy cannot be restored, got %+v", msg.undo)
+		}
+	})
+}
+
+func TestUndoFromResultScreenPartly(t *testing.T) {
+	press := func(m Model, key string) (Model, tea.Cmd) {
+		result, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+		return result.(Model), cmd
+	}
+	deliver := func(m Model, cmd tea.Cmd) Model {
+		result, _ := m.Update(runSkillCmd(t, cmd))
+		return result.(Model)
+	}
+
+	t.Run("removal with a link that can't be restored", func(t *testing.T) {
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+		skill := writeUndoCatalog(t, home, "react-19")
+		linkSkill(t, home, "react-19", skill.FullPath)
+
+		m := deliver(NewModel(), removeSkillActionCmd([]SkillInfo{skill}, SkillLinkOptions{}))
+		if !strings.Contains(m.View(), "u to undo the remove of react-19") {
+			t.Fatal("the result screen should offer the undo")
+		}
+
+		// One skills directory turned into a file meanwhile
+		dirs := skillLinkDirs(home)
+		os.RemoveAll(dirs[0])
+		os.WriteFile(dirs[0], nil, 0644)
+
+		m, cmd := press(m, "u")
+		if cmd == nil || m.SkillUndo != nil {
+			t.Fatal("u should run the undo once")
+		}
+		m = deliver(m, cmd)
+		log := strings.Join(m.SkillResultLog.Lines(), "\n")
+		if m.ErrorMsg == "" || !strings.Contains(log, "failed to restore") || !strings.Contains(log, "restored ~/") {
+			t.Errorf("the failed and the restored link should both be reported, got %q:\n%s", m.ErrorMsg, log)
+		}
+		if !symlinkPointsTo(filepath.Join(dirs[1], "react-19"), skill.FullPath) {
+			t.Error("the link that could be restored should be back")
+		}
+		if _, cmd := press(m, "u"); cmd != nil {
+			t.Error("an undo can't be undone")
+		}
+	})
+
+	t.Run("install with a link replaced since", func(t *testing.T) {
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+		skill := writeUndoCatalog(t, home, "react-19")
+
+		m := deliver(NewModel(), installSkillActionCmd([]SkillInfo{skill}, SkillLinkOptions{}))
+		dirs := skillLinkDirs(home)
+		mine := filepath.Join(dirs[0], "react-19")
+		os.Remove(mine)
+		os.MkdirAll(mine, 0755)
+
+		m, cmd := press(m, "u")
+		m = deliver(m, cmd)
+		log := strings.Join(m.SkillResultLog.Lines(), "\n")
+		if !strings.Contains(log, "changed since, left alone") || !strings.Contains(log, "removed ~/") {
+			t.Errorf("the kept and the removed link should both be reported, got:\n%s", log)
+		}
+		if info, err := os.Lstat(mine); err != nil || !info.IsDir() {
+			t.Error("a directory put in place of the link should be left alone")
+		}
+		if _, err := os.Lstat(filepath.Join(dirs[1], "react-19")); !os.IsNotExist(err) {
+			t.Error("the untouched link should be removed")
+		}
+	})
+}
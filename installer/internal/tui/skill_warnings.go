@@ -0,0 +1,112 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// SkillCatalogWarning is a catalog directory fetchSkillCatalog skipped, and why
+type SkillCatalogWarning struct {
+	Path   string // The skill directory
+	Reason string
+}
+
+const (
+	skillWarningMissing   = "no SKILL.md"
+	skillWarningBlankName = "no name in the frontmatter and the folder name is blank"
+)
+
+// skillFileProblem says why skillFile can't be read as a skill, or "" when it can
+func skillFileProblem(skillFile string) string {
+	if _, err := os.Stat(skillFile); errors.Is(err, fs.ErrNotExist) {
+		return skillWarningMissing
+	}
+	if _, err := os.ReadFile(skillFile); err != nil {
+		var pathErr *fs.PathError
+		if errors.As(err, &pathErr) {
+			err = pathErr.Err
+		}
+		return "SKILL.md is unreadable: " + err.Error()
+	}
+	return ""
+}
+
+// skillWarningsNotice is the browse screen line counting the skipped skills
+func skillWarningsNotice(n int) string {
+	if n == 1 {
+		return "1 skill skipped — press w for details"
+	}
+	return fmt.Sprintf("%d skills skipped — press w for details", n)
+}
+
+// skillWarningLines lists each skipped skill as its path and the reason below it
+func skillWarningLines(warnings []SkillCatalogWarning) []string {
+	var lines []string
+	for _, w := range warnings {
+		lines = append(lines, "  "+contractHome(w.Path), "      "+w.Reason)
+	}
+	return lines
+}
+
+// skillWarningsVisibleLines is how many lines fit between the header and the help line
+func (m Model) skillWarningsVisibleLines() int {
+	return m.visibleRows(8)
+}
+
+// handleSkillWarningsKeys scrolls the skipped skill list; Enter returns to Browse
+func (m Model) handleSkillWarningsKeys(key string) (tea.Model, tea.Cmd) {
+	maxScroll := len(skillWarningLines(m.SkillCatalogWarnings)) - m.skillWarningsVisibleLines()
+	switch key {
+	case "up", "k":
+		if m.SkillWarningScroll > 0 {
+			m.SkillWarningScroll--
+		}
+	case "down", "j":
+		if m.SkillWarningScroll < maxScroll {
+			m.SkillWarningScroll++
+		}
+	case "enter", "w", "q":
+		m.Screen = ScreenSkillBrowse
+		m.SkillWarningScroll = 0
+	}
+	return m, nil
+}
+
+func (m Model) renderSkillWarnings() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
+	s.WriteString("\n\n")
+
+	lines := skillWarningLines(m.SkillCatalogWarnings)
+	start := min(m.SkillWarningScroll, len(lines))
+	end := min(start+m.skillWarningsVisibleLines(), len(lines))
+	if start > 0 {
+		s.WriteString(m.Theme.Muted.Render(fmt.Sprintf("  ▲ %d more above", start)))
+		s.WriteString("\n")
+	}
+	for i, line := range lines[start:end] {
+		// Paths on even lines, reasons under them
+		if (start+i)%2 == 0 {
+			s.WriteString(line)
+		} else {
+			s.WriteString(m.Theme.Warning.Render(line))
+		}
+		s.WriteString("\n")
+	}
+	if end < len(lines) {
+		s.WriteString(m.Theme.Muted.Render(fmt.Sprintf("  ▼ %d more below", len(lines)-end)))
+		s.WriteString("\n")
+	}
+
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render("↑/k up • ↓/j down • [Enter/Esc] back"))
+	return s.String()
+}
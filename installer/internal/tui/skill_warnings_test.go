@@ -0,0 +1,155 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestFetchSkillCatalogWarnings(t *testing.T) {
+	tests := []struct {
+		name   string
+		setup  func(t *testing.T, dir string) // Builds the broken skill in dir
+		reason string
+	}{
+		{
+			name:   "missing SKILL.md",
+			setup:  func(t *testing.T, dir string) { os.WriteFile(filepath.Join(dir, "README.md"), []byte("# draft"), 0644) },
+			reason: skillWarningMissing,
+		},
+		{
+			name: "dangling SKILL.md link",
+			setup: func(t *testing.T, dir string) {
+				if err := os.Symlink(filepath.Join(dir, "gone.md"), filepath.Join(dir, "SKILL.md")); err != nil {
+					t.Fatal(err)
+				}
+			},
+			reason: skillWarningMissing,
+		},
+		{
+			name:   "unreadable SKILL.md",
+			setup:  func(t *testing.T, dir string) { os.MkdirAll(filepath.Join(dir, "SKILL.md"), 0755) },
+			reason: "SKILL.md is unreadable: is a directory",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			home := t.TempDir()
+			t.Setenv("HOME", home)
+			writeUndoCatalog(t, home, "react-19")
+			broken := filepath.Join(home, ".gentleman", "skills", "community", "broken")
+			os.MkdirAll(broken, 0755)
+			tt.setup(t, broken)
+
+			skills, warnings, err := fetchSkillCatalog()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(skills) != 1 || skills[0].Name != "react-19" {
+				t.Errorf("only the valid skill should be listed, got %+v", skills)
+			}
+			if len(warnings) != 1 {
+				t.Fatalf("expected one warning, got %+v", warnings)
+			}
+			if warnings[0].Path != broken || warnings[0].Reason != tt.reason {
+				t.Errorf("warning = %+v, want %s: %q", warnings[0], broken, tt.reason)
+			}
+		})
+	}
+
+	t.Run("blank name after fallback", func(t *testing.T) {
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+		// No name in the frontmatter, and the folder name is all spaces
+		writeUndoCatalog(t, home, "react-19")
+		blank := filepath.Join(home, ".gentleman", "skills", "curated", "  ")
+		os.MkdirAll(blank, 0755)
+		os.WriteFile(filepath.Join(blank, "SKILL.md"), []byte("---\ndescription: nameless\n---\n"), 0644)
+
+		skills, warnings, err := fetchSkillCatalog()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(skills) != 1 {
+			t.Errorf("the nameless skill should be skipped, got %+v", skills)
+		}
+		if len(warnings) != 1 || warnings[0].Reason != skillWarningBlankName {
+			t.Errorf("warnings = %+v", warnings)
+		}
+	})
+
+	t.Run("no warnings for a clean catalog", func(t *testing.T) {
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+		writeUndoCatalog(t, home, "react-19")
+		// A folder name stands in for a missing frontmatter name
+		os.WriteFile(filepath.Join(writeUndoCatalog(t, home, "tailwind-4").FullPath, "SKILL.md"), []byte("# Tailwind"), 0644)
+
+		skills, warnings, err := fetchSkillCatalog()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(skills) != 2 || len(warnings) != 0 {
+			t.Errorf("skills = %+v, warnings = %+v", skills, warnings)
+		}
+	})
+}
+
+func TestSkillWarningsScreen(t *testing.T) {
+	m := NewModel()
+	m.Height = 13
+	m.Screen = ScreenSkillBrowse
+	result, _ := m.Update(skillsLoadedMsg{
+		skills: []SkillInfo{{Name: "react-19", Category: "curated", Type: "skill"}},
+		warnings: []SkillCatalogWarning{
+			{Path: "/catalog/curated/a", Reason: skillWarningMissing},
+			{Path: "/catalog/curated/b", Reason: "SKILL.md is unreadable: permission denied"},
+			{Path: "/catalog/community/c", Reason: skillWarningMissing},
+		},
+	})
+	m = result.(Model)
+
+	if view := m.renderSkillBrowse(); !strings.Contains(view, "3 skills skipped — press w for details") {
+		t.Errorf("browse should count the skipped skills, got:\n%s", view)
+	}
+
+	result, _ = m.handleSkillBrowseKeys("w")
+	m = result.(Model)
+	if m.Screen != ScreenSkillWarnings {
+		t.Fatalf("w should open the skipped skills, got %v", m.Screen)
+	}
+	view := m.renderSkillWarnings()
+	for _, want := range []string{"/catalog/curated/a", "no SKILL.md", "permission denied", "▼ 1 more below"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("view should show %q, got:\n%s", want, view)
+		}
+	}
+
+	for range 5 {
+		result, _ = m.handleSkillWarningsKeys("down")
+		m = result.(Model)
+	}
+	if m.SkillWarningScroll != 1 {
+		t.Errorf("scroll should stop at the last line, got %d", m.SkillWarningScroll)
+	}
+
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if got := result.(Model).Screen; got != ScreenSkillBrowse {
+		t.Errorf("esc should return to browse, got %v", got)
+	}
+
+	// Without warnings there's nothing to open
+	m = NewModel()
+	m.Screen = ScreenSkillBrowse
+	m.SkillCatalog = []SkillInfo{{Name: "react-19", Category: "curated", Type: "skill"}}
+	result, _ = m.handleSkillBrowseKeys("w")
+	if got := result.(Model).Screen; got != ScreenSkillBrowse {
+		t.Errorf("w without warnings should stay on browse, got %v", got)
+	}
+	if view := m.renderSkillBrowse(); strings.Contains(view, "skipped") {
+		t.Errorf("a clean catalog shouldn't mention skipped skills, got:\n%s", view)
+	}
+}
@@ -0,0 +1,549 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+)
+
+// StepCommand is one shell command in a declarative step
+type StepCommand struct {
+	Log      string // Progress line sent before running ("" to stay quiet)
+	Run      string // Command line; {home}, {repo} and {scratch} are expanded
+	Runner   string // "" (plain shell), "sudo", "brew" (Run holds brew args), "pkg" or "native" (both: Run holds package names)
+	OnError  string // Error description shown when the command fails
+	Optional bool   // Log failures and keep going
+}
+
+// ConfigCopy deploys a file or directory from the dotfiles repo
+type ConfigCopy struct {
+	Src string // Relative to the repo checkout
+	Dst string // Relative to $HOME
+}
+
+// StepSpec declares one installable component. Simple components are fully
+// declarative (Binary, Install, Configs); complex ones keep a bespoke Run func.
+type StepSpec struct {
+	ID          string
+	Name        string
+	Description string
+	Interactive bool     // Needs a real terminal (sudo, chsh)
+	Retryable   bool     // Safe to run again after a failure; retried with stepRetryPolicy
+	DependsOn   []string // Steps that must finish first; the others may run alongside it
+	Locks       []string // Declarative steps take lockPackages on their own when they run a package manager
+
+	// Optional hooks for values that depend on the user's choices
+	When            func(m *Model) bool // Include the step in the plan; nil means always
+	Title           func(m *Model) string
+	Describe        func(m *Model) string
+	InteractiveWhen func(m *Model) bool
+
+	// Declarative body, used when Run is nil
+	Binary  string                   // Skip Install when this command is already on PATH
+	Install map[string][]StepCommand // Keyed as installCommands looks them up
+	Configs []ConfigCopy
+	Scratch string               // Temp dir name the commands build in ({scratch}); removed once they succeed
+	After   func(m *Model) error // Runs once the configs are deployed, for the edits they need
+	Done    string               // Final log line
+
+	// Steps whose body depends on a choice (the terminal, the multiplexer)
+	// declare one body per value; Variant returns the value to run
+	Variant  func(m *Model) string
+	Variants map[string]StepSpec
+
+	// Run handles steps too involved to declare
+	Run func(m *Model) error
+}
+
+// packageStepDeps set up the package manager and build tools
+var packageStepDeps = []string{"homebrew", "deps", "xcode"}
+
+// toolStepDeps fit steps that install a package and deploy its config from
+// the checkout over the backed-up one
+var toolStepDeps = append([]string{"backup", "clone"}, packageStepDeps...)
+
+// installStepRegistry is the full install plan, in an order that lists every
+// step after the steps it depends on. Independent steps run concurrently.
+// To add a component, append a StepSpec here; no other plumbing is needed.
+var installStepRegistry = []StepSpec{
+	{
+		ID:          "backup",
+		Name:        "Backup Existing Configs",
+		Description: "Creating backup of your current configuration",
+		When:        func(m *Model) bool { return m.Choices.CreateBackup && len(m.ExistingConfigs) > 0 },
+		Run:         stepBackupConfigs,
+	},
+	{
+		ID:          "clone",
+		Name:        "Clone Repository",
+		Description: "Downloading Javi.Dots",
+		Retryable:   true,
+		Run:         stepCloneRepo,
+	},
+	{
+		ID:          "homebrew",
+		Name:        "Install Homebrew",
+		Description: "Package manager",
+		Interactive: true, // First install needs a password
+		When: func(m *Model) bool {
+			return !m.SystemInfo.HasBrew && !m.SystemInfo.IsTermux && !m.Choices.NativePackages && !isWindowsChoice(m)
+		},
+		Run: stepInstallHomebrew,
+	},
+	{
+		ID:        "deps",
+		Name:      "Install Dependencies",
+		DependsOn: []string{"homebrew"},
+		When:      func(m *Model) bool { return m.Choices.OS == "linux" || isTermuxChoice(m) },
+		Describe: func(m *Model) string {
+			if isTermuxChoice(m) {
+				return "Base packages (pkg)"
+			}
+			return "Base packages"
+		},
+		InteractiveWhen: func(m *Model) bool { return !isTermuxChoice(m) }, // sudo, except on Termux
+		Run:             stepInstallDeps,
+	},
+	{
+		ID:          "xcode",
+		Name:        "Install Xcode CLI",
+		Description: "Developer tools",
+		DependsOn:   []string{"homebrew"},
+		When:        func(m *Model) bool { return m.Choices.OS == "mac" && !isTermuxChoice(m) && !m.SystemInfo.HasXcode },
+		Run:         stepInstallXcode,
+	},
+	{
+		ID:              "terminal",
+		Description:     "Terminal emulator",
+		DependsOn:       toolStepDeps,
+		Locks:           []string{lockPackages},
+		When:            func(m *Model) bool { return m.Choices.Terminal != "none" && m.Choices.Terminal != "" },
+		Title:           func(m *Model) string { return "Install " + m.Choices.Terminal },
+		InteractiveWhen: func(m *Model) bool { return m.Choices.OS == "linux" }, // pacman/apt need sudo
+		Variant:         func(m *Model) string { return m.Choices.Terminal },
+		Variants:        terminalVariants,
+	},
+	{
+		ID:          "font",
+		Name:        "Install Iosevka Nerd Font",
+		Description: "Nerd font with icons",
+		Retryable:   true,
+		DependsOn:   packageStepDeps,
+		When:        func(m *Model) bool { return m.Choices.InstallFont },
+		Install: map[string][]StepCommand{
+			"termux": {
+				{Run: "mkdir -p {home}/.termux", OnError: "Failed to create .termux directory"},
+				{Log: "Downloading JetBrainsMono Nerd Font for Termux...", Run: "curl -fsSL -o {home}/.termux/font.ttf https://github.com/ryanoasis/nerd-fonts/raw/HEAD/patched-fonts/JetBrainsMono/Ligatures/Regular/JetBrainsMonoNerdFont-Regular.ttf", OnError: "Failed to download font. Check your internet connection."},
+				{Log: "Reloading Termux settings... restart Termux to apply", Run: "termux-reload-settings", Optional: true},
+			},
+			"mac": {
+				{Log: "Installing Iosevka Term Nerd Font...", Run: "install --cask font-iosevka-term-nerd-font", Runner: "brew", OnError: "Failed to install font via Homebrew. Try installing manually from https://www.nerdfonts.com/"},
+			},
+			"linux": {
+				{Log: "Creating fonts directory...", Run: "mkdir -p {home}/.local/share/fonts", OnError: "Failed to create fonts directory"},
+				{Log: "Downloading Iosevka Term Nerd Font...", Run: "curl -fsSL -o {home}/.local/share/fonts/IosevkaTerm.zip https://github.com/ryanoasis/nerd-fonts/releases/download/v3.3.0/IosevkaTerm.zip", OnError: "Failed to download font. Check your internet connection."},
+				{Log: "Extracting font archive...", Run: "unzip -o {home}/.local/share/fonts/IosevkaTerm.zip -d {home}/.local/share/fonts/", OnError: "Failed to extract font archive"},
+				{Log: "Updating font cache...", Run: "fc-cache -fv", Optional: true},
+			},
+		},
+		Done: "✓ Font installed",
+	},
+	{
+		ID:          "shell",
+		Description: "Shell and plugins",
+		DependsOn:   toolStepDeps,
+		Locks:       []string{lockPackages},
+		When:        func(m *Model) bool { return !isWindowsChoice(m) },
+		Title:       func(m *Model) string { return "Install " + m.Choices.Shell },
+		// The distro package manager needs sudo
+		InteractiveWhen: func(m *Model) bool { return m.Choices.NativePackages },
+		Run:             stepInstallShell,
+	},
+	{
+		ID:              "wm",
+		Description:     "Terminal multiplexer",
+		DependsOn:       toolStepDeps,
+		Locks:           []string{lockPackages},
+		When:            func(m *Model) bool { return m.Choices.WindowMgr != "none" && m.Choices.WindowMgr != "" },
+		Title:           func(m *Model) string { return "Install " + m.Choices.WindowMgr },
+		InteractiveWhen: func(m *Model) bool { return m.Choices.NativePackages },
+		Variant:         func(m *Model) string { return m.Choices.WindowMgr },
+		Variants:        wmVariants,
+	},
+	{
+		ID:              "nvim",
+		Name:            "Install Neovim",
+		Description:     "Editor with config",
+		DependsOn:       toolStepDeps,
+		Locks:           []string{lockPackages},
+		When:            func(m *Model) bool { return m.Choices.InstallNvim },
+		InteractiveWhen: func(m *Model) bool { return m.Choices.NativePackages },
+		Run:             stepInstallNvim,
+	},
+	{
+		ID:          "zed",
+		Name:        "Install Zed",
+		Description: "Editor with Vim mode",
+		DependsOn:   toolStepDeps,
+		Locks:       []string{lockPackages},
+		When:        func(m *Model) bool { return m.Choices.InstallZed },
+		Run:         stepInstallZed,
+	},
+	{
+		ID:   "aitools",
+		Name: "Install AI Tools",
+		// npm comes with the Neovim setup
+		DependsOn: append([]string{"nvim"}, toolStepDeps...),
+		When:      func(m *Model) bool { return len(m.Choices.AITools) > 0 },
+		Describe:  func(m *Model) string { return strings.Join(m.Choices.AITools, " + ") },
+		Run:       stepInstallAITools,
+	},
+	{
+		ID:        "aiframework",
+		Name:      "Install AI Framework",
+		Retryable: true,
+		// Configures the CLIs installed by aitools
+		DependsOn: []string{"backup", "aitools"},
+		When:      func(m *Model) bool { return m.Choices.InstallAIFramework },
+		Describe: func(m *Model) string {
+			if m.Choices.AIFrameworkPreset == "" {
+				if m.AICategorySelected != nil {
+					return "Preset: custom (" + frameworkFeaturesFlag(m.Choices.AIFrameworkModules) + ")"
+				}
+				return "Preset: custom"
+			}
+			return "Preset: " + m.Choices.AIFrameworkPreset
+		},
+		Run: stepInstallAIFramework,
+	},
+	{
+		ID:          "setshell",
+		Name:        "Set Default Shell",
+		Description: "Configure default shell",
+		DependsOn:   []string{"shell"},
+		Interactive: true, // chsh needs a password
+		When:        func(m *Model) bool { return !isWindowsChoice(m) },
+		Run:         stepSetDefaultShell,
+	},
+	{
+		ID:          "cleanup",
+		Name:        "Cleanup",
+		Description: "Removing temporary files",
+		// Removes the checkout the other steps deploy from, so it goes last
+		DependsOn: []string{"backup", "clone", "homebrew", "deps", "xcode", "terminal", "font", "shell", "wm", "nvim", "zed", "aitools", "aiframework", "setshell"},
+		Run:       stepCleanup,
+	},
+}
+
+// terminalVariants install each terminal emulator and deploy its config
+var terminalVariants = map[string]StepSpec{
+	"alacritty": {
+		Binary: "alacritty",
+		Install: map[string][]StepCommand{
+			"mac":    {{Log: "Installing Alacritty...", Run: "install --cask alacritty", Runner: "brew", OnError: "Failed to install Alacritty terminal emulator"}},
+			"arch":   {{Log: "Installing Alacritty...", Run: "alacritty", Runner: "native", OnError: "Failed to install Alacritty terminal emulator"}},
+			"fedora": {{Log: "Installing Alacritty...", Run: "alacritty", Runner: "native", OnError: "Failed to install Alacritty terminal emulator"}},
+			// Debian/Ubuntu and every other distro: compile from source (PPAs
+			// are unreliable). The build dependencies come from apt, so other
+			// distros need them installed first.
+			"linux": {
+				{Log: "Building Alacritty from source...", Run: "apt-get install -y cmake pkg-config libfreetype6-dev libfontconfig1-dev libxcb-xfixes0-dev libxkbcommon-dev python3 gzip scdoc git curl", Runner: "sudo", OnError: "Failed to install build dependencies"},
+				// Rust/Cargo only for this build
+				{Log: "Checking for the Rust/Cargo toolchain...", Run: "command -v cargo >/dev/null || [ -x {home}/.cargo/bin/cargo ] || curl --proto '=https' --tlsv1.2 -sSf https://sh.rustup.rs | sh -s -- -y", OnError: "Failed to install Rust"},
+				{Log: "Cloning Alacritty repository...", Run: "git clone https://github.com/alacritty/alacritty.git {scratch}", OnError: "Failed to clone Alacritty repository"},
+				{Log: "Building Alacritty (this may take 5-10 minutes)...", Run: "PATH=$PATH:{home}/.cargo/bin cargo build --release --manifest-path {scratch}/Cargo.toml", OnError: "Failed to build Alacritty"},
+				{Log: "Installing Alacritty binary...", Run: "cp {scratch}/target/release/alacritty /usr/local/bin/alacritty", Runner: "sudo", OnError: "Failed to install Alacritty binary"},
+				{Run: "cp {scratch}/extra/linux/Alacritty.desktop /usr/share/applications/", Runner: "sudo", Optional: true},
+			},
+		},
+		Scratch: alacrittyTempName,
+		Configs: []ConfigCopy{{Src: "alacritty.toml", Dst: ".config/alacritty/alacritty.toml"}},
+		Done:    "✓ Alacritty configured",
+	},
+	"wezterm": {
+		Binary: "wezterm",
+		Install: map[string][]StepCommand{
+			"mac": {{Log: "Installing WezTerm...", Run: "install --cask wezterm", Runner: "brew", OnError: "Failed to install WezTerm terminal emulator"}},
+			"dnf": {
+				{Log: "Enabling the WezTerm COPR...", Run: "dnf copr enable -y wezfurlong/wezterm-nightly", Runner: "sudo", Optional: true},
+				{Log: "Installing WezTerm...", Run: "wezterm", Runner: "native", OnError: "Failed to install WezTerm terminal emulator"},
+			},
+			// Not packaged for apt; Homebrew on Linux has it
+			"apt": {
+				{Run: "tap wez/wezterm-linuxbrew", Runner: "brew", Optional: true},
+				{Log: "Installing WezTerm...", Run: "install wezterm", Runner: "brew", OnError: "Failed to install WezTerm terminal emulator"},
+			},
+			"linux": {{Log: "Installing WezTerm...", Run: "wezterm", Runner: "native", OnError: "Failed to install WezTerm terminal emulator"}},
+		},
+		Configs: []ConfigCopy{{Src: ".wezterm.lua", Dst: ".config/wezterm/wezterm.lua"}},
+		Done:    "✓ WezTerm configured",
+	},
+	"kitty": {
+		Binary: "kitty",
+		Install: map[string][]StepCommand{
+			"mac": {{Log: "Installing Kitty...", Run: "install --cask kitty", Runner: "brew", OnError: "Failed to install Kitty terminal emulator"}},
+			// Linux users install kitty themselves; only the config is deployed
+			"linux": nil,
+		},
+		Configs: []ConfigCopy{{Src: "GentlemanKitty", Dst: ".config/kitty"}},
+		Done:    "✓ Kitty configured",
+	},
+	"ghostty": {
+		Binary: "ghostty",
+		Install: map[string][]StepCommand{
+			"mac": {{Log: "Installing Ghostty...", Run: "install --cask ghostty", Runner: "brew", OnError: "Failed to install Ghostty terminal emulator"}},
+			"dnf": {
+				{Log: "Enabling the Ghostty COPR...", Run: "dnf copr enable -y pgdev/ghostty", Runner: "sudo", Optional: true},
+				{Log: "Installing Ghostty...", Run: "ghostty", Runner: "native", OnError: "Failed to install Ghostty terminal emulator"},
+			},
+			"apt": {{Log: "Installing Ghostty...", Run: `/bin/bash -c "$(curl -fsSL https://raw.githubusercontent.com/mkasberg/ghostty-ubuntu/HEAD/install.sh)"`, OnError: "Failed to install Ghostty terminal emulator"}},
+			"linux": {{Log: "Installing Ghostty...", Run: "ghostty", Runner: "native",
+				OnError: "Failed to install Ghostty; if your distro doesn't package it, see https://ghostty.org/docs/install/binary"}},
+		},
+		Configs: []ConfigCopy{{Src: "GentlemanGhostty", Dst: ".config/ghostty"}},
+		Done:    "✓ Ghostty configured",
+	},
+}
+
+// wmVariants install each multiplexer and deploy its config; After applies
+// the picked extras and the default shell
+var wmVariants = map[string]StepSpec{
+	"tmux": {
+		Binary: "tmux",
+		Install: map[string][]StepCommand{
+			"termux": {{Log: "Installing Tmux...", Run: "tmux", Runner: "pkg", OnError: "Failed to install Tmux"}},
+			"native": {{Log: "Installing Tmux...", Run: "tmux", Runner: "native", OnError: "Failed to install Tmux"}},
+			"mac":    {{Log: "Installing Tmux...", Run: "install tmux", Runner: "brew", OnError: "Failed to install Tmux"}},
+			"linux":  {{Log: "Installing Tmux...", Run: "install tmux", Runner: "brew", OnError: "Failed to install Tmux"}},
+		},
+		Configs: []ConfigCopy{{Src: "GentlemanTmux/tmux.conf", Dst: ".tmux.conf"}},
+		After:   configureTmux,
+		Done:    "✓ Tmux configured",
+	},
+	"zellij": {
+		Binary: "zellij",
+		Install: map[string][]StepCommand{
+			"termux": {{Log: "Installing Zellij...", Run: "zellij", Runner: "pkg", OnError: "Failed to install Zellij"}},
+			"native": {{Log: "Installing Zellij...", Run: "zellij", Runner: "native", OnError: "Failed to install Zellij"}},
+			"mac":    {{Log: "Installing Zellij...", Run: "install zellij", Runner: "brew", OnError: "Failed to install Zellij"}},
+			"linux":  {{Log: "Installing Zellij...", Run: "install zellij", Runner: "brew", OnError: "Failed to install Zellij"}},
+		},
+		Configs: []ConfigCopy{{Src: "GentlemanZellij/zellij/config.kdl", Dst: ".config/zellij/config.kdl"}},
+		After:   configureZellij,
+		Done:    "✓ Zellij configured",
+	},
+}
+
+// isTermuxChoice checks both the user's choice and detection (redundancy)
+func isTermuxChoice(m *Model) bool {
+	return m.Choices.OS == "termux" || m.SystemInfo.IsTermux
+}
+
+// isWindowsChoice reports a native Windows install, which has no shell,
+// terminal or multiplexer steps and never needs Homebrew
+func isWindowsChoice(m *Model) bool {
+	return m.Choices.OS == "windows"
+}
+
+// lookupStepSpec returns the registered spec for a step ID
+func lookupStepSpec(id string) (StepSpec, bool) {
+	for _, spec := range installStepRegistry {
+		if spec.ID == id {
+			return spec, true
+		}
+	}
+	return StepSpec{}, false
+}
+
+// planStep builds the pending InstallStep for a spec
+func (s StepSpec) planStep(m *Model) InstallStep {
+	step := InstallStep{
+		ID:          s.ID,
+		Name:        s.Name,
+		Description: s.Description,
+		Status:      StatusPending,
+		Interactive: s.Interactive,
+		Retryable:   s.Retryable,
+		DependsOn:   s.DependsOn,
+		Locks:       s.Locks,
+	}
+	if s.Title != nil {
+		step.Name = s.Title(m)
+	}
+	if s.Describe != nil {
+		step.Description = s.Describe(m)
+	}
+	if s.InteractiveWhen != nil {
+		step.Interactive = s.InteractiveWhen(m)
+	}
+	if cmds, _ := s.body(m).installCommands(m); slices.ContainsFunc(cmds, func(c StepCommand) bool { return c.Runner != "" }) {
+		step.Locks = append(slices.Clip(step.Locks), lockPackages)
+	}
+	return step
+}
+
+// stepPlatform is the Install key for the current machine
+func stepPlatform(m *Model) string {
+	if isTermuxChoice(m) {
+		return "termux"
+	}
+	switch m.SystemInfo.OS {
+	case system.OSMac:
+		return "mac"
+	case system.OSArch:
+		return "arch"
+	case system.OSFedora:
+		return "fedora"
+	case system.OSDebian:
+		return "debian"
+	case system.OSWindows:
+		return "windows"
+	}
+	return "linux"
+}
+
+// body is the spec whose declarative fields run for the user's choices: the
+// picked variant, or s itself when it has none. An unknown variant has an
+// empty body.
+func (s StepSpec) body(m *Model) StepSpec {
+	if s.Variant == nil {
+		return s
+	}
+	return s.Variants[s.Variant(m)]
+}
+
+// installCommands picks the commands for the current machine, the Install
+// entry installKey names
+func (s StepSpec) installCommands(m *Model) ([]StepCommand, bool) {
+	cmds, ok := s.Install[s.installKey(m)]
+	return cmds, ok
+}
+
+// installKey names the Install entry for the current machine. "native" wins
+// on installs with Choices.NativePackages, then the stepPlatform() entry;
+// other Linux distros fall back to their package manager's entry ("apt",
+// "dnf", ...) and then to the generic "linux" one. It returns "" when none fits.
+func (s StepSpec) installKey(m *Model) string {
+	if _, ok := s.Install["native"]; ok && m.Choices.NativePackages {
+		return "native"
+	}
+	platform := stepPlatform(m)
+	if _, ok := s.Install[platform]; ok && platform != "linux" {
+		return platform
+	}
+	if platform == "mac" || platform == "termux" || platform == "windows" {
+		return ""
+	}
+	if m.SystemInfo != nil {
+		if _, ok := s.Install[m.SystemInfo.PackageManager]; ok {
+			return m.SystemInfo.PackageManager
+		}
+	}
+	if _, ok := s.Install["linux"]; ok {
+		return "linux"
+	}
+	return ""
+}
+
+// runDeclarativeStep executes a spec without a bespoke Run func
+func runDeclarativeStep(spec StepSpec, m *Model) error {
+	homeDir := os.Getenv("HOME")
+	name := spec.planStep(m).Name
+	body := spec.body(m)
+	scratch := ""
+	if body.Scratch != "" {
+		scratch = filepath.Join(os.TempDir(), body.Scratch)
+	}
+	expand := strings.NewReplacer("{home}", homeDir, "{repo}", m.RepoDir, "{scratch}", scratch).Replace
+	logLine := func(line string) { SendLog(spec.ID, line) }
+
+	if body.Binary != "" && system.CommandExists(body.Binary) {
+		SendLog(spec.ID, body.Binary+" already installed")
+	} else if len(body.Install) > 0 {
+		cmds, ok := body.installCommands(m)
+		if !ok {
+			return wrapStepError(spec.ID, name,
+				"Unsupported operating system for this step",
+				fmt.Errorf("platform: %s", stepPlatform(m)))
+		}
+		if scratch != "" {
+			// A failed build stays tracked, so leaving the installer removes it
+			os.RemoveAll(scratch)
+			installTemps.Track(scratch)
+		}
+		for _, c := range cmds {
+			if c.Log != "" {
+				SendLog(spec.ID, c.Log)
+			}
+			cmdLine := expand(c.Run)
+			var result *system.ExecResult
+			switch c.Runner {
+			case "sudo":
+				result = system.RunSudoWithLogs(m.installContext(), cmdLine, nil, logLine)
+			case "brew":
+				result = system.RunBrewWithLogs(m.installContext(), cmdLine, nil, logLine)
+			case "pkg":
+				result = system.RunPkgInstall(m.installContext(), cmdLine, nil, logLine)
+			case "native":
+				result = runNativeInstall(m, spec.ID, map[string]string{"linux": cmdLine})
+			default:
+				result = system.RunWithLogs(m.installContext(), cmdLine, nil, logLine)
+			}
+			if result.Error == nil {
+				continue
+			}
+			if c.Optional {
+				SendLog(spec.ID, "⚠️  "+cmdLine+" failed, continuing")
+				continue
+			}
+			desc := c.OnError
+			if desc == "" {
+				desc = "Command failed: " + cmdLine
+			}
+			return wrapStepError(spec.ID, name, desc, result.Error)
+		}
+		if scratch != "" {
+			os.RemoveAll(scratch)
+			installTemps.Untrack(scratch)
+		}
+	}
+
+	// All configs of a step land together or not at all
+	deploys := make([]system.ConfigDeploy, 0, len(body.Configs))
+	for _, c := range body.Configs {
+		SendLog(spec.ID, "Copying "+c.Src+" → ~/"+c.Dst)
+		deploys = append(deploys, system.ConfigDeploy{
+			Src: filepath.Join(m.RepoDir, c.Src),
+			Dst: filepath.Join(homeDir, c.Dst),
+		})
+	}
+	if err := system.DeployConfigs(deploys...); err != nil {
+		failed := "configuration"
+		var deployErr *system.DeployError
+		if errors.As(err, &deployErr) {
+			for i, d := range deploys {
+				if d.Dst == deployErr.Config {
+					failed = body.Configs[i].Src
+				}
+			}
+		}
+		return wrapStepError(spec.ID, name,
+			"Failed to copy "+failed,
+			err)
+	}
+
+	if body.After != nil {
+		if err := body.After(m); err != nil {
+			return err
+		}
+	}
+	if body.Done != "" {
+		SendLog(spec.ID, body.Done)
+	}
+	return nil
+}
@@ -0,0 +1,280 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+)
+
+func TestStepRegistryIntegrity(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, spec := range installStepRegistry {
+		if spec.ID == "" {
+			t.Error("registry entry without an ID")
+			continue
+		}
+		if seen[spec.ID] {
+			t.Errorf("duplicate step ID %q", spec.ID)
+		}
+		seen[spec.ID] = true
+		if spec.Name == "" && spec.Title == nil {
+			t.Errorf("step %q has no name", spec.ID)
+		}
+		if spec.Run == nil && len(spec.Install) == 0 && len(spec.Configs) == 0 && len(spec.Variants) == 0 {
+			t.Errorf("step %q has neither a Run func nor a declarative body", spec.ID)
+		}
+		if (spec.Variant == nil) != (len(spec.Variants) == 0) {
+			t.Errorf("step %q needs both Variant and Variants", spec.ID)
+		}
+		for name, variant := range spec.Variants {
+			if variant.Binary == "" || len(variant.Configs) == 0 {
+				t.Errorf("step %q variant %q should name its binary and configs", spec.ID, name)
+			}
+		}
+	}
+}
+
+// TestSetupInstallStepsMatchesLegacyPlan pins the plans the hand-written
+// SetupInstallSteps produced before the registry existed
+func TestSetupInstallStepsMatchesLegacyPlan(t *testing.T) {
+	type planned struct {
+		id, name, desc string
+		interactive    bool
+	}
+	tests := []struct {
+		name   string
+		sys    *system.SystemInfo
+		setup  func(m *Model)
+		expect []planned
+	}{
+		{
+			name: "linux with terminal, font and tmux",
+			sys:  &system.SystemInfo{OS: system.OSArch, HasBrew: true},
+			setup: func(m *Model) {
+				m.Choices = UserChoices{OS: "linux", Terminal: "alacritty", InstallFont: true, Shell: "fish", WindowMgr: "tmux", InstallNvim: true}
+			},
+			expect: []planned{
+				{"clone", "Clone Repository", "Downloading Javi.Dots", false},
+				{"deps", "Install Dependencies", "Base packages", true},
+				{"terminal", "Install alacritty", "Terminal emulator", true},
+				{"font", "Install Iosevka Nerd Font", "Nerd font with icons", false},
+				{"shell", "Install fish", "Shell and plugins", false},
+				{"wm", "Install tmux", "Terminal multiplexer", false},
+				{"nvim", "Install Neovim", "Editor with config", false},
+				{"setshell", "Set Default Shell", "Configure default shell", true},
+				{"cleanup", "Cleanup", "Removing temporary files", false},
+			},
+		},
+		{
+			name: "fresh mac with backup and AI",
+			sys:  &system.SystemInfo{OS: system.OSMac},
+			setup: func(m *Model) {
+				m.ExistingConfigs = []string{"nvim"}
+				m.Choices = UserChoices{OS: "mac", Terminal: "ghostty", Shell: "zsh", WindowMgr: "zellij", InstallZed: true,
+					CreateBackup: true, AITools: []string{"claude", "opencode"}, InstallAIFramework: true}
+			},
+			expect: []planned{
+				{"backup", "Backup Existing Configs", "Creating backup of your current configuration", false},
+				{"clone", "Clone Repository", "Downloading Javi.Dots", false},
+				{"homebrew", "Install Homebrew", "Package manager", true},
+				{"xcode", "Install Xcode CLI", "Developer tools", false},
+				{"terminal", "Install ghostty", "Terminal emulator", false},
+				{"shell", "Install zsh", "Shell and plugins", false},
+				{"wm", "Install zellij", "Terminal multiplexer", false},
+				{"zed", "Install Zed", "Editor with Vim mode", false},
+				{"aitools", "Install AI Tools", "claude + opencode", false},
+				{"aiframework", "Install AI Framework", "Preset: custom", false},
+				{"setshell", "Set Default Shell", "Configure default shell", true},
+				{"cleanup", "Cleanup", "Removing temporary files", false},
+			},
+		},
+		{
+			name: "termux without terminal or wm",
+			sys:  &system.SystemInfo{OS: system.OSTermux, IsTermux: true},
+			setup: func(m *Model) {
+				m.Choices = UserChoices{OS: "termux", Terminal: "none", InstallFont: true, Shell: "nushell", WindowMgr: "none"}
+			},
+			expect: []planned{
+				{"clone", "Clone Repository", "Downloading Javi.Dots", false},
+				{"deps", "Install Dependencies", "Base packages (pkg)", false},
+				{"font", "Install Iosevka Nerd Font", "Nerd font with icons", false},
+				{"shell", "Install nushell", "Shell and plugins", false},
+				{"setshell", "Set Default Shell", "Configure default shell", true},
+				{"cleanup", "Cleanup", "Removing temporary files", false},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewModel()
+			m.SystemInfo = tt.sys
+			tt.setup(&m)
+			m.SetupInstallSteps()
+
+			if len(m.Steps) != len(tt.expect) {
+				ids := make([]string, len(m.Steps))
+				for i, s := range m.Steps {
+					ids[i] = s.ID
+				}
+				t.Fatalf("expected %d steps, got %d: %v", len(tt.expect), len(m.Steps), ids)
+			}
+			for i, want := range tt.expect {
+				got := m.Steps[i]
+				if got.ID != want.id || got.Name != want.name || got.Description != want.desc || got.Interactive != want.interactive {
+					t.Errorf("step %d: got {%s %q %q %v}, want {%s %q %q %v}",
+						i, got.ID, got.Name, got.Description, got.Interactive,
+						want.id, want.name, want.desc, want.interactive)
+				}
+				if got.Status != StatusPending {
+					t.Errorf("step %s should start pending", got.ID)
+				}
+			}
+		})
+	}
+}
+
+func TestStepPlatformFallback(t *testing.T) {
+	spec, _ := lookupStepSpec("font")
+	tests := []struct {
+		name string
+		sys  *system.SystemInfo
+		os   string
+		want string // first command of the chosen entry
+	}{
+		{"debian falls back to linux", &system.SystemInfo{OS: system.OSDebian}, "linux", "mkdir -p {home}/.local/share/fonts"},
+		{"mac", &system.SystemInfo{OS: system.OSMac}, "mac", "install --cask font-iosevka-term-nerd-font"},
+		{"termux choice wins", &system.SystemInfo{OS: system.OSLinux}, "termux", "mkdir -p {home}/.termux"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewModel()
+			m.SystemInfo = tt.sys
+			m.Choices.OS = tt.os
+			cmds, ok := spec.installCommands(&m)
+			if !ok || len(cmds) == 0 || cmds[0].Run != tt.want {
+				t.Errorf("expected first command %q, got %+v", tt.want, cmds)
+			}
+		})
+	}
+}
+
+// TestStepVariantCommands pins the commands the terminal and wm steps ran
+// before they were declared
+func TestStepVariantCommands(t *testing.T) {
+	alacrittySource := []string{
+		"sudo apt-get install -y cmake pkg-config libfreetype6-dev libfontconfig1-dev libxcb-xfixes0-dev libxkbcommon-dev python3 gzip scdoc git curl",
+		" command -v cargo >/dev/null || [ -x {home}/.cargo/bin/cargo ] || curl --proto '=https' --tlsv1.2 -sSf https://sh.rustup.rs | sh -s -- -y",
+		" git clone https://github.com/alacritty/alacritty.git {scratch}",
+		" PATH=$PATH:{home}/.cargo/bin cargo build --release --manifest-path {scratch}/Cargo.toml",
+		"sudo cp {scratch}/target/release/alacritty /usr/local/bin/alacritty",
+		"sudo cp {scratch}/extra/linux/Alacritty.desktop /usr/share/applications/",
+	}
+	tests := []struct {
+		name    string
+		step    string
+		sys     *system.SystemInfo
+		choices UserChoices
+		want    []string // Runner and Run of each command
+	}{
+		{"alacritty on mac", "terminal", &system.SystemInfo{OS: system.OSMac}, UserChoices{Terminal: "alacritty"},
+			[]string{"brew install --cask alacritty"}},
+		{"alacritty from source on apt", "terminal", &system.SystemInfo{OS: system.OSLinux, PackageManager: "apt"}, UserChoices{Terminal: "alacritty"},
+			alacrittySource},
+		{"alacritty from source on debian", "terminal", &system.SystemInfo{OS: system.OSDebian, PackageManager: "apt"}, UserChoices{Terminal: "alacritty"},
+			alacrittySource},
+		{"alacritty from source on other distros", "terminal", &system.SystemInfo{OS: system.OSLinux, PackageManager: "zypper"}, UserChoices{Terminal: "alacritty"},
+			alacrittySource},
+		{"alacritty on arch", "terminal", &system.SystemInfo{OS: system.OSArch, PackageManager: "pacman"}, UserChoices{Terminal: "alacritty"},
+			[]string{"native alacritty"}},
+		{"alacritty on fedora", "terminal", &system.SystemInfo{OS: system.OSFedora, PackageManager: "dnf"}, UserChoices{Terminal: "alacritty"},
+			[]string{"native alacritty"}},
+		{"wezterm on fedora", "terminal", &system.SystemInfo{OS: system.OSFedora, PackageManager: "dnf"}, UserChoices{Terminal: "wezterm"},
+			[]string{"sudo dnf copr enable -y wezfurlong/wezterm-nightly", "native wezterm"}},
+		{"wezterm on debian", "terminal", &system.SystemInfo{OS: system.OSDebian, PackageManager: "apt"}, UserChoices{Terminal: "wezterm"},
+			[]string{"brew tap wez/wezterm-linuxbrew", "brew install wezterm"}},
+		{"kitty on linux", "terminal", &system.SystemInfo{OS: system.OSArch, PackageManager: "pacman"}, UserChoices{Terminal: "kitty"},
+			nil},
+		{"ghostty on debian", "terminal", &system.SystemInfo{OS: system.OSDebian, PackageManager: "apt"}, UserChoices{Terminal: "ghostty"},
+			[]string{` /bin/bash -c "$(curl -fsSL https://raw.githubusercontent.com/mkasberg/ghostty-ubuntu/HEAD/install.sh)"`}},
+		{"tmux with homebrew", "wm", &system.SystemInfo{OS: system.OSArch, PackageManager: "pacman"}, UserChoices{WindowMgr: "tmux"},
+			[]string{"brew install tmux"}},
+		{"tmux with native packages", "wm", &system.SystemInfo{OS: system.OSDebian, PackageManager: "apt"}, UserChoices{WindowMgr: "tmux", NativePackages: true},
+			[]string{"native tmux"}},
+		{"zellij on termux", "wm", &system.SystemInfo{OS: system.OSTermux, IsTermux: true}, UserChoices{OS: "termux", WindowMgr: "zellij"},
+			[]string{"pkg zellij"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewModel()
+			m.SystemInfo = tt.sys
+			m.Choices = tt.choices
+			spec, _ := lookupStepSpec(tt.step)
+			cmds, ok := spec.body(&m).installCommands(&m)
+			if !ok {
+				t.Fatal("expected commands for this platform")
+			}
+			var got []string
+			for _, c := range cmds {
+				got = append(got, c.Runner+" "+c.Run)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRunDeclarativeStepConfigs(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	repo := t.TempDir()
+	os.WriteFile(filepath.Join(repo, "lazygit.yml"), []byte("gui: {}"), 0644)
+	os.MkdirAll(filepath.Join(repo, "GentlemanLazygit", "themes"), 0755)
+	os.WriteFile(filepath.Join(repo, "GentlemanLazygit", "themes", "dark.yml"), []byte("dark"), 0644)
+
+	m := NewModel()
+	m.RepoDir = repo
+	spec := StepSpec{
+		ID:     "lazygit",
+		Name:   "Install lazygit",
+		Binary: "sh", // Always on PATH, so Install is skipped
+		Install: map[string][]StepCommand{
+			"linux": {{Run: "false"}},
+			"mac":   {{Run: "false"}},
+		},
+		Configs: []ConfigCopy{
+			{Src: "lazygit.yml", Dst: ".config/lazygit/config.yml"},
+			{Src: "GentlemanLazygit/themes", Dst: ".config/lazygit/themes"},
+		},
+	}
+
+	if err := runDeclarativeStep(spec, &m); err != nil {
+		t.Fatalf("runDeclarativeStep failed: %v", err)
+	}
+	for path, want := range map[string]string{
+		".config/lazygit/config.yml":      "gui: {}",
+		".config/lazygit/themes/dark.yml": "dark",
+	} {
+		got, err := os.ReadFile(filepath.Join(home, path))
+		if err != nil || string(got) != want {
+			t.Errorf("%s: expected %q, got %q (%v)", path, want, got, err)
+		}
+	}
+
+	t.Run("failing command surfaces a step error", func(t *testing.T) {
+		spec.Binary = ""
+		spec.Install = map[string][]StepCommand{
+			"linux": {{Run: "false", OnError: "boom"}},
+			"mac":   {{Run: "false", OnError: "boom"}},
+		}
+		err := runDeclarativeStep(spec, &m)
+		stepErr, ok := err.(*StepError)
+		if !ok || stepErr.StepID != "lazygit" || stepErr.Description != "boom" {
+			t.Errorf("expected StepError for lazygit with description boom, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,71 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+)
+
+// retryPolicy reruns work that is safe to repeat after a transient failure,
+// waiting Delay before the first retry and twice as long before each next one
+type retryPolicy struct {
+	Retries int                                  // Attempts after the first one
+	Delay   time.Duration                        // Wait before the first retry
+	Sleep   func(context.Context, time.Duration) // Waits between attempts unless cancelled; tests pass a no-op
+}
+
+// stepRetryPolicy retries network-bound work three times, after 2s, 4s and 8s
+var stepRetryPolicy = retryPolicy{Retries: 3, Delay: 2 * time.Second, Sleep: sleepContext}
+
+// sleepContext waits d or until ctx is done, whichever comes first
+func sleepContext(ctx context.Context, d time.Duration) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+}
+
+// run calls fn until it succeeds or the retries run out, logging every failed
+// attempt to stepID, and returns the last error. A clone of a ref that
+// doesn't exist fails the same way each time, so it isn't retried, and
+// nothing is retried once ctx is cancelled.
+func (p retryPolicy) run(ctx context.Context, stepID string, fn func() error) error {
+	attempts := p.Retries + 1
+	delay := p.Delay
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil {
+			if attempt > 1 {
+				SendLog(stepID, fmt.Sprintf("✓ Succeeded on attempt %d of %d", attempt, attempts))
+			}
+			return nil
+		}
+		if attempt == attempts || errors.Is(err, system.ErrUnknownRef) || ctx.Err() != nil {
+			return err
+		}
+		SendLog(stepID, fmt.Sprintf("⚠️ Attempt %d of %d failed (%s), retrying in %s...", attempt, attempts, retryReason(err), delay))
+		if p.Sleep != nil {
+			p.Sleep(ctx, delay)
+		}
+		if ctx.Err() != nil {
+			return err
+		}
+		delay *= 2
+	}
+}
+
+// retryReason is the one-line cause of a failed attempt
+func retryReason(err error) string {
+	var stepErr *StepError
+	if errors.As(err, &stepErr) {
+		return stepErr.Description
+	}
+	reason, _, _ := strings.Cut(err.Error(), "\n")
+	return reason
+}
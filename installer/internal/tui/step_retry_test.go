@@ -0,0 +1,119 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	errFlaky := errors.New("could not resolve host")
+	tests := []struct {
+		name     string
+		failures int   // Attempts that fail before one succeeds
+		err      error // What the failing attempts return
+		attempts int
+		waits    []time.Duration
+		wantErr  bool
+	}{
+		{"succeeds at once", 0, errFlaky, 1, nil, false},
+		{"succeeds on a retry", 2, errFlaky, 3, []time.Duration{2 * time.Second, 4 * time.Second}, false},
+		{"gives up after three retries", 10, errFlaky, 4, []time.Duration{2 * time.Second, 4 * time.Second, 8 * time.Second}, true},
+		{"unknown ref is not retried", 10, fmt.Errorf("clone: %w", system.ErrUnknownRef), 1, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var waits []time.Duration
+			policy := stepRetryPolicy
+			policy.Sleep = func(_ context.Context, d time.Duration) { waits = append(waits, d) }
+
+			attempts := 0
+			err := policy.run(context.Background(), "clone", func() error {
+				attempts++
+				if attempts <= tt.failures {
+					return tt.err
+				}
+				return nil
+			})
+			if attempts != tt.attempts {
+				t.Errorf("ran %d attempts, want %d", attempts, tt.attempts)
+			}
+			if !reflect.DeepEqual(waits, tt.waits) {
+				t.Errorf("waited %v, want %v", waits, tt.waits)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("err = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyStopsWhenCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	policy := stepRetryPolicy
+	policy.Sleep = func(context.Context, time.Duration) { cancel() }
+
+	attempts := 0
+	err := policy.run(ctx, "clone", func() error {
+		attempts++
+		return errors.New("could not resolve host")
+	})
+	if attempts != 1 || err == nil {
+		t.Errorf("a cancel during the wait should end the retries, got %d attempts and %v", attempts, err)
+	}
+}
+
+// useTestRegistry swaps the install plan and makes retries instant
+func useTestRegistry(t *testing.T, specs ...StepSpec) {
+	t.Helper()
+	savedRegistry, savedPolicy := installStepRegistry, stepRetryPolicy
+	installStepRegistry = specs
+	stepRetryPolicy = retryPolicy{Retries: 3}
+	t.Cleanup(func() { installStepRegistry, stepRetryPolicy = savedRegistry, savedPolicy })
+}
+
+func TestRetryableStepsReportOnlyTheLastFailure(t *testing.T) {
+	attempts := map[string]int{}
+	failing := func(id string, failures int) func(m *Model) error {
+		return func(m *Model) error {
+			attempts[id]++
+			if attempts[id] <= failures {
+				return wrapStepError(id, id, "Download failed", errors.New("timeout"))
+			}
+			return nil
+		}
+	}
+	useTestRegistry(t,
+		StepSpec{ID: "flaky", Name: "Flaky", Retryable: true, Run: failing("flaky", 2)},
+		StepSpec{ID: "down", Name: "Down", Retryable: true, Run: failing("down", 10)},
+		StepSpec{ID: "pkgs", Name: "Packages", Run: failing("pkgs", 1)},
+	)
+	m := NewModel()
+
+	msg := m.stepCmd("flaky", false)().(stepCompleteMsg)
+	if msg.err != nil || attempts["flaky"] != 3 {
+		t.Errorf("a step that recovers should complete, got %v after %d attempts", msg.err, attempts["flaky"])
+	}
+	msg = m.stepCmd("down", false)().(stepCompleteMsg)
+	if msg.err == nil || attempts["down"] != 4 {
+		t.Errorf("the error should come after the retries run out, got %v after %d attempts", msg.err, attempts["down"])
+	}
+	msg = m.stepCmd("pkgs", false)().(stepCompleteMsg)
+	if msg.err == nil || attempts["pkgs"] != 1 {
+		t.Errorf("a step not marked retryable should fail at once, got %d attempts", attempts["pkgs"])
+	}
+}
+
+func TestRetryableStepsAreNotInteractive(t *testing.T) {
+	for _, spec := range installStepRegistry {
+		// A retry would ask for the password again
+		if spec.Retryable && (spec.Interactive || spec.InteractiveWhen != nil) {
+			t.Errorf("step %q is retryable but can need terminal input", spec.ID)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package tui
+
+import "slices"
+
+// installWorkers bounds how many non-interactive steps run at once
+const installWorkers = 3
+
+// lockPackages is held by steps that run the package manager. Homebrew, apt
+// and pacman take a lock of their own, so two such steps never overlap.
+const lockPackages = "packages"
+
+// stepScheduler decides which install steps may start. It only reads step
+// statuses, so it can be tested without running anything; Update starts the
+// steps it picks (see startReadySteps).
+//
+// A pending step is ready once every step it depends on is done or skipped;
+// dependencies outside the plan count as met. Ready steps start in plan order,
+// at most Workers at a time and never two holding the same lock. An
+// interactive step owns the terminal, so it starts only when nothing else is
+// running and nothing starts beside it. A failed step is never done, so the
+// steps depending on it wait for a retry or skip; unrelated ones go on.
+type stepScheduler struct {
+	Workers int
+}
+
+// next returns the indexes of the steps to start now, in plan order
+func (s stepScheduler) next(steps []InstallStep) []int {
+	running := 0
+	held := map[string]bool{}
+	for _, step := range steps {
+		if step.Status != StatusRunning {
+			continue
+		}
+		if step.Interactive {
+			return nil
+		}
+		running++
+		for _, lock := range step.Locks {
+			held[lock] = true
+		}
+	}
+
+	var start []int
+	for i, step := range steps {
+		if step.Status != StatusPending || !dependenciesMet(steps, step) {
+			continue
+		}
+		if step.Interactive {
+			// Let the running steps drain first, then run it alone
+			if running == 0 && len(start) == 0 {
+				return []int{i}
+			}
+			break
+		}
+		if running+len(start) >= s.Workers {
+			break
+		}
+		if slices.ContainsFunc(step.Locks, func(lock string) bool { return held[lock] }) {
+			continue
+		}
+		for _, lock := range step.Locks {
+			held[lock] = true
+		}
+		start = append(start, i)
+	}
+	return start
+}
+
+// dependenciesMet reports whether every step step depends on is done or skipped
+func dependenciesMet(steps []InstallStep, step InstallStep) bool {
+	for _, dep := range step.DependsOn {
+		for _, other := range steps {
+			if other.ID == dep && !stepFinished(other.Status) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// stepsRunning counts the steps that are running
+func stepsRunning(steps []InstallStep) int {
+	n := 0
+	for _, step := range steps {
+		if step.Status == StatusRunning {
+			n++
+		}
+	}
+	return n
+}
+
+// blockedBy returns the names of the pending steps that depend on the step
+// with the given ID, directly or through other steps, in plan order
+func blockedBy(steps []InstallStep, id string) []string {
+	blocked := map[string]bool{id: true}
+	// Plans list dependencies first, so one pass in order finds them all
+	var names []string
+	for _, step := range steps {
+		if step.Status != StatusPending {
+			continue
+		}
+		if slices.ContainsFunc(step.DependsOn, func(dep string) bool { return blocked[dep] }) {
+			blocked[step.ID] = true
+			names = append(names, step.Name)
+		}
+	}
+	return names
+}
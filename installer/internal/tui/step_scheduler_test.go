@@ -0,0 +1,266 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+)
+
+// plan builds steps from "id:status" entries
+func plan(entries ...string) []InstallStep {
+	statuses := map[string]StepStatus{
+		"pending": StatusPending, "running": StatusRunning, "done": StatusDone,
+		"failed": StatusFailed, "skipped": StatusSkipped,
+	}
+	var steps []InstallStep
+	for _, e := range entries {
+		id, status, _ := strings.Cut(e, ":")
+		steps = append(steps, InstallStep{ID: id, Name: id, Status: statuses[status]})
+	}
+	return steps
+}
+
+func TestStepSchedulerNext(t *testing.T) {
+	tests := []struct {
+		name  string
+		steps []InstallStep
+		setup func(steps []InstallStep)
+		want  []int
+	}{
+		{
+			name:  "independent steps start together up to the worker limit",
+			steps: plan("a:pending", "b:pending", "c:pending", "d:pending"),
+			want:  []int{0, 1, 2},
+		},
+		{
+			name:  "running steps use up workers",
+			steps: plan("a:running", "b:running", "c:pending", "d:pending"),
+			want:  []int{2},
+		},
+		{
+			name:  "a step waits for its dependencies",
+			steps: plan("clone:running", "shell:pending", "font:pending"),
+			setup: func(s []InstallStep) { s[1].DependsOn = []string{"clone"} },
+			want:  []int{2},
+		},
+		{
+			name:  "done, skipped and unplanned dependencies are met",
+			steps: plan("clone:done", "backup:skipped", "shell:pending"),
+			setup: func(s []InstallStep) { s[2].DependsOn = []string{"clone", "backup", "homebrew"} },
+			want:  []int{2},
+		},
+		{
+			name:  "steps sharing a lock take turns",
+			steps: plan("terminal:pending", "font:pending", "shell:pending"),
+			setup: func(s []InstallStep) {
+				s[0].Locks = []string{lockPackages}
+				s[2].Locks = []string{lockPackages}
+			},
+			want: []int{0, 1},
+		},
+		{
+			name:  "a held lock blocks the step but not the ones after it",
+			steps: plan("nvim:running", "shell:pending", "font:pending"),
+			setup: func(s []InstallStep) {
+				s[0].Locks = []string{lockPackages}
+				s[1].Locks = []string{lockPackages}
+			},
+			want: []int{2},
+		},
+		{
+			name:  "an interactive step waits for the running ones",
+			steps: plan("clone:running", "homebrew:pending", "font:pending"),
+			setup: func(s []InstallStep) { s[1].Interactive = true },
+			want:  nil,
+		},
+		{
+			name:  "an interactive step runs alone",
+			steps: plan("clone:done", "homebrew:pending", "font:pending"),
+			setup: func(s []InstallStep) { s[1].Interactive = true },
+			want:  []int{1},
+		},
+		{
+			name:  "nothing starts beside a running interactive step",
+			steps: plan("homebrew:running", "font:pending"),
+			setup: func(s []InstallStep) { s[0].Interactive = true },
+			want:  nil,
+		},
+		{
+			name:  "steps before a ready interactive one still start",
+			steps: plan("clone:pending", "setshell:pending"),
+			setup: func(s []InstallStep) { s[1].Interactive = true },
+			want:  []int{0},
+		},
+		{
+			name:  "unrelated steps still start after a failure",
+			steps: plan("font:failed", "clone:running", "skills:pending"),
+			want:  []int{2},
+		},
+		{
+			name:  "dependents of a failed step wait",
+			steps: plan("clone:failed", "font:running", "shell:pending"),
+			setup: func(s []InstallStep) { s[2].DependsOn = []string{"clone"} },
+			want:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(tt.steps)
+			}
+			got := stepScheduler{Workers: 3}.next(tt.steps)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("next = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBlockedBy(t *testing.T) {
+	steps := plan("clone:failed", "shell:pending", "setshell:pending", "font:pending", "cleanup:pending")
+	steps[1].DependsOn = []string{"clone"}
+	steps[2].DependsOn = []string{"shell"}
+	steps[4].DependsOn = []string{"font", "setshell"}
+
+	got := blockedBy(steps, "clone")
+	if want := []string{"shell", "setshell", "cleanup"}; !slices.Equal(got, want) {
+		t.Errorf("blockedBy = %v, want %v", got, want)
+	}
+}
+
+// TestInstallPlansRespectDependencies checks every plan lists a step after
+// the steps it depends on, so resumes and non-interactive runs, which go in
+// plan order, never run a step early
+func TestInstallPlansRespectDependencies(t *testing.T) {
+	ids := map[string]bool{}
+	for _, spec := range installStepRegistry {
+		ids[spec.ID] = true
+	}
+	for _, spec := range installStepRegistry {
+		for _, dep := range spec.DependsOn {
+			if !ids[dep] {
+				t.Errorf("step %q depends on unknown step %q", spec.ID, dep)
+			}
+		}
+	}
+
+	for name, choices := range map[string]UserChoices{
+		"full linux": {OS: "linux", Terminal: "kitty", InstallFont: true, Shell: "zsh", WindowMgr: "tmux", InstallNvim: true,
+			InstallZed: true, CreateBackup: true, AITools: []string{"claude"}, InstallAIFramework: true},
+		"native packages": {OS: "linux", Shell: "fish", InstallNvim: true, NativePackages: true},
+		"mac":             {OS: "mac", Terminal: "ghostty", InstallFont: true, Shell: "fish", InstallNvim: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			m := newFlowModel(t)
+			m.Choices = choices
+			m.ExistingConfigs = []string{"nvim"}
+			m.SetupInstallSteps()
+
+			seen := map[string]bool{}
+			for _, step := range m.Steps {
+				for _, dep := range step.DependsOn {
+					if !seen[dep] && slices.ContainsFunc(m.Steps, func(s InstallStep) bool { return s.ID == dep }) {
+						t.Errorf("step %q is planned before its dependency %q", step.ID, dep)
+					}
+				}
+				seen[step.ID] = true
+			}
+
+			// Driving the scheduler to the end runs every step exactly once
+			var order []string
+			for range m.Steps {
+				ready := stepScheduler{Workers: installWorkers}.next(m.Steps)
+				if len(ready) == 0 {
+					break
+				}
+				for _, i := range ready {
+					m.Steps[i].Status = StatusDone
+					order = append(order, m.Steps[i].ID)
+				}
+			}
+			if len(order) != len(m.Steps) {
+				t.Errorf("the scheduler stalled after %v", order)
+			}
+		})
+	}
+}
+
+func TestFontStepLocksPackagesOnlyWithBrew(t *testing.T) {
+	spec, _ := lookupStepSpec("font")
+	m := newFlowModel(t)
+	m.Choices = UserChoices{OS: "mac", InstallFont: true}
+	if got := spec.planStep(&m).Locks; !slices.Contains(got, lockPackages) {
+		t.Errorf("the Homebrew font install should hold the package lock, got %v", got)
+	}
+	m.SystemInfo.OS = system.OSDebian
+	m.Choices.OS = "linux"
+	if got := spec.planStep(&m).Locks; len(got) != 0 {
+		t.Errorf("the font download should run beside package installs, got %v", got)
+	}
+}
+
+func TestParallelStepsAndFailure(t *testing.T) {
+	m := newFlowModel(t)
+	m.Screen = ScreenInstalling
+	m.RepoDir = t.TempDir()
+	m.Steps = []InstallStep{
+		{ID: "clone", Name: "Clone Repository", Status: StatusPending},
+		{ID: "font", Name: "Install Font", Status: StatusPending},
+		{ID: "shell", Name: "Install fish", Status: StatusPending, DependsOn: []string{"clone"}},
+		{ID: "terminal", Name: "Install Terminal", Status: StatusPending, DependsOn: []string{"font"}},
+	}
+
+	result, cmd := m.Update(installStartMsg{})
+	m = result.(Model)
+	if cmd == nil || m.Steps[0].Status != StatusRunning || m.Steps[1].Status != StatusRunning {
+		t.Fatalf("clone and font should start together, got %v / %v", m.Steps[0].Status, m.Steps[1].Status)
+	}
+	if m.Steps[2].Status != StatusPending {
+		t.Fatal("shell must wait for the clone")
+	}
+
+	// The clone fails while the font is still running: wait for it
+	result, _ = m.Update(stepCompleteMsg{stepID: "clone", err: errors.New("no network")})
+	m = result.(Model)
+	if m.Screen != ScreenInstalling || m.Steps[2].Status != StatusPending {
+		t.Fatalf("the running font step should finish first, got screen %v and shell %v", m.Screen, m.Steps[2].Status)
+	}
+	if !strings.Contains(strings.Join(m.LogLines.Tail(5), "\n"), "Not starting Install fish") {
+		t.Errorf("the log should say which steps wait on the failure: %v", m.LogLines.Tail(5))
+	}
+
+	// The terminal doesn't need the clone, so it still runs
+	result, _ = m.Update(stepCompleteMsg{stepID: "font"})
+	m = result.(Model)
+	if m.Screen != ScreenInstalling || m.Steps[3].Status != StatusRunning || m.Steps[2].Status != StatusPending {
+		t.Fatalf("the terminal should start after the font, got screen %v and terminal %v", m.Screen, m.Steps[3].Status)
+	}
+
+	result, _ = m.Update(stepCompleteMsg{stepID: "terminal"})
+	m = result.(Model)
+	if m.Screen != ScreenError || m.Steps[1].Status != StatusDone || m.Steps[2].Status != StatusPending {
+		t.Fatalf("expected the error screen with the font done, got %v", m.Screen)
+	}
+	if !strings.Contains(m.ErrorMsg, "Clone Repository") {
+		t.Errorf("the error should name the failed step: %q", m.ErrorMsg)
+	}
+}
+
+func TestParallelLogLinesNameTheirStep(t *testing.T) {
+	m := newFlowModel(t)
+	m.Steps = plan("clone:running", "font:pending")
+	result, _ := m.Update(stepProgressMsg{stepID: "clone", log: "Cloning..."})
+	m = result.(Model)
+	m.Steps[1].Status = StatusRunning
+	result, _ = m.Update(stepProgressMsg{stepID: "font", log: "Downloading..."})
+	m = result.(Model)
+
+	got := m.LogLines.Tail(2)
+	if want := []string{"Cloning...", "[font] Downloading..."}; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("log lines = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,122 @@
+package tui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// fakeClock replaces installClock with a clock that only moves when told to
+func fakeClock(t *testing.T) *time.Time {
+	t.Helper()
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	saved := installClock
+	installClock = func() time.Time { return now }
+	t.Cleanup(func() { installClock = saved })
+	return &now
+}
+
+func TestStepElapsedAndTotalTime(t *testing.T) {
+	now := fakeClock(t)
+
+	m := NewModel()
+	m.Screen = ScreenInstalling
+	m.Steps = []InstallStep{
+		{ID: "step1", Name: "First", Status: StatusPending},
+		{ID: "step2", Name: "Second", Status: StatusPending, DependsOn: []string{"step1"}},
+		{ID: "step3", Name: "Third", Status: StatusPending, DependsOn: []string{"step2"}},
+	}
+
+	result, _ := m.Update(installStartMsg{})
+	m = result.(Model)
+	if !m.InstallStarted.Equal(*now) {
+		t.Fatalf("install start not recorded: %v", m.InstallStarted)
+	}
+
+	durations := []time.Duration{3 * time.Second, 90 * time.Second, 500 * time.Millisecond}
+	var cmd tea.Cmd
+	for i, d := range durations {
+		if m.Steps[i].Status != StatusRunning || !m.Steps[i].StartedAt.Equal(*now) {
+			t.Fatalf("step %d should have started at %v, got %v", i, *now, m.Steps[i].StartedAt)
+		}
+		*now = now.Add(d)
+		result, cmd = m.Update(stepCompleteMsg{stepID: m.Steps[i].ID})
+		m = result.(Model)
+	}
+
+	var sum time.Duration
+	var last time.Time
+	for i, step := range m.Steps {
+		if step.Elapsed != durations[i] {
+			t.Errorf("step %d: elapsed %v, want %v", i, step.Elapsed, durations[i])
+		}
+		if step.StartedAt.Before(last) {
+			t.Errorf("step %d started before the previous one", i)
+		}
+		last = step.StartedAt
+		sum += step.Elapsed
+	}
+
+	done, ok := cmd().(installCompleteMsg)
+	if !ok {
+		t.Fatal("the last step should finish the install")
+	}
+	result, _ = m.Update(done)
+	m = result.(Model)
+	if got := time.Duration(m.TotalTime * float64(time.Second)); got != sum {
+		t.Errorf("total time %v, want the sum of the steps %v", got, sum)
+	}
+	if !m.InstallStarted.IsZero() {
+		t.Error("the start timestamp should be cleared once the install completes")
+	}
+	if view := m.renderComplete(); !strings.Contains(view, "Total time: 1m 34s") {
+		t.Errorf("completion screen should show the total, got:\n%s", view)
+	}
+}
+
+func TestFailedStepKeepsElapsedAndStart(t *testing.T) {
+	now := fakeClock(t)
+
+	m := NewModel()
+	m.Screen = ScreenInstalling
+	m.Steps = []InstallStep{{ID: "step1", Name: "First", Status: StatusPending}}
+	result, _ := m.Update(installStartMsg{})
+	m = result.(Model)
+	started := m.InstallStarted
+
+	*now = now.Add(2 * time.Second)
+	result, _ = m.Update(stepCompleteMsg{stepID: "step1", err: errors.New("boom")})
+	m = result.(Model)
+	if m.Steps[0].Elapsed != 2*time.Second {
+		t.Errorf("a failed step should record its elapsed time, got %v", m.Steps[0].Elapsed)
+	}
+
+	// Retrying fires installStartMsg again; the total still counts from the first start
+	*now = now.Add(time.Second)
+	result, _ = m.Update(installStartMsg{})
+	if got := result.(Model).InstallStarted; !got.Equal(started) {
+		t.Errorf("a retry should keep the original start, got %v", got)
+	}
+}
+
+func TestFormatElapsed(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{400 * time.Millisecond, "0.4s"},
+		{12300 * time.Millisecond, "12.3s"},
+		{125 * time.Second, "2m 05s"},
+		{time.Hour + 30*time.Second, "60m 30s"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := formatElapsed(tt.d); got != tt.want {
+				t.Errorf("formatElapsed(%v) = %q, want %q", tt.d, got, tt.want)
+			}
+		})
+	}
+}
@@ -1,169 +1,253 @@
 package tui
 
-import "github.com/charmbracelet/lipgloss"
+import (
+	"os"
+	"slices"
 
-var (
-	// Colors - Gentleman Theme (from opencode theme)
-	// Base colors
-	Background        = lipgloss.Color("#06080f")
-	BackgroundPanel   = lipgloss.Color("#06080f")
-	BackgroundElement = lipgloss.Color("#06080f")
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Palette holds the colors a theme renders with
+type Palette struct {
+	Background lipgloss.TerminalColor // Text drawn on a colored cursor or selection
 
 	// Text colors
-	Text      = lipgloss.Color("#F3F6F9")
-	TextMuted = lipgloss.Color("#5C6170")
+	Text      lipgloss.TerminalColor
+	TextMuted lipgloss.TerminalColor
 
 	// Accent colors
-	Primary   = lipgloss.Color("#7FB4CA") // Blue-ish
-	Secondary = lipgloss.Color("#A3B5D6") // Light blue
-	Accent    = lipgloss.Color("#E0C15A") // Gold/Yellow
+	Primary   lipgloss.TerminalColor
+	Secondary lipgloss.TerminalColor
+	Accent    lipgloss.TerminalColor
 
 	// Status colors
-	Error   = lipgloss.Color("#CB7C94") // Pink-red
-	Warning = lipgloss.Color("#DEBA87") // Orange-tan
-	Success = lipgloss.Color("#B7CC85") // Green
-	Info    = lipgloss.Color("#7FB4CA") // Blue
+	Error   lipgloss.TerminalColor
+	Warning lipgloss.TerminalColor
+	Success lipgloss.TerminalColor
+	Info    lipgloss.TerminalColor
 
 	// Border colors
-	Border       = lipgloss.Color("#313342")
-	BorderActive = lipgloss.Color("#7FB4CA")
-	BorderSubtle = lipgloss.Color("#232A40")
-
-	// Syntax colors (for code display)
-	SyntaxComment     = lipgloss.Color("#8394A3")
-	SyntaxKeyword     = lipgloss.Color("#C99AD6") // Purple
-	SyntaxFunction    = lipgloss.Color("#B99BF2") // Light purple
-	SyntaxVariable    = lipgloss.Color("#F3F6F9")
-	SyntaxString      = lipgloss.Color("#DFBD76") // Gold
-	SyntaxNumber      = lipgloss.Color("#A4DAA7") // Light green
-	SyntaxType        = lipgloss.Color("#8FB8DD") // Light blue
-	SyntaxOperator    = lipgloss.Color("#DEBA87")
-	SyntaxPunctuation = lipgloss.Color("#96A2B0")
-
-	// Markdown colors
-	MarkdownHeading = lipgloss.Color("#B5B2D0") // Lavender
+	Border       lipgloss.TerminalColor
+	BorderActive lipgloss.TerminalColor
+
+	// Syntax colors (keys and code)
+	Keyword lipgloss.TerminalColor
+	String  lipgloss.TerminalColor
+
+	// Vim Trainer visual selection
+	Selection lipgloss.TerminalColor
+}
+
+// Theme is a palette and the styles built from it. Views render with
+// m.Theme, so switching it restyles every screen on the next frame.
+type Theme struct {
+	Name    string
+	Palette Palette
 
 	// Text styles
-	TitleStyle = lipgloss.NewStyle().
-			Foreground(Primary).
-			Bold(true).
-			MarginBottom(1)
+	Title    lipgloss.Style
+	Subtitle lipgloss.Style
+	Success  lipgloss.Style
+	Error    lipgloss.Style
+	Warning  lipgloss.Style
+	Info     lipgloss.Style
+	Muted    lipgloss.Style
 
-	SubtitleStyle = lipgloss.NewStyle().
-			Foreground(Secondary).
-			Italic(true)
+	// Selection styles
+	Selected   lipgloss.Style
+	Unselected lipgloss.Style
 
-	SuccessStyle = lipgloss.NewStyle().
-			Foreground(Success).
-			Bold(true)
+	Box  lipgloss.Style
+	Logo lipgloss.Style
 
-	ErrorStyle = lipgloss.NewStyle().
-			Foreground(Error).
-			Bold(true)
+	// Step indicator
+	StepActive  lipgloss.Style
+	StepDone    lipgloss.Style
+	StepPending lipgloss.Style
+
+	Help       lipgloss.Style
+	Key        lipgloss.Style // Keymaps
+	FooterKey  lipgloss.Style // Footer key hints
+	FooterText lipgloss.Style
+	Code       lipgloss.Style
+
+	// Backup screens
+	BackupItem lipgloss.Style
+	Danger     lipgloss.Style
+	Highlight  lipgloss.Style
+
+	// Vim Trainer cursors, visual selection and code already passed
+	StartCursor   lipgloss.Style
+	CurrentCursor lipgloss.Style
+	Selection     lipgloss.Style
+	DimmedCode    lipgloss.Style
+
+	// Cursor of the text inputs (inverted bg/fg)
+	Cursor lipgloss.Style
+}
 
-	WarningStyle = lipgloss.NewStyle().
-			Foreground(Warning)
+// defaultTheme is the theme used until the settings pick another one
+const defaultTheme = "gentleman-dark"
 
-	InfoStyle = lipgloss.NewStyle().
-			Foreground(Info)
+// monoTheme has no colors; NO_COLOR forces it
+const monoTheme = "mono"
 
-	MutedStyle = lipgloss.NewStyle().
-			Foreground(TextMuted)
+// namedPalette is a palette as offered on the Settings screen
+type namedPalette struct {
+	Name    string
+	Palette Palette
+}
 
-	// Selection styles
-	SelectedStyle = lipgloss.NewStyle().
-			Foreground(Accent).
-			Bold(true).
-			PaddingLeft(2)
-
-	UnselectedStyle = lipgloss.NewStyle().
-			Foreground(Text).
-			PaddingLeft(4)
-
-	// Box styles
-	BoxStyle = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(BorderActive).
-			Padding(1, 2)
-
-	// Progress bar styles
-	ProgressBarFilled = lipgloss.NewStyle().
-				Foreground(Success).
-				Background(Success)
-
-	ProgressBarEmpty = lipgloss.NewStyle().
-				Foreground(Border).
-				Background(Border)
-
-	// Logo style
-	LogoStyle = lipgloss.NewStyle().
-			Foreground(Primary).
-			Bold(true)
+// themePalettes are the themes the Settings screen offers, in order
+var themePalettes = []namedPalette{
+	// Gentleman Theme (from opencode theme)
+	{defaultTheme, Palette{
+		Background:   lipgloss.Color("#06080f"),
+		Text:         lipgloss.Color("#F3F6F9"),
+		TextMuted:    lipgloss.Color("#5C6170"),
+		Primary:      lipgloss.Color("#7FB4CA"), // Blue-ish
+		Secondary:    lipgloss.Color("#A3B5D6"), // Light blue
+		Accent:       lipgloss.Color("#E0C15A"), // Gold/Yellow
+		Error:        lipgloss.Color("#CB7C94"), // Pink-red
+		Warning:      lipgloss.Color("#DEBA87"), // Orange-tan
+		Success:      lipgloss.Color("#B7CC85"), // Green
+		Info:         lipgloss.Color("#7FB4CA"), // Blue
+		Border:       lipgloss.Color("#313342"),
+		BorderActive: lipgloss.Color("#7FB4CA"),
+		Keyword:      lipgloss.Color("#C99AD6"), // Purple
+		String:       lipgloss.Color("#DFBD76"), // Gold
+		Selection:    lipgloss.Color("#7aa2f7"),
+	}},
+	// Dark text for terminals with a light background
+	{"light", Palette{
+		Background:   lipgloss.Color("#FFFFFF"),
+		Text:         lipgloss.Color("#1F2328"),
+		TextMuted:    lipgloss.Color("#6E7781"),
+		Primary:      lipgloss.Color("#0550AE"),
+		Secondary:    lipgloss.Color("#3E5C8A"),
+		Accent:       lipgloss.Color("#8A4B00"),
+		Error:        lipgloss.Color("#CF222E"),
+		Warning:      lipgloss.Color("#9A6700"),
+		Success:      lipgloss.Color("#1A7F37"),
+		Info:         lipgloss.Color("#0969DA"),
+		Border:       lipgloss.Color("#D0D7DE"),
+		BorderActive: lipgloss.Color("#0550AE"),
+		Keyword:      lipgloss.Color("#8250DF"),
+		String:       lipgloss.Color("#0A3069"),
+		Selection:    lipgloss.Color("#54AEFF"),
+	}},
+	// Saturated colors and white muted text for low vision or washed-out screens
+	{"high-contrast", Palette{
+		Background:   lipgloss.Color("#000000"),
+		Text:         lipgloss.Color("#FFFFFF"),
+		TextMuted:    lipgloss.Color("#D0D0D0"),
+		Primary:      lipgloss.Color("#00FFFF"),
+		Secondary:    lipgloss.Color("#87D7FF"),
+		Accent:       lipgloss.Color("#FFFF00"),
+		Error:        lipgloss.Color("#FF5F5F"),
+		Warning:      lipgloss.Color("#FFAF00"),
+		Success:      lipgloss.Color("#00FF00"),
+		Info:         lipgloss.Color("#00FFFF"),
+		Border:       lipgloss.Color("#FFFFFF"),
+		BorderActive: lipgloss.Color("#FFFF00"),
+		Keyword:      lipgloss.Color("#FF87FF"),
+		String:       lipgloss.Color("#FFFF00"),
+		Selection:    lipgloss.Color("#00FFFF"),
+	}},
+	// No colors at all; cursors and selections are drawn reversed
+	{monoTheme, Palette{
+		Background:   lipgloss.NoColor{},
+		Text:         lipgloss.NoColor{},
+		TextMuted:    lipgloss.NoColor{},
+		Primary:      lipgloss.NoColor{},
+		Secondary:    lipgloss.NoColor{},
+		Accent:       lipgloss.NoColor{},
+		Error:        lipgloss.NoColor{},
+		Warning:      lipgloss.NoColor{},
+		Success:      lipgloss.NoColor{},
+		Info:         lipgloss.NoColor{},
+		Border:       lipgloss.NoColor{},
+		BorderActive: lipgloss.NoColor{},
+		Keyword:      lipgloss.NoColor{},
+		String:       lipgloss.NoColor{},
+		Selection:    lipgloss.NoColor{},
+	}},
+}
 
-	// Step indicator
-	StepActiveStyle = lipgloss.NewStyle().
-			Foreground(Accent).
-			Bold(true)
-
-	StepDoneStyle = lipgloss.NewStyle().
-			Foreground(Success)
-
-	StepPendingStyle = lipgloss.NewStyle().
-				Foreground(TextMuted)
-
-	// Help style
-	HelpStyle = lipgloss.NewStyle().
-			Foreground(TextMuted).
-			Italic(true).
-			MarginTop(1)
-
-	// Keymaps style
-	KeyStyle = lipgloss.NewStyle().
-			Foreground(SyntaxKeyword).
-			Bold(true)
-
-	// Code style
-	CodeStyle = lipgloss.NewStyle().
-			Foreground(SyntaxString)
-
-	// Additional styles for backup screens
-	BackupItemStyle = lipgloss.NewStyle().
-			Foreground(Secondary)
-
-	DangerStyle = lipgloss.NewStyle().
-			Foreground(Error).
-			Bold(true)
-
-	HighlightStyle = lipgloss.NewStyle().
-			Foreground(Accent).
-			Bold(true)
-
-	// Vim Trainer cursor styles
-	StartCursorStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.Color("#06080f")).
-				Background(Warning).
-				Bold(true)
-
-	CurrentCursorStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.Color("#06080f")).
-				Background(Success).
-				Bold(true)
-
-	// Visual selection style (like Vim's visual mode)
-	SelectionStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#06080f")).
-			Background(lipgloss.Color("#7aa2f7")).
-			Bold(false)
-
-	// Dimmed code style for parts already passed
-	DimmedCodeStyle = lipgloss.NewStyle().
-			Foreground(TextMuted)
-
-	// Cursor style for project path input (inverted bg/fg)
-	CursorStyle = lipgloss.NewStyle().
-			Foreground(Background).
-			Background(Primary)
-)
+// themeNames lists the names of themePalettes
+func themeNames() []string {
+	names := make([]string, len(themePalettes))
+	for i, t := range themePalettes {
+		names[i] = t.Name
+	}
+	return names
+}
+
+// NewTheme builds the named theme, or the default one for an unknown name
+func NewTheme(name string) *Theme {
+	i := slices.IndexFunc(themePalettes, func(t namedPalette) bool { return t.Name == name })
+	if i < 0 {
+		i = 0
+	}
+	return newThemeFromPalette(themePalettes[i].Name, themePalettes[i].Palette)
+}
+
+// activeTheme is the named theme, unless NO_COLOR (https://no-color.org)
+// asks for no colors
+func activeTheme(name string) *Theme {
+	if os.Getenv("NO_COLOR") != "" {
+		return NewTheme(monoTheme)
+	}
+	return NewTheme(name)
+}
+
+func newThemeFromPalette(name string, p Palette) *Theme {
+	t := &Theme{Name: name, Palette: p}
+
+	t.Title = lipgloss.NewStyle().Foreground(p.Primary).Bold(true).MarginBottom(1)
+	t.Subtitle = lipgloss.NewStyle().Foreground(p.Secondary).Italic(true)
+	t.Success = lipgloss.NewStyle().Foreground(p.Success).Bold(true)
+	t.Error = lipgloss.NewStyle().Foreground(p.Error).Bold(true)
+	t.Warning = lipgloss.NewStyle().Foreground(p.Warning)
+	t.Info = lipgloss.NewStyle().Foreground(p.Info)
+	t.Muted = lipgloss.NewStyle().Foreground(p.TextMuted)
+
+	t.Selected = lipgloss.NewStyle().Foreground(p.Accent).Bold(true).PaddingLeft(2)
+	t.Unselected = lipgloss.NewStyle().Foreground(p.Text).PaddingLeft(4)
+
+	t.Box = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(p.BorderActive).Padding(1, 2)
+	t.Logo = lipgloss.NewStyle().Foreground(p.Primary).Bold(true)
+
+	t.StepActive = lipgloss.NewStyle().Foreground(p.Accent).Bold(true)
+	t.StepDone = lipgloss.NewStyle().Foreground(p.Success)
+	t.StepPending = lipgloss.NewStyle().Foreground(p.TextMuted)
+
+	t.Help = lipgloss.NewStyle().Foreground(p.TextMuted).Italic(true).MarginTop(1)
+	t.Key = lipgloss.NewStyle().Foreground(p.Keyword).Bold(true)
+	t.FooterKey = lipgloss.NewStyle().Foreground(p.Accent).Bold(true)
+	t.FooterText = lipgloss.NewStyle().Foreground(p.TextMuted)
+	t.Code = lipgloss.NewStyle().Foreground(p.String)
+
+	t.BackupItem = lipgloss.NewStyle().Foreground(p.Secondary)
+	t.Danger = lipgloss.NewStyle().Foreground(p.Error).Bold(true)
+	t.Highlight = lipgloss.NewStyle().Foreground(p.Accent).Bold(true)
+
+	t.StartCursor = lipgloss.NewStyle().Foreground(p.Background).Background(p.Warning).Bold(true)
+	t.CurrentCursor = lipgloss.NewStyle().Foreground(p.Background).Background(p.Success).Bold(true)
+	t.Selection = lipgloss.NewStyle().Foreground(p.Background).Background(p.Selection)
+	t.DimmedCode = lipgloss.NewStyle().Foreground(p.TextMuted)
+	t.Cursor = lipgloss.NewStyle().Foreground(p.Background).Background(p.Primary)
+
+	if name == monoTheme {
+		// Without colors a cursor only shows up reversed; the two trainer
+		// cursors differ by underline
+		t.StartCursor = lipgloss.NewStyle().Reverse(true).Underline(true)
+		t.CurrentCursor = lipgloss.NewStyle().Reverse(true).Bold(true)
+		t.Selection = lipgloss.NewStyle().Reverse(true)
+		t.Cursor = lipgloss.NewStyle().Reverse(true)
+	}
+	return t
+}
 
 // CenterHorizontally centers text horizontally within a given width
 func CenterHorizontally(text string, width int) string {
@@ -0,0 +1,44 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestNewTheme(t *testing.T) {
+	for _, name := range themeNames() {
+		if got := NewTheme(name); got.Name != name {
+			t.Errorf("NewTheme(%q) built %q", name, got.Name)
+		}
+	}
+	if got := NewTheme("neon"); got.Name != defaultTheme {
+		t.Errorf("an unknown theme should give the default, got %q", got.Name)
+	}
+
+	mono := NewTheme(monoTheme)
+	if _, ok := mono.Title.GetForeground().(lipgloss.NoColor); !ok {
+		t.Error("the mono theme should not color text")
+	}
+	if !mono.Cursor.GetReverse() || !mono.Selection.GetReverse() {
+		t.Error("the mono theme should reverse cursors and selections")
+	}
+}
+
+func TestActiveThemeNoColor(t *testing.T) {
+	t.Setenv("NO_COLOR", "")
+	if got := activeTheme("light"); got.Name != "light" {
+		t.Errorf("an empty NO_COLOR should be ignored, got %q", got.Name)
+	}
+
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("NO_COLOR", "1")
+	if got := activeTheme("light"); got.Name != monoTheme {
+		t.Errorf("NO_COLOR should force the mono theme, got %q", got.Name)
+	}
+	m := NewModel().stepTheme(1)
+	if m.Theme.Name != monoTheme || !strings.Contains(m.settingsOptions()[settingsThemeRow], "light (NO_COLOR is set)") {
+		t.Errorf("the picked theme should be kept but noted as overridden, got %q", m.settingsOptions()[settingsThemeRow])
+	}
+}
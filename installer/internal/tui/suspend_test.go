@@ -0,0 +1,133 @@
+package tui
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// runBatch runs cmd and returns the messages of it and any batched commands
+func runBatch(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	msg := cmd()
+	batch, ok := msg.(tea.BatchMsg)
+	if !ok {
+		return []tea.Msg{msg}
+	}
+	var msgs []tea.Msg
+	for _, c := range batch {
+		msgs = append(msgs, runBatch(c)...)
+	}
+	return msgs
+}
+
+func TestCtrlZSuspends(t *testing.T) {
+	screens := []Screen{ScreenMainMenu, ScreenProjectPath, ScreenProjectInstalling, ScreenSkillInstall}
+	for _, screen := range screens {
+		t.Run(screenNames[screen], func(t *testing.T) {
+			m := NewModel()
+			m.Screen = screen
+			m.LeaderMode = true
+			m.Ticking = true
+
+			result, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlZ})
+			m = result.(Model)
+			if cmd == nil {
+				t.Fatal("ctrl+z should return a command")
+			}
+			if _, ok := cmd().(tea.SuspendMsg); !ok {
+				t.Error("ctrl+z should suspend the program")
+			}
+			if m.Screen != screen || m.Quitting || m.LeaderMode {
+				t.Errorf("suspending should keep the screen and clear leader mode, got %v", m.Screen)
+			}
+		})
+	}
+}
+
+func TestCtrlZBlockedWhileInstalling(t *testing.T) {
+	m := NewModel()
+	m.Screen = ScreenInstalling
+	m.Steps = []InstallStep{{ID: "nvim", Name: "Install Neovim", Status: StatusRunning}}
+	m.LeaderMode = true
+
+	result, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlZ})
+	m = result.(Model)
+	for _, msg := range runBatch(cmd) {
+		if _, ok := msg.(tea.SuspendMsg); ok {
+			t.Fatal("ctrl+z should not suspend while installing")
+		}
+	}
+	if m.Toast == "" || !strings.Contains(m.View(), m.Toast) || m.LeaderMode {
+		t.Errorf("a toast should explain why, got %q", m.Toast)
+	}
+
+	result, _ = m.Update(tickMsg(m.ToastUntil))
+	m = result.(Model)
+	if m.Toast != "" {
+		t.Error("the toast should expire")
+	}
+}
+
+func TestResumeRestoresProjectInitState(t *testing.T) {
+	m := NewModel()
+	m.Screen = ScreenProjectInstalling
+	m.ProjectLogLines.Add("Cloning...", "Running: bash init-project.sh")
+	m.SpinnerFrame = 7
+	m.Ticking = true
+	m.LeaderMode = true
+
+	// ctrl+z; the program stops, the pending tick is lost, and fg sends ResumeMsg
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlZ})
+	m = result.(Model)
+	result, cmd := m.Update(tea.ResumeMsg{})
+	m = result.(Model)
+
+	if !m.Ticking {
+		t.Error("resuming an animated screen should schedule a tick")
+	}
+	var sawClear, sawSize, sawTick bool
+	for _, msg := range runBatch(cmd) {
+		switch msg.(type) {
+		case tickMsg:
+			sawTick = true
+		default:
+			// tea.ClearScreen and tea.WindowSize() send unexported messages
+			sawClear = sawClear || fmt.Sprintf("%T", msg) == "tea.clearScreenMsg"
+			sawSize = sawSize || fmt.Sprintf("%T", msg) == "tea.windowSizeMsg"
+		}
+	}
+	if !sawClear || !sawSize || !sawTick {
+		t.Errorf("resume should repaint, query the window size and restart the tick (clear %v, size %v, tick %v)", sawClear, sawSize, sawTick)
+	}
+
+	result, _ = m.Update(tickMsg{})
+	m = result.(Model)
+	if m.SpinnerFrame != 8 {
+		t.Errorf("spinner should keep animating after resume, frame %d", m.SpinnerFrame)
+	}
+	if want := []string{"Cloning...", "Running: bash init-project.sh"}; m.Screen != ScreenProjectInstalling || !reflect.DeepEqual(m.ProjectLogLines.Lines(), want) {
+		t.Errorf("the running init should survive the suspend, got %v %v", m.Screen, m.ProjectLogLines.Lines())
+	}
+}
+
+func TestResumeOnIdleScreenDoesNotTick(t *testing.T) {
+	m := NewModel()
+	m.Screen = ScreenMainMenu
+
+	result, cmd := m.Update(tea.ResumeMsg{})
+	m = result.(Model)
+	if m.Ticking {
+		t.Error("an idle screen should not start ticking on resume")
+	}
+	for _, msg := range runBatch(cmd) {
+		if _, ok := msg.(tickMsg); ok {
+			t.Error("an idle screen should not get a tick on resume")
+		}
+	}
+}
@@ -174,7 +174,11 @@ func TestKeymapsE2E(t *testing.T) {
 	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
 	time.Sleep(50 * time.Millisecond)
 
-	// Main Menu -> Navigate to Learn & Practice (index 1)
+	// Main Menu -> Navigate to Learn & Practice (index 3)
+	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+	time.Sleep(20 * time.Millisecond)
+	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+	time.Sleep(20 * time.Millisecond)
 	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
 	time.Sleep(20 * time.Millisecond)
 	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
@@ -259,6 +263,7 @@ func TestBackupScreenGolden(t *testing.T) {
 	m.Height = 24
 	m.Screen = ScreenBackupConfirm
 	m.ExistingConfigs = []string{".config/nvim", ".zshrc", ".tmux.conf"}
+	m.BackupSizing = true
 
 	tm := teatest.NewTestModel(t, m,
 		teatest.WithInitialTermSize(80, 24),
@@ -423,7 +428,11 @@ func TestLazyVimGuideE2E(t *testing.T) {
 	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
 	time.Sleep(50 * time.Millisecond)
 
-	// Main Menu -> Navigate to Learn & Practice (index 1)
+	// Main Menu -> Navigate to Learn & Practice (index 3)
+	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+	time.Sleep(20 * time.Millisecond)
+	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+	time.Sleep(20 * time.Millisecond)
 	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
 	time.Sleep(20 * time.Millisecond)
 	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
@@ -0,0 +1,144 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Scratch directories the installer creates under the system temp dir
+const (
+	frameworkTempName  = "project-starter-framework-install"
+	agentTeamsTempName = "agent-teams-lite-install"
+	alacrittyTempName  = "alacritty-build"
+)
+
+// staleTempAge is how old a leftover scratch directory must be before the startup sweep removes it
+const staleTempAge = 7 * 24 * time.Hour
+
+// tempTracker remembers the scratch and clone directories an install created
+// so every exit path can remove them, not only the final cleanup step
+type tempTracker struct {
+	mu   sync.Mutex
+	dirs []string
+}
+
+// installTemps tracks the directories of the running install
+var installTemps = &tempTracker{}
+
+// Track registers dir for removal on exit
+func (t *tempTracker) Track(dir string) {
+	if abs, err := filepath.Abs(dir); err == nil {
+		dir = abs
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, d := range t.dirs {
+		if d == dir {
+			return
+		}
+	}
+	t.dirs = append(t.dirs, dir)
+}
+
+// Untrack forgets dir, once a step has removed it itself
+func (t *tempTracker) Untrack(dir string) {
+	if abs, err := filepath.Abs(dir); err == nil {
+		dir = abs
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for i, d := range t.dirs {
+		if d == dir {
+			t.dirs = append(t.dirs[:i], t.dirs[i+1:]...)
+			return
+		}
+	}
+}
+
+// Pending reports whether any tracked directory is still waiting to be removed
+func (t *tempTracker) Pending() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.dirs) > 0
+}
+
+// Cleanup removes every tracked directory and returns the ones that could not be
+// removed; those stay tracked so a later exit path can try again
+func (t *tempTracker) Cleanup() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var failed []string
+	for _, dir := range t.dirs {
+		if err := os.RemoveAll(dir); err != nil {
+			failed = append(failed, dir)
+		}
+	}
+	t.dirs = failed
+	return failed
+}
+
+// CleanupTempDirs removes the temporary directories of the current install.
+// It is safe to call on any exit path and returns the directories it could not remove.
+func CleanupTempDirs() []string {
+	return installTemps.Cleanup()
+}
+
+// tempCleanupNote describes the cleanup result for the error screen
+func tempCleanupNote(failed []string) string {
+	if len(failed) == 0 {
+		return "Temporary files cleaned"
+	}
+	return "Could not remove temporary files: " + strings.Join(failed, ", ")
+}
+
+// isInstallerTempName reports whether name is a scratch directory this installer creates
+// (the --test home is deliberately not one of them; it is meant to persist)
+func isInstallerTempName(name string) bool {
+	switch name {
+	case frameworkTempName, agentTeamsTempName, alacrittyTempName:
+		return true
+	}
+	return false
+}
+
+// sweepStaleTempDirs removes installer scratch directories in root that were last
+// modified more than maxAge before now. It returns the directories it removed.
+func sweepStaleTempDirs(root string, maxAge time.Duration, now time.Time) ([]string, error) {
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return nil, err
+	}
+	var removed []string
+	for _, e := range entries {
+		if !e.IsDir() || !isInstallerTempName(e.Name()) {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil || now.Sub(info.ModTime()) < maxAge {
+			continue
+		}
+		dir := filepath.Join(root, e.Name())
+		if err := os.RemoveAll(dir); err == nil {
+			removed = append(removed, dir)
+		}
+	}
+	return removed, nil
+}
+
+// SweepStaleTempDirs removes scratch directories that earlier, interrupted runs
+// left in the system temp dir
+func SweepStaleTempDirs() ([]string, error) {
+	return sweepStaleTempDirs(os.TempDir(), staleTempAge, time.Now())
+}
+
+// cleanupAfterFailure removes the install's temporary directories once a step has
+// failed and returns the note for the error screen, or "" when nothing was left behind
+func cleanupAfterFailure() string {
+	if !installTemps.Pending() {
+		return ""
+	}
+	return tempCleanupNote(installTemps.Cleanup())
+}
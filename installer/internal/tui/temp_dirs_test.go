@@ -0,0 +1,116 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestSweepStaleTempDirs(t *testing.T) {
+	root := t.TempDir()
+	now := time.Now()
+	old := now.Add(-8 * 24 * time.Hour)
+
+	dirs := []struct {
+		name    string
+		modTime time.Time
+		removed bool
+	}{
+		{frameworkTempName, old, true},
+		{agentTeamsTempName, now.Add(-time.Hour), false},
+		{alacrittyTempName, old, true},
+		{"gentleman-dots-test", old, false},
+		{"someone-elses-dir", old, false},
+	}
+	for _, d := range dirs {
+		path := filepath.Join(root, d.name)
+		if err := os.MkdirAll(filepath.Join(path, "sub"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, d.modTime, d.modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	removed, err := sweepStaleTempDirs(root, staleTempAge, now)
+	if err != nil {
+		t.Fatalf("sweepStaleTempDirs: %v", err)
+	}
+	if len(removed) != 2 {
+		t.Errorf("expected 2 directories removed, got %v", removed)
+	}
+	for _, d := range dirs {
+		t.Run(d.name, func(t *testing.T) {
+			_, err := os.Stat(filepath.Join(root, d.name))
+			if gone := os.IsNotExist(err); gone != d.removed {
+				t.Errorf("removed = %v, want %v", gone, d.removed)
+			}
+		})
+	}
+}
+
+func TestTempTrackerCleanup(t *testing.T) {
+	root := t.TempDir()
+	clone := filepath.Join(root, "Javi.Dots")
+	done := filepath.Join(root, "framework")
+	for _, dir := range []string{clone, done} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tracker := &tempTracker{}
+	tracker.Track(clone)
+	tracker.Track(clone)
+	tracker.Track(done)
+	tracker.Untrack(done)
+
+	if failed := tracker.Cleanup(); len(failed) != 0 {
+		t.Errorf("unexpected failures: %v", failed)
+	}
+	if _, err := os.Stat(clone); !os.IsNotExist(err) {
+		t.Error("tracked directory should be removed")
+	}
+	if _, err := os.Stat(done); err != nil {
+		t.Error("untracked directory should be left alone")
+	}
+	if tracker.Pending() {
+		t.Error("nothing should be pending after a successful cleanup")
+	}
+}
+
+func TestFailedStepCleansTempDirs(t *testing.T) {
+	saved := installTemps
+	installTemps = &tempTracker{}
+	t.Cleanup(func() { installTemps = saved })
+
+	clone := filepath.Join(t.TempDir(), "Javi.Dots")
+	if err := os.MkdirAll(clone, 0755); err != nil {
+		t.Fatal(err)
+	}
+	installTemps.Track(clone)
+
+	m := NewModel()
+	m.Screen = ScreenInstalling
+	m.Steps = []InstallStep{{ID: "clone", Name: "Clone Repository", Status: StatusRunning}}
+
+	result, _ := m.Update(stepCompleteMsg{stepID: "clone", err: os.ErrPermission})
+	nm := result.(Model)
+	if nm.Screen != ScreenError {
+		t.Fatalf("expected ScreenError, got %d", nm.Screen)
+	}
+	if nm.TempCleanupNote != "Temporary files cleaned" {
+		t.Errorf("unexpected cleanup note %q", nm.TempCleanupNote)
+	}
+	if _, err := os.Stat(clone); !os.IsNotExist(err) {
+		t.Error("the clone directory should be removed when a step fails")
+	}
+
+	result, _ = nm.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	if note := result.(Model).TempCleanupNote; note != "" {
+		t.Errorf("retry should clear the cleanup note, got %q", note)
+	}
+}
@@ -0,0 +1,19 @@
+-- Map Ctrl+c to escape from other modes
+vim.keymap.set({ "i", "n", "v" }, "<C-c>", [[<C-\><C-n>]])
+
+local nvim_tmux_nav = require("nvim-tmux-navigation")
+vim.keymap.set("n", "<C-\\>", nvim_tmux_nav.NvimTmuxNavigateLastActive) -- Navigate to the last active pane
+
+-- vim.keymap.set("n", "<leader>zz", "<cmd>ZenMode<CR>")
+
+-- Delete all buffers but the current one
+vim.keymap.set(
+  "n",
+  "<leader>bq",
+  '<Esc>:%bdelete|edit #|normal`"<Return>',
+  { desc = "Delete other buffers but the current one" }
+)
+
+vim.keymap.set("n", "<leader>fm", function()
+  require("mini.files").open(vim.api.nvim_buf_get_name(0))
+end, { desc = "Open mini.files here" })
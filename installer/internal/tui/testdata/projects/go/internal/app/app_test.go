@@ -0,0 +1,5 @@
+package app
+
+import "testing"
+
+func TestApp(t *testing.T) {}
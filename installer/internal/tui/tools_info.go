@@ -21,6 +21,7 @@ type Keymap struct {
 	Keys        string
 	Description string
 	Mode        string // "n" normal, "v" visual, "i" insert
+	Config      string // How the user's config differs, set by Refresh from my config
 }
 
 // GetTerminalInfo returns info about terminal emulators
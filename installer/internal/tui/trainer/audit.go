@@ -0,0 +1,88 @@
+package trainer
+
+import "sort"
+
+// ModuleContent summarizes what a module ships, for content audits
+type ModuleContent struct {
+	ID        ModuleID `json:"id"`
+	Name      string   `json:"name"`
+	Lessons   int      `json:"lessons"`
+	Practice  int      `json:"practice"`
+	HasBoss   bool     `json:"has_boss"`
+	BossName  string   `json:"boss_name,omitempty"`
+	BossSteps int      `json:"boss_steps"`
+}
+
+// ListModuleContent counts the lessons, practice exercises and boss steps of every module
+func ListModuleContent() []ModuleContent {
+	var list []ModuleContent
+	for _, mod := range GetAllModules() {
+		c := ModuleContent{
+			ID:       mod.ID,
+			Name:     mod.Name,
+			Lessons:  len(GetLessons(mod.ID)),
+			Practice: len(GetPracticeExercises(mod.ID)),
+		}
+		if boss := GetBoss(mod.ID); boss != nil {
+			c.HasBoss = true
+			c.BossName = boss.Name
+			c.BossSteps = len(boss.Steps)
+		}
+		list = append(list, c)
+	}
+	return list
+}
+
+// DuplicateExercise is an exercise ID used by more than one exercise.
+// Progress is keyed by exercise ID, so duplicates share (and corrupt) their stats.
+type DuplicateExercise struct {
+	ID        string   `json:"id"`
+	Locations []string `json:"locations"` // "module/lesson", "module/practice" or "module/boss"
+}
+
+// exerciseRef is where an exercise ID was found
+type exerciseRef struct {
+	ID       string
+	Location string
+}
+
+// allExerciseRefs lists the ID of every lesson, practice exercise and boss step
+func allExerciseRefs() []exerciseRef {
+	var refs []exerciseRef
+	for _, mod := range GetAllModules() {
+		for _, ex := range GetLessons(mod.ID) {
+			refs = append(refs, exerciseRef{ex.ID, string(mod.ID) + "/lesson"})
+		}
+		for _, ex := range GetPracticeExercises(mod.ID) {
+			refs = append(refs, exerciseRef{ex.ID, string(mod.ID) + "/practice"})
+		}
+		if boss := GetBoss(mod.ID); boss != nil {
+			for _, step := range boss.Steps {
+				refs = append(refs, exerciseRef{step.Exercise.ID, string(mod.ID) + "/boss"})
+			}
+		}
+	}
+	return refs
+}
+
+// findDuplicateIDs groups refs by ID and returns the IDs seen more than once, sorted
+func findDuplicateIDs(refs []exerciseRef) []DuplicateExercise {
+	locations := make(map[string][]string)
+	for _, r := range refs {
+		locations[r.ID] = append(locations[r.ID], r.Location)
+	}
+	var dups []DuplicateExercise
+	for id, locs := range locations {
+		if len(locs) > 1 {
+			dups = append(dups, DuplicateExercise{ID: id, Locations: locs})
+		}
+	}
+	sort.Slice(dups, func(i, j int) bool { return dups[i].ID < dups[j].ID })
+	return dups
+}
+
+// FindDuplicateExerciseIDs reports exercise IDs shared by more than one exercise
+// across all modules
+func FindDuplicateExerciseIDs() []DuplicateExercise {
+	return findDuplicateIDs(allExerciseRefs())
+}
@@ -0,0 +1,54 @@
+package trainer
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestNoDuplicateExerciseIDs keeps duplicate IDs from shipping: progress is keyed
+// by exercise ID, so two exercises sharing one would share their practice stats
+func TestNoDuplicateExerciseIDs(t *testing.T) {
+	for _, d := range FindDuplicateExerciseIDs() {
+		t.Errorf("exercise ID %q is used by %v", d.ID, d.Locations)
+	}
+}
+
+func TestNoEmptyExerciseIDs(t *testing.T) {
+	for _, r := range allExerciseRefs() {
+		if r.ID == "" {
+			t.Errorf("an exercise in %s has no ID", r.Location)
+		}
+	}
+}
+
+func TestFindDuplicateIDs(t *testing.T) {
+	refs := []exerciseRef{
+		{"vertical_001", "vertical/lesson"},
+		{"horizontal_001", "horizontal/lesson"},
+		{"vertical_001", "regex/boss"},
+		{"horizontal_p001", "horizontal/practice"},
+		{"horizontal_001", "horizontal/practice"},
+	}
+	want := []DuplicateExercise{
+		{ID: "horizontal_001", Locations: []string{"horizontal/lesson", "horizontal/practice"}},
+		{ID: "vertical_001", Locations: []string{"vertical/lesson", "regex/boss"}},
+	}
+	if got := findDuplicateIDs(refs); !reflect.DeepEqual(got, want) {
+		t.Errorf("findDuplicateIDs() = %+v, want %+v", got, want)
+	}
+}
+
+func TestListModuleContent(t *testing.T) {
+	list := ListModuleContent()
+	if len(list) != len(GetAllModules()) {
+		t.Fatalf("expected one entry per module, got %d", len(list))
+	}
+	for _, c := range list {
+		if c.Lessons != len(GetLessons(c.ID)) || c.Practice != len(GetPracticeExercises(c.ID)) {
+			t.Errorf("%s: counts don't match the exercise lists: %+v", c.ID, c)
+		}
+		if boss := GetBoss(c.ID); c.HasBoss != (boss != nil) {
+			t.Errorf("%s: HasBoss = %v", c.ID, c.HasBoss)
+		}
+	}
+}
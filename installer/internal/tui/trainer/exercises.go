@@ -1,5 +1,7 @@
 package trainer
 
+import "slices"
+
 // GetLessons returns lesson exercises for a module
 func GetLessons(module ModuleID) []Exercise {
 	switch module {
@@ -18,6 +20,9 @@ func GetLessons(module ModuleID) []Exercise {
 	case ModuleMacros:
 		return getMacrosLessons()
 	default:
+		if pack := findPack(module); pack != nil {
+			return slices.Clone(pack.lessons)
+		}
 		return []Exercise{}
 	}
 }
@@ -40,6 +45,9 @@ func GetPracticeExercises(module ModuleID) []Exercise {
 	case ModuleMacros:
 		return getMacrosPractice()
 	default:
+		if pack := findPack(module); pack != nil {
+			return slices.Clone(pack.practice)
+		}
 		return []Exercise{}
 	}
 }
@@ -62,6 +70,11 @@ func GetBoss(module ModuleID) *BossExercise {
 	case ModuleMacros:
 		return getMacrosBoss()
 	default:
+		if pack := findPack(module); pack != nil && pack.boss != nil {
+			boss := *pack.boss
+			boss.Steps = slices.Clone(boss.Steps)
+			return &boss
+		}
 		return nil
 	}
 }
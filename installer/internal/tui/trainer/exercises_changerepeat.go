@@ -402,10 +402,11 @@ func getChangeRepeatPractice() []Exercise {
 // getChangeRepeatBoss returns the boss fight for the Change & Repeat module
 func getChangeRepeatBoss() *BossExercise {
 	return &BossExercise{
-		ID:     "changerepeat_boss",
-		Module: ModuleChangeRepeat,
-		Name:   "The Change Master",
-		Lives:  3,
+		ID:        "changerepeat_boss",
+		Module:    ModuleChangeRepeat,
+		Name:      "The Change Master",
+		Lives:     3,
+		BonusTime: 45,
 		Steps: []BossStep{
 			{
 				Exercise: Exercise{
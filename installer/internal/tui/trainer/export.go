@@ -0,0 +1,213 @@
+package trainer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+)
+
+// Exported progress is wrapped with a format tag and version so an import
+// can tell a trainer export from any other JSON and refuse newer layouts
+const (
+	exportFormat  = "gentleman-trainer-export"
+	exportVersion = 1
+)
+
+// ExportFileName is the default export file, written to the home directory
+const ExportFileName = "gentleman-trainer-export.json"
+
+// exportFileJSON is the export document: the stats file plus a header
+type exportFileJSON struct {
+	Format     string         `json:"format"`
+	Version    int            `json:"version"`
+	ExportedAt string         `json:"exportedAt"`
+	Stats      *statsFileJSON `json:"stats"`
+}
+
+// DefaultExportPath returns ~/gentleman-trainer-export.json, or "" without a home
+func DefaultExportPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ExportFileName)
+}
+
+// ExportStats writes the saved progress to path as a versioned export document
+func ExportStats(path string) error {
+	stats := LoadStats()
+	if stats == nil {
+		return errors.New("no saved progress to export yet")
+	}
+	doc := exportFileJSON{
+		Format:     exportFormat,
+		Version:    exportVersion,
+		ExportedAt: time.Now().Format(time.RFC3339),
+		Stats:      statsToJSON(stats),
+	}
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// ImportStats merges the export at path into the saved progress, saves the
+// result and returns it. Nothing is saved when the file can't be imported.
+func ImportStats(path string) (*UserStats, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no export found at %s", path)
+		}
+		return nil, err
+	}
+	var doc exportFileJSON
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("%s is not valid JSON: %w", filepath.Base(path), err)
+	}
+	switch {
+	case doc.Format != exportFormat:
+		return nil, fmt.Errorf("%s is not a trainer export", filepath.Base(path))
+	case doc.Version > exportVersion:
+		return nil, fmt.Errorf("export version %d is newer than this trainer supports (%d); update the installer", doc.Version, exportVersion)
+	case doc.Stats == nil:
+		return nil, fmt.Errorf("%s has no progress in it", filepath.Base(path))
+	}
+
+	stats := LoadStats()
+	if stats == nil {
+		stats = NewUserStats()
+	}
+	stats.Merge(statsFromJSON(doc.Stats))
+	if err := SaveStats(stats); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// Merge folds other into s, keeping the further progress of each: counters
+// take the max, bosses and mastery are never lost and best times take the min
+func (s *UserStats) Merge(other *UserStats) {
+	if other == nil {
+		return
+	}
+	s.TotalScore = max(s.TotalScore, other.TotalScore)
+	s.CurrentStreak = max(s.CurrentStreak, other.CurrentStreak)
+	s.BestStreak = max(s.BestStreak, other.BestStreak)
+	if other.TotalTime > s.TotalTime {
+		s.TotalTime = other.TotalTime
+	}
+	s.TotalAnswered = max(s.TotalAnswered, other.TotalAnswered)
+	s.BestTimedScore = max(s.BestTimedScore, other.BestTimedScore)
+	if other.LastPlayed.After(s.LastPlayed) {
+		s.LastPlayed = other.LastPlayed
+	}
+
+	for _, boss := range other.BossesDefeated {
+		if !slices.Contains(s.BossesDefeated, boss) {
+			s.BossesDefeated = append(s.BossesDefeated, boss)
+		}
+	}
+
+	if other.Placement != nil && (s.Placement == nil || other.Placement.TakenAt.After(s.Placement.TakenAt)) {
+		placement := *other.Placement
+		s.Placement = &placement
+	}
+
+	for id, seconds := range other.BestTimes {
+		if s.BestTimes == nil {
+			s.BestTimes = make(map[string]float64)
+		}
+		if best, ok := s.BestTimes[id]; !ok || seconds < best {
+			s.BestTimes[id] = seconds
+		}
+	}
+
+	// Either machine's mistakes stay up for review, with the shorter run toward clearing them
+	for id, run := range other.ReviewMistakes {
+		if s.ReviewMistakes == nil {
+			s.ReviewMistakes = make(map[string]int)
+		}
+		if ours, ok := s.ReviewMistakes[id]; !ok || run < ours {
+			s.ReviewMistakes[id] = run
+		}
+	}
+
+	// The later practice day carries the running streak; same-day streaks take the longer
+	switch {
+	case other.LastPracticeDate > s.LastPracticeDate:
+		s.LastPracticeDate = other.LastPracticeDate
+		s.StreakDays = other.StreakDays
+	case other.LastPracticeDate == s.LastPracticeDate:
+		s.StreakDays = max(s.StreakDays, other.StreakDays)
+	}
+	s.LongestStreakDays = max(s.LongestStreakDays, other.LongestStreakDays)
+
+	if s.ModuleProgress == nil {
+		s.ModuleProgress = make(map[ModuleID]*ModuleProgress)
+	}
+	for id, theirs := range other.ModuleProgress {
+		if ours, ok := s.ModuleProgress[id]; ok {
+			ours.merge(theirs)
+		} else {
+			s.ModuleProgress[id] = theirs
+		}
+	}
+}
+
+// merge folds other into mp the same way Merge does for the whole stats
+func (mp *ModuleProgress) merge(other *ModuleProgress) {
+	mp.LessonsCompleted = max(mp.LessonsCompleted, other.LessonsCompleted)
+	mp.LessonsTotal = max(mp.LessonsTotal, other.LessonsTotal)
+	mp.PracticeAttempts = max(mp.PracticeAttempts, other.PracticeAttempts)
+	mp.PracticeCorrect = max(mp.PracticeCorrect, other.PracticeCorrect)
+	if mp.PracticeAttempts > 0 {
+		mp.PracticeAccuracy = float64(mp.PracticeCorrect) / float64(mp.PracticeAttempts)
+	}
+
+	mp.BossDefeated = mp.BossDefeated || other.BossDefeated
+	if other.BossBestTime > 0 && (mp.BossBestTime == 0 || other.BossBestTime < mp.BossBestTime) {
+		mp.BossBestTime = other.BossBestTime
+		mp.BossLivesLeft = other.BossLivesLeft
+	}
+	mp.BossAttempts = max(mp.BossAttempts, other.BossAttempts)
+	mp.BossUnderPar = mp.BossUnderPar || other.BossUnderPar
+	if mp.BossLastTime == 0 {
+		mp.BossLastTime = other.BossLastTime
+	}
+
+	// Progress earned on either machine is no longer just placement
+	mp.Placement = mp.Placement && other.Placement
+
+	if other.LastPracticed.After(mp.LastPracticed) {
+		mp.LastPracticed = other.LastPracticed
+		mp.WeakExercises = other.WeakExercises
+	}
+
+	if mp.ExerciseStats == nil {
+		mp.ExerciseStats = make(map[string]*ExerciseStats)
+	}
+	for id, theirs := range other.ExerciseStats {
+		ours, ok := mp.ExerciseStats[id]
+		if !ok {
+			mp.ExerciseStats[id] = theirs
+			continue
+		}
+		ours.TotalAttempts = max(ours.TotalAttempts, theirs.TotalAttempts)
+		ours.TotalCorrect = max(ours.TotalCorrect, theirs.TotalCorrect)
+		ours.TotalWrong = max(ours.TotalWrong, theirs.TotalWrong)
+		ours.ConsecutiveRight = max(ours.ConsecutiveRight, theirs.ConsecutiveRight)
+		ours.Mastered = ours.Mastered || theirs.Mastered
+		if theirs.LastAttempted > ours.LastAttempted {
+			ours.LastAttempted = theirs.LastAttempted
+		}
+	}
+}
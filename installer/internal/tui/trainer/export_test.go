@@ -0,0 +1,181 @@
+package trainer
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// =============================================================================
+// PROGRESS EXPORT / IMPORT
+// =============================================================================
+
+// useStatsDir points the stats file at a fresh directory for the test
+func useStatsDir(t *testing.T) {
+	t.Helper()
+	original := statsConfigPath
+	statsConfigPath = t.TempDir()
+	t.Cleanup(func() { statsConfigPath = original })
+}
+
+func TestExportImportStats_RoundTrip(t *testing.T) {
+	useStatsDir(t)
+	stats := NewUserStats()
+	stats.TotalScore = 900
+	stats.BossesDefeated = []ModuleID{ModuleHorizontal}
+	progress := stats.GetModuleProgress(ModuleHorizontal)
+	progress.LessonsCompleted = 15
+	progress.BossDefeated = true
+	progress.ExerciseStats = map[string]*ExerciseStats{"horizontal_001": {TotalAttempts: 4, TotalCorrect: 3, Mastered: true}}
+	stats.RecordBestTime("horizontal_001", 2.5)
+	if err := SaveStats(stats); err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(t.TempDir(), ExportFileName)
+	if err := ExportStats(path); err != nil {
+		t.Fatalf("ExportStats failed: %v", err)
+	}
+	data, _ := os.ReadFile(path)
+	if !strings.Contains(string(data), `"format": "gentleman-trainer-export"`) || !strings.Contains(string(data), `"version": 1`) {
+		t.Errorf("export should carry its format and version, got:\n%s", data)
+	}
+
+	// A second machine with no progress yet
+	useStatsDir(t)
+	imported, err := ImportStats(path)
+	if err != nil {
+		t.Fatalf("ImportStats failed: %v", err)
+	}
+	if imported.TotalScore != 900 || !imported.IsBossDefeated(ModuleHorizontal) || imported.BestTimes["horizontal_001"] != 2.5 {
+		t.Errorf("imported stats = %+v", imported)
+	}
+	if ex := imported.GetModuleProgress(ModuleHorizontal).ExerciseStats["horizontal_001"]; ex == nil || !ex.Mastered {
+		t.Error("exercise mastery should survive the round trip")
+	}
+	if saved := LoadStats(); saved == nil || saved.TotalScore != 900 {
+		t.Error("import should save the merged stats")
+	}
+}
+
+func TestExportStats_NothingSaved(t *testing.T) {
+	useStatsDir(t)
+	path := filepath.Join(t.TempDir(), ExportFileName)
+	if err := ExportStats(path); err == nil {
+		t.Error("exporting without saved progress should fail")
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Error("a failed export should write nothing")
+	}
+}
+
+func TestUserStats_Merge(t *testing.T) {
+	day := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	ours := NewUserStats()
+	ours.TotalScore = 500
+	ours.BossesDefeated = []ModuleID{ModuleHorizontal}
+	ours.BestTimes = map[string]float64{"a": 2, "b": 5}
+	ours.LastPracticeDate, ours.StreakDays, ours.LongestStreakDays = "2026-03-01", 2, 9
+	mine := ours.GetModuleProgress(ModuleHorizontal)
+	mine.PracticeAttempts, mine.PracticeCorrect = 10, 9
+	mine.BossBestTime = 40 * time.Second
+	mine.LastPracticed = day
+	mine.ExerciseStats = map[string]*ExerciseStats{"a": {TotalAttempts: 3, TotalCorrect: 3, ConsecutiveRight: 3, Mastered: true}}
+
+	theirs := NewUserStats()
+	theirs.TotalScore = 300
+	theirs.BossesDefeated = []ModuleID{ModuleHorizontal, ModuleVertical}
+	theirs.BestTimes = map[string]float64{"a": 3, "b": 1, "c": 4}
+	theirs.LastPracticeDate, theirs.StreakDays, theirs.LongestStreakDays = "2026-03-04", 1, 4
+	other := theirs.GetModuleProgress(ModuleHorizontal)
+	other.PracticeAttempts, other.PracticeCorrect = 20, 12
+	other.BossBestTime = 30 * time.Second
+	other.BossLivesLeft = 1
+	other.WeakExercises = []string{"b"}
+	other.LastPracticed = day.Add(time.Hour)
+	other.ExerciseStats = map[string]*ExerciseStats{
+		"a": {TotalAttempts: 6, TotalCorrect: 2, TotalWrong: 4},
+		"b": {TotalAttempts: 1, TotalCorrect: 1},
+	}
+	theirs.GetModuleProgress(ModuleVertical).LessonsCompleted = 7
+
+	ours.Merge(theirs)
+
+	if ours.TotalScore != 500 {
+		t.Errorf("TotalScore = %d, want the higher 500", ours.TotalScore)
+	}
+	if len(ours.BossesDefeated) != 2 || !ours.IsBossDefeated(ModuleVertical) {
+		t.Errorf("BossesDefeated = %v, want both bosses once", ours.BossesDefeated)
+	}
+	if ours.BestTimes["a"] != 2 || ours.BestTimes["b"] != 1 || ours.BestTimes["c"] != 4 {
+		t.Errorf("BestTimes = %v, want the fastest of each", ours.BestTimes)
+	}
+	if ours.LastPracticeDate != "2026-03-04" || ours.StreakDays != 1 || ours.LongestStreakDays != 9 {
+		t.Errorf("daily streak = %s/%d/%d", ours.LastPracticeDate, ours.StreakDays, ours.LongestStreakDays)
+	}
+
+	mp := ours.GetModuleProgress(ModuleHorizontal)
+	if mp.PracticeAttempts != 20 || mp.PracticeCorrect != 12 || mp.PracticeAccuracy != 0.6 {
+		t.Errorf("practice = %d/%d (%.2f)", mp.PracticeCorrect, mp.PracticeAttempts, mp.PracticeAccuracy)
+	}
+	if mp.BossBestTime != 30*time.Second || mp.BossLivesLeft != 1 {
+		t.Errorf("boss best = %v with %d lives", mp.BossBestTime, mp.BossLivesLeft)
+	}
+	if len(mp.WeakExercises) != 1 || !mp.LastPracticed.Equal(day.Add(time.Hour)) {
+		t.Errorf("weak exercises should come from the later session, got %v", mp.WeakExercises)
+	}
+	a := mp.ExerciseStats["a"]
+	if a.TotalAttempts != 6 || a.TotalCorrect != 3 || a.ConsecutiveRight != 3 || !a.Mastered {
+		t.Errorf("exercise a = %+v, want the max of each counter and mastery kept", a)
+	}
+	if mp.ExerciseStats["b"] == nil {
+		t.Error("exercises only practiced elsewhere should be added")
+	}
+	if ours.GetModuleProgress(ModuleVertical).LessonsCompleted != 7 {
+		t.Error("modules only practiced elsewhere should be added")
+	}
+}
+
+func TestImportStats_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string // "" leaves the file missing
+		wantErr string
+	}{
+		{"missing file", "", "no export found"},
+		{"corrupt JSON", `{"format": "gentleman-trainer-export", "stats": {`, "not valid JSON"},
+		{"other JSON", `{"totalScore": 10}`, "not a trainer export"},
+		{"newer version", `{"format": "gentleman-trainer-export", "version": 99, "stats": {}}`, "newer than this trainer supports"},
+		{"no stats", `{"format": "gentleman-trainer-export", "version": 1}`, "has no progress"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useStatsDir(t)
+			stats := NewUserStats()
+			stats.TotalScore = 42
+			if err := SaveStats(stats); err != nil {
+				t.Fatal(err)
+			}
+
+			path := filepath.Join(t.TempDir(), ExportFileName)
+			if tt.content != "" {
+				if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			imported, err := ImportStats(path)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("ImportStats() error = %v, want it to mention %q", err, tt.wantErr)
+			}
+			if imported != nil {
+				t.Error("a failed import should return no stats")
+			}
+			if saved := LoadStats(); saved == nil || saved.TotalScore != 42 {
+				t.Error("a failed import must leave the saved progress alone")
+			}
+		})
+	}
+}
@@ -17,22 +17,48 @@ type GameState struct {
 	ExerciseIndex   int
 
 	// Mode flags
-	IsLessonMode   bool
-	IsPracticeMode bool
-	IsBossMode     bool
+	IsLessonMode    bool
+	IsPracticeMode  bool
+	IsBossMode      bool
+	IsPlacementMode bool
+	IsTimedMode     bool
+	IsReviewMode    bool
+
+	// Placement test answers (kept out of score and practice stats)
+	PlacementCorrect int
 
 	// Streak and scoring
 	CurrentStreak   int
 	ComboMultiplier int
 	SessionScore    int
 
+	// Speed Run: answers so far and the countdown. The countdown has run for
+	// TimedElapsed plus the time since TimedResumedAt, which is zero while paused.
+	TimedAnswered  int
+	TimedCorrect   int
+	TimedElapsed   time.Duration
+	TimedResumedAt time.Time
+	TimedFinished  bool
+
 	// Boss state
 	BossLives      int
 	BossStep       int
 	IsBossDefeated bool
+	// When the boss fight started; the fight's time is TimeElapsed once won
+	BossStartedAt time.Time
+	// Last boss victory: whether it beat the module's best time and the par time
+	LastBossBest     bool
+	LastBossUnderPar bool
 
 	// Timing
 	TimeElapsed time.Duration
+	// Clock times the exercises; nil means time.Now (tests set a fixed clock)
+	Clock func() time.Time
+	// When the current exercise was shown; zero until one is
+	ExerciseShownAt time.Time
+	// Last correct answer: seconds taken, and whether it beat the exercise's best time
+	LastAnswerTime float64
+	LastAnswerBest bool
 }
 
 // NewGameState creates a new game state with fresh stats
@@ -54,6 +80,26 @@ func NewGameStateWithStats(stats *UserStats) *GameState {
 	}
 }
 
+func (g *GameState) now() time.Time {
+	if g.Clock != nil {
+		return g.Clock()
+	}
+	return time.Now()
+}
+
+// markExerciseShown starts timing the current exercise
+func (g *GameState) markExerciseShown() {
+	g.ExerciseShownAt = g.now()
+}
+
+// ExerciseSeconds returns how long the current exercise has been on screen
+func (g *GameState) ExerciseSeconds() float64 {
+	if g.ExerciseShownAt.IsZero() {
+		return 0
+	}
+	return g.now().Sub(g.ExerciseShownAt).Seconds()
+}
+
 // StartLesson starts lesson mode for a module
 func (g *GameState) StartLesson(module ModuleID) {
 	g.CurrentModule = module
@@ -68,12 +114,32 @@ func (g *GameState) StartLesson(module ModuleID) {
 	if len(g.Exercises) > 0 {
 		g.CurrentExercise = &g.Exercises[0]
 	}
+	g.markExerciseShown()
 
 	// Initialize lesson total in stats
 	progress := g.Stats.GetModuleProgress(module)
 	progress.LessonsTotal = len(g.Exercises)
 }
 
+// StartPlacement starts the placement test
+func (g *GameState) StartPlacement() {
+	g.IsLessonMode = false
+	g.IsPracticeMode = false
+	g.IsBossMode = false
+	g.IsPlacementMode = true
+	g.Exercises = GetPlacementExercises()
+	g.ExerciseIndex = 0
+	g.PlacementCorrect = 0
+	g.CurrentStreak = 0
+	g.ComboMultiplier = 1
+
+	if len(g.Exercises) > 0 {
+		g.CurrentExercise = &g.Exercises[0]
+		g.CurrentModule = g.CurrentExercise.Module
+	}
+	g.markExerciseShown()
+}
+
 // StartPractice starts practice mode for a module using intelligent selection
 func (g *GameState) StartPractice(module ModuleID) {
 	g.CurrentModule = module
@@ -89,11 +155,13 @@ func (g *GameState) StartPractice(module ModuleID) {
 	progress := g.Stats.GetModuleProgress(module)
 	exercise := SelectRandomPracticeExercise(module, progress)
 	g.CurrentExercise = exercise
+	g.markExerciseShown()
 }
 
 // SetPracticeExercise sets a specific exercise for practice mode
 func (g *GameState) SetPracticeExercise(exercise *Exercise) {
 	g.CurrentExercise = exercise
+	g.markExerciseShown()
 }
 
 // NextPracticeExercise selects the next random exercise for practice
@@ -112,6 +180,7 @@ func (g *GameState) NextPracticeExercise() bool {
 	}
 
 	g.CurrentExercise = exercise
+	g.markExerciseShown()
 	return true
 }
 
@@ -126,6 +195,10 @@ func (g *GameState) StartBoss(module ModuleID) {
 	g.CurrentStreak = 0
 	g.ComboMultiplier = 1
 	g.IsBossDefeated = false
+	g.BossStartedAt = g.now()
+	g.TimeElapsed = 0
+	g.LastBossBest = false
+	g.LastBossUnderPar = false
 
 	if g.CurrentBoss != nil {
 		g.BossLives = g.CurrentBoss.Lives
@@ -133,10 +206,19 @@ func (g *GameState) StartBoss(module ModuleID) {
 			g.CurrentExercise = &g.CurrentBoss.Steps[0].Exercise
 		}
 	}
+	g.markExerciseShown()
 }
 
 // RecordCorrectAnswer records a correct answer and updates stats
 func (g *GameState) RecordCorrectAnswer(timeSeconds float64, isOptimal bool) {
+	g.LastAnswerTime = timeSeconds
+	g.LastAnswerBest = false
+	if g.IsPlacementMode {
+		g.PlacementCorrect++
+		return
+	}
+	g.Stats.RecordPracticeDay(g.now())
+
 	g.CurrentStreak++
 	if g.CurrentStreak > g.Stats.BestStreak {
 		g.Stats.BestStreak = g.CurrentStreak
@@ -148,6 +230,13 @@ func (g *GameState) RecordCorrectAnswer(timeSeconds float64, isOptimal bool) {
 	g.SessionScore += points
 	g.Stats.TotalScore += points
 
+	if g.CurrentExercise != nil && g.CurrentExercise.ID != "" {
+		g.LastAnswerBest = g.Stats.RecordBestTime(g.CurrentExercise.ID, timeSeconds)
+		if g.IsReviewMode {
+			g.Stats.RecordReviewAnswer(g.CurrentExercise.ID, isOptimal)
+		}
+	}
+
 	// Update practice stats
 	if g.IsPracticeMode {
 		progress := g.Stats.GetModuleProgress(g.CurrentModule)
@@ -168,9 +257,15 @@ func (g *GameState) RecordCorrectAnswer(timeSeconds float64, isOptimal bool) {
 
 // RecordIncorrectAnswer records an incorrect answer
 func (g *GameState) RecordIncorrectAnswer() {
+	if g.IsPlacementMode {
+		return
+	}
+	g.Stats.RecordPracticeDay(g.now())
+
 	g.CurrentStreak = 0
 	g.Stats.CurrentStreak = 0
 	g.ComboMultiplier = 1
+	g.RecordMistake()
 
 	// Update practice stats
 	if g.IsPracticeMode {
@@ -201,6 +296,7 @@ func (g *GameState) NextExercise() bool {
 			return false
 		}
 		g.CurrentExercise = &g.CurrentBoss.Steps[g.BossStep].Exercise
+		g.markExerciseShown()
 		return true
 	}
 
@@ -216,11 +312,25 @@ func (g *GameState) NextExercise() bool {
 	}
 
 	g.CurrentExercise = &g.Exercises[g.ExerciseIndex]
+	if g.IsPlacementMode {
+		g.CurrentModule = g.CurrentExercise.Module
+	}
+	g.markExerciseShown()
 	return true
 }
 
+// BossElapsed is how long the current boss fight has run
+func (g *GameState) BossElapsed() time.Duration {
+	if g.BossStartedAt.IsZero() {
+		return 0
+	}
+	return g.now().Sub(g.BossStartedAt)
+}
+
 // RecordBossVictory records defeating a boss
 func (g *GameState) RecordBossVictory() {
+	g.TimeElapsed = g.BossElapsed()
+
 	// Add to defeated list if not already
 	alreadyDefeated := false
 	for _, boss := range g.Stats.BossesDefeated {
@@ -238,16 +348,29 @@ func (g *GameState) RecordBossVictory() {
 	progress.BossDefeated = true
 	progress.BossAttempts++
 
-	if progress.BossBestTime == 0 || g.TimeElapsed < progress.BossBestTime {
+	g.LastBossBest = progress.BossBestTime == 0 || g.TimeElapsed < progress.BossBestTime
+	if g.LastBossBest {
 		progress.BossBestTime = g.TimeElapsed
 	}
+	progress.BossLastTime = g.TimeElapsed
 	progress.BossLivesLeft = g.BossLives
 
 	// Boss victory bonus
 	g.Stats.TotalScore += 500
 	g.SessionScore += 500
+
+	// Speed bonus for a win within the boss's par time
+	g.LastBossUnderPar = g.CurrentBoss != nil && g.CurrentBoss.UnderPar(g.TimeElapsed)
+	if g.LastBossUnderPar {
+		progress.BossUnderPar = true
+		g.Stats.TotalScore += BossSpeedBonus
+		g.SessionScore += BossSpeedBonus
+	}
 }
 
+// BossSpeedBonus is added to the victory bonus for a win under par
+const BossSpeedBonus = 250
+
 // Reset resets the game state for a new session
 func (g *GameState) Reset() {
 	g.CurrentModule = ""
@@ -259,6 +382,16 @@ func (g *GameState) Reset() {
 	g.IsLessonMode = false
 	g.IsPracticeMode = false
 	g.IsBossMode = false
+	g.IsPlacementMode = false
+	g.IsTimedMode = false
+	g.IsReviewMode = false
+	g.PlacementCorrect = 0
+
+	g.TimedAnswered = 0
+	g.TimedCorrect = 0
+	g.TimedElapsed = 0
+	g.TimedResumedAt = time.Time{}
+	g.TimedFinished = false
 
 	g.CurrentStreak = 0
 	g.ComboMultiplier = 1
@@ -267,6 +400,12 @@ func (g *GameState) Reset() {
 	g.BossLives = 0
 	g.BossStep = 0
 	g.IsBossDefeated = false
+	g.BossStartedAt = time.Time{}
+	g.LastBossBest = false
+	g.LastBossUnderPar = false
 
 	g.TimeElapsed = 0
+	g.ExerciseShownAt = time.Time{}
+	g.LastAnswerTime = 0
+	g.LastAnswerBest = false
 }
@@ -335,10 +335,10 @@ func TestGameState_PracticeAccuracyCalculation(t *testing.T) {
 }
 
 func TestGameState_RecordBossVictory(t *testing.T) {
-	state := NewGameState()
+	state, clock := timedGameState()
 	state.StartBoss(ModuleHorizontal)
 	state.BossLives = 2
-	state.TimeElapsed = 25 * time.Second
+	clock.Advance(25)
 
 	state.RecordBossVictory()
 
@@ -483,3 +483,188 @@ func TestGameState_NextPracticeExercise_ReturnsFalseWhenAllMastered(t *testing.T
 		t.Error("NextPracticeExercise should return false when all exercises are mastered")
 	}
 }
+
+// =============================================================================
+// GAME STATE - Exercise Timing
+// =============================================================================
+
+// fakeClock is a settable time source for GameState.Clock
+type fakeClock struct{ now time.Time }
+
+func (c *fakeClock) Now() time.Time { return c.now }
+func (c *fakeClock) Advance(seconds float64) {
+	c.now = c.now.Add(time.Duration(seconds * float64(time.Second)))
+}
+
+func timedGameState() (*GameState, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)}
+	state := NewGameState()
+	state.Clock = clock.Now
+	return state, clock
+}
+
+func TestGameState_ExerciseSeconds(t *testing.T) {
+	tests := []struct {
+		name  string
+		start func(g *GameState) bool
+	}{
+		{"StartLesson", func(g *GameState) bool { g.StartLesson(ModuleHorizontal); return true }},
+		{"NextExercise", func(g *GameState) bool { g.StartLesson(ModuleHorizontal); return g.NextExercise() }},
+		{"StartPractice", func(g *GameState) bool { g.StartPractice(ModuleHorizontal); return true }},
+		{"NextPracticeExercise", func(g *GameState) bool { g.StartPractice(ModuleHorizontal); return g.NextPracticeExercise() }},
+		{"StartBoss", func(g *GameState) bool { g.StartBoss(ModuleHorizontal); return true }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, clock := timedGameState()
+			// Time spent before the exercise is shown doesn't count
+			state.StartLesson(ModuleHorizontal)
+			clock.Advance(30)
+
+			if !tt.start(state) {
+				t.Fatal("expected an exercise to be shown")
+			}
+			clock.Advance(4.5)
+			if got := state.ExerciseSeconds(); got != 4.5 {
+				t.Errorf("ExerciseSeconds() = %v, want 4.5", got)
+			}
+		})
+	}
+}
+
+func TestGameState_RecordCorrectAnswer_BestTime(t *testing.T) {
+	state, clock := timedGameState()
+	state.StartLesson(ModuleHorizontal)
+	id := state.CurrentExercise.ID
+
+	answer := func(seconds float64) {
+		t.Helper()
+		state.SetPracticeExercise(state.CurrentExercise)
+		clock.Advance(seconds)
+		state.RecordCorrectAnswer(state.ExerciseSeconds(), true)
+		if state.LastAnswerTime != seconds {
+			t.Errorf("LastAnswerTime = %v, want %v", state.LastAnswerTime, seconds)
+		}
+	}
+
+	answer(6)
+	if state.LastAnswerBest || state.Stats.BestTimes[id] != 6 {
+		t.Errorf("the first answer sets the best without announcing it: best %v, new %v", state.Stats.BestTimes[id], state.LastAnswerBest)
+	}
+	answer(8)
+	if state.LastAnswerBest || state.Stats.BestTimes[id] != 6 {
+		t.Errorf("a slower answer keeps the old best: best %v", state.Stats.BestTimes[id])
+	}
+	answer(2.5)
+	if !state.LastAnswerBest || state.Stats.BestTimes[id] != 2.5 {
+		t.Errorf("a faster answer is a new best: best %v, new %v", state.Stats.BestTimes[id], state.LastAnswerBest)
+	}
+}
+
+func TestGameState_RecordCorrectAnswer_PlacementSkipsBestTime(t *testing.T) {
+	state, clock := timedGameState()
+	state.StartPlacement()
+	clock.Advance(3)
+	state.RecordCorrectAnswer(state.ExerciseSeconds(), true)
+
+	if state.LastAnswerTime != 3 || len(state.Stats.BestTimes) != 0 {
+		t.Errorf("placement answers are timed but not recorded: time %v, bests %v", state.LastAnswerTime, state.Stats.BestTimes)
+	}
+}
+
+func TestGameState_AnswersCountTowardDailyStreak(t *testing.T) {
+	state, clock := timedGameState()
+	state.StartLesson(ModuleHorizontal)
+	state.RecordCorrectAnswer(state.ExerciseSeconds(), true)
+	state.RecordIncorrectAnswer()
+	clock.Advance(24 * 60 * 60)
+	state.StartPractice(ModuleHorizontal)
+	state.RecordIncorrectAnswer()
+
+	if state.Stats.StreakDays != 2 || state.Stats.TotalAnswered != 3 || state.Stats.LastPracticeDate != "2026-03-02" {
+		t.Errorf("expected a 2-day streak over 3 answers, got %d days, %d answers, last %q",
+			state.Stats.StreakDays, state.Stats.TotalAnswered, state.Stats.LastPracticeDate)
+	}
+
+	placement, _ := timedGameState()
+	placement.StartPlacement()
+	placement.RecordCorrectAnswer(1, true)
+	placement.RecordIncorrectAnswer()
+	if placement.Stats.TotalAnswered != 0 || placement.Stats.LastPracticeDate != "" {
+		t.Error("the placement test should not count toward the streak")
+	}
+}
+
+func TestGameState_BossFightTiming(t *testing.T) {
+	state, clock := timedGameState()
+	clock.Advance(60) // Time on the menu doesn't count
+	state.StartBoss(ModuleHorizontal)
+	clock.Advance(12.5)
+	if got := state.BossElapsed(); got != 12500*time.Millisecond {
+		t.Errorf("BossElapsed mid-fight = %v, want 12.5s", got)
+	}
+
+	clock.Advance(27.5)
+	state.RecordBossVictory()
+	progress := state.Stats.GetModuleProgress(ModuleHorizontal)
+	if state.TimeElapsed != 40*time.Second || progress.BossLastTime != 40*time.Second || progress.BossBestTime != 40*time.Second {
+		t.Fatalf("a 40s win: elapsed %v, last %v, best %v", state.TimeElapsed, progress.BossLastTime, progress.BossBestTime)
+	}
+	if !state.LastBossBest {
+		t.Error("the first win should be a new best time")
+	}
+
+	// A slower rematch keeps the best time
+	state.StartBoss(ModuleHorizontal)
+	clock.Advance(55)
+	state.RecordBossVictory()
+	if progress.BossLastTime != 55*time.Second || progress.BossBestTime != 40*time.Second || state.LastBossBest {
+		t.Errorf("a slower win: last %v, best %v, new best %v", progress.BossLastTime, progress.BossBestTime, state.LastBossBest)
+	}
+
+	// A faster one replaces it
+	state.StartBoss(ModuleHorizontal)
+	clock.Advance(31)
+	state.RecordBossVictory()
+	if progress.BossBestTime != 31*time.Second || !state.LastBossBest {
+		t.Errorf("a faster win: best %v, new best %v", progress.BossBestTime, state.LastBossBest)
+	}
+}
+
+func TestGameState_BossSpeedBonus(t *testing.T) {
+	tests := []struct {
+		name    string
+		seconds float64
+		under   bool
+	}{
+		{"well under par", 12, true},
+		{"right on par", 30, true},
+		{"over par", 30.5, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, clock := timedGameState()
+			state.StartBoss(ModuleHorizontal) // Par 30s
+			clock.Advance(tt.seconds)
+			state.RecordBossVictory()
+
+			want := 500
+			if tt.under {
+				want += BossSpeedBonus
+			}
+			progress := state.Stats.GetModuleProgress(ModuleHorizontal)
+			if state.LastBossUnderPar != tt.under || progress.BossUnderPar != tt.under {
+				t.Errorf("under par = %v (stored %v), want %v", state.LastBossUnderPar, progress.BossUnderPar, tt.under)
+			}
+			if state.SessionScore != want {
+				t.Errorf("score = %d, want %d", state.SessionScore, want)
+			}
+		})
+	}
+
+	// A boss without a par time never awards it
+	noPar := &BossExercise{Lives: 3}
+	if noPar.UnderPar(time.Second) {
+		t.Error("a boss with no par time shouldn't award the speed bonus")
+	}
+}
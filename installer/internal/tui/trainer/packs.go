@@ -0,0 +1,304 @@
+package trainer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
+)
+
+// PackModulePrefix starts the module and exercise IDs of user packs, so their
+// stats never collide with the built-in modules and removing a pack leaves
+// built-in progress alone
+const PackModulePrefix = "pack:"
+
+// Pack defaults for fields a pack file may leave out
+const (
+	packDefaultTimeout = 30
+	packDefaultPoints  = 10
+	packDefaultLives   = 3
+)
+
+// trainerPacksPath is the directory scanned for packs (can be overridden for testing)
+var trainerPacksPath = ""
+
+// packIDPattern is what a pack or exercise ID may contain
+var packIDPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// packFileJSON is a trainer pack: one module with its lessons, practice
+// exercises and optional boss
+type packFileJSON struct {
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	Icon        string             `json:"icon"`
+	Description string             `json:"description"`
+	Lessons     []packExerciseJSON `json:"lessons"`
+	Practice    []packExerciseJSON `json:"practice"`
+	Boss        *packBossJSON      `json:"boss"`
+}
+
+type packExerciseJSON struct {
+	ID          string       `json:"id"`
+	Level       int          `json:"level"`
+	Code        []string     `json:"code"`
+	Cursor      packPosJSON  `json:"cursor"`
+	Target      *packPosJSON `json:"target"`
+	Mission     string       `json:"mission"`
+	Solutions   []string     `json:"solutions"`
+	Optimal     string       `json:"optimal"`
+	Hint        string       `json:"hint"`
+	Explanation string       `json:"explanation"`
+	TimeoutSecs int          `json:"timeoutSecs"`
+	Points      int          `json:"points"`
+}
+
+type packPosJSON struct {
+	Line int `json:"line"`
+	Col  int `json:"col"`
+}
+
+type packBossJSON struct {
+	Name      string             `json:"name"`
+	Lives     int                `json:"lives"`
+	BonusTime int                `json:"bonusTime"`
+	Steps     []packBossStepJSON `json:"steps"`
+}
+
+type packBossStepJSON struct {
+	packExerciseJSON
+	TimeLimit int `json:"timeLimit"`
+}
+
+// userPack is a loaded pack, its IDs already qualified
+type userPack struct {
+	info     ModuleInfo
+	lessons  []Exercise
+	practice []Exercise
+	boss     *BossExercise
+}
+
+// userPacks holds the packs from the last LoadUserPacks, in file name order
+var userPacks []userPack
+
+// TrainerPacksDir returns the directory user packs are loaded from, or "" without a home
+func TrainerPacksDir() string {
+	if trainerPacksPath != "" {
+		return trainerPacksPath
+	}
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return paths.TrainerPacksDir(homeDir)
+}
+
+// IsPackModule reports whether a module comes from a user pack
+func IsPackModule(module ModuleID) bool {
+	return strings.HasPrefix(string(module), PackModulePrefix)
+}
+
+// findPack returns the loaded pack for module, or nil
+func findPack(module ModuleID) *userPack {
+	for i := range userPacks {
+		if userPacks[i].info.ID == module {
+			return &userPacks[i]
+		}
+	}
+	return nil
+}
+
+// packModuleIDs lists the modules of the loaded packs
+func packModuleIDs() []ModuleID {
+	var ids []ModuleID
+	for _, pack := range userPacks {
+		ids = append(ids, pack.info.ID)
+	}
+	return ids
+}
+
+// allModuleIDs lists the built-in modules in unlock order, then the packs
+func allModuleIDs() []ModuleID {
+	return append(slices.Clone(moduleUnlockOrder), packModuleIDs()...)
+}
+
+// LoadUserPacks replaces the loaded packs with the *.json files in
+// TrainerPacksDir. A file that can't be used is skipped and its error
+// returned; a missing directory just means no packs.
+func LoadUserPacks() []error {
+	userPacks = nil
+	dir := TrainerPacksDir()
+	if dir == "" {
+		return nil
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return []error{err}
+	}
+	sort.Strings(files)
+
+	var errs []error
+	for _, file := range files {
+		pack, err := loadPackFile(file)
+		if err == nil && findPack(pack.info.ID) != nil {
+			err = fmt.Errorf("pack id %q is already used by another pack", strings.TrimPrefix(string(pack.info.ID), PackModulePrefix))
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", filepath.Base(file), err))
+			continue
+		}
+		userPacks = append(userPacks, *pack)
+	}
+	return errs
+}
+
+// loadPackFile reads and checks one pack file
+func loadPackFile(path string) (*userPack, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file packFileJSON
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("not valid JSON: %w", err)
+	}
+	return file.toPack()
+}
+
+// toPack checks the pack and converts it, qualifying every ID with the pack's
+func (f *packFileJSON) toPack() (*userPack, error) {
+	if !packIDPattern.MatchString(f.ID) {
+		return nil, fmt.Errorf("id %q must be lowercase letters, digits, - or _", f.ID)
+	}
+	if f.Name == "" {
+		return nil, errors.New("name is missing")
+	}
+	if len(f.Lessons) == 0 {
+		return nil, errors.New("a pack needs at least one lesson")
+	}
+
+	module := ModuleID(PackModulePrefix + f.ID)
+	pack := &userPack{
+		info: ModuleInfo{
+			ID:          module,
+			Name:        f.Name,
+			Icon:        f.Icon,
+			Description: f.Description,
+			UserPack:    true,
+		},
+	}
+	if pack.info.Icon == "" {
+		pack.info.Icon = "🧩"
+	}
+
+	seen := make(map[string]bool)
+	convert := func(section string, ex packExerciseJSON, kind ExerciseType) (Exercise, error) {
+		exercise, err := ex.toExercise(module, kind)
+		if err != nil {
+			return exercise, fmt.Errorf("%s %q: %w", section, ex.ID, err)
+		}
+		if seen[exercise.ID] {
+			return exercise, fmt.Errorf("%s: exercise id %q is used twice", section, ex.ID)
+		}
+		seen[exercise.ID] = true
+		return exercise, nil
+	}
+
+	for _, ex := range f.Lessons {
+		exercise, err := convert("lessons", ex, ExerciseLesson)
+		if err != nil {
+			return nil, err
+		}
+		pack.lessons = append(pack.lessons, exercise)
+	}
+	for _, ex := range f.Practice {
+		exercise, err := convert("practice", ex, ExercisePractice)
+		if err != nil {
+			return nil, err
+		}
+		pack.practice = append(pack.practice, exercise)
+	}
+
+	if b := f.Boss; b != nil {
+		if len(b.Steps) == 0 {
+			return nil, errors.New("boss has no steps")
+		}
+		boss := &BossExercise{
+			ID:        string(module) + "/boss",
+			Module:    module,
+			Name:      b.Name,
+			Lives:     b.Lives,
+			BonusTime: b.BonusTime,
+		}
+		if boss.Name == "" {
+			boss.Name = f.Name + " Boss"
+		}
+		if boss.Lives <= 0 {
+			boss.Lives = packDefaultLives
+		}
+		for _, step := range b.Steps {
+			exercise, err := convert("boss", step.packExerciseJSON, ExerciseBoss)
+			if err != nil {
+				return nil, err
+			}
+			boss.Steps = append(boss.Steps, BossStep{Exercise: exercise, TimeLimit: step.TimeLimit})
+		}
+		pack.boss = boss
+		pack.info.BossName = boss.Name
+	}
+	return pack, nil
+}
+
+// toExercise checks one exercise and converts it, filling in defaults
+func (e *packExerciseJSON) toExercise(module ModuleID, kind ExerciseType) (Exercise, error) {
+	switch {
+	case !packIDPattern.MatchString(e.ID):
+		return Exercise{}, errors.New("id must be lowercase letters, digits, - or _")
+	case e.Mission == "":
+		return Exercise{}, errors.New("mission is missing")
+	case len(e.Code) == 0:
+		return Exercise{}, errors.New("code is missing")
+	case len(e.Solutions) == 0:
+		return Exercise{}, errors.New("solutions are missing")
+	case e.Cursor.Line < 0 || e.Cursor.Line >= len(e.Code) || e.Cursor.Col < 0:
+		return Exercise{}, fmt.Errorf("cursor line %d is outside the code", e.Cursor.Line)
+	}
+	optimal := e.Optimal
+	if optimal == "" {
+		optimal = e.Solutions[0]
+	} else if !slices.Contains(e.Solutions, optimal) {
+		return Exercise{}, fmt.Errorf("optimal %q is not one of the solutions", optimal)
+	}
+
+	exercise := Exercise{
+		ID:          string(module) + "/" + e.ID,
+		Module:      module,
+		Level:       max(e.Level, 1),
+		Type:        kind,
+		Code:        e.Code,
+		CursorPos:   Position{Line: e.Cursor.Line, Col: e.Cursor.Col},
+		Mission:     e.Mission,
+		Solutions:   e.Solutions,
+		Optimal:     optimal,
+		Hint:        e.Hint,
+		Explanation: e.Explanation,
+		TimeoutSecs: e.TimeoutSecs,
+		Points:      e.Points,
+	}
+	if e.Target != nil {
+		exercise.CursorTarget = &Position{Line: e.Target.Line, Col: e.Target.Col}
+	}
+	if exercise.TimeoutSecs <= 0 {
+		exercise.TimeoutSecs = packDefaultTimeout
+	}
+	if exercise.Points <= 0 {
+		exercise.Points = packDefaultPoints
+	}
+	return exercise, nil
+}
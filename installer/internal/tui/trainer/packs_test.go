@@ -0,0 +1,157 @@
+package trainer
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// packsDir points the pack loader at a temp dir holding files, and unloads
+// the packs when the test ends
+func packsDir(t *testing.T, files map[string]string) {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	original := trainerPacksPath
+	trainerPacksPath = dir
+	t.Cleanup(func() {
+		trainerPacksPath = original
+		userPacks = nil
+	})
+}
+
+const validPack = `{
+  "id": "harpoon",
+  "name": "Harpoon",
+  "lessons": [{"id": "mark", "code": ["x"], "mission": "Mark the file", "solutions": ["<leader>a"]}],
+  "practice": [{"id": "jump", "code": ["x"], "mission": "Jump to mark 1", "solutions": ["<C-h>", " 1"], "optimal": "<C-h>"}],
+  "boss": {"steps": [{"id": "boss-1", "code": ["x"], "mission": "Mark it", "solutions": ["<leader>a"]}]}
+}`
+
+func TestLoadUserPacks(t *testing.T) {
+	packsDir(t, map[string]string{"harpoon.json": validPack})
+	if errs := LoadUserPacks(); len(errs) != 0 {
+		t.Fatalf("a valid pack should load, got %v", errs)
+	}
+
+	module := ModuleID("pack:harpoon")
+	modules := GetAllModules()
+	last := modules[len(modules)-1]
+	if len(modules) != len(moduleUnlockOrder)+1 || last.ID != module || !last.UserPack {
+		t.Fatalf("the pack should follow the built-in modules, got %+v", last)
+	}
+	if last.Icon == "" || last.BossName != "Harpoon Boss" {
+		t.Errorf("missing icon and boss name should get defaults, got %q and %q", last.Icon, last.BossName)
+	}
+
+	lessons := GetLessons(module)
+	if len(lessons) != 1 || lessons[0].ID != "pack:harpoon/mark" || lessons[0].Optimal != "<leader>a" || lessons[0].Points != packDefaultPoints {
+		t.Errorf("lessons should be qualified and defaulted, got %+v", lessons)
+	}
+	if practice := GetPracticeExercises(module); len(practice) != 1 || practice[0].Type != ExercisePractice {
+		t.Errorf("practice should load, got %+v", practice)
+	}
+	if boss := GetBoss(module); boss == nil || boss.Lives != packDefaultLives || boss.Steps[0].Exercise.Module != module {
+		t.Errorf("the boss should load with default lives, got %+v", boss)
+	}
+
+	stats := NewUserStats()
+	if !stats.IsModuleUnlocked(module) {
+		t.Error("packs should be unlocked from the start")
+	}
+	if FindExercise("pack:harpoon/jump") == nil {
+		t.Error("pack exercises should be found for review")
+	}
+
+	// Keymaps don't move the cursor: only the listed solutions count
+	ex := &lessons[0]
+	if !ValidateAnswerDetailed(ex, "<leader>a").IsCorrect || ValidateAnswerDetailed(ex, "w").IsCorrect {
+		t.Error("pack answers should be checked against their solutions")
+	}
+}
+
+func TestLoadUserPacksSkipsBadFiles(t *testing.T) {
+	dupPack := strings.Replace(validPack, `"id": "jump"`, `"id": "mark"`, 1)
+	noSolutions := strings.Replace(validPack, `"Mark the file", "solutions": ["<leader>a"]`, `"Mark the file"`, 1)
+	badOptimal := strings.Replace(validPack, `"optimal": "<C-h>"`, `"optimal": "<C-j>"`, 1)
+	packsDir(t, map[string]string{
+		"a-harpoon.json":   validPack,
+		"b-again.json":     validPack,
+		"c-broken.json":    `{"id": "broken", `,
+		"d-dup.json":       strings.Replace(dupPack, "harpoon", "dup", 1),
+		"e-nosol.json":     strings.Replace(noSolutions, "harpoon", "nosol", 1),
+		"f-optimal.json":   strings.Replace(badOptimal, "harpoon", "optimal", 1),
+		"g-bad-id.json":    strings.Replace(validPack, "harpoon", "Bad ID", 1),
+		"h-no-lesson.json": `{"id": "empty", "name": "Empty"}`,
+		"notes.txt":        "not a pack",
+	})
+
+	errs := LoadUserPacks()
+	want := []string{
+		"b-again.json: pack id \"harpoon\" is already used",
+		"c-broken.json: not valid JSON",
+		"d-dup.json: practice: exercise id \"mark\" is used twice",
+		"e-nosol.json: lessons \"mark\": solutions are missing",
+		"f-optimal.json: practice \"jump\": optimal \"<C-j>\" is not one of the solutions",
+		"g-bad-id.json: id \"Bad ID\"",
+		"h-no-lesson.json: a pack needs at least one lesson",
+	}
+	if len(errs) != len(want) {
+		t.Fatalf("got %d errors, want %d: %v", len(errs), len(want), errs)
+	}
+	for i, err := range errs {
+		if !strings.HasPrefix(err.Error(), want[i]) {
+			t.Errorf("error %d = %q, want it to start with %q", i, err, want[i])
+		}
+	}
+	if modules := GetAllModules(); len(modules) != len(moduleUnlockOrder)+1 {
+		t.Errorf("only the valid pack should load, got %d modules", len(modules))
+	}
+}
+
+func TestExamplePackLoads(t *testing.T) {
+	example, err := os.ReadFile(filepath.Join("..", "..", "..", "..", "docs", "trainer-pack-example.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	packsDir(t, map[string]string{"telescope.json": string(example)})
+	if errs := LoadUserPacks(); len(errs) != 0 {
+		t.Fatalf("the documented example should load, got %v", errs)
+	}
+	if len(GetLessons("pack:telescope")) == 0 || GetBoss("pack:telescope") == nil {
+		t.Error("the example should have lessons and a boss")
+	}
+}
+
+func TestPackStatsStayApart(t *testing.T) {
+	originalPath := statsConfigPath
+	statsConfigPath = t.TempDir()
+	defer func() { statsConfigPath = originalPath }()
+	packsDir(t, map[string]string{"harpoon.json": validPack})
+	LoadUserPacks()
+
+	stats := NewUserStats()
+	stats.GetModuleProgress(ModuleHorizontal).LessonsCompleted = 3
+	stats.GetModuleProgress("pack:harpoon").LessonsCompleted = 1
+	if err := SaveStats(stats); err != nil {
+		t.Fatal(err)
+	}
+
+	// With the pack removed, its progress is left aside and the built-in modules are untouched
+	userPacks = nil
+	loaded := LoadStats()
+	if loaded.GetModuleProgress(ModuleHorizontal).LessonsCompleted != 3 {
+		t.Error("built-in progress should survive removing a pack")
+	}
+	if loaded.IsModuleUnlocked("pack:harpoon") {
+		t.Error("a removed pack should not be unlocked")
+	}
+	if len(GetAllModules()) != len(moduleUnlockOrder) {
+		t.Error("a removed pack should leave the menu")
+	}
+}
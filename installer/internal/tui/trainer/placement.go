@@ -0,0 +1,122 @@
+package trainer
+
+import "time"
+
+// placementExerciseIDs is the placement test, easiest first. Each entry is a
+// lesson ID; the test draws one exercise from each of the early modules so
+// experienced users can skip ahead without grinding every boss.
+var placementExerciseIDs = []string{
+	"horizontal_005",
+	"vertical_005",
+	"textobjects_003",
+	"changerepeat_003",
+	"substitution_003",
+}
+
+// PlacementLevel maps a minimum placement accuracy to the furthest module it unlocks
+type PlacementLevel struct {
+	MinAccuracy   float64
+	UnlockThrough ModuleID
+}
+
+// placementLevels is checked from the top; the first level reached wins
+var placementLevels = []PlacementLevel{
+	{MinAccuracy: 1.0, UnlockThrough: ModuleSubstitution},
+	{MinAccuracy: 0.8, UnlockThrough: ModuleChangeRepeat},
+	{MinAccuracy: 0.6, UnlockThrough: ModuleTextObjects},
+	{MinAccuracy: 0.4, UnlockThrough: ModuleVertical},
+}
+
+// PlacementResult records the outcome of the placement test
+type PlacementResult struct {
+	Correct         int
+	Total           int
+	UnlockedThrough ModuleID // Empty when nothing was unlocked
+	TakenAt         time.Time
+}
+
+// GetPlacementExercises returns the placement test exercises in order
+func GetPlacementExercises() []Exercise {
+	var exercises []Exercise
+	for _, id := range placementExerciseIDs {
+		if ex := findLesson(id); ex != nil {
+			exercises = append(exercises, *ex)
+		}
+	}
+	return exercises
+}
+
+// findLesson looks up a lesson by ID across all modules
+func findLesson(id string) *Exercise {
+	for _, module := range moduleUnlockOrder {
+		for _, ex := range GetLessons(module) {
+			if ex.ID == id {
+				return &ex
+			}
+		}
+	}
+	return nil
+}
+
+// PlacementUnlockThrough returns the furthest module a placement score unlocks,
+// or "" when the score is too low to skip anything
+func PlacementUnlockThrough(correct, total int) ModuleID {
+	if total <= 0 {
+		return ""
+	}
+	accuracy := float64(correct) / float64(total)
+	for _, level := range placementLevels {
+		if accuracy >= level.MinAccuracy {
+			return level.UnlockThrough
+		}
+	}
+	return ""
+}
+
+// ApplyPlacement records a placement result and unlocks modules up to the
+// level it demonstrates. Modules before the target get their lessons marked
+// complete with synthetic records flagged as Placement. Existing progress is
+// never lowered, and practice counters and score are left untouched so
+// placement answers stay out of accuracy stats.
+func (s *UserStats) ApplyPlacement(correct, total int, takenAt time.Time) ModuleID {
+	target := PlacementUnlockThrough(correct, total)
+	s.Placement = &PlacementResult{
+		Correct:         correct,
+		Total:           total,
+		UnlockedThrough: target,
+		TakenAt:         takenAt,
+	}
+	if target == "" {
+		return ""
+	}
+
+	for _, module := range moduleUnlockOrder {
+		progress := s.GetModuleProgress(module)
+		progress.Placement = true
+		if module == target {
+			break
+		}
+		lessons := len(GetLessons(module))
+		if progress.LessonsTotal < lessons {
+			progress.LessonsTotal = lessons
+		}
+		if progress.LessonsCompleted < progress.LessonsTotal {
+			progress.LessonsCompleted = progress.LessonsTotal
+		}
+	}
+	return target
+}
+
+// OverallPracticeAccuracy is the practice accuracy across all modules.
+// Placement answers are never recorded as practice, so they don't count.
+func (s *UserStats) OverallPracticeAccuracy() float64 {
+	attempts, correct := 0, 0
+	for _, progress := range s.ModuleProgress {
+		attempts += progress.PracticeAttempts
+		correct += progress.PracticeCorrect
+	}
+	if attempts == 0 {
+		return 0
+	}
+	return float64(correct) / float64(attempts)
+}
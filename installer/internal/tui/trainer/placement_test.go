@@ -0,0 +1,188 @@
+package trainer
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+// =============================================================================
+// PLACEMENT TEST
+// =============================================================================
+
+func TestGetPlacementExercises_ResolvesEveryID(t *testing.T) {
+	exercises := GetPlacementExercises()
+	if len(exercises) != len(placementExerciseIDs) {
+		t.Fatalf("Expected %d placement exercises, got %d", len(placementExerciseIDs), len(exercises))
+	}
+
+	seen := make(map[ModuleID]bool)
+	for i, ex := range exercises {
+		if ex.ID != placementExerciseIDs[i] {
+			t.Errorf("Exercise %d: expected %s, got %s", i, placementExerciseIDs[i], ex.ID)
+		}
+		if seen[ex.Module] {
+			t.Errorf("Module %s appears twice; placement should span modules", ex.Module)
+		}
+		seen[ex.Module] = true
+	}
+}
+
+func TestPlacementLevels_TargetsAreInUnlockOrder(t *testing.T) {
+	for i, level := range placementLevels {
+		found := false
+		for _, m := range moduleUnlockOrder {
+			if m == level.UnlockThrough {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("Level %d unlocks unknown module %s", i, level.UnlockThrough)
+		}
+		if i > 0 && level.MinAccuracy >= placementLevels[i-1].MinAccuracy {
+			t.Errorf("Levels must be sorted by descending accuracy (level %d)", i)
+		}
+	}
+}
+
+func TestPlacementUnlockThrough(t *testing.T) {
+	tests := []struct {
+		correct, total int
+		want           ModuleID
+	}{
+		{0, 5, ""},
+		{1, 5, ""},
+		{2, 5, ModuleVertical},
+		{3, 5, ModuleTextObjects},
+		{4, 5, ModuleChangeRepeat},
+		{5, 5, ModuleSubstitution},
+		{0, 0, ""},
+	}
+	for _, tt := range tests {
+		if got := PlacementUnlockThrough(tt.correct, tt.total); got != tt.want {
+			t.Errorf("PlacementUnlockThrough(%d, %d) = %q, want %q", tt.correct, tt.total, got, tt.want)
+		}
+	}
+}
+
+func TestApplyPlacement_UnlocksUpToTarget(t *testing.T) {
+	stats := NewUserStats()
+	takenAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	target := stats.ApplyPlacement(4, 5, takenAt)
+	if target != ModuleChangeRepeat {
+		t.Fatalf("Expected cgn target, got %s", target)
+	}
+
+	for _, m := range []ModuleID{ModuleHorizontal, ModuleVertical, ModuleTextObjects, ModuleChangeRepeat} {
+		if !stats.IsModuleUnlocked(m) {
+			t.Errorf("%s should be unlocked by placement", m)
+		}
+	}
+	for _, m := range []ModuleID{ModuleSubstitution, ModuleRegex, ModuleMacros} {
+		if stats.IsModuleUnlocked(m) {
+			t.Errorf("%s should stay locked", m)
+		}
+	}
+
+	// Modules below the target get synthetic completed lessons
+	for _, m := range []ModuleID{ModuleHorizontal, ModuleVertical, ModuleTextObjects} {
+		if !stats.IsLessonsComplete(m) || !stats.GetModuleProgress(m).Placement {
+			t.Errorf("%s lessons should be complete and marked as placement", m)
+		}
+	}
+	if stats.IsLessonsComplete(ModuleChangeRepeat) {
+		t.Error("Target module lessons should still need to be played")
+	}
+
+	if stats.Placement == nil || stats.Placement.Correct != 4 || stats.Placement.Total != 5 ||
+		stats.Placement.UnlockedThrough != ModuleChangeRepeat || !stats.Placement.TakenAt.Equal(takenAt) {
+		t.Errorf("Unexpected placement record: %+v", stats.Placement)
+	}
+}
+
+func TestApplyPlacement_KeepsExistingProgressAndAccuracy(t *testing.T) {
+	stats := NewUserStats()
+	progress := stats.GetModuleProgress(ModuleHorizontal)
+	progress.LessonsTotal = 99
+	progress.LessonsCompleted = 99
+	progress.PracticeAttempts = 10
+	progress.PracticeCorrect = 7
+	progress.PracticeAccuracy = 0.7
+	stats.TotalScore = 120
+
+	stats.ApplyPlacement(5, 5, time.Now())
+
+	if progress.LessonsCompleted != 99 || progress.LessonsTotal != 99 {
+		t.Errorf("Existing lesson progress should not be lowered, got %d/%d", progress.LessonsCompleted, progress.LessonsTotal)
+	}
+	if got := stats.OverallPracticeAccuracy(); got != 0.7 {
+		t.Errorf("Placement should not affect aggregate accuracy, got %.2f", got)
+	}
+	if stats.TotalScore != 120 {
+		t.Errorf("Placement should not award score, got %d", stats.TotalScore)
+	}
+}
+
+func TestApplyPlacement_LowScoreUnlocksNothing(t *testing.T) {
+	stats := NewUserStats()
+	if target := stats.ApplyPlacement(1, 5, time.Now()); target != "" {
+		t.Errorf("Expected no unlock, got %s", target)
+	}
+	if stats.IsModuleUnlocked(ModuleVertical) {
+		t.Error("Vertical should stay locked after a low placement score")
+	}
+	if stats.Placement == nil {
+		t.Error("Placement attempt should still be recorded")
+	}
+}
+
+func TestPlacementMode_KeepsScoreAndLessonsUntouched(t *testing.T) {
+	g := NewGameState()
+	g.StartPlacement()
+	if !g.IsPlacementMode || g.CurrentExercise == nil || g.CurrentModule != ModuleHorizontal {
+		t.Fatal("Placement should start on the first exercise")
+	}
+
+	g.RecordCorrectAnswer(10.0, true)
+	if !g.NextExercise() || g.CurrentModule != ModuleVertical {
+		t.Errorf("Expected to advance to vertical, got %s", g.CurrentModule)
+	}
+	g.RecordIncorrectAnswer()
+
+	if g.PlacementCorrect != 1 {
+		t.Errorf("Expected 1 correct placement answer, got %d", g.PlacementCorrect)
+	}
+	if g.Stats.TotalScore != 0 || g.Stats.GetModuleProgress(ModuleHorizontal).LessonsCompleted != 0 {
+		t.Error("Placement answers should not touch score or lesson progress")
+	}
+}
+
+func TestSaveAndLoadStats_Placement(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "trainer-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	originalPath := statsConfigPath
+	statsConfigPath = tempDir
+	defer func() { statsConfigPath = originalPath }()
+
+	stats := NewUserStats()
+	stats.ApplyPlacement(3, 5, time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC))
+	if err := SaveStats(stats); err != nil {
+		t.Fatalf("SaveStats failed: %v", err)
+	}
+
+	loaded := LoadStats()
+	if loaded == nil || loaded.Placement == nil {
+		t.Fatal("Placement record should round-trip")
+	}
+	if loaded.Placement.UnlockedThrough != ModuleTextObjects || loaded.Placement.Correct != 3 {
+		t.Errorf("Unexpected placement after load: %+v", loaded.Placement)
+	}
+	if !loaded.IsModuleUnlocked(ModuleTextObjects) || !loaded.GetModuleProgress(ModuleVertical).Placement {
+		t.Error("Placement unlocks should survive a reload")
+	}
+}
@@ -0,0 +1,148 @@
+package trainer
+
+import "slices"
+
+// ReviewClearStreak is how many optimal answers in a row take an exercise
+// off the review list
+const ReviewClearStreak = 2
+
+// RecordMistake puts an exercise on the review list, restarting its run of
+// optimal answers
+func (s *UserStats) RecordMistake(exerciseID string) {
+	if exerciseID == "" {
+		return
+	}
+	if s.ReviewMistakes == nil {
+		s.ReviewMistakes = make(map[string]int)
+	}
+	s.ReviewMistakes[exerciseID] = 0
+	if !slices.Contains(s.SessionMistakes, exerciseID) {
+		s.SessionMistakes = append(s.SessionMistakes, exerciseID)
+	}
+}
+
+// RecordReviewAnswer counts a reviewed answer. Optimal answers extend the run
+// and the exercise leaves the list at ReviewClearStreak, which is reported;
+// anything else restarts the run.
+func (s *UserStats) RecordReviewAnswer(exerciseID string, isOptimal bool) bool {
+	if _, ok := s.ReviewMistakes[exerciseID]; !ok {
+		return false
+	}
+	if !isOptimal {
+		s.ReviewMistakes[exerciseID] = 0
+		return false
+	}
+	s.ReviewMistakes[exerciseID]++
+	if s.ReviewMistakes[exerciseID] >= ReviewClearStreak {
+		delete(s.ReviewMistakes, exerciseID)
+		return true
+	}
+	return false
+}
+
+// ReviewCount is the number of exercises waiting for review
+func (s *UserStats) ReviewCount() int {
+	return len(s.ReviewMistakes)
+}
+
+// FindExercise looks up a lesson, practice or boss exercise by ID
+func FindExercise(id string) *Exercise {
+	for _, module := range allModuleIDs() {
+		for _, exercise := range moduleExercises(module) {
+			if exercise.ID == id {
+				return &exercise
+			}
+		}
+	}
+	return nil
+}
+
+// moduleExercises lists every exercise of a module: lessons, practice and boss steps
+func moduleExercises(module ModuleID) []Exercise {
+	pool := append(GetLessons(module), GetPracticeExercises(module)...)
+	if boss := GetBoss(module); boss != nil {
+		for _, step := range boss.Steps {
+			pool = append(pool, step.Exercise)
+		}
+	}
+	return pool
+}
+
+// ReviewExercises returns the exercises on the review list: the ones missed
+// in this session first, then the rest in module order. IDs that no longer
+// name an exercise are dropped from the list.
+func (g *GameState) ReviewExercises() []Exercise {
+	var session, earlier []Exercise
+	found := make(map[string]bool)
+	for _, module := range allModuleIDs() {
+		for _, exercise := range moduleExercises(module) {
+			if _, ok := g.Stats.ReviewMistakes[exercise.ID]; !ok || found[exercise.ID] {
+				continue
+			}
+			found[exercise.ID] = true
+			if slices.Contains(g.Stats.SessionMistakes, exercise.ID) {
+				session = append(session, exercise)
+			} else {
+				earlier = append(earlier, exercise)
+			}
+		}
+	}
+	for id := range g.Stats.ReviewMistakes {
+		if !found[id] {
+			delete(g.Stats.ReviewMistakes, id)
+		}
+	}
+	return append(session, earlier...)
+}
+
+// RecordMistake notes a wrong answer to the current exercise for review
+func (g *GameState) RecordMistake() {
+	if g.CurrentExercise != nil {
+		g.Stats.RecordMistake(g.CurrentExercise.ID)
+	}
+}
+
+// StartReview drills the exercises on the review list. It returns false,
+// leaving the state alone, when the list is empty.
+func (g *GameState) StartReview() bool {
+	pool := g.ReviewExercises()
+	if len(pool) == 0 {
+		return false
+	}
+	g.IsLessonMode = false
+	g.IsPracticeMode = false
+	g.IsBossMode = false
+	g.IsPlacementMode = false
+	g.IsTimedMode = false
+	g.IsReviewMode = true
+	g.Exercises = pool
+	g.ExerciseIndex = 0
+	g.CurrentStreak = 0
+	g.ComboMultiplier = 1
+	g.showReviewExercise()
+	return true
+}
+
+// NextReviewExercise moves to the next exercise still on the review list,
+// going round the drill until it is empty. It returns false once it is.
+func (g *GameState) NextReviewExercise() bool {
+	if !g.IsReviewMode {
+		return false
+	}
+	for step := 1; step <= len(g.Exercises); step++ {
+		i := (g.ExerciseIndex + step) % len(g.Exercises)
+		if _, ok := g.Stats.ReviewMistakes[g.Exercises[i].ID]; ok {
+			g.ExerciseIndex = i
+			g.showReviewExercise()
+			return true
+		}
+	}
+	return false
+}
+
+// showReviewExercise shows the exercise at ExerciseIndex
+func (g *GameState) showReviewExercise() {
+	g.CurrentExercise = &g.Exercises[g.ExerciseIndex]
+	g.CurrentModule = g.CurrentExercise.Module
+	g.markExerciseShown()
+}
@@ -0,0 +1,128 @@
+package trainer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRecordReviewAnswerNeedsTwoOptimalInARow(t *testing.T) {
+	stats := NewUserStats()
+	stats.RecordMistake("a")
+
+	if stats.RecordReviewAnswer("a", true) {
+		t.Fatal("one optimal answer should not clear the mistake")
+	}
+	stats.RecordReviewAnswer("a", false)
+	if stats.ReviewMistakes["a"] != 0 {
+		t.Fatalf("a non-optimal answer should restart the run, got %d", stats.ReviewMistakes["a"])
+	}
+	stats.RecordReviewAnswer("a", true)
+	if !stats.RecordReviewAnswer("a", true) || stats.ReviewCount() != 0 {
+		t.Errorf("two optimal answers in a row should clear it, left %v", stats.ReviewMistakes)
+	}
+	if stats.RecordReviewAnswer("b", true) {
+		t.Error("an exercise that was never missed is not reviewed")
+	}
+}
+
+func TestWrongAnswersGoOnTheReviewList(t *testing.T) {
+	state := NewGameState()
+	state.StartLesson(ModuleHorizontal)
+	missed := state.CurrentExercise.ID
+	state.RecordIncorrectAnswer()
+	if _, ok := state.Stats.ReviewMistakes[missed]; !ok {
+		t.Fatalf("a lesson mistake should be listed, got %v", state.Stats.ReviewMistakes)
+	}
+
+	state.StartPlacement()
+	state.RecordIncorrectAnswer()
+	if state.Stats.ReviewCount() != 1 {
+		t.Errorf("placement answers are not reviewed, got %v", state.Stats.ReviewMistakes)
+	}
+
+	state.StartTimed()
+	timedMiss := state.CurrentExercise.ID
+	state.RecordTimedAnswer(false, false)
+	if _, ok := state.Stats.ReviewMistakes[timedMiss]; !ok {
+		t.Errorf("a Speed Run mistake should be listed, got %v", state.Stats.ReviewMistakes)
+	}
+	if state.Stats.SessionMistakes[0] != missed {
+		t.Errorf("session mistakes should keep their order, got %v", state.Stats.SessionMistakes)
+	}
+}
+
+func TestReviewDrill(t *testing.T) {
+	lessons := GetLessons(ModuleHorizontal)
+	stats := NewUserStats()
+	stats.ReviewMistakes = map[string]int{lessons[0].ID: 0, "gone_001": 0}
+	stats.RecordMistake(lessons[1].ID)
+
+	state := NewGameStateWithStats(stats)
+	if !state.StartReview() || !state.IsReviewMode {
+		t.Fatal("StartReview should start with mistakes listed")
+	}
+	if _, ok := stats.ReviewMistakes["gone_001"]; ok {
+		t.Error("IDs of exercises that no longer exist should be dropped")
+	}
+	if state.CurrentExercise.ID != lessons[1].ID || len(state.Exercises) != 2 {
+		t.Fatalf("this session's mistake should come first, got %s of %d", state.CurrentExercise.ID, len(state.Exercises))
+	}
+
+	// Answer each exercise optimally until the list is empty
+	for answers := 0; ; answers++ {
+		if answers > 4 {
+			t.Fatalf("the drill should end after four optimal answers, left %v", stats.ReviewMistakes)
+		}
+		state.RecordCorrectAnswer(1, true)
+		if !state.NextReviewExercise() {
+			if answers != 3 {
+				t.Errorf("the drill ended after %d answers, want 4", answers+1)
+			}
+			break
+		}
+	}
+	if stats.ReviewCount() != 0 {
+		t.Errorf("the review list should be empty, got %v", stats.ReviewMistakes)
+	}
+	if NewGameStateWithStats(stats).StartReview() {
+		t.Error("StartReview should refuse an empty list")
+	}
+}
+
+func TestReviewMistakesPersistAndMerge(t *testing.T) {
+	originalPath := statsConfigPath
+	statsConfigPath = t.TempDir()
+	defer func() { statsConfigPath = originalPath }()
+
+	stats := NewUserStats()
+	stats.ReviewMistakes = map[string]int{"horizontal_001": 1}
+	if err := SaveStats(stats); err != nil {
+		t.Fatal(err)
+	}
+	loaded := LoadStats()
+	if loaded == nil || loaded.ReviewMistakes["horizontal_001"] != 1 {
+		t.Fatalf("ReviewMistakes should survive a save, got %+v", loaded)
+	}
+
+	other := NewUserStats()
+	other.ReviewMistakes = map[string]int{"horizontal_001": 0, "vertical_001": 1}
+	stats.Merge(other)
+	if stats.ReviewMistakes["horizontal_001"] != 0 || stats.ReviewMistakes["vertical_001"] != 1 {
+		t.Errorf("merging should keep every mistake and the shorter run, got %v", stats.ReviewMistakes)
+	}
+
+	// Files from before the review list load with an empty one
+	old := `{"totalScore": 40, "modules": {}}`
+	if err := os.WriteFile(filepath.Join(statsConfigPath, statsFileName), []byte(old), 0644); err != nil {
+		t.Fatal(err)
+	}
+	loaded = LoadStats()
+	if loaded == nil || loaded.TotalScore != 40 || loaded.ReviewCount() != 0 {
+		t.Fatalf("an old stats file should load without a review list, got %+v", loaded)
+	}
+	loaded.RecordMistake("horizontal_001")
+	if loaded.ReviewCount() != 1 {
+		t.Error("a stats file without a review list should still record mistakes")
+	}
+}
@@ -3,9 +3,12 @@ package trainer
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 	"time"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
 )
 
 // statsConfigPath is the directory for config files (can be overridden for testing)
@@ -22,6 +25,21 @@ type statsFileJSON struct {
 	LastPlayed       string                         `json:"lastPlayed"`
 	BossesDefeated   []string                       `json:"bossesDefeated"`
 	Modules          map[string]*moduleProgressJSON `json:"modules"`
+	Placement        *placementJSON                 `json:"placement,omitempty"`
+	BestTimes        map[string]float64             `json:"bestTimes,omitempty"`
+	LastPracticeDate string                         `json:"lastPracticeDate,omitempty"`
+	StreakDays       int                            `json:"streakDays,omitempty"`
+	LongestStreak    int                            `json:"longestStreakDays,omitempty"`
+	TotalAnswered    int                            `json:"totalAnswered,omitempty"`
+	BestTimedScore   int                            `json:"bestTimedScore,omitempty"`
+	ReviewMistakes   map[string]int                 `json:"reviewMistakes,omitempty"`
+}
+
+type placementJSON struct {
+	Correct         int    `json:"correct"`
+	Total           int    `json:"total"`
+	UnlockedThrough string `json:"unlockedThrough"`
+	TakenAt         string `json:"takenAt"`
 }
 
 type moduleProgressJSON struct {
@@ -34,9 +52,12 @@ type moduleProgressJSON struct {
 	BossBestTimeSeconds int64                         `json:"bossBestTimeSeconds"`
 	BossAttempts        int                           `json:"bossAttempts"`
 	BossLivesLeft       int                           `json:"bossLivesLeft"`
+	BossLastTimeSeconds int64                         `json:"bossLastTimeSeconds,omitempty"`
+	BossUnderPar        bool                          `json:"bossUnderPar,omitempty"`
 	ExerciseStats       map[string]*exerciseStatsJSON `json:"exerciseStats"`
 	WeakExercises       []string                      `json:"weakExercises"`
 	LastPracticed       string                        `json:"lastPracticed"`
+	Placement           bool                          `json:"placement,omitempty"`
 }
 
 type exerciseStatsJSON struct {
@@ -66,7 +87,7 @@ func getConfigDir() string {
 	if err != nil {
 		return ""
 	}
-	return filepath.Join(homeDir, ".config", "gentleman-trainer")
+	return paths.TrainerDir(homeDir)
 }
 
 // LoadStats loads user stats from file
@@ -85,8 +106,11 @@ func LoadStats() *UserStats {
 	if err := json.Unmarshal(data, &fileStats); err != nil {
 		return nil
 	}
+	return statsFromJSON(&fileStats)
+}
 
-	// Convert JSON struct to UserStats
+// statsFromJSON converts the file form of the stats to UserStats
+func statsFromJSON(fileStats *statsFileJSON) *UserStats {
 	stats := &UserStats{
 		TotalScore:     fileStats.TotalScore,
 		CurrentStreak:  fileStats.CurrentStreak,
@@ -94,6 +118,15 @@ func LoadStats() *UserStats {
 		TotalTime:      time.Duration(fileStats.TotalTimeSeconds) * time.Second,
 		ModuleProgress: make(map[ModuleID]*ModuleProgress),
 		BossesDefeated: make([]ModuleID, 0),
+		BestTimes:      fileStats.BestTimes,
+		// Older files have no daily streak; it starts at the next answer
+		LastPracticeDate:  fileStats.LastPracticeDate,
+		StreakDays:        fileStats.StreakDays,
+		LongestStreakDays: fileStats.LongestStreak,
+		TotalAnswered:     fileStats.TotalAnswered,
+		BestTimedScore:    fileStats.BestTimedScore,
+		// Older files have no review list; it fills with the next mistake
+		ReviewMistakes: fileStats.ReviewMistakes,
 	}
 
 	if fileStats.LastPlayed != "" {
@@ -104,6 +137,15 @@ func LoadStats() *UserStats {
 		stats.BossesDefeated = append(stats.BossesDefeated, ModuleID(boss))
 	}
 
+	if p := fileStats.Placement; p != nil {
+		stats.Placement = &PlacementResult{
+			Correct:         p.Correct,
+			Total:           p.Total,
+			UnlockedThrough: ModuleID(p.UnlockedThrough),
+		}
+		stats.Placement.TakenAt, _ = time.Parse(time.RFC3339, p.TakenAt)
+	}
+
 	for modID, modProgress := range fileStats.Modules {
 		mp := &ModuleProgress{
 			LessonsCompleted: modProgress.LessonsCompleted,
@@ -115,8 +157,11 @@ func LoadStats() *UserStats {
 			BossBestTime:     time.Duration(modProgress.BossBestTimeSeconds) * time.Second,
 			BossAttempts:     modProgress.BossAttempts,
 			BossLivesLeft:    modProgress.BossLivesLeft,
+			BossLastTime:     time.Duration(modProgress.BossLastTimeSeconds) * time.Second,
+			BossUnderPar:     modProgress.BossUnderPar,
 			ExerciseStats:    make(map[string]*ExerciseStats),
 			WeakExercises:    modProgress.WeakExercises,
+			Placement:        modProgress.Placement,
 		}
 		if modProgress.LastPracticed != "" {
 			mp.LastPracticed, _ = time.Parse(time.RFC3339, modProgress.LastPracticed)
@@ -155,13 +200,22 @@ func SaveStats(stats *UserStats) error {
 		return err
 	}
 
-	// Convert to JSON struct
+	data, err := json.MarshalIndent(statsToJSON(stats), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0644)
+}
+
+// statsToJSON converts stats to the form written to the stats file
+func statsToJSON(stats *UserStats) *statsFileJSON {
 	lastPlayed := ""
 	if !stats.LastPlayed.IsZero() {
 		lastPlayed = stats.LastPlayed.Format(time.RFC3339)
 	}
 
-	fileStats := statsFileJSON{
+	fileStats := &statsFileJSON{
 		TotalScore:       stats.TotalScore,
 		CurrentStreak:    stats.CurrentStreak,
 		BestStreak:       stats.BestStreak,
@@ -169,12 +223,28 @@ func SaveStats(stats *UserStats) error {
 		LastPlayed:       lastPlayed,
 		BossesDefeated:   make([]string, 0),
 		Modules:          make(map[string]*moduleProgressJSON),
+		BestTimes:        stats.BestTimes,
+		LastPracticeDate: stats.LastPracticeDate,
+		StreakDays:       stats.StreakDays,
+		LongestStreak:    stats.LongestStreakDays,
+		TotalAnswered:    stats.TotalAnswered,
+		BestTimedScore:   stats.BestTimedScore,
+		ReviewMistakes:   stats.ReviewMistakes,
 	}
 
 	for _, boss := range stats.BossesDefeated {
 		fileStats.BossesDefeated = append(fileStats.BossesDefeated, string(boss))
 	}
 
+	if p := stats.Placement; p != nil {
+		fileStats.Placement = &placementJSON{
+			Correct:         p.Correct,
+			Total:           p.Total,
+			UnlockedThrough: string(p.UnlockedThrough),
+			TakenAt:         p.TakenAt.Format(time.RFC3339),
+		}
+	}
+
 	for modID, modProgress := range stats.ModuleProgress {
 		lastPracticed := ""
 		if !modProgress.LastPracticed.IsZero() {
@@ -208,18 +278,16 @@ func SaveStats(stats *UserStats) error {
 			BossBestTimeSeconds: int64(modProgress.BossBestTime.Seconds()),
 			BossAttempts:        modProgress.BossAttempts,
 			BossLivesLeft:       modProgress.BossLivesLeft,
+			BossLastTimeSeconds: int64(modProgress.BossLastTime.Seconds()),
+			BossUnderPar:        modProgress.BossUnderPar,
 			ExerciseStats:       exerciseStats,
 			WeakExercises:       weakExercises,
 			LastPracticed:       lastPracticed,
+			Placement:           modProgress.Placement,
 		}
 	}
 
-	data, err := json.MarshalIndent(fileStats, "", "  ")
-	if err != nil {
-		return err
-	}
-
-	return os.WriteFile(path, data, 0644)
+	return fileStats
 }
 
 // ResetStats deletes the stats file
@@ -234,3 +302,32 @@ func ResetStats() error {
 	}
 	return err
 }
+
+// BackupAndResetStats moves the stats file aside to a timestamped .bak instead of
+// deleting it, so a full reset can be undone by renaming the file back.
+// Returns the backup path, or "" if there were no stats to back up.
+func BackupAndResetStats() (string, error) {
+	path := GetStatsPath()
+	if path == "" {
+		return "", errors.New("could not determine stats path")
+	}
+	if _, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	stamp := time.Now().Format("20060102-150405")
+	backup := path + "." + stamp + ".bak"
+	for i := 2; ; i++ {
+		if _, err := os.Stat(backup); os.IsNotExist(err) {
+			break
+		}
+		backup = fmt.Sprintf("%s.%s-%d.bak", path, stamp, i)
+	}
+	if err := os.Rename(path, backup); err != nil {
+		return "", err
+	}
+	return backup, nil
+}
@@ -3,6 +3,7 @@ package trainer
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 )
@@ -46,6 +47,9 @@ func TestSaveAndLoadStats_RoundTrip(t *testing.T) {
 	progress.BossLivesLeft = 2
 	progress.WeakExercises = []string{"horizontal_012", "horizontal_008"}
 	progress.LastPracticed = time.Date(2026, 1, 1, 15, 30, 0, 0, time.UTC)
+	stats.RecordBestTime("horizontal_001", 3.25)
+	stats.RecordPracticeDay(time.Date(2026, 1, 1, 9, 0, 0, 0, time.Local))
+	stats.RecordPracticeDay(time.Date(2026, 1, 2, 9, 0, 0, 0, time.Local))
 
 	// Save
 	err = SaveStats(stats)
@@ -96,6 +100,39 @@ func TestSaveAndLoadStats_RoundTrip(t *testing.T) {
 	if len(loadedProgress.WeakExercises) != 2 {
 		t.Errorf("WeakExercises: expected 2, got %d", len(loadedProgress.WeakExercises))
 	}
+	if best := loaded.BestTimes["horizontal_001"]; best != 3.25 {
+		t.Errorf("BestTimes: expected 3.25s for horizontal_001, got %v", best)
+	}
+	if loaded.LastPracticeDate != "2026-01-02" || loaded.StreakDays != 2 || loaded.LongestStreakDays != 2 || loaded.TotalAnswered != 2 {
+		t.Errorf("daily streak not round-tripped: %q, %d days, longest %d, %d answered",
+			loaded.LastPracticeDate, loaded.StreakDays, loaded.LongestStreakDays, loaded.TotalAnswered)
+	}
+}
+
+func TestLoadStats_OldFileWithoutDailyStreak(t *testing.T) {
+	tempDir := t.TempDir()
+	originalPath := statsConfigPath
+	statsConfigPath = tempDir
+	defer func() { statsConfigPath = originalPath }()
+
+	old := `{"totalScore": 120, "currentStreak": 3, "bestStreak": 5, "lastPlayed": "2025-12-01T10:00:00Z", "bossesDefeated": [], "modules": {}}`
+	if err := os.WriteFile(filepath.Join(tempDir, "stats.json"), []byte(old), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	stats := LoadStats()
+	if stats == nil {
+		t.Fatal("an old stats file should still load")
+	}
+	if stats.TotalScore != 120 || stats.LastPracticeDate != "" || stats.StreakDays != 0 || stats.TotalAnswered != 0 {
+		t.Errorf("old file should load with no daily streak, got %+v", stats)
+	}
+
+	// The first answer after upgrading starts the streak
+	stats.RecordPracticeDay(time.Date(2026, 1, 5, 9, 0, 0, 0, time.Local))
+	if stats.StreakDays != 1 || stats.LongestStreakDays != 1 {
+		t.Errorf("expected a fresh 1-day streak, got %d (longest %d)", stats.StreakDays, stats.LongestStreakDays)
+	}
 }
 
 func TestLoadStats_ReturnsNilWhenNoFile(t *testing.T) {
@@ -233,6 +270,60 @@ func TestResetStats_NoErrorWhenNoFile(t *testing.T) {
 	}
 }
 
+func TestBackupAndResetStats_RotatesFile(t *testing.T) {
+	tempDir := t.TempDir()
+	originalPath := statsConfigPath
+	statsConfigPath = tempDir
+	defer func() { statsConfigPath = originalPath }()
+
+	// Nothing to back up yet
+	backup, err := BackupAndResetStats()
+	if err != nil || backup != "" {
+		t.Fatalf("expected no backup without a stats file, got %q, %v", backup, err)
+	}
+
+	stats := NewUserStats()
+	stats.TotalScore = 100
+	if err := SaveStats(stats); err != nil {
+		t.Fatalf("SaveStats failed: %v", err)
+	}
+	first, err := BackupAndResetStats()
+	if err != nil {
+		t.Fatalf("BackupAndResetStats failed: %v", err)
+	}
+	if !strings.HasPrefix(filepath.Base(first), "stats.json.") || !strings.HasSuffix(first, ".bak") {
+		t.Errorf("unexpected backup name %q", first)
+	}
+	if _, err := os.Stat(filepath.Join(tempDir, "stats.json")); !os.IsNotExist(err) {
+		t.Error("stats file should be moved away")
+	}
+
+	// The backup still holds the old progress
+	statsConfigPath = t.TempDir()
+	data, err := os.ReadFile(first)
+	if err != nil {
+		t.Fatalf("backup unreadable: %v", err)
+	}
+	os.WriteFile(GetStatsPath(), data, 0644)
+	if restored := LoadStats(); restored == nil || restored.TotalScore != 100 {
+		t.Errorf("backup should restore the old stats, got %+v", restored)
+	}
+
+	// A second reset in the same second must not overwrite the first backup
+	statsConfigPath = tempDir
+	SaveStats(stats)
+	second, err := BackupAndResetStats()
+	if err != nil {
+		t.Fatalf("second BackupAndResetStats failed: %v", err)
+	}
+	if second == first {
+		t.Errorf("second backup reused %q", first)
+	}
+	if _, err := os.Stat(first); err != nil {
+		t.Errorf("first backup should survive: %v", err)
+	}
+}
+
 // =============================================================================
 // STATS FILE PATH
 // =============================================================================
@@ -248,3 +339,34 @@ func TestGetStatsPath_ReturnsValidPath(t *testing.T) {
 		// but in production it should be
 	}
 }
+
+func TestSaveAndLoadStats_BossTiming(t *testing.T) {
+	tempDir := t.TempDir()
+	originalPath := statsConfigPath
+	statsConfigPath = tempDir
+	defer func() { statsConfigPath = originalPath }()
+
+	stats := NewUserStats()
+	progress := stats.GetModuleProgress(ModuleRegex)
+	progress.BossBestTime = 41 * time.Second
+	progress.BossLastTime = 58 * time.Second
+	progress.BossUnderPar = true
+	if err := SaveStats(stats); err != nil {
+		t.Fatal(err)
+	}
+	loaded := LoadStats().GetModuleProgress(ModuleRegex)
+	if loaded.BossBestTime != 41*time.Second || loaded.BossLastTime != 58*time.Second || !loaded.BossUnderPar {
+		t.Errorf("boss timing not round-tripped: best %v, last %v, under par %v",
+			loaded.BossBestTime, loaded.BossLastTime, loaded.BossUnderPar)
+	}
+
+	// Files written before the boss timer load with no last time
+	old := `{"totalScore": 600, "bossesDefeated": ["horizontal"], "modules": {"horizontal": {"bossDefeated": true, "bossBestTimeSeconds": 27, "bossAttempts": 2, "bossLivesLeft": 1}}}`
+	if err := os.WriteFile(filepath.Join(tempDir, "stats.json"), []byte(old), 0644); err != nil {
+		t.Fatal(err)
+	}
+	oldProgress := LoadStats().GetModuleProgress(ModuleHorizontal)
+	if oldProgress.BossBestTime != 27*time.Second || oldProgress.BossLastTime != 0 || oldProgress.BossUnderPar {
+		t.Errorf("an old file should keep its best time only, got %+v", oldProgress)
+	}
+}
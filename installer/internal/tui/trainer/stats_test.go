@@ -252,3 +252,70 @@ func TestBossExercise_WithMultipleSteps(t *testing.T) {
 		t.Errorf("Boss should have 5 steps, got %d", len(boss.Steps))
 	}
 }
+
+// =============================================================================
+// USER STATS - Daily Streak
+// =============================================================================
+
+func TestUserStats_RecordPracticeDay(t *testing.T) {
+	day := func(d, hour int) time.Time { return time.Date(2026, 3, d, hour, 0, 0, 0, time.Local) }
+
+	tests := []struct {
+		name        string
+		answers     []time.Time
+		wantStreak  int
+		wantLongest int
+		wantDate    string
+	}{
+		{"first answer starts a streak", []time.Time{day(1, 9)}, 1, 1, "2026-03-01"},
+		{"same day is a no-op", []time.Time{day(1, 9), day(1, 13), day(1, 23)}, 1, 1, "2026-03-01"},
+		{"consecutive days increment", []time.Time{day(1, 23), day(2, 0), day(3, 18)}, 3, 3, "2026-03-03"},
+		{"a gap resets", []time.Time{day(1, 9), day(2, 9), day(4, 9)}, 1, 2, "2026-03-04"},
+		{"across a month", []time.Time{time.Date(2026, 2, 28, 9, 0, 0, 0, time.Local), day(1, 9)}, 2, 2, "2026-03-01"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats := NewUserStats()
+			for _, at := range tt.answers {
+				stats.RecordPracticeDay(at)
+			}
+			if stats.StreakDays != tt.wantStreak || stats.LongestStreakDays != tt.wantLongest {
+				t.Errorf("streak %d (longest %d), want %d (longest %d)", stats.StreakDays, stats.LongestStreakDays, tt.wantStreak, tt.wantLongest)
+			}
+			if stats.LastPracticeDate != tt.wantDate {
+				t.Errorf("LastPracticeDate = %q, want %q", stats.LastPracticeDate, tt.wantDate)
+			}
+			if stats.TotalAnswered != len(tt.answers) {
+				t.Errorf("TotalAnswered = %d, want %d", stats.TotalAnswered, len(tt.answers))
+			}
+		})
+	}
+}
+
+func TestUserStats_ActiveStreakDays(t *testing.T) {
+	stats := NewUserStats()
+	stats.RecordPracticeDay(time.Date(2026, 3, 1, 9, 0, 0, 0, time.Local))
+	stats.RecordPracticeDay(time.Date(2026, 3, 2, 9, 0, 0, 0, time.Local))
+
+	tests := []struct {
+		now       time.Time
+		want      int
+		practiced bool
+	}{
+		{time.Date(2026, 3, 2, 22, 0, 0, 0, time.Local), 2, true},
+		{time.Date(2026, 3, 3, 22, 0, 0, 0, time.Local), 2, false}, // Still alive until the day ends
+		{time.Date(2026, 3, 4, 0, 1, 0, 0, time.Local), 0, false},
+	}
+	for _, tt := range tests {
+		if got := stats.ActiveStreakDays(tt.now); got != tt.want {
+			t.Errorf("ActiveStreakDays(%s) = %d, want %d", tt.now.Format(time.DateTime), got, tt.want)
+		}
+		if got := stats.PracticedToday(tt.now); got != tt.practiced {
+			t.Errorf("PracticedToday(%s) = %v, want %v", tt.now.Format(time.DateTime), got, tt.practiced)
+		}
+	}
+
+	if NewUserStats().PracticedToday(time.Now()) || NewUserStats().ActiveStreakDays(time.Now()) != 0 {
+		t.Error("new stats have no streak")
+	}
+}
@@ -0,0 +1,142 @@
+package trainer
+
+import (
+	"math/rand"
+	"time"
+)
+
+// TimedRunLength is how long a Speed Run lasts
+const TimedRunLength = 60 * time.Second
+
+// Speed Run points per answer; wrong answers score nothing
+const (
+	TimedOptimalPoints = 10
+	TimedCorrectPoints = 5
+)
+
+// TimedPoints scores one Speed Run answer
+func TimedPoints(isCorrect, isOptimal bool) int {
+	switch {
+	case isCorrect && isOptimal:
+		return TimedOptimalPoints
+	case isCorrect:
+		return TimedCorrectPoints
+	}
+	return 0
+}
+
+// TimedExercises is the Speed Run pool: the lessons and practice exercises of
+// every unlocked module
+func TimedExercises(stats *UserStats) []Exercise {
+	var pool []Exercise
+	for _, module := range allModuleIDs() {
+		if stats.IsModuleUnlocked(module) {
+			pool = append(pool, GetLessons(module)...)
+			pool = append(pool, GetPracticeExercises(module)...)
+		}
+	}
+	return pool
+}
+
+// StartTimed starts a Speed Run with the countdown running. It returns false,
+// leaving the state alone, when no module has exercises.
+func (g *GameState) StartTimed() bool {
+	pool := TimedExercises(g.Stats)
+	if len(pool) == 0 {
+		return false
+	}
+	g.Reset()
+	g.IsTimedMode = true
+	g.Exercises = pool
+	g.TimedResumedAt = g.now()
+	g.nextTimedExercise()
+	return true
+}
+
+// nextTimedExercise shows a random exercise from the pool, never the same twice in a row
+func (g *GameState) nextTimedExercise() {
+	i := rand.Intn(len(g.Exercises))
+	if len(g.Exercises) > 1 && g.CurrentExercise != nil && g.Exercises[i].ID == g.CurrentExercise.ID {
+		i = (i + 1) % len(g.Exercises)
+	}
+	g.ExerciseIndex = i
+	g.CurrentExercise = &g.Exercises[i]
+	g.CurrentModule = g.CurrentExercise.Module
+	g.markExerciseShown()
+}
+
+// timedElapsed is the running time of the Speed Run, pauses excluded
+func (g *GameState) timedElapsed() time.Duration {
+	elapsed := g.TimedElapsed
+	if !g.TimedResumedAt.IsZero() {
+		elapsed += g.now().Sub(g.TimedResumedAt)
+	}
+	return elapsed
+}
+
+// TimedRemaining is what is left of the Speed Run countdown
+func (g *GameState) TimedRemaining() time.Duration {
+	if left := TimedRunLength - g.timedElapsed(); left > 0 {
+		return left
+	}
+	return 0
+}
+
+// TimedPaused reports whether the countdown is stopped
+func (g *GameState) TimedPaused() bool {
+	return g.IsTimedMode && g.TimedResumedAt.IsZero()
+}
+
+// PauseTimed stops the countdown until ResumeTimed
+func (g *GameState) PauseTimed() {
+	if g.TimedPaused() {
+		return
+	}
+	g.TimedElapsed = g.timedElapsed()
+	g.TimedResumedAt = time.Time{}
+}
+
+// ResumeTimed restarts a paused countdown where it stopped
+func (g *GameState) ResumeTimed() {
+	if g.TimedPaused() && !g.TimedFinished {
+		g.TimedResumedAt = g.now()
+		g.markExerciseShown()
+	}
+}
+
+// RecordTimedAnswer scores an answer to the current exercise and moves on to
+// the next one. It returns the points earned.
+func (g *GameState) RecordTimedAnswer(isCorrect, isOptimal bool) int {
+	g.Stats.RecordPracticeDay(g.now())
+	g.TimedAnswered++
+	points := TimedPoints(isCorrect, isOptimal)
+	if isCorrect {
+		g.TimedCorrect++
+	} else {
+		g.RecordMistake()
+	}
+	g.SessionScore += points
+	g.nextTimedExercise()
+	return points
+}
+
+// TimedAccuracy is the share of Speed Run answers that were correct
+func (g *GameState) TimedAccuracy() float64 {
+	if g.TimedAnswered == 0 {
+		return 0
+	}
+	return float64(g.TimedCorrect) / float64(g.TimedAnswered)
+}
+
+// FinishTimed ends the Speed Run: the score joins the total and becomes the
+// best Speed Run score when it beats it, which is reported
+func (g *GameState) FinishTimed() bool {
+	g.PauseTimed()
+	g.TimedFinished = true
+	g.Stats.TotalScore += g.SessionScore
+	if g.SessionScore > g.Stats.BestTimedScore {
+		g.Stats.BestTimedScore = g.SessionScore
+		return true
+	}
+	return false
+}
@@ -0,0 +1,128 @@
+package trainer
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimedPoints(t *testing.T) {
+	tests := []struct {
+		correct, optimal bool
+		want             int
+	}{
+		{true, true, 10},
+		{true, false, 5},
+		{false, false, 0},
+		{false, true, 0},
+	}
+	for _, tt := range tests {
+		if got := TimedPoints(tt.correct, tt.optimal); got != tt.want {
+			t.Errorf("TimedPoints(%v, %v) = %d, want %d", tt.correct, tt.optimal, got, tt.want)
+		}
+	}
+}
+
+func TestTimedExercisesUseUnlockedModules(t *testing.T) {
+	stats := NewUserStats()
+	for _, ex := range TimedExercises(stats) {
+		if ex.Module != ModuleHorizontal {
+			t.Fatalf("only the first module is unlocked, got %s", ex.ID)
+		}
+	}
+	stats.BossesDefeated = []ModuleID{ModuleHorizontal}
+	seen := map[ModuleID]bool{}
+	for _, ex := range TimedExercises(stats) {
+		seen[ex.Module] = true
+	}
+	if !seen[ModuleVertical] {
+		t.Error("beating a boss should add the next module to the pool")
+	}
+}
+
+func TestGameState_TimedRun(t *testing.T) {
+	state, clock := timedGameState()
+	if !state.StartTimed() {
+		t.Fatal("the first module should give a Speed Run")
+	}
+	first := state.CurrentExercise
+	if first == nil || state.TimedRemaining() != TimedRunLength {
+		t.Fatalf("the run should start on an exercise with the full minute, got %v", state.TimedRemaining())
+	}
+
+	clock.Advance(10)
+	if got := state.RecordTimedAnswer(true, true); got != 10 {
+		t.Errorf("an optimal answer should score 10, got %d", got)
+	}
+	if state.CurrentExercise == first {
+		t.Error("answering should move on to another exercise")
+	}
+	state.RecordTimedAnswer(true, false)
+	state.RecordTimedAnswer(false, false)
+	if state.SessionScore != 15 || state.TimedCorrect != 2 || state.TimedAnswered != 3 {
+		t.Errorf("score %d, %d/%d correct; want 15, 2/3", state.SessionScore, state.TimedCorrect, state.TimedAnswered)
+	}
+	if got := state.TimedAccuracy(); got < 0.66 || got > 0.67 {
+		t.Errorf("accuracy = %v, want 2/3", got)
+	}
+
+	// A pause stops the countdown
+	state.PauseTimed()
+	clock.Advance(300)
+	if got := state.TimedRemaining(); got != 50*time.Second {
+		t.Errorf("paused countdown = %v, want 50s", got)
+	}
+	state.ResumeTimed()
+	clock.Advance(20)
+	if got := state.TimedRemaining(); got != 30*time.Second {
+		t.Errorf("resumed countdown = %v, want 30s", got)
+	}
+	clock.Advance(45)
+	if got := state.TimedRemaining(); got != 0 {
+		t.Errorf("the countdown should stop at zero, got %v", got)
+	}
+}
+
+func TestGameState_FinishTimedKeepsBest(t *testing.T) {
+	state, _ := timedGameState()
+	state.Stats.TotalScore = 100
+	state.StartTimed()
+	state.RecordTimedAnswer(true, true)
+	state.RecordTimedAnswer(true, true)
+	if !state.FinishTimed() {
+		t.Error("the first run should set the best score")
+	}
+	if state.Stats.BestTimedScore != 20 || state.Stats.TotalScore != 120 {
+		t.Errorf("best %d, total %d; want 20, 120", state.Stats.BestTimedScore, state.Stats.TotalScore)
+	}
+	if !state.TimedFinished || !state.TimedPaused() {
+		t.Error("a finished run should stop its countdown")
+	}
+
+	state.StartTimed()
+	state.RecordTimedAnswer(true, false)
+	if state.FinishTimed() || state.Stats.BestTimedScore != 20 {
+		t.Errorf("a lower score should keep the best, got %d", state.Stats.BestTimedScore)
+	}
+}
+
+func TestBestTimedScorePersistsAndMerges(t *testing.T) {
+	originalPath := statsConfigPath
+	statsConfigPath = t.TempDir()
+	defer func() { statsConfigPath = originalPath }()
+
+	stats := NewUserStats()
+	stats.BestTimedScore = 85
+	if err := SaveStats(stats); err != nil {
+		t.Fatal(err)
+	}
+	if loaded := LoadStats(); loaded == nil || loaded.BestTimedScore != 85 {
+		t.Fatalf("BestTimedScore should survive a save, got %+v", loaded)
+	}
+
+	other := NewUserStats()
+	other.BestTimedScore = 120
+	stats.Merge(other)
+	if stats.BestTimedScore != 120 {
+		t.Errorf("merging should keep the higher best, got %d", stats.BestTimedScore)
+	}
+}
@@ -66,6 +66,7 @@ type ModuleInfo struct {
 	Icon        string
 	Description string
 	BossName    string
+	UserPack    bool // Loaded from a pack file in TrainerPacksDir
 }
 
 // BossStep represents a single step in a boss fight
@@ -81,7 +82,17 @@ type BossExercise struct {
 	Name      string     // "The Line Walker"
 	Lives     int        // 3
 	Steps     []BossStep // Chain of missions
-	BonusTime int        // Total time for bonus points
+	BonusTime int        // Par time in seconds: winning faster earns the speed bonus, 0 means none
+}
+
+// Par is the time to beat for the speed bonus; zero when the boss has none
+func (b *BossExercise) Par() time.Duration {
+	return time.Duration(b.BonusTime) * time.Second
+}
+
+// UnderPar reports a fight won within the par time
+func (b *BossExercise) UnderPar(elapsed time.Duration) bool {
+	return b.BonusTime > 0 && elapsed <= b.Par()
 }
 
 // ModuleProgress tracks progress within a module
@@ -100,10 +111,15 @@ type ModuleProgress struct {
 	BossBestTime  time.Duration
 	BossAttempts  int
 	BossLivesLeft int // Lives remaining on best run
+	BossLastTime  time.Duration
+	BossUnderPar  bool // Won under the par time at least once
 
 	// Per-exercise tracking for intelligent practice
 	ExerciseStats map[string]*ExerciseStats
 
+	// Placement marks progress seeded by the placement test rather than earned
+	Placement bool
+
 	// Legacy fields (kept for compatibility)
 	WeakExercises []string // IDs of exercises that fail most
 	LastPracticed time.Time
@@ -118,8 +134,24 @@ type UserStats struct {
 	ModuleProgress map[ModuleID]*ModuleProgress
 	BossesDefeated []ModuleID
 	LastPlayed     time.Time
+	Placement      *PlacementResult   // nil until the placement test is taken
+	BestTimes      map[string]float64 // Fastest correct answer per exercise ID, in seconds
+	// Daily practice streak, counted in local calendar days
+	LastPracticeDate  string // Day of the last answer ("2006-01-02"), "" before any
+	StreakDays        int    // Consecutive days with an answer, ending on LastPracticeDate
+	LongestStreakDays int
+	TotalAnswered     int // Lesson, practice and boss answers, right or wrong
+	BestTimedScore    int // Highest Speed Run score
+	// Exercises answered wrong, by ID, with the optimal answers given to each
+	// in a row since; they leave the review list at ReviewClearStreak
+	ReviewMistakes map[string]int
+	// IDs missed since the trainer was opened, oldest first (not saved)
+	SessionMistakes []string
 }
 
+// practiceDateLayout formats LastPracticeDate
+const practiceDateLayout = "2006-01-02"
+
 // NewUserStats creates a new UserStats with defaults
 func NewUserStats() *UserStats {
 	return &UserStats{
@@ -128,6 +160,54 @@ func NewUserStats() *UserStats {
 	}
 }
 
+// RecordBestTime keeps the fastest correct answer to an exercise. It reports
+// whether seconds beat an earlier best; the first answer only sets one.
+func (s *UserStats) RecordBestTime(exerciseID string, seconds float64) bool {
+	if s.BestTimes == nil {
+		s.BestTimes = make(map[string]float64)
+	}
+	best, ok := s.BestTimes[exerciseID]
+	if ok && seconds >= best {
+		return false
+	}
+	s.BestTimes[exerciseID] = seconds
+	return ok
+}
+
+// RecordPracticeDay counts an answer given at now. The first answer of a day
+// extends the streak when the last one was yesterday and restarts it after a
+// gap; later answers that day only add to the total.
+func (s *UserStats) RecordPracticeDay(now time.Time) {
+	s.TotalAnswered++
+	today := now.Format(practiceDateLayout)
+	switch s.LastPracticeDate {
+	case today:
+		return
+	case now.AddDate(0, 0, -1).Format(practiceDateLayout):
+		s.StreakDays++
+	default:
+		s.StreakDays = 1
+	}
+	s.LastPracticeDate = today
+	if s.StreakDays > s.LongestStreakDays {
+		s.LongestStreakDays = s.StreakDays
+	}
+}
+
+// ActiveStreakDays returns the daily streak as of now: it survives until the
+// end of the day after the last answer, then drops to 0
+func (s *UserStats) ActiveStreakDays(now time.Time) int {
+	if s.PracticedToday(now) || s.LastPracticeDate == now.AddDate(0, 0, -1).Format(practiceDateLayout) {
+		return s.StreakDays
+	}
+	return 0
+}
+
+// PracticedToday reports whether an answer was already given on now's day
+func (s *UserStats) PracticedToday(now time.Time) bool {
+	return s.LastPracticeDate != "" && s.LastPracticeDate == now.Format(practiceDateLayout)
+}
+
 // GetModuleProgress returns progress for a module, creating if needed
 func (s *UserStats) GetModuleProgress(module ModuleID) *ModuleProgress {
 	if s.ModuleProgress == nil {
@@ -157,6 +237,11 @@ func (s *UserStats) IsModuleUnlocked(module ModuleID) bool {
 		return true
 	}
 
+	// User packs are open from the start
+	if IsPackModule(module) {
+		return findPack(module) != nil
+	}
+
 	// Find position of requested module
 	var moduleIdx int = -1
 	for i, m := range moduleUnlockOrder {
@@ -171,6 +256,11 @@ func (s *UserStats) IsModuleUnlocked(module ModuleID) bool {
 		return false
 	}
 
+	// Unlocked by the placement test
+	if progress, ok := s.ModuleProgress[module]; ok && progress.Placement {
+		return true
+	}
+
 	// Need to have defeated the previous boss
 	if moduleIdx > 0 {
 		prevModule := moduleUnlockOrder[moduleIdx-1]
@@ -210,8 +300,17 @@ func (s *UserStats) IsBossReady(module ModuleID) bool {
 	return progress.PracticeAccuracy >= 0.80 && progress.PracticeAttempts >= 10
 }
 
-// GetAllModules returns info for all modules in order
+// GetAllModules returns info for all modules in order, the loaded user packs last
 func GetAllModules() []ModuleInfo {
+	modules := builtinModules()
+	for _, pack := range userPacks {
+		modules = append(modules, pack.info)
+	}
+	return modules
+}
+
+// builtinModules returns info for the modules that ship with the trainer
+func builtinModules() []ModuleInfo {
 	return []ModuleInfo{
 		{
 			ID:          ModuleHorizontal,
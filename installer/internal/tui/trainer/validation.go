@@ -51,7 +51,8 @@ func ValidateAnswerDetailed(exercise *Exercise, answer string) ValidationResult
 		(exercise.Solutions[0][0] == ':' || exercise.Solutions[0][0] == '/' || exercise.Solutions[0][0] == '?')
 	isNonMotionModule := exercise.Module == ModuleSubstitution ||
 		exercise.Module == ModuleMacros ||
-		exercise.Module == ModuleRegex
+		exercise.Module == ModuleRegex ||
+		IsPackModule(exercise.Module)
 	skipSimulation := isExCommand || isNonMotionModule
 
 	if skipSimulation {
@@ -101,7 +102,8 @@ func ValidateAnswer(exercise *Exercise, answer string) bool {
 		(exercise.Solutions[0][0] == ':' || exercise.Solutions[0][0] == '/' || exercise.Solutions[0][0] == '?')
 	isNonMotionModule := exercise.Module == ModuleSubstitution ||
 		exercise.Module == ModuleMacros ||
-		exercise.Module == ModuleRegex
+		exercise.Module == ModuleRegex ||
+		IsPackModule(exercise.Module)
 	skipSimulation := isExCommand || isNonMotionModule
 
 	if skipSimulation {
@@ -0,0 +1,50 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// bossFighting reports a boss fight in progress, whose timer redraws on tick
+func (m Model) bossFighting() bool {
+	gs := m.TrainerGameState
+	return m.Screen == ScreenTrainerBoss && gs != nil && gs.IsBossMode && !gs.IsBossDefeated
+}
+
+// formatBossTime renders a fight's time as "0:07" or "1:42"
+func formatBossTime(d time.Duration) string {
+	d = d.Truncate(time.Second)
+	return fmt.Sprintf("%d:%02d", int(d.Minutes()), int(d.Seconds())%60)
+}
+
+// renderBossTimer is the running fight time, with the par time when the boss has one
+func (m Model) renderBossTimer() string {
+	gs := m.TrainerGameState
+	timer := "⏱  " + formatBossTime(gs.BossElapsed())
+	if par := gs.CurrentBoss.Par(); par > 0 {
+		timer += " " + m.Tf("trainer_boss.par", formatBossTime(par))
+	}
+	return timer
+}
+
+// renderBossTimes lists the won fight's time against the module's best
+func (m Model) renderBossTimes() string {
+	gs := m.TrainerGameState
+	var s strings.Builder
+
+	line := m.Tf("trainer_boss.time", formatBossTime(gs.TimeElapsed))
+	if par := gs.CurrentBoss.Par(); par > 0 {
+		line += "  " + m.Tf("trainer_boss.par", formatBossTime(par))
+	}
+	if gs.LastBossBest {
+		line += "  " + m.T("trainer_boss.new_best")
+	}
+	s.WriteString(m.Theme.Info.Render(line))
+	s.WriteString("\n")
+
+	best := gs.Stats.GetModuleProgress(gs.CurrentModule).BossBestTime
+	s.WriteString(m.Theme.Info.Render(m.Tf("trainer_boss.best_time", formatBossTime(best))))
+	s.WriteString("\n")
+	return s.String()
+}
@@ -0,0 +1,49 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/tui/trainer"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestTrainerBossTimer(t *testing.T) {
+	m, now := timedModel(t)
+	progress := m.TrainerStats.GetModuleProgress(trainer.ModuleHorizontal)
+	progress.LessonsCompleted = 15
+	progress.LessonsTotal = 15
+	progress.PracticeAccuracy = 0.9
+	progress.PracticeAttempts = 10
+	progress.BossBestTime = 20 * time.Second
+
+	m = sendTimed(t, m, runes("b"))
+	if m.Screen != ScreenTrainerBoss || !m.needsAnimation() {
+		t.Fatalf("b should start a timed boss fight, got %v", m.Screen)
+	}
+
+	*now = now.Add(12 * time.Second)
+	if view := m.View(); !strings.Contains(view, "0:12 (par 0:30)") {
+		t.Errorf("the fight timer should show the elapsed and par times:\n%s", view)
+	}
+
+	for m.Screen == ScreenTrainerBoss {
+		step := m.TrainerGameState.CurrentBoss.Steps[m.TrainerGameState.BossStep]
+		m = typeAnswer(t, m, step.Exercise.Optimal)
+		m = sendTimed(t, m, tea.KeyMsg{Type: tea.KeyEnter})
+	}
+	if m.Screen != ScreenTrainerBossResult || m.needsAnimation() {
+		t.Fatalf("the boss should be beaten and the timer stopped, got %v", m.Screen)
+	}
+
+	view := m.View()
+	for _, want := range []string{"Time: 0:12", "Best time: 0:12", "New best!", "Lives remaining", "Under par! +250 speed bonus"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("the result should show %q:\n%s", want, view)
+		}
+	}
+	if progress.BossLastTime != 12*time.Second {
+		t.Errorf("the fight time should be stored, got %v", progress.BossLastTime)
+	}
+}
@@ -2,6 +2,9 @@ package tui
 
 import (
 	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -71,6 +74,7 @@ func TestTrainerResultCorrectGolden(t *testing.T) {
 	m.TrainerStats = trainer.NewUserStats()
 	m.TrainerGameState = trainer.NewGameStateWithStats(m.TrainerStats)
 	m.TrainerGameState.StartLesson(trainer.ModuleHorizontal)
+	m.TrainerGameState.LastAnswerTime = 3.4
 	m.TrainerLastCorrect = true
 	m.TrainerMessage = "✨ Perfect! Optimal solution!"
 
@@ -101,6 +105,9 @@ func TestTrainerBossGolden(t *testing.T) {
 	progress.PracticeAccuracy = 85.0
 
 	m.TrainerGameState = trainer.NewGameStateWithStats(m.TrainerStats)
+	// A stopped clock keeps the fight timer at 0:00
+	fixed := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	m.TrainerGameState.Clock = func() time.Time { return fixed }
 	m.TrainerGameState.StartBoss(trainer.ModuleHorizontal)
 	m.TrainerInput = ""
 	m.TrainerMessage = ""
@@ -136,7 +143,11 @@ func TestTrainerNavigationE2E(t *testing.T) {
 	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
 	time.Sleep(50 * time.Millisecond)
 
-	// Main Menu -> Navigate to "Learn & Practice" (index 1: Start, Learn & Practice)
+	// Main Menu -> Navigate to "Learn & Practice" (index 3: Start, Express, Server, Learn & Practice)
+	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+	time.Sleep(20 * time.Millisecond)
+	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+	time.Sleep(20 * time.Millisecond)
 	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
 	time.Sleep(20 * time.Millisecond)
 	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
@@ -636,3 +647,367 @@ func TestPracticeModeE2E(t *testing.T) {
 	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlC})
 	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
 }
+
+// TestTrainerPlacementFlow answers the placement test and checks the unlocks
+func TestTrainerPlacementFlow(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	m := NewModel()
+	m.Screen = ScreenTrainerMenu
+	m.TrainerStats = trainer.NewUserStats()
+
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'i'}})
+	m = result.(Model)
+	if m.Screen != ScreenTrainerLesson || m.TrainerGameState == nil || !m.TrainerGameState.IsPlacementMode {
+		t.Fatalf("Expected placement on the lesson screen, got %v", m.Screen)
+	}
+
+	// Answer the first three optimally, miss the rest
+	for i := 0; i < len(m.TrainerGameState.Exercises); i++ {
+		answer := "zzz"
+		if i < 3 {
+			answer = m.TrainerGameState.CurrentExercise.Optimal
+		}
+		m.TrainerInput = answer
+		result, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		m = result.(Model)
+		if m.Screen != ScreenTrainerResult {
+			t.Fatalf("Exercise %d: expected result screen, got %v", i, m.Screen)
+		}
+		result, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		m = result.(Model)
+	}
+
+	if m.Screen != ScreenTrainerMenu {
+		t.Fatalf("Expected trainer menu after placement, got %v", m.Screen)
+	}
+	if !m.TrainerStats.IsModuleUnlocked(trainer.ModuleTextObjects) || m.TrainerStats.IsModuleUnlocked(trainer.ModuleChangeRepeat) {
+		t.Error("3/5 should unlock through Text Objects only")
+	}
+	if m.TrainerStats.TotalScore != 0 {
+		t.Errorf("Placement should not award score, got %d", m.TrainerStats.TotalScore)
+	}
+	if !strings.Contains(m.TrainerMessage, "3/5") {
+		t.Errorf("Expected placement summary, got %q", m.TrainerMessage)
+	}
+}
+
+// trainerWithPractice returns a trainer-menu model whose first module has
+// some practice progress worth losing
+func trainerWithPractice(t *testing.T) Model {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+
+	m := NewModel()
+	m.Screen = ScreenTrainerMenu
+	m.TrainerStats = trainer.NewUserStats()
+	module := m.TrainerModules[0].ID
+	progress := m.TrainerStats.GetModuleProgress(module)
+	lessons := trainer.GetLessons(module)
+	for i := 0; i < trainer.MasteryThreshold; i++ {
+		progress.RecordPracticeResult(lessons[0].ID, true)
+	}
+	progress.RecordPracticeResult(lessons[1].ID, false)
+	return m
+}
+
+// TestTrainerModuleResetConfirm checks that [r] asks first and only resets on confirm
+func TestTrainerModuleResetConfirm(t *testing.T) {
+	press := func(m Model, keys ...string) Model {
+		for _, k := range keys {
+			msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+			switch k {
+			case "enter":
+				msg = tea.KeyMsg{Type: tea.KeyEnter}
+			case "esc":
+				msg = tea.KeyMsg{Type: tea.KeyEsc}
+			case "up":
+				msg = tea.KeyMsg{Type: tea.KeyUp}
+			}
+			result, _ := m.Update(msg)
+			m = result.(Model)
+		}
+		return m
+	}
+
+	tests := []struct {
+		name      string
+		keys      []string
+		wantReset bool
+	}{
+		{"enter defaults to cancel", []string{"r", "enter"}, false},
+		{"esc cancels", []string{"r", "esc"}, false},
+		{"n cancels", []string{"r", "n"}, false},
+		{"confirm with cursor", []string{"r", "up", "enter"}, true},
+		{"confirm with y", []string{"r", "y"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := trainerWithPractice(t)
+			module := m.TrainerModules[0]
+
+			m = press(m, "r")
+			if m.Screen != ScreenTrainerResetConfirm {
+				t.Fatalf("[r] should ask first, got %v", m.Screen)
+			}
+			view := m.View()
+			for _, want := range []string{"Exercises mastered: 1/", "Practice accuracy: 75% over 4 attempts"} {
+				if !strings.Contains(view, want) {
+					t.Errorf("confirm screen should show %q", want)
+				}
+			}
+			if progress := m.TrainerStats.GetModuleProgress(module.ID); progress.PracticeAttempts == 0 {
+				t.Fatal("progress must not change before confirming")
+			}
+
+			m = press(m, tt.keys[1:]...)
+			if m.Screen != ScreenTrainerMenu {
+				t.Fatalf("expected trainer menu, got %v", m.Screen)
+			}
+			progress := m.TrainerStats.GetModuleProgress(module.ID)
+			if reset := progress.PracticeAttempts == 0; reset != tt.wantReset {
+				t.Errorf("reset = %v, want %v (message %q)", reset, tt.wantReset, m.TrainerMessage)
+			}
+			if !tt.wantReset && m.TrainerMessage != "Reset cancelled." {
+				t.Errorf("expected a cancel message, got %q", m.TrainerMessage)
+			}
+		})
+	}
+
+	t.Run("nothing to reset", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+		m := NewModel()
+		m.Screen = ScreenTrainerMenu
+		m.TrainerStats = trainer.NewUserStats()
+		m = press(m, "r")
+		if m.Screen != ScreenTrainerMenu || !strings.Contains(m.TrainerMessage, "No practice progress") {
+			t.Errorf("expected a no-op message, got %v %q", m.Screen, m.TrainerMessage)
+		}
+	})
+}
+
+// TestTrainerResetAllProgress checks the typed confirmation and the stats backup
+func TestTrainerResetAllProgress(t *testing.T) {
+	m := trainerWithPractice(t)
+	m.TrainerStats.TotalScore = 420
+	if err := trainer.SaveStats(m.TrainerStats); err != nil {
+		t.Fatalf("SaveStats: %v", err)
+	}
+
+	send := func(msgs ...tea.KeyMsg) {
+		for _, msg := range msgs {
+			result, _ := m.Update(msg)
+			m = result.(Model)
+		}
+	}
+	runes := func(s string) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)} }
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+
+	send(runes("s"), enter)
+	if m.Screen != ScreenTrainerResetAll {
+		t.Fatalf("expected the reset-all screen, got %v", m.Screen)
+	}
+
+	// Anything but the exact word is refused
+	for _, r := range "rest" {
+		send(runes(string(r)))
+	}
+	send(enter)
+	if m.Screen != ScreenTrainerResetAll || m.TrainerStats.TotalScore != 420 {
+		t.Fatalf("a wrong word must not reset (screen %v, score %d)", m.Screen, m.TrainerStats.TotalScore)
+	}
+
+	// Esc backs out to settings and clears the input
+	send(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.Screen != ScreenTrainerSettings || m.TrainerResetInput != "" {
+		t.Fatalf("esc should return to settings with a clean input, got %v %q", m.Screen, m.TrainerResetInput)
+	}
+
+	send(enter)
+	for _, r := range "resett" {
+		send(runes(string(r)))
+	}
+	send(tea.KeyMsg{Type: tea.KeyBackspace}, enter)
+	if m.Screen != ScreenTrainerMenu {
+		t.Fatalf("typing reset should confirm, got %v (%q)", m.Screen, m.TrainerMessage)
+	}
+	if m.TrainerStats.TotalScore != 0 || m.TrainerStats.GetModuleProgress(m.TrainerModules[0].ID).PracticeAttempts != 0 {
+		t.Error("stats should start over")
+	}
+
+	// The old stats were rotated, not deleted
+	if _, err := os.Stat(trainer.GetStatsPath()); !os.IsNotExist(err) {
+		t.Error("stats file should be moved aside")
+	}
+	backups, _ := filepath.Glob(trainer.GetStatsPath() + ".*.bak")
+	if len(backups) != 1 {
+		t.Fatalf("expected one backup, got %v", backups)
+	}
+	if !strings.Contains(m.TrainerMessage, backups[0]) {
+		t.Errorf("message should point at the backup, got %q", m.TrainerMessage)
+	}
+	data, _ := os.ReadFile(backups[0])
+	if !strings.Contains(string(data), `"totalScore": 420`) {
+		t.Error("backup should hold the previous stats")
+	}
+}
+
+// TestTrainerAnswerTime times answers with a fixed clock and checks the result
+// screen reports the time and the personal best
+func TestTrainerAnswerTime(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	stats := trainer.NewUserStats()
+	answer := func(seconds float64) string {
+		t.Helper()
+		m := NewModel()
+		m.Screen = ScreenTrainerLesson
+		m.TrainerStats = stats
+		m.TrainerGameState = trainer.NewGameStateWithStats(stats)
+		m.TrainerGameState.Clock = func() time.Time { return now }
+		m.TrainerGameState.StartLesson(trainer.ModuleHorizontal)
+
+		now = now.Add(time.Duration(seconds * float64(time.Second)))
+		m.TrainerInput = m.TrainerGameState.CurrentExercise.Optimal
+		result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		m = result.(Model)
+		if m.Screen != ScreenTrainerResult || !m.TrainerLastCorrect {
+			t.Fatalf("expected a correct answer on the result screen, got %v", m.Screen)
+		}
+		return m.renderTrainerResult()
+	}
+
+	tests := []struct {
+		seconds float64
+		want    string
+		notWant string
+	}{
+		{4, "Time: 4.0s", "personal best"},
+		{2, "Time: 2.0s  🏅 New personal best!", "(best"},
+		{5, "Time: 5.0s  (best 2.0s)", "personal best"},
+	}
+	for _, tt := range tests {
+		view := answer(tt.seconds)
+		if !strings.Contains(view, tt.want) || strings.Contains(view, tt.notWant) {
+			t.Errorf("after %.0fs expected %q without %q, got:\n%s", tt.seconds, tt.want, tt.notWant, view)
+		}
+	}
+}
+
+// TestTrainerMenuDailyStreak checks the streak summary and the reminder
+// at the top of the trainer menu
+func TestTrainerMenuDailyStreak(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.Local)
+	practiced := func(daysAgo ...int) *trainer.UserStats {
+		stats := trainer.NewUserStats()
+		for _, d := range daysAgo {
+			stats.RecordPracticeDay(now.AddDate(0, 0, -d))
+		}
+		return stats
+	}
+
+	tests := []struct {
+		name  string
+		stats *trainer.UserStats
+		want  []string
+		not   []string
+	}{
+		{"new player", trainer.NewUserStats(), []string{"No daily streak yet"}, []string{"⏰"}},
+		{"practiced today", practiced(2, 1, 0), []string{"Daily streak: 3 days", "Longest: 3 days", "Answered: 3"}, []string{"⏰"}},
+		{"not yet today", practiced(1), []string{"Daily streak: 1 day ", "Practice today to keep your 1 day streak going"}, nil},
+		{"streak ended", practiced(5, 4, 3), []string{"Daily streak: 0 days", "Longest: 3 days", "Your streak ended"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewModel()
+			m.TrainerStats = tt.stats
+			view := m.renderTrainerDailyStreak(now)
+			for _, want := range tt.want {
+				if !strings.Contains(view, want) {
+					t.Errorf("expected %q in:\n%s", want, view)
+				}
+			}
+			for _, unwanted := range tt.not {
+				if strings.Contains(view, unwanted) {
+					t.Errorf("did not expect %q in:\n%s", unwanted, view)
+				}
+			}
+		})
+	}
+}
+
+// TestTrainerExportImport carries progress to a second home with [e] and
+// [I], and checks a bad file is reported instead of touching the stats
+func TestTrainerExportImport(t *testing.T) {
+	m := trainerWithPractice(t)
+	m.TrainerStats.TotalScore = 300
+	send := func(msgs ...tea.KeyMsg) {
+		for _, msg := range msgs {
+			result, _ := m.Update(msg)
+			m = result.(Model)
+		}
+	}
+	runes := func(s string) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)} }
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+
+	send(runes("e"))
+	export := filepath.Join(os.Getenv("HOME"), trainer.ExportFileName)
+	if _, err := os.Stat(export); err != nil {
+		t.Fatalf("export should be written to the home directory: %v", err)
+	}
+	if !strings.Contains(m.TrainerMessage, "~/"+trainer.ExportFileName) {
+		t.Errorf("message should name the export, got %q", m.TrainerMessage)
+	}
+
+	// Another machine: fresh home with its own progress
+	t.Setenv("HOME", t.TempDir())
+	m = NewModel()
+	m.Screen = ScreenTrainerMenu
+	m.TrainerStats = trainer.NewUserStats()
+	m.TrainerStats.TotalScore = 100
+
+	send(runes("I"))
+	if m.Screen != ScreenTrainerImport || m.ProjectPathInput != "~/"+trainer.ExportFileName {
+		t.Fatalf("expected the import prompt with the default path, got %v %q", m.Screen, m.ProjectPathInput)
+	}
+	if view := m.View(); !strings.Contains(view, "Import Progress") {
+		t.Errorf("prompt should render, got:\n%s", view)
+	}
+
+	// The default path doesn't exist on this machine
+	send(enter)
+	if m.Screen != ScreenTrainerMenu || !strings.Contains(m.TrainerMessage, "Import failed: no export found") {
+		t.Fatalf("a missing file should be reported on the menu, got %v %q", m.Screen, m.TrainerMessage)
+	}
+
+	send(runes("I"), tea.KeyMsg{Type: tea.KeyCtrlU})
+	for _, r := range export {
+		send(runes(string(r)))
+	}
+	send(enter)
+	if m.Screen != ScreenTrainerMenu || !strings.Contains(m.TrainerMessage, "Progress imported") {
+		t.Fatalf("import should return to the menu, got %v %q", m.Screen, m.TrainerMessage)
+	}
+	if m.TrainerStats.TotalScore != 300 || m.TrainerStats.GetModuleProgress(m.TrainerModules[0].ID).PracticeAttempts == 0 {
+		t.Errorf("imported progress should be merged in, got score %d", m.TrainerStats.TotalScore)
+	}
+
+	// Corrupt files are refused with a readable message
+	corrupt := filepath.Join(t.TempDir(), "broken.json")
+	os.WriteFile(corrupt, []byte("{oops"), 0644)
+	send(runes("I"), tea.KeyMsg{Type: tea.KeyCtrlU})
+	for _, r := range corrupt {
+		send(runes(string(r)))
+	}
+	send(enter)
+	if !strings.Contains(m.TrainerMessage, "Import failed: broken.json is not valid JSON") || m.TrainerStats.TotalScore != 300 {
+		t.Errorf("a corrupt file should be reported and change nothing, got %q", m.TrainerMessage)
+	}
+
+	// Esc cancels the prompt
+	send(runes("I"), tea.KeyMsg{Type: tea.KeyEsc})
+	if m.Screen != ScreenTrainerMenu || m.TrainerMessage != "Import cancelled." {
+		t.Errorf("esc should cancel the import, got %v %q", m.Screen, m.TrainerMessage)
+	}
+}
@@ -0,0 +1,23 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+)
+
+// trainerPackErrors sums up the pack files that could not be loaded for the
+// trainer menu, or "" when all of them loaded
+func trainerPackErrors(errs []error) string {
+	if len(errs) == 0 {
+		return ""
+	}
+	reasons := make([]string, len(errs))
+	for i, err := range errs {
+		reasons[i] = err.Error()
+	}
+	noun := "pack"
+	if len(errs) > 1 {
+		noun = "packs"
+	}
+	return fmt.Sprintf("⚠️  Skipped %d trainer %s: %s", len(errs), noun, strings.Join(reasons, "; "))
+}
@@ -0,0 +1,57 @@
+package tui
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/tui/trainer"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestTrainerMenuLoadsPacks(t *testing.T) {
+	// Runs after HOME is restored, dropping the test's packs
+	t.Cleanup(func() { trainer.LoadUserPacks() })
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	dir := paths.TrainerPacksDir(home)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	pack := `{"id": "oil", "name": "Oil", "lessons": [{"id": "open", "code": ["x"], "mission": "Open the parent dir", "solutions": ["-"]}]}`
+	for name, content := range map[string]string{"oil.json": pack, "broken.json": "{"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	m := NewModel()
+	m.Screen = ScreenLearnMenu
+	m.Cursor = 3 // Vim Trainer
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = result.(Model)
+
+	if last := m.TrainerModules[len(m.TrainerModules)-1]; last.ID != "pack:oil" {
+		t.Fatalf("the pack should be listed last, got %s", last.ID)
+	}
+	view := m.View()
+	if !strings.Contains(view, "Oil [pack] - ") {
+		t.Errorf("packs should carry a badge:\n%s", view)
+	}
+	if !strings.Contains(m.TrainerMessage, "Skipped 1 trainer pack: broken.json") {
+		t.Errorf("the broken pack should be reported, got %q", m.TrainerMessage)
+	}
+}
+
+func TestTrainerPackErrors(t *testing.T) {
+	if msg := trainerPackErrors(nil); msg != "" {
+		t.Errorf("no errors should mean no message, got %q", msg)
+	}
+	msg := trainerPackErrors([]error{errors.New("a.json: bad"), errors.New("b.json: worse")})
+	if msg != "⚠️  Skipped 2 trainer packs: a.json: bad; b.json: worse" {
+		t.Errorf("got %q", msg)
+	}
+}
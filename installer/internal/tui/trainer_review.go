@@ -0,0 +1,60 @@
+package tui
+
+import (
+	"fmt"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/tui/trainer"
+)
+
+// trainerReviewCount is the number of exercises waiting for review
+func (m Model) trainerReviewCount() int {
+	if m.TrainerStats == nil {
+		return 0
+	}
+	return m.TrainerStats.ReviewCount()
+}
+
+// trainerMenuRows is how many rows the trainer menu can select: the modules,
+// then "Review mistakes" while there is something to review
+func (m Model) trainerMenuRows() int {
+	if m.trainerReviewCount() > 0 {
+		return len(m.TrainerModules) + 1
+	}
+	return len(m.TrainerModules)
+}
+
+// onTrainerReviewRow reports whether the menu cursor is on "Review mistakes"
+func (m Model) onTrainerReviewRow() bool {
+	return m.TrainerCursor == len(m.TrainerModules) && m.trainerReviewCount() > 0
+}
+
+// startTrainerReview drills the exercises answered wrong until each is
+// answered optimally twice in a row
+func (m Model) startTrainerReview() Model {
+	gs := trainer.NewGameStateWithStats(m.TrainerStats)
+	if !gs.StartReview() {
+		m.TrainerMessage = m.T("trainer.review_empty")
+		return m
+	}
+	m.TrainerGameState = gs
+	m.TrainerInput = ""
+	m.TrainerMessage = ""
+	m.Screen = ScreenTrainerPractice
+	return m
+}
+
+// renderTrainerReviewRow renders the "Review mistakes" menu entry, or
+// nothing when the review list is empty
+func (m Model) renderTrainerReviewRow() string {
+	count := m.trainerReviewCount()
+	if count == 0 {
+		return ""
+	}
+	cursor := "  "
+	style := m.Theme.Unselected
+	if m.onTrainerReviewRow() {
+		cursor = "▸ "
+		style = m.Theme.Selected
+	}
+	return style.Render(fmt.Sprintf("%s📝 Review mistakes (%d)", cursor, count)) + "\n"
+}
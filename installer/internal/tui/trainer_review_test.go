@@ -0,0 +1,50 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/tui/trainer"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestTrainerReviewMistakes(t *testing.T) {
+	m, _ := timedModel(t)
+	if strings.Contains(m.View(), "Review mistakes") {
+		t.Fatal("the review row should stay hidden until something is missed")
+	}
+
+	missed := trainer.GetLessons(trainer.ModuleHorizontal)[0]
+	m.TrainerStats.RecordMistake(missed.ID)
+	if view := m.View(); !strings.Contains(view, "Review mistakes (1)") {
+		t.Fatalf("the menu should count the mistakes:\n%s", view)
+	}
+
+	// The review row sits below the modules
+	for range m.TrainerModules {
+		m = sendTimed(t, m, tea.KeyMsg{Type: tea.KeyDown})
+	}
+	if !m.onTrainerReviewRow() {
+		t.Fatalf("down should reach the review row, cursor at %d", m.TrainerCursor)
+	}
+	m = sendTimed(t, m, tea.KeyMsg{Type: tea.KeyEnter})
+	if m.Screen != ScreenTrainerPractice || !strings.Contains(m.View(), "Review Mode") {
+		t.Fatalf("enter should start the review drill, got %v", m.Screen)
+	}
+
+	// Two optimal answers in a row clear the exercise and end the drill
+	for i := 0; i < trainer.ReviewClearStreak; i++ {
+		if m.TrainerGameState.CurrentExercise.ID != missed.ID {
+			t.Fatalf("review should drill %s, got %s", missed.ID, m.TrainerGameState.CurrentExercise.ID)
+		}
+		m = typeAnswer(t, m, missed.Optimal)
+		m = sendTimed(t, m, tea.KeyMsg{Type: tea.KeyEnter})
+		m = sendTimed(t, m, tea.KeyMsg{Type: tea.KeyEnter})
+	}
+	if m.Screen != ScreenTrainerMenu || m.TrainerStats.ReviewCount() != 0 {
+		t.Fatalf("the drill should end with the list empty: screen %v, left %v", m.Screen, m.TrainerStats.ReviewMistakes)
+	}
+	if m.TrainerCursor != len(m.TrainerModules)-1 || strings.Contains(m.View(), "Review mistakes") {
+		t.Errorf("the cursor should leave the hidden review row, at %d", m.TrainerCursor)
+	}
+}
@@ -0,0 +1,169 @@
+package tui
+
+import (
+	"strings"
+	"time"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/tui/trainer"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// trainerClock times Speed Runs and boss fights; tests replace it to drive
+// the countdown
+var trainerClock = time.Now
+
+// startTimedRun starts a Speed Run over every unlocked module
+func (m Model) startTimedRun() Model {
+	gs := trainer.NewGameStateWithStats(m.TrainerStats)
+	gs.Clock = trainerClock
+	if !gs.StartTimed() {
+		m.TrainerMessage = m.T("trainer.timed_empty")
+		return m
+	}
+	m.TrainerGameState = gs
+	m.TrainerInput = ""
+	m.TrainerMessage = ""
+	m.TrainerTimedNewBest = false
+	m.Screen = ScreenTrainerTimed
+	return m
+}
+
+// timedRunning reports whether a Speed Run is counting down
+func (m Model) timedRunning() bool {
+	gs := m.TrainerGameState
+	return m.Screen == ScreenTrainerTimed && gs != nil && gs.IsTimedMode && !gs.TimedFinished && !gs.TimedPaused()
+}
+
+// timedFinished reports whether the Speed Run screen shows the results
+func (m Model) timedFinished() bool {
+	return m.TrainerGameState != nil && m.TrainerGameState.TimedFinished
+}
+
+// checkTimedRun ends the Speed Run once the countdown reaches zero
+func (m Model) checkTimedRun() Model {
+	if !m.timedRunning() || m.TrainerGameState.TimedRemaining() > 0 {
+		return m
+	}
+	m.TrainerTimedNewBest = m.TrainerGameState.FinishTimed()
+	m.saveTrainerStats()
+	m.TrainerInput = ""
+	m.TrainerMessage = ""
+	return m
+}
+
+// pauseTimedRun stops the countdown while the terminal is resized or
+// suspended, until the next key
+func (m Model) pauseTimedRun() Model {
+	if m.timedRunning() {
+		m.TrainerGameState.PauseTimed()
+	}
+	return m
+}
+
+// stopTimedRun abandons a Speed Run from Esc; its score is not kept
+func (m Model) stopTimedRun() Model {
+	if m.TrainerGameState != nil && !m.TrainerGameState.TimedFinished {
+		m.TrainerGameState.PauseTimed()
+		m.TrainerMessage = m.T("trainer.timed_stopped")
+	} else {
+		m.TrainerMessage = ""
+	}
+	if m.TrainerStats != nil {
+		m.saveTrainerStats()
+	}
+	m.Screen = ScreenTrainerMenu
+	return m
+}
+
+// handleTrainerTimedKeys answers Speed Run exercises until time is up, then
+// offers another run
+func (m Model) handleTrainerTimedKeys(key string) (tea.Model, tea.Cmd) {
+	gs := m.TrainerGameState
+	if gs == nil || !gs.IsTimedMode {
+		m.Screen = ScreenTrainerMenu
+		return m, nil
+	}
+
+	if gs.TimedFinished {
+		switch key {
+		case "enter", "r":
+			return m.startTimedRun(), nil
+		case "q":
+			return m.stopTimedRun(), nil
+		}
+		return m, nil
+	}
+	if gs.TimedPaused() {
+		// The key only resumes, so nothing is typed while the exercise was hidden
+		gs.ResumeTimed()
+		return m, nil
+	}
+	if m = m.checkTimedRun(); gs.TimedFinished {
+		return m, nil
+	}
+
+	switch key {
+	case "backspace":
+		if len(m.TrainerInput) > 0 {
+			m.TrainerInput = m.TrainerInput[:len(m.TrainerInput)-1]
+		}
+	case "enter":
+		if m.TrainerInput == "" {
+			return m, nil
+		}
+		exercise := gs.CurrentExercise
+		validation := trainer.ValidateAnswerDetailed(exercise, m.TrainerInput)
+		points := gs.RecordTimedAnswer(validation.IsCorrect, validation.IsOptimal)
+		switch {
+		case validation.IsCorrect && validation.IsOptimal:
+			m.TrainerMessage = m.Tf("trainer.timed_optimal", points)
+		case validation.IsCorrect:
+			m.TrainerMessage = m.Tf("trainer.timed_correct", points, exercise.Optimal)
+		default:
+			m.TrainerMessage = m.Tf("trainer.timed_wrong", trainer.FormatSolutionsHint(exercise))
+		}
+		m.TrainerLastCorrect = validation.IsCorrect
+		m.TrainerInput = ""
+	default:
+		m.TrainerInput = appendTrainerKey(m.TrainerInput, key)
+	}
+	return m, nil
+}
+
+// timedStatus is the countdown line above a Speed Run exercise
+func (m Model) timedStatus() string {
+	gs := m.TrainerGameState
+	left := int((gs.TimedRemaining() + time.Second - 1) / time.Second)
+	status := m.Tf("trainer_timed.status", left, gs.SessionScore, gs.TimedCorrect, gs.TimedAnswered)
+	if gs.TimedPaused() {
+		status += m.T("trainer_timed.paused")
+	}
+	return status
+}
+
+// renderTrainerTimed shows the running Speed Run, or its results once time is up
+func (m Model) renderTrainerTimed() string {
+	if !m.timedFinished() {
+		return m.renderTrainerExercise(m.T("trainer.mode_speed_run"))
+	}
+	gs := m.TrainerGameState
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.T("trainer_timed.times_up")))
+	s.WriteString("\n\n")
+	s.WriteString(m.Theme.Info.Render(m.Tf("trainer_timed.score", gs.SessionScore)))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Info.Render(m.Tf("trainer_timed.accuracy", gs.TimedAccuracy()*100, gs.TimedCorrect, gs.TimedAnswered)))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Info.Render(m.Tf("trainer_timed.best", m.TrainerStats.BestTimedScore)))
+	s.WriteString("\n")
+	if m.TrainerTimedNewBest {
+		s.WriteString("\n")
+		s.WriteString(m.Theme.Success.Render(m.T("trainer_timed.new_best")))
+		s.WriteString("\n")
+	}
+
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render(m.T("trainer_timed.help")))
+	return s.String()
+}
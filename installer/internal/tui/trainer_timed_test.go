@@ -0,0 +1,123 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/tui/trainer"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// timedModel opens the trainer menu with trainerClock under the test's control
+func timedModel(t *testing.T) (Model, *time.Time) {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	old := trainerClock
+	trainerClock = func() time.Time { return now }
+	t.Cleanup(func() { trainerClock = old })
+
+	m := NewModel()
+	m.Screen = ScreenTrainerMenu
+	m.TrainerStats = trainer.NewUserStats()
+	m.TrainerModules = trainer.GetAllModules()
+	return m, &now
+}
+
+// typeAnswer types s one key at a time, as the trainer reads it
+func typeAnswer(t *testing.T, m Model, s string) Model {
+	t.Helper()
+	for _, r := range s {
+		m = sendTimed(t, m, runes(string(r)))
+	}
+	return m
+}
+
+func sendTimed(t *testing.T, m Model, msg tea.Msg) Model {
+	t.Helper()
+	result, _ := m.Update(msg)
+	return result.(Model)
+}
+
+func TestTrainerTimedRun(t *testing.T) {
+	m, now := timedModel(t)
+	m = sendTimed(t, m, runes("t"))
+	if m.Screen != ScreenTrainerTimed || !m.timedRunning() {
+		t.Fatalf("t should start a Speed Run, got %v", m.Screen)
+	}
+	if view := m.View(); !strings.Contains(view, "60s left") {
+		t.Errorf("the countdown should show:\n%s", view)
+	}
+
+	// An optimal answer scores 10 and brings the next exercise
+	m = typeAnswer(t, m, m.TrainerGameState.CurrentExercise.Optimal)
+	m = sendTimed(t, m, tea.KeyMsg{Type: tea.KeyEnter})
+	if m.TrainerGameState.SessionScore != 10 || m.TrainerInput != "" {
+		t.Fatalf("score %d, input %q; want 10 and a cleared input", m.TrainerGameState.SessionScore, m.TrainerInput)
+	}
+
+	*now = now.Add(25 * time.Second)
+	if view := m.View(); !strings.Contains(view, "35s left") || !strings.Contains(view, "Score: 10") {
+		t.Errorf("the countdown should follow the clock:\n%s", view)
+	}
+
+	// Time up on a tick shows the results and keeps the best score
+	*now = now.Add(time.Minute)
+	m = sendTimed(t, m, tickMsg(*now))
+	if !m.timedFinished() || m.TrainerStats.BestTimedScore != 10 || !m.TrainerTimedNewBest {
+		t.Fatalf("time up should finish the run with a new best, got best %d", m.TrainerStats.BestTimedScore)
+	}
+	view := m.View()
+	for _, want := range []string{"Time's up", "Score:    10", "100% (1/1 correct)", "New best"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("results should show %q:\n%s", want, view)
+		}
+	}
+	if m.needsAnimation() {
+		t.Error("a finished run should stop the ticks")
+	}
+	if saved := trainer.LoadStats(); saved == nil || saved.BestTimedScore != 10 {
+		t.Errorf("the best score should be saved, got %+v", saved)
+	}
+}
+
+func TestTrainerTimedPausesOnResize(t *testing.T) {
+	m, now := timedModel(t)
+	m = sendTimed(t, m, runes("t"))
+
+	*now = now.Add(10 * time.Second)
+	m = sendTimed(t, m, tea.WindowSizeMsg{Width: 100, Height: 40})
+	if m.timedRunning() || !strings.Contains(m.View(), "Paused") {
+		t.Fatal("a resize should pause the countdown")
+	}
+	*now = now.Add(time.Minute)
+	m = sendTimed(t, m, tickMsg(*now))
+	if m.timedFinished() {
+		t.Fatal("time should not run out while paused")
+	}
+
+	// The next key resumes without being typed
+	m = sendTimed(t, m, runes("w"))
+	if !m.timedRunning() || m.TrainerInput != "" {
+		t.Fatalf("a key should resume the run, input %q", m.TrainerInput)
+	}
+	if left := m.TrainerGameState.TimedRemaining(); left != 50*time.Second {
+		t.Errorf("the pause should not cost time, %v left", left)
+	}
+}
+
+func TestTrainerTimedEscStops(t *testing.T) {
+	m, _ := timedModel(t)
+	m = sendTimed(t, m, runes("t"))
+	m = typeAnswer(t, m, m.TrainerGameState.CurrentExercise.Optimal)
+	m = sendTimed(t, m, tea.KeyMsg{Type: tea.KeyEnter})
+
+	m = sendTimed(t, m, tea.KeyMsg{Type: tea.KeyEsc})
+	if m.Screen != ScreenTrainerMenu || m.needsAnimation() {
+		t.Fatalf("esc should stop the run and the ticks, got %v", m.Screen)
+	}
+	if m.TrainerStats.BestTimedScore != 0 {
+		t.Error("a stopped run should not set the best score")
+	}
+}
@@ -0,0 +1,94 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/tui/trainer"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// exportTrainerProgress saves the current progress and exports it to
+// ~/gentleman-trainer-export.json, reporting the outcome in TrainerMessage
+func (m Model) exportTrainerProgress() Model {
+	path := trainer.DefaultExportPath()
+	if path == "" {
+		m.TrainerMessage = m.T("trainer.export_no_home")
+		return m
+	}
+	if m.TrainerStats != nil {
+		if err := m.saveTrainerStats(); err != nil {
+			m.TrainerMessage = m.Tf("trainer.export_failed", err)
+			return m
+		}
+	}
+	if err := trainer.ExportStats(path); err != nil {
+		m.TrainerMessage = m.Tf("trainer.export_failed", err)
+		return m
+	}
+	m.TrainerMessage = m.Tf("trainer.exported", contractHome(path))
+	return m
+}
+
+// enterTrainerImport opens the import prompt on the path input, prefilled
+// with the default export location. It's refused while another instance holds
+// the lock.
+func (m Model) enterTrainerImport() Model {
+	// Importing writes stats.json, which the other instance may be writing too
+	if m.readOnly() {
+		m.TrainerMessage = m.T("trainer.import_read_only")
+		return m
+	}
+	path := contractHome(trainer.DefaultExportPath())
+	m.ProjectPathInput = path
+	m.ProjectPathCursor = len([]rune(path))
+	m.ProjectPathError = ""
+	m.ProjectPathMode = PathModeTyping
+	m.TrainerMessage = ""
+	m.Screen = ScreenTrainerImport
+	return m
+}
+
+// handleTrainerImportKeys edits the import path; enter merges the file into the
+// current progress and returns to the trainer menu with the outcome
+func (m Model) handleTrainerImportKeys(key string) (tea.Model, tea.Cmd) {
+	if key != "enter" {
+		return m.editPathInput(key), nil
+	}
+
+	path := expandPath(strings.TrimSpace(m.ProjectPathInput))
+	if path == "" {
+		m.ProjectPathError = "Path cannot be empty"
+		return m, nil
+	}
+	// Merge against what's on screen, not an older save
+	if m.TrainerStats != nil {
+		if err := m.saveTrainerStats(); err != nil {
+			m.ProjectPathError = "Could not save the current progress: " + err.Error()
+			return m, nil
+		}
+	}
+
+	m.Screen = ScreenTrainerMenu
+	stats, err := trainer.ImportStats(path)
+	if err != nil {
+		m.TrainerMessage = m.Tf("trainer.import_failed", err)
+		return m, nil
+	}
+	m.TrainerStats = stats
+	m.TrainerMessage = m.Tf("trainer.imported", contractHome(path))
+	return m, nil
+}
+
+func (m Model) renderTrainerImport() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n\n")
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
+	s.WriteString("\n\n")
+	s.WriteString(m.renderPathInput())
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render("  Enter: import  •  Esc: cancel"))
+
+	return s.String()
+}
@@ -0,0 +1,488 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// uninstallItem is one thing the uninstall screen offers to remove
+type uninstallItem struct {
+	ID         string // Config ID, "skills" or "gentleman"
+	Label      string
+	Paths      []string // Existing config roots; the data dir for "gentleman"
+	BackupKeys []string // system.ConfigPaths keys to back up before removing
+	Selected   bool
+}
+
+// uninstallResult reports what removing one item did
+type uninstallResult struct {
+	Label   string
+	Removed int
+	Kept    []string // Paths left alone: edited since the install, or not the installer's
+	Err     string
+}
+
+// uninstallCompleteMsg carries the results of an uninstall run
+type uninstallCompleteMsg struct {
+	results   []uninstallResult
+	backupDir string
+	err       error
+}
+
+// uninstallConfigs are the configs the installer deploys that uninstall knows about,
+// with their paths relative to home
+var uninstallConfigs = []struct {
+	id, label string
+	rels      []string
+	keys      []string
+}{
+	{"nvim", "Neovim", []string{".config/nvim"}, []string{"nvim"}},
+	{"fish", "Fish", []string{".config/fish"}, []string{"fish"}},
+	{"zsh", "Zsh", []string{".zshrc", system.ZshMergeConfigPath, ".p10k.zsh", ".oh-my-zsh"}, []string{"zsh", "zsh_p10k", "oh-my-zsh"}},
+	{"tmux", "Tmux", []string{".tmux.conf", ".tmux/plugins"}, []string{"tmux"}},
+	{"zellij", "Zellij", []string{".config/zellij"}, []string{"zellij"}},
+	{"ghostty", "Ghostty", []string{".config/ghostty"}, []string{"ghostty"}},
+}
+
+// isWithin reports whether path, with symlinks resolved, is dir or inside it
+func isWithin(dir, path string) bool {
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return false
+	}
+	rel, err := filepath.Rel(dir, resolved)
+	return err == nil && !strings.HasPrefix(rel, "..")
+}
+
+// resolvedDir returns dir as an absolute path with symlinks resolved, or ""
+// when it doesn't exist
+func resolvedDir(dir string) string {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return ""
+	}
+	resolved, err := filepath.EvalSymlinks(abs)
+	if err != nil {
+		return ""
+	}
+	return resolved
+}
+
+// installerSkillLinks returns the skill links that point into the skills catalog
+// or the external clones, including ones left dangling by a catalog that's already gone
+func installerSkillLinks(home string) []skillLinkEntry {
+	var links []skillLinkEntry
+	for _, e := range scanSkillLinks(home).Entries {
+		switch e.State {
+		case skillLinkValid:
+			links = append(links, e)
+		case skillLinkDangling:
+			for _, root := range skillRootDirs(home) {
+				if rel, err := filepath.Rel(root, e.Target); err == nil && !strings.HasPrefix(rel, "..") {
+					links = append(links, e)
+					break
+				}
+			}
+		}
+	}
+	return links
+}
+
+// detectUninstallItems lists what the installer may have left in home. Nothing
+// is selected to begin with.
+func detectUninstallItems(home string) []uninstallItem {
+	var items []uninstallItem
+	for _, c := range uninstallConfigs {
+		var found, shown []string
+		for _, rel := range c.rels {
+			path := filepath.Join(home, rel)
+			if _, err := os.Lstat(path); err == nil {
+				found = append(found, path)
+				shown = append(shown, contractHome(path))
+			}
+		}
+		if len(found) > 0 {
+			items = append(items, uninstallItem{
+				ID:         c.id,
+				Label:      fmt.Sprintf("%s (%s)", c.label, strings.Join(shown, ", ")),
+				Paths:      found,
+				BackupKeys: c.keys,
+			})
+		}
+	}
+	if n := len(installerSkillLinks(home)); n > 0 {
+		items = append(items, uninstallItem{ID: "skills", Label: fmt.Sprintf("Skill links (%d)", n)})
+	}
+	// Last, so skill links are classified while the catalog still exists
+	if dataDir := paths.DataDir(home); dirExists(dataDir) {
+		items = append(items, uninstallItem{
+			ID:    "gentleman",
+			Label: fmt.Sprintf("Gentleman data (%s)", contractHome(dataDir)),
+			Paths: []string{dataDir},
+		})
+	}
+	return items
+}
+
+func dirExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
+// removeDeployed removes what the installer put at root. A symlink goes only when it
+// resolves into repoRoot (the repo clone); regular files only when the deploy record
+// has them with their current content. A .zshrc the installer merged into loses
+// just its managed block. Everything else is reported as kept, and directories are
+// removed once they're empty.
+func removeDeployed(root, repoRoot string, rec *system.DeployRecord, res *uninstallResult) []string {
+	var removed []string
+	remove := func(path string) {
+		if err := os.Remove(path); err != nil {
+			res.Err = err.Error()
+			return
+		}
+		res.Removed++
+		removed = append(removed, path)
+	}
+	isLink := func(info os.FileInfo) bool { return info.Mode()&os.ModeSymlink != 0 }
+
+	info, err := os.Lstat(root)
+	if err != nil {
+		return nil
+	}
+	if !info.IsDir() {
+		switch {
+		case isLink(info) && repoRoot != "" && isWithin(repoRoot, root):
+			remove(root)
+		case !isLink(info) && rec.Matches(root):
+			remove(root)
+		case !isLink(info) && filepath.Base(root) == ".zshrc":
+			unmerged, err := system.UnmergeZshrc(root)
+			switch {
+			case err != nil:
+				res.Err = err.Error()
+			case unmerged:
+				res.Removed++
+			}
+			res.Kept = append(res.Kept, root)
+		default:
+			res.Kept = append(res.Kept, root)
+		}
+		return removed
+	}
+
+	var dirs []string
+	filepath.Walk(root, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+		switch {
+		case fi.IsDir():
+			dirs = append(dirs, path)
+		case isLink(fi) && repoRoot != "" && isWithin(repoRoot, path):
+			remove(path)
+		case fi.Mode().IsRegular() && rec.Matches(path):
+			remove(path)
+		default:
+			res.Kept = append(res.Kept, path)
+		}
+		return nil
+	})
+	// Deepest first; a directory that still holds kept files stays
+	sort.Sort(sort.Reverse(sort.StringSlice(dirs)))
+	for _, dir := range dirs {
+		os.Remove(dir)
+	}
+	return removed
+}
+
+// runUninstall removes the selected items and reports on each. The deploy
+// record is read before anything is removed, since it lives in the data dir.
+func runUninstall(home, repoRoot string, items []uninstallItem) []uninstallResult {
+	recordPath := system.DeployRecordPath(home)
+	rec, err := system.LoadDeployRecord(recordPath)
+	if err != nil {
+		rec = nil
+	}
+
+	var results []uninstallResult
+	var removedFiles []string
+	dataDirRemoved := false
+	for _, it := range items {
+		if !it.Selected {
+			continue
+		}
+		res := uninstallResult{Label: it.Label}
+		switch it.ID {
+		case "skills":
+			for _, e := range installerSkillLinks(home) {
+				if err := os.Remove(e.Path); err != nil {
+					res.Err = err.Error()
+					continue
+				}
+				res.Removed++
+			}
+		case "gentleman":
+			for _, p := range it.Paths {
+				if err := os.RemoveAll(p); err != nil {
+					res.Err = err.Error()
+					continue
+				}
+				res.Removed++
+				dataDirRemoved = true
+			}
+		default:
+			for _, p := range it.Paths {
+				removedFiles = append(removedFiles, removeDeployed(p, repoRoot, rec, &res)...)
+			}
+		}
+		results = append(results, res)
+	}
+
+	// Forget removed files so a later uninstall doesn't look for them
+	if rec != nil && !dataDirRemoved && len(removedFiles) > 0 {
+		for _, p := range removedFiles {
+			delete(rec.Files, p)
+		}
+		system.SaveDeployRecord(recordPath, rec)
+	}
+	return results
+}
+
+// uninstallCmd optionally backs up the selected configs, then removes the items
+func uninstallCmd(items []uninstallItem, backup bool, repoDir string) tea.Cmd {
+	repoRoot := resolvedDir(repoDir)
+	return func() tea.Msg {
+		home := os.Getenv("HOME")
+		var backupDir string
+		if backup {
+			var keys []string
+			for _, it := range items {
+				if it.Selected {
+					keys = append(keys, it.BackupKeys...)
+				}
+			}
+			if len(keys) > 0 {
+				dir, err := system.CreateBackup(keys)
+				if err != nil {
+					return uninstallCompleteMsg{err: fmt.Errorf("backup failed, nothing was removed: %w", err)}
+				}
+				backupDir = dir
+			}
+		}
+		return uninstallCompleteMsg{results: runUninstall(home, repoRoot, items), backupDir: backupDir}
+	}
+}
+
+// recordDeploys adds every config this install run deploys to the deploy
+// record, so uninstall can later tell the installer's files from the user's
+func (m *Model) recordDeploys() {
+	if m.StateHome == "" {
+		return
+	}
+	system.RecordDeploys(system.DeployRecordPath(m.StateHome))
+}
+
+// uninstallSelectedCount returns how many detected items are checked
+func (m Model) uninstallSelectedCount() int {
+	n := 0
+	for _, it := range m.UninstallItems {
+		if it.Selected {
+			n++
+		}
+	}
+	return n
+}
+
+// uninstallOptions lists one checkbox per detected item, then the actions
+func (m Model) uninstallOptions() []string {
+	if len(m.UninstallItems) == 0 {
+		return []string{"← Back"}
+	}
+	opts := make([]string, 0, len(m.UninstallItems)+4)
+	for _, it := range m.UninstallItems {
+		check := "[ ]"
+		if it.Selected {
+			check = "[x]"
+		}
+		opts = append(opts, check+" "+it.Label)
+	}
+	n := m.uninstallSelectedCount()
+	return append(opts,
+		"─────────────",
+		fmt.Sprintf("🧹 Uninstall %d selected (backup first)", n),
+		fmt.Sprintf("🧹 Uninstall %d selected without backup", n),
+		"← Back",
+	)
+}
+
+// enterUninstall scans home and opens the uninstall checklist
+func (m Model) enterUninstall(home string) (tea.Model, tea.Cmd) {
+	m.UninstallItems = detectUninstallItems(home)
+	m.UninstallResults = nil
+	m.UninstallBackup = ""
+	m.UninstallErr = ""
+	m.Screen = ScreenUninstall
+	m.Cursor = 0
+	return m, nil
+}
+
+func (m Model) handleUninstallKeys(key string) (tea.Model, tea.Cmd) {
+	options := m.canonicalOptions()
+	separatorIdx := len(m.UninstallItems)
+
+	switch key {
+	case "up", "k":
+		if m.Cursor > 0 {
+			m.Cursor--
+			if m.Cursor == separatorIdx && separatorIdx > 0 {
+				m.Cursor--
+			}
+		}
+	case "down", "j":
+		if m.Cursor < len(options)-1 {
+			m.Cursor++
+			if m.Cursor == separatorIdx {
+				m.Cursor++
+			}
+		}
+	case "enter", " ":
+		confirmIdx := -1
+		if separatorIdx > 0 {
+			confirmIdx = separatorIdx + 1 // Backup first is the default
+		}
+		if !m.multiSelectRuns(key, m.Cursor < separatorIdx, confirmIdx) {
+			break
+		}
+		switch {
+		case m.Cursor == len(options)-1: // Back
+			m.Screen = ScreenMainMenu
+			m.Cursor = 0
+		case m.Cursor < separatorIdx:
+			m.UninstallItems[m.Cursor].Selected = !m.UninstallItems[m.Cursor].Selected
+		case m.Cursor > separatorIdx:
+			if m.uninstallSelectedCount() == 0 {
+				return m, nil
+			}
+			backup := m.Cursor == separatorIdx+1
+			m.UninstallResults = nil
+			m.UninstallBackup = ""
+			m.UninstallErr = ""
+			m.UninstallRunning = true
+			m.Screen = ScreenUninstallResult
+			m.Cursor = 0
+			return m, uninstallCmd(m.UninstallItems, backup, m.RepoDir)
+		}
+	}
+
+	return m, nil
+}
+
+// handleUninstallComplete shows the results; a new backup joins the restore list
+func (m Model) handleUninstallComplete(msg uninstallCompleteMsg) (tea.Model, tea.Cmd) {
+	m.UninstallRunning = false
+	m.UninstallResults = msg.results
+	m.UninstallBackup = msg.backupDir
+	if msg.err != nil {
+		m.UninstallErr = msg.err.Error()
+	}
+	if msg.backupDir != "" {
+		m.AvailableBackups = system.ListBackups()
+	}
+	return m, nil
+}
+
+// renderUninstall renders the checklist of detected items
+func (m Model) renderUninstall() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
+	s.WriteString("\n\n")
+
+	for i, opt := range m.GetCurrentOptions() {
+		if strings.HasPrefix(opt, "───") {
+			s.WriteString(m.Theme.Muted.Render("  " + opt))
+			s.WriteString("\n")
+			continue
+		}
+		cursor := "  "
+		style := m.Theme.Unselected
+		if i == m.Cursor {
+			cursor = "▸ "
+			style = m.Theme.Selected
+		}
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
+		s.WriteString("\n")
+	}
+
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render("  Only files the installer deployed and you haven't edited are removed."))
+	s.WriteString("\n\n")
+	s.WriteString(m.Theme.Help.Render("↑/k up • ↓/j down • [Space] toggle • [Enter] confirm • [Esc] back"))
+	return s.String()
+}
+
+// maxKeptShown caps the kept paths listed per item on the result screen
+const maxKeptShown = 5
+
+// renderUninstallResult renders what was removed and what was left alone
+func (m Model) renderUninstallResult() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n\n")
+
+	if m.UninstallRunning {
+		s.WriteString(m.Theme.Muted.Render("  ⏳ Removing the selected items..."))
+		s.WriteString("\n")
+		return s.String()
+	}
+	if m.UninstallErr != "" {
+		s.WriteString(m.Theme.Error.Render("  ❌ " + m.UninstallErr))
+		s.WriteString("\n\n")
+	}
+	if m.UninstallBackup != "" {
+		s.WriteString(m.Theme.Info.Render("  📦 Backup saved to " + m.UninstallBackup))
+		s.WriteString("\n\n")
+	}
+
+	for _, r := range m.UninstallResults {
+		switch {
+		case r.Err != "":
+			s.WriteString(m.Theme.Error.Render(fmt.Sprintf("  ✗ %s: %s", r.Label, r.Err)))
+		case len(r.Kept) > 0:
+			s.WriteString(m.Theme.Warning.Render(fmt.Sprintf("  ⚠️  %s: removed %d, kept %d", r.Label, r.Removed, len(r.Kept))))
+		default:
+			s.WriteString(m.Theme.Success.Render(fmt.Sprintf("  ✓ %s: removed %d", r.Label, r.Removed)))
+		}
+		s.WriteString("\n")
+		for i, p := range r.Kept {
+			if i == maxKeptShown {
+				s.WriteString(m.Theme.Muted.Render(fmt.Sprintf("      …and %d more", len(r.Kept)-maxKeptShown)))
+				s.WriteString("\n")
+				break
+			}
+			s.WriteString(m.Theme.Muted.Render("      kept " + contractHome(p)))
+			s.WriteString("\n")
+		}
+	}
+	if len(m.UninstallResults) > 0 {
+		s.WriteString("\n")
+		s.WriteString(m.Theme.Muted.Render("  Kept files were edited after the install or weren't put there by it."))
+		s.WriteString("\n")
+	}
+
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render("  Press Enter to return to the main menu"))
+	return s.String()
+}
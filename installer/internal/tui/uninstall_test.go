@@ -0,0 +1,269 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// uninstallFixture deploys nvim and zsh from a fake repo clone with the deploy
+// record on, then leaves the home the way a user would: an extra nvim file, an
+// edited .zshrc, a tmux config linked into the clone, a ghostty config linked
+// elsewhere, and one skill link
+func uninstallFixture(t *testing.T) (home, repo string) {
+	t.Helper()
+	home = t.TempDir()
+	t.Setenv("HOME", home)
+	repo = filepath.Join(t.TempDir(), "Javi.Dots")
+
+	write := func(path, content string) {
+		t.Helper()
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write(filepath.Join(repo, "nvim", "init.lua"), "-- gentleman")
+	write(filepath.Join(repo, "nvim", "lua", "plugins.lua"), "return {}")
+	write(filepath.Join(repo, ".zshrc"), "# gentleman zsh")
+	write(filepath.Join(repo, "tmux.conf"), "set -g mouse on")
+
+	system.RecordDeploys(system.DeployRecordPath(home))
+	t.Cleanup(func() { system.RecordDeploys("") })
+	if err := system.DeployConfig(filepath.Join(repo, "nvim"), filepath.Join(home, ".config", "nvim")); err != nil {
+		t.Fatal(err)
+	}
+	if err := system.DeployConfig(filepath.Join(repo, ".zshrc"), filepath.Join(home, ".zshrc")); err != nil {
+		t.Fatal(err)
+	}
+	system.RecordDeploys("")
+
+	write(filepath.Join(home, ".config", "nvim", "lua", "user.lua"), "-- mine")
+	write(filepath.Join(home, ".zshrc"), "# gentleman zsh\nalias k=kubectl")
+	os.Symlink(filepath.Join(repo, "tmux.conf"), filepath.Join(home, ".tmux.conf"))
+	write(filepath.Join(home, "dotfiles", "ghostty", "config"), "theme = mine")
+	os.MkdirAll(filepath.Join(home, ".config"), 0755)
+	os.Symlink(filepath.Join(home, "dotfiles", "ghostty"), filepath.Join(home, ".config", "ghostty"))
+
+	skill := writeUndoCatalog(t, home, "react-19")
+	linkSkill(t, home, "react-19", skill.FullPath)
+	return home, repo
+}
+
+func selectAllUninstall(items []uninstallItem, except ...string) []uninstallItem {
+	for i := range items {
+		items[i].Selected = true
+		for _, id := range except {
+			if items[i].ID == id {
+				items[i].Selected = false
+			}
+		}
+	}
+	return items
+}
+
+func TestDetectUninstallItems(t *testing.T) {
+	home, _ := uninstallFixture(t)
+
+	var ids []string
+	for _, it := range detectUninstallItems(home) {
+		if it.Selected {
+			t.Errorf("%s should start unchecked", it.ID)
+		}
+		ids = append(ids, it.ID)
+	}
+	want := []string{"nvim", "zsh", "tmux", "ghostty", "skills", "gentleman"}
+	if !reflect.DeepEqual(ids, want) {
+		t.Errorf("detectUninstallItems() = %v, want %v", ids, want)
+	}
+}
+
+func TestRunUninstallKeepsUserFiles(t *testing.T) {
+	home, repo := uninstallFixture(t)
+	items := selectAllUninstall(detectUninstallItems(home), "gentleman")
+
+	results := runUninstall(home, resolvedDir(repo), items)
+	if len(results) != 5 {
+		t.Fatalf("expected one result per selected item, got %+v", results)
+	}
+
+	gone := []string{
+		".config/nvim/init.lua",
+		".config/nvim/lua/plugins.lua",
+		".tmux.conf",
+		".claude/skills/react-19",
+		".agents/skills/react-19",
+	}
+	for _, rel := range gone {
+		if _, err := os.Lstat(filepath.Join(home, rel)); !os.IsNotExist(err) {
+			t.Errorf("%s should be removed", rel)
+		}
+	}
+	kept := []string{
+		".config/nvim/lua/user.lua", // Added by the user
+		".zshrc",                    // Edited since the install
+		".config/ghostty",           // Links outside the clone
+		"dotfiles/ghostty/config",   // ...and what they point at
+		".gentleman/skills/curated/react-19/SKILL.md",
+	}
+	for _, rel := range kept {
+		if _, err := os.Lstat(filepath.Join(home, rel)); err != nil {
+			t.Errorf("%s should be left alone: %v", rel, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(repo, "tmux.conf")); err != nil {
+		t.Error("removing a link must not touch the repo clone")
+	}
+
+	nvim := results[0]
+	if nvim.Removed != 2 || len(nvim.Kept) != 1 || !strings.HasSuffix(nvim.Kept[0], "user.lua") {
+		t.Errorf("nvim result = %+v", nvim)
+	}
+
+	rec, err := system.LoadDeployRecord(system.DeployRecordPath(home))
+	if err != nil || rec == nil {
+		t.Fatalf("record should survive while ~/.gentleman is kept: %v", err)
+	}
+	if len(rec.Files) != 1 {
+		t.Errorf("removed files should leave the record, got %v", rec.Files)
+	}
+}
+
+// A merged .zshrc never matches the record: uninstall takes out the managed
+// block and keeps the rest
+func TestRunUninstallUnmergesZshrc(t *testing.T) {
+	home := t.TempDir()
+	zshrc := filepath.Join(home, ".zshrc")
+	mine := "# mine\nalias ll='ls -l'\n"
+	os.WriteFile(zshrc, []byte(mine), 0644)
+	if err := system.MergeZshrc(zshrc); err != nil {
+		t.Fatal(err)
+	}
+	config := filepath.Join(home, system.ZshMergeConfigPath)
+	os.MkdirAll(filepath.Dir(config), 0755)
+	os.WriteFile(config, []byte("# gentleman"), 0644)
+	sum, _ := system.HashFile(config)
+	system.SaveDeployRecord(system.DeployRecordPath(home), &system.DeployRecord{
+		Version: 1,
+		Files:   map[string]string{config: sum},
+	})
+
+	results := runUninstall(home, "", selectAllUninstall(detectUninstallItems(home), "gentleman"))
+	if len(results) != 1 || results[0].Removed != 2 || results[0].Err != "" {
+		t.Fatalf("expected the block and the merged config removed, got %+v", results)
+	}
+	if content, _ := os.ReadFile(zshrc); string(content) != mine {
+		t.Errorf("the user's .zshrc should be back as they wrote it, got %q", content)
+	}
+	if _, err := os.Stat(config); !os.IsNotExist(err) {
+		t.Error("the merged config should be removed")
+	}
+}
+
+func TestRunUninstallWithoutClone(t *testing.T) {
+	home, _ := uninstallFixture(t)
+	items := selectAllUninstall(detectUninstallItems(home))
+
+	// The clone is gone: links into it can't be verified and stay
+	results := runUninstall(home, "", items)
+	if _, err := os.Lstat(filepath.Join(home, ".tmux.conf")); err != nil {
+		t.Error("an unverifiable link should be kept")
+	}
+	if _, err := os.Stat(filepath.Join(home, ".gentleman")); !os.IsNotExist(err) {
+		t.Error("~/.gentleman should be removed")
+	}
+	if last := results[len(results)-1]; last.Removed != 1 || last.Err != "" {
+		t.Errorf("gentleman result = %+v", last)
+	}
+}
+
+func TestUninstallScreen(t *testing.T) {
+	home, repo := uninstallFixture(t)
+
+	m := NewModel()
+	m.RepoDir = repo
+	m.Screen = ScreenMainMenu
+	for i, opt := range m.GetCurrentOptions() {
+		if opt == "🧹 Uninstall" {
+			m.Cursor = i
+		}
+	}
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = result.(Model)
+	if m.Screen != ScreenUninstall || len(m.UninstallItems) != 6 {
+		t.Fatalf("expected the uninstall checklist, got %v with %d items", m.Screen, len(m.UninstallItems))
+	}
+
+	// Confirming with nothing checked stays put
+	m.Cursor = len(m.UninstallItems) + 1
+	result, cmd := m.handleUninstallKeys("enter")
+	m = result.(Model)
+	if m.Screen != ScreenUninstall || cmd != nil {
+		t.Fatal("nothing selected should not start an uninstall")
+	}
+
+	// Space toggles nvim; moving up from the actions skips the separator
+	m.Cursor = 0
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	m = result.(Model)
+	if !m.UninstallItems[0].Selected || m.LeaderMode {
+		t.Fatal("space should toggle the item, not open leader mode")
+	}
+	m.Cursor = len(m.UninstallItems) + 1
+	result, _ = m.handleUninstallKeys("up")
+	if got := result.(Model).Cursor; got != len(m.UninstallItems)-1 {
+		t.Errorf("cursor should skip the separator, got %d", got)
+	}
+
+	// Back up first, then remove
+	result, cmd = m.handleUninstallKeys("enter")
+	m = result.(Model)
+	if m.Screen != ScreenUninstallResult || !m.UninstallRunning || cmd == nil {
+		t.Fatalf("confirming should run the uninstall, got %v", m.Screen)
+	}
+	result, _ = m.Update(cmd())
+	m = result.(Model)
+	if m.UninstallRunning || m.UninstallBackup == "" {
+		t.Fatalf("expected a finished run with a backup, got %+v", m.UninstallResults)
+	}
+	if data, err := os.ReadFile(filepath.Join(m.UninstallBackup, "nvim", "init.lua")); err != nil || string(data) != "-- gentleman" {
+		t.Errorf("backup should hold the removed config: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(home, ".config", "nvim", "init.lua")); !os.IsNotExist(err) {
+		t.Error("init.lua should be removed")
+	}
+
+	view := m.renderUninstallResult()
+	for _, want := range []string{"Backup saved to", "removed 2, kept 1", "kept ~/.config/nvim/lua/user.lua"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("result should show %q, got:\n%s", want, view)
+		}
+	}
+
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if got := result.(Model).Screen; got != ScreenMainMenu {
+		t.Errorf("enter should return to the main menu, got %v", got)
+	}
+}
+
+func TestUninstallScreenNothingFound(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	m := NewModel()
+	result, _ := m.enterUninstall(os.Getenv("HOME"))
+	m = result.(Model)
+	if opts := m.GetCurrentOptions(); len(opts) != 1 {
+		t.Fatalf("an empty home should only offer Back, got %v", opts)
+	}
+	result, _ = m.handleUninstallKeys("enter")
+	if got := result.(Model).Screen; got != ScreenMainMenu {
+		t.Errorf("Back should return to the main menu, got %v", got)
+	}
+}
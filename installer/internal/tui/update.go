@@ -1,14 +1,19 @@
 package tui
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"sort"
 	"strings"
 	"time"
+	"unicode/utf8"
 
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
 	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
 	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/tui/trainer"
 	tea "github.com/charmbracelet/bubbletea"
@@ -24,8 +29,11 @@ type (
 
 	// stepCompleteMsg signals a step completed
 	stepCompleteMsg struct {
-		stepID string
-		err    error
+		stepID     string
+		err        error
+		backupDir  string        // Set when the step created a backup
+		repoCommit RepoCommit    // Set when the step cloned the dotfiles repo
+		update     updateSummary // The step's part of an update's summary
 	}
 
 	// stepProgressMsg updates progress of current step
@@ -45,6 +53,11 @@ type (
 		backups []system.BackupInfo
 	}
 
+	// backupDiskSizesMsg carries the on-disk size of each backup, by path
+	backupDiskSizesMsg struct {
+		sizes map[string]int64
+	}
+
 	// execFinishedMsg signals an interactive process finished
 	execFinishedMsg struct {
 		stepID string
@@ -58,15 +71,28 @@ type (
 
 	// Skill manager messages
 	skillsLoadedMsg struct {
-		skills []SkillInfo
-		err    error
+		skills   []SkillInfo
+		warnings []SkillCatalogWarning
+		err      error
 	}
 	skillActionCompleteMsg struct {
 		logLines []string
 		err      error
+		undo     *skillOperation // What the skill menu can undo afterwards
 	}
 	skillUpdateCompleteMsg struct {
-		err error
+		changes *skillCatalogChanges // What the pull changed for the linked skills
+		err     error
+	}
+
+	// backupSizesMsg carries the size breakdown of configs about to be backed up
+	backupSizesMsg struct {
+		sizes []system.BackupSizeEstimate
+	}
+
+	// aiToolsDetectedMsg reports which AI tools already have a config directory
+	aiToolsDetectedMsg struct {
+		tools []string
 	}
 )
 
@@ -74,8 +100,10 @@ type (
 func (m Model) Init() tea.Cmd {
 	return tea.Batch(
 		tea.SetWindowTitle("Javi.Dots Installer"),
-		tickCmd(),
 		loadBackupsCmd(),
+		loadChoiceProfilesCmd(),
+		detectAIToolsCmd(),
+		detectExistingInstallCmd(m.RepoDir),
 	)
 }
 
@@ -92,6 +120,39 @@ func loadBackupsCmd() tea.Cmd {
 	}
 }
 
+// backupDiskSizesCmd walks the backups in the background, so listing them
+// at startup stays fast
+func backupDiskSizesCmd(backups []system.BackupInfo) tea.Cmd {
+	if len(backups) == 0 {
+		return nil
+	}
+	return func() tea.Msg {
+		sizes := map[string]int64{}
+		for _, b := range system.ComputeBackupSizes(backups) {
+			sizes[b.Path] = b.Size
+		}
+		return backupDiskSizesMsg{sizes: sizes}
+	}
+}
+
+// hasExistingZshrc reports whether the user already has a .zshrc worth preserving
+func hasExistingZshrc() bool {
+	info, err := os.Stat(system.ConfigPaths()["zsh"])
+	return err == nil && info.Size() > 0
+}
+
+func estimateBackupSizesCmd(configs []string) tea.Cmd {
+	return func() tea.Msg {
+		return backupSizesMsg{sizes: system.EstimateBackupSizes(configs)}
+	}
+}
+
+func detectAIToolsCmd() tea.Cmd {
+	return func() tea.Msg {
+		return aiToolsDetectedMsg{tools: detectInstalledAITools(os.Getenv("HOME"))}
+	}
+}
+
 // expandPath expands a leading ~/ to the user's home directory
 func expandPath(p string) string {
 	if strings.HasPrefix(p, "~/") {
@@ -108,162 +169,269 @@ func ExpandPath(p string) string {
 	return expandPath(p)
 }
 
-// detectStack detects the project stack from indicator files in the given directory
-func detectStack(path string) string {
-	indicators := map[string]string{
-		"angular.json":     "angular",
-		"package.json":     "node",
-		"go.mod":           "go",
-		"Cargo.toml":       "rust",
-		"pom.xml":          "java",
-		"pyproject.toml":   "python",
-		"requirements.txt": "python",
-		"Gemfile":          "ruby",
-		"composer.json":    "php",
+// Update implements tea.Model.
+// Tick scheduling is owned here: ticks only run while something is animating, and
+// the fast cadence is resumed whenever a handler moves the model into an animated state.
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	result, cmd := m.update(msg)
+	next, ok := result.(Model)
+	if !ok {
+		return result, cmd
 	}
-	for file, stack := range indicators {
-		if _, err := os.Stat(filepath.Join(path, file)); err == nil {
-			return stack
-		}
+	if next.needsAnimation() && !next.Ticking {
+		next.Ticking = true
+		return next, tea.Batch(cmd, tickCmd())
 	}
-	return "unknown"
+	return next, cmd
 }
 
-// Update implements tea.Model
-func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+// needsAnimation reports whether the current state renders something that changes over time
+func (m Model) needsAnimation() bool {
+	return m.Screen == ScreenInstalling || m.Screen == ScreenProjectInstalling || m.Screen == ScreenSkillUpdate || m.SkillLoading || m.DiagnoseRunning || m.InstallCheckRunning ||
+		m.timedRunning() || m.bossFighting() || m.LeaderMode || m.Toast != ""
+}
+
+func (m Model) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		return m.handleKeyPress(msg)
 
+	case tea.MouseMsg:
+		return m.handleMouse(msg)
+
 	case tea.WindowSizeMsg:
 		m.Width = msg.Width
 		m.Height = msg.Height
+		// The exercise reflows under the user; don't spend their time on it
+		m = m.pauseTimedRun()
 		return m, nil
 
+	case tea.ResumeMsg:
+		// Back from ctrl+z: drop keys typed at the shell, repaint whatever the
+		// shell left on screen and re-measure the terminal, which may have been
+		// resized meanwhile. A tick that fired while stopped can be lost, so
+		// Update schedules a fresh one if still animating.
+		m.regainScreen()
+		m = m.pauseTimedRun()
+		m.Ticking = false
+		return m, tea.Batch(tea.ClearScreen, tea.WindowSize())
+
 	case tickMsg:
-		// Animate spinner during installation
-		if m.Screen == ScreenInstalling || m.Screen == ScreenProjectInstalling || m.Screen == ScreenSkillUpdate || m.SkillLoading {
-			m.SpinnerFrame++
+		m = m.expireLeader(time.Time(msg))
+		m = m.expireToast(time.Time(msg))
+		if !m.needsAnimation() {
+			// Idle: stop ticking until an animated state resumes it
+			m.Ticking = false
+			return m, nil
 		}
-		// Continue ticking for animations
+		// Animate spinner and keep ticking
+		m.SpinnerFrame++
+		m = m.checkTimedRun()
 		return m, tickCmd()
 
 	case installStartMsg:
-		// Start the installation process
-		return m, m.runNextStep()
+		// Start the installation process; a retried or skipped step keeps the original start
+		if m.InstallStarted.IsZero() {
+			m.InstallStarted = installClock()
+		}
+		m.startInstallContext()
+		return m.runReadySteps()
 
 	case stepProgressMsg:
 		// Update progress
-		for i := range m.Steps {
-			if m.Steps[i].ID == msg.stepID {
-				m.Steps[i].Progress = msg.progress
-				break
+		// Plain SendLog lines carry no progress and must not reset the bar
+		if msg.progress > 0 {
+			for i := range m.Steps {
+				if m.Steps[i].ID == msg.stepID {
+					m.Steps[i].Progress = msg.progress
+					break
+				}
 			}
 		}
-		if msg.log != "" {
-			m.LogLines = append(m.LogLines, msg.log)
-			// Keep only last 20 lines
-			if len(m.LogLines) > 20 {
-				m.LogLines = m.LogLines[len(m.LogLines)-20:]
+		// Child output may carry escapes and \r progress updates; only the
+		// cleaned-up line is drawn (the message itself keeps the raw text).
+		// Lines are kept whole and clamped when drawn, to the width of the moment.
+		if line := sanitizeLogLine(msg.log, 0); line != "" {
+			// Say whose line it is while several steps share the log
+			if stepsRunning(m.Steps) > 1 && msg.stepID != "" {
+				line = sanitizeLogLine("["+msg.stepID+"] "+msg.log, 0)
 			}
+			m.LogLines.Add(line)
 		}
 		return m, nil
 
 	case stepCompleteMsg:
-		// Mark step as complete
-		for i := range m.Steps {
-			if m.Steps[i].ID == msg.stepID {
-				if msg.err != nil {
-					m.Steps[i].Status = StatusFailed
-					m.Steps[i].Error = msg.err
-					m.Screen = ScreenError
-					// Include step name in error message for clarity
-					m.ErrorMsg = fmt.Sprintf("Step '%s' failed:\n%s", m.Steps[i].Name, msg.err.Error())
-					return m, nil
-				}
-				m.Steps[i].Status = StatusDone
-				m.Steps[i].Progress = 1.0
-				break
+		if msg.err == nil {
+			if msg.backupDir != "" {
+				m.BackupDir = msg.backupDir
+			}
+			if msg.repoCommit.Hash != "" {
+				m.RepoCommit = msg.repoCommit
 			}
+			m.UpdateSummary.merge(msg.update)
 		}
-		m.CurrentStep++
-		return m, m.runNextStep()
+		return m.finishStep(msg.stepID, msg.err)
 
 	case installCompleteMsg:
 		m.TotalTime = msg.totalTime
+		m.InstallStarted = time.Time{}
+		m.releaseInstallContext()
+		activeLog.Write("", fmt.Sprintf("Installation complete in %s", formatElapsed(time.Duration(msg.totalTime*float64(time.Second)))))
+		activeLog.Close()
+		system.RecordDeploys("")
+		if m.DiagnoseFixMode {
+			// A diagnose fix finished: re-run the checks to show whether it worked
+			m.DiagnoseFixMode = false
+			return m.startDiagnosis()
+		}
 		m.Screen = ScreenComplete
-		return m, nil
+		m.finishProgress()
+		done := m.notifyCmd(m.Tf("notify.install_done", formatElapsed(time.Duration(msg.totalTime*float64(time.Second)))))
+		// Snapshot what was deployed so a later restore can spot user edits
+		if m.BackupDir != "" {
+			if err := system.RecordDeployedHashes(m.BackupDir); err != nil {
+				m.LogLines.Add("⚠️  Could not record deployed config hashes: " + err.Error())
+			}
+		}
+		if m.RepoCommit.Hash != "" {
+			manifest, err := recordInstallManifest(execRunner, os.Getenv("HOME"), &m)
+			if err != nil {
+				m.LogLines.Add("⚠️  Could not write the install manifest: " + err.Error())
+			}
+			m.InstallManifest = manifest
+		}
+		return m, done
 
 	case loadBackupsMsg:
 		m.AvailableBackups = msg.backups
 		return m, nil
 
-	case execFinishedMsg:
-		// Interactive process finished (sudo commands, chsh, etc)
-		for i := range m.Steps {
-			if m.Steps[i].ID == msg.stepID {
-				if msg.err != nil {
-					m.Steps[i].Status = StatusFailed
-					m.Steps[i].Error = msg.err
-					m.Screen = ScreenError
-					// Include step name in error message for clarity
-					m.ErrorMsg = fmt.Sprintf("Step '%s' failed:\n%s", m.Steps[i].Name, msg.err.Error())
-					return m, nil
-				}
-				m.Steps[i].Status = StatusDone
-				m.Steps[i].Progress = 1.0
-				break
+	case backupDiskSizesMsg:
+		// The list may have changed while sizing; fill in the backups still in it
+		for i, b := range m.AvailableBackups {
+			if size, ok := msg.sizes[b.Path]; ok {
+				m.AvailableBackups[i].Size = size
 			}
 		}
-		m.CurrentStep++
-		return m, m.runNextStep()
+		return m, nil
+
+	case choiceProfilesMsg:
+		m.ChoiceProfiles = msg.profiles
+		return m, nil
+
+	case backupSizesMsg:
+		m.BackupSizes = msg.sizes
+		m.BackupSizing = false
+		return m, nil
+
+	case diagnosisCompleteMsg:
+		m.DiagnoseResults = msg.results
+		m.DiagnoseRunning = false
+		m.Cursor = 0
+		return m, nil
+
+	case installCheckCompleteMsg:
+		m.InstallCheckResults = msg.results
+		m.InstallCheckRunning = false
+		return m, nil
+
+	case aiToolsDetectedMsg:
+		m.DetectedAITools = msg.tools
+		return m, nil
+
+	case existingInstallMsg:
+		m.ExistingInstall = msg.install
+		return m, nil
+
+	case execFinishedMsg:
+		// Interactive process finished (sudo commands, chsh, etc)
+		m.regainScreen()
+		return m.finishStep(msg.stepID, msg.err)
 
 	case projectInstallStartMsg:
-		return m, m.runProjectInit()
+		m.TaskStarted = installClock()
+		if m.ProjectBatch != nil {
+			return m.startProjectBatch()
+		}
+		return m.runProjectInit()
+
+	case projectBatchStepMsg:
+		return m.handleProjectBatchStep(msg)
+
+	case recentProjectsLoadedMsg:
+		m.ProjectRecent = msg.projects
+		return m, nil
 
 	case projectInstallLogMsg:
-		m.ProjectLogLines = append(m.ProjectLogLines, msg.line)
-		if len(m.ProjectLogLines) > 30 {
-			m.ProjectLogLines = m.ProjectLogLines[len(m.ProjectLogLines)-30:]
-		}
+		m.ProjectLogLines.Add(msg.line)
 		return m, nil
 
 	case projectInstallCompleteMsg:
+		m = m.releaseProjectInit()
+		body := m.Tf("notify.project_done", m.ProjectPathInput)
 		if msg.err != nil {
 			m.ErrorMsg = msg.err.Error()
+			body = m.Tf("notify.project_failed", m.ProjectPathInput)
 		}
 		m.Screen = ScreenProjectResult
+		cmd := m.finishLongTask(body)
+		return m, cmd
+
+	case skillCloneProgressMsg:
+		m.SkillCloneProgress = msg.progress
 		return m, nil
 
 	case skillsLoadedMsg:
 		m.SkillLoading = false
+		m.SkillCloneProgress = 0
 		if msg.err != nil {
 			m.SkillLoadError = msg.err.Error()
 		} else {
-			m.SkillCatalog = msg.skills
-			// Initialize selection booleans based on current screen
-			if m.Screen == ScreenSkillInstall {
-				notInstalled := m.getNotInstalledSkills()
-				m.SkillSelected = make([]bool, len(notInstalled))
-			} else if m.Screen == ScreenSkillRemove {
-				installed := m.getInstalledSkills()
-				m.SkillSelected = make([]bool, len(installed))
-			}
+			// Rebuild selection booleans for the current screen, keeping any
+			// checkmarks made before a mid-selection reload
+			m.applySkillCatalog(msg.skills)
+			m.SkillCatalogWarnings = msg.warnings
 		}
 		return m, nil
 
 	case skillUpdateCompleteMsg:
 		m.SkillLoading = false
+		m.SkillCloneProgress = 0
 		if msg.err != nil {
 			m.SkillLoadError = msg.err.Error()
 		} else {
-			m.SkillResultLog = []string{"✅ Catalog updated successfully"}
+			home, _ := os.UserHomeDir()
+			m.setSkillResultLog(msg.changes.logLines(home))
+			if msg.changes != nil {
+				m.SkillOrphans = msg.changes.Orphaned
+			}
+			m.SkillStats = nil
 		}
 		m.Screen = ScreenSkillResult
+		body := m.T("notify.skills_updated")
+		if msg.err != nil {
+			body = m.T("notify.skills_update_failed")
+		}
+		cmd := m.finishLongTask(body)
+		return m, cmd
+
+	case skillStatsMsg:
+		m.SkillLoading = false
+		if msg.err != nil {
+			m.SkillLoadError = msg.err.Error()
+		} else {
+			m.SkillStats = &msg.stats
+		}
 		return m, nil
 
+	case uninstallCompleteMsg:
+		return m.handleUninstallComplete(msg)
+
 	case skillActionCompleteMsg:
-		m.SkillResultLog = msg.logLines
+		// Installed counts changed
+		m.SkillStats = nil
+		m.setSkillResultLog(msg.logLines)
+		m.SkillUndo = msg.undo
 		if msg.err != nil {
 			m.ErrorMsg = msg.err.Error()
 		}
@@ -280,6 +448,34 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// inputSettleDelay is how long key events are ignored after an exec process returns
+const inputSettleDelay = 100 * time.Millisecond
+
+// toastDuration is how long a toast stays in place of the footer
+const toastDuration = 3 * time.Second
+
+// showToast shows text in place of the footer for toastDuration
+func (m Model) showToast(text string) Model {
+	m.Toast = text
+	m.ToastUntil = time.Now().Add(toastDuration)
+	return m
+}
+
+// expireToast clears the toast once its time is up
+func (m Model) expireToast(now time.Time) Model {
+	if m.Toast != "" && !now.Before(m.ToastUntil) {
+		m.Toast = ""
+	}
+	return m
+}
+
+// regainScreen clears key state that may be stale after the TUI was suspended
+// for an exec process, and starts the input-settle window
+func (m *Model) regainScreen() {
+	m.LeaderMode = false
+	m.InputSettleUntil = time.Now().Add(inputSettleDelay)
+}
+
 // execInteractiveCmd creates a tea.Cmd that runs an interactive process
 // This suspends the TUI and gives full terminal control to the process
 func execInteractiveCmd(stepID string, name string, args ...string) tea.Cmd {
@@ -289,48 +485,69 @@ func execInteractiveCmd(stepID string, name string, args ...string) tea.Cmd {
 	})
 }
 
-// runProjectInit returns a tea.Cmd that executes the project initialization
-func (m Model) runProjectInit() tea.Cmd {
+// runProjectInit returns a tea.Cmd that executes the project initialization,
+// keeping its cancel func so Esc can stop it
+func (m Model) runProjectInit() (Model, tea.Cmd) {
+	ctx, cancel := context.WithCancel(context.Background())
+	m.ProjectInitCancel = cancel
 	path := expandPath(m.ProjectPathInput)
 	memory := m.ProjectMemory
 	ci := m.ProjectCI
 	engram := m.ProjectEngram
 	rolePacks := m.ProjectRolePacks
-	return func() tea.Msg {
-		err := runProjectInitScript(path, memory, ci, engram, rolePacks)
+	return m, func() tea.Msg {
+		err := runProjectInitScript(ctx, path, memory, ci, engram, rolePacks)
 		return projectInstallCompleteMsg{err: err}
 	}
 }
 
+// releaseProjectInit drops the cancel func of a project init that has ended
+func (m Model) releaseProjectInit() Model {
+	if m.ProjectInitCancel != nil {
+		m.ProjectInitCancel()
+		m.ProjectInitCancel = nil
+	}
+	return m
+}
+
 // loadSkillsCmd returns a tea.Cmd that fetches the skill catalog
 func loadSkillsCmd() tea.Cmd {
 	return func() tea.Msg {
-		skills, err := fetchSkillCatalog()
-		return skillsLoadedMsg{skills: skills, err: err}
+		skills, warnings, err := fetchSkillCatalog()
+		return skillsLoadedMsg{skills: skills, warnings: warnings, err: err}
 	}
 }
 
-// fetchSkillCatalog reads the centralized skills directory and returns SkillInfo for each skill.
+// fetchSkillCatalog reads the centralized skills directory and returns SkillInfo for each skill,
+// plus a warning for each catalog directory it had to skip.
 // Source: ~/.gentleman/skills/ (cloned by setupCentralizedSkills or on-demand here).
-func fetchSkillCatalog() ([]SkillInfo, error) {
+func fetchSkillCatalog() ([]SkillInfo, []SkillCatalogWarning, error) {
 	home, err := os.UserHomeDir()
 	if err != nil {
-		return nil, fmt.Errorf("cannot determine home directory: %w", err)
+		return nil, nil, fmt.Errorf("cannot determine home directory: %w", err)
 	}
-	centralDir := filepath.Join(home, ".gentleman", "skills")
+	centralDir := paths.SkillsDir(home)
 
 	// If central dir doesn't exist, clone it
 	if _, err := os.Stat(centralDir); os.IsNotExist(err) {
-		os.MkdirAll(filepath.Join(home, ".gentleman"), 0755)
-		cmd := exec.Command("git", "clone", "--depth", "1",
-			"https://github.com/Gentleman-Programming/Gentleman-Skills.git", centralDir)
-		if err := cmd.Run(); err != nil {
-			return nil, fmt.Errorf("failed to clone skills repo: %w", err)
+		os.MkdirAll(paths.DataDir(home), 0755)
+		opts := system.CloneOptions{Progress: sendSkillCloneProgress}
+		if _, err := system.GitClone(context.Background(), cloneURLs(skillsRepoURL, UserChoices{}), centralDir, opts); err != nil {
+			return nil, nil, fmt.Errorf("failed to clone skills repo: %w", err)
 		}
 	}
+	skills, warnings := readSkillCatalog(home)
+	return skills, warnings, nil
+}
+
+// readSkillCatalog scans the catalog already on disk, without cloning it.
+// A missing catalog leaves only the plugins and external skills.
+func readSkillCatalog(home string) ([]SkillInfo, []SkillCatalogWarning) {
+	centralDir := paths.SkillsDir(home)
 
 	// Scan curated/ and community/ subdirs from Gentleman-Skills repo
 	var skills []SkillInfo
+	var warnings []SkillCatalogWarning
 	repoSkillPaths := make(map[string]bool) // track repo skill FullPaths to avoid duplicates
 	for _, category := range []string{"curated", "community"} {
 		dir := filepath.Join(centralDir, category)
@@ -344,30 +561,54 @@ func fetchSkillCatalog() ([]SkillInfo, error) {
 			}
 			skillDir := filepath.Join(dir, entry.Name())
 			skillFile := filepath.Join(skillDir, "SKILL.md")
-			if _, err := os.Stat(skillFile); err != nil {
+			if reason := skillFileProblem(skillFile); reason != "" {
+				warnings = append(warnings, SkillCatalogWarning{Path: skillDir, Reason: reason})
 				continue
 			}
 
-			name, desc, _, _ := parseSkillFrontmatter(skillFile)
+			meta := parseSkillFrontmatter(skillFile)
+			name := meta.Name
 			if name == "" {
 				name = entry.Name()
 			}
+			if strings.TrimSpace(name) == "" {
+				warnings = append(warnings, SkillCatalogWarning{Path: skillDir, Reason: skillWarningBlankName})
+				continue
+			}
 
-			installed := isSkillInstalled(home, name)
+			installedIn := skillInstalledTargets(home, name)
 			repoSkillPaths[skillDir] = true
 
 			skills = append(skills, SkillInfo{
 				Name:        name,
-				Description: desc,
+				Description: meta.Description,
 				Category:    category,
 				DirName:     entry.Name(),
 				FullPath:    skillDir,
-				Installed:   installed,
+				Installed:   len(installedIn) > 0,
+				InstalledIn: installedIn,
 				Type:        "skill",
+				Tags:        meta.Tags,
+				Permissions: meta.Permissions,
 			})
 		}
 	}
 
+	// Skills cloned from their own repositories with Install from URL
+	external, externalWarnings := scanExternalSkills(home)
+	skills = append(skills, external...)
+	warnings = append(warnings, externalWarnings...)
+
+	// Skills differing only by case share a link on case-insensitive
+	// filesystems, so a name lookup can't tell which one is installed
+	markSkillCollisions(skills)
+	for i := range skills {
+		if skills[i].CollidesWith != "" {
+			skills[i].InstalledIn = skillLinkedTargets(home, skills[i])
+			skills[i].Installed = len(skills[i].InstalledIn) > 0
+		}
+	}
+
 	// Scan GentlemanClaude/plugins/ from the repo clone
 	pluginDir := filepath.Join(centralDir, "..", "..", "GentlemanClaude", "plugins")
 	// Resolve in case of symlinks or relative paths
@@ -388,7 +629,8 @@ func fetchSkillCatalog() ([]SkillInfo, error) {
 			if _, err := os.Stat(pluginFile); err != nil {
 				continue
 			}
-			name, desc, _, perms := parseSkillFrontmatter(pluginFile)
+			meta := parseSkillFrontmatter(pluginFile)
+			name := meta.Name
 			if name == "" {
 				name = entry.Name()
 			}
@@ -396,13 +638,14 @@ func fetchSkillCatalog() ([]SkillInfo, error) {
 
 			skills = append(skills, SkillInfo{
 				Name:        name,
-				Description: desc,
+				Description: meta.Description,
 				Category:    "plugin",
 				DirName:     entry.Name(),
 				FullPath:    pDir,
 				Installed:   installed,
 				Type:        "plugin",
-				Permissions: perms,
+				Tags:        meta.Tags,
+				Permissions: meta.Permissions,
 			})
 		}
 	}
@@ -412,7 +655,7 @@ func fetchSkillCatalog() ([]SkillInfo, error) {
 	localSkills := scanLocalSkills(claudeSkillsDir, centralDir, repoSkillPaths)
 	skills = append(skills, localSkills...)
 
-	return skills, nil
+	return skills, warnings
 }
 
 // scanLocalSkills walks ~/.claude/skills/ looking for SKILL.md files in directories
@@ -458,18 +701,21 @@ func scanLocalSkills(claudeDir, repoDir string, repoSkillPaths map[string]bool)
 			if repoSkillPaths[entryPath] {
 				continue
 			}
-			name, desc, _, _ := parseSkillFrontmatter(skillFile)
+			meta := parseSkillFrontmatter(skillFile)
+			name := meta.Name
 			if name == "" {
 				name = entry.Name()
 			}
 			skills = append(skills, SkillInfo{
 				Name:        name,
-				Description: desc,
+				Description: meta.Description,
 				Category:    "local",
 				DirName:     entry.Name(),
 				FullPath:    entryPath,
 				Installed:   true, // it's in ~/.claude/skills/, so it's installed
 				Type:        "skill",
+				Tags:        meta.Tags,
+				Permissions: meta.Permissions,
 			})
 		} else {
 			// Parent directory with sub-skills (e.g. backend/api-gateway/, frontend/astro-ssr/)
@@ -489,18 +735,21 @@ func scanLocalSkills(claudeDir, repoDir string, repoSkillPaths map[string]bool)
 				if repoSkillPaths[subPath] {
 					continue
 				}
-				name, desc, _, _ := parseSkillFrontmatter(subSkillFile)
+				meta := parseSkillFrontmatter(subSkillFile)
+				name := meta.Name
 				if name == "" {
 					name = sub.Name()
 				}
 				skills = append(skills, SkillInfo{
 					Name:        name,
-					Description: desc,
+					Description: meta.Description,
 					Category:    "local:" + entry.Name(),
 					DirName:     sub.Name(),
 					FullPath:    subPath,
 					Installed:   true,
 					Type:        "skill",
+					Tags:        meta.Tags,
+					Permissions: meta.Permissions,
 				})
 			}
 		}
@@ -517,7 +766,8 @@ func scanLocalSkillDir(entryPath, resolvedPath, dirName, parentGroup string, rep
 	if _, err := os.Stat(skillFile); err != nil {
 		return
 	}
-	name, desc, _, _ := parseSkillFrontmatter(skillFile)
+	meta := parseSkillFrontmatter(skillFile)
+	name := meta.Name
 	if name == "" {
 		name = dirName
 	}
@@ -527,95 +777,154 @@ func scanLocalSkillDir(entryPath, resolvedPath, dirName, parentGroup string, rep
 	}
 	*skills = append(*skills, SkillInfo{
 		Name:        name,
-		Description: desc,
+		Description: meta.Description,
 		Category:    cat,
 		DirName:     dirName,
 		FullPath:    resolvedPath,
 		Installed:   true,
 		Type:        "skill",
+		Tags:        meta.Tags,
+		Permissions: meta.Permissions,
 	})
 }
 
+// SkillMeta is the YAML frontmatter of a SKILL.md/PLUGIN.md
+type SkillMeta struct {
+	Name        string
+	Description string // every description line, newline-separated
+	Type        string
+	Tags        []string
+	Permissions []string // "allowed-tools:" of skills or "permissions:" of plugins
+}
+
 // parseSkillFrontmatter does simple line-by-line parsing of SKILL.md/PLUGIN.md YAML frontmatter.
-// Extracts "name:", "description:", "type:", and "permissions:" fields.
-func parseSkillFrontmatter(path string) (name, description, skillType string, permissions []string) {
+// Only the first description line is kept, for display in lists.
+func parseSkillFrontmatter(path string) SkillMeta {
+	meta := parseSkillDocument(path).SkillMeta
+	meta.Description, _, _ = strings.Cut(meta.Description, "\n")
+	return meta
+}
+
+// skillDocument is a parsed SKILL.md/PLUGIN.md: the frontmatter fields plus the markdown after it
+type skillDocument struct {
+	SkillMeta
+	Body string
+}
+
+// parseSkillDocument parses the frontmatter like parseSkillFrontmatter and also returns the
+// body. A file without frontmatter is all body; a missing file yields an empty document.
+// Lists may be inline ("tags: [a, b]", "allowed-tools: Read, Grep") or one "- item" per line.
+func parseSkillDocument(path string) skillDocument {
+	var doc skillDocument
 	data, err := os.ReadFile(path)
 	if err != nil {
-		return "", "", "", nil
+		return doc
 	}
 	lines := strings.Split(string(data), "\n")
 	if len(lines) == 0 || strings.TrimSpace(lines[0]) != "---" {
-		return "", "", "", nil
+		doc.Body = strings.TrimSpace(string(data))
+		return doc
 	}
 
 	inDescription := false
-	inPermissions := false
+	var list *[]string // the list whose "- item" lines follow
 	var descLines []string
 
-	for _, line := range lines[1:] {
+	for i, line := range lines[1:] {
 		trimmed := strings.TrimSpace(line)
 		if trimmed == "---" {
+			doc.Body = strings.TrimSpace(strings.Join(lines[i+2:], "\n"))
 			break
 		}
 
-		// Check if this is a new top-level key (not indented or starts with a key)
-		if !strings.HasPrefix(line, " ") && !strings.HasPrefix(line, "\t") && strings.Contains(line, ":") {
+		indented := strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")
+		key, value, isKey := strings.Cut(trimmed, ":")
+		if !indented && isKey {
+			// A new top-level key ends the previous multi-line value
 			inDescription = false
-			inPermissions = false
-		}
-
-		if strings.HasPrefix(trimmed, "name:") {
-			name = strings.TrimSpace(strings.TrimPrefix(trimmed, "name:"))
-		} else if strings.HasPrefix(trimmed, "type:") {
-			skillType = strings.TrimSpace(strings.TrimPrefix(trimmed, "type:"))
-		} else if strings.HasPrefix(trimmed, "description:") {
-			rest := strings.TrimSpace(strings.TrimPrefix(trimmed, "description:"))
-			if rest == ">" || rest == "|" {
-				// Multi-line scalar, collect following indented lines
-				inDescription = true
-			} else {
-				descLines = append(descLines, rest)
-			}
-		} else if trimmed == "permissions:" {
-			inPermissions = true
-		} else if inDescription {
-			// Continuation of multi-line description (indented lines)
-			if strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") {
-				descLines = append(descLines, trimmed)
-			} else {
-				inDescription = false
+			list = nil
+			value = strings.TrimSpace(value)
+			switch key {
+			case "name":
+				doc.Name = value
+			case "type":
+				doc.Type = value
+			case "description":
+				if strings.HasPrefix(value, ">") || strings.HasPrefix(value, "|") {
+					// Multi-line scalar, collect following indented lines
+					inDescription = true
+				} else {
+					descLines = append(descLines, value)
+				}
+			case "tags":
+				list = &doc.Tags
+			case "allowed-tools", "permissions":
+				list = &doc.Permissions
 			}
-		} else if inPermissions {
-			// YAML list item under permissions:
-			if strings.HasPrefix(trimmed, "- ") {
-				perm := strings.TrimSpace(strings.TrimPrefix(trimmed, "- "))
-				perm = strings.Trim(perm, "\"'")
-				permissions = append(permissions, perm)
-			} else if !strings.HasPrefix(line, " ") && !strings.HasPrefix(line, "\t") {
-				inPermissions = false
+			if list != nil && value != "" {
+				*list = append(*list, splitInlineList(value)...)
+				list = nil
 			}
+			continue
+		}
+
+		switch {
+		case inDescription && indented:
+			descLines = append(descLines, trimmed)
+		case inDescription:
+			inDescription = false
+		case list != nil && strings.HasPrefix(trimmed, "- "):
+			*list = append(*list, unquoteYAML(strings.TrimPrefix(trimmed, "- ")))
+		case list != nil && !indented && trimmed != "":
+			list = nil
 		}
 	}
 
-	// Take only first line of description for display
-	if len(descLines) > 0 {
-		description = descLines[0]
+	doc.Description = strings.Join(descLines, "\n")
+	return doc
+}
+
+// splitInlineList splits "[a, 'b']" or "a, b" into its items
+func splitInlineList(value string) []string {
+	value = strings.TrimSuffix(strings.TrimPrefix(value, "["), "]")
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = unquoteYAML(item); item != "" {
+			items = append(items, item)
+		}
 	}
-	return name, description, skillType, permissions
+	return items
+}
+
+// unquoteYAML trims a scalar and the quotes around it
+func unquoteYAML(value string) string {
+	return strings.Trim(strings.TrimSpace(value), "\"'")
 }
 
-// isSkillInstalled checks if a skill symlink/dir exists in ~/.claude/skills/ OR ~/.agents/skills/
+// isSkillInstalled checks if a skill symlink/dir exists in any link target
+// (~/.claude/skills/ or ~/.agents/skills/)
 func isSkillInstalled(home, name string) bool {
-	paths := []string{
-		filepath.Join(home, ".claude", "skills", name),
-		filepath.Join(home, ".agents", "skills", name),
-	}
-	for _, p := range paths {
-		if _, err := os.Stat(p); err == nil {
-			return true
+	return len(skillInstalledTargets(home, name)) > 0
+}
+
+// skillInstalledTargets lists the IDs of the link targets holding a skill
+// symlink/dir named name
+func skillInstalledTargets(home, name string) []string {
+	var ids []string
+	for _, t := range skillTargets {
+		p := filepath.Join(home, t.Dir, name)
+		info, err := os.Lstat(p)
+		if err != nil {
+			continue
+		}
+		// A symlink only counts while its target is still there
+		if info.Mode()&os.ModeSymlink == 0 {
+			ids = append(ids, t.ID)
+		} else if _, err := filepath.EvalSymlinks(p); err == nil {
+			ids = append(ids, t.ID)
 		}
 	}
-	return false
+	return ids
 }
 
 // isPluginInstalled checks if a plugin directory exists in ~/.claude/plugins/<name>/PLUGIN.md
@@ -625,32 +934,75 @@ func isPluginInstalled(home, name string) bool {
 	return err == nil
 }
 
-// installSkillSymlinks creates symlinks for each skill into ~/.claude/skills/ and ~/.agents/skills/
+// skillSymlink links a skill into a target dir; tests swap it to refuse links
+var skillSymlink = os.Symlink
+
+// symlinkRefused reports whether a skillSymlink error calls for a copy. Only
+// Windows refuses links for privileges; tests swap it to act like Windows.
+var symlinkRefused = system.IsSymlinkPrivilegeError
+
+// linkSkillDir symlinks dst to the skill at src. Where symlinks need a
+// privilege the user lacks (Windows without Developer Mode), the skill is
+// copied instead and copied is true.
+func linkSkillDir(src, dst string) (copied bool, err error) {
+	err = skillSymlink(src, dst)
+	if !symlinkRefused(err) {
+		return false, err
+	}
+	if err := system.CopyDir(src, dst); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// installSkillSymlinks creates symlinks for each skill into the link targets of opts
+// (~/.claude/skills/ and ~/.agents/skills/ by default).
 // For plugins (Type=="plugin"), copies the entire directory to ~/.claude/plugins/<name>/ instead.
-func installSkillSymlinks(skills []SkillInfo) ([]string, error) {
+func installSkillSymlinks(skills []SkillInfo, opts SkillLinkOptions) ([]string, error) {
+	_, logLines, err := installSkills(skills, opts)
+	return logLines, err
+}
+
+// installSkills does the work of installSkillSymlinks and also reports a
+// result per skill. Skills whose symlinks already point at the catalog copy
+// are skipped rather than recreated. Only the targets of opts are created.
+func installSkills(skills []SkillInfo, opts SkillLinkOptions) ([]SkillResult, []string, error) {
 	home, err := os.UserHomeDir()
 	if err != nil {
-		return nil, fmt.Errorf("cannot determine home directory: %w", err)
+		return nil, nil, fmt.Errorf("cannot determine home directory: %w", err)
 	}
 
-	claudeSkillsDir := filepath.Join(home, ".claude", "skills")
-	agentsSkillsDir := filepath.Join(home, ".agents", "skills")
+	targets := opts.targets()
 	claudePluginsDir := filepath.Join(home, ".claude", "plugins")
-	os.MkdirAll(claudeSkillsDir, 0755)
-	os.MkdirAll(agentsSkillsDir, 0755)
-	os.MkdirAll(claudePluginsDir, 0755)
+	for _, t := range targets {
+		os.MkdirAll(filepath.Join(home, t.Dir), 0755)
+	}
 
+	var results []SkillResult
 	var logLines []string
 	var errors []string
 
+	skills, refused := planSkillInstall(home, skills)
+	for _, r := range refused {
+		logLines = append(logLines, fmt.Sprintf("❌ %s: %s", r.Name, r.Reason))
+		errors = append(errors, r.Name)
+	}
+	results = append(results, refused...)
+
 	for _, s := range skills {
 		if s.Type == "plugin" {
 			// Copy entire plugin directory to ~/.claude/plugins/<name>/
+			os.MkdirAll(claudePluginsDir, 0755)
 			pluginDst := filepath.Join(claudePluginsDir, s.Name)
+			var lost []string
+			if _, err := os.Lstat(pluginDst); err == nil {
+				lost = append(lost, pluginDst)
+			}
 			os.RemoveAll(pluginDst)
 			if err := system.CopyDir(s.FullPath, pluginDst); err != nil {
 				logLines = append(logLines, fmt.Sprintf("❌ %s → ~/.claude/plugins/: %v", s.Name, err))
 				errors = append(errors, s.Name)
+				results = append(results, SkillResult{Name: s.Name, Status: SkillFailed, Reason: err.Error(), Lost: lost})
 			} else {
 				// Make all .sh files in scripts/ subdirectory executable
 				scriptsDir := filepath.Join(pluginDst, "scripts")
@@ -662,54 +1014,123 @@ func installSkillSymlinks(skills []SkillInfo) ([]string, error) {
 					}
 				}
 				logLines = append(logLines, fmt.Sprintf("✅ %s → ~/.claude/plugins/", s.Name))
+				results = append(results, SkillResult{Name: s.Name, Status: SkillInstalled, Created: []SkillLink{{Path: pluginDst}}, Lost: lost})
 			}
 			continue
 		}
 
-		// Symlink to ~/.claude/skills/<name>
-		claudeDst := filepath.Join(claudeSkillsDir, s.Name)
-		os.RemoveAll(claudeDst)
-		if err := os.Symlink(s.FullPath, claudeDst); err != nil {
-			logLines = append(logLines, fmt.Sprintf("❌ %s → ~/.claude/skills/: %v", s.Name, err))
-			errors = append(errors, s.Name)
-		} else {
-			logLines = append(logLines, fmt.Sprintf("✅ %s → ~/.claude/skills/", s.Name))
+		linked := true
+		for _, t := range targets {
+			if !symlinkPointsTo(filepath.Join(home, t.Dir, s.Name), s.FullPath) {
+				linked = false
+			}
+		}
+		if linked {
+			logLines = append(logLines, fmt.Sprintf("⏭️  %s already installed", s.Name))
+			results = append(results, SkillResult{Name: s.Name, Status: SkillSkipped, Reason: "already present"})
+			continue
 		}
 
-		// Symlink to ~/.agents/skills/<name>
-		agentsDst := filepath.Join(agentsSkillsDir, s.Name)
-		os.RemoveAll(agentsDst)
-		if err := os.Symlink(s.FullPath, agentsDst); err != nil {
-			logLines = append(logLines, fmt.Sprintf("❌ %s → ~/.agents/skills/: %v", s.Name, err))
-			errors = append(errors, s.Name)
-		} else {
-			logLines = append(logLines, fmt.Sprintf("✅ %s → ~/.agents/skills/", s.Name))
+		var failures []string
+		result := SkillResult{Name: s.Name}
+
+		// Symlink to <target>/<name>
+		for _, t := range targets {
+			dst := filepath.Join(home, t.Dir, s.Name)
+			// A local directory is only replaced when asked to
+			if isLocalSkillDir(dst) {
+				switch opts.Conflicts[dst] {
+				case SkillConflictOverwrite:
+					// Removed below like any other entry
+				case SkillConflictBackup:
+					backup, err := backupSkillDir(dst, time.Now())
+					if err != nil {
+						logLines = append(logLines, fmt.Sprintf("❌ %s → %s: backup failed: %v", s.Name, t.displayDir(), err))
+						errors = append(errors, s.Name)
+						failures = append(failures, strings.TrimSuffix(t.displayDir(), "/")+": backup failed: "+err.Error())
+						continue
+					}
+					logLines = append(logLines, fmt.Sprintf("💾 %s: your directory was kept as %s", s.Name, contractHome(backup)))
+				default:
+					logLines = append(logLines, fmt.Sprintf("⚠️  %s → %s: a local directory is in the way, skipped", s.Name, t.displayDir()))
+					result.Conflicts = append(result.Conflicts, dst)
+					continue
+				}
+			}
+			result.removeEntry(dst, os.RemoveAll)
+			copied, err := linkSkillDir(s.FullPath, dst)
+			if err == nil && copied {
+				logLines = append(logLines, fmt.Sprintf("✅ %s → %s (copied)", s.Name, t.displayDir()))
+				result.Created = append(result.Created, SkillLink{Path: dst})
+				continue
+			}
+			if err != nil {
+				logLines = append(logLines, fmt.Sprintf("❌ %s → %s: %v", s.Name, t.displayDir(), err))
+				errors = append(errors, s.Name)
+				failures = append(failures, strings.TrimSuffix(t.displayDir(), "/")+": "+err.Error())
+			} else {
+				logLines = append(logLines, fmt.Sprintf("✅ %s → %s", s.Name, t.displayDir()))
+				result.Created = append(result.Created, SkillLink{Path: dst, Target: s.FullPath})
+			}
 		}
+
+		switch {
+		case len(failures) > 0:
+			result.Status = SkillFailed
+			result.Reason = strings.Join(failures, "; ")
+		case len(result.Created) == 0 && len(result.Conflicts) > 0:
+			result.Status = SkillSkipped
+			result.Reason = "a local directory is in the way"
+		default:
+			result.Status = SkillInstalled
+		}
+		results = append(results, result)
 	}
 
 	if len(errors) > 0 {
-		return logLines, fmt.Errorf("%d symlink(s) failed", len(errors))
+		return results, logLines, fmt.Errorf("%d symlink(s) failed", len(errors))
 	}
-	return logLines, nil
+	return results, logLines, nil
 }
 
-// InstallSkillSymlinks exposes installSkillSymlinks for CLI usage
-func InstallSkillSymlinks(skills []SkillInfo) ([]string, error) {
-	return installSkillSymlinks(skills)
+// symlinkPointsTo reports whether path is a symlink whose target is target
+func symlinkPointsTo(path, target string) bool {
+	dest, err := os.Readlink(path)
+	return err == nil && dest == target
 }
 
-// removeSkillSymlinks removes symlinks from ~/.claude/skills/ and ~/.agents/skills/
+// InstallSkillSymlinks exposes installSkillSymlinks for CLI usage. The zero
+// SkillLinkOptions links into every target, as before.
+func InstallSkillSymlinks(skills []SkillInfo, opts SkillLinkOptions) ([]string, error) {
+	return installSkillSymlinks(skills, opts)
+}
+
+// InstallSkills exposes installSkills for CLI usage
+func InstallSkills(skills []SkillInfo, opts SkillLinkOptions) ([]SkillResult, []string, error) {
+	return installSkills(skills, opts)
+}
+
+// removeSkillSymlinks removes symlinks from the link targets of opts
+// (~/.claude/skills/ and ~/.agents/skills/ by default).
 // For plugins (Type=="plugin"), removes ~/.claude/plugins/<name>/ instead.
-func removeSkillSymlinks(skills []SkillInfo) ([]string, error) {
+func removeSkillSymlinks(skills []SkillInfo, opts SkillLinkOptions) ([]string, error) {
+	_, logLines, err := removeSkills(skills, opts)
+	return logLines, err
+}
+
+// removeSkills does the work of removeSkillSymlinks and also reports a
+// result per skill. Skills that aren't installed in the targets of opts are
+// reported as skipped; links in other targets are left alone.
+func removeSkills(skills []SkillInfo, opts SkillLinkOptions) ([]SkillResult, []string, error) {
 	home, err := os.UserHomeDir()
 	if err != nil {
-		return nil, fmt.Errorf("cannot determine home directory: %w", err)
+		return nil, nil, fmt.Errorf("cannot determine home directory: %w", err)
 	}
 
-	claudeSkillsDir := filepath.Join(home, ".claude", "skills")
-	agentsSkillsDir := filepath.Join(home, ".agents", "skills")
+	targets := opts.targets()
 	claudePluginsDir := filepath.Join(home, ".claude", "plugins")
 
+	var results []SkillResult
 	var logLines []string
 	var errors []string
 
@@ -721,31 +1142,50 @@ func removeSkillSymlinks(skills []SkillInfo) ([]string, error) {
 				if err := os.RemoveAll(pluginDst); err != nil {
 					logLines = append(logLines, fmt.Sprintf("❌ %s: failed to remove from ~/.claude/plugins/: %v", s.Name, err))
 					errors = append(errors, s.Name)
+					results = append(results, SkillResult{Name: s.Name, Status: SkillFailed, Reason: err.Error()})
 				} else {
 					logLines = append(logLines, fmt.Sprintf("✅ %s removed from ~/.claude/plugins/", s.Name))
+					results = append(results, SkillResult{Name: s.Name, Status: SkillRemoved, Lost: []string{pluginDst}})
 				}
+			} else {
+				results = append(results, SkillResult{Name: s.Name, Status: SkillSkipped, Reason: "not installed"})
 			}
 			continue
 		}
 
 		removed := false
-		// Remove from ~/.claude/skills/<name>
-		claudeDst := filepath.Join(claudeSkillsDir, s.Name)
-		if _, err := os.Lstat(claudeDst); err == nil {
-			if err := os.RemoveAll(claudeDst); err != nil {
-				logLines = append(logLines, fmt.Sprintf("❌ %s: failed to remove from ~/.claude/skills/: %v", s.Name, err))
-				errors = append(errors, s.Name)
-			} else {
-				removed = true
+		var failures []string
+		result := SkillResult{Name: s.Name}
+
+		// A case-colliding skill may share its link path with the other
+		// skill, so only remove links that resolve to this one
+		if s.CollidesWith != "" {
+			for _, t := range targets {
+				for _, path := range foldedEntries(filepath.Join(home, t.Dir), s.Name) {
+					if !sameSkillTarget(path, s.FullPath) {
+						continue
+					}
+					if err := result.removeEntry(path, os.Remove); err != nil {
+						logLines = append(logLines, fmt.Sprintf("❌ %s: failed to remove %s: %v", s.Name, path, err))
+						errors = append(errors, s.Name)
+						failures = append(failures, err.Error())
+					} else {
+						removed = true
+					}
+				}
 			}
 		}
 
-		// Remove from ~/.agents/skills/<name>
-		agentsDst := filepath.Join(agentsSkillsDir, s.Name)
-		if _, err := os.Lstat(agentsDst); err == nil {
-			if err := os.RemoveAll(agentsDst); err != nil {
-				logLines = append(logLines, fmt.Sprintf("❌ %s: failed to remove from ~/.agents/skills/: %v", s.Name, err))
+		// Remove from <target>/<name>
+		for _, t := range targets {
+			dst := filepath.Join(home, t.Dir, s.Name)
+			if _, err := os.Lstat(dst); err != nil || s.CollidesWith != "" {
+				continue
+			}
+			if err := result.removeEntry(dst, os.RemoveAll); err != nil {
+				logLines = append(logLines, fmt.Sprintf("❌ %s: failed to remove from %s: %v", s.Name, t.displayDir(), err))
 				errors = append(errors, s.Name)
+				failures = append(failures, strings.TrimSuffix(t.displayDir(), "/")+": "+err.Error())
 			} else {
 				removed = true
 			}
@@ -753,58 +1193,126 @@ func removeSkillSymlinks(skills []SkillInfo) ([]string, error) {
 
 		if removed {
 			logLines = append(logLines, fmt.Sprintf("✅ %s removed", s.Name))
+			// An external skill's clone goes with its last link
+			if clone := externalCloneDir(home, s.FullPath); clone != "" && !isSkillLinked(home, s) {
+				if err := os.RemoveAll(clone); err != nil {
+					logLines = append(logLines, fmt.Sprintf("⚠️  %s: failed to delete the clone %s: %v", s.Name, contractHome(clone), err))
+				} else {
+					logLines = append(logLines, fmt.Sprintf("🗑️  %s: deleted the clone %s", s.Name, contractHome(clone)))
+					result.Lost = append(result.Lost, clone)
+				}
+			}
+		}
+
+		switch {
+		case len(failures) > 0:
+			result.Status = SkillFailed
+			result.Reason = strings.Join(failures, "; ")
+		case removed:
+			result.Status = SkillRemoved
+		default:
+			result.Status = SkillSkipped
+			result.Reason = "not installed"
 		}
+		results = append(results, result)
 	}
 
 	if len(errors) > 0 {
-		return logLines, fmt.Errorf("%d removal(s) failed", len(errors))
+		return results, logLines, fmt.Errorf("%d removal(s) failed", len(errors))
 	}
-	return logLines, nil
+	return results, logLines, nil
+}
+
+// RemoveSkillSymlinks exposes removeSkillSymlinks for CLI usage. The zero
+// SkillLinkOptions removes from every target, as before.
+func RemoveSkillSymlinks(skills []SkillInfo, opts SkillLinkOptions) ([]string, error) {
+	return removeSkillSymlinks(skills, opts)
 }
 
-// RemoveSkillSymlinks exposes removeSkillSymlinks for CLI usage
-func RemoveSkillSymlinks(skills []SkillInfo) ([]string, error) {
-	return removeSkillSymlinks(skills)
+// RemoveSkills exposes removeSkills for CLI usage
+func RemoveSkills(skills []SkillInfo, opts SkillLinkOptions) ([]SkillResult, []string, error) {
+	return removeSkills(skills, opts)
 }
 
 // FetchSkillCatalog exposes fetchSkillCatalog for CLI usage
-func FetchSkillCatalog() ([]SkillInfo, error) {
+func FetchSkillCatalog() ([]SkillInfo, []SkillCatalogWarning, error) {
 	return fetchSkillCatalog()
 }
 
+// LocalSkillCatalog exposes readSkillCatalog for CLI usage: the catalog on
+// disk, never cloned, for operations that must work offline
+func LocalSkillCatalog() ([]SkillInfo, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("cannot determine home directory: %w", err)
+	}
+	skills, _ := readSkillCatalog(home)
+	return skills, nil
+}
+
 // updateSkillCatalogCmd returns a tea.Cmd that runs git pull on ~/.gentleman/skills/
+// and reports which linked skills it changed
 func updateSkillCatalogCmd() tea.Cmd {
 	return func() tea.Msg {
 		home, err := os.UserHomeDir()
 		if err != nil {
 			return skillUpdateCompleteMsg{err: err}
 		}
-		centralDir := filepath.Join(home, ".gentleman", "skills")
-		if _, err := os.Stat(centralDir); os.IsNotExist(err) {
-			return skillUpdateCompleteMsg{err: fmt.Errorf("skills catalog not found; browse or install first")}
-		}
-		cmd := exec.Command("git", "-C", centralDir, "pull")
-		if err := cmd.Run(); err != nil {
-			return skillUpdateCompleteMsg{err: fmt.Errorf("git pull failed: %w", err)}
-		}
-		return skillUpdateCompleteMsg{err: nil}
+		changes, err := updateSkillCatalog(execRunner, home, skillGitProgress)
+		return skillUpdateCompleteMsg{changes: changes, err: err}
 	}
 }
 
+// pullSkillCatalog runs git pull on the skill catalog under home, or moves
+// it to ref when the catalog is pinned, sending git's output to log
+func pullSkillCatalog(ctx context.Context, home, ref string, log func(string)) error {
+	centralDir := paths.SkillsDir(home)
+	if _, err := os.Stat(centralDir); os.IsNotExist(err) {
+		return fmt.Errorf("skills catalog not found; browse or install first")
+	}
+	// Remember the current name set so the stats screen can list additions
+	if skills, _, err := fetchSkillCatalog(); err == nil {
+		saveSkillCatalogSnapshot(home, skills)
+	}
+	if ref != "" {
+		return fetchPinned(ctx, centralDir, ref, log)
+	}
+	opts := &system.ExecOptions{IdleTimeout: system.EnvCloneTimeout(), SplitCR: true}
+	result := system.RunWithLogs(ctx, "git -C "+centralDir+" pull --progress", opts, log)
+	if result.Error != nil {
+		return fmt.Errorf("git pull failed: %w", result.Error)
+	}
+	return nil
+}
+
 // installSkillActionCmd returns a tea.Cmd that installs skills via symlinks
-func installSkillActionCmd(skills []SkillInfo) tea.Cmd {
+// and records the install so it can be undone
+func installSkillActionCmd(skills []SkillInfo, opts SkillLinkOptions) tea.Cmd {
 	return func() tea.Msg {
-		logLines, err := installSkillSymlinks(skills)
-		return skillActionCompleteMsg{logLines: logLines, err: err}
+		_, logLines, err := InstallCatalogSkills(skills, nil, opts)
+		return skillActionCompleteMsg{logLines: logLines, err: err, undo: currentSkillUndo()}
 	}
 }
 
 // removeSkillActionCmd returns a tea.Cmd that removes skill symlinks
-func removeSkillActionCmd(skills []SkillInfo) tea.Cmd {
+// and records the removal so it can be undone
+func removeSkillActionCmd(skills []SkillInfo, opts SkillLinkOptions) tea.Cmd {
 	return func() tea.Msg {
-		logLines, err := removeSkillSymlinks(skills)
-		return skillActionCompleteMsg{logLines: logLines, err: err}
+		_, logLines, err := RemoveCatalogSkills(skills, opts)
+		return skillActionCompleteMsg{logLines: logLines, err: err, undo: currentSkillUndo()}
+	}
+}
+
+// recordSkillAction stores the operation in the skill ledger, noting in the log when it can't
+func recordSkillAction(kind string, results []SkillResult, logLines []string) []string {
+	home, err := os.UserHomeDir()
+	if err == nil {
+		err = recordSkillOperation(home, newSkillOperation(kind, results))
+	}
+	if err != nil {
+		logLines = append(logLines, "⚠️  Could not record the operation for undo: "+err.Error())
 	}
+	return logLines
 }
 
 func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
@@ -816,28 +1324,38 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, tea.Quit
 	}
 
+	// ctrl+z suspends to the shell on every screen; no input screen binds it.
+	// Bubble Tea releases the terminal and restores it on fg (see tea.ResumeMsg).
+	// Not while installing: the stopped steps' processes could be left orphaned.
+	if key == "ctrl+z" {
+		m.LeaderMode = false
+		if m.Screen == ScreenInstalling {
+			return m.showToast("Suspend is disabled while installing, so no step is left orphaned"), nil
+		}
+		return m, tea.Suspend
+	}
+
+	// Just got the terminal back from an exec process: drop stray input
+	if time.Now().Before(m.InputSettleUntil) {
+		return m, nil
+	}
+
+	// Any key closes the help overlay
+	if m.ShowHelp {
+		m.ShowHelp = false
+		return m, nil
+	}
+	// ? opens it, except where it would be typed; f1 works everywhere
+	if key == "f1" || key == "?" && !m.typingText() {
+		m.LeaderMode = false
+		m.ShowHelp = true
+		return m, nil
+	}
+
 	// Leader key mode: <space> activates, next key executes command
-	// Commands: <space>q = quit, <space>d = toggle details
+	// (see leader.go for the commands)
 	if m.LeaderMode {
-		m.LeaderMode = false // Reset leader mode
-		switch key {
-		case "q":
-			// Quit application
-			if m.Screen != ScreenInstalling {
-				m.Quitting = true
-				return m, tea.Quit
-			}
-			return m, nil
-		case "d":
-			// Toggle details during installation
-			if m.Screen == ScreenInstalling {
-				m.ShowDetails = !m.ShowDetails
-			}
-			return m, nil
-		default:
-			// Unknown leader command, ignore
-			return m, nil
-		}
+		return m.runLeader(key)
 	}
 
 	// <space> activates leader mode EXCEPT in screens that need space for input
@@ -854,20 +1372,45 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			// Complete/Error screens: space quits the app
 			m.Quitting = true
 			return m, tea.Quit
-		case ScreenProjectPath:
-			// Project path input: space is part of the path, pass through
-		case ScreenTrainerLesson, ScreenTrainerPractice, ScreenTrainerBoss:
+		case ScreenProjectPath, ScreenTrainerImport, ScreenProfileSave, ScreenAIFrameworkPresetSave, ScreenInstallRefInput, ScreenSkillManifest, ScreenSkillURL:
+			// Path inputs: space is part of the path, pass through
+		case ScreenTrainerLesson, ScreenTrainerPractice, ScreenTrainerBoss, ScreenTrainerTimed:
 			// Trainer input screens: space is part of the input, pass through
 			// (handled below in screen-specific handlers)
-		case ScreenSkillInstall, ScreenSkillRemove, ScreenProjectRolePack:
-			// Multi-select screens: space toggles selection, pass through
+		case ScreenAIToolsSelect, ScreenAIFrameworkCategoryItems, ScreenSkillInstall, ScreenSkillRemove,
+			ScreenProjectStack, ScreenProjectRolePack, ScreenProjectBatchSelect, ScreenUninstall, ScreenWMExtras, ScreenSkillTargets, ScreenSkillConflicts, ScreenSettings, ScreenRestoreItems:
+			// Multi-select screens: space toggles selection, pass through (see multi_select.go)
+		case ScreenKeymaps, ScreenKeymapsTmux, ScreenKeymapsZellij, ScreenKeymapsGhostty:
+			// Keymap menus: space is part of a search query while typing one
+			if !m.KeymapSearchActive {
+				return m.startLeader(), nil
+			}
+		case ScreenAIFrameworkCategories:
+			// Module categories: space is part of a search query or toggles a result
+			if !m.aiModuleSearching() {
+				return m.startLeader(), nil
+			}
 		default:
 			// All other screens: activate leader mode
-			m.LeaderMode = true
-			return m, nil
+			return m.startLeader(), nil
 		}
 	}
 
+	// Keymap menus search across their categories, see keymap_search.go
+	if _, ok := keymapViewers[m.Screen]; ok && (key == "/" || m.keymapSearching()) {
+		return m.handleKeymapSearchKeys(key)
+	}
+
+	// The AI module categories search every category's modules, see ai_module_search.go
+	if m.Screen == ScreenAIFrameworkCategories && (key == "/" || m.aiModuleSearching()) {
+		return m.handleAIModuleSearchKeys(key)
+	}
+
+	// The full install log pages like a pager, see install_log_view.go
+	if m.Screen == ScreenInstalling && m.ShowInstallLog {
+		return m.handleInstallLogKeys(key), nil
+	}
+
 	// ESC goes back from content/learn screens (and cancels leader mode implicitly)
 	if key == "esc" {
 		return m.handleEscape()
@@ -880,18 +1423,28 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		case "enter":
 			m.Screen = ScreenMainMenu
 			m.Cursor = 0
+		case "r":
+			if m.ResumeState != nil {
+				return m.resumeInstallation()
+			}
 		}
 
 	case ScreenMainMenu:
 		return m.handleMainMenuKeys(key)
 
-	case ScreenOSSelect, ScreenTerminalSelect, ScreenFontSelect, ScreenShellSelect, ScreenWMSelect, ScreenNvimSelect, ScreenZedSelect, ScreenAIFrameworkConfirm, ScreenAIFrameworkPreset, ScreenGhosttyWarning,
-		ScreenProjectStack, ScreenProjectMemory, ScreenProjectObsidianInstall, ScreenProjectEngram, ScreenProjectCI, ScreenProjectConfirm, ScreenSkillMenu, ScreenLearnMenu:
+	case ScreenOSSelect, ScreenTerminalSelect, ScreenFontSelect, ScreenShellSelect, ScreenZshMergeSelect, ScreenWMSelect, ScreenNvimSelect, ScreenZedSelect, ScreenAIFrameworkConfirm, ScreenAIFrameworkPreset, ScreenGhosttyWarning, ScreenWizardReview,
+		ScreenProjectMemory, ScreenProjectObsidianInstall, ScreenProjectEngram, ScreenProjectCI, ScreenProjectConfirm, ScreenSkillMenu, ScreenLearnMenu, ScreenDiagnoseSymptom:
 		return m.handleSelectionKeys(key)
 
 	case ScreenAIToolsSelect:
 		return m.handleAIToolsKeys(key)
 
+	case ScreenWMExtras:
+		return m.handleWMExtrasKeys(key)
+
+	case ScreenProjectStack:
+		return m.handleProjectStackKeys(key)
+
 	case ScreenProjectRolePack:
 		return m.handleRolePackKeys(key)
 
@@ -901,6 +1454,15 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case ScreenAIFrameworkCategoryItems:
 		return m.handleAICategoryItemsKeys(key)
 
+	case ScreenAIFrameworkSummary:
+		return m.handleAIFrameworkSummaryKeys(key)
+
+	case ScreenAIFrameworkPresetSave:
+		return m.handleFrameworkPresetSaveKeys(key)
+
+	case ScreenAIFrameworkApplyDiff:
+		return m.handleAIFrameworkApplyDiffKeys(key)
+
 	case ScreenLearnTerminals, ScreenLearnShells, ScreenLearnWM, ScreenLearnNvim:
 		return m.handleLearnMenuKeys(key)
 
@@ -940,12 +1502,36 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case ScreenBackupConfirm:
 		return m.handleBackupConfirmKeys(key)
 
+	case ScreenOSVersionWarning:
+		return m.handleOSVersionWarningKeys(key)
+
+	case ScreenDiskSpaceWarning:
+		return m.handleDiskSpaceWarningKeys(key)
+
 	case ScreenRestoreBackup:
 		return m.handleRestoreBackupKeys(key)
 
 	case ScreenRestoreConfirm:
 		return m.handleRestoreConfirmKeys(key)
 
+	case ScreenRestoreItems:
+		return m.handleRestoreItemsKeys(key)
+
+	case ScreenBackupPrune:
+		return m.handleBackupPruneKeys(key)
+
+	case ScreenProjectPreview:
+		return m.handleProjectPreviewKeys(key)
+
+	case ScreenRestoreConflict:
+		return m.handleRestoreConflictKeys(key)
+
+	case ScreenDiagnoseResults:
+		return m.handleDiagnoseResultsKeys(key)
+
+	case ScreenInstallCheck:
+		return m.handleInstallCheckKeys(key)
+
 	// Trainer screens
 	case ScreenTrainerMenu:
 		return m.handleTrainerMenuKeys(key)
@@ -956,26 +1542,98 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case ScreenTrainerBoss:
 		return m.handleTrainerBossKeys(key)
 
+	case ScreenTrainerTimed:
+		return m.handleTrainerTimedKeys(key)
+
 	case ScreenTrainerResult:
 		return m.handleTrainerResultKeys(key)
 
 	case ScreenTrainerBossResult:
 		return m.handleTrainerBossResultKeys(key)
 
+	case ScreenTrainerResetConfirm:
+		return m.handleTrainerResetConfirmKeys(key)
+
+	case ScreenTrainerSettings:
+		return m.handleTrainerSettingsKeys(key)
+
+	case ScreenTrainerImport:
+		return m.handleTrainerImportKeys(key)
+
+	case ScreenTrainerResetAll:
+		return m.handleTrainerResetAllKeys(key)
+
 	// Project init screens
 	case ScreenProjectPath:
 		return m.handleProjectPathKeys(key)
 
 	case ScreenProjectResult:
+		return m.handleProjectResultKeys(key)
+
+	case ScreenProjectBatchResult:
 		if key == "enter" {
 			m.Screen = ScreenMainMenu
 			m.Cursor = 0
 		}
 
+	case ScreenProjectBatchSelect:
+		return m.handleProjectBatchSelectKeys(key)
+
 	// Skill manager screens
 	case ScreenSkillBrowse:
 		return m.handleSkillBrowseKeys(key)
 
+	case ScreenSkillStats:
+		return m.handleSkillStatsKeys(key)
+
+	case ScreenSkillWarnings:
+		return m.handleSkillWarningsKeys(key)
+
+	case ScreenProfileSave:
+		return m.handleProfileSaveKeys(key)
+
+	case ScreenInstallRefs:
+		return m.handleInstallRefsKeys(key)
+
+	case ScreenInstallRefInput:
+		return m.handleInstallRefInputKeys(key)
+
+	case ScreenProfileSelect:
+		return m.handleProfileSelectKeys(key)
+
+	case ScreenProfileMismatch:
+		return m.handleProfileMismatchKeys(key)
+
+	case ScreenSkillTargets:
+		return m.handleSkillTargetsKeys(key)
+
+	case ScreenSkillConflicts:
+		return m.handleSkillConflictsKeys(key)
+
+	case ScreenSettings:
+		return m.handleSettingsKeys(key)
+
+	case ScreenSkillManifest:
+		return m.handleSkillManifestKeys(key)
+
+	case ScreenSkillURL:
+		return m.handleSkillURLKeys(key)
+
+	case ScreenSkillDetail:
+		return m.handleSkillDetailKeys(key)
+
+	case ScreenSkillDoctor:
+		return m.handleSkillDoctorKeys(key)
+
+	case ScreenUninstall:
+		return m.handleUninstallKeys(key)
+
+	case ScreenUninstallResult:
+		if key == "enter" && !m.UninstallRunning {
+			m.Screen = ScreenMainMenu
+			m.Cursor = 0
+		}
+
 	case ScreenSkillInstall:
 		return m.handleSkillInstallKeys(key)
 
@@ -983,9 +1641,30 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.handleSkillRemoveKeys(key)
 
 	case ScreenSkillResult:
-		if key == "enter" {
+		switch {
+		case key == "enter":
+			m.SkillOrphans = nil
 			m.Screen = ScreenSkillMenu
 			m.Cursor = 0
+		case key == "r" && len(m.SkillOrphans) > 0:
+			orphaned := m.SkillOrphans
+			m.SkillOrphans = nil
+			m.SkillResultLog.Reset()
+			m.ErrorMsg = ""
+			return m, removeOrphanedSkillsCmd(orphaned)
+		case key == "u" && m.SkillUndo.Undoable():
+			op := m.SkillUndo
+			m.SkillUndo = nil
+			m.SkillOrphans = nil
+			m.SkillResultLog.Reset()
+			m.ErrorMsg = ""
+			return m, undoSkillActionCmd(op)
+		}
+
+	case ScreenUnsupportedPlatform, ScreenInstanceLocked:
+		if key == "enter" || key == "backspace" {
+			m.Screen = ScreenMainMenu
+			m.Cursor = 0
 		}
 
 	case ScreenComplete:
@@ -1001,9 +1680,27 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.Quitting = true
 			return m, tea.Quit
 		case "r":
-			// Retry - go back to beginning
-			m.Screen = ScreenWelcome
+			if m.InstallCancelled {
+				return m.resumeCancelledInstall()
+			}
 			m.ErrorMsg = ""
+			m.TempCleanupNote = ""
+			if m.DiagnoseFixMode {
+				// A diagnose fix failed: return to the results and re-check
+				m.DiagnoseFixMode = false
+				return m.startDiagnosis()
+			}
+			if m.CurrentStep >= len(m.Steps) {
+				// Nothing to retry - go back to beginning
+				m.Screen = ScreenWelcome
+				return m, nil
+			}
+			return m.retryFailedStep()
+		case "s":
+			if m.InstallCancelled || m.DiagnoseFixMode || m.CurrentStep >= len(m.Steps) {
+				return m, nil
+			}
+			return m.skipFailedStep()
 		}
 	}
 
@@ -1013,24 +1710,23 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 func (m Model) handleEscape() (tea.Model, tea.Cmd) {
 	switch m.Screen {
 	// Installation wizard screens - go back through the flow
-	case ScreenOSSelect, ScreenTerminalSelect, ScreenFontSelect, ScreenShellSelect, ScreenWMSelect, ScreenNvimSelect, ScreenZedSelect, ScreenAIToolsSelect, ScreenAIFrameworkConfirm, ScreenAIFrameworkPreset, ScreenAIFrameworkCategories, ScreenAIFrameworkCategoryItems, ScreenProjectRolePack:
+	case ScreenOSSelect, ScreenTerminalSelect, ScreenFontSelect, ScreenShellSelect, ScreenZshMergeSelect, ScreenWMSelect, ScreenWMExtras, ScreenNvimSelect, ScreenZedSelect, ScreenAIToolsSelect, ScreenAIFrameworkConfirm, ScreenAIFrameworkPreset, ScreenAIFrameworkCategories, ScreenAIFrameworkCategoryItems, ScreenProjectRolePack, ScreenWizardReview:
 		return m.goBackInstallStep()
 	case ScreenGhosttyWarning:
 		// Go back to terminal selection
 		m.Screen = ScreenTerminalSelect
 		m.Cursor = 0
-	case ScreenBackupConfirm:
-		// Go back to last AI screen in the wizard flow
-		if len(m.Choices.AITools) > 0 && m.Choices.InstallAIFramework && m.AICategorySelected != nil {
-			m.Screen = ScreenAIFrameworkCategories
-		} else if len(m.Choices.AITools) > 0 && m.Choices.InstallAIFramework {
-			m.Screen = ScreenAIFrameworkPreset
-		} else if len(m.Choices.AITools) > 0 {
-			m.Screen = ScreenAIFrameworkConfirm
-		} else {
-			m.Screen = ScreenAIToolsSelect
-		}
+	case ScreenAIFrameworkSummary:
+		return m.backToAICategories()
+	case ScreenAIFrameworkPresetSave:
+		m.Screen = ScreenAIFrameworkSummary
+		m.Cursor = 0
+		m.ProjectPathError = ""
+	case ScreenAIFrameworkApplyDiff:
+		m.Screen = ScreenAIFrameworkCategories
 		m.Cursor = 0
+	case ScreenBackupConfirm, ScreenOSVersionWarning, ScreenDiskSpaceWarning:
+		return m.goBackInstallStep()
 	// Content/Learn screens
 	case ScreenKeymapCategory:
 		m.Screen = ScreenKeymaps
@@ -1067,11 +1763,42 @@ func (m Model) handleEscape() (tea.Model, tea.Cmd) {
 	case ScreenRestoreBackup, ScreenRestoreConfirm:
 		m.Screen = ScreenMainMenu
 		m.Cursor = 0
+	// Diagnose screens
+	case ScreenDiagnoseSymptom:
+		m.Screen = ScreenMainMenu
+		m.Cursor = 0
+	case ScreenUnsupportedPlatform, ScreenInstanceLocked:
+		m.Screen = ScreenMainMenu
+		m.Cursor = 0
+	case ScreenDiagnoseResults:
+		if m.DiagnoseRunning {
+			return m, nil
+		}
+		m.Screen = ScreenDiagnoseSymptom
+		m.Cursor = m.DiagnoseSymptom
+	case ScreenInstallCheck:
+		if m.InstallCheckRunning {
+			return m, nil
+		}
+		m.Screen = ScreenMainMenu
+		m.Cursor = 0
+		m.InstallCheckScroll = 0
+	case ScreenBackupPrune:
+		m = m.leaveBackupPrune()
+	case ScreenProjectPreview:
+		m = m.leaveProjectPreview()
+	case ScreenRestoreItems:
+		m.Screen = ScreenRestoreConfirm
+		m.Cursor = 0
+		m.RestoreItemSelected = nil
+	case ScreenRestoreConflict:
+		// Back to the item picks; nothing has been written yet
+		m = m.leaveRestoreConflicts()
 	// Trainer screens
 	case ScreenTrainerMenu:
 		// Save stats and return to previous screen
 		if m.TrainerStats != nil {
-			trainer.SaveStats(m.TrainerStats)
+			m.saveTrainerStats()
 		}
 		m.Screen = m.PrevScreen
 		m.Cursor = 0
@@ -1079,15 +1806,35 @@ func (m Model) handleEscape() (tea.Model, tea.Cmd) {
 		// Return to trainer menu (stats saved in handlers)
 		m.Screen = ScreenTrainerMenu
 		m.TrainerMessage = ""
+	case ScreenTrainerTimed:
+		m = m.stopTimedRun()
 	case ScreenTrainerResult, ScreenTrainerBossResult:
 		// Return to trainer menu
 		if m.TrainerStats != nil {
-			trainer.SaveStats(m.TrainerStats)
+			m.saveTrainerStats()
 		}
 		m.Screen = ScreenTrainerMenu
 		m.TrainerMessage = ""
+	case ScreenTrainerResetConfirm:
+		m.Screen = ScreenTrainerMenu
+		m.TrainerMessage = m.T("trainer.reset_cancelled")
+	case ScreenTrainerSettings:
+		m.Screen = ScreenTrainerMenu
+		m.TrainerMessage = ""
+	case ScreenTrainerImport:
+		m.Screen = ScreenTrainerMenu
+		m.TrainerMessage = m.T("trainer.import_cancelled")
+		m.ProjectPathError = ""
+	case ScreenTrainerResetAll:
+		m.Screen = ScreenTrainerSettings
+		m.Cursor = 0
+		m.TrainerResetInput = ""
+		m.TrainerMessage = ""
 	// Project init screens
 	case ScreenProjectPath:
+		if m.ProjectPathMode == PathModeNewDir {
+			return m.handlePathNewDirKeys("esc")
+		}
 		if m.ProjectPathMode != PathModeTyping {
 			// Close browser/completion, stay on screen
 			m.ProjectPathMode = PathModeTyping
@@ -1098,23 +1845,95 @@ func (m Model) handleEscape() (tea.Model, tea.Cmd) {
 			m.Screen = ScreenMainMenu
 			m.Cursor = 0
 		}
-	case ScreenProjectResult:
+	case ScreenProjectResult, ScreenProjectBatchResult:
 		m.Screen = ScreenMainMenu
 		m.Cursor = 0
+	case ScreenProjectBatchSelect:
+		return m.goBackInstallStep()
 	// Skill manager screens
 	case ScreenSkillMenu:
 		m.Screen = ScreenMainMenu
 		m.Cursor = 0
 	case ScreenSkillBrowse, ScreenSkillInstall, ScreenSkillRemove:
+		if m.SkillFilterActive || m.SkillFilter != "" || m.SkillTagFilter != "" {
+			// First Esc clears the filter, the next one leaves the screen
+			m.SkillFilter = ""
+			m.SkillFilterActive = false
+			m.SkillTagFilter = ""
+			m.Cursor = 0
+			m.SkillScroll = 0
+			return m, nil
+		}
 		m.Screen = ScreenSkillMenu
 		m.Cursor = 0
 		m.SkillScroll = 0
 	case ScreenSkillResult:
+		m.SkillOrphans = nil
 		m.Screen = ScreenSkillMenu
 		m.Cursor = 0
 	case ScreenSkillUpdate:
 		m.Screen = ScreenSkillMenu
 		m.Cursor = 0
+	case ScreenSkillStats:
+		m.Screen = ScreenSkillMenu
+		m.Cursor = 4
+		m.SkillScroll = 0
+	case ScreenSkillDetail:
+		return m.leaveSkillDetail(), nil
+	case ScreenSkillWarnings:
+		m.Screen = ScreenSkillBrowse
+		m.SkillWarningScroll = 0
+	case ScreenProfileSave:
+		m.Screen = ScreenBackupConfirm
+		m.Cursor = 2
+		m.ProjectPathError = ""
+	case ScreenInstallRefs:
+		m = m.leaveInstallRefs()
+	case ScreenInstallRefInput:
+		m.Screen = ScreenInstallRefs
+		m.Cursor = m.RefField
+		m.ProjectPathError = ""
+	case ScreenProfileSelect:
+		m.Screen = ScreenMainMenu
+		m.Cursor = 0
+		m.ProfileNotice = ""
+	case ScreenProfileMismatch:
+		return m.leaveProfileMismatch(), nil
+	case ScreenSkillTargets:
+		return m.leaveSkillTargets(), nil
+	case ScreenSkillConflicts:
+		return m.leaveSkillConflicts(), nil
+	case ScreenSettings:
+		return m.leaveSettings(), nil
+	case ScreenProjectInstalling:
+		// The script is killed; its completion message shows the result
+		if m.ProjectInitCancel != nil {
+			m.ProjectInitCancel()
+			m.ProjectInitCancel = nil
+			m.ProjectLogLines.Add("⏹ Cancelling...")
+		}
+	case ScreenSkillManifest:
+		m.Screen = ScreenSkillMenu
+		m.Cursor = 7
+		if m.SkillManifestExport {
+			m.Cursor = 8
+		}
+		m.ProjectPathError = ""
+	case ScreenSkillURL:
+		m.Screen = ScreenSkillMenu
+		m.Cursor = 9
+		m.ProjectPathError = ""
+	case ScreenSkillDoctor:
+		m.Screen = ScreenSkillMenu
+		m.Cursor = 6
+	case ScreenUninstall:
+		m.Screen = ScreenMainMenu
+		m.Cursor = 0
+	case ScreenUninstallResult:
+		if !m.UninstallRunning {
+			m.Screen = ScreenMainMenu
+			m.Cursor = 0
+		}
 	// Main menu - quit
 	case ScreenMainMenu:
 		m.Quitting = true
@@ -1124,7 +1943,7 @@ func (m Model) handleEscape() (tea.Model, tea.Cmd) {
 }
 
 func (m Model) handleMainMenuKeys(key string) (tea.Model, tea.Cmd) {
-	options := m.GetCurrentOptions()
+	options := m.canonicalOptions()
 	hasRestoreOption := len(m.AvailableBackups) > 0
 
 	switch key {
@@ -1139,8 +1958,21 @@ func (m Model) handleMainMenuKeys(key string) (tea.Model, tea.Cmd) {
 	case "enter", " ":
 		selected := options[m.Cursor]
 		switch {
+		case strings.HasSuffix(selected, lockedSuffix):
+			m.Screen = ScreenInstanceLocked
+			m.Cursor = 0
+		case (strings.Contains(selected, "Start Installation") || strings.Contains(selected, "Install from Profile") || profileForEntry(selected) != nil) && m.installUnsupported():
+			m.Screen = ScreenUnsupportedPlatform
+			m.Cursor = 0
+		case profileForEntry(selected) != nil:
+			return m.startProfileInstall(*profileForEntry(selected))
+		case strings.Contains(selected, "Install from Profile"):
+			return m.enterProfileSelect(), nil
+		case strings.Contains(selected, "Update Javi.Dots"):
+			return m.startUpdate()
 		case strings.Contains(selected, "Start Installation"):
 			m.Screen = ScreenOSSelect
+			m.ReturnToReview = false
 			// Pre-select detected OS
 			if m.SystemInfo.OS == system.OSLinux {
 				m.Cursor = 1 // Linux is second option
@@ -1153,33 +1985,36 @@ func (m Model) handleMainMenuKeys(key string) (tea.Model, tea.Cmd) {
 		case strings.Contains(selected, "Restore from Backup") && hasRestoreOption:
 			m.Screen = ScreenRestoreBackup
 			m.Cursor = 0
+			m.BackupNotice = ""
+			return m, backupDiskSizesCmd(m.AvailableBackups)
 		case strings.Contains(selected, "Initialize Project"):
 			cwd, err := os.Getwd()
 			if err != nil {
 				cwd = ""
 			}
-			m.ProjectPathInput = cwd
-			m.ProjectPathCursor = len([]rune(cwd))
-			m.ProjectPathError = ""
-			m.ProjectPathMode = PathModeTyping
-			m.ProjectPathCompletions = nil
-			m.ProjectPathCompIdx = -1
-			m.FileBrowserEntries = nil
-			m.FileBrowserCursor = 0
-			m.FileBrowserScroll = 0
-			m.FileBrowserRoot = ""
-			m.FileBrowserShowHidden = false
-			m.ProjectStack = ""
+			m = m.enterProjectPath(cwd)
 			m.ProjectMemory = ""
 			m.ProjectEngram = false
 			m.ProjectCI = ""
-			m.ProjectLogLines = nil
-			m.ErrorMsg = ""
-			m.Screen = ScreenProjectPath
-			m.Cursor = 0
+			m.ProjectReuseSettings = false
+			return m, loadRecentProjectsCmd()
 		case strings.Contains(selected, "Skill Manager"):
 			m.Screen = ScreenSkillMenu
 			m.Cursor = 0
+			m.SkillUndo = currentSkillUndo()
+		case strings.Contains(selected, "AI Framework"):
+			return m.enterAIFrameworkApply(os.Getenv("HOME"))
+		case strings.Contains(selected, "Check Installation"):
+			return m.startInstallCheck()
+		case strings.Contains(selected, "Diagnose Setup"):
+			m.Screen = ScreenDiagnoseSymptom
+			m.Cursor = 0
+			m.DiagnoseManifest, _ = loadInstallManifest(os.Getenv("HOME"))
+		case strings.Contains(selected, "Uninstall"):
+			return m.enterUninstall(os.Getenv("HOME"))
+		case strings.Contains(selected, "Settings"):
+			m.Screen = ScreenSettings
+			m.Cursor = 0
 		case strings.Contains(selected, "Exit"):
 			m.Quitting = true
 			return m, tea.Quit
@@ -1190,7 +2025,7 @@ func (m Model) handleMainMenuKeys(key string) (tea.Model, tea.Cmd) {
 }
 
 func (m Model) handleSelectionKeys(key string) (tea.Model, tea.Cmd) {
-	options := m.GetCurrentOptions()
+	options := m.canonicalOptions()
 
 	switch key {
 	case "up", "k":
@@ -1228,6 +2063,11 @@ func (m Model) handleSelectionKeys(key string) (tea.Model, tea.Cmd) {
 
 // goBackInstallStep handles going back during installation wizard
 func (m Model) goBackInstallStep() (tea.Model, tea.Cmd) {
+	if m.ReturnToReview {
+		// Backing out of an answer edited from the review keeps the old one
+		return m.cancelReviewEdit(), nil
+	}
+
 	switch m.Screen {
 	case ScreenOSSelect:
 		// Go back to main menu
@@ -1262,13 +2102,40 @@ func (m Model) goBackInstallStep() (tea.Model, tea.Cmd) {
 		m.Choices.Shell = ""
 
 	case ScreenWMSelect:
-		m.Screen = ScreenShellSelect
+		if m.Choices.Shell == "zsh" && hasExistingZshrc() {
+			m.Screen = ScreenZshMergeSelect
+		} else {
+			m.Screen = ScreenShellSelect
+		}
 		m.Cursor = 0
 		m.Choices.WindowMgr = ""
+		m.Choices.WMExtras = nil
 
-	case ScreenNvimSelect:
+	case ScreenWMExtras:
 		m.Screen = ScreenWMSelect
 		m.Cursor = 0
+		m.Choices.WMExtras = nil
+		m.WMExtraSelected = nil
+
+	case ScreenZshMergeSelect:
+		m.Screen = ScreenShellSelect
+		m.Cursor = 0
+		m.Choices.ZshMerge = false
+
+	case ScreenNvimSelect:
+		if m.Choices.OS == "windows" {
+			// Windows skipped straight from OS selection
+			m.Screen = ScreenOSSelect
+			m.Cursor = 0
+			m.Choices = UserChoices{}
+			return m, nil
+		}
+		if len(wmExtraOptions[m.Choices.WindowMgr]) > 0 {
+			m = m.enterWMExtras()
+		} else {
+			m.Screen = ScreenWMSelect
+			m.Cursor = 0
+		}
 		m.Choices.InstallNvim = false
 
 	case ScreenZedSelect:
@@ -1278,9 +2145,13 @@ func (m Model) goBackInstallStep() (tea.Model, tea.Cmd) {
 
 	case ScreenAIToolsSelect:
 		m.Screen = ScreenZedSelect
+		if m.Choices.OS == "windows" {
+			m.Screen = ScreenNvimSelect
+		}
 		m.Cursor = 0
 		m.Choices.AITools = nil
 		m.AIToolSelected = nil
+		m.AIToolStatus = nil
 
 	case ScreenAIFrameworkConfirm:
 		m.Screen = ScreenAIToolsSelect
@@ -1293,22 +2164,58 @@ func (m Model) goBackInstallStep() (tea.Model, tea.Cmd) {
 		m.Choices.AIFrameworkPreset = ""
 
 	case ScreenAIFrameworkCategories:
+		if m.AIFrameworkApplyMode {
+			// Apply-only mode started from the main menu
+			return m.exitAIFrameworkApply()
+		}
 		m.Screen = ScreenAIFrameworkPreset
 		m.Cursor = 0
 		m.Choices.AIFrameworkModules = nil
 		m.AICategorySelected = nil
+		m.AIFrameworkPresetNotice = ""
 
 	case ScreenAIFrameworkCategoryItems:
 		// Back to categories — restore cursor to this category
 		m.Screen = ScreenAIFrameworkCategories
 		m.Cursor = m.SelectedModuleCategory
 
+	case ScreenBackupConfirm, ScreenOSVersionWarning, ScreenDiskSpaceWarning:
+		return m.enterWizardReview(), nil
+
+	case ScreenWizardReview:
+		// Back to the last wizard screen the user actually saw
+		switch {
+		case m.SystemInfo != nil && m.SystemInfo.IsTermux:
+			m.Screen = ScreenNvimSelect
+		case m.Choices.OS == "windows":
+			m.Screen = ScreenAIToolsSelect
+		case len(m.Choices.AITools) > 0 && m.AIFrameworkRecommended:
+			// Recommended skipped the preset screen
+			m.Screen = ScreenAIFrameworkConfirm
+			m.Choices.InstallAIFramework = false
+			m.Choices.AIFrameworkPreset = ""
+			m.AIFrameworkRecommended = false
+		case len(m.Choices.AITools) > 0 && m.Choices.InstallAIFramework && m.AICategorySelected != nil:
+			// Was in custom mode — go back to the selection summary
+			m.Screen = ScreenAIFrameworkSummary
+		case len(m.Choices.AITools) > 0 && m.Choices.InstallAIFramework:
+			m.Screen = ScreenAIFrameworkPreset
+		case len(m.Choices.AITools) > 0:
+			m.Screen = ScreenAIFrameworkConfirm
+		default:
+			m.Screen = ScreenAIToolsSelect
+		}
+		m.Cursor = 0
+
 	// Project init screens - back navigation
-	case ScreenProjectStack:
+	case ScreenProjectStack, ScreenProjectBatchSelect:
 		m.Screen = ScreenProjectPath
 		m.Cursor = 0
 	case ScreenProjectMemory:
 		m.Screen = ScreenProjectStack
+		if m.ProjectBatch != nil {
+			m.Screen = ScreenProjectBatchSelect
+		}
 		m.Cursor = 0
 	case ScreenProjectObsidianInstall:
 		m.Screen = ScreenProjectMemory
@@ -1345,13 +2252,17 @@ func (m Model) goBackInstallStep() (tea.Model, tea.Cmd) {
 	case ScreenSkillMenu:
 		m.Screen = ScreenMainMenu
 		m.Cursor = 0
+
+	case ScreenDiagnoseSymptom:
+		m.Screen = ScreenMainMenu
+		m.Cursor = 0
 	}
 
 	return m, nil
 }
 
 func (m Model) handleSelection() (tea.Model, tea.Cmd) {
-	options := m.GetCurrentOptions()
+	options := m.canonicalOptions()
 	if m.Cursor >= len(options) {
 		return m, nil
 	}
@@ -1403,14 +2314,34 @@ func (m Model) handleSelection() (tea.Model, tea.Cmd) {
 
 	switch m.Screen {
 	case ScreenOSSelect:
+		prevOS := m.Choices.OS
 		selectedLower := strings.ToLower(selected)
 		if strings.Contains(selectedLower, "mac") {
 			m.Choices.OS = "mac"
 		} else if strings.Contains(selectedLower, "termux") {
 			m.Choices.OS = "termux"
+		} else if strings.Contains(selectedLower, "windows") {
+			m.Choices.OS = "windows"
 		} else {
 			m.Choices.OS = "linux"
 		}
+		if m.ReturnToReview {
+			if m.Choices.OS == prevOS {
+				return m.enterWizardReview(), nil
+			}
+			// Another OS asks other questions, so the rest of the wizard runs again
+			m.ReturnToReview = false
+			m.Choices = UserChoices{OS: m.Choices.OS, Mirrors: m.Choices.Mirrors, RepoRef: m.Choices.RepoRef, SkillsRef: m.Choices.SkillsRef}
+		}
+		// Native Windows: no terminal, shell or window manager to set up,
+		// only Neovim and the AI tools
+		if m.Choices.OS == "windows" {
+			m.Choices.Terminal = "none"
+			m.Choices.WindowMgr = "none"
+			m.Screen = ScreenNvimSelect
+			m.Cursor = 0
+			return m, nil
+		}
 		// Termux: skip Terminal selection (you're already in a terminal!)
 		// But allow font installation (Termux supports custom fonts)
 		if m.Choices.OS == "termux" {
@@ -1424,6 +2355,7 @@ func (m Model) handleSelection() (tea.Model, tea.Cmd) {
 
 	case ScreenTerminalSelect:
 		term := strings.ToLower(strings.Split(options[m.Cursor], " ")[0])
+		prevTerm := m.Choices.Terminal
 		m.Choices.Terminal = term
 
 		// Check if Ghostty on Debian/Ubuntu - show warning
@@ -1433,6 +2365,10 @@ func (m Model) handleSelection() (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		// An edit only asks about the font when there was no terminal to ask it for
+		if m.ReturnToReview && (term == "none" || prevTerm != "none") {
+			return m.enterWizardReview(), nil
+		}
 		if term != "none" {
 			m.Screen = ScreenFontSelect
 		} else {
@@ -1442,14 +2378,38 @@ func (m Model) handleSelection() (tea.Model, tea.Cmd) {
 
 	case ScreenFontSelect:
 		m.Choices.InstallFont = m.Cursor == 0
+		if m.ReturnToReview {
+			return m.enterWizardReview(), nil
+		}
 		m.Screen = ScreenShellSelect
 		m.Cursor = 0
 
 	case ScreenShellSelect:
 		m.Choices.Shell = strings.ToLower(options[m.Cursor])
+		m.Choices.ZshMerge = false
+		// Offer to merge instead of replacing a zsh config the user already has
+		if m.Choices.Shell == "zsh" && hasExistingZshrc() {
+			m.Screen = ScreenZshMergeSelect
+			m.Cursor = 0
+			return m, nil
+		}
+		if m.ReturnToReview {
+			return m.enterWizardReview(), nil
+		}
 		m.Screen = ScreenWMSelect
 		m.Cursor = 0
 
+	case ScreenZshMergeSelect:
+		m.Choices.ZshMerge = m.Cursor == 0
+		if m.ReturnToReview {
+			return m.enterWizardReview(), nil
+		}
+		m.Screen = ScreenWMSelect
+		m.Cursor = 0
+
+	case ScreenWizardReview:
+		return m.handleWizardReviewSelection()
+
 	case ScreenGhosttyWarning:
 		switch m.Cursor {
 		case 0: // Continue with Ghostty anyway
@@ -1464,48 +2424,63 @@ func (m Model) handleSelection() (tea.Model, tea.Cmd) {
 		}
 
 	case ScreenWMSelect:
-		m.Choices.WindowMgr = strings.ToLower(options[m.Cursor])
-		m.Screen = ScreenNvimSelect
-		m.Cursor = 0
+		wm := strings.ToLower(options[m.Cursor])
+		if wm != m.Choices.WindowMgr {
+			// Extras picked for another multiplexer don't carry over
+			m.Choices.WMExtras = nil
+		}
+		m.Choices.WindowMgr = wm
+		m = m.enterWMExtras()
+		if m.ReturnToReview && m.Screen != ScreenWMExtras {
+			return m.enterWizardReview(), nil
+		}
+		return m, nil
 
 	case ScreenNvimSelect:
 		m.Choices.InstallNvim = m.Cursor == 0
 		// Proceed to Zed selection (skip on Termux — Zed needs GUI)
-		if m.SystemInfo.IsTermux {
-			// Termux doesn't support Zed or AI tools, skip to backup/install
-			return m.proceedToBackupOrInstall()
+		if m.SystemInfo.IsTermux || m.ReturnToReview {
+			// Termux doesn't support Zed or AI tools, skip to the review
+			return m.enterWizardReview(), nil
+		}
+		if m.Choices.OS == "windows" {
+			return m.enterAIToolsSelect(), nil
 		}
 		m.Screen = ScreenZedSelect
 		m.Cursor = 0
 
 	case ScreenZedSelect:
 		m.Choices.InstallZed = m.Cursor == 0
-		m.Screen = ScreenAIToolsSelect
-		m.Cursor = 0
-		m.AIToolSelected = make([]bool, len(aiToolIDMap))
+		if m.ReturnToReview {
+			return m.enterWizardReview(), nil
+		}
+		m = m.enterAIToolsSelect()
 
 	case ScreenAIFrameworkConfirm:
-		m.Choices.InstallAIFramework = m.Cursor == 0
-		if m.Choices.InstallAIFramework {
+		m.AIFrameworkRecommended = false
+		switch m.Cursor {
+		case 0: // Recommended — pick a preset from the tool selection and skip ahead
+			m.Choices.InstallAIFramework = true
+			m.Choices.AIFrameworkPreset = recommendAIPreset(m.Choices.AITools).Preset
+			m.Choices.AIFrameworkModules = nil
+			m.AICategorySelected = nil
+			m.AIFrameworkRecommended = true
+			return m.enterWizardReview(), nil
+		case 1: // Customize — preset list, then categories for a custom pick
+			m.Choices.InstallAIFramework = true
+			// A broken presets file only hides the saved presets
+			m.AIFrameworkUserPresets, _ = loadFrameworkPresets(os.Getenv("HOME"))
 			m.Screen = ScreenAIFrameworkPreset
 			m.Cursor = 0
-		} else {
-			return m.proceedToBackupOrInstall()
+		default:
+			m.Choices.InstallAIFramework = false
+			return m.enterWizardReview(), nil
 		}
 
 	// Project init selection screens
-	case ScreenProjectStack:
-		stacks := []string{"angular", "node", "go", "python", "rust", "java", "ruby", "php", "other"}
-		if m.Cursor < len(stacks) {
-			m.ProjectStack = stacks[m.Cursor]
-		}
-		m.Screen = ScreenProjectMemory
-		m.Cursor = 0
-
 	case ScreenProjectMemory:
-		memories := []string{"obsidian-brain", "vibekanban", "engram", "simple", "none"}
-		if m.Cursor < len(memories) {
-			m.ProjectMemory = memories[m.Cursor]
+		if m.Cursor < len(projectMemoryIDs) {
+			m.ProjectMemory = projectMemoryIDs[m.Cursor]
 		}
 		if m.ProjectMemory == "obsidian-brain" {
 			if !system.CommandExists("obsidian") {
@@ -1513,10 +2488,11 @@ func (m Model) handleSelection() (tea.Model, tea.Cmd) {
 			} else {
 				m.Screen = ScreenProjectEngram
 			}
+			m.Cursor = 0
 		} else {
 			m.Screen = ScreenProjectCI
+			m.Cursor = projectChoiceCursor(projectCIIDs, m.ProjectCI)
 		}
-		m.Cursor = 0
 
 	case ScreenProjectObsidianInstall:
 		m.Choices.InstallObsidian = m.Cursor == 0
@@ -1530,26 +2506,18 @@ func (m Model) handleSelection() (tea.Model, tea.Cmd) {
 		m.RolePackSelected = make([]bool, len(rolePackIDMap))
 
 	case ScreenProjectCI:
-		cis := []string{"github", "gitlab", "woodpecker", "none"}
-		if m.Cursor < len(cis) {
-			m.ProjectCI = cis[m.Cursor]
+		if m.Cursor < len(projectCIIDs) {
+			m.ProjectCI = projectCIIDs[m.Cursor]
 		}
-		m.Screen = ScreenProjectConfirm
-		m.Cursor = 0
+		m = m.enterProjectConfirm()
 
 	case ScreenProjectConfirm:
-		if m.Cursor == 0 { // Confirm
-			m.Choices.InitProject = true
-			m.Choices.ProjectPath = m.ProjectPathInput
-			m.Choices.ProjectStack = m.ProjectStack
-			m.Choices.ProjectMemory = m.ProjectMemory
-			m.Choices.ProjectCI = m.ProjectCI
-			m.Choices.ProjectEngram = m.ProjectEngram
-			m.Choices.ProjectRolePacks = m.ProjectRolePacks
-			m.ProjectLogLines = []string{}
-			m.Screen = ScreenProjectInstalling
-			return m, func() tea.Msg { return projectInstallStartMsg{} }
-		} else { // Cancel
+		switch m.Cursor {
+		case 0: // Confirm
+			return m.startProjectInit()
+		case 1: // Preview
+			return m.enterProjectPreview(), nil
+		default: // Cancel
 			m.Screen = ScreenMainMenu
 			m.Cursor = 0
 		}
@@ -1579,6 +2547,8 @@ func (m Model) handleSelection() (tea.Model, tea.Cmd) {
 			m.TrainerGameState = nil
 			m.TrainerCursor = 0
 			m.TrainerInput = ""
+			m.TrainerMessage = trainerPackErrors(trainer.LoadUserPacks())
+			m.TrainerModules = trainer.GetAllModules()
 			m.Screen = ScreenTrainerMenu
 			m.PrevScreen = ScreenLearnMenu
 		case strings.Contains(selected, "← back"):
@@ -1586,26 +2556,47 @@ func (m Model) handleSelection() (tea.Model, tea.Cmd) {
 			m.Cursor = 0
 		}
 
+	// Diagnose wizard: pick a symptom and run its checks
+	case ScreenDiagnoseSymptom:
+		if m.Cursor < len(diagnosticSymptoms) {
+			m.DiagnoseSymptom = m.Cursor
+			m.DiagnoseLastFix = ""
+			return m.startDiagnosis()
+		}
+		m.Screen = ScreenMainMenu
+		m.Cursor = 0
+
 	// Skill manager menu
 	case ScreenSkillMenu:
 		switch m.Cursor {
 		case 0: // Browse
 			m.SkillLoading = true
 			m.SkillLoadError = ""
+			m.SkillTagFilter = ""
 			m.Screen = ScreenSkillBrowse
 			m.Cursor = 0
 			m.SkillScroll = 0
 			return m, loadSkillsCmd()
 		case 1: // Install
+			m.initSkillTargets()
 			m.SkillLoading = true
 			m.SkillLoadError = ""
+			m.SkillNotice = ""
+			m.SkillSelected = nil
+			m.SkillFilter = ""
+			m.SkillFilterActive = false
 			m.Screen = ScreenSkillInstall
 			m.Cursor = 0
 			m.SkillScroll = 0
 			return m, loadSkillsCmd()
 		case 2: // Remove
+			m.initSkillTargets()
 			m.SkillLoading = true
 			m.SkillLoadError = ""
+			m.SkillNotice = ""
+			m.SkillSelected = nil
+			m.SkillFilter = ""
+			m.SkillFilterActive = false
 			m.Screen = ScreenSkillRemove
 			m.Cursor = 0
 			m.SkillScroll = 0
@@ -1613,11 +2604,45 @@ func (m Model) handleSelection() (tea.Model, tea.Cmd) {
 		case 3: // Update Catalog
 			m.SkillLoading = true
 			m.SkillLoadError = ""
-			m.SkillResultLog = nil
+			m.SkillResultLog.Reset()
 			m.ErrorMsg = ""
 			m.Screen = ScreenSkillUpdate
+			m.TaskStarted = installClock()
 			return m, updateSkillCatalogCmd()
-		case 5: // Back (after separator at 4)
+		case 4: // Catalog Stats
+			m.Screen = ScreenSkillStats
+			m.SkillScroll = 0
+			if m.SkillStats == nil {
+				m.SkillLoading = true
+				m.SkillLoadError = ""
+				return m, loadSkillStatsCmd()
+			}
+		case 5: // Undo Last Operation
+			if !m.SkillUndo.Undoable() {
+				return m, nil
+			}
+			op := m.SkillUndo
+			m.SkillLoadError = ""
+			m.SkillResultLog.Reset()
+			m.ErrorMsg = ""
+			m.Screen = ScreenSkillResult
+			return m, undoSkillActionCmd(op)
+		case 6: // Doctor
+			home, err := os.UserHomeDir()
+			if err != nil {
+				m.SkillLoadError = "cannot determine home directory: " + err.Error()
+				return m, nil
+			}
+			m.SkillDoctor = scanSkillLinks(home)
+			m.Screen = ScreenSkillDoctor
+			m.Cursor = 0
+		case 7: // Install from Manifest
+			return m.enterSkillManifest(false), nil
+		case 8: // Export Installed Skills
+			return m.enterSkillManifest(true), nil
+		case 9: // Install from URL
+			return m.enterSkillURL(), nil
+		case 11: // Back (after separator at 10)
 			m.Screen = ScreenMainMenu
 			m.Cursor = 0
 		}
@@ -1630,8 +2655,12 @@ func (m Model) handleSelection() (tea.Model, tea.Cmd) {
 			for _, cat := range moduleCategories {
 				m.AICategorySelected[cat.ID] = make([]bool, len(cat.Items))
 			}
+			m.AIFrameworkPresetNotice = ""
 			m.Screen = ScreenAIFrameworkCategories
 			m.Cursor = 0
+		} else if idx := m.Cursor - userPresetsStart; idx >= 0 && idx < len(m.AIFrameworkUserPresets) {
+			// Saved presets open the categories for review
+			m = m.applyFrameworkPreset(m.AIFrameworkUserPresets[idx])
 		} else if m.Cursor >= 2 && m.Cursor <= 7 {
 			// Presets at indices 2-7 (after separator at 1)
 			presets := []string{"minimal", "frontend", "backend", "fullstack", "data", "complete"}
@@ -1639,7 +2668,7 @@ func (m Model) handleSelection() (tea.Model, tea.Cmd) {
 			if presetIdx < len(presets) {
 				m.Choices.AIFrameworkPreset = presets[presetIdx]
 				m.Choices.AIFrameworkModules = nil
-				return m.proceedToBackupOrInstall()
+				return m.enterWizardReview(), nil
 			}
 		}
 	}
@@ -1649,22 +2678,99 @@ func (m Model) handleSelection() (tea.Model, tea.Cmd) {
 
 // proceedToBackupOrInstall handles the transition from the last wizard screen to installation
 func (m Model) proceedToBackupOrInstall() (tea.Model, tea.Cmd) {
+	if !m.OSWarningAccepted {
+		if w := m.SystemInfo.VersionWarning(); w != nil {
+			m.OSWarning = w
+			m.Screen = ScreenOSVersionWarning
+			m.Cursor = 0
+			return m, nil
+		}
+	}
+	m.DiskCheck = m.checkDiskSpace()
+	if !m.DiskWarningAccepted && m.DiskCheck.Short() {
+		m.Screen = ScreenDiskSpaceWarning
+		m.Cursor = 0
+		return m, nil
+	}
 	m.ExistingConfigs = system.DetectExistingConfigs()
 	if len(m.ExistingConfigs) > 0 {
 		m.Screen = ScreenBackupConfirm
 		m.Cursor = 0
-	} else {
-		m.SetupInstallSteps()
-		m.Screen = ScreenInstalling
-		m.CurrentStep = 0
-		return m, func() tea.Msg { return installStartMsg{} }
+		m.ProfileNotice = ""
+		// Walking large config trees can take a while, so size them in the background
+		m.BackupSizes = nil
+		m.BackupSizing = true
+		return m, estimateBackupSizesCmd(m.ExistingConfigs)
 	}
-	return m, nil
+	return m.startInstallation()
+}
+
+// startInstallation validates the wizard choices and begins running the install steps.
+// The wizard should never produce invalid choices, so problems are surfaced in the
+// install log as a regression signal rather than blocking the install.
+func (m Model) startInstallation() (tea.Model, tea.Cmd) {
+	for _, ce := range ValidateChoices(m.Choices, m.SystemInfo) {
+		m.LogLines.Add("⚠️  Invalid choice " + ce.Error())
+	}
+	m.SetupInstallSteps()
+	m.Screen = ScreenInstalling
+	m.CurrentStep = 0
+	m.trackProgress = true
+	m.ResumeState = nil
+	m.openInstallLog()
+	m.recordDeploys()
+	return m, func() tea.Msg { return installStartMsg{} }
 }
 
 // aiToolIDMap maps AI tool option index to tool ID
 var aiToolIDMap = []string{"claude", "opencode", "gemini", "copilot", "codex", "qwen"}
 
+// aiToolNames are the option labels of aiToolIDMap
+var aiToolNames = []string{"Claude Code", "OpenCode", "Gemini CLI", "GitHub Copilot", "Codex CLI", "Qwen Code"}
+
+// aiToolCommandExists looks for AI tools and their installers on PATH; tests
+// swap it to fake what is installed
+var aiToolCommandExists = system.CommandExists
+
+// enterAIToolsSelect opens the AI tools checklist with nothing selected,
+// checking which tools are installed and which can't be installed here
+func (m Model) enterAIToolsSelect() Model {
+	m.Screen = ScreenAIToolsSelect
+	m.Cursor = 0
+	m.AIToolSelected = make([]bool, len(aiToolIDMap))
+	m.AIToolStatus = make([]system.AIToolStatus, len(aiToolIDMap))
+	for i, tool := range aiToolIDMap {
+		// The Neovim step installs Node.js, and npm with it
+		m.AIToolStatus[i] = system.AIToolAvailability(tool, m.Choices.OS, m.Choices.InstallNvim, aiToolCommandExists)
+	}
+	return m
+}
+
+// aiToolOptions labels each AI tool with what the availability check found
+func (m Model) aiToolOptions() []string {
+	opts := make([]string, len(aiToolNames))
+	for i, name := range aiToolNames {
+		opts[i] = name
+		if i >= len(m.AIToolStatus) {
+			continue
+		}
+		switch status := m.AIToolStatus[i]; {
+		case status.Unsupported != "":
+			opts[i] += " (unavailable on " + status.Unsupported + ")"
+		case status.Installed:
+			opts[i] += " (already installed)"
+		case status.Needs != "":
+			opts[i] += " (unavailable: needs " + status.Needs + ")"
+		}
+	}
+	return opts
+}
+
+// aiToolAvailable reports whether the tool at index i can be selected
+func (m Model) aiToolAvailable(i int) bool {
+	return i >= len(m.AIToolStatus) || m.AIToolStatus[i].Available()
+}
+
 // rolePackIDMap maps role pack option index to pack ID (0=developer, 1=pm-lead)
 var rolePackIDMap = []string{"developer", "pm-lead"}
 
@@ -2090,7 +3196,7 @@ func isAgentTeamsLiteSelected(sel map[string][]bool) bool {
 }
 
 func (m Model) handleAIToolsKeys(key string) (tea.Model, tea.Cmd) {
-	options := m.GetCurrentOptions()
+	options := m.canonicalOptions()
 	lastToolIdx := len(aiToolIDMap) - 1 // Last toggleable tool index
 	confirmIdx := len(options) - 1      // "Confirm selection" is last option
 	selectAllIdx := confirmIdx - 1      // "Select All" is second to last
@@ -2112,22 +3218,32 @@ func (m Model) handleAIToolsKeys(key string) (tea.Model, tea.Cmd) {
 			}
 		}
 	case "enter", " ":
+		if !m.multiSelectRuns(key, m.Cursor <= selectAllIdx, confirmIdx) {
+			break
+		}
 		if m.Cursor <= lastToolIdx {
 			// Toggle tool selection
-			if m.AIToolSelected != nil && m.Cursor < len(m.AIToolSelected) {
+			if !m.aiToolAvailable(m.Cursor) {
+				status := m.AIToolStatus[m.Cursor]
+				toast := fmt.Sprintf("%s can't be installed here: install %s first", aiToolNames[m.Cursor], status.Needs)
+				if status.Unsupported != "" {
+					toast = fmt.Sprintf("%s doesn't run on %s", aiToolNames[m.Cursor], status.Unsupported)
+				}
+				m = m.showToast(toast)
+			} else if m.AIToolSelected != nil && m.Cursor < len(m.AIToolSelected) {
 				m.AIToolSelected[m.Cursor] = !m.AIToolSelected[m.Cursor]
 			}
 		} else if m.Cursor == selectAllIdx {
-			// Select All — toggle all tools on/off
+			// Select All — toggle the available tools on/off
 			allSelected := true
 			for i := 0; i < len(aiToolIDMap); i++ {
-				if !m.AIToolSelected[i] {
+				if m.aiToolAvailable(i) && !m.AIToolSelected[i] {
 					allSelected = false
 					break
 				}
 			}
 			for i := 0; i < len(aiToolIDMap); i++ {
-				m.AIToolSelected[i] = !allSelected
+				m.AIToolSelected[i] = !allSelected && m.aiToolAvailable(i)
 			}
 		} else if m.Cursor == confirmIdx {
 			// Confirm — collect selected tools
@@ -2138,14 +3254,19 @@ func (m Model) handleAIToolsKeys(key string) (tea.Model, tea.Cmd) {
 				}
 			}
 			m.Choices.AITools = selected
-			// If any AI tools selected, ask about framework
-			if len(m.Choices.AITools) > 0 {
+			// If any AI tools selected, ask about framework (not on
+			// native Windows, where its installer can't run)
+			if len(m.Choices.AITools) > 0 && m.Choices.OS != "windows" {
+				// An edit keeps a framework that was already picked
+				if m.ReturnToReview && m.Choices.InstallAIFramework {
+					return m.enterWizardReview(), nil
+				}
 				m.Screen = ScreenAIFrameworkConfirm
 				m.Cursor = 0
 			} else {
 				// No AI tools, skip framework too
 				m.Choices.InstallAIFramework = false
-				return m.proceedToBackupOrInstall()
+				return m.enterWizardReview(), nil
 			}
 		}
 	case "esc", "backspace":
@@ -2156,7 +3277,7 @@ func (m Model) handleAIToolsKeys(key string) (tea.Model, tea.Cmd) {
 }
 
 func (m Model) handleRolePackKeys(key string) (tea.Model, tea.Cmd) {
-	options := m.GetCurrentOptions()
+	options := m.canonicalOptions()
 	confirmIdx := len(options) - 1 // "✅ Confirm selection" is last option
 
 	switch key {
@@ -2176,6 +3297,9 @@ func (m Model) handleRolePackKeys(key string) (tea.Model, tea.Cmd) {
 			}
 		}
 	case "enter", " ":
+		if !m.multiSelectRuns(key, m.Cursor <= 2, confirmIdx) {
+			break
+		}
 		switch {
 		case m.Cursor == 0:
 			// Core — always on, no-op
@@ -2197,7 +3321,7 @@ func (m Model) handleRolePackKeys(key string) (tea.Model, tea.Cmd) {
 			}
 			m.ProjectRolePacks = packs
 			m.Screen = ScreenProjectCI
-			m.Cursor = 0
+			m.Cursor = projectChoiceCursor(projectCIIDs, m.ProjectCI)
 		}
 	case "esc", "backspace":
 		return m.goBackInstallStep()
@@ -2207,7 +3331,7 @@ func (m Model) handleRolePackKeys(key string) (tea.Model, tea.Cmd) {
 }
 
 func (m Model) handleAICategoriesKeys(key string) (tea.Model, tea.Cmd) {
-	options := m.GetCurrentOptions()
+	options := m.canonicalOptions()
 	lastCategoryIdx := len(moduleCategories) - 1
 	confirmIdx := len(options) - 1
 
@@ -2234,6 +3358,13 @@ func (m Model) handleAICategoriesKeys(key string) (tea.Model, tea.Cmd) {
 			m.Cursor = 0
 			m.CategoryItemsScroll = 0
 		} else if m.Cursor == confirmIdx {
+			if m.AIFrameworkApplyMode {
+				// Apply-only mode: review the diff against what's installed
+				m.AIFrameworkDiffAdded, m.AIFrameworkDiffRemoved = diffFrameworkSelection(m.AIFrameworkInstalled, m.AICategorySelected)
+				m.Screen = ScreenAIFrameworkApplyDiff
+				m.Cursor = 0
+				return m, nil
+			}
 			// Confirm — collect selected features for setup-global.sh
 			m.Choices.AIFrameworkModules = collectSelectedFeatures(m.AICategorySelected)
 			// Check if Agent Teams Lite is selected in SDD category
@@ -2241,7 +3372,9 @@ func (m Model) handleAICategoriesKeys(key string) (tea.Model, tea.Cmd) {
 			if len(m.Choices.AIFrameworkModules) == 0 && !m.Choices.InstallAgentTeamsLite {
 				m.Choices.InstallAIFramework = false
 			}
-			return m.proceedToBackupOrInstall()
+			// Review the selection before installing
+			m.Screen = ScreenAIFrameworkSummary
+			m.Cursor = 0
 		}
 	case "esc", "backspace":
 		return m.goBackInstallStep()
@@ -2250,6 +3383,78 @@ func (m Model) handleAICategoriesKeys(key string) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// enterAIFrameworkApply opens the module categories pre-populated with what is already
+// installed under home, so the user can add or remove modules on an existing setup.
+func (m Model) enterAIFrameworkApply(home string) (tea.Model, tea.Cmd) {
+	installed := detectInstalledFrameworkModules(home)
+	m.AIFrameworkApplyMode = true
+	m.AIFrameworkInstalled = installed
+	m.AICategorySelected = copySelection(installed)
+	m.AIFrameworkDiffAdded = nil
+	m.AIFrameworkDiffRemoved = nil
+	m.Choices = UserChoices{
+		AITools:            append([]string(nil), m.DetectedAITools...),
+		InstallAIFramework: true,
+	}
+	m.Screen = ScreenAIFrameworkCategories
+	m.Cursor = 0
+	return m, nil
+}
+
+// exitAIFrameworkApply leaves apply-only mode and returns to the main menu
+func (m Model) exitAIFrameworkApply() (tea.Model, tea.Cmd) {
+	m.AIFrameworkApplyMode = false
+	m.AIFrameworkInstalled = nil
+	m.AIFrameworkDiffAdded = nil
+	m.AIFrameworkDiffRemoved = nil
+	m.AICategorySelected = nil
+	m.Choices = UserChoices{}
+	m.Screen = ScreenMainMenu
+	m.Cursor = 0
+	return m, nil
+}
+
+// handleAIFrameworkApplyDiffKeys handles the review screen of apply-only mode
+func (m Model) handleAIFrameworkApplyDiffKeys(key string) (tea.Model, tea.Cmd) {
+	options := m.canonicalOptions()
+
+	switch key {
+	case "up", "k":
+		if m.Cursor > 0 {
+			m.Cursor--
+		}
+	case "down", "j":
+		if m.Cursor < len(options)-1 {
+			m.Cursor++
+		}
+	case "enter", " ":
+		selected := options[m.Cursor]
+		switch {
+		case strings.Contains(selected, "Apply changes"):
+			features, agentTeams, prune := planFrameworkApply(m.AICategorySelected, m.AIFrameworkDiffAdded, m.AIFrameworkDiffRemoved)
+			m.Choices.AIFrameworkPreset = ""
+			m.Choices.AIFrameworkModules = features
+			m.Choices.InstallAgentTeamsLite = agentTeams
+			m.Choices.AIFrameworkRemove = prune
+			m.SetupAIFrameworkApplySteps()
+			m.trackProgress = false
+			m.Screen = ScreenInstalling
+			m.CurrentStep = 0
+			return m, func() tea.Msg { return installStartMsg{} }
+		case strings.Contains(selected, "Back to modules"):
+			m.Screen = ScreenAIFrameworkCategories
+			m.Cursor = 0
+		case strings.Contains(selected, "Cancel"):
+			return m.exitAIFrameworkApply()
+		}
+	case "backspace":
+		m.Screen = ScreenAIFrameworkCategories
+		m.Cursor = 0
+	}
+
+	return m, nil
+}
+
 func (m Model) handleAICategoryItemsKeys(key string) (tea.Model, tea.Cmd) {
 	if m.SelectedModuleCategory < 0 || m.SelectedModuleCategory >= len(moduleCategories) {
 		return m, nil
@@ -2279,6 +3484,10 @@ func (m Model) handleAICategoryItemsKeys(key string) (tea.Model, tea.Cmd) {
 	case "enter", " ":
 		if m.Cursor < len(entries) {
 			entry := entries[m.Cursor]
+			// No Confirm row here: the choices apply when going back
+			if !m.multiSelectRuns(key, !entry.back, -1) {
+				break
+			}
 			if entry.selectAll {
 				m.toggleAllCategoryItems(cat.ID, bools)
 			} else if entry.isGroupHeader() {
@@ -2299,10 +3508,7 @@ func (m Model) handleAICategoryItemsKeys(key string) (tea.Model, tea.Cmd) {
 	}
 
 	// Keep scroll in sync with cursor (viewport follows cursor)
-	visibleItems := m.Height - 8
-	if visibleItems < 5 {
-		visibleItems = 5
-	}
+	visibleItems := m.visibleRows(8)
 	if m.Cursor < m.CategoryItemsScroll {
 		m.CategoryItemsScroll = m.Cursor
 	}
@@ -2344,7 +3550,7 @@ func (m *Model) toggleGroupItems(catID string, bools []bool, start, end int) {
 }
 
 func (m Model) handleLearnMenuKeys(key string) (tea.Model, tea.Cmd) {
-	options := m.GetCurrentOptions()
+	options := m.canonicalOptions()
 
 	switch key {
 	case "up", "k":
@@ -2400,7 +3606,7 @@ func (m Model) handleLearnMenuKeys(key string) (tea.Model, tea.Cmd) {
 }
 
 func (m Model) handleKeymapsMenuKeys(key string) (tea.Model, tea.Cmd) {
-	options := m.GetCurrentOptions()
+	options := m.canonicalOptions()
 
 	switch key {
 	case "up", "k":
@@ -2441,10 +3647,7 @@ func (m Model) handleKeymapCategoryKeys(key string) (tea.Model, tea.Cmd) {
 	category := m.KeymapCategories[m.SelectedCategory]
 
 	// Calculate visible items based on terminal height (same as view)
-	visibleItems := m.Height - 9
-	if visibleItems < 5 {
-		visibleItems = 5
-	}
+	visibleItems := m.visibleRows(9)
 
 	maxScroll := len(category.Keymaps) - visibleItems
 	if maxScroll < 0 {
@@ -2470,7 +3673,7 @@ func (m Model) handleKeymapCategoryKeys(key string) (tea.Model, tea.Cmd) {
 
 // handleToolKeymapsMenuKeys handles the tool selection menu (Neovim, Tmux, Zellij, Ghostty)
 func (m Model) handleToolKeymapsMenuKeys(key string) (tea.Model, tea.Cmd) {
-	options := m.GetCurrentOptions()
+	options := m.canonicalOptions()
 
 	switch key {
 	case "up", "k":
@@ -2512,6 +3715,8 @@ func (m Model) handleToolKeymapsMenuKeys(key string) (tea.Model, tea.Cmd) {
 		case 3: // Ghostty
 			m.Screen = ScreenKeymapsGhostty
 			m.Cursor = 0
+		case 4: // Refresh from my config
+			m = m.refreshKeymapsFromConfig(os.Getenv("HOME"))
 		}
 	}
 
@@ -2520,7 +3725,7 @@ func (m Model) handleToolKeymapsMenuKeys(key string) (tea.Model, tea.Cmd) {
 
 // handleTmuxKeymapsMenuKeys handles Tmux keymap category selection
 func (m Model) handleTmuxKeymapsMenuKeys(key string) (tea.Model, tea.Cmd) {
-	options := m.GetCurrentOptions()
+	options := m.canonicalOptions()
 
 	switch key {
 	case "up", "k":
@@ -2561,10 +3766,7 @@ func (m Model) handleTmuxKeymapsMenuKeys(key string) (tea.Model, tea.Cmd) {
 func (m Model) handleTmuxKeymapCategoryKeys(key string) (tea.Model, tea.Cmd) {
 	category := m.TmuxKeymapCategories[m.TmuxSelectedCategory]
 
-	visibleItems := m.Height - 9
-	if visibleItems < 5 {
-		visibleItems = 5
-	}
+	visibleItems := m.visibleRows(9)
 
 	maxScroll := len(category.Keymaps) - visibleItems
 	if maxScroll < 0 {
@@ -2590,7 +3792,7 @@ func (m Model) handleTmuxKeymapCategoryKeys(key string) (tea.Model, tea.Cmd) {
 
 // handleZellijKeymapsMenuKeys handles Zellij keymap category selection
 func (m Model) handleZellijKeymapsMenuKeys(key string) (tea.Model, tea.Cmd) {
-	options := m.GetCurrentOptions()
+	options := m.canonicalOptions()
 
 	switch key {
 	case "up", "k":
@@ -2631,10 +3833,7 @@ func (m Model) handleZellijKeymapsMenuKeys(key string) (tea.Model, tea.Cmd) {
 func (m Model) handleZellijKeymapCategoryKeys(key string) (tea.Model, tea.Cmd) {
 	category := m.ZellijKeymapCategories[m.ZellijSelectedCategory]
 
-	visibleItems := m.Height - 9
-	if visibleItems < 5 {
-		visibleItems = 5
-	}
+	visibleItems := m.visibleRows(9)
 
 	maxScroll := len(category.Keymaps) - visibleItems
 	if maxScroll < 0 {
@@ -2660,7 +3859,7 @@ func (m Model) handleZellijKeymapCategoryKeys(key string) (tea.Model, tea.Cmd) {
 
 // handleGhosttyKeymapsMenuKeys handles Ghostty keymap category selection
 func (m Model) handleGhosttyKeymapsMenuKeys(key string) (tea.Model, tea.Cmd) {
-	options := m.GetCurrentOptions()
+	options := m.canonicalOptions()
 
 	switch key {
 	case "up", "k":
@@ -2701,10 +3900,7 @@ func (m Model) handleGhosttyKeymapsMenuKeys(key string) (tea.Model, tea.Cmd) {
 func (m Model) handleGhosttyKeymapCategoryKeys(key string) (tea.Model, tea.Cmd) {
 	category := m.GhosttyKeymapCategories[m.GhosttySelectedCategory]
 
-	visibleItems := m.Height - 9
-	if visibleItems < 5 {
-		visibleItems = 5
-	}
+	visibleItems := m.visibleRows(9)
 
 	maxScroll := len(category.Keymaps) - visibleItems
 	if maxScroll < 0 {
@@ -2729,7 +3925,7 @@ func (m Model) handleGhosttyKeymapCategoryKeys(key string) (tea.Model, tea.Cmd)
 }
 
 func (m Model) handleLazyVimMenuKeys(key string) (tea.Model, tea.Cmd) {
-	options := m.GetCurrentOptions()
+	options := m.canonicalOptions()
 
 	switch key {
 	case "up", "k":
@@ -2771,10 +3967,7 @@ func (m Model) handleLazyVimTopicKeys(key string) (tea.Model, tea.Cmd) {
 
 	// Calculate view height based on terminal size (same as view)
 	// Reserve space for: title(1) + description(1) + blank(2) + scroll info(2) + help(2) = 8 lines
-	viewHeight := m.Height - 8
-	if viewHeight < 10 {
-		viewHeight = 10 // Minimum
-	}
+	viewHeight := m.visibleRows(8)
 
 	// Calculate content height: content lines + code example lines + tips
 	contentLines := len(topic.Content) + strings.Count(topic.CodeExample, "\n") + len(topic.Tips) + 10
@@ -2811,7 +4004,7 @@ func (m Model) handleLazyVimTopicKeys(key string) (tea.Model, tea.Cmd) {
 }
 
 func (m Model) handleBackupConfirmKeys(key string) (tea.Model, tea.Cmd) {
-	options := m.GetCurrentOptions()
+	options := m.canonicalOptions()
 
 	switch key {
 	case "up", "k":
@@ -2822,46 +4015,70 @@ func (m Model) handleBackupConfirmKeys(key string) (tea.Model, tea.Cmd) {
 		if m.Cursor < len(options)-1 {
 			m.Cursor++
 		}
+	case "c":
+		// Toggle backing up regenerable caches
+		m.Choices.BackupIncludeCaches = !m.Choices.BackupIncludeCaches
+	case "h":
+		// Toggle leaving shell history out of the backup
+		m.Choices.BackupExcludeHistory = !m.Choices.BackupExcludeHistory
+	case "z":
+		// Toggle writing the backup as a compressed archive
+		m.Choices.BackupCompress = !m.Choices.BackupCompress
+	case "p":
+		return m.enterInstallRefs(), nil
 	case "enter", " ":
 		switch m.Cursor {
 		case 0: // Install with Backup
 			m.Choices.CreateBackup = true
-			m.SetupInstallSteps()
-			m.Screen = ScreenInstalling
-			m.CurrentStep = 0
-			return m, func() tea.Msg { return installStartMsg{} }
+			return m.startInstallation()
 		case 1: // Install without Backup
 			m.Choices.CreateBackup = false
-			m.SetupInstallSteps()
-			m.Screen = ScreenInstalling
-			m.CurrentStep = 0
-			return m, func() tea.Msg { return installStartMsg{} }
-		case 2: // Cancel - abort the entire wizard
+			return m.startInstallation()
+		case 2: // Save these choices as a profile
+			return m.enterProfileSave(), nil
+		case 3: // Cancel - abort the entire wizard
 			m.Screen = ScreenMainMenu
 			m.Cursor = 0
 			// Reset choices when canceling
 			m.Choices = UserChoices{}
 		}
 	case "esc", "backspace":
-		// Go back to the last AI screen in the wizard flow
-		if len(m.Choices.AITools) > 0 && m.Choices.InstallAIFramework && m.AICategorySelected != nil {
-			// Was in custom mode — go back to categories
-			m.Screen = ScreenAIFrameworkCategories
-		} else if len(m.Choices.AITools) > 0 && m.Choices.InstallAIFramework {
-			m.Screen = ScreenAIFrameworkPreset
-		} else if len(m.Choices.AITools) > 0 {
-			m.Screen = ScreenAIFrameworkConfirm
-		} else {
-			m.Screen = ScreenAIToolsSelect
+		return m.goBackInstallStep()
+	}
+
+	return m, nil
+}
+
+func (m Model) handleOSVersionWarningKeys(key string) (tea.Model, tea.Cmd) {
+	options := m.canonicalOptions()
+
+	switch key {
+	case "up", "k":
+		if m.Cursor > 0 {
+			m.Cursor--
+		}
+	case "down", "j":
+		if m.Cursor < len(options)-1 {
+			m.Cursor++
+		}
+	case "enter":
+		if m.Cursor == 0 { // Continue anyway
+			m.OSWarningAccepted = true
+			return m.proceedToBackupOrInstall()
 		}
+		// Abort - same as cancelling the wizard
+		m.Screen = ScreenMainMenu
 		m.Cursor = 0
+		m.Choices = UserChoices{}
+	case "esc", "backspace":
+		return m.goBackInstallStep()
 	}
 
 	return m, nil
 }
 
 func (m Model) handleRestoreBackupKeys(key string) (tea.Model, tea.Cmd) {
-	options := m.GetCurrentOptions()
+	options := m.canonicalOptions()
 
 	switch key {
 	case "up", "k":
@@ -2891,11 +4108,17 @@ func (m Model) handleRestoreBackupKeys(key string) (tea.Model, tea.Cmd) {
 		if strings.HasPrefix(options[m.Cursor], "───") {
 			return m, nil
 		}
+		if strings.Contains(options[m.Cursor], "Prune old backups") {
+			m.Screen = ScreenBackupPrune
+			m.Cursor = 0
+			return m, nil
+		}
 		// Select a backup
 		if m.Cursor < len(m.AvailableBackups) {
 			m.SelectedBackup = m.Cursor
 			m.Screen = ScreenRestoreConfirm
 			m.Cursor = 0
+			m.BackupNotice = ""
 		}
 	case "esc":
 		m.Screen = ScreenMainMenu
@@ -2906,7 +4129,7 @@ func (m Model) handleRestoreBackupKeys(key string) (tea.Model, tea.Cmd) {
 }
 
 func (m Model) handleRestoreConfirmKeys(key string) (tea.Model, tea.Cmd) {
-	options := m.GetCurrentOptions()
+	options := m.canonicalOptions()
 
 	switch key {
 	case "up", "k":
@@ -2921,16 +4144,11 @@ func (m Model) handleRestoreConfirmKeys(key string) (tea.Model, tea.Cmd) {
 		backup := m.AvailableBackups[m.SelectedBackup]
 		switch m.Cursor {
 		case 0: // Restore
-			err := system.RestoreBackup(backup.Path)
-			if err != nil {
-				m.Screen = ScreenError
-				m.ErrorMsg = "Failed to restore backup: " + err.Error()
-				return m, nil
+			if len(backup.Items) == 0 {
+				// Nothing to pick from: restore whatever the backup holds
+				return m.planRestore(nil)
 			}
-			// Refresh backups list
-			m.AvailableBackups = system.ListBackups()
-			m.Screen = ScreenComplete
-			m.Choices = UserChoices{} // Clear choices to indicate restore
+			return m.enterRestoreItems(), nil
 		case 1: // Delete
 			_ = system.DeleteBackup(backup.Path)
 			// Refresh backups list
@@ -2938,6 +4156,7 @@ func (m Model) handleRestoreConfirmKeys(key string) (tea.Model, tea.Cmd) {
 			m.Screen = ScreenRestoreBackup
 			m.Cursor = 0
 			m.SelectedBackup = 0
+			return m, backupDiskSizesCmd(m.AvailableBackups)
 		case 2: // Cancel
 			m.Screen = ScreenRestoreBackup
 			m.Cursor = m.SelectedBackup
@@ -2950,29 +4169,206 @@ func (m Model) handleRestoreConfirmKeys(key string) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
-// runNextStep starts the next installation step
-func (m Model) runNextStep() tea.Cmd {
-	if m.CurrentStep >= len(m.Steps) {
-		return func() tea.Msg {
-			return installCompleteMsg{totalTime: 0}
+func (m Model) handleRestoreConflictKeys(key string) (tea.Model, tea.Cmd) {
+	options := m.canonicalOptions()
+
+	switch key {
+	case "up", "k":
+		if m.Cursor > 0 {
+			m.Cursor--
+		}
+	case "down", "j":
+		if m.Cursor < len(options)-1 {
+			m.Cursor++
+		}
+	case "a":
+		// Toggle applying the next decision to every remaining conflict
+		m.RestoreApplyAll = !m.RestoreApplyAll
+	case "enter", " ":
+		choice := system.ConflictChoice(m.Cursor)
+		last := m.RestoreConflictIdx
+		if m.RestoreApplyAll {
+			last = len(m.RestoreConflicts) - 1
+		}
+		for i := m.RestoreConflictIdx; i <= last; i++ {
+			m.RestoreChoices[m.RestoreConflicts[i].Path] = choice
+		}
+		m.RestoreConflictIdx = last + 1
+		m.Cursor = 0
+		if m.RestoreConflictIdx >= len(m.RestoreConflicts) {
+			return m.applyRestorePlan()
+		}
+	}
+
+	return m, nil
+}
+
+// planRestore plans restoring the given keys of the selected backup (nil for
+// all of them) and asks about conflicts before anything is written
+func (m Model) planRestore(keys []string) (tea.Model, tea.Cmd) {
+	backup := m.AvailableBackups[m.SelectedBackup]
+	// A compressed backup is extracted once; the plan points into the copy
+	dir, cleanup, err := system.OpenBackup(backup.Path)
+	if err != nil {
+		m.Screen = ScreenError
+		m.ErrorMsg = m.Tf("error.restore_failed", err)
+		return m, nil
+	}
+	m.RestoreCleanup = cleanup
+	plan, err := system.PlanRestoreItems(dir, keys)
+	if err != nil {
+		m.releaseRestoreBackup()
+		m.Screen = ScreenError
+		m.ErrorMsg = m.Tf("error.restore_failed", err)
+		return m, nil
+	}
+	m.RestorePlan = plan
+	m.RestoreConflicts = system.RestoreConflicts(plan)
+	m.RestoreConflictIdx = 0
+	m.RestoreChoices = make(map[string]system.ConflictChoice)
+	m.RestoreApplyAll = false
+	// Files changed since the install need a decision before anything is written
+	if len(m.RestoreConflicts) > 0 {
+		m.Screen = ScreenRestoreConflict
+		m.Cursor = 0
+		return m, nil
+	}
+	return m.applyRestorePlan()
+}
+
+// releaseRestoreBackup removes the extracted copy of a compressed backup once
+// the restore is applied or abandoned
+func (m *Model) releaseRestoreBackup() {
+	if m.RestoreCleanup != nil {
+		m.RestoreCleanup()
+		m.RestoreCleanup = nil
+	}
+}
+
+// applyRestorePlan writes the planned restore using the conflict decisions collected so far
+func (m Model) applyRestorePlan() (tea.Model, tea.Cmd) {
+	err := system.ApplyRestore(m.RestorePlan, m.RestoreChoices)
+	m.releaseRestoreBackup()
+	if err != nil {
+		m.Screen = ScreenError
+		m.ErrorMsg = m.Tf("error.restore_failed", err)
+		return m, nil
+	}
+	m.RestorePlan = nil
+	m.RestoreConflicts = nil
+	m.RestoreChoices = nil
+	m.RestoreItemSelected = nil
+	// Refresh backups list
+	m.AvailableBackups = system.ListBackups()
+	m.Screen = ScreenComplete
+	m.Choices = UserChoices{} // Clear choices to indicate restore
+	return m, nil
+}
+
+// finishStep records the outcome of a step and starts whatever it unblocked
+func (m Model) finishStep(stepID string, err error) (tea.Model, tea.Cmd) {
+	for i := range m.Steps {
+		if m.Steps[i].ID != stepID {
+			continue
+		}
+		m.Steps[i].stopClock()
+		logStepEnd(m.Steps[i], err)
+		if errors.Is(err, errInstallCancelled) {
+			m.skipCancelledStep(i)
+		} else if err != nil {
+			m.Steps[i].Status = StatusFailed
+			m.Steps[i].Error = err
+			if blocked := blockedBy(m.Steps, stepID); len(blocked) > 0 {
+				m.LogLines.Add(fmt.Sprintf("⏸️  Not starting %s: needs %s", strings.Join(blocked, ", "), m.Steps[i].Name))
+			}
+		} else {
+			m.Steps[i].Status = StatusDone
+			m.Steps[i].Progress = 1.0
+		}
+		break
+	}
+	m.saveProgress()
+	m.skipFinishedSteps()
+	return m.runReadySteps()
+}
+
+// runReadySteps starts every step the scheduler allows (see step_scheduler.go)
+// and finishes the install once no step is left. After a failure the steps
+// that don't depend on it still run; once nothing else can, the error shows.
+// After a cancel it only waits for the running steps.
+func (m Model) runReadySteps() (tea.Model, tea.Cmd) {
+	running := stepsRunning(m.Steps)
+	if m.InstallCancelled {
+		if running > 0 {
+			return m, nil
+		}
+		return m.showInstallCancelled(), nil
+	}
+	var failures []string
+	for _, step := range m.Steps {
+		if step.Status == StatusFailed {
+			// Include step name in error message for clarity
+			failures = append(failures, m.Tf("error.step_failed", step.Name, step.Error))
+		}
+	}
+	ready := stepScheduler{Workers: installWorkers}.next(m.Steps)
+	if len(failures) > 0 && len(ready) == 0 {
+		if running > 0 {
+			return m, nil
 		}
+		return m.showInstallError(strings.Join(failures, "\n\n")), m.notifyCmd(m.T("notify.install_failed"))
 	}
 
-	step := &m.Steps[m.CurrentStep]
-	step.Status = StatusRunning
+	if m.CurrentStep >= len(m.Steps) {
+		var total float64
+		if !m.InstallStarted.IsZero() {
+			total = installClock().Sub(m.InstallStarted).Seconds()
+		}
+		return m, func() tea.Msg {
+			return installCompleteMsg{totalTime: total}
+		}
+	}
 
-	// Check if this step needs interactive input (sudo, chsh, etc)
-	if step.Interactive {
-		return runInteractiveStep(step.ID, &m)
+	if len(ready) == 0 && running == 0 {
+		// Only a dependency cycle or a step depending on a later one gets here
+		return m.showInstallError(m.Tf("error.step_blocked", m.Steps[m.CurrentStep].Name)), m.notifyCmd(m.T("notify.install_failed"))
 	}
+	cmds := make([]tea.Cmd, 0, len(ready))
+	for _, i := range ready {
+		m.Steps[i].Status = StatusRunning
+		m.Steps[i].StartedAt = installClock()
+		logStepStart(m.Steps[i])
+		cmds = append(cmds, m.stepCmd(m.Steps[i].ID, m.Steps[i].Interactive))
+	}
+	return m, tea.Batch(cmds...)
+}
 
+// stepCmd runs one step on its own copy of the model. Interactive steps
+// (sudo, chsh, etc) take over the terminal through tea.ExecProcess.
+func (m Model) stepCmd(stepID string, interactive bool) tea.Cmd {
+	if interactive {
+		return runInteractiveStep(stepID, &m)
+	}
+	// The copy reports only what this step changed; Update merges it in
+	m.UpdateSummary = updateSummary{}
 	return func() tea.Msg {
-		// Execute the step
-		err := executeStep(step.ID, &m)
-		return stepCompleteMsg{stepID: step.ID, err: err}
+		err := executeStep(m.installContext(), stepID, &m)
+		return stepCompleteMsg{stepID: stepID, err: err, backupDir: m.BackupDir, repoCommit: m.RepoCommit, update: m.UpdateSummary}
 	}
 }
 
+// showInstallError stops the install on the error screen, removing its
+// temporary files
+func (m Model) showInstallError(msg string) Model {
+	m.releaseInstallContext()
+	m.Screen = ScreenError
+	m.ErrorMsg = msg
+	m.TempCleanupNote = cleanupAfterFailure()
+	m.saveProgress()
+	system.RecordDeploys("")
+	return m
+}
+
 // ============================================================================
 // Trainer Handlers
 // ============================================================================
@@ -2985,22 +4381,30 @@ func (m Model) handleTrainerMenuKeys(key string) (tea.Model, tea.Cmd) {
 			m.TrainerCursor--
 		}
 	case "down", "j":
-		if m.TrainerCursor < len(m.TrainerModules)-1 {
+		if m.TrainerCursor < m.trainerMenuRows()-1 {
 			m.TrainerCursor++
 		}
 	case "enter", " ":
+		if m.onTrainerReviewRow() {
+			m = m.startTrainerReview()
+			return m, nil
+		}
+		if m.TrainerCursor >= len(m.TrainerModules) {
+			return m, nil
+		}
+
 		// Select module and start lesson
 		module := m.TrainerModules[m.TrainerCursor]
 
 		if !m.TrainerStats.IsModuleUnlocked(module.ID) {
-			m.TrainerMessage = "🔒 Module locked! Complete previous boss first."
+			m.TrainerMessage = m.T("trainer.module_locked")
 			return m, nil
 		}
 
 		// Start lessons for the module
 		lessons := trainer.GetLessons(module.ID)
 		if len(lessons) == 0 {
-			m.TrainerMessage = "No lessons available for this module yet."
+			m.TrainerMessage = m.T("trainer.no_lessons")
 			return m, nil
 		}
 
@@ -3037,7 +4441,7 @@ func (m Model) handleTrainerMenuKeys(key string) (tea.Model, tea.Cmd) {
 				// Check if practice is complete
 				progress := m.TrainerStats.GetModuleProgress(module.ID)
 				if progress.IsPracticeComplete(module.ID) {
-					m.TrainerMessage = "🎉 Practice complete! All exercises mastered! Press [r] to reset."
+					m.TrainerMessage = m.T("trainer.practice_complete")
 					return m, nil
 				}
 
@@ -3046,7 +4450,7 @@ func (m Model) handleTrainerMenuKeys(key string) (tea.Model, tea.Cmd) {
 
 				// Check if we got an exercise (shouldn't fail if not complete, but safety check)
 				if m.TrainerGameState.CurrentExercise == nil {
-					m.TrainerMessage = "🎉 Practice complete! All exercises mastered! Press [r] to reset."
+					m.TrainerMessage = m.T("trainer.practice_complete")
 					return m, nil
 				}
 
@@ -3054,22 +4458,51 @@ func (m Model) handleTrainerMenuKeys(key string) (tea.Model, tea.Cmd) {
 				m.TrainerMessage = ""
 				m.Screen = ScreenTrainerPractice
 			} else {
-				m.TrainerMessage = "Complete all lessons first to unlock practice!"
+				m.TrainerMessage = m.T("trainer.practice_locked")
 			}
 		}
 	case "r":
-		// R key to reset practice progress for selected module
+		// R key asks before resetting practice progress for selected module
 		if m.TrainerCursor < len(m.TrainerModules) {
 			module := m.TrainerModules[m.TrainerCursor]
-			if m.TrainerStats.IsModuleUnlocked(module.ID) {
-				progress := m.TrainerStats.GetModuleProgress(module.ID)
-				progress.ResetModulePractice()
-				trainer.SaveStats(m.TrainerStats)
-				m.TrainerMessage = "🔄 Practice progress reset for " + module.Name + ". Try again!"
-			} else {
-				m.TrainerMessage = "🔒 Module locked. Complete previous boss first."
+			if !m.TrainerStats.IsModuleUnlocked(module.ID) {
+				m.TrainerMessage = m.T("trainer.practice_module_locked")
+				return m, nil
+			}
+			progress := m.TrainerStats.GetModuleProgress(module.ID)
+			if progress.PracticeAttempts == 0 && len(progress.ExerciseStats) == 0 {
+				m.TrainerMessage = m.Tf("trainer.no_practice_progress", module.Name)
+				return m, nil
 			}
+			m.TrainerMessage = ""
+			m.Screen = ScreenTrainerResetConfirm
+			m.Cursor = 1 // Default to Cancel so a stray Enter loses nothing
+		}
+	case "t":
+		// T key starts a Speed Run over every unlocked module
+		m = m.startTimedRun()
+	case "s":
+		// S key opens trainer settings
+		m.TrainerMessage = ""
+		m.Screen = ScreenTrainerSettings
+		m.Cursor = 0
+	case "e":
+		// E key exports progress to carry it to another machine
+		m = m.exportTrainerProgress()
+	case "I":
+		// Shift+I imports an export; plain i is the placement test
+		m = m.enterTrainerImport()
+	case "i":
+		// I key imports prior experience via the placement test
+		m.TrainerGameState = trainer.NewGameStateWithStats(m.TrainerStats)
+		m.TrainerGameState.StartPlacement()
+		if m.TrainerGameState.CurrentExercise == nil {
+			m.TrainerMessage = m.T("trainer.no_placement")
+			return m, nil
 		}
+		m.TrainerInput = ""
+		m.TrainerMessage = ""
+		m.Screen = ScreenTrainerLesson
 	case "b":
 		// B key for Boss fight (if ready)
 		if m.TrainerCursor < len(m.TrainerModules) {
@@ -3078,26 +4511,129 @@ func (m Model) handleTrainerMenuKeys(key string) (tea.Model, tea.Cmd) {
 				boss := trainer.GetBoss(module.ID)
 				if boss != nil {
 					m.TrainerGameState = trainer.NewGameStateWithStats(m.TrainerStats)
+					m.TrainerGameState.Clock = trainerClock
 					m.TrainerGameState.StartBoss(module.ID)
 					m.TrainerInput = ""
 					m.TrainerMessage = ""
 					m.Screen = ScreenTrainerBoss
 				} else {
-					m.TrainerMessage = "Boss not implemented yet!"
+					m.TrainerMessage = m.T("trainer.no_boss")
 				}
 			} else {
-				m.TrainerMessage = "Complete lessons + 80% practice accuracy to fight boss!"
+				m.TrainerMessage = m.T("trainer.boss_locked")
 			}
 		}
-	case "esc", "q":
-		// Save stats and go back to main menu
-		if m.TrainerStats != nil {
-			trainer.SaveStats(m.TrainerStats)
+	case "esc", "q":
+		// Save stats and go back to main menu
+		if m.TrainerStats != nil {
+			m.saveTrainerStats()
+		}
+		m.Screen = ScreenMainMenu
+		m.Cursor = 0
+	}
+
+	return m, nil
+}
+
+// handleTrainerResetConfirmKeys resets the selected module's practice progress
+// once the user confirms, or returns to the menu untouched
+func (m Model) handleTrainerResetConfirmKeys(key string) (tea.Model, tea.Cmd) {
+	switch key {
+	case "up", "k":
+		if m.Cursor > 0 {
+			m.Cursor--
+		}
+	case "down", "j":
+		if m.Cursor < len(m.canonicalOptions())-1 {
+			m.Cursor++
+		}
+	case "y":
+		m.Cursor = 0
+		return m.handleTrainerResetConfirmKeys("enter")
+	case "n", "backspace":
+		m.Screen = ScreenTrainerMenu
+		m.TrainerMessage = m.T("trainer.reset_cancelled")
+	case "enter", " ":
+		m.Screen = ScreenTrainerMenu
+		if m.Cursor != 0 {
+			m.TrainerMessage = m.T("trainer.reset_cancelled")
+			return m, nil
+		}
+		module := m.TrainerModules[m.TrainerCursor]
+		m.TrainerStats.GetModuleProgress(module.ID).ResetModulePractice()
+		m.saveTrainerStats()
+		m.TrainerMessage = m.Tf("trainer.practice_reset", module.Name)
+	}
+	return m, nil
+}
+
+// handleTrainerSettingsKeys handles the trainer settings menu
+func (m Model) handleTrainerSettingsKeys(key string) (tea.Model, tea.Cmd) {
+	options := m.canonicalOptions()
+	switch key {
+	case "up", "k":
+		if m.Cursor > 0 {
+			m.Cursor--
+		}
+		if strings.HasPrefix(options[m.Cursor], "───") && m.Cursor > 0 {
+			m.Cursor--
+		}
+	case "down", "j":
+		if m.Cursor < len(options)-1 {
+			m.Cursor++
+		}
+		if strings.HasPrefix(options[m.Cursor], "───") && m.Cursor < len(options)-1 {
+			m.Cursor++
+		}
+	case "backspace", "q":
+		m.Screen = ScreenTrainerMenu
+	case "enter", " ":
+		if strings.Contains(options[m.Cursor], "Reset all") {
+			m.Screen = ScreenTrainerResetAll
+			m.TrainerResetInput = ""
+			m.TrainerMessage = ""
+		} else {
+			m.Screen = ScreenTrainerMenu
+		}
+	}
+	return m, nil
+}
+
+// trainerResetWord must be typed to confirm a full progress reset
+const trainerResetWord = "reset"
+
+// handleTrainerResetAllKeys collects the typed confirmation for a full reset.
+// The old stats file is rotated to a .bak rather than deleted.
+func (m Model) handleTrainerResetAllKeys(key string) (tea.Model, tea.Cmd) {
+	switch key {
+	case "backspace":
+		if len(m.TrainerResetInput) > 0 {
+			m.TrainerResetInput = m.TrainerResetInput[:len(m.TrainerResetInput)-1]
+		}
+	case "enter":
+		if m.TrainerResetInput != trainerResetWord {
+			m.TrainerMessage = m.Tf("trainer.reset_type_word", trainerResetWord)
+			return m, nil
+		}
+		backup, err := trainer.BackupAndResetStats()
+		m.TrainerResetInput = ""
+		if err != nil {
+			m.TrainerMessage = m.Tf("trainer.reset_failed", err)
+			return m, nil
+		}
+		m.TrainerStats = trainer.NewUserStats()
+		m.TrainerCursor = 0
+		m.Screen = ScreenTrainerMenu
+		m.TrainerMessage = m.T("trainer.reset_all_done")
+		if backup != "" {
+			m.TrainerMessage += m.Tf("trainer.reset_all_backup", backup)
+		}
+	default:
+		if len(key) == 1 && len(m.TrainerResetInput) < 32 {
+			m.TrainerResetInput += key
+			m.TrainerMessage = ""
 		}
-		m.Screen = ScreenMainMenu
-		m.Cursor = 0
 	}
-
 	return m, nil
 }
 
@@ -3118,7 +4654,7 @@ func (m Model) handleTrainerExerciseKeys(key string) (tea.Model, tea.Cmd) {
 	case "esc":
 		// Exit to menu, save progress
 		if m.TrainerStats != nil {
-			trainer.SaveStats(m.TrainerStats)
+			m.saveTrainerStats()
 		}
 		m.Screen = ScreenTrainerMenu
 		m.TrainerMessage = ""
@@ -3139,34 +4675,38 @@ func (m Model) handleTrainerExerciseKeys(key string) (tea.Model, tea.Cmd) {
 
 		// Validate answer using detailed validation
 		validation := trainer.ValidateAnswerDetailed(exercise, m.TrainerInput)
+		if m.TrainerGameState.IsPlacementMode {
+			// Placement only credits known solutions: edit exercises leave the
+			// cursor in place, so the position check alone accepts anything
+			validation.IsCorrect = validation.IsInSolutions
+		}
 
 		if validation.IsCorrect {
-			// Record correct answer - time and optimal flag
-			// Using a fixed time of 10 seconds for now (can add actual timing later)
-			m.TrainerGameState.RecordCorrectAnswer(10.0, validation.IsOptimal)
+			// Record correct answer - seconds since the exercise was shown and optimal flag
+			m.TrainerGameState.RecordCorrectAnswer(m.TrainerGameState.ExerciseSeconds(), validation.IsOptimal)
 			m.TrainerLastCorrect = true
 
 			if validation.IsOptimal {
-				m.TrainerMessage = "✨ Perfect! Optimal solution!"
+				m.TrainerMessage = m.T("trainer.perfect")
 			} else if validation.IsInSolutions {
 				// Valid predefined solution but not optimal
-				m.TrainerMessage = "✓ Correct! But " + exercise.Optimal + " is more efficient."
+				m.TrainerMessage = m.Tf("trainer.correct_not_optimal", exercise.Optimal)
 			} else {
 				// Creative solution that works but not in predefined list
-				m.TrainerMessage = "✓ Correct! Creative solution! Optimal: " + exercise.Optimal
+				m.TrainerMessage = m.Tf("trainer.correct_creative", exercise.Optimal)
 			}
 		} else {
 			m.TrainerGameState.RecordIncorrectAnswer()
 			m.TrainerLastCorrect = false
 			// Show all valid solutions, not just optimal
-			m.TrainerMessage = "✗ Incorrect. Solutions: " + trainer.FormatSolutionsHint(exercise)
+			m.TrainerMessage = m.Tf("trainer.incorrect", trainer.FormatSolutionsHint(exercise))
 		}
 
 		// Record practice result for intelligent practice system
 		if m.TrainerGameState.IsPracticeMode && exercise.ID != "" {
 			progress := m.TrainerStats.GetModuleProgress(m.TrainerGameState.CurrentModule)
 			progress.RecordPracticeResult(exercise.ID, validation.IsCorrect)
-			trainer.SaveStats(m.TrainerStats)
+			m.saveTrainerStats()
 		}
 
 		m.Screen = ScreenTrainerResult
@@ -3174,43 +4714,49 @@ func (m Model) handleTrainerExerciseKeys(key string) (tea.Model, tea.Cmd) {
 
 	case "tab":
 		// Show hint
-		m.TrainerMessage = "💡 Hint: " + exercise.Hint
+		m.TrainerMessage = m.Tf("trainer.hint", exercise.Hint)
 		return m, nil
 
 	default:
-		// Add character to input (filter control keys)
-		// Accept single chars and specific ctrl combinations used in Vim
-		validCtrlKeys := map[string]bool{
-			"ctrl+a": true, "ctrl+e": true, "ctrl+w": true,
-			"ctrl+d": true, "ctrl+u": true, "ctrl+f": true, "ctrl+b": true,
-		}
-		if len(key) == 1 || validCtrlKeys[key] {
-			// Handle ctrl combinations - convert to control character
-			if strings.HasPrefix(key, "ctrl+") {
-				// Convert ctrl+X to actual control character for simulator
-				switch key {
-				case "ctrl+d":
-					m.TrainerInput += "\x04"
-				case "ctrl+u":
-					m.TrainerInput += "\x15"
-				case "ctrl+f":
-					m.TrainerInput += "\x06"
-				case "ctrl+b":
-					m.TrainerInput += "\x02"
-				default:
-					m.TrainerInput += key
-				}
-			} else if len(key) == 1 {
-				m.TrainerInput += key
-			}
-		} else if key == "space" {
-			m.TrainerInput += " "
-		}
+		m.TrainerInput = appendTrainerKey(m.TrainerInput, key)
 	}
 
 	return m, nil
 }
 
+// appendTrainerKey adds a typed key to a lesson, practice or Speed Run answer
+func appendTrainerKey(input, key string) string {
+	// Add character to input (filter control keys)
+	// Accept single chars and specific ctrl combinations used in Vim
+	validCtrlKeys := map[string]bool{
+		"ctrl+a": true, "ctrl+e": true, "ctrl+w": true,
+		"ctrl+d": true, "ctrl+u": true, "ctrl+f": true, "ctrl+b": true,
+	}
+	if len(key) == 1 || validCtrlKeys[key] {
+		// Handle ctrl combinations - convert to control character
+		if strings.HasPrefix(key, "ctrl+") {
+			// Convert ctrl+X to actual control character for simulator
+			switch key {
+			case "ctrl+d":
+				input += "\x04"
+			case "ctrl+u":
+				input += "\x15"
+			case "ctrl+f":
+				input += "\x06"
+			case "ctrl+b":
+				input += "\x02"
+			default:
+				input += key
+			}
+		} else if len(key) == 1 {
+			input += key
+		}
+	} else if key == "space" {
+		input += " "
+	}
+	return input
+}
+
 // handleTrainerBossKeys handles input during boss fights
 func (m Model) handleTrainerBossKeys(key string) (tea.Model, tea.Cmd) {
 	if m.TrainerGameState == nil || m.TrainerGameState.CurrentBoss == nil {
@@ -3222,10 +4768,10 @@ func (m Model) handleTrainerBossKeys(key string) (tea.Model, tea.Cmd) {
 	case "esc":
 		// Forfeit boss fight
 		if m.TrainerStats != nil {
-			trainer.SaveStats(m.TrainerStats)
+			m.saveTrainerStats()
 		}
 		m.Screen = ScreenTrainerMenu
-		m.TrainerMessage = "Boss fight abandoned!"
+		m.TrainerMessage = m.T("trainer.boss_abandoned")
 		return m, nil
 
 	case "backspace":
@@ -3245,7 +4791,7 @@ func (m Model) handleTrainerBossKeys(key string) (tea.Model, tea.Cmd) {
 			// Boss complete!
 			m.TrainerGameState.RecordBossVictory()
 			m.TrainerLastCorrect = true
-			m.TrainerMessage = "🏆 VICTORY! You defeated " + boss.Name + "!"
+			m.TrainerMessage = m.Tf("trainer.boss_victory", boss.Name)
 			m.Screen = ScreenTrainerBossResult
 			return m, nil
 		}
@@ -3263,18 +4809,19 @@ func (m Model) handleTrainerBossKeys(key string) (tea.Model, tea.Cmd) {
 				// Boss defeated!
 				m.TrainerGameState.RecordBossVictory()
 				m.TrainerLastCorrect = true
-				m.TrainerMessage = "🏆 VICTORY! You defeated " + boss.Name + "!"
+				m.TrainerMessage = m.Tf("trainer.boss_victory", boss.Name)
 				m.Screen = ScreenTrainerBossResult
 			} else {
 				if isOptimal {
-					m.TrainerMessage = "✨ Perfect! Next challenge..."
+					m.TrainerMessage = m.T("trainer.boss_perfect")
 				} else {
-					m.TrainerMessage = "✓ Good! (Optimal: " + step.Exercise.Optimal + ") Next..."
+					m.TrainerMessage = m.Tf("trainer.boss_good", step.Exercise.Optimal)
 				}
 			}
 		} else {
 			// Lose a life - SHOW THE CORRECT SOLUTION
 			m.TrainerGameState.BossLives--
+			m.TrainerStats.RecordMistake(step.Exercise.ID)
 			m.TrainerInput = ""
 
 			// Format the solution hint
@@ -3283,12 +4830,12 @@ func (m Model) handleTrainerBossKeys(key string) (tea.Model, tea.Cmd) {
 			if m.TrainerGameState.BossLives <= 0 {
 				// Game over - show final solution
 				m.TrainerLastCorrect = false
-				m.TrainerMessage = "💀 DEFEATED! Solution was: " + solutionHint
+				m.TrainerMessage = m.Tf("trainer.boss_defeated", solutionHint)
 				m.Screen = ScreenTrainerBossResult
 			} else {
 				// Still has lives - show solution and remaining lives
 				livesStr := strings.Repeat("❤️", m.TrainerGameState.BossLives)
-				m.TrainerMessage = "✗ Wrong! Was: " + solutionHint + " | Lives: " + livesStr
+				m.TrainerMessage = m.Tf("trainer.boss_wrong", solutionHint, livesStr)
 			}
 		}
 
@@ -3333,7 +4880,10 @@ func (m Model) handleTrainerResultKeys(key string) (tea.Model, tea.Cmd) {
 		}
 
 		var hasNext bool
-		if m.TrainerGameState.IsPracticeMode {
+		if m.TrainerGameState.IsReviewMode {
+			// Review goes round the exercises still on the list
+			hasNext = m.TrainerGameState.NextReviewExercise()
+		} else if m.TrainerGameState.IsPracticeMode {
 			// Use intelligent practice selection
 			hasNext = m.TrainerGameState.NextPracticeExercise()
 		} else {
@@ -3344,21 +4894,29 @@ func (m Model) handleTrainerResultKeys(key string) (tea.Model, tea.Cmd) {
 		if hasNext {
 			m.TrainerInput = ""
 			m.TrainerMessage = ""
-			if m.TrainerGameState.IsLessonMode {
+			if m.TrainerGameState.IsLessonMode || m.TrainerGameState.IsPlacementMode {
 				m.Screen = ScreenTrainerLesson
 			} else {
 				m.Screen = ScreenTrainerPractice
 			}
 		} else {
 			// Session complete
+			if m.TrainerGameState.IsPlacementMode {
+				m.TrainerMessage = m.finishPlacement()
+				m.Screen = ScreenTrainerMenu
+				return m, nil
+			}
 			if m.TrainerStats != nil {
-				trainer.SaveStats(m.TrainerStats)
+				m.saveTrainerStats()
 			}
 
-			if m.TrainerGameState.IsPracticeMode {
-				m.TrainerMessage = "🎉 All exercises mastered! You're a Vim master! 🏆"
+			if m.TrainerGameState.IsReviewMode {
+				m.TrainerMessage = m.T("trainer.review_cleared")
+				m.TrainerCursor = min(m.TrainerCursor, len(m.TrainerModules)-1)
+			} else if m.TrainerGameState.IsPracticeMode {
+				m.TrainerMessage = m.T("trainer.all_mastered")
 			} else {
-				m.TrainerMessage = "🎉 Lesson complete! Practice mode unlocked!"
+				m.TrainerMessage = m.T("trainer.lesson_complete")
 			}
 			m.Screen = ScreenTrainerMenu
 		}
@@ -3366,7 +4924,7 @@ func (m Model) handleTrainerResultKeys(key string) (tea.Model, tea.Cmd) {
 	case "esc", "q":
 		// Return to menu
 		if m.TrainerStats != nil {
-			trainer.SaveStats(m.TrainerStats)
+			m.saveTrainerStats()
 		}
 		m.Screen = ScreenTrainerMenu
 	}
@@ -3374,13 +4932,35 @@ func (m Model) handleTrainerResultKeys(key string) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// finishPlacement applies the placement score, saves stats and returns the summary
+func (m *Model) finishPlacement() string {
+	if m.TrainerStats == nil {
+		m.TrainerStats = trainer.NewUserStats()
+	}
+	correct := m.TrainerGameState.PlacementCorrect
+	total := len(m.TrainerGameState.Exercises)
+	target := m.TrainerStats.ApplyPlacement(correct, total, time.Now())
+	m.saveTrainerStats()
+
+	if target == "" {
+		return fmt.Sprintf("📍 Placement: %d/%d. Start from the first module!", correct, total)
+	}
+	name := string(target)
+	for _, module := range m.TrainerModules {
+		if module.ID == target {
+			name = module.Name
+		}
+	}
+	return fmt.Sprintf("📍 Placement: %d/%d. Unlocked through %s!", correct, total, name)
+}
+
 // handleTrainerBossResultKeys handles the result screen after a boss fight
 func (m Model) handleTrainerBossResultKeys(key string) (tea.Model, tea.Cmd) {
 	switch key {
 	case "enter", " ", "esc", "q":
 		// Return to menu
 		if m.TrainerStats != nil {
-			trainer.SaveStats(m.TrainerStats)
+			m.saveTrainerStats()
 		}
 		m.Screen = ScreenTrainerMenu
 		m.TrainerMessage = ""
@@ -3454,7 +5034,7 @@ func contractHome(path string) string {
 	if path == home {
 		return "~"
 	}
-	if strings.HasPrefix(path, home+"/") {
+	if strings.HasPrefix(path, home+string(filepath.Separator)) {
 		return "~" + path[len(home):]
 	}
 	return path
@@ -3467,6 +5047,10 @@ func (m Model) handleProjectPathKeys(key string) (tea.Model, tea.Cmd) {
 		return m.handlePathCompletionKeys(key)
 	case PathModeBrowser:
 		return m.handlePathBrowserKeys(key)
+	case PathModeRecent:
+		return m.handlePathRecentKeys(key)
+	case PathModeNewDir:
+		return m.handlePathNewDirKeys(key)
 	default:
 		return m.handlePathTypingKeys(key)
 	}
@@ -3474,6 +5058,83 @@ func (m Model) handleProjectPathKeys(key string) (tea.Model, tea.Cmd) {
 
 // handlePathTypingKeys handles keys in the normal typing mode
 func (m Model) handlePathTypingKeys(key string) (tea.Model, tea.Cmd) {
+	switch key {
+	case "tab":
+		return m.triggerTabCompletion()
+
+	case "ctrl+b":
+		return m.openFileBrowser()
+
+	case "ctrl+n":
+		return m.createTypedPath()
+
+	case "ctrl+r":
+		if len(m.ProjectRecent) == 0 {
+			m.ProjectPathError = "No recent projects yet"
+			return m, nil
+		}
+		return m.openRecentProjects(false), nil
+
+	case "up", "down":
+		// The history is one arrow away while the input is empty
+		if m.ProjectPathInput == "" {
+			return m.openRecentProjects(key == "up"), nil
+		}
+
+	case "enter":
+		// Validate path
+		path := expandPath(m.ProjectPathInput)
+		if path == "" {
+			m.ProjectPathError = m.T("project_path.empty")
+			return m, nil
+		}
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			m.ProjectPathError = m.Tf("project_path.invalid", err)
+			return m, nil
+		}
+		info, err := os.Stat(absPath)
+		if err != nil {
+			m.ProjectPathError = m.Tf("project_path.not_found", absPath)
+			// ctrl+n creates the typed path
+			if errors.Is(err, fs.ErrNotExist) {
+				m.ProjectPathError += m.T("project_path.not_found_hint")
+			}
+			return m, nil
+		}
+		if !info.IsDir() {
+			m.ProjectPathError = m.Tf("project_path.not_dir", absPath)
+			return m, nil
+		}
+		// Valid path - store and advance
+		m.ProjectPathInput = absPath
+		m.ProjectPathError = ""
+		m.ProjectDetectedStacks = detectStacks(absPath)
+		m.ProjectStack = detectStack(absPath)
+		m.ProjectBatch = nil
+		m = m.enterProjectStack()
+		// A parent of several projects (not a project itself) offers batch mode
+		if m.ProjectStack == "unknown" {
+			if subs := discoverSubProjects(absPath); len(subs) > 0 {
+				m.ProjectBatch = subs
+				m.Screen = ScreenProjectBatchSelect
+			}
+		}
+		// "Same settings, new path" skips straight to the summary
+		if m.ProjectReuseSettings && m.ProjectBatch == nil {
+			m = m.enterProjectConfirm()
+		}
+		return m.rememberProjectPath(absPath)
+
+	default:
+		m = m.editPathInput(key)
+	}
+	return m, nil
+}
+
+// editPathInput applies a line-editing key to the path input: cursor moves,
+// deletions and printable characters. Other keys leave it unchanged.
+func (m Model) editPathInput(key string) Model {
 	runes := []rune(m.ProjectPathInput)
 
 	switch key {
@@ -3532,40 +5193,6 @@ func (m Model) handlePathTypingKeys(key string) (tea.Model, tea.Cmd) {
 		}
 		m.ProjectPathError = ""
 
-	case "tab":
-		return m.triggerTabCompletion()
-
-	case "ctrl+b":
-		return m.openFileBrowser()
-
-	case "enter":
-		// Validate path
-		path := expandPath(m.ProjectPathInput)
-		if path == "" {
-			m.ProjectPathError = "Path cannot be empty"
-			return m, nil
-		}
-		absPath, err := filepath.Abs(path)
-		if err != nil {
-			m.ProjectPathError = "Invalid path: " + err.Error()
-			return m, nil
-		}
-		info, err := os.Stat(absPath)
-		if err != nil {
-			m.ProjectPathError = "Directory not found: " + absPath
-			return m, nil
-		}
-		if !info.IsDir() {
-			m.ProjectPathError = "Path is not a directory: " + absPath
-			return m, nil
-		}
-		// Valid path - store and advance
-		m.ProjectPathInput = absPath
-		m.ProjectPathError = ""
-		m.ProjectStack = detectStack(absPath)
-		m.Screen = ScreenProjectStack
-		m.Cursor = 0
-
 	case " ":
 		// Insert space at cursor
 		runes = append(runes[:m.ProjectPathCursor], append([]rune{' '}, runes[m.ProjectPathCursor:]...)...)
@@ -3583,7 +5210,7 @@ func (m Model) handlePathTypingKeys(key string) (tea.Model, tea.Cmd) {
 			m.ProjectPathError = ""
 		}
 	}
-	return m, nil
+	return m
 }
 
 // triggerTabCompletion triggers tab-completion for the current input
@@ -3734,107 +5361,42 @@ func (m Model) handlePathBrowserKeys(key string) (tea.Model, tea.Cmd) {
 	case ".":
 		// Toggle hidden files
 		m.FileBrowserShowHidden = !m.FileBrowserShowHidden
+		m.Settings.ShowHidden = m.FileBrowserShowHidden
 		m.FileBrowserEntries = listDirectories(m.FileBrowserRoot, "", m.FileBrowserShowHidden)
 		m.FileBrowserCursor = 0
 		m.FileBrowserScroll = 0
+	case "n":
+		// Prompt for a directory to create here
+		return m.openNewDirPrompt(), nil
 	}
 
-	// Update scroll to keep cursor visible
 	if m.ProjectPathMode == PathModeBrowser {
-		visibleLines := m.Height - 12
-		if visibleLines < 3 {
-			visibleLines = 3
-		}
-		if m.FileBrowserCursor < m.FileBrowserScroll {
-			m.FileBrowserScroll = m.FileBrowserCursor
-		}
-		if m.FileBrowserCursor >= m.FileBrowserScroll+visibleLines {
-			m.FileBrowserScroll = m.FileBrowserCursor - visibleLines + 1
-		}
+		m = m.scrollBrowserToCursor()
 	}
 
 	return m, nil
 }
 
-// isSkillGroupHeader returns true if the option text is a group header or separator
-func isSkillGroupHeader(opt string) bool {
-	return strings.HasPrefix(opt, "📦") || strings.HasPrefix(opt, "🌐") ||
-		strings.HasPrefix(opt, "🏠") || strings.HasPrefix(opt, "📁") ||
-		strings.HasPrefix(opt, "───") || strings.HasPrefix(opt, "✅ Select All")
-}
-
-// isSkillItem returns true if the option is an actual skill (not header, separator, etc.)
-func isSkillItem(opt string) bool {
-	return !isSkillGroupHeader(opt) && !strings.HasPrefix(opt, "───") &&
-		!strings.Contains(opt, "Confirm") && !strings.Contains(opt, "← Back") &&
-		!strings.HasPrefix(opt, "✅ All skills") && !strings.HasPrefix(opt, "No skills")
-}
-
-// skillOptionToIndex maps a cursor position in the options list to an index into SkillSelected.
-// Returns -1 if the cursor is on a non-skill item (header, separator, Select All, Confirm, Back).
-func skillOptionToIndex(options []string, cursor int) int {
-	if cursor < 0 || cursor >= len(options) {
-		return -1
-	}
-	if !isSkillItem(options[cursor]) {
-		return -1
-	}
-	// Count actual skill items before this cursor position
-	idx := 0
-	for i := 0; i < cursor; i++ {
-		if isSkillItem(options[i]) {
-			idx++
-		}
-	}
-	return idx
-}
-
-// skillGroupRange returns the range of SkillSelected indices for a category header at the given cursor.
-// Returns (start, end) where end is exclusive. Returns (-1, -1) if cursor is not on a category header.
-func skillGroupRange(options []string, cursor int) (int, int) {
-	if cursor < 0 || cursor >= len(options) {
-		return -1, -1
+// scrollBrowserToCursor updates the browser scroll to keep the cursor visible
+func (m Model) scrollBrowserToCursor() Model {
+	visibleLines := m.visibleRows(12)
+	if m.FileBrowserCursor < m.FileBrowserScroll {
+		m.FileBrowserScroll = m.FileBrowserCursor
 	}
-	opt := options[cursor]
-	// Must be a category header icon (📦, 🌐, 🏠, 📁) but NOT Select All or separator
-	if !strings.HasPrefix(opt, "📦") && !strings.HasPrefix(opt, "🌐") &&
-		!strings.HasPrefix(opt, "🏠") && !strings.HasPrefix(opt, "📁") {
-		return -1, -1
+	if m.FileBrowserCursor >= m.FileBrowserScroll+visibleLines {
+		m.FileBrowserScroll = m.FileBrowserCursor - visibleLines + 1
 	}
-
-	// Count skill items BEFORE this header → that's start index
-	start := 0
-	for i := 0; i < cursor; i++ {
-		if isSkillItem(options[i]) {
-			start++
-		}
-	}
-
-	// Count skill items AFTER this header until next header/separator/end
-	end := start
-	for i := cursor + 1; i < len(options); i++ {
-		o := options[i]
-		if strings.HasPrefix(o, "📦") || strings.HasPrefix(o, "🌐") ||
-			strings.HasPrefix(o, "🏠") || strings.HasPrefix(o, "📁") ||
-			strings.HasPrefix(o, "───") {
-			break
-		}
-		if isSkillItem(o) {
-			end++
-		}
-	}
-
-	if end == start {
-		return -1, -1
-	}
-	return start, end
+	return m
 }
 
-// skillGroupCheck returns a checkbox string for a group range: [✓] all, [ ] none, [-] partial
-func skillGroupCheck(selected []bool, start, end int) string {
+// skillGroupCheck returns a checkbox string for a group: [✓] all, [ ] none, [-] partial
+func skillGroupCheck(selected []bool, group []int) string {
 	allOn := true
 	anyOn := false
-	for i := start; i < end && i < len(selected); i++ {
+	for _, i := range group {
+		if i >= len(selected) {
+			continue
+		}
 		if selected[i] {
 			anyOn = true
 		} else {
@@ -3850,88 +5412,217 @@ func skillGroupCheck(selected []bool, start, end int) string {
 	return "[ ]"
 }
 
+// toggleSkillGroup selects every skill in group, or clears them all if they already are
+func (m *Model) toggleSkillGroup(group []int) {
+	allOn := true
+	for _, i := range group {
+		if i < len(m.SkillSelected) && !m.SkillSelected[i] {
+			allOn = false
+			break
+		}
+	}
+	for _, i := range group {
+		if i < len(m.SkillSelected) {
+			m.SkillSelected[i] = !allOn
+		}
+	}
+}
+
+// handleSkillFilterKeys edits the filter query typed after "/"; the list narrows
+// with every keystroke. Enter keeps the filter and returns to navigation.
+func (m Model) handleSkillFilterKeys(key string) (tea.Model, tea.Cmd) {
+	switch key {
+	case "enter":
+		m.SkillFilterActive = false
+		return m, nil
+	case "backspace":
+		r := []rune(m.SkillFilter)
+		if len(r) == 0 {
+			return m, nil
+		}
+		m.SkillFilter = string(r[:len(r)-1])
+	default:
+		if utf8.RuneCountInString(key) != 1 || utf8.RuneCountInString(m.SkillFilter) >= 40 {
+			return m, nil
+		}
+		m.SkillFilter += key
+	}
+	m.Cursor = 0
+	m.SkillScroll = 0
+	return m, nil
+}
+
 // handleSkillBrowseKeys handles the skill browse screen (read-only scroll with viewport)
 func (m Model) handleSkillBrowseKeys(key string) (tea.Model, tea.Cmd) {
-	options := m.GetCurrentOptions()
+	rows := m.skillRows()
 	switch key {
 	case "up", "k":
 		if m.Cursor > 0 {
 			m.Cursor--
 			// Skip separator lines
-			if m.Cursor < len(options) && strings.HasPrefix(options[m.Cursor], "───") {
-				if m.Cursor > 0 {
-					m.Cursor--
-				}
+			if rowAt(rows, m.Cursor).Kind == RowSeparator && m.Cursor > 0 {
+				m.Cursor--
 			}
 		}
 	case "down", "j":
-		if m.Cursor < len(options)-1 {
+		if m.Cursor < len(rows)-1 {
 			m.Cursor++
-			if m.Cursor < len(options) && strings.HasPrefix(options[m.Cursor], "───") {
-				if m.Cursor < len(options)-1 {
-					m.Cursor++
-				}
+			if rowAt(rows, m.Cursor).Kind == RowSeparator && m.Cursor < len(rows)-1 {
+				m.Cursor++
 			}
 		}
 	case "enter":
-		if m.Cursor < len(options) && strings.Contains(options[m.Cursor], "← Back") {
+		if rowAt(rows, m.Cursor).Kind == RowBack {
 			m.Screen = ScreenSkillMenu
 			m.Cursor = 0
 			m.SkillScroll = 0
+			m.SkillTagFilter = ""
+			return m, nil
+		}
+		skills := m.browseSkills()
+		if idx := skillRowIndex(rows, m.Cursor); idx >= 0 && idx < len(skills) {
+			skill := skills[idx]
+			m.SkillDetail = &skill
+			m.SkillDoc = parseSkillDocument(skillDocumentPath(skill))
+			m.SkillTree = skillTreeLines(skill.FullPath, skillTreeMaxDepth, skillTreeMaxEntries)
+			m.SkillDetailPos = 0
+			m.Screen = ScreenSkillDetail
+			return m, nil
+		}
+	case "w":
+		if len(m.SkillCatalogWarnings) > 0 {
+			m.SkillWarningScroll = 0
+			m.Screen = ScreenSkillWarnings
+			return m, nil
 		}
+	case "t":
+		if m.SkillLoading || len(skillCatalogTags(m.SkillCatalog)) == 0 {
+			break
+		}
+		m.SkillTagFilter = nextSkillTag(m.SkillCatalog, m.SkillTagFilter)
+		m.Cursor = 0
+		m.SkillScroll = 0
+		return m, nil
 	}
 
 	// Keep scroll in sync with cursor
-	m.updateSkillScroll(len(options))
+	m.updateSkillScroll(len(rows))
+
+	return m, nil
+}
+
+// handleSkillDetailKeys scrolls the skill detail view; Enter returns to Browse
+func (m Model) handleSkillDetailKeys(key string) (tea.Model, tea.Cmd) {
+	if m.SkillDetail == nil {
+		return m.leaveSkillDetail(), nil
+	}
+	maxScroll := len(m.skillDetailContent()) - m.skillDetailViewHeight()
+	if maxScroll < 0 {
+		maxScroll = 0
+	}
+
+	switch key {
+	case "up", "k":
+		if m.SkillDetailPos > 0 {
+			m.SkillDetailPos--
+		}
+	case "down", "j":
+		if m.SkillDetailPos < maxScroll {
+			m.SkillDetailPos++
+		}
+	case "pgup":
+		m.SkillDetailPos -= 10
+		if m.SkillDetailPos < 0 {
+			m.SkillDetailPos = 0
+		}
+	case "pgdown":
+		m.SkillDetailPos += 10
+		if m.SkillDetailPos > maxScroll {
+			m.SkillDetailPos = maxScroll
+		}
+	case "enter", "q":
+		return m.leaveSkillDetail(), nil
+	}
+	return m, nil
+}
+
+// leaveSkillDetail returns to Browse; its cursor and scroll were left untouched
+func (m Model) leaveSkillDetail() Model {
+	m.Screen = ScreenSkillBrowse
+	m.SkillDetail = nil
+	m.SkillDoc = skillDocument{}
+	m.SkillTree = nil
+	m.SkillDetailPos = 0
+	return m
+}
 
+// handleSkillStatsKeys scrolls the read-only stats view
+func (m Model) handleSkillStatsKeys(key string) (tea.Model, tea.Cmd) {
+	if m.SkillLoading || m.SkillStats == nil {
+		return m, nil
+	}
+	maxScroll := len(skillStatsLines(*m.SkillStats)) - m.skillStatsVisibleLines()
+	switch key {
+	case "up", "k":
+		if m.SkillScroll > 0 {
+			m.SkillScroll--
+		}
+	case "down", "j":
+		if m.SkillScroll < maxScroll {
+			m.SkillScroll++
+		}
+	case "enter":
+		m.Screen = ScreenSkillMenu
+		m.Cursor = 4
+		m.SkillScroll = 0
+	}
 	return m, nil
 }
 
 // handleSkillInstallKeys handles multi-select for skill installation
 func (m Model) handleSkillInstallKeys(key string) (tea.Model, tea.Cmd) {
-	options := m.GetCurrentOptions()
+	if m.SkillFilterActive {
+		return m.handleSkillFilterKeys(key)
+	}
+	rows := m.skillRows()
 	notInstalled := m.getNotInstalledSkills()
 
 	switch key {
+	case "/":
+		m.SkillFilterActive = true
+		return m, nil
 	case "up", "k":
 		if m.Cursor > 0 {
 			m.Cursor--
-			if m.Cursor < len(options) && strings.HasPrefix(options[m.Cursor], "───") {
-				if m.Cursor > 0 {
-					m.Cursor--
-				}
+			if rowAt(rows, m.Cursor).Kind == RowSeparator && m.Cursor > 0 {
+				m.Cursor--
 			}
 		}
 	case "down", "j":
-		if m.Cursor < len(options)-1 {
+		if m.Cursor < len(rows)-1 {
 			m.Cursor++
-			if m.Cursor < len(options) && strings.HasPrefix(options[m.Cursor], "───") {
-				if m.Cursor < len(options)-1 {
-					m.Cursor++
-				}
+			if rowAt(rows, m.Cursor).Kind == RowSeparator && m.Cursor < len(rows)-1 {
+				m.Cursor++
 			}
 		}
 	case "enter", " ":
-		if m.Cursor < len(options) {
-			opt := options[m.Cursor]
-			if strings.Contains(opt, "← Back") {
+		if m.Cursor < len(rows) {
+			row := rows[m.Cursor]
+			onItem := row.Kind != RowBack && row.Kind != RowConfirm
+			if !m.multiSelectRuns(key, onItem, skillConfirmIndex(rows)) {
+				break
+			}
+			switch row.Kind {
+			case RowBack:
+				m.SkillFilter = ""
 				m.Screen = ScreenSkillMenu
 				m.Cursor = 0
 				m.SkillScroll = 0
 				return m, nil
-			} else if strings.HasPrefix(opt, "✅ Select All") {
-				// Toggle all
-				allSelected := true
-				for _, sel := range m.SkillSelected {
-					if !sel {
-						allSelected = false
-						break
-					}
-				}
-				for i := range m.SkillSelected {
-					m.SkillSelected[i] = !allSelected
-				}
-			} else if strings.Contains(opt, "Confirm") {
+			case RowSelectAll:
+				// Toggle every skill the filter shows
+				m.toggleSkillGroup(m.visibleSkillIndexes(notInstalled))
+			case RowConfirm:
 				// Collect selected skills
 				var selected []SkillInfo
 				for i, sel := range m.SkillSelected {
@@ -3942,26 +5633,13 @@ func (m Model) handleSkillInstallKeys(key string) (tea.Model, tea.Cmd) {
 				if len(selected) == 0 {
 					return m, nil // No-op if nothing selected
 				}
-				m.ErrorMsg = ""
-				m.SkillResultLog = []string{}
-				m.Screen = ScreenSkillResult
-				return m, installSkillActionCmd(selected)
-			} else if start, end := skillGroupRange(options, m.Cursor); start >= 0 {
+				return m.enterSkillTargets(ScreenSkillInstall, selected), nil
+			case RowHeader:
 				// Toggle entire category
-				allOn := true
-				for i := start; i < end && i < len(m.SkillSelected); i++ {
-					if !m.SkillSelected[i] {
-						allOn = false
-						break
-					}
-				}
-				for i := start; i < end && i < len(m.SkillSelected); i++ {
-					m.SkillSelected[i] = !allOn
-				}
-			} else {
+				m.toggleSkillGroup(skillGroupIndexes(rows, m.Cursor))
+			case RowSkill:
 				// Toggle individual skill
-				idx := skillOptionToIndex(options, m.Cursor)
-				if idx >= 0 && idx < len(m.SkillSelected) {
+				if idx := row.SkillIdx; idx < len(m.SkillSelected) {
 					m.SkillSelected[idx] = !m.SkillSelected[idx]
 				}
 			}
@@ -3969,56 +5647,55 @@ func (m Model) handleSkillInstallKeys(key string) (tea.Model, tea.Cmd) {
 	}
 
 	// Keep scroll in sync with cursor
-	m.updateSkillScroll(len(options))
+	m.updateSkillScroll(len(rows))
 
 	return m, nil
 }
 
 // handleSkillRemoveKeys handles multi-select for skill removal
 func (m Model) handleSkillRemoveKeys(key string) (tea.Model, tea.Cmd) {
-	options := m.GetCurrentOptions()
+	if m.SkillFilterActive {
+		return m.handleSkillFilterKeys(key)
+	}
+	rows := m.skillRows()
 	installed := m.getInstalledSkills()
 
 	switch key {
+	case "/":
+		m.SkillFilterActive = true
+		return m, nil
 	case "up", "k":
 		if m.Cursor > 0 {
 			m.Cursor--
-			if m.Cursor < len(options) && strings.HasPrefix(options[m.Cursor], "───") {
-				if m.Cursor > 0 {
-					m.Cursor--
-				}
+			if rowAt(rows, m.Cursor).Kind == RowSeparator && m.Cursor > 0 {
+				m.Cursor--
 			}
 		}
 	case "down", "j":
-		if m.Cursor < len(options)-1 {
+		if m.Cursor < len(rows)-1 {
 			m.Cursor++
-			if m.Cursor < len(options) && strings.HasPrefix(options[m.Cursor], "───") {
-				if m.Cursor < len(options)-1 {
-					m.Cursor++
-				}
+			if rowAt(rows, m.Cursor).Kind == RowSeparator && m.Cursor < len(rows)-1 {
+				m.Cursor++
 			}
 		}
 	case "enter", " ":
-		if m.Cursor < len(options) {
-			opt := options[m.Cursor]
-			if strings.Contains(opt, "← Back") {
+		if m.Cursor < len(rows) {
+			row := rows[m.Cursor]
+			onItem := row.Kind != RowBack && row.Kind != RowConfirm
+			if !m.multiSelectRuns(key, onItem, skillConfirmIndex(rows)) {
+				break
+			}
+			switch row.Kind {
+			case RowBack:
+				m.SkillFilter = ""
 				m.Screen = ScreenSkillMenu
 				m.Cursor = 0
 				m.SkillScroll = 0
 				return m, nil
-			} else if strings.HasPrefix(opt, "✅ Select All") {
-				// Toggle all
-				allSelected := true
-				for _, sel := range m.SkillSelected {
-					if !sel {
-						allSelected = false
-						break
-					}
-				}
-				for i := range m.SkillSelected {
-					m.SkillSelected[i] = !allSelected
-				}
-			} else if strings.Contains(opt, "Confirm") {
+			case RowSelectAll:
+				// Toggle every skill the filter shows
+				m.toggleSkillGroup(m.visibleSkillIndexes(installed))
+			case RowConfirm:
 				// Collect selected skills
 				var selected []SkillInfo
 				for i, sel := range m.SkillSelected {
@@ -4029,26 +5706,13 @@ func (m Model) handleSkillRemoveKeys(key string) (tea.Model, tea.Cmd) {
 				if len(selected) == 0 {
 					return m, nil // No-op if nothing selected
 				}
-				m.ErrorMsg = ""
-				m.SkillResultLog = []string{}
-				m.Screen = ScreenSkillResult
-				return m, removeSkillActionCmd(selected)
-			} else if start, end := skillGroupRange(options, m.Cursor); start >= 0 {
+				return m.enterSkillTargets(ScreenSkillRemove, selected), nil
+			case RowHeader:
 				// Toggle entire category
-				allOn := true
-				for i := start; i < end && i < len(m.SkillSelected); i++ {
-					if !m.SkillSelected[i] {
-						allOn = false
-						break
-					}
-				}
-				for i := start; i < end && i < len(m.SkillSelected); i++ {
-					m.SkillSelected[i] = !allOn
-				}
-			} else {
+				m.toggleSkillGroup(skillGroupIndexes(rows, m.Cursor))
+			case RowSkill:
 				// Toggle individual skill
-				idx := skillOptionToIndex(options, m.Cursor)
-				if idx >= 0 && idx < len(m.SkillSelected) {
+				if idx := row.SkillIdx; idx < len(m.SkillSelected) {
 					m.SkillSelected[idx] = !m.SkillSelected[idx]
 				}
 			}
@@ -4056,17 +5720,14 @@ func (m Model) handleSkillRemoveKeys(key string) (tea.Model, tea.Cmd) {
 	}
 
 	// Keep scroll in sync with cursor
-	m.updateSkillScroll(len(options))
+	m.updateSkillScroll(len(rows))
 
 	return m, nil
 }
 
 // updateSkillScroll keeps SkillScroll in sync with cursor (viewport follows cursor)
 func (m *Model) updateSkillScroll(totalItems int) {
-	visibleItems := m.Height - 8
-	if visibleItems < 5 {
-		visibleItems = 5
-	}
+	visibleItems := m.visibleRows(8)
 	if m.Cursor < m.SkillScroll {
 		m.SkillScroll = m.Cursor
 	}
@@ -0,0 +1,474 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// existingInstall describes what an earlier install left on this machine.
+// detectExistingInstall returns nil when there is nothing to update.
+type existingInstall struct {
+	Manifest   *installManifest // Last install's manifest; nil if none was written
+	CloneDir   string           // Dotfiles checkout to pull; "" when the update has to clone
+	Configs    int              // Files in the deploy record
+	SkillLinks int              // Skill links pointing into the catalog
+	Skills     bool             // Skill catalog present
+	Nvim       bool             // Neovim config deployed
+}
+
+// existingInstallMsg reports the install found at startup (nil if none)
+type existingInstallMsg struct {
+	install *existingInstall
+}
+
+// updateCloneDir is where the update flow keeps its dotfiles checkout, so
+// later updates only pull the new commits
+func updateCloneDir(home string) string {
+	return filepath.Join(paths.DataDir(home), "dotfiles")
+}
+
+// isGitCheckout reports whether dir is a git working tree
+func isGitCheckout(dir string) bool {
+	_, err := os.Stat(filepath.Join(dir, ".git"))
+	return err == nil
+}
+
+// detectExistingInstall looks for an earlier install under home: the install
+// manifest, deployed config files, skill links into the catalog, or a dotfiles
+// checkout (the update clone, or repoDir when the install kept it)
+func detectExistingInstall(home, repoDir string) *existingInstall {
+	found := &existingInstall{}
+	found.Manifest, _ = loadInstallManifest(home)
+	for _, dir := range []string{updateCloneDir(home), repoDir} {
+		if dir != "" && isGitCheckout(dir) {
+			found.CloneDir = dir
+			break
+		}
+	}
+	if rec, err := system.LoadDeployRecord(system.DeployRecordPath(home)); err == nil && rec != nil {
+		found.Configs = len(rec.Files)
+	}
+	found.SkillLinks = len(installerSkillLinks(home))
+	found.Skills = dirExists(paths.SkillsDir(home))
+	found.Nvim = dirExists(filepath.Join(home, ".config", "nvim"))
+
+	if found.Manifest == nil && found.CloneDir == "" && found.Configs == 0 && found.SkillLinks == 0 {
+		return nil
+	}
+	return found
+}
+
+func detectExistingInstallCmd(repoDir string) tea.Cmd {
+	return func() tea.Msg {
+		return existingInstallMsg{install: detectExistingInstall(os.Getenv("HOME"), repoDir)}
+	}
+}
+
+// updateSummary is what an update changed, shown on the complete screen.
+// Each step fills in its own part; see merge.
+type updateSummary struct {
+	CommitsPulled int      // -1 when the count is unknown
+	FilesSynced   []string // Deployed files replaced with the new version
+	FilesKept     []string // Changed upstream but edited locally, so left alone
+	SkillsUpdated bool
+	PluginsSynced bool
+	Status        []string // How each clone in the install manifest compares with origin's HEAD
+}
+
+// merge folds the part of the summary one step filled in into s
+func (s *updateSummary) merge(part updateSummary) {
+	if part.CommitsPulled != 0 {
+		s.CommitsPulled = part.CommitsPulled
+	}
+	s.FilesSynced = append(s.FilesSynced, part.FilesSynced...)
+	s.FilesKept = append(s.FilesKept, part.FilesKept...)
+	s.SkillsUpdated = s.SkillsUpdated || part.SkillsUpdated
+	s.PluginsSynced = s.PluginsSynced || part.PluginsSynced
+	s.Status = append(s.Status, part.Status...)
+}
+
+// SetupUpdateSteps prepares the update-only plan for an existing install: pull
+// the dotfiles, then refresh only what the install put in place. Terminal,
+// shell and font steps are never part of it.
+func (m *Model) SetupUpdateSteps() {
+	found := m.ExistingInstall
+	if found == nil {
+		found = &existingInstall{}
+	}
+	m.Steps = []InstallStep{{
+		ID:          "update-pull",
+		Name:        "Pull Dotfiles",
+		Description: "Fetch the latest Javi.Dots commits",
+		Status:      StatusPending,
+	}}
+	if found.Configs > 0 {
+		m.Steps = append(m.Steps, InstallStep{
+			ID:          "update-configs",
+			Name:        "Re-sync Configs",
+			Description: "Update deployed configs you haven't edited",
+			Status:      StatusPending,
+			DependsOn:   []string{"update-pull"},
+		})
+	}
+	if found.Skills {
+		m.Steps = append(m.Steps, InstallStep{
+			ID:          "update-skills",
+			Name:        "Update Skill Catalog",
+			Description: "Pull the latest skills",
+			Status:      StatusPending,
+		})
+	}
+	if found.Nvim {
+		step := InstallStep{
+			ID:          "nvim-sync",
+			Name:        "Update Neovim Plugins",
+			Description: "Lazy sync, headless",
+			Status:      StatusPending,
+			DependsOn:   []string{"update-pull"},
+		}
+		if found.Configs > 0 {
+			step.DependsOn = []string{"update-configs"}
+		}
+		m.Steps = append(m.Steps, step)
+	}
+}
+
+// startUpdate runs the update-only plan on the install found at startup
+func (m Model) startUpdate() (tea.Model, tea.Cmd) {
+	home := os.Getenv("HOME")
+	m.UpdateMode = true
+	m.UpdateSummary = updateSummary{}
+	// Keep the refs the last run was pinned to, unless --ref or
+	// --skills-ref picked others for this run
+	m.InstallManifest, _ = loadInstallManifest(home)
+	if m.InstallManifest != nil {
+		if m.Choices.RepoRef == "" {
+			m.Choices.RepoRef = m.InstallManifest.Repos[manifestDotfiles].Ref
+		}
+		if m.Choices.SkillsRef == "" {
+			m.Choices.SkillsRef = m.InstallManifest.Repos[manifestSkills].Ref
+		}
+	}
+	m.RepoDir = updateCloneDir(home)
+	if m.ExistingInstall != nil && m.ExistingInstall.CloneDir != "" {
+		m.RepoDir = m.ExistingInstall.CloneDir
+	}
+	m.SetupUpdateSteps()
+	m.trackProgress = false
+	m.LogLines.Reset()
+	m.Screen = ScreenInstalling
+	m.CurrentStep = 0
+	return m, func() tea.Msg { return installStartMsg{} }
+}
+
+// stepPullDotfiles brings the dotfiles checkout up to date, cloning it into
+// the data dir the first time, and counts the commits it brought in
+func stepPullDotfiles(m *Model) error {
+	stepID := "update-pull"
+	var from string
+	if isGitCheckout(m.RepoDir) {
+		if before, err := readRepoCommit(execRunner, m.RepoDir); err == nil {
+			from = before.Hash
+		}
+		if m.Choices.RepoRef != "" {
+			if err := fetchPinned(m.installContext(), m.RepoDir, m.Choices.RepoRef, func(line string) { SendLog(stepID, line) }); err != nil {
+				return wrapStepError("update-pull", "Pull Dotfiles",
+					"Failed to check out "+m.Choices.RepoRef+" in "+m.RepoDir+". Local changes can block it; commit or discard them and retry.",
+					err)
+			}
+		} else {
+			// Fetch first so the log shows how far behind the install is before pulling
+			SendLog(stepID, "Fetching "+m.RepoDir+"...")
+			opts := &system.ExecOptions{IdleTimeout: system.EnvCloneTimeout(), SplitCR: true}
+			fetch := system.RunWithLogs(m.installContext(), "git -C "+m.RepoDir+" fetch --progress origin", opts, func(line string) {
+				SendLog(stepID, line)
+			})
+			installed := m.InstallManifest.dotfilesCommit()
+			if installed.Hash == "" {
+				installed = RepoCommit{Hash: from}
+			}
+			if fetch.Error == nil {
+				if line := pendingUpdateLine(execRunner, m.RepoDir, installed); line != "" {
+					SendLog(stepID, line)
+				}
+			}
+			SendLog(stepID, "Pulling "+m.RepoDir+"...")
+			result := system.RunWithLogs(m.installContext(), "git -C "+m.RepoDir+" pull --ff-only", nil, func(line string) {
+				SendLog(stepID, line)
+			})
+			if result.Error != nil {
+				return wrapStepError("update-pull", "Pull Dotfiles",
+					"git pull failed. Local changes in "+m.RepoDir+" can block a fast-forward; commit or discard them and retry.",
+					result.Error)
+			}
+		}
+	} else {
+		SendLog(stepID, "Cloning repository into "+m.RepoDir+"...")
+		system.EnsureDir(filepath.Dir(m.RepoDir))
+		_, err := system.GitClone(m.installContext(), cloneURLs(m.RepoURL, m.Choices), m.RepoDir, system.CloneOptions{
+			Full:     true,
+			Ref:      m.Choices.RepoRef,
+			Log:      func(line string) { SendLog(stepID, line) },
+			Progress: func(progress float64) { SendProgress(stepID, progress) },
+		})
+		if err != nil {
+			return wrapStepError("update-pull", "Pull Dotfiles",
+				"Failed to clone the repository. Check your internet connection and git installation.",
+				err)
+		}
+	}
+
+	commit, err := readRepoCommit(execRunner, m.RepoDir)
+	if err != nil {
+		SendLog(stepID, "Warning: could not read the repository commit: "+err.Error())
+		m.UpdateSummary.CommitsPulled = -1
+		return nil
+	}
+	m.RepoCommit = commit
+	m.UpdateSummary.Status = append(m.UpdateSummary.Status, manifestRepoStatus(m.InstallManifest, manifestDotfiles, "Dotfiles", m.RepoDir)...)
+	if from == "" {
+		// A fresh clone: count from the commit the last install deployed
+		from = m.InstallManifest.dotfilesCommit().Hash
+	}
+	m.UpdateSummary.CommitsPulled = -1
+	switch {
+	case from == commit.Hash:
+		m.UpdateSummary.CommitsPulled = 0
+		SendLog(stepID, "✓ Already up to date ("+commit.Label()+")")
+		return nil
+	case from != "":
+		if n, err := commitsBehind(execRunner, m.RepoDir, from, commit.Hash); err == nil {
+			m.UpdateSummary.CommitsPulled = n
+		}
+	}
+	SendLog(stepID, "✓ Now at "+commit.Label())
+	return nil
+}
+
+// pendingUpdateLine compares the installed commit with the fetched upstream
+// of the checkout in repoDir, or returns "" when either is unknown
+func pendingUpdateLine(run CommandRunner, repoDir string, installed RepoCommit) string {
+	if installed.Hash == "" {
+		return ""
+	}
+	latest, err := readRepoCommitAt(run, repoDir, "@{u}")
+	if err != nil {
+		return ""
+	}
+	if installed.Date.IsZero() {
+		if dated, err := readRepoCommitAt(run, repoDir, installed.Hash); err == nil {
+			installed = dated
+		}
+	}
+	return repoUpdateLine(run, repoDir, installed, latest)
+}
+
+// updateConfigSources maps the deployed config roots, relative to home, to
+// where they come from in the repo
+var updateConfigSources = []struct{ dst, src string }{
+	{".config/nvim", "GentlemanNvim/nvim"},
+	{".config/fish", "GentlemanFish/fish"},
+	{".config/nushell", "GentlemanNushell"},
+	{"Library/Application Support/nushell", "GentlemanNushell"},
+	{".config/zellij", "GentlemanZellij/zellij"},
+	{".config/ghostty", "GentlemanGhostty"},
+	{".config/kitty", "GentlemanKitty"},
+	{".config/wezterm/wezterm.lua", ".wezterm.lua"},
+	{".config/alacritty/alacritty.toml", "alacritty.toml"},
+	{".config/starship.toml", "starship.toml"},
+	{".config/bash-env-json", "bash-env-json"},
+	{".config/bash-env.nu", "bash-env.nu"},
+	{".zshrc", "GentlemanZsh/.zshrc"},
+	{".p10k.zsh", "GentlemanZsh/.p10k.zsh"},
+	{".oh-my-zsh", "GentlemanZsh/.oh-my-zsh"},
+	{".tmux.conf", "GentlemanTmux/tmux.conf"},
+	{".tmux/plugins", "GentlemanTmux/plugins"},
+}
+
+// serverZshrc is the minimal .zshrc native installs deploy instead of
+// GentlemanZsh/.zshrc; serverZshrcHeader is its first line, which tells the
+// two apart once deployed
+const (
+	serverZshrc       = "GentlemanZsh/server.zshrc"
+	serverZshrcHeader = "# Gentleman.Dots minimal zsh config for servers."
+)
+
+// isServerZshrc reports whether the file at path is the deployed server.zshrc
+func isServerZshrc(path string) bool {
+	data, err := os.ReadFile(path)
+	return err == nil && firstLine(string(data)) == serverZshrcHeader
+}
+
+// updateSourceFor returns the repo file a deployed file under home came from,
+// or "" when it isn't one of the configs the update knows
+func updateSourceFor(home, repoDir, deployed string) string {
+	rel, err := filepath.Rel(home, deployed)
+	if err != nil {
+		return ""
+	}
+	if rel == ".zshrc" && isServerZshrc(deployed) {
+		return filepath.Join(repoDir, serverZshrc)
+	}
+	for _, c := range updateConfigSources {
+		if rel == c.dst {
+			return filepath.Join(repoDir, c.src)
+		}
+		if strings.HasPrefix(rel, c.dst+string(filepath.Separator)) {
+			return filepath.Join(repoDir, c.src, strings.TrimPrefix(rel, c.dst+string(filepath.Separator)))
+		}
+	}
+	return ""
+}
+
+// syncChangedConfigs replaces the deployed files whose repo version changed.
+// Only files in the deploy record are touched, and only while they still
+// have the content the installer put there; edited ones are reported as kept.
+// The record is updated with the new hashes.
+func syncChangedConfigs(home, repoDir string) (synced, kept []string, err error) {
+	recordPath := system.DeployRecordPath(home)
+	rec, err := system.LoadDeployRecord(recordPath)
+	if err != nil || rec == nil {
+		return nil, nil, err
+	}
+	deployed := make([]string, 0, len(rec.Files))
+	for path := range rec.Files {
+		deployed = append(deployed, path)
+	}
+	sort.Strings(deployed)
+
+	for _, path := range deployed {
+		src := updateSourceFor(home, repoDir, path)
+		if src == "" {
+			continue
+		}
+		sum, err := system.HashFile(src)
+		if err != nil || sum == rec.Files[path] {
+			continue // Gone upstream, or unchanged
+		}
+		if !rec.Matches(path) {
+			kept = append(kept, path)
+			continue
+		}
+		if err := system.CopyFile(src, path); err != nil {
+			return synced, kept, fmt.Errorf("failed to update %s: %w", path, err)
+		}
+		rec.Files[path] = sum
+		synced = append(synced, path)
+	}
+	if len(synced) > 0 {
+		if err := system.SaveDeployRecord(recordPath, rec); err != nil {
+			return synced, kept, err
+		}
+	}
+	return synced, kept, nil
+}
+
+func stepUpdateConfigs(m *Model) error {
+	stepID := "update-configs"
+	home := os.Getenv("HOME")
+	SendLog(stepID, "Comparing deployed configs with the repository...")
+	synced, kept, err := syncChangedConfigs(home, m.RepoDir)
+	// A merged .zshrc isn't a deployed file: only its managed block is ours
+	zshrc := filepath.Join(home, ".zshrc")
+	if refreshed, zerr := system.RefreshZshrc(zshrc); zerr != nil {
+		SendLog(stepID, "  ⚠ could not refresh the managed block: "+zerr.Error())
+		kept = append(kept, zshrc)
+	} else if refreshed {
+		synced = append(synced, zshrc)
+	}
+	m.UpdateSummary.FilesSynced = synced
+	m.UpdateSummary.FilesKept = kept
+	for _, path := range synced {
+		SendLog(stepID, "  ↻ "+contractHome(path))
+	}
+	for _, path := range kept {
+		SendLog(stepID, "  • kept your edits: "+contractHome(path))
+	}
+	if err != nil {
+		return wrapStepError("update-configs", "Re-sync Configs",
+			"Failed to update a deployed config",
+			err)
+	}
+	SendLog(stepID, fmt.Sprintf("✓ %d file(s) synced", len(synced)))
+	return nil
+}
+
+func stepUpdateSkills(m *Model) error {
+	stepID := "update-skills"
+	home := os.Getenv("HOME")
+	log := func(line string) { SendLog(stepID, line) }
+	if m.Choices.SkillsRef == "" {
+		SendLog(stepID, "Pulling the skill catalog...")
+	}
+	if err := pullSkillCatalog(m.installContext(), home, m.Choices.SkillsRef, log); err != nil {
+		msg := "Failed to update the skill catalog. Check your internet connection."
+		if m.Choices.SkillsRef != "" {
+			msg = "Failed to check out " + m.Choices.SkillsRef + " in the skill catalog."
+		}
+		return wrapStepError("update-skills", "Update Skill Catalog", msg, err)
+	}
+	m.UpdateSummary.SkillsUpdated = true
+	m.UpdateSummary.Status = append(m.UpdateSummary.Status, manifestRepoStatus(m.InstallManifest, manifestSkills, "Skill catalog", paths.SkillsDir(home))...)
+	SendLog(stepID, "✓ Skill catalog updated")
+	return nil
+}
+
+// renderUpdateComplete is the complete screen of the update-only flow
+func (m Model) renderUpdateComplete() string {
+	var s strings.Builder
+	s.WriteString(m.Theme.Success.Render("✨ Javi.Dots Updated! ✨"))
+	s.WriteString("\n\n")
+	s.WriteString(m.Theme.Title.Render("What changed"))
+	s.WriteString("\n")
+
+	sum := m.UpdateSummary
+	var items []string
+	switch {
+	case sum.CommitsPulled < 0:
+		items = append(items, "Commits pulled: unknown")
+	case sum.CommitsPulled == 0:
+		items = append(items, "Commits pulled: none, already up to date")
+	default:
+		items = append(items, fmt.Sprintf("Commits pulled: %d", sum.CommitsPulled))
+	}
+	items = append(items, m.commitItems()...)
+	items = append(items, fmt.Sprintf("Files synced: %d", len(sum.FilesSynced)))
+	if len(sum.FilesKept) > 0 {
+		items = append(items, fmt.Sprintf("Kept your edits: %d file(s) changed upstream", len(sum.FilesKept)))
+	}
+	if sum.SkillsUpdated {
+		items = append(items, "Skill catalog: updated")
+	}
+	if sum.PluginsSynced {
+		items = append(items, "Neovim plugins: synced")
+	}
+	items = append(items, sum.Status...)
+	if m.TotalTime > 0 {
+		items = append(items, "Total time: "+formatElapsed(time.Duration(m.TotalTime*float64(time.Second))))
+	}
+	for _, item := range items {
+		s.WriteString(m.Theme.Info.Render("  • " + item))
+		s.WriteString("\n")
+	}
+
+	for _, path := range sum.FilesSynced {
+		s.WriteString(m.Theme.Muted.Render("    ↻ " + contractHome(path)))
+		s.WriteString("\n")
+	}
+	for _, path := range sum.FilesKept {
+		s.WriteString(m.Theme.Warning.Render("    • " + contractHome(path)))
+		s.WriteString("\n")
+	}
+
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render("Press [Enter] or [q] to exit"))
+	return s.String()
+}
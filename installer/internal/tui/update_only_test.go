@@ -0,0 +1,205 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+)
+
+func stepIDs(steps []InstallStep) []string {
+	var ids []string
+	for _, s := range steps {
+		ids = append(ids, s.ID)
+	}
+	return ids
+}
+
+func TestSetupUpdateSteps(t *testing.T) {
+	tests := []struct {
+		name  string
+		found *existingInstall
+		want  []string
+	}{
+		{"nothing detected", nil, []string{"update-pull"}},
+		{"manifest only", &existingInstall{Manifest: &installManifest{}}, []string{"update-pull"}},
+		{"configs and nvim", &existingInstall{Configs: 12, Nvim: true}, []string{"update-pull", "update-configs", "nvim-sync"}},
+		{"skills only", &existingInstall{Skills: true, SkillLinks: 3}, []string{"update-pull", "update-skills"}},
+		{"everything", &existingInstall{Configs: 4, Skills: true, Nvim: true}, []string{"update-pull", "update-configs", "update-skills", "nvim-sync"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewModel()
+			m.ExistingInstall = tt.found
+			m.Choices = UserChoices{Terminal: "ghostty", Shell: "fish", InstallFont: true}
+			m.SetupUpdateSteps()
+			if got := stepIDs(m.Steps); !slices.Equal(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+			for _, s := range m.Steps {
+				if s.ID == "nvim-sync" && tt.found.Configs > 0 && !slices.Equal(s.DependsOn, []string{"update-configs"}) {
+					t.Errorf("plugins should sync after the configs, got %v", s.DependsOn)
+				}
+			}
+		})
+	}
+}
+
+func TestDetectExistingInstall(t *testing.T) {
+	home := t.TempDir()
+	if found := detectExistingInstall(home, filepath.Join(home, "Javi.Dots")); found != nil {
+		t.Fatalf("a clean home should have no install, got %+v", found)
+	}
+
+	clone := updateCloneDir(home)
+	os.MkdirAll(filepath.Join(clone, ".git"), 0755)
+	os.MkdirAll(filepath.Join(home, ".config", "nvim"), 0755)
+	os.MkdirAll(paths.SkillsDir(home), 0755)
+	found := detectExistingInstall(home, "")
+	if found == nil || found.CloneDir != clone || !found.Nvim || !found.Skills || found.Configs != 0 {
+		t.Fatalf("expected the update clone, nvim and skills, got %+v", found)
+	}
+}
+
+func TestSyncChangedConfigs(t *testing.T) {
+	home := t.TempDir()
+	repo := t.TempDir()
+	nvimSrc := filepath.Join(repo, "GentlemanNvim", "nvim")
+	os.MkdirAll(filepath.Join(nvimSrc, "lua"), 0755)
+	os.WriteFile(filepath.Join(nvimSrc, "init.lua"), []byte("v1"), 0644)
+	os.WriteFile(filepath.Join(nvimSrc, "lua", "opts.lua"), []byte("v1"), 0644)
+	os.WriteFile(filepath.Join(repo, "starship.toml"), []byte("v1"), 0644)
+
+	system.RecordDeploys(system.DeployRecordPath(home))
+	t.Cleanup(func() { system.RecordDeploys("") })
+	if err := system.DeployConfig(nvimSrc, filepath.Join(home, ".config", "nvim")); err != nil {
+		t.Fatal(err)
+	}
+	if err := system.DeployConfig(filepath.Join(repo, "starship.toml"), filepath.Join(home, ".config", "starship.toml")); err != nil {
+		t.Fatal(err)
+	}
+	system.RecordDeploys("")
+
+	// Upstream changes two files; the user edited one of them
+	os.WriteFile(filepath.Join(nvimSrc, "init.lua"), []byte("v2"), 0644)
+	os.WriteFile(filepath.Join(repo, "starship.toml"), []byte("v2"), 0644)
+	starship := filepath.Join(home, ".config", "starship.toml")
+	os.WriteFile(starship, []byte("mine"), 0644)
+
+	synced, kept, err := syncChangedConfigs(home, repo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	initLua := filepath.Join(home, ".config", "nvim", "init.lua")
+	if !slices.Equal(synced, []string{initLua}) || !slices.Equal(kept, []string{starship}) {
+		t.Fatalf("expected init.lua synced and starship kept, got %v / %v", synced, kept)
+	}
+	if data, _ := os.ReadFile(initLua); string(data) != "v2" {
+		t.Errorf("init.lua should be updated, got %q", data)
+	}
+	if data, _ := os.ReadFile(starship); string(data) != "mine" {
+		t.Errorf("the user's edit should stay, got %q", data)
+	}
+
+	// The record follows the update, so a second run has nothing to do
+	synced, _, _ = syncChangedConfigs(home, repo)
+	if len(synced) != 0 {
+		t.Errorf("a second sync should change nothing, got %v", synced)
+	}
+}
+
+// Update refreshes a merged .zshrc's managed block and nothing else in it
+func TestUpdateConfigsRefreshesZshBlock(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	zshrc := filepath.Join(home, ".zshrc")
+	os.WriteFile(zshrc, []byte("# mine\n\n"+system.RenderManagedBlock([]string{"source ~/.old"})+"\n"), 0644)
+
+	m := NewModel()
+	m.RepoDir = t.TempDir()
+	if err := stepUpdateConfigs(&m); err != nil {
+		t.Fatal(err)
+	}
+	content, _ := os.ReadFile(zshrc)
+	if !strings.HasPrefix(string(content), "# mine\n") || !strings.Contains(string(content), system.ZshMergeConfigPath) {
+		t.Errorf("the block should be refreshed in place, got:\n%s", content)
+	}
+	if !slices.Equal(m.UpdateSummary.FilesSynced, []string{zshrc}) {
+		t.Errorf("the refreshed .zshrc should be reported, got %v", m.UpdateSummary.FilesSynced)
+	}
+}
+
+// Each step reports only its own changes, so merging them counts nothing twice
+func TestUpdateStepReportsOnlyItsOwnChanges(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	m := NewModel()
+	m.RepoDir = t.TempDir()
+	m.UpdateSummary = updateSummary{FilesSynced: []string{"/x/init.lua"}, Status: []string{"Dotfiles: up to date"}}
+
+	msg := m.stepCmd("update-configs", false)().(stepCompleteMsg)
+	if msg.err != nil {
+		t.Fatal(msg.err)
+	}
+	if len(msg.update.FilesSynced) != 0 || len(msg.update.Status) != 0 {
+		t.Errorf("the step should not report earlier steps' changes, got %+v", msg.update)
+	}
+	result, _ := m.Update(msg)
+	if got := result.(Model).UpdateSummary; len(got.FilesSynced) != 1 || len(got.Status) != 1 {
+		t.Errorf("merging the step should keep one copy of each entry, got %+v", got)
+	}
+}
+
+func TestMainMenuUpdateEntry(t *testing.T) {
+	m := NewModel()
+	m.Screen = ScreenMainMenu
+	for _, opt := range m.GetCurrentOptions() {
+		if strings.Contains(opt, "Update Javi.Dots") {
+			t.Fatal("the update entry should be hidden without an existing install")
+		}
+	}
+
+	result, _ := m.Update(existingInstallMsg{install: &existingInstall{Configs: 3}})
+	m = result.(Model)
+	options := m.GetCurrentOptions()
+	if options[1] != "🔁 Update Javi.Dots" {
+		t.Fatalf("expected the update entry after Start Installation, got %v", options)
+	}
+
+	t.Setenv("HOME", t.TempDir())
+	m.Cursor = 1
+	result, cmd := m.handleMainMenuKeys("enter")
+	m = result.(Model)
+	if m.Screen != ScreenInstalling || !m.UpdateMode || cmd == nil {
+		t.Fatalf("expected the update to start, got screen %v", m.Screen)
+	}
+	if got := stepIDs(m.Steps); !slices.Equal(got, []string{"update-pull", "update-configs"}) {
+		t.Errorf("unexpected update plan %v", got)
+	}
+}
+
+func TestUpdateCompleteSummary(t *testing.T) {
+	m := NewModel()
+	m.UpdateMode = true
+	m.Screen = ScreenComplete
+	m.UpdateSummary.merge(updateSummary{CommitsPulled: 5})
+	m.UpdateSummary.merge(updateSummary{FilesSynced: []string{"/x/init.lua"}, FilesKept: []string{"/x/starship.toml"}})
+	m.UpdateSummary.merge(updateSummary{PluginsSynced: true})
+
+	view := m.renderComplete()
+	for _, want := range []string{"Updated", "Commits pulled: 5", "Files synced: 1", "Kept your edits: 1", "Neovim plugins: synced"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("summary should mention %q:\n%s", want, view)
+		}
+	}
+	if strings.Contains(view, "exec ") {
+		t.Error("an update has no new shell to switch to")
+	}
+	if m.GetScreenTitle() != "Update Complete!" {
+		t.Errorf("unexpected title %q", m.GetScreenTitle())
+	}
+}
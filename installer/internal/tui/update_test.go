@@ -1,6 +1,10 @@
 package tui
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -110,7 +114,7 @@ func TestHandleBackupConfirmKeys(t *testing.T) {
 	t.Run("should go to MainMenu when selecting cancel", func(t *testing.T) {
 		m := NewModel()
 		m.Screen = ScreenBackupConfirm
-		m.Cursor = 2 // Cancel
+		m.Cursor = 3 // Cancel
 
 		result, _ := m.handleBackupConfirmKeys("enter")
 		newModel := result.(Model)
@@ -120,18 +124,17 @@ func TestHandleBackupConfirmKeys(t *testing.T) {
 		}
 	})
 
-	t.Run("should go to AIToolsSelect on escape with no AI tools (go back)", func(t *testing.T) {
+	t.Run("should go to WizardReview on escape (go back)", func(t *testing.T) {
 		m := NewModel()
 		m.Screen = ScreenBackupConfirm
 		m.Cursor = 0
 
 		// Note: ESC is handled by handleEscape(), not handleBackupConfirmKeys()
-		// With no AI tools set, goes back to AI tools select
 		result, _ := m.handleEscape()
 		newModel := result.(Model)
 
-		if newModel.Screen != ScreenAIToolsSelect {
-			t.Errorf("Expected ScreenAIToolsSelect (go back, no AI tools), got %v", newModel.Screen)
+		if newModel.Screen != ScreenWizardReview {
+			t.Errorf("Expected ScreenWizardReview (go back), got %v", newModel.Screen)
 		}
 	})
 }
@@ -246,9 +249,9 @@ func TestHandleMainMenuWithRestore(t *testing.T) {
 		m.AvailableBackups = []system.BackupInfo{
 			{Path: "/test/backup1"},
 		}
-		// Options: Start, Learn & Practice, Restore, Init Project, Skill Manager, Exit
-		// Restore is at index 2
-		m.Cursor = 2
+		// Options: Start, Express, Server, Learn & Practice, Restore, Init Project, Skill Manager, Check Installation, Diagnose Setup, Uninstall, Exit
+		// Restore is at index 4
+		m.Cursor = 4
 
 		result, _ := m.handleMainMenuKeys("enter")
 		newModel := result.(Model)
@@ -262,9 +265,9 @@ func TestHandleMainMenuWithRestore(t *testing.T) {
 		m := NewModel()
 		m.Screen = ScreenMainMenu
 		m.AvailableBackups = []system.BackupInfo{} // No backups
-		// Options without restore: Start, Learn & Practice, Init Project, Skill Manager, Exit
-		// Exit is at index 4
-		m.Cursor = 4
+		// Options without restore: Start, Express, Server, Learn & Practice, Init Project, Skill Manager, Check Installation, Diagnose Setup, Uninstall, Settings, Exit
+		// Exit is at index 10
+		m.Cursor = 10
 
 		_, cmd := m.handleMainMenuKeys("enter")
 
@@ -316,6 +319,74 @@ func TestTickCmd(t *testing.T) {
 	})
 }
 
+func TestTickScheduling(t *testing.T) {
+	t.Run("idle main menu tick does not schedule another tick", func(t *testing.T) {
+		m := NewModel()
+		m.Screen = ScreenMainMenu
+		m.Ticking = true
+
+		result, cmd := m.Update(tickMsg(time.Now()))
+		newModel := result.(Model)
+
+		if cmd != nil {
+			t.Error("idle tick should not return a command")
+		}
+		if newModel.Ticking {
+			t.Error("ticking should pause when idle")
+		}
+		if newModel.SpinnerFrame != 0 {
+			t.Error("spinner should not advance when idle")
+		}
+	})
+
+	t.Run("entering ScreenInstalling restarts ticking", func(t *testing.T) {
+		m := NewModel()
+		m.Screen = ScreenBackupConfirm
+		m.Cursor = 1 // Install without Backup
+		m.Choices = UserChoices{OS: "linux", Terminal: "none", Shell: "fish", WindowMgr: "none"}
+
+		result, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		newModel := result.(Model)
+
+		if newModel.Screen != ScreenInstalling {
+			t.Fatalf("expected ScreenInstalling, got %v", newModel.Screen)
+		}
+		if !newModel.Ticking {
+			t.Error("ticking should resume when installation starts")
+		}
+		if cmd == nil {
+			t.Error("expected commands when installation starts")
+		}
+	})
+
+	t.Run("tick while installing keeps the fast cadence", func(t *testing.T) {
+		m := NewModel()
+		m.Screen = ScreenInstalling
+		m.Ticking = true
+
+		result, cmd := m.Update(tickMsg(time.Now()))
+		newModel := result.(Model)
+
+		if cmd == nil {
+			t.Error("tick while installing should schedule the next tick")
+		}
+		if newModel.SpinnerFrame != 1 {
+			t.Errorf("expected spinner to advance, got %d", newModel.SpinnerFrame)
+		}
+	})
+
+	t.Run("already ticking does not double schedule", func(t *testing.T) {
+		m := NewModel()
+		m.Screen = ScreenInstalling
+		m.Ticking = true
+
+		result, _ := m.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+		if !result.(Model).Ticking {
+			t.Error("ticking state should be preserved")
+		}
+	})
+}
+
 func TestLoadBackupsCmd(t *testing.T) {
 	t.Run("loadBackupsCmd should return a command", func(t *testing.T) {
 		cmd := loadBackupsCmd()
@@ -545,7 +616,7 @@ func TestMainMenuLearnAndPractice(t *testing.T) {
 	t.Run("Learn & Practice goes to ScreenLearnMenu", func(t *testing.T) {
 		m := NewModel()
 		m.Screen = ScreenMainMenu
-		m.Cursor = 1 // Learn & Practice
+		m.Cursor = 3 // Learn & Practice
 
 		result, _ := m.handleMainMenuKeys("enter")
 		nm := result.(Model)
@@ -575,3 +646,219 @@ func TestLearnMenuScreenTitleAndDescription(t *testing.T) {
 		}
 	})
 }
+
+func TestBackupConfirmExclusionToggles(t *testing.T) {
+	m := NewModel()
+	m.Screen = ScreenBackupConfirm
+	m.ExistingConfigs = []string{"nvim: /tmp/nvim"}
+
+	result, _ := m.handleBackupConfirmKeys("c")
+	m = result.(Model)
+	if !m.Choices.BackupIncludeCaches {
+		t.Error("expected 'c' to enable backing up caches")
+	}
+
+	result, _ = m.handleBackupConfirmKeys("h")
+	m = result.(Model)
+	if !m.Choices.BackupExcludeHistory {
+		t.Error("expected 'h' to exclude shell history")
+	}
+
+	result, _ = m.handleBackupConfirmKeys("z")
+	m = result.(Model)
+	if !m.Choices.BackupCompress {
+		t.Error("expected 'z' to compress the backup")
+	}
+
+	sizes := []system.BackupSizeEstimate{{Key: "nvim", Total: 2048, Cache: 1024}}
+	result, _ = m.Update(backupSizesMsg{sizes: sizes})
+	m = result.(Model)
+	if len(m.BackupSizes) != 1 {
+		t.Fatalf("expected backup sizes to be stored, got %d", len(m.BackupSizes))
+	}
+	if m.Screen != ScreenBackupConfirm {
+		t.Errorf("size estimate should not change screen, got %d", m.Screen)
+	}
+
+	// An estimate that found nothing to size still ends the wait
+	m.BackupSizing = true
+	result, _ = m.Update(backupSizesMsg{})
+	m = result.(Model)
+	if view := m.renderBackupConfirm(); strings.Contains(view, "Estimating") || !strings.Contains(view, "No size estimate") {
+		t.Errorf("a nil estimate should not leave the screen estimating:\n%s", view)
+	}
+}
+
+func TestZshMergeSelect(t *testing.T) {
+	zshIndex := 1 // Fish, Zsh, Nushell
+
+	t.Run("existing .zshrc offers merge", func(t *testing.T) {
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+		os.WriteFile(filepath.Join(home, ".zshrc"), []byte("export FOO=1\n"), 0644)
+
+		m := NewModel()
+		m.Screen = ScreenShellSelect
+		m.Cursor = zshIndex
+		result, _ := m.handleSelectionKeys("enter")
+		m = result.(Model)
+		if m.Screen != ScreenZshMergeSelect {
+			t.Fatalf("expected ScreenZshMergeSelect, got %d", m.Screen)
+		}
+
+		m.Cursor = 0 // Merge
+		result, _ = m.handleSelectionKeys("enter")
+		m = result.(Model)
+		if !m.Choices.ZshMerge {
+			t.Error("expected ZshMerge to be set")
+		}
+		if m.Screen != ScreenWMSelect {
+			t.Errorf("expected ScreenWMSelect, got %d", m.Screen)
+		}
+
+		result, _ = m.handleEscape()
+		m = result.(Model)
+		if m.Screen != ScreenZshMergeSelect {
+			t.Errorf("esc from WM should return to merge screen, got %d", m.Screen)
+		}
+	})
+
+	t.Run("no .zshrc skips merge screen", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+
+		m := NewModel()
+		m.Screen = ScreenShellSelect
+		m.Cursor = zshIndex
+		result, _ := m.handleSelectionKeys("enter")
+		m = result.(Model)
+		if m.Screen != ScreenWMSelect {
+			t.Errorf("expected ScreenWMSelect, got %d", m.Screen)
+		}
+		if m.Choices.ZshMerge {
+			t.Error("ZshMerge should stay off without an existing .zshrc")
+		}
+	})
+}
+
+func TestRestoreConflictFlow(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	zshrc := filepath.Join(home, ".zshrc")
+	tmux := filepath.Join(home, ".tmux.conf")
+	os.WriteFile(zshrc, []byte("user zshrc"), 0644)
+	os.WriteFile(tmux, []byte("user tmux"), 0644)
+
+	backupDir, err := system.CreateBackup([]string{"zsh", "tmux"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Install, then the user edits both files afterwards
+	os.WriteFile(zshrc, []byte("gentleman zshrc"), 0644)
+	os.WriteFile(tmux, []byte("gentleman tmux"), 0644)
+	if err := system.RecordDeployedHashes(backupDir); err != nil {
+		t.Fatal(err)
+	}
+	os.WriteFile(zshrc, []byte("edited zshrc"), 0644)
+	os.WriteFile(tmux, []byte("edited tmux"), 0644)
+
+	m := NewModel()
+	m.AvailableBackups = system.ListBackups()
+	m.Screen = ScreenRestoreConfirm
+	m.Cursor = 0
+
+	result, _ := m.handleRestoreConfirmKeys("enter")
+	m = result.(Model)
+	if m.Screen != ScreenRestoreItems {
+		t.Fatalf("expected ScreenRestoreItems, got %d", m.Screen)
+	}
+	result, _ = m.handleRestoreItemsKeys("enter")
+	m = result.(Model)
+	if m.Screen != ScreenRestoreConflict {
+		t.Fatalf("expected ScreenRestoreConflict, got %d", m.Screen)
+	}
+	if len(m.RestoreConflicts) != 2 {
+		t.Fatalf("expected 2 conflicts, got %d", len(m.RestoreConflicts))
+	}
+
+	t.Run("esc cancels without writing", func(t *testing.T) {
+		result, _ := m.handleEscape()
+		nm := result.(Model)
+		if nm.Screen != ScreenRestoreItems {
+			t.Errorf("expected ScreenRestoreItems, got %d", nm.Screen)
+		}
+		if got, _ := os.ReadFile(zshrc); string(got) != "edited zshrc" {
+			t.Errorf("cancel should not touch files, got %q", got)
+		}
+	})
+
+	// Keep both, applied to every remaining conflict
+	result, _ = m.handleRestoreConflictKeys("a")
+	m = result.(Model)
+	m.Cursor = int(system.ConflictKeepBoth)
+	result, _ = m.handleRestoreConflictKeys("enter")
+	m = result.(Model)
+
+	if m.Screen != ScreenComplete {
+		t.Fatalf("expected ScreenComplete after resolving all conflicts, got %d", m.Screen)
+	}
+	for path, want := range map[string]string{
+		zshrc:                           "edited zshrc",
+		zshrc + system.FromBackupSuffix: "user zshrc",
+		tmux:                            "edited tmux",
+		tmux + system.FromBackupSuffix:  "user tmux",
+	} {
+		if got, _ := os.ReadFile(path); string(got) != want {
+			t.Errorf("%s: expected %q, got %q", path, want, got)
+		}
+	}
+}
+
+func TestExecFinishedResetsLeaderMode(t *testing.T) {
+	qKey := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}}
+
+	t.Run("q right after exec returns is dropped", func(t *testing.T) {
+		m := NewModel()
+		m.Screen = ScreenInstalling
+		m.Steps = []InstallStep{{ID: "deps", Name: "Install Dependencies", Status: StatusRunning, Interactive: true}}
+		m.LeaderMode = true
+
+		// A failed step lands on the error screen, where <space>q would quit
+		result, _ := m.Update(execFinishedMsg{stepID: "deps", err: fmt.Errorf("sudo failed")})
+		m = result.(Model)
+		if m.LeaderMode {
+			t.Error("execFinishedMsg should reset LeaderMode")
+		}
+
+		result, cmd := m.Update(qKey)
+		m = result.(Model)
+		if m.Quitting || cmd != nil {
+			t.Error("q delivered right after exec should not quit")
+		}
+	})
+
+	t.Run("q after the settle window is not a stale leader command", func(t *testing.T) {
+		m := NewModel()
+		m.Screen = ScreenInstalling
+		m.Steps = []InstallStep{{ID: "deps", Name: "Install Dependencies", Status: StatusRunning, Interactive: true}}
+		m.LeaderMode = true
+
+		result, _ := m.Update(execFinishedMsg{stepID: "deps", err: fmt.Errorf("sudo failed")})
+		m = result.(Model)
+		m.InputSettleUntil = time.Now().Add(-time.Millisecond)
+
+		result, _ = m.Update(qKey)
+		m = result.(Model)
+		if m.Quitting {
+			t.Error("q after exec should not be treated as <space>q")
+		}
+	})
+
+	t.Run("ctrl+c still quits during the settle window", func(t *testing.T) {
+		m := NewModel()
+		m.InputSettleUntil = time.Now().Add(time.Hour)
+		result, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlC})
+		if !result.(Model).Quitting {
+			t.Error("ctrl+c should always quit")
+		}
+	})
+}
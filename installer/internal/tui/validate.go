@@ -0,0 +1,149 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+)
+
+// ChoiceError describes a single invalid field in UserChoices
+type ChoiceError struct {
+	Field   string
+	Message string
+}
+
+func (e ChoiceError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// ChoiceErrors collects every problem found in a set of choices so they can be
+// reported at once instead of failing on the first one
+type ChoiceErrors []ChoiceError
+
+func (e ChoiceErrors) Error() string {
+	parts := make([]string, len(e))
+	for i, ce := range e {
+		parts[i] = ce.Error()
+	}
+	return "invalid choices: " + strings.Join(parts, "; ")
+}
+
+var (
+	validOSChoices       = map[string]bool{"mac": true, "linux": true, "termux": true, "windows": true}
+	validTerminalChoices = map[string]bool{"alacritty": true, "wezterm": true, "kitty": true, "ghostty": true, "none": true, "": true}
+	validShellChoices    = map[string]bool{"fish": true, "zsh": true, "nushell": true}
+	validWMChoices       = map[string]bool{"tmux": true, "zellij": true, "none": true, "": true}
+	validPresetChoices   = map[string]bool{"minimal": true, "frontend": true, "backend": true, "fullstack": true, "data": true, "complete": true, "": true}
+)
+
+// ValidateChoices checks that a set of choices can be turned into a sane list
+// of install steps on the given system. It returns nil when everything is valid.
+func ValidateChoices(choices UserChoices, sysInfo *system.SystemInfo) ChoiceErrors {
+	var errs ChoiceErrors
+	add := func(field, format string, args ...interface{}) {
+		errs = append(errs, ChoiceError{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if !validOSChoices[choices.OS] {
+		if choices.OS == "" {
+			add("os", "is required (mac, linux, termux, windows)")
+		} else {
+			add("os", "unsupported value %q (valid: mac, linux, termux, windows)", choices.OS)
+		}
+	}
+
+	if !validTerminalChoices[choices.Terminal] {
+		add("terminal", "unsupported value %q (valid: alacritty, wezterm, kitty, ghostty, none)", choices.Terminal)
+	}
+
+	// Native Windows installs no shell
+	isWindows := choices.OS == "windows"
+	if !validShellChoices[choices.Shell] && !(isWindows && choices.Shell == "") {
+		if choices.Shell == "" {
+			add("shell", "is required (fish, zsh, nushell)")
+		} else {
+			add("shell", "unsupported value %q (valid: fish, zsh, nushell)", choices.Shell)
+		}
+	}
+
+	if !validWMChoices[choices.WindowMgr] {
+		add("window_manager", "unsupported value %q (valid: tmux, zellij, none)", choices.WindowMgr)
+	}
+
+	for _, id := range choices.WMExtras {
+		if findWMExtra(wmExtraOptions[choices.WindowMgr], id) == nil {
+			add("wm_extras", "unknown %s extra %q (valid: %s)", choices.WindowMgr, id, strings.Join(wmExtraIDs(wmExtraOptions[choices.WindowMgr]), ", "))
+		}
+	}
+
+	for _, tool := range choices.AITools {
+		if !hasAITool(aiToolIDMap, tool) {
+			add("ai_tools", "unknown tool %q (valid: %s)", tool, strings.Join(aiToolIDMap, ", "))
+		}
+	}
+
+	if err := validateGitRef(choices.RepoRef); err != nil {
+		add("repo_ref", "%v", err)
+	}
+	if err := validateGitRef(choices.SkillsRef); err != nil {
+		add("skills_ref", "%v", err)
+	}
+
+	if !validPresetChoices[choices.AIFrameworkPreset] {
+		add("ai_preset", "unsupported value %q (valid: minimal, frontend, backend, fullstack, data, complete)", choices.AIFrameworkPreset)
+	}
+
+	// Platform-specific combinations
+	isTermux := choices.OS == "termux" || (sysInfo != nil && sysInfo.IsTermux)
+	hasTerminal := choices.Terminal != "" && choices.Terminal != "none"
+
+	if isTermux {
+		if hasTerminal {
+			add("terminal", "%s cannot be installed on Termux (Termux is already the terminal)", choices.Terminal)
+		}
+		if choices.InstallZed {
+			add("zed", "Zed is not supported on Termux (requires GUI with Vulkan)")
+		}
+		if len(choices.AITools) > 0 {
+			add("ai_tools", "AI tools are not supported on Termux")
+		}
+	}
+
+	if isWindows {
+		if sysInfo != nil && sysInfo.OS != system.OSWindows {
+			add("os", "windows can only be selected on native Windows (use linux inside WSL)")
+		}
+		if hasTerminal {
+			add("terminal", "%s cannot be installed on native Windows", choices.Terminal)
+		}
+		if choices.Shell != "" {
+			add("shell", "%s cannot be installed on native Windows", choices.Shell)
+		}
+		if choices.WindowMgr != "" && choices.WindowMgr != "none" {
+			add("window_manager", "%s cannot be installed on native Windows", choices.WindowMgr)
+		}
+		if choices.InstallZed {
+			add("zed", "Zed is not installed on native Windows")
+		}
+		if choices.InstallAIFramework {
+			add("ai_framework", "the AI framework needs bash and is not installed on native Windows")
+		}
+	}
+
+	if choices.NativePackages && (choices.OS == "mac" || isTermux || isWindows) {
+		add("native_packages", "native packages are only used on Linux (macOS uses Homebrew, Termux uses pkg, Windows uses winget or scoop)")
+	}
+
+	if choices.Terminal == "kitty" && choices.OS != "mac" && choices.OS != "" {
+		add("terminal", "kitty is only supported on macOS")
+	}
+
+	if sysInfo != nil && sysInfo.IsWSL {
+		if choices.OS == "mac" || choices.OS == "termux" {
+			add("os", "%s cannot be selected when running inside WSL", choices.OS)
+		}
+	}
+
+	return errs
+}
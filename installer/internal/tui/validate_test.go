@@ -0,0 +1,116 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+)
+
+func TestValidateChoices(t *testing.T) {
+	linux := &system.SystemInfo{OS: system.OSDebian, OSName: "Debian/Ubuntu"}
+	mac := &system.SystemInfo{OS: system.OSMac, OSName: "macOS"}
+	termux := &system.SystemInfo{OS: system.OSTermux, OSName: "Termux", IsTermux: true}
+	wsl := &system.SystemInfo{OS: system.OSDebian, OSName: "Debian/Ubuntu", IsWSL: true}
+	windows := &system.SystemInfo{OS: system.OSWindows, OSName: "Windows", HasWinget: true}
+	onWindows := func(c *UserChoices) { c.OS = "windows"; c.Terminal = "none"; c.Shell = ""; c.WindowMgr = "none" }
+
+	valid := UserChoices{OS: "linux", Terminal: "alacritty", Shell: "fish", WindowMgr: "tmux"}
+
+	tests := []struct {
+		name       string
+		modify     func(c *UserChoices)
+		sysInfo    *system.SystemInfo
+		wantFields []string
+	}{
+		{"valid linux choices", func(c *UserChoices) {}, linux, nil},
+		{"valid mac with kitty", func(c *UserChoices) { c.OS = "mac"; c.Terminal = "kitty" }, mac, nil},
+		{"valid termux", func(c *UserChoices) { c.OS = "termux"; c.Terminal = "none" }, termux, nil},
+		{"empty terminal treated as none", func(c *UserChoices) { c.Terminal = "" }, linux, nil},
+		{"valid wsl linux", func(c *UserChoices) {}, wsl, nil},
+		{"empty shell", func(c *UserChoices) { c.Shell = "" }, linux, []string{"shell"}},
+		{"unsupported shell bash", func(c *UserChoices) { c.Shell = "bash" }, linux, []string{"shell"}},
+		{"empty os", func(c *UserChoices) { c.OS = "" }, linux, []string{"os"}},
+		{"unknown os", func(c *UserChoices) { c.OS = "solaris" }, linux, []string{"os"}},
+		{"unknown terminal", func(c *UserChoices) { c.Terminal = "xterm" }, linux, []string{"terminal"}},
+		{"unknown window manager", func(c *UserChoices) { c.WindowMgr = "screen" }, linux, []string{"window_manager"}},
+		{"tmux extras", func(c *UserChoices) { c.WMExtras = []string{"essentials", "kanagawa"} }, linux, nil},
+		{"no extras", func(c *UserChoices) { c.WMExtras = []string{} }, linux, nil},
+		{"zellij extra on tmux", func(c *UserChoices) { c.WMExtras = []string{"layouts"} }, linux, []string{"wm_extras"}},
+		{"extras without a window manager", func(c *UserChoices) { c.WindowMgr = "none"; c.WMExtras = []string{"forgot"} }, linux, []string{"wm_extras"}},
+		{"unknown ai tool", func(c *UserChoices) { c.AITools = []string{"claude", "cursor"} }, linux, []string{"ai_tools"}},
+		{"unknown ai preset", func(c *UserChoices) { c.AIFrameworkPreset = "huge" }, linux, []string{"ai_preset"}},
+		{"kitty on linux", func(c *UserChoices) { c.Terminal = "kitty" }, linux, []string{"terminal"}},
+		{"ghostty on termux", func(c *UserChoices) { c.OS = "termux"; c.Terminal = "ghostty" }, termux, []string{"terminal"}},
+		{"termux detected even if os says linux", func(c *UserChoices) { c.Terminal = "wezterm" }, termux, []string{"terminal"}},
+		{"zed on termux", func(c *UserChoices) { c.OS = "termux"; c.Terminal = "none"; c.InstallZed = true }, termux, []string{"zed"}},
+		{"ai tools on termux", func(c *UserChoices) { c.OS = "termux"; c.Terminal = "none"; c.AITools = []string{"claude"} }, termux, []string{"ai_tools"}},
+		{"native packages on linux", func(c *UserChoices) { c.NativePackages = true }, linux, nil},
+		{"native packages on mac", func(c *UserChoices) { c.OS = "mac"; c.NativePackages = true }, mac, []string{"native_packages"}},
+		{"native packages on termux", func(c *UserChoices) { c.OS = "termux"; c.Terminal = "none"; c.NativePackages = true }, termux, []string{"native_packages"}},
+		{"valid windows", func(c *UserChoices) { onWindows(c); c.InstallNvim = true; c.AITools = []string{"claude"} }, windows, nil},
+		{"windows on linux", onWindows, linux, []string{"os"}},
+		{"shell on windows", func(c *UserChoices) { onWindows(c); c.Shell = "fish" }, windows, []string{"shell"}},
+		{"terminal on windows", func(c *UserChoices) { onWindows(c); c.Terminal = "wezterm" }, windows, []string{"terminal"}},
+		{"zed and framework on windows", func(c *UserChoices) { onWindows(c); c.InstallZed = true; c.InstallAIFramework = true }, windows, []string{"zed", "ai_framework"}},
+		{"native packages on windows", func(c *UserChoices) { onWindows(c); c.NativePackages = true }, windows, []string{"native_packages"}},
+		{"pinned refs", func(c *UserChoices) { c.RepoRef = "v2.1.0"; c.SkillsRef = "a1b2c3d" }, linux, nil},
+		{"ref that looks like a flag", func(c *UserChoices) { c.RepoRef = "--upload-pack=x" }, linux, []string{"repo_ref"}},
+		{"ref with a space", func(c *UserChoices) { c.SkillsRef = "my branch" }, linux, []string{"skills_ref"}},
+		{"mac os inside wsl", func(c *UserChoices) { c.OS = "mac" }, wsl, []string{"os"}},
+		{"termux os inside wsl", func(c *UserChoices) { c.OS = "termux"; c.Terminal = "none" }, wsl, []string{"os"}},
+		{"multiple problems reported at once", func(c *UserChoices) {
+			c.Shell = "bash"
+			c.WindowMgr = "screen"
+			c.Terminal = "xterm"
+		}, linux, []string{"terminal", "shell", "window_manager"}},
+		{"nil system info", func(c *UserChoices) {}, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			choices := valid
+			tt.modify(&choices)
+
+			errs := ValidateChoices(choices, tt.sysInfo)
+			if len(errs) != len(tt.wantFields) {
+				t.Fatalf("expected %d errors %v, got %d: %v", len(tt.wantFields), tt.wantFields, len(errs), errs)
+			}
+			for i, field := range tt.wantFields {
+				if errs[i].Field != field {
+					t.Errorf("error %d: expected field %q, got %q (%s)", i, field, errs[i].Field, errs[i].Message)
+				}
+			}
+		})
+	}
+}
+
+func TestChoiceErrorsMessage(t *testing.T) {
+	errs := ValidateChoices(UserChoices{OS: "linux", Shell: "bash", WindowMgr: "screen"}, nil)
+	msg := errs.Error()
+	if !strings.Contains(msg, "shell:") || !strings.Contains(msg, "window_manager:") {
+		t.Errorf("expected all problems in message, got %q", msg)
+	}
+}
+
+func TestStartInstallationLogsInvalidChoices(t *testing.T) {
+	m := NewModel()
+	m.SystemInfo = &system.SystemInfo{OS: system.OSDebian}
+	m.Choices = UserChoices{OS: "linux", Terminal: "none", Shell: "bash"}
+
+	result, _ := m.startInstallation()
+	newModel := result.(Model)
+
+	if newModel.Screen != ScreenInstalling {
+		t.Fatalf("expected ScreenInstalling, got %v", newModel.Screen)
+	}
+	found := false
+	for _, line := range newModel.LogLines.Lines() {
+		if strings.Contains(line, "shell:") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected shell validation warning in log, got %v", newModel.LogLines.Lines())
+	}
+}
@@ -3,7 +3,10 @@ package tui
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/paths"
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
 	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/tui/trainer"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -38,12 +41,28 @@ const brandText = `
 
 // View implements tea.Model
 func (m Model) View() string {
+	if m.ASCIIIcons {
+		return asciiIcons(m.render())
+	}
+	return m.render()
+}
+
+// render draws the current screen; View swaps in the ASCII icons when they're on
+func (m Model) render() string {
 	if m.Quitting {
 		return ""
 	}
 
 	var s strings.Builder
 
+	// Renderers record their option rows again for mouse clicks
+	m.VisibleRows.reset()
+
+	// Below the minimum size the layout breaks; the next resize recovers
+	if m.tooSmall() {
+		return lipgloss.NewStyle().Padding(1, 2, 0, 2).Render(m.renderTooSmall())
+	}
+
 	switch m.Screen {
 	case ScreenWelcome:
 		s.WriteString(m.renderWelcome())
@@ -51,14 +70,22 @@ func (m Model) View() string {
 		s.WriteString(m.renderMainMenu())
 	case ScreenLearnMenu:
 		s.WriteString(m.renderSelection())
-	case ScreenOSSelect, ScreenTerminalSelect, ScreenFontSelect, ScreenShellSelect, ScreenWMSelect, ScreenNvimSelect, ScreenZedSelect, ScreenAIFrameworkConfirm, ScreenAIFrameworkPreset, ScreenGhosttyWarning:
+	case ScreenOSSelect, ScreenTerminalSelect, ScreenFontSelect, ScreenShellSelect, ScreenZshMergeSelect, ScreenWMSelect, ScreenNvimSelect, ScreenZedSelect, ScreenAIFrameworkConfirm, ScreenAIFrameworkPreset, ScreenGhosttyWarning, ScreenWizardReview:
 		s.WriteString(m.renderSelection())
 	case ScreenAIToolsSelect:
 		s.WriteString(m.renderAIToolSelection())
+	case ScreenWMExtras:
+		s.WriteString(m.renderWMExtrasSelection())
 	case ScreenAIFrameworkCategories:
 		s.WriteString(m.renderAICategoryMenu())
 	case ScreenAIFrameworkCategoryItems:
 		s.WriteString(m.renderAICategoryItems())
+	case ScreenAIFrameworkSummary:
+		s.WriteString(m.renderAIFrameworkSummary())
+	case ScreenAIFrameworkPresetSave:
+		s.WriteString(m.renderFrameworkPresetSave())
+	case ScreenAIFrameworkApplyDiff:
+		s.WriteString(m.renderAIFrameworkApplyDiff())
 	case ScreenLearnTerminals:
 		s.WriteString(m.renderLearnTerminals())
 	case ScreenLearnShells:
@@ -91,10 +118,26 @@ func (m Model) View() string {
 		s.WriteString(m.renderLazyVimTopic())
 	case ScreenBackupConfirm:
 		s.WriteString(m.renderBackupConfirm())
+	case ScreenOSVersionWarning:
+		s.WriteString(m.renderOSVersionWarning())
+	case ScreenDiskSpaceWarning:
+		s.WriteString(m.renderDiskSpaceWarning())
 	case ScreenRestoreBackup:
 		s.WriteString(m.renderRestoreBackup())
 	case ScreenRestoreConfirm:
 		s.WriteString(m.renderRestoreConfirm())
+	case ScreenRestoreItems:
+		s.WriteString(m.renderRestoreItems())
+	case ScreenBackupPrune:
+		s.WriteString(m.renderBackupPrune())
+	case ScreenRestoreConflict:
+		s.WriteString(m.renderRestoreConflict())
+	case ScreenDiagnoseSymptom:
+		s.WriteString(m.renderDiagnoseSymptom())
+	case ScreenDiagnoseResults:
+		s.WriteString(m.renderDiagnoseResults())
+	case ScreenInstallCheck:
+		s.WriteString(m.renderInstallCheck())
 	case ScreenInstalling:
 		s.WriteString(m.renderInstalling())
 	case ScreenComplete:
@@ -105,33 +148,73 @@ func (m Model) View() string {
 	case ScreenTrainerMenu:
 		s.WriteString(m.renderTrainerMenu())
 	case ScreenTrainerLesson:
-		s.WriteString(m.renderTrainerExercise("Lesson"))
+		if m.TrainerGameState != nil && m.TrainerGameState.IsPlacementMode {
+			s.WriteString(m.renderTrainerExercise(m.T("trainer.mode_placement")))
+		} else {
+			s.WriteString(m.renderTrainerExercise(m.T("trainer.mode_lesson")))
+		}
 	case ScreenTrainerPractice:
-		s.WriteString(m.renderTrainerExercise("Practice"))
+		if m.TrainerGameState != nil && m.TrainerGameState.IsReviewMode {
+			s.WriteString(m.renderTrainerExercise(m.T("trainer.mode_review")))
+		} else {
+			s.WriteString(m.renderTrainerExercise(m.T("trainer.mode_practice")))
+		}
 	case ScreenTrainerBoss:
 		s.WriteString(m.renderTrainerBoss())
 	case ScreenTrainerResult:
 		s.WriteString(m.renderTrainerResult())
 	case ScreenTrainerBossResult:
 		s.WriteString(m.renderTrainerBossResult())
+	case ScreenTrainerTimed:
+		s.WriteString(m.renderTrainerTimed())
+	case ScreenInstallRefs:
+		s.WriteString(m.renderInstallRefs())
+	case ScreenInstallRefInput:
+		s.WriteString(m.renderInstallRefInput())
 	// Project init screens
 	case ScreenProjectPath:
 		s.WriteString(m.renderProjectPath())
-	case ScreenProjectStack, ScreenProjectMemory, ScreenProjectObsidianInstall, ScreenProjectEngram, ScreenProjectCI:
+	case ScreenProjectMemory, ScreenProjectObsidianInstall, ScreenProjectEngram, ScreenProjectCI:
 		s.WriteString(m.renderSelection())
-	case ScreenProjectRolePack:
+	case ScreenProjectStack, ScreenProjectRolePack:
 		s.WriteString(m.renderRolePackSelection())
 	case ScreenProjectConfirm:
 		s.WriteString(m.renderProjectConfirm())
+	case ScreenProjectPreview:
+		s.WriteString(m.renderProjectPreview())
 	case ScreenProjectInstalling:
 		s.WriteString(m.renderProjectInstalling())
 	case ScreenProjectResult:
 		s.WriteString(m.renderProjectResult())
+	case ScreenProjectBatchSelect:
+		s.WriteString(m.renderProjectBatchSelect())
+	case ScreenProjectBatchResult:
+		s.WriteString(m.renderProjectBatchResult())
 	// Skill manager screens
 	case ScreenSkillMenu:
 		s.WriteString(m.renderSelection())
 	case ScreenSkillBrowse:
 		s.WriteString(m.renderSkillBrowse())
+	case ScreenSkillStats:
+		s.WriteString(m.renderSkillStats())
+	case ScreenSkillWarnings:
+		s.WriteString(m.renderSkillWarnings())
+	case ScreenProfileSave:
+		s.WriteString(m.renderProfileSave())
+	case ScreenProfileSelect:
+		s.WriteString(m.renderProfileSelect())
+	case ScreenProfileMismatch:
+		s.WriteString(m.renderProfileMismatch())
+	case ScreenSkillTargets:
+		s.WriteString(m.renderSkillTargets())
+	case ScreenSkillConflicts:
+		s.WriteString(m.renderSkillConflicts())
+	case ScreenSettings:
+		s.WriteString(m.renderSettings())
+	case ScreenSkillManifest:
+		s.WriteString(m.renderSkillManifest())
+	case ScreenSkillURL:
+		s.WriteString(m.renderSkillURL())
 	case ScreenSkillInstall:
 		s.WriteString(m.renderSkillInstall())
 	case ScreenSkillRemove:
@@ -140,46 +223,114 @@ func (m Model) View() string {
 		s.WriteString(m.renderSkillResult())
 	case ScreenSkillUpdate:
 		s.WriteString(m.renderSkillUpdate())
-	}
-
-	// Leader mode indicator
+	case ScreenUnsupportedPlatform:
+		s.WriteString(m.renderUnsupportedPlatform())
+	case ScreenInstanceLocked:
+		s.WriteString(m.renderInstanceLocked())
+	case ScreenTrainerResetConfirm:
+		s.WriteString(m.renderTrainerResetConfirm())
+	case ScreenTrainerSettings:
+		s.WriteString(m.renderTrainerSettings())
+	case ScreenTrainerImport:
+		s.WriteString(m.renderTrainerImport())
+	case ScreenTrainerResetAll:
+		s.WriteString(m.renderTrainerResetAll())
+	case ScreenSkillDetail:
+		s.WriteString(m.renderSkillDetail())
+	case ScreenSkillDoctor:
+		s.WriteString(m.renderSkillDoctor())
+	case ScreenUninstall:
+		s.WriteString(m.renderUninstall())
+	case ScreenUninstallResult:
+		s.WriteString(m.renderUninstallResult())
+	}
+
+	// Leader mode indicator, in place of the key hints footer while active
 	if m.LeaderMode {
 		s.WriteString("\n")
-		s.WriteString(WarningStyle.Render("▶ LEADER MODE - Press: q=quit, d=details"))
+		s.WriteString(m.renderLeaderBar())
+	} else if m.Toast != "" {
+		s.WriteString("\n\n")
+		s.WriteString(m.Theme.Warning.Render(m.Toast))
+	} else if footer := renderFooter(m.Theme, m.footerHints(), m.Width-4); footer != "" && m.footerFits(s.String()) {
+		s.WriteString("\n\n")
+		s.WriteString(footer)
 	}
 
 	// Apply global padding (top: 1, right: 2, bottom: 0, left: 2)
 	paddedStyle := lipgloss.NewStyle().Padding(1, 2, 0, 2)
+	if m.ShowHelp {
+		return overlayCenter(m.Theme, paddedStyle.Render(s.String()), m.renderHelpOverlay(), m.Width, m.Height)
+	}
 	return paddedStyle.Render(s.String())
 }
 
+// logLineWidth is how wide a log line can be inside the details box
+// (global padding, border and box padding take 10 columns); 0 means unknown
+func (m Model) logLineWidth() int {
+	if m.Width <= 10 {
+		return 0
+	}
+	return m.Width - 10
+}
+
+// logTail is the last n lines of LogLines clamped to logLineWidth, so they are
+// redrawn to fit after a resize
+func (m Model) logTail(n int) []string {
+	lines := m.LogLines.Tail(n)
+	for i, line := range lines {
+		lines[i] = clampLogLine(line, m.logLineWidth())
+	}
+	return lines
+}
+
+// footerFits reports whether the key hints footer fits below body without pushing
+// the top of the screen out of view; full screens go without it
+func (m Model) footerFits(body string) bool {
+	if m.Height <= 0 {
+		return true
+	}
+	// top padding + body + blank line + footer
+	return 1+lipgloss.Height(body)+2 <= m.Height
+}
+
 func (m Model) renderWelcome() string {
 	var s strings.Builder
 
 	// Logo centered over brand text
-	renderedBrand := TitleStyle.Render(brandText)
+	renderedBrand := m.Theme.Title.Render(brandText)
 	brandWidth := lipgloss.Width(renderedBrand)
-	renderedLogo := LogoStyle.Render(logo)
+	renderedLogo := m.Theme.Logo.Render(logo)
 	s.WriteString(lipgloss.PlaceHorizontal(brandWidth, lipgloss.Center, renderedLogo))
 	s.WriteString("\n")
 	s.WriteString(renderedBrand)
 	s.WriteString("\n\n")
 
 	// System info
-	info := fmt.Sprintf("Detected: %s", m.SystemInfo.OSName)
+	info := m.Tf("welcome.detected", m.SystemInfo.OSName)
 	if m.SystemInfo.IsWSL {
 		info += " (WSL)"
 	}
 	if m.SystemInfo.HasBrew {
 		info += " | Homebrew ✓"
 	}
-	s.WriteString(InfoStyle.Render(info))
+	s.WriteString(m.Theme.Info.Render(info))
 	s.WriteString("\n\n")
+	if notice := m.autoASCIINotice(); notice != "" {
+		s.WriteString(m.Theme.Muted.Render(notice))
+		s.WriteString("\n\n")
+	}
 
 	// Instructions
-	s.WriteString(SubtitleStyle.Render("Your terminal environment, configured in minutes."))
+	s.WriteString(m.Theme.Subtitle.Render(m.T("welcome.tagline")))
 	s.WriteString("\n\n")
-	s.WriteString(HelpStyle.Render("Press [Enter] to start • [Space q] to quit"))
+	if m.ResumeState != nil {
+		s.WriteString(m.Theme.Warning.Render(m.Tf("welcome.unfinished", m.ResumeState.Summary())))
+		s.WriteString("\n\n")
+		s.WriteString(m.Theme.Help.Render(m.T("welcome.help_resume")))
+	} else {
+		s.WriteString(m.Theme.Help.Render(m.T("welcome.help")))
+	}
 
 	// Center both horizontally and vertically
 	return CenterBoth(s.String(), m.Width, m.Height)
@@ -189,26 +340,74 @@ func (m Model) renderMainMenu() string {
 	var s strings.Builder
 
 	// Title
-	s.WriteString(TitleStyle.Render("🎩 Javi.Dots"))
+	s.WriteString(m.Theme.Title.Render("🎩 Javi.Dots"))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render("What would you like to do?"))
+	s.WriteString(m.Theme.Muted.Render(m.T("main_menu.prompt")))
 	s.WriteString("\n\n")
 
+	if root := paths.PortableRoot(); root != "" {
+		s.WriteString(m.Theme.Warning.Render(m.Tf("main_menu.portable", root)))
+		s.WriteString("\n\n")
+	}
+
+	// Without the welcome screen, the main menu is the first one
+	if notice := m.autoASCIINotice(); notice != "" && m.Settings.SkipWelcome {
+		s.WriteString(m.Theme.Muted.Render(notice))
+		s.WriteString("\n\n")
+	}
+
+	if m.readOnly() {
+		s.WriteString(m.Theme.Warning.Render(m.Tf("main_menu.read_only", m.lockHolderSummary())))
+		s.WriteString("\n\n")
+	}
+
 	// Options
 	options := m.GetCurrentOptions()
 	for i, opt := range options {
 		cursor := "  "
-		style := UnselectedStyle
+		style := m.Theme.Unselected
 		if i == m.Cursor {
 			cursor = "▸ "
-			style = SelectedStyle
+			style = m.Theme.Selected
 		}
-		s.WriteString(style.Render(cursor + opt))
+		if strings.HasSuffix(opt, m.T("main_menu.unsupported")) || strings.HasSuffix(opt, m.T("main_menu.locked")) {
+			style = m.Theme.Muted
+		}
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
 		s.WriteString("\n")
 	}
 
 	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("↑/k up • ↓/j down • [Enter] select • [Space q] quit"))
+	s.WriteString(m.Theme.Help.Render(m.T("help.select_quit")))
+
+	return s.String()
+}
+
+// renderUnsupportedPlatform explains what still works when installation can't run
+func (m Model) renderUnsupportedPlatform() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
+	s.WriteString("\n\n")
+
+	s.WriteString(m.Theme.Success.Render(m.T("unsupported_platform.works")))
+	s.WriteString("\n")
+	s.WriteString("  " + m.T("unsupported_platform.works_skills") + "\n")
+	s.WriteString("  " + m.T("unsupported_platform.works_learn") + "\n")
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Error.Render(m.T("unsupported_platform.not_available")))
+	s.WriteString("\n")
+	s.WriteString("  " + m.T("unsupported_platform.no_install") + "\n")
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.T("unsupported_platform.supported")))
+	s.WriteString("\n\n")
+
+	s.WriteString(m.Theme.Selected.Render("▸ " + m.GetCurrentOptions()[0]))
+	s.WriteString("\n\n")
+	s.WriteString(m.Theme.Help.Render(m.T("unsupported_platform.help")))
 
 	return s.String()
 }
@@ -221,9 +420,9 @@ func (m Model) renderSelection() string {
 	s.WriteString("\n\n")
 
 	// Title
-	s.WriteString(TitleStyle.Render(m.GetScreenTitle()))
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render(m.GetScreenDescription()))
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
 	s.WriteString("\n\n")
 
 	// Options
@@ -231,23 +430,27 @@ func (m Model) renderSelection() string {
 	for i, opt := range options {
 		// Separator line
 		if strings.HasPrefix(opt, "───") {
-			s.WriteString(MutedStyle.Render(opt))
+			s.WriteString(m.Theme.Muted.Render(opt))
 			s.WriteString("\n")
 			continue
 		}
 
 		cursor := "  "
-		style := UnselectedStyle
+		style := m.Theme.Unselected
 		if i == m.Cursor {
 			cursor = "▸ "
-			style = SelectedStyle
+			style = m.Theme.Selected
+		}
+		if strings.HasSuffix(opt, m.T("skill_menu.nothing_to_undo")) {
+			style = m.Theme.Muted
 		}
-		s.WriteString(style.Render(cursor + opt))
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
 		s.WriteString("\n")
 	}
 
 	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("↑/k up • ↓/j down • [Enter] select • [Esc] back"))
+	s.WriteString(m.Theme.Help.Render(m.T("help.select_back")))
 
 	return s.String()
 }
@@ -263,9 +466,9 @@ func (m Model) renderStepProgress() string {
 		currentIdx = 1
 	case ScreenFontSelect:
 		currentIdx = 2
-	case ScreenShellSelect:
+	case ScreenShellSelect, ScreenZshMergeSelect:
 		currentIdx = 3
-	case ScreenWMSelect:
+	case ScreenWMSelect, ScreenWMExtras:
 		currentIdx = 4
 	case ScreenNvimSelect:
 		currentIdx = 5
@@ -273,26 +476,28 @@ func (m Model) renderStepProgress() string {
 		currentIdx = 6
 	case ScreenAIToolsSelect:
 		currentIdx = 7
-	case ScreenAIFrameworkConfirm, ScreenAIFrameworkPreset, ScreenAIFrameworkCategories, ScreenAIFrameworkCategoryItems:
+	case ScreenAIFrameworkConfirm, ScreenAIFrameworkPreset, ScreenAIFrameworkCategories, ScreenAIFrameworkCategoryItems, ScreenAIFrameworkSummary:
 		currentIdx = 8
+	case ScreenWizardReview:
+		currentIdx = len(steps)
 	}
 
 	var parts []string
 	for i, step := range steps {
 		var style lipgloss.Style
 		if i < currentIdx {
-			style = StepDoneStyle
+			style = m.Theme.StepDone
 			parts = append(parts, style.Render("✓ "+step))
 		} else if i == currentIdx {
-			style = StepActiveStyle
+			style = m.Theme.StepActive
 			parts = append(parts, style.Render("● "+step))
 		} else {
-			style = StepPendingStyle
+			style = m.Theme.StepPending
 			parts = append(parts, style.Render("○ "+step))
 		}
 	}
 
-	return strings.Join(parts, MutedStyle.Render(" → "))
+	return strings.Join(parts, m.Theme.Muted.Render(" → "))
 }
 
 func (m Model) renderAIToolSelection() string {
@@ -303,9 +508,9 @@ func (m Model) renderAIToolSelection() string {
 	s.WriteString("\n\n")
 
 	// Title
-	s.WriteString(TitleStyle.Render(m.GetScreenTitle()))
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render(m.GetScreenDescription()))
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
 	s.WriteString("\n\n")
 
 	// Options with checkboxes
@@ -313,16 +518,16 @@ func (m Model) renderAIToolSelection() string {
 	for i, opt := range options {
 		// Separator line
 		if strings.HasPrefix(opt, "───") {
-			s.WriteString(MutedStyle.Render(opt))
+			s.WriteString(m.Theme.Muted.Render(opt))
 			s.WriteString("\n")
 			continue
 		}
 
 		cursor := "  "
-		style := UnselectedStyle
+		style := m.Theme.Unselected
 		if i == m.Cursor {
 			cursor = "▸ "
-			style = SelectedStyle
+			style = m.Theme.Selected
 		}
 
 		// Show checkbox for toggleable tools
@@ -330,18 +535,25 @@ func (m Model) renderAIToolSelection() string {
 		if m.AIToolSelected != nil && i < len(m.AIToolSelected) && m.AIToolSelected[i] {
 			checkbox = "[✓] "
 		}
+		if i < len(aiToolIDMap) && !m.aiToolAvailable(i) {
+			checkbox = "[-] "
+			if i != m.Cursor {
+				style = m.Theme.Muted
+			}
+		}
 
 		// "Select All" and "Confirm selection" don't get a checkbox
 		if strings.HasPrefix(opt, "✅") || strings.HasPrefix(opt, "🔘") {
 			checkbox = ""
 		}
 
-		s.WriteString(style.Render(cursor + checkbox + opt))
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+checkbox+opt))
 		s.WriteString("\n")
 	}
 
 	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("↑/k up • ↓/j down • [Enter] toggle/confirm • [Esc] back"))
+	s.WriteString(m.Theme.Help.Render(m.T("help.toggle_confirm")))
 
 	return s.String()
 }
@@ -350,9 +562,9 @@ func (m Model) renderRolePackSelection() string {
 	var s strings.Builder
 
 	// Title
-	s.WriteString(TitleStyle.Render(m.GetScreenTitle()))
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render(m.GetScreenDescription()))
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
 	s.WriteString("\n\n")
 
 	// Options with checkboxes
@@ -360,65 +572,78 @@ func (m Model) renderRolePackSelection() string {
 	for i, opt := range options {
 		// Separator line
 		if strings.HasPrefix(opt, "───") {
-			s.WriteString(MutedStyle.Render(opt))
+			s.WriteString(m.Theme.Muted.Render(opt))
 			s.WriteString("\n")
 			continue
 		}
 
 		cursor := "  "
-		style := UnselectedStyle
+		style := m.Theme.Unselected
 		if i == m.Cursor {
 			cursor = "▸ "
-			style = SelectedStyle
+			style = m.Theme.Selected
 		}
 
 		// "Confirm selection" and checkbox-prefixed items don't need extra checkbox
 		// The options already include [x]/[ ] prefixes from GetCurrentOptions()
-		s.WriteString(style.Render(cursor + opt))
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
 		s.WriteString("\n")
 	}
 
 	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("↑/k up • ↓/j down • [Enter] toggle/confirm • [Esc] back"))
+	s.WriteString(m.Theme.Help.Render(m.T("help.toggle_confirm")))
 
 	return s.String()
 }
 
 func (m Model) renderAICategoryMenu() string {
+	if m.aiModuleSearching() {
+		return m.renderAIModuleSearch()
+	}
+
 	var s strings.Builder
 
-	// Progress indicator
-	s.WriteString(m.renderStepProgress())
-	s.WriteString("\n\n")
+	// Progress indicator (not shown in apply-only mode, which skips the wizard)
+	if !m.AIFrameworkApplyMode {
+		s.WriteString(m.renderStepProgress())
+		s.WriteString("\n\n")
+	}
 
 	// Title
-	s.WriteString(TitleStyle.Render(m.GetScreenTitle()))
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render(m.GetScreenDescription()))
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
 	s.WriteString("\n\n")
 
+	if m.AIFrameworkPresetNotice != "" {
+		s.WriteString(m.Theme.Info.Render(m.AIFrameworkPresetNotice))
+		s.WriteString("\n\n")
+	}
+
 	// Category list (no checkboxes — just cursor navigation)
 	options := m.GetCurrentOptions()
 	for i, opt := range options {
 		if strings.HasPrefix(opt, "───") {
-			s.WriteString(MutedStyle.Render(opt))
+			s.WriteString(m.Theme.Muted.Render(opt))
 			s.WriteString("\n")
 			continue
 		}
 
 		cursor := "  "
-		style := UnselectedStyle
+		style := m.Theme.Unselected
 		if i == m.Cursor {
 			cursor = "▸ "
-			style = SelectedStyle
+			style = m.Theme.Selected
 		}
 
-		s.WriteString(style.Render(cursor + opt))
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
 		s.WriteString("\n")
 	}
 
 	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("↑/k up • ↓/j down • [Enter] open/confirm • [Esc] back"))
+	s.WriteString(m.Theme.Help.Render(m.T("ai_framework_categories.help")))
 
 	return s.String()
 }
@@ -426,14 +651,16 @@ func (m Model) renderAICategoryMenu() string {
 func (m Model) renderAICategoryItems() string {
 	var s strings.Builder
 
-	// Progress indicator
-	s.WriteString(m.renderStepProgress())
-	s.WriteString("\n\n")
+	// Progress indicator (not shown in apply-only mode, which skips the wizard)
+	if !m.AIFrameworkApplyMode {
+		s.WriteString(m.renderStepProgress())
+		s.WriteString("\n\n")
+	}
 
 	// Title
-	s.WriteString(TitleStyle.Render(m.GetScreenTitle()))
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render(m.GetScreenDescription()))
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
 	s.WriteString("\n\n")
 
 	if m.SelectedModuleCategory < 0 || m.SelectedModuleCategory >= len(moduleCategories) {
@@ -444,10 +671,7 @@ func (m Model) renderAICategoryItems() string {
 	entries := buildCatItemEntries(cat, bools)
 
 	// Calculate visible area: reserve lines for progress(1)+blank(1)+title(1)+desc(1)+blank(1)+scroll(1)+blank(1)+help(1) = 8
-	visibleItems := m.Height - 8
-	if visibleItems < 5 {
-		visibleItems = 5
-	}
+	visibleItems := m.visibleRows(8)
 	if visibleItems > len(entries) {
 		visibleItems = len(entries)
 	}
@@ -465,7 +689,7 @@ func (m Model) renderAICategoryItems() string {
 
 	// Show scroll-up indicator
 	if start > 0 {
-		s.WriteString(MutedStyle.Render(fmt.Sprintf("  ▲ %d more above", start)))
+		s.WriteString(m.Theme.Muted.Render("  " + m.Tf("common.more_above", start)))
 		s.WriteString("\n")
 	}
 
@@ -473,16 +697,16 @@ func (m Model) renderAICategoryItems() string {
 		entry := entries[i]
 
 		if entry.separator {
-			s.WriteString(MutedStyle.Render(entry.label))
+			s.WriteString(m.Theme.Muted.Render(entry.label))
 			s.WriteString("\n")
 			continue
 		}
 
 		cursor := "  "
-		style := UnselectedStyle
+		style := m.Theme.Unselected
 		if i == m.Cursor {
 			cursor = "▸ "
-			style = SelectedStyle
+			style = m.Theme.Selected
 		}
 
 		// Checkboxes only for regular items (not select all, group headers, or back)
@@ -495,18 +719,64 @@ func (m Model) renderAICategoryItems() string {
 			}
 		}
 
-		s.WriteString(style.Render(cursor + checkbox + entry.label))
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+checkbox+entry.label))
 		s.WriteString("\n")
 	}
 
 	// Show scroll-down indicator
 	if end < len(entries) {
-		s.WriteString(MutedStyle.Render(fmt.Sprintf("  ▼ %d more below", len(entries)-end)))
+		s.WriteString(m.Theme.Muted.Render("  " + m.Tf("common.more_below", len(entries)-end)))
+		s.WriteString("\n")
+	}
+
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render(m.T("ai_framework_category_items.help")))
+
+	return s.String()
+}
+
+func (m Model) renderAIFrameworkApplyDiff() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
+	s.WriteString("\n\n")
+
+	if len(m.AIFrameworkDiffAdded) == 0 && len(m.AIFrameworkDiffRemoved) == 0 {
+		s.WriteString(m.Theme.Info.Render(m.T("ai_framework_apply_diff.no_changes")))
+		s.WriteString("\n\n")
+	} else {
+		for _, key := range m.AIFrameworkDiffAdded {
+			s.WriteString(m.Theme.Success.Render("  + " + frameworkItemLabel(key)))
+			s.WriteString("\n")
+		}
+		for _, key := range m.AIFrameworkDiffRemoved {
+			s.WriteString(m.Theme.Error.Render("  - " + frameworkItemLabel(key)))
+			s.WriteString("\n")
+		}
+		s.WriteString("\n")
+	}
+
+	options := m.GetCurrentOptions()
+	for i, opt := range options {
+		cursor := "  "
+		style := m.Theme.Unselected
+		if i == m.Cursor {
+			cursor = "▸ "
+			style = m.Theme.Selected
+		}
+		if strings.HasSuffix(opt, m.T("skill_menu.nothing_to_undo")) {
+			style = m.Theme.Muted
+		}
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
 		s.WriteString("\n")
 	}
 
 	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("↑/k up • ↓/j down • [a] select all • [Enter] toggle/back • [Esc] back"))
+	s.WriteString(m.Theme.Help.Render(m.T("help.select_back")))
 
 	return s.String()
 }
@@ -514,9 +784,9 @@ func (m Model) renderAICategoryItems() string {
 func (m Model) renderLearnTerminals() string {
 	var s strings.Builder
 
-	s.WriteString(TitleStyle.Render(m.GetScreenTitle()))
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render("Select a terminal to learn more about it"))
+	s.WriteString(m.Theme.Muted.Render(m.T("learn_terminals.desc")))
 	s.WriteString("\n\n")
 
 	// If viewing a specific tool, show its info
@@ -528,23 +798,27 @@ func (m Model) renderLearnTerminals() string {
 	options := m.GetCurrentOptions()
 	for i, opt := range options {
 		if strings.HasPrefix(opt, "───") {
-			s.WriteString(MutedStyle.Render(opt))
+			s.WriteString(m.Theme.Muted.Render(opt))
 			s.WriteString("\n")
 			continue
 		}
 
 		cursor := "  "
-		style := UnselectedStyle
+		style := m.Theme.Unselected
 		if i == m.Cursor {
 			cursor = "▸ "
-			style = SelectedStyle
+			style = m.Theme.Selected
+		}
+		if strings.HasSuffix(opt, m.T("skill_menu.nothing_to_undo")) {
+			style = m.Theme.Muted
 		}
-		s.WriteString(style.Render(cursor + opt))
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
 		s.WriteString("\n")
 	}
 
 	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("↑/k up • ↓/j down • [Enter] select • [Esc] back"))
+	s.WriteString(m.Theme.Help.Render(m.T("help.select_back")))
 
 	return s.String()
 }
@@ -552,9 +826,9 @@ func (m Model) renderLearnTerminals() string {
 func (m Model) renderLearnShells() string {
 	var s strings.Builder
 
-	s.WriteString(TitleStyle.Render(m.GetScreenTitle()))
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render("Select a shell to learn more about it"))
+	s.WriteString(m.Theme.Muted.Render(m.T("learn_shells.desc")))
 	s.WriteString("\n\n")
 
 	// If viewing a specific tool, show its info
@@ -566,23 +840,27 @@ func (m Model) renderLearnShells() string {
 	options := m.GetCurrentOptions()
 	for i, opt := range options {
 		if strings.HasPrefix(opt, "───") {
-			s.WriteString(MutedStyle.Render(opt))
+			s.WriteString(m.Theme.Muted.Render(opt))
 			s.WriteString("\n")
 			continue
 		}
 
 		cursor := "  "
-		style := UnselectedStyle
+		style := m.Theme.Unselected
 		if i == m.Cursor {
 			cursor = "▸ "
-			style = SelectedStyle
+			style = m.Theme.Selected
 		}
-		s.WriteString(style.Render(cursor + opt))
+		if strings.HasSuffix(opt, m.T("skill_menu.nothing_to_undo")) {
+			style = m.Theme.Muted
+		}
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
 		s.WriteString("\n")
 	}
 
 	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("↑/k up • ↓/j down • [Enter] select • [Esc] back"))
+	s.WriteString(m.Theme.Help.Render(m.T("help.select_back")))
 
 	return s.String()
 }
@@ -590,9 +868,9 @@ func (m Model) renderLearnShells() string {
 func (m Model) renderLearnWM() string {
 	var s strings.Builder
 
-	s.WriteString(TitleStyle.Render(m.GetScreenTitle()))
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render("Select a window manager to learn more about it"))
+	s.WriteString(m.Theme.Muted.Render(m.T("learn_wm.desc")))
 	s.WriteString("\n\n")
 
 	// If viewing a specific tool, show its info
@@ -604,23 +882,27 @@ func (m Model) renderLearnWM() string {
 	options := m.GetCurrentOptions()
 	for i, opt := range options {
 		if strings.HasPrefix(opt, "───") {
-			s.WriteString(MutedStyle.Render(opt))
+			s.WriteString(m.Theme.Muted.Render(opt))
 			s.WriteString("\n")
 			continue
 		}
 
 		cursor := "  "
-		style := UnselectedStyle
+		style := m.Theme.Unselected
 		if i == m.Cursor {
 			cursor = "▸ "
-			style = SelectedStyle
+			style = m.Theme.Selected
+		}
+		if strings.HasSuffix(opt, m.T("skill_menu.nothing_to_undo")) {
+			style = m.Theme.Muted
 		}
-		s.WriteString(style.Render(cursor + opt))
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
 		s.WriteString("\n")
 	}
 
 	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("↑/k up • ↓/j down • [Enter] select • [Esc] back"))
+	s.WriteString(m.Theme.Help.Render(m.T("help.select_back")))
 
 	return s.String()
 }
@@ -628,9 +910,9 @@ func (m Model) renderLearnWM() string {
 func (m Model) renderLearnNvim() string {
 	var s strings.Builder
 
-	s.WriteString(TitleStyle.Render(m.GetScreenTitle()))
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render("Explore Neovim features and keybindings"))
+	s.WriteString(m.Theme.Muted.Render(m.T("learn_nvim.desc")))
 	s.WriteString("\n\n")
 
 	// If viewing features, show Nvim info
@@ -643,23 +925,27 @@ func (m Model) renderLearnNvim() string {
 	options := m.GetCurrentOptions()
 	for i, opt := range options {
 		if strings.HasPrefix(opt, "───") {
-			s.WriteString(MutedStyle.Render(opt))
+			s.WriteString(m.Theme.Muted.Render(opt))
 			s.WriteString("\n")
 			continue
 		}
 
 		cursor := "  "
-		style := UnselectedStyle
+		style := m.Theme.Unselected
 		if i == m.Cursor {
 			cursor = "▸ "
-			style = SelectedStyle
+			style = m.Theme.Selected
 		}
-		s.WriteString(style.Render(cursor + opt))
+		if strings.HasSuffix(opt, m.T("skill_menu.nothing_to_undo")) {
+			style = m.Theme.Muted
+		}
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
 		s.WriteString("\n")
 	}
 
 	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("↑/k up • ↓/j down • [Enter] select • [Esc] back"))
+	s.WriteString(m.Theme.Help.Render(m.T("help.select_back")))
 
 	return s.String()
 }
@@ -669,7 +955,7 @@ func (m Model) renderToolInfo(tools map[string]ToolInfo, toolKey string, categor
 
 	info, exists := tools[toolKey]
 	if !exists {
-		s.WriteString(ErrorStyle.Render("Tool not found"))
+		s.WriteString(m.Theme.Error.Render(m.T("tool_info.not_found")))
 		return s.String()
 	}
 
@@ -680,66 +966,71 @@ func (m Model) renderSingleToolInfo(info ToolInfo) string {
 	var s strings.Builder
 
 	// Tool name and description
-	s.WriteString(TitleStyle.Render(info.Name))
+	s.WriteString(m.Theme.Title.Render(info.Name))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render(info.Description))
+	s.WriteString(m.Theme.Muted.Render(info.Description))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render(info.Website))
+	s.WriteString(m.Theme.Muted.Render(info.Website))
 	s.WriteString("\n\n")
 
 	// Pros
-	s.WriteString(SuccessStyle.Render("✓ Pros"))
+	s.WriteString(m.Theme.Success.Render(m.T("tool_info.pros")))
 	s.WriteString("\n")
 	for _, pro := range info.Pros {
-		s.WriteString(InfoStyle.Render("  • " + pro))
+		s.WriteString(m.Theme.Info.Render("  • " + pro))
 		s.WriteString("\n")
 	}
 
 	s.WriteString("\n")
 
 	// Cons
-	s.WriteString(WarningStyle.Render("✗ Cons"))
+	s.WriteString(m.Theme.Warning.Render(m.T("tool_info.cons")))
 	s.WriteString("\n")
 	for _, con := range info.Cons {
-		s.WriteString(MutedStyle.Render("  • " + con))
+		s.WriteString(m.Theme.Muted.Render("  • " + con))
 		s.WriteString("\n")
 	}
 
 	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("↑/k up • ↓/j down • [Enter] select • [Esc] back • [Space q] quit"))
+	s.WriteString(m.Theme.Help.Render(m.T("help.select_back_quit")))
 
 	return s.String()
 }
 
 func (m Model) renderKeymapsMenu() string {
+	if m.keymapSearching() {
+		return m.renderKeymapSearch()
+	}
+
 	var s strings.Builder
 
-	s.WriteString(TitleStyle.Render(m.GetScreenTitle()))
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render("Select a category to view keybindings"))
+	s.WriteString(m.Theme.Muted.Render(m.T("keymaps.desc")))
 	s.WriteString("\n\n")
 
 	// Menu
 	options := m.GetCurrentOptions()
 	for i, opt := range options {
 		if strings.HasPrefix(opt, "───") {
-			s.WriteString(MutedStyle.Render(opt))
+			s.WriteString(m.Theme.Muted.Render(opt))
 			s.WriteString("\n")
 			continue
 		}
 
 		cursor := "  "
-		style := UnselectedStyle
+		style := m.Theme.Unselected
 		if i == m.Cursor {
 			cursor = "▸ "
-			style = SelectedStyle
+			style = m.Theme.Selected
 		}
-		s.WriteString(style.Render(cursor + opt))
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
 		s.WriteString("\n")
 	}
 
 	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("↑/k up • ↓/j down • [Enter] select • [Esc/q] back"))
+	s.WriteString(m.Theme.Help.Render(m.T("help.select_search")))
 
 	return s.String()
 }
@@ -748,29 +1039,26 @@ func (m Model) renderKeymapCategory() string {
 	var s strings.Builder
 
 	if m.SelectedCategory >= len(m.KeymapCategories) {
-		return ErrorStyle.Render("Category not found")
+		return m.Theme.Error.Render(m.T("common.category_not_found"))
 	}
 
 	category := m.KeymapCategories[m.SelectedCategory]
 
-	s.WriteString(TitleStyle.Render(category.Name))
+	s.WriteString(m.Theme.Title.Render(category.Name))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render(category.Description))
+	s.WriteString(m.Theme.Muted.Render(category.Description))
 	s.WriteString("\n\n")
 
 	// Table header
-	header := fmt.Sprintf("%-15s %-6s %s", "Keys", "Mode", "Description")
-	s.WriteString(SubtitleStyle.Render(header))
+	header := fmt.Sprintf("%-15s %-6s %s", m.T("keymap_category.keys"), m.T("keymap_category.mode"), m.T("keymap_category.description"))
+	s.WriteString(m.Theme.Subtitle.Render(header))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render(strings.Repeat("─", 60)))
+	s.WriteString(m.Theme.Muted.Render(strings.Repeat("─", 60)))
 	s.WriteString("\n")
 
 	// Calculate visible items based on terminal height
 	// Reserve space for: title(1) + description(1) + blank(1) + header(1) + separator(1) + scroll info(2) + help(2) = 9 lines
-	visibleItems := m.Height - 9
-	if visibleItems < 5 {
-		visibleItems = 5 // Minimum 5 items
-	}
+	visibleItems := m.visibleRows(9)
 	if visibleItems > len(category.Keymaps) {
 		visibleItems = len(category.Keymaps)
 	}
@@ -788,21 +1076,22 @@ func (m Model) renderKeymapCategory() string {
 
 	for i := start; i < end; i++ {
 		km := category.Keymaps[i]
-		s.WriteString(KeyStyle.Render(km.Keys))
-		s.WriteString(MutedStyle.Render(fmt.Sprintf(" %-6s ", km.Mode)))
-		s.WriteString(InfoStyle.Render(km.Description))
+		s.WriteString(m.Theme.Key.Render(km.Keys))
+		s.WriteString(m.Theme.Muted.Render(fmt.Sprintf(" %-6s ", km.Mode)))
+		s.WriteString(m.Theme.Info.Render(km.Description))
+		s.WriteString(m.renderKeymapConfig(km))
 		s.WriteString("\n")
 	}
 
 	// Scroll indicator
 	if len(category.Keymaps) > visibleItems {
 		s.WriteString("\n")
-		scrollInfo := fmt.Sprintf("Showing %d-%d of %d", start+1, end, len(category.Keymaps))
-		s.WriteString(MutedStyle.Render(scrollInfo))
+		scrollInfo := m.Tf("common.showing", start+1, end, len(category.Keymaps))
+		s.WriteString(m.Theme.Muted.Render(scrollInfo))
 	}
 
 	s.WriteString("\n\n")
-	s.WriteString(HelpStyle.Render("↑/k up • ↓/j down • [Enter/Esc/q] back"))
+	s.WriteString(m.Theme.Help.Render(m.T("help.back")))
 
 	return s.String()
 }
@@ -811,66 +1100,93 @@ func (m Model) renderKeymapCategory() string {
 func (m Model) renderToolKeymapsMenu() string {
 	var s strings.Builder
 
-	s.WriteString(TitleStyle.Render(m.GetScreenTitle()))
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render("Select a tool to view its keybindings"))
+	s.WriteString(m.Theme.Muted.Render(m.T("keymaps_menu.desc")))
 	s.WriteString("\n\n")
 
 	// Menu
 	options := m.GetCurrentOptions()
 	for i, opt := range options {
 		if strings.HasPrefix(opt, "───") {
-			s.WriteString(MutedStyle.Render(opt))
+			s.WriteString(m.Theme.Muted.Render(opt))
 			s.WriteString("\n")
 			continue
 		}
 
 		cursor := "  "
-		style := UnselectedStyle
+		style := m.Theme.Unselected
 		if i == m.Cursor {
 			cursor = "▸ "
-			style = SelectedStyle
+			style = m.Theme.Selected
 		}
-		s.WriteString(style.Render(cursor + opt))
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
+		s.WriteString("\n")
+	}
+
+	// Outcome of the last refresh from the installed configs
+	if len(m.KeymapRefreshNotes) > 0 {
 		s.WriteString("\n")
+		for _, note := range m.KeymapRefreshNotes {
+			style := m.Theme.Muted
+			if strings.HasPrefix(note, "⚠") {
+				style = m.Theme.Warning
+			}
+			s.WriteString(style.Render(note))
+			s.WriteString("\n")
+		}
 	}
 
 	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("↑/k up • ↓/j down • [Enter] select • [Esc/q] back"))
+	s.WriteString(m.Theme.Help.Render(m.T("help.select_back_q")))
 
 	return s.String()
 }
 
+// renderKeymapConfig notes how the user's config differs from a documented keymap
+func (m Model) renderKeymapConfig(km Keymap) string {
+	if km.Config == "" {
+		return ""
+	}
+	return m.Theme.Warning.Render("  ≠ " + km.Config)
+}
+
 // renderTmuxKeymapsMenu renders the Tmux keymap categories menu
 func (m Model) renderTmuxKeymapsMenu() string {
+	if m.keymapSearching() {
+		return m.renderKeymapSearch()
+	}
+
 	var s strings.Builder
 
-	s.WriteString(TitleStyle.Render(m.GetScreenTitle()))
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render("Select a category to view Tmux keybindings"))
+	s.WriteString(m.Theme.Muted.Render(m.T("keymaps_tmux.desc")))
 	s.WriteString("\n\n")
 
 	// Menu
 	options := m.GetCurrentOptions()
 	for i, opt := range options {
 		if strings.HasPrefix(opt, "───") {
-			s.WriteString(MutedStyle.Render(opt))
+			s.WriteString(m.Theme.Muted.Render(opt))
 			s.WriteString("\n")
 			continue
 		}
 
 		cursor := "  "
-		style := UnselectedStyle
+		style := m.Theme.Unselected
 		if i == m.Cursor {
 			cursor = "▸ "
-			style = SelectedStyle
+			style = m.Theme.Selected
 		}
-		s.WriteString(style.Render(cursor + opt))
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
 		s.WriteString("\n")
 	}
 
 	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("↑/k up • ↓/j down • [Enter] select • [Esc/q] back"))
+	s.WriteString(m.Theme.Help.Render(m.T("help.select_search")))
 
 	return s.String()
 }
@@ -880,28 +1196,25 @@ func (m Model) renderTmuxKeymapCategory() string {
 	var s strings.Builder
 
 	if m.TmuxSelectedCategory >= len(m.TmuxKeymapCategories) {
-		return ErrorStyle.Render("Category not found")
+		return m.Theme.Error.Render(m.T("common.category_not_found"))
 	}
 
 	category := m.TmuxKeymapCategories[m.TmuxSelectedCategory]
 
-	s.WriteString(TitleStyle.Render(category.Name))
+	s.WriteString(m.Theme.Title.Render(category.Name))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render(category.Description))
+	s.WriteString(m.Theme.Muted.Render(category.Description))
 	s.WriteString("\n\n")
 
 	// Table header
-	header := fmt.Sprintf("%-20s %-6s %s", "Keys", "Mode", "Description")
-	s.WriteString(SubtitleStyle.Render(header))
+	header := fmt.Sprintf("%-20s %-6s %s", m.T("keymap_category.keys"), m.T("keymap_category.mode"), m.T("keymap_category.description"))
+	s.WriteString(m.Theme.Subtitle.Render(header))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render(strings.Repeat("─", 60)))
+	s.WriteString(m.Theme.Muted.Render(strings.Repeat("─", 60)))
 	s.WriteString("\n")
 
 	// Calculate visible items
-	visibleItems := m.Height - 9
-	if visibleItems < 5 {
-		visibleItems = 5
-	}
+	visibleItems := m.visibleRows(9)
 	if visibleItems > len(category.Keymaps) {
 		visibleItems = len(category.Keymaps)
 	}
@@ -919,55 +1232,61 @@ func (m Model) renderTmuxKeymapCategory() string {
 
 	for i := start; i < end; i++ {
 		km := category.Keymaps[i]
-		s.WriteString(KeyStyle.Render(km.Keys))
-		s.WriteString(MutedStyle.Render(fmt.Sprintf(" %-6s ", km.Mode)))
-		s.WriteString(InfoStyle.Render(km.Description))
+		s.WriteString(m.Theme.Key.Render(km.Keys))
+		s.WriteString(m.Theme.Muted.Render(fmt.Sprintf(" %-6s ", km.Mode)))
+		s.WriteString(m.Theme.Info.Render(km.Description))
+		s.WriteString(m.renderKeymapConfig(km))
 		s.WriteString("\n")
 	}
 
 	// Scroll indicator
 	if len(category.Keymaps) > visibleItems {
 		s.WriteString("\n")
-		scrollInfo := fmt.Sprintf("Showing %d-%d of %d", start+1, end, len(category.Keymaps))
-		s.WriteString(MutedStyle.Render(scrollInfo))
+		scrollInfo := m.Tf("common.showing", start+1, end, len(category.Keymaps))
+		s.WriteString(m.Theme.Muted.Render(scrollInfo))
 	}
 
 	s.WriteString("\n\n")
-	s.WriteString(HelpStyle.Render("↑/k up • ↓/j down • [Enter/Esc/q] back"))
+	s.WriteString(m.Theme.Help.Render(m.T("help.back")))
 
 	return s.String()
 }
 
 // renderZellijKeymapsMenu renders the Zellij keymap categories menu
 func (m Model) renderZellijKeymapsMenu() string {
+	if m.keymapSearching() {
+		return m.renderKeymapSearch()
+	}
+
 	var s strings.Builder
 
-	s.WriteString(TitleStyle.Render(m.GetScreenTitle()))
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render("Select a category to view Zellij keybindings"))
+	s.WriteString(m.Theme.Muted.Render(m.T("keymaps_zellij.desc")))
 	s.WriteString("\n\n")
 
 	// Menu
 	options := m.GetCurrentOptions()
 	for i, opt := range options {
 		if strings.HasPrefix(opt, "───") {
-			s.WriteString(MutedStyle.Render(opt))
+			s.WriteString(m.Theme.Muted.Render(opt))
 			s.WriteString("\n")
 			continue
 		}
 
 		cursor := "  "
-		style := UnselectedStyle
+		style := m.Theme.Unselected
 		if i == m.Cursor {
 			cursor = "▸ "
-			style = SelectedStyle
+			style = m.Theme.Selected
 		}
-		s.WriteString(style.Render(cursor + opt))
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
 		s.WriteString("\n")
 	}
 
 	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("↑/k up • ↓/j down • [Enter] select • [Esc/q] back"))
+	s.WriteString(m.Theme.Help.Render(m.T("help.select_search")))
 
 	return s.String()
 }
@@ -977,28 +1296,25 @@ func (m Model) renderZellijKeymapCategory() string {
 	var s strings.Builder
 
 	if m.ZellijSelectedCategory >= len(m.ZellijKeymapCategories) {
-		return ErrorStyle.Render("Category not found")
+		return m.Theme.Error.Render(m.T("common.category_not_found"))
 	}
 
 	category := m.ZellijKeymapCategories[m.ZellijSelectedCategory]
 
-	s.WriteString(TitleStyle.Render(category.Name))
+	s.WriteString(m.Theme.Title.Render(category.Name))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render(category.Description))
+	s.WriteString(m.Theme.Muted.Render(category.Description))
 	s.WriteString("\n\n")
 
 	// Table header
-	header := fmt.Sprintf("%-15s %-8s %s", "Keys", "Mode", "Description")
-	s.WriteString(SubtitleStyle.Render(header))
+	header := fmt.Sprintf("%-15s %-8s %s", m.T("keymap_category.keys"), m.T("keymap_category.mode"), m.T("keymap_category.description"))
+	s.WriteString(m.Theme.Subtitle.Render(header))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render(strings.Repeat("─", 60)))
+	s.WriteString(m.Theme.Muted.Render(strings.Repeat("─", 60)))
 	s.WriteString("\n")
 
 	// Calculate visible items
-	visibleItems := m.Height - 9
-	if visibleItems < 5 {
-		visibleItems = 5
-	}
+	visibleItems := m.visibleRows(9)
 	if visibleItems > len(category.Keymaps) {
 		visibleItems = len(category.Keymaps)
 	}
@@ -1016,55 +1332,61 @@ func (m Model) renderZellijKeymapCategory() string {
 
 	for i := start; i < end; i++ {
 		km := category.Keymaps[i]
-		s.WriteString(KeyStyle.Render(km.Keys))
-		s.WriteString(MutedStyle.Render(fmt.Sprintf(" %-8s ", km.Mode)))
-		s.WriteString(InfoStyle.Render(km.Description))
+		s.WriteString(m.Theme.Key.Render(km.Keys))
+		s.WriteString(m.Theme.Muted.Render(fmt.Sprintf(" %-8s ", km.Mode)))
+		s.WriteString(m.Theme.Info.Render(km.Description))
+		s.WriteString(m.renderKeymapConfig(km))
 		s.WriteString("\n")
 	}
 
 	// Scroll indicator
 	if len(category.Keymaps) > visibleItems {
 		s.WriteString("\n")
-		scrollInfo := fmt.Sprintf("Showing %d-%d of %d", start+1, end, len(category.Keymaps))
-		s.WriteString(MutedStyle.Render(scrollInfo))
+		scrollInfo := m.Tf("common.showing", start+1, end, len(category.Keymaps))
+		s.WriteString(m.Theme.Muted.Render(scrollInfo))
 	}
 
 	s.WriteString("\n\n")
-	s.WriteString(HelpStyle.Render("↑/k up • ↓/j down • [Enter/Esc/q] back"))
+	s.WriteString(m.Theme.Help.Render(m.T("help.back")))
 
 	return s.String()
 }
 
 // renderGhosttyKeymapsMenu renders the Ghostty keymap categories menu
 func (m Model) renderGhosttyKeymapsMenu() string {
+	if m.keymapSearching() {
+		return m.renderKeymapSearch()
+	}
+
 	var s strings.Builder
 
-	s.WriteString(TitleStyle.Render(m.GetScreenTitle()))
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render("Select a category to view Ghostty keybindings"))
+	s.WriteString(m.Theme.Muted.Render(m.T("keymaps_ghostty.desc")))
 	s.WriteString("\n\n")
 
 	// Menu
 	options := m.GetCurrentOptions()
 	for i, opt := range options {
 		if strings.HasPrefix(opt, "───") {
-			s.WriteString(MutedStyle.Render(opt))
+			s.WriteString(m.Theme.Muted.Render(opt))
 			s.WriteString("\n")
 			continue
 		}
 
 		cursor := "  "
-		style := UnselectedStyle
+		style := m.Theme.Unselected
 		if i == m.Cursor {
 			cursor = "▸ "
-			style = SelectedStyle
+			style = m.Theme.Selected
 		}
-		s.WriteString(style.Render(cursor + opt))
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
 		s.WriteString("\n")
 	}
 
 	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("↑/k up • ↓/j down • [Enter] select • [Esc/q] back"))
+	s.WriteString(m.Theme.Help.Render(m.T("help.select_search")))
 
 	return s.String()
 }
@@ -1074,28 +1396,25 @@ func (m Model) renderGhosttyKeymapCategory() string {
 	var s strings.Builder
 
 	if m.GhosttySelectedCategory >= len(m.GhosttyKeymapCategories) {
-		return ErrorStyle.Render("Category not found")
+		return m.Theme.Error.Render(m.T("common.category_not_found"))
 	}
 
 	category := m.GhosttyKeymapCategories[m.GhosttySelectedCategory]
 
-	s.WriteString(TitleStyle.Render(category.Name))
+	s.WriteString(m.Theme.Title.Render(category.Name))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render(category.Description))
+	s.WriteString(m.Theme.Muted.Render(category.Description))
 	s.WriteString("\n\n")
 
 	// Table header
-	header := fmt.Sprintf("%-18s %-6s %s", "Keys", "Mode", "Description")
-	s.WriteString(SubtitleStyle.Render(header))
+	header := fmt.Sprintf("%-18s %-6s %s", m.T("keymap_category.keys"), m.T("keymap_category.mode"), m.T("keymap_category.description"))
+	s.WriteString(m.Theme.Subtitle.Render(header))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render(strings.Repeat("─", 60)))
+	s.WriteString(m.Theme.Muted.Render(strings.Repeat("─", 60)))
 	s.WriteString("\n")
 
 	// Calculate visible items
-	visibleItems := m.Height - 9
-	if visibleItems < 5 {
-		visibleItems = 5
-	}
+	visibleItems := m.visibleRows(9)
 	if visibleItems > len(category.Keymaps) {
 		visibleItems = len(category.Keymaps)
 	}
@@ -1113,21 +1432,22 @@ func (m Model) renderGhosttyKeymapCategory() string {
 
 	for i := start; i < end; i++ {
 		km := category.Keymaps[i]
-		s.WriteString(KeyStyle.Render(km.Keys))
-		s.WriteString(MutedStyle.Render(fmt.Sprintf(" %-6s ", km.Mode)))
-		s.WriteString(InfoStyle.Render(km.Description))
+		s.WriteString(m.Theme.Key.Render(km.Keys))
+		s.WriteString(m.Theme.Muted.Render(fmt.Sprintf(" %-6s ", km.Mode)))
+		s.WriteString(m.Theme.Info.Render(km.Description))
+		s.WriteString(m.renderKeymapConfig(km))
 		s.WriteString("\n")
 	}
 
 	// Scroll indicator
 	if len(category.Keymaps) > visibleItems {
 		s.WriteString("\n")
-		scrollInfo := fmt.Sprintf("Showing %d-%d of %d", start+1, end, len(category.Keymaps))
-		s.WriteString(MutedStyle.Render(scrollInfo))
+		scrollInfo := m.Tf("common.showing", start+1, end, len(category.Keymaps))
+		s.WriteString(m.Theme.Muted.Render(scrollInfo))
 	}
 
 	s.WriteString("\n\n")
-	s.WriteString(HelpStyle.Render("↑/k up • ↓/j down • [Enter/Esc/q] back"))
+	s.WriteString(m.Theme.Help.Render(m.T("help.back")))
 
 	return s.String()
 }
@@ -1135,32 +1455,33 @@ func (m Model) renderGhosttyKeymapCategory() string {
 func (m Model) renderLazyVimMenu() string {
 	var s strings.Builder
 
-	s.WriteString(TitleStyle.Render(m.GetScreenTitle()))
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render("Learn how to use and customize LazyVim"))
+	s.WriteString(m.Theme.Muted.Render(m.T("learn_lazy_vim.desc")))
 	s.WriteString("\n\n")
 
 	// Menu
 	options := m.GetCurrentOptions()
 	for i, opt := range options {
 		if strings.HasPrefix(opt, "───") {
-			s.WriteString(MutedStyle.Render(opt))
+			s.WriteString(m.Theme.Muted.Render(opt))
 			s.WriteString("\n")
 			continue
 		}
 
 		cursor := "  "
-		style := UnselectedStyle
+		style := m.Theme.Unselected
 		if i == m.Cursor {
 			cursor = "▸ "
-			style = SelectedStyle
+			style = m.Theme.Selected
 		}
-		s.WriteString(style.Render(cursor + opt))
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
 		s.WriteString("\n")
 	}
 
 	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("↑/k up • ↓/j down • [Enter] select • [Esc/q] back"))
+	s.WriteString(m.Theme.Help.Render(m.T("help.select_back_q")))
 
 	return s.String()
 }
@@ -1169,14 +1490,14 @@ func (m Model) renderLazyVimTopic() string {
 	var s strings.Builder
 
 	if m.SelectedLazyVimTopic >= len(m.LazyVimTopics) {
-		return ErrorStyle.Render("Topic not found")
+		return m.Theme.Error.Render(m.T("lazy_vim_topic.not_found"))
 	}
 
 	topic := m.LazyVimTopics[m.SelectedLazyVimTopic]
 
-	s.WriteString(TitleStyle.Render(topic.Title))
+	s.WriteString(m.Theme.Title.Render(topic.Title))
 	s.WriteString("\n")
-	s.WriteString(SubtitleStyle.Render(topic.Description))
+	s.WriteString(m.Theme.Subtitle.Render(topic.Description))
 	s.WriteString("\n\n")
 
 	// Build all content
@@ -1205,10 +1526,7 @@ func (m Model) renderLazyVimTopic() string {
 
 	// Calculate view height based on terminal size
 	// Reserve space for: title(1) + description(1) + blank(2) + scroll info(2) + help(2) = 8 lines
-	viewHeight := m.Height - 8
-	if viewHeight < 10 {
-		viewHeight = 10 // Minimum
-	}
+	viewHeight := m.visibleRows(8)
 
 	// Apply scrolling
 	start := m.LazyVimScroll
@@ -1228,15 +1546,15 @@ func (m Model) renderLazyVimTopic() string {
 			strings.HasPrefix(line, "}") || strings.HasPrefix(line, "  ") ||
 			strings.HasPrefix(line, "map(") || strings.HasPrefix(line, "vim.") ||
 			strings.HasPrefix(line, "require") {
-			s.WriteString(CodeStyle.Render(line))
+			s.WriteString(m.Theme.Code.Render(line))
 		} else if strings.HasPrefix(line, "📝") || strings.HasPrefix(line, "💡") {
-			s.WriteString(SubtitleStyle.Render(line))
+			s.WriteString(m.Theme.Subtitle.Render(line))
 		} else if strings.HasPrefix(line, "  •") {
-			s.WriteString(InfoStyle.Render(line))
+			s.WriteString(m.Theme.Info.Render(line))
 		} else if strings.HasPrefix(line, "•") {
-			s.WriteString(MutedStyle.Render(line))
+			s.WriteString(m.Theme.Muted.Render(line))
 		} else {
-			s.WriteString(InfoStyle.Render(line))
+			s.WriteString(m.Theme.Info.Render(line))
 		}
 		s.WriteString("\n")
 	}
@@ -1244,12 +1562,12 @@ func (m Model) renderLazyVimTopic() string {
 	// Scroll indicator
 	if len(allLines) > viewHeight {
 		s.WriteString("\n")
-		scrollInfo := fmt.Sprintf("Lines %d-%d of %d (↑↓ to scroll, PgUp/PgDn for fast scroll)", start+1, end, len(allLines))
-		s.WriteString(MutedStyle.Render(scrollInfo))
+		scrollInfo := m.Tf("common.lines_scroll", start+1, end, len(allLines))
+		s.WriteString(m.Theme.Muted.Render(scrollInfo))
 	}
 
 	s.WriteString("\n\n")
-	s.WriteString(HelpStyle.Render("↑/k up • ↓/j down • PgUp/PgDn • [Enter/Esc/q] back"))
+	s.WriteString(m.Theme.Help.Render(m.T("help.page_back")))
 
 	return s.String()
 }
@@ -1260,52 +1578,64 @@ var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "
 func (m Model) renderInstalling() string {
 	var s strings.Builder
 
-	s.WriteString(TitleStyle.Render("🚀 Installing Javi.Dots"))
+	s.WriteString(m.Theme.Title.Render(m.T("installing.heading")))
 	s.WriteString("\n\n")
 
+	if m.ShowInstallLog {
+		s.WriteString(m.renderInstallLog())
+		return s.String()
+	}
+
 	// Progress steps
-	for i, step := range m.Steps {
+	for _, step := range m.Steps {
 		var icon string
 		var style lipgloss.Style
 
 		switch step.Status {
 		case StatusPending:
 			icon = "○"
-			style = MutedStyle
+			style = m.Theme.Muted
 		case StatusRunning:
 			// Animated spinner
 			icon = spinnerFrames[m.SpinnerFrame%len(spinnerFrames)]
-			style = WarningStyle
+			style = m.Theme.Warning
 		case StatusDone:
 			icon = "✓"
-			style = SuccessStyle
+			style = m.Theme.Success
 		case StatusFailed:
 			icon = "✗"
-			style = ErrorStyle
+			style = m.Theme.Error
 		case StatusSkipped:
 			icon = "⊘"
-			style = MutedStyle
+			style = m.Theme.Muted
 		}
 
 		line := fmt.Sprintf("%s %s", icon, step.Name)
 		s.WriteString(style.Render(line))
+		if step.Status == StatusDone || step.Status == StatusFailed {
+			s.WriteString(m.Theme.Muted.Render("  " + formatElapsed(step.Elapsed)))
+		}
 		s.WriteString("\n")
 
-		// Show current step description
-		if i == m.CurrentStep && step.Status == StatusRunning {
-			s.WriteString(MutedStyle.Render("   " + step.Description))
+		// Show what the running steps do; several may run at once
+		if step.Status == StatusRunning {
+			s.WriteString(m.Theme.Muted.Render("   " + step.Description))
 			s.WriteString("\n")
+			if step.Progress > 0 && step.Progress < 1 {
+				s.WriteString(m.Theme.Info.Render("   " + progressBar(step.Progress, 30)))
+				s.WriteString("\n")
+			}
 		}
 	}
 
 	// Log output if details enabled
-	if m.ShowDetails && len(m.LogLines) > 0 {
+	if m.ShowDetails && m.LogLines.Len() > 0 {
 		s.WriteString("\n")
-		s.WriteString(BoxStyle.Render(strings.Join(m.LogLines[max(0, len(m.LogLines)-10):], "\n")))
+		s.WriteString(m.Theme.Box.Render(strings.Join(m.logTail(10), "\n")))
 	}
 
 	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("[space+d] toggle details"))
+	s.WriteString(m.Theme.Help.Render(m.T("installing.help")))
 
 	return s.String()
 }
@@ -1313,29 +1643,56 @@ func (m Model) renderInstalling() string {
 func (m Model) renderComplete() string {
 	var s strings.Builder
 
-	s.WriteString(SuccessStyle.Render("✨ Installation Complete! ✨"))
+	if m.UpdateMode {
+		return m.renderUpdateComplete()
+	}
+
+	if m.AIFrameworkApplyMode {
+		s.WriteString(m.Theme.Success.Render(m.T("complete.framework_updated")))
+		s.WriteString("\n\n")
+		s.WriteString(m.Theme.Info.Render("  • " + m.Tf("complete.framework_added", len(m.AIFrameworkDiffAdded))))
+		s.WriteString("\n")
+		s.WriteString(m.Theme.Info.Render("  • " + m.Tf("complete.framework_removed", len(m.AIFrameworkDiffRemoved))))
+		s.WriteString("\n\n")
+		s.WriteString(m.Theme.Help.Render(m.T("help.exit")))
+		return s.String()
+	}
+
+	s.WriteString(m.Theme.Success.Render(m.T("complete.heading")))
 	s.WriteString("\n\n")
 
 	// Summary
-	s.WriteString(TitleStyle.Render("Summary"))
+	s.WriteString(m.Theme.Title.Render(m.T("complete.summary")))
 	s.WriteString("\n")
 
 	items := []string{
-		fmt.Sprintf("OS: %s", m.Choices.OS),
-		fmt.Sprintf("Terminal: %s", m.Choices.Terminal),
-		fmt.Sprintf("Shell: %s", m.Choices.Shell),
-		fmt.Sprintf("Window Manager: %s", m.Choices.WindowMgr),
+		m.Tf("complete.os", m.Choices.OS),
+		m.Tf("complete.terminal", m.Choices.Terminal),
+		m.Tf("complete.shell", m.Choices.Shell),
+		m.Tf("complete.wm", m.Choices.WindowMgr),
+	}
+
+	if len(wmExtraOptions[m.Choices.WindowMgr]) > 0 {
+		extras := wmExtraIDs(selectedWMExtras(m.Choices.WindowMgr, m.Choices.WMExtras))
+		items = append(items, m.Tf("complete.wm_extras", wmDisplayName(m.Choices.WindowMgr), strings.Join(extras, ", ")))
 	}
 
 	if m.Choices.InstallFont {
-		items = append(items, "Font: Iosevka Term Nerd Font")
+		items = append(items, m.T("complete.font"))
 	}
 	if m.Choices.InstallNvim {
-		items = append(items, "Editor: Neovim with Gentleman config")
+		items = append(items, m.T("complete.editor"))
+	}
+	items = append(items, m.commitItems()...)
+	if m.TotalTime > 0 {
+		items = append(items, m.Tf("complete.total_time", formatElapsed(time.Duration(m.TotalTime*float64(time.Second)))))
+	}
+	if m.LogPath != "" {
+		items = append(items, m.Tf("common.full_log", m.LogPath))
 	}
 
 	for _, item := range items {
-		s.WriteString(InfoStyle.Render("  • " + item))
+		s.WriteString(m.Theme.Info.Render("  • " + item))
 		s.WriteString("\n")
 	}
 
@@ -1347,47 +1704,60 @@ func (m Model) renderComplete() string {
 	}
 
 	s.WriteString("\n")
-	s.WriteString(TitleStyle.Render("Next Step"))
+	s.WriteString(m.Theme.Title.Render(m.T("complete.next_step")))
 	s.WriteString("\n\n")
 
-	s.WriteString(InfoStyle.Render("To use your new shell now, run:"))
+	s.WriteString(m.Theme.Info.Render(m.T("complete.run_shell")))
 	s.WriteString("\n")
-	s.WriteString(HighlightStyle.Render(fmt.Sprintf("   exec %s", shellCmd)))
+	s.WriteString(m.Theme.Highlight.Render(fmt.Sprintf("   exec %s", shellCmd)))
 	s.WriteString("\n\n")
 
-	s.WriteString(HelpStyle.Render("Press [Enter] or [q] to exit"))
+	s.WriteString(m.Theme.Help.Render(m.T("help.exit")))
 
 	return s.String()
 }
 
 func (m Model) renderError() string {
+	if m.InstallCancelled {
+		return m.renderInstallCancelled()
+	}
 	var s strings.Builder
 
-	s.WriteString(ErrorStyle.Render("❌ Installation Failed"))
+	s.WriteString(m.Theme.Error.Render(m.T("error.failed")))
 	s.WriteString("\n\n")
 
-	s.WriteString(MutedStyle.Render("Error:"))
+	s.WriteString(m.Theme.Muted.Render(m.T("error.label")))
 	s.WriteString("\n")
-	s.WriteString(ErrorStyle.Render(m.ErrorMsg))
+	s.WriteString(m.Theme.Error.Render(m.ErrorMsg))
 	s.WriteString("\n\n")
 
+	if m.TempCleanupNote != "" {
+		s.WriteString(m.Theme.Muted.Render(m.TempCleanupNote))
+		s.WriteString("\n\n")
+	}
+
+	if m.LogPath != "" {
+		s.WriteString(m.Theme.Muted.Render(m.Tf("common.full_log", m.LogPath)))
+		s.WriteString("\n\n")
+	}
+
 	// Show last few log lines for context
-	if len(m.LogLines) > 0 {
-		s.WriteString(MutedStyle.Render("Recent logs:"))
+	if m.LogLines.Len() > 0 {
+		s.WriteString(m.Theme.Muted.Render(m.T("error.recent_logs")))
 		s.WriteString("\n")
 		// Show last 5 log lines
-		startIdx := len(m.LogLines) - 5
-		if startIdx < 0 {
-			startIdx = 0
-		}
-		for _, line := range m.LogLines[startIdx:] {
-			s.WriteString(InfoStyle.Render("  " + line))
+		for _, line := range m.logTail(5) {
+			s.WriteString(m.Theme.Info.Render("  " + line))
 			s.WriteString("\n")
 		}
 		s.WriteString("\n")
 	}
 
-	s.WriteString(HelpStyle.Render("[r] retry • [space+q] quit"))
+	if m.DiagnoseFixMode || m.CurrentStep >= len(m.Steps) {
+		s.WriteString(m.Theme.Help.Render(m.T("error.help_retry")))
+	} else {
+		s.WriteString(m.Theme.Help.Render(m.T("error.help_step")))
+	}
 
 	return s.String()
 }
@@ -1402,84 +1772,177 @@ func max(a, b int) int {
 func (m Model) renderBackupConfirm() string {
 	var s strings.Builder
 
-	s.WriteString(TitleStyle.Render(m.GetScreenTitle()))
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render("The following configs will be overwritten:"))
+	s.WriteString(m.Theme.Muted.Render(m.T("backup_confirm.overwritten")))
 	s.WriteString("\n\n")
 
 	// List existing configs
 	for _, config := range m.ExistingConfigs {
-		s.WriteString(WarningStyle.Render("  ⚠️  " + config))
+		s.WriteString(m.Theme.Warning.Render("  ⚠️  " + config))
 		s.WriteString("\n")
 	}
 
 	s.WriteString("\n")
-	s.WriteString(InfoStyle.Render("Creating a backup allows you to restore later if needed."))
+	s.WriteString(m.Theme.Info.Render(m.T("backup_confirm.explain")))
 	s.WriteString("\n\n")
 
+	// Backup size breakdown with exclusions applied
+	opts := system.BackupOptions{
+		IncludeCaches:  m.Choices.BackupIncludeCaches,
+		ExcludeHistory: m.Choices.BackupExcludeHistory,
+	}
+	switch {
+	case m.BackupSizing:
+		s.WriteString(m.Theme.Muted.Render("  " + m.T("backup_confirm.estimating")))
+		s.WriteString("\n")
+	case len(m.BackupSizes) == 0:
+		s.WriteString(m.Theme.Muted.Render("  " + m.T("backup_confirm.no_estimate")))
+		s.WriteString("\n")
+	default:
+		for _, est := range m.BackupSizes {
+			after := est.SizeWith(opts)
+			line := fmt.Sprintf("  %s: %s", est.Key, system.FormatBytes(est.Total))
+			if after != est.Total {
+				line += m.Tf("backup_confirm.after_exclusions", system.FormatBytes(after))
+			}
+			s.WriteString(m.Theme.Muted.Render(line))
+			s.WriteString("\n")
+		}
+	}
+	check := func(on bool) string {
+		if on {
+			return "[✓] "
+		}
+		return "[ ] "
+	}
+	cachesLabel := check(opts.IncludeCaches) + m.T("backup_confirm.caches")
+	historyLabel := check(opts.ExcludeHistory) + m.T("backup_confirm.history")
+	// Shares the history line so the screen still fits 24 rows
+	compressLabel := check(m.Choices.BackupCompress) + m.Tf("backup_confirm.compress", system.BackupArchiveExt)
+	s.WriteString(m.Theme.Muted.Render("  " + cachesLabel))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render("  " + historyLabel + "   " + compressLabel))
+	s.WriteString("\n")
+	if m.DiskCheck != nil {
+		style := m.Theme.Muted
+		if m.DiskCheck.Short() {
+			style = m.Theme.Warning
+		}
+		s.WriteString(style.Render("  " + m.DiskCheck.Summary()))
+		s.WriteString("\n")
+	}
+	s.WriteString("\n")
+
+	if m.ProfileNotice != "" {
+		s.WriteString(m.Theme.Success.Render(m.ProfileNotice))
+		s.WriteString("\n\n")
+	}
+
 	// Options
 	options := m.GetCurrentOptions()
 	for i, opt := range options {
 		cursor := "  "
-		style := UnselectedStyle
+		style := m.Theme.Unselected
 		if i == m.Cursor {
 			cursor = "▸ "
-			style = SelectedStyle
+			style = m.Theme.Selected
 		}
-		s.WriteString(style.Render(cursor + opt))
+		if strings.HasSuffix(opt, m.T("skill_menu.nothing_to_undo")) {
+			style = m.Theme.Muted
+		}
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
 		s.WriteString("\n")
 	}
 
 	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("↑/k up • ↓/j down • [Enter] select • [Esc] back"))
+	s.WriteString(m.Theme.Help.Render(m.T("backup_confirm.help")))
 
 	return s.String()
 }
 
-func (m Model) renderRestoreBackup() string {
+func (m Model) renderOSVersionWarning() string {
 	var s strings.Builder
 
-	s.WriteString(TitleStyle.Render(m.GetScreenTitle()))
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render("Select a backup to restore or delete"))
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
 	s.WriteString("\n\n")
 
-	if len(m.AvailableBackups) == 0 {
-		s.WriteString(MutedStyle.Render("No backups found."))
-		s.WriteString("\n")
-	} else {
-		// List backups
-		for i, backup := range m.AvailableBackups {
-			cursor := "  "
-			style := UnselectedStyle
-			if i == m.Cursor {
-				cursor = "▸ "
-				style = SelectedStyle
+	if w := m.OSWarning; w != nil {
+		s.WriteString(m.Theme.Warning.Render(m.Tf("os_version_warning.may_fail", w.Reason)))
+		s.WriteString("\n\n")
+		if steps := m.likelyFailingSteps(); len(steps) > 0 {
+			s.WriteString(m.Theme.Info.Render(m.T("os_version_warning.steps")))
+			s.WriteString("\n")
+			for _, name := range steps {
+				s.WriteString(m.Theme.Warning.Render("  ⚠️  " + name))
+				s.WriteString("\n")
 			}
-
-			// Format: timestamp + item count
-			label := fmt.Sprintf("📁 %s (%d items)", backup.Timestamp.Format("2006-01-02 15:04:05"), len(backup.Files))
-			s.WriteString(style.Render(cursor + label))
 			s.WriteString("\n")
 		}
 	}
 
-	// Separator and Back
-	s.WriteString(MutedStyle.Render("─────────────"))
+	for i, opt := range m.GetCurrentOptions() {
+		cursor := "  "
+		style := m.Theme.Unselected
+		if i == m.Cursor {
+			cursor = "▸ "
+			style = m.Theme.Selected
+		}
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
+		s.WriteString("\n")
+	}
+
 	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render(m.T("help.select_back")))
 
-	backIdx := len(m.AvailableBackups) + 1
-	cursor := "  "
-	style := UnselectedStyle
-	if m.Cursor == backIdx {
-		cursor = "▸ "
-		style = SelectedStyle
-	}
-	s.WriteString(style.Render(cursor + "← Back"))
+	return s.String()
+}
+
+func (m Model) renderRestoreBackup() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
 	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.T("restore_backup.desc")))
+	s.WriteString("\n\n")
+
+	if m.BackupNotice != "" {
+		s.WriteString(m.Theme.Success.Render(m.BackupNotice))
+		s.WriteString("\n\n")
+	}
+
+	if len(m.AvailableBackups) == 0 {
+		s.WriteString(m.Theme.Muted.Render(m.T("restore_backup.empty")))
+		s.WriteString("\n")
+	}
+
+	for i, opt := range m.GetCurrentOptions() {
+		if strings.HasPrefix(opt, "───") {
+			s.WriteString(m.Theme.Muted.Render(opt))
+			s.WriteString("\n")
+			continue
+		}
+
+		cursor := "  "
+		style := m.Theme.Unselected
+		if i == m.Cursor {
+			cursor = "▸ "
+			style = m.Theme.Selected
+		}
+		if i < len(m.AvailableBackups) {
+			opt = "📁 " + opt
+		}
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
+		s.WriteString("\n")
+	}
 
 	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("↑/k up • ↓/j down • [Enter] select • [Esc] back"))
+	s.WriteString(m.Theme.Help.Render(m.T("help.select_back")))
 
 	return s.String()
 }
@@ -1488,92 +1951,261 @@ func (m Model) renderRestoreConfirm() string {
 	var s strings.Builder
 
 	if m.SelectedBackup >= len(m.AvailableBackups) {
-		return ErrorStyle.Render("No backup selected")
+		return m.Theme.Error.Render(m.T("restore_confirm.none"))
 	}
 
 	backup := m.AvailableBackups[m.SelectedBackup]
 
-	s.WriteString(TitleStyle.Render(m.GetScreenTitle()))
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render("Backup from: " + backup.Timestamp.Format("2006-01-02 15:04:05")))
+	s.WriteString(m.Theme.Muted.Render(m.Tf("restore_confirm.from", backup.Timestamp.Format("2006-01-02 15:04:05"))))
+	s.WriteString("\n")
+	format := m.T("restore_confirm.format_dir")
+	if backup.Compressed {
+		format = m.Tf("restore_confirm.format_archive", system.BackupArchiveExt)
+	}
+	s.WriteString(m.Theme.Muted.Render(format))
 	s.WriteString("\n\n")
 
 	// List files in backup
-	s.WriteString(SubtitleStyle.Render("Contents:"))
+	s.WriteString(m.Theme.Subtitle.Render(m.T("restore_confirm.contents")))
 	s.WriteString("\n")
 	for _, file := range backup.Files {
-		s.WriteString(InfoStyle.Render("  • " + file))
+		s.WriteString(m.Theme.Info.Render("  • " + file))
 		s.WriteString("\n")
 	}
 
 	s.WriteString("\n")
-	s.WriteString(WarningStyle.Render("⚠️  Restoring will overwrite your current configs!"))
+	s.WriteString(m.Theme.Warning.Render(m.T("restore_confirm.warning")))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render("   " + m.T("restore_confirm.review")))
 	s.WriteString("\n\n")
 
 	// Options
 	options := m.GetCurrentOptions()
 	for i, opt := range options {
 		cursor := "  "
-		style := UnselectedStyle
+		style := m.Theme.Unselected
 		if i == m.Cursor {
 			cursor = "▸ "
-			style = SelectedStyle
+			style = m.Theme.Selected
 		}
-		s.WriteString(style.Render(cursor + opt))
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
 		s.WriteString("\n")
 	}
 
 	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("↑/k up • ↓/j down • [Enter] select • [Esc] cancel"))
+	s.WriteString(m.Theme.Help.Render(m.T("help.select_cancel")))
 
 	return s.String()
 }
 
-// ============================================================================
-// Trainer Views
-// ============================================================================
-
-func (m Model) renderTrainerMenu() string {
+func (m Model) renderRestoreConflict() string {
 	var s strings.Builder
 
-	// Header
-	s.WriteString(TitleStyle.Render("🎮 Vim Mastery Trainer"))
-	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render("Master Vim motions through progressive challenges"))
-	s.WriteString("\n\n")
-
-	// Stats bar
-	if m.TrainerStats != nil {
-		score := fmt.Sprintf("Score: %d", m.TrainerStats.TotalScore)
-		streak := fmt.Sprintf("Streak: %d", m.TrainerStats.CurrentStreak)
-		bosses := fmt.Sprintf("Bosses: %d/7", len(m.TrainerStats.BossesDefeated))
-		s.WriteString(InfoStyle.Render(fmt.Sprintf("📊 %s  |  🔥 %s  |  👑 %s", score, streak, bosses)))
-		s.WriteString("\n\n")
+	if m.RestoreConflictIdx >= len(m.RestoreConflicts) {
+		return m.Theme.Error.Render(m.T("restore_conflict.none"))
 	}
 
-	// Module list
-	s.WriteString(SubtitleStyle.Render("Select a Module:"))
-	s.WriteString("\n\n")
+	item := m.RestoreConflicts[m.RestoreConflictIdx]
 
-	for i, module := range m.TrainerModules {
-		isUnlocked := m.TrainerStats != nil && m.TrainerStats.IsModuleUnlocked(module.ID)
-		isBossDefeated := m.TrainerStats != nil && m.TrainerStats.IsBossDefeated(module.ID)
-		isLessonsComplete := m.TrainerStats != nil && m.TrainerStats.IsLessonsComplete(module.ID)
-		isPracticeReady := m.TrainerStats != nil && m.TrainerStats.IsPracticeReady(module.ID)
-		isBossReady := m.TrainerStats != nil && m.TrainerStats.IsBossReady(module.ID)
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n\n")
+	s.WriteString(m.Theme.Info.Render("  " + item.LivePath))
+	s.WriteString("\n")
+	if item.BackupPath == "" {
+		s.WriteString(m.Theme.Muted.Render("  " + m.T("restore_conflict.not_in_backup")))
+	} else {
+		s.WriteString(m.Theme.Muted.Render("  " + m.T("restore_conflict.differs")))
+	}
+	s.WriteString("\n\n")
 
+	options := m.GetCurrentOptions()
+	for i, opt := range options {
 		cursor := "  "
-		style := UnselectedStyle
-		if i == m.TrainerCursor {
+		style := m.Theme.Unselected
+		if i == m.Cursor {
 			cursor = "▸ "
-			style = SelectedStyle
+			style = m.Theme.Selected
+		}
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
+		s.WriteString("\n")
+	}
+
+	s.WriteString("\n")
+	check := "[ ]"
+	if m.RestoreApplyAll {
+		check = "[✓]"
+	}
+	remaining := len(m.RestoreConflicts) - m.RestoreConflictIdx
+	s.WriteString(m.Theme.Muted.Render("  " + m.Tf("restore_conflict.apply_all", check, remaining)))
+	s.WriteString("\n\n")
+	s.WriteString(m.Theme.Help.Render(m.T("restore_conflict.help")))
+
+	return s.String()
+}
+
+func (m Model) renderDiagnoseSymptom() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
+	s.WriteString("\n")
+	s.WriteString(m.renderInstalledCommit())
+	s.WriteString("\n")
+	s.WriteString(m.renderOptionList())
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render(m.T("diagnose_symptom.help")))
+
+	return s.String()
+}
+
+func (m Model) renderDiagnoseResults() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
+	s.WriteString("\n\n")
+
+	if m.DiagnoseRunning {
+		s.WriteString(m.Theme.Info.Render(spinnerFrames[m.SpinnerFrame%len(spinnerFrames)] + " " + m.T("diagnose_results.running")))
+		s.WriteString("\n")
+		return s.String()
+	}
+
+	if m.DiagnoseLastFix != "" {
+		s.WriteString(m.Theme.Success.Render(m.Tf("diagnose_results.fix_applied", m.DiagnoseLastFix)))
+		s.WriteString("\n\n")
+	}
+
+	if m.DiagnoseManifest != nil {
+		s.WriteString(m.renderInstalledCommit())
+		s.WriteString("\n")
+	}
+
+	// Results arrive ranked, most likely cause first
+	for _, r := range m.DiagnoseResults {
+		var line string
+		switch r.Status {
+		case DiagFail:
+			line = m.Theme.Error.Render("✗ " + r.Name)
+		case DiagWarn:
+			line = m.Theme.Warning.Render("⚠ " + r.Name)
+		case DiagPass:
+			line = m.Theme.Success.Render("✓ " + r.Name)
+		default:
+			line = m.Theme.Muted.Render("– " + r.Name)
+		}
+		s.WriteString("  " + line)
+		s.WriteString("\n")
+		if r.Detail != "" {
+			s.WriteString(m.Theme.Muted.Render("      " + r.Detail))
+			s.WriteString("\n")
+		}
+	}
+
+	if len(m.diagnoseFixIDs()) == 0 {
+		s.WriteString("\n")
+		s.WriteString(m.Theme.Muted.Render(m.T("diagnose_results.no_fix")))
+		s.WriteString("\n")
+	}
+
+	s.WriteString("\n")
+	s.WriteString(m.renderOptionList())
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render(m.T("diagnose_results.help")))
+
+	return s.String()
+}
+
+// renderOptionList draws the cursor list shared by both diagnose screens
+// renderInstalledCommit shows which dotfiles commit the last install deployed
+func (m Model) renderInstalledCommit() string {
+	commit := m.DiagnoseManifest.dotfilesCommit()
+	if commit.Hash == "" {
+		return m.Theme.Muted.Render(m.T("diagnose.no_install")) + "\n"
+	}
+	line := m.Tf("diagnose.installed",
+		commit.Label(), m.DiagnoseManifest.UpdatedAt.Format("2006-01-02"))
+	return m.Theme.Info.Render(line) + "\n"
+}
+
+func (m Model) renderOptionList() string {
+	var s strings.Builder
+	for i, opt := range m.GetCurrentOptions() {
+		if strings.HasPrefix(opt, "───") {
+			s.WriteString(m.Theme.Muted.Render(opt))
+			s.WriteString("\n")
+			continue
+		}
+		cursor := "  "
+		style := m.Theme.Unselected
+		if i == m.Cursor {
+			cursor = "▸ "
+			style = m.Theme.Selected
+		}
+		s.WriteString(m.renderRow(style, cursor+opt))
+		s.WriteString("\n")
+	}
+	return s.String()
+}
+
+// ============================================================================
+// Trainer Views
+// ============================================================================
+
+func (m Model) renderTrainerMenu() string {
+	var s strings.Builder
+
+	// Header
+	s.WriteString(m.Theme.Title.Render(m.T("trainer_menu.heading")))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.T("trainer_menu.tagline")))
+	s.WriteString("\n\n")
+
+	// Stats bar
+	if m.TrainerStats != nil {
+		score := m.Tf("trainer_menu.score", m.TrainerStats.TotalScore)
+		streak := m.Tf("trainer_menu.streak", m.TrainerStats.CurrentStreak)
+		bosses := m.Tf("trainer_menu.bosses", len(m.TrainerStats.BossesDefeated))
+		accuracy := m.Tf("trainer_menu.accuracy", m.TrainerStats.OverallPracticeAccuracy()*100)
+		s.WriteString(m.Theme.Info.Render(fmt.Sprintf("📊 %s  |  🔥 %s  |  👑 %s  |  🎯 %s", score, streak, bosses, accuracy)))
+		s.WriteString("\n")
+		s.WriteString(m.renderTrainerDailyStreak(time.Now()))
+		if p := m.TrainerStats.Placement; p != nil {
+			s.WriteString(m.Theme.Muted.Render(m.Tf("trainer_menu.placement", p.Correct, p.Total, p.TakenAt.Format("2006-01-02"))))
+			s.WriteString("\n")
+		}
+		s.WriteString("\n")
+	}
+
+	// Module list
+	s.WriteString(m.Theme.Subtitle.Render(m.T("trainer_menu.select_module")))
+	s.WriteString("\n\n")
+
+	for i, module := range m.TrainerModules {
+		isUnlocked := m.TrainerStats != nil && m.TrainerStats.IsModuleUnlocked(module.ID)
+		isBossDefeated := m.TrainerStats != nil && m.TrainerStats.IsBossDefeated(module.ID)
+		isLessonsComplete := m.TrainerStats != nil && m.TrainerStats.IsLessonsComplete(module.ID)
+		isPracticeReady := m.TrainerStats != nil && m.TrainerStats.IsPracticeReady(module.ID)
+		isBossReady := m.TrainerStats != nil && m.TrainerStats.IsBossReady(module.ID)
+
+		cursor := "  "
+		style := m.Theme.Unselected
+		if i == m.TrainerCursor {
+			cursor = "▸ "
+			style = m.Theme.Selected
 		}
 
 		// Module name with status indicators
 		status := ""
 		if !isUnlocked {
 			status = "🔒"
-			style = MutedStyle
+			style = m.Theme.Muted
 		} else if isBossDefeated {
 			status = "👑"
 		} else if isBossReady {
@@ -1586,8 +2218,12 @@ func (m Model) renderTrainerMenu() string {
 			status = "📖"
 		}
 
-		line := fmt.Sprintf("%s %s %s - %s", status, module.Icon, module.Name, module.Description)
-		s.WriteString(style.Render(cursor + line))
+		name := module.Name
+		if module.UserPack {
+			name += " [pack]"
+		}
+		line := fmt.Sprintf("%s %s %s - %s", status, module.Icon, name, module.Description)
+		s.WriteString(m.renderRow(style, cursor+line))
 		s.WriteString("\n")
 
 		// Show progress for selected module
@@ -1596,40 +2232,111 @@ func (m Model) renderTrainerMenu() string {
 			var progressLine string
 			if progress.LessonsTotal > 0 {
 				lessonsPercent := float64(progress.LessonsCompleted) / float64(progress.LessonsTotal) * 100
-				progressLine = fmt.Sprintf("     Lessons: %d/%d (%.0f%%)", progress.LessonsCompleted, progress.LessonsTotal, lessonsPercent)
+				progressLine = "     " + m.Tf("trainer_menu.lessons", progress.LessonsCompleted, progress.LessonsTotal, lessonsPercent)
 			} else {
-				progressLine = "     Lessons: 0/0"
+				progressLine = "     " + m.T("trainer_menu.lessons_none")
+			}
+			if progress.Placement {
+				progressLine += m.T("trainer_menu.placed")
 			}
 			if progress.PracticeAttempts > 0 {
-				progressLine += fmt.Sprintf("  |  Practice: %.0f%%", progress.PracticeAccuracy*100)
+				progressLine += "  |  " + m.Tf("trainer_menu.practice", progress.PracticeAccuracy*100)
 			}
 
 			// Show mastery progress for practice mode
 			if isPracticeReady {
 				practiceStats := trainer.GetPracticeStatsForModule(module.ID, progress)
 				if practiceStats.TotalExercises > 0 {
-					progressLine += fmt.Sprintf("  |  Mastered: %d/%d", practiceStats.MasteredCount, practiceStats.TotalExercises)
+					progressLine += "  |  " + m.Tf("trainer_menu.mastered", practiceStats.MasteredCount, practiceStats.TotalExercises)
 					if practiceStats.PracticeComplete {
 						progressLine += " ✅"
 					}
 				}
 			}
 
-			s.WriteString(MutedStyle.Render(progressLine))
+			s.WriteString(m.Theme.Muted.Render(progressLine))
 			s.WriteString("\n")
 		}
 	}
 
+	s.WriteString(m.renderTrainerReviewRow())
+
 	// Show message if any
 	if m.TrainerMessage != "" {
 		s.WriteString("\n")
-		s.WriteString(WarningStyle.Render(m.TrainerMessage))
+		s.WriteString(m.Theme.Warning.Render(m.TrainerMessage))
 		s.WriteString("\n")
 	}
 
 	// Help
 	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("↑/k up • ↓/j down • [Enter/l] lesson • [p] practice • [b] boss • [r] reset • [q/Esc] back"))
+	s.WriteString(m.Theme.Help.Render(m.T("trainer_menu.help")))
+
+	return s.String()
+}
+
+func (m Model) renderTrainerResetConfirm() string {
+	var s strings.Builder
+
+	module := m.TrainerModules[m.TrainerCursor]
+	progress := m.TrainerStats.GetModuleProgress(module.ID)
+	practice := trainer.GetPracticeStatsForModule(module.ID, progress)
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
+	s.WriteString("\n\n")
+	s.WriteString(m.Theme.Warning.Render(m.Tf("trainer_reset_confirm.question", module.Icon, module.Name)))
+	s.WriteString("\n\n")
+	s.WriteString(m.Theme.Info.Render(m.T("trainer_reset_confirm.lose")))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Info.Render("  • " + m.Tf("trainer_reset_confirm.mastered", practice.MasteredCount, practice.TotalExercises)))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Info.Render("  • " + m.Tf("trainer_reset_confirm.accuracy", progress.PracticeAccuracy*100, progress.PracticeAttempts)))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.T("trainer_reset_confirm.kept")))
+	s.WriteString("\n\n")
+	s.WriteString(m.renderOptionList())
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render(m.T("trainer_reset_confirm.help")))
+
+	return s.String()
+}
+
+func (m Model) renderTrainerSettings() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
+	s.WriteString("\n\n")
+	s.WriteString(m.renderOptionList())
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render(m.T("help.select_back")))
+
+	return s.String()
+}
+
+func (m Model) renderTrainerResetAll() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
+	s.WriteString("\n\n")
+	s.WriteString(m.Theme.Warning.Render(m.Tf("trainer_reset_all.prompt", trainerResetWord)))
+	s.WriteString("\n\n")
+	s.WriteString(m.Theme.Highlight.Render("> " + m.TrainerResetInput + "█"))
+	s.WriteString("\n")
+
+	if m.TrainerMessage != "" {
+		s.WriteString("\n")
+		s.WriteString(m.Theme.Error.Render(m.TrainerMessage))
+		s.WriteString("\n")
+	}
+
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render(m.T("trainer_reset_all.help")))
 
 	return s.String()
 }
@@ -1638,33 +2345,37 @@ func (m Model) renderTrainerExercise(mode string) string {
 	var s strings.Builder
 
 	if m.TrainerGameState == nil || m.TrainerGameState.CurrentExercise == nil {
-		s.WriteString(ErrorStyle.Render("No exercise loaded"))
+		s.WriteString(m.Theme.Error.Render(m.T("trainer.no_exercise")))
 		return s.String()
 	}
 
 	exercise := m.TrainerGameState.CurrentExercise
 
 	// Header with mode
-	title := fmt.Sprintf("🎮 %s Mode: %s", mode, string(m.TrainerGameState.CurrentModule))
-	s.WriteString(TitleStyle.Render(title))
+	title := m.Tf("trainer.mode_title", mode, string(m.TrainerGameState.CurrentModule))
+	s.WriteString(m.Theme.Title.Render(title))
 	s.WriteString("\n")
 
 	// Progress bar
 	var progressText string
-	if m.TrainerGameState.IsLessonMode {
+	if m.TrainerGameState.IsLessonMode || m.TrainerGameState.IsPlacementMode {
 		current := m.TrainerGameState.ExerciseIndex + 1
 		total := len(m.TrainerGameState.Exercises)
-		progressText = fmt.Sprintf("Exercise %d of %d", current, total)
+		progressText = m.Tf("trainer.exercise_of", current, total)
+	} else if m.TrainerGameState.IsTimedMode {
+		progressText = m.timedStatus()
+	} else if m.TrainerGameState.IsReviewMode {
+		progressText = m.Tf("trainer.score_review", m.TrainerGameState.SessionScore, m.TrainerGameState.CurrentStreak, m.trainerReviewCount())
 	} else {
-		progressText = fmt.Sprintf("Score: %d | Streak: %d", m.TrainerGameState.SessionScore, m.TrainerGameState.CurrentStreak)
+		progressText = m.Tf("trainer.score", m.TrainerGameState.SessionScore, m.TrainerGameState.CurrentStreak)
 	}
-	s.WriteString(MutedStyle.Render(progressText))
+	s.WriteString(m.Theme.Muted.Render(progressText))
 	s.WriteString("\n\n")
 
 	// Mission
-	s.WriteString(SubtitleStyle.Render("📋 Mission:"))
+	s.WriteString(m.Theme.Subtitle.Render(m.T("trainer.mission")))
 	s.WriteString("\n")
-	s.WriteString(InfoStyle.Render("   " + exercise.Mission))
+	s.WriteString(m.Theme.Info.Render("   " + exercise.Mission))
 	s.WriteString("\n\n")
 
 	// Detect if this exercise should skip cursor simulation
@@ -1676,7 +2387,8 @@ func (m Model) renderTrainerExercise(mode string) string {
 		(exercise.Solutions[0][0] == ':' || exercise.Solutions[0][0] == '/' || exercise.Solutions[0][0] == '?')
 	isNonMotionModule := exercise.Module == trainer.ModuleSubstitution ||
 		exercise.Module == trainer.ModuleMacros ||
-		exercise.Module == trainer.ModuleRegex
+		exercise.Module == trainer.ModuleRegex ||
+		trainer.IsPackModule(exercise.Module)
 	skipSimulation := isExCommand || isNonMotionModule
 
 	// Calculate simulated cursor position and selection based on current input
@@ -1695,14 +2407,14 @@ func (m Model) renderTrainerExercise(mode string) string {
 	}
 
 	// Code display with cursors and selection
-	s.WriteString(SubtitleStyle.Render("📝 Code:"))
+	s.WriteString(m.Theme.Subtitle.Render(m.T("trainer.code")))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render(strings.Repeat("─", 60)))
+	s.WriteString(m.Theme.Muted.Render(strings.Repeat("─", 60)))
 	s.WriteString("\n")
 
 	for lineNum, line := range exercise.Code {
 		lineNumStr := fmt.Sprintf("%2d │ ", lineNum+1)
-		s.WriteString(MutedStyle.Render(lineNumStr))
+		s.WriteString(m.Theme.Muted.Render(lineNumStr))
 
 		// For non-motion exercises, show code with only the start cursor (no simulation)
 		if skipSimulation {
@@ -1714,11 +2426,11 @@ func (m Model) renderTrainerExercise(mode string) string {
 				if startPos.Col+1 < len(line) {
 					after = line[startPos.Col+1:]
 				}
-				s.WriteString(CodeStyle.Render(before))
-				s.WriteString(StartCursorStyle.Render(cursor))
-				s.WriteString(CodeStyle.Render(after))
+				s.WriteString(m.Theme.Code.Render(before))
+				s.WriteString(m.Theme.StartCursor.Render(cursor))
+				s.WriteString(m.Theme.Code.Render(after))
 			} else {
-				s.WriteString(CodeStyle.Render(line))
+				s.WriteString(m.Theme.Code.Render(line))
 			}
 			s.WriteString("\n")
 			continue
@@ -1726,7 +2438,7 @@ func (m Model) renderTrainerExercise(mode string) string {
 
 		// Check if there's an active selection on this line
 		if selection.Active && lineNum == selection.StartLine {
-			s.WriteString(renderLineWithSelection(line, startPos, selection))
+			s.WriteString(renderLineWithSelection(m.Theme, line, startPos, selection))
 			s.WriteString("\n")
 			continue
 		}
@@ -1753,17 +2465,17 @@ func (m Model) renderTrainerExercise(mode string) string {
 				if startPos.Col+1 < len(line) {
 					after = line[startPos.Col+1:]
 				}
-				s.WriteString(CodeStyle.Render(before))
-				s.WriteString(StartCursorStyle.Render(cursor))
-				s.WriteString(CodeStyle.Render(after))
+				s.WriteString(m.Theme.Code.Render(before))
+				s.WriteString(m.Theme.StartCursor.Render(cursor))
+				s.WriteString(m.Theme.Code.Render(after))
 			} else {
 				// Cursor at end of line or empty line
-				s.WriteString(CodeStyle.Render(line))
-				s.WriteString(StartCursorStyle.Render(" "))
+				s.WriteString(m.Theme.Code.Render(line))
+				s.WriteString(m.Theme.StartCursor.Render(" "))
 			}
 		} else if startOnLine && currentOnLine {
 			// Both cursors on same line but different positions
-			s.WriteString(renderLineWithTwoCursors(line, startPos.Col, simPos.Col))
+			s.WriteString(renderLineWithTwoCursors(m.Theme, line, startPos.Col, simPos.Col))
 		} else if startOnLine {
 			// Only start cursor on this line
 			if startPos.Col < len(line) {
@@ -1773,13 +2485,13 @@ func (m Model) renderTrainerExercise(mode string) string {
 				if startPos.Col+1 < len(line) {
 					after = line[startPos.Col+1:]
 				}
-				s.WriteString(CodeStyle.Render(before))
-				s.WriteString(StartCursorStyle.Render(cursor))
-				s.WriteString(CodeStyle.Render(after))
+				s.WriteString(m.Theme.Code.Render(before))
+				s.WriteString(m.Theme.StartCursor.Render(cursor))
+				s.WriteString(m.Theme.Code.Render(after))
 			} else {
 				// Cursor at end of line or empty line
-				s.WriteString(CodeStyle.Render(line))
-				s.WriteString(StartCursorStyle.Render(" "))
+				s.WriteString(m.Theme.Code.Render(line))
+				s.WriteString(m.Theme.StartCursor.Render(" "))
 			}
 		} else if currentOnLine {
 			// Only current cursor on this line
@@ -1790,50 +2502,54 @@ func (m Model) renderTrainerExercise(mode string) string {
 				if simPos.Col+1 < len(line) {
 					after = line[simPos.Col+1:]
 				}
-				s.WriteString(CodeStyle.Render(before))
-				s.WriteString(CurrentCursorStyle.Render(cursor))
-				s.WriteString(CodeStyle.Render(after))
+				s.WriteString(m.Theme.Code.Render(before))
+				s.WriteString(m.Theme.CurrentCursor.Render(cursor))
+				s.WriteString(m.Theme.Code.Render(after))
 			} else {
 				// Cursor at end of line or empty line
-				s.WriteString(CodeStyle.Render(line))
-				s.WriteString(CurrentCursorStyle.Render(" "))
+				s.WriteString(m.Theme.Code.Render(line))
+				s.WriteString(m.Theme.CurrentCursor.Render(" "))
 			}
 		} else {
 			// No cursors on this line
-			s.WriteString(CodeStyle.Render(line))
+			s.WriteString(m.Theme.Code.Render(line))
 		}
 		s.WriteString("\n")
 	}
 
-	s.WriteString(MutedStyle.Render(strings.Repeat("─", 60)))
+	s.WriteString(m.Theme.Muted.Render(strings.Repeat("─", 60)))
 	s.WriteString("\n\n")
 
 	// Input field
-	s.WriteString(SubtitleStyle.Render("⌨️  Your answer:"))
+	s.WriteString(m.Theme.Subtitle.Render(m.T("trainer.your_answer")))
 	s.WriteString("\n")
 	inputDisplay := formatControlChars(m.TrainerInput)
 	if inputDisplay == "" {
 		inputDisplay = "..."
 	}
-	s.WriteString(BoxStyle.Render(KeyStyle.Render(inputDisplay)))
+	s.WriteString(m.Theme.Box.Render(m.Theme.Key.Render(inputDisplay)))
 	s.WriteString("\n")
 
 	// Show message/hint if any
 	if m.TrainerMessage != "" {
 		s.WriteString("\n")
-		s.WriteString(InfoStyle.Render(m.TrainerMessage))
+		s.WriteString(m.Theme.Info.Render(m.TrainerMessage))
 		s.WriteString("\n")
 	}
 
 	// Help
 	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("Type command • [Enter] submit • [Tab] hint • [Backspace] clear • [Esc] quit"))
+	if m.TrainerGameState.IsTimedMode {
+		s.WriteString(m.Theme.Help.Render(m.T("trainer.help_timed")))
+	} else {
+		s.WriteString(m.Theme.Help.Render(m.T("trainer.help")))
+	}
 
 	return s.String()
 }
 
 // renderLineWithTwoCursors renders a line with both start and current cursor
-func renderLineWithTwoCursors(line string, startCol, currentCol int) string {
+func renderLineWithTwoCursors(t *Theme, line string, startCol, currentCol int) string {
 	var result strings.Builder
 
 	// Helper to get cursor character (use space for empty/end of line)
@@ -1846,10 +2562,10 @@ func renderLineWithTwoCursors(line string, startCol, currentCol int) string {
 
 	// Determine order of cursors
 	firstCol, secondCol := startCol, currentCol
-	firstStyle, secondStyle := StartCursorStyle, CurrentCursorStyle
+	firstStyle, secondStyle := t.StartCursor, t.CurrentCursor
 	if currentCol < startCol {
 		firstCol, secondCol = currentCol, startCol
-		firstStyle, secondStyle = CurrentCursorStyle, StartCursorStyle
+		firstStyle, secondStyle = t.CurrentCursor, t.StartCursor
 	}
 
 	// Handle empty line case
@@ -1868,7 +2584,7 @@ func renderLineWithTwoCursors(line string, startCol, currentCol int) string {
 	// Build the line piece by piece
 	// Part before first cursor
 	if firstCol > 0 && firstCol <= len(line) {
-		result.WriteString(CodeStyle.Render(line[:firstCol]))
+		result.WriteString(t.Code.Render(line[:firstCol]))
 	}
 
 	// First cursor
@@ -1881,7 +2597,7 @@ func renderLineWithTwoCursors(line string, startCol, currentCol int) string {
 			endIdx = len(line)
 		}
 		if firstCol+1 < endIdx {
-			result.WriteString(CodeStyle.Render(line[firstCol+1 : endIdx]))
+			result.WriteString(t.Code.Render(line[firstCol+1 : endIdx]))
 		}
 	}
 
@@ -1892,19 +2608,19 @@ func renderLineWithTwoCursors(line string, startCol, currentCol int) string {
 
 	// Part after second cursor
 	if secondCol+1 < len(line) {
-		result.WriteString(CodeStyle.Render(line[secondCol+1:]))
+		result.WriteString(t.Code.Render(line[secondCol+1:]))
 	}
 
 	return result.String()
 }
 
 // renderLineWithSelection renders a line with visual selection highlighted
-func renderLineWithSelection(line string, startPos trainer.Position, sel trainer.Selection) string {
+func renderLineWithSelection(t *Theme, line string, startPos trainer.Position, sel trainer.Selection) string {
 	var result strings.Builder
 
 	if len(line) == 0 {
 		// Empty line with selection
-		result.WriteString(SelectionStyle.Render(" "))
+		result.WriteString(t.Selection.Render(" "))
 		return result.String()
 	}
 
@@ -1920,29 +2636,29 @@ func renderLineWithSelection(line string, startPos trainer.Position, sel trainer
 	if selEnd < selStart {
 		// Invalid selection, just render the line normally with start cursor
 		if startPos.Col < len(line) {
-			result.WriteString(CodeStyle.Render(line[:startPos.Col]))
-			result.WriteString(StartCursorStyle.Render(string(line[startPos.Col])))
+			result.WriteString(t.Code.Render(line[:startPos.Col]))
+			result.WriteString(t.StartCursor.Render(string(line[startPos.Col])))
 			if startPos.Col+1 < len(line) {
-				result.WriteString(CodeStyle.Render(line[startPos.Col+1:]))
+				result.WriteString(t.Code.Render(line[startPos.Col+1:]))
 			}
 		} else {
-			result.WriteString(CodeStyle.Render(line))
+			result.WriteString(t.Code.Render(line))
 		}
 		return result.String()
 	}
 
 	// Render: [before selection] [SELECTION] [after selection]
 	if selStart > 0 {
-		result.WriteString(CodeStyle.Render(line[:selStart]))
+		result.WriteString(t.Code.Render(line[:selStart]))
 	}
 
 	// The selection itself
 	selectedText := line[selStart : selEnd+1]
-	result.WriteString(SelectionStyle.Render(selectedText))
+	result.WriteString(t.Selection.Render(selectedText))
 
 	// After selection
 	if selEnd+1 < len(line) {
-		result.WriteString(CodeStyle.Render(line[selEnd+1:]))
+		result.WriteString(t.Code.Render(line[selEnd+1:]))
 	}
 
 	return result.String()
@@ -1952,7 +2668,7 @@ func (m Model) renderTrainerBoss() string {
 	var s strings.Builder
 
 	if m.TrainerGameState == nil || m.TrainerGameState.CurrentBoss == nil {
-		s.WriteString(ErrorStyle.Render("No boss loaded"))
+		s.WriteString(m.Theme.Error.Render(m.T("trainer_boss.none")))
 		return s.String()
 	}
 
@@ -1960,13 +2676,14 @@ func (m Model) renderTrainerBoss() string {
 	currentStep := m.TrainerGameState.BossStep
 
 	// Boss header
-	s.WriteString(DangerStyle.Render("⚔️  BOSS FIGHT: " + boss.Name))
+	s.WriteString(m.Theme.Danger.Render(m.Tf("trainer_boss.header", boss.Name)))
 	s.WriteString("\n")
 
 	// Lives and progress
 	lives := strings.Repeat("❤️ ", m.TrainerGameState.BossLives)
 	lostLives := strings.Repeat("🖤 ", boss.Lives-m.TrainerGameState.BossLives)
-	s.WriteString(fmt.Sprintf("Lives: %s%s  |  Step: %d/%d", lives, lostLives, currentStep+1, len(boss.Steps)))
+	s.WriteString(m.Tf("trainer_boss.status", lives, lostLives, currentStep+1, len(boss.Steps)))
+	s.WriteString("  |  " + m.renderBossTimer())
 	s.WriteString("\n\n")
 
 	if currentStep < len(boss.Steps) {
@@ -1974,9 +2691,9 @@ func (m Model) renderTrainerBoss() string {
 		exercise := &step.Exercise
 
 		// Mission
-		s.WriteString(SubtitleStyle.Render("📋 Challenge:"))
+		s.WriteString(m.Theme.Subtitle.Render(m.T("trainer_boss.challenge")))
 		s.WriteString("\n")
-		s.WriteString(InfoStyle.Render("   " + exercise.Mission))
+		s.WriteString(m.Theme.Info.Render("   " + exercise.Mission))
 		s.WriteString("\n\n")
 
 		// Detect if this exercise should skip cursor simulation
@@ -1984,7 +2701,8 @@ func (m Model) renderTrainerBoss() string {
 			(exercise.Solutions[0][0] == ':' || exercise.Solutions[0][0] == '/' || exercise.Solutions[0][0] == '?')
 		isNonMotionModule := exercise.Module == trainer.ModuleSubstitution ||
 			exercise.Module == trainer.ModuleMacros ||
-			exercise.Module == trainer.ModuleRegex
+			exercise.Module == trainer.ModuleRegex ||
+			trainer.IsPackModule(exercise.Module)
 		skipSimulation := isExCommand || isNonMotionModule
 
 		// Calculate simulated cursor position and selection based on current input
@@ -2001,14 +2719,14 @@ func (m Model) renderTrainerBoss() string {
 		}
 
 		// Code display with cursors and selection
-		s.WriteString(SubtitleStyle.Render("📝 Code:"))
+		s.WriteString(m.Theme.Subtitle.Render(m.T("trainer.code")))
 		s.WriteString("\n")
-		s.WriteString(MutedStyle.Render(strings.Repeat("─", 60)))
+		s.WriteString(m.Theme.Muted.Render(strings.Repeat("─", 60)))
 		s.WriteString("\n")
 
 		for lineNum, line := range exercise.Code {
 			lineNumStr := fmt.Sprintf("%2d │ ", lineNum+1)
-			s.WriteString(MutedStyle.Render(lineNumStr))
+			s.WriteString(m.Theme.Muted.Render(lineNumStr))
 
 			// For non-motion exercises, show code with only the start cursor
 			if skipSimulation {
@@ -2019,11 +2737,11 @@ func (m Model) renderTrainerBoss() string {
 					if startPos.Col+1 < len(line) {
 						after = line[startPos.Col+1:]
 					}
-					s.WriteString(CodeStyle.Render(before))
-					s.WriteString(StartCursorStyle.Render(cursor))
-					s.WriteString(CodeStyle.Render(after))
+					s.WriteString(m.Theme.Code.Render(before))
+					s.WriteString(m.Theme.StartCursor.Render(cursor))
+					s.WriteString(m.Theme.Code.Render(after))
 				} else {
-					s.WriteString(CodeStyle.Render(line))
+					s.WriteString(m.Theme.Code.Render(line))
 				}
 				s.WriteString("\n")
 				continue
@@ -2031,7 +2749,7 @@ func (m Model) renderTrainerBoss() string {
 
 			// Check if there's an active selection on this line
 			if selection.Active && lineNum == selection.StartLine {
-				s.WriteString(renderLineWithSelection(line, startPos, selection))
+				s.WriteString(renderLineWithSelection(m.Theme, line, startPos, selection))
 				s.WriteString("\n")
 				continue
 			}
@@ -2058,17 +2776,17 @@ func (m Model) renderTrainerBoss() string {
 					if startPos.Col+1 < len(line) {
 						after = line[startPos.Col+1:]
 					}
-					s.WriteString(CodeStyle.Render(before))
-					s.WriteString(StartCursorStyle.Render(cursor))
-					s.WriteString(CodeStyle.Render(after))
+					s.WriteString(m.Theme.Code.Render(before))
+					s.WriteString(m.Theme.StartCursor.Render(cursor))
+					s.WriteString(m.Theme.Code.Render(after))
 				} else {
 					// Cursor at end of line or empty line
-					s.WriteString(CodeStyle.Render(line))
-					s.WriteString(StartCursorStyle.Render(" "))
+					s.WriteString(m.Theme.Code.Render(line))
+					s.WriteString(m.Theme.StartCursor.Render(" "))
 				}
 			} else if startOnLine && currentOnLine {
 				// Both cursors on same line but different positions
-				s.WriteString(renderLineWithTwoCursors(line, startPos.Col, simPos.Col))
+				s.WriteString(renderLineWithTwoCursors(m.Theme, line, startPos.Col, simPos.Col))
 			} else if startOnLine {
 				// Only start cursor on this line
 				if startPos.Col < len(line) {
@@ -2078,13 +2796,13 @@ func (m Model) renderTrainerBoss() string {
 					if startPos.Col+1 < len(line) {
 						after = line[startPos.Col+1:]
 					}
-					s.WriteString(CodeStyle.Render(before))
-					s.WriteString(StartCursorStyle.Render(cursor))
-					s.WriteString(CodeStyle.Render(after))
+					s.WriteString(m.Theme.Code.Render(before))
+					s.WriteString(m.Theme.StartCursor.Render(cursor))
+					s.WriteString(m.Theme.Code.Render(after))
 				} else {
 					// Cursor at end of line or empty line
-					s.WriteString(CodeStyle.Render(line))
-					s.WriteString(StartCursorStyle.Render(" "))
+					s.WriteString(m.Theme.Code.Render(line))
+					s.WriteString(m.Theme.StartCursor.Render(" "))
 				}
 			} else if currentOnLine {
 				// Only current cursor on this line
@@ -2095,45 +2813,45 @@ func (m Model) renderTrainerBoss() string {
 					if simPos.Col+1 < len(line) {
 						after = line[simPos.Col+1:]
 					}
-					s.WriteString(CodeStyle.Render(before))
-					s.WriteString(CurrentCursorStyle.Render(cursor))
-					s.WriteString(CodeStyle.Render(after))
+					s.WriteString(m.Theme.Code.Render(before))
+					s.WriteString(m.Theme.CurrentCursor.Render(cursor))
+					s.WriteString(m.Theme.Code.Render(after))
 				} else {
 					// Cursor at end of line or empty line
-					s.WriteString(CodeStyle.Render(line))
-					s.WriteString(CurrentCursorStyle.Render(" "))
+					s.WriteString(m.Theme.Code.Render(line))
+					s.WriteString(m.Theme.CurrentCursor.Render(" "))
 				}
 			} else {
 				// No cursors on this line
-				s.WriteString(CodeStyle.Render(line))
+				s.WriteString(m.Theme.Code.Render(line))
 			}
 			s.WriteString("\n")
 		}
 
-		s.WriteString(MutedStyle.Render(strings.Repeat("─", 60)))
+		s.WriteString(m.Theme.Muted.Render(strings.Repeat("─", 60)))
 		s.WriteString("\n\n")
 
 		// Input field
-		s.WriteString(SubtitleStyle.Render("⌨️  Your answer:"))
+		s.WriteString(m.Theme.Subtitle.Render(m.T("trainer.your_answer")))
 		s.WriteString("\n")
 		inputDisplay := formatControlChars(m.TrainerInput)
 		if inputDisplay == "" {
 			inputDisplay = "..."
 		}
-		s.WriteString(BoxStyle.Render(KeyStyle.Render(inputDisplay)))
+		s.WriteString(m.Theme.Box.Render(m.Theme.Key.Render(inputDisplay)))
 		s.WriteString("\n")
 	}
 
 	// Show message if any
 	if m.TrainerMessage != "" {
 		s.WriteString("\n")
-		s.WriteString(WarningStyle.Render(m.TrainerMessage))
+		s.WriteString(m.Theme.Warning.Render(m.TrainerMessage))
 		s.WriteString("\n")
 	}
 
 	// Help
 	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("Type command • [Enter] submit • [Esc] forfeit"))
+	s.WriteString(m.Theme.Help.Render(m.T("trainer_boss.help")))
 
 	return s.String()
 }
@@ -2143,83 +2861,137 @@ func (m Model) renderTrainerResult() string {
 
 	// Result header
 	if m.TrainerLastCorrect {
-		s.WriteString(SuccessStyle.Render("✨ CORRECT! ✨"))
+		s.WriteString(m.Theme.Success.Render(m.T("trainer_result.correct")))
 	} else {
-		s.WriteString(ErrorStyle.Render("❌ INCORRECT"))
+		s.WriteString(m.Theme.Error.Render(m.T("trainer_result.incorrect")))
 	}
 	s.WriteString("\n\n")
 
 	// Show message/explanation
-	s.WriteString(InfoStyle.Render(m.TrainerMessage))
+	s.WriteString(m.Theme.Info.Render(m.TrainerMessage))
 	s.WriteString("\n")
 
 	if m.TrainerGameState != nil && m.TrainerGameState.CurrentExercise != nil {
 		exercise := m.TrainerGameState.CurrentExercise
 		if exercise.Explanation != "" {
 			s.WriteString("\n")
-			s.WriteString(SubtitleStyle.Render("📖 Explanation:"))
+			s.WriteString(m.Theme.Subtitle.Render(m.T("trainer_result.explanation")))
 			s.WriteString("\n")
-			s.WriteString(MutedStyle.Render("   " + exercise.Explanation))
+			s.WriteString(m.Theme.Muted.Render("   " + exercise.Explanation))
 			s.WriteString("\n")
 		}
 	}
 
+	// Time taken, against the best for this exercise
+	if m.TrainerLastCorrect && m.TrainerGameState != nil {
+		s.WriteString("\n")
+		s.WriteString(m.renderTrainerAnswerTime())
+		s.WriteString("\n")
+	}
+
 	// Score info
 	if m.TrainerGameState != nil {
 		s.WriteString("\n")
-		s.WriteString(MutedStyle.Render(fmt.Sprintf("Session Score: %d  |  Streak: %d", m.TrainerGameState.SessionScore, m.TrainerGameState.CurrentStreak)))
+		s.WriteString(m.Theme.Muted.Render(m.Tf("trainer_result.session", m.TrainerGameState.SessionScore, m.TrainerGameState.CurrentStreak)))
 		s.WriteString("\n")
 	}
 
 	// Help
 	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("[Enter] continue • [Esc] back"))
+	s.WriteString(m.Theme.Help.Render(m.T("trainer_result.help")))
 
 	return s.String()
 }
 
+// renderTrainerDailyStreak summarizes the daily practice streak and nudges
+// the user when today's practice would keep it going
+func (m Model) renderTrainerDailyStreak(now time.Time) string {
+	stats := m.TrainerStats
+	if stats.LastPracticeDate == "" {
+		return m.Theme.Muted.Render(m.T("trainer.no_daily_streak")) + "\n"
+	}
+
+	days := func(n int) string {
+		if n == 1 {
+			return m.T("trainer.day")
+		}
+		return m.Tf("trainer.days", n)
+	}
+	current := stats.ActiveStreakDays(now)
+	line := m.Theme.Muted.Render(m.Tf("trainer.daily_streak",
+		days(current), days(stats.LongestStreakDays), stats.TotalAnswered)) + "\n"
+
+	switch {
+	case stats.PracticedToday(now):
+		return line
+	case current > 0:
+		return line + m.Theme.Warning.Render(m.Tf("trainer.keep_streak", days(current))) + "\n"
+	}
+	return line + m.Theme.Warning.Render(m.T("trainer.streak_ended")) + "\n"
+}
+
+// renderTrainerAnswerTime shows how long the last correct answer took
+func (m Model) renderTrainerAnswerTime() string {
+	gs := m.TrainerGameState
+	line := m.Tf("trainer.answer_time", gs.LastAnswerTime)
+	if gs.LastAnswerBest {
+		return m.Theme.Success.Render(line + "  " + m.T("trainer.new_best"))
+	}
+	if gs.CurrentExercise != nil && gs.Stats != nil {
+		if best, ok := gs.Stats.BestTimes[gs.CurrentExercise.ID]; ok && !gs.IsPlacementMode {
+			line += "  " + m.Tf("trainer.best_time", best)
+		}
+	}
+	return m.Theme.Muted.Render(line)
+}
+
 func (m Model) renderTrainerBossResult() string {
 	var s strings.Builder
 
 	// Victory or defeat
 	if m.TrainerLastCorrect {
-		s.WriteString(SuccessStyle.Render("🏆 VICTORY! 🏆"))
+		s.WriteString(m.Theme.Success.Render(m.T("trainer_boss_result.victory")))
 		s.WriteString("\n\n")
 		if m.TrainerGameState != nil && m.TrainerGameState.CurrentBoss != nil {
-			s.WriteString(TitleStyle.Render("You defeated " + m.TrainerGameState.CurrentBoss.Name + "!"))
+			s.WriteString(m.Theme.Title.Render(m.Tf("trainer_boss_result.you_won", m.TrainerGameState.CurrentBoss.Name)))
 			s.WriteString("\n\n")
-			s.WriteString(InfoStyle.Render(fmt.Sprintf("Lives remaining: %s", strings.Repeat("❤️ ", m.TrainerGameState.BossLives))))
+			s.WriteString(m.renderBossTimes())
+			s.WriteString(m.Theme.Info.Render(m.Tf("trainer_boss_result.lives", strings.Repeat("❤️ ", m.TrainerGameState.BossLives))))
 			s.WriteString("\n")
 		}
 		s.WriteString("\n")
-		s.WriteString(SuccessStyle.Render("🎉 +500 bonus points!"))
+		s.WriteString(m.Theme.Success.Render(m.T("trainer_boss_result.bonus")))
 		s.WriteString("\n")
-		s.WriteString(SuccessStyle.Render("🔓 Next module unlocked!"))
+		if m.TrainerGameState != nil && m.TrainerGameState.LastBossUnderPar {
+			s.WriteString(m.Theme.Success.Render(m.Tf("trainer_boss_result.under_par", trainer.BossSpeedBonus)))
+			s.WriteString("\n")
+		}
+		s.WriteString(m.Theme.Success.Render(m.T("trainer_boss_result.unlocked")))
 	} else {
-		s.WriteString(DangerStyle.Render("💀 DEFEATED 💀"))
+		s.WriteString(m.Theme.Danger.Render(m.T("trainer_boss_result.defeated")))
 		s.WriteString("\n\n")
 		if m.TrainerGameState != nil && m.TrainerGameState.CurrentBoss != nil {
-			s.WriteString(MutedStyle.Render(m.TrainerGameState.CurrentBoss.Name + " wins this time..."))
+			s.WriteString(m.Theme.Muted.Render(m.Tf("trainer_boss_result.boss_wins", m.TrainerGameState.CurrentBoss.Name)))
 			s.WriteString("\n\n")
 		}
-		s.WriteString(InfoStyle.Render("Keep practicing and try again!"))
+		s.WriteString(m.Theme.Info.Render(m.T("trainer_boss_result.try_again")))
 	}
 
 	// Show message
 	if m.TrainerMessage != "" {
 		s.WriteString("\n\n")
-		s.WriteString(MutedStyle.Render(m.TrainerMessage))
+		s.WriteString(m.Theme.Muted.Render(m.TrainerMessage))
 	}
 
 	// Stats
 	if m.TrainerStats != nil {
 		s.WriteString("\n\n")
-		s.WriteString(MutedStyle.Render(fmt.Sprintf("Total Score: %d  |  Bosses Defeated: %d/7", m.TrainerStats.TotalScore, len(m.TrainerStats.BossesDefeated))))
+		s.WriteString(m.Theme.Muted.Render(m.Tf("trainer_boss_result.totals", m.TrainerStats.TotalScore, len(m.TrainerStats.BossesDefeated))))
 	}
 
 	// Help
 	s.WriteString("\n\n")
-	s.WriteString(HelpStyle.Render("[Enter/Space/Esc/q] return to menu"))
+	s.WriteString(m.Theme.Help.Render(m.T("trainer_boss_result.help")))
 
 	return s.String()
 }
@@ -2228,11 +3000,11 @@ func (m Model) renderTrainerBossResult() string {
 func (m Model) renderProjectPath() string {
 	var s strings.Builder
 
-	s.WriteString(TitleStyle.Render(m.GetScreenTitle()))
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
 	s.WriteString("\n\n")
 	desc := m.GetScreenDescription()
 	if desc != "" {
-		s.WriteString(MutedStyle.Render(desc))
+		s.WriteString(m.Theme.Muted.Render(desc))
 		s.WriteString("\n\n")
 	}
 
@@ -2241,7 +3013,17 @@ func (m Model) renderProjectPath() string {
 		s.WriteString(m.renderPathCompletion())
 	case PathModeBrowser:
 		s.WriteString(m.renderPathBrowser())
+	case PathModeNewDir:
+		s.WriteString(m.renderPathBrowser())
+		s.WriteString("\n\n")
+		s.WriteString(m.renderNewDirPrompt())
+	case PathModeRecent:
+		s.WriteString(m.renderRecentProjects())
+		s.WriteString(m.renderPathInput())
+		s.WriteString("\n")
+		s.WriteString(m.Theme.Help.Render("  " + m.T("project_path.help_recent")))
 	default:
+		s.WriteString(m.renderRecentProjects())
 		s.WriteString(m.renderPathTyping())
 	}
 
@@ -2252,6 +3034,16 @@ func (m Model) renderProjectPath() string {
 func (m Model) renderPathTyping() string {
 	var s strings.Builder
 
+	s.WriteString(m.renderPathInput())
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render("  " + m.T("project_path.help")))
+	return s.String()
+}
+
+// renderPathInput renders the path input line with its cursor and any error
+func (m Model) renderPathInput() string {
+	var s strings.Builder
+
 	runes := []rune(m.ProjectPathInput)
 	cursor := m.ProjectPathCursor
 	if cursor > len(runes) {
@@ -2262,23 +3054,21 @@ func (m Model) renderPathTyping() string {
 	s.WriteString("  > ")
 	if cursor < len(runes) {
 		s.WriteString(string(runes[:cursor]))
-		s.WriteString(CursorStyle.Render(string(runes[cursor])))
+		s.WriteString(m.Theme.Cursor.Render(string(runes[cursor])))
 		if cursor+1 < len(runes) {
 			s.WriteString(string(runes[cursor+1:]))
 		}
 	} else {
 		s.WriteString(string(runes))
-		s.WriteString(CursorStyle.Render(" "))
+		s.WriteString(m.Theme.Cursor.Render(" "))
 	}
 	s.WriteString("\n")
 
 	if m.ProjectPathError != "" {
 		s.WriteString("\n")
-		s.WriteString(ErrorStyle.Render("  ⚠ " + m.ProjectPathError))
+		s.WriteString(m.Theme.Error.Render("  ⚠ " + m.ProjectPathError))
 		s.WriteString("\n")
 	}
-	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("  Tab: complete  •  Ctrl+B: browse  •  Enter: confirm  •  Esc: cancel"))
 	return s.String()
 }
 
@@ -2289,7 +3079,7 @@ func (m Model) renderPathCompletion() string {
 	// Show the current input
 	s.WriteString("  > " + m.ProjectPathInput)
 	s.WriteString("\n\n")
-	s.WriteString(MutedStyle.Render("  Matches:"))
+	s.WriteString(m.Theme.Muted.Render("  " + m.T("project_path.matches")))
 	s.WriteString("\n")
 
 	maxVisible := 8
@@ -2304,26 +3094,26 @@ func (m Model) renderPathCompletion() string {
 	}
 
 	if start > 0 {
-		s.WriteString(MutedStyle.Render("    ↑ more"))
+		s.WriteString(m.Theme.Muted.Render("    " + m.T("common.more_up")))
 		s.WriteString("\n")
 	}
 
 	for i := start; i < end; i++ {
 		if i == m.ProjectPathCompIdx {
-			s.WriteString(SelectedStyle.Render("▸ " + m.ProjectPathCompletions[i] + "/"))
+			s.WriteString(m.Theme.Selected.Render("▸ " + m.ProjectPathCompletions[i] + "/"))
 		} else {
-			s.WriteString(UnselectedStyle.Render(m.ProjectPathCompletions[i] + "/"))
+			s.WriteString(m.Theme.Unselected.Render(m.ProjectPathCompletions[i] + "/"))
 		}
 		s.WriteString("\n")
 	}
 
 	if end < total {
-		s.WriteString(MutedStyle.Render("    ↓ more"))
+		s.WriteString(m.Theme.Muted.Render("    " + m.T("common.more_down")))
 		s.WriteString("\n")
 	}
 
 	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("  ↑/↓: navigate  •  Enter/Tab: select  •  Esc: cancel"))
+	s.WriteString(m.Theme.Help.Render("  " + m.T("project_path.help_matches")))
 	return s.String()
 }
 
@@ -2332,7 +3122,7 @@ func (m Model) renderPathBrowser() string {
 	var s strings.Builder
 
 	displayRoot := contractHome(m.FileBrowserRoot)
-	s.WriteString(InfoStyle.Render("  Browsing: " + displayRoot))
+	s.WriteString(m.Theme.Info.Render("  " + m.Tf("project_path.browsing", displayRoot)))
 	s.WriteString("\n\n")
 
 	// Build items: [0] select, [1] ../, [2..] entries
@@ -2340,7 +3130,7 @@ func (m Model) renderPathBrowser() string {
 		label string
 	}
 	items := []item{
-		{label: "✅ Select this directory"},
+		{label: m.T("project_path.select_dir")},
 		{label: "⬆️  ../"},
 	}
 	for _, e := range m.FileBrowserEntries {
@@ -2348,10 +3138,7 @@ func (m Model) renderPathBrowser() string {
 	}
 
 	// Scrolling
-	visibleLines := m.Height - 12
-	if visibleLines < 3 {
-		visibleLines = 3
-	}
+	visibleLines := m.visibleRows(12)
 	start := m.FileBrowserScroll
 	end := start + visibleLines
 	if end > len(items) {
@@ -2359,26 +3146,29 @@ func (m Model) renderPathBrowser() string {
 	}
 
 	if start > 0 {
-		s.WriteString(MutedStyle.Render("    ↑ more"))
+		s.WriteString(m.Theme.Muted.Render("    " + m.T("common.more_up")))
 		s.WriteString("\n")
 	}
 
 	for i := start; i < end; i++ {
 		if i == m.FileBrowserCursor {
-			s.WriteString(SelectedStyle.Render("▸ " + items[i].label))
+			s.WriteString(m.Theme.Selected.Render("▸ " + items[i].label))
 		} else {
-			s.WriteString(UnselectedStyle.Render(items[i].label))
+			s.WriteString(m.Theme.Unselected.Render(items[i].label))
 		}
 		s.WriteString("\n")
 	}
 
 	if end < len(items) {
-		s.WriteString(MutedStyle.Render("    ↓ more"))
+		s.WriteString(m.Theme.Muted.Render("    " + m.T("common.more_down")))
 		s.WriteString("\n")
 	}
 
+	if m.ProjectPathMode == PathModeNewDir {
+		return s.String()
+	}
 	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("  j/↓ k/↑: move  •  Enter/l: open  •  h: up  •  .: hidden  •  Esc: close"))
+	s.WriteString(m.Theme.Help.Render("  " + m.T("project_path.help_browser")))
 	return s.String()
 }
 
@@ -2386,41 +3176,66 @@ func (m Model) renderPathBrowser() string {
 func (m Model) renderProjectConfirm() string {
 	var s strings.Builder
 
-	s.WriteString(TitleStyle.Render(m.GetScreenTitle()))
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
 	s.WriteString("\n\n")
-	s.WriteString(InfoStyle.Render("  Configuration Summary:"))
+	s.WriteString(m.Theme.Info.Render("  " + m.T("project_confirm.summary")))
 	s.WriteString("\n\n")
-	s.WriteString(fmt.Sprintf("    Path:    %s\n", m.ProjectPathInput))
-	s.WriteString(fmt.Sprintf("    Stack:   %s\n", m.ProjectStack))
-	s.WriteString(fmt.Sprintf("    Memory:  %s\n", m.ProjectMemory))
+	if m.ProjectBatch != nil {
+		s.WriteString(fmt.Sprintf("    %-9s%s\n", m.T("project_confirm.parent"), m.ProjectPathInput))
+		s.WriteString("    " + m.Tf("project_confirm.projects", m.batchSelectedCount()) + "\n")
+		for _, it := range m.ProjectBatch {
+			if it.Selected {
+				s.WriteString(fmt.Sprintf("      %s (%s)\n", it.Name(), it.Stack))
+			}
+		}
+	} else {
+		s.WriteString(fmt.Sprintf("    %-9s%s\n", m.T("project_confirm.path"), m.ProjectPathInput))
+		s.WriteString(fmt.Sprintf("    %-9s%s\n", m.T("project_confirm.stack"), m.projectStackSummary()))
+	}
+	s.WriteString(fmt.Sprintf("    %-9s%s\n", m.T("project_confirm.memory"), m.ProjectMemory))
 	if m.ProjectMemory == "obsidian-brain" {
-		engram := "No"
+		engram := m.T("project_confirm.no")
 		if m.ProjectEngram {
-			engram = "Yes"
+			engram = m.T("project_confirm.yes")
 		}
-		s.WriteString(fmt.Sprintf("    Engram:  %s\n", engram))
+		s.WriteString(fmt.Sprintf("    %-9s%s\n", m.T("project_confirm.engram"), engram))
 		if len(m.ProjectRolePacks) > 0 {
-			s.WriteString(fmt.Sprintf("    Packs:   %s\n", strings.Join(m.ProjectRolePacks, ", ")))
+			s.WriteString(fmt.Sprintf("    %-9s%s\n", m.T("project_confirm.packs"), strings.Join(m.ProjectRolePacks, ", ")))
+		}
+	}
+	s.WriteString(fmt.Sprintf("    %-9s%s\n", m.T("project_confirm.ci"), m.ProjectCI))
+	if m.ProjectMemory == "simple" {
+		if len(m.ProjectCommands) == 0 {
+			s.WriteString(m.Theme.Muted.Render("    " + m.T("project_confirm.no_commands")))
+			s.WriteString("\n")
+		} else {
+			s.WriteString("\n    " + m.T("project_confirm.commands") + "\n")
+			for _, c := range m.ProjectCommands {
+				s.WriteString(fmt.Sprintf("      %-8s %s\n", c.Purpose, c.Command))
+			}
 		}
 	}
-	s.WriteString(fmt.Sprintf("    CI:      %s\n", m.ProjectCI))
 	s.WriteString("\n")
 
 	// Options
 	options := m.GetCurrentOptions()
 	for i, opt := range options {
 		cursor := "  "
-		style := UnselectedStyle
+		style := m.Theme.Unselected
 		if i == m.Cursor {
 			cursor = "▸ "
-			style = SelectedStyle
+			style = m.Theme.Selected
+		}
+		if strings.HasSuffix(opt, m.T("skill_menu.nothing_to_undo")) {
+			style = m.Theme.Muted
 		}
-		s.WriteString(style.Render(cursor + opt))
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
 		s.WriteString("\n")
 	}
 
 	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("↑/k up • ↓/j down • [Enter] select • [Esc] back"))
+	s.WriteString(m.Theme.Help.Render(m.T("help.select_back")))
 	return s.String()
 }
 
@@ -2428,37 +3243,26 @@ func (m Model) renderProjectConfirm() string {
 func (m Model) renderProjectInstalling() string {
 	var s strings.Builder
 
-	s.WriteString(TitleStyle.Render(m.GetScreenTitle()))
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
 	s.WriteString("\n\n")
 
 	// Spinner
 	spinners := []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
 	spinner := spinners[m.SpinnerFrame%len(spinners)]
-	s.WriteString(fmt.Sprintf("  %s Initializing project...\n\n", spinner))
+	if m.ProjectBatch != nil {
+		s.WriteString(fmt.Sprintf("  %s %s\n", spinner, m.Tf("project_installing.initializing", m.ProjectBatch[m.ProjectBatchCurrent].Name())))
+		s.WriteString(m.renderProjectBatchProgress())
+	} else {
+		s.WriteString(fmt.Sprintf("  %s %s\n\n", spinner, m.T("project_installing.initializing_one")))
+	}
 
 	// Log lines
-	for _, line := range m.ProjectLogLines {
-		s.WriteString("    " + line + "\n")
+	if header := m.ProjectLogLines.Header(); header != "" {
+		s.WriteString(m.Theme.Muted.Render("    "+header) + "\n")
 	}
-	return s.String()
-}
-
-// renderProjectResult renders the project initialization result screen
-func (m Model) renderProjectResult() string {
-	var s strings.Builder
-
-	s.WriteString(TitleStyle.Render(m.GetScreenTitle()))
-	s.WriteString("\n\n")
-
-	if m.ErrorMsg != "" {
-		s.WriteString(ErrorStyle.Render("  ❌ Project initialization failed"))
-		s.WriteString("\n\n")
-		s.WriteString("    " + m.ErrorMsg)
-	} else {
-		s.WriteString(SuccessStyle.Render("  ✅ Project initialized successfully!"))
+	for _, line := range m.ProjectLogLines.Lines() {
+		s.WriteString("    " + line + "\n")
 	}
-	s.WriteString("\n\n")
-	s.WriteString(HelpStyle.Render("  Press Enter to return to the main menu"))
 	return s.String()
 }
 
@@ -2466,39 +3270,41 @@ func (m Model) renderProjectResult() string {
 func (m Model) renderSkillBrowse() string {
 	var s strings.Builder
 
-	s.WriteString(TitleStyle.Render(m.GetScreenTitle()))
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render(m.GetScreenDescription()))
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
 	s.WriteString("\n\n")
 
 	if m.SkillLoading {
 		spinners := []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
 		spinner := spinners[m.SpinnerFrame%len(spinners)]
-		s.WriteString(fmt.Sprintf("  %s Fetching skill catalog...\n", spinner))
+		s.WriteString(fmt.Sprintf("  %s %s\n", spinner, m.T("common.skill_catalog_fetching")))
+		s.WriteString(m.renderSkillCloneProgress())
 		return s.String()
 	}
 	if m.SkillLoadError != "" {
-		s.WriteString(ErrorStyle.Render("  ⚠ " + m.SkillLoadError))
+		s.WriteString(m.Theme.Error.Render("  ⚠ " + m.SkillLoadError))
 		s.WriteString("\n\n")
-		s.WriteString(HelpStyle.Render("  Press Esc to go back"))
+		s.WriteString(m.Theme.Help.Render("  " + m.T("help.esc_back")))
 		return s.String()
 	}
+	if n := len(m.SkillCatalogWarnings); n > 0 {
+		s.WriteString(m.Theme.Warning.Render("  ⚠ " + skillWarningsNotice(n)))
+		s.WriteString("\n\n")
+	}
 
-	options := m.GetCurrentOptions()
+	rows := m.skillRows()
 
 	// Calculate visible area
-	visibleItems := m.Height - 8
-	if visibleItems < 5 {
-		visibleItems = 5
-	}
-	if visibleItems > len(options) {
-		visibleItems = len(options)
+	visibleItems := m.visibleRows(8)
+	if visibleItems > len(rows) {
+		visibleItems = len(rows)
 	}
 
 	start := m.SkillScroll
 	end := start + visibleItems
-	if end > len(options) {
-		end = len(options)
+	if end > len(rows) {
+		end = len(rows)
 		start = end - visibleItems
 		if start < 0 {
 			start = 0
@@ -2507,81 +3313,113 @@ func (m Model) renderSkillBrowse() string {
 
 	// Scroll-up indicator
 	if start > 0 {
-		s.WriteString(MutedStyle.Render(fmt.Sprintf("  ▲ %d more above", start)))
+		s.WriteString(m.Theme.Muted.Render("  " + m.Tf("common.more_above", start)))
 		s.WriteString("\n")
 	}
 
 	for i := start; i < end; i++ {
-		opt := options[i]
-		if strings.HasPrefix(opt, "───") {
-			s.WriteString(MutedStyle.Render(opt))
+		row := rows[i]
+		opt := row.Label
+		if row.Kind == RowSeparator {
+			s.WriteString(m.Theme.Muted.Render(opt))
 			s.WriteString("\n")
 			continue
 		}
 		// Group headers are rendered differently
-		if strings.HasPrefix(opt, "📦") || strings.HasPrefix(opt, "🌐") {
-			s.WriteString(InfoStyle.Render("  " + opt))
+		if row.Kind == RowHeader {
+			s.WriteString(m.Theme.Info.Render("  " + opt))
 			s.WriteString("\n")
 			continue
 		}
 		cursor := "  "
-		style := UnselectedStyle
+		style := m.Theme.Unselected
 		if i == m.Cursor {
 			cursor = "▸ "
-			style = SelectedStyle
+			style = m.Theme.Selected
 		}
-		s.WriteString(style.Render(cursor + opt))
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+opt))
 		s.WriteString("\n")
 	}
 
 	// Scroll-down indicator
-	if end < len(options) {
-		s.WriteString(MutedStyle.Render(fmt.Sprintf("  ▼ %d more below", len(options)-end)))
+	if end < len(rows) {
+		s.WriteString(m.Theme.Muted.Render("  " + m.Tf("common.more_below", len(rows)-end)))
 		s.WriteString("\n")
 	}
 
 	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("↑/k up • ↓/j down • [Enter] back • [Esc] back"))
+	help := m.T("skill_browse.help")
+	if len(skillCatalogTags(m.SkillCatalog)) > 0 {
+		help = m.T("skill_browse.help_tags")
+	}
+	s.WriteString(m.Theme.Help.Render(help))
 	return s.String()
 }
 
+// renderSkillFilter renders the filter input of the install/remove screens, or "" when unused
+func (m Model) renderSkillFilter() string {
+	if m.SkillFilterActive {
+		return m.Theme.Highlight.Render("  / " + m.SkillFilter + "█")
+	}
+	if m.SkillFilter != "" {
+		return m.Theme.Info.Render("  "+m.Tf("skill_install.filter", m.SkillFilter)) + m.Theme.Muted.Render("  "+m.T("skill_install.filter_clear"))
+	}
+	return ""
+}
+
+// skillSelectHelp is the help line of the install/remove screens
+func (m Model) skillSelectHelp() string {
+	if m.SkillFilterActive {
+		return m.T("skill_install.help_filter")
+	}
+	return m.T("skill_install.help")
+}
+
 // renderSkillInstall renders the skill install multi-select screen with viewport scrolling
 func (m Model) renderSkillInstall() string {
 	var s strings.Builder
 
-	s.WriteString(TitleStyle.Render(m.GetScreenTitle()))
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render(m.GetScreenDescription()))
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
 	s.WriteString("\n\n")
 
 	if m.SkillLoading {
 		spinners := []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
 		spinner := spinners[m.SpinnerFrame%len(spinners)]
-		s.WriteString(fmt.Sprintf("  %s Fetching skill catalog...\n", spinner))
+		s.WriteString(fmt.Sprintf("  %s %s\n", spinner, m.T("common.skill_catalog_fetching")))
+		s.WriteString(m.renderSkillCloneProgress())
 		return s.String()
 	}
 	if m.SkillLoadError != "" {
-		s.WriteString(ErrorStyle.Render("  ⚠ " + m.SkillLoadError))
+		s.WriteString(m.Theme.Error.Render("  ⚠ " + m.SkillLoadError))
 		s.WriteString("\n\n")
-		s.WriteString(HelpStyle.Render("  Press Esc to go back"))
+		s.WriteString(m.Theme.Help.Render("  " + m.T("help.esc_back")))
 		return s.String()
 	}
 
-	options := m.GetCurrentOptions()
+	if m.SkillNotice != "" {
+		s.WriteString(m.Theme.Warning.Render("  ⚠ " + m.SkillNotice))
+		s.WriteString("\n\n")
+	}
+	if filter := m.renderSkillFilter(); filter != "" {
+		s.WriteString(filter)
+		s.WriteString("\n\n")
+	}
+
+	rows := m.skillRows()
 
 	// Calculate visible area
-	visibleItems := m.Height - 8
-	if visibleItems < 5 {
-		visibleItems = 5
-	}
-	if visibleItems > len(options) {
-		visibleItems = len(options)
+	visibleItems := m.visibleRows(8)
+	if visibleItems > len(rows) {
+		visibleItems = len(rows)
 	}
 
 	start := m.SkillScroll
 	end := start + visibleItems
-	if end > len(options) {
-		end = len(options)
+	if end > len(rows) {
+		end = len(rows)
 		start = end - visibleItems
 		if start < 0 {
 			start = 0
@@ -2590,51 +3428,53 @@ func (m Model) renderSkillInstall() string {
 
 	// Scroll-up indicator
 	if start > 0 {
-		s.WriteString(MutedStyle.Render(fmt.Sprintf("  ▲ %d more above", start)))
+		s.WriteString(m.Theme.Muted.Render("  " + m.Tf("common.more_above", start)))
 		s.WriteString("\n")
 	}
 
 	for i := start; i < end; i++ {
-		opt := options[i]
-		if strings.HasPrefix(opt, "───") {
-			s.WriteString(MutedStyle.Render(opt))
+		row := rows[i]
+		opt := row.Label
+		if row.Kind == RowSeparator {
+			s.WriteString(m.Theme.Muted.Render(opt))
 			s.WriteString("\n")
 			continue
 		}
 
 		cursor := "  "
-		style := UnselectedStyle
+		style := m.Theme.Unselected
 		if i == m.Cursor {
 			cursor = "▸ "
-			style = SelectedStyle
+			style = m.Theme.Selected
 		}
 
+		m.VisibleRows.mark(&s, i)
+
 		// Checkbox for skill items (not Select All, Confirm, or headers)
-		idx := skillOptionToIndex(options, i)
-		if idx >= 0 && idx < len(m.SkillSelected) {
+		if idx := row.SkillIdx; row.Kind == RowSkill && idx < len(m.SkillSelected) {
 			check := "[ ]"
 			if m.SkillSelected[idx] {
 				check = "[✓]"
 			}
 			s.WriteString(style.Render(fmt.Sprintf("%s%s %s", cursor, check, opt)))
-		} else if gStart, gEnd := skillGroupRange(options, i); gStart >= 0 {
+		} else if group := skillGroupIndexes(rows, i); group != nil {
 			// Category header — show group selection state
-			check := skillGroupCheck(m.SkillSelected, gStart, gEnd)
+			check := skillGroupCheck(m.SkillSelected, group)
 			s.WriteString(style.Render(fmt.Sprintf("%s%s %s", cursor, check, opt)))
 		} else {
-			s.WriteString(style.Render(cursor + opt))
+			s.WriteString(m.renderRow(style, cursor+opt))
 		}
 		s.WriteString("\n")
 	}
 
 	// Scroll-down indicator
-	if end < len(options) {
-		s.WriteString(MutedStyle.Render(fmt.Sprintf("  ▼ %d more below", len(options)-end)))
+	if end < len(rows) {
+		s.WriteString(m.Theme.Muted.Render("  " + m.Tf("common.more_below", len(rows)-end)))
 		s.WriteString("\n")
 	}
 
 	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("↑/k up • ↓/j down • [Enter/Space] toggle • [Esc] back"))
+	s.WriteString(m.Theme.Help.Render(m.skillSelectHelp()))
 	return s.String()
 }
 
@@ -2642,47 +3482,53 @@ func (m Model) renderSkillInstall() string {
 func (m Model) renderSkillRemove() string {
 	var s strings.Builder
 
-	s.WriteString(TitleStyle.Render(m.GetScreenTitle()))
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render(m.GetScreenDescription()))
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
 	s.WriteString("\n\n")
 
 	if m.SkillLoading {
 		spinners := []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
 		spinner := spinners[m.SpinnerFrame%len(spinners)]
-		s.WriteString(fmt.Sprintf("  %s Loading installed skills...\n", spinner))
+		s.WriteString(fmt.Sprintf("  %s %s\n", spinner, m.T("skill_remove.loading")))
 		return s.String()
 	}
 	if m.SkillLoadError != "" {
-		s.WriteString(ErrorStyle.Render("  ⚠ " + m.SkillLoadError))
+		s.WriteString(m.Theme.Error.Render("  ⚠ " + m.SkillLoadError))
 		s.WriteString("\n\n")
-		s.WriteString(HelpStyle.Render("  Press Esc to go back"))
+		s.WriteString(m.Theme.Help.Render("  " + m.T("help.esc_back")))
 		return s.String()
 	}
 
 	installed := m.getInstalledSkills()
 	if len(installed) == 0 {
-		s.WriteString("  No skills installed\n")
+		s.WriteString("  " + m.T("skill_remove.none") + "\n")
 		s.WriteString("\n")
-		s.WriteString(HelpStyle.Render("  Press Esc to go back"))
+		s.WriteString(m.Theme.Help.Render("  " + m.T("help.esc_back")))
 		return s.String()
 	}
 
-	options := m.GetCurrentOptions()
+	if m.SkillNotice != "" {
+		s.WriteString(m.Theme.Warning.Render("  ⚠ " + m.SkillNotice))
+		s.WriteString("\n\n")
+	}
+	if filter := m.renderSkillFilter(); filter != "" {
+		s.WriteString(filter)
+		s.WriteString("\n\n")
+	}
+
+	rows := m.skillRows()
 
 	// Calculate visible area
-	visibleItems := m.Height - 8
-	if visibleItems < 5 {
-		visibleItems = 5
-	}
-	if visibleItems > len(options) {
-		visibleItems = len(options)
+	visibleItems := m.visibleRows(8)
+	if visibleItems > len(rows) {
+		visibleItems = len(rows)
 	}
 
 	start := m.SkillScroll
 	end := start + visibleItems
-	if end > len(options) {
-		end = len(options)
+	if end > len(rows) {
+		end = len(rows)
 		start = end - visibleItems
 		if start < 0 {
 			start = 0
@@ -2691,51 +3537,53 @@ func (m Model) renderSkillRemove() string {
 
 	// Scroll-up indicator
 	if start > 0 {
-		s.WriteString(MutedStyle.Render(fmt.Sprintf("  ▲ %d more above", start)))
+		s.WriteString(m.Theme.Muted.Render("  " + m.Tf("common.more_above", start)))
 		s.WriteString("\n")
 	}
 
 	for i := start; i < end; i++ {
-		opt := options[i]
-		if strings.HasPrefix(opt, "───") {
-			s.WriteString(MutedStyle.Render(opt))
+		row := rows[i]
+		opt := row.Label
+		if row.Kind == RowSeparator {
+			s.WriteString(m.Theme.Muted.Render(opt))
 			s.WriteString("\n")
 			continue
 		}
 
 		cursor := "  "
-		style := UnselectedStyle
+		style := m.Theme.Unselected
 		if i == m.Cursor {
 			cursor = "▸ "
-			style = SelectedStyle
+			style = m.Theme.Selected
 		}
 
+		m.VisibleRows.mark(&s, i)
+
 		// Checkbox for skill items (not Select All or Confirm)
-		idx := skillOptionToIndex(options, i)
-		if idx >= 0 && idx < len(m.SkillSelected) {
+		if idx := row.SkillIdx; row.Kind == RowSkill && idx < len(m.SkillSelected) {
 			check := "[ ]"
 			if m.SkillSelected[idx] {
 				check = "[✓]"
 			}
 			s.WriteString(style.Render(fmt.Sprintf("%s%s %s", cursor, check, opt)))
-		} else if gStart, gEnd := skillGroupRange(options, i); gStart >= 0 {
+		} else if group := skillGroupIndexes(rows, i); group != nil {
 			// Category header — show group selection state
-			check := skillGroupCheck(m.SkillSelected, gStart, gEnd)
+			check := skillGroupCheck(m.SkillSelected, group)
 			s.WriteString(style.Render(fmt.Sprintf("%s%s %s", cursor, check, opt)))
 		} else {
-			s.WriteString(style.Render(cursor + opt))
+			s.WriteString(m.renderRow(style, cursor+opt))
 		}
 		s.WriteString("\n")
 	}
 
 	// Scroll-down indicator
-	if end < len(options) {
-		s.WriteString(MutedStyle.Render(fmt.Sprintf("  ▼ %d more below", len(options)-end)))
+	if end < len(rows) {
+		s.WriteString(m.Theme.Muted.Render("  " + m.Tf("common.more_below", len(rows)-end)))
 		s.WriteString("\n")
 	}
 
 	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("↑/k up • ↓/j down • [Enter/Space] toggle • [Esc] back"))
+	s.WriteString(m.Theme.Help.Render(m.skillSelectHelp()))
 	return s.String()
 }
 
@@ -2743,23 +3591,39 @@ func (m Model) renderSkillRemove() string {
 func (m Model) renderSkillResult() string {
 	var s strings.Builder
 
-	s.WriteString(TitleStyle.Render(m.GetScreenTitle()))
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
 	s.WriteString("\n\n")
 
 	if m.ErrorMsg != "" {
-		s.WriteString(WarningStyle.Render("  ⚠ Some operations failed"))
+		s.WriteString(m.Theme.Warning.Render("  " + m.T("skill_result.some_failed")))
 		s.WriteString("\n\n")
 	} else {
-		s.WriteString(SuccessStyle.Render("  ✅ All operations completed"))
+		s.WriteString(m.Theme.Success.Render("  " + m.T("skill_result.all_done")))
 		s.WriteString("\n\n")
 	}
 
-	for _, line := range m.SkillResultLog {
+	if header := m.SkillResultLog.Header(); header != "" {
+		s.WriteString(m.Theme.Muted.Render("    "+header) + "\n")
+	}
+	for _, line := range m.SkillResultLog.Lines() {
 		s.WriteString("    " + line + "\n")
 	}
+	if m.SkillLogPath != "" {
+		s.WriteString("\n")
+		s.WriteString(m.Theme.Muted.Render("    " + m.Tf("common.full_log", contractHome(m.SkillLogPath))))
+		s.WriteString("\n")
+	}
 
+	var keys []string
+	if len(m.SkillOrphans) > 0 {
+		keys = append(keys, m.T("skill_result.key_orphans"))
+	}
+	if m.SkillUndo.Undoable() {
+		keys = append(keys, m.Tf("skill_result.key_undo", m.SkillUndo.Label()))
+	}
+	keys = append(keys, m.T("skill_result.key_return"))
 	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("  Press Enter to return"))
+	s.WriteString(m.Theme.Help.Render("  " + m.Tf("skill_result.press", strings.Join(keys, " • "))))
 	return s.String()
 }
 
@@ -2767,18 +3631,148 @@ func (m Model) renderSkillResult() string {
 func (m Model) renderSkillUpdate() string {
 	var s strings.Builder
 
-	s.WriteString(TitleStyle.Render(m.GetScreenTitle()))
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
+	s.WriteString("\n\n")
+
+	if m.SkillLoading {
+		spinners := []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+		spinner := spinners[m.SpinnerFrame%len(spinners)]
+		s.WriteString(fmt.Sprintf("  %s %s\n", spinner, m.T("skill_update.updating")))
+		s.WriteString(m.renderSkillCloneProgress())
+	}
+
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render("  " + m.T("help.wait")))
+	return s.String()
+}
+
+// skillStatsVisibleLines is how many stats lines fit below the header
+func (m Model) skillStatsVisibleLines() int {
+	return m.visibleRows(8)
+}
+
+// renderSkillStats renders the read-only catalog statistics with viewport scrolling
+func (m Model) renderSkillStats() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
 	s.WriteString("\n")
-	s.WriteString(MutedStyle.Render(m.GetScreenDescription()))
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
 	s.WriteString("\n\n")
 
 	if m.SkillLoading {
 		spinners := []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
 		spinner := spinners[m.SpinnerFrame%len(spinners)]
-		s.WriteString(fmt.Sprintf("  %s Updating catalog...\n", spinner))
+		s.WriteString(fmt.Sprintf("  %s %s\n", spinner, m.T("skill_stats.reading")))
+		return s.String()
+	}
+	if m.SkillLoadError != "" || m.SkillStats == nil {
+		s.WriteString(m.Theme.Error.Render("  ⚠ " + m.SkillLoadError))
+		s.WriteString("\n\n")
+		s.WriteString(m.Theme.Help.Render("  " + m.T("help.esc_back")))
+		return s.String()
+	}
+
+	m.writeScrolledLines(&s, skillStatsLines(*m.SkillStats), m.SkillScroll, m.skillStatsVisibleLines())
+
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render(m.T("help.back_enter_esc")))
+	return s.String()
+}
+
+// writeScrolledLines writes the visible window of a read-only line list with
+// scroll markers; lines ending in ":" are rendered as section headings
+func (m Model) writeScrolledLines(s *strings.Builder, lines []string, scroll, visible int) {
+	t := m.Theme
+	start := scroll
+	end := start + visible
+	if end > len(lines) {
+		end = len(lines)
+	}
+	if start > end {
+		start = end
+	}
+
+	if start > 0 {
+		s.WriteString(t.Muted.Render("  " + m.Tf("common.more_above", start)))
+		s.WriteString("\n")
+	}
+	for _, line := range lines[start:end] {
+		if strings.HasSuffix(line, ":") {
+			s.WriteString(t.Subtitle.Render(line))
+		} else {
+			s.WriteString(t.Unselected.Render(line))
+		}
+		s.WriteString("\n")
+	}
+	if end < len(lines) {
+		s.WriteString(t.Muted.Render("  " + m.Tf("common.more_below", len(lines)-end)))
+		s.WriteString("\n")
 	}
+}
 
+// skillDetailViewHeight is how many detail lines fit on screen.
+// Reserve space for: title(1) + description(1) + blank(2) + scroll info(2) + help(2) = 8 lines
+func (m Model) skillDetailViewHeight() int {
+	return m.visibleRows(8)
+}
+
+// skillDetailContent builds the detail lines for the current terminal width
+func (m Model) skillDetailContent() []skillDetailLine {
+	width := m.Width - 4
+	if m.Width == 0 {
+		width = 76
+	}
+	return skillDetailLines(*m.SkillDetail, m.SkillDoc, m.SkillTree, width)
+}
+
+// renderSkillDetail renders one skill's frontmatter, SKILL.md body and file tree
+// in a scrollable viewport, like the LazyVim topics
+func (m Model) renderSkillDetail() string {
+	var s strings.Builder
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
 	s.WriteString("\n")
-	s.WriteString(HelpStyle.Render("  Please wait..."))
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
+	s.WriteString("\n\n")
+
+	if m.SkillDetail == nil {
+		s.WriteString(m.Theme.Help.Render("  " + m.T("help.esc_back")))
+		return s.String()
+	}
+
+	allLines := m.skillDetailContent()
+	viewHeight := m.skillDetailViewHeight()
+	start := m.SkillDetailPos
+	end := start + viewHeight
+	if end > len(allLines) {
+		end = len(allLines)
+	}
+	if start > len(allLines) {
+		start = 0
+	}
+
+	for _, line := range allLines[start:end] {
+		switch line.Kind {
+		case skillDetailHeading:
+			s.WriteString(m.Theme.Subtitle.Render(line.Text))
+		case skillDetailCode:
+			s.WriteString(m.Theme.Code.Render(line.Text))
+		default:
+			s.WriteString(m.Theme.Info.Render(line.Text))
+		}
+		s.WriteString("\n")
+	}
+
+	if len(allLines) > viewHeight {
+		s.WriteString("\n")
+		scrollInfo := m.Tf("common.lines_scroll", start+1, end, len(allLines))
+		s.WriteString(m.Theme.Muted.Render(scrollInfo))
+	}
+
+	s.WriteString("\n\n")
+	s.WriteString(m.Theme.Help.Render(m.T("help.page_back_list")))
 	return s.String()
 }
@@ -0,0 +1,100 @@
+package tui
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+)
+
+// windowsNvimPackages are the Neovim dependencies per Windows package
+// manager: winget takes one package ID per install, scoop takes them all
+var windowsNvimPackages = map[string][]string{
+	"winget": {"Neovim.Neovim", "Git.Git", "BurntSushi.ripgrep.MSVC", "sharkdp.fd", "junegunn.fzf", "OpenJS.NodeJS.LTS", "JesseDuffield.lazygit"},
+	"scoop":  {"neovim", "git", "ripgrep", "fd", "fzf", "nodejs-lts", "lazygit"},
+}
+
+// windowsAIToolPackages are the npm packages replacing the install scripts
+// of AI tools that only ship a bash installer
+var windowsAIToolPackages = map[string]string{
+	"claude":   "@anthropic-ai/claude-code",
+	"opencode": "opencode-ai",
+	"copilot":  "@github/copilot",
+}
+
+// errNoWindowsPackageManager fails the Neovim step when neither winget nor
+// scoop is on PATH
+var errNoWindowsPackageManager = errors.New("neither winget nor scoop found")
+
+// windowsInstallCommands lists the commands that install packages with pm
+func windowsInstallCommands(pm string, packages []string) []string {
+	if pm == "scoop" {
+		return []string{"scoop install " + strings.Join(packages, " ")}
+	}
+	cmds := make([]string, len(packages))
+	for i, p := range packages {
+		cmds[i] = "winget install --id " + p + " --exact --silent --accept-source-agreements --accept-package-agreements"
+	}
+	return cmds
+}
+
+// aiToolInstallCommand is the command installing tool: script elsewhere, and
+// npm on native Windows, where there is no bash to pipe the script into
+func aiToolInstallCommand(m *Model, tool, script string) string {
+	if pkg, ok := windowsAIToolPackages[tool]; ok && isWindowsChoice(m) {
+		return "npm install -g " + pkg
+	}
+	return script
+}
+
+// windowsNvimConfigDir is where Neovim looks for its config on Windows
+func windowsNvimConfigDir(home string) string {
+	if dir := os.Getenv("LOCALAPPDATA"); dir != "" {
+		return filepath.Join(dir, "nvim")
+	}
+	return filepath.Join(home, "AppData", "Local", "nvim")
+}
+
+// stepInstallNvimWindows installs Neovim and its tools with winget or scoop
+// and deploys the config to %LOCALAPPDATA%\nvim
+func stepInstallNvimWindows(m *Model) error {
+	stepID := "nvim"
+	pm := m.SystemInfo.WindowsPackageManager()
+	if pm == "" {
+		return wrapStepError("nvim", "Install Neovim",
+			"Install winget (App Installer from the Microsoft Store) or scoop (https://scoop.sh), then run the installer again",
+			errNoWindowsPackageManager)
+	}
+
+	SendLog(stepID, "Installing Neovim and dependencies with "+pm+"...")
+	for _, cmd := range windowsInstallCommands(pm, windowsNvimPackages[pm]) {
+		result := system.RunWithLogs(m.installContext(), cmd, nil, func(line string) {
+			SendLog(stepID, line)
+		})
+		if result.Error == nil {
+			continue
+		}
+		// winget exits non-zero for packages that are already installed,
+		// so only scoop failures stop the step
+		if pm == "winget" {
+			SendLog(stepID, "⚠️ "+cmd+" failed (the package may already be installed)")
+			continue
+		}
+		return wrapStepError("nvim", "Install Neovim",
+			"Failed to install Neovim and dependencies",
+			result.Error)
+	}
+
+	SendLog(stepID, "Copying Neovim configuration...")
+	srcNvim := filepath.Join(m.RepoDir, "GentlemanNvim", "nvim")
+	if err := system.DeployConfig(srcNvim, windowsNvimConfigDir(m.SystemInfo.HomeDir)); err != nil {
+		return wrapStepError("nvim", "Install Neovim",
+			"Failed to copy Neovim configuration",
+			err)
+	}
+
+	SendLog(stepID, "✓ Neovim configured with Gentleman setup")
+	return nil
+}
@@ -0,0 +1,131 @@
+package tui
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+)
+
+func TestWindowsStepPlan(t *testing.T) {
+	m := NewModel()
+	m.SystemInfo = &system.SystemInfo{OS: system.OSWindows, HasWinget: true}
+	m.Choices = UserChoices{OS: "windows", Terminal: "none", WindowMgr: "none", InstallNvim: true, AITools: []string{"claude", "gemini"}}
+	m.SetupInstallSteps()
+
+	var ids []string
+	for _, s := range m.Steps {
+		ids = append(ids, s.ID)
+	}
+	if want := []string{"clone", "nvim", "aitools", "cleanup"}; !slices.Equal(ids, want) {
+		t.Errorf("expected %v, got %v", want, ids)
+	}
+	if stepPlatform(&m) != "windows" {
+		t.Errorf("expected the windows platform, got %s", stepPlatform(&m))
+	}
+	font, _ := lookupStepSpec("font")
+	if _, ok := font.installCommands(&m); ok {
+		t.Error("Windows should not fall back to the linux font commands")
+	}
+}
+
+func TestWindowsWizardFlow(t *testing.T) {
+	m := NewModel()
+	m.SystemInfo = &system.SystemInfo{OS: system.OSWindows, HasScoop: true}
+	m.Screen = ScreenOSSelect
+	options := m.GetCurrentOptions()
+	if options[len(options)-1] != "Windows (detected)" {
+		t.Fatalf("Windows should be offered when detected, got %v", options)
+	}
+
+	m.Cursor = len(options) - 1
+	result, _ := m.handleSelection()
+	m = result.(Model)
+	if m.Screen != ScreenNvimSelect || m.Choices.OS != "windows" || m.Choices.Terminal != "none" || m.Choices.Shell != "" {
+		t.Fatalf("Windows should skip straight to Neovim, got screen %v and %+v", m.Screen, m.Choices)
+	}
+
+	m.Cursor = 0
+	result, _ = m.handleSelection()
+	m = result.(Model)
+	if m.Screen != ScreenAIToolsSelect || !m.Choices.InstallNvim {
+		t.Fatalf("Zed should be skipped, got screen %v", m.Screen)
+	}
+
+	result, _ = m.goBackInstallStep()
+	if back := result.(Model); back.Screen != ScreenNvimSelect {
+		t.Errorf("back from AI tools should return to Neovim, got %v", back.Screen)
+	}
+	result, _ = m.goBackInstallStep()
+	result, _ = result.(Model).goBackInstallStep()
+	if back := result.(Model); back.Screen != ScreenOSSelect {
+		t.Errorf("back from Neovim should return to OS selection, got %v", back.Screen)
+	}
+}
+
+func TestWindowsHiddenElsewhere(t *testing.T) {
+	m := NewModel()
+	m.SystemInfo = &system.SystemInfo{OS: system.OSDebian}
+	m.Screen = ScreenOSSelect
+	for _, opt := range m.GetCurrentOptions() {
+		if opt == "Windows (detected)" {
+			t.Error("Windows should only be offered on Windows")
+		}
+	}
+}
+
+func TestAIToolInstallCommand(t *testing.T) {
+	m := NewModel()
+	m.Choices.OS = "linux"
+	if got := aiToolInstallCommand(&m, "claude", "curl x | bash"); got != "curl x | bash" {
+		t.Errorf("the script should be used off Windows, got %q", got)
+	}
+	m.Choices.OS = "windows"
+	if got := aiToolInstallCommand(&m, "claude", "curl x | bash"); got != "npm install -g @anthropic-ai/claude-code" {
+		t.Errorf("npm should be used on Windows, got %q", got)
+	}
+}
+
+func TestWindowsInstallCommands(t *testing.T) {
+	if got := windowsInstallCommands("scoop", []string{"neovim", "fd"}); !slices.Equal(got, []string{"scoop install neovim fd"}) {
+		t.Errorf("scoop should install in one go, got %v", got)
+	}
+	got := windowsInstallCommands("winget", []string{"Neovim.Neovim", "sharkdp.fd"})
+	if len(got) != 2 || got[0] != "winget install --id Neovim.Neovim --exact --silent --accept-source-agreements --accept-package-agreements" {
+		t.Errorf("winget should install one ID per command, got %v", got)
+	}
+}
+
+func TestInstallSkillsCopiesWithoutSymlinkPrivilege(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	original, originalRefused := skillSymlink, symlinkRefused
+	skillSymlink = func(oldname, newname string) error {
+		return &os.LinkError{Op: "symlink", Old: oldname, New: newname, Err: os.ErrPermission}
+	}
+	symlinkRefused = func(err error) bool { return errors.Is(err, os.ErrPermission) }
+	t.Cleanup(func() { skillSymlink, symlinkRefused = original, originalRefused })
+
+	src := filepath.Join(t.TempDir(), "react-19")
+	os.MkdirAll(src, 0755)
+	os.WriteFile(filepath.Join(src, "SKILL.md"), []byte("---\nname: react-19\n---\n"), 0644)
+
+	results, logLines, err := installSkills([]SkillInfo{{Name: "react-19", FullPath: src}}, SkillLinkOptions{})
+	if err != nil {
+		t.Fatalf("a refused symlink should fall back to a copy, got %v (%v)", err, logLines)
+	}
+	if results[0].Status != SkillInstalled {
+		t.Errorf("expected the skill installed, got %+v", results[0])
+	}
+	for _, target := range skillTargets {
+		if _, err := os.Stat(filepath.Join(home, target.Dir, "react-19", "SKILL.md")); err != nil {
+			t.Errorf("the skill should be copied into %s: %v", target.Dir, err)
+		}
+	}
+	if !isSkillInstalled(home, "react-19") {
+		t.Error("a copied skill should count as installed")
+	}
+}
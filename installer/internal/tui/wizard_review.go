@@ -0,0 +1,237 @@
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// reviewRow is one wizard answer on ScreenWizardReview
+type reviewRow struct {
+	Label  string
+	Value  string
+	Screen Screen // Where the answer is asked, and edited from the review
+}
+
+// reviewRows lists the answers the wizard asked for, skipping the questions
+// it never asks on this platform or for these choices
+func (m Model) reviewRows() []reviewRow {
+	c := m.Choices
+	yesNo := func(b bool) string {
+		if b {
+			return m.T("wizard_review.yes")
+		}
+		return m.T("wizard_review.no")
+	}
+	orNone := func(s string) string {
+		if s == "" || s == "none" {
+			return m.T("common.none")
+		}
+		return s
+	}
+	termux := m.SystemInfo != nil && m.SystemInfo.IsTermux
+	windows := c.OS == "windows"
+
+	rows := []reviewRow{{m.T("wizard_review.os"), c.OS, ScreenOSSelect}}
+	if c.OS != "termux" && !windows {
+		rows = append(rows, reviewRow{m.T("wizard_review.terminal"), orNone(c.Terminal), ScreenTerminalSelect})
+		if c.Terminal != "none" {
+			rows = append(rows, reviewRow{m.T("wizard_review.font"), yesNo(c.InstallFont), ScreenFontSelect})
+		}
+	}
+	if !windows {
+		rows = append(rows, reviewRow{m.T("wizard_review.shell"), c.Shell, ScreenShellSelect})
+		if c.Shell == "zsh" && hasExistingZshrc() {
+			rows = append(rows, reviewRow{m.T("wizard_review.zsh_merge"), yesNo(c.ZshMerge), ScreenZshMergeSelect})
+		}
+		rows = append(rows, reviewRow{m.T("wizard_review.wm"), orNone(c.WindowMgr), ScreenWMSelect})
+		if extras := wmExtraOptions[c.WindowMgr]; len(extras) > 0 {
+			value := m.T("wizard_review.all")
+			if c.WMExtras != nil {
+				var labels []string
+				for _, id := range c.WMExtras {
+					if extra := findWMExtra(extras, id); extra != nil {
+						labels = append(labels, extra.Label)
+					}
+				}
+				value = orNone(strings.Join(labels, ", "))
+			}
+			rows = append(rows, reviewRow{m.T("wizard_review.wm_extras"), value, ScreenWMExtras})
+		}
+	}
+	rows = append(rows, reviewRow{m.T("wizard_review.nvim"), yesNo(c.InstallNvim), ScreenNvimSelect})
+	if !termux && !windows {
+		rows = append(rows, reviewRow{m.T("wizard_review.zed"), yesNo(c.InstallZed), ScreenZedSelect})
+	}
+	if !termux {
+		var names []string
+		for _, tool := range c.AITools {
+			if i := slices.Index(aiToolIDMap, tool); i >= 0 {
+				names = append(names, aiToolNames[i])
+			}
+		}
+		rows = append(rows, reviewRow{m.T("wizard_review.ai_tools"), orNone(strings.Join(names, ", ")), ScreenAIToolsSelect})
+		if len(c.AITools) > 0 && !windows {
+			value := m.T("wizard_review.no")
+			switch {
+			case c.InstallAIFramework && c.AIFrameworkPreset != "":
+				value = presetTitle(c.AIFrameworkPreset)
+			case c.InstallAIFramework:
+				value = m.Tf("wizard_review.custom", len(c.AIFrameworkModules))
+			}
+			rows = append(rows, reviewRow{m.T("wizard_review.ai_framework"), value, ScreenAIFrameworkConfirm})
+		}
+	}
+	return rows
+}
+
+// wizardReviewOptions are the answers, then installing or cancelling
+func (m Model) wizardReviewOptions() []string {
+	var opts []string
+	for _, row := range m.reviewRows() {
+		opts = append(opts, fmt.Sprintf("%-18s %s", row.Label+":", row.Value))
+	}
+	return append(opts, "─────────────", m.T("wizard_review.install"), m.T("common.cancel"))
+}
+
+// enterWizardReview ends the wizard, or an edit made from the review, on the
+// list of answers with the cursor on Install. The linear flow only asks what
+// its earlier answers allow, so only an edit needs revalidating.
+func (m Model) enterWizardReview() Model {
+	if m.ReturnToReview {
+		m = m.revalidateChoices()
+	}
+	m.ReturnToReview = false
+	m.ReviewChoices = UserChoices{}
+	m.Screen = ScreenWizardReview
+	m.Cursor = len(m.reviewRows()) + 1
+	return m
+}
+
+// revalidateChoices drops the answers an edit made moot, the way the linear
+// flow never asks for them
+func (m Model) revalidateChoices() Model {
+	c := &m.Choices
+	if c.Terminal == "none" && c.OS != "termux" {
+		c.InstallFont = false
+	}
+	if c.Shell != "zsh" {
+		c.ZshMerge = false
+	}
+	if extras := wmExtraOptions[c.WindowMgr]; len(extras) == 0 {
+		c.WMExtras = nil
+	} else if c.WMExtras != nil {
+		c.WMExtras = slices.DeleteFunc(slices.Clone(c.WMExtras), func(id string) bool {
+			return findWMExtra(extras, id) == nil
+		})
+	}
+	// Tools installed with npm need Neovim's Node.js when npm isn't there yet
+	c.AITools = slices.DeleteFunc(slices.Clone(c.AITools), func(tool string) bool {
+		return !system.AIToolAvailability(tool, c.OS, c.InstallNvim, aiToolCommandExists).Available()
+	})
+	if len(c.AITools) == 0 || c.OS == "windows" {
+		c.InstallAIFramework = false
+		c.AIFrameworkPreset = ""
+		c.AIFrameworkModules = nil
+		c.InstallAgentTeamsLite = false
+		m.AIFrameworkRecommended = false
+	} else if m.AIFrameworkRecommended {
+		// The recommendation follows the tools
+		c.AIFrameworkPreset = recommendAIPreset(c.AITools).Preset
+	}
+	return m
+}
+
+// handleWizardReviewSelection edits the answer under the cursor, or installs
+func (m Model) handleWizardReviewSelection() (tea.Model, tea.Cmd) {
+	rows := m.reviewRows()
+	switch {
+	case m.Cursor < len(rows):
+		return m.editReviewRow(rows[m.Cursor]), nil
+	case m.Cursor == len(rows)+1: // Install
+		return m.proceedToBackupOrInstall()
+	default: // Cancel
+		m.Screen = ScreenMainMenu
+		m.Cursor = 0
+		m.Choices = UserChoices{}
+	}
+	return m, nil
+}
+
+// editReviewRow opens the screen of row in edit mode, on the current answer:
+// confirming it comes back to the review, Esc drops the edit
+func (m Model) editReviewRow(row reviewRow) Model {
+	m.ReviewChoices = m.Choices
+	m.ReviewChoices.WMExtras = slices.Clone(m.Choices.WMExtras)
+	m.ReviewChoices.AITools = slices.Clone(m.Choices.AITools)
+	m.ReturnToReview = true
+	c := m.Choices
+
+	switch row.Screen {
+	case ScreenWMExtras:
+		return m.enterWMExtras()
+	case ScreenAIToolsSelect:
+		m = m.enterAIToolsSelect()
+		for i, tool := range aiToolIDMap {
+			m.AIToolSelected[i] = slices.Contains(c.AITools, tool)
+		}
+		return m
+	}
+
+	m.Screen = row.Screen
+	m.Cursor = 0
+	switch row.Screen {
+	case ScreenOSSelect:
+		m.Cursor = m.optionCursor(func(opt string) bool { return strings.Contains(strings.ToLower(opt), c.OS) })
+	case ScreenTerminalSelect:
+		m.Cursor = m.optionCursor(func(opt string) bool { return strings.ToLower(strings.Split(opt, " ")[0]) == c.Terminal })
+	case ScreenShellSelect:
+		m.Cursor = m.optionCursor(func(opt string) bool { return strings.ToLower(opt) == c.Shell })
+	case ScreenWMSelect:
+		m.Cursor = m.optionCursor(func(opt string) bool { return strings.ToLower(opt) == c.WindowMgr })
+	case ScreenFontSelect:
+		m.Cursor = yesNoCursor(c.InstallFont)
+	case ScreenZshMergeSelect:
+		m.Cursor = yesNoCursor(c.ZshMerge)
+	case ScreenNvimSelect:
+		m.Cursor = yesNoCursor(c.InstallNvim)
+	case ScreenZedSelect:
+		m.Cursor = yesNoCursor(c.InstallZed)
+	case ScreenAIFrameworkConfirm:
+		switch {
+		case !c.InstallAIFramework:
+			m.Cursor = 2 // Skip
+		case !m.AIFrameworkRecommended:
+			m.Cursor = 1 // Customize
+		}
+	}
+	return m
+}
+
+// optionCursor is the first option of the current screen that matches, as
+// the screen's handler reads it; 0 when none does
+func (m Model) optionCursor(match func(option string) bool) int {
+	for i, opt := range m.canonicalOptions() {
+		if match(opt) {
+			return i
+		}
+	}
+	return 0
+}
+
+// yesNoCursor puts the cursor on the yes/no screens' first or second option
+func yesNoCursor(yes bool) int {
+	if yes {
+		return 0
+	}
+	return 1
+}
+
+// cancelReviewEdit drops the answer being edited and goes back to the review
+func (m Model) cancelReviewEdit() Model {
+	m.Choices = m.ReviewChoices
+	return m.enterWizardReview()
+}
@@ -0,0 +1,221 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var (
+	enterKey = tea.KeyMsg{Type: tea.KeyEnter}
+	escKey   = tea.KeyMsg{Type: tea.KeyEsc}
+	downKey  = tea.KeyMsg{Type: tea.KeyDown}
+)
+
+// reviewModel is on the review with every question answered; an existing
+// .zshrc brings in the merge question
+func reviewModel(t *testing.T) Model {
+	t.Helper()
+	m := newFlowModel(t)
+	if err := os.WriteFile(filepath.Join(os.Getenv("HOME"), ".zshrc"), []byte("# mine\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	m.Choices = UserChoices{
+		OS:                 "mac",
+		Terminal:           "ghostty",
+		InstallFont:        true,
+		Shell:              "zsh",
+		ZshMerge:           true,
+		WindowMgr:          "zellij",
+		WMExtras:           []string{"statusbar"},
+		InstallNvim:        true,
+		AITools:            []string{"claude", "opencode"},
+		InstallAIFramework: true,
+		AIFrameworkPreset:  "fullstack",
+	}
+	m.AIFrameworkRecommended = true
+	return m.enterWizardReview()
+}
+
+// editRow opens the answer asked on screen from the review
+func editRow(t *testing.T, m Model, screen Screen) Model {
+	t.Helper()
+	for i, row := range m.reviewRows() {
+		if row.Screen == screen {
+			m.Cursor = i
+			m = typeKeys(t, m, enterKey)
+			if m.Screen != screen || !m.ReturnToReview {
+				t.Fatalf("editing should open %v in edit mode, got %v", screen, m.Screen)
+			}
+			return m
+		}
+	}
+	t.Fatalf("no review row for %v", screen)
+	return m
+}
+
+func TestWizardReviewListsEveryAnswer(t *testing.T) {
+	m := reviewModel(t)
+	view := m.View()
+	for _, want := range []string{"Operating system:", "ghostty", "Keep .zshrc:", "zellij", "Extras:", "Claude Code, OpenCode", "Fullstack", "Install"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("the review should show %q:\n%s", want, view)
+		}
+	}
+	if opts := m.GetCurrentOptions(); !strings.Contains(opts[m.Cursor], "Install") {
+		t.Errorf("the review should open on Install, got %q", opts[m.Cursor])
+	}
+}
+
+func TestWizardReviewEditRoundTrip(t *testing.T) {
+	base := reviewModel(t)
+	for _, row := range base.reviewRows() {
+		t.Run(row.Label, func(t *testing.T) {
+			m := editRow(t, base, row.Screen)
+			// Confirming the answer as it stands comes straight back; on the
+			// checklists Enter first moves to Confirm
+			for i := 0; i < 3 && m.Screen != ScreenWizardReview; i++ {
+				m = typeKeys(t, m, enterKey)
+			}
+			if m.Screen != ScreenWizardReview || m.ReturnToReview {
+				t.Fatalf("confirming should return to the review, got %v", m.Screen)
+			}
+			if !reflect.DeepEqual(m.Choices, base.Choices) {
+				t.Errorf("the answers changed:\n got %+v\nwant %+v", m.Choices, base.Choices)
+			}
+		})
+	}
+}
+
+func TestWizardReviewEscDropsTheEdit(t *testing.T) {
+	m := editRow(t, reviewModel(t), ScreenShellSelect)
+	m = typeKeys(t, m, downKey, escKey)
+	if m.Screen != ScreenWizardReview || m.Choices.Shell != "zsh" || !m.Choices.ZshMerge {
+		t.Errorf("esc should return to the review with the old answer, got %v with %q", m.Screen, m.Choices.Shell)
+	}
+
+	// Esc on the review itself goes back into the wizard
+	m = typeKeys(t, m, escKey)
+	if m.Screen != ScreenAIFrameworkConfirm {
+		t.Errorf("esc on the review should open the last question, got %v", m.Screen)
+	}
+}
+
+func TestWizardReviewRevalidatesDependentAnswers(t *testing.T) {
+	t.Run("no terminal means no font", func(t *testing.T) {
+		m := editRow(t, reviewModel(t), ScreenTerminalSelect)
+		m.Cursor = m.optionCursor(func(opt string) bool { return opt == "None" })
+		m = typeKeys(t, m, enterKey)
+		if m.Screen != ScreenWizardReview || m.Choices.Terminal != "none" || m.Choices.InstallFont {
+			t.Fatalf("terminal none should drop the font, got %v font=%v", m.Screen, m.Choices.InstallFont)
+		}
+		for _, row := range m.reviewRows() {
+			if row.Screen == ScreenFontSelect {
+				t.Error("the font row should be gone")
+			}
+		}
+
+		// A terminal again asks the font question it never got
+		m = editRow(t, m, ScreenTerminalSelect)
+		m.Cursor = 0 // Alacritty
+		m = typeKeys(t, m, enterKey)
+		if m.Screen != ScreenFontSelect {
+			t.Fatalf("picking a terminal should ask about the font, got %v", m.Screen)
+		}
+		m = typeKeys(t, m, enterKey)
+		if m.Screen != ScreenWizardReview || !m.Choices.InstallFont {
+			t.Errorf("the font answer should return to the review, got %v font=%v", m.Screen, m.Choices.InstallFont)
+		}
+	})
+
+	t.Run("fish drops the zsh merge", func(t *testing.T) {
+		m := editRow(t, reviewModel(t), ScreenShellSelect)
+		m.Cursor = 0 // Fish
+		m = typeKeys(t, m, enterKey)
+		if m.Screen != ScreenWizardReview || m.Choices.Shell != "fish" || m.Choices.ZshMerge {
+			t.Errorf("fish should drop the merge, got %v merge=%v", m.Screen, m.Choices.ZshMerge)
+		}
+	})
+
+	t.Run("another multiplexer asks for its extras", func(t *testing.T) {
+		m := editRow(t, reviewModel(t), ScreenWMSelect)
+		m.Cursor = 0 // Tmux
+		m = typeKeys(t, m, enterKey)
+		if m.Screen != ScreenWMExtras || flowBools(m.WMExtraSelected) != "xxx" {
+			t.Fatalf("tmux should offer all of its extras, got %v %s", m.Screen, flowBools(m.WMExtraSelected))
+		}
+		m.Cursor = len(m.canonicalOptions()) - 1
+		m = typeKeys(t, m, enterKey)
+		if m.Screen != ScreenWizardReview || flowExtras(m.Choices.WMExtras) != "essentials,resurrect,kanagawa" {
+			t.Errorf("the tmux extras should return to the review, got %v %v", m.Screen, m.Choices.WMExtras)
+		}
+
+		m = editRow(t, m, ScreenWMSelect)
+		m.Cursor = 2 // None
+		m = typeKeys(t, m, enterKey)
+		if m.Screen != ScreenWizardReview || m.Choices.WMExtras != nil {
+			t.Errorf("no multiplexer should drop the extras, got %v %v", m.Screen, m.Choices.WMExtras)
+		}
+	})
+
+	t.Run("without Neovim, npm tools need npm", func(t *testing.T) {
+		m := reviewModel(t)
+		fakeAIToolCommands(t, "curl")
+		m.Choices.AITools = []string{"claude", "gemini"}
+		m = editRow(t, m, ScreenNvimSelect)
+		m = typeKeys(t, m, downKey, enterKey)
+		if m.Screen != ScreenWizardReview || m.Choices.InstallNvim || strings.Join(m.Choices.AITools, ",") != "claude" {
+			t.Errorf("gemini can't be installed without Neovim's npm, got %v", m.Choices.AITools)
+		}
+	})
+
+	t.Run("the recommended preset follows the tools", func(t *testing.T) {
+		m := editRow(t, reviewModel(t), ScreenAIToolsSelect)
+		if flowBools(m.AIToolSelected) != "xx...." {
+			t.Fatalf("the checklist should open on the picked tools, got %s", flowBools(m.AIToolSelected))
+		}
+		m.AIToolSelected[1] = false // Drop OpenCode
+		m.Cursor = len(m.canonicalOptions()) - 1
+		m = typeKeys(t, m, enterKey)
+		if m.Screen != ScreenWizardReview || m.Choices.AIFrameworkPreset != "minimal" {
+			t.Errorf("Claude Code alone is recommended minimal, got %v %q", m.Screen, m.Choices.AIFrameworkPreset)
+		}
+	})
+
+	t.Run("no AI tools means no framework", func(t *testing.T) {
+		m := editRow(t, reviewModel(t), ScreenAIToolsSelect)
+		m.AIToolSelected = make([]bool, len(aiToolIDMap))
+		m.Cursor = len(m.canonicalOptions()) - 1
+		m = typeKeys(t, m, enterKey)
+		if m.Screen != ScreenWizardReview || m.Choices.InstallAIFramework || m.Choices.AIFrameworkPreset != "" {
+			t.Errorf("no tools should drop the framework, got %v %+v", m.Screen, m.Choices)
+		}
+	})
+
+	t.Run("another OS runs the rest of the wizard again", func(t *testing.T) {
+		m := editRow(t, reviewModel(t), ScreenOSSelect)
+		m.Cursor = 1 // Linux
+		m = typeKeys(t, m, enterKey)
+		if m.Screen != ScreenTerminalSelect || m.ReturnToReview || m.Choices.Shell != "" {
+			t.Errorf("a new OS should continue with the terminal and clear the rest, got %v %+v", m.Screen, m.Choices)
+		}
+	})
+}
+
+func TestWizardReviewInstalls(t *testing.T) {
+	m := reviewModel(t)
+	m = typeKeys(t, m, enterKey)
+	if m.Screen != ScreenInstalling && m.Screen != ScreenBackupConfirm {
+		t.Errorf("Install should go on to the install, got %v", m.Screen)
+	}
+
+	m = reviewModel(t)
+	m = typeKeys(t, m, downKey, enterKey)
+	if m.Screen != ScreenMainMenu || m.Choices.OS != "" {
+		t.Errorf("Cancel should drop the answers, got %v", m.Screen)
+	}
+}
@@ -0,0 +1,377 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// wmExtra is an optional part of a multiplexer config. Tmux extras are TPM
+// plugins written into the generated plugin list; zellij extras are files
+// deployed next to config.kdl. Either can own a marked block of the config
+// (see renderExtraBlocks) that is only kept when the extra is picked.
+type wmExtra struct {
+	ID          string
+	Label       string
+	Description string
+	Requires    string   // Extra this one is useless without
+	Plugins     []string // tmux: TPM plugin specs
+	Options     []string // tmux: settings written after the plugins
+	Files       []string // zellij: paths under GentlemanZellij/zellij
+	Fallback    []string // zellij: lines that replace the block when not picked
+}
+
+// wmExtraOptions lists the extras per multiplexer, in install order
+var wmExtraOptions = map[string][]wmExtra{
+	"tmux": {
+		{
+			ID:          "essentials",
+			Label:       "Essential plugins",
+			Description: "Sensible defaults, clipboard yank, vim-tmux-navigator, which-key",
+			Plugins: []string{
+				"tmux-plugins/tmux-sensible",
+				"tmux-plugins/tmux-yank",
+				"christoomey/vim-tmux-navigator",
+				"alexwforsythe/tmux-which-key",
+			},
+		},
+		{
+			ID:          "resurrect",
+			Label:       "Session restore",
+			Description: "tmux-resurrect + tmux-continuum keep sessions across restarts",
+			Plugins:     []string{"tmux-plugins/tmux-resurrect", "tmux-plugins/tmux-continuum"},
+		},
+		{
+			ID:          "kanagawa",
+			Label:       "Kanagawa status bar",
+			Description: "Themed status bar with git, CPU and RAM",
+			Plugins:     []string{"Nybkox/tmux-kanagawa"},
+			Options: []string{
+				"set -g @kanagawa-theme 'Kanagawa'",
+				`set -g @kanagawa-plugins "git cpu-usage ram-usage"`,
+				"set -g @kanagawa-ignore-window-colors true",
+			},
+		},
+	},
+	"zellij": {
+		{
+			ID:          "layouts",
+			Label:       "Gentleman layouts",
+			Description: "nvim + shell work layouts in five themes, Kanagawa on startup",
+			Files:       []string{"layouts"},
+		},
+		{
+			ID:          "statusbar",
+			Label:       "Gentleman status bar",
+			Description: "zjstatus bar with session, tabs, git branch and clock (needs the layouts)",
+			Requires:    "layouts",
+			Files:       []string{"plugins/zjstatus.wasm"},
+			Fallback: []string{
+				"pane size=1 borderless=true {",
+				`    plugin location="zellij:compact-bar"`,
+				"}",
+			},
+		},
+		{
+			ID:          "forgot",
+			Label:       "Keybinding cheatsheet",
+			Description: "zellij-forgot popup on Alt+y",
+			Files:       []string{"plugins/zellij_forgot.wasm"},
+		},
+	},
+}
+
+// selectedWMExtras resolves which extras of wm to install. A nil pick means
+// every extra, so non-interactive installs and redeploys keep the full config;
+// an empty one means none. Extras whose requirement isn't picked are dropped.
+func selectedWMExtras(wm string, picked []string) []wmExtra {
+	options := wmExtraOptions[wm]
+	if picked == nil {
+		return options
+	}
+	isPicked := make(map[string]bool, len(picked))
+	for _, id := range picked {
+		isPicked[id] = true
+	}
+	var extras []wmExtra
+	for _, extra := range options {
+		if isPicked[extra.ID] && (extra.Requires == "" || isPicked[extra.Requires]) {
+			extras = append(extras, extra)
+		}
+	}
+	return extras
+}
+
+// wmExtraIDs returns the IDs of extras, or "none" when there are none
+func wmExtraIDs(extras []wmExtra) []string {
+	if len(extras) == 0 {
+		return []string{"none"}
+	}
+	ids := make([]string, len(extras))
+	for i, extra := range extras {
+		ids[i] = extra.ID
+	}
+	return ids
+}
+
+// findWMExtra returns the extra with the given ID, or nil
+func findWMExtra(extras []wmExtra, id string) *wmExtra {
+	for i := range extras {
+		if extras[i].ID == id {
+			return &extras[i]
+		}
+	}
+	return nil
+}
+
+// renderExtraBlocks rewrites the blocks of content marked with
+//
+//	<comment> >>> gentleman:<name>
+//	<comment> <<< gentleman:<name>
+//
+// replacing each with the lines body returns for it, given the indentation of
+// the opening marker. The markers are dropped; so is the blank line after a
+// block that renders to nothing. Unterminated blocks are left as they are.
+func renderExtraBlocks(content, comment string, body func(name, indent string, inner []string) []string) string {
+	open, end := comment+" >>> gentleman:", comment+" <<< gentleman:"
+	lines := strings.Split(content, "\n")
+	var out []string
+	for i := 0; i < len(lines); i++ {
+		trimmed := strings.TrimSpace(lines[i])
+		if !strings.HasPrefix(trimmed, open) {
+			out = append(out, lines[i])
+			continue
+		}
+		name := strings.TrimPrefix(trimmed, open)
+		closing := -1
+		for j := i + 1; j < len(lines); j++ {
+			if strings.TrimSpace(lines[j]) == end+name {
+				closing = j
+				break
+			}
+		}
+		if closing < 0 {
+			out = append(out, lines[i])
+			continue
+		}
+		indent := lines[i][:len(lines[i])-len(strings.TrimLeft(lines[i], " \t"))]
+		rendered := body(name, indent, lines[i+1:closing])
+		out = append(out, rendered...)
+		i = closing
+		if len(rendered) == 0 && i+1 < len(lines) && strings.TrimSpace(lines[i+1]) == "" {
+			i++
+		}
+	}
+	return strings.Join(out, "\n")
+}
+
+// templateTmuxConf writes the TPM plugin list of the picked extras into
+// tmux.conf. Without extras the plugin list and the TPM init are removed.
+func templateTmuxConf(content string, extras []wmExtra) string {
+	return renderExtraBlocks(content, "#", func(name, indent string, inner []string) []string {
+		if len(extras) == 0 {
+			return nil
+		}
+		if name == "plugins" {
+			return tmuxPluginList(extras)
+		}
+		return inner
+	})
+}
+
+// tmuxPluginList generates the @plugin lines for TPM and the picked extras
+func tmuxPluginList(extras []wmExtra) []string {
+	lines := []string{"# Plugins (picked in the installer)", "set -g @plugin 'tmux-plugins/tpm'"}
+	for _, extra := range extras {
+		lines = append(lines, "", "# "+extra.Label)
+		for _, plugin := range extra.Plugins {
+			lines = append(lines, "set -g @plugin '"+plugin+"'")
+		}
+		lines = append(lines, extra.Options...)
+	}
+	return lines
+}
+
+// templateZellijConfig keeps the blocks of config.kdl or a layout that belong
+// to picked extras and swaps the others for their fallback, if any
+func templateZellijConfig(content string, extras []wmExtra) string {
+	return renderExtraBlocks(content, "//", func(name, indent string, inner []string) []string {
+		if findWMExtra(extras, name) != nil {
+			return inner
+		}
+		extra := findWMExtra(wmExtraOptions["zellij"], name)
+		if extra == nil {
+			return inner
+		}
+		fallback := make([]string, len(extra.Fallback))
+		for i, line := range extra.Fallback {
+			fallback[i] = indent + line
+		}
+		return fallback
+	})
+}
+
+// templateConfigFile rewrites path with fn, leaving it untouched when nothing changes
+func templateConfigFile(path string, fn func(string) string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if out := fn(string(data)); out != string(data) {
+		return os.WriteFile(path, []byte(out), 0644)
+	}
+	return nil
+}
+
+// templateZellijDir templates config.kdl and the layouts in dir
+func templateZellijDir(dir string, extras []wmExtra) error {
+	apply := func(content string) string { return templateZellijConfig(content, extras) }
+	if err := templateConfigFile(filepath.Join(dir, "config.kdl"), apply); err != nil {
+		return err
+	}
+	layouts, _ := filepath.Glob(filepath.Join(dir, "layouts", "*.kdl"))
+	for _, layout := range layouts {
+		if err := templateConfigFile(layout, apply); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// wmDisplayName capitalizes a multiplexer ID for titles
+func wmDisplayName(wm string) string {
+	if wm == "" {
+		return wm
+	}
+	return strings.ToUpper(wm[:1]) + wm[1:]
+}
+
+// enterWMExtras opens the extras checklist for the chosen multiplexer, checked
+// as picked before (everything the first time), or moves on to Neovim when it
+// has no extras
+func (m Model) enterWMExtras() Model {
+	extras := wmExtraOptions[m.Choices.WindowMgr]
+	m.Cursor = 0
+	if len(extras) == 0 {
+		m.Choices.WMExtras = nil
+		m.WMExtraSelected = nil
+		m.Screen = ScreenNvimSelect
+		return m
+	}
+	m.WMExtraSelected = make([]bool, len(extras))
+	for i, extra := range extras {
+		m.WMExtraSelected[i] = m.Choices.WMExtras == nil || slices.Contains(m.Choices.WMExtras, extra.ID)
+	}
+	m.Screen = ScreenWMExtras
+	return m
+}
+
+// wmExtrasOptions lists the extras of the chosen multiplexer plus the confirm action
+func (m Model) wmExtrasOptions() []string {
+	var opts []string
+	for _, extra := range wmExtraOptions[m.Choices.WindowMgr] {
+		opts = append(opts, extra.Label)
+	}
+	return append(opts, "─────────────", "✅ Confirm selection")
+}
+
+func (m Model) handleWMExtrasKeys(key string) (tea.Model, tea.Cmd) {
+	options := m.canonicalOptions()
+	extras := wmExtraOptions[m.Choices.WindowMgr]
+	confirmIdx := len(options) - 1
+
+	switch key {
+	case "up", "k":
+		if m.Cursor > 0 {
+			m.Cursor--
+			if strings.HasPrefix(options[m.Cursor], "───") && m.Cursor > 0 {
+				m.Cursor--
+			}
+		}
+	case "down", "j":
+		if m.Cursor < len(options)-1 {
+			m.Cursor++
+			if strings.HasPrefix(options[m.Cursor], "───") && m.Cursor < len(options)-1 {
+				m.Cursor++
+			}
+		}
+	case "enter", " ":
+		if !m.multiSelectRuns(key, m.Cursor < len(extras), confirmIdx) {
+			break
+		}
+		if m.Cursor < len(extras) && m.Cursor < len(m.WMExtraSelected) {
+			m.WMExtraSelected[m.Cursor] = !m.WMExtraSelected[m.Cursor]
+		} else if m.Cursor == confirmIdx {
+			// Non-nil even when empty: nothing picked means a minimal config
+			picked := []string{}
+			for i, sel := range m.WMExtraSelected {
+				if sel && i < len(extras) {
+					picked = append(picked, extras[i].ID)
+				}
+			}
+			m.Choices.WMExtras = picked
+			if m.ReturnToReview {
+				return m.enterWizardReview(), nil
+			}
+			m.Screen = ScreenNvimSelect
+			m.Cursor = 0
+		}
+	case "backspace":
+		return m.goBackInstallStep()
+	}
+
+	return m, nil
+}
+
+func (m Model) renderWMExtrasSelection() string {
+	var s strings.Builder
+
+	s.WriteString(m.renderStepProgress())
+	s.WriteString("\n\n")
+
+	s.WriteString(m.Theme.Title.Render(m.GetScreenTitle()))
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Muted.Render(m.GetScreenDescription()))
+	s.WriteString("\n\n")
+
+	extras := wmExtraOptions[m.Choices.WindowMgr]
+	for i, opt := range m.GetCurrentOptions() {
+		if strings.HasPrefix(opt, "───") {
+			s.WriteString(m.Theme.Muted.Render(opt))
+			s.WriteString("\n")
+			continue
+		}
+
+		cursor := "  "
+		style := m.Theme.Unselected
+		if i == m.Cursor {
+			cursor = "▸ "
+			style = m.Theme.Selected
+		}
+
+		if i >= len(extras) {
+			m.VisibleRows.mark(&s, i)
+			s.WriteString(m.renderRow(style, cursor+opt))
+			s.WriteString("\n")
+			continue
+		}
+
+		checkbox := "[ ] "
+		if i < len(m.WMExtraSelected) && m.WMExtraSelected[i] {
+			checkbox = "[✓] "
+		}
+		m.VisibleRows.mark(&s, i)
+		s.WriteString(m.renderRow(style, cursor+checkbox+opt))
+		s.WriteString("\n")
+		s.WriteString(m.Theme.Muted.Render("      " + extras[i].Description))
+		s.WriteString("\n")
+	}
+
+	s.WriteString("\n")
+	s.WriteString(m.Theme.Help.Render("↑/k up • ↓/j down • [Space] toggle • [Enter] confirm • [Esc] back"))
+
+	return s.String()
+}
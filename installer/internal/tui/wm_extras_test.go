@@ -0,0 +1,233 @@
+package tui
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Gentleman-Programming/Gentleman.Dots/installer/internal/system"
+	"github.com/charmbracelet/x/exp/golden"
+)
+
+func TestSelectedWMExtras(t *testing.T) {
+	tests := []struct {
+		name   string
+		wm     string
+		picked []string
+		want   []string
+	}{
+		{"nil picks everything", "tmux", nil, []string{"essentials", "resurrect", "kanagawa"}},
+		{"empty picks nothing", "tmux", []string{}, []string{"none"}},
+		{"install order, not pick order", "tmux", []string{"kanagawa", "essentials"}, []string{"essentials", "kanagawa"}},
+		{"status bar needs the layouts", "zellij", []string{"statusbar", "forgot"}, []string{"forgot"}},
+		{"status bar with the layouts", "zellij", []string{"statusbar", "layouts"}, []string{"layouts", "statusbar"}},
+		{"no extras without a multiplexer", "none", nil, []string{"none"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := wmExtraIDs(selectedWMExtras(tt.wm, tt.picked))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("selectedWMExtras(%q, %v) = %v, want %v", tt.wm, tt.picked, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderExtraBlocksLeavesUnterminatedBlocks(t *testing.T) {
+	content := "a\n# >>> gentleman:plugins\nb\n"
+	got := renderExtraBlocks(content, "#", func(string, string, []string) []string { return nil })
+	if got != content {
+		t.Errorf("an unterminated block should be left alone, got %q", got)
+	}
+}
+
+func readWMFixture(t *testing.T, name string) string {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", "wm", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+var wmExtraPicks = []struct {
+	name   string
+	picked []string
+}{
+	{"all", nil},
+	{"none", []string{}},
+	{"some", []string{"resurrect", "statusbar", "forgot"}},
+}
+
+func TestTemplateTmuxConf(t *testing.T) {
+	conf := readWMFixture(t, "tmux.conf")
+	for _, pick := range wmExtraPicks {
+		t.Run(pick.name, func(t *testing.T) {
+			golden.RequireEqual(t, []byte(templateTmuxConf(conf, selectedWMExtras("tmux", pick.picked))))
+		})
+	}
+}
+
+func TestTemplateZellijConfig(t *testing.T) {
+	conf := readWMFixture(t, "config.kdl")
+	layout := readWMFixture(t, "layout.kdl")
+	for _, pick := range wmExtraPicks {
+		t.Run(pick.name, func(t *testing.T) {
+			extras := selectedWMExtras("zellij", pick.picked)
+			out := templateZellijConfig(conf, extras) + "\n--- layout ---\n" + templateZellijConfig(layout, extras)
+			golden.RequireEqual(t, []byte(out))
+		})
+	}
+}
+
+// wmStepFixture fakes a repo clone with the tmux and zellij configs and puts
+// stub tmux and zellij binaries on PATH so the WM step skips the package install
+func wmStepFixture(t *testing.T, wm string, extras []string) (*Model, string) {
+	t.Helper()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	repo := t.TempDir()
+
+	files := map[string]string{
+		"GentlemanTmux/tmux.conf":                           readWMFixture(t, "tmux.conf"),
+		"GentlemanZellij/zellij/config.kdl":                 readWMFixture(t, "config.kdl"),
+		"GentlemanZellij/zellij/layouts/work.kdl":           readWMFixture(t, "layout.kdl"),
+		"GentlemanZellij/zellij/plugins/zjstatus.wasm":      "wasm",
+		"GentlemanZellij/zellij/plugins/zellij_forgot.wasm": "wasm",
+	}
+	for rel, content := range files {
+		path := filepath.Join(repo, rel)
+		os.MkdirAll(filepath.Dir(path), 0755)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	bin := t.TempDir()
+	for _, name := range []string{"tmux", "zellij"} {
+		os.WriteFile(filepath.Join(bin, name), []byte("#!/bin/sh\n"), 0755)
+	}
+	t.Setenv("PATH", bin)
+
+	m := NewModel()
+	m.RepoDir = repo
+	m.SystemInfo = &system.SystemInfo{OS: system.OSMac, HomeDir: home}
+	m.Choices = UserChoices{WindowMgr: wm, WMExtras: extras}
+	return &m, home
+}
+
+func TestStepInstallWMMinimalTmux(t *testing.T) {
+	m, home := wmStepFixture(t, "tmux", []string{})
+	if err := runStep(context.Background(), "wm", m); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(home, ".tmux.conf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if conf := string(data); strings.Contains(conf, "@plugin") || strings.Contains(conf, "tpm") || !strings.Contains(conf, "set -g mouse on") {
+		t.Errorf("a minimal tmux.conf keeps the settings and drops TPM, got:\n%s", conf)
+	}
+	if _, err := os.Stat(filepath.Join(home, ".tmux", "plugins")); !os.IsNotExist(err) {
+		t.Error("no plugins should be deployed without extras")
+	}
+}
+
+func TestStepInstallWMZellijExtras(t *testing.T) {
+	tests := []struct {
+		name     string
+		extras   []string
+		present  []string
+		absent   []string
+		contains map[string]string // File under ~/.config/zellij -> expected text
+		excludes map[string]string
+	}{
+		{
+			name:     "minimal",
+			extras:   []string{},
+			present:  []string{"config.kdl"},
+			absent:   []string{"layouts", "plugins"},
+			excludes: map[string]string{"config.kdl": "default_layout"},
+		},
+		{
+			name:     "layouts without the status bar",
+			extras:   []string{"layouts", "forgot"},
+			present:  []string{"layouts/work.kdl", "plugins/zellij_forgot.wasm"},
+			absent:   []string{"plugins/zjstatus.wasm"},
+			contains: map[string]string{"config.kdl": `bind "Alt y"`, "layouts/work.kdl": "zellij:compact-bar"},
+			excludes: map[string]string{"layouts/work.kdl": "zjstatus"},
+		},
+		{
+			name:     "everything",
+			extras:   nil,
+			present:  []string{"layouts/work.kdl", "plugins/zjstatus.wasm", "plugins/zellij_forgot.wasm"},
+			contains: map[string]string{"config.kdl": `default_layout "work_kanagawa"`, "layouts/work.kdl": "zjstatus"},
+			excludes: map[string]string{"config.kdl": "gentleman:"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, home := wmStepFixture(t, "zellij", tt.extras)
+			if err := runStep(context.Background(), "wm", m); err != nil {
+				t.Fatal(err)
+			}
+			dir := filepath.Join(home, ".config", "zellij")
+			for _, rel := range tt.present {
+				if _, err := os.Stat(filepath.Join(dir, rel)); err != nil {
+					t.Errorf("%s should be deployed: %v", rel, err)
+				}
+			}
+			for _, rel := range tt.absent {
+				if _, err := os.Stat(filepath.Join(dir, rel)); !os.IsNotExist(err) {
+					t.Errorf("%s should not be deployed", rel)
+				}
+			}
+			for rel, want := range tt.contains {
+				if data, _ := os.ReadFile(filepath.Join(dir, rel)); !strings.Contains(string(data), want) {
+					t.Errorf("%s should contain %q, got:\n%s", rel, want, data)
+				}
+			}
+			for rel, unwanted := range tt.excludes {
+				if data, _ := os.ReadFile(filepath.Join(dir, rel)); strings.Contains(string(data), unwanted) {
+					t.Errorf("%s should not contain %q, got:\n%s", rel, unwanted, data)
+				}
+			}
+		})
+	}
+}
+
+func TestWMExtrasScreenNoneSkipsIt(t *testing.T) {
+	m := NewModel()
+	m.Screen = ScreenWMSelect
+	m.Cursor = 2 // None
+	m.Choices.WMExtras = []string{"layouts"}
+
+	m, _ = simulateKeyPress(m, "enter")
+	if m.Screen != ScreenNvimSelect || m.Choices.WMExtras != nil {
+		t.Errorf("no multiplexer should skip the extras, got %v with %v", m.Screen, m.Choices.WMExtras)
+	}
+
+	m.Screen = ScreenNvimSelect
+	m, _ = simulateKeyPress(m, "esc")
+	if m.Screen != ScreenWMSelect {
+		t.Errorf("back from Neovim should skip the extras too, got %v", m.Screen)
+	}
+}
+
+func TestRenderWMExtrasSelection(t *testing.T) {
+	m := NewModel()
+	m.Choices.WindowMgr = "zellij"
+	m = m.enterWMExtras()
+	m.WMExtraSelected[1] = false
+
+	view := m.renderWMExtrasSelection()
+	for _, want := range []string{"Step 5: Zellij Extras", "[✓] Gentleman layouts", "[ ] Gentleman status bar", "zellij-forgot popup on Alt+y", "✅ Confirm selection"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("view should show %q, got:\n%s", want, view)
+		}
+	}
+}